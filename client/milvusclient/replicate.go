@@ -5,10 +5,12 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/metricsinfo"
 )
 
 // UpdateReplicateConfiguration updates the replicate configuration to the Milvus cluster.
@@ -46,6 +48,27 @@ func (c *Client) GetReplicateInfo(ctx context.Context, req *milvuspb.GetReplicat
 	return resp, err
 }
 
+// GetClusterChannels gets the control channel and all pchannels of the Milvus cluster,
+// the pchannels are ordered by the index of the channel name.
+func (c *Client) GetClusterChannels(ctx context.Context, opts ...grpc.CallOption) (*milvuspb.ClusterInfo, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.ClusterChannelsMetrics)
+	if err != nil {
+		return nil, err
+	}
+	info := &milvuspb.ClusterInfo{}
+	err = c.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err := milvusService.GetMetrics(ctx, req, opts...)
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+		return protojson.Unmarshal([]byte(resp.GetResponse()), info)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
 // CreateReplicateStream creates a replicate stream
 func (c *Client) CreateReplicateStream(ctx context.Context, opts ...grpc.CallOption) (milvuspb.MilvusService_CreateReplicateStreamClient, error) {
 	var streamClient milvuspb.MilvusService_CreateReplicateStreamClient
@@ -83,6 +83,36 @@ func (s *ReplicateSuite) TestGetReplicateConfiguration() {
 	})
 }
 
+func (s *ReplicateSuite) TestGetClusterChannels() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		s.mock.EXPECT().GetMetrics(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+				s.Contains(req.GetRequest(), "cluster_channels")
+				return &milvuspb.GetMetricsResponse{
+					Status:   merr.Success(),
+					Response: `{"clusterId":"by-dev","cchannel":"by-dev-rootcoord-dml_0_vcchan","pchannels":["by-dev-rootcoord-dml_0","by-dev-rootcoord-dml_1"]}`,
+				}, nil
+			}).Once()
+
+		info, err := s.client.GetClusterChannels(ctx)
+		s.NoError(err)
+		s.Equal("by-dev", info.GetClusterId())
+		s.Equal("by-dev-rootcoord-dml_0_vcchan", info.GetCchannel())
+		s.Equal([]string{"by-dev-rootcoord-dml_0", "by-dev-rootcoord-dml_1"}, info.GetPchannels())
+	})
+
+	s.Run("failure", func() {
+		s.mock.EXPECT().GetMetrics(mock.Anything, mock.Anything).
+			Return(&milvuspb.GetMetricsResponse{Status: merr.Status(merr.WrapErrMetricNotFound("cluster_channels"))}, nil).Once()
+
+		_, err := s.client.GetClusterChannels(ctx)
+		s.Error(err)
+	})
+}
+
 func TestReplicate(t *testing.T) {
 	suite.Run(t, new(ReplicateSuite))
 }
@@ -0,0 +1,75 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	ChannelStateCmd = "channel-state"
+
+	ChannelStateTypeExport = "export"
+)
+
+// channelState fetches the state of the channel manager of streamingcoord for the support bundle.
+type channelState struct {
+	etcdIP  string
+	output  string
+	timeout time.Duration
+}
+
+func (c *channelState) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 || args[2] != ChannelStateTypeExport {
+		fmt.Fprintln(os.Stderr, channelStateLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, channelStateLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.output, "output", "", "File to write the state, stdout if empty")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	resp, err := c.export()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to export channel state: %s\n", err)
+		os.Exit(1)
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp.GetState())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal channel state: %s\n", err)
+		os.Exit(1)
+	}
+	if c.output == "" {
+		fmt.Fprintln(os.Stdout, string(data))
+		return
+	}
+	if err := os.WriteFile(c.output, data, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write channel state to %s: %s\n", c.output, err)
+		os.Exit(1)
+	}
+}
+
+// export fetches the channel manager state from the streamingcoord.
+func (c *channelState) export() (*streamingpb.ExportStateResponse, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().ExportState(ctx, &streamingpb.ExportStateRequest{})
+}
@@ -13,7 +13,7 @@ const (
 
 var (
 	usageLine = fmt.Sprintf("Usage:\n"+
-		"%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, serverTypeLine)
+		"%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n", runLine, stopLine, mckLine, pchannelPoolLine, pchannelRetentionLine, pchannelTopicLine, channelStateLine, replicateTaskLine, promoteSecondaryLine, replicatePlanLine, replicateHistoryLine, replicateRBACLine, replicateFilterLine, serverTypeLine)
 
 	serverTypeLine = `
 [server type]
@@ -64,5 +64,165 @@ milvus mck run [flags]
 milvus mck cleanTrash [flags]
 	Clean the back inconsistent data
 	Tips: The flags is the same as its of the 'milvus mck [flags]'
+`
+	pchannelPoolLine = `
+milvus pchannel-pool [list|set|drop] [flags]
+	Manage the pchannel pools bound to databases.
+	The vchannels of the collections in the bound databases are only allocated from the pchannels of the pool.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-name ''
+		The name of the pchannel pool, required by set and drop.
+	-pchannels ''
+		Comma separated pchannels of the pool, required by set.
+	-databases ''
+		Comma separated databases bound to the pool.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	pchannelRetentionLine = `
+milvus pchannel-retention [list|set|clear] [flags]
+	Manage the retention policies of the wal of pchannels.
+	The wal is retained until it is out of both the ttl and the max bytes of the policy, and is never truncated
+	before the checkpoints of the flusher and the replicating tasks from the pchannel.
+	The policy only takes effect on the wal implementation that supports truncation, e.g. woodpecker.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-pchannels ''
+		Comma separated pchannels, required by set and clear.
+	-ttl '0s'
+		The max age of the retained wal, 0 means unlimited.
+	-maxBytes '0'
+		The max bytes of the retained wal, 0 means unlimited, at least one of ttl and maxBytes is required by set.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	pchannelTopicLine = `
+milvus pchannel-topic migrate [flags]
+	Migrate the wal of pchannels to other topics of mq, e.g. the cluster is moved to another pulsar namespace.
+	The pchannels are fenced and flushed, then the wal is switched to the earliest message of the new topic.
+	It is rejected when the cluster is replicating.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-topics ''
+		Comma separated pchannel=topic pairs, e.g. 'by-dev-rootcoord-dml_0=ns2-dml_0'.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	channelStateLine = `
+milvus channel-state export [flags]
+	Export a consistent snapshot of the channel manager state of streamingcoord as json,
+	including the pchannel metas, the pchannel stats, the replicate configuration and the version info.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-output ''
+		The file to write the state, the state is printed to stdout if empty.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	replicateTaskLine = `
+milvus replicate-task [list|pause|resume|reset-checkpoint|dead-letters|requeue|update-connection] [flags]
+	List the replicating tasks from current cluster with the runtime state, the streaming node of the source pchannel,
+	the checkpoint and lag confirmed by the target cluster and the recent error.
+	Pause or resume the replicating tasks from current cluster to a target cluster,
+	the paused task is resumed from the checkpoint of the target cluster.
+	Resume the bootstrapping tasks after the existing data is copied into the target cluster,
+	the replication starts from the initialized checkpoint of the task.
+	Reset the start position of a replicating task with reset-checkpoint, e.g. the target cluster is restored from backup
+	or the checkpoint of the target cluster conflicts with the task.
+	List the dead letters of the replicating tasks parked after too many rejections by the target cluster with dead-letters,
+	and resume the parked tasks with requeue after the cause of the rejection is fixed.
+	Update the uri and token of the target cluster with update-connection without broadcasting the replicate configuration,
+	the replicating tasks reconnect the target cluster with the new connection param.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-targetCluster ''
+		The id of the target cluster, required except list and dead-letters, all target clusters are listed if empty.
+	-sourceChannel ''
+		The source pchannel of the replicating task, all tasks to the target cluster are updated if empty.
+		Required by reset-checkpoint.
+	-policy ''
+		The reset policy of reset-checkpoint, one of checkpoint, earliest, latest and none.
+	-checkpoint ''
+		The json of the replicate checkpoint at the source pchannel, required by the checkpoint policy.
+	-ignoreRetentionWindow false
+		Reset to the checkpoint older than the retention window of the source wal.
+	-uri ''
+		The new uri of the target cluster, required by update-connection.
+	-token ''
+		The new token of the target cluster used by update-connection.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	promoteSecondaryLine = `
+milvus promote-secondary [flags]
+	Switch the primary of the replication from current cluster to a direct secondary cluster.
+	The writes on current cluster are fenced at once, and the command returns after the secondary cluster
+	confirms the switchover, so no replication lag is left. It's safe to retry after timeout.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server of current primary cluster.
+	-targetCluster ''
+		The id of the secondary cluster to be promoted, required.
+	-timeout '5m'
+		Timeout of waiting the switchover to be confirmed.
+`
+	replicatePlanLine = `
+milvus replicate-plan [flags]
+	Compute the changes of current cluster made by a replicate configuration without applying it,
+	including the replicating tasks created or removed and the channels whose availability in replication is flipped.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-config ''
+		The json file of the replicate configuration, required.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	replicateHistoryLine = `
+milvus replicate-history [flags]
+	List the accepted replicate configurations of current cluster ordered by version,
+	with the time, the operator and the broadcast id of every change.
+	Print the json of the replicate configuration at a version with -version, which can be applied again to roll back.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server.
+	-version 0
+		The version of the replicate configuration to print, all versions are listed if 0.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	replicateRBACLine = `
+milvus replicate-rbac [enable|disable] [flags]
+	Enable or disable the replication of the users, roles and privileges into the secondary clusters.
+	The setting is applied with the replicate configuration, so run it on the primary cluster.
+	If disabled, the secondary clusters ignore the replicated rbac operations and accept the rbac operations sent to them directly.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server of the primary cluster.
+	-timeout '30s'
+		Timeout of the operation.
+`
+	replicateFilterLine = `
+milvus replicate-filter [set|clear] [flags]
+	Set or clear the collection filter of a target cluster, so only the selected databases and collections are replicated into it.
+	The filter is applied with the replicate configuration by the cdc of the primary cluster, so run it on the primary cluster.
+	A rule is <database>.<collection> or <database>, and * matches any name. The excludes take precedence over the includes.
+[flags]
+	-etcdIp ''
+		Ip to connect the ectd server of the primary cluster.
+	-target ''
+		The cluster id of the target cluster, required.
+	-includes ''
+		Comma-separated list of the databases or collections replicated, empty means all.
+	-excludes ''
+		Comma-separated list of the databases or collections not replicated.
+	-timeout '30s'
+		Timeout of the operation.
 `
 )
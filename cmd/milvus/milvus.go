@@ -54,6 +54,26 @@ func RunMilvus(args []string) {
 		c = &dryRun{}
 	case MckCmd:
 		c = &mck{}
+	case PChannelPoolCmd:
+		c = &pchannelPool{}
+	case PChannelRetentionCmd:
+		c = &pchannelRetention{}
+	case PChannelTopicCmd:
+		c = &pchannelTopic{}
+	case ChannelStateCmd:
+		c = &channelState{}
+	case ReplicateTaskCmd:
+		c = &replicateTask{}
+	case PromoteSecondaryCmd:
+		c = &promoteSecondary{}
+	case ReplicatePlanCmd:
+		c = &replicatePlan{}
+	case ReplicateHistoryCmd:
+		c = &replicateHistory{}
+	case ReplicateRBACCmd:
+		c = &replicateRBAC{}
+	case ReplicateFilterCmd:
+		c = &replicateFilter{}
 	default:
 		c = &defaultCommand{}
 	}
@@ -0,0 +1,106 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	PChannelPoolCmd = "pchannel-pool"
+
+	PChannelPoolTypeList = "list"
+	PChannelPoolTypeSet  = "set"
+	PChannelPoolTypeDrop = "drop"
+)
+
+// pchannelPool manages the pchannel pools bound to databases through the streamingcoord.
+type pchannelPool struct {
+	etcdIP    string
+	name      string
+	pchannels string
+	databases string
+	timeout   time.Duration
+}
+
+func (c *pchannelPool) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, pchannelPoolLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, pchannelPoolLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.name, "name", "", "Name of the pchannel pool")
+	flags.StringVar(&c.pchannels, "pchannels", "", "Comma separated pchannels of the pool")
+	flags.StringVar(&c.databases, "databases", "", "Comma separated databases bound to the pool")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	req := &streamingpb.UpdatePChannelPoolsRequest{}
+	switch args[2] {
+	case PChannelPoolTypeList:
+	case PChannelPoolTypeSet:
+		if c.name == "" || c.pchannels == "" {
+			fmt.Fprintln(os.Stderr, pchannelPoolLine)
+			os.Exit(1)
+		}
+		req.UpsertPools = []*streamingpb.PChannelPoolMeta{{
+			Name:      c.name,
+			Pchannels: splitPChannelPoolFlag(c.pchannels),
+			Databases: splitPChannelPoolFlag(c.databases),
+		}}
+	case PChannelPoolTypeDrop:
+		if c.name == "" {
+			fmt.Fprintln(os.Stderr, pchannelPoolLine)
+			os.Exit(1)
+		}
+		req.DropPools = []string{c.name}
+	default:
+		fmt.Fprintln(os.Stderr, pchannelPoolLine)
+		return
+	}
+
+	resp, err := c.update(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update pchannel pools: %s\n", err)
+		os.Exit(1)
+	}
+	for _, pool := range resp.GetPools() {
+		fmt.Fprintf(os.Stdout, "%s\tpchannels=%s\tdatabases=%s\n",
+			pool.GetName(), strings.Join(pool.GetPchannels(), ","), strings.Join(pool.GetDatabases(), ","))
+	}
+}
+
+// update sends the update request to the streamingcoord.
+func (c *pchannelPool) update(req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().UpdatePChannelPools(ctx, req)
+}
+
+// splitPChannelPoolFlag splits the comma separated flag value and drops the empty items.
+func splitPChannelPoolFlag(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
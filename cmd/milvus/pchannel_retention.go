@@ -0,0 +1,99 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	PChannelRetentionCmd = "pchannel-retention"
+
+	PChannelRetentionTypeList  = "list"
+	PChannelRetentionTypeSet   = "set"
+	PChannelRetentionTypeClear = "clear"
+)
+
+// pchannelRetention manages the retention policies of the wal of pchannels through the streamingcoord.
+type pchannelRetention struct {
+	etcdIP    string
+	pchannels string
+	ttl       time.Duration
+	maxBytes  uint64
+	timeout   time.Duration
+}
+
+func (c *pchannelRetention) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, pchannelRetentionLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, pchannelRetentionLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.pchannels, "pchannels", "", "Comma separated pchannels to update")
+	flags.DurationVar(&c.ttl, "ttl", 0, "Max age of the retained wal")
+	flags.Uint64Var(&c.maxBytes, "maxBytes", 0, "Max bytes of the retained wal")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	req := &streamingpb.UpdatePChannelRetentionPoliciesRequest{}
+	switch args[2] {
+	case PChannelRetentionTypeList:
+	case PChannelRetentionTypeSet:
+		pchannels := splitPChannelPoolFlag(c.pchannels)
+		if len(pchannels) == 0 || (c.ttl <= 0 && c.maxBytes == 0) {
+			fmt.Fprintln(os.Stderr, pchannelRetentionLine)
+			os.Exit(1)
+		}
+		for _, pchannel := range pchannels {
+			req.Policies = append(req.Policies, &streamingpb.PChannelRetentionPolicy{
+				Pchannel: pchannel,
+				Policy: &streamingpb.WALRetentionPolicy{
+					TtlSeconds: uint64(c.ttl / time.Second),
+					MaxBytes:   c.maxBytes,
+				},
+			})
+		}
+	case PChannelRetentionTypeClear:
+		req.ClearPchannels = splitPChannelPoolFlag(c.pchannels)
+		if len(req.ClearPchannels) == 0 {
+			fmt.Fprintln(os.Stderr, pchannelRetentionLine)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, pchannelRetentionLine)
+		return
+	}
+
+	resp, err := c.update(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update pchannel retention policies: %s\n", err)
+		os.Exit(1)
+	}
+	for _, policy := range resp.GetPolicies() {
+		fmt.Fprintf(os.Stdout, "%s\tttl=%s\tmaxBytes=%d\n",
+			policy.GetPchannel(), time.Duration(policy.GetPolicy().GetTtlSeconds())*time.Second, policy.GetPolicy().GetMaxBytes())
+	}
+}
+
+// update sends the update request to the streamingcoord.
+func (c *pchannelRetention) update(req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().UpdatePChannelRetentionPolicies(ctx, req)
+}
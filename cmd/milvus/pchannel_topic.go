@@ -0,0 +1,88 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	PChannelTopicCmd = "pchannel-topic"
+
+	PChannelTopicTypeMigrate = "migrate"
+)
+
+// pchannelTopic migrates the wal of pchannels to other topics of mq through the streamingcoord.
+type pchannelTopic struct {
+	etcdIP  string
+	topics  string
+	timeout time.Duration
+}
+
+func (c *pchannelTopic) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 || args[2] != PChannelTopicTypeMigrate {
+		fmt.Fprintln(os.Stderr, pchannelTopicLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, pchannelTopicLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.topics, "topics", "", "Comma separated pchannel=topic pairs to migrate")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	pchannelTopics, err := parsePChannelTopicsFlag(c.topics)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n%s\n", err, pchannelTopicLine)
+		os.Exit(1)
+	}
+	resp, err := c.migrate(&streamingpb.MigratePChannelTopicsRequest{PchannelTopics: pchannelTopics})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to migrate pchannel topics: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stdout, "pchannels are fenced for topic migration, broadcastID=%d\n", resp.GetBroadcastId())
+}
+
+// migrate sends the migrate request to the streamingcoord.
+func (c *pchannelTopic) migrate(req *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().MigratePChannelTopics(ctx, req)
+}
+
+// parsePChannelTopicsFlag parses the comma separated pchannel=topic pairs.
+func parsePChannelTopicsFlag(value string) (map[string]string, error) {
+	items := splitPChannelPoolFlag(value)
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no pchannel to migrate")
+	}
+	pchannelTopics := make(map[string]string, len(items))
+	for _, item := range items {
+		pchannel, topic, ok := strings.Cut(item, "=")
+		pchannel, topic = strings.TrimSpace(pchannel), strings.TrimSpace(topic)
+		if !ok || pchannel == "" || topic == "" {
+			return nil, fmt.Errorf("invalid pchannel topic %q", item)
+		}
+		if _, ok := pchannelTopics[pchannel]; ok {
+			return nil, fmt.Errorf("pchannel %s is migrated more than once", pchannel)
+		}
+		pchannelTopics[pchannel] = topic
+	}
+	return pchannelTopics, nil
+}
@@ -0,0 +1,68 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	PromoteSecondaryCmd = "promote-secondary"
+)
+
+// promoteSecondary switches the primary of the replication from current cluster to a direct secondary cluster.
+type promoteSecondary struct {
+	etcdIP        string
+	targetCluster string
+	timeout       time.Duration
+}
+
+func (c *promoteSecondary) execute(args []string, flags *flag.FlagSet) {
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, promoteSecondaryLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.targetCluster, "targetCluster", "", "Id of the secondary cluster to be promoted")
+	flags.DurationVar(&c.timeout, "timeout", 5*time.Minute, "Timeout of waiting the switchover to be confirmed")
+	if err := flags.Parse(args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+	if c.targetCluster == "" {
+		fmt.Fprintln(os.Stderr, promoteSecondaryLine)
+		os.Exit(1)
+	}
+
+	resp, err := c.promote()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to promote secondary cluster %s: %s\n", c.targetCluster, err)
+		os.Exit(1)
+	}
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp.GetConfiguration())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal replicate configuration: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintln(os.Stdout, string(data))
+}
+
+// promote sends the promote request to the streamingcoord of current primary cluster.
+func (c *promoteSecondary) promote() (*streamingpb.PromoteSecondaryResponse, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{
+		TargetClusterId: c.targetCluster,
+	})
+}
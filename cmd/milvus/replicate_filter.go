@@ -0,0 +1,114 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	ReplicateFilterCmd = "replicate-filter"
+
+	ReplicateFilterTypeSet   = "set"
+	ReplicateFilterTypeClear = "clear"
+)
+
+// replicateFilter sets or clears the collection filter of a target cluster.
+// The filter is a part of the replicate configuration, so it's applied by the cdc of the primary cluster before shipping the messages.
+type replicateFilter struct {
+	etcdIP   string
+	target   string
+	includes string
+	excludes string
+	timeout  time.Duration
+}
+
+func (c *replicateFilter) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, replicateFilterLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, replicateFilterLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.target, "target", "", "Cluster id of the target cluster")
+	flags.StringVar(&c.includes, "includes", "", "Comma-separated list of the databases or collections replicated")
+	flags.StringVar(&c.excludes, "excludes", "", "Comma-separated list of the databases or collections not replicated")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+	if c.target == "" {
+		fmt.Fprintln(os.Stderr, "target cluster id is required")
+		os.Exit(1)
+	}
+
+	var filter *messagespb.ReplicateCollectionFilter
+	switch args[2] {
+	case ReplicateFilterTypeSet:
+		filter = &messagespb.ReplicateCollectionFilter{
+			Includes: splitRules(c.includes),
+			Excludes: splitRules(c.excludes),
+		}
+	case ReplicateFilterTypeClear:
+	default:
+		fmt.Fprintln(os.Stderr, replicateFilterLine)
+		return
+	}
+	if err := c.update(filter); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update collection filter: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stdout, "target=%s includes=%s excludes=%s\n",
+		c.target, strings.Join(filter.GetIncludes(), ","), strings.Join(filter.GetExcludes(), ","))
+}
+
+// update sends the collection filters with current replicate configuration to the streamingcoord,
+// the filters of the other target clusters are kept.
+func (c *replicateFilter) update(filter *messagespb.ReplicateCollectionFilter) error {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := client.Assignment().GetReplicateConfigurationHistory(ctx, &streamingpb.GetReplicateConfigurationHistoryRequest{})
+	if err != nil {
+		return err
+	}
+	filters := make(map[string]*messagespb.ReplicateCollectionFilter)
+	if histories := resp.GetHistories(); len(histories) > 0 {
+		for clusterID, f := range histories[len(histories)-1].GetCollectionFilters() {
+			filters[clusterID] = proto.Clone(f).(*messagespb.ReplicateCollectionFilter)
+		}
+	}
+	if filter == nil {
+		delete(filters, c.target)
+	} else {
+		filters[c.target] = filter
+	}
+	return client.Assignment().UpdateReplicateCollectionFilters(ctx, filters)
+}
+
+// splitRules splits the comma-separated rules of the collection filter.
+func splitRules(rules string) []string {
+	result := make([]string, 0)
+	for _, rule := range strings.Split(rules, ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			result = append(result, rule)
+		}
+	}
+	return result
+}
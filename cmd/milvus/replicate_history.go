@@ -0,0 +1,89 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	ReplicateHistoryCmd = "replicate-history"
+)
+
+// replicateHistory lists the accepted replicate configurations of current cluster,
+// or prints the replicate configuration of a version to roll back to.
+type replicateHistory struct {
+	etcdIP  string
+	version int64
+	timeout time.Duration
+}
+
+func (c *replicateHistory) execute(args []string, flags *flag.FlagSet) {
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, replicateHistoryLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.Int64Var(&c.version, "version", 0, "Version of the replicate configuration to print")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	histories, err := c.list()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to get replicate configuration history: %s\n", err)
+		os.Exit(1)
+	}
+	if c.version != 0 {
+		// print the configuration only, so it can be applied again to roll back.
+		output, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(histories[0].GetReplicateConfiguration())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to marshal replicate configuration: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintln(os.Stdout, string(output))
+		return
+	}
+	for _, history := range histories {
+		operator := history.GetOperator()
+		if operator == "" {
+			operator = "-"
+		}
+		fmt.Fprintf(os.Stdout, "%d\t%s\t%s\tclusters=%d\ttopology=%d\tforcePromoted=%t\trbacReplicationDisabled=%t\tcollectionFilters=%d\tbroadcastID=%d\n",
+			history.GetVersion(),
+			time.Unix(history.GetAppliedTimestampSeconds(), 0).Format(time.RFC3339),
+			operator,
+			len(history.GetReplicateConfiguration().GetClusters()),
+			len(history.GetReplicateConfiguration().GetCrossClusterTopology()),
+			history.GetForcePromoted(),
+			history.GetRbacReplicationDisabled(),
+			len(history.GetCollectionFilters()),
+			history.GetBroadcastId())
+	}
+}
+
+// list gets the history of the replicate configuration from the streamingcoord.
+func (c *replicateHistory) list() ([]*streamingpb.ReplicateConfigurationHistoryMeta, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := client.Assignment().GetReplicateConfigurationHistory(ctx, &streamingpb.GetReplicateConfigurationHistoryRequest{
+		Version: c.version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetHistories(), nil
+}
@@ -0,0 +1,77 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	ReplicatePlanCmd = "replicate-plan"
+)
+
+// replicatePlan computes the changes made by a replicate configuration without applying it.
+type replicatePlan struct {
+	etcdIP  string
+	config  string
+	timeout time.Duration
+}
+
+func (c *replicatePlan) execute(args []string, flags *flag.FlagSet) {
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, replicatePlanLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.config, "config", "", "Json file of the replicate configuration")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+	if c.config == "" {
+		fmt.Fprintln(os.Stderr, replicatePlanLine)
+		os.Exit(1)
+	}
+	data, err := os.ReadFile(c.config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read replicate configuration from %s: %s\n", c.config, err)
+		os.Exit(1)
+	}
+	config := &commonpb.ReplicateConfiguration{}
+	if err := protojson.Unmarshal(data, config); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse replicate configuration: %s\n", err)
+		os.Exit(1)
+	}
+
+	plan, err := c.plan(config)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to plan replicate configuration: %s\n", err)
+		os.Exit(1)
+	}
+	output, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(plan)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal replicate configuration plan: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintln(os.Stdout, string(output))
+}
+
+// plan sends the dry run request to the streamingcoord.
+func (c *replicatePlan) plan(config *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().DryRunReplicateConfiguration(ctx, config)
+}
@@ -0,0 +1,68 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	ReplicateRBACCmd = "replicate-rbac"
+
+	ReplicateRBACTypeEnable  = "enable"
+	ReplicateRBACTypeDisable = "disable"
+)
+
+// replicateRBAC enables or disables the replication of the users, roles and privileges into the secondary clusters.
+// The setting is a part of the replicate configuration, so it's applied by the primary cluster and replicated to the secondary clusters.
+type replicateRBAC struct {
+	etcdIP  string
+	timeout time.Duration
+}
+
+func (c *replicateRBAC) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, replicateRBACLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, replicateRBACLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+
+	var disabled bool
+	switch args[2] {
+	case ReplicateRBACTypeEnable:
+		disabled = false
+	case ReplicateRBACTypeDisable:
+		disabled = true
+	default:
+		fmt.Fprintln(os.Stderr, replicateRBACLine)
+		return
+	}
+	if err := c.update(disabled); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update rbac replication: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stdout, "rbacReplicationDisabled=%t\n", disabled)
+}
+
+// update sends the rbac replication setting with current replicate configuration to the streamingcoord.
+func (c *replicateRBAC) update(disabled bool) error {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().UpdateRBACReplication(ctx, disabled)
+}
@@ -0,0 +1,281 @@
+package milvus
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+const (
+	ReplicateTaskCmd = "replicate-task"
+
+	ReplicateTaskTypeList            = "list"
+	ReplicateTaskTypePause           = "pause"
+	ReplicateTaskTypeResume          = "resume"
+	ReplicateTaskTypeResetCheckpoint = "reset-checkpoint"
+	ReplicateTaskTypeDeadLetters     = "dead-letters"
+	ReplicateTaskTypeRequeue         = "requeue"
+	ReplicateTaskTypeUpdateConn      = "update-connection"
+)
+
+// replicateTask lists, pauses, resumes, resets the checkpoint or requeues the dead letters of the replicating tasks
+// to a target cluster, or updates the connection param of the target cluster through the streamingcoord.
+type replicateTask struct {
+	etcdIP                string
+	targetCluster         string
+	sourceChannel         string
+	policy                string
+	checkpoint            string
+	ignoreRetentionWindow bool
+	uri                   string
+	token                 string
+	timeout               time.Duration
+}
+
+func (c *replicateTask) execute(args []string, flags *flag.FlagSet) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+		return
+	}
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+	}
+	flags.StringVar(&c.etcdIP, "etcdIp", "", "Etcd endpoint to connect")
+	flags.StringVar(&c.targetCluster, "targetCluster", "", "Id of the target cluster")
+	flags.StringVar(&c.sourceChannel, "sourceChannel", "", "Source pchannel of the replicating task, all tasks of the target cluster if empty")
+	flags.StringVar(&c.policy, "policy", "", "Reset policy of the checkpoint, one of checkpoint, earliest, latest and none")
+	flags.StringVar(&c.checkpoint, "checkpoint", "", "Json of the replicate checkpoint to reset to")
+	flags.BoolVar(&c.ignoreRetentionWindow, "ignoreRetentionWindow", false, "Reset to the checkpoint older than the retention window of the source wal")
+	flags.StringVar(&c.uri, "uri", "", "New uri of the target cluster")
+	flags.StringVar(&c.token, "token", "", "New token of the target cluster")
+	flags.DurationVar(&c.timeout, "timeout", 30*time.Second, "Timeout of the operation")
+	if err := flags.Parse(args[3:]); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse flags: %s\n", err)
+		os.Exit(1)
+	}
+	switch args[2] {
+	case ReplicateTaskTypeList:
+		c.list()
+		return
+	case ReplicateTaskTypeResetCheckpoint:
+		c.resetCheckpoint()
+		return
+	case ReplicateTaskTypeDeadLetters, ReplicateTaskTypeRequeue:
+		c.deadLetters(args[2] == ReplicateTaskTypeRequeue)
+		return
+	case ReplicateTaskTypeUpdateConn:
+		c.updateConnection()
+		return
+	}
+
+	req := &streamingpb.UpdateReplicatingTaskStateRequest{
+		TargetClusterId:   c.targetCluster,
+		SourceChannelName: c.sourceChannel,
+	}
+	switch args[2] {
+	case ReplicateTaskTypePause:
+		req.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED
+	case ReplicateTaskTypeResume:
+		req.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING
+	default:
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+		return
+	}
+	if c.targetCluster == "" {
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+		os.Exit(1)
+	}
+
+	resp, err := c.update(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update replicating tasks: %s\n", err)
+		os.Exit(1)
+	}
+	for _, task := range resp.GetTasks() {
+		fmt.Fprintf(os.Stdout, "%s\t%s -> %s\t%s\n",
+			task.GetTargetCluster().GetClusterId(), task.GetSourceChannelName(), task.GetTargetChannelName(), task.GetState())
+	}
+}
+
+// list lists the replicating tasks with the runtime state, the streaming node of the source pchannel,
+// the checkpoint and lag confirmed by the target cluster and the recent error.
+func (c *replicateTask) list() {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to list replicating tasks: %s\n", err)
+		os.Exit(1)
+	}
+	defer closer()
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := client.Assignment().ListReplicatingTasks(ctx, &streamingpb.ListReplicatingTasksRequest{
+		TargetClusterId: c.targetCluster,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to list replicating tasks: %s\n", err)
+		os.Exit(1)
+	}
+	for _, progress := range resp.GetTasks() {
+		if c.sourceChannel != "" && progress.GetTask().GetSourceChannelName() != c.sourceChannel {
+			continue
+		}
+		task := progress.GetTask()
+		checkpoint := "-"
+		if progress.GetCheckpoint() != nil {
+			checkpoint = fmt.Sprintf("%s@%d", progress.GetCheckpoint().GetMessageId().GetId(), progress.GetCheckpoint().GetTimeTick())
+		} else if task.GetState() == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING && task.GetInitializedCheckpoint() != nil {
+			// the replication starts from the initialized checkpoint after the bootstrap is finished.
+			checkpoint = fmt.Sprintf("%s@%d", task.GetInitializedCheckpoint().GetMessageId().GetId(), task.GetInitializedCheckpoint().GetTimeTick())
+		}
+		runtimeState := strings.TrimPrefix(progress.GetRuntimeState().String(), "REPLICATING_TASK_RUNTIME_STATE_")
+		fmt.Fprintf(os.Stdout, "%s\t%s -> %s\t%s\tnode=%d\t%s\t%s",
+			task.GetTargetCluster().GetClusterId(), task.GetSourceChannelName(), task.GetTargetChannelName(), runtimeState,
+			progress.GetStreamingNodeId(), checkpoint, time.Duration(progress.GetLagMilliseconds())*time.Millisecond)
+		if progress.GetError() != "" {
+			fmt.Fprintf(os.Stdout, "\t%s", progress.GetError())
+		}
+		if progress.GetDeadLetter() != nil {
+			fmt.Fprintf(os.Stdout, "\t%s", progress.GetDeadLetter().GetError())
+		}
+		fmt.Fprintln(os.Stdout)
+	}
+}
+
+// resetCheckpoint resets the start position of a replicating task.
+func (c *replicateTask) resetCheckpoint() {
+	policy, ok := streamingpb.ReplicateCheckpointResetPolicy_value["REPLICATE_CHECKPOINT_RESET_POLICY_"+strings.ToUpper(c.policy)]
+	if !ok || c.targetCluster == "" || c.sourceChannel == "" {
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+		os.Exit(1)
+	}
+	req := &streamingpb.ResetReplicateCheckpointRequest{
+		TargetClusterId:       c.targetCluster,
+		SourceChannelName:     c.sourceChannel,
+		Policy:                streamingpb.ReplicateCheckpointResetPolicy(policy),
+		IgnoreRetentionWindow: c.ignoreRetentionWindow,
+	}
+	if c.checkpoint != "" {
+		req.Checkpoint = &commonpb.ReplicateCheckpoint{}
+		if err := protojson.Unmarshal([]byte(c.checkpoint), req.Checkpoint); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to parse checkpoint: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to reset replicate checkpoint: %s\n", err)
+		os.Exit(1)
+	}
+	defer closer()
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := client.Assignment().ResetReplicateCheckpoint(ctx, req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to reset replicate checkpoint: %s\n", err)
+		os.Exit(1)
+	}
+	task := resp.GetTask()
+	fmt.Fprintf(os.Stdout, "%s\t%s -> %s\t%s\t%d\n",
+		task.GetTargetCluster().GetClusterId(), task.GetSourceChannelName(), task.GetTargetChannelName(),
+		task.GetCheckpointResetPolicy(), task.GetInitializedCheckpoint().GetTimeTick())
+}
+
+// updateConnection updates the connection param of the target cluster without broadcasting the replicate configuration.
+func (c *replicateTask) updateConnection() {
+	if c.targetCluster == "" || c.uri == "" {
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+		os.Exit(1)
+	}
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update replicate cluster connection: %s\n", err)
+		os.Exit(1)
+	}
+	defer closer()
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := client.Assignment().UpdateReplicateClusterConnection(ctx, &streamingpb.UpdateReplicateClusterConnectionRequest{
+		ClusterId:       c.targetCluster,
+		ConnectionParam: &commonpb.ConnectionParam{Uri: c.uri, Token: c.token},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to update replicate cluster connection: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stdout, "replicate configuration version %d\n", resp.GetVersion())
+	for _, task := range resp.GetTasks() {
+		fmt.Fprintf(os.Stdout, "%s\t%s -> %s\t%s\n",
+			task.GetTargetCluster().GetClusterId(), task.GetSourceChannelName(), task.GetTargetChannelName(),
+			task.GetTargetCluster().GetConnectionParam().GetUri())
+	}
+}
+
+// deadLetters lists or requeues the dead letters of the replicating tasks parked after too many rejections by the target cluster.
+func (c *replicateTask) deadLetters(requeue bool) {
+	if requeue && c.targetCluster == "" {
+		fmt.Fprintln(os.Stderr, replicateTaskLine)
+		os.Exit(1)
+	}
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect streamingcoord: %s\n", err)
+		os.Exit(1)
+	}
+	defer closer()
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var deadLetters []*streamingpb.ReplicateDeadLetterMeta
+	if requeue {
+		req := &streamingpb.RequeueReplicateDeadLettersRequest{TargetClusterId: c.targetCluster}
+		if c.sourceChannel != "" {
+			req.SourceChannelNames = []string{c.sourceChannel}
+		}
+		resp, err := client.Assignment().RequeueReplicateDeadLetters(ctx, req)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to requeue replicate dead letters: %s\n", err)
+			os.Exit(1)
+		}
+		deadLetters = resp.GetDeadLetters()
+	} else {
+		resp, err := client.Assignment().ListReplicateDeadLetters(ctx, &streamingpb.ListReplicateDeadLettersRequest{
+			TargetClusterId: c.targetCluster,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to list replicate dead letters: %s\n", err)
+			os.Exit(1)
+		}
+		deadLetters = resp.GetDeadLetters()
+	}
+	for _, deadLetter := range deadLetters {
+		if c.sourceChannel != "" && deadLetter.GetSourceChannelName() != c.sourceChannel {
+			continue
+		}
+		fmt.Fprintf(os.Stdout, "%s\t%s -> %s\t%s@%d\t%s\t%d\t%s\n",
+			deadLetter.GetTargetClusterId(), deadLetter.GetSourceChannelName(), deadLetter.GetTargetChannelName(),
+			deadLetter.GetMessageId().GetId(), deadLetter.GetTimeTick(), deadLetter.GetMessageType(),
+			deadLetter.GetRetries(), deadLetter.GetError())
+	}
+}
+
+// update sends the update request to the streamingcoord.
+func (c *replicateTask) update(req *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error) {
+	client, closer, err := newStreamingCoordClient(c.etcdIP)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	return client.Assignment().UpdateReplicatingTaskState(ctx, req)
+}
@@ -19,10 +19,12 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus/cmd/roles"
+	streamingcoordclient "github.com/milvus-io/milvus/internal/streamingcoord/client"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/util/etcd"
 	"github.com/milvus-io/milvus/pkg/v3/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
@@ -299,3 +301,38 @@ func getServerID(ctx context.Context, client *clientv3.Client, key string) (int6
 
 	return session.ServerID, nil
 }
+
+// newStreamingCoordClient creates a streamingcoord client with the etcd of the cluster.
+// The etcd configured in milvus.yaml is used if the etcdIP is empty.
+// The returned closer should be called to release the client and the etcd client.
+func newStreamingCoordClient(etcdIP string) (streamingcoordclient.Client, func(), error) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	var etcdCli *clientv3.Client
+	var err error
+	if etcdIP != "" {
+		etcdCli, err = etcd.GetRemoteEtcdClient([]string{etcdIP}, params.EtcdCfg.ClientOptions()...)
+	} else {
+		etcdCli, err = etcd.CreateEtcdClient(
+			params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+			params.EtcdCfg.EtcdEnableAuth.GetAsBool(),
+			params.EtcdCfg.EtcdAuthUserName.GetValue(),
+			params.EtcdCfg.EtcdAuthPassword.GetValue(),
+			params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+			params.EtcdCfg.Endpoints.GetAsStrings(),
+			params.EtcdCfg.EtcdTLSCert.GetValue(),
+			params.EtcdCfg.EtcdTLSKey.GetValue(),
+			params.EtcdCfg.EtcdTLSCACert.GetValue(),
+			params.EtcdCfg.EtcdTLSMinVersion.GetValue(),
+			params.EtcdCfg.ClientOptions()...)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	client := streamingcoordclient.NewClient(etcdCli)
+	return client, func() {
+		client.Close()
+		etcdCli.Close()
+	}, nil
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
 	"github.com/milvus-io/milvus/client/v2/milvusclient"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
@@ -35,6 +36,16 @@ type MilvusClient interface {
 	GetReplicateInfo(ctx context.Context, req *milvuspb.GetReplicateInfoRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicateInfoResponse, error)
 	// CreateReplicateStream creates a replicate stream to the milvus cluster.
 	CreateReplicateStream(ctx context.Context, opts ...grpc.CallOption) (milvuspb.MilvusService_CreateReplicateStreamClient, error)
+	// ListDatabase lists the databases of the milvus cluster.
+	ListDatabase(ctx context.Context, option milvusclient.ListDatabaseOption, callOptions ...grpc.CallOption) ([]string, error)
+	// ListCollections lists the collections of the milvus cluster.
+	ListCollections(ctx context.Context, option milvusclient.ListCollectionOption, callOptions ...grpc.CallOption) ([]string, error)
+	// DescribeCollection describes the collection of the milvus cluster.
+	DescribeCollection(ctx context.Context, option milvusclient.DescribeCollectionOption, callOptions ...grpc.CallOption) (*entity.Collection, error)
+	// GetClusterChannels gets the control channel and all pchannels of the milvus cluster.
+	GetClusterChannels(ctx context.Context, opts ...grpc.CallOption) (*milvuspb.ClusterInfo, error)
+	// GetServerVersion gets the version of the milvus cluster, it's used to probe the reachability of the cluster.
+	GetServerVersion(ctx context.Context, option milvusclient.GetServerVersionOption, callOptions ...grpc.CallOption) (string, error)
 	// Close closes the milvus client.
 	Close(ctx context.Context) error
 }
@@ -47,6 +58,10 @@ func NewMilvusClient(ctx context.Context, cluster *commonpb.MilvusCluster) (Milv
 		Address: connParam.GetUri(),
 		APIKey:  connParam.GetToken(),
 	}
+	// The token configured locally takes precedence over the one in the replicate configuration.
+	if token := paramtable.Get().ProxyGrpcServerCfg.GetClusterToken(cluster.GetClusterId()); token != "" {
+		config.APIKey = token
+	}
 
 	// Build TLS config from per-cluster paramtable config.
 	tlsConfig, err := buildCDCTLSConfig(cluster.GetClusterId())
@@ -72,7 +87,7 @@ func NewMilvusClient(ctx context.Context, cluster *commonpb.MilvusCluster) (Milv
 }
 
 // buildCDCTLSConfig reads per-cluster TLS config from paramtable for CDC outbound connections.
-// Looks up tls.clusters.<clusterID>.{caPemPath,clientPemPath,clientKeyPath}.
+// Looks up tls.clusters.<clusterID>.{caPemPath,clientPemPath,clientKeyPath,serverName}.
 // Returns nil if client cert paths are not configured for this cluster.
 func buildCDCTLSConfig(clusterID string) (*tls.Config, error) {
 	caPemPath, clientPemPath, clientKeyPath := paramtable.Get().ProxyGrpcServerCfg.GetClusterTLSConfig(clusterID)
@@ -87,5 +102,14 @@ func buildCDCTLSConfig(clusterID string) (*tls.Config, error) {
 		mlog.String("clientPemPath", clientPemPath),
 		mlog.String("clientKeyPath", clientKeyPath))
 
-	return milvusclient.BuildTLSConfig(caPemPath, clientPemPath, clientKeyPath)
+	tlsConfig, err := milvusclient.BuildTLSConfig(caPemPath, clientPemPath, clientKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	// Override the server name if the certificate of target cluster is not issued for the host of its uri,
+	// e.g. the target cluster is behind a load balancer of another security domain.
+	if serverName := paramtable.Get().ProxyGrpcServerCfg.GetClusterServerName(clusterID); serverName != "" {
+		tlsConfig.ServerName = serverName
+	}
+	return tlsConfig, nil
 }
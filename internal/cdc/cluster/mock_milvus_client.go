@@ -5,8 +5,11 @@ package cluster
 import (
 	context "context"
 
+	entity "github.com/milvus-io/milvus/client/v2/entity"
 	grpc "google.golang.org/grpc"
 
+	milvusclient "github.com/milvus-io/milvus/client/v2/milvusclient"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 
 	mock "github.com/stretchr/testify/mock"
@@ -144,6 +147,153 @@ func (_c *MockMilvusClient_CreateReplicateStream_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DescribeCollection provides a mock function with given fields: ctx, option, callOptions
+func (_m *MockMilvusClient) DescribeCollection(ctx context.Context, option milvusclient.DescribeCollectionOption, callOptions ...grpc.CallOption) (*entity.Collection, error) {
+	_va := make([]interface{}, len(callOptions))
+	for _i := range callOptions {
+		_va[_i] = callOptions[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, option)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeCollection")
+	}
+
+	var r0 *entity.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.DescribeCollectionOption, ...grpc.CallOption) (*entity.Collection, error)); ok {
+		return rf(ctx, option, callOptions...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.DescribeCollectionOption, ...grpc.CallOption) *entity.Collection); ok {
+		r0 = rf(ctx, option, callOptions...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, milvusclient.DescribeCollectionOption, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, option, callOptions...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilvusClient_DescribeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeCollection'
+type MockMilvusClient_DescribeCollection_Call struct {
+	*mock.Call
+}
+
+// DescribeCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - option milvusclient.DescribeCollectionOption
+//   - callOptions ...grpc.CallOption
+func (_e *MockMilvusClient_Expecter) DescribeCollection(ctx interface{}, option interface{}, callOptions ...interface{}) *MockMilvusClient_DescribeCollection_Call {
+	return &MockMilvusClient_DescribeCollection_Call{Call: _e.mock.On("DescribeCollection",
+		append([]interface{}{ctx, option}, callOptions...)...)}
+}
+
+func (_c *MockMilvusClient_DescribeCollection_Call) Run(run func(ctx context.Context, option milvusclient.DescribeCollectionOption, callOptions ...grpc.CallOption)) *MockMilvusClient_DescribeCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(milvusclient.DescribeCollectionOption), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMilvusClient_DescribeCollection_Call) Return(_a0 *entity.Collection, _a1 error) *MockMilvusClient_DescribeCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilvusClient_DescribeCollection_Call) RunAndReturn(run func(context.Context, milvusclient.DescribeCollectionOption, ...grpc.CallOption) (*entity.Collection, error)) *MockMilvusClient_DescribeCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClusterChannels provides a mock function with given fields: ctx, opts
+func (_m *MockMilvusClient) GetClusterChannels(ctx context.Context, opts ...grpc.CallOption) (*milvuspb.ClusterInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterChannels")
+	}
+
+	var r0 *milvuspb.ClusterInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) (*milvuspb.ClusterInfo, error)); ok {
+		return rf(ctx, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) *milvuspb.ClusterInfo); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ClusterInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilvusClient_GetClusterChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterChannels'
+type MockMilvusClient_GetClusterChannels_Call struct {
+	*mock.Call
+}
+
+// GetClusterChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts ...grpc.CallOption
+func (_e *MockMilvusClient_Expecter) GetClusterChannels(ctx interface{}, opts ...interface{}) *MockMilvusClient_GetClusterChannels_Call {
+	return &MockMilvusClient_GetClusterChannels_Call{Call: _e.mock.On("GetClusterChannels",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockMilvusClient_GetClusterChannels_Call) Run(run func(ctx context.Context, opts ...grpc.CallOption)) *MockMilvusClient_GetClusterChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMilvusClient_GetClusterChannels_Call) Return(_a0 *milvuspb.ClusterInfo, _a1 error) *MockMilvusClient_GetClusterChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilvusClient_GetClusterChannels_Call) RunAndReturn(run func(context.Context, ...grpc.CallOption) (*milvuspb.ClusterInfo, error)) *MockMilvusClient_GetClusterChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReplicateInfo provides a mock function with given fields: ctx, req, opts
 func (_m *MockMilvusClient) GetReplicateInfo(ctx context.Context, req *milvuspb.GetReplicateInfoRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicateInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -218,6 +368,226 @@ func (_c *MockMilvusClient_GetReplicateInfo_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetServerVersion provides a mock function with given fields: ctx, option, callOptions
+func (_m *MockMilvusClient) GetServerVersion(ctx context.Context, option milvusclient.GetServerVersionOption, callOptions ...grpc.CallOption) (string, error) {
+	_va := make([]interface{}, len(callOptions))
+	for _i := range callOptions {
+		_va[_i] = callOptions[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, option)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServerVersion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.GetServerVersionOption, ...grpc.CallOption) (string, error)); ok {
+		return rf(ctx, option, callOptions...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.GetServerVersionOption, ...grpc.CallOption) string); ok {
+		r0 = rf(ctx, option, callOptions...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, milvusclient.GetServerVersionOption, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, option, callOptions...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilvusClient_GetServerVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServerVersion'
+type MockMilvusClient_GetServerVersion_Call struct {
+	*mock.Call
+}
+
+// GetServerVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - option milvusclient.GetServerVersionOption
+//   - callOptions ...grpc.CallOption
+func (_e *MockMilvusClient_Expecter) GetServerVersion(ctx interface{}, option interface{}, callOptions ...interface{}) *MockMilvusClient_GetServerVersion_Call {
+	return &MockMilvusClient_GetServerVersion_Call{Call: _e.mock.On("GetServerVersion",
+		append([]interface{}{ctx, option}, callOptions...)...)}
+}
+
+func (_c *MockMilvusClient_GetServerVersion_Call) Run(run func(ctx context.Context, option milvusclient.GetServerVersionOption, callOptions ...grpc.CallOption)) *MockMilvusClient_GetServerVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(milvusclient.GetServerVersionOption), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMilvusClient_GetServerVersion_Call) Return(_a0 string, _a1 error) *MockMilvusClient_GetServerVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilvusClient_GetServerVersion_Call) RunAndReturn(run func(context.Context, milvusclient.GetServerVersionOption, ...grpc.CallOption) (string, error)) *MockMilvusClient_GetServerVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCollections provides a mock function with given fields: ctx, option, callOptions
+func (_m *MockMilvusClient) ListCollections(ctx context.Context, option milvusclient.ListCollectionOption, callOptions ...grpc.CallOption) ([]string, error) {
+	_va := make([]interface{}, len(callOptions))
+	for _i := range callOptions {
+		_va[_i] = callOptions[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, option)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollections")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.ListCollectionOption, ...grpc.CallOption) ([]string, error)); ok {
+		return rf(ctx, option, callOptions...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.ListCollectionOption, ...grpc.CallOption) []string); ok {
+		r0 = rf(ctx, option, callOptions...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, milvusclient.ListCollectionOption, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, option, callOptions...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilvusClient_ListCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollections'
+type MockMilvusClient_ListCollections_Call struct {
+	*mock.Call
+}
+
+// ListCollections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - option milvusclient.ListCollectionOption
+//   - callOptions ...grpc.CallOption
+func (_e *MockMilvusClient_Expecter) ListCollections(ctx interface{}, option interface{}, callOptions ...interface{}) *MockMilvusClient_ListCollections_Call {
+	return &MockMilvusClient_ListCollections_Call{Call: _e.mock.On("ListCollections",
+		append([]interface{}{ctx, option}, callOptions...)...)}
+}
+
+func (_c *MockMilvusClient_ListCollections_Call) Run(run func(ctx context.Context, option milvusclient.ListCollectionOption, callOptions ...grpc.CallOption)) *MockMilvusClient_ListCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(milvusclient.ListCollectionOption), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMilvusClient_ListCollections_Call) Return(_a0 []string, _a1 error) *MockMilvusClient_ListCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilvusClient_ListCollections_Call) RunAndReturn(run func(context.Context, milvusclient.ListCollectionOption, ...grpc.CallOption) ([]string, error)) *MockMilvusClient_ListCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDatabase provides a mock function with given fields: ctx, option, callOptions
+func (_m *MockMilvusClient) ListDatabase(ctx context.Context, option milvusclient.ListDatabaseOption, callOptions ...grpc.CallOption) ([]string, error) {
+	_va := make([]interface{}, len(callOptions))
+	for _i := range callOptions {
+		_va[_i] = callOptions[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, option)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabase")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.ListDatabaseOption, ...grpc.CallOption) ([]string, error)); ok {
+		return rf(ctx, option, callOptions...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, milvusclient.ListDatabaseOption, ...grpc.CallOption) []string); ok {
+		r0 = rf(ctx, option, callOptions...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, milvusclient.ListDatabaseOption, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, option, callOptions...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilvusClient_ListDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabase'
+type MockMilvusClient_ListDatabase_Call struct {
+	*mock.Call
+}
+
+// ListDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - option milvusclient.ListDatabaseOption
+//   - callOptions ...grpc.CallOption
+func (_e *MockMilvusClient_Expecter) ListDatabase(ctx interface{}, option interface{}, callOptions ...interface{}) *MockMilvusClient_ListDatabase_Call {
+	return &MockMilvusClient_ListDatabase_Call{Call: _e.mock.On("ListDatabase",
+		append([]interface{}{ctx, option}, callOptions...)...)}
+}
+
+func (_c *MockMilvusClient_ListDatabase_Call) Run(run func(ctx context.Context, option milvusclient.ListDatabaseOption, callOptions ...grpc.CallOption)) *MockMilvusClient_ListDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(milvusclient.ListDatabaseOption), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockMilvusClient_ListDatabase_Call) Return(_a0 []string, _a1 error) *MockMilvusClient_ListDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilvusClient_ListDatabase_Call) RunAndReturn(run func(context.Context, milvusclient.ListDatabaseOption, ...grpc.CallOption) ([]string, error)) *MockMilvusClient_ListDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockMilvusClient creates a new instance of MockMilvusClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMilvusClient(t interface {
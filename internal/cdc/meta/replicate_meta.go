@@ -75,6 +75,107 @@ func RemoveReplicatePChannelWithRevision(ctx context.Context, etcdCli *clientv3.
 	return result, err
 }
 
+// ClearReplicateCheckpointResetPolicy clears the checkpoint reset policy of the replicating task,
+// so the reset is applied only once and the restarted replicator starts from the checkpoint of the target cluster.
+// The task is updated only if the revision of the key matches, the task updated by others is kept as it is.
+func ClearReplicateCheckpointResetPolicy(ctx context.Context, etcdCli *clientv3.Client, key string, revision int64, task *streamingpb.ReplicatePChannelMeta) (bool, error) {
+	task = proto.Clone(task).(*streamingpb.ReplicatePChannelMeta)
+	task.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_NONE
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return false, merr.Wrapf(err, "marshal replicate pchannel meta %s failed", key)
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := etcdCli.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", revision)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// FinishReplicateBootstrap marks the bootstrap of the replicating task finished,
+// the task is running from its initialized checkpoint, which is the time tick the existing data is copied at.
+// The checkpoint reset is required because the checkpoint of the target cluster is older than the initialized checkpoint after the bootstrap.
+// The task is updated only if the revision of the key matches, the task updated by others is kept as it is.
+func FinishReplicateBootstrap(ctx context.Context, etcdCli *clientv3.Client, key string, revision int64, task *streamingpb.ReplicatePChannelMeta) (bool, error) {
+	task = proto.Clone(task).(*streamingpb.ReplicatePChannelMeta)
+	task.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING
+	task.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return false, merr.Wrapf(err, "marshal replicate pchannel meta %s failed", key)
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := etcdCli.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", revision)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// SaveReplicateDeadLetter saves the dead letter of the parked replicating task into metastore.
+func SaveReplicateDeadLetter(ctx context.Context, etcdCli *clientv3.Client, key string, deadLetter *streamingpb.ReplicateDeadLetterMeta) error {
+	value, err := proto.Marshal(deadLetter)
+	if err != nil {
+		return merr.Wrapf(err, "marshal replicate dead letter %s failed", key)
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	_, err = etcdCli.Put(ctx, key, string(value))
+	return err
+}
+
+// ReplicateDeadLetterExists checks if the dead letter of the replicating task exists in metastore,
+// the dead letter is removed when it's requeued by the operator.
+func ReplicateDeadLetterExists(ctx context.Context, etcdCli *clientv3.Client, key string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := etcdCli.Get(ctx, key, clientv3.WithCountOnly())
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
+
+// GetReplicateFilteredCollections gets the collections filtered out by the collection filter of the replicating task from metastore.
+// Return nil if the task never filters any collection.
+func GetReplicateFilteredCollections(ctx context.Context, etcdCli *clientv3.Client, key string) (*streamingpb.ReplicateFilteredCollectionsMeta, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	resp, err := etcdCli.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	filtered := &streamingpb.ReplicateFilteredCollectionsMeta{}
+	if err := proto.Unmarshal(resp.Kvs[0].Value, filtered); err != nil {
+		return nil, merr.Wrapf(err, "unmarshal replicate filtered collections %s failed", key)
+	}
+	return filtered, nil
+}
+
+// SaveReplicateFilteredCollections saves the collections filtered out by the collection filter of the replicating task into metastore.
+func SaveReplicateFilteredCollections(ctx context.Context, etcdCli *clientv3.Client, key string, filtered *streamingpb.ReplicateFilteredCollectionsMeta) error {
+	value, err := proto.Marshal(filtered)
+	if err != nil {
+		return merr.Wrapf(err, "marshal replicate filtered collections %s failed", key)
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	_, err = etcdCli.Put(ctx, key, string(value))
+	return err
+}
+
 func MustParseReplicateChannelFromEvent(e *clientv3.Event) *streamingpb.ReplicatePChannelMeta {
 	meta := &streamingpb.ReplicatePChannelMeta{}
 	err := proto.Unmarshal(e.Kv.Value, meta)
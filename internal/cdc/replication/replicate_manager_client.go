@@ -20,7 +20,8 @@ import "github.com/milvus-io/milvus/internal/cdc/meta"
 
 // ReplicateManagerClient is the client that manages the replicate configuration.
 type ReplicateManagerClient interface {
-	// CreateReplicator creates a new replicator for the replicate pchannel.
+	// CreateReplicator creates a new replicator for the replicate pchannel,
+	// the replicator of the old revision is stopped, and no replicator is created if the replicating task is paused.
 	CreateReplicator(channel *meta.ReplicateChannel)
 
 	// RemoveReplicator removes a replicator for the replicate pchannel.
@@ -0,0 +1,800 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/internal/cdc/meta"
+	"github.com/milvus-io/milvus/internal/cdc/replication/replicatestream"
+	"github.com/milvus-io/milvus/internal/cdc/resource"
+	"github.com/milvus-io/milvus/internal/cdc/util"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	pkgutil "github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+var (
+	// bootstrapRetryInterval is the interval of retrying the bootstrap after a failure.
+	bootstrapRetryInterval = 10 * time.Second
+	// snapshotCheckInterval is the interval of checking the snapshot of a flushed vchannel again.
+	snapshotCheckInterval = 3 * time.Second
+)
+
+// bootstrapCoordClient lists the existing data of the source cluster, it's implemented by the mixcoord client.
+type bootstrapCoordClient interface {
+	streaming.RecoveryInfoClient
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	DescribeDatabase(ctx context.Context, in *rootcoordpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*rootcoordpb.DescribeDatabaseResponse, error)
+	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
+	DescribeCollectionInternal(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
+	ShowPartitionsInternal(ctx context.Context, in *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
+	AllocTimestamp(ctx context.Context, in *rootcoordpb.AllocTimestampRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocTimestampResponse, error)
+}
+
+// snapshotReader reads the existing data of a vchannel from the binlogs, it's implemented by the catch-up reader.
+type snapshotReader interface {
+	ReadSnapshot(ctx context.Context, opts streaming.CatchupReadOption, until uint64) (*streaming.VChannelSnapshot, error)
+}
+
+// bootstrapDatabase is a database existing at the initialized checkpoint of the task.
+type bootstrapDatabase struct {
+	id         int64
+	name       string
+	properties []*commonpb.KeyValuePair
+}
+
+// bootstrapCollection is a collection existing at the initialized checkpoint of the task.
+type bootstrapCollection struct {
+	desc           *milvuspb.DescribeCollectionResponse
+	partitionIDs   []int64
+	partitionNames []string
+	// filtered is set if the collection is filtered out by the collection filter of the task.
+	filtered bool
+}
+
+var _ Replicator = (*bootstrapReplicator)(nil)
+
+// bootstrapReplicator copies the data existing at the initialized checkpoint of a bootstrapping task to the target cluster.
+// The databases, collections and rows alive at the initialized checkpoint are replicated as synthetic messages,
+// which carry the message id of the initialized checkpoint and the time ticks between the checkpoint of the target cluster
+// and the initialized checkpoint, so the target cluster applies them before the live messages and skips the confirmed ones.
+// The task is switched to running from the initialized checkpoint after the target cluster confirms all the synthetic messages.
+type bootstrapReplicator struct {
+	channel       *meta.ReplicateChannel
+	createRscFunc replicatestream.CreateReplicateStreamClientFunc
+	createMcFunc  cluster.CreateMilvusClientFunc
+	getCoordFunc  func(ctx context.Context) (bootstrapCoordClient, error)
+	newReaderFunc func(coord bootstrapCoordClient) snapshotReader
+	finishFunc    func(ctx context.Context, channel *meta.ReplicateChannel) (bool, error)
+	filterStore   filteredCollectionStore
+
+	targetClient   cluster.MilvusClient
+	streamClient   replicatestream.ReplicateStreamClient
+	filter         *collectionFilter
+	coord          bootstrapCoordClient
+	reader         snapshotReader
+	controlChannel string
+
+	messageID message.MessageID // the message id of the initialized checkpoint.
+	until     uint64            // the time tick of the initialized checkpoint, the data existing at it is copied.
+	timeTick  uint64            // the time tick of the last synthetic message.
+	// redo is set if the target cluster may hold part of the existing data,
+	// then the rows are deleted before they're inserted again to keep the primary keys unique.
+	redo bool
+
+	asyncNotifier *syncutil.AsyncTaskNotifier[struct{}]
+}
+
+// NewBootstrapReplicator creates a new replicator to bootstrap the replicating task.
+func NewBootstrapReplicator(channel *meta.ReplicateChannel) Replicator {
+	return &bootstrapReplicator{
+		channel:       channel,
+		createRscFunc: replicatestream.NewReplicateStreamClient,
+		createMcFunc:  cluster.NewMilvusClient,
+		getCoordFunc: func(ctx context.Context) (bootstrapCoordClient, error) {
+			return resource.Resource().MixCoordClient().GetWithContext(ctx)
+		},
+		newReaderFunc: func(coord bootstrapCoordClient) snapshotReader {
+			return streaming.NewCatchupReader(streaming.NewRecoveryInfoSnapshotSource(coord), resource.Resource().ChunkManager())
+		},
+		finishFunc: func(ctx context.Context, channel *meta.ReplicateChannel) (bool, error) {
+			return meta.FinishReplicateBootstrap(ctx, resource.Resource().ETCD(), channel.Key, channel.ModRevision, channel.Value)
+		},
+		filterStore:   newETCDFilteredCollectionStore(channel.Value.GetTargetCluster().GetClusterId(), channel.Value.GetSourceChannelName()),
+		asyncNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+	}
+}
+
+func (r *bootstrapReplicator) StartReplication() {
+	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
+	logger.Info(context.TODO(), "start bootstrap of replicating task")
+	go func() {
+		defer func() {
+			if r.targetClient != nil {
+				r.targetClient.Close(r.asyncNotifier.Context())
+			}
+			r.asyncNotifier.Finish(struct{}{})
+		}()
+		for {
+			err := r.bootstrap(r.asyncNotifier.Context())
+			if err == nil {
+				return
+			}
+			if r.asyncNotifier.Context().Err() != nil {
+				logger.Info(context.TODO(), "bootstrap of replicating task stopped")
+				return
+			}
+			logger.Warn(context.TODO(), "bootstrap of replicating task failed, retry...", mlog.Err(err))
+			select {
+			case <-r.asyncNotifier.Context().Done():
+				return
+			case <-time.After(bootstrapRetryInterval):
+			}
+		}
+	}()
+}
+
+// bootstrap copies the existing data to the target cluster and finishes the bootstrap of the task.
+func (r *bootstrapReplicator) bootstrap(ctx context.Context) error {
+	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	checkpoint, err := r.getTargetCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	if checkpoint >= r.until {
+		logger.Info(context.TODO(), "existing data is already replicated", mlog.Uint64("checkpoint", checkpoint))
+		return r.finish(ctx)
+	}
+	r.timeTick = checkpoint
+	r.redo = r.redo || checkpoint > 0
+
+	databases, collections, err := r.listExistingData(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info(context.TODO(), "start to replicate existing data",
+		mlog.Uint64("until", r.until),
+		mlog.Uint64("checkpoint", checkpoint),
+		mlog.Int("databases", len(databases)),
+		mlog.Int("collections", len(collections)))
+
+	r.streamClient = r.createRscFunc(ctx, r.targetClient, r.channel)
+	defer func() {
+		r.streamClient.Close()
+		r.streamClient = nil
+	}()
+	if err := r.replicateDDL(ctx, databases, collections); err != nil {
+		return err
+	}
+	for _, coll := range collections {
+		if coll.filtered {
+			continue
+		}
+		for _, vchannel := range coll.desc.GetVirtualChannelNames() {
+			if funcutil.ToPhysicalChannel(vchannel) != r.channel.Value.GetSourceChannelName() {
+				continue
+			}
+			if err := r.replicateVChannel(ctx, coll, vchannel); err != nil {
+				return err
+			}
+		}
+	}
+	if err := r.streamClient.BlockUntilConfirmed(ctx); err != nil {
+		return err
+	}
+	logger.Info(context.TODO(), "existing data is replicated", mlog.Uint64("timeTick", r.timeTick))
+	return r.finish(ctx)
+}
+
+func (r *bootstrapReplicator) init(ctx context.Context) error {
+	initialized := r.channel.Value.GetInitializedCheckpoint()
+	if initialized.GetMessageId() == nil || initialized.GetTimeTick() == 0 {
+		return errors.Errorf("replicating task of channel %s has no initialized checkpoint to bootstrap", r.channel.Value.GetSourceChannelName())
+	}
+	cp := utility.NewReplicateCheckpointFromProto(initialized)
+	r.messageID = cp.MessageID
+	r.until = cp.TimeTick
+
+	if r.targetClient == nil {
+		dialCtx, dialCancel := context.WithTimeout(ctx, 30*time.Second)
+		defer dialCancel()
+		milvusClient, err := r.createMcFunc(dialCtx, r.channel.Value.GetTargetCluster())
+		if err != nil {
+			return err
+		}
+		r.targetClient = milvusClient
+	}
+	if r.filter == nil {
+		filter, err := newCollectionFilter(r.channel.Value, r.filterStore)
+		if err != nil {
+			return err
+		}
+		r.filter = filter
+	}
+	if r.coord == nil {
+		coord, err := r.getCoordFunc(ctx)
+		if err != nil {
+			return err
+		}
+		r.coord = coord
+		r.reader = r.newReaderFunc(coord)
+	}
+	if r.controlChannel == "" {
+		r.controlChannel = streaming.WAL().ControlChannel()
+	}
+	return nil
+}
+
+// getTargetCheckpoint returns the time tick of the checkpoint of the target cluster, 0 if nothing is replicated.
+func (r *bootstrapReplicator) getTargetCheckpoint(ctx context.Context) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	sourceClusterID := paramtable.Get().CommonCfg.ClusterPrefix.GetValue()
+	replicateInfo, err := r.targetClient.GetReplicateInfo(ctx, &milvuspb.GetReplicateInfoRequest{
+		SourceClusterId: sourceClusterID,
+		TargetPchannel:  r.channel.Value.GetTargetChannelName(),
+	})
+	if err != nil {
+		return 0, merr.Wrap(err, "failed to get replicate info")
+	}
+	checkpoint := replicateInfo.GetCheckpoint()
+	if checkpoint.GetMessageId() == nil {
+		return 0, nil
+	}
+	// the checkpoint of the target cluster is always older than the initialized checkpoint during the bootstrap,
+	// so only the source of the checkpoint is checked.
+	if checkpoint.GetClusterId() != "" && checkpoint.GetClusterId() != sourceClusterID {
+		return 0, errors.Wrapf(util.ErrReplicateCheckpointConflict, "checkpoint of target channel %s is replicated from cluster %s but not %s",
+			r.channel.Value.GetTargetChannelName(), checkpoint.GetClusterId(), sourceClusterID)
+	}
+	if checkpoint.GetPchannel() != "" && checkpoint.GetPchannel() != r.channel.Value.GetSourceChannelName() {
+		return 0, errors.Wrapf(util.ErrReplicateCheckpointConflict, "checkpoint of target channel %s is replicated from channel %s but not %s",
+			r.channel.Value.GetTargetChannelName(), checkpoint.GetPchannel(), r.channel.Value.GetSourceChannelName())
+	}
+	return checkpoint.GetTimeTick(), nil
+}
+
+// listExistingData lists the databases and collections existing at the initialized checkpoint, sorted by id.
+// The collections dropped after the initialized checkpoint are skipped, their data is gone and the drop is replicated by the live messages.
+func (r *bootstrapReplicator) listExistingData(ctx context.Context) ([]*bootstrapDatabase, []*bootstrapCollection, error) {
+	resp, err := r.coord.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, nil, err
+	}
+	databases := make([]*bootstrapDatabase, 0, len(resp.GetDbNames()))
+	collections := make([]*bootstrapCollection, 0)
+	for _, dbName := range resp.GetDbNames() {
+		dbResp, err := r.coord.DescribeDatabase(ctx, &rootcoordpb.DescribeDatabaseRequest{DbName: dbName})
+		if err := merr.CheckRPCCall(dbResp, err); err != nil {
+			if errors.Is(err, merr.ErrDatabaseNotFound) {
+				continue
+			}
+			return nil, nil, err
+		}
+		if dbResp.GetCreatedTimestamp() > r.until {
+			continue
+		}
+		databases = append(databases, &bootstrapDatabase{
+			id:         dbResp.GetDbID(),
+			name:       dbName,
+			properties: dbResp.GetProperties(),
+		})
+
+		collResp, err := r.coord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{DbName: dbName, TimeStamp: r.until})
+		if err := merr.CheckRPCCall(collResp, err); err != nil {
+			return nil, nil, err
+		}
+		for _, collectionID := range collResp.GetCollectionIds() {
+			coll, err := r.describeCollection(ctx, dbName, collectionID)
+			if errors.Is(err, merr.ErrCollectionNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			collections = append(collections, coll)
+		}
+	}
+	sort.Slice(databases, func(i, j int) bool { return databases[i].id < databases[j].id })
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].desc.GetCollectionID() < collections[j].desc.GetCollectionID()
+	})
+	return databases, collections, nil
+}
+
+// describeCollection describes the collection at the initialized checkpoint with the partitions created before it.
+func (r *bootstrapReplicator) describeCollection(ctx context.Context, dbName string, collectionID int64) (*bootstrapCollection, error) {
+	desc, err := r.coord.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+		DbName:       dbName,
+		CollectionID: collectionID,
+		TimeStamp:    r.until,
+	})
+	if err := merr.CheckRPCCall(desc, err); err != nil {
+		return nil, err
+	}
+	// the partitions dropped after the initialized checkpoint are not listed, their data is gone too.
+	partitions, err := r.coord.ShowPartitionsInternal(ctx, &milvuspb.ShowPartitionsRequest{
+		DbName:       dbName,
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(partitions, err); err != nil {
+		return nil, err
+	}
+	coll := &bootstrapCollection{desc: desc}
+	for i, partitionID := range partitions.GetPartitionIDs() {
+		if i < len(partitions.GetCreatedTimestamps()) && partitions.GetCreatedTimestamps()[i] > r.until {
+			continue
+		}
+		coll.partitionIDs = append(coll.partitionIDs, partitionID)
+		coll.partitionNames = append(coll.partitionNames, partitions.GetPartitionNames()[i])
+	}
+	return coll, nil
+}
+
+// replicateDDL replicates the creation of the databases and collections in the order of their ids,
+// which is the order of the broadcast ids the target cluster executes them.
+// The databases and collections already existing on the target cluster are not created again.
+func (r *bootstrapReplicator) replicateDDL(ctx context.Context, databases []*bootstrapDatabase, collections []*bootstrapCollection) error {
+	targetDatabases, err := r.targetClient.ListDatabase(ctx, &listDatabaseOption{})
+	if err != nil {
+		return merr.Wrap(err, "failed to list databases of target cluster")
+	}
+	existingDatabases := typeutil.NewSet(targetDatabases...)
+	existingCollections := make(map[string]typeutil.Set[string])
+	getExistingCollections := func(dbName string) (typeutil.Set[string], error) {
+		if names, ok := existingCollections[dbName]; ok {
+			return names, nil
+		}
+		names := typeutil.NewSet[string]()
+		if existingDatabases.Contain(dbName) {
+			targetCollections, err := r.targetClient.ListCollections(ctx, &listCollectionOption{dbName: dbName})
+			if err != nil {
+				return nil, merr.Wrapf(err, "failed to list collections of database %s of target cluster", dbName)
+			}
+			names.Insert(targetCollections...)
+		}
+		existingCollections[dbName] = names
+		return names, nil
+	}
+
+	for i, j := 0, 0; i < len(databases) || j < len(collections); {
+		if j >= len(collections) || (i < len(databases) && databases[i].id < collections[j].desc.GetCollectionID()) {
+			if err := r.replicateCreateDatabase(ctx, databases[i], existingDatabases); err != nil {
+				return err
+			}
+			i++
+			continue
+		}
+		existing, err := getExistingCollections(collections[j].desc.GetDbName())
+		if err != nil {
+			return err
+		}
+		if err := r.replicateCreateCollection(ctx, collections[j], existing); err != nil {
+			return err
+		}
+		j++
+	}
+	return nil
+}
+
+// replicateCreateDatabase replicates the creation of the database,
+// it's done by the task of the pchannel holding the control channel because the message is only broadcast to the control channel.
+func (r *bootstrapReplicator) replicateCreateDatabase(ctx context.Context, db *bootstrapDatabase, existing typeutil.Set[string]) error {
+	if !r.ownsControlChannel() || db.name == pkgutil.DefaultDBName || existing.Contain(db.name) {
+		return nil
+	}
+	msgs := message.NewCreateDatabaseMessageBuilderV2().
+		WithHeader(&message.CreateDatabaseMessageHeader{
+			DbName: db.name,
+			DbId:   db.id,
+		}).
+		WithBody(&message.CreateDatabaseMessageBody{
+			Properties: db.properties,
+		}).
+		WithBroadcast([]string{r.controlChannel}).
+		MustBuildBroadcast().
+		OverwriteBroadcastHeader(uint64(db.id), message.NewExclusiveDBNameResourceKey(db.name)).
+		SplitIntoMutableMessage()
+	for _, msg := range msgs {
+		if err := r.replicate(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replicateCreateCollection replicates the pieces of the collection creation on the source pchannel.
+// The collection filtered out by the collection filter is recorded into the filter store, so its live messages are filtered too.
+func (r *bootstrapReplicator) replicateCreateCollection(ctx context.Context, coll *bootstrapCollection, existing typeutil.Set[string]) error {
+	desc := coll.desc
+	schema := proto.Clone(desc.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Properties = make([]*commonpb.KeyValuePair, 0, len(desc.GetProperties())+1)
+	for _, kv := range desc.GetProperties() {
+		if kv.GetKey() != common.ConsistencyLevel {
+			schema.Properties = append(schema.Properties, kv)
+		}
+	}
+	schema.Properties = append(schema.Properties, &commonpb.KeyValuePair{
+		Key:   common.ConsistencyLevel,
+		Value: strconv.Itoa(int(desc.GetConsistencyLevel())),
+	})
+
+	broadcastChannels := make([]string, 0, len(desc.GetVirtualChannelNames())+1)
+	broadcastChannels = append(broadcastChannels, r.controlChannel)
+	broadcastChannels = append(broadcastChannels, desc.GetVirtualChannelNames()...)
+	pieces := message.NewCreateCollectionMessageBuilderV1().
+		WithHeader(&message.CreateCollectionMessageHeader{
+			CollectionId: desc.GetCollectionID(),
+			PartitionIds: coll.partitionIDs,
+			DbId:         desc.GetDbId(),
+		}).
+		WithBody(&message.CreateCollectionRequest{
+			Base:                 commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+			DbName:               desc.GetDbName(),
+			CollectionName:       desc.GetCollectionName(),
+			DbID:                 desc.GetDbId(),
+			CollectionID:         desc.GetCollectionID(),
+			PartitionIDs:         coll.partitionIDs,
+			PartitionNames:       coll.partitionNames,
+			VirtualChannelNames:  desc.GetVirtualChannelNames(),
+			PhysicalChannelNames: desc.GetPhysicalChannelNames(),
+			CollectionSchema:     schema,
+		}).
+		WithBroadcast(broadcastChannels).
+		MustBuildBroadcast().
+		OverwriteBroadcastHeader(uint64(desc.GetCollectionID()),
+			message.NewSharedDBNameResourceKey(desc.GetDbName()),
+			message.NewExclusiveCollectionNameResourceKey(desc.GetDbName(), desc.GetCollectionName())).
+		SplitIntoMutableMessage()
+
+	msgs := make([]message.MutableMessage, 0, len(pieces))
+	for _, piece := range pieces {
+		if funcutil.ToPhysicalChannel(piece.VChannel()) != r.channel.Value.GetSourceChannelName() {
+			continue
+		}
+		// the filter records the collection filtered out, so its live messages are filtered too.
+		filtered, err := r.filter.Filter(ctx, r.intoImmutableMessage(piece, r.timeTick))
+		if err != nil {
+			return err
+		}
+		if filtered {
+			coll.filtered = true
+			continue
+		}
+		msgs = append(msgs, piece)
+	}
+	if coll.filtered || existing.Contain(desc.GetCollectionName()) {
+		return nil
+	}
+	for _, msg := range msgs {
+		if err := r.replicate(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replicateVChannel replicates the rows of the vchannel alive at the initialized checkpoint.
+// The vchannel is flushed if the persisted data doesn't cover the initialized checkpoint yet.
+func (r *bootstrapReplicator) replicateVChannel(ctx context.Context, coll *bootstrapCollection, vchannel string) error {
+	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.String("vchannel", vchannel))
+	desc := coll.desc
+	partitionNames := make(map[int64]string, len(coll.partitionIDs))
+	for i, partitionID := range coll.partitionIDs {
+		partitionNames[partitionID] = coll.partitionNames[i]
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(desc.GetSchema())
+	if err != nil {
+		return err
+	}
+	opts := streaming.CatchupReadOption{
+		CollectionID: desc.GetCollectionID(),
+		VChannel:     vchannel,
+		Schema:       desc.GetSchema(),
+		BatchSize:    paramtable.Get().PulsarCfg.MaxMessageSize.GetAsInt(),
+		SnapshotHandler: func(ctx context.Context, data *streaming.CatchupData) error {
+			partitionName, ok := partitionNames[data.PartitionID]
+			if !ok && data.PartitionID != common.AllPartitionsID {
+				// the partition is dropped after the initialized checkpoint.
+				return nil
+			}
+			if data.Deletes != nil {
+				pks := storage.ParsePrimaryKeys2IDs(data.Deletes.Pks)
+				msg, err := newBootstrapDeleteMessage(desc, vchannel, data.PartitionID, partitionName, pks, data.Deletes.Tss)
+				if err != nil {
+					return err
+				}
+				if err := r.replicate(ctx, msg); err != nil {
+					return err
+				}
+			}
+			if data.Inserts != nil {
+				return r.replicateInserts(ctx, desc, pkField, vchannel, data.PartitionID, partitionName, data.Inserts)
+			}
+			return nil
+		},
+	}
+
+	flushed := false
+	for {
+		_, err := r.reader.ReadSnapshot(ctx, opts, r.until)
+		if err == nil {
+			logger.Info(context.TODO(), "existing data of vchannel is replicated", mlog.Uint64("timeTick", r.timeTick))
+			return nil
+		}
+		if !errors.Is(err, streaming.ErrSnapshotNotReady) {
+			return err
+		}
+		if !flushed {
+			if err := r.flush(ctx, desc.GetCollectionID(), vchannel); err != nil {
+				return err
+			}
+			flushed = true
+			logger.Info(context.TODO(), "vchannel is flushed to persist the existing data")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(snapshotCheckInterval):
+		}
+	}
+}
+
+// replicateInserts replicates the rows read from a segment,
+// the rows are deleted first if the target cluster may hold them already.
+func (r *bootstrapReplicator) replicateInserts(
+	ctx context.Context,
+	desc *milvuspb.DescribeCollectionResponse,
+	pkField *schemapb.FieldSchema,
+	vchannel string,
+	partitionID int64,
+	partitionName string,
+	data *storage.InsertData,
+) error {
+	msg, pks, err := newBootstrapInsertMessage(desc, pkField, vchannel, partitionID, partitionName, data)
+	if err != nil {
+		return err
+	}
+	if r.redo {
+		timestamps := make([]uint64, typeutil.GetSizeOfIDs(pks))
+		deleteMsg, err := newBootstrapDeleteMessage(desc, vchannel, partitionID, partitionName, pks, timestamps)
+		if err != nil {
+			return err
+		}
+		if err := r.replicate(ctx, deleteMsg); err != nil {
+			return err
+		}
+	}
+	return r.replicate(ctx, msg)
+}
+
+// flush flushes the vchannel, so the data before the initialized checkpoint is persisted into the binlogs.
+func (r *bootstrapReplicator) flush(ctx context.Context, collectionID int64, vchannel string) error {
+	resp, err := r.coord.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{Count: 1})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+	msg, err := message.NewManualFlushMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&message.ManualFlushMessageHeader{
+			CollectionId: collectionID,
+			FlushTs:      resp.GetTimestamp(),
+		}).
+		WithBody(&message.ManualFlushMessageBody{}).
+		BuildMutable()
+	if err != nil {
+		return err
+	}
+	_, err = streaming.WAL().RawAppend(ctx, msg, streaming.AppendOption{BarrierTimeTick: resp.GetTimestamp()})
+	return err
+}
+
+// replicate replicates the synthetic message with the next time tick,
+// the message filtered out by the collection filter is skipped.
+func (r *bootstrapReplicator) replicate(ctx context.Context, msg message.MutableMessage) error {
+	if r.timeTick+1 >= r.until {
+		return errors.Errorf("no time tick left to replicate existing data before the initialized checkpoint %d", r.until)
+	}
+	r.timeTick++
+	immutableMsg := r.intoImmutableMessage(msg, r.timeTick)
+	filtered, err := r.filter.Filter(ctx, immutableMsg)
+	if err != nil {
+		return err
+	}
+	if filtered {
+		return nil
+	}
+	if err := r.streamClient.Replicate(immutableMsg); err != nil {
+		return err
+	}
+	// the message may be applied by the target cluster even if the bootstrap fails later.
+	r.redo = true
+	return nil
+}
+
+func (r *bootstrapReplicator) intoImmutableMessage(msg message.MutableMessage, timeTick uint64) message.ImmutableMessage {
+	return msg.WithTimeTick(timeTick).
+		WithLastConfirmed(r.messageID).
+		IntoImmutableMessage(r.messageID)
+}
+
+// finish switches the task to running from the initialized checkpoint.
+func (r *bootstrapReplicator) finish(ctx context.Context) error {
+	ok, err := r.finishFunc(ctx, r.channel)
+	if err != nil {
+		return err
+	}
+	mlog.Info(context.TODO(), "bootstrap of replicating task finished",
+		mlog.String("key", r.channel.Key),
+		mlog.Int64("modRevision", r.channel.ModRevision),
+		mlog.Bool("updated", ok))
+	return nil
+}
+
+func (r *bootstrapReplicator) ownsControlChannel() bool {
+	return funcutil.ToPhysicalChannel(r.controlChannel) == r.channel.Value.GetSourceChannelName()
+}
+
+func (r *bootstrapReplicator) StopReplication() {
+	r.asyncNotifier.Cancel()
+	r.asyncNotifier.BlockUntilFinish()
+}
+
+// newBootstrapInsertMessage builds the insert message of the rows, the primary keys of the rows are returned.
+func newBootstrapInsertMessage(
+	desc *milvuspb.DescribeCollectionResponse,
+	pkField *schemapb.FieldSchema,
+	vchannel string,
+	partitionID int64,
+	partitionName string,
+	data *storage.InsertData,
+) (message.MutableMessage, *schemapb.IDs, error) {
+	rowIDs, ok := data.Data[common.RowIDField].(*storage.Int64FieldData)
+	if !ok {
+		return nil, nil, errors.Errorf("row id field not found in the data of collection %d", desc.GetCollectionID())
+	}
+	tsField, ok := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok {
+		return nil, nil, errors.Errorf("timestamp field not found in the data of collection %d", desc.GetCollectionID())
+	}
+	userData := &storage.InsertData{Data: make(map[int64]storage.FieldData, len(data.Data))}
+	for fieldID, fieldData := range data.Data {
+		if fieldID >= common.StartOfUserFieldID {
+			userData.Data[fieldID] = fieldData
+		}
+	}
+	record, err := storage.TransferInsertDataToInsertRecord(userData)
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldNames := make(map[int64]string, len(desc.GetSchema().GetFields()))
+	for _, field := range desc.GetSchema().GetFields() {
+		fieldNames[field.GetFieldID()] = field.GetName()
+	}
+	fieldsData := record.GetFieldsData()
+	sort.Slice(fieldsData, func(i, j int) bool { return fieldsData[i].GetFieldId() < fieldsData[j].GetFieldId() })
+	var pks *schemapb.IDs
+	for _, fieldData := range fieldsData {
+		fieldData.FieldName = fieldNames[fieldData.GetFieldId()]
+		if fieldData.GetFieldId() == pkField.GetFieldID() {
+			primaryKeys, err := storage.ParseFieldData2PrimaryKeys(fieldData)
+			if err != nil {
+				return nil, nil, err
+			}
+			pks = storage.ParsePrimaryKeys2IDs(primaryKeys)
+		}
+	}
+	if pks == nil {
+		return nil, nil, errors.Errorf("primary field not found in the data of collection %d", desc.GetCollectionID())
+	}
+	timestamps := make([]uint64, len(tsField.Data))
+	for i, ts := range tsField.Data {
+		timestamps[i] = uint64(ts)
+	}
+
+	msg, err := message.NewInsertMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.InsertMessageHeader{
+			CollectionId: desc.GetCollectionID(),
+			Partitions: []*message.PartitionSegmentAssignment{
+				{
+					PartitionId: partitionID,
+					Rows:        uint64(len(rowIDs.Data)),
+					BinarySize:  0, // TODO: current not used, message estimate size is used.
+				},
+			},
+		}).
+		WithBody(&msgpb.InsertRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Insert)),
+			ShardName:      vchannel,
+			DbName:         desc.GetDbName(),
+			CollectionName: desc.GetCollectionName(),
+			PartitionName:  partitionName,
+			CollectionID:   desc.GetCollectionID(),
+			PartitionID:    partitionID,
+			Timestamps:     timestamps,
+			RowIDs:         rowIDs.Data,
+			FieldsData:     fieldsData,
+			NumRows:        uint64(len(rowIDs.Data)),
+			Version:        msgpb.InsertDataVersion_ColumnBased,
+		}).
+		BuildMutable()
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, pks, nil
+}
+
+// newBootstrapDeleteMessage builds the delete message of the primary keys.
+func newBootstrapDeleteMessage(
+	desc *milvuspb.DescribeCollectionResponse,
+	vchannel string,
+	partitionID int64,
+	partitionName string,
+	pks *schemapb.IDs,
+	timestamps []uint64,
+) (message.MutableMessage, error) {
+	rows := typeutil.GetSizeOfIDs(pks)
+	return message.NewDeleteMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.DeleteMessageHeader{
+			CollectionId: desc.GetCollectionID(),
+			Rows:         uint64(rows),
+		}).
+		WithBody(&msgpb.DeleteRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_Delete)),
+			ShardName:      vchannel,
+			DbName:         desc.GetDbName(),
+			CollectionName: desc.GetCollectionName(),
+			PartitionName:  partitionName,
+			DbID:           desc.GetDbId(),
+			CollectionID:   desc.GetCollectionID(),
+			PartitionID:    partitionID,
+			Timestamps:     timestamps,
+			NumRows:        int64(rows),
+			PrimaryKeys:    pks,
+		}).
+		BuildMutable()
+}
@@ -0,0 +1,345 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mock "github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/internal/cdc/meta"
+	"github.com/milvus-io/milvus/internal/cdc/replication/replicatestream"
+	"github.com/milvus-io/milvus/internal/cdc/util"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type fakeSnapshotReader struct {
+	notReady int
+	data     map[string][]*streaming.CatchupData
+}
+
+func (r *fakeSnapshotReader) ReadSnapshot(ctx context.Context, opts streaming.CatchupReadOption, until uint64) (*streaming.VChannelSnapshot, error) {
+	if r.notReady > 0 {
+		r.notReady--
+		return nil, streaming.ErrSnapshotNotReady
+	}
+	for _, data := range r.data[opts.VChannel] {
+		if err := opts.SnapshotHandler(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+	return &streaming.VChannelSnapshot{VChannel: opts.VChannel}, nil
+}
+
+func newBootstrapTestCoord(t *testing.T) *mocks.MockMixCoordClient {
+	coord := mocks.NewMockMixCoordClient(t)
+	coord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+		Status:  merr.Success(),
+		DbNames: []string{"default", "db1", "db2"},
+	}, nil).Maybe()
+	databases := map[string]*rootcoordpb.DescribeDatabaseResponse{
+		"default": {Status: merr.Success(), DbName: "default", DbID: 1, CreatedTimestamp: 1},
+		"db1":     {Status: merr.Success(), DbName: "db1", DbID: 2, CreatedTimestamp: 10},
+		// created after the initialized checkpoint.
+		"db2": {Status: merr.Success(), DbName: "db2", DbID: 3, CreatedTimestamp: 2000},
+	}
+	coord.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*rootcoordpb.DescribeDatabaseResponse, error) {
+			return databases[req.GetDbName()], nil
+		}).Maybe()
+	collections := map[string][]int64{"default": {100, 103}, "db1": {101}}
+	coord.EXPECT().ShowCollections(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
+			assert.Equal(t, uint64(1000), req.GetTimeStamp())
+			return &milvuspb.ShowCollectionsResponse{Status: merr.Success(), CollectionIds: collections[req.GetDbName()]}, nil
+		}).Maybe()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "val", DataType: schemapb.DataType_Int64},
+		},
+	}
+	descs := map[int64]*milvuspb.DescribeCollectionResponse{
+		100: {
+			Status:               merr.Success(),
+			DbName:               "default",
+			DbId:                 1,
+			CollectionID:         100,
+			CollectionName:       "c1",
+			Schema:               schema,
+			VirtualChannelNames:  []string{"by-dev-dml_0_100v0", "by-dev-dml_1_100v1"},
+			PhysicalChannelNames: []string{"by-dev-dml_0", "by-dev-dml_1"},
+			ConsistencyLevel:     commonpb.ConsistencyLevel_Bounded,
+		},
+		101: {
+			Status:               merr.Success(),
+			DbName:               "db1",
+			DbId:                 2,
+			CollectionID:         101,
+			CollectionName:       "tmp",
+			Schema:               schema,
+			VirtualChannelNames:  []string{"by-dev-dml_0_101v0"},
+			PhysicalChannelNames: []string{"by-dev-dml_0"},
+		},
+	}
+	coord.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+			desc, ok := descs[req.GetCollectionID()]
+			if !ok {
+				// dropped after the initialized checkpoint.
+				return &milvuspb.DescribeCollectionResponse{Status: merr.Status(merr.WrapErrCollectionNotFound(req.GetCollectionID()))}, nil
+			}
+			return desc, nil
+		}).Maybe()
+	coord.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+		Status:            merr.Success(),
+		PartitionNames:    []string{"_default", "p1"},
+		PartitionIDs:      []int64{1000, 1001},
+		CreatedTimestamps: []uint64{1, 2000},
+	}, nil).Maybe()
+	coord.EXPECT().AllocTimestamp(mock.Anything, mock.Anything).Return(&rootcoordpb.AllocTimestampResponse{
+		Status:    merr.Success(),
+		Timestamp: 1100,
+	}, nil).Maybe()
+	return coord
+}
+
+func newBootstrapTestInsertData() *storage.InsertData {
+	return &storage.InsertData{Data: map[int64]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: []int64{1, 2}},
+		common.TimeStampField: &storage.Int64FieldData{Data: []int64{10, 20}},
+		100:                   &storage.Int64FieldData{Data: []int64{1, 2}},
+		101:                   &storage.Int64FieldData{Data: []int64{5, 6}},
+	}}
+}
+
+func newTestBootstrapReplicator(
+	t *testing.T,
+	targetClient cluster.MilvusClient,
+	reader snapshotReader,
+	store filteredCollectionStore,
+) (*bootstrapReplicator, *[]message.ImmutableMessage, *atomic.Int32) {
+	replicated := make([]message.ImmutableMessage, 0)
+	rs := replicatestream.NewMockReplicateStreamClient(t)
+	rs.EXPECT().Replicate(mock.Anything).RunAndReturn(func(msg message.ImmutableMessage) error {
+		replicated = append(replicated, msg)
+		return nil
+	}).Maybe()
+	rs.EXPECT().BlockUntilConfirmed(mock.Anything).Return(nil).Maybe()
+	rs.EXPECT().Close().Return().Maybe()
+
+	finished := atomic.NewInt32(0)
+	coord := newBootstrapTestCoord(t)
+	r := NewBootstrapReplicator(&meta.ReplicateChannel{
+		Key: "test-key",
+		Value: &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: "by-dev-dml_0",
+			TargetChannelName: "target-dml_0",
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: "target"},
+			InitializedCheckpoint: &commonpb.ReplicateCheckpoint{
+				MessageId: newMockPulsarMessageID(),
+				TimeTick:  1000,
+			},
+			CollectionFilter: &messagespb.ReplicateCollectionFilter{Excludes: []string{"db1.tmp"}},
+			State:            streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING,
+		},
+	}).(*bootstrapReplicator)
+	r.createMcFunc = func(ctx context.Context, cluster *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+		return targetClient, nil
+	}
+	r.createRscFunc = func(ctx context.Context, c cluster.MilvusClient, rm *meta.ReplicateChannel) replicatestream.ReplicateStreamClient {
+		return rs
+	}
+	r.getCoordFunc = func(ctx context.Context) (bootstrapCoordClient, error) {
+		return coord, nil
+	}
+	r.newReaderFunc = func(coord bootstrapCoordClient) snapshotReader {
+		return reader
+	}
+	r.finishFunc = func(ctx context.Context, channel *meta.ReplicateChannel) (bool, error) {
+		finished.Inc()
+		return true, nil
+	}
+	r.filterStore = store
+	r.controlChannel = "by-dev-dml_0_vcchan"
+	return r, &replicated, finished
+}
+
+func TestBootstrapReplicator(t *testing.T) {
+	paramtable.Init()
+	oldInterval := snapshotCheckInterval
+	snapshotCheckInterval = time.Millisecond
+	defer func() { snapshotCheckInterval = oldInterval }()
+
+	wal := mock_streaming.NewMockWALAccesser(t)
+	flushed := atomic.NewInt32(0)
+	wal.EXPECT().RawAppend(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, msg message.MutableMessage, opts ...streaming.AppendOption) (*types.AppendResult, error) {
+			assert.Equal(t, message.MessageTypeManualFlush, msg.MessageType())
+			assert.Equal(t, "by-dev-dml_0_100v0", msg.VChannel())
+			assert.Equal(t, uint64(1100), opts[0].BarrierTimeTick)
+			flushed.Inc()
+			return nil, nil
+		})
+	streaming.SetWALForTest(wal)
+
+	targetClient := cluster.NewMockMilvusClient(t)
+	targetClient.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).Return(&milvuspb.GetReplicateInfoResponse{}, nil).Once()
+	targetClient.EXPECT().ListDatabase(mock.Anything, mock.Anything).Return([]string{"default"}, nil).Once()
+	targetClient.EXPECT().ListCollections(mock.Anything, mock.Anything).Return([]string{}, nil).Once()
+
+	reader := &fakeSnapshotReader{
+		notReady: 1,
+		data: map[string][]*streaming.CatchupData{
+			"by-dev-dml_0_100v0": {
+				{PartitionID: 1000, SegmentID: 1, Inserts: newBootstrapTestInsertData()},
+				// the partition is created after the initialized checkpoint.
+				{PartitionID: 1001, SegmentID: 2, Inserts: newBootstrapTestInsertData()},
+			},
+		},
+	}
+	store := &memFilteredCollectionStore{}
+	r, replicated, finished := newTestBootstrapReplicator(t, targetClient, reader, store)
+	assert.NoError(t, r.bootstrap(context.Background()))
+	assert.Equal(t, int32(1), finished.Load())
+	assert.Equal(t, int32(1), flushed.Load())
+
+	// the database and collection are created in the order of ids before the rows are inserted,
+	// the filtered collection is recorded so its live messages are filtered too.
+	msgs := *replicated
+	assert.Len(t, msgs, 4)
+	assert.Equal(t, message.MessageTypeCreateDatabase, msgs[0].MessageType())
+	assert.Equal(t, "db1", message.MustAsImmutableCreateDatabaseMessageV2(msgs[0]).Header().GetDbName())
+	assert.Equal(t, uint64(2), msgs[0].BroadcastHeader().BroadcastID)
+	assert.Equal(t, message.MessageTypeCreateCollection, msgs[1].MessageType())
+	assert.Equal(t, "by-dev-dml_0_vcchan", msgs[1].VChannel())
+	assert.Equal(t, message.MessageTypeCreateCollection, msgs[2].MessageType())
+	assert.Equal(t, "by-dev-dml_0_100v0", msgs[2].VChannel())
+	assert.Equal(t, uint64(100), msgs[2].BroadcastHeader().BroadcastID)
+	body := message.MustAsImmutableCreateCollectionMessageV1(msgs[2]).MustBody()
+	assert.Equal(t, []int64{1000}, body.GetPartitionIDs())
+	level, ok := funcutil.TryGetAttrByKeyFromRepeatedKV(common.ConsistencyLevel, body.GetCollectionSchema().GetProperties())
+	assert.True(t, ok)
+	assert.Equal(t, "2", level)
+	assert.Equal(t, message.MessageTypeInsert, msgs[3].MessageType())
+	insert := message.MustAsImmutableInsertMessageV1(msgs[3]).MustBody()
+	assert.Equal(t, uint64(2), insert.GetNumRows())
+	assert.Equal(t, "_default", insert.GetPartitionName())
+	assert.Equal(t, []int64{1, 2}, insert.GetRowIDs())
+	assert.Len(t, insert.GetFieldsData(), 2)
+	assert.Equal(t, "pk", insert.GetFieldsData()[0].GetFieldName())
+	for i, msg := range msgs {
+		assert.Equal(t, uint64(i+1), msg.TimeTick())
+		assert.True(t, msg.MessageID().EQ(r.messageID))
+	}
+	assert.Equal(t, map[int64][]string{101: {"by-dev-dml_0_101v0", "by-dev-dml_0_vcchan"}}, store.collections())
+
+	// the bootstrap is redone after a failure, the created database and collection are skipped
+	// and the rows are deleted before they're inserted again.
+	targetClient.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).Return(&milvuspb.GetReplicateInfoResponse{
+		Checkpoint: &commonpb.ReplicateCheckpoint{
+			ClusterId: "by-dev",
+			Pchannel:  "by-dev-dml_0",
+			MessageId: newMockPulsarMessageID(),
+			TimeTick:  3,
+		},
+	}, nil).Once()
+	targetClient.EXPECT().ListDatabase(mock.Anything, mock.Anything).Return([]string{"default", "db1"}, nil).Once()
+	targetClient.EXPECT().ListCollections(mock.Anything, mock.Anything).Return([]string{"c1"}, nil).Twice()
+	r, replicated, finished = newTestBootstrapReplicator(t, targetClient, reader, store)
+	assert.NoError(t, r.bootstrap(context.Background()))
+	assert.Equal(t, int32(1), finished.Load())
+	msgs = *replicated
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, message.MessageTypeDelete, msgs[0].MessageType())
+	assert.Equal(t, uint64(4), msgs[0].TimeTick())
+	assert.Equal(t, []int64{1, 2}, message.MustAsImmutableDeleteMessageV1(msgs[0]).MustBody().GetPrimaryKeys().GetIntId().GetData())
+	assert.Equal(t, message.MessageTypeInsert, msgs[1].MessageType())
+	assert.Equal(t, uint64(5), msgs[1].TimeTick())
+
+	// the bootstrap is finished directly if the target cluster has confirmed the initialized checkpoint.
+	targetClient.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).Return(&milvuspb.GetReplicateInfoResponse{
+		Checkpoint: &commonpb.ReplicateCheckpoint{
+			ClusterId: "by-dev",
+			MessageId: newMockPulsarMessageID(),
+			TimeTick:  1000,
+		},
+	}, nil).Once()
+	r, replicated, finished = newTestBootstrapReplicator(t, targetClient, reader, store)
+	assert.NoError(t, r.bootstrap(context.Background()))
+	assert.Equal(t, int32(1), finished.Load())
+	assert.Empty(t, *replicated)
+
+	// the checkpoint replicated from another cluster conflicts with the task.
+	targetClient.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).Return(&milvuspb.GetReplicateInfoResponse{
+		Checkpoint: &commonpb.ReplicateCheckpoint{
+			ClusterId: "another",
+			MessageId: newMockPulsarMessageID(),
+			TimeTick:  3,
+		},
+	}, nil).Once()
+	r, _, finished = newTestBootstrapReplicator(t, targetClient, reader, store)
+	assert.ErrorIs(t, r.bootstrap(context.Background()), util.ErrReplicateCheckpointConflict)
+	assert.Equal(t, int32(0), finished.Load())
+}
+
+func TestBootstrapReplicator_StartAndStop(t *testing.T) {
+	oldInterval := bootstrapRetryInterval
+	bootstrapRetryInterval = time.Millisecond
+	defer func() { bootstrapRetryInterval = oldInterval }()
+
+	targetClient := cluster.NewMockMilvusClient(t)
+	called := atomic.NewInt32(0)
+	targetClient.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.GetReplicateInfoRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicateInfoResponse, error) {
+			called.Inc()
+			return nil, merr.ErrServiceUnavailable
+		})
+	targetClient.EXPECT().Close(mock.Anything).Return(nil)
+
+	r, _, finished := newTestBootstrapReplicator(t, targetClient, &fakeSnapshotReader{}, &memFilteredCollectionStore{})
+	r.StartReplication()
+	// the bootstrap is retried until it's stopped.
+	assert.Eventually(t, func() bool {
+		return called.Load() > 1
+	}, time.Second, time.Millisecond)
+	r.StopReplication()
+	assert.Equal(t, int32(0), finished.Load())
+}
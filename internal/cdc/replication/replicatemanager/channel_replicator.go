@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
@@ -39,6 +40,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 )
 
+// checkpointConflictRetryInterval is the interval of checking the replicate checkpoint again after a conflict is found.
+var checkpointConflictRetryInterval = 30 * time.Second
+
 // Replicator is the client that replicates the message to the channel in the target cluster.
 type Replicator interface {
 	// StartReplication starts the replicate for the channel.
@@ -60,6 +64,8 @@ type channelReplicator struct {
 	streamClient  replicatestream.ReplicateStreamClient
 	msgScanner    streaming.Scanner
 	msgChan       adaptor.ChanMessageHandler
+	filterStore   filteredCollectionStore
+	filter        *collectionFilter
 
 	asyncNotifier *syncutil.AsyncTaskNotifier[struct{}]
 }
@@ -71,6 +77,7 @@ func NewChannelReplicator(channel *meta.ReplicateChannel) Replicator {
 		channel:       channel,
 		createRscFunc: createRscFunc,
 		createMcFunc:  cluster.NewMilvusClient,
+		filterStore:   newETCDFilteredCollectionStore(channel.Value.GetTargetCluster().GetClusterId(), channel.Value.GetSourceChannelName()),
 		asyncNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
 	}
 }
@@ -98,6 +105,16 @@ func (r *channelReplicator) StartReplication() {
 				return
 			default:
 				err := r.init()
+				if errors.Is(err, util.ErrReplicateCheckpointConflict) {
+					// never replicate from a conflicting checkpoint, wait for the operator to reset the checkpoint.
+					logger.Error(context.TODO(), "replicate checkpoint conflicts with the task, replication is not started", mlog.Err(err))
+					select {
+					case <-r.asyncNotifier.Context().Done():
+						return
+					case <-time.After(checkpointConflictRetryInterval):
+					}
+					continue
+				}
 				if err != nil {
 					logger.Warn(context.TODO(), "initialize replicator failed", mlog.Err(err))
 					continue
@@ -122,23 +139,32 @@ func (r *channelReplicator) init() error {
 		r.targetClient = milvusClient
 		logger.Info(context.TODO(), "target client initialized")
 	}
+	// init collection filter
+	if r.filter == nil {
+		filter, err := newCollectionFilter(r.channel.Value, r.filterStore)
+		if err != nil {
+			return err
+		}
+		r.filter = filter
+	}
 	// init msg scanner
 	if r.msgScanner == nil {
-		cp, err := r.getReplicateCheckpoint()
+		deliverPolicy, deliverFilters, err := r.getDeliverPolicy()
 		if err != nil {
 			return err
 		}
 		ch := make(adaptor.ChanMessageHandler)
 		scanner := streaming.WAL().Read(r.asyncNotifier.Context(), streaming.ReadOption{
 			PChannel:               r.channel.Value.GetSourceChannelName(),
-			DeliverPolicy:          options.DeliverPolicyStartFrom(cp.MessageID),
-			DeliverFilters:         []options.DeliverFilter{options.DeliverFilterTimeTickGT(cp.TimeTick)},
+			DeliverPolicy:          deliverPolicy,
+			DeliverFilters:         deliverFilters,
 			MessageHandler:         ch,
 			IgnorePauseConsumption: true,
+			PreferReadReplica:      true,
 		})
 		r.msgScanner = scanner
 		r.msgChan = ch
-		logger.Info(context.TODO(), "scanner initialized", mlog.Any("checkpoint", cp))
+		logger.Info(context.TODO(), "scanner initialized", mlog.Any("deliverPolicy", deliverPolicy))
 	}
 	// init replicate stream client
 	if r.streamClient == nil {
@@ -159,6 +185,10 @@ func (r *channelReplicator) startConsumeLoop() {
 			logger.Info(context.TODO(), "consume loop stopped")
 			return
 		case msg := <-r.msgChan:
+			if r.filterMessage(msg) {
+				logger.Debug(context.TODO(), "message is skipped by collection filter", mlog.FieldMessage(msg))
+				continue
+			}
 			err := r.streamClient.Replicate(msg)
 			if err != nil {
 				if !errors.Is(err, replicatestream.ErrReplicateIgnored) {
@@ -178,9 +208,57 @@ func (r *channelReplicator) startConsumeLoop() {
 	}
 }
 
+// filterMessage checks if the message is filtered out by the collection filter of the task,
+// it retries until the filtered collections are recovered or persisted, or the replicator is stopped.
+func (r *channelReplicator) filterMessage(msg message.ImmutableMessage) bool {
+	for {
+		filtered, err := r.filter.Filter(r.asyncNotifier.Context(), msg)
+		if err == nil {
+			return filtered
+		}
+		if r.asyncNotifier.Context().Err() != nil {
+			return true
+		}
+		mlog.Warn(context.TODO(), "failed to filter message by collection filter, retry...",
+			mlog.String("key", r.channel.Key), mlog.FieldMessage(msg), mlog.Err(err))
+		select {
+		case <-r.asyncNotifier.Context().Done():
+			return true
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// getDeliverPolicy returns the position of the source channel to start the replication from.
+// The checkpoint reset by the operator has the highest priority, otherwise the replicate checkpoint is used.
+// The reset is one-shot, it's cleared by the replicate stream client once the target cluster confirms the replicated messages.
+func (r *channelReplicator) getDeliverPolicy() (options.DeliverPolicy, []options.DeliverFilter, error) {
+	switch r.channel.Value.GetCheckpointResetPolicy() {
+	case streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST:
+		return options.DeliverPolicyAll(), nil, nil
+	case streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_LATEST:
+		return options.DeliverPolicyLatest(), nil, nil
+	}
+	cp, err := r.getReplicateCheckpoint()
+	if err != nil {
+		return nil, nil, err
+	}
+	return options.DeliverPolicyStartFrom(cp.MessageID), []options.DeliverFilter{options.DeliverFilterTimeTickGT(cp.TimeTick)}, nil
+}
+
 func (r *channelReplicator) getReplicateCheckpoint() (*utility.ReplicateCheckpoint, error) {
 	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("modRevision", r.channel.ModRevision))
 
+	// The checkpoint is reset by the operator, e.g. the target cluster is restored from backup.
+	if r.channel.Value.GetCheckpointResetPolicy() == streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT {
+		resetCheckpoint := utility.NewReplicateCheckpointFromProto(r.channel.Value.InitializedCheckpoint)
+		logger.Info(context.TODO(), "use the checkpoint reset by operator",
+			mlog.Stringer("messageID", resetCheckpoint.MessageID),
+			mlog.Uint64("timeTick", resetCheckpoint.TimeTick),
+		)
+		return resetCheckpoint, nil
+	}
+
 	// For pchannel-increasing tasks, the secondary WAL for new pchannels hasn't received the
 	// AlterReplicateConfig yet, so GetReplicateInfo would fail. Use InitializedCheckpoint directly.
 	if r.channel.Value.GetSkipGetReplicateCheckpoint() {
@@ -215,6 +293,9 @@ func (r *channelReplicator) getReplicateCheckpoint() (*utility.ReplicateCheckpoi
 		return initializedCheckpoint, nil
 	}
 
+	if err := util.CheckReplicateCheckpointConflict(r.channel.Value, sourceClusterID, checkpoint); err != nil {
+		return nil, err
+	}
 	cp := utility.NewReplicateCheckpointFromProto(checkpoint)
 	logger.Info(context.TODO(), "replicate messages from position",
 		mlog.Stringer("messageID", cp.MessageID),
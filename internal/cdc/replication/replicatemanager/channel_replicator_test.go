@@ -24,6 +24,8 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
@@ -93,3 +95,46 @@ func TestChannelReplicator_StartReplicateChannel(t *testing.T) {
 	time.Sleep(200 * time.Millisecond)
 	replicator.StopReplication()
 }
+
+func TestChannelReplicator_CheckpointConflict(t *testing.T) {
+	oldInterval := checkpointConflictRetryInterval
+	checkpointConflictRetryInterval = 10 * time.Millisecond
+	defer func() { checkpointConflictRetryInterval = oldInterval }()
+
+	mockMilvusClient := cluster.NewMockMilvusClient(t)
+	called := atomic.NewInt32(0)
+	mockMilvusClient.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.GetReplicateInfoRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicateInfoResponse, error) {
+			called.Inc()
+			return &milvuspb.GetReplicateInfoResponse{
+				Checkpoint: &commonpb.ReplicateCheckpoint{
+					ClusterId: "another-cluster",
+					Pchannel:  "test-source-channel",
+					MessageId: newMockPulsarMessageID(),
+				},
+			}, nil
+		})
+	mockMilvusClient.EXPECT().Close(mock.Anything).Return(nil)
+
+	// the source channel is never read if the checkpoint conflicts.
+	streaming.SetWALForTest(mock_streaming.NewMockWALAccesser(t))
+
+	replicator := NewChannelReplicator(&meta.ReplicateChannel{
+		Value: &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: "test-source-channel",
+			TargetChannelName: "test-target-channel",
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: "test-cluster"},
+		},
+	})
+	replicator.(*channelReplicator).createMcFunc = func(ctx context.Context,
+		cluster *commonpb.MilvusCluster,
+	) (cluster.MilvusClient, error) {
+		return mockMilvusClient, nil
+	}
+
+	replicator.StartReplication()
+	assert.Eventually(t, func() bool {
+		return called.Load() > 1
+	}, time.Second, 10*time.Millisecond)
+	replicator.StopReplication()
+}
@@ -0,0 +1,217 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"path"
+
+	"github.com/milvus-io/milvus/internal/cdc/meta"
+	"github.com/milvus-io/milvus/internal/cdc/resource"
+	"github.com/milvus-io/milvus/internal/metastore/kv/streamingcoord"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// filteredCollectionStore is the store of the collections filtered out by the collection filter of a replicating task.
+type filteredCollectionStore interface {
+	// Get gets the filtered collections of the task, return nil if the task never filters any collection.
+	Get(ctx context.Context) (*streamingpb.ReplicateFilteredCollectionsMeta, error)
+
+	// Save saves the filtered collections of the task.
+	Save(ctx context.Context, filtered *streamingpb.ReplicateFilteredCollectionsMeta) error
+}
+
+// newETCDFilteredCollectionStore creates a filtered collection store of the replicating task on etcd,
+// which shares the key with the catalog of streamingcoord, so it's removed when the task is archived.
+func newETCDFilteredCollectionStore(targetClusterID, sourceChannelName string) *etcdFilteredCollectionStore {
+	return &etcdFilteredCollectionStore{
+		key: path.Join(
+			paramtable.Get().EtcdCfg.MetaRootPath.GetValue(),
+			streamingcoord.BuildReplicateFilteredCollectionsKey(targetClusterID, sourceChannelName),
+		),
+	}
+}
+
+type etcdFilteredCollectionStore struct {
+	key string
+}
+
+func (s *etcdFilteredCollectionStore) Get(ctx context.Context) (*streamingpb.ReplicateFilteredCollectionsMeta, error) {
+	return meta.GetReplicateFilteredCollections(ctx, resource.Resource().ETCD(), s.key)
+}
+
+func (s *etcdFilteredCollectionStore) Save(ctx context.Context, filtered *streamingpb.ReplicateFilteredCollectionsMeta) error {
+	return meta.SaveReplicateFilteredCollections(ctx, resource.Resource().ETCD(), s.key, filtered)
+}
+
+// newCollectionFilter creates the collection filter of the replicating task.
+// The filter of the task is validated by streamingcoord, so the invalid filter never happens.
+func newCollectionFilter(task *streamingpb.ReplicatePChannelMeta, store filteredCollectionStore) (*collectionFilter, error) {
+	filter, err := replicateutil.NewCollectionFilterFromProto(task.GetCollectionFilter())
+	if err != nil {
+		return nil, err
+	}
+	return &collectionFilter{
+		task:                task,
+		filter:              filter,
+		store:               store,
+		filteredCollections: make(map[int64][]string),
+		filteredVChannels:   make(map[string]struct{}),
+	}, nil
+}
+
+// collectionFilter filters the messages of the source channel by the collection filter of the replicating task,
+// so only the selected databases and collections are shipped to the target cluster.
+// The collection is decided to be filtered when its create collection message arrives,
+// and all the following messages of the collection are skipped until it's dropped.
+// The filtered collections are persisted, so the decision is kept after the replicator is restarted.
+type collectionFilter struct {
+	task                *streamingpb.ReplicatePChannelMeta
+	filter              *replicateutil.CollectionFilter
+	store               filteredCollectionStore
+	recovered           bool
+	filteredCollections map[int64][]string  // collection id -> vchannels of the collection on the source channel.
+	filteredVChannels   map[string]struct{} // the vchannels of the filtered collections, the control channel is never included.
+}
+
+// Filter returns true if the message should not be shipped to the target cluster.
+func (f *collectionFilter) Filter(ctx context.Context, msg message.ImmutableMessage) (bool, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeTimeTick, message.MessageTypeAlterReplicateConfig:
+		// the time tick keeps the target cluster moving and the configuration should always be replicated.
+		return false, nil
+	}
+	if err := f.recover(ctx); err != nil {
+		return false, err
+	}
+	if f.filter.IsEmpty() && len(f.filteredCollections) == 0 {
+		return false, nil
+	}
+
+	switch msg.MessageType() {
+	case message.MessageTypeCreateDatabase:
+		return !f.filter.AllowDatabase(message.MustAsImmutableCreateDatabaseMessageV2(msg).Header().GetDbName()), nil
+	case message.MessageTypeAlterDatabase:
+		return !f.filter.AllowDatabase(message.MustAsImmutableAlterDatabaseMessageV2(msg).Header().GetDbName()), nil
+	case message.MessageTypeDropDatabase:
+		return !f.filter.AllowDatabase(message.MustAsImmutableDropDatabaseMessageV2(msg).Header().GetDbName()), nil
+	case message.MessageTypeCreateCollection:
+		body, err := message.MustAsImmutableCreateCollectionMessageV1(msg).Body()
+		if err != nil {
+			return false, err
+		}
+		if f.filter.AllowCollection(body.GetDbName(), body.GetCollectionName()) {
+			return false, nil
+		}
+		return true, f.addFilteredCollection(ctx, body.GetCollectionID(), msg.VChannel())
+	case message.MessageTypeTxn:
+		// the txn is always on a single vchannel, so it's filtered by the vchannel.
+		_, filtered := f.filteredVChannels[msg.VChannel()]
+		return filtered, nil
+	}
+
+	collectionID, ok := message.GetCollectionIDOfMessage(msg)
+	if !ok {
+		return false, nil
+	}
+	if _, filtered := f.filteredCollections[collectionID]; !filtered {
+		return false, nil
+	}
+	if msg.MessageType() == message.MessageTypeDropCollection {
+		return true, f.removeFilteredCollection(ctx, collectionID, msg.VChannel())
+	}
+	return true, nil
+}
+
+// recover recovers the filtered collections from the store at the first filtered message.
+func (f *collectionFilter) recover(ctx context.Context) error {
+	if f.recovered {
+		return nil
+	}
+	filtered, err := f.store.Get(ctx)
+	if err != nil {
+		return merr.Wrap(err, "failed to recover the collections filtered by collection filter")
+	}
+	for _, collection := range filtered.GetCollections() {
+		for _, vchannel := range collection.GetVchannels() {
+			f.addVChannel(collection.GetCollectionId(), vchannel)
+		}
+	}
+	f.recovered = true
+	return nil
+}
+
+// addFilteredCollection adds the vchannel of the filtered collection and persists it.
+func (f *collectionFilter) addFilteredCollection(ctx context.Context, collectionID int64, vchannel string) error {
+	f.addVChannel(collectionID, vchannel)
+	return f.save(ctx)
+}
+
+// removeFilteredCollection removes the vchannel of the dropped collection,
+// the collection is removed when all its vchannels on the source channel are dropped.
+func (f *collectionFilter) removeFilteredCollection(ctx context.Context, collectionID int64, vchannel string) error {
+	vchannels := f.filteredCollections[collectionID]
+	remains := make([]string, 0, len(vchannels))
+	for _, v := range vchannels {
+		if v != vchannel {
+			remains = append(remains, v)
+		}
+	}
+	if len(remains) == 0 {
+		delete(f.filteredCollections, collectionID)
+	} else {
+		f.filteredCollections[collectionID] = remains
+	}
+	delete(f.filteredVChannels, vchannel)
+	return f.save(ctx)
+}
+
+func (f *collectionFilter) addVChannel(collectionID int64, vchannel string) {
+	for _, v := range f.filteredCollections[collectionID] {
+		if v == vchannel {
+			return
+		}
+	}
+	f.filteredCollections[collectionID] = append(f.filteredCollections[collectionID], vchannel)
+	if !funcutil.IsControlChannel(vchannel) {
+		f.filteredVChannels[vchannel] = struct{}{}
+	}
+}
+
+// save persists the filtered collections into the store.
+func (f *collectionFilter) save(ctx context.Context) error {
+	filtered := &streamingpb.ReplicateFilteredCollectionsMeta{
+		SourceChannelName: f.task.GetSourceChannelName(),
+		TargetClusterId:   f.task.GetTargetCluster().GetClusterId(),
+		Collections:       make([]*streamingpb.ReplicateFilteredCollection, 0, len(f.filteredCollections)),
+	}
+	for collectionID, vchannels := range f.filteredCollections {
+		filtered.Collections = append(filtered.Collections, &streamingpb.ReplicateFilteredCollection{
+			CollectionId: collectionID,
+			Vchannels:    vchannels,
+		})
+	}
+	if err := f.store.Save(ctx, filtered); err != nil {
+		return merr.Wrap(err, "failed to save the collections filtered by collection filter")
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+type memFilteredCollectionStore struct {
+	filtered *streamingpb.ReplicateFilteredCollectionsMeta
+	getErr   error
+}
+
+func (s *memFilteredCollectionStore) Get(ctx context.Context) (*streamingpb.ReplicateFilteredCollectionsMeta, error) {
+	if s.getErr != nil {
+		err := s.getErr
+		s.getErr = nil
+		return nil, err
+	}
+	return s.filtered, nil
+}
+
+func (s *memFilteredCollectionStore) Save(ctx context.Context, filtered *streamingpb.ReplicateFilteredCollectionsMeta) error {
+	s.filtered = filtered
+	return nil
+}
+
+func (s *memFilteredCollectionStore) collections() map[int64][]string {
+	collections := make(map[int64][]string)
+	for _, c := range s.filtered.GetCollections() {
+		vchannels := append([]string{}, c.GetVchannels()...)
+		sort.Strings(vchannels)
+		collections[c.GetCollectionId()] = vchannels
+	}
+	return collections
+}
+
+func TestCollectionFilter(t *testing.T) {
+	task := &streamingpb.ReplicatePChannelMeta{
+		SourceChannelName: "p1",
+		TargetChannelName: "p2",
+		TargetCluster:     &commonpb.MilvusCluster{ClusterId: "by-dev2"},
+		CollectionFilter:  &messagespb.ReplicateCollectionFilter{Includes: []string{"db1", "default.c1"}, Excludes: []string{"db1.tmp"}},
+	}
+	store := &memFilteredCollectionStore{
+		filtered: &streamingpb.ReplicateFilteredCollectionsMeta{
+			Collections: []*streamingpb.ReplicateFilteredCollection{{CollectionId: 4, Vchannels: []string{"v4"}}},
+		},
+		getErr: errors.New("test"),
+	}
+	f, err := newCollectionFilter(task, store)
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assertFiltered := func(msg message.ImmutableMessage, expected bool) {
+		filtered, err := f.Filter(ctx, msg)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, filtered)
+	}
+
+	// the recovery failure of the filtered collections is returned.
+	_, err = f.Filter(ctx, newCreateDatabaseMessage("db1"))
+	assert.Error(t, err)
+
+	assertFiltered(newCreateDatabaseMessage("db1"), false)
+	assertFiltered(newCreateDatabaseMessage("db2"), true)
+	// the recovered filtered collection is still filtered.
+	assertFiltered(newInsertMessage("v4", 4), true)
+	assertFiltered(newCreateCollectionMessage("v1", "db1", "c1", 1), false)
+	assertFiltered(newCreateCollectionMessage("v2", "db1", "tmp", 2), true)
+	assertFiltered(newCreateCollectionMessage("p1_vcchan", "db1", "tmp", 2), true)
+	assertFiltered(newCreateCollectionMessage("v3", "default", "c2", 3), true)
+	assertFiltered(newInsertMessage("v1", 1), false)
+	assertFiltered(newInsertMessage("v2", 2), true)
+	assertFiltered(newInsertMessage("v3", 3), true)
+	assertFiltered(newTxnMessage("v1"), false)
+	assertFiltered(newTxnMessage("v2"), true)
+	assert.Equal(t, map[int64][]string{
+		2: {"p1_vcchan", "v2"},
+		3: {"v3"},
+		4: {"v4"},
+	}, store.collections())
+
+	// the collection is removed after all its vchannels are dropped.
+	assertFiltered(newDropCollectionMessage("v2", 2), true)
+	assert.Equal(t, map[int64][]string{2: {"p1_vcchan"}, 3: {"v3"}, 4: {"v4"}}, store.collections())
+	assertFiltered(newTxnMessage("v2"), false)
+	assertFiltered(newDropCollectionMessage("p1_vcchan", 2), true)
+	assert.Equal(t, map[int64][]string{3: {"v3"}, 4: {"v4"}}, store.collections())
+
+	// the time tick and the replicate configuration are never filtered.
+	assertFiltered(newTimeTickMessage("v3"), false)
+
+	// the filtered collections are recovered after restart even if the filter is removed,
+	// so the messages of the collections never created on the target cluster are still filtered.
+	task.CollectionFilter = nil
+	f, err = newCollectionFilter(task, store)
+	assert.NoError(t, err)
+	assertFiltered(newCreateDatabaseMessage("db2"), false)
+	assertFiltered(newCreateCollectionMessage("v5", "db2", "c5", 5), false)
+	assertFiltered(newInsertMessage("v3", 3), true)
+	assertFiltered(newDropCollectionMessage("v3", 3), true)
+	assertFiltered(newDropCollectionMessage("v4", 4), true)
+	assert.Empty(t, store.collections())
+	assertFiltered(newInsertMessage("v3", 3), false)
+}
+
+func newImmutableMessage(msg message.MutableMessage) message.ImmutableMessage {
+	return msg.WithTimeTick(1).
+		WithLastConfirmed(walimplstest.NewTestMessageID(1)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+}
+
+func newCreateDatabaseMessage(dbName string) message.ImmutableMessage {
+	return newImmutableMessage(message.NewCreateDatabaseMessageBuilderV2().
+		WithHeader(&message.CreateDatabaseMessageHeader{DbName: dbName}).
+		WithBody(&message.CreateDatabaseMessageBody{}).
+		WithVChannel("p1_vcchan").
+		MustBuildMutable())
+}
+
+func newCreateCollectionMessage(vchannel string, dbName string, collectionName string, collectionID int64) message.ImmutableMessage {
+	return newImmutableMessage(message.NewCreateCollectionMessageBuilderV1().
+		WithHeader(&message.CreateCollectionMessageHeader{CollectionId: collectionID}).
+		WithBody(&msgpb.CreateCollectionRequest{DbName: dbName, CollectionName: collectionName, CollectionID: collectionID}).
+		WithVChannel(vchannel).
+		MustBuildMutable())
+}
+
+func newDropCollectionMessage(vchannel string, collectionID int64) message.ImmutableMessage {
+	return newImmutableMessage(message.NewDropCollectionMessageBuilderV1().
+		WithHeader(&message.DropCollectionMessageHeader{CollectionId: collectionID}).
+		WithBody(&msgpb.DropCollectionRequest{}).
+		WithVChannel(vchannel).
+		MustBuildMutable())
+}
+
+func newInsertMessage(vchannel string, collectionID int64) message.ImmutableMessage {
+	return newImmutableMessage(message.NewInsertMessageBuilderV1().
+		WithHeader(&message.InsertMessageHeader{CollectionId: collectionID}).
+		WithBody(&msgpb.InsertRequest{}).
+		WithVChannel(vchannel).
+		MustBuildMutable())
+}
+
+func newTimeTickMessage(vchannel string) message.ImmutableMessage {
+	return newImmutableMessage(message.NewTimeTickMessageBuilderV1().
+		WithHeader(&message.TimeTickMessageHeader{}).
+		WithBody(&msgpb.TimeTickMsg{}).
+		WithVChannel(vchannel).
+		MustBuildMutable())
+}
+
+func newTxnMessage(vchannel string) message.ImmutableMessage {
+	txnCtx := message.TxnContext{TxnID: 1, Keepalive: message.TxnKeepaliveInfinite}
+	begin := message.NewBeginTxnMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&message.BeginTxnMessageHeader{}).
+		WithBody(&message.BeginTxnMessageBody{}).
+		MustBuildMutable().
+		WithTimeTick(1).
+		WithTxnContext(txnCtx).
+		WithLastConfirmed(walimplstest.NewTestMessageID(1)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+	commit := message.NewCommitTxnMessageBuilderV2().
+		WithVChannel(vchannel).
+		WithHeader(&message.CommitTxnMessageHeader{}).
+		WithBody(&message.CommitTxnMessageBody{}).
+		MustBuildMutable().
+		WithTimeTick(2).
+		WithTxnContext(txnCtx).
+		WithLastConfirmed(walimplstest.NewTestMessageID(2)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(2))
+	txn, err := message.NewImmutableTxnMessageBuilder(message.MustAsImmutableBeginTxnMessageV2(begin)).
+		Build(message.MustAsImmutableCommitTxnMessageV2(commit))
+	if err != nil {
+		panic(err)
+	}
+	return txn
+}
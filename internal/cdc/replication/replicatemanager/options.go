@@ -0,0 +1,43 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatemanager
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/milvusclient"
+)
+
+var (
+	_ milvusclient.ListDatabaseOption   = (*listDatabaseOption)(nil)
+	_ milvusclient.ListCollectionOption = (*listCollectionOption)(nil)
+)
+
+// listDatabaseOption builds the request to list the databases of the target cluster.
+type listDatabaseOption struct{}
+
+func (opt *listDatabaseOption) Request() *milvuspb.ListDatabasesRequest {
+	return &milvuspb.ListDatabasesRequest{}
+}
+
+// listCollectionOption builds the request to list the collections of a database of the target cluster.
+type listCollectionOption struct {
+	dbName string
+}
+
+func (opt *listCollectionOption) Request() *milvuspb.ShowCollectionsRequest {
+	return &milvuspb.ShowCollectionsRequest{DbName: opt.dbName}
+}
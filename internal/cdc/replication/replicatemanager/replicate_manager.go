@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/cdc/meta"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
@@ -64,7 +65,24 @@ func (r *replicateManager) CreateReplicator(channel *meta.ReplicateChannel) {
 		logger.Debug(r.ctx, "replicator already exists, skip create replicator")
 		return
 	}
-	replicator := NewChannelReplicator(channel)
+	// the replicating task is updated, e.g. paused or resumed, stop the replicator of the old revision.
+	for oldRepKey, oldChannel := range r.replicatorChannels {
+		if oldChannel.Key == channel.Key && oldRepKey != repKey {
+			r.removeReplicatorInternal(oldChannel.Key, oldChannel.ModRevision)
+		}
+	}
+	if channel.Value.GetState() == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED {
+		logger.Info(r.ctx, "replicating task is paused, skip create replicator")
+		return
+	}
+	var replicator Replicator
+	if channel.Value.GetState() == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING {
+		// the existing data is copied before the live messages are replicated,
+		// the task is updated to running after the bootstrap, then the channel replicator is created.
+		replicator = NewBootstrapReplicator(channel)
+	} else {
+		replicator = NewChannelReplicator(channel)
+	}
 	replicator.StartReplication()
 	r.replicators[repKey] = replicator
 	r.replicatorChannels[repKey] = channel
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus/internal/cdc/cluster"
@@ -91,3 +92,63 @@ func TestReplicateManager_CreateReplicator(t *testing.T) {
 	assert.True(t, exists)
 	assert.NotNil(t, replicator1)
 }
+
+func TestReplicateManager_PauseAndResume(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().CommonCfg.ClusterPrefix.Key, "test-source")
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.ClusterPrefix.Key)
+
+	manager := NewReplicateManager()
+	defer manager.Close()
+
+	key := "test-replicate-key-1"
+	replicateInfo := &streamingpb.ReplicatePChannelMeta{
+		SourceChannelName: "test-source-channel-1",
+		TargetChannelName: "test-target-channel-1",
+		TargetCluster: &commonpb.MilvusCluster{
+			ClusterId: "test-cluster-1",
+		},
+	}
+	manager.CreateReplicator(&meta.ReplicateChannel{Key: key, Value: replicateInfo, ModRevision: 1})
+	assert.Contains(t, manager.replicators, buildReplicatorKey(key, 1))
+
+	// The replicator is stopped after the task is paused.
+	paused := proto.Clone(replicateInfo).(*streamingpb.ReplicatePChannelMeta)
+	paused.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED
+	manager.CreateReplicator(&meta.ReplicateChannel{Key: key, Value: paused, ModRevision: 2})
+	assert.Empty(t, manager.replicators)
+	assert.Empty(t, manager.replicatorChannels)
+
+	// The replicator is recreated after the task is resumed.
+	manager.CreateReplicator(&meta.ReplicateChannel{Key: key, Value: replicateInfo, ModRevision: 3})
+	assert.Len(t, manager.replicators, 1)
+	assert.Contains(t, manager.replicators, buildReplicatorKey(key, 3))
+}
+
+func TestReplicateManager_Bootstrap(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().CommonCfg.ClusterPrefix.Key, "test-source")
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.ClusterPrefix.Key)
+
+	manager := NewReplicateManager()
+	defer manager.Close()
+
+	key := "test-replicate-key-1"
+	replicateInfo := &streamingpb.ReplicatePChannelMeta{
+		SourceChannelName: "test-source-channel-1",
+		TargetChannelName: "test-target-channel-1",
+		TargetCluster: &commonpb.MilvusCluster{
+			ClusterId: "test-cluster-1",
+		},
+		State: streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING,
+	}
+	// The bootstrapping task copies the existing data first.
+	manager.CreateReplicator(&meta.ReplicateChannel{Key: key, Value: replicateInfo, ModRevision: 1})
+	assert.Len(t, manager.replicators, 1)
+	assert.IsType(t, &bootstrapReplicator{}, manager.replicators[buildReplicatorKey(key, 1)])
+
+	// The channel replicator is created after the bootstrap is finished.
+	running := proto.Clone(replicateInfo).(*streamingpb.ReplicatePChannelMeta)
+	running.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING
+	manager.CreateReplicator(&meta.ReplicateChannel{Key: key, Value: running, ModRevision: 2})
+	assert.Len(t, manager.replicators, 1)
+	assert.IsType(t, &channelReplicator{}, manager.replicators[buildReplicatorKey(key, 2)])
+}
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+const compressionNone = "none"
+
+// getReplicationCompressor returns the grpc compressor of the replication stream to the target cluster,
+// empty string means no compression.
+// The compression of the target cluster configured at `streaming.replication.compression.clusters.<clusterID>`
+// overrides the default compression.
+func getReplicationCompressor(targetClusterID string) string {
+	cfg := &paramtable.Get().StreamingCfg
+	compression := cfg.ReplicationCompressionType.GetValue()
+	// the keys of the configuration are case-insensitive.
+	if v, ok := cfg.ReplicationCompressionClusters.GetValue()[strings.ToLower(targetClusterID)]; ok {
+		compression = v
+	}
+	switch compression = strings.ToLower(strings.TrimSpace(compression)); compression {
+	case grpcclient.Zstd, grpcclient.Lz4:
+		return compression
+	case grpcclient.None, compressionNone:
+		return grpcclient.None
+	default:
+		mlog.Warn(context.TODO(), "unknown compression of replication stream, the compression is disabled",
+			mlog.String("targetCluster", targetClusterID),
+			mlog.String("compression", compression))
+		return grpcclient.None
+	}
+}
+
+// isCompressorUnsupported checks if the replication stream is broken because the compressor is not installed on the target cluster.
+func isCompressorUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Unimplemented && strings.Contains(s.Message(), "grpc-encoding")
+}
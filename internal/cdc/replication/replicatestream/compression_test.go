@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestGetReplicationCompressor(t *testing.T) {
+	paramtable.Init()
+	cfg := &paramtable.Get().StreamingCfg
+
+	assert.Equal(t, grpcclient.None, getReplicationCompressor("compress-by-dev2"))
+
+	paramtable.Get().Save(cfg.ReplicationCompressionType.Key, "ZSTD")
+	defer paramtable.Get().Reset(cfg.ReplicationCompressionType.Key)
+	paramtable.Get().SaveGroup(map[string]string{
+		cfg.ReplicationCompressionClusters.KeyPrefix + "compress-by-dev2": "lz4",
+		cfg.ReplicationCompressionClusters.KeyPrefix + "compress-by-dev3": "none",
+		cfg.ReplicationCompressionClusters.KeyPrefix + "compress-by-dev4": "unknown",
+	})
+
+	assert.Equal(t, grpcclient.Zstd, getReplicationCompressor("compress-by-dev1"))
+	assert.Equal(t, grpcclient.Lz4, getReplicationCompressor("compress-by-dev2"))
+	assert.Equal(t, grpcclient.None, getReplicationCompressor("compress-by-dev3"))
+	assert.Equal(t, grpcclient.None, getReplicationCompressor("compress-by-dev4"))
+}
+
+func TestIsCompressorUnsupported(t *testing.T) {
+	assert.False(t, isCompressorUnsupported(nil))
+	assert.False(t, isCompressorUnsupported(errors.New("test")))
+	assert.False(t, isCompressorUnsupported(status.Error(codes.Unknown, "stream timeout")))
+	assert.True(t, isCompressorUnsupported(status.Errorf(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding %q", grpcclient.Lz4)))
+}
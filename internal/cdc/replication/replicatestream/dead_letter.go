@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/cdc/meta"
+	"github.com/milvus-io/milvus/internal/cdc/resource"
+	"github.com/milvus-io/milvus/internal/metastore/kv/streamingcoord"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// deadLetterCheckInterval is the interval of checking if the dead letter of a parked task is requeued.
+var deadLetterCheckInterval = 5 * time.Second
+
+// deadLetterStore is the store of the dead letter of a replicating task.
+type deadLetterStore interface {
+	// Save saves the dead letter of the task.
+	Save(ctx context.Context, deadLetter *streamingpb.ReplicateDeadLetterMeta) error
+
+	// Exists checks if the dead letter of the task exists, it's removed when the dead letter is requeued.
+	Exists(ctx context.Context) (bool, error)
+}
+
+// newETCDDeadLetterStore creates a dead letter store of the replicating task on etcd,
+// which shares the key with the catalog of streamingcoord.
+func newETCDDeadLetterStore(targetClusterID, sourceChannelName string) *etcdDeadLetterStore {
+	return &etcdDeadLetterStore{
+		key: path.Join(
+			paramtable.Get().EtcdCfg.MetaRootPath.GetValue(),
+			streamingcoord.BuildReplicateDeadLetterKey(targetClusterID, sourceChannelName),
+		),
+	}
+}
+
+type etcdDeadLetterStore struct {
+	key string
+}
+
+func (s *etcdDeadLetterStore) Save(ctx context.Context, deadLetter *streamingpb.ReplicateDeadLetterMeta) error {
+	return meta.SaveReplicateDeadLetter(ctx, resource.Resource().ETCD(), s.key, deadLetter)
+}
+
+func (s *etcdDeadLetterStore) Exists(ctx context.Context) (bool, error) {
+	return meta.ReplicateDeadLetterExists(ctx, resource.Resource().ETCD(), s.key)
+}
+
+// isReplicateMessageRejected checks if the replication stream is closed because the target cluster rejects the replicated message.
+func isReplicateMessageRejected(err error) bool {
+	if err == nil {
+		return false
+	}
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.FailedPrecondition
+}
+
+// deadLetterEnabled returns true if the replicating task is parked after too many rejections.
+func deadLetterEnabled() bool {
+	return paramtable.Get().StreamingCfg.ReplicationRetryMaxRetries.GetAsInt() > 0
+}
+
+// handleRejection handles the message rejected by the target cluster.
+// The task is parked with a dead letter if the message is rejected for too many times,
+// and it's blocked until the dead letter is requeued.
+func (r *replicateStreamClient) handleRejection(err error) (needRestart bool) {
+	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("revision", r.channel.ModRevision))
+	r.rejections++
+	maxRetries := paramtable.Get().StreamingCfg.ReplicationRetryMaxRetries.GetAsInt()
+	if maxRetries <= 0 || r.rejections <= maxRetries {
+		logger.Warn(r.ctx, "replicate message rejected by target cluster, retry...",
+			mlog.Int("rejections", r.rejections), mlog.Err(err))
+		return true
+	}
+
+	deadLetter := &streamingpb.ReplicateDeadLetterMeta{
+		SourceChannelName:       r.channel.Value.GetSourceChannelName(),
+		TargetChannelName:       r.channel.Value.GetTargetChannelName(),
+		TargetClusterId:         r.channel.Value.GetTargetCluster().GetClusterId(),
+		Error:                   err.Error(),
+		Retries:                 int64(r.rejections - 1),
+		CreatedTimestampSeconds: time.Now().Unix(),
+	}
+	if head := r.pendingMessages.Head(); head != nil {
+		deadLetter.MessageId = head.MessageID().IntoProto()
+		deadLetter.TimeTick = head.TimeTick()
+		deadLetter.MessageType = head.MessageType().String()
+	}
+	if err := r.deadLetters.Save(r.ctx, deadLetter); err != nil {
+		logger.Warn(r.ctx, "failed to save the dead letter of replicating task, retry...", mlog.Err(err))
+		return true
+	}
+	logger.Warn(r.ctx, "replicating task is parked with a dead letter, waiting for requeue",
+		mlog.Int64("retries", deadLetter.GetRetries()),
+		mlog.Uint64("timeTick", deadLetter.GetTimeTick()),
+		mlog.String("messageType", deadLetter.GetMessageType()),
+		mlog.String("error", deadLetter.GetError()))
+	r.waitUntilRequeued()
+	return r.ctx.Err() == nil
+}
+
+// waitUntilRequeued blocks until the dead letter of the task is requeued or the client is closed.
+func (r *replicateStreamClient) waitUntilRequeued() {
+	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("revision", r.channel.ModRevision))
+	ticker := time.NewTicker(deadLetterCheckInterval)
+	defer ticker.Stop()
+	for {
+		exists, err := r.deadLetters.Exists(r.ctx)
+		if err != nil {
+			logger.Warn(r.ctx, "failed to check the dead letter of replicating task", mlog.Err(err))
+		} else if !exists {
+			if r.rejections > 0 {
+				logger.Info(r.ctx, "dead letter of replicating task is requeued, resume replication")
+			}
+			r.rejections = 0
+			return
+		}
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
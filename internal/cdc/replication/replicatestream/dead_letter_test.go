@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/cdc/meta"
+	mock_message "github.com/milvus-io/milvus/pkg/v3/mocks/streaming/util/mock_message"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestIsReplicateMessageRejected(t *testing.T) {
+	assert.False(t, isReplicateMessageRejected(nil))
+	assert.False(t, isReplicateMessageRejected(errors.New("test")))
+	assert.False(t, isReplicateMessageRejected(status.Error(codes.Unavailable, "unavailable")))
+	assert.True(t, isReplicateMessageRejected(status.Error(codes.FailedPrecondition, "collection not found")))
+}
+
+func TestHandleRejection(t *testing.T) {
+	paramtable.Init()
+	oldInterval := deadLetterCheckInterval
+	deadLetterCheckInterval = 10 * time.Millisecond
+	defer func() { deadLetterCheckInterval = oldInterval }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := &testDeadLetterStore{}
+	r := &replicateStreamClient{
+		channel: &meta.ReplicateChannel{
+			Key: "test-key",
+			Value: &streamingpb.ReplicatePChannelMeta{
+				SourceChannelName: "test-source-channel",
+				TargetChannelName: "test-target-channel",
+				TargetCluster:     &commonpb.MilvusCluster{ClusterId: "test-cluster"},
+			},
+		},
+		pendingMessages: NewMsgQueue(MsgQueueOptions{Capacity: 10, MaxSize: 1024}),
+		deadLetters:     store,
+		ctx:             ctx,
+	}
+	msg := mock_message.NewMockImmutableMessage(t)
+	msg.EXPECT().TimeTick().Return(uint64(100))
+	msg.EXPECT().EstimateSize().Return(10)
+	msg.EXPECT().MessageType().Return(message.MessageTypeInsert)
+	msg.EXPECT().MessageID().Return(walimplstest.NewTestMessageID(1))
+	assert.NoError(t, r.pendingMessages.Enqueue(ctx, msg))
+	rejectErr := status.Error(codes.FailedPrecondition, "collection not found")
+
+	// retry forever by default.
+	for i := 0; i < 5; i++ {
+		assert.True(t, r.handleRejection(rejectErr))
+	}
+	assert.Nil(t, store.Get())
+
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.ReplicationRetryMaxRetries.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.ReplicationRetryMaxRetries.Key)
+	r.rejections = 0
+	assert.True(t, r.handleRejection(rejectErr))
+	assert.True(t, r.handleRejection(rejectErr))
+	assert.Nil(t, store.Get())
+
+	// the task is parked until the dead letter is requeued.
+	done := make(chan bool)
+	go func() {
+		done <- r.handleRejection(rejectErr)
+	}()
+	assert.Eventually(t, func() bool {
+		return store.Get() != nil
+	}, time.Second, 10*time.Millisecond)
+	deadLetter := store.Get()
+	assert.Equal(t, "test-cluster", deadLetter.GetTargetClusterId())
+	assert.Equal(t, "test-source-channel", deadLetter.GetSourceChannelName())
+	assert.Equal(t, uint64(100), deadLetter.GetTimeTick())
+	assert.Equal(t, message.MessageTypeInsert.String(), deadLetter.GetMessageType())
+	assert.Equal(t, int64(2), deadLetter.GetRetries())
+	assert.Contains(t, deadLetter.GetError(), "collection not found")
+	select {
+	case <-done:
+		t.Fatal("the task should be parked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	store.Remove()
+	assert.True(t, <-done)
+	assert.Zero(t, r.rejections)
+
+	// the parked task is released when the client is closed.
+	r.rejections = 2
+	go func() {
+		done <- r.handleRejection(rejectErr)
+	}()
+	assert.Eventually(t, func() bool {
+		return store.Get() != nil
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	assert.False(t, <-done)
+}
+
+// testDeadLetterStore is an in-memory dead letter store for test.
+type testDeadLetterStore struct {
+	mu         sync.Mutex
+	deadLetter *streamingpb.ReplicateDeadLetterMeta
+}
+
+func (s *testDeadLetterStore) Save(ctx context.Context, deadLetter *streamingpb.ReplicateDeadLetterMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLetter = deadLetter
+	return nil
+}
+
+func (s *testDeadLetterStore) Exists(ctx context.Context) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deadLetter != nil, nil
+}
+
+func (s *testDeadLetterStore) Get() *streamingpb.ReplicateDeadLetterMeta {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deadLetter
+}
+
+func (s *testDeadLetterStore) Remove() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLetter = nil
+}
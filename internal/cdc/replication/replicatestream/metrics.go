@@ -17,6 +17,8 @@
 package replicatestream
 
 import (
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
@@ -29,6 +31,8 @@ import (
 
 type ReplicateMetrics interface {
 	UpdateLastReplicatedTimeTick(ts uint64)
+	UpdateLatestTimeTick(ts uint64)
+	UpdatePendingMessages(count int)
 	StartReplicate(msg message.ImmutableMessage)
 	OnSent(msg message.ImmutableMessage)
 	OnConfirmed(msg message.ImmutableMessage)
@@ -45,12 +49,18 @@ type msgMetrics struct {
 type replicateMetrics struct {
 	replicateInfo *streamingpb.ReplicatePChannelMeta
 	msgsMetrics   *typeutil.ConcurrentMap[string, msgMetrics] // message id -> msgMetrics
+
+	mu                     sync.Mutex
+	latestTimeTick         uint64 // the time tick of the latest message read from the source channel.
+	lastReplicatedTimeTick uint64 // the time tick of the last message confirmed by the target cluster.
+	pendingMessages        int
 }
 
 func NewReplicateMetrics(replicateInfo *streamingpb.ReplicatePChannelMeta) ReplicateMetrics {
 	return &replicateMetrics{
-		replicateInfo: replicateInfo,
-		msgsMetrics:   typeutil.NewConcurrentMap[string, msgMetrics](),
+		replicateInfo:          replicateInfo,
+		msgsMetrics:            typeutil.NewConcurrentMap[string, msgMetrics](),
+		lastReplicatedTimeTick: replicateInfo.GetInitializedCheckpoint().GetTimeTick(),
 	}
 }
 
@@ -59,6 +69,49 @@ func (m *replicateMetrics) UpdateLastReplicatedTimeTick(ts uint64) {
 		m.replicateInfo.GetSourceChannelName(),
 		m.replicateInfo.GetTargetChannelName(),
 	).Set(tsoutil.PhysicalTimeSeconds(ts))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ts > m.lastReplicatedTimeTick {
+		m.lastReplicatedTimeTick = ts
+	}
+	m.updateLag()
+}
+
+// UpdateLatestTimeTick updates the time tick of the latest message read from the source channel.
+func (m *replicateMetrics) UpdateLatestTimeTick(ts uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lastReplicatedTimeTick == 0 {
+		// nothing is replicated before, the lag is measured from the first message.
+		m.lastReplicatedTimeTick = ts
+	}
+	if ts > m.latestTimeTick {
+		m.latestTimeTick = ts
+	}
+	m.updateLag()
+}
+
+// UpdatePendingMessages updates the count of messages that are not confirmed by the target cluster.
+func (m *replicateMetrics) UpdatePendingMessages(count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pendingMessages = count
+	m.updateLag()
+}
+
+// updateLag updates the lag gauges of the replicating task and the target cluster, must be called with the lock held.
+func (m *replicateMetrics) updateLag() {
+	lag := float64(0)
+	if m.latestTimeTick > m.lastReplicatedTimeTick {
+		lag = tsoutil.PhysicalTimeSeconds(m.latestTimeTick) - tsoutil.PhysicalTimeSeconds(m.lastReplicatedTimeTick)
+	}
+	targetClusterID := m.replicateInfo.GetTargetCluster().GetClusterId()
+	sourceChannel := m.replicateInfo.GetSourceChannelName()
+	targetChannel := m.replicateInfo.GetTargetChannelName()
+	metrics.CDCReplicateLagSeconds.WithLabelValues(targetClusterID, sourceChannel, targetChannel).Set(lag)
+	metrics.CDCReplicatePendingMessages.WithLabelValues(targetClusterID, sourceChannel, targetChannel).Set(float64(m.pendingMessages))
+	clusterLags.update(targetClusterID, sourceChannel+"/"+targetChannel, taskLag{lagSeconds: lag, pendingMessages: m.pendingMessages})
 }
 
 func (m *replicateMetrics) StartReplicate(msg message.ImmutableMessage) {
@@ -135,7 +188,76 @@ func (m *replicateMetrics) OnConnect() {
 }
 
 func (m *replicateMetrics) OnClose() {
+	targetClusterID := m.replicateInfo.GetTargetCluster().GetClusterId()
+	sourceChannel := m.replicateInfo.GetSourceChannelName()
+	targetChannel := m.replicateInfo.GetTargetChannelName()
 	metrics.CDCStreamRPCConnections.DeletePartialMatch(prometheus.Labels{
-		metrics.CDCLabelTargetCluster: m.replicateInfo.GetTargetCluster().GetClusterId(),
+		metrics.CDCLabelTargetCluster: targetClusterID,
 	})
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	metrics.CDCReplicateLagSeconds.DeleteLabelValues(targetClusterID, sourceChannel, targetChannel)
+	metrics.CDCReplicatePendingMessages.DeleteLabelValues(targetClusterID, sourceChannel, targetChannel)
+	clusterLags.remove(targetClusterID, sourceChannel+"/"+targetChannel)
+}
+
+// clusterLags aggregates the lag of all replicating tasks of the cdc node by the target cluster.
+var clusterLags = &clusterLagAggregator{
+	lags: make(map[string]map[string]taskLag),
+}
+
+// taskLag is the lag of a replicating task.
+type taskLag struct {
+	lagSeconds      float64
+	pendingMessages int
+}
+
+// clusterLagAggregator aggregates the lag of replicating tasks into the max lag and the total pending messages of the target cluster.
+type clusterLagAggregator struct {
+	mu   sync.Mutex
+	lags map[string]map[string]taskLag // target cluster id -> task key -> lag
+}
+
+// update updates the lag of the task and the aggregated lag of the target cluster.
+func (a *clusterLagAggregator) update(clusterID string, taskKey string, lag taskLag) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, ok := a.lags[clusterID]; !ok {
+		a.lags[clusterID] = make(map[string]taskLag)
+	}
+	a.lags[clusterID][taskKey] = lag
+	a.updateCluster(clusterID)
+}
+
+// remove removes the lag of the task, the aggregated lag of the target cluster is removed if there's no task left.
+func (a *clusterLagAggregator) remove(clusterID string, taskKey string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	tasks, ok := a.lags[clusterID]
+	if !ok {
+		return
+	}
+	delete(tasks, taskKey)
+	if len(tasks) == 0 {
+		delete(a.lags, clusterID)
+		metrics.CDCClusterMaxLagSeconds.DeleteLabelValues(clusterID)
+		metrics.CDCClusterPendingMessages.DeleteLabelValues(clusterID)
+		return
+	}
+	a.updateCluster(clusterID)
+}
+
+// updateCluster updates the aggregated lag gauges of the target cluster, must be called with the lock held.
+func (a *clusterLagAggregator) updateCluster(clusterID string) {
+	maxLag := float64(0)
+	pending := 0
+	for _, lag := range a.lags[clusterID] {
+		if lag.lagSeconds > maxLag {
+			maxLag = lag.lagSeconds
+		}
+		pending += lag.pendingMessages
+	}
+	metrics.CDCClusterMaxLagSeconds.WithLabelValues(clusterID).Set(maxLag)
+	metrics.CDCClusterPendingMessages.WithLabelValues(clusterID).Set(float64(pending))
 }
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+func TestReplicateMetrics_Lag(t *testing.T) {
+	cluster := &commonpb.MilvusCluster{ClusterId: "lag-cluster"}
+	now := time.Now()
+	ts := func(d time.Duration) uint64 {
+		return tsoutil.ComposeTSByTime(now.Add(d), 0)
+	}
+
+	m1 := NewReplicateMetrics(&streamingpb.ReplicatePChannelMeta{
+		SourceChannelName: "source-1",
+		TargetChannelName: "target-1",
+		TargetCluster:     cluster,
+		InitializedCheckpoint: &commonpb.ReplicateCheckpoint{
+			TimeTick: ts(0),
+		},
+	})
+	m2 := NewReplicateMetrics(&streamingpb.ReplicatePChannelMeta{
+		SourceChannelName: "source-2",
+		TargetChannelName: "target-2",
+		TargetCluster:     cluster,
+	})
+	lag := func(source, target string) float64 {
+		return testutil.ToFloat64(metrics.CDCReplicateLagSeconds.WithLabelValues(cluster.ClusterId, source, target))
+	}
+
+	// the lag is measured from the initialized checkpoint.
+	m1.UpdateLatestTimeTick(ts(10 * time.Second))
+	m1.UpdatePendingMessages(3)
+	assert.InDelta(t, 10, lag("source-1", "target-1"), 0.01)
+	assert.Equal(t, float64(3), testutil.ToFloat64(metrics.CDCReplicatePendingMessages.WithLabelValues(cluster.ClusterId, "source-1", "target-1")))
+
+	// the lag is measured from the first message if there's no initialized checkpoint.
+	m2.UpdateLatestTimeTick(ts(5 * time.Second))
+	assert.Equal(t, float64(0), lag("source-2", "target-2"))
+	m2.UpdateLatestTimeTick(ts(25 * time.Second))
+	m2.UpdatePendingMessages(2)
+	assert.InDelta(t, 20, lag("source-2", "target-2"), 0.01)
+
+	// the cluster lag is the max lag and the total pending messages of all tasks.
+	assert.InDelta(t, 20, testutil.ToFloat64(metrics.CDCClusterMaxLagSeconds.WithLabelValues(cluster.ClusterId)), 0.01)
+	assert.Equal(t, float64(5), testutil.ToFloat64(metrics.CDCClusterPendingMessages.WithLabelValues(cluster.ClusterId)))
+
+	// the lag is reduced after the messages are confirmed.
+	m2.UpdateLastReplicatedTimeTick(ts(25 * time.Second))
+	m2.UpdatePendingMessages(0)
+	assert.Equal(t, float64(0), lag("source-2", "target-2"))
+	assert.InDelta(t, 10, testutil.ToFloat64(metrics.CDCClusterMaxLagSeconds.WithLabelValues(cluster.ClusterId)), 0.01)
+	assert.Equal(t, float64(3), testutil.ToFloat64(metrics.CDCClusterPendingMessages.WithLabelValues(cluster.ClusterId)))
+
+	// the stale time tick never moves the lag backward.
+	m1.UpdateLatestTimeTick(ts(time.Second))
+	assert.InDelta(t, 10, lag("source-1", "target-1"), 0.01)
+
+	// the metrics of the task are removed after the task is closed.
+	m1.OnClose()
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.CDCClusterMaxLagSeconds.WithLabelValues(cluster.ClusterId)))
+	m2.OnClose()
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.CDCClusterMaxLagSeconds))
+	assert.Equal(t, 0, testutil.CollectAndCount(metrics.CDCReplicateLagSeconds))
+}
@@ -3,6 +3,8 @@
 package replicatestream
 
 import (
+	context "context"
+
 	message "github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,6 +22,52 @@ func (_m *MockReplicateStreamClient) EXPECT() *MockReplicateStreamClient_Expecte
 	return &MockReplicateStreamClient_Expecter{mock: &_m.Mock}
 }
 
+// BlockUntilConfirmed provides a mock function with given fields: ctx
+func (_m *MockReplicateStreamClient) BlockUntilConfirmed(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockUntilConfirmed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReplicateStreamClient_BlockUntilConfirmed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockUntilConfirmed'
+type MockReplicateStreamClient_BlockUntilConfirmed_Call struct {
+	*mock.Call
+}
+
+// BlockUntilConfirmed is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReplicateStreamClient_Expecter) BlockUntilConfirmed(ctx interface{}) *MockReplicateStreamClient_BlockUntilConfirmed_Call {
+	return &MockReplicateStreamClient_BlockUntilConfirmed_Call{Call: _e.mock.On("BlockUntilConfirmed", ctx)}
+}
+
+func (_c *MockReplicateStreamClient_BlockUntilConfirmed_Call) Run(run func(ctx context.Context)) *MockReplicateStreamClient_BlockUntilConfirmed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReplicateStreamClient_BlockUntilConfirmed_Call) Return(_a0 error) *MockReplicateStreamClient_BlockUntilConfirmed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReplicateStreamClient_BlockUntilConfirmed_Call) RunAndReturn(run func(context.Context) error) *MockReplicateStreamClient_BlockUntilConfirmed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockUntilFinish provides a mock function with no fields
 func (_m *MockReplicateStreamClient) BlockUntilFinish() {
 	_m.Called()
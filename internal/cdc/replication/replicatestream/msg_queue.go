@@ -40,6 +40,9 @@ type MsgQueue interface {
 	// SeekToHead moves the read cursor to the first not-yet-deleted message.
 	SeekToHead()
 
+	// Head returns the first not-yet-deleted message, nil if the queue is empty.
+	Head() message.ImmutableMessage
+
 	// CleanupConfirmedMessages permanently removes all messages whose Timetick()
 	// <= lastConfirmedTimeTick. This may free capacity and wake Enqueue waiters.
 	// Returns the messages that were cleaned up.
@@ -162,6 +165,16 @@ func (q *msgQueue) SeekToHead() {
 	q.mu.Unlock()
 }
 
+// Head returns the first existing element.
+func (q *msgQueue) Head() message.ImmutableMessage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.buf) == 0 {
+		return nil
+	}
+	return q.buf[0]
+}
+
 // CleanupConfirmedMessages permanently drops messages with Timetick <= watermark.
 // This frees capacity and may move the read cursor backward proportionally to the
 // number of deleted messages (but clamped at 0).
@@ -104,6 +104,8 @@ func TestMsgQueue_SeekToHead(t *testing.T) {
 	queue := NewMsgQueue(MsgQueueOptions{Capacity: 3, MaxSize: 1000})
 	ctx := context.Background()
 
+	assert.Nil(t, queue.Head())
+
 	// Add messages
 	msg1 := mock_message.NewMockImmutableMessage(t)
 	msg1.EXPECT().TimeTick().Return(uint64(100)).Maybe()
@@ -121,6 +123,8 @@ func TestMsgQueue_SeekToHead(t *testing.T) {
 	dequeuedMsg, err := queue.ReadNext(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, msg1, dequeuedMsg)
+	// The head is not moved by reading
+	assert.Equal(t, msg1, queue.Head())
 
 	// Seek to head
 	queue.SeekToHead()
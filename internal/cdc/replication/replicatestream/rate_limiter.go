@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+const (
+	rateLimitBytesPerSecondKey    = "bytespersecond"
+	rateLimitMessagesPerSecondKey = "messagespersecond"
+
+	// rateLimitRefreshInterval is the interval of refreshing the limits from the configuration.
+	rateLimitRefreshInterval = time.Second
+)
+
+// clusterRateLimiters is the rate limiters of all target clusters in the process,
+// the replication traffic of all channels to the same target cluster shares one limiter.
+var clusterRateLimiters = &rateLimiterRegistry{
+	limiters: make(map[string]*clusterRateLimiter),
+}
+
+type rateLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[string]*clusterRateLimiter
+}
+
+// get returns the rate limiter of the target cluster, create it if not exist.
+func (r *rateLimiterRegistry) get(targetClusterID string) *clusterRateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if limiter, ok := r.limiters[targetClusterID]; ok {
+		return limiter
+	}
+	limiter := newClusterRateLimiter(targetClusterID)
+	r.limiters[targetClusterID] = limiter
+	return limiter
+}
+
+// clusterRateLimiter limits the bytes and messages per second replicated to a target cluster,
+// so the replication traffic cannot saturate the network shared with the user requests.
+// The limits are refreshed from the configuration periodically, zero means unlimited.
+type clusterRateLimiter struct {
+	targetClusterID string
+	bytes           *rate.Limiter
+	messages        *rate.Limiter
+	throttled       prometheus.Counter
+
+	mu          sync.Mutex
+	lastRefresh time.Time
+}
+
+func newClusterRateLimiter(targetClusterID string) *clusterRateLimiter {
+	return &clusterRateLimiter{
+		targetClusterID: targetClusterID,
+		bytes:           rate.NewLimiter(rate.Inf, 0),
+		messages:        rate.NewLimiter(rate.Inf, 0),
+		throttled:       metrics.CDCReplicateThrottledSeconds.WithLabelValues(targetClusterID),
+	}
+}
+
+// Wait blocks until a message of the given size is allowed to be sent or the context is done.
+func (l *clusterRateLimiter) Wait(ctx context.Context, size int) error {
+	l.refresh()
+	start := time.Now()
+	defer func() {
+		l.throttled.Add(time.Since(start).Seconds())
+	}()
+	if err := l.messages.WaitN(ctx, 1); err != nil {
+		return err
+	}
+	// The message larger than the burst is split into several waits,
+	// so the huge message can still be sent at the limited rate.
+	for size > 0 {
+		n := size
+		if burst := l.bytes.Burst(); l.bytes.Limit() != rate.Inf && n > burst {
+			n = burst
+		}
+		if err := l.bytes.WaitN(ctx, n); err != nil {
+			return err
+		}
+		size -= n
+	}
+	return nil
+}
+
+// refresh refreshes the limits from the configuration if the refresh interval is reached.
+func (l *clusterRateLimiter) refresh() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if time.Since(l.lastRefresh) < rateLimitRefreshInterval {
+		return
+	}
+	l.lastRefresh = time.Now()
+	bytesPerSecond, messagesPerSecond := getReplicationRateLimit(l.targetClusterID)
+	updateLimit(l.bytes, bytesPerSecond)
+	updateLimit(l.messages, messagesPerSecond)
+}
+
+// updateLimit updates the limit of the limiter, the burst is the limit of one second.
+func updateLimit(limiter *rate.Limiter, limit int64) {
+	if limit <= 0 {
+		if limiter.Limit() != rate.Inf {
+			limiter.SetLimit(rate.Inf)
+		}
+		return
+	}
+	if limiter.Limit() != rate.Limit(limit) {
+		limiter.SetLimit(rate.Limit(limit))
+		limiter.SetBurst(int(limit))
+	}
+}
+
+// getReplicationRateLimit returns the bytes and messages per second limit of the target cluster.
+// The limit of the target cluster configured at `streaming.replication.rateLimit.clusters.<clusterID>.*`
+// overrides the default limit.
+func getReplicationRateLimit(targetClusterID string) (bytesPerSecond int64, messagesPerSecond int64) {
+	cfg := &paramtable.Get().StreamingCfg
+	bytesPerSecond = cfg.ReplicationRateLimitBytesPerSecond.GetAsSize()
+	messagesPerSecond = cfg.ReplicationRateLimitMessagesPerSecond.GetAsInt64()
+	// the keys of the configuration are case-insensitive.
+	overrides := cfg.ReplicationRateLimitClusters.GetValue()
+	prefix := strings.ToLower(targetClusterID) + "."
+	if v, ok := overrides[prefix+rateLimitBytesPerSecondKey]; ok {
+		bytesPerSecond = paramtable.ParseAsSize(v)
+	}
+	if v, ok := overrides[prefix+rateLimitMessagesPerSecondKey]; ok {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			messagesPerSecond = n
+		}
+	}
+	return bytesPerSecond, messagesPerSecond
+}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicatestream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestGetReplicationRateLimit(t *testing.T) {
+	paramtable.Init()
+	cfg := &paramtable.Get().StreamingCfg
+
+	bytesPerSecond, messagesPerSecond := getReplicationRateLimit("limit-by-dev2")
+	assert.Zero(t, bytesPerSecond)
+	assert.Zero(t, messagesPerSecond)
+
+	paramtable.Get().Save(cfg.ReplicationRateLimitBytesPerSecond.Key, "1mb")
+	paramtable.Get().Save(cfg.ReplicationRateLimitMessagesPerSecond.Key, "100")
+	defer func() {
+		paramtable.Get().Reset(cfg.ReplicationRateLimitBytesPerSecond.Key)
+		paramtable.Get().Reset(cfg.ReplicationRateLimitMessagesPerSecond.Key)
+	}()
+	paramtable.Get().SaveGroup(map[string]string{
+		cfg.ReplicationRateLimitClusters.KeyPrefix + "limit-by-dev2.bytesPerSecond":    "1kb",
+		cfg.ReplicationRateLimitClusters.KeyPrefix + "limit-by-dev2.messagesPerSecond": "10",
+	})
+
+	bytesPerSecond, messagesPerSecond = getReplicationRateLimit("limit-by-dev2")
+	assert.Equal(t, int64(1024), bytesPerSecond)
+	assert.Equal(t, int64(10), messagesPerSecond)
+	bytesPerSecond, messagesPerSecond = getReplicationRateLimit("limit-by-dev3")
+	assert.Equal(t, int64(1024*1024), bytesPerSecond)
+	assert.Equal(t, int64(100), messagesPerSecond)
+}
+
+func TestClusterRateLimiter(t *testing.T) {
+	paramtable.Init()
+	cfg := &paramtable.Get().StreamingCfg
+	ctx := context.Background()
+
+	// Unlimited by default.
+	limiter := newClusterRateLimiter("by-dev2")
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		assert.NoError(t, limiter.Wait(ctx, 1024*1024))
+	}
+	assert.Less(t, time.Since(start), time.Second)
+
+	paramtable.Get().SaveGroup(map[string]string{
+		cfg.ReplicationRateLimitClusters.KeyPrefix + "throttled-by-dev2.bytesPerSecond": "100",
+	})
+
+	// The message larger than the burst is throttled but not rejected.
+	limiter = newClusterRateLimiter("throttled-by-dev2")
+	start = time.Now()
+	assert.NoError(t, limiter.Wait(ctx, 150))
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	// The wait is canceled by the context.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, limiter.Wait(ctx, 1000))
+
+	// The limiter is shared by the target cluster.
+	assert.Same(t, clusterRateLimiters.get("by-dev2"), clusterRateLimiters.get("by-dev2"))
+	assert.NotSame(t, clusterRateLimiters.get("by-dev2"), clusterRateLimiters.get("by-dev3"))
+}
@@ -40,6 +40,10 @@ type ReplicateStreamClient interface {
 	// BlockUntilFinish blocks until the replicate stream client is finished.
 	BlockUntilFinish()
 
+	// BlockUntilConfirmed blocks until all the replicated messages are confirmed by the target cluster,
+	// or the context is done, or the client is closed.
+	BlockUntilConfirmed(ctx context.Context) error
+
 	// Close closes the replicate stream client.
 	Close()
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -33,14 +34,19 @@ import (
 	"github.com/milvus-io/milvus/internal/cdc/meta"
 	"github.com/milvus-io/milvus/internal/cdc/resource"
 	"github.com/milvus-io/milvus/internal/cdc/util"
+	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/contextutil"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 var ErrReplicationRemoved = errors.New("replication removed")
 
+// confirmCheckInterval is the interval to check if all the replicated messages are confirmed.
+var confirmCheckInterval = 200 * time.Millisecond
+
 // replicateStreamClient is the implementation of ReplicateStreamClient.
 type replicateStreamClient struct {
 	clusterID       string
@@ -49,6 +55,17 @@ type replicateStreamClient struct {
 	channel         *meta.ReplicateChannel
 	pendingMessages MsgQueue
 	metrics         ReplicateMetrics
+	rateLimiter     *clusterRateLimiter
+	// compressionUnsupported is set if the target cluster doesn't support the configured compression,
+	// then the replication stream falls back to no compression.
+	compressionUnsupported bool
+	// rejections is the number of the consecutive rejections by the target cluster without any progress.
+	rejections  int
+	deadLetters deadLetterStore
+	// clearCheckpointResetPolicy clears the checkpoint reset policy of the task,
+	// resetPolicyCleared is set after the reset policy is cleared.
+	clearCheckpointResetPolicy func(ctx context.Context) (bool, error)
+	resetPolicyCleared         bool
 
 	ctx        context.Context
 	cancel     context.CancelFunc
@@ -71,10 +88,15 @@ func NewReplicateStreamClient(ctx context.Context, c cluster.MilvusClient, chann
 		channel:         channel,
 		pendingMessages: pendingMessages,
 		metrics:         NewReplicateMetrics(channel.Value),
+		rateLimiter:     clusterRateLimiters.get(channel.Value.GetTargetCluster().GetClusterId()),
+		deadLetters:     newETCDDeadLetterStore(channel.Value.GetTargetCluster().GetClusterId(), channel.Value.GetSourceChannelName()),
 		ctx:             ctx1,
 		cancel:          cancel,
 		finishedCh:      make(chan struct{}),
 	}
+	rs.clearCheckpointResetPolicy = func(ctx context.Context) (bool, error) {
+		return meta.ClearReplicateCheckpointResetPolicy(ctx, resource.Resource().ETCD(), channel.Key, channel.ModRevision, channel.Value)
+	}
 
 	rs.metrics.OnInitiate()
 	go rs.startInternal()
@@ -91,10 +113,15 @@ func (r *replicateStreamClient) startInternal() {
 	}()
 
 	backoff := backoff.NewExponentialBackOff()
-	backoff.InitialInterval = 100 * time.Millisecond
-	backoff.MaxInterval = 10 * time.Second
+	backoff.InitialInterval = paramtable.Get().StreamingCfg.ReplicationRetryInitialInterval.GetAsDurationByParse()
+	backoff.MaxInterval = paramtable.Get().StreamingCfg.ReplicationRetryMaxInterval.GetAsDurationByParse()
 	backoff.MaxElapsedTime = 0
 
+	if deadLetterEnabled() {
+		// the task may be parked before the cdc is restarted, wait until it's requeued.
+		r.waitUntilRequeued()
+	}
+
 	for {
 		restart := r.startReplicating(backoff)
 		if !restart {
@@ -116,14 +143,19 @@ func (r *replicateStreamClient) startReplicating(backoff backoff.BackOff) (needR
 	connCtx, connCancel := context.WithCancel(r.ctx)
 	defer connCancel()
 
-	client, err := r.targetClient.CreateReplicateStream(connCtx)
+	compressor := r.getCompressor()
+	var callOpts []grpc.CallOption
+	if compressor != grpcclient.None {
+		callOpts = append(callOpts, grpc.UseCompressor(compressor))
+	}
+	client, err := r.targetClient.CreateReplicateStream(connCtx, callOpts...)
 	if err != nil {
 		logger.Warn(r.ctx, "create milvus replicate stream failed, retry...", mlog.Err(err))
 		return true
 	}
 	defer client.CloseSend()
 
-	logger.Info(r.ctx, "replicate stream client service started")
+	logger.Info(r.ctx, "replicate stream client service started", mlog.String("compressor", compressor))
 	r.metrics.OnConnect()
 	backoff.Reset()
 
@@ -157,6 +189,15 @@ func (r *replicateStreamClient) startReplicating(backoff backoff.BackOff) (needR
 		logger.Info(r.ctx, "replicate stream closed due to stream idle timeout, will reconnect", mlog.Err(chErr))
 		r.metrics.OnDisconnect()
 		return true
+	} else if compressor != grpcclient.None && isCompressorUnsupported(chErr) {
+		logger.Warn(r.ctx, "compression is not supported by the target cluster, fall back to no compression",
+			mlog.String("compressor", compressor), mlog.Err(chErr))
+		r.compressionUnsupported = true
+		r.metrics.OnDisconnect()
+		return true
+	} else if isReplicateMessageRejected(chErr) {
+		r.metrics.OnDisconnect()
+		return r.handleRejection(chErr)
 	} else {
 		logger.Warn(r.ctx, "restart replicate stream client due to unexpected error", mlog.Err(chErr))
 		r.metrics.OnDisconnect()
@@ -164,12 +205,21 @@ func (r *replicateStreamClient) startReplicating(backoff backoff.BackOff) (needR
 	}
 }
 
+// getCompressor returns the compressor of the replication stream.
+func (r *replicateStreamClient) getCompressor() string {
+	if r.compressionUnsupported {
+		return grpcclient.None
+	}
+	return getReplicationCompressor(r.channel.Value.GetTargetCluster().GetClusterId())
+}
+
 // Replicate replicates the message to the target cluster.
 func (r *replicateStreamClient) Replicate(msg message.ImmutableMessage) error {
 	select {
 	case <-r.ctx.Done():
 		return nil
 	default:
+		r.metrics.UpdateLatestTimeTick(msg.TimeTick())
 		if msg.MessageType().IsSelfControlled() {
 			// If no messages are being replicated, update the last replicated time tick.
 			if r.pendingMessages.Len() == 0 {
@@ -179,6 +229,7 @@ func (r *replicateStreamClient) Replicate(msg message.ImmutableMessage) error {
 		}
 		r.metrics.StartReplicate(msg)
 		r.pendingMessages.Enqueue(r.ctx, msg)
+		r.metrics.UpdatePendingMessages(r.pendingMessages.Len())
 		return nil
 	}
 }
@@ -227,14 +278,14 @@ func (r *replicateStreamClient) sendLoop(ctx context.Context) (err error) {
 
 				// send txn begin message
 				beginMsg := txnMsg.Begin()
-				err := r.sendMessage(beginMsg)
+				err := r.sendMessage(ctx, beginMsg)
 				if err != nil {
 					return err
 				}
 
 				// send txn messages
 				err = txnMsg.RangeOver(func(msg message.ImmutableMessage) error {
-					return r.sendMessage(msg)
+					return r.sendMessage(ctx, msg)
 				})
 				if err != nil {
 					return err
@@ -242,12 +293,12 @@ func (r *replicateStreamClient) sendLoop(ctx context.Context) (err error) {
 
 				// send txn commit message
 				commitMsg := txnMsg.Commit()
-				err = r.sendMessage(commitMsg)
+				err = r.sendMessage(ctx, commitMsg)
 				if err != nil {
 					return err
 				}
 			} else {
-				err = r.sendMessage(msg)
+				err = r.sendMessage(ctx, msg)
 				if err != nil {
 					return err
 				}
@@ -256,7 +307,7 @@ func (r *replicateStreamClient) sendLoop(ctx context.Context) (err error) {
 	}
 }
 
-func (r *replicateStreamClient) sendMessage(msg message.ImmutableMessage) (err error) {
+func (r *replicateStreamClient) sendMessage(ctx context.Context, msg message.ImmutableMessage) (err error) {
 	defer func() {
 		logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("revision", r.channel.ModRevision))
 		if err != nil {
@@ -266,6 +317,10 @@ func (r *replicateStreamClient) sendMessage(msg message.ImmutableMessage) (err e
 			logger.Debug(r.ctx, "send message success", mlog.FieldMessage(msg))
 		}
 	}()
+	// Throttle the replication traffic to the target cluster, the wait is canceled if the connection is closed.
+	if err := r.rateLimiter.Wait(ctx, msg.EstimateSize()); err != nil {
+		return err
+	}
 	immutableMessage := msg.IntoImmutableMessageProto()
 	req := &milvuspb.ReplicateRequest{
 		Request: &milvuspb.ReplicateRequest_ReplicateMessage{
@@ -312,6 +367,12 @@ func (r *replicateStreamClient) recvLoop(ctx context.Context) (err error) {
 			lastConfirmedMessageInfo := resp.GetReplicateConfirmedMessageInfo()
 			if lastConfirmedMessageInfo != nil {
 				messages := r.pendingMessages.CleanupConfirmedMessages(lastConfirmedMessageInfo.GetConfirmedTimeTick())
+				r.metrics.UpdatePendingMessages(r.pendingMessages.Len())
+				if len(messages) > 0 {
+					// the replication makes progress, so the rejections are reset.
+					r.rejections = 0
+					r.tryClearCheckpointResetPolicy()
+				}
 				for _, msg := range messages {
 					r.metrics.OnConfirmed(msg)
 					if msg.MessageType() == message.MessageTypeAlterReplicateConfig {
@@ -327,6 +388,28 @@ func (r *replicateStreamClient) recvLoop(ctx context.Context) (err error) {
 	}
 }
 
+// tryClearCheckpointResetPolicy clears the checkpoint reset policy of the task after the target cluster confirms
+// the first message replicated from the reset position, so the reset is applied only once.
+// The replicator is restarted by the updated task, and then it starts from the checkpoint of the target cluster
+// with the checkpoint conflict check as usual.
+func (r *replicateStreamClient) tryClearCheckpointResetPolicy() {
+	if r.resetPolicyCleared ||
+		r.channel.Value.GetCheckpointResetPolicy() == streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_NONE {
+		return
+	}
+	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("revision", r.channel.ModRevision))
+	ok, err := r.clearCheckpointResetPolicy(r.ctx)
+	if err != nil {
+		// retry at the next confirmation.
+		logger.Warn(r.ctx, "failed to clear the checkpoint reset policy of replicating task", mlog.Err(err))
+		return
+	}
+	r.resetPolicyCleared = true
+	logger.Info(r.ctx, "checkpoint reset policy of replicating task cleared",
+		mlog.String("policy", r.channel.Value.GetCheckpointResetPolicy().String()),
+		mlog.Bool("updated", ok))
+}
+
 func (r *replicateStreamClient) handleAlterReplicateConfigMessage(msg message.ImmutableMessage) (replicationRemoved bool) {
 	logger := mlog.With(mlog.String("key", r.channel.Key), mlog.Int64("revision", r.channel.ModRevision))
 	logger.Info(r.ctx, "handle AlterReplicateConfigMessage", mlog.FieldMessage(msg))
@@ -371,6 +454,21 @@ func (r *replicateStreamClient) BlockUntilFinish() {
 	<-r.finishedCh
 }
 
+func (r *replicateStreamClient) BlockUntilConfirmed(ctx context.Context) error {
+	ticker := time.NewTicker(confirmCheckInterval)
+	defer ticker.Stop()
+	for r.pendingMessages.Len() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-r.ctx.Done():
+			return r.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
 func (r *replicateStreamClient) Close() {
 	r.cancel()
 	<-r.finishedCh
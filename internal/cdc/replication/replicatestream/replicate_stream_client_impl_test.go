@@ -351,3 +351,36 @@ func TestIsStreamIdleTimeout(t *testing.T) {
 	// Non-gRPC errors should not match
 	assert.False(t, isStreamIdleTimeout(assert.AnError))
 }
+
+func TestTryClearCheckpointResetPolicy(t *testing.T) {
+	calls := 0
+	clearErr := assert.AnError
+	r := &replicateStreamClient{
+		channel: &meta.ReplicateChannel{
+			Key:   "test-key",
+			Value: &streamingpb.ReplicatePChannelMeta{},
+		},
+		clearCheckpointResetPolicy: func(ctx context.Context) (bool, error) {
+			calls++
+			return clearErr == nil, clearErr
+		},
+		ctx: context.Background(),
+	}
+
+	// nothing to clear without reset.
+	r.tryClearCheckpointResetPolicy()
+	assert.Equal(t, 0, calls)
+
+	r.channel.Value.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_LATEST
+	r.tryClearCheckpointResetPolicy()
+	assert.Equal(t, 1, calls)
+	assert.False(t, r.resetPolicyCleared)
+
+	// retried at the next confirmation, and cleared only once.
+	clearErr = nil
+	r.tryClearCheckpointResetPolicy()
+	assert.Equal(t, 2, calls)
+	assert.True(t, r.resetPolicyCleared)
+	r.tryClearCheckpointResetPolicy()
+	assert.Equal(t, 2, calls)
+}
@@ -22,6 +22,9 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus/internal/cdc/replication"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 )
 
 var r *resourceImpl // singleton resource instance
@@ -43,6 +46,20 @@ func OptReplicateManagerClient(replicateManagerClient replication.ReplicateManag
 	}
 }
 
+// OptMixCoordClient provides the mixcoord client of the source cluster to the resource.
+func OptMixCoordClient(mixCoordClient *syncutil.Future[types.MixCoordClient]) optResourceInit {
+	return func(r *resourceImpl) {
+		r.mixCoordClient = mixCoordClient
+	}
+}
+
+// OptChunkManager provides the chunk manager of the source cluster to the resource.
+func OptChunkManager(chunkManager storage.ChunkManager) optResourceInit {
+	return func(r *resourceImpl) {
+		r.chunkManager = chunkManager
+	}
+}
+
 // Done finish all initialization of resources.
 func Init(opts ...optResourceInit) {
 	newR := &resourceImpl{}
@@ -52,6 +69,8 @@ func Init(opts ...optResourceInit) {
 
 	assertNotNil(newR.ETCD())
 	assertNotNil(newR.ReplicateManagerClient())
+	assertNotNil(newR.MixCoordClient())
+	assertNotNil(newR.ChunkManager())
 	r = newR
 }
 
@@ -68,6 +87,8 @@ func Resource() *resourceImpl {
 type resourceImpl struct {
 	etcdClient             *clientv3.Client
 	replicateManagerClient replication.ReplicateManagerClient
+	mixCoordClient         *syncutil.Future[types.MixCoordClient]
+	chunkManager           storage.ChunkManager
 }
 
 // ETCD returns the etcd client.
@@ -80,6 +101,16 @@ func (r *resourceImpl) ReplicateManagerClient() replication.ReplicateManagerClie
 	return r.replicateManagerClient
 }
 
+// MixCoordClient returns the mixcoord client of the source cluster.
+func (r *resourceImpl) MixCoordClient() *syncutil.Future[types.MixCoordClient] {
+	return r.mixCoordClient
+}
+
+// ChunkManager returns the chunk manager to read the binlogs of the source cluster.
+func (r *resourceImpl) ChunkManager() storage.ChunkManager {
+	return r.chunkManager
+}
+
 // assertNotNil panics if the resource is nil.
 func assertNotNil(v interface{}) {
 	iv := reflect.ValueOf(v)
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+// ErrReplicateCheckpointConflict is returned when the checkpoint of the target cluster conflicts with the replicating task,
+// replicating from the checkpoint would break the time tick ordering of the target cluster.
+var ErrReplicateCheckpointConflict = errors.New("replicate checkpoint conflict")
+
+// replicateCheckpointConflictHint is the remediation of the checkpoint conflict.
+const replicateCheckpointConflictHint = "the target cluster may be written independently before the replication is set up, " +
+	"clean up the target cluster or restore it from a backup of the source cluster, " +
+	"then reset the checkpoint of the task by `milvus replicate-task reset-checkpoint`"
+
+// CheckReplicateCheckpointConflict checks if the checkpoint returned by the target cluster conflicts with the replicating task.
+// The check is skipped if the checkpoint is reset by the operator or the target cluster has not confirmed any replicated message.
+func CheckReplicateCheckpointConflict(task *streamingpb.ReplicatePChannelMeta, sourceClusterID string, checkpoint *commonpb.ReplicateCheckpoint) error {
+	if task.GetCheckpointResetPolicy() != streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_NONE ||
+		checkpoint.GetMessageId() == nil {
+		return nil
+	}
+	if checkpoint.GetClusterId() != "" && checkpoint.GetClusterId() != sourceClusterID {
+		return errors.Wrapf(ErrReplicateCheckpointConflict, "checkpoint of target channel %s is replicated from cluster %s but not %s, %s",
+			task.GetTargetChannelName(), checkpoint.GetClusterId(), sourceClusterID, replicateCheckpointConflictHint)
+	}
+	if checkpoint.GetPchannel() != "" && checkpoint.GetPchannel() != task.GetSourceChannelName() {
+		return errors.Wrapf(ErrReplicateCheckpointConflict, "checkpoint of target channel %s is replicated from channel %s but not %s, %s",
+			task.GetTargetChannelName(), checkpoint.GetPchannel(), task.GetSourceChannelName(), replicateCheckpointConflictHint)
+	}
+	// The replicated messages of the task always come after the initialized checkpoint of the task,
+	// so an older checkpoint overlaps with the history written before the task is created.
+	initialized := task.GetInitializedCheckpoint()
+	if initialized.GetTimeTick() > 0 && checkpoint.GetTimeTick() < initialized.GetTimeTick() {
+		return errors.Wrapf(ErrReplicateCheckpointConflict, "checkpoint of target channel %s at time tick %d (%s) is older than the initialized checkpoint of the task at time tick %d (%s), %s",
+			task.GetTargetChannelName(), checkpoint.GetTimeTick(), tsoutil.PhysicalTimeFormat(checkpoint.GetTimeTick()),
+			initialized.GetTimeTick(), tsoutil.PhysicalTimeFormat(initialized.GetTimeTick()), replicateCheckpointConflictHint)
+	}
+	return nil
+}
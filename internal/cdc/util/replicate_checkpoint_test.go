@@ -0,0 +1,46 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestCheckReplicateCheckpointConflict(t *testing.T) {
+	task := &streamingpb.ReplicatePChannelMeta{
+		SourceChannelName:     "by-dev-1",
+		TargetChannelName:     "test-1",
+		InitializedCheckpoint: &commonpb.ReplicateCheckpoint{ClusterId: "by-dev", Pchannel: "by-dev-1", TimeTick: 100},
+	}
+	newCheckpoint := func(clusterID string, pchannel string, timeTick uint64) *commonpb.ReplicateCheckpoint {
+		return &commonpb.ReplicateCheckpoint{
+			ClusterId: clusterID,
+			Pchannel:  pchannel,
+			MessageId: &commonpb.MessageID{Id: "1"},
+			TimeTick:  timeTick,
+		}
+	}
+
+	assert.NoError(t, CheckReplicateCheckpointConflict(task, "by-dev", nil))
+	assert.NoError(t, CheckReplicateCheckpointConflict(task, "by-dev", &commonpb.ReplicateCheckpoint{ClusterId: "another"}))
+	assert.NoError(t, CheckReplicateCheckpointConflict(task, "by-dev", newCheckpoint("by-dev", "by-dev-1", 100)))
+	assert.NoError(t, CheckReplicateCheckpointConflict(task, "by-dev", newCheckpoint("", "", 200)))
+
+	err := CheckReplicateCheckpointConflict(task, "by-dev", newCheckpoint("another", "by-dev-1", 200))
+	assert.ErrorIs(t, err, ErrReplicateCheckpointConflict)
+	assert.Contains(t, err.Error(), "replicated from cluster another")
+	err = CheckReplicateCheckpointConflict(task, "by-dev", newCheckpoint("by-dev", "by-dev-2", 200))
+	assert.ErrorIs(t, err, ErrReplicateCheckpointConflict)
+	assert.Contains(t, err.Error(), "replicated from channel by-dev-2")
+	err = CheckReplicateCheckpointConflict(task, "by-dev", newCheckpoint("by-dev", "by-dev-1", 99))
+	assert.ErrorIs(t, err, ErrReplicateCheckpointConflict)
+	assert.Contains(t, err.Error(), "older than the initialized checkpoint")
+	assert.Contains(t, err.Error(), "reset-checkpoint")
+
+	// the checkpoint reset by the operator is never checked.
+	task.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT
+	assert.NoError(t, CheckReplicateCheckpointConflict(task, "by-dev", newCheckpoint("another", "by-dev-1", 99)))
+}
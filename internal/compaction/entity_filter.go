@@ -16,8 +16,23 @@ type EntityFilter interface {
 	GetMissingDeleteCount() int
 }
 
-func NewEntityFilter(deletedPkTs map[interface{}]typeutil.Timestamp, ttl int64, currTime time.Time, commitTs typeutil.Timestamp) EntityFilter {
-	return newEntityFilter(deletedPkTs, ttl, currTime, commitTs)
+func NewEntityFilter(deletedPkTs map[interface{}]typeutil.Timestamp, ttl int64, currTime time.Time, commitTs typeutil.Timestamp, opts ...EntityFilterOption) EntityFilter {
+	filter := newEntityFilter(deletedPkTs, ttl, currTime, commitTs)
+	for _, opt := range opts {
+		opt(filter)
+	}
+	return filter
+}
+
+// EntityFilterOption is the option of the entity filter.
+type EntityFilterOption func(*EntityFilterImpl)
+
+// WithTTLFieldRetention sets the retention of the ttl field,
+// the value of ttl field is the event time of the entity and the entity is expired at the event time plus the retention.
+func WithTTLFieldRetention(retention time.Duration) EntityFilterOption {
+	return func(filter *EntityFilterImpl) {
+		filter.ttlFieldRetention = retention
+	}
 }
 
 type EntityFilterImpl struct {
@@ -30,6 +45,8 @@ type EntityFilterImpl struct {
 	// max(row_ts, commitTs) so that no row is prematurely expired and no
 	// pre-commit delete is applied.
 	commitTs typeutil.Timestamp
+	// ttlFieldRetention is the retention of the ttl field, 0 if the value of ttl field is the expiration time.
+	ttlFieldRetention time.Duration
 
 	expiredCount int
 	deletedCount int
@@ -127,5 +144,5 @@ func (filter *EntityFilterImpl) isEntityExpiredByTTLField(expirationTimeMicros i
 	}
 
 	// entityExpireTs is microseconds
-	return filter.currentTime.UnixMicro() >= expirationTimeMicros
+	return filter.currentTime.UnixMicro() >= expirationTimeMicros+filter.ttlFieldRetention.Microseconds()
 }
@@ -84,6 +84,24 @@ func (s *EntityFilterSuite) TestEntityFilterByTTL() {
 	}
 }
 
+func (s *EntityFilterSuite) TestEntityFilterByTTLFieldRetention() {
+	milvusBirthday := getMilvusBirthday()
+	eventTimeMicros := milvusBirthday.UnixMicro()
+
+	filter := newEntityFilter(nil, 0, milvusBirthday.Add(30*time.Minute), 0)
+	s.True(filter.Filtered("mockpk", 0, eventTimeMicros))
+
+	filter = newEntityFilter(nil, 0, milvusBirthday.Add(30*time.Minute), 0)
+	WithTTLFieldRetention(time.Hour)(filter)
+	s.False(filter.Filtered("mockpk", 0, eventTimeMicros))
+	s.False(filter.Filtered("mockpk", 0, -1))
+
+	filter = newEntityFilter(nil, 0, milvusBirthday.Add(time.Hour), 0)
+	WithTTLFieldRetention(time.Hour)(filter)
+	s.True(filter.Filtered("mockpk", 0, eventTimeMicros))
+	s.Equal(1, filter.GetExpiredCount())
+}
+
 // TestEntityFilterByTTLWithCommitTs verifies that import/CDC segments (commitTs != 0)
 // are protected from premature TTL expiry caused by outdated row timestamps.
 func (s *EntityFilterSuite) TestEntityFilterByTTLWithCommitTs() {
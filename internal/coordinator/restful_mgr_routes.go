@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -18,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
@@ -46,13 +49,18 @@ func RegisterMgrRoute(s *mixCoordImpl) {
 			{management.BatchTransferPath, s.TransferBatchSegment},
 			// streaming
 			{management.StreamingBalanceStatusPath, s.HandleStreamingBalanceStatus},
+			{management.StreamingBalancePreviewPath, s.GetStreamingBalancePreview},
+			{management.StreamingBalanceFreezePath, s.HandleStreamingBalanceFreeze},
+			{management.StreamingPChannelsPath, s.HandleStreamingPChannels},
 			{management.StreamingNodesPath, s.HandleStreamingNodes},
 			{management.StreamingNodeStatusPath, s.HandleStreamingNodeStatus},
 			{management.StreamingNodeDistributionPath, s.GetStreamingNodeDistribution},
 			{management.StreamingTransferPath, s.TransferStreamingChannel},
+			{management.StreamingDisablePath, s.DisableStreaming},
 			{management.DataGCPath, s.HandleDatacoordGC}, // This route is unique, so it's included here.
 			// WAL
 			{management.WALAlterPath, s.HandleAlterWAL},
+			{management.WALTruncatePath, s.HandleTruncateWAL},
 			// config
 			{management.ConfigAlterPath, s.HandleAlterConfig},
 			{management.ConfigGetPath, s.HandleGetConfig},
@@ -733,6 +741,232 @@ func (s *mixCoordImpl) controlStreamingBalanceStatus(w http.ResponseWriter, req
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+// HandleStreamingBalanceFreeze handles GET requests to fetch the balance frozen flag and PUT requests to freeze or unfreeze the balance.
+// The frozen balance suspends the automatic reassignment of wal until it's unfrozen, even if the coordinator is restarted.
+func (s *mixCoordImpl) HandleStreamingBalanceFreeze(w http.ResponseWriter, req *http.Request) {
+	logger := mlog.With(mlog.String("Scope", "Rolling"))
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var requestBody struct {
+			Frozen *bool `json:"frozen"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil || requestBody.Frozen == nil {
+			logger.Info(req.Context(), "HandleStreamingBalanceFreeze invalid request body", mlog.Err(err))
+			http.Error(w, `{"msg": "Invalid request body, use {\"frozen\": true|false}"}`, http.StatusBadRequest)
+			return
+		}
+		var err error
+		if *requestBody.Frozen {
+			err = streaming.WAL().Balancer().FreezeBalance(req.Context())
+		} else {
+			err = streaming.WAL().Balancer().UnfreezeBalance(req.Context())
+		}
+		if err != nil {
+			logger.Info(req.Context(), "HandleStreamingBalanceFreeze failed to update balance frozen", mlog.Err(err))
+			http.Error(w, fmt.Sprintf(`{"msg": "failed to update balance frozen: %s"}`, err.Error()), http.StatusInternalServerError)
+			return
+		}
+		logger.Info(req.Context(), "HandleStreamingBalanceFreeze update balance frozen", mlog.Bool("frozen", *requestBody.Frozen))
+	default:
+		http.Error(w, `{"msg": "Method not allowed"}`, http.StatusMethodNotAllowed)
+		return
+	}
+
+	frozen, err := streaming.WAL().Balancer().IsBalanceFrozen(req.Context())
+	if err != nil {
+		logger.Info(req.Context(), "HandleStreamingBalanceFreeze failed to get balance frozen", mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "failed to get balance frozen: %s"}`, err.Error()), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"msg":    "OK",
+		"frozen": frozen,
+	})
+}
+
+// DisableStreaming handles POST requests to roll back the streaming service to the legacy path.
+// It's designed for the test clusters, and is rejected if there's any state only served by the streaming based WAL.
+func (s *mixCoordImpl) DisableStreaming(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, `{"msg": "Method not allowed"}`, http.StatusMethodNotAllowed)
+		return
+	}
+	logger := mlog.With(mlog.String("Scope", "Rolling"))
+	if err := streaming.WAL().Balancer().DisableStreaming(req.Context()); err != nil {
+		logger.Info(req.Context(), "DisableStreaming failed", mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "failed to disable streaming: %s"}`, err.Error()), http.StatusInternalServerError)
+		return
+	}
+	logger.Info(req.Context(), "DisableStreaming success")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// GetStreamingBalancePreview handles GET requests to preview the wal moves of the next balance round.
+// Nothing is applied by the preview.
+func (s *mixCoordImpl) GetStreamingBalancePreview(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, `{"msg": "Method not allowed"}`, http.StatusMethodNotAllowed)
+		return
+	}
+	logger := mlog.With(mlog.String("Scope", "Rolling"))
+	moves, err := streaming.WAL().Balancer().PreviewRebalance(req.Context())
+	if err != nil {
+		logger.Info(req.Context(), "GetStreamingBalancePreview failed", mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "failed to preview balance: %s"}`, err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	type moveResponse struct {
+		Channel        string `json:"channel"`
+		FromNodeID     int64  `json:"from_node_id"`
+		ToNodeID       int64  `json:"to_node_id"`
+		FromAccessMode string `json:"from_access_mode,omitempty"`
+		ToAccessMode   string `json:"to_access_mode"`
+		Throttled      bool   `json:"throttled"`
+	}
+	response := struct {
+		Msg   string         `json:"msg"`
+		Moves []moveResponse `json:"moves"`
+	}{
+		Msg:   "OK",
+		Moves: make([]moveResponse, 0, len(moves)),
+	}
+	for _, move := range moves {
+		m := moveResponse{
+			Channel:      move.Channel,
+			FromNodeID:   move.FromServerID,
+			ToNodeID:     move.ToServerID,
+			ToAccessMode: move.ToAccessMode.String(),
+			Throttled:    move.Throttled,
+		}
+		if move.FromServerID != 0 {
+			m.FromAccessMode = move.FromAccessMode.String()
+		}
+		response.Moves = append(response.Moves, m)
+	}
+	logger.Info(req.Context(), "GetStreamingBalancePreview success", mlog.Int("moves", len(moves)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// HandleStreamingPChannels is the main handler that dispatches the pchannel requests based on the HTTP method.
+func (s *mixCoordImpl) HandleStreamingPChannels(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		s.GetStreamingPChannels(w, req)
+	case http.MethodPost:
+		s.RegisterStreamingPChannels(w, req)
+	default:
+		http.Error(w, `{"msg": "Method not allowed"}`, http.StatusMethodNotAllowed)
+	}
+}
+
+// GetStreamingPChannels handles GET requests to inspect the topology of pchannels,
+// including the assignment, term, assignment histories and replication availability of all pchannels.
+func (s *mixCoordImpl) GetStreamingPChannels(w http.ResponseWriter, req *http.Request) {
+	logger := mlog.With(mlog.String("Scope", "Rolling"))
+	view, err := streaming.WAL().Balancer().GetPChannelsView(req.Context())
+	if err != nil {
+		logger.Info(req.Context(), "GetStreamingPChannels failed", mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "failed to get pchannels: %s"}`, err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	type assignmentResponse struct {
+		Term       int64  `json:"term"`
+		NodeID     int64  `json:"node_id"`
+		AccessMode string `json:"access_mode"`
+	}
+	type pchannelResponse struct {
+		Name                   string               `json:"name"`
+		Term                   int64                `json:"term"`
+		AccessMode             string               `json:"access_mode"`
+		State                  string               `json:"state"`
+		NodeID                 int64                `json:"node_id"`
+		NodeAddress            string               `json:"node_address,omitempty"`
+		PinnedNodeID           int64                `json:"pinned_node_id,omitempty"`
+		AvailableInReplication bool                 `json:"available_in_replication"`
+		LastAssignTime         string               `json:"last_assign_time,omitempty"`
+		VChannelNum            int                  `json:"vchannel_num"`
+		AppendBytesPerSecond   float64              `json:"append_bytes_per_second"`
+		AssignHistories        []assignmentResponse `json:"assign_histories,omitempty"`
+	}
+	response := struct {
+		Msg       string             `json:"msg"`
+		PChannels []pchannelResponse `json:"pchannels"`
+	}{
+		Msg:       "OK",
+		PChannels: make([]pchannelResponse, 0, len(view.Channels)),
+	}
+	for id, meta := range view.Channels {
+		assignment := meta.CurrentAssignment()
+		stats := view.Stats[id]
+		p := pchannelResponse{
+			Name:                   meta.Name(),
+			Term:                   meta.CurrentTerm(),
+			AccessMode:             assignment.Channel.AccessMode.String(),
+			State:                  meta.State().String(),
+			NodeID:                 meta.CurrentServerID(),
+			NodeAddress:            assignment.Node.Address,
+			PinnedNodeID:           meta.PinnedServerID(),
+			AvailableInReplication: meta.AvailableInReplication(),
+			VChannelNum:            len(stats.VChannels),
+			AppendBytesPerSecond:   stats.AppendBytesPerSecond,
+		}
+		if !stats.LastAssignTimestamp.IsZero() {
+			p.LastAssignTime = stats.LastAssignTimestamp.Format(time.RFC3339)
+		}
+		for _, history := range meta.AssignHistories() {
+			p.AssignHistories = append(p.AssignHistories, assignmentResponse{
+				Term:       history.Channel.Term,
+				NodeID:     history.Node.ServerID,
+				AccessMode: history.Channel.AccessMode.String(),
+			})
+		}
+		response.PChannels = append(response.PChannels, p)
+	}
+	sort.Slice(response.PChannels, func(i, j int) bool {
+		return response.PChannels[i].Name < response.PChannels[j].Name
+	})
+	logger.Info(req.Context(), "GetStreamingPChannels success", mlog.Int("pchannels", len(response.PChannels)))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// RegisterStreamingPChannels handles POST requests to register new pchannels of wal on a running cluster.
+func (s *mixCoordImpl) RegisterStreamingPChannels(w http.ResponseWriter, req *http.Request) {
+	var requestBody struct {
+		PChannels []string `json:"pchannels"`
+	}
+	logger := mlog.With(mlog.String("Scope", "Rolling"))
+	if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+		logger.Info(req.Context(), "RegisterStreamingPChannels json decoder failed", mlog.Err(err))
+		http.Error(w, `{"msg": "Invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+
+	if err := streaming.WAL().Balancer().RegisterPChannels(req.Context(), requestBody.PChannels); err != nil {
+		logger.Info(req.Context(), "RegisterStreamingPChannels failed", mlog.Strings("pchannels", requestBody.PChannels), mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "failed to register pchannels: %s"}`, err.Error()), http.StatusInternalServerError)
+		return
+	}
+	logger.Info(req.Context(), "RegisterStreamingPChannels success", mlog.Strings("pchannels", requestBody.PChannels))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
 // HandleStreamingNodeStatus is the main handler that dispatches requests
 // based on the HTTP method.
 func (s *mixCoordImpl) HandleStreamingNodeStatus(w http.ResponseWriter, req *http.Request) {
@@ -1164,6 +1398,12 @@ func (s *mixCoordImpl) HandleAlterWAL(w http.ResponseWriter, req *http.Request)
 		http.Error(w, `{"msg": "unknown target_wal_name"}`, http.StatusBadRequest)
 		return
 	}
+	if targetWAL == message.WALNameTiered {
+		// the tiered wal is in front of the mq, it's not a target of the wal alteration.
+		logger.Info(req.Context(), "HandleAlterWAL tiered wal is not a valid target_wal_name")
+		http.Error(w, `{"msg": "tiered wal can not be the target_wal_name, set streaming.walTiered.enabled to true to enable it"}`, http.StatusBadRequest)
+		return
+	}
 
 	// Check if targetWALName is the same as current mq.type
 	// GetValue() will automatically resolve from all config sources including etcd
@@ -1200,6 +1440,64 @@ func (s *mixCoordImpl) HandleAlterWAL(w http.ResponseWriter, req *http.Request)
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+// HandleTruncateWAL handles POST requests to truncate the wal of a pchannel to the given message id explicitly,
+// the truncation is rejected by the streaming node if any consumer checkpoint of the pchannel is before the message id.
+func (s *mixCoordImpl) HandleTruncateWAL(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, `{"msg": "Method not allowed, use POST"}`, http.StatusMethodNotAllowed)
+		return
+	}
+
+	logger := mlog.With(mlog.String("Scope", "WAL"))
+
+	var requestBody struct {
+		PChannel  string `json:"pchannel"`
+		WALName   string `json:"wal_name,omitempty"` // e.g., "woodpecker", "kafka", "pulsar", the wal of current cluster by default
+		MessageID string `json:"message_id"`         // the serialized message id, e.g. the checkpoint listed by replicate-task list
+	}
+	if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+		logger.Info(req.Context(), "HandleTruncateWAL failed to decode request body", mlog.Err(err))
+		http.Error(w, `{"msg": "Invalid request body"}`, http.StatusBadRequest)
+		return
+	}
+	if requestBody.PChannel == "" || requestBody.MessageID == "" {
+		logger.Info(req.Context(), "HandleTruncateWAL missing pchannel or message_id")
+		http.Error(w, `{"msg": "pchannel and message_id are required"}`, http.StatusBadRequest)
+		return
+	}
+
+	walName := util.MustSelectWALName()
+	if requestBody.WALName != "" {
+		walName = message.NewWALName(strings.ToLower(requestBody.WALName))
+		if walName == message.WALNameUnknown {
+			logger.Info(req.Context(), "HandleTruncateWAL unknown wal_name", mlog.String("walName", requestBody.WALName))
+			http.Error(w, `{"msg": "unknown wal_name"}`, http.StatusBadRequest)
+			return
+		}
+	}
+	truncateTo, err := message.UnmarshalMessageID(&commonpb.MessageID{
+		WALName: commonpb.WALName(walName),
+		Id:      requestBody.MessageID,
+	})
+	if err != nil {
+		logger.Info(req.Context(), "HandleTruncateWAL invalid message_id", mlog.String("messageID", requestBody.MessageID), mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "invalid message_id, %s"}`, err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	logger.Info(req.Context(), "HandleTruncateWAL start", mlog.String("pchannel", requestBody.PChannel), mlog.Stringer("truncateTo", truncateTo))
+	if err := streaming.WAL().TruncateWAL(req.Context(), requestBody.PChannel, truncateTo); err != nil {
+		logger.Info(req.Context(), "HandleTruncateWAL failed", mlog.String("pchannel", requestBody.PChannel), mlog.Err(err))
+		http.Error(w, fmt.Sprintf(`{"msg": "failed to truncate wal, %s"}`, err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info(req.Context(), "HandleTruncateWAL success", mlog.String("pchannel", requestBody.PChannel), mlog.Stringer("truncateTo", truncateTo))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
 // broadcastAlterWALMessage broadcasts an AlterWALMessage to all active pChannels.
 func (s *mixCoordImpl) broadcastAlterWALMessage(ctx context.Context, targetWALName commonpb.WALName, config map[string]string) error {
 	logger := mlog.With(mlog.String("Scope", "WAL"), mlog.Stringer("targetWAL", targetWALName))
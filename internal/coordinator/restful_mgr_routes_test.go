@@ -17,15 +17,22 @@ package coordinator
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
@@ -439,3 +446,42 @@ func TestHandleGetConfig(t *testing.T) {
 		assert.Contains(t, w.Body.String(), "Method not allowed")
 	})
 }
+
+func TestHandleTruncateWAL(t *testing.T) {
+	paramtable.Init()
+	wal := mock_streaming.NewMockWALAccesser(t)
+	streaming.SetWALForTest(wal)
+	defer streaming.SetWALForTest(nil)
+
+	s := &mixCoordImpl{}
+	truncate := func(method string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/management/wal/truncate", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		s.HandleTruncateWAL(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusMethodNotAllowed, truncate(http.MethodGet, "").Code)
+	assert.Equal(t, http.StatusBadRequest, truncate(http.MethodPost, "invalid").Code)
+	assert.Equal(t, http.StatusBadRequest, truncate(http.MethodPost, `{"pchannel": "by-dev-rootcoord-dml_0"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, truncate(http.MethodPost, `{"pchannel": "by-dev-rootcoord-dml_0", "wal_name": "unknown", "message_id": "1"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, truncate(http.MethodPost, `{"pchannel": "by-dev-rootcoord-dml_0", "wal_name": "rocksmq", "message_id": "invalid"}`).Code)
+
+	messageID := rmq.NewRmqID(10)
+	body, err := json.Marshal(map[string]string{"pchannel": "by-dev-rootcoord-dml_0", "wal_name": "rocksmq", "message_id": messageID.Marshal()})
+	require.NoError(t, err)
+	wal.EXPECT().TruncateWAL(mock.Anything, "by-dev-rootcoord-dml_0", mock.Anything).RunAndReturn(
+		func(ctx context.Context, pchannel string, truncateTo message.MessageID) error {
+			assert.True(t, truncateTo.EQ(messageID))
+			return nil
+		}).Once()
+	w := truncate(http.MethodPost, string(body))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "OK")
+
+	// the truncation rejected by the streaming node.
+	wal.EXPECT().TruncateWAL(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("checkpoint is before the truncate position")).Once()
+	w = truncate(http.MethodPost, string(body))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "checkpoint is before the truncate position")
+}
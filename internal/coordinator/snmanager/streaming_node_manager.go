@@ -59,6 +59,29 @@ func (s *StreamingReadyNotifier) IsReady() bool {
 	return s.inner.Context().Err() != nil
 }
 
+// NewStreamingDisabledNotifier creates a new streaming disabled notifier.
+func NewStreamingDisabledNotifier() *StreamingDisabledNotifier {
+	return &StreamingDisabledNotifier{
+		inner: syncutil.NewAsyncTaskNotifier[struct{}](),
+	}
+}
+
+// StreamingDisabledNotifier is a notifier for streaming service rolled back to the legacy path.
+// The disabling of streaming service is blocked until the notifier is released.
+type StreamingDisabledNotifier struct {
+	inner *syncutil.AsyncTaskNotifier[struct{}]
+}
+
+// Release releases the notifier.
+func (s *StreamingDisabledNotifier) Release() {
+	s.inner.Finish(struct{}{})
+}
+
+// Disabled returns a channel that will be closed when the streaming service is disabled.
+func (s *StreamingDisabledNotifier) Disabled() <-chan struct{} {
+	return s.inner.Context().Done()
+}
+
 // Context returns the context of the notifier.
 // StreamingNodeManager is a manager for manage the querynode that embedded into streaming node.
 // StreamingNodeManager is exclusive with ResourceManager.
@@ -89,6 +112,15 @@ func (s *StreamingNodeManager) AllocVirtualChannels(ctx context.Context, param b
 	return balancer.AllocVirtualChannels(ctx, param)
 }
 
+// ReallocVirtualChannels expands or shrinks the vchannels of an existing collection to the expected shard number.
+func (s *StreamingNodeManager) ReallocVirtualChannels(ctx context.Context, param balancer.ReallocVChannelParam) ([]string, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return balancer.ReallocVirtualChannels(ctx, param)
+}
+
 // GetLatestWALLocated returns the server id of the node that the wal of the vChannel is located.
 // Return -1 and error if the vchannel is not found or context is canceled.
 func (s *StreamingNodeManager) GetLatestWALLocated(ctx context.Context, vchannel string) (int64, error) {
@@ -104,6 +136,27 @@ func (s *StreamingNodeManager) GetLatestWALLocated(ctx context.Context, vchannel
 	return serverID, nil
 }
 
+// GetLatestWALLocatedBatch returns the server ids of the nodes that the wals of the vchannels are located, keyed by vchannel.
+// The vchannels are resolved in one call, the vchannel that is not found is absent in the result.
+func (s *StreamingNodeManager) GetLatestWALLocatedBatch(ctx context.Context, vchannels []string) (map[string]int64, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pchannels := typeutil.NewSet[string]()
+	for _, vchannel := range vchannels {
+		pchannels.Insert(funcutil.ToPhysicalChannel(vchannel))
+	}
+	pchannelLocated := balancer.GetLatestWALLocatedBatch(ctx, pchannels.Collect())
+	located := make(map[string]int64, len(vchannels))
+	for _, vchannel := range vchannels {
+		if serverID, ok := pchannelLocated[funcutil.ToPhysicalChannel(vchannel)]; ok {
+			located[vchannel] = serverID
+		}
+	}
+	return located, nil
+}
+
 // CheckIfStreamingServiceReady checks if the streaming service is ready.
 func (s *StreamingNodeManager) CheckIfStreamingServiceReady(ctx context.Context) error {
 	n := NewStreamingReadyNotifier()
@@ -128,6 +181,16 @@ func (s *StreamingNodeManager) RegisterStreamingEnabledListener(ctx context.Cont
 	return nil
 }
 
+// RegisterStreamingDisabledListener registers a notifier into the balancer, which is notified when the streaming service is disabled.
+func (s *StreamingNodeManager) RegisterStreamingDisabledListener(ctx context.Context, notifier *StreamingDisabledNotifier) error {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	balancer.RegisterStreamingDisabledNotifier(notifier.inner)
+	return nil
+}
+
 // GetWALLocated returns the server id of the node that the wal of the vChannel is located.
 func (s *StreamingNodeManager) GetWALLocated(vChannel string) int64 {
 	pchannel := funcutil.ToPhysicalChannel(vChannel)
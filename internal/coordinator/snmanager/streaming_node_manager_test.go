@@ -69,6 +69,14 @@ func TestStreamingNodeManager(t *testing.T) {
 	node := m.GetWALLocated("a_test")
 	assert.Equal(t, node, int64(1))
 
+	b.EXPECT().GetLatestWALLocatedBatch(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, pchannels []string) map[string]int64 {
+		assert.ElementsMatch(t, []string{"a_test", "b_test"}, pchannels)
+		return map[string]int64{"a_test": 1}
+	})
+	located, err := m.GetLatestWALLocatedBatch(context.Background(), []string{"a_test_1v0", "a_test_2v1", "b_test_3v0"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{"a_test_1v0": 1, "a_test_2v1": 1}, located)
+
 	b.EXPECT().GetAvailableStreamingNodes(mock.Anything).Unset()
 	b.EXPECT().GetAvailableStreamingNodes(mock.Anything).Return(map[int64]*types.StreamingNodeInfoWithResourceGroup{
 		1: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 1, Address: "localhost:1"}, ResourceGroup: "rg1"},
@@ -77,6 +85,8 @@ func TestStreamingNodeManager(t *testing.T) {
 	assert.Equal(t, len(streamingNodes), 1)
 
 	assert.NoError(t, m.RegisterStreamingEnabledListener(context.Background(), NewStreamingReadyNotifier()))
+	b.EXPECT().RegisterStreamingDisabledNotifier(mock.Anything).Return()
+	assert.NoError(t, m.RegisterStreamingDisabledListener(context.Background(), NewStreamingDisabledNotifier()))
 
 	// --- Test GetStreamingQueryNodeIDsByResourceGroup ---
 	// Return multiple nodes across multiple resource groups
@@ -133,6 +143,19 @@ func TestStreamingNodeManager(t *testing.T) {
 	m.Close()
 }
 
+func TestStreamingDisabledNotifier(t *testing.T) {
+	n := NewStreamingDisabledNotifier()
+	select {
+	case <-n.Disabled():
+		t.Fatal("should not be disabled")
+	default:
+	}
+	n.inner.Cancel()
+	<-n.Disabled()
+	n.Release()
+	n.inner.BlockUntilFinish()
+}
+
 func TestStreamingReadyNotifier(t *testing.T) {
 	n := NewStreamingReadyNotifier()
 	assert.False(t, n.IsReady())
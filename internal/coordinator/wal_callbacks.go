@@ -19,6 +19,7 @@ package coordinator
 import (
 	"context"
 
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
@@ -46,7 +47,8 @@ func (c *WALCallback) registerAlterWALCallback() {
 
 // alterWALV2AckCallback is the callback function for AlterWAL message ack.
 // This callback is called when all vchannels have acknowledged the AlterWAL broadcast message.
-// It updates the etcd configuration mq.type to the targetWALName.
+// It updates the etcd configuration mq.type to the targetWALName,
+// or updates the topics of the pchannels if the AlterWAL message migrates the pchannels to other topics.
 func (c *WALCallback) alterWALV2AckCallback(
 	ctx context.Context,
 	result message.BroadcastResult[*message.AlterWALMessageHeader, *message.AlterWALMessageBody],
@@ -60,6 +62,20 @@ func (c *WALCallback) alterWALV2AckCallback(
 	logger.Info(ctx, "AlterWAL broadcast message acknowledged by all vchannels",
 		mlog.Int("vchannelCount", len(result.Results)))
 
+	if pchannelTopics := result.Message.Header().GetPchannelTopics(); len(pchannelTopics) > 0 {
+		// the topic migration keeps the wal name, so the mq.type is not updated.
+		b, err := balance.GetWithContext(ctx)
+		if err != nil {
+			return err
+		}
+		if err := b.UpdatePChannelTopics(ctx, pchannelTopics); err != nil {
+			logger.Warn(ctx, "failed to update the topics of pchannels", mlog.Any("pchannelTopics", pchannelTopics), mlog.Err(err))
+			return err
+		}
+		logger.Info(ctx, "successfully updated the topics of pchannels", mlog.Any("pchannelTopics", pchannelTopics))
+		return nil
+	}
+
 	// Convert WALName enum to string representation
 	targetWALName := result.Message.Header().TargetWalName
 	mqTypeValue := message.WALName(targetWALName).String()
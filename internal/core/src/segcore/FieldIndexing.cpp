@@ -50,6 +50,7 @@
 #include "storage/ChunkManager.h"
 #include "storage/FileManager.h"
 #include "storage/LocalChunkManagerSingleton.h"
+#include "storage/ThreadPools.h"
 
 namespace milvus::segcore {
 using std::unique_ptr;
@@ -399,6 +400,120 @@ VectorFieldIndexing::AppendSegmentIndexSparse(int64_t reserved_offset,
     }
 }
 
+VectorFieldIndexing::~VectorFieldIndexing() {
+    stopped_ = true;
+    if (build_future_.valid()) {
+        build_future_.wait();
+    }
+}
+
+// copy rows [begin, end) of the dense vector out of the chunks
+static std::unique_ptr<char[]>
+CopyDenseRows(const VectorBase* field_raw_data,
+              int64_t begin,
+              int64_t end,
+              size_t vec_length) {
+    auto size_per_chunk = field_raw_data->get_size_per_chunk();
+    auto data_buf = std::make_unique<char[]>((end - begin) * vec_length);
+    for (int64_t chunk_id = begin / size_per_chunk;
+         chunk_id * size_per_chunk < end;
+         ++chunk_id) {
+        auto chunk_data =
+            static_cast<const char*>(field_raw_data->get_chunk_data(chunk_id));
+        int64_t copy_start = std::max(begin, chunk_id * size_per_chunk);
+        int64_t copy_end = std::min(end, (chunk_id + 1) * size_per_chunk);
+        milvus::fastmem::FastMemcpy(
+            data_buf.get() + (copy_start - begin) * vec_length,
+            chunk_data + (copy_start - chunk_id * size_per_chunk) * vec_length,
+            (copy_end - copy_start) * vec_length);
+    }
+    return data_buf;
+}
+
+void
+VectorFieldIndexing::append_dense_async(int64_t appended_rows,
+                                        const VectorBase* field_raw_data,
+                                        size_t vec_length) {
+    std::lock_guard<std::mutex> lock(index_mutex_);
+    appended_rows_ = std::max(appended_rows_, appended_rows);
+    if (built_) {
+        catch_up_dense(field_raw_data, vec_length);
+        return;
+    }
+    if (building_ || stopped_) {
+        return;
+    }
+    // retry on the next insert if too many indexes are being built
+    if (running_async_builds_.fetch_add(1) >=
+        segcore_config_.get_interim_index_async_build_concurrency()) {
+        running_async_builds_.fetch_sub(1);
+        return;
+    }
+    building_ = true;
+    auto& pool = ThreadPools::GetThreadPool(ThreadPoolPriority::LOW);
+    build_future_ = pool.Submit([this, field_raw_data, vec_length]() {
+        build_dense_in_background(field_raw_data, vec_length);
+    });
+}
+
+void
+VectorFieldIndexing::build_dense_in_background(
+    const VectorBase* field_raw_data, size_t vec_length) {
+    auto build_threshold = get_build_threshold();
+    bool success = false;
+    if (!stopped_) {
+        auto data_buf =
+            CopyDenseRows(field_raw_data, 0, build_threshold, vec_length);
+        auto dataset =
+            knowhere::GenDataSet(build_threshold, get_dim(), data_buf.get());
+        try {
+            index_->BuildWithDataset(dataset,
+                                     get_build_params(get_data_type()));
+            success = true;
+        } catch (SegcoreError& error) {
+            LOG_ERROR("growing index build error: {}", error.what());
+        }
+    }
+    {
+        std::lock_guard<std::mutex> lock(index_mutex_);
+        if (success) {
+            built_ = true;
+            index_cur_.store(build_threshold);
+            // the rows inserted during the build are added here,
+            // and the search switches from brute force to the index
+            catch_up_dense(field_raw_data, vec_length);
+        } else if (!stopped_) {
+            recreate_index(get_data_type(), field_raw_data);
+        }
+        building_ = false;
+    }
+    running_async_builds_.fetch_sub(1);
+}
+
+void
+VectorFieldIndexing::catch_up_dense(const VectorBase* field_raw_data,
+                                    size_t vec_length) {
+    auto begin = index_cur_.load();
+    if (appended_rows_ > begin) {
+        auto data_buf =
+            CopyDenseRows(field_raw_data, begin, appended_rows_, vec_length);
+        auto dataset = knowhere::GenDataSet(
+            appended_rows_ - begin, get_dim(), data_buf.get());
+        try {
+            index_->AddWithDataset(dataset, get_build_params(get_data_type()));
+            index_cur_.store(appended_rows_);
+        } catch (SegcoreError& error) {
+            LOG_ERROR("growing index add error: {}", error.what());
+            sync_with_index_.store(false);
+            built_ = false;
+            index_cur_.store(0);
+            recreate_index(get_data_type(), field_raw_data);
+            return;
+        }
+    }
+    sync_with_index_.store(true);
+}
+
 void
 VectorFieldIndexing::AppendSegmentIndexDense(int64_t reserved_offset,
                                              int64_t size,
@@ -426,6 +541,11 @@ VectorFieldIndexing::AppendSegmentIndexDense(int64_t reserved_offset,
     } else {
         vec_length = dim * sizeof(bfloat16);
     }
+    if (segcore_config_.get_interim_index_async_build() &&
+        valid_data.empty() && !is_mapping_storage) {
+        append_dense_async(reserved_offset + size, field_raw_data, vec_length);
+        return;
+    }
     if (!built_) {
         const void* data_ptr;
         std::unique_ptr<char[]> data_buf;
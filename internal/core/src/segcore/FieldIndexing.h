@@ -16,8 +16,10 @@
 #include <index/ScalarIndex.h>
 #include <atomic>
 #include <cstdint>
+#include <future>
 #include <map>
 #include <memory>
+#include <mutex>
 #include <string>
 #include <type_traits>
 #include <unordered_map>
@@ -148,6 +150,8 @@ class ScalarFieldIndexing : public FieldIndexing {
                                  const SegcoreConfig& segcore_config,
                                  const VectorBase* field_raw_data);
 
+    ~VectorFieldIndexing() override;
+
     void
     AppendSegmentIndexDense(int64_t reserved_offset,
                             int64_t size,
@@ -349,6 +353,22 @@ class VectorFieldIndexing : public FieldIndexing {
  private:
     void
     recreate_index(DataType data_type, const VectorBase* field_raw_data);
+
+    // append rows of dense vector with the index built in background,
+    // the segment is searched by brute force until the index catches up.
+    void
+    append_dense_async(int64_t appended_rows,
+                       const VectorBase* field_raw_data,
+                       size_t vec_length);
+
+    void
+    build_dense_in_background(const VectorBase* field_raw_data,
+                              size_t vec_length);
+
+    // add the rows not in index yet from the raw data, index_mutex_ must be held.
+    void
+    catch_up_dense(const VectorBase* field_raw_data, size_t vec_length);
+
     // current number of rows in index.
     std::atomic<idx_t> index_cur_ = 0;
     // whether the growing index has been built.
@@ -359,6 +379,16 @@ class VectorFieldIndexing : public FieldIndexing {
     std::unique_ptr<VecIndexConfig> config_;
     std::unique_ptr<index::VectorIndex> index_;
     tbb::concurrent_vector<std::unique_ptr<index::VectorIndex>> data_;
+
+    // states of the background build, guarded by index_mutex_
+    std::mutex index_mutex_;
+    int64_t appended_rows_ = 0;
+    bool building_ = false;
+    std::future<void> build_future_;
+    std::atomic<bool> stopped_ = false;
+    // number of the interim indexes being built in background,
+    // limited to throttle the cpu usage of the background builds.
+    inline static std::atomic<int64_t> running_async_builds_ = 0;
 };
 
 std::unique_ptr<FieldIndexing>
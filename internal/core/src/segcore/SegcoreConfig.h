@@ -218,6 +218,26 @@ class SegcoreConfig {
         return interim_index_mem_expansion_rate_;
     }
 
+    void
+    set_interim_index_async_build(bool async_build) {
+        interim_index_async_build_ = async_build;
+    }
+
+    bool
+    get_interim_index_async_build() const {
+        return interim_index_async_build_;
+    }
+
+    void
+    set_interim_index_async_build_concurrency(int64_t concurrency) {
+        interim_index_async_build_concurrency_ = concurrency;
+    }
+
+    int64_t
+    get_interim_index_async_build_concurrency() const {
+        return interim_index_async_build_concurrency_;
+    }
+
  private:
     inline static const std::unordered_set<std::string>
         valid_dense_vector_index_type = {
@@ -242,6 +262,8 @@ class SegcoreConfig {
     inline static bool visibility_filter_enabled_ = true;
     inline static bool prefer_field_data_when_index_has_raw_data_ = false;
     inline static float interim_index_mem_expansion_rate_ = 1.15f;
+    inline static bool interim_index_async_build_ = false;
+    inline static int64_t interim_index_async_build_concurrency_ = 1;
     inline static int64_t max_group_by_groups_ = kDefaultMaxGroupByGroups;
 };
 
@@ -12,6 +12,7 @@
 #include <folly/FBVector.h>
 #include <gtest/gtest.h>
 #include <algorithm>
+#include <chrono>
 #include <cstddef>
 #include <cstdint>
 #include <map>
@@ -19,6 +20,7 @@
 #include <optional>
 #include <stdexcept>
 #include <string>
+#include <thread>
 #include <tuple>
 #include <utility>
 #include <vector>
@@ -596,6 +598,76 @@ TEST_P(GrowingIndexTest, MissIndexMeta) {
     auto segment = CreateGrowingSegment(schema, nullptr);
 }
 
+TEST_P(GrowingIndexTest, AsyncBuild) {
+    if (is_sparse) {
+        return;
+    }
+    auto& config = SegcoreConfig::default_config();
+    ScopedSegcoreConfigRestore config_restore(config);
+
+    auto dim = 4;
+    auto schema = std::make_shared<Schema>();
+    auto pk = schema->AddDebugField("pk", DataType::INT64);
+    schema->AddDebugField("random", DataType::DOUBLE);
+    auto vec = schema->AddDebugField("embeddings", data_type, dim, metric_type);
+    schema->set_primary_field_id(pk);
+
+    std::map<std::string, std::string> index_params = {
+        {"index_type", index_type},
+        {"metric_type", metric_type},
+        {"nlist", "128"}};
+    std::map<std::string, std::string> type_params = {
+        {"dim", std::to_string(dim)}};
+    FieldIndexMeta fieldIndexMeta(
+        vec, std::move(index_params), std::move(type_params));
+    config.set_chunk_rows(1024);
+    config.set_enable_interim_segment_index(true);
+    config.set_interim_index_async_build(true);
+    if (dense_vec_intermin_index_type.has_value()) {
+        config.set_dense_vector_intermin_index_type(
+            dense_vec_intermin_index_type.value());
+    }
+    std::map<FieldId, FieldIndexMeta> filedMap = {{vec, fieldIndexMeta}};
+    IndexMetaPtr metaPtr =
+        std::make_shared<CollectionIndexMeta>(100000, std::move(filedMap));
+    auto segment_growing = CreateGrowingSegment(schema, metaPtr);
+    auto segment = dynamic_cast<SegmentGrowingImpl*>(segment_growing.get());
+
+    int64_t per_batch = 1000;
+    int64_t n_batch = 5;
+    for (int64_t i = 0; i < n_batch; i++) {
+        auto dataset = DataGen(schema, per_batch);
+        auto offset = segment->PreInsert(per_batch);
+        segment->Insert(offset,
+                        per_batch,
+                        dataset.row_ids_.data(),
+                        dataset.timestamps_.data(),
+                        dataset.raw_);
+    }
+
+    // the interim index is built in background, the segment is searched
+    // by brute force until all the inserted rows are added into the index
+    auto& indexing_record = segment->get_indexing_record();
+    for (int i = 0; i < 600 && !indexing_record.SyncDataWithIndex(vec); i++) {
+        std::this_thread::sleep_for(std::chrono::milliseconds(100));
+    }
+    ASSERT_TRUE(indexing_record.SyncDataWithIndex(vec));
+    auto indexing =
+        indexing_record.get_field_indexing(vec).get_segment_indexing();
+    EXPECT_EQ(indexing.get()->Count(), per_batch * n_batch);
+
+    // the rows inserted after the build are added synchronously
+    auto dataset = DataGen(schema, per_batch);
+    auto offset = segment->PreInsert(per_batch);
+    segment->Insert(offset,
+                    per_batch,
+                    dataset.row_ids_.data(),
+                    dataset.timestamps_.data(),
+                    dataset.raw_);
+    EXPECT_TRUE(indexing_record.SyncDataWithIndex(vec));
+    EXPECT_EQ(indexing.get()->Count(), per_batch * (n_batch + 1));
+}
+
 TEST_P(GrowingIndexTest, GetVector) {
     auto& config = SegcoreConfig::default_config();
     ScopedSegcoreConfigRestore config_restore(config);
@@ -24,21 +24,39 @@
 #include "common/QueryInfo.h"
 #include "common/Schema.h"
 #include "common/Types.h"
+#include "pb/schema.pb.h"
 #include "query/Plan.h"
 #include "query/PlanImpl.h"
 #include "query/PlanNode.h"
 #include "segcore/Collection.h"
 #include "segcore/plan_c.h"
 
-// Note: serialized_expr_plan is of binary format
-CStatus
-CreateSearchPlanByExpr(CCollection c_col,
-                       const void* serialized_expr_plan,
-                       const int64_t size,
-                       CSearchPlan* res_plan) {
-    auto col = static_cast<milvus::segcore::Collection*>(c_col);
-    auto schema = col->get_schema();
+namespace {
 
+// ParseSchemaOfVersion parses the schema that a request is planned with,
+// at the given segcore schema version. The load fields follow the current
+// schema of the collection.
+milvus::SchemaPtr
+ParseSchemaOfVersion(milvus::segcore::Collection* col,
+                     const void* schema_proto_blob,
+                     const int64_t schema_length,
+                     const uint64_t schema_version) {
+    milvus::proto::schema::CollectionSchema collection_schema;
+    auto suc =
+        collection_schema.ParseFromArray(schema_proto_blob, schema_length);
+    AssertInfo(suc, "parse schema proto failed");
+    auto schema = milvus::Schema::ParseFrom(collection_schema);
+    schema->set_schema_version(schema_version);
+    schema->UpdateLoadFields(col->get_schema()->load_fields());
+    return schema;
+}
+
+CStatus
+CreateSearchPlanWithSchema(milvus::segcore::Collection* col,
+                           const milvus::SchemaPtr& schema,
+                           const void* serialized_expr_plan,
+                           const int64_t size,
+                           CSearchPlan* res_plan) {
     try {
         auto res = milvus::query::CreateSearchPlanByExpr(
             schema, serialized_expr_plan, size);
@@ -85,6 +103,70 @@ CreateSearchPlanByExpr(CCollection c_col,
     }
 }
 
+CStatus
+CreateRetrievePlanWithSchema(const milvus::SchemaPtr& schema,
+                             const void* serialized_expr_plan,
+                             const int64_t size,
+                             CRetrievePlan* res_plan) {
+    try {
+        auto res = milvus::query::CreateRetrievePlanByExpr(
+            schema, serialized_expr_plan, size);
+
+        auto status = CStatus();
+        status.error_code = milvus::Success;
+        status.error_msg = "";
+        auto plan = (CRetrievePlan)res.release();
+        *res_plan = plan;
+        return status;
+    } catch (milvus::SegcoreError& e) {
+        auto status = CStatus();
+        status.error_code = e.get_error_code();
+        status.error_msg = strdup(e.what());
+        *res_plan = nullptr;
+        return status;
+    } catch (std::exception& e) {
+        auto status = CStatus();
+        status.error_code = milvus::UnexpectedError;
+        status.error_msg = strdup(e.what());
+        *res_plan = nullptr;
+        return status;
+    }
+}
+
+}  // namespace
+
+// Note: serialized_expr_plan is of binary format
+CStatus
+CreateSearchPlanByExpr(CCollection c_col,
+                       const void* serialized_expr_plan,
+                       const int64_t size,
+                       CSearchPlan* res_plan) {
+    auto col = static_cast<milvus::segcore::Collection*>(c_col);
+    return CreateSearchPlanWithSchema(
+        col, col->get_schema(), serialized_expr_plan, size, res_plan);
+}
+
+CStatus
+CreateSearchPlanByExprWithSchema(CCollection c_col,
+                                 const void* schema_proto_blob,
+                                 const int64_t schema_length,
+                                 const uint64_t schema_version,
+                                 const void* serialized_expr_plan,
+                                 const int64_t size,
+                                 CSearchPlan* res_plan) {
+    auto col = static_cast<milvus::segcore::Collection*>(c_col);
+    milvus::SchemaPtr schema;
+    try {
+        schema = ParseSchemaOfVersion(
+            col, schema_proto_blob, schema_length, schema_version);
+    } catch (std::exception& e) {
+        *res_plan = nullptr;
+        return milvus::FailureCStatus(&e);
+    }
+    return CreateSearchPlanWithSchema(
+        col, schema, serialized_expr_plan, size, res_plan);
+}
+
 CStatus
 ParsePlaceholderGroup(CSearchPlan c_plan,
                       const void* placeholder_group_blob,
@@ -170,30 +252,29 @@ CreateRetrievePlanByExpr(CCollection c_col,
                          const int64_t size,
                          CRetrievePlan* res_plan) {
     auto col = static_cast<milvus::segcore::Collection*>(c_col);
+    return CreateRetrievePlanWithSchema(
+        col->get_schema(), serialized_expr_plan, size, res_plan);
+}
 
+CStatus
+CreateRetrievePlanByExprWithSchema(CCollection c_col,
+                                   const void* schema_proto_blob,
+                                   const int64_t schema_length,
+                                   const uint64_t schema_version,
+                                   const void* serialized_expr_plan,
+                                   const int64_t size,
+                                   CRetrievePlan* res_plan) {
+    auto col = static_cast<milvus::segcore::Collection*>(c_col);
+    milvus::SchemaPtr schema;
     try {
-        auto res = milvus::query::CreateRetrievePlanByExpr(
-            col->get_schema(), serialized_expr_plan, size);
-
-        auto status = CStatus();
-        status.error_code = milvus::Success;
-        status.error_msg = "";
-        auto plan = (CRetrievePlan)res.release();
-        *res_plan = plan;
-        return status;
-    } catch (milvus::SegcoreError& e) {
-        auto status = CStatus();
-        status.error_code = e.get_error_code();
-        status.error_msg = strdup(e.what());
-        *res_plan = nullptr;
-        return status;
+        schema = ParseSchemaOfVersion(
+            col, schema_proto_blob, schema_length, schema_version);
     } catch (std::exception& e) {
-        auto status = CStatus();
-        status.error_code = milvus::UnexpectedError;
-        status.error_msg = strdup(e.what());
         *res_plan = nullptr;
-        return status;
+        return milvus::FailureCStatus(&e);
     }
+    return CreateRetrievePlanWithSchema(
+        schema, serialized_expr_plan, size, res_plan);
 }
 
 void
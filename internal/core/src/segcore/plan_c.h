@@ -32,6 +32,17 @@ CreateSearchPlanByExpr(CCollection c_col,
                        const int64_t size,
                        CSearchPlan* res_plan);
 
+// Create the search plan with the schema the request is planned with,
+// instead of the current schema of the collection.
+CStatus
+CreateSearchPlanByExprWithSchema(CCollection c_col,
+                                 const void* schema_proto_blob,
+                                 const int64_t schema_length,
+                                 const uint64_t schema_version,
+                                 const void* serialized_expr_plan,
+                                 const int64_t size,
+                                 CSearchPlan* res_plan);
+
 CStatus
 ParsePlaceholderGroup(CSearchPlan c_plan,
                       const void* placeholder_group_blob,
@@ -65,6 +76,17 @@ CreateRetrievePlanByExpr(CCollection c_col,
                          const int64_t size,
                          CRetrievePlan* res_plan);
 
+// Create the retrieve plan with the schema the request is planned with,
+// instead of the current schema of the collection.
+CStatus
+CreateRetrievePlanByExprWithSchema(CCollection c_col,
+                                   const void* schema_proto_blob,
+                                   const int64_t schema_length,
+                                   const uint64_t schema_version,
+                                   const void* serialized_expr_plan,
+                                   const int64_t size,
+                                   CRetrievePlan* res_plan);
+
 void
 DeleteRetrievePlan(CRetrievePlan plan);
 
@@ -143,6 +143,20 @@ SegcoreSetInterimIndexMemExpansionRate(const float value) {
     config.set_interim_index_mem_expansion_rate(value);
 }
 
+extern "C" void
+SegcoreSetInterimIndexAsyncBuild(const bool value) {
+    milvus::segcore::SegcoreConfig& config =
+        milvus::segcore::SegcoreConfig::default_config();
+    config.set_interim_index_async_build(value);
+}
+
+extern "C" void
+SegcoreSetInterimIndexAsyncBuildConcurrency(const int64_t value) {
+    milvus::segcore::SegcoreConfig& config =
+        milvus::segcore::SegcoreConfig::default_config();
+    config.set_interim_index_async_build_concurrency(value);
+}
+
 extern "C" void
 SegcoreSetMaxGroupByGroups(const int64_t value) {
     milvus::segcore::SegcoreConfig& config =
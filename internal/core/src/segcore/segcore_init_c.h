@@ -68,6 +68,12 @@ SegcoreSetDenseVectorInterminIndexRefineWithQuantFlag(const bool);
 void
 SegcoreSetInterimIndexMemExpansionRate(const float);
 
+void
+SegcoreSetInterimIndexAsyncBuild(const bool);
+
+void
+SegcoreSetInterimIndexAsyncBuildConcurrency(const int64_t);
+
 void
 SegcoreSetMaxGroupByGroups(const int64_t);
 
@@ -52,7 +52,8 @@ class ScopedSegcoreConfigRestore {
               config.get_dense_vector_intermin_index_type()),
           refine_quant_type_(
               RefineTypeToConfigStringForTest(config.get_refine_quant_type())),
-          refine_with_quant_flag_(config.get_refine_with_quant_flag()) {
+          refine_with_quant_flag_(config.get_refine_with_quant_flag()),
+          interim_index_async_build_(config.get_interim_index_async_build()) {
     }
 
     ~ScopedSegcoreConfigRestore() {
@@ -66,6 +67,7 @@ class ScopedSegcoreConfigRestore {
             dense_vector_interim_index_type_);
         config_.set_refine_quant_type(refine_quant_type_);
         config_.set_refine_with_quant_flag(refine_with_quant_flag_);
+        config_.set_interim_index_async_build(interim_index_async_build_);
     }
 
     ScopedSegcoreConfigRestore(const ScopedSegcoreConfigRestore&) = delete;
@@ -83,6 +85,7 @@ class ScopedSegcoreConfigRestore {
     std::string dense_vector_interim_index_type_;
     std::string refine_quant_type_;
     bool refine_with_quant_flag_;
+    bool interim_index_async_build_;
 };
 
 struct InterimIndexConfigForTest {
@@ -0,0 +1,320 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+const (
+	MixCompactionPolicyDefault     = "default"
+	MixCompactionPolicySize        = "size"
+	MixCompactionPolicyDeleteRatio = "delete_ratio"
+	MixCompactionPolicyTimeWindow  = "time_window"
+)
+
+// MixCompactionPolicy selects the segments of a channel and partition to be compacted together by the mix compaction.
+// The policy is selected per collection by the collection property collection.compaction.policy,
+// custom builds could add their own policies by RegisterMixCompactionPolicy.
+type MixCompactionPolicy interface {
+	// Name returns the name of the policy, which is the value of the collection property to select it.
+	Name() string
+	// Select returns the buckets of segments, the segments of each bucket are compacted by one mix compaction task,
+	// and the reasons of the buckets for logging.
+	Select(input *MixCompactionInput) (buckets [][]*SegmentInfo, reasons []string)
+}
+
+// MixCompactionInput is the input of MixCompactionPolicy, the candidate segments of one channel and partition.
+type MixCompactionInput struct {
+	CollectionID int64
+	// Properties are the properties of the collection, nil if the collection is not found.
+	Properties map[string]string
+	Segments   []*SegmentInfo
+	// Force is true if all the segments should be compacted, e.g. the manual compaction.
+	Force bool
+	// ExpectedSize is the expected size of the compacted segment.
+	ExpectedSize int64
+
+	trigger     *compactionTrigger
+	compactTime *compactTime
+}
+
+func newMixCompactionInput(t *compactionTrigger, coll *collectionInfo, segments []*SegmentInfo, signal *compactionSignal, compactTime *compactTime, expectedSize int64) *MixCompactionInput {
+	input := &MixCompactionInput{
+		Segments:     segments,
+		Force:        signal.isForce,
+		ExpectedSize: expectedSize,
+		trigger:      t,
+		compactTime:  compactTime,
+	}
+	if coll != nil {
+		input.CollectionID = coll.ID
+		input.Properties = coll.Properties
+	} else {
+		input.CollectionID = signal.collectionID
+	}
+	return input
+}
+
+// ShouldRewrite returns whether the segment has too many deleted or expired entities and should be rewritten.
+func (input *MixCompactionInput) ShouldRewrite(segment *SegmentInfo) bool {
+	return input.trigger.ShouldDoSingleCompaction(segment, input.compactTime)
+}
+
+// IsSmall returns whether the segment is small enough to be merged.
+func (input *MixCompactionInput) IsSmall(segment *SegmentInfo) bool {
+	return input.trigger.isSmallSegment(segment, input.ExpectedSize)
+}
+
+// Pack packs the prioritized segments and the small segments into buckets of the expected size,
+// the prioritized segments are always packed, the small segments are packed only if they could make a bucket.
+func (input *MixCompactionInput) Pack(prioritized, small []*SegmentInfo) ([][]*SegmentInfo, []string) {
+	return input.trigger.packMixCompactionCandidates(prioritized, small, input.compactTime, input.ExpectedSize)
+}
+
+// withSegments returns a copy of the input with the given candidate segments.
+func (input *MixCompactionInput) withSegments(segments []*SegmentInfo) *MixCompactionInput {
+	cloned := *input
+	cloned.Segments = segments
+	return &cloned
+}
+
+var mixCompactionPolicies = struct {
+	sync.RWMutex
+	policies map[string]MixCompactionPolicy
+}{
+	policies: make(map[string]MixCompactionPolicy),
+}
+
+func init() {
+	RegisterMixCompactionPolicy(&defaultMixCompactionPolicy{})
+	RegisterMixCompactionPolicy(&sizeMixCompactionPolicy{})
+	RegisterMixCompactionPolicy(&deleteRatioMixCompactionPolicy{})
+	RegisterMixCompactionPolicy(&timeWindowMixCompactionPolicy{})
+}
+
+// RegisterMixCompactionPolicy registers the mix compaction policy by its name, the registered one with the same name is replaced.
+func RegisterMixCompactionPolicy(policy MixCompactionPolicy) {
+	mixCompactionPolicies.Lock()
+	defer mixCompactionPolicies.Unlock()
+	mixCompactionPolicies.policies[policy.Name()] = policy
+}
+
+// getMixCompactionPolicy returns the mix compaction policy of the collection,
+// the default policy is returned if the configured one is not registered.
+func getMixCompactionPolicy(coll *collectionInfo) MixCompactionPolicy {
+	name := Params.DataCoordCfg.MixCompactionPolicy.GetValue()
+	if coll != nil {
+		if v, ok := coll.Properties[common.CollectionCompactionPolicyKey]; ok {
+			name = v
+		}
+	}
+
+	mixCompactionPolicies.RLock()
+	defer mixCompactionPolicies.RUnlock()
+	if policy, ok := mixCompactionPolicies.policies[name]; ok {
+		return policy
+	}
+	// the policy is checked on every compaction signal, so the warning is rate limited.
+	mlog.RatedWarn(context.TODO(), rate.Limit(0.1), "mix compaction policy not found, use the default one", mlog.String("policy", name))
+	return mixCompactionPolicies.policies[MixCompactionPolicyDefault]
+}
+
+// defaultMixCompactionPolicy rewrites the segments with too many deleted or expired entities,
+// and merges the small segments.
+type defaultMixCompactionPolicy struct{}
+
+func (p *defaultMixCompactionPolicy) Name() string {
+	return MixCompactionPolicyDefault
+}
+
+func (p *defaultMixCompactionPolicy) Select(input *MixCompactionInput) ([][]*SegmentInfo, []string) {
+	prioritized, small := classifyMixCompactionCandidates(input, true, true)
+	return input.Pack(prioritized, small)
+}
+
+// sizeMixCompactionPolicy merges the small segments only.
+type sizeMixCompactionPolicy struct{}
+
+func (p *sizeMixCompactionPolicy) Name() string {
+	return MixCompactionPolicySize
+}
+
+func (p *sizeMixCompactionPolicy) Select(input *MixCompactionInput) ([][]*SegmentInfo, []string) {
+	prioritized, small := classifyMixCompactionCandidates(input, false, true)
+	return input.Pack(prioritized, small)
+}
+
+// deleteRatioMixCompactionPolicy rewrites the segments with too many deleted or expired entities only.
+type deleteRatioMixCompactionPolicy struct{}
+
+func (p *deleteRatioMixCompactionPolicy) Name() string {
+	return MixCompactionPolicyDeleteRatio
+}
+
+func (p *deleteRatioMixCompactionPolicy) Select(input *MixCompactionInput) ([][]*SegmentInfo, []string) {
+	prioritized, small := classifyMixCompactionCandidates(input, true, false)
+	return input.Pack(prioritized, small)
+}
+
+// timeWindowMixCompactionPolicy works as the default policy, but merges the segments flushed in the same time window only,
+// so that the entities of close time are kept together, which is friendly to the time range filter and the expiration.
+type timeWindowMixCompactionPolicy struct{}
+
+func (p *timeWindowMixCompactionPolicy) Name() string {
+	return MixCompactionPolicyTimeWindow
+}
+
+func (p *timeWindowMixCompactionPolicy) Select(input *MixCompactionInput) ([][]*SegmentInfo, []string) {
+	window := getMixCompactionTimeWindow(input.CollectionID, input.Properties)
+	windowOf := func(segment *SegmentInfo) int64 {
+		ts := segment.GetDmlPosition().GetTimestamp()
+		if ts == 0 {
+			ts = segment.GetStartPosition().GetTimestamp()
+		}
+		return tsoutil.PhysicalTime(ts).UnixNano() / int64(window)
+	}
+
+	windows := make(map[int64][]*SegmentInfo)
+	for _, segment := range input.Segments {
+		w := windowOf(segment)
+		windows[w] = append(windows[w], segment)
+	}
+	keys := make([]int64, 0, len(windows))
+	for w := range windows {
+		keys = append(keys, w)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var buckets [][]*SegmentInfo
+	var reasons []string
+	for _, w := range keys {
+		windowInput := input.withSegments(windows[w])
+		prioritized, small := classifyMixCompactionCandidates(windowInput, true, true)
+		windowBuckets, windowReasons := windowInput.Pack(prioritized, small)
+		buckets = append(buckets, windowBuckets...)
+		for _, reason := range windowReasons {
+			reasons = append(reasons, fmt.Sprintf("%s in time window %s", reason, time.Unix(0, w*int64(window)).Format(time.RFC3339)))
+		}
+	}
+	return buckets, reasons
+}
+
+// getMixCompactionTimeWindow returns the time window of the time_window mix compaction policy of the collection.
+func getMixCompactionTimeWindow(collectionID int64, properties map[string]string) time.Duration {
+	window := Params.DataCoordCfg.MixCompactionTimeWindow.GetAsDuration(time.Second)
+	if v, ok := properties[common.CollectionCompactionTimeWindowKey]; ok {
+		seconds, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && seconds > 0 {
+			window = time.Duration(seconds) * time.Second
+		} else {
+			mlog.RatedWarn(context.TODO(), rate.Limit(0.1), "invalid compaction time window of collection, use the default one",
+				mlog.FieldCollectionID(collectionID), mlog.String("timeWindow", v))
+		}
+	}
+	if window <= 0 {
+		window = 24 * time.Hour
+	}
+	return window
+}
+
+// classifyMixCompactionCandidates classifies the segments into the prioritized ones to be rewritten,
+// and the small ones to be merged, all the segments are prioritized if the compaction is forced.
+func classifyMixCompactionCandidates(input *MixCompactionInput, rewrite bool, merge bool) (prioritized []*SegmentInfo, small []*SegmentInfo) {
+	for _, segment := range input.Segments {
+		segment := segment.ShadowClone()
+		// TODO should we trigger compaction periodically even if the segment has no obvious reason to be compacted?
+		if input.Force || (rewrite && input.ShouldRewrite(segment)) {
+			prioritized = append(prioritized, segment)
+		} else if merge && input.IsSmall(segment) {
+			small = append(small, segment)
+		}
+	}
+	return prioritized, small
+}
+
+// packMixCompactionCandidates packs the prioritized segments and the small segments into buckets.
+func (t *compactionTrigger) packMixCompactionCandidates(prioritizedCandidates, smallCandidates []*SegmentInfo, compactTime *compactTime, expectedSize int64) ([][]*SegmentInfo, []string) {
+	buckets := [][]*SegmentInfo{}
+	toUpdate := newSegmentPacker("update", prioritizedCandidates, compactTime)
+	toMerge := newSegmentPacker("merge", smallCandidates, compactTime)
+
+	maxSegs := int64(4096) // Deprecate the max segment limit since it is irrelevant in simple compactions.
+	minSegs := Params.DataCoordCfg.MinSegmentToMerge.GetAsInt64()
+	compactableProportion := Params.DataCoordCfg.SegmentCompactableProportion.GetAsFloat()
+	satisfiedSize := int64(float64(expectedSize) * compactableProportion)
+	maxLeftSize := expectedSize - satisfiedSize
+	reasons := make([]string, 0)
+	// 1. Merge small segments if they can make a full bucket
+	for {
+		pack, left := toMerge.pack(expectedSize, maxLeftSize, minSegs, maxSegs)
+		if len(pack) == 0 {
+			break
+		}
+		reasons = append(reasons, fmt.Sprintf("merging %d small segments with left size %d", len(pack), left))
+		buckets = append(buckets, pack)
+	}
+
+	// 2. Pack prioritized candidates with small segments
+	// TODO the compaction selection policy should consider if compaction workload is high
+	for {
+		// No limit on the remaining size because we want to pack all prioritized candidates
+		pack, _ := toUpdate.packWith(expectedSize, math.MaxInt64, 0, maxSegs, toMerge)
+		if len(pack) == 0 {
+			break
+		}
+		reasons = append(reasons, fmt.Sprintf("packing %d prioritized segments", len(pack)))
+		buckets = append(buckets, pack)
+	}
+	// if there is any segment toUpdate left, its size must be greater than expectedSize, add it to the buckets
+	for _, s := range toUpdate.candidates {
+		buckets = append(buckets, []*SegmentInfo{s})
+		reasons = append(reasons, fmt.Sprintf("force packing prioritized segment %d", s.GetID()))
+	}
+
+	// 2.+ legacy: squeeze small segments
+	// Try merge all small segments, and then squeeze
+	for {
+		pack, _ := toMerge.pack(expectedSize, math.MaxInt64, minSegs, maxSegs)
+		if len(pack) == 0 {
+			break
+		}
+		reasons = append(reasons, fmt.Sprintf("packing all %d small segments", len(pack)))
+		buckets = append(buckets, pack)
+	}
+	smallRemaining := t.squeezeSmallSegmentsToBuckets(toMerge.candidates, buckets, expectedSize)
+	if len(smallRemaining) > 0 {
+		mlog.RatedInfo(context.TODO(), rate.Limit(300), "remain small segments",
+			mlog.FieldCollectionID(smallRemaining[0].GetCollectionID()),
+			mlog.FieldPartitionID(smallRemaining[0].GetPartitionID()),
+			mlog.String("channel", smallRemaining[0].GetInsertChannel()),
+			mlog.Int("smallRemainingCount", len(smallRemaining)))
+	}
+	return buckets, reasons
+}
@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+type MixCompactionPolicySuite struct {
+	suite.Suite
+
+	trigger *compactionTrigger
+	base    time.Time
+}
+
+func (s *MixCompactionPolicySuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *MixCompactionPolicySuite) SetupTest() {
+	s.trigger = &compactionTrigger{testingOnly: true}
+	s.base = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *MixCompactionPolicySuite) newSegment(id int64, size int64, deletedRows int64, flushedAt time.Time) *SegmentInfo {
+	segment := &datapb.SegmentInfo{
+		ID:           id,
+		CollectionID: 1,
+		PartitionID:  10,
+		State:        commonpb.SegmentState_Flushed,
+		NumOfRows:    100,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: 100, MemorySize: size, TimestampTo: tsoutil.ComposeTSByTime(flushedAt, 0)}}},
+		},
+		DmlPosition: &msgpb.MsgPosition{Timestamp: tsoutil.ComposeTSByTime(flushedAt, 0)},
+	}
+	if deletedRows > 0 {
+		segment.Deltalogs = []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{EntriesNum: deletedRows, MemorySize: 1}}},
+		}
+	}
+	return NewSegmentInfo(segment)
+}
+
+func (s *MixCompactionPolicySuite) selectSegmentIDs(coll *collectionInfo, segments []*SegmentInfo) [][]int64 {
+	policy := getMixCompactionPolicy(coll)
+	buckets, _ := policy.Select(newMixCompactionInput(s.trigger, coll, segments, &compactionSignal{collectionID: 1}, &compactTime{}, 1024))
+	ids := make([][]int64, 0, len(buckets))
+	for _, bucket := range buckets {
+		bucketIDs := make([]int64, 0, len(bucket))
+		for _, segment := range bucket {
+			bucketIDs = append(bucketIDs, segment.GetID())
+		}
+		ids = append(ids, bucketIDs)
+	}
+	return ids
+}
+
+func (s *MixCompactionPolicySuite) TestGetMixCompactionPolicy() {
+	s.Equal(MixCompactionPolicyDefault, getMixCompactionPolicy(nil).Name())
+
+	coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: MixCompactionPolicySize}}
+	s.Equal(MixCompactionPolicySize, getMixCompactionPolicy(coll).Name())
+
+	coll.Properties[common.CollectionCompactionPolicyKey] = "unknown"
+	s.Equal(MixCompactionPolicyDefault, getMixCompactionPolicy(coll).Name())
+
+	paramtable.Get().Save(Params.DataCoordCfg.MixCompactionPolicy.Key, MixCompactionPolicyTimeWindow)
+	defer paramtable.Get().Reset(Params.DataCoordCfg.MixCompactionPolicy.Key)
+	s.Equal(MixCompactionPolicyTimeWindow, getMixCompactionPolicy(&collectionInfo{ID: 1}).Name())
+}
+
+func (s *MixCompactionPolicySuite) TestRegisterMixCompactionPolicy() {
+	custom := &customMixCompactionPolicy{}
+	RegisterMixCompactionPolicy(custom)
+	defer func() {
+		mixCompactionPolicies.Lock()
+		delete(mixCompactionPolicies.policies, custom.Name())
+		mixCompactionPolicies.Unlock()
+	}()
+
+	coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: custom.Name()}}
+	segments := []*SegmentInfo{s.newSegment(1, 100, 0, s.base), s.newSegment(2, 100, 0, s.base)}
+	s.Equal([][]int64{{1}, {2}}, s.selectSegmentIDs(coll, segments))
+}
+
+func (s *MixCompactionPolicySuite) TestSizeAndDeleteRatio() {
+	segments := []*SegmentInfo{
+		s.newSegment(1, 100, 50, s.base),
+		s.newSegment(2, 100, 0, s.base),
+		s.newSegment(3, 100, 0, s.base),
+		s.newSegment(4, 100, 0, s.base),
+	}
+
+	coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: MixCompactionPolicyDeleteRatio}}
+	s.Equal([][]int64{{1}}, s.selectSegmentIDs(coll, segments))
+
+	coll.Properties[common.CollectionCompactionPolicyKey] = MixCompactionPolicySize
+	ids := s.selectSegmentIDs(coll, segments)
+	s.Require().Len(ids, 1)
+	s.ElementsMatch([]int64{1, 2, 3, 4}, ids[0])
+}
+
+func (s *MixCompactionPolicySuite) TestTimeWindow() {
+	segments := []*SegmentInfo{
+		s.newSegment(1, 100, 0, s.base),
+		s.newSegment(2, 100, 0, s.base.Add(time.Hour)),
+		s.newSegment(3, 100, 0, s.base.Add(2*time.Hour)),
+		s.newSegment(4, 100, 0, s.base.Add(25*time.Hour)),
+		s.newSegment(5, 100, 0, s.base.Add(26*time.Hour)),
+		s.newSegment(6, 100, 0, s.base.Add(27*time.Hour)),
+	}
+
+	coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: MixCompactionPolicyTimeWindow}}
+	ids := s.selectSegmentIDs(coll, segments)
+	s.Require().Len(ids, 2)
+	s.ElementsMatch([]int64{1, 2, 3}, ids[0])
+	s.ElementsMatch([]int64{4, 5, 6}, ids[1])
+
+	// each segment is in its own window, nothing to merge
+	coll.Properties[common.CollectionCompactionTimeWindowKey] = "3600"
+	s.Empty(s.selectSegmentIDs(coll, segments))
+	s.Equal(time.Hour, getMixCompactionTimeWindow(coll.ID, coll.Properties))
+
+	coll.Properties[common.CollectionCompactionTimeWindowKey] = "invalid"
+	s.Equal(Params.DataCoordCfg.MixCompactionTimeWindow.GetAsDuration(time.Second), getMixCompactionTimeWindow(coll.ID, coll.Properties))
+}
+
+// customMixCompactionPolicy compacts every segment by itself.
+type customMixCompactionPolicy struct{}
+
+func (p *customMixCompactionPolicy) Name() string {
+	return "custom"
+}
+
+func (p *customMixCompactionPolicy) Select(input *MixCompactionInput) ([][]*SegmentInfo, []string) {
+	buckets := make([][]*SegmentInfo, 0, len(input.Segments))
+	for _, segment := range input.Segments {
+		buckets = append(buckets, []*SegmentInfo{segment})
+	}
+	return buckets, nil
+}
+
+func TestMixCompactionPolicy(t *testing.T) {
+	suite.Run(t, new(MixCompactionPolicySuite))
+}
@@ -18,7 +18,6 @@ package datacoord
 
 import (
 	"context"
-	"fmt"
 	"math"
 	"sync"
 	"time"
@@ -42,9 +41,10 @@ import (
 )
 
 type compactTime struct {
-	startTime     Timestamp
-	expireTime    Timestamp
-	collectionTTL time.Duration
+	startTime         Timestamp
+	expireTime        Timestamp
+	collectionTTL     time.Duration
+	ttlFieldRetention time.Duration // the expir quantiles of the segment are the event time if it's set.
 }
 
 // todo: migrate to compaction_trigger_v2
@@ -252,16 +252,21 @@ func getCompactTime(ts Timestamp, coll *collectionInfo) (*compactTime, error) {
 		return nil, err
 	}
 
+	ttlFieldRetention, err := common.GetTTLFieldRetentionFromMap(coll.Properties)
+	if err != nil {
+		return nil, err
+	}
+
 	pts, _ := tsoutil.ParseTS(ts)
 
 	if collectionTTL > 0 {
 		ttexpired := pts.Add(-collectionTTL)
 		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ts, ttexpiredLogic, collectionTTL}, nil
+		return &compactTime{ts, ttexpiredLogic, collectionTTL, ttlFieldRetention}, nil
 	}
 
 	// no expiration time
-	return &compactTime{ts, 0, 0}, nil
+	return &compactTime{ts, 0, 0, ttlFieldRetention}, nil
 }
 
 // TrigerCompaction is the public interface to send compaction signal to work queue.
@@ -386,7 +391,7 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) error {
 		}
 
 		expectedSize := getExpectedSegmentSize(t.meta, coll.ID, coll.Schema)
-		plans := t.generatePlans(group.segments, signal, ct, expectedSize)
+		plans := t.generatePlans(coll, group.segments, signal, ct, expectedSize)
 		for _, plan := range plans {
 			if !signal.isForce && t.inspector.isFull() {
 				log.Warn(context.TODO(), "skip to generate compaction plan due to handler full")
@@ -444,79 +449,15 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) error {
 	return nil
 }
 
-func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, signal *compactionSignal, compactTime *compactTime, expectedSize int64) []*typeutil.Pair[int64, []int64] {
+func (t *compactionTrigger) generatePlans(coll *collectionInfo, segments []*SegmentInfo, signal *compactionSignal, compactTime *compactTime, expectedSize int64) []*typeutil.Pair[int64, []int64] {
 	if len(segments) == 0 {
 		mlog.Warn(context.TODO(), "the number of candidate segments is 0, skip to generate compaction plan")
 		return []*typeutil.Pair[int64, []int64]{}
 	}
 
-	// find segments need internal compaction
-	// TODO add low priority candidates, for example if the segment is smaller than full 0.9 * max segment size but larger than small segment boundary, we only execute compaction when there are no compaction running actively
-	var prioritizedCandidates []*SegmentInfo
-	var smallCandidates []*SegmentInfo
-	var nonPlannedSegments []*SegmentInfo
-
 	// TODO, currently we lack of the measurement of data distribution, there should be another compaction help on redistributing segment based on scalar/vector field distribution
-	for _, segment := range segments {
-		segment := segment.ShadowClone()
-		// TODO should we trigger compaction periodically even if the segment has no obvious reason to be compacted?
-		if signal.isForce || t.ShouldDoSingleCompaction(segment, compactTime) {
-			prioritizedCandidates = append(prioritizedCandidates, segment)
-		} else if t.isSmallSegment(segment, expectedSize) {
-			smallCandidates = append(smallCandidates, segment)
-		} else {
-			nonPlannedSegments = append(nonPlannedSegments, segment)
-		}
-	}
-
-	buckets := [][]*SegmentInfo{}
-	toUpdate := newSegmentPacker("update", prioritizedCandidates, compactTime)
-	toMerge := newSegmentPacker("merge", smallCandidates, compactTime)
-
-	maxSegs := int64(4096) // Deprecate the max segment limit since it is irrelevant in simple compactions.
-	minSegs := Params.DataCoordCfg.MinSegmentToMerge.GetAsInt64()
-	compactableProportion := Params.DataCoordCfg.SegmentCompactableProportion.GetAsFloat()
-	satisfiedSize := int64(float64(expectedSize) * compactableProportion)
-	maxLeftSize := expectedSize - satisfiedSize
-	reasons := make([]string, 0)
-	// 1. Merge small segments if they can make a full bucket
-	for {
-		pack, left := toMerge.pack(expectedSize, maxLeftSize, minSegs, maxSegs)
-		if len(pack) == 0 {
-			break
-		}
-		reasons = append(reasons, fmt.Sprintf("merging %d small segments with left size %d", len(pack), left))
-		buckets = append(buckets, pack)
-	}
-
-	// 2. Pack prioritized candidates with small segments
-	// TODO the compaction selection policy should consider if compaction workload is high
-	for {
-		// No limit on the remaining size because we want to pack all prioritized candidates
-		pack, _ := toUpdate.packWith(expectedSize, math.MaxInt64, 0, maxSegs, toMerge)
-		if len(pack) == 0 {
-			break
-		}
-		reasons = append(reasons, fmt.Sprintf("packing %d prioritized segments", len(pack)))
-		buckets = append(buckets, pack)
-	}
-	// if there is any segment toUpdate left, its size must be greater than expectedSize, add it to the buckets
-	for _, s := range toUpdate.candidates {
-		buckets = append(buckets, []*SegmentInfo{s})
-		reasons = append(reasons, fmt.Sprintf("force packing prioritized segment %d", s.GetID()))
-	}
-
-	// 2.+ legacy: squeeze small segments
-	// Try merge all small segments, and then squeeze
-	for {
-		pack, _ := toMerge.pack(expectedSize, math.MaxInt64, minSegs, maxSegs)
-		if len(pack) == 0 {
-			break
-		}
-		reasons = append(reasons, fmt.Sprintf("packing all %d small segments", len(pack)))
-		buckets = append(buckets, pack)
-	}
-	smallRemaining := t.squeezeSmallSegmentsToBuckets(toMerge.candidates, buckets, expectedSize)
+	policy := getMixCompactionPolicy(coll)
+	buckets, reasons := policy.Select(newMixCompactionInput(t, coll, segments, signal, compactTime, expectedSize))
 
 	tasks := make([]*typeutil.Pair[int64, []int64], len(buckets))
 	for i, b := range buckets {
@@ -533,18 +474,10 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, signal *compa
 	if len(tasks) > 0 {
 		mlog.Info(context.TODO(), "generated nontrivial compaction tasks",
 			mlog.FieldCollectionID(signal.collectionID),
-			mlog.Int("prioritizedCandidates", len(prioritizedCandidates)),
-			mlog.Int("smallCandidates", len(smallCandidates)),
-			mlog.Int("nonPlannedSegments", len(nonPlannedSegments)),
+			mlog.String("policy", policy.Name()),
+			mlog.Int("candidates", len(segments)),
 			mlog.Strings("reasons", reasons))
 	}
-	if len(smallRemaining) > 0 {
-		mlog.RatedInfo(context.TODO(), rate.Limit(300), "remain small segments",
-			mlog.FieldCollectionID(signal.collectionID),
-			mlog.FieldPartitionID(signal.partitionID),
-			mlog.String("channel", signal.channel),
-			mlog.Int("smallRemainingCount", len(smallRemaining)))
-	}
 	return tasks
 }
 
@@ -739,7 +672,7 @@ func (t *compactionTrigger) ShouldCompactExpiryWithTTLField(compactTime *compact
 	expirationTime := percentiles[index]
 	// If current time (startTime) is greater than the expiration time at this percentile, trigger compaction
 	startTs := tsoutil.PhysicalTime(compactTime.startTime)
-	return startTs.UnixMicro() >= expirationTime && expirationTime > 0
+	return startTs.UnixMicro() >= expirationTime+compactTime.ttlFieldRetention.Microseconds() && expirationTime > 0
 }
 
 func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compactTime *compactTime) bool {
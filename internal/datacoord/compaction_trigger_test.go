@@ -3005,7 +3005,7 @@ func Test_compactionTrigger_generatePlans(t *testing.T) {
 				testingOnly:   true,
 			}
 
-			if got := tr.generatePlans(tt.args.segments, tt.args.signal, tt.args.compactTime, tt.args.expectedSize); !reflect.DeepEqual(got, tt.want) {
+			if got := tr.generatePlans(nil, tt.args.segments, tt.args.signal, tt.args.compactTime, tt.args.expectedSize); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("compactionTrigger.generatePlans() = %+v, want %+v", got, tt.want)
 			}
 		})
@@ -3301,7 +3301,7 @@ func Test_compactionTrigger_generatePlansByTime(t *testing.T) {
 				testingOnly:   true,
 			}
 
-			got := tr.generatePlans(tt.args.segments, tt.args.signal, tt.args.compactTime, tt.args.expectedSize)
+			got := tr.generatePlans(nil, tt.args.segments, tt.args.signal, tt.args.compactTime, tt.args.expectedSize)
 			for i, pair := range got {
 				t.Logf("got[%d]: totalRows=%d, segmentIDs=%v", i, pair.A, pair.B)
 			}
@@ -3370,6 +3370,17 @@ func Test_compactionTrigger_ShouldCompactExpiryWithTTLField(t *testing.T) {
 	ct = &compactTime{startTime: startTime, collectionTTL: 0}
 	shouldCompact = trigger.ShouldCompactExpiryWithTTLField(ct, segment2)
 	assert.False(t, shouldCompact)
+
+	// the quantiles are the event time if ttl field retention is set.
+	startTime = tsoutil.ComposeTSByTime(ts.Add(time.Minute), 0)
+	ct = &compactTime{startTime: startTime, ttlFieldRetention: time.Hour}
+	shouldCompact = trigger.ShouldCompactExpiryWithTTLField(ct, segment)
+	assert.False(t, shouldCompact)
+
+	startTime = tsoutil.ComposeTSByTime(ts.Add(2*time.Hour+time.Minute), 0)
+	ct = &compactTime{startTime: startTime, ttlFieldRetention: time.Hour}
+	shouldCompact = trigger.ShouldCompactExpiryWithTTLField(ct, segment)
+	assert.True(t, shouldCompact)
 }
 
 func Test_compactionTrigger_ShouldCompactExpiryWithTTLField_CommitTimestamp(t *testing.T) {
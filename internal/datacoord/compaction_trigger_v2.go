@@ -121,6 +121,54 @@ type CompactionPolicy interface {
 	Name() string
 }
 
+// CompactionPolicyWrapper wraps the built-in compaction policy of a ticker, custom builds could replace or decorate
+// the periodical L0, clustering, single, storage version upgrade and bump schema version triggers with it.
+// The manual triggers always use the built-in policies.
+type CompactionPolicyWrapper func(builtin CompactionPolicy) CompactionPolicy
+
+// SortCompactionPolicy triggers the sort compaction of the new flushed segment.
+type SortCompactionPolicy interface {
+	// TriggerSegmentSortCompaction returns the view to sort the segment, nil if the segment needs no sort compaction.
+	TriggerSegmentSortCompaction(ctx context.Context, segmentID int64) CompactionView
+}
+
+// SortCompactionPolicyWrapper wraps the built-in sort compaction policy.
+type SortCompactionPolicyWrapper func(builtin SortCompactionPolicy) SortCompactionPolicy
+
+type sortCompactionPolicyFunc func(ctx context.Context, segmentID int64) CompactionView
+
+func (f sortCompactionPolicyFunc) TriggerSegmentSortCompaction(ctx context.Context, segmentID int64) CompactionView {
+	return f(ctx, segmentID)
+}
+
+var compactionPolicyWrappers = struct {
+	sync.RWMutex
+	tickers map[TickerType]CompactionPolicyWrapper
+	sort    SortCompactionPolicyWrapper
+}{
+	tickers: make(map[TickerType]CompactionPolicyWrapper),
+}
+
+// RegisterCompactionPolicyWrapper registers the wrapper of the compaction policy of the ticker,
+// it takes effect on the CompactionTriggerManager created after the registration, nil removes the registered one.
+func RegisterCompactionPolicyWrapper(tickerType TickerType, wrapper CompactionPolicyWrapper) {
+	compactionPolicyWrappers.Lock()
+	defer compactionPolicyWrappers.Unlock()
+	if wrapper == nil {
+		delete(compactionPolicyWrappers.tickers, tickerType)
+		return
+	}
+	compactionPolicyWrappers.tickers[tickerType] = wrapper
+}
+
+// RegisterSortCompactionPolicyWrapper registers the wrapper of the sort compaction policy,
+// it takes effect on the CompactionTriggerManager created after the registration, nil removes the registered one.
+func RegisterSortCompactionPolicyWrapper(wrapper SortCompactionPolicyWrapper) {
+	compactionPolicyWrappers.Lock()
+	defer compactionPolicyWrappers.Unlock()
+	compactionPolicyWrappers.sort = wrapper
+}
+
 type TriggerManager interface {
 	Start()
 	Stop()
@@ -139,8 +187,9 @@ type CompactionTriggerManager struct {
 	handler   Handler
 	allocator allocator.Allocator
 
-	meta     *meta
-	policies map[TickerType]CompactionPolicy
+	meta       *meta
+	policies   map[TickerType]CompactionPolicy
+	sortPolicy SortCompactionPolicy
 
 	l0Policy                    *l0CompactionPolicy
 	clusteringPolicy            *clusteringCompactionPolicy
@@ -179,6 +228,18 @@ func NewCompactionTriggerManager(alloc allocator.Allocator, handler Handler, ins
 	m.policies[SingleTicker] = m.singlePolicy
 	m.policies[BumpSchemaVersionTicker] = m.bumpSchemaVersionPolicy
 	m.policies[StorageVersionTicker] = m.upgradeStorageVersionPolicy
+	m.sortPolicy = sortCompactionPolicyFunc(m.singlePolicy.triggerSegmentSortCompaction)
+
+	compactionPolicyWrappers.RLock()
+	defer compactionPolicyWrappers.RUnlock()
+	for tickerType, wrapper := range compactionPolicyWrappers.tickers {
+		if builtin, ok := m.policies[tickerType]; ok {
+			m.policies[tickerType] = wrapper(builtin)
+		}
+	}
+	if compactionPolicyWrappers.sort != nil {
+		m.sortPolicy = compactionPolicyWrappers.sort(m.sortPolicy)
+	}
 	return m
 }
 
@@ -245,7 +306,7 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 			m.handleTicker(ctx, BumpSchemaVersionTicker)
 		case segID := <-getStatsTaskChSingleton():
 			log.Info(ctx, "receive new segment to trigger sort compaction", mlog.Int64("segmentID", segID))
-			view := m.singlePolicy.triggerSegmentSortCompaction(ctx, segID)
+			view := m.sortPolicy.TriggerSegmentSortCompaction(ctx, segID)
 			if view == nil {
 				log.Warn(ctx, "segment no need to do sort compaction", mlog.Int64("segmentID", segID))
 				continue
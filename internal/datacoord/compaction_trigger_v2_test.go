@@ -231,6 +231,34 @@ func (s *CompactionTriggerManagerSuite) SetupTest() {
 	s.triggerManager = NewCompactionTriggerManager(s.mockAlloc, s.handler, s.inspector, s.meta, s.versionManager)
 }
 
+func (s *CompactionTriggerManagerSuite) TestRegisterCompactionPolicyWrapper() {
+	var wrappedL0 CompactionPolicy
+	RegisterCompactionPolicyWrapper(L0Ticker, func(builtin CompactionPolicy) CompactionPolicy {
+		wrappedL0 = builtin
+		return newClusteringCompactionPolicy(s.meta, s.mockAlloc, s.handler)
+	})
+	defer RegisterCompactionPolicyWrapper(L0Ticker, nil)
+	sortSegments := make([]int64, 0)
+	RegisterSortCompactionPolicyWrapper(func(builtin SortCompactionPolicy) SortCompactionPolicy {
+		return sortCompactionPolicyFunc(func(ctx context.Context, segmentID int64) CompactionView {
+			sortSegments = append(sortSegments, segmentID)
+			return nil
+		})
+	})
+	defer RegisterSortCompactionPolicyWrapper(nil)
+
+	m := NewCompactionTriggerManager(s.mockAlloc, s.handler, s.inspector, s.meta, s.versionManager)
+	s.Same(m.l0Policy, wrappedL0)
+	s.IsType(&clusteringCompactionPolicy{}, m.policies[L0Ticker])
+	s.Same(m.clusteringPolicy, m.policies[ClusteringTicker])
+	s.Nil(m.sortPolicy.TriggerSegmentSortCompaction(context.TODO(), 100))
+	s.Equal([]int64{100}, sortSegments)
+
+	// the manager created after the removal uses the built-in policies
+	m = NewCompactionTriggerManager(s.mockAlloc, s.handler, s.inspector, s.meta, s.versionManager)
+	s.Same(m.l0Policy, m.policies[L0Ticker])
+}
+
 func (s *CompactionTriggerManagerSuite) TestNotifyByViewIDLE() {
 	handler := NewNMockHandler(s.T())
 	handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(&collectionInfo{}, nil)
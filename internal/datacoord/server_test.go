@@ -30,6 +30,7 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/bytedance/mockey"
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -2229,6 +2230,9 @@ func TestDataCoordServer_UpdateChannelCheckpoint(t *testing.T) {
 		return ctx.Err()
 	})
 	b.EXPECT().GetLatestWALLocated(mock.Anything, mock.Anything).Return(1, true)
+	b.EXPECT().GetLatestWALLocatedBatch(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, pchannels []string) map[string]int64 {
+		return lo.SliceToMap(pchannels, func(pchannel string) (string, int64) { return pchannel, 1 })
+	})
 
 	balance.Register(b)
 	mockVChannel := "fake-by-dev-rootcoord-dml-1-testchannelcp-v0"
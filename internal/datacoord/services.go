@@ -1650,10 +1650,16 @@ func (s *Server) UpdateChannelCheckpoint(ctx context.Context, req *datapb.Update
 		return merr.Success(), nil
 	}
 
+	located, err := snmanager.StaticStreamingNodeManager.GetLatestWALLocatedBatch(ctx, lo.Map(req.GetChannelCheckpoints(), func(cp *msgpb.MsgPosition, _ int) string {
+		return cp.GetChannelName()
+	}))
+	if err != nil {
+		mlog.Warn(context.TODO(), "failed to get latest wal allocated", mlog.Err(err))
+		return merr.Status(err), nil
+	}
 	checkpoints := lo.Filter(req.GetChannelCheckpoints(), func(cp *msgpb.MsgPosition, _ int) bool {
 		channel := cp.GetChannelName()
-		targetID, err := snmanager.StaticStreamingNodeManager.GetLatestWALLocated(ctx, channel)
-		if err != nil || targetID != nodeID {
+		if targetID, ok := located[channel]; !ok || targetID != nodeID {
 			err := merr.WrapErrChannelNotFound(channel, fmt.Sprintf("for node %d", nodeID))
 			mlog.Warn(context.TODO(), "failed to get latest wal allocated", mlog.Err(err))
 			return false
@@ -1661,7 +1667,7 @@ func (s *Server) UpdateChannelCheckpoint(ctx context.Context, req *datapb.Update
 		return true
 	})
 
-	err := s.meta.UpdateChannelCheckpoints(ctx, checkpoints)
+	err = s.meta.UpdateChannelCheckpoints(ctx, checkpoints)
 	if err != nil {
 		mlog.Warn(context.TODO(), "failed to update channel checkpoint", mlog.Err(err))
 		return merr.Status(err), nil
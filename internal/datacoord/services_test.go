@@ -79,6 +79,9 @@ func (s *ServerSuite) SetupSuite() {
 		return ctx.Err()
 	})
 	b.EXPECT().GetLatestWALLocated(mock.Anything, mock.Anything).Return(0, true).Maybe()
+	b.EXPECT().GetLatestWALLocatedBatch(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, pchannels []string) map[string]int64 {
+		return lo.SliceToMap(pchannels, func(pchannel string) (string, int64) { return pchannel, 0 })
+	}).Maybe()
 	balance.Register(b)
 }
 
@@ -496,6 +496,15 @@ func serializeWrite(ctx context.Context, allocator allocator.Interface, writer *
 	return
 }
 
+// getTTLFieldRetention returns the retention of the ttl field of the schema, 0 if it's not set or invalid.
+func getTTLFieldRetention(schema *schemapb.CollectionSchema) time.Duration {
+	retention, err := common.GetTTLFieldRetention(schema.GetProperties())
+	if err != nil {
+		return 0
+	}
+	return retention
+}
+
 func getTTLFieldID(schema *schemapb.CollectionSchema) int64 {
 	ttlFieldName := ""
 	for _, pair := range schema.GetProperties() {
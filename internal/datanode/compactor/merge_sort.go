@@ -94,7 +94,8 @@ func mergeSortMultipleSegments(ctx context.Context,
 		if err != nil {
 			return nil, err
 		}
-		segmentFilters[i] = compaction.NewEntityFilter(delta, collectionTTL, currentTime, s.GetCommitTimestamp())
+		segmentFilters[i] = compaction.NewEntityFilter(delta, collectionTTL, currentTime, s.GetCommitTimestamp(),
+			compaction.WithTTLFieldRetention(getTTLFieldRetention(plan.GetSchema())))
 	}
 
 	var predicate func(r storage.Record, ri, i int) bool
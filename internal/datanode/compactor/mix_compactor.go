@@ -282,7 +282,8 @@ func (t *mixCompactionTask) writeSegment(ctx context.Context,
 		mlog.Warn(context.TODO(), "compact wrong, fail to merge deltalogs", mlog.Err(err))
 		return
 	}
-	entityFilter := compaction.NewEntityFilter(delta, t.plan.GetCollectionTtl(), t.currentTime, seg.GetCommitTimestamp())
+	entityFilter := compaction.NewEntityFilter(delta, t.plan.GetCollectionTtl(), t.currentTime, seg.GetCommitTimestamp(),
+		compaction.WithTTLFieldRetention(getTTLFieldRetention(t.plan.GetSchema())))
 
 	reader, existingFields, err := newCompactionSegmentRecordReader(ctx, seg, t.plan.GetSchema(), t.compactionParams.StorageConfig,
 		storage.WithCollectionID(t.collectionID),
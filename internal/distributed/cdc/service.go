@@ -19,7 +19,9 @@ package cdc
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	clientv3 "go.etcd.io/etcd/client/v3"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
@@ -27,9 +29,15 @@ import (
 	"github.com/milvus-io/milvus/internal/cdc"
 	"github.com/milvus-io/milvus/internal/cdc/replication/replicatemanager"
 	"github.com/milvus-io/milvus/internal/cdc/resource"
+	mix "github.com/milvus-io/milvus/internal/distributed/mixcoord/client"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/retry"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
@@ -38,8 +46,10 @@ type Server struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	cdcServer *cdc.CDCServer
-	etcdCli   *clientv3.Client
+	cdcServer    *cdc.CDCServer
+	etcdCli      *clientv3.Client
+	mixCoord     *syncutil.Future[types.MixCoordClient]
+	chunkManager storage.ChunkManager
 
 	componentState *componentutil.ComponentStateService
 	stopOnce       sync.Once
@@ -51,6 +61,7 @@ func NewServer(ctx context.Context) (*Server, error) {
 	return &Server{
 		ctx:            ctx1,
 		cancel:         cancel,
+		mixCoord:       syncutil.NewFuture[types.MixCoordClient](),
 		componentState: componentutil.NewComponentStateService(typeutil.CDCRole),
 		stopOnce:       sync.Once{},
 	}, nil
@@ -91,6 +102,13 @@ func (s *Server) stop() {
 	// Stop CDC service.
 	s.cdcServer.Stop()
 
+	// Stop mixCoord client.
+	if s.mixCoord.Ready() {
+		if err := s.mixCoord.Get().Close(); err != nil {
+			mlog.Warn(s.ctx, "cdc stop mixCoord client failed", mlog.Err(err))
+		}
+	}
+
 	// Don't close s.etcdCli here because it's a shared instance from kvfactory.
 	// The kvfactory.CloseEtcdClient() will be called in roles.go to close it properly.
 
@@ -115,15 +133,53 @@ func (s *Server) init() (err error) {
 	// Create etcd client.
 	s.etcdCli, _ = kvfactory.GetEtcdAndPath()
 
+	// Create the mixCoord client and the chunk manager to bootstrap the replication of the existing data.
+	s.initMixCoord()
+	if err := s.initChunkManager(); err != nil {
+		return err
+	}
+
 	// Create CDC service.
 	s.cdcServer = cdc.NewCDCServer(s.ctx)
 	resource.Init(
 		resource.OptETCD(s.etcdCli),
 		resource.OptReplicateManagerClient(replicatemanager.NewReplicateManager()),
+		resource.OptMixCoordClient(s.mixCoord),
+		resource.OptChunkManager(s.chunkManager),
 	)
 	return nil
 }
 
+func (s *Server) initMixCoord() {
+	go func() {
+		retry.Do(s.ctx, func() error {
+			mlog.Info(s.ctx, "cdc connect to mixCoord...")
+			mixCoord, err := mix.NewClient(s.ctx)
+			if err != nil {
+				return errors.Wrap(err, "cdc try to new mixCoord client failed")
+			}
+
+			err = componentutil.WaitForComponentHealthy(s.ctx, mixCoord, "mixCoord", 1000000, time.Millisecond*200)
+			if err != nil {
+				return errors.Wrap(err, "cdc wait for mixCoord ready failed")
+			}
+			mlog.Info(s.ctx, "cdc wait for mixCoord ready")
+			s.mixCoord.Set(mixCoord)
+			return nil
+		}, retry.AttemptAlways())
+	}()
+}
+
+func (s *Server) initChunkManager() (err error) {
+	mlog.Info(s.ctx, "cdc init chunk manager...")
+	manager, err := storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "cdc try to new chunk manager failed")
+	}
+	s.chunkManager = manager
+	return nil
+}
+
 func (s *Server) start() (err error) {
 	defer func() {
 		if err != nil {
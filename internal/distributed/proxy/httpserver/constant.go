@@ -63,6 +63,8 @@ const (
 	AdvancedSearchAction = "advanced_search"
 	HybridSearchAction   = "hybrid_search"
 
+	MultiCollectionSearchAction = "multi_collection_search"
+
 	UpdatePasswordAction            = "update_password"
 	GrantRoleAction                 = "grant_role"
 	RevokeRoleAction                = "revoke_role"
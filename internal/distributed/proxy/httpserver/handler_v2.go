@@ -32,6 +32,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/tidwall/gjson"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
@@ -104,14 +105,15 @@ var routeToMethod = map[string]string{ //nolint:gosec // not credentials, just a
 	"/v2/vectordb/databases/alter":            "AlterDatabase",
 	"/v2/vectordb/databases/alter_properties": "AlterDatabase",
 
-	"/v2/vectordb/entities/query":           "Query",
-	"/v2/vectordb/entities/get":             "Query",
-	"/v2/vectordb/entities/delete":          "Delete",
-	"/v2/vectordb/entities/insert":          "Insert",
-	"/v2/vectordb/entities/upsert":          "Upsert",
-	"/v2/vectordb/entities/search":          "Search",
-	"/v2/vectordb/entities/advanced_search": "HybridSearch",
-	"/v2/vectordb/entities/hybrid_search":   "HybridSearch",
+	"/v2/vectordb/entities/query":                   "Query",
+	"/v2/vectordb/entities/get":                     "Query",
+	"/v2/vectordb/entities/delete":                  "Delete",
+	"/v2/vectordb/entities/insert":                  "Insert",
+	"/v2/vectordb/entities/upsert":                  "Upsert",
+	"/v2/vectordb/entities/search":                  "Search",
+	"/v2/vectordb/entities/advanced_search":         "HybridSearch",
+	"/v2/vectordb/entities/hybrid_search":           "HybridSearch",
+	"/v2/vectordb/entities/multi_collection_search": "Search",
 
 	"/v2/vectordb/partitions/list":      "ShowPartitions",
 	"/v2/vectordb/partitions/has":       "HasPartition",
@@ -260,6 +262,12 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 			Limit: 100,
 		}
 	}, wrapperTraceLog(h.advancedSearch))), true))
+	// MultiCollectionSearch
+	router.POST(EntityCategory+MultiCollectionSearchAction, restfulSizeMiddleware(timeoutMiddleware(wrapperPost(func() any {
+		return &MultiCollectionSearchReq{
+			Limit: 100,
+		}
+	}, wrapperTraceLog(h.multiCollectionSearch))), true))
 
 	router.POST(PartitionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.listPartitions))))
 	router.POST(PartitionCategory+HasAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.hasPartitions))))
@@ -2002,6 +2010,209 @@ func (h *HandlersV2) advancedSearch(ctx context.Context, c *gin.Context, anyReq
 	return resp, err
 }
 
+// multiCollectionSearch fans out the same search to multiple collections and merges the results by the weighted scores.
+// It's only provided by the RESTful API: each collection is searched by an ordinary Search served by the querynodes,
+// and the results are merged on the proxy. The gRPC API is not provided until MilvusService defines the request.
+func (h *HandlersV2) multiCollectionSearch(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*MultiCollectionSearchReq)
+	if len(httpReq.Collections) == 0 {
+		err := merr.WrapErrParameterInvalidMsg("collections must be non-empty")
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	consistencyLevel, useDefaultConsistency, err := convertConsistencyLevel(httpReq.ConsistencyLevel)
+	if err != nil {
+		mlog.Warn(ctx, "high level restful api, search with consistency_level invalid", mlog.Err(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: "consistencyLevel can only be [Strong, Session, Bounded, Eventually, Customized], default: Bounded, err:" + err.Error(),
+		})
+		return nil, err
+	}
+	searchParams, err := generateSearchParams(httpReq.SearchParams)
+	if err != nil {
+		mlog.Warn(ctx, "high level restful api, generate SearchParams failed", mlog.Err(err))
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	searchParams = append(searchParams,
+		&commonpb.KeyValuePair{Key: common.TopKKey, Value: strconv.FormatInt(int64(httpReq.Limit), 10)},
+		&commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: httpReq.MetricType},
+		&commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField},
+	)
+
+	body, _ := c.Get(gin.BodyBytesKey)
+	schemas := make([]*schemapb.CollectionSchema, len(httpReq.Collections))
+	weights := make([]float32, len(httpReq.Collections))
+	reqs := make([]*milvuspb.SearchRequest, len(httpReq.Collections))
+	var annsField *schemapb.FieldSchema
+	for i, subReq := range httpReq.Collections {
+		weights[i] = 1
+		if subReq.Weight != nil {
+			weights[i] = *subReq.Weight
+		}
+		if weights[i] <= 0 {
+			err := merr.WrapErrParameterInvalidMsg("the weight of collection %s must be positive", subReq.CollectionName)
+			HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+			return nil, err
+		}
+		schemas[i], err = h.GetCollectionSchema(ctx, c, dbName, subReq.CollectionName)
+		if err != nil {
+			// has already throw http in GetCollectionSchema if fails to get schema
+			return nil, err
+		}
+		field, err := getAnnsField(schemas[i], httpReq.AnnsField)
+		if err == nil && annsField != nil && !isCompatibleVectorField(annsField, field) {
+			err = merr.WrapErrParameterInvalidMsg("the vector field %s of collection %s is not compatible with the one of collection %s",
+				field.GetName(), subReq.CollectionName, httpReq.Collections[0].CollectionName)
+		}
+		if err != nil {
+			HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+			return nil, err
+		}
+		annsField = field
+		placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), schemas[i], field.GetName())
+		if err != nil {
+			mlog.Warn(ctx, "high level restful api, search with vector invalid", mlog.Err(err))
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+				HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+			})
+			return nil, err
+		}
+		reqs[i] = &milvuspb.SearchRequest{
+			DbName:         dbName,
+			CollectionName: subReq.CollectionName,
+			PartitionNames: subReq.PartitionNames,
+			Dsl:            subReq.Filter,
+			DslType:        commonpb.DslType_BoolExprV1,
+			SearchInput: &milvuspb.SearchRequest_PlaceholderGroup{
+				PlaceholderGroup: placeholderGroup,
+			},
+			OutputFields:          httpReq.OutputFields,
+			SearchParams:          searchParams,
+			ConsistencyLevel:      consistencyLevel,
+			UseDefaultConsistency: useDefaultConsistency,
+		}
+		if _, err := CheckLimiter(ctx, reqs[i], h.proxy); err != nil {
+			mlog.Warn(ctx, "high level restful api, fail to check limiter", mlog.Err(err), mlog.String("collection", subReq.CollectionName))
+			HTTPAbortReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrHTTPRateLimit),
+				HTTPReturnMessage: merr.ErrHTTPRateLimit.Error() + ", error: " + err.Error(),
+			})
+			return nil, RestRequestInterceptorErr
+		}
+	}
+	c.Set(ContextRequest, reqs[0])
+
+	// fan out the search to the collections, the errors are returned once after all the searches are done
+	results := make([]*milvuspb.SearchResults, len(reqs))
+	g, gctx := errgroup.WithContext(ctx)
+	for i := range reqs {
+		g.Go(func() error {
+			resp, err := wrapperProxyWithLimit(gctx, c, reqs[i], h.checkAuth, true, "/milvus.proto.milvus.MilvusService/Search", false, nil, func(reqCtx context.Context, req any) (interface{}, error) {
+				return h.proxy.Search(reqCtx, req.(*milvuspb.SearchRequest))
+			})
+			if err != nil {
+				return err
+			}
+			results[i] = resp.(*milvuspb.SearchResults)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		HTTPAbortReturn(c, http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+
+	nq := int64(0)
+	cost := 0
+	allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+	rows := make([][]map[string]interface{}, len(results))
+	resultData := make([]*schemapb.SearchResultData, len(results))
+	for i, result := range results {
+		resultData[i] = result.GetResults()
+		nq = max(nq, resultData[i].GetNumQueries(), int64(len(resultData[i].GetTopks())))
+		cost += proxy.GetCostValue(result.GetStatus())
+		if resultData[i].GetTopK() == 0 {
+			continue
+		}
+		rows[i], err = buildQueryResp(0, resultData[i].GetOutputFields(), resultData[i].GetFieldsData(), resultData[i].GetIds(), resultData[i].GetScores(), allowJS, schemas[i])
+		if err != nil {
+			mlog.Warn(ctx, "high level restful api, fail to deal with search result", mlog.String("collection", httpReq.Collections[i].CollectionName), mlog.Err(err))
+			HTTPReturn(c, http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+				HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+			})
+			return nil, err
+		}
+	}
+	hits, err := proxy.MergeMultiCollectionSearchResults(resultData, weights, httpReq.MetricType, nq, int64(httpReq.Limit))
+	if err != nil {
+		mlog.Warn(ctx, "high level restful api, fail to merge search results of collections", mlog.Err(err))
+		HTTPReturn(c, http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+			HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+
+	outputData := make([]map[string]interface{}, 0)
+	topks := make([]int64, 0, len(hits))
+	for _, queryHits := range hits {
+		for _, hit := range queryHits {
+			row := rows[hit.CollectionIndex][hit.Offset]
+			row[HTTPCollectionName] = httpReq.Collections[hit.CollectionIndex].CollectionName
+			row[HTTPReturnDistance] = hit.Score
+			outputData = append(outputData, row)
+		}
+		topks = append(topks, int64(len(queryHits)))
+	}
+	HTTPReturnStream(c, http.StatusOK, gin.H{
+		HTTPReturnCode:  merr.Code(nil),
+		HTTPReturnData:  outputData,
+		HTTPReturnCost:  cost,
+		HTTPReturnTopks: topks,
+	})
+	return results, nil
+}
+
+// getAnnsField returns the vector field to search, the only vector field is used if the annsField is not specified.
+func getAnnsField(collSchema *schemapb.CollectionSchema, annsField string) (*schemapb.FieldSchema, error) {
+	var vectorField *schemapb.FieldSchema
+	for _, field := range collSchema.GetFields() {
+		if !typeutil.IsVectorType(field.GetDataType()) {
+			continue
+		}
+		if field.GetName() == annsField {
+			return field, nil
+		}
+		if annsField == "" {
+			if vectorField != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("search without annsField, but already found multiple vector fields: [%s, %s,,,]", vectorField.GetName(), field.GetName())
+			}
+			vectorField = field
+		}
+	}
+	if vectorField == nil {
+		return nil, merr.WrapErrFieldNotFound(annsField, "cannot find a vector field")
+	}
+	return vectorField, nil
+}
+
+// isCompatibleVectorField returns whether the vectors of the two fields could be searched by the same queries.
+func isCompatibleVectorField(a, b *schemapb.FieldSchema) bool {
+	if a.GetDataType() != b.GetDataType() {
+		return false
+	}
+	if typeutil.IsSparseFloatVectorType(a.GetDataType()) {
+		return true
+	}
+	dimA, errA := getDim(a)
+	dimB, errB := getDim(b)
+	return errA == nil && errB == nil && dimA == dimB
+}
+
 func (h *HandlersV2) createCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*CollectionReq)
 	req := &milvuspb.CreateCollectionRequest{
@@ -2344,6 +2555,7 @@ func (h *HandlersV2) createCollection(ctx context.Context, c *gin.Context, anyRe
 		common.WarmupScalarIndexKey,
 		common.WarmupVectorFieldKey,
 		common.WarmupVectorIndexKey,
+		common.WarmupPolicyKey,
 	} {
 		if _, ok := httpReq.Params[key]; ok {
 			req.Properties = append(req.Properties, &commonpb.KeyValuePair{
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -962,6 +963,82 @@ func TestHybridSearchWithRerank(t *testing.T) {
 	sendReqAndVerify(t, testEngine, queryTestCases.path, http.MethodPost, queryTestCases)
 }
 
+func TestMultiCollectionSearchV2(t *testing.T) {
+	paramtable.Init()
+	// disable rate limit
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.QuotaAndLimitsEnabled.Key)
+
+	mp := mocks.NewMockProxy(t)
+	testEngine := initHTTPServerV2(mp, false)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64, true, true),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil)
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		scores := []float32{0.9, 0.5, 0.1}
+		if req.GetCollectionName() == "book_2025" {
+			scores = []float32{0.8, 0.7, 0.6}
+		}
+		return &milvuspb.SearchResults{Status: commonSuccessStatus, Results: &schemapb.SearchResultData{
+			NumQueries:   1,
+			TopK:         int64(3),
+			Topks:        []int64{3},
+			OutputFields: []string{FieldWordCount},
+			FieldsData:   generateFieldData(),
+			Ids:          generateIDs(schemapb.DataType_Int64, 3),
+			Scores:       scores,
+		}}, nil
+	}).Twice()
+
+	path := versionalV2(EntityCategory, MultiCollectionSearchAction)
+	body := []byte(`{"collections": [{"collectionName": "book_2024"}, {"collectionName": "book_2025", "weight": 2}], "data": [[0.1, 0.2]], "metricType": "IP", "limit": 3, "outputFields": ["word_count"]}`)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	resp := struct {
+		Code  int32                    `json:"code"`
+		Data  []map[string]interface{} `json:"data"`
+		Topks []int64                  `json:"topks"`
+	}{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, int32(0), resp.Code)
+	assert.Equal(t, []int64{3}, resp.Topks)
+	assert.Len(t, resp.Data, 3)
+	for _, row := range resp.Data {
+		assert.Equal(t, "book_2025", row[HTTPCollectionName])
+	}
+	// the inner product is normalized by 0.5 + atan(score) / pi before weighting
+	assert.InDelta(t, 2*(0.5+math.Atan(0.8)/math.Pi), resp.Data[0][HTTPReturnDistance], 1e-5)
+
+	testCases := []requestBodyTestCase{
+		{
+			path:        path,
+			requestBody: []byte(`{"collections": [], "data": [[0.1, 0.2]], "metricType": "IP"}`),
+			errCode:     1100,
+			errMsg:      "collections must be non-empty",
+		},
+		{
+			path:        path,
+			requestBody: []byte(`{"collections": [{"collectionName": "book", "weight": 0}], "data": [[0.1, 0.2]], "metricType": "IP"}`),
+			errCode:     1100,
+			errMsg:      "the weight of collection book must be positive",
+		},
+		{
+			path:        path,
+			requestBody: []byte(`{"collections": [{"collectionName": "book"}], "data": [[0.1, 0.2]]}`),
+			errCode:     1802,
+			errMsg:      "missing required parameters",
+		},
+	}
+	for _, testcase := range testCases {
+		sendReqAndVerify(t, testEngine, testcase.path, http.MethodPost, testcase)
+	}
+}
+
 func TestDocInDocOutSearch(t *testing.T) {
 	paramtable.Init()
 	// disable rate limit
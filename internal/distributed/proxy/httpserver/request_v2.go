@@ -512,6 +512,29 @@ type HybridSearchReq struct {
 func (req *HybridSearchReq) GetDbName() string         { return req.DbName }
 func (req *HybridSearchReq) GetCollectionName() string { return req.CollectionName }
 
+// MultiCollectionSearchReq searches the same vectors on multiple collections with compatible vector fields,
+// and merges the results by the weighted scores.
+type MultiCollectionSearchReq struct {
+	DbName           string                        `json:"dbName"`
+	Collections      []MultiCollectionSearchSubReq `json:"collections" binding:"required"`
+	Data             []interface{}                 `json:"data" binding:"required"`
+	AnnsField        string                        `json:"annsField"`
+	MetricType       string                        `json:"metricType" binding:"required"`
+	Limit            int32                         `json:"limit"`
+	OutputFields     []string                      `json:"outputFields"`
+	SearchParams     map[string]interface{}        `json:"searchParams"`
+	ConsistencyLevel string                        `json:"consistencyLevel"`
+}
+
+func (req *MultiCollectionSearchReq) GetDbName() string { return req.DbName }
+
+type MultiCollectionSearchSubReq struct {
+	CollectionName string   `json:"collectionName" binding:"required"`
+	PartitionNames []string `json:"partitionNames"`
+	Filter         string   `json:"filter"`
+	Weight         *float32 `json:"weight"` // default to 1
+}
+
 type ReturnErrMsg struct {
 	Code    int32  `json:"code"`
 	Message string `json:"message"`
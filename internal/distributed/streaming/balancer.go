@@ -166,6 +166,53 @@ func (b balancerImpl) ResumeRebalance(ctx context.Context) error {
 	return err
 }
 
+// IsBalanceFrozen returns whether the automatic reassignment of the wal is frozen.
+func (b balancerImpl) IsBalanceFrozen(ctx context.Context) (bool, error) {
+	_, err := b.checkIfStreamingServiceReady(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	sbalancer := snmanager.StaticStreamingNodeManager.GetBalancer()
+	resp, err := sbalancer.UpdateBalancePolicy(ctx, &types.UpdateWALBalancePolicyRequest{
+		Config:     &streamingpb.WALBalancePolicyConfig{},
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetConfig().GetBalanceFrozen(), nil
+}
+
+// FreezeBalance freezes the automatic reassignment of the wal.
+func (b balancerImpl) FreezeBalance(ctx context.Context) error {
+	return b.updateBalanceFrozen(ctx, true)
+}
+
+// UnfreezeBalance unfreezes the automatic reassignment of the wal.
+func (b balancerImpl) UnfreezeBalance(ctx context.Context) error {
+	return b.updateBalanceFrozen(ctx, false)
+}
+
+// updateBalanceFrozen updates the balance frozen flag of the balancer.
+func (b balancerImpl) updateBalanceFrozen(ctx context.Context, frozen bool) error {
+	_, err := b.checkIfStreamingServiceReady(ctx)
+	if err != nil {
+		return err
+	}
+
+	sbalancer := snmanager.StaticStreamingNodeManager.GetBalancer()
+	_, err = sbalancer.UpdateBalancePolicy(ctx, &types.UpdateWALBalancePolicyRequest{
+		Config: &streamingpb.WALBalancePolicyConfig{
+			BalanceFrozen: frozen,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{types.UpdateMaskPathWALBalancePolicyBalanceFrozen},
+		},
+	})
+	return err
+}
+
 func (b balancerImpl) FreezeNodeIDs(ctx context.Context, nodeIDs []int64) error {
 	_, err := b.checkIfStreamingServiceReady(ctx)
 	if err != nil {
@@ -206,6 +253,50 @@ func (b balancerImpl) DefreezeNodeIDs(ctx context.Context, nodeIDs []int64) erro
 	return err
 }
 
+// RegisterPChannels registers the pchannels of wal on a running cluster.
+func (b balancerImpl) RegisterPChannels(ctx context.Context, pchannels []string) error {
+	_, err := b.checkIfStreamingServiceReady(ctx)
+	if err != nil {
+		return err
+	}
+
+	return snmanager.StaticStreamingNodeManager.GetBalancer().RegisterPChannels(ctx, pchannels)
+}
+
+// GetPChannelsView returns the current view of all pchannels of wal.
+func (b balancerImpl) GetPChannelsView(ctx context.Context) (*balancer.PChannelView, error) {
+	_, err := b.checkIfStreamingServiceReady(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return snmanager.StaticStreamingNodeManager.GetBalancer().GetPChannelsView(ctx)
+}
+
+// DisableStreaming rolls back the streaming service to the legacy path.
+func (b balancerImpl) DisableStreaming(ctx context.Context) error {
+	_, err := b.checkIfStreamingServiceReady(ctx)
+	if err != nil {
+		// the streaming service is not enabled, nothing to roll back.
+		if errors.Is(err, snmanager.ErrStreamingServiceNotReady) {
+			return nil
+		}
+		return err
+	}
+
+	return snmanager.StaticStreamingNodeManager.GetBalancer().DisableStreaming(ctx)
+}
+
+// PreviewRebalance returns the wal moves that the next balance round would apply.
+func (b balancerImpl) PreviewRebalance(ctx context.Context) ([]balancer.RebalanceMove, error) {
+	_, err := b.checkIfStreamingServiceReady(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return snmanager.StaticStreamingNodeManager.GetBalancer().PreviewRebalance(ctx)
+}
+
 func (b balancerImpl) checkIfStreamingServiceReady(ctx context.Context) (bool, error) {
 	if !paramtable.IsLocalComponentEnabled(typeutil.MixCoordRole) {
 		panic("should be only called at mix coord")
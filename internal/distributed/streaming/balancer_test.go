@@ -96,6 +96,12 @@ func TestBalancer(t *testing.T) {
 	assert.NoError(t, err)
 	_, err = balancer.IsRebalanceSuspended(context.Background())
 	assert.NoError(t, err)
+	err = balancer.FreezeBalance(context.Background())
+	assert.NoError(t, err)
+	err = balancer.UnfreezeBalance(context.Background())
+	assert.NoError(t, err)
+	_, err = balancer.IsBalanceFrozen(context.Background())
+	assert.NoError(t, err)
 
 	sbalancer.EXPECT().UpdateBalancePolicy(mock.Anything, mock.Anything).Unset()
 	sbalancer.EXPECT().UpdateBalancePolicy(mock.Anything, mock.Anything).Return(nil, errors.New("test"))
@@ -111,6 +117,10 @@ func TestBalancer(t *testing.T) {
 	assert.Error(t, err)
 	_, err = balancer.IsRebalanceSuspended(context.Background())
 	assert.Error(t, err)
+	err = balancer.FreezeBalance(context.Background())
+	assert.Error(t, err)
+	_, err = balancer.IsBalanceFrozen(context.Background())
+	assert.Error(t, err)
 
 	sbalancer.EXPECT().RegisterStreamingEnabledNotifier(mock.Anything).Unset()
 	sbalancer.EXPECT().RegisterStreamingEnabledNotifier(mock.Anything).RunAndReturn(func(notifier *syncutil.AsyncTaskNotifier[struct{}]) {
@@ -0,0 +1,482 @@
+package streaming
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/compaction"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const (
+	catchupSegmentInfoBatchSize = 1000
+
+	// defaultCatchupBatchSize is the default memory size of the data served in one CatchupData.
+	defaultCatchupBatchSize = 16 * 1024 * 1024
+)
+
+// ErrSnapshotNotReady is returned if the checkpoint of the vchannel snapshot doesn't cover the requested time tick yet,
+// the caller should flush the vchannel and retry.
+var ErrSnapshotNotReady = errors.New("snapshot of vchannel is not ready")
+
+// VChannelSnapshot is the historical data of a vchannel persisted in the object storage.
+type VChannelSnapshot struct {
+	VChannel string
+
+	// Checkpoint is the position of the wal, all the data of the vchannel before it is persisted in the segments.
+	// The live wal consumption is started from it.
+	Checkpoint *msgpb.MsgPosition
+
+	// Segments are the segments holding the data before the checkpoint, the binlog paths are decompressed.
+	Segments []*datapb.SegmentInfo
+}
+
+// SnapshotSource is the source of the historical data of a vchannel.
+type SnapshotSource interface {
+	// GetSnapshot returns the snapshot of the vchannel.
+	GetSnapshot(ctx context.Context, collectionID int64, vchannel string) (*VChannelSnapshot, error)
+}
+
+// RecoveryInfoClient is the client to fetch the recovery info of the collection, it's implemented by the datacoord client.
+type RecoveryInfoClient interface {
+	GetRecoveryInfoV2(ctx context.Context, in *datapb.GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error)
+	GetSegmentInfo(ctx context.Context, in *datapb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error)
+}
+
+// NewRecoveryInfoSnapshotSource creates a snapshot source from the recovery info of datacoord,
+// which is the same view used by the querynode to watch a vchannel.
+func NewRecoveryInfoSnapshotSource(c RecoveryInfoClient) SnapshotSource {
+	return &recoveryInfoSnapshotSource{c: c}
+}
+
+type recoveryInfoSnapshotSource struct {
+	c RecoveryInfoClient
+}
+
+// GetSnapshot returns the snapshot of the vchannel.
+func (s *recoveryInfoSnapshotSource) GetSnapshot(ctx context.Context, collectionID int64, vchannel string) (*VChannelSnapshot, error) {
+	resp, err := s.c.GetRecoveryInfoV2(ctx, &datapb.GetRecoveryInfoRequestV2{CollectionID: collectionID})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	var info *datapb.VchannelInfo
+	for _, ch := range resp.GetChannels() {
+		if ch.GetChannelName() == vchannel {
+			info = ch
+			break
+		}
+	}
+	if info == nil {
+		return nil, merr.WrapErrChannelNotFound(vchannel, "vchannel not found in recovery info")
+	}
+	if info.GetSeekPosition() == nil {
+		return nil, errors.Errorf("vchannel %s has no checkpoint in recovery info", vchannel)
+	}
+
+	segmentIDs := make([]int64, 0, len(info.GetFlushedSegmentIds())+len(info.GetUnflushedSegmentIds())+len(info.GetLevelZeroSegmentIds()))
+	segmentIDs = append(segmentIDs, info.GetFlushedSegmentIds()...)
+	segmentIDs = append(segmentIDs, info.GetUnflushedSegmentIds()...)
+	segmentIDs = append(segmentIDs, info.GetLevelZeroSegmentIds()...)
+	segments, err := s.getSegmentInfo(ctx, segmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &VChannelSnapshot{
+		VChannel:   vchannel,
+		Checkpoint: info.GetSeekPosition(),
+		Segments:   segments,
+	}, nil
+}
+
+// getSegmentInfo gets the segment infos with the decompressed binlog paths.
+func (s *recoveryInfoSnapshotSource) getSegmentInfo(ctx context.Context, segmentIDs []int64) ([]*datapb.SegmentInfo, error) {
+	segments := make([]*datapb.SegmentInfo, 0, len(segmentIDs))
+	for start := 0; start < len(segmentIDs); start += catchupSegmentInfoBatchSize {
+		end := min(start+catchupSegmentInfoBatchSize, len(segmentIDs))
+		resp, err := s.c.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			SegmentIDs:       segmentIDs[start:end],
+			IncludeUnHealthy: true,
+		})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return nil, err
+		}
+		if err := binlog.DecompressMultiBinLogs(resp.GetInfos()); err != nil {
+			return nil, err
+		}
+		segments = append(segments, resp.GetInfos()...)
+	}
+	return segments, nil
+}
+
+// CatchupData is a batch of the historical data of a vchannel read from the binlogs.
+// A batch holds either the deletes or the inserts, never both.
+type CatchupData struct {
+	// PartitionID is the partition of the data, common.AllPartitionsID for the deletes of the L0 segments.
+	PartitionID int64
+
+	// SegmentID is the segment the inserts are read from, 0 for the deletes.
+	SegmentID int64
+
+	// Inserts are the rows not consumed and not deleted before the served time tick, the system fields are included.
+	Inserts *storage.InsertData
+
+	// Deletes are the deletes not consumed before the served time tick, the duplicated deletes across segments are removed.
+	Deletes *storage.DeleteData
+}
+
+// CatchupReadOption is the option for the catch-up read.
+type CatchupReadOption struct {
+	// CollectionID is the collection of the vchannel.
+	CollectionID int64
+
+	// VChannel is the target vchannel to read.
+	VChannel string
+
+	// Schema is the schema to read the binlogs of the vchannel.
+	Schema *schemapb.CollectionSchema
+
+	// Checkpoint is the position to resume the consumption from, same semantic as the seek position of msgstream,
+	// so all the data before its timestamp is consumed. nil if the consumer is new.
+	Checkpoint *msgpb.MsgPosition
+
+	// BatchSize is the max memory size of one CatchupData, 16MB if not set.
+	BatchSize int
+
+	// SnapshotHandler handles the historical data read from the binlogs in the object storage.
+	// It's called before the live wal consumption is started if the checkpoint is nil or older than the checkpoint of the snapshot.
+	// All the deletes are handled before the inserts.
+	SnapshotHandler func(ctx context.Context, data *CatchupData) error
+
+	// DeliverFilters is the extra deliver filters of the live wal consumption.
+	DeliverFilters []options.DeliverFilter
+
+	// MessageHandler handles the messages of the live wal consumption.
+	MessageHandler message.Handler
+
+	// PreferReadReplica is the flag to allow the live wal consumption to read from the read-only replica of the pchannel.
+	PreferReadReplica bool
+}
+
+// CatchupReader serves the historical data of a vchannel from the flushed binlogs in the object storage,
+// and then switches to the live wal consumption at the checkpoint boundary.
+// So the long-lagging consumers (new replicas, CDC) can be served without infinite retention of the wal.
+//
+// The data is deduplicated by row but not by segment, because the compaction rewrites the consumed rows into new segments:
+// an insert is served if its timestamp is after the checkpoint of consumer and it's not deleted before the served time tick,
+// a delete is served if its timestamp is after the checkpoint of consumer and the consumer is not new.
+// The deletes already applied and dropped by the compaction are not served,
+// so a lagging consumer may keep the rows inserted before its checkpoint and deleted after it if the compaction happens in between.
+type CatchupReader struct {
+	source        SnapshotSource
+	cm            storage.ChunkManager
+	storageConfig *indexpb.StorageConfig
+}
+
+// NewCatchupReader creates a new catch-up reader, the binlogs are read by the chunk manager.
+func NewCatchupReader(source SnapshotSource, cm storage.ChunkManager) *CatchupReader {
+	return &CatchupReader{
+		source:        source,
+		cm:            cm,
+		storageConfig: compaction.CreateStorageConfig(),
+	}
+}
+
+// ReadSnapshot serves the data of the vchannel after the checkpoint of the option and not after the until time tick from the binlogs.
+// ErrSnapshotNotReady is returned if the checkpoint of the snapshot is not after the until time tick,
+// the caller should flush the vchannel and retry.
+func (r *CatchupReader) ReadSnapshot(ctx context.Context, opts CatchupReadOption, until uint64) (*VChannelSnapshot, error) {
+	snapshot, err := r.source.GetSnapshot(ctx, opts.CollectionID, opts.VChannel)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get snapshot of vchannel %s", opts.VChannel)
+	}
+	if snapshot.Checkpoint.GetTimestamp() <= until {
+		return nil, errors.Wrapf(ErrSnapshotNotReady, "vchannel %s, checkpoint %d, until %d", opts.VChannel, snapshot.Checkpoint.GetTimestamp(), until)
+	}
+	if err := r.serveSnapshot(ctx, snapshot, opts, until); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Read reads the vchannel from the checkpoint of the option.
+// The snapshot handler is called synchronously before the returned scanner is created.
+func (r *CatchupReader) Read(ctx context.Context, opts CatchupReadOption) (Scanner, error) {
+	snapshot, err := r.source.GetSnapshot(ctx, opts.CollectionID, opts.VChannel)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get snapshot of vchannel %s", opts.VChannel)
+	}
+
+	startFrom := opts.Checkpoint
+	if startFrom == nil || startFrom.GetTimestamp() < snapshot.Checkpoint.GetTimestamp() {
+		// the wal before the checkpoint of snapshot may be truncated, so the historical data is served from the object storage.
+		// the messages at the checkpoint of snapshot are not persisted, so they're served by the wal.
+		if err := r.serveSnapshot(ctx, snapshot, opts, snapshot.Checkpoint.GetTimestamp()-1); err != nil {
+			return nil, err
+		}
+		startFrom = snapshot.Checkpoint
+	}
+	mlog.Info(ctx, "catch-up reader switches to wal",
+		mlog.String("vchannel", opts.VChannel),
+		mlog.Bool("fromSnapshot", startFrom == snapshot.Checkpoint),
+		mlog.Uint64("timestamp", startFrom.GetTimestamp()))
+
+	startMessageID := adaptor.MustGetMessageIDFromMQWrapperIDBytesWithWALName(message.WALName(startFrom.GetWALName()), startFrom.GetMsgID())
+	deliverFilters := make([]options.DeliverFilter, 0, len(opts.DeliverFilters)+1)
+	deliverFilters = append(deliverFilters, options.DeliverFilterTimeTickGTE(startFrom.GetTimestamp()))
+	deliverFilters = append(deliverFilters, opts.DeliverFilters...)
+	return WAL().Read(ctx, ReadOption{
+		VChannel:          opts.VChannel,
+		DeliverPolicy:     options.DeliverPolicyStartFrom(startMessageID),
+		DeliverFilters:    deliverFilters,
+		MessageHandler:    opts.MessageHandler,
+		PreferReadReplica: opts.PreferReadReplica,
+	}), nil
+}
+
+// serveSnapshot serves the data of the snapshot in the time range (consumed, until] to the snapshot handler.
+func (r *CatchupReader) serveSnapshot(ctx context.Context, snapshot *VChannelSnapshot, opts CatchupReadOption, until uint64) error {
+	// the data before the timestamp of the checkpoint is consumed.
+	consumed := uint64(0)
+	if opts.Checkpoint != nil && opts.Checkpoint.GetTimestamp() > 0 {
+		consumed = opts.Checkpoint.GetTimestamp() - 1
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultCatchupBatchSize
+	}
+	schema := opts.Schema
+	if !hasSystemFields(schema) {
+		schema = typeutil.AppendSystemFields(schema)
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	segments := make([]*datapb.SegmentInfo, len(snapshot.Segments))
+	copy(segments, snapshot.Segments)
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+
+	deletes, err := r.readDeletes(ctx, pkField.GetDataType(), segments, until)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read deletes of vchannel %s", snapshot.VChannel)
+	}
+	if consumed > 0 {
+		// a new consumer has no rows to delete, the deleted rows are just skipped.
+		if err := deletes.serve(ctx, consumed, batchSize, opts.SnapshotHandler); err != nil {
+			return errors.Wrapf(err, "failed to handle deletes of vchannel %s", snapshot.VChannel)
+		}
+	}
+	for _, segment := range segments {
+		if segment.GetLevel() == datapb.SegmentLevel_L0 {
+			continue
+		}
+		if err := r.serveInserts(ctx, schema, segment, consumed, until, deletes.latest, batchSize, opts.SnapshotHandler); err != nil {
+			return errors.Wrapf(err, "failed to handle inserts of segment %d of vchannel %s", segment.GetID(), snapshot.VChannel)
+		}
+	}
+	mlog.Info(ctx, "catch-up reader serves snapshot done",
+		mlog.String("vchannel", snapshot.VChannel),
+		mlog.Int("segments", len(segments)),
+		mlog.Uint64("consumed", consumed),
+		mlog.Uint64("until", until))
+	return nil
+}
+
+// catchupDeletes is the deletes of a snapshot not after the served time tick.
+type catchupDeletes struct {
+	// latest is the timestamp of the latest delete of the primary key.
+	latest map[any]uint64
+	// partitions is the deduplicated deletes of each partition, kept in the order of the segments.
+	partitions   map[int64]*storage.DeleteData
+	partitionIDs []int64
+}
+
+// readDeletes reads the deletes not after the until time tick from the deltalogs of all the segments.
+func (r *CatchupReader) readDeletes(ctx context.Context, pkType schemapb.DataType, segments []*datapb.SegmentInfo, until uint64) (*catchupDeletes, error) {
+	deletes := &catchupDeletes{
+		latest:     make(map[any]uint64),
+		partitions: make(map[int64]*storage.DeleteData),
+	}
+	type deleteKey struct {
+		pk any
+		ts uint64
+	}
+	seen := make(map[deleteKey]struct{})
+	for _, segment := range segments {
+		if len(segment.GetDeltalogs()) == 0 && segment.GetManifestPath() == "" {
+			continue
+		}
+		data, err := compaction.ComposeDeleteDataFromDeltalogs(ctx, pkType, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segment.GetID(),
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			Deltalogs:    segment.GetDeltalogs(),
+			Manifest:     segment.GetManifestPath(),
+		}, storage.WithDownloader(r.cm.MultiRead), storage.WithStorageConfig(r.storageConfig))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read deltalogs of segment %d", segment.GetID())
+		}
+		for i, pk := range data.Pks {
+			ts := data.Tss[i]
+			if ts > until {
+				continue
+			}
+			// the same delete may be kept by both the L0 segment and the compacted segment.
+			key := deleteKey{pk: pk.GetValue(), ts: ts}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			if ts > deletes.latest[key.pk] {
+				deletes.latest[key.pk] = ts
+			}
+			partition, ok := deletes.partitions[segment.GetPartitionID()]
+			if !ok {
+				partition = storage.NewDeleteData(nil, nil)
+				deletes.partitions[segment.GetPartitionID()] = partition
+				deletes.partitionIDs = append(deletes.partitionIDs, segment.GetPartitionID())
+			}
+			partition.Append(pk, ts)
+		}
+	}
+	return deletes, nil
+}
+
+// serve serves the deletes after the consumed time tick in batches.
+func (d *catchupDeletes) serve(ctx context.Context, consumed uint64, batchSize int, handler func(ctx context.Context, data *CatchupData) error) error {
+	for _, partitionID := range d.partitionIDs {
+		partition := d.partitions[partitionID]
+		batch := storage.NewDeleteData(nil, nil)
+		for i, pk := range partition.Pks {
+			if partition.Tss[i] <= consumed {
+				continue
+			}
+			batch.Append(pk, partition.Tss[i])
+			if batch.Size() >= int64(batchSize) {
+				if err := handler(ctx, &CatchupData{PartitionID: partitionID, Deletes: batch}); err != nil {
+					return err
+				}
+				batch = storage.NewDeleteData(nil, nil)
+			}
+		}
+		if batch.RowCount > 0 {
+			if err := handler(ctx, &CatchupData{PartitionID: partitionID, Deletes: batch}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// serveInserts serves the rows of the segment in the time range (consumed, until] which are not deleted before until.
+func (r *CatchupReader) serveInserts(
+	ctx context.Context,
+	schema *schemapb.CollectionSchema,
+	segment *datapb.SegmentInfo,
+	consumed uint64,
+	until uint64,
+	deleted map[any]uint64,
+	batchSize int,
+	handler func(ctx context.Context, data *CatchupData) error,
+) error {
+	if len(segment.GetBinlogs()) == 0 && segment.GetManifestPath() == "" {
+		return nil
+	}
+	rr, err := r.newSegmentRecordReader(ctx, schema, segment)
+	if err != nil {
+		return err
+	}
+	dr := storage.NewDeserializeReader(rr, func(record storage.Record, v []*storage.Value) error {
+		return storage.ValueDeserializerWithSchema(record, v, schema, true)
+	})
+	defer dr.Close()
+
+	newBatch := func() (*CatchupData, error) {
+		inserts, err := storage.NewInsertDataWithFunctionOutputField(schema)
+		if err != nil {
+			return nil, err
+		}
+		return &CatchupData{PartitionID: segment.GetPartitionID(), SegmentID: segment.GetID(), Inserts: inserts}, nil
+	}
+	batch, err := newBatch()
+	if err != nil {
+		return err
+	}
+	for {
+		v, err := dr.NextValue()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		row := (*v).Value.(map[storage.FieldID]interface{})
+		ts := uint64((*v).Timestamp)
+		if commitTs := segment.GetCommitTimestamp(); commitTs != 0 {
+			// the rows of the imported segment are visible at the commit timestamp.
+			ts = commitTs
+			row[common.TimeStampField] = int64(commitTs)
+		}
+		if ts <= consumed || ts > until {
+			continue
+		}
+		if deleteTs, ok := deleted[(*v).PK.GetValue()]; ok && deleteTs > ts {
+			continue
+		}
+		if err := batch.Inserts.Append(row); err != nil {
+			return err
+		}
+		if batch.Inserts.GetMemorySize() >= batchSize {
+			if err := handler(ctx, batch); err != nil {
+				return err
+			}
+			if batch, err = newBatch(); err != nil {
+				return err
+			}
+		}
+	}
+	if batch.Inserts.GetRowNum() > 0 {
+		return handler(ctx, batch)
+	}
+	return nil
+}
+
+// newSegmentRecordReader creates the record reader of the insert binlogs of the segment.
+func (r *CatchupReader) newSegmentRecordReader(ctx context.Context, schema *schemapb.CollectionSchema, segment *datapb.SegmentInfo) (storage.RecordReader, error) {
+	rwOptions := []storage.RwOption{
+		storage.WithVersion(segment.GetStorageVersion()),
+		storage.WithDownloader(r.cm.MultiRead),
+		storage.WithStorageConfig(r.storageConfig),
+		storage.WithCollectionID(segment.GetCollectionID()),
+	}
+	if segment.GetManifestPath() != "" {
+		return storage.NewManifestRecordReader(ctx, segment.GetManifestPath(), schema, rwOptions...)
+	}
+	return storage.NewBinlogRecordReader(ctx, segment.GetBinlogs(), schema, rwOptions...)
+}
+
+// hasSystemFields checks if the schema has the system fields.
+func hasSystemFields(schema *schemapb.CollectionSchema) bool {
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() == common.RowIDField {
+			return true
+		}
+	}
+	return false
+}
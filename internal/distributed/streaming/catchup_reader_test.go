@@ -0,0 +1,286 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mq/mqimpl/rocksmq/server"
+	"github.com/milvus-io/milvus/pkg/v3/objectstorage"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type readRecordWALAccesser struct {
+	noopWALAccesser
+	opts []ReadOption
+}
+
+func (w *readRecordWALAccesser) Read(ctx context.Context, opts ReadOption) Scanner {
+	w.opts = append(w.opts, opts)
+	return &noopScanner{}
+}
+
+func newRmqPosition(id int64, ts uint64) *msgpb.MsgPosition {
+	return &msgpb.MsgPosition{
+		ChannelName: "v1",
+		MsgID:       server.SerializeRmqID(id),
+		Timestamp:   ts,
+		WALName:     commonpb.WALName_RocksMQ,
+	}
+}
+
+func TestRecoveryInfoSnapshotSource(t *testing.T) {
+	c := mocks.NewMockDataCoordClient(t)
+	c.EXPECT().GetRecoveryInfoV2(mock.Anything, mock.Anything).Return(&datapb.GetRecoveryInfoResponseV2{
+		Status: merr.Success(),
+		Channels: []*datapb.VchannelInfo{
+			{ChannelName: "v0", SeekPosition: newRmqPosition(1, 1)},
+			{
+				ChannelName:         "v1",
+				SeekPosition:        newRmqPosition(10, 100),
+				FlushedSegmentIds:   []int64{1, 2},
+				UnflushedSegmentIds: []int64{3},
+				LevelZeroSegmentIds: []int64{4},
+			},
+		},
+	}, nil)
+	c.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *datapb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error) {
+			assert.ElementsMatch(t, []int64{1, 2, 3, 4}, req.GetSegmentIDs())
+			infos := make([]*datapb.SegmentInfo, 0, len(req.GetSegmentIDs()))
+			for _, id := range req.GetSegmentIDs() {
+				infos = append(infos, &datapb.SegmentInfo{ID: id, InsertChannel: "v1"})
+			}
+			return &datapb.GetSegmentInfoResponse{Status: merr.Success(), Infos: infos}, nil
+		})
+
+	source := NewRecoveryInfoSnapshotSource(c)
+	snapshot, err := source.GetSnapshot(context.Background(), 1, "v1")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", snapshot.VChannel)
+	assert.Equal(t, uint64(100), snapshot.Checkpoint.GetTimestamp())
+	assert.Len(t, snapshot.Segments, 4)
+
+	snapshot, err = source.GetSnapshot(context.Background(), 1, "v2")
+	assert.True(t, errors.Is(err, merr.ErrChannelNotFound))
+	assert.Nil(t, snapshot)
+}
+
+type staticSnapshotSource struct {
+	snapshot *VChannelSnapshot
+	err      error
+}
+
+func (s *staticSnapshotSource) GetSnapshot(ctx context.Context, collectionID int64, vchannel string) (*VChannelSnapshot, error) {
+	return s.snapshot, s.err
+}
+
+var catchupTestSchema = &schemapb.CollectionSchema{
+	Name: "test",
+	Fields: []*schemapb.FieldSchema{
+		{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+		{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+		{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+	},
+}
+
+// writeInsertBinlogs writes the rows of (pk, ts) into the v1 insert binlogs of the segment.
+func writeInsertBinlogs(t *testing.T, cm storage.ChunkManager, segmentID int64, rows [][2]int64) []*datapb.FieldBinlog {
+	data, err := storage.NewInsertData(catchupTestSchema)
+	require.NoError(t, err)
+	for _, row := range rows {
+		require.NoError(t, data.Append(map[storage.FieldID]interface{}{
+			common.RowIDField:     row[0],
+			common.TimeStampField: row[1],
+			100:                   row[0],
+			101:                   []float32{float32(row[0]), 0},
+		}))
+	}
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: 1, Schema: catchupTestSchema})
+	blobs, err := codec.Serialize(10, segmentID, data)
+	require.NoError(t, err)
+	binlogs := make([]*datapb.FieldBinlog, 0, len(blobs))
+	for _, blob := range blobs {
+		var fieldID int64
+		_, err := fmt.Sscanf(blob.GetKey(), "%d", &fieldID)
+		require.NoError(t, err)
+		logPath := path.Join(cm.RootPath(), "insert_log", fmt.Sprint(segmentID), blob.GetKey())
+		require.NoError(t, cm.Write(context.Background(), logPath, blob.GetValue()))
+		binlogs = append(binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath, EntriesNum: int64(len(rows))}},
+		})
+	}
+	return binlogs
+}
+
+// writeDeltalogs writes the deletes of (pk, ts) into the v1 deltalog of the segment.
+func writeDeltalogs(t *testing.T, cm storage.ChunkManager, partitionID, segmentID int64, deletes [][2]int64) []*datapb.FieldBinlog {
+	data := storage.NewDeleteData(nil, nil)
+	for _, d := range deletes {
+		data.Append(storage.NewInt64PrimaryKey(d[0]), uint64(d[1]))
+	}
+	blob, err := storage.NewDeleteCodec().Serialize(1, partitionID, segmentID, data)
+	require.NoError(t, err)
+	logPath := path.Join(cm.RootPath(), "delta_log", fmt.Sprint(segmentID))
+	require.NoError(t, cm.Write(context.Background(), logPath, blob.GetValue()))
+	return []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: logPath, EntriesNum: int64(len(deletes))}}}}
+}
+
+// catchupRecorder records the data served by the catch-up reader.
+type catchupRecorder struct {
+	inserts map[int64]int64
+	deletes map[int64]uint64
+	order   []string
+}
+
+func newCatchupRecorder() *catchupRecorder {
+	return &catchupRecorder{inserts: make(map[int64]int64), deletes: make(map[int64]uint64)}
+}
+
+func (r *catchupRecorder) handle(ctx context.Context, data *CatchupData) error {
+	if data.Deletes != nil {
+		r.order = append(r.order, "delete")
+		for i, pk := range data.Deletes.Pks {
+			r.deletes[pk.GetValue().(int64)] = data.Deletes.Tss[i]
+		}
+		return nil
+	}
+	r.order = append(r.order, "insert")
+	pks := data.Inserts.Data[100].(*storage.Int64FieldData).Data
+	tss := data.Inserts.Data[common.TimeStampField].(*storage.Int64FieldData).Data
+	for i, pk := range pks {
+		r.inserts[pk] = tss[i]
+	}
+	return nil
+}
+
+func TestCatchupReader(t *testing.T) {
+	paramtable.Init()
+	w := &readRecordWALAccesser{}
+	SetWALForTest(w)
+	defer SetupNoopWALForTest()
+
+	cm := storage.NewLocalChunkManager(objectstorage.RootPath(t.TempDir()))
+	snapshot := &VChannelSnapshot{
+		VChannel:   "v1",
+		Checkpoint: newRmqPosition(10, 100),
+		Segments: []*datapb.SegmentInfo{
+			{
+				ID: 1, CollectionID: 1, PartitionID: 10, Level: datapb.SegmentLevel_L1,
+				Binlogs: writeInsertBinlogs(t, cm, 1, [][2]int64{{1, 10}, {2, 20}, {3, 30}, {4, 40}}),
+			},
+			{
+				// the compacted segment keeps the rows consumed by the lagging consumer.
+				ID: 2, CollectionID: 1, PartitionID: 10, Level: datapb.SegmentLevel_L2,
+				Binlogs:   writeInsertBinlogs(t, cm, 2, [][2]int64{{5, 15}, {6, 60}}),
+				Deltalogs: writeDeltalogs(t, cm, 10, 2, [][2]int64{{2, 50}, {5, 55}}),
+			},
+			{
+				ID: 3, CollectionID: 1, PartitionID: common.AllPartitionsID, Level: datapb.SegmentLevel_L0,
+				Deltalogs: writeDeltalogs(t, cm, common.AllPartitionsID, 3, [][2]int64{{2, 50}, {3, 25}}),
+			},
+			{ID: 4, CollectionID: 1, PartitionID: 10, Level: datapb.SegmentLevel_L1},
+		},
+	}
+	reader := NewCatchupReader(&staticSnapshotSource{snapshot: snapshot}, cm)
+
+	// new consumer gets the rows alive before the checkpoint of snapshot without deletes.
+	recorder := newCatchupRecorder()
+	scanner, err := reader.Read(context.Background(), CatchupReadOption{
+		CollectionID:    1,
+		VChannel:        "v1",
+		Schema:          catchupTestSchema,
+		SnapshotHandler: recorder.handle,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, scanner)
+	assert.Equal(t, map[int64]int64{1: 10, 3: 30, 4: 40, 6: 60}, recorder.inserts)
+	assert.Empty(t, recorder.deletes)
+	assert.Len(t, w.opts, 1)
+	assert.Equal(t, "v1", w.opts[0].VChannel)
+	assert.Len(t, w.opts[0].DeliverFilters, 1)
+	assert.Equal(t, uint64(100), w.opts[0].DeliverFilters[0].GetTimeTickGte().GetTimeTick())
+
+	// lagging consumer gets the rows and deletes after its checkpoint, the consumed rows of the compacted segment are skipped.
+	recorder = newCatchupRecorder()
+	_, err = reader.Read(context.Background(), CatchupReadOption{
+		CollectionID:    1,
+		VChannel:        "v1",
+		Schema:          catchupTestSchema,
+		Checkpoint:      newRmqPosition(5, 31),
+		SnapshotHandler: recorder.handle,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{4: 40, 6: 60}, recorder.inserts)
+	assert.Equal(t, map[int64]uint64{2: 50, 5: 55}, recorder.deletes)
+	assert.Equal(t, "delete", recorder.order[0])
+	assert.Equal(t, uint64(100), w.opts[1].DeliverFilters[0].GetTimeTickGte().GetTimeTick())
+
+	// consumer in the wal is served from its checkpoint.
+	recorder = newCatchupRecorder()
+	_, err = reader.Read(context.Background(), CatchupReadOption{
+		CollectionID:    1,
+		VChannel:        "v1",
+		Schema:          catchupTestSchema,
+		Checkpoint:      newRmqPosition(12, 120),
+		SnapshotHandler: recorder.handle,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, recorder.order)
+	assert.Equal(t, uint64(120), w.opts[2].DeliverFilters[0].GetTimeTickGte().GetTimeTick())
+
+	// the snapshot is served until the given time tick.
+	recorder = newCatchupRecorder()
+	_, err = reader.ReadSnapshot(context.Background(), CatchupReadOption{
+		CollectionID:    1,
+		VChannel:        "v1",
+		Schema:          catchupTestSchema,
+		SnapshotHandler: recorder.handle,
+	}, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int64{1: 10, 2: 20, 3: 30, 4: 40, 5: 15}, recorder.inserts)
+
+	_, err = reader.ReadSnapshot(context.Background(), CatchupReadOption{
+		CollectionID:    1,
+		VChannel:        "v1",
+		Schema:          catchupTestSchema,
+		SnapshotHandler: recorder.handle,
+	}, 100)
+	assert.ErrorIs(t, err, ErrSnapshotNotReady)
+
+	// the error of snapshot handler is returned.
+	_, err = reader.Read(context.Background(), CatchupReadOption{
+		CollectionID: 1,
+		VChannel:     "v1",
+		Schema:       catchupTestSchema,
+		SnapshotHandler: func(ctx context.Context, data *CatchupData) error {
+			return errors.New("test")
+		},
+	})
+	assert.Error(t, err)
+	assert.Len(t, w.opts, 3)
+
+	// the error of snapshot source is returned.
+	reader = NewCatchupReader(&staticSnapshotSource{err: errors.New("test")}, cm)
+	_, err = reader.Read(context.Background(), CatchupReadOption{CollectionID: 1, VChannel: "v1"})
+	assert.Error(t, err)
+	assert.Len(t, w.opts, 3)
+}
@@ -0,0 +1,148 @@
+package streaming
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/distributed/streaming/internal/producer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+var _ CrossVChannelTxn = (*crossVChannelTxnImpl)(nil)
+
+// CrossVChannelTxnOption is the option to begin a transaction across the vchannels of one collection.
+type CrossVChannelTxnOption struct {
+	// CollectionID is the collection that all vchannels of the transaction belong to.
+	CollectionID int64
+
+	// VChannels are the vchannels that the transaction spans.
+	VChannels []string
+
+	// Keepalive is the time that the transaction can be kept before it's committed,
+	// the transaction is rollbacked by streamingcoord after it.
+	// If the keepalive is 0, the default keepalive of transaction is used.
+	Keepalive time.Duration
+}
+
+// CrossVChannelTxn is the transaction across the vchannels of one collection.
+// The messages are appended into the vchannel they belong to,
+// and they are visible at all vchannels after committed, or at none of them after rollbacked.
+type CrossVChannelTxn interface {
+	// Append writes a record into the transaction.
+	// The vchannel of the message must be one of the vchannels of the transaction.
+	Append(ctx context.Context, msg message.MutableMessage, opts ...AppendOption) error
+
+	// Commit commits the transaction by broadcasting the commit message to all vchannels of the transaction.
+	Commit(ctx context.Context) (*types.BroadcastAppendResult, error)
+
+	// Rollback rollbacks the transaction by broadcasting the rollback message to all vchannels of the transaction.
+	Rollback(ctx context.Context) error
+}
+
+// CrossVChannelTxn begins a transaction across the vchannels of one collection.
+func (w *walAccesserImpl) CrossVChannelTxn(ctx context.Context, opts CrossVChannelTxnOption) (CrossVChannelTxn, error) {
+	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, ErrWALAccesserClosed
+	}
+	defer w.lifetime.Done()
+
+	meta, err := w.streamingCoordClient.Broadcast().BeginCrossVChannelTxn(ctx, opts.CollectionID, opts.VChannels, opts.Keepalive)
+	if err != nil {
+		return nil, err
+	}
+	txn := &crossVChannelTxnImpl{
+		walAccesserImpl: w,
+		meta:            meta,
+	}
+	if err := txn.begin(ctx); err != nil {
+		// rollback the transaction as soon as possible, otherwise the begun pieces are kept until the deadline.
+		if rollbackErr := txn.Rollback(ctx); rollbackErr != nil {
+			w.Logger().Warn(ctx, "failed to rollback the cross vchannel txn after begin failure",
+				mlog.Int64("txnID", meta.GetTxnId()), mlog.Err(rollbackErr))
+		}
+		return nil, err
+	}
+	return txn, nil
+}
+
+type crossVChannelTxnImpl struct {
+	*walAccesserImpl
+
+	meta *streamingpb.CrossVChannelTxnMeta
+}
+
+// begin appends the begin message of the transaction into all vchannels.
+func (t *crossVChannelTxnImpl) begin(ctx context.Context) error {
+	guards := make([]*producer.ProduceGuard, 0, len(t.meta.GetVchannelTxnIds()))
+	for vchannel, txnID := range t.meta.GetVchannelTxnIds() {
+		beginTxn := message.NewBeginTxnMessageBuilderV2().
+			WithVChannel(vchannel).
+			WithHeader(&message.BeginTxnMessageHeader{
+				CoordinatedTxnId:    txnID,
+				CoordinatedDeadline: t.meta.GetDeadline(),
+			}).
+			WithBody(&message.BeginTxnMessageBody{}).
+			MustBuildMutable()
+		g, err := t.getProducer(vchannel).BeginProduce(ctx, beginTxn)
+		if err != nil {
+			for _, guard := range guards {
+				guard.Cancel()
+			}
+			return err
+		}
+		guards = append(guards, g)
+	}
+	return producer.BatchCommitProduce(ctx, guards...).UnwrapFirstError()
+}
+
+func (t *crossVChannelTxnImpl) Append(ctx context.Context, msg message.MutableMessage, opts ...AppendOption) error {
+	assertValidMessage(msg)
+	if !t.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return ErrWALAccesserClosed
+	}
+	defer t.lifetime.Done()
+
+	txnID, ok := t.meta.GetVchannelTxnIds()[msg.VChannel()]
+	if !ok {
+		return status.NewInvalidArgument("vchannel %s is not a part of cross vchannel txn %d", msg.VChannel(), t.meta.GetTxnId())
+	}
+	msg = applyOpt(msg, opts...).WithTxnContext(message.TxnContext{
+		TxnID:     message.TxnID(txnID),
+		Keepalive: message.TxnKeepaliveInfinite,
+	})
+	g, err := t.getProducer(msg.VChannel()).BeginProduce(ctx, msg)
+	if err != nil {
+		return err
+	}
+	return producer.BatchCommitProduce(ctx, g).UnwrapFirstError()
+}
+
+func (t *crossVChannelTxnImpl) Commit(ctx context.Context) (*types.BroadcastAppendResult, error) {
+	if !t.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, ErrWALAccesserClosed
+	}
+	defer t.lifetime.Done()
+
+	return t.streamingCoordClient.Broadcast().CommitCrossVChannelTxn(ctx, t.meta.GetTxnId())
+}
+
+func (t *crossVChannelTxnImpl) Rollback(ctx context.Context) error {
+	if !t.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return ErrWALAccesserClosed
+	}
+	defer t.lifetime.Done()
+
+	err := t.streamingCoordClient.Broadcast().RollbackCrossVChannelTxn(ctx, t.meta.GetTxnId())
+	if err != nil && status.AsStreamingError(err).IsTxnExpired() {
+		// the transaction is already rollbacked by streamingcoord.
+		return nil
+	}
+	return errors.Wrapf(err, "failed to rollback cross vchannel txn %d", t.meta.GetTxnId())
+}
@@ -0,0 +1,90 @@
+package streaming
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/streamingnode/client/handler/mock_producer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+func TestCrossVChannelTxn(t *testing.T) {
+	ctx := context.Background()
+	w, _, bs, handler := createMockWAL(t)
+	defer w.Close()
+
+	meta := &streamingpb.CrossVChannelTxnMeta{
+		TxnId:          1,
+		CollectionId:   100,
+		VchannelTxnIds: map[string]int64{vChannel1: 2, vChannel2: 3},
+		Deadline:       1000,
+	}
+	bs.EXPECT().BeginCrossVChannelTxn(mock.Anything, int64(100), []string{vChannel1, vChannel2}, time.Minute).Return(meta, nil)
+	bs.EXPECT().CommitCrossVChannelTxn(mock.Anything, int64(1)).Return(&types.BroadcastAppendResult{BroadcastID: 1}, nil)
+
+	mu := sync.Mutex{}
+	appended := make([]message.MutableMessage, 0)
+	p := mock_producer.NewMockProducer(t)
+	p.EXPECT().IsAvailable().Return(true).Maybe()
+	p.EXPECT().Available().Return(make(chan struct{})).Maybe()
+	p.EXPECT().Close().Return().Maybe()
+	p.EXPECT().Append(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, mm message.MutableMessage) (*types.AppendResult, error) {
+			mu.Lock()
+			appended = append(appended, mm)
+			mu.Unlock()
+			return &types.AppendResult{MessageID: walimplstest.NewTestMessageID(1), TimeTick: 10}, nil
+		})
+	handler.EXPECT().CreateProducer(mock.Anything, mock.Anything).Return(p, nil)
+
+	txn, err := w.CrossVChannelTxn(ctx, CrossVChannelTxnOption{
+		CollectionID: 100,
+		VChannels:    []string{vChannel1, vChannel2},
+		Keepalive:    time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, appended, 2)
+	for _, msg := range appended {
+		begin := message.MustAsMutableBeginTxnMessageV2(msg)
+		assert.Equal(t, meta.GetVchannelTxnIds()[msg.VChannel()], begin.Header().GetCoordinatedTxnId())
+		assert.Equal(t, uint64(1000), begin.Header().GetCoordinatedDeadline())
+	}
+
+	err = txn.Append(ctx, newInsertMessage(vChannel2))
+	assert.NoError(t, err)
+	assert.Len(t, appended, 3)
+	assert.Equal(t, message.TxnID(3), appended[2].TxnContext().TxnID)
+	assert.Equal(t, message.TxnKeepaliveInfinite, appended[2].TxnContext().Keepalive)
+
+	// the vchannel out of the txn is rejected.
+	err = txn.Append(ctx, newInsertMessage(vChannel3))
+	assert.Error(t, err)
+
+	result, err := txn.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), result.BroadcastID)
+
+	// the txn is rollbacked if the begin is failed.
+	bs.EXPECT().BeginCrossVChannelTxn(mock.Anything, int64(100), []string{vChannel3}, time.Duration(0)).Return(&streamingpb.CrossVChannelTxnMeta{
+		TxnId:          4,
+		CollectionId:   100,
+		VchannelTxnIds: map[string]int64{vChannel3: 5},
+	}, nil)
+	bs.EXPECT().RollbackCrossVChannelTxn(mock.Anything, int64(4)).Return(status.NewTransactionExpired("expired"))
+	p.EXPECT().Append(mock.Anything, mock.Anything).Unset()
+	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewUnrecoverableError("begin failed"))
+	_, err = w.CrossVChannelTxn(ctx, CrossVChannelTxnOption{
+		CollectionID: 100,
+		VChannels:    []string{vChannel3},
+	})
+	assert.Error(t, err)
+}
@@ -26,6 +26,9 @@ type ConsumerOptions struct {
 
 	// IgnorePauseConsumption is the flag to ignore the consumption pause of the consumer.
 	IgnorePauseConsumption bool
+
+	// PreferReadReplica is the flag to allow the consumer to read from the read-only replica of the pchannel.
+	PreferReadReplica bool
 }
 
 // ResumableConsumer is the interface for consuming message to log service.
@@ -118,6 +118,7 @@ func (rc *resumableConsumerImpl) resumeLoop() {
 			DeliverFilters:         deliverFilters,
 			MessageHandler:         nopCloseMH,
 			IgnorePauseConsumption: rc.opts.IgnorePauseConsumption,
+			PreferReadReplica:      rc.opts.PreferReadReplica,
 		}
 
 		// Create a new consumer.
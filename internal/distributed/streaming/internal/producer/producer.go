@@ -7,6 +7,8 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus/internal/distributed/streaming/internal/errs"
 	"github.com/milvus-io/milvus/internal/streamingnode/client/handler"
@@ -15,6 +17,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -26,6 +30,13 @@ type ProducerOptions struct {
 	PChannel string
 }
 
+var (
+	// producerID identifies the producers of current process, it's shared by the producers of all pchannels.
+	producerID = uuid.NewString()
+	// producerEpoch is increased for every new producer, so the appends of a closed producer are fenced by the newer one.
+	producerEpoch = atomic.NewUint64(0)
+)
+
 // NewResumableProducer creates a new producer.
 // Provide an auto resuming producer.
 func NewResumableProducer(f factory, opts *ProducerOptions) *ResumableProducer {
@@ -42,6 +53,11 @@ func NewResumableProducer(f factory, opts *ProducerOptions) *ResumableProducer {
 		factory:        f,
 		metrics:        newResumingProducerMetrics(opts.PChannel),
 		rateLimiter:    newProduceRateLimiter(opts.PChannel),
+		sequence:       atomic.NewUint64(0),
+	}
+	if paramtable.Get().StreamingCfg.WALDedupEnabled.GetAsBool() {
+		p.producerID = producerID
+		p.epoch = producerEpoch.Inc()
 	}
 	p.SetLogger(mlog.With(mlog.FieldPChannel(opts.PChannel)))
 	go p.resumeLoop()
@@ -76,6 +92,12 @@ type ResumableProducer struct {
 	metrics *resumingProducerMetrics
 
 	rateLimiter *produceRateLimiter
+
+	// producerID, epoch and sequence are attached to every message to deduplicate the retried appends at wal,
+	// producerID is empty if the deduplication is disabled.
+	producerID string
+	epoch      uint64
+	sequence   *atomic.Uint64
 }
 
 // BeginProduce begins a new produce task.
@@ -118,6 +140,14 @@ func (p *ResumableProducer) produceInternal(ctx context.Context, msg message.Mut
 	}
 	defer p.lifetime.Done()
 
+	if p.producerID != "" {
+		// The retries of the append share the same sequence, so the wal can drop the duplicate one.
+		msg = message.WithProducerSequence(msg, message.ProducerSequence{
+			ProducerID: p.producerID,
+			Epoch:      p.epoch,
+			Sequence:   p.sequence.Inc(),
+		})
+	}
 	for {
 		// get producer.
 		producerHandler, err := p.producer.GetProducerAfterAvailable(ctx)
@@ -142,6 +172,18 @@ func (p *ResumableProducer) produceInternal(ctx context.Context, msg message.Mut
 			if sErr.IsIgnoredOperation() {
 				return nil, errors.Mark(err, errs.ErrIgnoredOperation)
 			}
+			if sErr.IsDuplicateAppend() {
+				// the message is already appended by a previous try of the append,
+				// but the result of that append is expired from the deduplication window,
+				// so the append is done with an unknown message id instead of writing the message twice.
+				p.Logger().Debug(ctx, "the duplicate append is out of the deduplication window, treat it as appended", mlog.Err(err))
+				return &types.AppendResult{}, nil
+			}
+			if sErr.IsCollectionQuotaExceeded() {
+				// the quota of collection is exceeded, it's not a wal level rejection,
+				// so return it to the caller to retry later instead of blocking other collections.
+				return nil, merr.WrapErrServiceRateLimit(0, sErr.Cause)
+			}
 			if sErr.IsRateLimitRejected() {
 				// current message is rate limit rejected, wait until the rate limit is available.
 				if err := p.rateLimiter.WaitUntilAvailable(ctx); err != nil {
@@ -66,7 +66,8 @@ func (arl *produceRateLimiter) RequestReservation(ctx context.Context, msgs ...m
 	}
 	r := arl.limiter.ReserveN(time.Now(), msgSize)
 	if !r.OK() {
-		return nil, merr.WrapErrServiceRateLimit(0, "reach the limit of request, please slowdown and retry later")
+		retryAfter := paramtable.Get().StreamingCfg.WALRateLimitRejectRetryAfter.GetAsDurationByParse()
+		return nil, merr.WrapErrServiceRateLimitWithRetryAfter(0, retryAfter, "reach the limit of request, please slowdown and retry later")
 	}
 	return r, nil
 }
@@ -87,6 +87,21 @@ func TestProduceRateLimiter(t *testing.T) {
 		assert.True(t, res.OK())
 	})
 
+	t.Run("RequestReservation_Rejected", func(t *testing.T) {
+		rl.UpdateRateLimitState(ratelimit.RateLimitState{
+			State: streamingpb.WALRateLimitState_WAL_RATE_LIMIT_STATE_REJECT,
+			Rate:  0,
+		})
+		msg := mock_message.NewMockMutableMessage(t)
+		msg.EXPECT().EstimateSize().Return(50)
+
+		// the retriable error with suggested delay is returned.
+		res, err := rl.RequestReservation(context.Background(), msg)
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.Contains(t, err.Error(), "retryAfter")
+	})
+
 	t.Run("WaitUntilAvailable", func(t *testing.T) {
 		rl.UpdateRateLimitState(ratelimit.RateLimitState{
 			State: streamingpb.WALRateLimitState_WAL_RATE_LIMIT_STATE_REJECT,
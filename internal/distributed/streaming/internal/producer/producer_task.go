@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
@@ -103,6 +104,12 @@ func waitForReservationOK(ctx context.Context, tasks ...*ProduceGuard) error {
 	// Record the rate limit delay
 	metrics.StreamingServiceClientProduceRateLimitDelaySeconds.WithLabelValues(paramtable.GetStringNodeID(), pchannel).Observe(maxDelay.Seconds())
 
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < maxDelay {
+		// the reservations cannot be ready before the deadline,
+		// return a retriable error with the suggested delay instead of waiting until the deadline.
+		return merr.WrapErrServiceRateLimitWithRetryAfter(0, maxDelay, "wal is slowing down the writes, please retry later")
+	}
+
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 )
 
 func TestBatchCommitProduce(t *testing.T) {
@@ -143,6 +144,27 @@ func TestWaitForReservationOK(t *testing.T) {
 		assert.True(t, time.Since(start) >= 1*time.Second)
 	})
 
+	t.Run("DeadlineBeforeReady", func(t *testing.T) {
+		limiter := rate.NewLimiter(1, 1)
+		limiter.ReserveN(time.Now(), 1)
+		res := limiter.ReserveN(time.Now(), 10)
+		task := &ProduceGuard{
+			r:        res,
+			producer: &ResumableProducer{opts: &ProducerOptions{PChannel: "test"}},
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// the retriable error with suggested delay is returned without waiting until the deadline.
+		start := time.Now()
+		err := waitForReservationOK(ctx, task)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.True(t, merr.IsRetryableErr(err))
+		assert.Contains(t, err.Error(), "retryAfter")
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
 	t.Run("ContextCanceled", func(t *testing.T) {
 		limiter := rate.NewLimiter(1, 1)
 		limiter.ReserveN(time.Now(), 1)
@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/ratelimit"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 )
 
 func TestResumableProducer(t *testing.T) {
@@ -194,6 +195,8 @@ func TestResumableProducer_ProduceInternalErrors(t *testing.T) {
 	p.EXPECT().Close().Return().Maybe()
 	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewUnrecoverableError("unrecoverable")).Once()
 	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewIgnoreOperation("ignored")).Once()
+	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewCollectionQuotaExceeded("exceeded")).Once()
+	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewDuplicateAppend("duplicate")).Once()
 	p.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewRateLimitRejected("rejected")).Once()
 	p.EXPECT().Append(mock.Anything, mock.Anything).Return(&types.AppendResult{}, nil).Once()
 
@@ -216,6 +219,17 @@ func TestResumableProducer_ProduceInternalErrors(t *testing.T) {
 		assert.True(t, errors.Is(err, errs.ErrIgnoredOperation))
 	})
 
+	t.Run("CollectionQuotaExceeded", func(t *testing.T) {
+		_, err := rp.produceInternal(context.Background(), msg)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	})
+
+	t.Run("DuplicateAppend", func(t *testing.T) {
+		result, err := rp.produceInternal(context.Background(), msg)
+		assert.NoError(t, err)
+		assert.Nil(t, result.MessageID)
+	})
+
 	t.Run("RateLimitRejected_Retry", func(t *testing.T) {
 		_, err := rp.produceInternal(context.Background(), msg)
 		assert.NoError(t, err)
@@ -3,6 +3,8 @@ package streaming
 import (
 	"context"
 
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -29,3 +31,39 @@ func (w localServiceImpl) GetMetricsIfLocal(ctx context.Context) (*types.Streami
 
 	return w.handlerClient.GetWALMetricsIfLocal(ctx)
 }
+
+// RenewPChannelLease renews the lease of the pchannels held by the local streaming node.
+func (w localServiceImpl) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, ErrWALAccesserClosed
+	}
+	defer w.lifetime.Done()
+
+	return w.streamingCoordClient.Assignment().RenewPChannelLease(ctx, node, channels)
+}
+
+// GetPChannelRetentions gets the retention of the wal of the pchannels held by the local streaming node.
+func (w localServiceImpl) GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error) {
+	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, ErrWALAccesserClosed
+	}
+	defer w.lifetime.Done()
+
+	return w.streamingCoordClient.Assignment().GetPChannelRetentions(ctx, pchannels)
+}
+
+// ReportPChannelHandoff reports the pchannels closed by the local streaming node to streamingcoord.
+// The pchannels are marked as unavailable by streamingcoord and reassigned right away.
+func (w localServiceImpl) ReportPChannelHandoff(ctx context.Context, channels []types.PChannelInfo) error {
+	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return ErrWALAccesserClosed
+	}
+	defer w.lifetime.Done()
+
+	for _, channel := range channels {
+		if err := w.streamingCoordClient.Assignment().ReportAssignmentError(ctx, channel, status.NewOnShutdownError("wal is handed off by streaming node")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
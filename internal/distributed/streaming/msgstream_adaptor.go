@@ -10,6 +10,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 var (
@@ -113,7 +114,8 @@ func (m *delegatorMsgstreamAdaptor) Seek(ctx context.Context, msgPositions []*ms
 			// only consume insert, delete, schema change, and manual flush messages
 			options.DeliverFilterMessageType(message.MessageTypeInsert, message.MessageTypeDelete, message.MessageTypeSchemaChange, message.MessageTypeAlterCollection, message.MessageTypeManualFlush),
 		},
-		MessageHandler: handler,
+		MessageHandler:    handler,
+		PreferReadReplica: paramtable.Get().StreamingCfg.WALBalancerReadReplicaDelegatorEnabled.GetAsBool(),
 	})
 	m.ch = handler.Chan()
 	return nil
@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/client/assignment"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
@@ -80,6 +81,15 @@ func (s replicateService) GetReplicateConfiguration(ctx context.Context) (*commo
 	return replicateutil.SanitizeReplicateConfiguration(configHelper.GetReplicateConfiguration()), nil
 }
 
+func (s replicateService) GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error) {
+	if !s.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, ErrWALAccesserClosed
+	}
+	defer s.lifetime.Done()
+
+	return s.streamingCoordClient.Assignment().GetClusterChannels(ctx)
+}
+
 func (s replicateService) GetReplicateCheckpoint(ctx context.Context, channelName string) (*wal.ReplicateCheckpoint, error) {
 	if !s.lifetime.Add(typeutil.LifetimeStateWorking) {
 		return nil, ErrWALAccesserClosed
@@ -176,6 +186,10 @@ func (s replicateService) overwriteReplicateMessage(ctx context.Context, msg mes
 		if err := s.overwriteCreateCollectionMessage(sourceCluster, msg); err != nil {
 			return nil, err
 		}
+	case message.MessageTypeDropCollection:
+		if err := s.overwriteDropCollectionMessage(sourceCluster, msg); err != nil {
+			return nil, err
+		}
 	case message.MessageTypeAlterReplicateConfig:
 		if err := s.overwriteAlterReplicateConfigMessage(cfg, msg); err != nil {
 			return nil, err
@@ -219,6 +233,39 @@ func (s replicateService) overwriteCreateCollectionMessage(sourceCluster *replic
 		body.VirtualChannelNames[idx] = strings.Replace(body.VirtualChannelNames[idx], sourcePChannel, targetPChannel, 1)
 	}
 	createCollectionMsg.OverwriteBody(body)
+	if header := createCollectionMsg.Header(); header.Reshard != nil {
+		if err := s.overwriteCollectionReshardInfo(sourceCluster, header.Reshard); err != nil {
+			return err
+		}
+		createCollectionMsg.OverwriteHeader(header)
+	}
+	return nil
+}
+
+// overwriteDropCollectionMessage overwrites the vchannels of the drop collection message that shrinks the shards of collection.
+func (s replicateService) overwriteDropCollectionMessage(sourceCluster *replicateutil.MilvusCluster, msg message.ReplicateMutableMessage) error {
+	dropCollectionMsg := message.MustAsMutableDropCollectionMessageV1(msg)
+	header := dropCollectionMsg.Header()
+	if header.Reshard == nil {
+		return nil
+	}
+	if err := s.overwriteCollectionReshardInfo(sourceCluster, header.Reshard); err != nil {
+		return err
+	}
+	dropCollectionMsg.OverwriteHeader(header)
+	return nil
+}
+
+// overwriteCollectionReshardInfo overwrites the vchannels of the collection after the shard number is changed.
+func (s replicateService) overwriteCollectionReshardInfo(sourceCluster *replicateutil.MilvusCluster, reshard *messagespb.CollectionReshardInfo) error {
+	for idx, sourcePChannel := range reshard.PhysicalChannelNames {
+		targetPChannel, err := sourceCluster.GetTargetChannel(sourcePChannel, s.clusterID)
+		if err != nil {
+			return status.NewReplicateViolation("failed to get target channel, %s", err.Error())
+		}
+		reshard.PhysicalChannelNames[idx] = targetPChannel
+		reshard.VirtualChannelNames[idx] = strings.Replace(reshard.VirtualChannelNames[idx], sourcePChannel, targetPChannel, 1)
+	}
 	return nil
 }
 
@@ -8,10 +8,12 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -92,6 +94,11 @@ type ReadOption struct {
 
 	// IgnorePauseConsumption is the flag to ignore the consumption pause of the scanner.
 	IgnorePauseConsumption bool
+
+	// PreferReadReplica is the flag to allow the scanner to read from the read-only replica of the pchannel.
+	// It's used by the read-heavy consumers (replication, CDC export, recovery, delegator) to balance the read load across the replicas,
+	// the scanner falls back to the read-write owner if there's no ready replica.
+	PreferReadReplica bool
 }
 
 // Scanner is the interface for reading records from the wal.
@@ -118,6 +125,10 @@ type ReplicateService interface {
 	// with sensitive fields (tokens) sanitized.
 	GetReplicateConfiguration(ctx context.Context) (*commonpb.ReplicateConfiguration, error)
 
+	// GetClusterChannels returns the cchannel and pchannels of current cluster,
+	// which are used to fill the pchannels of the replicate configuration.
+	GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error)
+
 	// GetReplicateCheckpoint returns the WAL checkpoint that will be used to create scanner
 	// from the correct position, ensuring no duplicate or missing messages.
 	GetReplicateCheckpoint(ctx context.Context, channelName string) (*wal.ReplicateCheckpoint, error)
@@ -147,12 +158,38 @@ type Balancer interface {
 	// ResumeRebalance resumes the rebalance of the wal.
 	ResumeRebalance(ctx context.Context) error
 
+	// IsBalanceFrozen returns whether the automatic reassignment of the wal is frozen.
+	IsBalanceFrozen(ctx context.Context) (bool, error)
+
+	// FreezeBalance freezes the automatic reassignment of the wal, the frozen state is persisted and kept after restart of coordinator.
+	// The wal served by an available streaming node is not moved until the balance is unfrozen,
+	// but the pinned wal, the wal on the frozen or unavailable node are still assigned.
+	FreezeBalance(ctx context.Context) error
+
+	// UnfreezeBalance unfreezes the automatic reassignment of the wal.
+	UnfreezeBalance(ctx context.Context) error
+
 	// FreezeNodeIDs freezes the streaming node.
 	// The wal will not be assigned to the frozen nodes and the wal will be removed from the frozen nodes.
 	FreezeNodeIDs(ctx context.Context, nodeIDs []int64) error
 
 	// DefreezeNodeIDs defreezes the streaming node.
 	DefreezeNodeIDs(ctx context.Context, nodeIDs []int64) error
+
+	// RegisterPChannels registers the pchannels of wal on a running cluster.
+	// The unknown pchannels are added and assigned to the streaming nodes asynchronously.
+	RegisterPChannels(ctx context.Context, pchannels []string) error
+
+	// GetPChannelsView returns the current view of all pchannels of wal, including the assignment, terms and stats.
+	GetPChannelsView(ctx context.Context) (*balancer.PChannelView, error)
+
+	// PreviewRebalance returns the wal moves that the next balance round would apply.
+	// Nothing is persisted or applied to the streaming node.
+	PreviewRebalance(ctx context.Context) ([]balancer.RebalanceMove, error)
+
+	// DisableStreaming rolls back the streaming service to the legacy path, it's designed for the test clusters.
+	// It's rejected if there's any state that can only be served by the streaming based WAL.
+	DisableStreaming(ctx context.Context) error
 }
 
 // WALAccesser is the interfaces to interact with the milvus write ahead log.
@@ -178,6 +215,10 @@ type WALAccesser interface {
 	// the channel does not need growing-source retention.
 	PrepareReleaseManualFlush(ctx context.Context, collectionID int64, vchannel string, releaseSegmentIDs []int64) (bool, error)
 
+	// TruncateWAL truncates the wal of the pchannel to the given message id explicitly.
+	// The truncation is rejected if any consumer checkpoint of the pchannel is before the message id.
+	TruncateWAL(ctx context.Context, pchannel string, truncateTo message.MessageID) error
+
 	// RawAppend writes a records to the log.
 	RawAppend(ctx context.Context, msgs message.MutableMessage, opts ...AppendOption) (*types.AppendResult, error)
 
@@ -188,6 +229,10 @@ type WALAccesser interface {
 	// Broadcast also support the resource-key to achieve a resource-exclusive acquirsion.
 	Broadcast() Broadcast
 
+	// CrossVChannelTxn begins a transaction across the vchannels of one collection.
+	// The transaction is coordinated by streamingcoord, and committed or rollbacked atomically at all its vchannels.
+	CrossVChannelTxn(ctx context.Context, opts CrossVChannelTxnOption) (CrossVChannelTxn, error)
+
 	// Read returns a scanner for reading records from the wal.
 	Read(ctx context.Context, opts ReadOption) Scanner
 
@@ -207,6 +252,17 @@ type Local interface {
 	// GetMetricsIfLocal gets the metrics of the local wal.
 	// It will only return the metrics of the local wal but not the remote wal.
 	GetMetricsIfLocal(ctx context.Context) (*types.StreamingNodeMetrics, error)
+
+	// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the local streaming node.
+	// Return the pchannels that are not owned by the local streaming node any more.
+	RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error)
+
+	// GetPChannelRetentions gets the retention of the wal of the pchannels held by the local streaming node from streamingcoord.
+	GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error)
+
+	// ReportPChannelHandoff reports the pchannels closed by the local streaming node when shutting down to streamingcoord,
+	// so streamingcoord reassigns the pchannels right away instead of waiting for the session or the lease to expire.
+	ReportPChannelHandoff(ctx context.Context, channels []types.PChannelInfo) error
 }
 
 // Broadcast is the interface for writing broadcast message into the wal.
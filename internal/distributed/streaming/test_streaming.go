@@ -26,9 +26,11 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
@@ -69,6 +71,10 @@ func (n *noopReplicateService) GetReplicateConfiguration(ctx context.Context) (*
 	return nil, nil
 }
 
+func (n *noopReplicateService) GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error) {
+	return nil, nil
+}
+
 func (n *noopReplicateService) GetReplicateCheckpoint(ctx context.Context, channelName string) (*wal.ReplicateCheckpoint, error) {
 	return nil, nil
 }
@@ -103,6 +109,18 @@ func (n *noopBalancer) ResumeRebalance(ctx context.Context) error {
 	return nil
 }
 
+func (n *noopBalancer) IsBalanceFrozen(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (n *noopBalancer) FreezeBalance(ctx context.Context) error {
+	return nil
+}
+
+func (n *noopBalancer) UnfreezeBalance(ctx context.Context) error {
+	return nil
+}
+
 func (n *noopBalancer) FreezeNodeIDs(ctx context.Context, nodeIDs []int64) error {
 	return nil
 }
@@ -111,6 +129,22 @@ func (n *noopBalancer) DefreezeNodeIDs(ctx context.Context, nodeIDs []int64) err
 	return nil
 }
 
+func (n *noopBalancer) RegisterPChannels(ctx context.Context, pchannels []string) error {
+	return nil
+}
+
+func (n *noopBalancer) GetPChannelsView(ctx context.Context) (*balancer.PChannelView, error) {
+	return &balancer.PChannelView{}, nil
+}
+
+func (n *noopBalancer) DisableStreaming(ctx context.Context) error {
+	return nil
+}
+
+func (n *noopBalancer) PreviewRebalance(ctx context.Context) ([]balancer.RebalanceMove, error) {
+	return nil, nil
+}
+
 type noopLocal struct{}
 
 func (n *noopLocal) GetLatestMVCCTimestampIfLocal(ctx context.Context, vchannel string) (uint64, error) {
@@ -121,6 +155,18 @@ func (n *noopLocal) GetMetricsIfLocal(ctx context.Context) (*types.StreamingNode
 	return &types.StreamingNodeMetrics{}, nil
 }
 
+func (n *noopLocal) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	return nil, nil
+}
+
+func (n *noopLocal) GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error) {
+	return nil, nil
+}
+
+func (n *noopLocal) ReportPChannelHandoff(ctx context.Context, channels []types.PChannelInfo) error {
+	return nil
+}
+
 type noopBroadcast struct{}
 
 func (n *noopBroadcast) Append(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
@@ -166,6 +212,29 @@ func (n *noopTxn) Rollback(ctx context.Context) error {
 	return nil
 }
 
+type noopCrossVChannelTxn struct{}
+
+func (n *noopCrossVChannelTxn) Append(ctx context.Context, msg message.MutableMessage, opts ...AppendOption) error {
+	if err := getExpectErr(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (n *noopCrossVChannelTxn) Commit(ctx context.Context) (*types.BroadcastAppendResult, error) {
+	if err := getExpectErr(); err != nil {
+		return nil, err
+	}
+	return &types.BroadcastAppendResult{}, nil
+}
+
+func (n *noopCrossVChannelTxn) Rollback(ctx context.Context) error {
+	if err := getExpectErr(); err != nil {
+		return err
+	}
+	return nil
+}
+
 type noopWALAccesser struct{}
 
 func (n *noopWALAccesser) Replicate() ReplicateService {
@@ -192,6 +261,10 @@ func (n *noopWALAccesser) PrepareReleaseManualFlush(ctx context.Context, collect
 	return false, getExpectErr()
 }
 
+func (n *noopWALAccesser) TruncateWAL(ctx context.Context, pchannel string, truncateTo message.MessageID) error {
+	return getExpectErr()
+}
+
 func (n *noopWALAccesser) RawAppend(ctx context.Context, msgs message.MutableMessage, opts ...AppendOption) (*types.AppendResult, error) {
 	if err := getExpectErr(); err != nil {
 		return nil, err
@@ -210,6 +283,13 @@ func (n *noopWALAccesser) Broadcast() Broadcast {
 	return &noopBroadcast{}
 }
 
+func (n *noopWALAccesser) CrossVChannelTxn(ctx context.Context, opts CrossVChannelTxnOption) (CrossVChannelTxn, error) {
+	if err := getExpectErr(); err != nil {
+		return nil, err
+	}
+	return &noopCrossVChannelTxn{}, nil
+}
+
 func (n *noopWALAccesser) Read(ctx context.Context, opts ReadOption) Scanner {
 	return &noopScanner{}
 }
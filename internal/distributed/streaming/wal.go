@@ -87,6 +87,10 @@ func (w *walAccesserImpl) PrepareReleaseManualFlush(ctx context.Context, collect
 	return w.handlerClient.PrepareReleaseManualFlush(ctx, collectionID, vchannel, releaseSegmentIDs)
 }
 
+func (w *walAccesserImpl) TruncateWAL(ctx context.Context, pchannel string, truncateTo message.MessageID) error {
+	return w.handlerClient.TruncateWAL(ctx, pchannel, truncateTo)
+}
+
 // ControlChannel returns the control channel name of the wal.
 func (w *walAccesserImpl) ControlChannel() string {
 	last, err := w.streamingCoordClient.Assignment().GetLatestAssignments(context.Background())
@@ -132,6 +136,7 @@ func (w *walAccesserImpl) Read(ctx context.Context, opts ReadOption) Scanner {
 		DeliverFilters:         opts.DeliverFilters,
 		MessageHandler:         opts.MessageHandler,
 		IgnorePauseConsumption: opts.IgnorePauseConsumption,
+		PreferReadReplica:      opts.PreferReadReplica,
 	})
 	return rc
 }
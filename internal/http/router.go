@@ -80,12 +80,17 @@ const (
 	BatchTransferPath         = "/management/batch/transfer"
 
 	StreamingBalanceStatusPath    = "/management/streaming/balance/status"
+	StreamingBalancePreviewPath   = "/management/streaming/balance/preview"
+	StreamingBalanceFreezePath    = "/management/streaming/balance/freeze"
+	StreamingPChannelsPath        = "/management/streaming/pchannels"
 	StreamingNodesPath            = "/management/streaming/nodes"
 	StreamingNodeStatusPath       = "/management/streaming/nodes/status"
 	StreamingNodeDistributionPath = "/management/streaming/nodes/distribution"
 	StreamingTransferPath         = "/management/streaming/transfer"
+	StreamingDisablePath          = "/management/streaming/disable"
 
-	WALAlterPath = "/management/wal/alter"
+	WALAlterPath    = "/management/wal/alter"
+	WALTruncatePath = "/management/wal/truncate"
 
 	ConfigAlterPath = "/management/config/alter"
 	ConfigGetPath   = "/management/config/get"
@@ -127,6 +132,10 @@ const (
 	QNSegmentsPath = "/_qn/segments"
 	// QNChannelsPath is the path to get channels in QueryNode.
 	QNChannelsPath = "/_qn/channels"
+	// QNCollectionRefsPath is the path to get the reference info of collections in QueryNode.
+	QNCollectionRefsPath = "/_qn/collection_refs"
+	// QNSegmentMemoryPath is the path to get the memory breakdown of segments in QueryNode.
+	QNSegmentMemoryPath = "/_qn/segment_memory"
 
 	// DCDistPath is the path to get all segments and channels distribution in DataCoord.
 	DCDistPath = "/_dc/dist"
@@ -167,4 +176,6 @@ const (
 	TelemetryCommandsPath = "/_telemetry/commands"
 	// TelemetryUIPath is the path for telemetry management web UI.
 	TelemetryUIPath = "/telemetry"
+	// SegmentMemoryUIPath is the path for segment memory web UI of QueryNode.
+	SegmentMemoryUIPath = "/segment_memory"
 )
@@ -207,6 +207,13 @@ func RegisterWebUIHandler() {
 		Path:    TelemetryUIPath,
 		Handler: serveTelemetry,
 	})
+
+	// Segment memory UI handler
+	serveSegmentMemory := serveFile("webui/segment_memory.html", httpFS)
+	Register(&Handler{
+		Path:    SegmentMemoryUIPath,
+		Handler: serveSegmentMemory,
+	})
 }
 
 type responseInterceptor struct {
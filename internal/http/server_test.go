@@ -540,6 +540,7 @@ func TestRegisterWebUIHandler(t *testing.T) {
 		{"/webui/", http.StatusOK, "<!doctype html>"},
 		{"/webui/index.html", http.StatusOK, "<!doctype html>"},
 		{"/webui/unknown", http.StatusOK, "<!doctype html>"},
+		{"/segment_memory", http.StatusOK, "<!doctype html>"},
 	}
 
 	for _, tt := range tests {
@@ -311,6 +311,10 @@ type StreamingCoordCataLog interface {
 	// Only return error if the ctx is canceled, otherwise it will retry until success.
 	SaveVersion(ctx context.Context, version *streamingpb.StreamingVersion) error
 
+	// RemoveVersion removes the streaming version from metastore, the streaming service is treated as never enabled after removing.
+	// Only return error if the ctx is canceled, otherwise it will retry until success.
+	RemoveVersion(ctx context.Context) error
+
 	// physical channel watch related
 
 	// ListPChannel list all pchannels on milvus.
@@ -320,6 +324,36 @@ type StreamingCoordCataLog interface {
 	// Only return error if the ctx is canceled, otherwise it will retry until success.
 	SavePChannels(ctx context.Context, info []*streamingpb.PChannelMeta) error
 
+	// ListPChannelPool list all pchannel pools on milvus.
+	ListPChannelPool(ctx context.Context) ([]*streamingpb.PChannelPoolMeta, error)
+
+	// SavePChannelPools saves the pchannel pools and removes the dropped pools from metastore.
+	// Only return error if the ctx is canceled, otherwise it will retry until success.
+	SavePChannelPools(ctx context.Context, pools []*streamingpb.PChannelPoolMeta, droppedPools []string) error
+
+	// ListPChannelAntiAffinityGroup list all pchannel anti-affinity groups on milvus.
+	ListPChannelAntiAffinityGroup(ctx context.Context) ([]*streamingpb.PChannelAntiAffinityGroupMeta, error)
+
+	// SavePChannelAntiAffinityGroups saves the pchannel anti-affinity groups and removes the dropped groups from metastore.
+	// Only return error if the ctx is canceled, otherwise it will retry until success.
+	SavePChannelAntiAffinityGroups(ctx context.Context, groups []*streamingpb.PChannelAntiAffinityGroupMeta, droppedGroups []string) error
+
+	// GetIDAllocator get the id allocator meta from metastore.
+	// Return nil, nil if the allocator is not exist.
+	GetIDAllocator(ctx context.Context, name string) (*streamingpb.IDAllocatorMeta, error)
+
+	// SaveIDAllocator save the id allocator meta to metastore.
+	// Only return error if the ctx is canceled, otherwise it will retry until success.
+	SaveIDAllocator(ctx context.Context, meta *streamingpb.IDAllocatorMeta) error
+
+	// GetBalancerConfig get the balancer config from metastore.
+	// Return nil, nil if the config is not exist.
+	GetBalancerConfig(ctx context.Context) (*streamingpb.BalancerConfigMeta, error)
+
+	// SaveBalancerConfig save the balancer config to metastore.
+	// Only return error if the ctx is canceled, otherwise it will retry until success.
+	SaveBalancerConfig(ctx context.Context, config *streamingpb.BalancerConfigMeta) error
+
 	// ListBroadcastTask list all broadcast tasks.
 	// Used to recovery the broadcast tasks.
 	ListBroadcastTask(ctx context.Context) ([]*streamingpb.BroadcastTask, error)
@@ -330,12 +364,44 @@ type StreamingCoordCataLog interface {
 	// Only return error if the ctx is canceled, otherwise it will retry until success.
 	SaveBroadcastTask(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask) error
 
+	// ListCrossVChannelTxn list all cross vchannel transactions.
+	// Used to recovery the cross vchannel transactions.
+	ListCrossVChannelTxn(ctx context.Context) ([]*streamingpb.CrossVChannelTxnMeta, error)
+
+	// SaveCrossVChannelTxn save the cross vchannel transaction to metastore.
+	// When the transaction is done, it will be removed from metastore.
+	// Only return error if the ctx is canceled, otherwise it will retry until success.
+	SaveCrossVChannelTxn(ctx context.Context, txn *streamingpb.CrossVChannelTxnMeta) error
+
 	// SaveReplicateConfiguration saves the replicate configuration to metastore.
+	// The history of the configuration is saved together if it's not nil, and the expired histories are removed.
 	// Only return error if the ctx is canceled, otherwise it will retry until success.
-	SaveReplicateConfiguration(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error
+	SaveReplicateConfiguration(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error
 
 	// GetReplicateConfiguration gets the replicate configuration from metastore.
 	GetReplicateConfiguration(ctx context.Context) (*streamingpb.ReplicateConfigurationMeta, error)
+
+	// ListReplicateConfigurationHistory lists the history of the replicate configuration ordered by version.
+	ListReplicateConfigurationHistory(ctx context.Context) ([]*streamingpb.ReplicateConfigurationHistoryMeta, error)
+
+	// ListReplicatePChannelArchive list all archives of the replicating tasks whose replication edge is removed.
+	ListReplicatePChannelArchive(ctx context.Context) ([]*streamingpb.ReplicatePChannelArchiveMeta, error)
+
+	// SaveReplicatePChannelArchives saves the archives of the replicating tasks into metastore,
+	// the replicating task of the archive is removed at the same time if the archive is marked as task removed.
+	SaveReplicatePChannelArchives(ctx context.Context, archives []*streamingpb.ReplicatePChannelArchiveMeta) error
+
+	// ListReplicatePChannels lists all replicating tasks.
+	ListReplicatePChannels(ctx context.Context) ([]*streamingpb.ReplicatePChannelMeta, error)
+
+	// SaveReplicatePChannels saves the replicating tasks into metastore, e.g. the state of the task is updated.
+	SaveReplicatePChannels(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error
+
+	// ListReplicateDeadLetters lists the dead letters of the parked replicating tasks, which are saved by the cdc.
+	ListReplicateDeadLetters(ctx context.Context) ([]*streamingpb.ReplicateDeadLetterMeta, error)
+
+	// RemoveReplicateDeadLetters removes the dead letters from metastore, so the parked replicating tasks are resumed by the cdc.
+	RemoveReplicateDeadLetters(ctx context.Context, deadLetters []*streamingpb.ReplicateDeadLetterMeta) error
 }
 
 // StreamingNodeCataLog is the interface for streamingnode catalog
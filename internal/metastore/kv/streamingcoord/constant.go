@@ -6,8 +6,30 @@ const (
 	BroadcastTaskPrefix = MetaPrefix + "broadcast-task/"
 	VersionKey          = MetaPrefix + "version"
 	CChannelMetaKey     = MetaPrefix + "cchannel"
+	PChannelPoolPrefix  = MetaPrefix + "pchannel-pool/"
+	IDAllocatorPrefix   = MetaPrefix + "id-allocator/"
+	BalancerConfigKey   = MetaPrefix + "balancer-config"
+
+	PChannelAntiAffinityGroupPrefix = MetaPrefix + "pchannel-anti-affinity/"
+
+	CrossVChannelTxnPrefix = MetaPrefix + "cross-vchannel-txn/"
+
+	// PChannelRegistryPrefix is the prefix of registered pchannels,
+	// it's watched directly on etcd by the channel provider, so it's never accessed by the catalog.
+	PChannelRegistryPrefix = MetaPrefix + "pchannel-registry/"
 
 	// Replicate
 	ReplicatePChannelMetaPrefix = MetaPrefix + "replicating-pchannel/"
 	ReplicateConfigurationKey   = MetaPrefix + "replicate-configuration"
+	// ReplicateConfigurationHistoryPrefix is the prefix of the accepted replicate configurations keyed by version.
+	ReplicateConfigurationHistoryPrefix = MetaPrefix + "replicate-config-history/"
+	// ReplicatePChannelArchivePrefix is the prefix of the archived replicating tasks,
+	// it should never share the prefix with ReplicatePChannelMetaPrefix, which is watched by the CDC.
+	ReplicatePChannelArchivePrefix = MetaPrefix + "replicate-pchannel-archive/"
+	// ReplicateDeadLetterPrefix is the prefix of the dead letters of the parked replicating tasks,
+	// the dead letters are saved by the CDC directly on etcd.
+	ReplicateDeadLetterPrefix = MetaPrefix + "replicate-dead-letter/"
+	// ReplicateFilteredCollectionsPrefix is the prefix of the collections filtered out by the collection filter of the replicating tasks,
+	// the filtered collections are saved by the CDC directly on etcd.
+	ReplicateFilteredCollectionsPrefix = MetaPrefix + "replicate-filtered-collections/"
 )
@@ -3,6 +3,7 @@ package streamingcoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -29,6 +30,21 @@ import (
 //	├── pchannel-1
 //	└── pchannel-2
 //
+// └── pchannel-pool
+//
+//	├── pool-1
+//	└── pool-2
+//
+// └── pchannel-anti-affinity
+//
+//	├── group-1
+//	└── group-2
+//
+// └── id-allocator
+//
+//	└── vchannel-suffix
+//
+// └── balancer-config
 // └── replicate-configuration
 // └── replicating-pchannel
 // │   ├── cluster-1-pchannel-1
@@ -110,6 +126,11 @@ func (c *catalog) SaveVersion(ctx context.Context, version *streamingpb.Streamin
 	return c.saveAndRemoveLegacyMeta(ctx, VersionKey, string(v))
 }
 
+// RemoveVersion removes the streaming version, the legacy key is removed together.
+func (c *catalog) RemoveVersion(ctx context.Context) error {
+	return c.metaKV.MultiSaveAndRemove(ctx, nil, []string{VersionKey, VersionKey + "/"})
+}
+
 func (c *catalog) loadMetaWithLegacyTrailingSlash(ctx context.Context, key string) (string, bool, bool, error) {
 	// Callers must serialize Get/Save for key; read repair is not CAS.
 	keys, values, err := c.metaKV.LoadWithPrefix(ctx, key)
@@ -174,6 +195,127 @@ func (c *catalog) SavePChannels(ctx context.Context, infos []*streamingpb.PChann
 	})
 }
 
+// ListPChannelPool returns all pchannel pools
+func (c *catalog) ListPChannelPool(ctx context.Context) ([]*streamingpb.PChannelPoolMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, PChannelPoolPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]*streamingpb.PChannelPoolMeta, 0, len(values))
+	for k, value := range values {
+		pool := &streamingpb.PChannelPoolMeta{}
+		if err = proto.Unmarshal([]byte(value), pool); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal pchannel pool %s failed", keys[k])
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// SavePChannelPools saves the pchannel pools and removes the dropped pools
+func (c *catalog) SavePChannelPools(ctx context.Context, pools []*streamingpb.PChannelPoolMeta, droppedPools []string) error {
+	kvs := make(map[string]string, len(pools))
+	for _, pool := range pools {
+		v, err := proto.Marshal(pool)
+		if err != nil {
+			return errors.Wrapf(err, "marshal pchannel pool %s failed", pool.GetName())
+		}
+		kvs[buildPChannelPoolPath(pool.GetName())] = string(v)
+	}
+	removals := make([]string, 0, len(droppedPools))
+	for _, name := range droppedPools {
+		removals = append(removals, buildPChannelPoolPath(name))
+	}
+	// the pools are few, so save them in one txn to keep the pool membership consistent.
+	return c.metaKV.MultiSaveAndRemove(ctx, kvs, removals)
+}
+
+// ListPChannelAntiAffinityGroup returns all pchannel anti-affinity groups
+func (c *catalog) ListPChannelAntiAffinityGroup(ctx context.Context) ([]*streamingpb.PChannelAntiAffinityGroupMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, PChannelAntiAffinityGroupPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*streamingpb.PChannelAntiAffinityGroupMeta, 0, len(values))
+	for k, value := range values {
+		group := &streamingpb.PChannelAntiAffinityGroupMeta{}
+		if err = proto.Unmarshal([]byte(value), group); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal pchannel anti-affinity group %s failed", keys[k])
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// SavePChannelAntiAffinityGroups saves the pchannel anti-affinity groups and removes the dropped groups
+func (c *catalog) SavePChannelAntiAffinityGroups(ctx context.Context, groups []*streamingpb.PChannelAntiAffinityGroupMeta, droppedGroups []string) error {
+	kvs := make(map[string]string, len(groups))
+	for _, group := range groups {
+		v, err := proto.Marshal(group)
+		if err != nil {
+			return errors.Wrapf(err, "marshal pchannel anti-affinity group %s failed", group.GetName())
+		}
+		kvs[buildPChannelAntiAffinityGroupPath(group.GetName())] = string(v)
+	}
+	removals := make([]string, 0, len(droppedGroups))
+	for _, name := range droppedGroups {
+		removals = append(removals, buildPChannelAntiAffinityGroupPath(name))
+	}
+	return c.metaKV.MultiSaveAndRemove(ctx, kvs, removals)
+}
+
+// GetIDAllocator returns the id allocator meta
+func (c *catalog) GetIDAllocator(ctx context.Context, name string) (*streamingpb.IDAllocatorMeta, error) {
+	value, err := c.metaKV.Load(ctx, buildIDAllocatorPath(name))
+	if err != nil {
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	meta := &streamingpb.IDAllocatorMeta{}
+	if err = proto.Unmarshal([]byte(value), meta); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal id allocator %s failed", name)
+	}
+	return meta, nil
+}
+
+// SaveIDAllocator saves the id allocator meta
+func (c *catalog) SaveIDAllocator(ctx context.Context, meta *streamingpb.IDAllocatorMeta) error {
+	v, err := proto.Marshal(meta)
+	if err != nil {
+		return errors.Wrapf(err, "marshal id allocator %s failed", meta.GetName())
+	}
+	return c.metaKV.Save(ctx, buildIDAllocatorPath(meta.GetName()), string(v))
+}
+
+// GetBalancerConfig returns the balancer config
+func (c *catalog) GetBalancerConfig(ctx context.Context) (*streamingpb.BalancerConfigMeta, error) {
+	value, err := c.metaKV.Load(ctx, BalancerConfigKey)
+	if err != nil {
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	config := &streamingpb.BalancerConfigMeta{}
+	if err = proto.Unmarshal([]byte(value), config); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal balancer config failed")
+	}
+	return config, nil
+}
+
+// SaveBalancerConfig saves the balancer config
+func (c *catalog) SaveBalancerConfig(ctx context.Context, config *streamingpb.BalancerConfigMeta) error {
+	v, err := proto.Marshal(config)
+	if err != nil {
+		return errors.Wrapf(err, "marshal balancer config failed")
+	}
+	return c.metaKV.Save(ctx, BalancerConfigKey, string(v))
+}
+
 func (c *catalog) ListBroadcastTask(ctx context.Context) ([]*streamingpb.BroadcastTask, error) {
 	keys, values, err := c.metaKV.LoadWithPrefix(ctx, BroadcastTaskPrefix)
 	if err != nil {
@@ -203,25 +345,83 @@ func (c *catalog) SaveBroadcastTask(ctx context.Context, broadcastID uint64, tas
 	return c.metaKV.Save(ctx, key, string(v))
 }
 
+func (c *catalog) ListCrossVChannelTxn(ctx context.Context) ([]*streamingpb.CrossVChannelTxnMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, CrossVChannelTxnPrefix)
+	if err != nil {
+		return nil, err
+	}
+	txns := make([]*streamingpb.CrossVChannelTxnMeta, 0, len(values))
+	for k, value := range values {
+		txn := &streamingpb.CrossVChannelTxnMeta{}
+		err = proto.Unmarshal([]byte(value), txn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshal cross vchannel txn %s failed", keys[k])
+		}
+		txns = append(txns, txn)
+	}
+	return txns, nil
+}
+
+func (c *catalog) SaveCrossVChannelTxn(ctx context.Context, txn *streamingpb.CrossVChannelTxnMeta) error {
+	key := buildCrossVChannelTxnPath(txn.GetTxnId())
+	if txn.GetState() == streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_DONE {
+		return c.metaKV.Remove(ctx, key)
+	}
+	v, err := proto.Marshal(txn)
+	if err != nil {
+		return errors.Wrapf(err, "marshal cross vchannel txn failed")
+	}
+	return c.metaKV.Save(ctx, key, string(v))
+}
+
 // buildPChannelInfoPath builds the path for pchannel info.
 func buildPChannelInfoPath(name string) string {
 	return PChannelMetaPrefix + name
 }
 
+// buildPChannelPoolPath builds the path for pchannel pool.
+func buildPChannelPoolPath(name string) string {
+	return PChannelPoolPrefix + name
+}
+
+// buildPChannelAntiAffinityGroupPath builds the path for pchannel anti-affinity group.
+func buildPChannelAntiAffinityGroupPath(name string) string {
+	return PChannelAntiAffinityGroupPrefix + name
+}
+
+// buildIDAllocatorPath builds the path for id allocator.
+func buildIDAllocatorPath(name string) string {
+	return IDAllocatorPrefix + name
+}
+
 // buildBroadcastTaskPath builds the path for broadcast task.
 func buildBroadcastTaskPath(id uint64) string {
 	return BroadcastTaskPrefix + strconv.FormatUint(id, 10)
 }
 
-func (c *catalog) SaveReplicateConfiguration(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+// buildCrossVChannelTxnPath builds the path for cross vchannel transaction.
+func buildCrossVChannelTxnPath(id int64) string {
+	return CrossVChannelTxnPrefix + strconv.FormatInt(id, 10)
+}
+
+func (c *catalog) SaveReplicateConfiguration(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
 	v, err := proto.Marshal(config)
 	if err != nil {
 		return errors.Wrapf(err, "marshal replicate configuration failed")
 	}
 
-	kvs := make(map[string]string, len(replicatingTasks)+1)
+	kvs := make(map[string]string, len(replicatingTasks)+2)
 	kvs[ReplicateConfigurationKey] = string(v)
 
+	maxHistoryCount := paramtable.Get().StreamingCfg.ReplicationConfigHistoryMaxCount.GetAsInt64()
+	if history != nil && maxHistoryCount > 0 {
+		v, err := proto.Marshal(history)
+		if err != nil {
+			return errors.Wrapf(err, "marshal replicate configuration history failed")
+		}
+		kvs[buildReplicateConfigurationHistoryPath(history.GetVersion())] = string(v)
+	}
+
 	for _, task := range replicatingTasks {
 		key := buildReplicatePChannelPath(task.GetTargetCluster().GetClusterId(), task.GetSourceChannelName())
 		v, err := proto.Marshal(task)
@@ -231,9 +431,54 @@ func (c *catalog) SaveReplicateConfiguration(ctx context.Context, config *stream
 		kvs[key] = string(v)
 	}
 	maxTxnNum := paramtable.Get().MetaStoreCfg.MaxEtcdTxnNum.GetAsInt()
-	return etcd.SaveByBatchWithLimit(kvs, maxTxnNum, func(partialKvs map[string]string) error {
+	if err := etcd.SaveByBatchWithLimit(kvs, maxTxnNum, func(partialKvs map[string]string) error {
 		return c.metaKV.MultiSave(ctx, partialKvs)
+	}); err != nil {
+		return err
+	}
+	if history == nil || history.GetVersion() <= maxHistoryCount {
+		return nil
+	}
+	return c.removeReplicateConfigurationHistoryBefore(ctx, history.GetVersion()-maxHistoryCount)
+}
+
+// removeReplicateConfigurationHistoryBefore removes the histories of the replicate configuration whose version is not greater than the given version.
+func (c *catalog) removeReplicateConfigurationHistoryBefore(ctx context.Context, version int64) error {
+	histories, err := c.ListReplicateConfigurationHistory(ctx)
+	if err != nil {
+		return err
+	}
+	removals := make([]string, 0, len(histories))
+	for _, history := range histories {
+		if history.GetVersion() <= version {
+			removals = append(removals, buildReplicateConfigurationHistoryPath(history.GetVersion()))
+		}
+	}
+	if len(removals) == 0 {
+		return nil
+	}
+	return c.metaKV.MultiRemove(ctx, removals)
+}
+
+// ListReplicateConfigurationHistory lists the history of the replicate configuration ordered by version.
+func (c *catalog) ListReplicateConfigurationHistory(ctx context.Context) ([]*streamingpb.ReplicateConfigurationHistoryMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, ReplicateConfigurationHistoryPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make([]*streamingpb.ReplicateConfigurationHistoryMeta, 0, len(values))
+	for k, value := range values {
+		history := &streamingpb.ReplicateConfigurationHistoryMeta{}
+		if err = proto.Unmarshal([]byte(value), history); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal replicate configuration history %s failed", keys[k])
+		}
+		histories = append(histories, history)
+	}
+	sort.Slice(histories, func(i, j int) bool {
+		return histories[i].GetVersion() < histories[j].GetVersion()
 	})
+	return histories, nil
 }
 
 func (c *catalog) GetReplicateConfiguration(ctx context.Context) (*streamingpb.ReplicateConfigurationMeta, error) {
@@ -252,6 +497,108 @@ func (c *catalog) GetReplicateConfiguration(ctx context.Context) (*streamingpb.R
 	return config, nil
 }
 
+// ListReplicatePChannelArchive lists all archives of the replicating tasks.
+func (c *catalog) ListReplicatePChannelArchive(ctx context.Context) ([]*streamingpb.ReplicatePChannelArchiveMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, ReplicatePChannelArchivePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	archives := make([]*streamingpb.ReplicatePChannelArchiveMeta, 0, len(values))
+	for k, value := range values {
+		archive := &streamingpb.ReplicatePChannelArchiveMeta{}
+		if err = proto.Unmarshal([]byte(value), archive); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal replicate pchannel archive %s failed", keys[k])
+		}
+		archives = append(archives, archive)
+	}
+	return archives, nil
+}
+
+// SaveReplicatePChannelArchives saves the archives of the replicating tasks,
+// and removes the replicating tasks and their dead letters and filtered collections of the archives that are marked as task removed.
+func (c *catalog) SaveReplicatePChannelArchives(ctx context.Context, archives []*streamingpb.ReplicatePChannelArchiveMeta) error {
+	kvs := make(map[string]string, len(archives))
+	removals := make([]string, 0, 3*len(archives))
+	for _, archive := range archives {
+		v, err := proto.Marshal(archive)
+		if err != nil {
+			return errors.Wrapf(err, "marshal replicate pchannel archive failed")
+		}
+		targetClusterID := archive.GetTask().GetTargetCluster().GetClusterId()
+		sourceChannelName := archive.GetTask().GetSourceChannelName()
+		kvs[buildReplicatePChannelArchivePath(targetClusterID, sourceChannelName)] = string(v)
+		if archive.GetTaskRemoved() {
+			removals = append(removals,
+				buildReplicatePChannelPath(targetClusterID, sourceChannelName),
+				BuildReplicateDeadLetterKey(targetClusterID, sourceChannelName),
+				BuildReplicateFilteredCollectionsKey(targetClusterID, sourceChannelName))
+		}
+	}
+	return c.metaKV.MultiSaveAndRemove(ctx, kvs, removals)
+}
+
+// ListReplicatePChannels lists all replicating tasks.
+func (c *catalog) ListReplicatePChannels(ctx context.Context) ([]*streamingpb.ReplicatePChannelMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, ReplicatePChannelMetaPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*streamingpb.ReplicatePChannelMeta, 0, len(values))
+	for k, value := range values {
+		task := &streamingpb.ReplicatePChannelMeta{}
+		if err = proto.Unmarshal([]byte(value), task); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal replicate pchannel meta %s failed", keys[k])
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// SaveReplicatePChannels saves the replicating tasks.
+func (c *catalog) SaveReplicatePChannels(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error {
+	kvs := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		v, err := proto.Marshal(task)
+		if err != nil {
+			return errors.Wrapf(err, "marshal replicate pchannel meta failed")
+		}
+		kvs[BuildReplicatePChannelMetaKey(task)] = string(v)
+	}
+	maxTxnNum := paramtable.Get().MetaStoreCfg.MaxEtcdTxnNum.GetAsInt()
+	return etcd.SaveByBatchWithLimit(kvs, maxTxnNum, func(partialKvs map[string]string) error {
+		return c.metaKV.MultiSave(ctx, partialKvs)
+	})
+}
+
+// ListReplicateDeadLetters lists the dead letters of the parked replicating tasks.
+func (c *catalog) ListReplicateDeadLetters(ctx context.Context) ([]*streamingpb.ReplicateDeadLetterMeta, error) {
+	keys, values, err := c.metaKV.LoadWithPrefix(ctx, ReplicateDeadLetterPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters := make([]*streamingpb.ReplicateDeadLetterMeta, 0, len(values))
+	for k, value := range values {
+		deadLetter := &streamingpb.ReplicateDeadLetterMeta{}
+		if err = proto.Unmarshal([]byte(value), deadLetter); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal replicate dead letter %s failed", keys[k])
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+// RemoveReplicateDeadLetters removes the dead letters of the parked replicating tasks.
+func (c *catalog) RemoveReplicateDeadLetters(ctx context.Context, deadLetters []*streamingpb.ReplicateDeadLetterMeta) error {
+	keys := make([]string, 0, len(deadLetters))
+	for _, deadLetter := range deadLetters {
+		keys = append(keys, BuildReplicateDeadLetterKey(deadLetter.GetTargetClusterId(), deadLetter.GetSourceChannelName()))
+	}
+	return c.metaKV.MultiRemove(ctx, keys)
+}
+
 func BuildReplicatePChannelMetaKey(meta *streamingpb.ReplicatePChannelMeta) string {
 	targetClusterID := meta.GetTargetCluster().GetClusterId()
 	sourceChannelName := meta.GetSourceChannelName()
@@ -261,3 +608,22 @@ func BuildReplicatePChannelMetaKey(meta *streamingpb.ReplicatePChannelMeta) stri
 func buildReplicatePChannelPath(targetClusterID, sourceChannelName string) string {
 	return fmt.Sprintf("%s%s-%s", ReplicatePChannelMetaPrefix, targetClusterID, sourceChannelName)
 }
+
+// BuildReplicateDeadLetterKey builds the key of the dead letter of a replicating task.
+func BuildReplicateDeadLetterKey(targetClusterID, sourceChannelName string) string {
+	return fmt.Sprintf("%s%s-%s", ReplicateDeadLetterPrefix, targetClusterID, sourceChannelName)
+}
+
+// BuildReplicateFilteredCollectionsKey builds the key of the collections filtered out by the collection filter of a replicating task.
+func BuildReplicateFilteredCollectionsKey(targetClusterID, sourceChannelName string) string {
+	return fmt.Sprintf("%s%s-%s", ReplicateFilteredCollectionsPrefix, targetClusterID, sourceChannelName)
+}
+
+// buildReplicateConfigurationHistoryPath builds the path for the history of the replicate configuration.
+func buildReplicateConfigurationHistoryPath(version int64) string {
+	return ReplicateConfigurationHistoryPrefix + strconv.FormatInt(version, 10)
+}
+
+func buildReplicatePChannelArchivePath(targetClusterID, sourceChannelName string) string {
+	return fmt.Sprintf("%s%s-%s", ReplicatePChannelArchivePrefix, targetClusterID, sourceChannelName)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/mocks/mock_kv"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 const (
@@ -85,6 +86,12 @@ func newTestCatalog(t *testing.T) (metastore.StreamingCoordCataLog, map[string]s
 		delete(kvStorage, key)
 		return nil
 	}).Maybe()
+	kv.EXPECT().MultiRemove(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, keys []string) error {
+		for _, key := range keys {
+			delete(kvStorage, key)
+		}
+		return nil
+	}).Maybe()
 	return NewCataLog(kv), kvStorage, kv
 }
 
@@ -110,6 +117,16 @@ func TestCatalog(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, v.Version, int64(1))
 
+	err = catalog.RemoveVersion(context.Background())
+	assert.NoError(t, err)
+	v, err = catalog.GetVersion(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	err = catalog.SaveVersion(context.Background(), &streamingpb.StreamingVersion{
+		Version: 1,
+	})
+	assert.NoError(t, err)
+
 	// CChannel test
 	err = catalog.SaveCChannel(context.Background(), &streamingpb.CChannelMeta{
 		Pchannel: "test",
@@ -165,6 +182,93 @@ func TestCatalog(t *testing.T) {
 		assert.Equal(t, streamingpb.BroadcastTaskState_BROADCAST_TASK_STATE_PENDING, task.State)
 	}
 
+	// CrossVChannelTxn test
+	err = catalog.SaveCrossVChannelTxn(context.Background(), &streamingpb.CrossVChannelTxnMeta{
+		TxnId:          1,
+		CollectionId:   100,
+		VchannelTxnIds: map[string]int64{"v1": 2, "v2": 3},
+		State:          streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_PREPARING,
+	})
+	assert.NoError(t, err)
+	err = catalog.SaveCrossVChannelTxn(context.Background(), &streamingpb.CrossVChannelTxnMeta{
+		TxnId: 4,
+		State: streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_COMMITTING,
+	})
+	assert.NoError(t, err)
+	txns, err := catalog.ListCrossVChannelTxn(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, txns, 2)
+
+	err = catalog.SaveCrossVChannelTxn(context.Background(), &streamingpb.CrossVChannelTxnMeta{
+		TxnId: 4,
+		State: streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_DONE,
+	})
+	assert.NoError(t, err)
+	txns, err = catalog.ListCrossVChannelTxn(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, txns, 1)
+	assert.Equal(t, int64(1), txns[0].GetTxnId())
+	assert.Equal(t, map[string]int64{"v1": 2, "v2": 3}, txns[0].GetVchannelTxnIds())
+
+	// PChannelPool test
+	err = catalog.SavePChannelPools(context.Background(), []*streamingpb.PChannelPoolMeta{
+		{Name: "pool1", Pchannels: []string{"test"}, Databases: []string{"db1"}},
+		{Name: "pool2", Pchannels: []string{"test2"}, Databases: []string{"db2"}},
+	}, nil)
+	assert.NoError(t, err)
+	pools, err := catalog.ListPChannelPool(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, pools, 2)
+	metas, err = catalog.ListPChannel(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, metas, 2)
+
+	err = catalog.SavePChannelPools(context.Background(), []*streamingpb.PChannelPoolMeta{
+		{Name: "pool1", Pchannels: []string{"test", "test2"}, Databases: []string{"db1"}},
+	}, []string{"pool2"})
+	assert.NoError(t, err)
+	pools, err = catalog.ListPChannelPool(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, pools, 1)
+	assert.Equal(t, []string{"test", "test2"}, pools[0].Pchannels)
+
+	// PChannelAntiAffinityGroup test
+	err = catalog.SavePChannelAntiAffinityGroups(context.Background(), []*streamingpb.PChannelAntiAffinityGroupMeta{
+		{Name: "group1", Pchannels: []string{"test", "test2"}},
+		{Name: "group2", Pchannels: []string{"test", "test3"}},
+	}, nil)
+	assert.NoError(t, err)
+	groups, err := catalog.ListPChannelAntiAffinityGroup(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, groups, 2)
+
+	err = catalog.SavePChannelAntiAffinityGroups(context.Background(), nil, []string{"group2"})
+	assert.NoError(t, err)
+	groups, err = catalog.ListPChannelAntiAffinityGroup(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "group1", groups[0].GetName())
+
+	// IDAllocator test
+	allocator, err := catalog.GetIDAllocator(context.Background(), "test")
+	assert.NoError(t, err)
+	assert.Nil(t, allocator)
+	err = catalog.SaveIDAllocator(context.Background(), &streamingpb.IDAllocatorMeta{Name: "test", Watermark: 100})
+	assert.NoError(t, err)
+	allocator, err = catalog.GetIDAllocator(context.Background(), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), allocator.GetWatermark())
+
+	// BalancerConfig test
+	balancerConfig, err := catalog.GetBalancerConfig(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, balancerConfig)
+	err = catalog.SaveBalancerConfig(context.Background(), &streamingpb.BalancerConfigMeta{BalanceFrozen: true})
+	assert.NoError(t, err)
+	balancerConfig, err = catalog.GetBalancerConfig(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, balancerConfig.GetBalanceFrozen())
+
 	// error path.
 	kv.EXPECT().LoadWithPrefix(mock.Anything, mock.Anything).Unset()
 	kv.EXPECT().LoadWithPrefix(mock.Anything, mock.Anything).Return(nil, nil, errors.New("load error"))
@@ -175,6 +279,14 @@ func TestCatalog(t *testing.T) {
 	tasks, err = catalog.ListBroadcastTask(context.Background())
 	assert.Error(t, err)
 	assert.Nil(t, tasks)
+
+	pools, err = catalog.ListPChannelPool(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, pools)
+
+	groups, err = catalog.ListPChannelAntiAffinityGroup(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, groups)
 }
 
 func TestCatalog_CChannelMetaKeyCompatibility(t *testing.T) {
@@ -439,7 +551,7 @@ func TestCatalog_VersionMetaKeyCompatibility(t *testing.T) {
 }
 
 func TestCatalog_ReplicationCatalog(t *testing.T) {
-	catalog, _, _ := newTestCatalog(t)
+	catalog, kvStorage, _ := newTestCatalog(t)
 
 	// ReplicateConfiguration test
 	config := &commonpb.ReplicateConfiguration{
@@ -468,7 +580,7 @@ func TestCatalog_ReplicationCatalog(t *testing.T) {
 			},
 		},
 	}
-	err := catalog.SaveReplicateConfiguration(context.Background(), &streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: config}, nil)
+	err := catalog.SaveReplicateConfiguration(context.Background(), &streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: config}, nil, nil)
 	assert.NoError(t, err)
 
 	cfg, err := catalog.GetReplicateConfiguration(context.Background())
@@ -484,6 +596,7 @@ func TestCatalog_ReplicationCatalog(t *testing.T) {
 	// ReplicatePChannel test
 	err = catalog.SaveReplicateConfiguration(context.Background(),
 		&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: config},
+		nil,
 		[]*streamingpb.ReplicatePChannelMeta{
 			{
 				SourceChannelName: "source-channel-1",
@@ -497,4 +610,106 @@ func TestCatalog_ReplicationCatalog(t *testing.T) {
 			},
 		})
 	assert.NoError(t, err)
+
+	tasks, err := catalog.ListReplicatePChannels(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	task := &streamingpb.ReplicatePChannelMeta{
+		SourceChannelName: "source-channel-2",
+		TargetChannelName: "target-channel-2",
+		TargetCluster:     &commonpb.MilvusCluster{ClusterId: "target-cluster"},
+		State:             streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED,
+	}
+	err = catalog.SaveReplicatePChannels(context.Background(), []*streamingpb.ReplicatePChannelMeta{task})
+	assert.NoError(t, err)
+	tasks, err = catalog.ListReplicatePChannels(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	for _, task := range tasks {
+		if task.GetSourceChannelName() == "source-channel-2" {
+			assert.Equal(t, streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED, task.GetState())
+		} else {
+			assert.Equal(t, streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING, task.GetState())
+		}
+	}
+
+	// ReplicatePChannelArchive test
+	archives, err := catalog.ListReplicatePChannelArchive(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, archives)
+	archive := &streamingpb.ReplicatePChannelArchiveMeta{
+		Task: &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: "source-channel-1",
+			TargetChannelName: "target-channel-1",
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: "target-cluster"},
+		},
+		FinalCheckpoint: &commonpb.ReplicateCheckpoint{ClusterId: "source-cluster", Pchannel: "source-channel-1", TimeTick: 100},
+	}
+	err = catalog.SaveReplicatePChannelArchives(context.Background(), []*streamingpb.ReplicatePChannelArchiveMeta{archive})
+	assert.NoError(t, err)
+	assert.Contains(t, kvStorage, buildReplicatePChannelPath("target-cluster", "source-channel-1"))
+	archives, err = catalog.ListReplicatePChannelArchive(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, archives, 1)
+	assert.Equal(t, uint64(100), archives[0].GetFinalCheckpoint().GetTimeTick())
+
+	// The replicating task and its dead letter and filtered collections are removed with the archive marked as task removed.
+	kvStorage[BuildReplicateDeadLetterKey("target-cluster", "source-channel-1")] = ""
+	kvStorage[BuildReplicateFilteredCollectionsKey("target-cluster", "source-channel-1")] = ""
+	archive.TaskRemoved = true
+	err = catalog.SaveReplicatePChannelArchives(context.Background(), []*streamingpb.ReplicatePChannelArchiveMeta{archive})
+	assert.NoError(t, err)
+	assert.NotContains(t, kvStorage, buildReplicatePChannelPath("target-cluster", "source-channel-1"))
+	assert.NotContains(t, kvStorage, BuildReplicateDeadLetterKey("target-cluster", "source-channel-1"))
+	assert.NotContains(t, kvStorage, BuildReplicateFilteredCollectionsKey("target-cluster", "source-channel-1"))
+	assert.Contains(t, kvStorage, buildReplicatePChannelPath("target-cluster", "source-channel-2"))
+	archives, err = catalog.ListReplicatePChannelArchive(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, archives, 1)
+	assert.True(t, archives[0].GetTaskRemoved())
+
+	// ReplicateDeadLetter test, the dead letters are saved by the cdc.
+	deadLetters, err := catalog.ListReplicateDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters)
+	deadLetter := &streamingpb.ReplicateDeadLetterMeta{
+		SourceChannelName: "source-channel-2",
+		TargetChannelName: "target-channel-2",
+		TargetClusterId:   "target-cluster",
+		TimeTick:          100,
+		Retries:           3,
+	}
+	v, err := proto.Marshal(deadLetter)
+	assert.NoError(t, err)
+	kvStorage[BuildReplicateDeadLetterKey("target-cluster", "source-channel-2")] = string(v)
+	deadLetters, err = catalog.ListReplicateDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, deadLetters, 1)
+	assert.Equal(t, int64(3), deadLetters[0].GetRetries())
+
+	err = catalog.RemoveReplicateDeadLetters(context.Background(), deadLetters)
+	assert.NoError(t, err)
+	deadLetters, err = catalog.ListReplicateDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetters)
+
+	// ReplicateConfigurationHistory test, only the latest histories are kept.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.ReplicationConfigHistoryMaxCount.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.ReplicationConfigHistoryMaxCount.Key)
+	for version := int64(1); version <= 3; version++ {
+		err = catalog.SaveReplicateConfiguration(context.Background(),
+			&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: config, Version: version},
+			&streamingpb.ReplicateConfigurationHistoryMeta{Version: version, ReplicateConfiguration: config, Operator: "root"},
+			nil)
+		assert.NoError(t, err)
+	}
+	histories, err := catalog.ListReplicateConfigurationHistory(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, histories, 2)
+	assert.Equal(t, int64(2), histories[0].GetVersion())
+	assert.Equal(t, int64(3), histories[1].GetVersion())
+	assert.Equal(t, "root", histories[1].GetOperator())
+	cfg, err = catalog.GetReplicateConfiguration(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), cfg.GetVersion())
 }
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	streamingpb "github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+
 	types "github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 )
 
@@ -138,6 +140,172 @@ func (_c *MockLocal_GetMetricsIfLocal_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetPChannelRetentions provides a mock function with given fields: ctx, pchannels
+func (_m *MockLocal) GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error) {
+	ret := _m.Called(ctx, pchannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPChannelRetentions")
+	}
+
+	var r0 []*streamingpb.PChannelRetention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*streamingpb.PChannelRetention, error)); ok {
+		return rf(ctx, pchannels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*streamingpb.PChannelRetention); ok {
+		r0 = rf(ctx, pchannels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.PChannelRetention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pchannels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocal_GetPChannelRetentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPChannelRetentions'
+type MockLocal_GetPChannelRetentions_Call struct {
+	*mock.Call
+}
+
+// GetPChannelRetentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pchannels []string
+func (_e *MockLocal_Expecter) GetPChannelRetentions(ctx interface{}, pchannels interface{}) *MockLocal_GetPChannelRetentions_Call {
+	return &MockLocal_GetPChannelRetentions_Call{Call: _e.mock.On("GetPChannelRetentions", ctx, pchannels)}
+}
+
+func (_c *MockLocal_GetPChannelRetentions_Call) Run(run func(ctx context.Context, pchannels []string)) *MockLocal_GetPChannelRetentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockLocal_GetPChannelRetentions_Call) Return(_a0 []*streamingpb.PChannelRetention, _a1 error) *MockLocal_GetPChannelRetentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocal_GetPChannelRetentions_Call) RunAndReturn(run func(context.Context, []string) ([]*streamingpb.PChannelRetention, error)) *MockLocal_GetPChannelRetentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportPChannelHandoff provides a mock function with given fields: ctx, channels
+func (_m *MockLocal) ReportPChannelHandoff(ctx context.Context, channels []types.PChannelInfo) error {
+	ret := _m.Called(ctx, channels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportPChannelHandoff")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.PChannelInfo) error); ok {
+		r0 = rf(ctx, channels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLocal_ReportPChannelHandoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportPChannelHandoff'
+type MockLocal_ReportPChannelHandoff_Call struct {
+	*mock.Call
+}
+
+// ReportPChannelHandoff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channels []types.PChannelInfo
+func (_e *MockLocal_Expecter) ReportPChannelHandoff(ctx interface{}, channels interface{}) *MockLocal_ReportPChannelHandoff_Call {
+	return &MockLocal_ReportPChannelHandoff_Call{Call: _e.mock.On("ReportPChannelHandoff", ctx, channels)}
+}
+
+func (_c *MockLocal_ReportPChannelHandoff_Call) Run(run func(ctx context.Context, channels []types.PChannelInfo)) *MockLocal_ReportPChannelHandoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]types.PChannelInfo))
+	})
+	return _c
+}
+
+func (_c *MockLocal_ReportPChannelHandoff_Call) Return(_a0 error) *MockLocal_ReportPChannelHandoff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLocal_ReportPChannelHandoff_Call) RunAndReturn(run func(context.Context, []types.PChannelInfo) error) *MockLocal_ReportPChannelHandoff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewPChannelLease provides a mock function with given fields: ctx, node, channels
+func (_m *MockLocal) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	ret := _m.Called(ctx, node, channels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewPChannelLease")
+	}
+
+	var r0 []types.PChannelInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) ([]types.PChannelInfo, error)); ok {
+		return rf(ctx, node, channels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) []types.PChannelInfo); ok {
+		r0 = rf(ctx, node, channels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.PChannelInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) error); ok {
+		r1 = rf(ctx, node, channels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocal_RenewPChannelLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewPChannelLease'
+type MockLocal_RenewPChannelLease_Call struct {
+	*mock.Call
+}
+
+// RenewPChannelLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - node types.StreamingNodeInfo
+//   - channels []types.PChannelInfo
+func (_e *MockLocal_Expecter) RenewPChannelLease(ctx interface{}, node interface{}, channels interface{}) *MockLocal_RenewPChannelLease_Call {
+	return &MockLocal_RenewPChannelLease_Call{Call: _e.mock.On("RenewPChannelLease", ctx, node, channels)}
+}
+
+func (_c *MockLocal_RenewPChannelLease_Call) Run(run func(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo)) *MockLocal_RenewPChannelLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.StreamingNodeInfo), args[2].([]types.PChannelInfo))
+	})
+	return _c
+}
+
+func (_c *MockLocal_RenewPChannelLease_Call) Return(_a0 []types.PChannelInfo, _a1 error) *MockLocal_RenewPChannelLease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocal_RenewPChannelLease_Call) RunAndReturn(run func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) ([]types.PChannelInfo, error)) *MockLocal_RenewPChannelLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockLocal creates a new instance of MockLocal. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockLocal(t interface {
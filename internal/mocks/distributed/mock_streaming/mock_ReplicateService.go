@@ -90,6 +90,64 @@ func (_c *MockReplicateService_Append_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetClusterChannels provides a mock function with given fields: ctx
+func (_m *MockReplicateService) GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterChannels")
+	}
+
+	var r0 *milvuspb.ClusterInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.ClusterInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.ClusterInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ClusterInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReplicateService_GetClusterChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterChannels'
+type MockReplicateService_GetClusterChannels_Call struct {
+	*mock.Call
+}
+
+// GetClusterChannels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReplicateService_Expecter) GetClusterChannels(ctx interface{}) *MockReplicateService_GetClusterChannels_Call {
+	return &MockReplicateService_GetClusterChannels_Call{Call: _e.mock.On("GetClusterChannels", ctx)}
+}
+
+func (_c *MockReplicateService_GetClusterChannels_Call) Run(run func(ctx context.Context)) *MockReplicateService_GetClusterChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReplicateService_GetClusterChannels_Call) Return(_a0 *milvuspb.ClusterInfo, _a1 error) *MockReplicateService_GetClusterChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReplicateService_GetClusterChannels_Call) RunAndReturn(run func(context.Context) (*milvuspb.ClusterInfo, error)) *MockReplicateService_GetClusterChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReplicateCheckpoint provides a mock function with given fields: ctx, channelName
 func (_m *MockReplicateService) GetReplicateCheckpoint(ctx context.Context, channelName string) (*wal.ReplicateCheckpoint, error) {
 	ret := _m.Called(ctx, channelName)
@@ -226,6 +226,65 @@ func (_c *MockWALAccesser_ControlChannel_Call) RunAndReturn(run func() string) *
 	return _c
 }
 
+// CrossVChannelTxn provides a mock function with given fields: ctx, opts
+func (_m *MockWALAccesser) CrossVChannelTxn(ctx context.Context, opts streaming.CrossVChannelTxnOption) (streaming.CrossVChannelTxn, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CrossVChannelTxn")
+	}
+
+	var r0 streaming.CrossVChannelTxn
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, streaming.CrossVChannelTxnOption) (streaming.CrossVChannelTxn, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, streaming.CrossVChannelTxnOption) streaming.CrossVChannelTxn); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(streaming.CrossVChannelTxn)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, streaming.CrossVChannelTxnOption) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWALAccesser_CrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CrossVChannelTxn'
+type MockWALAccesser_CrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// CrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts streaming.CrossVChannelTxnOption
+func (_e *MockWALAccesser_Expecter) CrossVChannelTxn(ctx interface{}, opts interface{}) *MockWALAccesser_CrossVChannelTxn_Call {
+	return &MockWALAccesser_CrossVChannelTxn_Call{Call: _e.mock.On("CrossVChannelTxn", ctx, opts)}
+}
+
+func (_c *MockWALAccesser_CrossVChannelTxn_Call) Run(run func(ctx context.Context, opts streaming.CrossVChannelTxnOption)) *MockWALAccesser_CrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(streaming.CrossVChannelTxnOption))
+	})
+	return _c
+}
+
+func (_c *MockWALAccesser_CrossVChannelTxn_Call) Return(_a0 streaming.CrossVChannelTxn, _a1 error) *MockWALAccesser_CrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWALAccesser_CrossVChannelTxn_Call) RunAndReturn(run func(context.Context, streaming.CrossVChannelTxnOption) (streaming.CrossVChannelTxn, error)) *MockWALAccesser_CrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ForwardService provides a mock function with no fields
 func (_m *MockWALAccesser) ForwardService() streaming.ForwardService {
 	ret := _m.Called()
@@ -549,6 +608,54 @@ func (_c *MockWALAccesser_Replicate_Call) RunAndReturn(run func() streaming.Repl
 	return _c
 }
 
+// TruncateWAL provides a mock function with given fields: ctx, pchannel, truncateTo
+func (_m *MockWALAccesser) TruncateWAL(ctx context.Context, pchannel string, truncateTo message.MessageID) error {
+	ret := _m.Called(ctx, pchannel, truncateTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateWAL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, message.MessageID) error); ok {
+		r0 = rf(ctx, pchannel, truncateTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWALAccesser_TruncateWAL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateWAL'
+type MockWALAccesser_TruncateWAL_Call struct {
+	*mock.Call
+}
+
+// TruncateWAL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pchannel string
+//   - truncateTo message.MessageID
+func (_e *MockWALAccesser_Expecter) TruncateWAL(ctx interface{}, pchannel interface{}, truncateTo interface{}) *MockWALAccesser_TruncateWAL_Call {
+	return &MockWALAccesser_TruncateWAL_Call{Call: _e.mock.On("TruncateWAL", ctx, pchannel, truncateTo)}
+}
+
+func (_c *MockWALAccesser_TruncateWAL_Call) Run(run func(ctx context.Context, pchannel string, truncateTo message.MessageID)) *MockWALAccesser_TruncateWAL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(message.MessageID))
+	})
+	return _c
+}
+
+func (_c *MockWALAccesser_TruncateWAL_Call) Return(_a0 error) *MockWALAccesser_TruncateWAL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWALAccesser_TruncateWAL_Call) RunAndReturn(run func(context.Context, string, message.MessageID) error) *MockWALAccesser_TruncateWAL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWALAccesser creates a new instance of MockWALAccesser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWALAccesser(t interface {
@@ -23,6 +23,64 @@ func (_m *MockStreamingCoordCataLog) EXPECT() *MockStreamingCoordCataLog_Expecte
 	return &MockStreamingCoordCataLog_Expecter{mock: &_m.Mock}
 }
 
+// GetBalancerConfig provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) GetBalancerConfig(ctx context.Context) (*streamingpb.BalancerConfigMeta, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalancerConfig")
+	}
+
+	var r0 *streamingpb.BalancerConfigMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*streamingpb.BalancerConfigMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *streamingpb.BalancerConfigMeta); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.BalancerConfigMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordCataLog_GetBalancerConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalancerConfig'
+type MockStreamingCoordCataLog_GetBalancerConfig_Call struct {
+	*mock.Call
+}
+
+// GetBalancerConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStreamingCoordCataLog_Expecter) GetBalancerConfig(ctx interface{}) *MockStreamingCoordCataLog_GetBalancerConfig_Call {
+	return &MockStreamingCoordCataLog_GetBalancerConfig_Call{Call: _e.mock.On("GetBalancerConfig", ctx)}
+}
+
+func (_c *MockStreamingCoordCataLog_GetBalancerConfig_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_GetBalancerConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_GetBalancerConfig_Call) Return(_a0 *streamingpb.BalancerConfigMeta, _a1 error) *MockStreamingCoordCataLog_GetBalancerConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_GetBalancerConfig_Call) RunAndReturn(run func(context.Context) (*streamingpb.BalancerConfigMeta, error)) *MockStreamingCoordCataLog_GetBalancerConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCChannel provides a mock function with given fields: ctx
 func (_m *MockStreamingCoordCataLog) GetCChannel(ctx context.Context) (*streamingpb.CChannelMeta, error) {
 	ret := _m.Called(ctx)
@@ -81,6 +139,65 @@ func (_c *MockStreamingCoordCataLog_GetCChannel_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetIDAllocator provides a mock function with given fields: ctx, name
+func (_m *MockStreamingCoordCataLog) GetIDAllocator(ctx context.Context, name string) (*streamingpb.IDAllocatorMeta, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIDAllocator")
+	}
+
+	var r0 *streamingpb.IDAllocatorMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*streamingpb.IDAllocatorMeta, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *streamingpb.IDAllocatorMeta); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.IDAllocatorMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordCataLog_GetIDAllocator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIDAllocator'
+type MockStreamingCoordCataLog_GetIDAllocator_Call struct {
+	*mock.Call
+}
+
+// GetIDAllocator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockStreamingCoordCataLog_Expecter) GetIDAllocator(ctx interface{}, name interface{}) *MockStreamingCoordCataLog_GetIDAllocator_Call {
+	return &MockStreamingCoordCataLog_GetIDAllocator_Call{Call: _e.mock.On("GetIDAllocator", ctx, name)}
+}
+
+func (_c *MockStreamingCoordCataLog_GetIDAllocator_Call) Run(run func(ctx context.Context, name string)) *MockStreamingCoordCataLog_GetIDAllocator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_GetIDAllocator_Call) Return(_a0 *streamingpb.IDAllocatorMeta, _a1 error) *MockStreamingCoordCataLog_GetIDAllocator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_GetIDAllocator_Call) RunAndReturn(run func(context.Context, string) (*streamingpb.IDAllocatorMeta, error)) *MockStreamingCoordCataLog_GetIDAllocator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReplicateConfiguration provides a mock function with given fields: ctx
 func (_m *MockStreamingCoordCataLog) GetReplicateConfiguration(ctx context.Context) (*streamingpb.ReplicateConfigurationMeta, error) {
 	ret := _m.Called(ctx)
@@ -255,6 +372,64 @@ func (_c *MockStreamingCoordCataLog_ListBroadcastTask_Call) RunAndReturn(run fun
 	return _c
 }
 
+// ListCrossVChannelTxn provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListCrossVChannelTxn(ctx context.Context) ([]*streamingpb.CrossVChannelTxnMeta, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCrossVChannelTxn")
+	}
+
+	var r0 []*streamingpb.CrossVChannelTxnMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.CrossVChannelTxnMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.CrossVChannelTxnMeta); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.CrossVChannelTxnMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordCataLog_ListCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCrossVChannelTxn'
+type MockStreamingCoordCataLog_ListCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// ListCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStreamingCoordCataLog_Expecter) ListCrossVChannelTxn(ctx interface{}) *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call {
+	return &MockStreamingCoordCataLog_ListCrossVChannelTxn_Call{Call: _e.mock.On("ListCrossVChannelTxn", ctx)}
+}
+
+func (_c *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call) Return(_a0 []*streamingpb.CrossVChannelTxnMeta, _a1 error) *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.CrossVChannelTxnMeta, error)) *MockStreamingCoordCataLog_ListCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPChannel provides a mock function with given fields: ctx
 func (_m *MockStreamingCoordCataLog) ListPChannel(ctx context.Context) ([]*streamingpb.PChannelMeta, error) {
 	ret := _m.Called(ctx)
@@ -313,182 +488,861 @@ func (_c *MockStreamingCoordCataLog_ListPChannel_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// SaveBroadcastTask provides a mock function with given fields: ctx, broadcastID, task
-func (_m *MockStreamingCoordCataLog) SaveBroadcastTask(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask) error {
-	ret := _m.Called(ctx, broadcastID, task)
+// ListPChannelAntiAffinityGroup provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListPChannelAntiAffinityGroup(ctx context.Context) ([]*streamingpb.PChannelAntiAffinityGroupMeta, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveBroadcastTask")
+		panic("no return value specified for ListPChannelAntiAffinityGroup")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, *streamingpb.BroadcastTask) error); ok {
-		r0 = rf(ctx, broadcastID, task)
+	var r0 []*streamingpb.PChannelAntiAffinityGroupMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.PChannelAntiAffinityGroupMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.PChannelAntiAffinityGroupMeta); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.PChannelAntiAffinityGroupMeta)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStreamingCoordCataLog_SaveBroadcastTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBroadcastTask'
-type MockStreamingCoordCataLog_SaveBroadcastTask_Call struct {
+// MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPChannelAntiAffinityGroup'
+type MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call struct {
 	*mock.Call
 }
 
-// SaveBroadcastTask is a helper method to define mock.On call
+// ListPChannelAntiAffinityGroup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - broadcastID uint64
-//   - task *streamingpb.BroadcastTask
-func (_e *MockStreamingCoordCataLog_Expecter) SaveBroadcastTask(ctx interface{}, broadcastID interface{}, task interface{}) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
-	return &MockStreamingCoordCataLog_SaveBroadcastTask_Call{Call: _e.mock.On("SaveBroadcastTask", ctx, broadcastID, task)}
+func (_e *MockStreamingCoordCataLog_Expecter) ListPChannelAntiAffinityGroup(ctx interface{}) *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call {
+	return &MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call{Call: _e.mock.On("ListPChannelAntiAffinityGroup", ctx)}
 }
 
-func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) Run(run func(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask)) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
+func (_c *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(*streamingpb.BroadcastTask))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call) Return(_a0 []*streamingpb.PChannelAntiAffinityGroupMeta, _a1 error) *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) RunAndReturn(run func(context.Context, uint64, *streamingpb.BroadcastTask) error) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
+func (_c *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.PChannelAntiAffinityGroupMeta, error)) *MockStreamingCoordCataLog_ListPChannelAntiAffinityGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveCChannel provides a mock function with given fields: ctx, info
-func (_m *MockStreamingCoordCataLog) SaveCChannel(ctx context.Context, info *streamingpb.CChannelMeta) error {
-	ret := _m.Called(ctx, info)
+// ListPChannelPool provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListPChannelPool(ctx context.Context) ([]*streamingpb.PChannelPoolMeta, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveCChannel")
+		panic("no return value specified for ListPChannelPool")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CChannelMeta) error); ok {
-		r0 = rf(ctx, info)
+	var r0 []*streamingpb.PChannelPoolMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.PChannelPoolMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.PChannelPoolMeta); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.PChannelPoolMeta)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStreamingCoordCataLog_SaveCChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCChannel'
-type MockStreamingCoordCataLog_SaveCChannel_Call struct {
+// MockStreamingCoordCataLog_ListPChannelPool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPChannelPool'
+type MockStreamingCoordCataLog_ListPChannelPool_Call struct {
 	*mock.Call
 }
 
-// SaveCChannel is a helper method to define mock.On call
+// ListPChannelPool is a helper method to define mock.On call
 //   - ctx context.Context
-//   - info *streamingpb.CChannelMeta
-func (_e *MockStreamingCoordCataLog_Expecter) SaveCChannel(ctx interface{}, info interface{}) *MockStreamingCoordCataLog_SaveCChannel_Call {
-	return &MockStreamingCoordCataLog_SaveCChannel_Call{Call: _e.mock.On("SaveCChannel", ctx, info)}
+func (_e *MockStreamingCoordCataLog_Expecter) ListPChannelPool(ctx interface{}) *MockStreamingCoordCataLog_ListPChannelPool_Call {
+	return &MockStreamingCoordCataLog_ListPChannelPool_Call{Call: _e.mock.On("ListPChannelPool", ctx)}
 }
 
-func (_c *MockStreamingCoordCataLog_SaveCChannel_Call) Run(run func(ctx context.Context, info *streamingpb.CChannelMeta)) *MockStreamingCoordCataLog_SaveCChannel_Call {
+func (_c *MockStreamingCoordCataLog_ListPChannelPool_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListPChannelPool_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*streamingpb.CChannelMeta))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SaveCChannel_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveCChannel_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStreamingCoordCataLog_ListPChannelPool_Call) Return(_a0 []*streamingpb.PChannelPoolMeta, _a1 error) *MockStreamingCoordCataLog_ListPChannelPool_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SaveCChannel_Call) RunAndReturn(run func(context.Context, *streamingpb.CChannelMeta) error) *MockStreamingCoordCataLog_SaveCChannel_Call {
+func (_c *MockStreamingCoordCataLog_ListPChannelPool_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.PChannelPoolMeta, error)) *MockStreamingCoordCataLog_ListPChannelPool_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SavePChannels provides a mock function with given fields: ctx, info
-func (_m *MockStreamingCoordCataLog) SavePChannels(ctx context.Context, info []*streamingpb.PChannelMeta) error {
-	ret := _m.Called(ctx, info)
+// ListReplicateConfigurationHistory provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListReplicateConfigurationHistory(ctx context.Context) ([]*streamingpb.ReplicateConfigurationHistoryMeta, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SavePChannels")
+		panic("no return value specified for ListReplicateConfigurationHistory")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.PChannelMeta) error); ok {
-		r0 = rf(ctx, info)
+	var r0 []*streamingpb.ReplicateConfigurationHistoryMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.ReplicateConfigurationHistoryMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.ReplicateConfigurationHistoryMeta); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.ReplicateConfigurationHistoryMeta)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStreamingCoordCataLog_SavePChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePChannels'
-type MockStreamingCoordCataLog_SavePChannels_Call struct {
+// MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicateConfigurationHistory'
+type MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call struct {
 	*mock.Call
 }
 
-// SavePChannels is a helper method to define mock.On call
+// ListReplicateConfigurationHistory is a helper method to define mock.On call
 //   - ctx context.Context
-//   - info []*streamingpb.PChannelMeta
-func (_e *MockStreamingCoordCataLog_Expecter) SavePChannels(ctx interface{}, info interface{}) *MockStreamingCoordCataLog_SavePChannels_Call {
-	return &MockStreamingCoordCataLog_SavePChannels_Call{Call: _e.mock.On("SavePChannels", ctx, info)}
+func (_e *MockStreamingCoordCataLog_Expecter) ListReplicateConfigurationHistory(ctx interface{}) *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call {
+	return &MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call{Call: _e.mock.On("ListReplicateConfigurationHistory", ctx)}
 }
 
-func (_c *MockStreamingCoordCataLog_SavePChannels_Call) Run(run func(ctx context.Context, info []*streamingpb.PChannelMeta)) *MockStreamingCoordCataLog_SavePChannels_Call {
+func (_c *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]*streamingpb.PChannelMeta))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SavePChannels_Call) Return(_a0 error) *MockStreamingCoordCataLog_SavePChannels_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call) Return(_a0 []*streamingpb.ReplicateConfigurationHistoryMeta, _a1 error) *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SavePChannels_Call) RunAndReturn(run func(context.Context, []*streamingpb.PChannelMeta) error) *MockStreamingCoordCataLog_SavePChannels_Call {
+func (_c *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.ReplicateConfigurationHistoryMeta, error)) *MockStreamingCoordCataLog_ListReplicateConfigurationHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveReplicateConfiguration provides a mock function with given fields: ctx, config, replicatingTasks
-func (_m *MockStreamingCoordCataLog) SaveReplicateConfiguration(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
-	ret := _m.Called(ctx, config, replicatingTasks)
+// ListReplicateDeadLetters provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListReplicateDeadLetters(ctx context.Context) ([]*streamingpb.ReplicateDeadLetterMeta, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveReplicateConfiguration")
+		panic("no return value specified for ListReplicateDeadLetters")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ReplicateConfigurationMeta, []*streamingpb.ReplicatePChannelMeta) error); ok {
-		r0 = rf(ctx, config, replicatingTasks)
+	var r0 []*streamingpb.ReplicateDeadLetterMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.ReplicateDeadLetterMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.ReplicateDeadLetterMeta); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.ReplicateDeadLetterMeta)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStreamingCoordCataLog_SaveReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReplicateConfiguration'
-type MockStreamingCoordCataLog_SaveReplicateConfiguration_Call struct {
+// MockStreamingCoordCataLog_ListReplicateDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicateDeadLetters'
+type MockStreamingCoordCataLog_ListReplicateDeadLetters_Call struct {
 	*mock.Call
 }
 
-// SaveReplicateConfiguration is a helper method to define mock.On call
+// ListReplicateDeadLetters is a helper method to define mock.On call
 //   - ctx context.Context
-//   - config *streamingpb.ReplicateConfigurationMeta
-//   - replicatingTasks []*streamingpb.ReplicatePChannelMeta
-func (_e *MockStreamingCoordCataLog_Expecter) SaveReplicateConfiguration(ctx interface{}, config interface{}, replicatingTasks interface{}) *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call {
-	return &MockStreamingCoordCataLog_SaveReplicateConfiguration_Call{Call: _e.mock.On("SaveReplicateConfiguration", ctx, config, replicatingTasks)}
+func (_e *MockStreamingCoordCataLog_Expecter) ListReplicateDeadLetters(ctx interface{}) *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call {
+	return &MockStreamingCoordCataLog_ListReplicateDeadLetters_Call{Call: _e.mock.On("ListReplicateDeadLetters", ctx)}
 }
 
-func (_c *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call) Run(run func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta)) *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call {
+func (_c *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*streamingpb.ReplicateConfigurationMeta), args[2].([]*streamingpb.ReplicatePChannelMeta))
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call) Return(_a0 []*streamingpb.ReplicateDeadLetterMeta, _a1 error) *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.ReplicateDeadLetterMeta, error)) *MockStreamingCoordCataLog_ListReplicateDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicatePChannelArchive provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListReplicatePChannelArchive(ctx context.Context) ([]*streamingpb.ReplicatePChannelArchiveMeta, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicatePChannelArchive")
+	}
+
+	var r0 []*streamingpb.ReplicatePChannelArchiveMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.ReplicatePChannelArchiveMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.ReplicatePChannelArchiveMeta); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.ReplicatePChannelArchiveMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicatePChannelArchive'
+type MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call struct {
+	*mock.Call
+}
+
+// ListReplicatePChannelArchive is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStreamingCoordCataLog_Expecter) ListReplicatePChannelArchive(ctx interface{}) *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call {
+	return &MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call{Call: _e.mock.On("ListReplicatePChannelArchive", ctx)}
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call) Return(_a0 []*streamingpb.ReplicatePChannelArchiveMeta, _a1 error) *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.ReplicatePChannelArchiveMeta, error)) *MockStreamingCoordCataLog_ListReplicatePChannelArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicatePChannels provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) ListReplicatePChannels(ctx context.Context) ([]*streamingpb.ReplicatePChannelMeta, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicatePChannels")
+	}
+
+	var r0 []*streamingpb.ReplicatePChannelMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*streamingpb.ReplicatePChannelMeta, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*streamingpb.ReplicatePChannelMeta); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.ReplicatePChannelMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordCataLog_ListReplicatePChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicatePChannels'
+type MockStreamingCoordCataLog_ListReplicatePChannels_Call struct {
+	*mock.Call
+}
+
+// ListReplicatePChannels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStreamingCoordCataLog_Expecter) ListReplicatePChannels(ctx interface{}) *MockStreamingCoordCataLog_ListReplicatePChannels_Call {
+	return &MockStreamingCoordCataLog_ListReplicatePChannels_Call{Call: _e.mock.On("ListReplicatePChannels", ctx)}
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicatePChannels_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_ListReplicatePChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicatePChannels_Call) Return(_a0 []*streamingpb.ReplicatePChannelMeta, _a1 error) *MockStreamingCoordCataLog_ListReplicatePChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_ListReplicatePChannels_Call) RunAndReturn(run func(context.Context) ([]*streamingpb.ReplicatePChannelMeta, error)) *MockStreamingCoordCataLog_ListReplicatePChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReplicateDeadLetters provides a mock function with given fields: ctx, deadLetters
+func (_m *MockStreamingCoordCataLog) RemoveReplicateDeadLetters(ctx context.Context, deadLetters []*streamingpb.ReplicateDeadLetterMeta) error {
+	ret := _m.Called(ctx, deadLetters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReplicateDeadLetters")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.ReplicateDeadLetterMeta) error); ok {
+		r0 = rf(ctx, deadLetters)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReplicateDeadLetters'
+type MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call struct {
+	*mock.Call
+}
+
+// RemoveReplicateDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deadLetters []*streamingpb.ReplicateDeadLetterMeta
+func (_e *MockStreamingCoordCataLog_Expecter) RemoveReplicateDeadLetters(ctx interface{}, deadLetters interface{}) *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call {
+	return &MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call{Call: _e.mock.On("RemoveReplicateDeadLetters", ctx, deadLetters)}
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call) Run(run func(ctx context.Context, deadLetters []*streamingpb.ReplicateDeadLetterMeta)) *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.ReplicateDeadLetterMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call) Return(_a0 error) *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call) RunAndReturn(run func(context.Context, []*streamingpb.ReplicateDeadLetterMeta) error) *MockStreamingCoordCataLog_RemoveReplicateDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVersion provides a mock function with given fields: ctx
+func (_m *MockStreamingCoordCataLog) RemoveVersion(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_RemoveVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveVersion'
+type MockStreamingCoordCataLog_RemoveVersion_Call struct {
+	*mock.Call
+}
+
+// RemoveVersion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStreamingCoordCataLog_Expecter) RemoveVersion(ctx interface{}) *MockStreamingCoordCataLog_RemoveVersion_Call {
+	return &MockStreamingCoordCataLog_RemoveVersion_Call{Call: _e.mock.On("RemoveVersion", ctx)}
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveVersion_Call) Run(run func(ctx context.Context)) *MockStreamingCoordCataLog_RemoveVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveVersion_Call) Return(_a0 error) *MockStreamingCoordCataLog_RemoveVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_RemoveVersion_Call) RunAndReturn(run func(context.Context) error) *MockStreamingCoordCataLog_RemoveVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBalancerConfig provides a mock function with given fields: ctx, config
+func (_m *MockStreamingCoordCataLog) SaveBalancerConfig(ctx context.Context, config *streamingpb.BalancerConfigMeta) error {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBalancerConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.BalancerConfigMeta) error); ok {
+		r0 = rf(ctx, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveBalancerConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBalancerConfig'
+type MockStreamingCoordCataLog_SaveBalancerConfig_Call struct {
+	*mock.Call
+}
+
+// SaveBalancerConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *streamingpb.BalancerConfigMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveBalancerConfig(ctx interface{}, config interface{}) *MockStreamingCoordCataLog_SaveBalancerConfig_Call {
+	return &MockStreamingCoordCataLog_SaveBalancerConfig_Call{Call: _e.mock.On("SaveBalancerConfig", ctx, config)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveBalancerConfig_Call) Run(run func(ctx context.Context, config *streamingpb.BalancerConfigMeta)) *MockStreamingCoordCataLog_SaveBalancerConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.BalancerConfigMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveBalancerConfig_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveBalancerConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveBalancerConfig_Call) RunAndReturn(run func(context.Context, *streamingpb.BalancerConfigMeta) error) *MockStreamingCoordCataLog_SaveBalancerConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBroadcastTask provides a mock function with given fields: ctx, broadcastID, task
+func (_m *MockStreamingCoordCataLog) SaveBroadcastTask(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask) error {
+	ret := _m.Called(ctx, broadcastID, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBroadcastTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *streamingpb.BroadcastTask) error); ok {
+		r0 = rf(ctx, broadcastID, task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveBroadcastTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBroadcastTask'
+type MockStreamingCoordCataLog_SaveBroadcastTask_Call struct {
+	*mock.Call
+}
+
+// SaveBroadcastTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - broadcastID uint64
+//   - task *streamingpb.BroadcastTask
+func (_e *MockStreamingCoordCataLog_Expecter) SaveBroadcastTask(ctx interface{}, broadcastID interface{}, task interface{}) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
+	return &MockStreamingCoordCataLog_SaveBroadcastTask_Call{Call: _e.mock.On("SaveBroadcastTask", ctx, broadcastID, task)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) Run(run func(ctx context.Context, broadcastID uint64, task *streamingpb.BroadcastTask)) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*streamingpb.BroadcastTask))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveBroadcastTask_Call) RunAndReturn(run func(context.Context, uint64, *streamingpb.BroadcastTask) error) *MockStreamingCoordCataLog_SaveBroadcastTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCChannel provides a mock function with given fields: ctx, info
+func (_m *MockStreamingCoordCataLog) SaveCChannel(ctx context.Context, info *streamingpb.CChannelMeta) error {
+	ret := _m.Called(ctx, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCChannel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CChannelMeta) error); ok {
+		r0 = rf(ctx, info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveCChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCChannel'
+type MockStreamingCoordCataLog_SaveCChannel_Call struct {
+	*mock.Call
+}
+
+// SaveCChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - info *streamingpb.CChannelMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveCChannel(ctx interface{}, info interface{}) *MockStreamingCoordCataLog_SaveCChannel_Call {
+	return &MockStreamingCoordCataLog_SaveCChannel_Call{Call: _e.mock.On("SaveCChannel", ctx, info)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveCChannel_Call) Run(run func(ctx context.Context, info *streamingpb.CChannelMeta)) *MockStreamingCoordCataLog_SaveCChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.CChannelMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveCChannel_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveCChannel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveCChannel_Call) RunAndReturn(run func(context.Context, *streamingpb.CChannelMeta) error) *MockStreamingCoordCataLog_SaveCChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCrossVChannelTxn provides a mock function with given fields: ctx, txn
+func (_m *MockStreamingCoordCataLog) SaveCrossVChannelTxn(ctx context.Context, txn *streamingpb.CrossVChannelTxnMeta) error {
+	ret := _m.Called(ctx, txn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCrossVChannelTxn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CrossVChannelTxnMeta) error); ok {
+		r0 = rf(ctx, txn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCrossVChannelTxn'
+type MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// SaveCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txn *streamingpb.CrossVChannelTxnMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveCrossVChannelTxn(ctx interface{}, txn interface{}) *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call {
+	return &MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call{Call: _e.mock.On("SaveCrossVChannelTxn", ctx, txn)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call) Run(run func(ctx context.Context, txn *streamingpb.CrossVChannelTxnMeta)) *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.CrossVChannelTxnMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, *streamingpb.CrossVChannelTxnMeta) error) *MockStreamingCoordCataLog_SaveCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveIDAllocator provides a mock function with given fields: ctx, meta
+func (_m *MockStreamingCoordCataLog) SaveIDAllocator(ctx context.Context, meta *streamingpb.IDAllocatorMeta) error {
+	ret := _m.Called(ctx, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIDAllocator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.IDAllocatorMeta) error); ok {
+		r0 = rf(ctx, meta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveIDAllocator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIDAllocator'
+type MockStreamingCoordCataLog_SaveIDAllocator_Call struct {
+	*mock.Call
+}
+
+// SaveIDAllocator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - meta *streamingpb.IDAllocatorMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveIDAllocator(ctx interface{}, meta interface{}) *MockStreamingCoordCataLog_SaveIDAllocator_Call {
+	return &MockStreamingCoordCataLog_SaveIDAllocator_Call{Call: _e.mock.On("SaveIDAllocator", ctx, meta)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveIDAllocator_Call) Run(run func(ctx context.Context, meta *streamingpb.IDAllocatorMeta)) *MockStreamingCoordCataLog_SaveIDAllocator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.IDAllocatorMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveIDAllocator_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveIDAllocator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveIDAllocator_Call) RunAndReturn(run func(context.Context, *streamingpb.IDAllocatorMeta) error) *MockStreamingCoordCataLog_SaveIDAllocator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePChannelAntiAffinityGroups provides a mock function with given fields: ctx, groups, droppedGroups
+func (_m *MockStreamingCoordCataLog) SavePChannelAntiAffinityGroups(ctx context.Context, groups []*streamingpb.PChannelAntiAffinityGroupMeta, droppedGroups []string) error {
+	ret := _m.Called(ctx, groups, droppedGroups)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePChannelAntiAffinityGroups")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.PChannelAntiAffinityGroupMeta, []string) error); ok {
+		r0 = rf(ctx, groups, droppedGroups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePChannelAntiAffinityGroups'
+type MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call struct {
+	*mock.Call
+}
+
+// SavePChannelAntiAffinityGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groups []*streamingpb.PChannelAntiAffinityGroupMeta
+//   - droppedGroups []string
+func (_e *MockStreamingCoordCataLog_Expecter) SavePChannelAntiAffinityGroups(ctx interface{}, groups interface{}, droppedGroups interface{}) *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call {
+	return &MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call{Call: _e.mock.On("SavePChannelAntiAffinityGroups", ctx, groups, droppedGroups)}
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call) Run(run func(ctx context.Context, groups []*streamingpb.PChannelAntiAffinityGroupMeta, droppedGroups []string)) *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.PChannelAntiAffinityGroupMeta), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call) Return(_a0 error) *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call) RunAndReturn(run func(context.Context, []*streamingpb.PChannelAntiAffinityGroupMeta, []string) error) *MockStreamingCoordCataLog_SavePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePChannelPools provides a mock function with given fields: ctx, pools, droppedPools
+func (_m *MockStreamingCoordCataLog) SavePChannelPools(ctx context.Context, pools []*streamingpb.PChannelPoolMeta, droppedPools []string) error {
+	ret := _m.Called(ctx, pools, droppedPools)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePChannelPools")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.PChannelPoolMeta, []string) error); ok {
+		r0 = rf(ctx, pools, droppedPools)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SavePChannelPools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePChannelPools'
+type MockStreamingCoordCataLog_SavePChannelPools_Call struct {
+	*mock.Call
+}
+
+// SavePChannelPools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pools []*streamingpb.PChannelPoolMeta
+//   - droppedPools []string
+func (_e *MockStreamingCoordCataLog_Expecter) SavePChannelPools(ctx interface{}, pools interface{}, droppedPools interface{}) *MockStreamingCoordCataLog_SavePChannelPools_Call {
+	return &MockStreamingCoordCataLog_SavePChannelPools_Call{Call: _e.mock.On("SavePChannelPools", ctx, pools, droppedPools)}
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannelPools_Call) Run(run func(ctx context.Context, pools []*streamingpb.PChannelPoolMeta, droppedPools []string)) *MockStreamingCoordCataLog_SavePChannelPools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.PChannelPoolMeta), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannelPools_Call) Return(_a0 error) *MockStreamingCoordCataLog_SavePChannelPools_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannelPools_Call) RunAndReturn(run func(context.Context, []*streamingpb.PChannelPoolMeta, []string) error) *MockStreamingCoordCataLog_SavePChannelPools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePChannels provides a mock function with given fields: ctx, info
+func (_m *MockStreamingCoordCataLog) SavePChannels(ctx context.Context, info []*streamingpb.PChannelMeta) error {
+	ret := _m.Called(ctx, info)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePChannels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.PChannelMeta) error); ok {
+		r0 = rf(ctx, info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SavePChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePChannels'
+type MockStreamingCoordCataLog_SavePChannels_Call struct {
+	*mock.Call
+}
+
+// SavePChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - info []*streamingpb.PChannelMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SavePChannels(ctx interface{}, info interface{}) *MockStreamingCoordCataLog_SavePChannels_Call {
+	return &MockStreamingCoordCataLog_SavePChannels_Call{Call: _e.mock.On("SavePChannels", ctx, info)}
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannels_Call) Run(run func(ctx context.Context, info []*streamingpb.PChannelMeta)) *MockStreamingCoordCataLog_SavePChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.PChannelMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannels_Call) Return(_a0 error) *MockStreamingCoordCataLog_SavePChannels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SavePChannels_Call) RunAndReturn(run func(context.Context, []*streamingpb.PChannelMeta) error) *MockStreamingCoordCataLog_SavePChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveReplicateConfiguration provides a mock function with given fields: ctx, config, history, replicatingTasks
+func (_m *MockStreamingCoordCataLog) SaveReplicateConfiguration(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+	ret := _m.Called(ctx, config, history, replicatingTasks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReplicateConfiguration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ReplicateConfigurationMeta, *streamingpb.ReplicateConfigurationHistoryMeta, []*streamingpb.ReplicatePChannelMeta) error); ok {
+		r0 = rf(ctx, config, history, replicatingTasks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReplicateConfiguration'
+type MockStreamingCoordCataLog_SaveReplicateConfiguration_Call struct {
+	*mock.Call
+}
+
+// SaveReplicateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *streamingpb.ReplicateConfigurationMeta
+//   - history *streamingpb.ReplicateConfigurationHistoryMeta
+//   - replicatingTasks []*streamingpb.ReplicatePChannelMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveReplicateConfiguration(ctx interface{}, config interface{}, history interface{}, replicatingTasks interface{}) *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call {
+	return &MockStreamingCoordCataLog_SaveReplicateConfiguration_Call{Call: _e.mock.On("SaveReplicateConfiguration", ctx, config, history, replicatingTasks)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call) Run(run func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta)) *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ReplicateConfigurationMeta), args[2].(*streamingpb.ReplicateConfigurationHistoryMeta), args[3].([]*streamingpb.ReplicatePChannelMeta))
 	})
 	return _c
 }
@@ -498,7 +1352,101 @@ func (_c *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call) Return(_a0
 	return _c
 }
 
-func (_c *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call) RunAndReturn(run func(context.Context, *streamingpb.ReplicateConfigurationMeta, []*streamingpb.ReplicatePChannelMeta) error) *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call {
+func (_c *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call) RunAndReturn(run func(context.Context, *streamingpb.ReplicateConfigurationMeta, *streamingpb.ReplicateConfigurationHistoryMeta, []*streamingpb.ReplicatePChannelMeta) error) *MockStreamingCoordCataLog_SaveReplicateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveReplicatePChannelArchives provides a mock function with given fields: ctx, archives
+func (_m *MockStreamingCoordCataLog) SaveReplicatePChannelArchives(ctx context.Context, archives []*streamingpb.ReplicatePChannelArchiveMeta) error {
+	ret := _m.Called(ctx, archives)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReplicatePChannelArchives")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.ReplicatePChannelArchiveMeta) error); ok {
+		r0 = rf(ctx, archives)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReplicatePChannelArchives'
+type MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call struct {
+	*mock.Call
+}
+
+// SaveReplicatePChannelArchives is a helper method to define mock.On call
+//   - ctx context.Context
+//   - archives []*streamingpb.ReplicatePChannelArchiveMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveReplicatePChannelArchives(ctx interface{}, archives interface{}) *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call {
+	return &MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call{Call: _e.mock.On("SaveReplicatePChannelArchives", ctx, archives)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call) Run(run func(ctx context.Context, archives []*streamingpb.ReplicatePChannelArchiveMeta)) *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.ReplicatePChannelArchiveMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call) RunAndReturn(run func(context.Context, []*streamingpb.ReplicatePChannelArchiveMeta) error) *MockStreamingCoordCataLog_SaveReplicatePChannelArchives_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveReplicatePChannels provides a mock function with given fields: ctx, tasks
+func (_m *MockStreamingCoordCataLog) SaveReplicatePChannels(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error {
+	ret := _m.Called(ctx, tasks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveReplicatePChannels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.ReplicatePChannelMeta) error); ok {
+		r0 = rf(ctx, tasks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStreamingCoordCataLog_SaveReplicatePChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveReplicatePChannels'
+type MockStreamingCoordCataLog_SaveReplicatePChannels_Call struct {
+	*mock.Call
+}
+
+// SaveReplicatePChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tasks []*streamingpb.ReplicatePChannelMeta
+func (_e *MockStreamingCoordCataLog_Expecter) SaveReplicatePChannels(ctx interface{}, tasks interface{}) *MockStreamingCoordCataLog_SaveReplicatePChannels_Call {
+	return &MockStreamingCoordCataLog_SaveReplicatePChannels_Call{Call: _e.mock.On("SaveReplicatePChannels", ctx, tasks)}
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicatePChannels_Call) Run(run func(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta)) *MockStreamingCoordCataLog_SaveReplicatePChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.ReplicatePChannelMeta))
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicatePChannels_Call) Return(_a0 error) *MockStreamingCoordCataLog_SaveReplicatePChannels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreamingCoordCataLog_SaveReplicatePChannels_Call) RunAndReturn(run func(context.Context, []*streamingpb.ReplicatePChannelMeta) error) *MockStreamingCoordCataLog_SaveReplicatePChannels_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,8 +5,12 @@ package mock_client
 import (
 	assignment "github.com/milvus-io/milvus/internal/streamingcoord/client/assignment"
 
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+
 	context "context"
 
+	messagespb "github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 
 	mock "github.com/stretchr/testify/mock"
@@ -27,80 +31,1379 @@ type MockAssignmentService_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockAssignmentService) EXPECT() *MockAssignmentService_Expecter {
-	return &MockAssignmentService_Expecter{mock: &_m.Mock}
+func (_m *MockAssignmentService) EXPECT() *MockAssignmentService_Expecter {
+	return &MockAssignmentService_Expecter{mock: &_m.Mock}
+}
+
+// AssignmentDiscover provides a mock function with given fields: ctx, cb
+func (_m *MockAssignmentService) AssignmentDiscover(ctx context.Context, cb func(*types.VersionedStreamingNodeAssignments) error) error {
+	ret := _m.Called(ctx, cb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignmentDiscover")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(*types.VersionedStreamingNodeAssignments) error) error); ok {
+		r0 = rf(ctx, cb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAssignmentService_AssignmentDiscover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignmentDiscover'
+type MockAssignmentService_AssignmentDiscover_Call struct {
+	*mock.Call
+}
+
+// AssignmentDiscover is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cb func(*types.VersionedStreamingNodeAssignments) error
+func (_e *MockAssignmentService_Expecter) AssignmentDiscover(ctx interface{}, cb interface{}) *MockAssignmentService_AssignmentDiscover_Call {
+	return &MockAssignmentService_AssignmentDiscover_Call{Call: _e.mock.On("AssignmentDiscover", ctx, cb)}
+}
+
+func (_c *MockAssignmentService_AssignmentDiscover_Call) Run(run func(ctx context.Context, cb func(*types.VersionedStreamingNodeAssignments) error)) *MockAssignmentService_AssignmentDiscover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(*types.VersionedStreamingNodeAssignments) error))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_AssignmentDiscover_Call) Return(_a0 error) *MockAssignmentService_AssignmentDiscover_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAssignmentService_AssignmentDiscover_Call) RunAndReturn(run func(context.Context, func(*types.VersionedStreamingNodeAssignments) error) error) *MockAssignmentService_AssignmentDiscover_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckReplicateSchemaConsistency provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) CheckReplicateSchemaConsistency(ctx context.Context, req *streamingpb.CheckReplicateSchemaConsistencyRequest) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckReplicateSchemaConsistency")
+	}
+
+	var r0 *streamingpb.CheckReplicateSchemaConsistencyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest) *streamingpb.CheckReplicateSchemaConsistencyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.CheckReplicateSchemaConsistencyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_CheckReplicateSchemaConsistency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckReplicateSchemaConsistency'
+type MockAssignmentService_CheckReplicateSchemaConsistency_Call struct {
+	*mock.Call
+}
+
+// CheckReplicateSchemaConsistency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.CheckReplicateSchemaConsistencyRequest
+func (_e *MockAssignmentService_Expecter) CheckReplicateSchemaConsistency(ctx interface{}, req interface{}) *MockAssignmentService_CheckReplicateSchemaConsistency_Call {
+	return &MockAssignmentService_CheckReplicateSchemaConsistency_Call{Call: _e.mock.On("CheckReplicateSchemaConsistency", ctx, req)}
+}
+
+func (_c *MockAssignmentService_CheckReplicateSchemaConsistency_Call) Run(run func(ctx context.Context, req *streamingpb.CheckReplicateSchemaConsistencyRequest)) *MockAssignmentService_CheckReplicateSchemaConsistency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.CheckReplicateSchemaConsistencyRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_CheckReplicateSchemaConsistency_Call) Return(_a0 *streamingpb.CheckReplicateSchemaConsistencyResponse, _a1 error) *MockAssignmentService_CheckReplicateSchemaConsistency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_CheckReplicateSchemaConsistency_Call) RunAndReturn(run func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error)) *MockAssignmentService_CheckReplicateSchemaConsistency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrainNode provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) DrainNode(ctx context.Context, req *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainNode")
+	}
+
+	var r0 *streamingpb.DrainNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.DrainNodeRequest) *streamingpb.DrainNodeResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.DrainNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.DrainNodeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_DrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainNode'
+type MockAssignmentService_DrainNode_Call struct {
+	*mock.Call
+}
+
+// DrainNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.DrainNodeRequest
+func (_e *MockAssignmentService_Expecter) DrainNode(ctx interface{}, req interface{}) *MockAssignmentService_DrainNode_Call {
+	return &MockAssignmentService_DrainNode_Call{Call: _e.mock.On("DrainNode", ctx, req)}
+}
+
+func (_c *MockAssignmentService_DrainNode_Call) Run(run func(ctx context.Context, req *streamingpb.DrainNodeRequest)) *MockAssignmentService_DrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.DrainNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_DrainNode_Call) Return(_a0 *streamingpb.DrainNodeResponse, _a1 error) *MockAssignmentService_DrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_DrainNode_Call) RunAndReturn(run func(context.Context, *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error)) *MockAssignmentService_DrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DryRunReplicateConfiguration provides a mock function with given fields: ctx, config
+func (_m *MockAssignmentService) DryRunReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunReplicateConfiguration")
+	}
+
+	var r0 *streamingpb.ReplicateConfigurationPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *commonpb.ReplicateConfiguration) *streamingpb.ReplicateConfigurationPlan); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ReplicateConfigurationPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *commonpb.ReplicateConfiguration) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_DryRunReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunReplicateConfiguration'
+type MockAssignmentService_DryRunReplicateConfiguration_Call struct {
+	*mock.Call
+}
+
+// DryRunReplicateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *commonpb.ReplicateConfiguration
+func (_e *MockAssignmentService_Expecter) DryRunReplicateConfiguration(ctx interface{}, config interface{}) *MockAssignmentService_DryRunReplicateConfiguration_Call {
+	return &MockAssignmentService_DryRunReplicateConfiguration_Call{Call: _e.mock.On("DryRunReplicateConfiguration", ctx, config)}
+}
+
+func (_c *MockAssignmentService_DryRunReplicateConfiguration_Call) Run(run func(ctx context.Context, config *commonpb.ReplicateConfiguration)) *MockAssignmentService_DryRunReplicateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*commonpb.ReplicateConfiguration))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_DryRunReplicateConfiguration_Call) Return(_a0 *streamingpb.ReplicateConfigurationPlan, _a1 error) *MockAssignmentService_DryRunReplicateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_DryRunReplicateConfiguration_Call) RunAndReturn(run func(context.Context, *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error)) *MockAssignmentService_DryRunReplicateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportState provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) ExportState(ctx context.Context, req *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportState")
+	}
+
+	var r0 *streamingpb.ExportStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ExportStateRequest) *streamingpb.ExportStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ExportStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ExportStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_ExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportState'
+type MockAssignmentService_ExportState_Call struct {
+	*mock.Call
+}
+
+// ExportState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ExportStateRequest
+func (_e *MockAssignmentService_Expecter) ExportState(ctx interface{}, req interface{}) *MockAssignmentService_ExportState_Call {
+	return &MockAssignmentService_ExportState_Call{Call: _e.mock.On("ExportState", ctx, req)}
+}
+
+func (_c *MockAssignmentService_ExportState_Call) Run(run func(ctx context.Context, req *streamingpb.ExportStateRequest)) *MockAssignmentService_ExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ExportStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_ExportState_Call) Return(_a0 *streamingpb.ExportStateResponse, _a1 error) *MockAssignmentService_ExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_ExportState_Call) RunAndReturn(run func(context.Context, *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error)) *MockAssignmentService_ExportState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssignmentHistory provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) GetAssignmentHistory(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignmentHistory")
+	}
+
+	var r0 *streamingpb.GetAssignmentHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest) *streamingpb.GetAssignmentHistoryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetAssignmentHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetAssignmentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignmentHistory'
+type MockAssignmentService_GetAssignmentHistory_Call struct {
+	*mock.Call
+}
+
+// GetAssignmentHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.GetAssignmentHistoryRequest
+func (_e *MockAssignmentService_Expecter) GetAssignmentHistory(ctx interface{}, req interface{}) *MockAssignmentService_GetAssignmentHistory_Call {
+	return &MockAssignmentService_GetAssignmentHistory_Call{Call: _e.mock.On("GetAssignmentHistory", ctx, req)}
+}
+
+func (_c *MockAssignmentService_GetAssignmentHistory_Call) Run(run func(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest)) *MockAssignmentService_GetAssignmentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.GetAssignmentHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetAssignmentHistory_Call) Return(_a0 *streamingpb.GetAssignmentHistoryResponse, _a1 error) *MockAssignmentService_GetAssignmentHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetAssignmentHistory_Call) RunAndReturn(run func(context.Context, *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error)) *MockAssignmentService_GetAssignmentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClusterChannels provides a mock function with given fields: ctx
+func (_m *MockAssignmentService) GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterChannels")
+	}
+
+	var r0 *milvuspb.ClusterInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*milvuspb.ClusterInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *milvuspb.ClusterInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ClusterInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetClusterChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterChannels'
+type MockAssignmentService_GetClusterChannels_Call struct {
+	*mock.Call
+}
+
+// GetClusterChannels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAssignmentService_Expecter) GetClusterChannels(ctx interface{}) *MockAssignmentService_GetClusterChannels_Call {
+	return &MockAssignmentService_GetClusterChannels_Call{Call: _e.mock.On("GetClusterChannels", ctx)}
+}
+
+func (_c *MockAssignmentService_GetClusterChannels_Call) Run(run func(ctx context.Context)) *MockAssignmentService_GetClusterChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetClusterChannels_Call) Return(_a0 *milvuspb.ClusterInfo, _a1 error) *MockAssignmentService_GetClusterChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetClusterChannels_Call) RunAndReturn(run func(context.Context) (*milvuspb.ClusterInfo, error)) *MockAssignmentService_GetClusterChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestAssignments provides a mock function with given fields: ctx
+func (_m *MockAssignmentService) GetLatestAssignments(ctx context.Context) (*types.VersionedStreamingNodeAssignments, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestAssignments")
+	}
+
+	var r0 *types.VersionedStreamingNodeAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*types.VersionedStreamingNodeAssignments, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *types.VersionedStreamingNodeAssignments); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.VersionedStreamingNodeAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetLatestAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestAssignments'
+type MockAssignmentService_GetLatestAssignments_Call struct {
+	*mock.Call
+}
+
+// GetLatestAssignments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAssignmentService_Expecter) GetLatestAssignments(ctx interface{}) *MockAssignmentService_GetLatestAssignments_Call {
+	return &MockAssignmentService_GetLatestAssignments_Call{Call: _e.mock.On("GetLatestAssignments", ctx)}
+}
+
+func (_c *MockAssignmentService_GetLatestAssignments_Call) Run(run func(ctx context.Context)) *MockAssignmentService_GetLatestAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetLatestAssignments_Call) Return(_a0 *types.VersionedStreamingNodeAssignments, _a1 error) *MockAssignmentService_GetLatestAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetLatestAssignments_Call) RunAndReturn(run func(context.Context) (*types.VersionedStreamingNodeAssignments, error)) *MockAssignmentService_GetLatestAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestStreamingVersion provides a mock function with given fields: ctx
+func (_m *MockAssignmentService) GetLatestStreamingVersion(ctx context.Context) (*streamingpb.StreamingVersion, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestStreamingVersion")
+	}
+
+	var r0 *streamingpb.StreamingVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*streamingpb.StreamingVersion, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *streamingpb.StreamingVersion); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.StreamingVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetLatestStreamingVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStreamingVersion'
+type MockAssignmentService_GetLatestStreamingVersion_Call struct {
+	*mock.Call
+}
+
+// GetLatestStreamingVersion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAssignmentService_Expecter) GetLatestStreamingVersion(ctx interface{}) *MockAssignmentService_GetLatestStreamingVersion_Call {
+	return &MockAssignmentService_GetLatestStreamingVersion_Call{Call: _e.mock.On("GetLatestStreamingVersion", ctx)}
+}
+
+func (_c *MockAssignmentService_GetLatestStreamingVersion_Call) Run(run func(ctx context.Context)) *MockAssignmentService_GetLatestStreamingVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetLatestStreamingVersion_Call) Return(_a0 *streamingpb.StreamingVersion, _a1 error) *MockAssignmentService_GetLatestStreamingVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetLatestStreamingVersion_Call) RunAndReturn(run func(context.Context) (*streamingpb.StreamingVersion, error)) *MockAssignmentService_GetLatestStreamingVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPChannelRetentions provides a mock function with given fields: ctx, pchannels
+func (_m *MockAssignmentService) GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error) {
+	ret := _m.Called(ctx, pchannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPChannelRetentions")
+	}
+
+	var r0 []*streamingpb.PChannelRetention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*streamingpb.PChannelRetention, error)); ok {
+		return rf(ctx, pchannels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*streamingpb.PChannelRetention); ok {
+		r0 = rf(ctx, pchannels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*streamingpb.PChannelRetention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pchannels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetPChannelRetentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPChannelRetentions'
+type MockAssignmentService_GetPChannelRetentions_Call struct {
+	*mock.Call
+}
+
+// GetPChannelRetentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pchannels []string
+func (_e *MockAssignmentService_Expecter) GetPChannelRetentions(ctx interface{}, pchannels interface{}) *MockAssignmentService_GetPChannelRetentions_Call {
+	return &MockAssignmentService_GetPChannelRetentions_Call{Call: _e.mock.On("GetPChannelRetentions", ctx, pchannels)}
+}
+
+func (_c *MockAssignmentService_GetPChannelRetentions_Call) Run(run func(ctx context.Context, pchannels []string)) *MockAssignmentService_GetPChannelRetentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetPChannelRetentions_Call) Return(_a0 []*streamingpb.PChannelRetention, _a1 error) *MockAssignmentService_GetPChannelRetentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetPChannelRetentions_Call) RunAndReturn(run func(context.Context, []string) ([]*streamingpb.PChannelRetention, error)) *MockAssignmentService_GetPChannelRetentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplicateConfiguration provides a mock function with given fields: ctx, opts
+func (_m *MockAssignmentService) GetReplicateConfiguration(ctx context.Context, opts ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplicateConfiguration")
+	}
+
+	var r0 *replicateutil.ConfigHelper
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error)); ok {
+		return rf(ctx, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...assignment.GetReplicateConfigurationOpt) *replicateutil.ConfigHelper); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*replicateutil.ConfigHelper)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...assignment.GetReplicateConfigurationOpt) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicateConfiguration'
+type MockAssignmentService_GetReplicateConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetReplicateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts ...assignment.GetReplicateConfigurationOpt
+func (_e *MockAssignmentService_Expecter) GetReplicateConfiguration(ctx interface{}, opts ...interface{}) *MockAssignmentService_GetReplicateConfiguration_Call {
+	return &MockAssignmentService_GetReplicateConfiguration_Call{Call: _e.mock.On("GetReplicateConfiguration",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockAssignmentService_GetReplicateConfiguration_Call) Run(run func(ctx context.Context, opts ...assignment.GetReplicateConfigurationOpt)) *MockAssignmentService_GetReplicateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]assignment.GetReplicateConfigurationOpt, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(assignment.GetReplicateConfigurationOpt)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetReplicateConfiguration_Call) Return(_a0 *replicateutil.ConfigHelper, _a1 error) *MockAssignmentService_GetReplicateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetReplicateConfiguration_Call) RunAndReturn(run func(context.Context, ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error)) *MockAssignmentService_GetReplicateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplicateConfigurationHistory provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) GetReplicateConfigurationHistory(ctx context.Context, req *streamingpb.GetReplicateConfigurationHistoryRequest) (*streamingpb.GetReplicateConfigurationHistoryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplicateConfigurationHistory")
+	}
+
+	var r0 *streamingpb.GetReplicateConfigurationHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest) (*streamingpb.GetReplicateConfigurationHistoryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest) *streamingpb.GetReplicateConfigurationHistoryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetReplicateConfigurationHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_GetReplicateConfigurationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicateConfigurationHistory'
+type MockAssignmentService_GetReplicateConfigurationHistory_Call struct {
+	*mock.Call
+}
+
+// GetReplicateConfigurationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.GetReplicateConfigurationHistoryRequest
+func (_e *MockAssignmentService_Expecter) GetReplicateConfigurationHistory(ctx interface{}, req interface{}) *MockAssignmentService_GetReplicateConfigurationHistory_Call {
+	return &MockAssignmentService_GetReplicateConfigurationHistory_Call{Call: _e.mock.On("GetReplicateConfigurationHistory", ctx, req)}
+}
+
+func (_c *MockAssignmentService_GetReplicateConfigurationHistory_Call) Run(run func(ctx context.Context, req *streamingpb.GetReplicateConfigurationHistoryRequest)) *MockAssignmentService_GetReplicateConfigurationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.GetReplicateConfigurationHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_GetReplicateConfigurationHistory_Call) Return(_a0 *streamingpb.GetReplicateConfigurationHistoryResponse, _a1 error) *MockAssignmentService_GetReplicateConfigurationHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_GetReplicateConfigurationHistory_Call) RunAndReturn(run func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest) (*streamingpb.GetReplicateConfigurationHistoryResponse, error)) *MockAssignmentService_GetReplicateConfigurationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicateDeadLetters provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) ListReplicateDeadLetters(ctx context.Context, req *streamingpb.ListReplicateDeadLettersRequest) (*streamingpb.ListReplicateDeadLettersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicateDeadLetters")
+	}
+
+	var r0 *streamingpb.ListReplicateDeadLettersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicateDeadLettersRequest) (*streamingpb.ListReplicateDeadLettersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicateDeadLettersRequest) *streamingpb.ListReplicateDeadLettersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ListReplicateDeadLettersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ListReplicateDeadLettersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_ListReplicateDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicateDeadLetters'
+type MockAssignmentService_ListReplicateDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListReplicateDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ListReplicateDeadLettersRequest
+func (_e *MockAssignmentService_Expecter) ListReplicateDeadLetters(ctx interface{}, req interface{}) *MockAssignmentService_ListReplicateDeadLetters_Call {
+	return &MockAssignmentService_ListReplicateDeadLetters_Call{Call: _e.mock.On("ListReplicateDeadLetters", ctx, req)}
+}
+
+func (_c *MockAssignmentService_ListReplicateDeadLetters_Call) Run(run func(ctx context.Context, req *streamingpb.ListReplicateDeadLettersRequest)) *MockAssignmentService_ListReplicateDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ListReplicateDeadLettersRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_ListReplicateDeadLetters_Call) Return(_a0 *streamingpb.ListReplicateDeadLettersResponse, _a1 error) *MockAssignmentService_ListReplicateDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_ListReplicateDeadLetters_Call) RunAndReturn(run func(context.Context, *streamingpb.ListReplicateDeadLettersRequest) (*streamingpb.ListReplicateDeadLettersResponse, error)) *MockAssignmentService_ListReplicateDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicatingTasks provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) ListReplicatingTasks(ctx context.Context, req *streamingpb.ListReplicatingTasksRequest) (*streamingpb.ListReplicatingTasksResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicatingTasks")
+	}
+
+	var r0 *streamingpb.ListReplicatingTasksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicatingTasksRequest) (*streamingpb.ListReplicatingTasksResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicatingTasksRequest) *streamingpb.ListReplicatingTasksResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ListReplicatingTasksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ListReplicatingTasksRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_ListReplicatingTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicatingTasks'
+type MockAssignmentService_ListReplicatingTasks_Call struct {
+	*mock.Call
+}
+
+// ListReplicatingTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ListReplicatingTasksRequest
+func (_e *MockAssignmentService_Expecter) ListReplicatingTasks(ctx interface{}, req interface{}) *MockAssignmentService_ListReplicatingTasks_Call {
+	return &MockAssignmentService_ListReplicatingTasks_Call{Call: _e.mock.On("ListReplicatingTasks", ctx, req)}
+}
+
+func (_c *MockAssignmentService_ListReplicatingTasks_Call) Run(run func(ctx context.Context, req *streamingpb.ListReplicatingTasksRequest)) *MockAssignmentService_ListReplicatingTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ListReplicatingTasksRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_ListReplicatingTasks_Call) Return(_a0 *streamingpb.ListReplicatingTasksResponse, _a1 error) *MockAssignmentService_ListReplicatingTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_ListReplicatingTasks_Call) RunAndReturn(run func(context.Context, *streamingpb.ListReplicatingTasksRequest) (*streamingpb.ListReplicatingTasksResponse, error)) *MockAssignmentService_ListReplicatingTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigratePChannelTopics provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) MigratePChannelTopics(ctx context.Context, req *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigratePChannelTopics")
+	}
+
+	var r0 *streamingpb.MigratePChannelTopicsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MigratePChannelTopicsRequest) *streamingpb.MigratePChannelTopicsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.MigratePChannelTopicsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.MigratePChannelTopicsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_MigratePChannelTopics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigratePChannelTopics'
+type MockAssignmentService_MigratePChannelTopics_Call struct {
+	*mock.Call
+}
+
+// MigratePChannelTopics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.MigratePChannelTopicsRequest
+func (_e *MockAssignmentService_Expecter) MigratePChannelTopics(ctx interface{}, req interface{}) *MockAssignmentService_MigratePChannelTopics_Call {
+	return &MockAssignmentService_MigratePChannelTopics_Call{Call: _e.mock.On("MigratePChannelTopics", ctx, req)}
+}
+
+func (_c *MockAssignmentService_MigratePChannelTopics_Call) Run(run func(ctx context.Context, req *streamingpb.MigratePChannelTopicsRequest)) *MockAssignmentService_MigratePChannelTopics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.MigratePChannelTopicsRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_MigratePChannelTopics_Call) Return(_a0 *streamingpb.MigratePChannelTopicsResponse, _a1 error) *MockAssignmentService_MigratePChannelTopics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_MigratePChannelTopics_Call) RunAndReturn(run func(context.Context, *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error)) *MockAssignmentService_MigratePChannelTopics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveControlChannel provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) MoveControlChannel(ctx context.Context, req *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveControlChannel")
+	}
+
+	var r0 *streamingpb.MoveControlChannelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MoveControlChannelRequest) *streamingpb.MoveControlChannelResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.MoveControlChannelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.MoveControlChannelRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_MoveControlChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveControlChannel'
+type MockAssignmentService_MoveControlChannel_Call struct {
+	*mock.Call
+}
+
+// MoveControlChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.MoveControlChannelRequest
+func (_e *MockAssignmentService_Expecter) MoveControlChannel(ctx interface{}, req interface{}) *MockAssignmentService_MoveControlChannel_Call {
+	return &MockAssignmentService_MoveControlChannel_Call{Call: _e.mock.On("MoveControlChannel", ctx, req)}
+}
+
+func (_c *MockAssignmentService_MoveControlChannel_Call) Run(run func(ctx context.Context, req *streamingpb.MoveControlChannelRequest)) *MockAssignmentService_MoveControlChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.MoveControlChannelRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_MoveControlChannel_Call) Return(_a0 *streamingpb.MoveControlChannelResponse, _a1 error) *MockAssignmentService_MoveControlChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_MoveControlChannel_Call) RunAndReturn(run func(context.Context, *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error)) *MockAssignmentService_MoveControlChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteSecondary provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) PromoteSecondary(ctx context.Context, req *streamingpb.PromoteSecondaryRequest) (*streamingpb.PromoteSecondaryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteSecondary")
+	}
+
+	var r0 *streamingpb.PromoteSecondaryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.PromoteSecondaryRequest) (*streamingpb.PromoteSecondaryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.PromoteSecondaryRequest) *streamingpb.PromoteSecondaryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.PromoteSecondaryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.PromoteSecondaryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_PromoteSecondary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteSecondary'
+type MockAssignmentService_PromoteSecondary_Call struct {
+	*mock.Call
+}
+
+// PromoteSecondary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.PromoteSecondaryRequest
+func (_e *MockAssignmentService_Expecter) PromoteSecondary(ctx interface{}, req interface{}) *MockAssignmentService_PromoteSecondary_Call {
+	return &MockAssignmentService_PromoteSecondary_Call{Call: _e.mock.On("PromoteSecondary", ctx, req)}
+}
+
+func (_c *MockAssignmentService_PromoteSecondary_Call) Run(run func(ctx context.Context, req *streamingpb.PromoteSecondaryRequest)) *MockAssignmentService_PromoteSecondary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.PromoteSecondaryRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_PromoteSecondary_Call) Return(_a0 *streamingpb.PromoteSecondaryResponse, _a1 error) *MockAssignmentService_PromoteSecondary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_PromoteSecondary_Call) RunAndReturn(run func(context.Context, *streamingpb.PromoteSecondaryRequest) (*streamingpb.PromoteSecondaryResponse, error)) *MockAssignmentService_PromoteSecondary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewPChannelLease provides a mock function with given fields: ctx, node, channels
+func (_m *MockAssignmentService) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	ret := _m.Called(ctx, node, channels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewPChannelLease")
+	}
+
+	var r0 []types.PChannelInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) ([]types.PChannelInfo, error)); ok {
+		return rf(ctx, node, channels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) []types.PChannelInfo); ok {
+		r0 = rf(ctx, node, channels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.PChannelInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) error); ok {
+		r1 = rf(ctx, node, channels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_RenewPChannelLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewPChannelLease'
+type MockAssignmentService_RenewPChannelLease_Call struct {
+	*mock.Call
+}
+
+// RenewPChannelLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - node types.StreamingNodeInfo
+//   - channels []types.PChannelInfo
+func (_e *MockAssignmentService_Expecter) RenewPChannelLease(ctx interface{}, node interface{}, channels interface{}) *MockAssignmentService_RenewPChannelLease_Call {
+	return &MockAssignmentService_RenewPChannelLease_Call{Call: _e.mock.On("RenewPChannelLease", ctx, node, channels)}
+}
+
+func (_c *MockAssignmentService_RenewPChannelLease_Call) Run(run func(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo)) *MockAssignmentService_RenewPChannelLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.StreamingNodeInfo), args[2].([]types.PChannelInfo))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_RenewPChannelLease_Call) Return(_a0 []types.PChannelInfo, _a1 error) *MockAssignmentService_RenewPChannelLease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_RenewPChannelLease_Call) RunAndReturn(run func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) ([]types.PChannelInfo, error)) *MockAssignmentService_RenewPChannelLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportAssignmentError provides a mock function with given fields: ctx, pchannel, err
+func (_m *MockAssignmentService) ReportAssignmentError(ctx context.Context, pchannel types.PChannelInfo, err error) error {
+	ret := _m.Called(ctx, pchannel, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportAssignmentError")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.PChannelInfo, error) error); ok {
+		r0 = rf(ctx, pchannel, err)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAssignmentService_ReportAssignmentError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportAssignmentError'
+type MockAssignmentService_ReportAssignmentError_Call struct {
+	*mock.Call
+}
+
+// ReportAssignmentError is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pchannel types.PChannelInfo
+//   - err error
+func (_e *MockAssignmentService_Expecter) ReportAssignmentError(ctx interface{}, pchannel interface{}, err interface{}) *MockAssignmentService_ReportAssignmentError_Call {
+	return &MockAssignmentService_ReportAssignmentError_Call{Call: _e.mock.On("ReportAssignmentError", ctx, pchannel, err)}
+}
+
+func (_c *MockAssignmentService_ReportAssignmentError_Call) Run(run func(ctx context.Context, pchannel types.PChannelInfo, err error)) *MockAssignmentService_ReportAssignmentError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.PChannelInfo), args[2].(error))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_ReportAssignmentError_Call) Return(_a0 error) *MockAssignmentService_ReportAssignmentError_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAssignmentService_ReportAssignmentError_Call) RunAndReturn(run func(context.Context, types.PChannelInfo, error) error) *MockAssignmentService_ReportAssignmentError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueReplicateDeadLetters provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) RequeueReplicateDeadLetters(ctx context.Context, req *streamingpb.RequeueReplicateDeadLettersRequest) (*streamingpb.RequeueReplicateDeadLettersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueReplicateDeadLetters")
+	}
+
+	var r0 *streamingpb.RequeueReplicateDeadLettersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest) (*streamingpb.RequeueReplicateDeadLettersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest) *streamingpb.RequeueReplicateDeadLettersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.RequeueReplicateDeadLettersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_RequeueReplicateDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueReplicateDeadLetters'
+type MockAssignmentService_RequeueReplicateDeadLetters_Call struct {
+	*mock.Call
+}
+
+// RequeueReplicateDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.RequeueReplicateDeadLettersRequest
+func (_e *MockAssignmentService_Expecter) RequeueReplicateDeadLetters(ctx interface{}, req interface{}) *MockAssignmentService_RequeueReplicateDeadLetters_Call {
+	return &MockAssignmentService_RequeueReplicateDeadLetters_Call{Call: _e.mock.On("RequeueReplicateDeadLetters", ctx, req)}
+}
+
+func (_c *MockAssignmentService_RequeueReplicateDeadLetters_Call) Run(run func(ctx context.Context, req *streamingpb.RequeueReplicateDeadLettersRequest)) *MockAssignmentService_RequeueReplicateDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.RequeueReplicateDeadLettersRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_RequeueReplicateDeadLetters_Call) Return(_a0 *streamingpb.RequeueReplicateDeadLettersResponse, _a1 error) *MockAssignmentService_RequeueReplicateDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_RequeueReplicateDeadLetters_Call) RunAndReturn(run func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest) (*streamingpb.RequeueReplicateDeadLettersResponse, error)) *MockAssignmentService_RequeueReplicateDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetReplicateCheckpoint provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) ResetReplicateCheckpoint(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetReplicateCheckpoint")
+	}
+
+	var r0 *streamingpb.ResetReplicateCheckpointResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) *streamingpb.ResetReplicateCheckpointResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ResetReplicateCheckpointResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_ResetReplicateCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetReplicateCheckpoint'
+type MockAssignmentService_ResetReplicateCheckpoint_Call struct {
+	*mock.Call
+}
+
+// ResetReplicateCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ResetReplicateCheckpointRequest
+func (_e *MockAssignmentService_Expecter) ResetReplicateCheckpoint(ctx interface{}, req interface{}) *MockAssignmentService_ResetReplicateCheckpoint_Call {
+	return &MockAssignmentService_ResetReplicateCheckpoint_Call{Call: _e.mock.On("ResetReplicateCheckpoint", ctx, req)}
+}
+
+func (_c *MockAssignmentService_ResetReplicateCheckpoint_Call) Run(run func(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest)) *MockAssignmentService_ResetReplicateCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ResetReplicateCheckpointRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_ResetReplicateCheckpoint_Call) Return(_a0 *streamingpb.ResetReplicateCheckpointResponse, _a1 error) *MockAssignmentService_ResetReplicateCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_ResetReplicateCheckpoint_Call) RunAndReturn(run func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error)) *MockAssignmentService_ResetReplicateCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelAntiAffinityGroups provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) UpdatePChannelAntiAffinityGroups(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelAntiAffinityGroups")
+	}
+
+	var r0 *streamingpb.UpdatePChannelAntiAffinityGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) *streamingpb.UpdatePChannelAntiAffinityGroupsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelAntiAffinityGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelAntiAffinityGroups'
+type MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelAntiAffinityGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest
+func (_e *MockAssignmentService_Expecter) UpdatePChannelAntiAffinityGroups(ctx interface{}, req interface{}) *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call {
+	return &MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call{Call: _e.mock.On("UpdatePChannelAntiAffinityGroups", ctx, req)}
+}
+
+func (_c *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest)) *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelAntiAffinityGroupsRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call) Return(_a0 *streamingpb.UpdatePChannelAntiAffinityGroupsResponse, _a1 error) *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)) *MockAssignmentService_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// AssignmentDiscover provides a mock function with given fields: ctx, cb
-func (_m *MockAssignmentService) AssignmentDiscover(ctx context.Context, cb func(*types.VersionedStreamingNodeAssignments) error) error {
-	ret := _m.Called(ctx, cb)
+// UpdatePChannelPins provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AssignmentDiscover")
+		panic("no return value specified for UpdatePChannelPins")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, func(*types.VersionedStreamingNodeAssignments) error) error); ok {
-		r0 = rf(ctx, cb)
+	var r0 *streamingpb.UpdatePChannelPinsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest) *streamingpb.UpdatePChannelPinsResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelPinsResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockAssignmentService_AssignmentDiscover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignmentDiscover'
-type MockAssignmentService_AssignmentDiscover_Call struct {
+// MockAssignmentService_UpdatePChannelPins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelPins'
+type MockAssignmentService_UpdatePChannelPins_Call struct {
 	*mock.Call
 }
 
-// AssignmentDiscover is a helper method to define mock.On call
+// UpdatePChannelPins is a helper method to define mock.On call
 //   - ctx context.Context
-//   - cb func(*types.VersionedStreamingNodeAssignments) error
-func (_e *MockAssignmentService_Expecter) AssignmentDiscover(ctx interface{}, cb interface{}) *MockAssignmentService_AssignmentDiscover_Call {
-	return &MockAssignmentService_AssignmentDiscover_Call{Call: _e.mock.On("AssignmentDiscover", ctx, cb)}
+//   - req *streamingpb.UpdatePChannelPinsRequest
+func (_e *MockAssignmentService_Expecter) UpdatePChannelPins(ctx interface{}, req interface{}) *MockAssignmentService_UpdatePChannelPins_Call {
+	return &MockAssignmentService_UpdatePChannelPins_Call{Call: _e.mock.On("UpdatePChannelPins", ctx, req)}
 }
 
-func (_c *MockAssignmentService_AssignmentDiscover_Call) Run(run func(ctx context.Context, cb func(*types.VersionedStreamingNodeAssignments) error)) *MockAssignmentService_AssignmentDiscover_Call {
+func (_c *MockAssignmentService_UpdatePChannelPins_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest)) *MockAssignmentService_UpdatePChannelPins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(func(*types.VersionedStreamingNodeAssignments) error))
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelPinsRequest))
 	})
 	return _c
 }
 
-func (_c *MockAssignmentService_AssignmentDiscover_Call) Return(_a0 error) *MockAssignmentService_AssignmentDiscover_Call {
-	_c.Call.Return(_a0)
+func (_c *MockAssignmentService_UpdatePChannelPins_Call) Return(_a0 *streamingpb.UpdatePChannelPinsResponse, _a1 error) *MockAssignmentService_UpdatePChannelPins_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAssignmentService_AssignmentDiscover_Call) RunAndReturn(run func(context.Context, func(*types.VersionedStreamingNodeAssignments) error) error) *MockAssignmentService_AssignmentDiscover_Call {
+func (_c *MockAssignmentService_UpdatePChannelPins_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error)) *MockAssignmentService_UpdatePChannelPins_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestAssignments provides a mock function with given fields: ctx
-func (_m *MockAssignmentService) GetLatestAssignments(ctx context.Context) (*types.VersionedStreamingNodeAssignments, error) {
-	ret := _m.Called(ctx)
+// UpdatePChannelPools provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestAssignments")
+		panic("no return value specified for UpdatePChannelPools")
 	}
 
-	var r0 *types.VersionedStreamingNodeAssignments
+	var r0 *streamingpb.UpdatePChannelPoolsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*types.VersionedStreamingNodeAssignments, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *types.VersionedStreamingNodeAssignments); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) *streamingpb.UpdatePChannelPoolsResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.VersionedStreamingNodeAssignments)
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelPoolsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,57 +1411,58 @@ func (_m *MockAssignmentService) GetLatestAssignments(ctx context.Context) (*typ
 	return r0, r1
 }
 
-// MockAssignmentService_GetLatestAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestAssignments'
-type MockAssignmentService_GetLatestAssignments_Call struct {
+// MockAssignmentService_UpdatePChannelPools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelPools'
+type MockAssignmentService_UpdatePChannelPools_Call struct {
 	*mock.Call
 }
 
-// GetLatestAssignments is a helper method to define mock.On call
+// UpdatePChannelPools is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockAssignmentService_Expecter) GetLatestAssignments(ctx interface{}) *MockAssignmentService_GetLatestAssignments_Call {
-	return &MockAssignmentService_GetLatestAssignments_Call{Call: _e.mock.On("GetLatestAssignments", ctx)}
+//   - req *streamingpb.UpdatePChannelPoolsRequest
+func (_e *MockAssignmentService_Expecter) UpdatePChannelPools(ctx interface{}, req interface{}) *MockAssignmentService_UpdatePChannelPools_Call {
+	return &MockAssignmentService_UpdatePChannelPools_Call{Call: _e.mock.On("UpdatePChannelPools", ctx, req)}
 }
 
-func (_c *MockAssignmentService_GetLatestAssignments_Call) Run(run func(ctx context.Context)) *MockAssignmentService_GetLatestAssignments_Call {
+func (_c *MockAssignmentService_UpdatePChannelPools_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest)) *MockAssignmentService_UpdatePChannelPools_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelPoolsRequest))
 	})
 	return _c
 }
 
-func (_c *MockAssignmentService_GetLatestAssignments_Call) Return(_a0 *types.VersionedStreamingNodeAssignments, _a1 error) *MockAssignmentService_GetLatestAssignments_Call {
+func (_c *MockAssignmentService_UpdatePChannelPools_Call) Return(_a0 *streamingpb.UpdatePChannelPoolsResponse, _a1 error) *MockAssignmentService_UpdatePChannelPools_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAssignmentService_GetLatestAssignments_Call) RunAndReturn(run func(context.Context) (*types.VersionedStreamingNodeAssignments, error)) *MockAssignmentService_GetLatestAssignments_Call {
+func (_c *MockAssignmentService_UpdatePChannelPools_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error)) *MockAssignmentService_UpdatePChannelPools_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestStreamingVersion provides a mock function with given fields: ctx
-func (_m *MockAssignmentService) GetLatestStreamingVersion(ctx context.Context) (*streamingpb.StreamingVersion, error) {
-	ret := _m.Called(ctx)
+// UpdatePChannelRetentionPolicies provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) UpdatePChannelRetentionPolicies(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestStreamingVersion")
+		panic("no return value specified for UpdatePChannelRetentionPolicies")
 	}
 
-	var r0 *streamingpb.StreamingVersion
+	var r0 *streamingpb.UpdatePChannelRetentionPoliciesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*streamingpb.StreamingVersion, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *streamingpb.StreamingVersion); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) *streamingpb.UpdatePChannelRetentionPoliciesResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*streamingpb.StreamingVersion)
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelRetentionPoliciesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -166,64 +1470,105 @@ func (_m *MockAssignmentService) GetLatestStreamingVersion(ctx context.Context)
 	return r0, r1
 }
 
-// MockAssignmentService_GetLatestStreamingVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestStreamingVersion'
-type MockAssignmentService_GetLatestStreamingVersion_Call struct {
+// MockAssignmentService_UpdatePChannelRetentionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelRetentionPolicies'
+type MockAssignmentService_UpdatePChannelRetentionPolicies_Call struct {
 	*mock.Call
 }
 
-// GetLatestStreamingVersion is a helper method to define mock.On call
+// UpdatePChannelRetentionPolicies is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockAssignmentService_Expecter) GetLatestStreamingVersion(ctx interface{}) *MockAssignmentService_GetLatestStreamingVersion_Call {
-	return &MockAssignmentService_GetLatestStreamingVersion_Call{Call: _e.mock.On("GetLatestStreamingVersion", ctx)}
+//   - req *streamingpb.UpdatePChannelRetentionPoliciesRequest
+func (_e *MockAssignmentService_Expecter) UpdatePChannelRetentionPolicies(ctx interface{}, req interface{}) *MockAssignmentService_UpdatePChannelRetentionPolicies_Call {
+	return &MockAssignmentService_UpdatePChannelRetentionPolicies_Call{Call: _e.mock.On("UpdatePChannelRetentionPolicies", ctx, req)}
 }
 
-func (_c *MockAssignmentService_GetLatestStreamingVersion_Call) Run(run func(ctx context.Context)) *MockAssignmentService_GetLatestStreamingVersion_Call {
+func (_c *MockAssignmentService_UpdatePChannelRetentionPolicies_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest)) *MockAssignmentService_UpdatePChannelRetentionPolicies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelRetentionPoliciesRequest))
 	})
 	return _c
 }
 
-func (_c *MockAssignmentService_GetLatestStreamingVersion_Call) Return(_a0 *streamingpb.StreamingVersion, _a1 error) *MockAssignmentService_GetLatestStreamingVersion_Call {
+func (_c *MockAssignmentService_UpdatePChannelRetentionPolicies_Call) Return(_a0 *streamingpb.UpdatePChannelRetentionPoliciesResponse, _a1 error) *MockAssignmentService_UpdatePChannelRetentionPolicies_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAssignmentService_GetLatestStreamingVersion_Call) RunAndReturn(run func(context.Context) (*streamingpb.StreamingVersion, error)) *MockAssignmentService_GetLatestStreamingVersion_Call {
+func (_c *MockAssignmentService_UpdatePChannelRetentionPolicies_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)) *MockAssignmentService_UpdatePChannelRetentionPolicies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetReplicateConfiguration provides a mock function with given fields: ctx, opts
-func (_m *MockAssignmentService) GetReplicateConfiguration(ctx context.Context, opts ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error) {
-	_va := make([]interface{}, len(opts))
-	for _i := range opts {
-		_va[_i] = opts[_i]
+// UpdateRBACReplication provides a mock function with given fields: ctx, disabled
+func (_m *MockAssignmentService) UpdateRBACReplication(ctx context.Context, disabled bool) error {
+	ret := _m.Called(ctx, disabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRBACReplication")
 	}
-	var _ca []interface{}
-	_ca = append(_ca, ctx)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) error); ok {
+		r0 = rf(ctx, disabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAssignmentService_UpdateRBACReplication_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRBACReplication'
+type MockAssignmentService_UpdateRBACReplication_Call struct {
+	*mock.Call
+}
+
+// UpdateRBACReplication is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disabled bool
+func (_e *MockAssignmentService_Expecter) UpdateRBACReplication(ctx interface{}, disabled interface{}) *MockAssignmentService_UpdateRBACReplication_Call {
+	return &MockAssignmentService_UpdateRBACReplication_Call{Call: _e.mock.On("UpdateRBACReplication", ctx, disabled)}
+}
+
+func (_c *MockAssignmentService_UpdateRBACReplication_Call) Run(run func(ctx context.Context, disabled bool)) *MockAssignmentService_UpdateRBACReplication_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_UpdateRBACReplication_Call) Return(_a0 error) *MockAssignmentService_UpdateRBACReplication_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAssignmentService_UpdateRBACReplication_Call) RunAndReturn(run func(context.Context, bool) error) *MockAssignmentService_UpdateRBACReplication_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReplicateClusterConnection provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) UpdateReplicateClusterConnection(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetReplicateConfiguration")
+		panic("no return value specified for UpdateReplicateClusterConnection")
 	}
 
-	var r0 *replicateutil.ConfigHelper
+	var r0 *streamingpb.UpdateReplicateClusterConnectionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error)); ok {
-		return rf(ctx, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ...assignment.GetReplicateConfigurationOpt) *replicateutil.ConfigHelper); ok {
-		r0 = rf(ctx, opts...)
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) *streamingpb.UpdateReplicateClusterConnectionResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*replicateutil.ConfigHelper)
+			r0 = ret.Get(0).(*streamingpb.UpdateReplicateClusterConnectionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ...assignment.GetReplicateConfigurationOpt) error); ok {
-		r1 = rf(ctx, opts...)
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -231,53 +1576,46 @@ func (_m *MockAssignmentService) GetReplicateConfiguration(ctx context.Context,
 	return r0, r1
 }
 
-// MockAssignmentService_GetReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicateConfiguration'
-type MockAssignmentService_GetReplicateConfiguration_Call struct {
+// MockAssignmentService_UpdateReplicateClusterConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicateClusterConnection'
+type MockAssignmentService_UpdateReplicateClusterConnection_Call struct {
 	*mock.Call
 }
 
-// GetReplicateConfiguration is a helper method to define mock.On call
+// UpdateReplicateClusterConnection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - opts ...assignment.GetReplicateConfigurationOpt
-func (_e *MockAssignmentService_Expecter) GetReplicateConfiguration(ctx interface{}, opts ...interface{}) *MockAssignmentService_GetReplicateConfiguration_Call {
-	return &MockAssignmentService_GetReplicateConfiguration_Call{Call: _e.mock.On("GetReplicateConfiguration",
-		append([]interface{}{ctx}, opts...)...)}
+//   - req *streamingpb.UpdateReplicateClusterConnectionRequest
+func (_e *MockAssignmentService_Expecter) UpdateReplicateClusterConnection(ctx interface{}, req interface{}) *MockAssignmentService_UpdateReplicateClusterConnection_Call {
+	return &MockAssignmentService_UpdateReplicateClusterConnection_Call{Call: _e.mock.On("UpdateReplicateClusterConnection", ctx, req)}
 }
 
-func (_c *MockAssignmentService_GetReplicateConfiguration_Call) Run(run func(ctx context.Context, opts ...assignment.GetReplicateConfigurationOpt)) *MockAssignmentService_GetReplicateConfiguration_Call {
+func (_c *MockAssignmentService_UpdateReplicateClusterConnection_Call) Run(run func(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest)) *MockAssignmentService_UpdateReplicateClusterConnection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]assignment.GetReplicateConfigurationOpt, len(args)-1)
-		for i, a := range args[1:] {
-			if a != nil {
-				variadicArgs[i] = a.(assignment.GetReplicateConfigurationOpt)
-			}
-		}
-		run(args[0].(context.Context), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateReplicateClusterConnectionRequest))
 	})
 	return _c
 }
 
-func (_c *MockAssignmentService_GetReplicateConfiguration_Call) Return(_a0 *replicateutil.ConfigHelper, _a1 error) *MockAssignmentService_GetReplicateConfiguration_Call {
+func (_c *MockAssignmentService_UpdateReplicateClusterConnection_Call) Return(_a0 *streamingpb.UpdateReplicateClusterConnectionResponse, _a1 error) *MockAssignmentService_UpdateReplicateClusterConnection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockAssignmentService_GetReplicateConfiguration_Call) RunAndReturn(run func(context.Context, ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error)) *MockAssignmentService_GetReplicateConfiguration_Call {
+func (_c *MockAssignmentService_UpdateReplicateClusterConnection_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)) *MockAssignmentService_UpdateReplicateClusterConnection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReportAssignmentError provides a mock function with given fields: ctx, pchannel, err
-func (_m *MockAssignmentService) ReportAssignmentError(ctx context.Context, pchannel types.PChannelInfo, err error) error {
-	ret := _m.Called(ctx, pchannel, err)
+// UpdateReplicateCollectionFilters provides a mock function with given fields: ctx, filters
+func (_m *MockAssignmentService) UpdateReplicateCollectionFilters(ctx context.Context, filters map[string]*messagespb.ReplicateCollectionFilter) error {
+	ret := _m.Called(ctx, filters)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReportAssignmentError")
+		panic("no return value specified for UpdateReplicateCollectionFilters")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.PChannelInfo, error) error); ok {
-		r0 = rf(ctx, pchannel, err)
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]*messagespb.ReplicateCollectionFilter) error); ok {
+		r0 = rf(ctx, filters)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -285,32 +1623,31 @@ func (_m *MockAssignmentService) ReportAssignmentError(ctx context.Context, pcha
 	return r0
 }
 
-// MockAssignmentService_ReportAssignmentError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportAssignmentError'
-type MockAssignmentService_ReportAssignmentError_Call struct {
+// MockAssignmentService_UpdateReplicateCollectionFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicateCollectionFilters'
+type MockAssignmentService_UpdateReplicateCollectionFilters_Call struct {
 	*mock.Call
 }
 
-// ReportAssignmentError is a helper method to define mock.On call
+// UpdateReplicateCollectionFilters is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pchannel types.PChannelInfo
-//   - err error
-func (_e *MockAssignmentService_Expecter) ReportAssignmentError(ctx interface{}, pchannel interface{}, err interface{}) *MockAssignmentService_ReportAssignmentError_Call {
-	return &MockAssignmentService_ReportAssignmentError_Call{Call: _e.mock.On("ReportAssignmentError", ctx, pchannel, err)}
+//   - filters map[string]*messagespb.ReplicateCollectionFilter
+func (_e *MockAssignmentService_Expecter) UpdateReplicateCollectionFilters(ctx interface{}, filters interface{}) *MockAssignmentService_UpdateReplicateCollectionFilters_Call {
+	return &MockAssignmentService_UpdateReplicateCollectionFilters_Call{Call: _e.mock.On("UpdateReplicateCollectionFilters", ctx, filters)}
 }
 
-func (_c *MockAssignmentService_ReportAssignmentError_Call) Run(run func(ctx context.Context, pchannel types.PChannelInfo, err error)) *MockAssignmentService_ReportAssignmentError_Call {
+func (_c *MockAssignmentService_UpdateReplicateCollectionFilters_Call) Run(run func(ctx context.Context, filters map[string]*messagespb.ReplicateCollectionFilter)) *MockAssignmentService_UpdateReplicateCollectionFilters_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(types.PChannelInfo), args[2].(error))
+		run(args[0].(context.Context), args[1].(map[string]*messagespb.ReplicateCollectionFilter))
 	})
 	return _c
 }
 
-func (_c *MockAssignmentService_ReportAssignmentError_Call) Return(_a0 error) *MockAssignmentService_ReportAssignmentError_Call {
+func (_c *MockAssignmentService_UpdateReplicateCollectionFilters_Call) Return(_a0 error) *MockAssignmentService_UpdateReplicateCollectionFilters_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockAssignmentService_ReportAssignmentError_Call) RunAndReturn(run func(context.Context, types.PChannelInfo, error) error) *MockAssignmentService_ReportAssignmentError_Call {
+func (_c *MockAssignmentService_UpdateReplicateCollectionFilters_Call) RunAndReturn(run func(context.Context, map[string]*messagespb.ReplicateCollectionFilter) error) *MockAssignmentService_UpdateReplicateCollectionFilters_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -362,6 +1699,65 @@ func (_c *MockAssignmentService_UpdateReplicateConfiguration_Call) RunAndReturn(
 	return _c
 }
 
+// UpdateReplicatingTaskState provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) UpdateReplicatingTaskState(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReplicatingTaskState")
+	}
+
+	var r0 *streamingpb.UpdateReplicatingTaskStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) *streamingpb.UpdateReplicatingTaskStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdateReplicatingTaskStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_UpdateReplicatingTaskState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicatingTaskState'
+type MockAssignmentService_UpdateReplicatingTaskState_Call struct {
+	*mock.Call
+}
+
+// UpdateReplicatingTaskState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdateReplicatingTaskStateRequest
+func (_e *MockAssignmentService_Expecter) UpdateReplicatingTaskState(ctx interface{}, req interface{}) *MockAssignmentService_UpdateReplicatingTaskState_Call {
+	return &MockAssignmentService_UpdateReplicatingTaskState_Call{Call: _e.mock.On("UpdateReplicatingTaskState", ctx, req)}
+}
+
+func (_c *MockAssignmentService_UpdateReplicatingTaskState_Call) Run(run func(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest)) *MockAssignmentService_UpdateReplicatingTaskState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateReplicatingTaskStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_UpdateReplicatingTaskState_Call) Return(_a0 *streamingpb.UpdateReplicatingTaskStateResponse, _a1 error) *MockAssignmentService_UpdateReplicatingTaskState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_UpdateReplicatingTaskState_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error)) *MockAssignmentService_UpdateReplicatingTaskState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWALBalancePolicy provides a mock function with given fields: ctx, req
 func (_m *MockAssignmentService) UpdateWALBalancePolicy(ctx context.Context, req *types.UpdateWALBalancePolicyRequest) (*types.UpdateWALBalancePolicyResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -421,6 +1817,65 @@ func (_c *MockAssignmentService_UpdateWALBalancePolicy_Call) RunAndReturn(run fu
 	return _c
 }
 
+// ValidateReplicateConfiguration provides a mock function with given fields: ctx, req
+func (_m *MockAssignmentService) ValidateReplicateConfiguration(ctx context.Context, req *streamingpb.ValidateReplicateConfigurationRequest) (*streamingpb.ValidateReplicateConfigurationResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateReplicateConfiguration")
+	}
+
+	var r0 *streamingpb.ValidateReplicateConfigurationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest) (*streamingpb.ValidateReplicateConfigurationResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest) *streamingpb.ValidateReplicateConfigurationResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ValidateReplicateConfigurationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAssignmentService_ValidateReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateReplicateConfiguration'
+type MockAssignmentService_ValidateReplicateConfiguration_Call struct {
+	*mock.Call
+}
+
+// ValidateReplicateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ValidateReplicateConfigurationRequest
+func (_e *MockAssignmentService_Expecter) ValidateReplicateConfiguration(ctx interface{}, req interface{}) *MockAssignmentService_ValidateReplicateConfiguration_Call {
+	return &MockAssignmentService_ValidateReplicateConfiguration_Call{Call: _e.mock.On("ValidateReplicateConfiguration", ctx, req)}
+}
+
+func (_c *MockAssignmentService_ValidateReplicateConfiguration_Call) Run(run func(ctx context.Context, req *streamingpb.ValidateReplicateConfigurationRequest)) *MockAssignmentService_ValidateReplicateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ValidateReplicateConfigurationRequest))
+	})
+	return _c
+}
+
+func (_c *MockAssignmentService_ValidateReplicateConfiguration_Call) Return(_a0 *streamingpb.ValidateReplicateConfigurationResponse, _a1 error) *MockAssignmentService_ValidateReplicateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAssignmentService_ValidateReplicateConfiguration_Call) RunAndReturn(run func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest) (*streamingpb.ValidateReplicateConfigurationResponse, error)) *MockAssignmentService_ValidateReplicateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAssignmentService creates a new instance of MockAssignmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAssignmentService(t interface {
@@ -8,6 +8,10 @@ import (
 	message "github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	mock "github.com/stretchr/testify/mock"
 
+	streamingpb "github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+
+	time "time"
+
 	types "github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 )
 
@@ -71,6 +75,67 @@ func (_c *MockBroadcastService_Ack_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// BeginCrossVChannelTxn provides a mock function with given fields: ctx, collectionID, vchannels, keepalive
+func (_m *MockBroadcastService) BeginCrossVChannelTxn(ctx context.Context, collectionID int64, vchannels []string, keepalive time.Duration) (*streamingpb.CrossVChannelTxnMeta, error) {
+	ret := _m.Called(ctx, collectionID, vchannels, keepalive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginCrossVChannelTxn")
+	}
+
+	var r0 *streamingpb.CrossVChannelTxnMeta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, time.Duration) (*streamingpb.CrossVChannelTxnMeta, error)); ok {
+		return rf(ctx, collectionID, vchannels, keepalive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, time.Duration) *streamingpb.CrossVChannelTxnMeta); ok {
+		r0 = rf(ctx, collectionID, vchannels, keepalive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.CrossVChannelTxnMeta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []string, time.Duration) error); ok {
+		r1 = rf(ctx, collectionID, vchannels, keepalive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroadcastService_BeginCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginCrossVChannelTxn'
+type MockBroadcastService_BeginCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// BeginCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - vchannels []string
+//   - keepalive time.Duration
+func (_e *MockBroadcastService_Expecter) BeginCrossVChannelTxn(ctx interface{}, collectionID interface{}, vchannels interface{}, keepalive interface{}) *MockBroadcastService_BeginCrossVChannelTxn_Call {
+	return &MockBroadcastService_BeginCrossVChannelTxn_Call{Call: _e.mock.On("BeginCrossVChannelTxn", ctx, collectionID, vchannels, keepalive)}
+}
+
+func (_c *MockBroadcastService_BeginCrossVChannelTxn_Call) Run(run func(ctx context.Context, collectionID int64, vchannels []string, keepalive time.Duration)) *MockBroadcastService_BeginCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockBroadcastService_BeginCrossVChannelTxn_Call) Return(_a0 *streamingpb.CrossVChannelTxnMeta, _a1 error) *MockBroadcastService_BeginCrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroadcastService_BeginCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, int64, []string, time.Duration) (*streamingpb.CrossVChannelTxnMeta, error)) *MockBroadcastService_BeginCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Broadcast provides a mock function with given fields: ctx, msg
 func (_m *MockBroadcastService) Broadcast(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
 	ret := _m.Called(ctx, msg)
@@ -130,6 +195,161 @@ func (_c *MockBroadcastService_Broadcast_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CommitCrossVChannelTxn provides a mock function with given fields: ctx, txnID
+func (_m *MockBroadcastService) CommitCrossVChannelTxn(ctx context.Context, txnID int64) (*types.BroadcastAppendResult, error) {
+	ret := _m.Called(ctx, txnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitCrossVChannelTxn")
+	}
+
+	var r0 *types.BroadcastAppendResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*types.BroadcastAppendResult, error)); ok {
+		return rf(ctx, txnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *types.BroadcastAppendResult); ok {
+		r0 = rf(ctx, txnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BroadcastAppendResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, txnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroadcastService_CommitCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitCrossVChannelTxn'
+type MockBroadcastService_CommitCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// CommitCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txnID int64
+func (_e *MockBroadcastService_Expecter) CommitCrossVChannelTxn(ctx interface{}, txnID interface{}) *MockBroadcastService_CommitCrossVChannelTxn_Call {
+	return &MockBroadcastService_CommitCrossVChannelTxn_Call{Call: _e.mock.On("CommitCrossVChannelTxn", ctx, txnID)}
+}
+
+func (_c *MockBroadcastService_CommitCrossVChannelTxn_Call) Run(run func(ctx context.Context, txnID int64)) *MockBroadcastService_CommitCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroadcastService_CommitCrossVChannelTxn_Call) Return(_a0 *types.BroadcastAppendResult, _a1 error) *MockBroadcastService_CommitCrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroadcastService_CommitCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, int64) (*types.BroadcastAppendResult, error)) *MockBroadcastService_CommitCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackCrossVChannelTxn provides a mock function with given fields: ctx, txnID
+func (_m *MockBroadcastService) RollbackCrossVChannelTxn(ctx context.Context, txnID int64) error {
+	ret := _m.Called(ctx, txnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackCrossVChannelTxn")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, txnID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroadcastService_RollbackCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackCrossVChannelTxn'
+type MockBroadcastService_RollbackCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// RollbackCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txnID int64
+func (_e *MockBroadcastService_Expecter) RollbackCrossVChannelTxn(ctx interface{}, txnID interface{}) *MockBroadcastService_RollbackCrossVChannelTxn_Call {
+	return &MockBroadcastService_RollbackCrossVChannelTxn_Call{Call: _e.mock.On("RollbackCrossVChannelTxn", ctx, txnID)}
+}
+
+func (_c *MockBroadcastService_RollbackCrossVChannelTxn_Call) Run(run func(ctx context.Context, txnID int64)) *MockBroadcastService_RollbackCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroadcastService_RollbackCrossVChannelTxn_Call) Return(_a0 error) *MockBroadcastService_RollbackCrossVChannelTxn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroadcastService_RollbackCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, int64) error) *MockBroadcastService_RollbackCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Watch provides a mock function with given fields: ctx, token, messageTypes, cb
+func (_m *MockBroadcastService) Watch(ctx context.Context, token *streamingpb.BroadcastWatchResumeToken, messageTypes []message.MessageType, cb func(*streamingpb.BroadcastWatchResponse) error) error {
+	ret := _m.Called(ctx, token, messageTypes, cb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.BroadcastWatchResumeToken, []message.MessageType, func(*streamingpb.BroadcastWatchResponse) error) error); ok {
+		r0 = rf(ctx, token, messageTypes, cb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroadcastService_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type MockBroadcastService_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *streamingpb.BroadcastWatchResumeToken
+//   - messageTypes []message.MessageType
+//   - cb func(*streamingpb.BroadcastWatchResponse) error
+func (_e *MockBroadcastService_Expecter) Watch(ctx interface{}, token interface{}, messageTypes interface{}, cb interface{}) *MockBroadcastService_Watch_Call {
+	return &MockBroadcastService_Watch_Call{Call: _e.mock.On("Watch", ctx, token, messageTypes, cb)}
+}
+
+func (_c *MockBroadcastService_Watch_Call) Run(run func(ctx context.Context, token *streamingpb.BroadcastWatchResumeToken, messageTypes []message.MessageType, cb func(*streamingpb.BroadcastWatchResponse) error)) *MockBroadcastService_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.BroadcastWatchResumeToken), args[2].([]message.MessageType), args[3].(func(*streamingpb.BroadcastWatchResponse) error))
+	})
+	return _c
+}
+
+func (_c *MockBroadcastService_Watch_Call) Return(_a0 error) *MockBroadcastService_Watch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroadcastService_Watch_Call) RunAndReturn(run func(context.Context, *streamingpb.BroadcastWatchResumeToken, []message.MessageType, func(*streamingpb.BroadcastWatchResponse) error) error) *MockBroadcastService_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockBroadcastService creates a new instance of MockBroadcastService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockBroadcastService(t interface {
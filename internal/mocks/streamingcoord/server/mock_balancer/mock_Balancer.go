@@ -7,6 +7,10 @@ import (
 
 	balancer "github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
 
+	channel "github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+
+	commonpb "github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+
 	message "github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 
 	mock "github.com/stretchr/testify/mock"
@@ -92,6 +96,53 @@ func (_c *MockBalancer_AllocVirtualChannels_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CheckPChannelTopicMigration provides a mock function with given fields: ctx, pchannelTopics
+func (_m *MockBalancer) CheckPChannelTopicMigration(ctx context.Context, pchannelTopics map[string]string) error {
+	ret := _m.Called(ctx, pchannelTopics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPChannelTopicMigration")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) error); ok {
+		r0 = rf(ctx, pchannelTopics)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_CheckPChannelTopicMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPChannelTopicMigration'
+type MockBalancer_CheckPChannelTopicMigration_Call struct {
+	*mock.Call
+}
+
+// CheckPChannelTopicMigration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pchannelTopics map[string]string
+func (_e *MockBalancer_Expecter) CheckPChannelTopicMigration(ctx interface{}, pchannelTopics interface{}) *MockBalancer_CheckPChannelTopicMigration_Call {
+	return &MockBalancer_CheckPChannelTopicMigration_Call{Call: _e.mock.On("CheckPChannelTopicMigration", ctx, pchannelTopics)}
+}
+
+func (_c *MockBalancer_CheckPChannelTopicMigration_Call) Run(run func(ctx context.Context, pchannelTopics map[string]string)) *MockBalancer_CheckPChannelTopicMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_CheckPChannelTopicMigration_Call) Return(_a0 error) *MockBalancer_CheckPChannelTopicMigration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_CheckPChannelTopicMigration_Call) RunAndReturn(run func(context.Context, map[string]string) error) *MockBalancer_CheckPChannelTopicMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with no fields
 func (_m *MockBalancer) Close() {
 	_m.Called()
@@ -170,6 +221,170 @@ func (_c *MockBalancer_ConfirmPrimaryResourceGroupReady_Call) RunAndReturn(run f
 	return _c
 }
 
+// DisableStreaming provides a mock function with given fields: ctx
+func (_m *MockBalancer) DisableStreaming(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableStreaming")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_DisableStreaming_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableStreaming'
+type MockBalancer_DisableStreaming_Call struct {
+	*mock.Call
+}
+
+// DisableStreaming is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalancer_Expecter) DisableStreaming(ctx interface{}) *MockBalancer_DisableStreaming_Call {
+	return &MockBalancer_DisableStreaming_Call{Call: _e.mock.On("DisableStreaming", ctx)}
+}
+
+func (_c *MockBalancer_DisableStreaming_Call) Run(run func(ctx context.Context)) *MockBalancer_DisableStreaming_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_DisableStreaming_Call) Return(_a0 error) *MockBalancer_DisableStreaming_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_DisableStreaming_Call) RunAndReturn(run func(context.Context) error) *MockBalancer_DisableStreaming_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrainNode provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) DrainNode(ctx context.Context, req *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainNode")
+	}
+
+	var r0 *streamingpb.DrainNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.DrainNodeRequest) *streamingpb.DrainNodeResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.DrainNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.DrainNodeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_DrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainNode'
+type MockBalancer_DrainNode_Call struct {
+	*mock.Call
+}
+
+// DrainNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.DrainNodeRequest
+func (_e *MockBalancer_Expecter) DrainNode(ctx interface{}, req interface{}) *MockBalancer_DrainNode_Call {
+	return &MockBalancer_DrainNode_Call{Call: _e.mock.On("DrainNode", ctx, req)}
+}
+
+func (_c *MockBalancer_DrainNode_Call) Run(run func(ctx context.Context, req *streamingpb.DrainNodeRequest)) *MockBalancer_DrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.DrainNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_DrainNode_Call) Return(_a0 *streamingpb.DrainNodeResponse, _a1 error) *MockBalancer_DrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_DrainNode_Call) RunAndReturn(run func(context.Context, *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error)) *MockBalancer_DrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportState provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) ExportState(ctx context.Context, req *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportState")
+	}
+
+	var r0 *streamingpb.ExportStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ExportStateRequest) *streamingpb.ExportStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ExportStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ExportStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_ExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportState'
+type MockBalancer_ExportState_Call struct {
+	*mock.Call
+}
+
+// ExportState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ExportStateRequest
+func (_e *MockBalancer_Expecter) ExportState(ctx interface{}, req interface{}) *MockBalancer_ExportState_Call {
+	return &MockBalancer_ExportState_Call{Call: _e.mock.On("ExportState", ctx, req)}
+}
+
+func (_c *MockBalancer_ExportState_Call) Run(run func(ctx context.Context, req *streamingpb.ExportStateRequest)) *MockBalancer_ExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ExportStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_ExportState_Call) Return(_a0 *streamingpb.ExportStateResponse, _a1 error) *MockBalancer_ExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_ExportState_Call) RunAndReturn(run func(context.Context, *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error)) *MockBalancer_ExportState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllStreamingNodes provides a mock function with given fields: ctx
 func (_m *MockBalancer) GetAllStreamingNodes(ctx context.Context) (map[int64]*types.StreamingNodeInfoWithResourceGroup, error) {
 	ret := _m.Called(ctx)
@@ -228,6 +443,65 @@ func (_c *MockBalancer_GetAllStreamingNodes_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetAssignmentHistory provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) GetAssignmentHistory(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignmentHistory")
+	}
+
+	var r0 *streamingpb.GetAssignmentHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest) *streamingpb.GetAssignmentHistoryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetAssignmentHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_GetAssignmentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignmentHistory'
+type MockBalancer_GetAssignmentHistory_Call struct {
+	*mock.Call
+}
+
+// GetAssignmentHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.GetAssignmentHistoryRequest
+func (_e *MockBalancer_Expecter) GetAssignmentHistory(ctx interface{}, req interface{}) *MockBalancer_GetAssignmentHistory_Call {
+	return &MockBalancer_GetAssignmentHistory_Call{Call: _e.mock.On("GetAssignmentHistory", ctx, req)}
+}
+
+func (_c *MockBalancer_GetAssignmentHistory_Call) Run(run func(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest)) *MockBalancer_GetAssignmentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.GetAssignmentHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_GetAssignmentHistory_Call) Return(_a0 *streamingpb.GetAssignmentHistoryResponse, _a1 error) *MockBalancer_GetAssignmentHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_GetAssignmentHistory_Call) RunAndReturn(run func(context.Context, *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error)) *MockBalancer_GetAssignmentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAvailableStreamingNodes provides a mock function with given fields: ctx
 func (_m *MockBalancer) GetAvailableStreamingNodes(ctx context.Context) (map[int64]*types.StreamingNodeInfoWithResourceGroup, error) {
 	ret := _m.Called(ctx)
@@ -400,175 +674,1238 @@ func (_c *MockBalancer_GetLatestWALLocated_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// MarkAsUnavailable provides a mock function with given fields: ctx, pChannels
-func (_m *MockBalancer) MarkAsUnavailable(ctx context.Context, pChannels []types.PChannelInfo) error {
-	ret := _m.Called(ctx, pChannels)
+// GetLatestWALLocatedBatch provides a mock function with given fields: ctx, pchannels
+func (_m *MockBalancer) GetLatestWALLocatedBatch(ctx context.Context, pchannels []string) map[string]int64 {
+	ret := _m.Called(ctx, pchannels)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MarkAsUnavailable")
+		panic("no return value specified for GetLatestWALLocatedBatch")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []types.PChannelInfo) error); ok {
-		r0 = rf(ctx, pChannels)
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int64); ok {
+		r0 = rf(ctx, pchannels)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
 	}
 
 	return r0
 }
 
-// MockBalancer_MarkAsUnavailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUnavailable'
-type MockBalancer_MarkAsUnavailable_Call struct {
+// MockBalancer_GetLatestWALLocatedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestWALLocatedBatch'
+type MockBalancer_GetLatestWALLocatedBatch_Call struct {
 	*mock.Call
 }
 
-// MarkAsUnavailable is a helper method to define mock.On call
+// GetLatestWALLocatedBatch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pChannels []types.PChannelInfo
-func (_e *MockBalancer_Expecter) MarkAsUnavailable(ctx interface{}, pChannels interface{}) *MockBalancer_MarkAsUnavailable_Call {
-	return &MockBalancer_MarkAsUnavailable_Call{Call: _e.mock.On("MarkAsUnavailable", ctx, pChannels)}
+//   - pchannels []string
+func (_e *MockBalancer_Expecter) GetLatestWALLocatedBatch(ctx interface{}, pchannels interface{}) *MockBalancer_GetLatestWALLocatedBatch_Call {
+	return &MockBalancer_GetLatestWALLocatedBatch_Call{Call: _e.mock.On("GetLatestWALLocatedBatch", ctx, pchannels)}
 }
 
-func (_c *MockBalancer_MarkAsUnavailable_Call) Run(run func(ctx context.Context, pChannels []types.PChannelInfo)) *MockBalancer_MarkAsUnavailable_Call {
+func (_c *MockBalancer_GetLatestWALLocatedBatch_Call) Run(run func(ctx context.Context, pchannels []string)) *MockBalancer_GetLatestWALLocatedBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]types.PChannelInfo))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockBalancer_MarkAsUnavailable_Call) Return(_a0 error) *MockBalancer_MarkAsUnavailable_Call {
+func (_c *MockBalancer_GetLatestWALLocatedBatch_Call) Return(_a0 map[string]int64) *MockBalancer_GetLatestWALLocatedBatch_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockBalancer_MarkAsUnavailable_Call) RunAndReturn(run func(context.Context, []types.PChannelInfo) error) *MockBalancer_MarkAsUnavailable_Call {
+func (_c *MockBalancer_GetLatestWALLocatedBatch_Call) RunAndReturn(run func(context.Context, []string) map[string]int64) *MockBalancer_GetLatestWALLocatedBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterStreamingEnabledNotifier provides a mock function with given fields: notifier
-func (_m *MockBalancer) RegisterStreamingEnabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}]) {
-	_m.Called(notifier)
+// GetPChannelRetentionPolicies provides a mock function with given fields: ctx, pchannels
+func (_m *MockBalancer) GetPChannelRetentionPolicies(ctx context.Context, pchannels []string) (map[string]*streamingpb.WALRetentionPolicy, error) {
+	ret := _m.Called(ctx, pchannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPChannelRetentionPolicies")
+	}
+
+	var r0 map[string]*streamingpb.WALRetentionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*streamingpb.WALRetentionPolicy, error)); ok {
+		return rf(ctx, pchannels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*streamingpb.WALRetentionPolicy); ok {
+		r0 = rf(ctx, pchannels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*streamingpb.WALRetentionPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, pchannels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockBalancer_RegisterStreamingEnabledNotifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterStreamingEnabledNotifier'
-type MockBalancer_RegisterStreamingEnabledNotifier_Call struct {
+// MockBalancer_GetPChannelRetentionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPChannelRetentionPolicies'
+type MockBalancer_GetPChannelRetentionPolicies_Call struct {
 	*mock.Call
 }
 
-// RegisterStreamingEnabledNotifier is a helper method to define mock.On call
-//   - notifier *syncutil.AsyncTaskNotifier[struct{}]
-func (_e *MockBalancer_Expecter) RegisterStreamingEnabledNotifier(notifier interface{}) *MockBalancer_RegisterStreamingEnabledNotifier_Call {
-	return &MockBalancer_RegisterStreamingEnabledNotifier_Call{Call: _e.mock.On("RegisterStreamingEnabledNotifier", notifier)}
+// GetPChannelRetentionPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pchannels []string
+func (_e *MockBalancer_Expecter) GetPChannelRetentionPolicies(ctx interface{}, pchannels interface{}) *MockBalancer_GetPChannelRetentionPolicies_Call {
+	return &MockBalancer_GetPChannelRetentionPolicies_Call{Call: _e.mock.On("GetPChannelRetentionPolicies", ctx, pchannels)}
 }
 
-func (_c *MockBalancer_RegisterStreamingEnabledNotifier_Call) Run(run func(notifier *syncutil.AsyncTaskNotifier[struct{}])) *MockBalancer_RegisterStreamingEnabledNotifier_Call {
+func (_c *MockBalancer_GetPChannelRetentionPolicies_Call) Run(run func(ctx context.Context, pchannels []string)) *MockBalancer_GetPChannelRetentionPolicies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*syncutil.AsyncTaskNotifier[struct{}]))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockBalancer_RegisterStreamingEnabledNotifier_Call) Return() *MockBalancer_RegisterStreamingEnabledNotifier_Call {
-	_c.Call.Return()
+func (_c *MockBalancer_GetPChannelRetentionPolicies_Call) Return(_a0 map[string]*streamingpb.WALRetentionPolicy, _a1 error) *MockBalancer_GetPChannelRetentionPolicies_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockBalancer_RegisterStreamingEnabledNotifier_Call) RunAndReturn(run func(*syncutil.AsyncTaskNotifier[struct{}])) *MockBalancer_RegisterStreamingEnabledNotifier_Call {
-	_c.Run(run)
+func (_c *MockBalancer_GetPChannelRetentionPolicies_Call) RunAndReturn(run func(context.Context, []string) (map[string]*streamingpb.WALRetentionPolicy, error)) *MockBalancer_GetPChannelRetentionPolicies_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// ReplicateRole provides a mock function with no fields
-func (_m *MockBalancer) ReplicateRole() replicateutil.Role {
-	ret := _m.Called()
+// GetPChannelsView provides a mock function with given fields: ctx
+func (_m *MockBalancer) GetPChannelsView(ctx context.Context) (*channel.PChannelView, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReplicateRole")
+		panic("no return value specified for GetPChannelsView")
 	}
 
-	var r0 replicateutil.Role
-	if rf, ok := ret.Get(0).(func() replicateutil.Role); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(replicateutil.Role)
-	}
+	var r0 *channel.PChannelView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*channel.PChannelView, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *channel.PChannelView); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*channel.PChannelView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_GetPChannelsView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPChannelsView'
+type MockBalancer_GetPChannelsView_Call struct {
+	*mock.Call
+}
+
+// GetPChannelsView is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalancer_Expecter) GetPChannelsView(ctx interface{}) *MockBalancer_GetPChannelsView_Call {
+	return &MockBalancer_GetPChannelsView_Call{Call: _e.mock.On("GetPChannelsView", ctx)}
+}
+
+func (_c *MockBalancer_GetPChannelsView_Call) Run(run func(ctx context.Context)) *MockBalancer_GetPChannelsView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_GetPChannelsView_Call) Return(_a0 *channel.PChannelView, _a1 error) *MockBalancer_GetPChannelsView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_GetPChannelsView_Call) RunAndReturn(run func(context.Context) (*channel.PChannelView, error)) *MockBalancer_GetPChannelsView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAsUnavailable provides a mock function with given fields: ctx, pChannels
+func (_m *MockBalancer) MarkAsUnavailable(ctx context.Context, pChannels []types.PChannelInfo) error {
+	ret := _m.Called(ctx, pChannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUnavailable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.PChannelInfo) error); ok {
+		r0 = rf(ctx, pChannels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_MarkAsUnavailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUnavailable'
+type MockBalancer_MarkAsUnavailable_Call struct {
+	*mock.Call
+}
+
+// MarkAsUnavailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pChannels []types.PChannelInfo
+func (_e *MockBalancer_Expecter) MarkAsUnavailable(ctx interface{}, pChannels interface{}) *MockBalancer_MarkAsUnavailable_Call {
+	return &MockBalancer_MarkAsUnavailable_Call{Call: _e.mock.On("MarkAsUnavailable", ctx, pChannels)}
+}
+
+func (_c *MockBalancer_MarkAsUnavailable_Call) Run(run func(ctx context.Context, pChannels []types.PChannelInfo)) *MockBalancer_MarkAsUnavailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]types.PChannelInfo))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_MarkAsUnavailable_Call) Return(_a0 error) *MockBalancer_MarkAsUnavailable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_MarkAsUnavailable_Call) RunAndReturn(run func(context.Context, []types.PChannelInfo) error) *MockBalancer_MarkAsUnavailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveControlChannel provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) MoveControlChannel(ctx context.Context, req *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveControlChannel")
+	}
+
+	var r0 *streamingpb.MoveControlChannelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MoveControlChannelRequest) *streamingpb.MoveControlChannelResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.MoveControlChannelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.MoveControlChannelRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_MoveControlChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveControlChannel'
+type MockBalancer_MoveControlChannel_Call struct {
+	*mock.Call
+}
+
+// MoveControlChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.MoveControlChannelRequest
+func (_e *MockBalancer_Expecter) MoveControlChannel(ctx interface{}, req interface{}) *MockBalancer_MoveControlChannel_Call {
+	return &MockBalancer_MoveControlChannel_Call{Call: _e.mock.On("MoveControlChannel", ctx, req)}
+}
+
+func (_c *MockBalancer_MoveControlChannel_Call) Run(run func(ctx context.Context, req *streamingpb.MoveControlChannelRequest)) *MockBalancer_MoveControlChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.MoveControlChannelRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_MoveControlChannel_Call) Return(_a0 *streamingpb.MoveControlChannelResponse, _a1 error) *MockBalancer_MoveControlChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_MoveControlChannel_Call) RunAndReturn(run func(context.Context, *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error)) *MockBalancer_MoveControlChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanReplicateConfiguration provides a mock function with given fields: ctx, config
+func (_m *MockBalancer) PlanReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanReplicateConfiguration")
+	}
+
+	var r0 *streamingpb.ReplicateConfigurationPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *commonpb.ReplicateConfiguration) *streamingpb.ReplicateConfigurationPlan); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ReplicateConfigurationPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *commonpb.ReplicateConfiguration) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_PlanReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanReplicateConfiguration'
+type MockBalancer_PlanReplicateConfiguration_Call struct {
+	*mock.Call
+}
+
+// PlanReplicateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *commonpb.ReplicateConfiguration
+func (_e *MockBalancer_Expecter) PlanReplicateConfiguration(ctx interface{}, config interface{}) *MockBalancer_PlanReplicateConfiguration_Call {
+	return &MockBalancer_PlanReplicateConfiguration_Call{Call: _e.mock.On("PlanReplicateConfiguration", ctx, config)}
+}
+
+func (_c *MockBalancer_PlanReplicateConfiguration_Call) Run(run func(ctx context.Context, config *commonpb.ReplicateConfiguration)) *MockBalancer_PlanReplicateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*commonpb.ReplicateConfiguration))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_PlanReplicateConfiguration_Call) Return(_a0 *streamingpb.ReplicateConfigurationPlan, _a1 error) *MockBalancer_PlanReplicateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_PlanReplicateConfiguration_Call) RunAndReturn(run func(context.Context, *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error)) *MockBalancer_PlanReplicateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewRebalance provides a mock function with given fields: ctx
+func (_m *MockBalancer) PreviewRebalance(ctx context.Context) ([]channel.RebalanceMove, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewRebalance")
+	}
+
+	var r0 []channel.RebalanceMove
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]channel.RebalanceMove, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []channel.RebalanceMove); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]channel.RebalanceMove)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_PreviewRebalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewRebalance'
+type MockBalancer_PreviewRebalance_Call struct {
+	*mock.Call
+}
+
+// PreviewRebalance is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalancer_Expecter) PreviewRebalance(ctx interface{}) *MockBalancer_PreviewRebalance_Call {
+	return &MockBalancer_PreviewRebalance_Call{Call: _e.mock.On("PreviewRebalance", ctx)}
+}
+
+func (_c *MockBalancer_PreviewRebalance_Call) Run(run func(ctx context.Context)) *MockBalancer_PreviewRebalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_PreviewRebalance_Call) Return(_a0 []channel.RebalanceMove, _a1 error) *MockBalancer_PreviewRebalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_PreviewRebalance_Call) RunAndReturn(run func(context.Context) ([]channel.RebalanceMove, error)) *MockBalancer_PreviewRebalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterAssignmentWatcher provides a mock function with given fields: ctx, id, cb, opts
+func (_m *MockBalancer) RegisterAssignmentWatcher(ctx context.Context, id string, cb balancer.WatchChannelAssignmentsCallback, opts ...channel.WatchAssignmentOption) (*channel.AssignmentWatcher, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, id, cb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterAssignmentWatcher")
+	}
+
+	var r0 *channel.AssignmentWatcher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, balancer.WatchChannelAssignmentsCallback, ...channel.WatchAssignmentOption) (*channel.AssignmentWatcher, error)); ok {
+		return rf(ctx, id, cb, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, balancer.WatchChannelAssignmentsCallback, ...channel.WatchAssignmentOption) *channel.AssignmentWatcher); ok {
+		r0 = rf(ctx, id, cb, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*channel.AssignmentWatcher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, balancer.WatchChannelAssignmentsCallback, ...channel.WatchAssignmentOption) error); ok {
+		r1 = rf(ctx, id, cb, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_RegisterAssignmentWatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterAssignmentWatcher'
+type MockBalancer_RegisterAssignmentWatcher_Call struct {
+	*mock.Call
+}
+
+// RegisterAssignmentWatcher is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - cb balancer.WatchChannelAssignmentsCallback
+//   - opts ...channel.WatchAssignmentOption
+func (_e *MockBalancer_Expecter) RegisterAssignmentWatcher(ctx interface{}, id interface{}, cb interface{}, opts ...interface{}) *MockBalancer_RegisterAssignmentWatcher_Call {
+	return &MockBalancer_RegisterAssignmentWatcher_Call{Call: _e.mock.On("RegisterAssignmentWatcher",
+		append([]interface{}{ctx, id, cb}, opts...)...)}
+}
+
+func (_c *MockBalancer_RegisterAssignmentWatcher_Call) Run(run func(ctx context.Context, id string, cb balancer.WatchChannelAssignmentsCallback, opts ...channel.WatchAssignmentOption)) *MockBalancer_RegisterAssignmentWatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]channel.WatchAssignmentOption, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(channel.WatchAssignmentOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(balancer.WatchChannelAssignmentsCallback), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBalancer_RegisterAssignmentWatcher_Call) Return(_a0 *channel.AssignmentWatcher, _a1 error) *MockBalancer_RegisterAssignmentWatcher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_RegisterAssignmentWatcher_Call) RunAndReturn(run func(context.Context, string, balancer.WatchChannelAssignmentsCallback, ...channel.WatchAssignmentOption) (*channel.AssignmentWatcher, error)) *MockBalancer_RegisterAssignmentWatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReallocVirtualChannels provides a mock function with given fields: ctx, param
+func (_m *MockBalancer) ReallocVirtualChannels(ctx context.Context, param balancer.ReallocVChannelParam) ([]string, error) {
+	ret := _m.Called(ctx, param)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReallocVirtualChannels")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, balancer.ReallocVChannelParam) ([]string, error)); ok {
+		return rf(ctx, param)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, balancer.ReallocVChannelParam) []string); ok {
+		r0 = rf(ctx, param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, balancer.ReallocVChannelParam) error); ok {
+		r1 = rf(ctx, param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_ReallocVirtualChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReallocVirtualChannels'
+type MockBalancer_ReallocVirtualChannels_Call struct {
+	*mock.Call
+}
+
+// ReallocVirtualChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - param balancer.ReallocVChannelParam
+func (_e *MockBalancer_Expecter) ReallocVirtualChannels(ctx interface{}, param interface{}) *MockBalancer_ReallocVirtualChannels_Call {
+	return &MockBalancer_ReallocVirtualChannels_Call{Call: _e.mock.On("ReallocVirtualChannels", ctx, param)}
+}
+
+func (_c *MockBalancer_ReallocVirtualChannels_Call) Run(run func(ctx context.Context, param balancer.ReallocVChannelParam)) *MockBalancer_ReallocVirtualChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(balancer.ReallocVChannelParam))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_ReallocVirtualChannels_Call) Return(_a0 []string, _a1 error) *MockBalancer_ReallocVirtualChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_ReallocVirtualChannels_Call) RunAndReturn(run func(context.Context, balancer.ReallocVChannelParam) ([]string, error)) *MockBalancer_ReallocVirtualChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterPChannels provides a mock function with given fields: ctx, names
+func (_m *MockBalancer) RegisterPChannels(ctx context.Context, names []string) error {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterPChannels")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_RegisterPChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterPChannels'
+type MockBalancer_RegisterPChannels_Call struct {
+	*mock.Call
+}
+
+// RegisterPChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockBalancer_Expecter) RegisterPChannels(ctx interface{}, names interface{}) *MockBalancer_RegisterPChannels_Call {
+	return &MockBalancer_RegisterPChannels_Call{Call: _e.mock.On("RegisterPChannels", ctx, names)}
+}
+
+func (_c *MockBalancer_RegisterPChannels_Call) Run(run func(ctx context.Context, names []string)) *MockBalancer_RegisterPChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_RegisterPChannels_Call) Return(_a0 error) *MockBalancer_RegisterPChannels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_RegisterPChannels_Call) RunAndReturn(run func(context.Context, []string) error) *MockBalancer_RegisterPChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterStreamingDisabledNotifier provides a mock function with given fields: notifier
+func (_m *MockBalancer) RegisterStreamingDisabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}]) {
+	_m.Called(notifier)
+}
+
+// MockBalancer_RegisterStreamingDisabledNotifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterStreamingDisabledNotifier'
+type MockBalancer_RegisterStreamingDisabledNotifier_Call struct {
+	*mock.Call
+}
+
+// RegisterStreamingDisabledNotifier is a helper method to define mock.On call
+//   - notifier *syncutil.AsyncTaskNotifier[struct{}]
+func (_e *MockBalancer_Expecter) RegisterStreamingDisabledNotifier(notifier interface{}) *MockBalancer_RegisterStreamingDisabledNotifier_Call {
+	return &MockBalancer_RegisterStreamingDisabledNotifier_Call{Call: _e.mock.On("RegisterStreamingDisabledNotifier", notifier)}
+}
+
+func (_c *MockBalancer_RegisterStreamingDisabledNotifier_Call) Run(run func(notifier *syncutil.AsyncTaskNotifier[struct{}])) *MockBalancer_RegisterStreamingDisabledNotifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*syncutil.AsyncTaskNotifier[struct{}]))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_RegisterStreamingDisabledNotifier_Call) Return() *MockBalancer_RegisterStreamingDisabledNotifier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBalancer_RegisterStreamingDisabledNotifier_Call) RunAndReturn(run func(*syncutil.AsyncTaskNotifier[struct{}])) *MockBalancer_RegisterStreamingDisabledNotifier_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RegisterStreamingEnabledNotifier provides a mock function with given fields: notifier
+func (_m *MockBalancer) RegisterStreamingEnabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}]) {
+	_m.Called(notifier)
+}
+
+// MockBalancer_RegisterStreamingEnabledNotifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterStreamingEnabledNotifier'
+type MockBalancer_RegisterStreamingEnabledNotifier_Call struct {
+	*mock.Call
+}
+
+// RegisterStreamingEnabledNotifier is a helper method to define mock.On call
+//   - notifier *syncutil.AsyncTaskNotifier[struct{}]
+func (_e *MockBalancer_Expecter) RegisterStreamingEnabledNotifier(notifier interface{}) *MockBalancer_RegisterStreamingEnabledNotifier_Call {
+	return &MockBalancer_RegisterStreamingEnabledNotifier_Call{Call: _e.mock.On("RegisterStreamingEnabledNotifier", notifier)}
+}
+
+func (_c *MockBalancer_RegisterStreamingEnabledNotifier_Call) Run(run func(notifier *syncutil.AsyncTaskNotifier[struct{}])) *MockBalancer_RegisterStreamingEnabledNotifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*syncutil.AsyncTaskNotifier[struct{}]))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_RegisterStreamingEnabledNotifier_Call) Return() *MockBalancer_RegisterStreamingEnabledNotifier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBalancer_RegisterStreamingEnabledNotifier_Call) RunAndReturn(run func(*syncutil.AsyncTaskNotifier[struct{}])) *MockBalancer_RegisterStreamingEnabledNotifier_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RenewPChannelLease provides a mock function with given fields: ctx, node, pChannels
+func (_m *MockBalancer) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, pChannels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	ret := _m.Called(ctx, node, pChannels)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewPChannelLease")
+	}
+
+	var r0 []types.PChannelInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) ([]types.PChannelInfo, error)); ok {
+		return rf(ctx, node, pChannels)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) []types.PChannelInfo); ok {
+		r0 = rf(ctx, node, pChannels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.PChannelInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) error); ok {
+		r1 = rf(ctx, node, pChannels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_RenewPChannelLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewPChannelLease'
+type MockBalancer_RenewPChannelLease_Call struct {
+	*mock.Call
+}
+
+// RenewPChannelLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - node types.StreamingNodeInfo
+//   - pChannels []types.PChannelInfo
+func (_e *MockBalancer_Expecter) RenewPChannelLease(ctx interface{}, node interface{}, pChannels interface{}) *MockBalancer_RenewPChannelLease_Call {
+	return &MockBalancer_RenewPChannelLease_Call{Call: _e.mock.On("RenewPChannelLease", ctx, node, pChannels)}
+}
+
+func (_c *MockBalancer_RenewPChannelLease_Call) Run(run func(ctx context.Context, node types.StreamingNodeInfo, pChannels []types.PChannelInfo)) *MockBalancer_RenewPChannelLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.StreamingNodeInfo), args[2].([]types.PChannelInfo))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_RenewPChannelLease_Call) Return(_a0 []types.PChannelInfo, _a1 error) *MockBalancer_RenewPChannelLease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_RenewPChannelLease_Call) RunAndReturn(run func(context.Context, types.StreamingNodeInfo, []types.PChannelInfo) ([]types.PChannelInfo, error)) *MockBalancer_RenewPChannelLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplicateRole provides a mock function with no fields
+func (_m *MockBalancer) ReplicateRole() replicateutil.Role {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicateRole")
+	}
+
+	var r0 replicateutil.Role
+	if rf, ok := ret.Get(0).(func() replicateutil.Role); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(replicateutil.Role)
+	}
+
+	return r0
+}
+
+// MockBalancer_ReplicateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicateRole'
+type MockBalancer_ReplicateRole_Call struct {
+	*mock.Call
+}
+
+// ReplicateRole is a helper method to define mock.On call
+func (_e *MockBalancer_Expecter) ReplicateRole() *MockBalancer_ReplicateRole_Call {
+	return &MockBalancer_ReplicateRole_Call{Call: _e.mock.On("ReplicateRole")}
+}
+
+func (_c *MockBalancer_ReplicateRole_Call) Run(run func()) *MockBalancer_ReplicateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockBalancer_ReplicateRole_Call) Return(_a0 replicateutil.Role) *MockBalancer_ReplicateRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_ReplicateRole_Call) RunAndReturn(run func() replicateutil.Role) *MockBalancer_ReplicateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetReplicateCheckpoint provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) ResetReplicateCheckpoint(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetReplicateCheckpoint")
+	}
+
+	var r0 *streamingpb.ResetReplicateCheckpointResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) *streamingpb.ResetReplicateCheckpointResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ResetReplicateCheckpointResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_ResetReplicateCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetReplicateCheckpoint'
+type MockBalancer_ResetReplicateCheckpoint_Call struct {
+	*mock.Call
+}
+
+// ResetReplicateCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.ResetReplicateCheckpointRequest
+func (_e *MockBalancer_Expecter) ResetReplicateCheckpoint(ctx interface{}, req interface{}) *MockBalancer_ResetReplicateCheckpoint_Call {
+	return &MockBalancer_ResetReplicateCheckpoint_Call{Call: _e.mock.On("ResetReplicateCheckpoint", ctx, req)}
+}
+
+func (_c *MockBalancer_ResetReplicateCheckpoint_Call) Run(run func(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest)) *MockBalancer_ResetReplicateCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.ResetReplicateCheckpointRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_ResetReplicateCheckpoint_Call) Return(_a0 *streamingpb.ResetReplicateCheckpointResponse, _a1 error) *MockBalancer_ResetReplicateCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_ResetReplicateCheckpoint_Call) RunAndReturn(run func(context.Context, *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error)) *MockBalancer_ResetReplicateCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFileResourceChecker provides a mock function with given fields: checker
+func (_m *MockBalancer) SetFileResourceChecker(checker balancer.FileResourceChecker) {
+	_m.Called(checker)
+}
+
+// MockBalancer_SetFileResourceChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFileResourceChecker'
+type MockBalancer_SetFileResourceChecker_Call struct {
+	*mock.Call
+}
+
+// SetFileResourceChecker is a helper method to define mock.On call
+//   - checker balancer.FileResourceChecker
+func (_e *MockBalancer_Expecter) SetFileResourceChecker(checker interface{}) *MockBalancer_SetFileResourceChecker_Call {
+	return &MockBalancer_SetFileResourceChecker_Call{Call: _e.mock.On("SetFileResourceChecker", checker)}
+}
+
+func (_c *MockBalancer_SetFileResourceChecker_Call) Run(run func(checker balancer.FileResourceChecker)) *MockBalancer_SetFileResourceChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(balancer.FileResourceChecker))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_SetFileResourceChecker_Call) Return() *MockBalancer_SetFileResourceChecker_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBalancer_SetFileResourceChecker_Call) RunAndReturn(run func(balancer.FileResourceChecker)) *MockBalancer_SetFileResourceChecker_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Trigger provides a mock function with given fields: ctx
+func (_m *MockBalancer) Trigger(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_Trigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trigger'
+type MockBalancer_Trigger_Call struct {
+	*mock.Call
+}
+
+// Trigger is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalancer_Expecter) Trigger(ctx interface{}) *MockBalancer_Trigger_Call {
+	return &MockBalancer_Trigger_Call{Call: _e.mock.On("Trigger", ctx)}
+}
+
+func (_c *MockBalancer_Trigger_Call) Run(run func(ctx context.Context)) *MockBalancer_Trigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_Trigger_Call) Return(_a0 error) *MockBalancer_Trigger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_Trigger_Call) RunAndReturn(run func(context.Context) error) *MockBalancer_Trigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBalancePolicy provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdateBalancePolicy(ctx context.Context, req *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBalancePolicy")
+	}
+
+	var r0 *streamingpb.UpdateWALBalancePolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) *streamingpb.UpdateWALBalancePolicyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdateWALBalancePolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_UpdateBalancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBalancePolicy'
+type MockBalancer_UpdateBalancePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateBalancePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdateWALBalancePolicyRequest
+func (_e *MockBalancer_Expecter) UpdateBalancePolicy(ctx interface{}, req interface{}) *MockBalancer_UpdateBalancePolicy_Call {
+	return &MockBalancer_UpdateBalancePolicy_Call{Call: _e.mock.On("UpdateBalancePolicy", ctx, req)}
+}
+
+func (_c *MockBalancer_UpdateBalancePolicy_Call) Run(run func(ctx context.Context, req *streamingpb.UpdateWALBalancePolicyRequest)) *MockBalancer_UpdateBalancePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateWALBalancePolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_UpdateBalancePolicy_Call) Return(_a0 *streamingpb.UpdateWALBalancePolicyResponse, _a1 error) *MockBalancer_UpdateBalancePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_UpdateBalancePolicy_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error)) *MockBalancer_UpdateBalancePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCollectionAppendQuotas provides a mock function with given fields: ctx, quotas
+func (_m *MockBalancer) UpdateCollectionAppendQuotas(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota) error {
+	ret := _m.Called(ctx, quotas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollectionAppendQuotas")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.CollectionAppendQuota) error); ok {
+		r0 = rf(ctx, quotas)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_UpdateCollectionAppendQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCollectionAppendQuotas'
+type MockBalancer_UpdateCollectionAppendQuotas_Call struct {
+	*mock.Call
+}
+
+// UpdateCollectionAppendQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - quotas []*streamingpb.CollectionAppendQuota
+func (_e *MockBalancer_Expecter) UpdateCollectionAppendQuotas(ctx interface{}, quotas interface{}) *MockBalancer_UpdateCollectionAppendQuotas_Call {
+	return &MockBalancer_UpdateCollectionAppendQuotas_Call{Call: _e.mock.On("UpdateCollectionAppendQuotas", ctx, quotas)}
+}
+
+func (_c *MockBalancer_UpdateCollectionAppendQuotas_Call) Run(run func(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota)) *MockBalancer_UpdateCollectionAppendQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.CollectionAppendQuota))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_UpdateCollectionAppendQuotas_Call) Return(_a0 error) *MockBalancer_UpdateCollectionAppendQuotas_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_UpdateCollectionAppendQuotas_Call) RunAndReturn(run func(context.Context, []*streamingpb.CollectionAppendQuota) error) *MockBalancer_UpdateCollectionAppendQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelAntiAffinityGroups provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdatePChannelAntiAffinityGroups(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelAntiAffinityGroups")
+	}
+
+	var r0 *streamingpb.UpdatePChannelAntiAffinityGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) *streamingpb.UpdatePChannelAntiAffinityGroupsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelAntiAffinityGroupsResponse)
+		}
+	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockBalancer_ReplicateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicateRole'
-type MockBalancer_ReplicateRole_Call struct {
+// MockBalancer_UpdatePChannelAntiAffinityGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelAntiAffinityGroups'
+type MockBalancer_UpdatePChannelAntiAffinityGroups_Call struct {
 	*mock.Call
 }
 
-// ReplicateRole is a helper method to define mock.On call
-func (_e *MockBalancer_Expecter) ReplicateRole() *MockBalancer_ReplicateRole_Call {
-	return &MockBalancer_ReplicateRole_Call{Call: _e.mock.On("ReplicateRole")}
+// UpdatePChannelAntiAffinityGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest
+func (_e *MockBalancer_Expecter) UpdatePChannelAntiAffinityGroups(ctx interface{}, req interface{}) *MockBalancer_UpdatePChannelAntiAffinityGroups_Call {
+	return &MockBalancer_UpdatePChannelAntiAffinityGroups_Call{Call: _e.mock.On("UpdatePChannelAntiAffinityGroups", ctx, req)}
 }
 
-func (_c *MockBalancer_ReplicateRole_Call) Run(run func()) *MockBalancer_ReplicateRole_Call {
+func (_c *MockBalancer_UpdatePChannelAntiAffinityGroups_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest)) *MockBalancer_UpdatePChannelAntiAffinityGroups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelAntiAffinityGroupsRequest))
 	})
 	return _c
 }
 
-func (_c *MockBalancer_ReplicateRole_Call) Return(_a0 replicateutil.Role) *MockBalancer_ReplicateRole_Call {
-	_c.Call.Return(_a0)
+func (_c *MockBalancer_UpdatePChannelAntiAffinityGroups_Call) Return(_a0 *streamingpb.UpdatePChannelAntiAffinityGroupsResponse, _a1 error) *MockBalancer_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockBalancer_ReplicateRole_Call) RunAndReturn(run func() replicateutil.Role) *MockBalancer_ReplicateRole_Call {
+func (_c *MockBalancer_UpdatePChannelAntiAffinityGroups_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)) *MockBalancer_UpdatePChannelAntiAffinityGroups_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetFileResourceChecker provides a mock function with given fields: checker
-func (_m *MockBalancer) SetFileResourceChecker(checker balancer.FileResourceChecker) {
-	_m.Called(checker)
+// UpdatePChannelPins provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelPins")
+	}
+
+	var r0 *streamingpb.UpdatePChannelPinsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest) *streamingpb.UpdatePChannelPinsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelPinsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockBalancer_SetFileResourceChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFileResourceChecker'
-type MockBalancer_SetFileResourceChecker_Call struct {
+// MockBalancer_UpdatePChannelPins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelPins'
+type MockBalancer_UpdatePChannelPins_Call struct {
 	*mock.Call
 }
 
-// SetFileResourceChecker is a helper method to define mock.On call
-//   - checker balancer.FileResourceChecker
-func (_e *MockBalancer_Expecter) SetFileResourceChecker(checker interface{}) *MockBalancer_SetFileResourceChecker_Call {
-	return &MockBalancer_SetFileResourceChecker_Call{Call: _e.mock.On("SetFileResourceChecker", checker)}
+// UpdatePChannelPins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdatePChannelPinsRequest
+func (_e *MockBalancer_Expecter) UpdatePChannelPins(ctx interface{}, req interface{}) *MockBalancer_UpdatePChannelPins_Call {
+	return &MockBalancer_UpdatePChannelPins_Call{Call: _e.mock.On("UpdatePChannelPins", ctx, req)}
 }
 
-func (_c *MockBalancer_SetFileResourceChecker_Call) Run(run func(checker balancer.FileResourceChecker)) *MockBalancer_SetFileResourceChecker_Call {
+func (_c *MockBalancer_UpdatePChannelPins_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest)) *MockBalancer_UpdatePChannelPins_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(balancer.FileResourceChecker))
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelPinsRequest))
 	})
 	return _c
 }
 
-func (_c *MockBalancer_SetFileResourceChecker_Call) Return() *MockBalancer_SetFileResourceChecker_Call {
-	_c.Call.Return()
+func (_c *MockBalancer_UpdatePChannelPins_Call) Return(_a0 *streamingpb.UpdatePChannelPinsResponse, _a1 error) *MockBalancer_UpdatePChannelPins_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockBalancer_SetFileResourceChecker_Call) RunAndReturn(run func(balancer.FileResourceChecker)) *MockBalancer_SetFileResourceChecker_Call {
-	_c.Run(run)
+func (_c *MockBalancer_UpdatePChannelPins_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error)) *MockBalancer_UpdatePChannelPins_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Trigger provides a mock function with given fields: ctx
-func (_m *MockBalancer) Trigger(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// UpdatePChannelPools provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Trigger")
+		panic("no return value specified for UpdatePChannelPools")
+	}
+
+	var r0 *streamingpb.UpdatePChannelPoolsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) *streamingpb.UpdatePChannelPoolsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelPoolsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_UpdatePChannelPools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelPools'
+type MockBalancer_UpdatePChannelPools_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelPools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdatePChannelPoolsRequest
+func (_e *MockBalancer_Expecter) UpdatePChannelPools(ctx interface{}, req interface{}) *MockBalancer_UpdatePChannelPools_Call {
+	return &MockBalancer_UpdatePChannelPools_Call{Call: _e.mock.On("UpdatePChannelPools", ctx, req)}
+}
+
+func (_c *MockBalancer_UpdatePChannelPools_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest)) *MockBalancer_UpdatePChannelPools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelPoolsRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_UpdatePChannelPools_Call) Return(_a0 *streamingpb.UpdatePChannelPoolsResponse, _a1 error) *MockBalancer_UpdatePChannelPools_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_UpdatePChannelPools_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error)) *MockBalancer_UpdatePChannelPools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelRetentionPolicies provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdatePChannelRetentionPolicies(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelRetentionPolicies")
+	}
+
+	var r0 *streamingpb.UpdatePChannelRetentionPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) *streamingpb.UpdatePChannelRetentionPoliciesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelRetentionPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_UpdatePChannelRetentionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelRetentionPolicies'
+type MockBalancer_UpdatePChannelRetentionPolicies_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelRetentionPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdatePChannelRetentionPoliciesRequest
+func (_e *MockBalancer_Expecter) UpdatePChannelRetentionPolicies(ctx interface{}, req interface{}) *MockBalancer_UpdatePChannelRetentionPolicies_Call {
+	return &MockBalancer_UpdatePChannelRetentionPolicies_Call{Call: _e.mock.On("UpdatePChannelRetentionPolicies", ctx, req)}
+}
+
+func (_c *MockBalancer_UpdatePChannelRetentionPolicies_Call) Run(run func(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest)) *MockBalancer_UpdatePChannelRetentionPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelRetentionPoliciesRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_UpdatePChannelRetentionPolicies_Call) Return(_a0 *streamingpb.UpdatePChannelRetentionPoliciesResponse, _a1 error) *MockBalancer_UpdatePChannelRetentionPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_UpdatePChannelRetentionPolicies_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)) *MockBalancer_UpdatePChannelRetentionPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelTopics provides a mock function with given fields: ctx, pchannelTopics
+func (_m *MockBalancer) UpdatePChannelTopics(ctx context.Context, pchannelTopics map[string]string) error {
+	ret := _m.Called(ctx, pchannelTopics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelTopics")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) error); ok {
+		r0 = rf(ctx, pchannelTopics)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -576,56 +1913,57 @@ func (_m *MockBalancer) Trigger(ctx context.Context) error {
 	return r0
 }
 
-// MockBalancer_Trigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trigger'
-type MockBalancer_Trigger_Call struct {
+// MockBalancer_UpdatePChannelTopics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelTopics'
+type MockBalancer_UpdatePChannelTopics_Call struct {
 	*mock.Call
 }
 
-// Trigger is a helper method to define mock.On call
+// UpdatePChannelTopics is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockBalancer_Expecter) Trigger(ctx interface{}) *MockBalancer_Trigger_Call {
-	return &MockBalancer_Trigger_Call{Call: _e.mock.On("Trigger", ctx)}
+//   - pchannelTopics map[string]string
+func (_e *MockBalancer_Expecter) UpdatePChannelTopics(ctx interface{}, pchannelTopics interface{}) *MockBalancer_UpdatePChannelTopics_Call {
+	return &MockBalancer_UpdatePChannelTopics_Call{Call: _e.mock.On("UpdatePChannelTopics", ctx, pchannelTopics)}
 }
 
-func (_c *MockBalancer_Trigger_Call) Run(run func(ctx context.Context)) *MockBalancer_Trigger_Call {
+func (_c *MockBalancer_UpdatePChannelTopics_Call) Run(run func(ctx context.Context, pchannelTopics map[string]string)) *MockBalancer_UpdatePChannelTopics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(map[string]string))
 	})
 	return _c
 }
 
-func (_c *MockBalancer_Trigger_Call) Return(_a0 error) *MockBalancer_Trigger_Call {
+func (_c *MockBalancer_UpdatePChannelTopics_Call) Return(_a0 error) *MockBalancer_UpdatePChannelTopics_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockBalancer_Trigger_Call) RunAndReturn(run func(context.Context) error) *MockBalancer_Trigger_Call {
+func (_c *MockBalancer_UpdatePChannelTopics_Call) RunAndReturn(run func(context.Context, map[string]string) error) *MockBalancer_UpdatePChannelTopics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateBalancePolicy provides a mock function with given fields: ctx, req
-func (_m *MockBalancer) UpdateBalancePolicy(ctx context.Context, req *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error) {
+// UpdateReplicateClusterConnection provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdateReplicateClusterConnection(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error) {
 	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateBalancePolicy")
+		panic("no return value specified for UpdateReplicateClusterConnection")
 	}
 
-	var r0 *streamingpb.UpdateWALBalancePolicyResponse
+	var r0 *streamingpb.UpdateReplicateClusterConnectionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) *streamingpb.UpdateWALBalancePolicyResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) *streamingpb.UpdateReplicateClusterConnectionResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*streamingpb.UpdateWALBalancePolicyResponse)
+			r0 = ret.Get(0).(*streamingpb.UpdateReplicateClusterConnectionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -634,31 +1972,31 @@ func (_m *MockBalancer) UpdateBalancePolicy(ctx context.Context, req *streamingp
 	return r0, r1
 }
 
-// MockBalancer_UpdateBalancePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBalancePolicy'
-type MockBalancer_UpdateBalancePolicy_Call struct {
+// MockBalancer_UpdateReplicateClusterConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicateClusterConnection'
+type MockBalancer_UpdateReplicateClusterConnection_Call struct {
 	*mock.Call
 }
 
-// UpdateBalancePolicy is a helper method to define mock.On call
+// UpdateReplicateClusterConnection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req *streamingpb.UpdateWALBalancePolicyRequest
-func (_e *MockBalancer_Expecter) UpdateBalancePolicy(ctx interface{}, req interface{}) *MockBalancer_UpdateBalancePolicy_Call {
-	return &MockBalancer_UpdateBalancePolicy_Call{Call: _e.mock.On("UpdateBalancePolicy", ctx, req)}
+//   - req *streamingpb.UpdateReplicateClusterConnectionRequest
+func (_e *MockBalancer_Expecter) UpdateReplicateClusterConnection(ctx interface{}, req interface{}) *MockBalancer_UpdateReplicateClusterConnection_Call {
+	return &MockBalancer_UpdateReplicateClusterConnection_Call{Call: _e.mock.On("UpdateReplicateClusterConnection", ctx, req)}
 }
 
-func (_c *MockBalancer_UpdateBalancePolicy_Call) Run(run func(ctx context.Context, req *streamingpb.UpdateWALBalancePolicyRequest)) *MockBalancer_UpdateBalancePolicy_Call {
+func (_c *MockBalancer_UpdateReplicateClusterConnection_Call) Run(run func(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest)) *MockBalancer_UpdateReplicateClusterConnection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*streamingpb.UpdateWALBalancePolicyRequest))
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateReplicateClusterConnectionRequest))
 	})
 	return _c
 }
 
-func (_c *MockBalancer_UpdateBalancePolicy_Call) Return(_a0 *streamingpb.UpdateWALBalancePolicyResponse, _a1 error) *MockBalancer_UpdateBalancePolicy_Call {
+func (_c *MockBalancer_UpdateReplicateClusterConnection_Call) Return(_a0 *streamingpb.UpdateReplicateClusterConnectionResponse, _a1 error) *MockBalancer_UpdateReplicateClusterConnection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockBalancer_UpdateBalancePolicy_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error)) *MockBalancer_UpdateBalancePolicy_Call {
+func (_c *MockBalancer_UpdateReplicateClusterConnection_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)) *MockBalancer_UpdateReplicateClusterConnection_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -710,6 +2048,65 @@ func (_c *MockBalancer_UpdateReplicateConfiguration_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateReplicatingTaskState provides a mock function with given fields: ctx, req
+func (_m *MockBalancer) UpdateReplicatingTaskState(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReplicatingTaskState")
+	}
+
+	var r0 *streamingpb.UpdateReplicatingTaskStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) *streamingpb.UpdateReplicatingTaskStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdateReplicatingTaskStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBalancer_UpdateReplicatingTaskState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicatingTaskState'
+type MockBalancer_UpdateReplicatingTaskState_Call struct {
+	*mock.Call
+}
+
+// UpdateReplicatingTaskState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *streamingpb.UpdateReplicatingTaskStateRequest
+func (_e *MockBalancer_Expecter) UpdateReplicatingTaskState(ctx interface{}, req interface{}) *MockBalancer_UpdateReplicatingTaskState_Call {
+	return &MockBalancer_UpdateReplicatingTaskState_Call{Call: _e.mock.On("UpdateReplicatingTaskState", ctx, req)}
+}
+
+func (_c *MockBalancer_UpdateReplicatingTaskState_Call) Run(run func(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest)) *MockBalancer_UpdateReplicatingTaskState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateReplicatingTaskStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_UpdateReplicatingTaskState_Call) Return(_a0 *streamingpb.UpdateReplicatingTaskStateResponse, _a1 error) *MockBalancer_UpdateReplicatingTaskState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBalancer_UpdateReplicatingTaskState_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error)) *MockBalancer_UpdateReplicatingTaskState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WaitUntilSchemaDropReady provides a mock function with given fields: ctx
 func (_m *MockBalancer) WaitUntilSchemaDropReady(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -802,6 +2199,53 @@ func (_c *MockBalancer_WaitUntilWALbasedDDLReady_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// WatchChannelAssignmentDiffs provides a mock function with given fields: ctx, cb
+func (_m *MockBalancer) WatchChannelAssignmentDiffs(ctx context.Context, cb balancer.WatchChannelAssignmentsCallback) error {
+	ret := _m.Called(ctx, cb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchChannelAssignmentDiffs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, balancer.WatchChannelAssignmentsCallback) error); ok {
+		r0 = rf(ctx, cb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBalancer_WatchChannelAssignmentDiffs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchChannelAssignmentDiffs'
+type MockBalancer_WatchChannelAssignmentDiffs_Call struct {
+	*mock.Call
+}
+
+// WatchChannelAssignmentDiffs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cb balancer.WatchChannelAssignmentsCallback
+func (_e *MockBalancer_Expecter) WatchChannelAssignmentDiffs(ctx interface{}, cb interface{}) *MockBalancer_WatchChannelAssignmentDiffs_Call {
+	return &MockBalancer_WatchChannelAssignmentDiffs_Call{Call: _e.mock.On("WatchChannelAssignmentDiffs", ctx, cb)}
+}
+
+func (_c *MockBalancer_WatchChannelAssignmentDiffs_Call) Run(run func(ctx context.Context, cb balancer.WatchChannelAssignmentsCallback)) *MockBalancer_WatchChannelAssignmentDiffs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(balancer.WatchChannelAssignmentsCallback))
+	})
+	return _c
+}
+
+func (_c *MockBalancer_WatchChannelAssignmentDiffs_Call) Return(_a0 error) *MockBalancer_WatchChannelAssignmentDiffs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBalancer_WatchChannelAssignmentDiffs_Call) RunAndReturn(run func(context.Context, balancer.WatchChannelAssignmentsCallback) error) *MockBalancer_WatchChannelAssignmentDiffs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchChannelAssignments provides a mock function with given fields: ctx, cb
 func (_m *MockBalancer) WatchChannelAssignments(ctx context.Context, cb balancer.WatchChannelAssignmentsCallback) error {
 	ret := _m.Called(ctx, cb)
@@ -199,6 +199,54 @@ func (_c *MockBroadcaster_LegacyAck_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Watch provides a mock function with given fields: ctx, token, cb
+func (_m *MockBroadcaster) Watch(ctx context.Context, token *broadcaster.WatchResumeToken, cb broadcaster.WatchCallback) error {
+	ret := _m.Called(ctx, token, cb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *broadcaster.WatchResumeToken, broadcaster.WatchCallback) error); ok {
+		r0 = rf(ctx, token, cb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockBroadcaster_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type MockBroadcaster_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *broadcaster.WatchResumeToken
+//   - cb broadcaster.WatchCallback
+func (_e *MockBroadcaster_Expecter) Watch(ctx interface{}, token interface{}, cb interface{}) *MockBroadcaster_Watch_Call {
+	return &MockBroadcaster_Watch_Call{Call: _e.mock.On("Watch", ctx, token, cb)}
+}
+
+func (_c *MockBroadcaster_Watch_Call) Run(run func(ctx context.Context, token *broadcaster.WatchResumeToken, cb broadcaster.WatchCallback)) *MockBroadcaster_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*broadcaster.WatchResumeToken), args[2].(broadcaster.WatchCallback))
+	})
+	return _c
+}
+
+func (_c *MockBroadcaster_Watch_Call) Return(_a0 error) *MockBroadcaster_Watch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroadcaster_Watch_Call) RunAndReturn(run func(context.Context, *broadcaster.WatchResumeToken, broadcaster.WatchCallback) error) *MockBroadcaster_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithResourceKeys provides a mock function with given fields: ctx, resourceKeys
 func (_m *MockBroadcaster) WithResourceKeys(ctx context.Context, resourceKeys ...message.ResourceKey) (broadcaster.BroadcastAPI, error) {
 	_va := make([]interface{}, len(resourceKeys))
@@ -103,6 +103,55 @@ func (_c *MockWatcher_Get_Call) RunAndReturn(run func(context.Context, string) *
 	return _c
 }
 
+// GetForRead provides a mock function with given fields: ctx, channel
+func (_m *MockWatcher) GetForRead(ctx context.Context, channel string) *types.PChannelInfoAssigned {
+	ret := _m.Called(ctx, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForRead")
+	}
+
+	var r0 *types.PChannelInfoAssigned
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.PChannelInfoAssigned); ok {
+		r0 = rf(ctx, channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PChannelInfoAssigned)
+		}
+	}
+
+	return r0
+}
+
+// MockWatcher_GetForRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForRead'
+type MockWatcher_GetForRead_Call struct {
+	*mock.Call
+}
+
+// GetForRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+func (_e *MockWatcher_Expecter) GetForRead(ctx interface{}, channel interface{}) *MockWatcher_GetForRead_Call {
+	return &MockWatcher_GetForRead_Call{Call: _e.mock.On("GetForRead", ctx, channel)}
+}
+
+func (_c *MockWatcher_GetForRead_Call) Run(run func(ctx context.Context, channel string)) *MockWatcher_GetForRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWatcher_GetForRead_Call) Return(_a0 *types.PChannelInfoAssigned) *MockWatcher_GetForRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWatcher_GetForRead_Call) RunAndReturn(run func(context.Context, string) *types.PChannelInfoAssigned) *MockWatcher_GetForRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Watch provides a mock function with given fields: ctx, channel, previous
 func (_m *MockWatcher) Watch(ctx context.Context, channel string, previous *types.PChannelInfoAssigned) error {
 	ret := _m.Called(ctx, channel, previous)
@@ -9,6 +9,7 @@ import (
 
 	handler "github.com/milvus-io/milvus/internal/streamingnode/client/handler"
 
+	message "github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	mock "github.com/stretchr/testify/mock"
 
 	producer "github.com/milvus-io/milvus/internal/streamingnode/client/handler/producer"
@@ -473,6 +474,54 @@ func (_c *MockHandlerClient_PrepareReleaseManualFlush_Call) RunAndReturn(run fun
 	return _c
 }
 
+// TruncateWAL provides a mock function with given fields: ctx, channelName, truncateTo
+func (_m *MockHandlerClient) TruncateWAL(ctx context.Context, channelName string, truncateTo message.MessageID) error {
+	ret := _m.Called(ctx, channelName, truncateTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateWAL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, message.MessageID) error); ok {
+		r0 = rf(ctx, channelName, truncateTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockHandlerClient_TruncateWAL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateWAL'
+type MockHandlerClient_TruncateWAL_Call struct {
+	*mock.Call
+}
+
+// TruncateWAL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channelName string
+//   - truncateTo message.MessageID
+func (_e *MockHandlerClient_Expecter) TruncateWAL(ctx interface{}, channelName interface{}, truncateTo interface{}) *MockHandlerClient_TruncateWAL_Call {
+	return &MockHandlerClient_TruncateWAL_Call{Call: _e.mock.On("TruncateWAL", ctx, channelName, truncateTo)}
+}
+
+func (_c *MockHandlerClient_TruncateWAL_Call) Run(run func(ctx context.Context, channelName string, truncateTo message.MessageID)) *MockHandlerClient_TruncateWAL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(message.MessageID))
+	})
+	return _c
+}
+
+func (_c *MockHandlerClient_TruncateWAL_Call) Return(_a0 error) *MockHandlerClient_TruncateWAL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockHandlerClient_TruncateWAL_Call) RunAndReturn(run func(context.Context, string, message.MessageID) error) *MockHandlerClient_TruncateWAL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockHandlerClient creates a new instance of MockHandlerClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockHandlerClient(t interface {
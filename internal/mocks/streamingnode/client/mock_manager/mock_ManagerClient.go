@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	streamingpb "github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+
 	types "github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 )
 
@@ -266,6 +268,53 @@ func (_c *MockManagerClient_Remove_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateAppendQuotas provides a mock function with given fields: ctx, quotas
+func (_m *MockManagerClient) UpdateAppendQuotas(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota) error {
+	ret := _m.Called(ctx, quotas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppendQuotas")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*streamingpb.CollectionAppendQuota) error); ok {
+		r0 = rf(ctx, quotas)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockManagerClient_UpdateAppendQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppendQuotas'
+type MockManagerClient_UpdateAppendQuotas_Call struct {
+	*mock.Call
+}
+
+// UpdateAppendQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - quotas []*streamingpb.CollectionAppendQuota
+func (_e *MockManagerClient_Expecter) UpdateAppendQuotas(ctx interface{}, quotas interface{}) *MockManagerClient_UpdateAppendQuotas_Call {
+	return &MockManagerClient_UpdateAppendQuotas_Call{Call: _e.mock.On("UpdateAppendQuotas", ctx, quotas)}
+}
+
+func (_c *MockManagerClient_UpdateAppendQuotas_Call) Run(run func(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota)) *MockManagerClient_UpdateAppendQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*streamingpb.CollectionAppendQuota))
+	})
+	return _c
+}
+
+func (_c *MockManagerClient_UpdateAppendQuotas_Call) Return(_a0 error) *MockManagerClient_UpdateAppendQuotas_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockManagerClient_UpdateAppendQuotas_Call) RunAndReturn(run func(context.Context, []*streamingpb.CollectionAppendQuota) error) *MockManagerClient_UpdateAppendQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchNodeChanged provides a mock function with given fields: ctx
 func (_m *MockManagerClient) WatchNodeChanged(ctx context.Context) (<-chan struct{}, error) {
 	ret := _m.Called(ctx)
@@ -591,6 +591,53 @@ func (_c *MockWAL_Register_Call) RunAndReturn(run func(ratelimit.RateLimitObserv
 	return _c
 }
 
+// Truncate provides a mock function with given fields: ctx, truncateTo
+func (_m *MockWAL) Truncate(ctx context.Context, truncateTo message.MessageID) error {
+	ret := _m.Called(ctx, truncateTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Truncate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, message.MessageID) error); ok {
+		r0 = rf(ctx, truncateTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWAL_Truncate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Truncate'
+type MockWAL_Truncate_Call struct {
+	*mock.Call
+}
+
+// Truncate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - truncateTo message.MessageID
+func (_e *MockWAL_Expecter) Truncate(ctx interface{}, truncateTo interface{}) *MockWAL_Truncate_Call {
+	return &MockWAL_Truncate_Call{Call: _e.mock.On("Truncate", ctx, truncateTo)}
+}
+
+func (_c *MockWAL_Truncate_Call) Run(run func(ctx context.Context, truncateTo message.MessageID)) *MockWAL_Truncate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(message.MessageID))
+	})
+	return _c
+}
+
+func (_c *MockWAL_Truncate_Call) Return(_a0 error) *MockWAL_Truncate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWAL_Truncate_Call) RunAndReturn(run func(context.Context, message.MessageID) error) *MockWAL_Truncate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unregister provides a mock function with given fields: observer
 func (_m *MockWAL) Unregister(observer ratelimit.RateLimitObserver) {
 	_m.Called(observer)
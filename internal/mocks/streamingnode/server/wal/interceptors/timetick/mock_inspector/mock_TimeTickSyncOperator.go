@@ -9,6 +9,8 @@ import (
 
 	mvcc "github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/timetick/mvcc"
 
+	time "time"
+
 	types "github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 
 	wab "github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/wab"
@@ -153,6 +155,51 @@ func (_c *MockTimeTickSyncOperator_Sync_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SyncInterval provides a mock function with no fields
+func (_m *MockTimeTickSyncOperator) SyncInterval() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncInterval")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// MockTimeTickSyncOperator_SyncInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncInterval'
+type MockTimeTickSyncOperator_SyncInterval_Call struct {
+	*mock.Call
+}
+
+// SyncInterval is a helper method to define mock.On call
+func (_e *MockTimeTickSyncOperator_Expecter) SyncInterval() *MockTimeTickSyncOperator_SyncInterval_Call {
+	return &MockTimeTickSyncOperator_SyncInterval_Call{Call: _e.mock.On("SyncInterval")}
+}
+
+func (_c *MockTimeTickSyncOperator_SyncInterval_Call) Run(run func()) *MockTimeTickSyncOperator_SyncInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTimeTickSyncOperator_SyncInterval_Call) Return(_a0 time.Duration) *MockTimeTickSyncOperator_SyncInterval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTimeTickSyncOperator_SyncInterval_Call) RunAndReturn(run func() time.Duration) *MockTimeTickSyncOperator_SyncInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteAheadBuffer provides a mock function with no fields
 func (_m *MockTimeTickSyncOperator) WriteAheadBuffer() wab.ROWriteAheadBuffer {
 	ret := _m.Called()
@@ -166,6 +166,39 @@ func (_c *MockRecoveryStorage_GetSchema_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// MarkCleanHandoff provides a mock function with given fields: timetick
+func (_m *MockRecoveryStorage) MarkCleanHandoff(timetick uint64) {
+	_m.Called(timetick)
+}
+
+// MockRecoveryStorage_MarkCleanHandoff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCleanHandoff'
+type MockRecoveryStorage_MarkCleanHandoff_Call struct {
+	*mock.Call
+}
+
+// MarkCleanHandoff is a helper method to define mock.On call
+//   - timetick uint64
+func (_e *MockRecoveryStorage_Expecter) MarkCleanHandoff(timetick interface{}) *MockRecoveryStorage_MarkCleanHandoff_Call {
+	return &MockRecoveryStorage_MarkCleanHandoff_Call{Call: _e.mock.On("MarkCleanHandoff", timetick)}
+}
+
+func (_c *MockRecoveryStorage_MarkCleanHandoff_Call) Run(run func(timetick uint64)) *MockRecoveryStorage_MarkCleanHandoff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockRecoveryStorage_MarkCleanHandoff_Call) Return() *MockRecoveryStorage_MarkCleanHandoff_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRecoveryStorage_MarkCleanHandoff_Call) RunAndReturn(run func(uint64)) *MockRecoveryStorage_MarkCleanHandoff_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Metrics provides a mock function with no fields
 func (_m *MockRecoveryStorage) Metrics() recovery.RecoveryMetrics {
 	ret := _m.Called()
@@ -258,6 +291,53 @@ func (_c *MockRecoveryStorage_ObserveMessage_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// TruncateWAL provides a mock function with given fields: ctx, truncateTo
+func (_m *MockRecoveryStorage) TruncateWAL(ctx context.Context, truncateTo message.MessageID) error {
+	ret := _m.Called(ctx, truncateTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateWAL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, message.MessageID) error); ok {
+		r0 = rf(ctx, truncateTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRecoveryStorage_TruncateWAL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateWAL'
+type MockRecoveryStorage_TruncateWAL_Call struct {
+	*mock.Call
+}
+
+// TruncateWAL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - truncateTo message.MessageID
+func (_e *MockRecoveryStorage_Expecter) TruncateWAL(ctx interface{}, truncateTo interface{}) *MockRecoveryStorage_TruncateWAL_Call {
+	return &MockRecoveryStorage_TruncateWAL_Call{Call: _e.mock.On("TruncateWAL", ctx, truncateTo)}
+}
+
+func (_c *MockRecoveryStorage_TruncateWAL_Call) Run(run func(ctx context.Context, truncateTo message.MessageID)) *MockRecoveryStorage_TruncateWAL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(message.MessageID))
+	})
+	return _c
+}
+
+func (_c *MockRecoveryStorage_TruncateWAL_Call) Return(_a0 error) *MockRecoveryStorage_TruncateWAL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRecoveryStorage_TruncateWAL_Call) RunAndReturn(run func(context.Context, message.MessageID) error) *MockRecoveryStorage_TruncateWAL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFlusherCheckpoint provides a mock function with given fields: vchannel, checkpoint
 func (_m *MockRecoveryStorage) UpdateFlusherCheckpoint(vchannel string, checkpoint *recovery.WALCheckpoint) {
 	_m.Called(vchannel, checkpoint)
@@ -210,6 +210,9 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}
 
 	switch msgType {
+	case commonpb.MsgType_AlterCollection:
+		// the vchannels of the collection may be changed by altering the shard number, so the dml stream is rebuilt lazily.
+		node.chMgr.removeDMLStream(request.GetCollectionID())
 	case commonpb.MsgType_DropCollection:
 		// no need to handle error, since this Proxy may not create dml stream for the collection.
 		node.chMgr.removeDMLStream(request.GetCollectionID())
@@ -3148,6 +3151,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest,
 		})
 		SetReportValue(qt.result.GetStatus(), v)
 		SetStorageCost(qt.result.GetStatus(), qt.storageCost)
+		SetExplain(qt.result.GetStatus(), qt.explains)
 		if merr.Ok(qt.result.GetStatus()) {
 			metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeSearch, dbName, username).Add(float64(v))
 		}
@@ -3378,6 +3382,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		})
 		SetReportValue(qt.result.GetStatus(), v)
 		SetStorageCost(qt.result.GetStatus(), qt.storageCost)
+		SetExplain(qt.result.GetStatus(), qt.explains)
 		if merr.Ok(qt.result.GetStatus()) {
 			metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeHybridSearch, dbName, username).Add(float64(v))
 		}
@@ -3891,6 +3896,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	})
 	SetReportValue(res.Status, v)
 	SetStorageCost(res.Status, storageCost)
+	SetExplain(res.Status, qt.explains)
 	metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeQuery, request.DbName, username).Add(float64(v))
 
 	if mlog.LevelEnabled(mlog.DebugLevel) && matchCountRule(request.OutputFields) {
@@ -4583,6 +4589,28 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.ClusterChannelsMetrics {
+		clusterInfo, err := streaming.WAL().Replicate().GetClusterChannels(ctx)
+		if err != nil {
+			mlog.Warn(ctx, "Proxy.GetMetrics failed to get cluster channels",
+				mlog.Int64("nodeID", paramtable.GetNodeID()),
+				mlog.Err(err))
+			return &milvuspb.GetMetricsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		resp, err := protojson.Marshal(clusterInfo)
+		if err != nil {
+			return &milvuspb.GetMetricsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		return &milvuspb.GetMetricsResponse{
+			Status:   merr.Success(),
+			Response: string(resp),
+		}, nil
+	}
+
 	mlog.RatedWarn(context.TODO(), rate.Limit(60), "Proxy.GetMetrics failed, request metric type is not implemented yet",
 		mlog.Int64("nodeID", paramtable.GetNodeID()),
 		mlog.String("req", req.Request),
@@ -6554,6 +6582,8 @@ func (node *Proxy) RegisterRestRouter(router gin.IRouter) {
 	// QueryNode requests that are forwarded from querycoord
 	router.GET(http.QNSegmentsPath, getQueryComponentMetrics(node, metricsinfo.SegmentKey, metricsinfo.RequestParamsInQN))
 	router.GET(http.QNChannelsPath, getQueryComponentMetrics(node, metricsinfo.ChannelKey))
+	router.GET(http.QNCollectionRefsPath, getQueryComponentMetrics(node, metricsinfo.CollectionRefKey))
+	router.GET(http.QNSegmentMemoryPath, getQueryComponentMetrics(node, metricsinfo.SegmentMemoryKey))
 
 	// DataCoord requests that are forwarded from proxy
 	router.GET(http.DCDistPath, getDataComponentMetrics(node, metricsinfo.DistKey))
@@ -84,6 +84,13 @@ func TestProxy_InvalidateCollectionMetaCache_remove_stream(t *testing.T) {
 	status, err := node.InvalidateCollectionMetaCache(ctx, req)
 	assert.NoError(t, err)
 	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+	// the dml stream is also removed when altering collection, the vchannels may be changed by the shard number.
+	req.Base.MsgType = commonpb.MsgType_AlterCollection
+	status, err = node.InvalidateCollectionMetaCache(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	chMgr.AssertNumberOfCalls(t, "removeDMLStream", 2)
 }
 
 func TestProxy_CheckHealth(t *testing.T) {
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/metric"
+)
+
+// MultiCollectionHit is a hit of the search across multiple collections.
+type MultiCollectionHit struct {
+	// CollectionIndex is the index of the collection in the request.
+	CollectionIndex int
+	// Offset is the offset of the hit in the search result of the collection.
+	Offset int64
+	// Score is the weighted score of the hit, the larger the better.
+	Score float32
+}
+
+// MergeMultiCollectionSearchResults merges the search results of the same queries on multiple collections
+// with the per-collection weights, and returns the topk hits of each query.
+// The scores are normalized into [0, 1] by the metric type before weighting, in the same way as the normalized merge of rerank,
+// so that the larger weighted score is always better.
+func MergeMultiCollectionSearchResults(results []*schemapb.SearchResultData, weights []float32, metricType string, nq int64, topk int64) ([][]MultiCollectionHit, error) {
+	if len(results) != len(weights) {
+		return nil, merr.WrapErrParameterInvalidMsg("the number of weights %d mismatches the number of collections %d", len(weights), len(results))
+	}
+	normalize := getMultiCollectionNormalizeFunc(metricType)
+
+	hits := make([][]MultiCollectionHit, nq)
+	for collectionIdx, result := range results {
+		topks := result.GetTopks()
+		if int64(len(topks)) != nq && result.GetTopK() != 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("the number of queries %d of collection %d mismatches %d", len(topks), collectionIdx, nq)
+		}
+		var offset int64
+		for i, k := range topks {
+			for j := int64(0); j < k; j++ {
+				score := normalize(result.GetScores()[offset+j])
+				hits[i] = append(hits[i], MultiCollectionHit{
+					CollectionIndex: collectionIdx,
+					Offset:          offset + j,
+					Score:           score * weights[collectionIdx],
+				})
+			}
+			offset += k
+		}
+	}
+
+	for i := range hits {
+		sort.SliceStable(hits[i], func(a, b int) bool {
+			return hits[i][a].Score > hits[i][b].Score
+		})
+		if int64(len(hits[i])) > topk {
+			hits[i] = hits[i][:topk]
+		}
+	}
+	return hits, nil
+}
+
+// getMultiCollectionNormalizeFunc returns the function to normalize the scores of the metric type into [0, 1],
+// it's kept the same as the normalized merge of rerank, so the weighted scores are comparable with the rerank.
+func getMultiCollectionNormalizeFunc(metricType string) func(score float32) float32 {
+	switch strings.ToUpper(metricType) {
+	case metric.COSINE:
+		return func(score float32) float32 {
+			return (1 + score) * 0.5
+		}
+	case metric.BM25:
+		return func(score float32) float32 {
+			return 2 * float32(math.Atan(float64(score))) / math.Pi
+		}
+	default:
+		if metric.PositivelyRelated(metricType) {
+			return func(score float32) float32 {
+				return 0.5 + float32(math.Atan(float64(score)))/math.Pi
+			}
+		}
+		// the distance is inverted so the larger is better.
+		return func(distance float32) float32 {
+			return 1.0 - 2*float32(math.Atan(float64(distance)))/math.Pi
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/metric"
+)
+
+func TestMergeMultiCollectionSearchResults(t *testing.T) {
+	results := []*schemapb.SearchResultData{
+		{TopK: 2, Topks: []int64{2, 1}, Scores: []float32{0.9, 0.5, 0.8}},
+		{TopK: 2, Topks: []int64{1, 2}, Scores: []float32{0.7, 0.6, 0.4}},
+		{},
+	}
+
+	// the cosine similarity is normalized by (1 + score) / 2 before weighting
+	hits, err := MergeMultiCollectionSearchResults(results, []float32{1, 2, 1}, metric.COSINE, 2, 2)
+	assert.NoError(t, err)
+	expected := [][]MultiCollectionHit{
+		{{CollectionIndex: 1, Offset: 0, Score: 1.7}, {CollectionIndex: 0, Offset: 0, Score: 0.95}},
+		{{CollectionIndex: 1, Offset: 1, Score: 1.6}, {CollectionIndex: 1, Offset: 2, Score: 1.4}},
+	}
+	assert.Len(t, hits, len(expected))
+	for i := range expected {
+		assert.Len(t, hits[i], len(expected[i]))
+		for j := range expected[i] {
+			assert.Equal(t, expected[i][j].CollectionIndex, hits[i][j].CollectionIndex)
+			assert.Equal(t, expected[i][j].Offset, hits[i][j].Offset)
+			assert.InDelta(t, expected[i][j].Score, hits[i][j].Score, 1e-6)
+		}
+	}
+
+	// the unbounded inner product is normalized into [0, 1], so the weight is not overwhelmed by the raw score
+	ipResults := []*schemapb.SearchResultData{
+		{TopK: 1, Topks: []int64{1}, Scores: []float32{100}},
+		{TopK: 1, Topks: []int64{1}, Scores: []float32{10}},
+	}
+	hits, err = MergeMultiCollectionSearchResults(ipResults, []float32{1, 2}, metric.IP, 1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, hits[0][0].CollectionIndex)
+	assert.LessOrEqual(t, hits[0][0].Score, float32(2))
+
+	// the smaller distance gets the larger score
+	hits, err = MergeMultiCollectionSearchResults(results[:2], []float32{1, 1}, metric.L2, 2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, hits[0][0].CollectionIndex)
+	assert.Equal(t, int64(0), hits[0][0].Offset)
+	assert.Equal(t, int64(2), hits[1][0].Offset)
+
+	_, err = MergeMultiCollectionSearchResults(results, []float32{1}, metric.IP, 2, 2)
+	assert.Error(t, err)
+	_, err = MergeMultiCollectionSearchResults(results[:2], []float32{1, 1}, metric.IP, 3, 2)
+	assert.Error(t, err)
+}
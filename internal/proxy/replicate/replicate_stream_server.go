@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
@@ -35,6 +37,10 @@ type ReplicateStreamServer struct {
 	streamServer    milvuspb.MilvusService_CreateReplicateStreamServer
 	replicateRespCh chan *milvuspb.ReplicateResponse
 	wg              sync.WaitGroup
+	// rejectErr is the error of the replicate message rejected by current cluster,
+	// it's returned to the client as the status of the stream after all pending responses are sent,
+	// so the client can tell the rejection from the broken stream.
+	rejectErr error
 }
 
 // Execute starts the replicate server.
@@ -66,7 +72,8 @@ func (p *ReplicateStreamServer) sendLoop() (err error) {
 		select {
 		case resp, ok := <-p.replicateRespCh:
 			if !ok {
-				return nil
+				// the recv arm is closed, the rejectErr is visible after the channel is closed.
+				return p.rejectErr
 			}
 			if err := p.streamServer.Send(resp); err != nil {
 				return err
@@ -133,13 +140,19 @@ func (p *ReplicateStreamServer) handleReplicateMessage(req *milvuspb.ReplicateRe
 		p.sendReplicateResult(sourceTs, msg)
 		return nil
 	}
-	if status.AsStreamingError(err).IsIgnoredOperation() {
+	streamingErr := status.AsStreamingError(err)
+	if streamingErr.IsIgnoredOperation() {
 		mlog.Info(ctx, "append replicate message to wal ignored", mlog.FieldMessage(msg), mlog.Err(err))
 		p.sendReplicateResult(sourceTs, msg)
 		return nil
 	}
 	// unexpected error, will close the stream and wait for client to reconnect.
 	mlog.Warn(ctx, "append replicate message to wal failed", mlog.FieldMessage(msg), mlog.Err(err))
+	if streamingErr.IsUnrecoverable() || streamingErr.IsInvalidArgument() {
+		// Only the permanent rejection is reported to the client,
+		// the transient failure is retried by the reconnection of client.
+		p.rejectErr = grpcstatus.Errorf(codes.FailedPrecondition, "replicate message at source time tick %d is rejected: %s", sourceTs, err.Error())
+	}
 	return err
 }
 
@@ -11,7 +11,9 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
@@ -188,6 +190,74 @@ func TestReplicateStreamServer_IgnoredMessageSendsConfirmation(t *testing.T) {
 	wg.Wait()
 }
 
+func TestReplicateStreamServer_RejectedMessageClosesStream(t *testing.T) {
+	ctx := createContextWithClusterID("test-cluster")
+	mockStreamServer := newMockReplicateStreamServer(ctx)
+
+	replicateService := mock_streaming.NewMockReplicateService(t)
+	replicateService.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, status.NewReplicateViolation("collection not found"))
+	mockWAL := mock_streaming.NewMockWALAccesser(t)
+	mockWAL.EXPECT().Replicate().Return(replicateService)
+	streaming.SetWALForTest(mockWAL)
+
+	server, err := CreateReplicateServer(mockStreamServer)
+	assert.NoError(t, err)
+
+	messageID := pulsar2.NewPulsarID(pulsar.EarliestMessageID())
+	msg := message.NewInsertMessageBuilderV1().
+		WithVChannel("test-vchannel").
+		WithHeader(&messagespb.InsertMessageHeader{}).
+		WithBody(&msgpb.InsertRequest{}).
+		MustBuildMutable().WithTimeTick(1).
+		WithLastConfirmed(messageID)
+	mockStreamServer.SendRequest(&milvuspb.ReplicateRequest{
+		Request: &milvuspb.ReplicateRequest_ReplicateMessage{
+			ReplicateMessage: &milvuspb.ReplicateMessage{
+				Message: message.ImmutableMessageToMilvusMessage(commonpb.WALName_Pulsar.String(), msg.IntoImmutableMessage(messageID)),
+			},
+		},
+	})
+
+	// The rejection is returned as the status of the stream.
+	err = server.Execute()
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
+	assert.Contains(t, err.Error(), "collection not found")
+}
+
+func TestReplicateStreamServer_TransientFailureIsNotRejected(t *testing.T) {
+	ctx := createContextWithClusterID("test-cluster")
+	mockStreamServer := newMockReplicateStreamServer(ctx)
+
+	replicateService := mock_streaming.NewMockReplicateService(t)
+	replicateService.EXPECT().Append(mock.Anything, mock.Anything).Return(nil, errors.New("wal unavailable"))
+	mockWAL := mock_streaming.NewMockWALAccesser(t)
+	mockWAL.EXPECT().Replicate().Return(replicateService)
+	streaming.SetWALForTest(mockWAL)
+
+	server, err := CreateReplicateServer(mockStreamServer)
+	assert.NoError(t, err)
+
+	messageID := pulsar2.NewPulsarID(pulsar.EarliestMessageID())
+	msg := message.NewInsertMessageBuilderV1().
+		WithVChannel("test-vchannel").
+		WithHeader(&messagespb.InsertMessageHeader{}).
+		WithBody(&msgpb.InsertRequest{}).
+		MustBuildMutable().WithTimeTick(1).
+		WithLastConfirmed(messageID)
+	mockStreamServer.SendRequest(&milvuspb.ReplicateRequest{
+		Request: &milvuspb.ReplicateRequest_ReplicateMessage{
+			ReplicateMessage: &milvuspb.ReplicateMessage{
+				Message: message.ImmutableMessageToMilvusMessage(commonpb.WALName_Pulsar.String(), msg.IntoImmutableMessage(messageID)),
+			},
+		},
+	})
+
+	// The transient failure only closes the stream, the client will reconnect and retry.
+	err = server.Execute()
+	assert.NoError(t, err)
+}
+
 func TestReplicateStreamServer_recvLoop_RecvError(t *testing.T) {
 	ctx := createContextWithClusterID("test-cluster")
 	mockStreamServer := newMockReplicateStreamServer(ctx)
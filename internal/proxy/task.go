@@ -87,6 +87,7 @@ const (
 	GroupByFieldsKey       = "group_by_fields"
 	OrderByFieldsKey       = "order_by_fields"
 	PipelineTraceKey       = "pipeline_trace"
+	ExplainKey             = "explain"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -408,6 +409,25 @@ func validateTTLField(props []*commonpb.KeyValuePair, fields []*schemapb.FieldSc
 	return false, nil
 }
 
+func validateTTLFieldRetention(props []*commonpb.KeyValuePair) (bool, error) {
+	for _, pair := range props {
+		if pair.Key == common.CollectionTTLFieldRetentionKey {
+			if _, err := common.GetTTLFieldRetention([]*commonpb.KeyValuePair{pair}); err != nil {
+				return true, merr.WrapErrParameterInvalidMsg("ttl field retention is invalid, %s", err.Error())
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func validateSearchCPUShare(props []*commonpb.KeyValuePair) error {
+	if _, err := common.GetCollectionSearchCPUShare(props); err != nil {
+		return merr.WrapErrParameterInvalidMsg("search cpu share property value not valid, parse error: %s", err.Error())
+	}
+	return nil
+}
+
 func (t *createCollectionTask) validateTTL() error {
 	hasCollectionTTL, err := validateCollectionTTL(t.GetProperties())
 	if err != nil {
@@ -422,6 +442,14 @@ func (t *createCollectionTask) validateTTL() error {
 	if hasCollectionTTL && hasTTLField {
 		return merr.WrapErrParameterInvalidMsg("collection TTL and ttl field cannot be set at the same time")
 	}
+
+	hasTTLFieldRetention, err := validateTTLFieldRetention(t.GetProperties())
+	if err != nil {
+		return err
+	}
+	if hasTTLFieldRetention && !hasTTLField {
+		return merr.WrapErrParameterInvalidMsg("ttl field retention can only be set with ttl field")
+	}
 	return nil
 }
 
@@ -565,6 +593,10 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 		return merr.WrapErrParameterInvalidMsg("collection ttl property value not valid, parse error: %s", err.Error())
 	}
 
+	if err := validateSearchCPUShare(t.GetProperties()); err != nil {
+		return err
+	}
+
 	// Validate warmup policy for all warmup keys
 	if hasWarmupProp(t.GetProperties()...) {
 		for _, prop := range t.GetProperties() {
@@ -572,7 +604,7 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 				return merr.WrapErrParameterInvalidMsg("warmup key '%s' is only allowed at field level, use warmup.scalarField/warmup.scalarIndex/warmup.vectorField/warmup.vectorIndex at collection level", prop.GetKey())
 			}
 			if common.IsCollectionWarmupKey(prop.GetKey()) {
-				if err := common.ValidateWarmupPolicy(prop.GetValue()); err != nil {
+				if err := common.ValidateCollectionWarmupPolicy(prop.GetKey(), prop.GetValue()); err != nil {
 					return merr.WrapErrParameterInvalidMsg("invalid warmup value for key %s: %s", prop.GetKey(), err.Error())
 				}
 			}
@@ -2182,7 +2214,8 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	if len(t.GetProperties()) > 0 {
 		hasMmap := hasMmapProp(t.Properties...)
 		hasWarmup := hasWarmupProp(t.Properties...)
-		if hasMmap || hasWarmup {
+		_, hasShardsNum := funcutil.TryGetAttrByKeyFromRepeatedKV(common.CollectionShardsNumKey, t.Properties)
+		if hasMmap || hasWarmup || hasShardsNum {
 			loaded, err := isCollectionLoaded(ctx, t.mixCoord, t.CollectionID)
 			if err != nil {
 				return err
@@ -2195,6 +2228,9 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 				if hasWarmup {
 					return merr.WrapErrCollectionLoaded(t.CollectionName, "can not alter warmup properties if collection loaded")
 				}
+				if hasShardsNum {
+					return merr.WrapErrCollectionLoaded(t.CollectionName, "can not alter shards num if collection loaded")
+				}
 			}
 		}
 
@@ -2247,6 +2283,16 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 		if hasTTLField && hasTTLProp(collSchema.GetProperties()...) {
 			return merr.WrapErrParameterInvalidMsg("collection TTL is already set, cannot be set ttl field")
 		}
+		hasTTLFieldRetention, err := validateTTLFieldRetention(t.GetProperties())
+		if err != nil {
+			return err
+		}
+		if hasTTLFieldRetention && !hasTTLField && !hasTTLFieldProp(collSchema.GetProperties()...) {
+			return merr.WrapErrParameterInvalidMsg("ttl field retention can only be set with ttl field")
+		}
+		if err := validateSearchCPUShare(t.GetProperties()); err != nil {
+			return err
+		}
 
 		// Validate warmup policy for all warmup keys
 		if hasWarmupProp(t.Properties...) {
@@ -2255,7 +2301,7 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 					return merr.WrapErrParameterInvalidMsg("warmup key '%s' is only allowed at field level, use warmup.scalarField/warmup.scalarIndex/warmup.vectorField/warmup.vectorIndex at collection level", prop.GetKey())
 				}
 				if common.IsCollectionWarmupKey(prop.GetKey()) {
-					if err := common.ValidateWarmupPolicy(prop.GetValue()); err != nil {
+					if err := common.ValidateCollectionWarmupPolicy(prop.GetKey(), prop.GetValue()); err != nil {
 						return merr.WrapErrParameterInvalidMsg("invalid warmup value for key %s: %s", prop.GetKey(), err.Error())
 					}
 				}
@@ -4292,6 +4338,20 @@ func (t *HighlightTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// isExplain is used to check if the request should return how it is executed on each segment
+func isExplain(params []*commonpb.KeyValuePair) (bool, error) {
+	for _, kv := range params {
+		if kv.GetKey() == ExplainKey {
+			explain, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, merr.WrapErrParameterInvalidMsg("parse explain field failed")
+			}
+			return explain, nil
+		}
+	}
+	return false, nil
+}
+
 // isIgnoreGrowing is used to check if the request should ignore growing
 func isIgnoreGrowing(params []*commonpb.KeyValuePair) (bool, error) {
 	for _, kv := range params {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
@@ -79,6 +80,9 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	resp := streaming.WAL().AppendMessages(ctx, msgs...)
 	if err := resp.UnwrapFirstError(); err != nil {
 		mlog.Warn(ctx, "append messages to wal failed", mlog.Err(err))
+		if status.AsStreamingError(err).IsSecondaryWriteRejected() {
+			return merr.WrapErrReplicateSecondaryWriteDenied(err.Error())
+		}
 		return err
 	}
 	dt.sessionTS = resp.MaxTimeTick()
@@ -77,6 +77,8 @@ func (it *insertTask) Execute(ctx context.Context) error {
 		mlog.Warn(ctx, "append messages to wal failed", mlog.Err(err))
 		if status.AsStreamingError(err).IsSchemaVersionMismatch() {
 			it.result.Status = merr.Status(merr.ErrCollectionSchemaMismatch)
+		} else if status.AsStreamingError(err).IsSecondaryWriteRejected() {
+			it.result.Status = merr.Status(merr.WrapErrReplicateSecondaryWriteDenied(err.Error()))
 		} else {
 			it.result.Status = merr.Status(err)
 		}
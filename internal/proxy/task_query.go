@@ -87,6 +87,7 @@ type queryTask struct {
 	resolvedTimezoneStr  string
 	storageCost          segcore.StorageCost
 	aggregationFieldMap  *agg.AggregationFieldMap
+	explains             []*internalpb.SegmentExplain
 }
 
 func (t *queryTask) getQueryLabel() string {
@@ -730,6 +731,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.IgnoreGrowing, err = isIgnoreGrowing(t.request.GetQueryParams()); err != nil {
 		return err
 	}
+	if t.Explain, err = isExplain(t.request.GetQueryParams()); err != nil {
+		return err
+	}
 	queryParams, err := parseQueryParams(t.request.GetQueryParams(), colInfo.queryMode == common.QueryModeLargeTopK, getPrimaryKeyDataType(schema.CollectionSchema))
 	if err != nil {
 		return err
@@ -876,8 +880,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 
 	t.GuaranteeTimestamp = guaranteeTs
 	// Extract physical time for entity-level TTL (issue #47413)
-	physicalTimeMs, _ := tsoutil.ParseHybridTs(guaranteeTs)
-	t.EntityTtlPhysicalTime = uint64(physicalTimeMs * 1000)
+	t.EntityTtlPhysicalTime = getEntityTTLPhysicalTime(collectionInfo, guaranteeTs)
+	// querynode pins the schema version the plan is created with.
+	t.SchemaVersion = proto.Int32(t.schema.GetVersion())
 	// need modify mvccTs and guaranteeTs for iterator specially
 	if t.queryParams.isIterator && t.request.GetGuaranteeTimestamp() > 0 {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
@@ -949,6 +954,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 	t.allQueryCnt = 0
 	t.totalRelatedDataSize = 0
 	t.storageCost = segcore.StorageCost{}
+	t.explains = nil
 	select {
 	case <-t.TraceCtx().Done():
 		log.Warn(ctx, "proxy", mlog.Int64("Query: wait to finish failed, timeout!, msgID:", t.ID()))
@@ -961,6 +967,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 			t.storageCost.ScannedRemoteBytes += res.GetScannedRemoteBytes()
 			t.storageCost.ScannedTotalBytes += res.GetScannedTotalBytes()
 			t.totalRelatedDataSize += res.GetCostAggregation().GetTotalRelatedDataSize()
+			t.explains = append(t.explains, res.GetSegmentExplains()...)
 			log.Debug(ctx, "proxy receives one query result", mlog.Int64("sourceID", res.GetBase().GetSourceID()))
 			return true
 		})
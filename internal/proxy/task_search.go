@@ -117,6 +117,7 @@ type searchTask struct {
 
 	storageCost  segcore.StorageCost
 	traceEnabled bool
+	explains     []*internalpb.SegmentExplain
 
 	aggCtx *search_agg.SearchAggregationContext
 
@@ -246,6 +247,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if t.IgnoreGrowing, err = isIgnoreGrowing(t.request.SearchParams); err != nil {
 		return err
 	}
+	if t.Explain, err = isExplain(t.request.GetSearchParams()); err != nil {
+		return err
+	}
 
 	outputFieldIDs, err := getOutputFieldIDs(t.schema, t.translatedOutputFields)
 	if err != nil {
@@ -307,8 +311,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 	t.GuaranteeTimestamp = guaranteeTs
 	// Extract physical time for entity-level TTL (issue #47413)
-	physicalTimeMs, _ := tsoutil.ParseHybridTs(guaranteeTs)
-	t.EntityTtlPhysicalTime = uint64(physicalTimeMs * 1000)
+	t.EntityTtlPhysicalTime = getEntityTTLPhysicalTime(collectionInfo, guaranteeTs)
+	// querynode pins the schema version the plan is created with.
+	t.SchemaVersion = proto.Int32(t.schema.GetVersion())
 	t.ConsistencyLevel = consistencyLevel
 	if t.isIterator && t.request.GetGuaranteeTimestamp() > 0 {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
@@ -1285,6 +1290,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	t.queryChannelsTs = make(map[string]uint64)
 	t.relatedDataSize = 0
+	t.explains = nil
 	isTopkReduce := false
 	isRecallEvaluation := false
 	storageCost := segcore.StorageCost{}
@@ -1301,6 +1307,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		}
 		storageCost.ScannedRemoteBytes += r.GetScannedRemoteBytes()
 		storageCost.ScannedTotalBytes += r.GetScannedTotalBytes()
+		t.explains = append(t.explains, r.GetSegmentExplains()...)
 	}
 
 	t.isTopkReduce = isTopkReduce
@@ -1901,6 +1901,31 @@ func TestCreateCollectionTask(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 
+		// Test invalid ttl field retention - without ttl field
+		task.Properties = []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLFieldRetentionKey, Value: "3600"},
+		}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		// Test invalid ttl field retention - out of range
+		task.Properties = []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLFieldKey, Value: testTTLField},
+			{Key: common.CollectionTTLFieldRetentionKey, Value: "-1"},
+		}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		// Test invalid search cpu share
+		task.Properties = []*commonpb.KeyValuePair{
+			{Key: common.CollectionSearchCPUShareKey, Value: "1.5"},
+		}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
 		// Restore original schema for remaining tests
 		task.CreateCollectionRequest = reqBackup
 	})
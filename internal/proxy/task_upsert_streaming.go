@@ -44,6 +44,9 @@ func (ut *upsertTask) Execute(ctx context.Context) error {
 		if status.AsStreamingError(err).IsSchemaVersionMismatch() {
 			return merr.ErrCollectionSchemaMismatch
 		}
+		if status.AsStreamingError(err).IsSecondaryWriteRejected() {
+			return merr.WrapErrReplicateSecondaryWriteDenied(err.Error())
+		}
 		return err
 	}
 	// Update result.Timestamp for session consistency.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
@@ -2838,6 +2840,40 @@ func SetStorageCost(status *commonpb.Status, storageCost segcore.StorageCost) {
 	status.ExtraInfo["cache_hit_ratio"] = strconv.FormatFloat(cacheHitRatio, 'f', -1, 64)
 }
 
+// SetExplain sets the estimated execution of the search or query on each segment into the status as a json array.
+func SetExplain(status *commonpb.Status, explains []*internalpb.SegmentExplain) {
+	if len(explains) == 0 {
+		return
+	}
+	if !merr.Ok(status) {
+		return
+	}
+	sort.Slice(explains, func(i, j int) bool {
+		return explains[i].GetSegmentID() < explains[j].GetSegmentID()
+	})
+	marshaler := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	items := make([]json.RawMessage, 0, len(explains))
+	for _, explain := range explains {
+		bs, err := marshaler.Marshal(explain)
+		if err != nil {
+			mlog.Warn(context.TODO(), "failed to marshal segment explain", mlog.Err(err))
+			return
+		}
+		items = append(items, bs)
+	}
+	bs, err := json.Marshal(items)
+	if err != nil {
+		mlog.Warn(context.TODO(), "failed to marshal explain", mlog.Err(err))
+		return
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+		// set report_value to 0 for compatibility, see SetStorageCost
+		status.ExtraInfo["report_value"] = strconv.Itoa(0)
+	}
+	status.ExtraInfo[ExplainKey] = string(bs)
+}
+
 func GetCostValue(status *commonpb.Status) int {
 	if status == nil || status.ExtraInfo == nil {
 		return 0
@@ -3168,6 +3204,20 @@ func getColTimezone(colInfo *collectionInfo) string {
 	return timezone
 }
 
+// getEntityTTLPhysicalTime returns the physical time in microseconds to filter the entities expired by the ttl field.
+// If the ttl field retention is set, the ttl field holds the event time,
+// so the physical time is shifted back by the retention to compare with it.
+func getEntityTTLPhysicalTime(colInfo *collectionInfo, guaranteeTs uint64) uint64 {
+	physicalTimeMs, _ := tsoutil.ParseHybridTs(guaranteeTs)
+	physicalTimeMicros := physicalTimeMs * 1000
+	retention, _ := common.GetTTLFieldRetention(colInfo.properties)
+	physicalTimeMicros -= retention.Microseconds()
+	if physicalTimeMicros < 0 {
+		return 0
+	}
+	return uint64(physicalTimeMicros)
+}
+
 // timestamptzUTC2IsoStr converts Timestamptz (Unix Microsecond) data
 // within FieldData results into ISO-8601 strings, applying the correct
 // timezone offset and using the optimized format (microsecond precision, no trailing zeros).
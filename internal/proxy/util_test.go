@@ -5392,6 +5392,49 @@ func TestGetStorageCost(t *testing.T) {
 	})
 }
 
+func TestSetExplain(t *testing.T) {
+	t.Run("no explain", func(t *testing.T) {
+		st := merr.Success()
+		SetExplain(st, nil)
+		assert.Nil(t, st.ExtraInfo)
+	})
+
+	t.Run("failed status", func(t *testing.T) {
+		st := merr.Status(merr.WrapErrServiceInternal("mock"))
+		SetExplain(st, []*internalpb.SegmentExplain{{SegmentID: 1}})
+		assert.NotContains(t, st.GetExtraInfo(), ExplainKey)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		st := merr.Success()
+		SetExplain(st, []*internalpb.SegmentExplain{
+			{SegmentID: 2, EstimatedStrategy: "brute_force"},
+			{SegmentID: 1, EstimatedStrategy: "index", IndexType: "HNSW"},
+		})
+		assert.Equal(t, "0", st.GetExtraInfo()["report_value"])
+		var explains []map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(st.GetExtraInfo()[ExplainKey]), &explains))
+		assert.Len(t, explains, 2)
+		assert.Equal(t, "1", explains[0]["segmentID"])
+		assert.Equal(t, "HNSW", explains[0]["index_type"])
+		assert.Equal(t, "brute_force", explains[1]["estimated_strategy"])
+		assert.Equal(t, "", explains[1]["estimated_filter_order"])
+	})
+}
+
+func TestIsExplain(t *testing.T) {
+	explain, err := isExplain(nil)
+	assert.NoError(t, err)
+	assert.False(t, explain)
+
+	explain, err = isExplain([]*commonpb.KeyValuePair{{Key: ExplainKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, explain)
+
+	_, err = isExplain([]*commonpb.KeyValuePair{{Key: ExplainKey, Value: "yes"}})
+	assert.Error(t, err)
+}
+
 func TestMinHashFunction(t *testing.T) {
 	t.Run("MinHash function without permutations ", func(t *testing.T) {
 		schema := &schemapb.CollectionSchema{
@@ -51,6 +51,21 @@ type workloadStatus struct {
 	nodeGlobalChannels        map[int64][]*meta.DmChannel
 	nodeCollectionRowCount    map[int64]map[int64]int
 	nodeDelegatorCount        map[int64]map[int64]int
+
+	// the search and query rate of the loaded segments, used by qps weighted balance.
+	nodeGlobalQPS     map[int64]float64
+	nodeCollectionQPS map[int64]map[int64]float64
+	rowsPerQPS        float64 // the average rows per qps of all the loaded segments
+}
+
+// qpsToRowCount converts the qps into the row count by the average rows per qps and the qps weight,
+// so the hot segments are spread out even if the row count is even.
+func (s *workloadStatus) qpsToRowCount(qps float64) float64 {
+	weight := params.Params.QueryCoordCfg.BalanceQPSWeight.GetAsFloat()
+	if weight <= 0 {
+		return 0
+	}
+	return qps * s.rowsPerQPS * weight
 }
 
 // getWorkloadStatus refreshes and returns the workload status if the underlying distribution version has changed.
@@ -69,8 +84,11 @@ func (p *ScoreBasedAssignPolicy) getWorkloadStatus() *workloadStatus {
 		nodeGlobalChannels:        make(map[int64][]*meta.DmChannel),
 		nodeCollectionRowCount:    make(map[int64]map[int64]int),
 		nodeDelegatorCount:        make(map[int64]map[int64]int),
+		nodeGlobalQPS:             make(map[int64]float64),
+		nodeCollectionQPS:         make(map[int64]map[int64]float64),
 	}
 
+	totalRowCount, totalQPS := int64(0), float64(0)
 	allSegments := p.dist.SegmentDistManager.GetByFilter()
 	for _, s := range allSegments {
 		status.nodeGlobalRowCount[s.Node] += int(s.GetNumOfRows())
@@ -79,6 +97,19 @@ func (p *ScoreBasedAssignPolicy) getWorkloadStatus() *workloadStatus {
 			status.nodeCollectionRowCount[s.Node] = make(map[int64]int)
 		}
 		status.nodeCollectionRowCount[s.Node][collectionID] += int(s.GetNumOfRows())
+		totalRowCount += s.GetNumOfRows()
+
+		if s.QPS > 0 {
+			status.nodeGlobalQPS[s.Node] += s.QPS
+			if status.nodeCollectionQPS[s.Node] == nil {
+				status.nodeCollectionQPS[s.Node] = make(map[int64]float64)
+			}
+			status.nodeCollectionQPS[s.Node][collectionID] += s.QPS
+			totalQPS += s.QPS
+		}
+	}
+	if totalQPS > 0 {
+		status.rowsPerQPS = float64(totalRowCount) / totalQPS
 	}
 
 	allChannels := p.dist.ChannelDistManager.GetByFilter()
@@ -154,16 +185,20 @@ func (p *ScoreBasedAssignPolicy) AssignSegment(
 		queue.Push(item)
 	}
 
-	// Sort segments by row count (descending), with secondary sort by node's score
+	// Sort segments by score (descending), with secondary sort by node's score
+	segmentScores := make(map[*meta.Segment]float64, len(segments))
+	for _, s := range segments {
+		segmentScores[s] = p.CalculateSegmentScore(s)
+	}
 	sort.Slice(segments, func(i, j int) bool {
-		if segments[i].GetNumOfRows() == segments[j].GetNumOfRows() {
+		if segmentScores[segments[i]] == segmentScores[segments[j]] {
 			node1 := nodeItemsMap[segments[i].Node]
 			node2 := nodeItemsMap[segments[j].Node]
 			if node1 != nil && node2 != nil {
 				return node1.getPriority() > node2.getPriority()
 			}
 		}
-		return segments[i].GetNumOfRows() > segments[j].GetNumOfRows()
+		return segmentScores[segments[i]] > segmentScores[segments[j]]
 	})
 
 	plans := make([]SegmentAssignPlan, 0, len(segments))
@@ -171,7 +206,7 @@ func (p *ScoreBasedAssignPolicy) AssignSegment(
 		// For each segment, pick the node with the least score
 		targetNode := queue.Pop().(*NodeItem)
 
-		scoreChanges := p.CalculateSegmentScore(s)
+		scoreChanges := segmentScores[s]
 		sourceNode := nodeItemsMap[s.Node]
 
 		// If segment's node exists, check if there's enough benefit
@@ -285,22 +320,28 @@ func (p *ScoreBasedAssignPolicy) calculateScoreBySegment(
 	status *workloadStatus,
 	delta *task.SegmentTaskDeltaSnapshot,
 ) int {
-	nodeRowCount := status.nodeGlobalRowCount[nodeID] + status.nodeGlobalChannelRowCount[nodeID]
+	nodeRowCount := status.nodeGlobalRowCount[nodeID] + status.nodeGlobalChannelRowCount[nodeID] +
+		int(status.qpsToRowCount(status.nodeGlobalQPS[nodeID]))
 
 	// Calculate executing task cost in scheduler
 	nodeRowCount += delta.GetByNode(nodeID)
 
 	// Calculate executing task cost for collection
-	collectionRowCount := status.nodeCollectionRowCount[nodeID][collectionID] + delta.GetByNodeInCollection(nodeID)
+	collectionRowCount := status.nodeCollectionRowCount[nodeID][collectionID] + delta.GetByNodeInCollection(nodeID) +
+		int(status.qpsToRowCount(status.nodeCollectionQPS[nodeID][collectionID]))
 
 	// Final score: collection row count + global row count * factor
 	return collectionRowCount + int(float64(nodeRowCount)*
 		params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
 }
 
-// calculateSegmentScore calculates the score of a segment
+// calculateSegmentScore calculates the score of a segment, the qps of the segment is counted as rows if qps weighted balance is enabled.
 func (p *ScoreBasedAssignPolicy) CalculateSegmentScore(s *meta.Segment) float64 {
-	return float64(s.GetNumOfRows()) * (1 + params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
+	rowCount := float64(s.GetNumOfRows())
+	if s.QPS > 0 {
+		rowCount += p.getWorkloadStatus().qpsToRowCount(s.QPS)
+	}
+	return rowCount * (1 + params.Params.QueryCoordCfg.GlobalRowCountFactor.GetAsFloat())
 }
 
 // AssignChannel assigns channels to nodes using score-based strategy
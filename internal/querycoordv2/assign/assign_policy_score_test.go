@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/pkg/v3/common"
 	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 // TestScoreBasedAssignPolicy_AssignSegment_BasicFunctionality tests basic segment assignment
@@ -317,6 +318,53 @@ func TestScoreBasedAssignPolicy_WorkloadStatusWithCollectionRows(t *testing.T) {
 	assert.Equal(t, 1, status.nodeDelegatorCount[1][100])
 }
 
+func TestScoreBasedAssignPolicy_QPSWeightedScore(t *testing.T) {
+	nodeManager := session.NewNodeManager()
+	mockScheduler := task.NewMockScheduler(t)
+	mockScheduler.EXPECT().GetSegmentTaskDeltaSnapshot(mock.Anything, mock.Anything).Return(task.NewSegmentTaskDeltaSnapshot(nil, nil)).Maybe()
+	dist := meta.NewDistributionManager(nodeManager)
+	metaMgr := meta.NewMeta(nil, nil, nodeManager)
+
+	for i := int64(1); i <= 2; i++ {
+		nodeManager.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   i,
+			Version:  common.Version,
+			Address:  "localhost",
+			Hostname: "node",
+		}))
+		nodeManager.Get(i).SetState(session.NodeStateNormal)
+	}
+
+	// the row count is even, but the segments on node 1 are hot
+	hot := &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, CollectionID: 100, NumOfRows: 1000}, Node: 1, QPS: 100}
+	dist.SegmentDistManager.Update(1, hot,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 2, CollectionID: 100, NumOfRows: 1000}, Node: 1, QPS: 100},
+	)
+	cold := &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 3, CollectionID: 100, NumOfRows: 1000}, Node: 2}
+	dist.SegmentDistManager.Update(2, cold,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 4, CollectionID: 100, NumOfRows: 1000}, Node: 2},
+	)
+
+	policy := newScoreBasedAssignPolicy(nodeManager, mockScheduler, dist, metaMgr)
+	status := policy.getWorkloadStatus()
+	assert.Equal(t, 200.0, status.nodeGlobalQPS[1])
+	assert.Equal(t, 200.0, status.nodeCollectionQPS[1][100])
+	assert.Equal(t, 20.0, status.rowsPerQPS)
+
+	// qps is ignored by default
+	nodeItems := policy.ConvertToNodeItemsBySegment(100, []int64{1, 2})
+	assert.Equal(t, nodeItems[1].GetCurrentScore(), nodeItems[2].GetCurrentScore())
+	assert.Equal(t, policy.CalculateSegmentScore(hot), policy.CalculateSegmentScore(cold))
+
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.BalanceQPSWeight.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.BalanceQPSWeight.Key)
+
+	nodeItems = policy.ConvertToNodeItemsBySegment(100, []int64{1, 2})
+	assert.Greater(t, nodeItems[1].GetCurrentScore(), nodeItems[2].GetCurrentScore())
+	assert.Greater(t, nodeItems[1].GetCurrentScore(), nodeItems[1].GetAssignedScore())
+	assert.Greater(t, policy.CalculateSegmentScore(hot), policy.CalculateSegmentScore(cold))
+}
+
 func TestScoreBasedAssignPolicy_ChannelOnlyCollectionRowsAndDelegatorOverhead(t *testing.T) {
 	nodeManager := session.NewNodeManager()
 	mockScheduler := task.NewMockScheduler(t)
@@ -161,11 +161,15 @@ func (b *ScoreBasedBalancer) genSegmentPlan(ctx context.Context, br *balanceRepo
 			continue
 		}
 
+		segmentScores := make(map[*meta.Segment]float64, len(segments))
+		for _, s := range segments {
+			segmentScores[s] = b.assignPolicy.CalculateSegmentScore(s)
+		}
 		sort.Slice(segments, func(i, j int) bool {
-			return segments[i].GetNumOfRows() < segments[j].GetNumOfRows()
+			return segmentScores[segments[i]] < segmentScores[segments[j]]
 		})
 		for _, s := range segments {
-			segmentScore := b.assignPolicy.CalculateSegmentScore(s)
+			segmentScore := segmentScores[s]
 			br.AddRecord(StrRecordf("pick segment %d with score %f from node %d", s.ID, segmentScore, node))
 			segmentsToMove = append(segmentsToMove, s)
 			currentScore -= segmentScore
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
 // RegisterDDLCallbacks registers the ddl callbacks.
@@ -69,17 +70,33 @@ func shouldApplyLocallyOnNonPrimary(err error, msgType message.MessageType) bool
 }
 
 // startBroadcastWithCollectionIDLock starts a broadcast with collection id lock.
-func (c *Server) startBroadcastWithCollectionIDLock(ctx context.Context, collectionID int64) (broadcaster.BroadcastAPI, error) {
+// The extra resource keys are held together with the collection lock.
+func (c *Server) startBroadcastWithCollectionIDLock(ctx context.Context, collectionID int64, extraResourceKeys ...message.ResourceKey) (broadcaster.BroadcastAPI, error) {
 	coll, err := c.broker.DescribeCollection(ctx, collectionID)
 	if err != nil {
 		return nil, err
 	}
-	broadcaster, err := broadcast.StartBroadcastWithResourceKeys(ctx,
+	resourceKeys := append([]message.ResourceKey{
 		message.NewSharedDBNameResourceKey(coll.GetDbName()),
 		message.NewExclusiveCollectionNameResourceKey(coll.GetDbName(), coll.GetCollectionName()),
-	)
+	}, extraResourceKeys...)
+	broadcaster, err := broadcast.StartBroadcastWithResourceKeys(ctx, resourceKeys...)
 	if err != nil {
 		return nil, merr.Wrap(err, "failed to start broadcast with collection lock")
 	}
 	return broadcaster, nil
 }
+
+// resourceGroupIsolationResourceKeys returns the exclusive resource keys of the resource groups
+// if the database resource group isolation is enabled, so the check of the databases hosted by the resource groups
+// and the spawn of the replicas are serialized until the replicas are created by the ack callback.
+func resourceGroupIsolationResourceKeys(resourceGroups ...string) []message.ResourceKey {
+	if !paramtable.Get().QueryCoordCfg.DatabaseResourceGroupIsolation.GetAsBool() {
+		return nil
+	}
+	keys := make([]message.ResourceKey, 0, len(resourceGroups))
+	for _, rg := range typeutil.NewSet(resourceGroups...).Collect() {
+		keys = append(keys, message.NewExclusiveResourceGroupResourceKey(rg))
+	}
+	return keys
+}
@@ -29,7 +29,11 @@ import (
 
 // broadcastAlterLoadConfigCollectionV2ForLoadCollection is called when the load collection request is received.
 func (s *Server) broadcastAlterLoadConfigCollectionV2ForLoadCollection(ctx context.Context, req *querypb.LoadCollectionRequest) error {
-	broadcaster, err := s.startBroadcastWithCollectionIDLock(ctx, req.GetCollectionID())
+	replicaNumber, resourceGroups, err := s.getDefaultResourceGroupsAndReplicaNumber(ctx, req.GetReplicaNumber(), req.GetResourceGroups(), req.GetCollectionID())
+	if err != nil {
+		return err
+	}
+	broadcaster, err := s.startBroadcastWithCollectionIDLock(ctx, req.GetCollectionID(), resourceGroupIsolationResourceKeys(resourceGroups...)...)
 	if err != nil {
 		return err
 	}
@@ -47,10 +51,6 @@ func (s *Server) broadcastAlterLoadConfigCollectionV2ForLoadCollection(ctx conte
 	}
 	// if user specified the replica number in load request, load config changes won't be apply to the collection automatically
 	userSpecifiedReplicaMode := req.GetReplicaNumber() > 0
-	replicaNumber, resourceGroups, err := s.getDefaultResourceGroupsAndReplicaNumber(ctx, req.GetReplicaNumber(), req.GetResourceGroups(), req.GetCollectionID())
-	if err != nil {
-		return err
-	}
 
 	currentLoadConfig := s.getCurrentLoadConfig(ctx, req.GetCollectionID())
 	// only check node number when the collection is not loaded
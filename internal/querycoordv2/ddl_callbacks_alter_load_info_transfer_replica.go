@@ -29,7 +29,7 @@ import (
 
 // broadcastAlterLoadConfigCollectionV2ForTransferReplica broadcasts the alter load config message for transfer replica.
 func (s *Server) broadcastAlterLoadConfigCollectionV2ForTransferReplica(ctx context.Context, req *querypb.TransferReplicaRequest) error {
-	broadcaster, err := s.startBroadcastWithCollectionIDLock(ctx, req.GetCollectionID())
+	broadcaster, err := s.startBroadcastWithCollectionIDLock(ctx, req.GetCollectionID(), resourceGroupIsolationResourceKeys(req.GetTargetResourceGroup())...)
 	if err != nil {
 		return err
 	}
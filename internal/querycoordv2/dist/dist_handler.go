@@ -221,6 +221,7 @@ func (dh *distHandler) updateSegmentsDistribution(ctx context.Context, resp *que
 			JSONStatsField:     s.GetJsonStatsInfo(),
 			ManifestPath:       s.GetManifestPath(),
 			DataVersion:        s.DataVersion,
+			QPS:                s.GetQps(),
 		})
 	}
 
@@ -288,6 +288,16 @@ func (s *Server) getChannelsFromQueryNode(ctx context.Context, req *milvuspb.Get
 	return metricsinfo.MarshalGetMetricsValues(channels, err)
 }
 
+func (s *Server) getCollectionRefsFromQueryNode(ctx context.Context, req *milvuspb.GetMetricsRequest) (string, error) {
+	refs, err := getMetrics[*metricsinfo.CollectionRef](ctx, s, req)
+	return metricsinfo.MarshalGetMetricsValues(refs, err)
+}
+
+func (s *Server) getSegmentMemoryFromQueryNode(ctx context.Context, req *milvuspb.GetMetricsRequest) (string, error) {
+	segments, err := getMetrics[*metricsinfo.SegmentMemory](ctx, s, req)
+	return metricsinfo.MarshalGetMetricsValues(segments, err)
+}
+
 func (s *Server) getSegmentsFromQueryNode(ctx context.Context, req *milvuspb.GetMetricsRequest) (string, error) {
 	segments, err := getMetrics[*metricsinfo.Segment](ctx, s, req)
 	return metricsinfo.MarshalGetMetricsValues(segments, err)
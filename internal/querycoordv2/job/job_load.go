@@ -113,6 +113,10 @@ func (job *LoadCollectionJob) Execute() error {
 			mlog.Int("localReplicaCount", len(localReplicas)))
 	}
 
+	// 1.1 the collection keeps readable when its loaded replicas are only moved between resource groups,
+	// the segments are handed over to the nodes of the new resource group in background.
+	keepLoaded := isReplicaReassignment(job.ctx, job.meta, req, replicas)
+
 	// 2. create replica if not exist (may also remove redundant replicas)
 	if _, err := utils.SpawnReplicasWithReplicaConfig(job.ctx, job.meta, meta.SpawnWithReplicaConfigParams{
 		CollectionID: req.GetCollectionId(),
@@ -140,34 +144,43 @@ func (job *LoadCollectionJob) Execute() error {
 		fieldIDs = append(fieldIDs, loadField.GetFieldId())
 	}
 	replicaNumber := int32(len(replicas))
+	status, loadPercentage := querypb.LoadStatus_Loading, int32(0)
+	if keepLoaded {
+		status, loadPercentage = querypb.LoadStatus_Loaded, int32(100)
+	}
 	partitions := lo.Map(req.GetPartitionIds(), func(partID int64, _ int) *meta.Partition {
 		return &meta.Partition{
 			PartitionLoadInfo: &querypb.PartitionLoadInfo{
 				CollectionID:  req.GetCollectionId(),
 				PartitionID:   partID,
 				ReplicaNumber: replicaNumber,
-				Status:        querypb.LoadStatus_Loading,
+				Status:        status,
 				FieldIndexID:  fieldIndexIDs,
 			},
-			CreatedAt: time.Now(),
+			LoadPercentage: loadPercentage,
+			CreatedAt:      time.Now(),
 		}
 	})
 
-	ctx, sp := otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadCollection", trace.WithNewRoot())
+	ctx, sp := job.ctx, trace.Span(nil)
+	if !keepLoaded {
+		ctx, sp = otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadCollection", trace.WithNewRoot())
+	}
 	collection := &meta.Collection{
 		CollectionLoadInfo: &querypb.CollectionLoadInfo{
 			CollectionID:             req.GetCollectionId(),
 			ReplicaNumber:            replicaNumber,
-			Status:                   querypb.LoadStatus_Loading,
+			Status:                   status,
 			FieldIndexID:             fieldIndexIDs,
 			LoadType:                 querypb.LoadType_LoadCollection,
 			LoadFields:               fieldIDs,
 			DbID:                     req.GetDbId(),
 			UserSpecifiedReplicaMode: req.GetUserSpecifiedReplicaMode(),
 		},
-		CreatedAt: time.Now(),
-		LoadSpan:  sp,
-		Schema:    collInfo.GetSchema(),
+		LoadPercentage: loadPercentage,
+		CreatedAt:      time.Now(),
+		LoadSpan:       sp,
+		Schema:         collInfo.GetSchema(),
 	}
 	incomingPartitions := typeutil.NewSet(req.GetPartitionIds()...)
 	currentPartitions := job.meta.GetPartitionsByCollection(job.ctx, req.GetCollectionId())
@@ -196,6 +209,7 @@ func (job *LoadCollectionJob) Execute() error {
 		mlog.Int64("collectionID", req.GetCollectionId()),
 		mlog.Int64s("partitions", req.GetPartitionIds()),
 		mlog.Int64s("toReleasePartitions", toReleasePartitions),
+		mlog.Bool("keepLoaded", keepLoaded),
 	)
 
 	// 5. update next target, no need to rollback if pull target failed, target observer will pull target in periodically
@@ -223,6 +237,37 @@ func (job *LoadCollectionJob) Execute() error {
 	return nil
 }
 
+// isReplicaReassignment returns whether the load config only moves or releases the replicas of a loaded collection,
+// that is, no replica, partition or field has to be loaded from scratch.
+func isReplicaReassignment(ctx context.Context, m *meta.Meta, header *messagespb.AlterLoadConfigMessageHeader, replicas []*messagespb.LoadReplicaConfig) bool {
+	collection := m.GetCollection(ctx, header.GetCollectionId())
+	if collection == nil || collection.GetStatus() != querypb.LoadStatus_Loaded {
+		return false
+	}
+	for _, config := range replicas {
+		replica := m.ReplicaManager.Get(ctx, config.GetReplicaId())
+		if replica == nil || replica.GetCollectionID() != header.GetCollectionId() {
+			return false
+		}
+	}
+	for _, partitionID := range header.GetPartitionIds() {
+		partition := m.GetPartition(ctx, partitionID)
+		if partition == nil || partition.GetStatus() != querypb.LoadStatus_Loaded {
+			return false
+		}
+	}
+	loadFields := generateLoadFields(collection.GetLoadFields(), collection.GetFieldIndexID())
+	if len(loadFields) != len(header.GetLoadFields()) {
+		return false
+	}
+	for i, field := range header.GetLoadFields() {
+		if field.GetFieldId() != loadFields[i].GetFieldId() || field.GetIndexId() != loadFields[i].GetIndexId() {
+			return false
+		}
+	}
+	return true
+}
+
 // getLocalReplicaConfig reads the local cluster-level replica config and generates LoadReplicaConfig entries.
 // It uses generateReplicas to ensure idempotency on WAL replay by reusing existing replicas from meta.
 // If local config is not set, defaults to 1 replica in __default_resource_group.
@@ -418,6 +418,96 @@ func (suite *LoadCollectionJobSuite) TestGetLocalReplicaConfig_AllocIDError() {
 	suite.Nil(replicas)
 }
 
+func (suite *LoadCollectionJobSuite) TestCheckResourceGroupIsolation() {
+	ctx := context.Background()
+	catalog := mocks.NewQueryCoordCatalog(suite.T())
+	catalog.EXPECT().SaveReplica(mock.Anything, mock.Anything).Return(nil).Maybe()
+	m := &meta.Meta{
+		CollectionManager: meta.NewCollectionManager(catalog),
+		ReplicaManager:    meta.NewReplicaManager(func() (int64, error) { return 100, nil }, catalog),
+		ResourceManager:   meta.NewResourceManager(nil, nil),
+	}
+	// collection 1 of database 1 is loaded in rg1
+	suite.NoError(m.PutCollectionWithoutSave(ctx, &meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{CollectionID: 1, DbID: 1},
+	}))
+	suite.NoError(m.Put(ctx, meta.NewReplica(&querypb.Replica{ID: 1, CollectionID: 1, ResourceGroup: "rg1"}, typeutil.NewUniqueSet())))
+
+	newRequest := func(dbID int64, rgName string) *AlterLoadConfigRequest {
+		return &AlterLoadConfigRequest{
+			Meta:           m,
+			CollectionInfo: &milvuspb.DescribeCollectionResponse{CollectionID: 2, DbId: dbID},
+			Expected:       ExpectedLoadConfig{ExpectedReplicaNumber: map[string]int{rgName: 1}},
+		}
+	}
+
+	// isolation is disabled by default
+	suite.NoError(newRequest(2, "rg1").checkResourceGroupIsolation(ctx))
+
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.DatabaseResourceGroupIsolation.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.DatabaseResourceGroupIsolation.Key)
+
+	suite.ErrorIs(newRequest(2, "rg1").checkResourceGroupIsolation(ctx), merr.ErrParameterInvalid)
+	suite.NoError(newRequest(1, "rg1").checkResourceGroupIsolation(ctx))
+	suite.NoError(newRequest(2, "rg2").checkResourceGroupIsolation(ctx))
+}
+
+// TestIsReplicaReassignment tests that only moving the replicas of a loaded collection keeps it loaded.
+func (suite *LoadCollectionJobSuite) TestIsReplicaReassignment() {
+	ctx := context.Background()
+	collectionID := int64(4000)
+	catalog := mocks.NewQueryCoordCatalog(suite.T())
+	catalog.EXPECT().SaveCollection(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().SaveReplica(mock.Anything, mock.Anything).Return(nil).Maybe()
+	m := &meta.Meta{
+		CollectionManager: meta.NewCollectionManager(catalog),
+		ReplicaManager:    meta.NewReplicaManager(nil, catalog),
+		ResourceManager:   meta.NewResourceManager(nil, nil),
+	}
+	suite.NoError(m.Put(ctx, meta.NewReplica(&querypb.Replica{
+		ID:            1,
+		CollectionID:  collectionID,
+		ResourceGroup: "rg1",
+	}, typeutil.NewUniqueSet())))
+
+	header := &messagespb.AlterLoadConfigMessageHeader{
+		CollectionId: collectionID,
+		PartitionIds: []int64{10},
+		LoadFields:   []*messagespb.LoadFieldConfig{{FieldId: 100, IndexId: 1000}, {FieldId: 101}},
+	}
+	moved := []*messagespb.LoadReplicaConfig{{ReplicaId: 1, ResourceGroupName: "rg2"}}
+	// not loaded yet.
+	suite.False(isReplicaReassignment(ctx, m, header, moved))
+
+	suite.NoError(m.PutCollection(ctx, &meta.Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{
+			CollectionID: collectionID,
+			Status:       querypb.LoadStatus_Loaded,
+			FieldIndexID: map[int64]int64{100: 1000},
+			LoadFields:   []int64{101, 100},
+		},
+	}, &meta.Partition{
+		PartitionLoadInfo: &querypb.PartitionLoadInfo{
+			CollectionID: collectionID,
+			PartitionID:  10,
+			Status:       querypb.LoadStatus_Loaded,
+		},
+	}))
+	suite.True(isReplicaReassignment(ctx, m, header, moved))
+
+	// a new replica has to be loaded.
+	suite.False(isReplicaReassignment(ctx, m, header, append(moved, &messagespb.LoadReplicaConfig{ReplicaId: 2, ResourceGroupName: "rg2"})))
+
+	// a new partition has to be loaded.
+	header.PartitionIds = []int64{10, 11}
+	suite.False(isReplicaReassignment(ctx, m, header, moved))
+
+	// a new field has to be loaded.
+	header.PartitionIds = []int64{10}
+	header.LoadFields = append(header.LoadFields, &messagespb.LoadFieldConfig{FieldId: 102})
+	suite.False(isReplicaReassignment(ctx, m, header, moved))
+}
+
 func TestLoadCollectionJob(t *testing.T) {
 	suite.Run(t, new(LoadCollectionJobSuite))
 }
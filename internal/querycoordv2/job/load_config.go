@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 type AlterLoadConfigRequest struct {
@@ -142,6 +143,9 @@ func (c *CurrentLoadConfig) IntoLoadConfigMessageHeader() *messagespb.AlterLoadC
 // It returns a nil message (with a nil error) when the expected load config is identical to
 // the current one, i.e. there is nothing to broadcast and the operation is a no-op.
 func GenerateAlterLoadConfigMessage(ctx context.Context, req *AlterLoadConfigRequest) (message.BroadcastMutableMessage, error) {
+	if err := req.checkResourceGroupIsolation(ctx); err != nil {
+		return nil, err
+	}
 	loadFields := generateLoadFields(req.Expected.ExpectedLoadFields, req.Expected.ExpectedFieldIndexID)
 	loadReplicaConfigs, err := req.generateReplicas(ctx)
 	if err != nil {
@@ -172,6 +176,32 @@ func GenerateAlterLoadConfigMessage(ctx context.Context, req *AlterLoadConfigReq
 		MustBuildBroadcast(), nil
 }
 
+// checkResourceGroupIsolation checks that the resource groups to spawn new replicas in don't host the replicas of other databases,
+// so the replicas of different databases are served by disjoint query nodes if the database resource group isolation is enabled.
+// The caller should hold the exclusive resource keys of the resource groups until the replicas are spawned,
+// otherwise the concurrent loads of different databases into the same empty resource group may both pass the check.
+func (req *AlterLoadConfigRequest) checkResourceGroupIsolation(ctx context.Context) error {
+	if !paramtable.Get().QueryCoordCfg.DatabaseResourceGroupIsolation.GetAsBool() {
+		return nil
+	}
+	dbID := req.CollectionInfo.GetDbId()
+	currentReplicaNumber := req.Current.GetReplicaNumber()
+	for rgName, num := range req.Expected.ExpectedReplicaNumber {
+		// the existing replicas are kept as they are, only check the resource groups with incoming replicas.
+		if num <= currentReplicaNumber[rgName] {
+			continue
+		}
+		for _, replica := range req.Meta.GetByResourceGroup(ctx, rgName) {
+			collection := req.Meta.GetCollection(ctx, replica.GetCollectionID())
+			if collection != nil && collection.GetDbID() != dbID {
+				return merr.WrapErrParameterInvalidMsg("resource group %s hosts the replicas of database %d, can't spawn the replicas of database %d in it",
+					rgName, collection.GetDbID(), dbID)
+			}
+		}
+	}
+	return nil
+}
+
 // generateLoadFields generates the load fields for the collection.
 func generateLoadFields(loadedFields []int64, fieldIndexID map[int64]int64) []*messagespb.LoadFieldConfig {
 	loadFields := lo.Map(loadedFields, func(fieldID int64, _ int) *messagespb.LoadFieldConfig {
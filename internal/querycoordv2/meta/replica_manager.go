@@ -61,7 +61,7 @@ type ReplicaManagerInterface interface {
 	GetByResourceGroup(ctx context.Context, rgName string) []*Replica
 
 	// Node management
-	RecoverNodesInCollection(ctx context.Context, collectionID typeutil.UniqueID, rgs map[string]*ResourceGroup) error
+	RecoverNodesInCollection(ctx context.Context, collectionID typeutil.UniqueID, rgs map[string]*ResourceGroup, opts ...RecoverOption) error
 	RemoveNode(ctx context.Context, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID, nodes ...typeutil.UniqueID) error
 	RemoveSQNode(ctx context.Context, collectionID typeutil.UniqueID, replicaID typeutil.UniqueID, nodes ...typeutil.UniqueID) error
 
@@ -88,6 +88,10 @@ type ReplicaManager struct {
 	// scanning all replicas in the load-config promotion loop.
 	queryInvisibleReplicas *typeutil.ConcurrentSet[int64]
 
+	// handovers records the replicas moved to another resource group by the load config,
+	// replicaID -> handover, the nodes of previous resource group keep serving until the handover is done.
+	handovers *typeutil.ConcurrentMap[int64, *replicaHandover]
+
 	idAllocator func() (int64, error)
 	catalog     metastore.QueryCoordCatalog
 }
@@ -98,6 +102,7 @@ func NewReplicaManager(idAllocator func() (int64, error), catalog metastore.Quer
 		flatReplicas:           typeutil.NewConcurrentMap[int64, *Replica](),
 		coll2Replicas:          typeutil.NewConcurrentMap[int64, []*Replica](),
 		queryInvisibleReplicas: typeutil.NewConcurrentSet[int64](),
+		handovers:              typeutil.NewConcurrentMap[int64, *replicaHandover](),
 		idAllocator:            idAllocator,
 		catalog:                catalog,
 	}
@@ -217,9 +222,13 @@ func (m *ReplicaManager) SpawnWithReplicaConfig(ctx context.Context, params Spaw
 	balancePolicy := paramtable.Get().QueryCoordCfg.Balancer.GetValue()
 	enableChannelExclusiveMode := balancePolicy == ChannelLevelScoreBalancerName
 	replicas := make([]*Replica, 0)
+	handovers := make(map[int64]*replicaHandover)
 	for _, config := range params.Configs {
 		if existedReplica, ok := m.flatReplicas.Get(config.GetReplicaId()); ok &&
 			existedReplica.GetCollectionID() == params.CollectionID {
+			if existedReplica.GetResourceGroup() != config.GetResourceGroupName() && existedReplica.RWNodesCount() > 0 {
+				handovers[existedReplica.GetID()] = newReplicaHandover(existedReplica.GetRWNodes())
+			}
 			// if the replica is already existed, just update the resource group
 			mutableReplica := existedReplica.CopyForWrite()
 			mutableReplica.SetResourceGroup(config.GetResourceGroupName())
@@ -246,6 +255,9 @@ func (m *ReplicaManager) SpawnWithReplicaConfig(ctx context.Context, params Spaw
 	if err := m.put(ctx, params.CollectionID, replicas...); err != nil {
 		return nil, merr.Wrap(err, "failed to put replicas")
 	}
+	for replicaID, handover := range handovers {
+		m.handovers.Insert(replicaID, handover)
+	}
 	if err := m.removeRedundantReplicas(ctx, params); err != nil {
 		return nil, merr.Wrap(err, "failed to remove redundant replicas")
 	}
@@ -611,6 +623,7 @@ func (m *ReplicaManager) removeReplicasInMemory(collectionID typeutil.UniqueID,
 	for _, replicaID := range replicaIDs {
 		m.flatReplicas.Remove(replicaID)
 		m.queryInvisibleReplicas.Remove(replicaID)
+		m.handovers.Remove(replicaID)
 	}
 }
 
@@ -658,12 +671,49 @@ func (m *ReplicaManager) GetByResourceGroup(ctx context.Context, rgName string)
 	return ret
 }
 
+// replicaHandover is the handover of a replica moved to another resource group by the load config.
+type replicaHandover struct {
+	nodes    typeutil.UniqueSet // the rw nodes of the replica in previous resource group
+	deadline time.Time
+}
+
+func newReplicaHandover(nodes []int64) *replicaHandover {
+	return &replicaHandover{
+		nodes:    typeutil.NewUniqueSet(nodes...),
+		deadline: time.Now().Add(paramtable.Get().QueryCoordCfg.ReplicaHandoverTimeout.GetAsDuration(time.Second)),
+	}
+}
+
+// RecoverOption is a functional option for RecoverNodesInCollection.
+type RecoverOption func(*recoverConfig)
+
+type recoverConfig struct {
+	handoverNodes typeutil.UniqueSet
+}
+
+// WithHandoverNodes returns a RecoverOption that allows the given nodes to keep serving as rw nodes
+// of a replica moved out of their resource group by the load config, until the replica gets rw nodes in its new resource group
+// or queryCoord.replicaHandoverTimeout is reached.
+// So the replica is transferred between resource groups without availability gap,
+// the nodes of the previous resource group are set to ro once the segments and channels can be handed over.
+// Only the rw nodes of the replica at the time it's moved are kept, the other nodes are set to ro as usual.
+func WithHandoverNodes(nodes typeutil.UniqueSet) RecoverOption {
+	return func(cfg *recoverConfig) {
+		cfg.handoverNodes = nodes
+	}
+}
+
 // RecoverNodesInCollection recovers all nodes in collection with latest resource group.
 // Promise a node will be only assigned to one replica in same collection at same time.
 // 1. Move the rw nodes to ro nodes if they are not in related resource group.
 // 2. Add new incoming nodes into the replica if they are not in-used by other replicas of same collection.
 // 3. replicas in same resource group will shared the nodes in resource group fairly.
-func (m *ReplicaManager) RecoverNodesInCollection(ctx context.Context, collectionID typeutil.UniqueID, rgs map[string]*ResourceGroup) error {
+func (m *ReplicaManager) RecoverNodesInCollection(ctx context.Context, collectionID typeutil.UniqueID, rgs map[string]*ResourceGroup, opts ...RecoverOption) error {
+	cfg := &recoverConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	// Build node sets from resource groups.
 	rgNodeSets := make(map[string]typeutil.UniqueSet, len(rgs))
 	for rgName, rg := range rgs {
@@ -716,6 +766,27 @@ func (m *ReplicaManager) RecoverNodesInCollection(ctx context.Context, collectio
 			// Even we filtering the nodes that are used by other replica of same collection in other resource group,
 			// current replica's expected node may be still used by other replica of same collection in same resource group.
 			incomingNode := replicaHelper.AllocateIncomingNodes(incomingNodeCount)
+			var handoverNodes []int64
+			if handover, ok := m.handovers.Get(replica.GetID()); ok {
+				if assignment.RWNodesCount()+len(recoverableNodes)+len(incomingNode) > 0 || time.Now().After(handover.deadline) {
+					// the nodes in current resource group take over the segments and channels, or the handover is timeout.
+					m.handovers.Remove(replica.GetID())
+				} else if cfg.handoverNodes != nil {
+					// no rw node in current resource group to take over the segments and channels,
+					// keep the nodes in previous resource group serving.
+					roNodes, handoverNodes = lo.FilterReject(roNodes, func(nodeID int64, _ int) bool {
+						return !handover.nodes.Contain(nodeID) || !cfg.handoverNodes.Contain(nodeID)
+					})
+				}
+			}
+			if len(handoverNodes) > 0 {
+				mlog.RatedInfo(ctx, rate.Limit(10), "wait for rw nodes in resource group to hand over replica",
+					mlog.FieldCollectionID(collectionID),
+					mlog.Int64("replicaID", replica.GetID()),
+					mlog.String("rgName", replica.GetResourceGroup()),
+					mlog.Int64s("handoverNodes", handoverNodes),
+				)
+			}
 			if len(roNodes) == 0 && len(recoverableNodes) == 0 && len(incomingNode) == 0 {
 				// nothing to do.
 				return
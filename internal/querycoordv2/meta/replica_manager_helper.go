@@ -232,6 +232,11 @@ func (s *replicaAssignmentInfo) GetReplicaID() typeutil.UniqueID {
 	return s.replica.GetID()
 }
 
+// RWNodesCount returns the count of rw nodes in current resource group for these replica.
+func (s *replicaAssignmentInfo) RWNodesCount() int {
+	return s.rwNodes.Len()
+}
+
 // GetNewRONodes returns the new ro nodes for these replica.
 func (s *replicaAssignmentInfo) GetNewRONodes() []int64 {
 	newRONodes := make([]int64, 0, s.newRONodes.Len())
@@ -19,6 +19,7 @@ package meta
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -1189,3 +1190,66 @@ func TestReplicaManagerMoveReplicaPersistError(t *testing.T) {
 	assert.ErrorIs(t, err, saveErr)
 	assert.Equal(t, "rg1", mgr.Get(ctx, replica.GetID()).GetResourceGroup())
 }
+
+func TestReplicaManagerRecoverWithHandoverNodes(t *testing.T) {
+	paramtable.Init()
+	catalog := mocks.NewQueryCoordCatalog(t)
+	catalog.EXPECT().SaveReplica(mock.Anything, mock.Anything).Return(nil)
+	mgr := NewReplicaManager(nil, catalog)
+	ctx := context.Background()
+	replica := newReplica(&querypb.Replica{
+		ID:            1,
+		CollectionID:  10,
+		ResourceGroup: "rg1",
+		Nodes:         []int64{1, 2},
+	})
+	assert.NoError(t, mgr.Put(ctx, replica))
+	emptyRG2 := map[string]*ResourceGroup{"rg2": newTestResourceGroup("rg2", typeutil.NewUniqueSet())}
+
+	// the replica moved by transfer replica is not handed over.
+	assert.NoError(t, mgr.MoveReplica(ctx, replica.GetCollectionID(), "rg2", []*Replica{replica}))
+	assert.NoError(t, mgr.RecoverNodesInCollection(ctx, 10, emptyRG2, WithHandoverNodes(typeutil.NewUniqueSet(1, 2))))
+	assert.Empty(t, mgr.Get(ctx, 1).GetRWNodes())
+	assert.ElementsMatch(t, []int64{1, 2}, mgr.Get(ctx, 1).GetRONodes())
+
+	// the replica moved by load config is handed over.
+	assert.NoError(t, mgr.Put(ctx, replica))
+	_, err := mgr.SpawnWithReplicaConfig(ctx, SpawnWithReplicaConfigParams{
+		CollectionID: 10,
+		Configs:      []*messagespb.LoadReplicaConfig{{ReplicaId: 1, ResourceGroupName: "rg2"}},
+	})
+	assert.NoError(t, err)
+
+	// no node in rg2 to take over, keep the nodes in rg1 serving.
+	assert.NoError(t, mgr.RecoverNodesInCollection(ctx, 10, emptyRG2, WithHandoverNodes(typeutil.NewUniqueSet(1, 2))))
+	assert.ElementsMatch(t, []int64{1, 2}, mgr.Get(ctx, 1).GetRWNodes())
+	assert.Empty(t, mgr.Get(ctx, 1).GetRONodes())
+
+	// the node down can not be kept.
+	assert.NoError(t, mgr.RecoverNodesInCollection(ctx, 10, emptyRG2, WithHandoverNodes(typeutil.NewUniqueSet(1))))
+	assert.ElementsMatch(t, []int64{1}, mgr.Get(ctx, 1).GetRWNodes())
+	assert.ElementsMatch(t, []int64{2}, mgr.Get(ctx, 1).GetRONodes())
+
+	// hand over to the incoming node of rg2.
+	assert.NoError(t, mgr.RecoverNodesInCollection(ctx, 10, map[string]*ResourceGroup{
+		"rg2": newTestResourceGroup("rg2", typeutil.NewUniqueSet(3)),
+	}, WithHandoverNodes(typeutil.NewUniqueSet(1))))
+	assert.ElementsMatch(t, []int64{3}, mgr.Get(ctx, 1).GetRWNodes())
+	assert.ElementsMatch(t, []int64{1, 2}, mgr.Get(ctx, 1).GetRONodes())
+	_, ok := mgr.handovers.Get(1)
+	assert.False(t, ok)
+
+	// the handover is timeout.
+	assert.NoError(t, mgr.Put(ctx, replica))
+	_, err = mgr.SpawnWithReplicaConfig(ctx, SpawnWithReplicaConfigParams{
+		CollectionID: 10,
+		Configs:      []*messagespb.LoadReplicaConfig{{ReplicaId: 1, ResourceGroupName: "rg2"}},
+	})
+	assert.NoError(t, err)
+	handover, ok := mgr.handovers.Get(1)
+	assert.True(t, ok)
+	handover.deadline = time.Now().Add(-time.Second)
+	assert.NoError(t, mgr.RecoverNodesInCollection(ctx, 10, emptyRG2, WithHandoverNodes(typeutil.NewUniqueSet(1, 2))))
+	assert.Empty(t, mgr.Get(ctx, 1).GetRWNodes())
+	assert.ElementsMatch(t, []int64{1, 2}, mgr.Get(ctx, 1).GetRONodes())
+}
@@ -143,6 +143,8 @@ type Segment struct {
 	// nil means the reporting QueryNode does not support DataVersion (mixed-version rollout),
 	// so the field is not comparable.
 	DataVersion *int32
+	// QPS is the recent search and query rate of the segment reported by the QueryNode.
+	QPS float64
 }
 
 func SegmentFromInfo(info *datapb.SegmentInfo) *Segment {
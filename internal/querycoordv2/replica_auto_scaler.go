@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/querycoordv2/job"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// NewReplicaAutoScaler creates a new replica auto scaler.
+func NewReplicaAutoScaler(s *Server) *ReplicaAutoScaler {
+	a := &ReplicaAutoScaler{
+		notifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+		s:        s,
+		pressure: make(map[int64]*replicaPressure),
+	}
+	a.SetLogger(mlog.With(mlog.FieldModule(typeutil.QueryCoordRole), mlog.FieldComponent("replica_auto_scaler")))
+	go a.background()
+	return a
+}
+
+// replicaPressure records since when the replica number of a collection is expected to be changed.
+type replicaPressure struct {
+	target int32
+	since  time.Time
+}
+
+// ReplicaAutoScaler raises or lowers the replica number of the loaded collections
+// by the search and query rate per replica, within the bounds configured by the operator.
+type ReplicaAutoScaler struct {
+	mlog.Binder
+	notifier *syncutil.AsyncTaskNotifier[struct{}]
+	s        *Server
+
+	pressure map[int64]*replicaPressure
+}
+
+// background is the background task for replica auto scaler.
+func (a *ReplicaAutoScaler) background() {
+	defer func() {
+		a.notifier.Finish(struct{}{})
+		a.Logger().Info(context.TODO(), "replica auto scaler stopped")
+	}()
+	a.Logger().Info(context.TODO(), "replica auto scaler started")
+
+	for {
+		interval := paramtable.Get().QueryCoordCfg.ReplicaAutoScaleCheckInterval.GetAsDuration(time.Second)
+		select {
+		case <-a.notifier.Context().Done():
+			return
+		case <-time.After(interval):
+		}
+		if !paramtable.Get().QueryCoordCfg.ReplicaAutoScaleEnabled.GetAsBool() {
+			a.pressure = make(map[int64]*replicaPressure)
+			continue
+		}
+		a.check(time.Now())
+	}
+}
+
+// check checks the search and query rate of all the loaded collections,
+// and updates the replica number of the collections under sustained pressure.
+func (a *ReplicaAutoScaler) check(now time.Time) {
+	ctx := a.notifier.Context()
+	params := paramtable.Get().QueryCoordCfg
+	minReplicaNum := params.ReplicaAutoScaleMinReplicaNumber.GetAsInt32()
+	maxReplicaNum := params.ReplicaAutoScaleMaxReplicaNumber.GetAsInt32()
+	upQPS := params.ReplicaAutoScaleUpQPS.GetAsFloat()
+	downQPS := params.ReplicaAutoScaleDownQPS.GetAsFloat()
+	sustain := params.ReplicaAutoScaleSustainDuration.GetAsDuration(time.Second)
+	if minReplicaNum <= 0 || maxReplicaNum < minReplicaNum || downQPS >= upQPS {
+		a.Logger().Warn(ctx, "illegal replica auto scale config, skip it",
+			mlog.Int32("minReplicaNumber", minReplicaNum),
+			mlog.Int32("maxReplicaNumber", maxReplicaNum),
+			mlog.Float64("scaleUpQPSPerReplica", upQPS),
+			mlog.Float64("scaleDownQPSPerReplica", downQPS))
+		return
+	}
+
+	collectionIDs := a.s.meta.GetAll(ctx)
+	checked := typeutil.NewUniqueSet()
+	for _, collectionID := range collectionIDs {
+		collection := a.s.meta.GetCollection(ctx, collectionID)
+		if !a.scalable(ctx, collection) {
+			continue
+		}
+		checked.Insert(collectionID)
+
+		current := collection.GetReplicaNumber()
+		qps := a.collectionQPS(ctx, collectionID)
+		target := autoScaleReplicaNumber(current, qps, minReplicaNum, maxReplicaNum, upQPS, downQPS)
+		if target == current {
+			delete(a.pressure, collectionID)
+			continue
+		}
+		pressure, ok := a.pressure[collectionID]
+		if !ok || pressure.target != target {
+			a.pressure[collectionID] = &replicaPressure{target: target, since: now}
+			continue
+		}
+		if now.Sub(pressure.since) < sustain {
+			continue
+		}
+
+		if err := a.updateReplicaNumber(ctx, collection, target); err != nil {
+			a.Logger().Warn(ctx, "failed to auto scale replica number",
+				mlog.FieldCollectionID(collectionID),
+				mlog.Int32("replicaNumber", current),
+				mlog.Int32("targetReplicaNumber", target),
+				mlog.Err(err))
+			continue
+		}
+		a.Logger().Info(ctx, "auto scale replica number",
+			mlog.FieldCollectionID(collectionID),
+			mlog.Float64("qps", qps),
+			mlog.Int32("replicaNumber", current),
+			mlog.Int32("targetReplicaNumber", target))
+		delete(a.pressure, collectionID)
+	}
+
+	for collectionID := range a.pressure {
+		if !checked.Contain(collectionID) {
+			delete(a.pressure, collectionID)
+		}
+	}
+}
+
+// scalable returns whether the replica number of the collection could be changed automatically.
+func (a *ReplicaAutoScaler) scalable(ctx context.Context, collection *meta.Collection) bool {
+	if collection == nil || collection.GetStatus() != querypb.LoadStatus_Loaded {
+		return false
+	}
+	// the replica number is managed by the cluster level load config
+	if !collection.UserSpecifiedReplicaMode &&
+		paramtable.Get().QueryCoordCfg.ClusterLevelLoadReplicaNumber.GetAsInt32() > 0 {
+		return false
+	}
+	// the replicas spread over multiple resource groups are arranged by the user
+	return a.s.meta.ReplicaManager.GetResourceGroupByCollection(ctx, collection.GetCollectionID()).Len() == 1
+}
+
+// collectionQPS returns the search and query rate of the collection.
+// Every request is served by all the segments of one replica,
+// so the rate of a replica is the max rate of its segments.
+func (a *ReplicaAutoScaler) collectionQPS(ctx context.Context, collectionID int64) float64 {
+	var qps float64
+	for _, replica := range a.s.meta.ReplicaManager.GetByCollection(ctx, collectionID) {
+		var replicaQPS float64
+		for _, segment := range a.s.dist.SegmentDistManager.GetByFilter(meta.WithReplica(replica)) {
+			replicaQPS = max(replicaQPS, segment.QPS)
+		}
+		qps += replicaQPS
+	}
+	return qps
+}
+
+// updateReplicaNumber updates the replica number of the collection in its current resource group.
+func (a *ReplicaAutoScaler) updateReplicaNumber(ctx context.Context, collection *meta.Collection, replicaNum int32) error {
+	collectionID := collection.GetCollectionID()
+	req := &querypb.UpdateLoadConfigRequest{
+		CollectionIDs:  []int64{collectionID},
+		ReplicaNumber:  replicaNum,
+		ResourceGroups: a.s.meta.ReplicaManager.GetResourceGroupByCollection(ctx, collectionID).Collect(),
+	}
+	updateJob := job.NewUpdateLoadConfigJob(
+		ctx,
+		req,
+		a.s.meta,
+		a.s.targetMgr,
+		a.s.targetObserver,
+		a.s.collectionObserver,
+		a.s.proxyClientManager,
+		collection.UserSpecifiedReplicaMode,
+		true,
+	)
+	a.s.jobScheduler.Add(updateJob)
+	return updateJob.Wait()
+}
+
+// Close closes the replica auto scaler.
+func (a *ReplicaAutoScaler) Close() {
+	a.notifier.Cancel()
+	a.notifier.BlockUntilFinish()
+}
+
+// autoScaleReplicaNumber returns the expected replica number of the collection by its search and query rate,
+// the replica number is changed by one at a time, and kept within [minReplicaNum, maxReplicaNum].
+func autoScaleReplicaNumber(current int32, qps float64, minReplicaNum, maxReplicaNum int32, upQPS, downQPS float64) int32 {
+	if current < minReplicaNum {
+		return minReplicaNum
+	}
+	if current > maxReplicaNum {
+		return maxReplicaNum
+	}
+	perReplica := qps / float64(current)
+	if perReplica > upQPS && current < maxReplicaNum {
+		return current + 1
+	}
+	// avoid lowering the replica number if the rest replicas would be scaled up again
+	if perReplica < downQPS && current > minReplicaNum && qps/float64(current-1) <= upQPS {
+		return current - 1
+	}
+	return current
+}
@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoScaleReplicaNumber(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  int32
+		qps      float64
+		upQPS    float64
+		expected int32
+	}{
+		{"keep", 2, 100, 100, 2},
+		{"scale up", 2, 300, 100, 3},
+		{"reach max", 4, 1000, 100, 4},
+		{"scale down", 3, 15, 100, 2},
+		{"reach min", 1, 0, 100, 1},
+		// 2 replicas with 14.5 qps each would be scaled up again
+		{"no flapping", 3, 29, 14, 3},
+		{"below min", 0, 0, 100, 1},
+		{"above max", 6, 1000, 100, 4},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, autoScaleReplicaNumber(c.current, c.qps, 1, 4, c.upQPS, 10))
+		})
+	}
+}
@@ -134,6 +134,7 @@ type Server struct {
 
 	// load config watcher
 	loadConfigWatcher *LoadConfigWatcher
+	replicaAutoScaler *ReplicaAutoScaler
 }
 
 type FileResourceObserver interface {
@@ -220,6 +221,14 @@ func (s *Server) registerMetricsRequest() {
 		return s.getChannelsFromQueryNode(ctx, req)
 	}
 
+	QueryCollectionRefsAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getCollectionRefsFromQueryNode(ctx, req)
+	}
+
+	QuerySegmentMemoryAction := func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+		return s.getSegmentMemoryFromQueryNode(ctx, req)
+	}
+
 	// register actions that requests are processed in querycoord
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SystemInfoMetrics, getSystemInfoAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.AllTaskKey, QueryTasksAction)
@@ -231,6 +240,8 @@ func (s *Server) registerMetricsRequest() {
 	// register actions that requests are processed in querynode
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentKey, QuerySegmentsAction)
 	s.metricsRequest.RegisterMetricsRequest(metricsinfo.ChannelKey, QueryChannelsAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.CollectionRefKey, QueryCollectionRefsAction)
+	s.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentMemoryKey, QuerySegmentMemoryAction)
 	mlog.Info(s.ctx, "register metrics actions finished")
 }
 
@@ -499,6 +510,7 @@ func (s *Server) startQueryCoord() error {
 	// check replica changes after restart
 	// Note: this should be called after start progress is done
 	s.watchLoadConfigChanges()
+	s.replicaAutoScaler = NewReplicaAutoScaler(s)
 	return nil
 }
 
@@ -539,6 +551,11 @@ func (s *Server) Stop() error {
 		s.loadConfigWatcher.Close()
 	}
 
+	if s.replicaAutoScaler != nil {
+		mlog.Info(s.ctx, "stop replica auto scaler...")
+		s.replicaAutoScaler.Close()
+	}
+
 	if s.jobScheduler != nil {
 		mlog.Info(s.ctx, "stop job scheduler...")
 		s.jobScheduler.Stop()
@@ -285,6 +285,8 @@ func applyCollectionSettings(schema *schemapb.CollectionSchema,
 	schemaCloned := typeutil.Clone(schema)
 	schemaCloned.Properties = mergeCollectionProps(schemaCloned.Properties, collectionProperties)
 
+	// the collection-level warmup preset is applied as the granular settings that are not set explicitly.
+	collectionProperties = common.ExpandWarmupPolicy(collectionProperties...)
 	schemaCloned = applyCollectionMmapSetting(schemaCloned, collectionProperties)
 	schemaCloned = applyCollectionWarmupSetting(schemaCloned, collectionProperties)
 	return schemaCloned
@@ -452,6 +454,7 @@ func applyCollectionWarmupSetting(schema *schemapb.CollectionSchema,
 // Priority: index-level > collection-level > autoWarmupForNonPKIsolationCollection (all indexes)
 // Collection-level granular keys: warmup.scalarIndex, warmup.vectorIndex
 func applyIndexWarmupSetting(loadInfo *querypb.SegmentLoadInfo, schema *schemapb.CollectionSchema, collectionProperties []*commonpb.KeyValuePair) {
+	collectionProperties = common.ExpandWarmupPolicy(collectionProperties...)
 	// Get collection-level granular warmup policies for indexes
 	scalarIndexWarmup, scalarIndexExist := common.GetWarmupPolicyByKey(common.WarmupScalarIndexKey, collectionProperties...)
 	vectorIndexWarmup, vectorIndexExist := common.GetWarmupPolicyByKey(common.WarmupVectorIndexKey, collectionProperties...)
@@ -809,6 +809,53 @@ func TestApplyIndexWarmupSettingAutoWarmup(t *testing.T) {
 	})
 }
 
+func TestApplyCollectionWarmupPolicy(t *testing.T) {
+	paramtable.Init()
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 1, DataType: schemapb.DataType_Int64},
+			{FieldID: 2, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.WarmupKey, Value: common.WarmupAsync},
+			}},
+		},
+	}
+	collectionProps := []*commonpb.KeyValuePair{
+		{Key: common.WarmupPolicyKey, Value: common.WarmupPolicyIndexOnly},
+	}
+
+	result := applyCollectionSettings(schema, collectionProps)
+	warmup, exist := common.GetWarmupPolicy(result.GetFields()[0].GetTypeParams()...)
+	assert.True(t, exist)
+	assert.Equal(t, common.WarmupDisable, warmup)
+	// field-level setting has higher priority than the collection-level preset.
+	warmup, _ = common.GetWarmupPolicy(result.GetFields()[1].GetTypeParams()...)
+	assert.Equal(t, common.WarmupAsync, warmup)
+	// the input properties are not modified.
+	assert.Len(t, collectionProps, 1)
+
+	loadInfo := &querypb.SegmentLoadInfo{
+		IndexInfos: []*querypb.FieldIndexInfo{
+			{FieldID: 1, IndexParams: []*commonpb.KeyValuePair{}},
+			{FieldID: 2, IndexParams: []*commonpb.KeyValuePair{}},
+		},
+	}
+	applyIndexWarmupSetting(loadInfo, result, collectionProps)
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		warmup, exist := common.GetWarmupPolicy(indexInfo.IndexParams...)
+		assert.True(t, exist)
+		assert.Equal(t, common.WarmupSync, warmup)
+	}
+
+	collectionProps = []*commonpb.KeyValuePair{
+		{Key: common.WarmupPolicyKey, Value: common.WarmupPolicyLazy},
+	}
+	result = applyCollectionSettings(schema, collectionProps)
+	mmap, exist := common.IsMmapDataEnabled(result.GetFields()[0].GetTypeParams()...)
+	assert.True(t, exist)
+	assert.True(t, mmap)
+}
+
 func TestUtils(t *testing.T) {
 	suite.Run(t, new(UtilsSuite))
 }
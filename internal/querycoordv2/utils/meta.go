@@ -94,11 +94,29 @@ func RecoverReplicaOfCollection(ctx context.Context, m *meta.Meta, collectionID
 		return
 	}
 
-	if err := m.RecoverNodesInCollection(ctx, collectionID, rgs); err != nil {
+	if err := m.RecoverNodesInCollection(ctx, collectionID, rgs, meta.WithHandoverNodes(getHandoverNodes(ctx, m, collectionID))); err != nil {
 		logger.Warn(ctx, "fail to set available nodes in replica", mlog.Err(err))
 	}
 }
 
+// getHandoverNodes returns the rw nodes of the collection which are still assigned to a resource group,
+// they can keep serving the replica moved by the load config until the segments and channels are handed over
+// to the replica's new resource group.
+// The nodes down or stopping are not assigned to any resource group, so they are never kept.
+func getHandoverNodes(ctx context.Context, m *meta.Meta, collectionID typeutil.UniqueID) typeutil.UniqueSet {
+	nodes := make([]int64, 0)
+	for _, replica := range m.ReplicaManager.GetByCollection(ctx, collectionID) {
+		nodes = append(nodes, replica.GetRWNodes()...)
+	}
+	handoverNodes := typeutil.NewUniqueSet()
+	for node, suspended := range m.GetNodesSuspended(nodes) {
+		if !suspended {
+			handoverNodes.Insert(node)
+		}
+	}
+	return handoverNodes
+}
+
 // RecoverAllCollectionrecovers all replica of all collection in resource group.
 func RecoverAllCollection(m *meta.Meta) {
 	for _, collection := range m.GetAll(context.TODO()) {
@@ -187,6 +187,9 @@ type shardDelegator struct {
 	// delegator's optional growing-source source.
 	growingSourceRegistration *syncmgr.GrowingSourceRegistration
 	growingSourceProvider     *delegatorGrowingSourceProvider
+
+	// searchCache caches the search results of the identical search requests
+	searchCache *searchResultCache
 }
 
 // getLogger returns the logger with pre-defined shard attributes.
@@ -280,11 +283,13 @@ func (sd *shardDelegator) GetSegmentInfo(readable bool) ([]SnapshotItem, []Segme
 
 // SyncDistribution revises distribution.
 func (sd *shardDelegator) SyncDistribution(ctx context.Context, entries ...SegmentEntry) {
+	defer sd.searchCache.Invalidate()
 	sd.distribution.AddDistributions(entries...)
 }
 
 // SyncDistribution revises distribution.
 func (sd *shardDelegator) SyncPartitionStats(ctx context.Context, partVersions map[int64]int64) {
+	defer sd.searchCache.Invalidate()
 	log := sd.getLogger(ctx)
 	log.RatedInfo(ctx, rate.Limit(60), "update partition stats versions")
 	sd.loadPartitionStats(ctx, partVersions)
@@ -368,9 +373,12 @@ func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest
 
 	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
 		func() {
+			// the plan is pruned with the schema version it's planned with.
+			schema, schemaVersion := sd.collection.PinSchemaVersionOf(req.GetReq().SchemaVersion)
+			defer sd.collection.UnpinSchemaVersion(schemaVersion)
 			sd.partitionStatsMut.RLock()
 			defer sd.partitionStatsMut.RUnlock()
-			PruneSegments(ctx, sd.partitionStats, req.GetReq(), nil, sd.collection.Schema(), sealed,
+			PruneSegments(ctx, sd.partitionStats, req.GetReq(), nil, schema, sealed,
 				PruneInfo{filterRatio: paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
 		}()
 	}
@@ -502,6 +510,21 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 	}
 	defer sd.distribution.Unpin(version)
 
+	// the partial results, the iterator results and the explained results are not cached
+	var cacheKey string
+	useCache := sd.searchCache.Enabled() && partialResultRequiredDataRatio >= 1.0 && !req.GetReq().GetIsIterator() && !req.GetReq().GetExplain()
+	dataVersion := sd.searchCache.DataVersion()
+	if useCache {
+		cacheKey, err = searchResultCacheKey(req.GetReq())
+		if err != nil {
+			mlog.Warn(ctx, "failed to generate search result cache key", mlog.Err(err))
+			useCache = false
+		} else if results, ok := sd.searchCache.Get(cacheKey, version, dataVersion, req.GetReq().GetMvccTimestamp()); ok {
+			mlog.Debug(ctx, "delegator search hit result cache")
+			return results, nil
+		}
+	}
+
 	if req.GetReq().GetIsAdvanced() {
 		futures := make([]*conc.Future[*internalpb.SearchResults], len(req.GetReq().GetSubReqs()))
 		for index, subReq := range req.GetReq().GetSubReqs() {
@@ -536,6 +559,8 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 				AnalyzerName:            subReq.GetAnalyzerName(),
 				PkFilter:                common.PkFilterNoPkFilter, // hybrid search sub-requests rarely have PK predicates, skip unmarshal
 				SearchType:              subReq.GetSearchType(),
+				SchemaVersion:           req.GetReq().SchemaVersion,
+				Explain:                 req.GetReq().GetExplain(),
 			}
 			future := conc.Go(func() (*internalpb.SearchResults, error) {
 				searchReq := &querypb.SearchRequest{
@@ -577,6 +602,9 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 			}
 			results[i] = result
 		}
+		if useCache {
+			sd.searchCache.Put(cacheKey, version, dataVersion, req.GetReq().GetMvccTimestamp(), results)
+		}
 		return results, nil
 	}
 
@@ -585,6 +613,9 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		mlog.Warn(ctx, "delegator common search failed", mlog.Err(err))
 		return nil, err
 	}
+	if useCache {
+		sd.searchCache.Put(cacheKey, version, dataVersion, req.GetReq().GetMvccTimestamp(), results)
+	}
 	return results, nil
 }
 
@@ -728,9 +759,12 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 
 	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
 		func() {
+			// the plan is pruned with the schema version it's planned with.
+			schema, schemaVersion := sd.collection.PinSchemaVersionOf(req.GetReq().SchemaVersion)
+			defer sd.collection.UnpinSchemaVersion(schemaVersion)
 			sd.partitionStatsMut.RLock()
 			defer sd.partitionStatsMut.RUnlock()
-			PruneSegments(ctx, sd.partitionStats, nil, req.GetReq(), sd.collection.Schema(), sealed, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
+			PruneSegments(ctx, sd.partitionStats, nil, req.GetReq(), schema, sealed, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
 		}()
 	}
 
@@ -1298,7 +1332,7 @@ func (sd *shardDelegator) UpdateSchema(ctx context.Context, schema *schemapb.Col
 
 	// Apply the local collection update with the same barrier used for remote
 	// workers. collectionManager keeps schema.Version as the logical freshness key.
-	if err := sd.collectionManager.UpdateSchema(sd.collectionID, schema, schemaBarrierTs); err != nil {
+	if err := sd.collectionManager.UpdateSchema(ctx, sd.collectionID, schema, schemaBarrierTs); err != nil {
 		newFunctionState.Close()
 		return err
 	}
@@ -1363,6 +1397,7 @@ func (sd *shardDelegator) Close() {
 
 	sd.functionState.Close()
 	sd.releaseFunctionRunners()
+	sd.searchCache.Release()
 
 	// clean up l0 segment in delete buffer
 	start := time.Now()
@@ -1371,6 +1406,7 @@ func (sd *shardDelegator) Close() {
 
 	metrics.QueryNodeDeleteBufferSize.DeleteLabelValues(paramtable.GetStringNodeID(), sd.vchannelName)
 	metrics.QueryNodeDeleteBufferRowNum.DeleteLabelValues(paramtable.GetStringNodeID(), sd.vchannelName)
+	metrics.QueryNodeDeleteBufferSpilledSize.DeleteLabelValues(paramtable.GetStringNodeID(), sd.vchannelName)
 	if sd.postLoadConfigHandler != nil {
 		paramtable.Get().Unwatch(paramtable.Get().QueryNodeCfg.DelegatorPostLoadConcurrencyFactor.Key, sd.postLoadConfigHandler)
 	}
@@ -1475,6 +1511,19 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 
 	sizePerBlock := paramtable.Get().QueryNodeCfg.DeleteBufferBlockSize.GetAsInt64()
 	log.Info(ctx, "Init delete cache with list delete buffer", mlog.Int64("sizePerBlock", sizePerBlock), mlog.Time("startTime", tsoutil.PhysicalTime(startTs)))
+	deleteBufferLabels := []string{paramtable.GetStringNodeID(), channel}
+	deleteBuffer := deletebuffer.NewListDeleteBuffer[*deletebuffer.Item](startTs, sizePerBlock, deleteBufferLabels)
+	if paramtable.Get().QueryNodeCfg.DeleteBufferSpillEnabled.GetAsBool() {
+		dir := path.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), "delete_buffer", paramtable.GetStringNodeID(), channel)
+		memoryLimit := paramtable.Get().QueryNodeCfg.DeleteBufferSpillMemoryLimit.GetAsInt64()
+		spillable, err := deletebuffer.NewSpillableListDeleteBuffer(startTs, sizePerBlock, deleteBufferLabels, dir, memoryLimit)
+		if err != nil {
+			log.Warn(ctx, "failed to init spillable delete buffer, fallback to memory only", mlog.Err(err))
+		} else {
+			log.Info(ctx, "delete buffer spill enabled", mlog.String("dir", dir), mlog.Int64("memoryLimit", memoryLimit))
+			deleteBuffer = spillable
+		}
+	}
 
 	excludedSegments := NewExcludedSegments(paramtable.Get().QueryNodeCfg.CleanExcludeSegInterval.GetAsDuration(time.Second))
 
@@ -1490,18 +1539,17 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 	})
 
 	sd := &shardDelegator{
-		collectionID:      collectionID,
-		replicaID:         replicaID,
-		vchannelName:      channel,
-		version:           version,
-		collection:        collection,
-		collectionManager: manager.Collection,
-		segmentManager:    manager.Segment,
-		workerManager:     workerManager,
-		lifetime:          lifetime.NewLifetime(lifetime.Initializing),
-		distribution:      NewDistribution(channel, queryView),
-		deleteBuffer: deletebuffer.NewListDeleteBuffer[*deletebuffer.Item](startTs, sizePerBlock,
-			[]string{paramtable.GetStringNodeID(), channel}),
+		collectionID:               collectionID,
+		replicaID:                  replicaID,
+		vchannelName:               channel,
+		version:                    version,
+		collection:                 collection,
+		collectionManager:          manager.Collection,
+		segmentManager:             manager.Segment,
+		workerManager:              workerManager,
+		lifetime:                   lifetime.NewLifetime(lifetime.Initializing),
+		distribution:               NewDistribution(channel, queryView),
+		deleteBuffer:               deleteBuffer,
 		latestTsafe:                atomic.NewUint64(startTs),
 		loader:                     loader,
 		queryHook:                  queryHook,
@@ -1513,8 +1561,11 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		postLoadConfigHandler:      postLoadConfigHandler,
 		catchingUpStreamingData:    atomic.NewBool(true),
 		latestRequiredMVCCTimeTick: atomic.NewUint64(0),
+		searchCache:                newSearchResultCache(),
 	}
 
+	sd.distribution.SetTargetSyncedCallback(sd.cleanDeleteBuffer)
+
 	functionState, err := buildFunctionRuntimeState(collection.Schema())
 	if err != nil {
 		return nil, err
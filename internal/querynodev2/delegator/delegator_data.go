@@ -97,6 +97,8 @@ func (d *DeleteData) Append(ad DeleteData) {
 
 // ProcessInsert handles insert data in delegator.
 func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
+	// the cached search results are invalid once the data is applied
+	defer sd.searchCache.Invalidate()
 	method := "ProcessInsert"
 	tr := timerecord.NewTimeRecorder(method)
 	log := sd.getLogger(context.Background())
@@ -204,15 +206,17 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		return
 	}
 
+	defer sd.searchCache.Invalidate()
 	method := "ProcessDelete"
 	tr := timerecord.NewTimeRecorder(method)
 	// block load segment handle delete buffer
 	sd.deleteMut.Lock()
 	defer sd.deleteMut.Unlock()
 
-	log := sd.getLogger(context.Background())
+	ctx := context.Background()
+	log := sd.getLogger(ctx)
 
-	log.Debug(context.TODO(), "start to process delete", mlog.Uint64("ts", ts))
+	log.Debug(ctx, "start to process delete", mlog.Uint64("ts", ts))
 	// add deleteData into buffer.
 	cacheItems := make([]deletebuffer.BufferItem, 0, len(deleteData))
 	for _, entry := range deleteData {
@@ -226,12 +230,12 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		})
 	}
 
-	sd.deleteBuffer.Put(&deletebuffer.Item{
+	sd.deleteBuffer.Put(ctx, &deletebuffer.Item{
 		Ts:   ts,
 		Data: cacheItems,
 	})
 
-	sd.forwardStreamingDeletion(context.Background(), deleteData)
+	sd.forwardStreamingDeletion(ctx, deleteData)
 
 	metrics.QueryNodeProcessCost.WithLabelValues(paramtable.GetStringNodeID(), metrics.DeleteLabel).
 		Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -374,6 +378,7 @@ func (sd *shardDelegator) addGrowing(entries ...SegmentEntry) {
 
 // LoadGrowing load growing segments locally.
 func (sd *shardDelegator) LoadGrowing(ctx context.Context, infos []*querypb.SegmentLoadInfo, version int64) error {
+	defer sd.searchCache.Invalidate()
 	log := sd.getLogger(ctx)
 
 	segmentIDs := lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) int64 { return info.GetSegmentID() })
@@ -531,7 +536,7 @@ func (sd *shardDelegator) syncCollectionIndexMeta(ctx context.Context, req *quer
 	}
 
 	meta := segments.ComposeIndexMeta(ctx, req.GetIndexInfoList(), schema)
-	if err := sd.collectionManager.PutOrRef(req.GetCollectionID(), schema, meta, loadMeta); err != nil {
+	if err := sd.collectionManager.PutOrRef(ctx, req.GetCollectionID(), schema, meta, loadMeta); err != nil {
 		return err
 	}
 	sd.collectionManager.Unref(req.GetCollectionID(), 1)
@@ -543,6 +548,7 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 	if len(req.GetInfos()) == 0 {
 		return nil
 	}
+	defer sd.searchCache.Invalidate()
 
 	log := sd.getLogger(ctx)
 
@@ -716,6 +722,7 @@ func (sd *shardDelegator) addDistributionIfSchemaBarrierOK(schemaBarrierTs uint6
 
 // LoadGrowing load growing segments locally.
 func (sd *shardDelegator) LoadL0(ctx context.Context, infos []*querypb.SegmentLoadInfo, version int64) error {
+	defer sd.searchCache.Invalidate()
 	log := sd.getLogger(ctx)
 
 	segmentIDs := lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) int64 { return info.GetSegmentID() })
@@ -942,7 +949,12 @@ func (sd *shardDelegator) loadStreamDelete(ctx context.Context,
 	sd.deleteMut.RLock()
 	snapshots := make([]segDeleteSnapshot, len(infos))
 	for i, info := range infos {
-		records := sd.deleteBuffer.ListAfter(segmentEffectiveTs(info))
+		// the spilled records are filtered by the bloom filter of the segment when read from disk
+		records, err := sd.deleteBuffer.ListAfterFor(ctx, segmentEffectiveTs(info), idCandidates[info.GetSegmentID()])
+		if err != nil {
+			sd.deleteMut.RUnlock()
+			return err
+		}
 		// Copy the slice to safely use outside lock scope.
 		// ListAfter returns a new slice from doubleCacheBuffer, but we copy to
 		// ensure no dependency on internal buffer state that may change after unlock.
@@ -1009,7 +1021,10 @@ func (sd *shardDelegator) loadStreamDelete(ctx context.Context,
 		if snapshots[i].snapshotMaxTs > 0 {
 			catchUpTs = snapshots[i].snapshotMaxTs + 1
 		}
-		newRecords := sd.deleteBuffer.ListAfter(catchUpTs)
+		newRecords, err := sd.deleteBuffer.ListAfterFor(ctx, catchUpTs, candidate)
+		if err != nil {
+			return err
+		}
 		if len(newRecords) > 0 {
 			start := time.Now()
 			tsHit, bfHit, err := sd.processDeleteRecords(candidate, newRecords, forwarders[i])
@@ -1043,6 +1058,7 @@ func (sd *shardDelegator) loadStreamDelete(ctx context.Context,
 
 // ReleaseSegments releases segments local or remotely depending on the target node.
 func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest, force bool) error {
+	defer sd.searchCache.Invalidate()
 	log := sd.getLogger(ctx)
 
 	targetNodeID := req.GetNodeID()
@@ -1136,7 +1152,16 @@ func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.Rele
 }
 
 func (sd *shardDelegator) SyncTargetVersion(action *querypb.SyncAction, partitions []int64) {
-	sd.distribution.SyncTargetVersion(action, partitions)
+	defer sd.searchCache.Invalidate()
+	// the delete buffer is still needed by the segments to load if the new query view is deferred,
+	// it's cleaned by the target synced callback of the distribution when the deferred sync is applied.
+	if sd.distribution.SyncTargetVersion(action, partitions) {
+		sd.cleanDeleteBuffer(action)
+	}
+}
+
+// cleanDeleteBuffer cleans the delete buffer by the delete checkpoint of the applied sync action.
+func (sd *shardDelegator) cleanDeleteBuffer(action *querypb.SyncAction) {
 	// clean delete buffer after distribution becomes serviceable
 	if sd.distribution.queryView.Serviceable() {
 		checkpoint := action.GetCheckpoint()
@@ -136,7 +136,7 @@ func (s *DelegatorDataSuite) TearDownSuite() {
 }
 
 func (s *DelegatorDataSuite) genNormalCollection() {
-	s.manager.Collection.PutOrRef(s.collectionID, &schemapb.CollectionSchema{
+	s.manager.Collection.PutOrRef(context.Background(), s.collectionID, &schemapb.CollectionSchema{
 		Name: "TestCollection",
 		Fields: []*schemapb.FieldSchema{
 			{
@@ -192,7 +192,7 @@ func (s *DelegatorDataSuite) genNormalCollection() {
 }
 
 func (s *DelegatorDataSuite) genTextCollection() {
-	s.manager.Collection.PutOrRef(s.collectionID, &schemapb.CollectionSchema{
+	s.manager.Collection.PutOrRef(context.Background(), s.collectionID, &schemapb.CollectionSchema{
 		Name: "TestTextCollection",
 		Fields: []*schemapb.FieldSchema{
 			{
@@ -226,7 +226,7 @@ func (s *DelegatorDataSuite) genTextCollection() {
 }
 
 func (s *DelegatorDataSuite) genCollectionWithFunction() {
-	s.manager.Collection.PutOrRef(s.collectionID, &schemapb.CollectionSchema{
+	s.manager.Collection.PutOrRef(context.Background(), s.collectionID, &schemapb.CollectionSchema{
 		Name:    "TestCollection",
 		Version: 1,
 		Fields: []*schemapb.FieldSchema{
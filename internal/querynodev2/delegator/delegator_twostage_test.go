@@ -74,7 +74,7 @@ func (s *TwoStageSearchSuite) SetupTest() {
 	s.rootPath = "delegator_twostage_test"
 
 	// init schema
-	s.manager.Collection.PutOrRef(s.collectionID, &schemapb.CollectionSchema{
+	s.manager.Collection.PutOrRef(context.Background(), s.collectionID, &schemapb.CollectionSchema{
 		Name: "TestCollection",
 		Fields: []*schemapb.FieldSchema{
 			{
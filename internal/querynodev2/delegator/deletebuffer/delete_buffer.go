@@ -38,8 +38,13 @@ type timed interface {
 
 // DeleteBuffer is the interface for delete buffer.
 type DeleteBuffer[T timed] interface {
-	Put(T)
-	ListAfter(uint64) []T
+	Put(ctx context.Context, entry T)
+	// ListAfter returns the entries after ts,
+	// an error is returned if the spilled entries can't be read back.
+	ListAfter(ctx context.Context, ts uint64) ([]T, error)
+	// ListAfterFor returns the entries after ts which might delete the rows of the candidate,
+	// the entries in memory are not filtered.
+	ListAfterFor(ctx context.Context, ts uint64, candidate PkCandidate) ([]T, error)
 	SafeTs() uint64
 	TryDiscard(uint64)
 	// Size returns current size information of delete buffer: entryNum and memory
@@ -148,7 +153,7 @@ func (c *doubleCacheBuffer[T]) TryDiscard(_ uint64) {
 }
 
 // Put implements DeleteBuffer.
-func (c *doubleCacheBuffer[T]) Put(entry T) {
+func (c *doubleCacheBuffer[T]) Put(_ context.Context, entry T) {
 	c.mut.Lock()
 	defer c.mut.Unlock()
 
@@ -159,7 +164,7 @@ func (c *doubleCacheBuffer[T]) Put(entry T) {
 }
 
 // ListAfter implements DeleteBuffer.
-func (c *doubleCacheBuffer[T]) ListAfter(ts uint64) []T {
+func (c *doubleCacheBuffer[T]) ListAfter(_ context.Context, ts uint64) ([]T, error) {
 	c.mut.RLock()
 	defer c.mut.RUnlock()
 	var result []T
@@ -169,7 +174,12 @@ func (c *doubleCacheBuffer[T]) ListAfter(ts uint64) []T {
 	if c.head != nil {
 		result = append(result, c.head.ListAfter(ts)...)
 	}
-	return result
+	return result, nil
+}
+
+// ListAfterFor implements DeleteBuffer.
+func (c *doubleCacheBuffer[T]) ListAfterFor(ctx context.Context, ts uint64, _ PkCandidate) ([]T, error) {
+	return c.ListAfter(ctx, ts)
 }
 
 func (c *doubleCacheBuffer[T]) Size() (entryNum int64, memorySize int64) {
@@ -227,6 +237,8 @@ type cacheBlock[T timed] struct {
 	maxSize  int64
 
 	data []T
+	// spilled is not nil if the data is spilled to disk
+	spilled *spilledBlock
 }
 
 // Cache adds entry into cache item.
@@ -17,10 +17,12 @@
 package deletebuffer
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
@@ -28,6 +30,13 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 )
 
+// mustListAfter lists the entries after ts of the buffer, the test fails if the entries can't be listed.
+func mustListAfter[T timed](t *testing.T, buffer DeleteBuffer[T], ts uint64) []T {
+	result, err := buffer.ListAfter(context.Background(), ts)
+	require.NoError(t, err)
+	return result
+}
+
 func TestSkipListDeleteBuffer(t *testing.T) {
 	db := NewDeleteBuffer()
 
@@ -57,7 +66,7 @@ func (s *DoubleCacheBufferSuite) TestNewBuffer() {
 
 func (s *DoubleCacheBufferSuite) TestCache() {
 	buffer := NewDoubleCacheDeleteBuffer[*Item](10, 1000)
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 11,
 		Data: []BufferItem{
 			{
@@ -67,7 +76,7 @@ func (s *DoubleCacheBufferSuite) TestCache() {
 		},
 	})
 
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 12,
 		Data: []BufferItem{
 			{
@@ -77,13 +86,13 @@ func (s *DoubleCacheBufferSuite) TestCache() {
 		},
 	})
 
-	s.Equal(2, len(buffer.ListAfter(11)))
-	s.Equal(1, len(buffer.ListAfter(12)))
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 11)))
+	s.Equal(1, len(mustListAfter(s.T(), buffer, 12)))
 }
 
 func (s *DoubleCacheBufferSuite) TestPut() {
 	buffer := NewDoubleCacheDeleteBuffer[*Item](10, 1)
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 11,
 		Data: []BufferItem{
 			{
@@ -97,7 +106,7 @@ func (s *DoubleCacheBufferSuite) TestPut() {
 		},
 	})
 
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 12,
 		Data: []BufferItem{
 			{
@@ -111,13 +120,13 @@ func (s *DoubleCacheBufferSuite) TestPut() {
 		},
 	})
 
-	s.Equal(2, len(buffer.ListAfter(11)))
-	s.Equal(1, len(buffer.ListAfter(12)))
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 11)))
+	s.Equal(1, len(mustListAfter(s.T(), buffer, 12)))
 	entryNum, memorySize := buffer.Size()
 	s.EqualValues(2, entryNum)
 	s.EqualValues(234, memorySize)
 
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 13,
 		Data: []BufferItem{
 			{
@@ -131,9 +140,9 @@ func (s *DoubleCacheBufferSuite) TestPut() {
 		},
 	})
 
-	s.Equal(2, len(buffer.ListAfter(11)))
-	s.Equal(2, len(buffer.ListAfter(12)))
-	s.Equal(1, len(buffer.ListAfter(13)))
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 11)))
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 12)))
+	s.Equal(1, len(mustListAfter(s.T(), buffer, 13)))
 	entryNum, memorySize = buffer.Size()
 	s.EqualValues(2, entryNum)
 	s.EqualValues(234, memorySize)
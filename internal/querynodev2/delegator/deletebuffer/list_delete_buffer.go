@@ -39,6 +39,18 @@ func NewListDeleteBuffer[T timed](startTs uint64, sizePerBlock int64, labels []s
 	}
 }
 
+// NewSpillableListDeleteBuffer creates a list delete buffer,
+// which spills the earliest blocks to dir once the memory size exceeds memoryLimit.
+func NewSpillableListDeleteBuffer(startTs uint64, sizePerBlock int64, labels []string, dir string, memoryLimit int64) (DeleteBuffer[*Item], error) {
+	spiller, err := newBlockSpiller[*Item](dir, memoryLimit, itemSpillCodec{})
+	if err != nil {
+		return nil, err
+	}
+	buffer := NewListDeleteBuffer[*Item](startTs, sizePerBlock, labels).(*listDeleteBuffer[*Item])
+	buffer.spiller = spiller
+	return buffer, nil
+}
+
 // listDeleteBuffer implements DeleteBuffer with a list.
 // head points to the earliest block.
 // tail points to the latest block which shall be written into.
@@ -53,6 +65,11 @@ type listDeleteBuffer[T timed] struct {
 	// cached metrics
 	rowNum int64
 	size   int64
+	// size of the spilled blocks, not counted in size
+	spilledSize int64
+
+	// spiller is nil if spilling is disabled
+	spiller *blockSpiller[T]
 
 	// metrics labels
 	labels []string
@@ -124,15 +141,24 @@ func (b *listDeleteBuffer[T]) Clear() {
 
 	// reset cache block
 	b.list = []*cacheBlock[T]{newCacheBlock[T](b.safeTs, b.sizePerBlock)}
+	if b.spiller != nil {
+		if err := b.spiller.Clear(); err != nil {
+			mlog.Warn(context.TODO(), "failed to clear spilled delete buffer", mlog.Err(err))
+		}
+		b.spilledSize = 0
+	}
 	b.updateMetrics()
 }
 
 func (b *listDeleteBuffer[T]) updateMetrics() {
 	metrics.QueryNodeDeleteBufferRowNum.WithLabelValues(b.labels...).Set(float64(b.rowNum))
 	metrics.QueryNodeDeleteBufferSize.WithLabelValues(b.labels...).Set(float64(b.size))
+	if b.spiller != nil {
+		metrics.QueryNodeDeleteBufferSpilledSize.WithLabelValues(b.labels...).Set(float64(b.spilledSize))
+	}
 }
 
-func (b *listDeleteBuffer[T]) Put(entry T) {
+func (b *listDeleteBuffer[T]) Put(ctx context.Context, entry T) {
 	b.mut.Lock()
 	defer b.mut.Unlock()
 
@@ -145,18 +171,76 @@ func (b *listDeleteBuffer[T]) Put(entry T) {
 	// update metrics
 	b.rowNum += entry.EntryNum()
 	b.size += entry.Size()
+	b.trySpill(ctx)
 	b.updateMetrics()
 }
 
-func (b *listDeleteBuffer[T]) ListAfter(ts uint64) []T {
+// trySpill spills the earliest blocks to disk until the memory size is under the limit,
+// the tail block is not spilled since it's still being written.
+func (b *listDeleteBuffer[T]) trySpill(ctx context.Context) {
+	if b.spiller == nil {
+		return
+	}
+	for _, block := range b.list[:len(b.list)-1] {
+		if b.size <= b.spiller.memoryLimit {
+			return
+		}
+		_, memSize := block.Size()
+		if block.spilled != nil || memSize == 0 {
+			continue
+		}
+		if err := b.spiller.Spill(block); err != nil {
+			mlog.Warn(ctx, "failed to spill delete buffer block", mlog.Err(err))
+			return
+		}
+		b.size -= memSize
+		b.spilledSize += memSize
+	}
+}
+
+func (b *listDeleteBuffer[T]) ListAfter(ctx context.Context, ts uint64) ([]T, error) {
+	return b.ListAfterFor(ctx, ts, nil)
+}
+
+func (b *listDeleteBuffer[T]) ListAfterFor(ctx context.Context, ts uint64, candidate PkCandidate) ([]T, error) {
 	b.mut.RLock()
 	defer b.mut.RUnlock()
 
 	var result []T
-	for _, block := range b.list {
+	for idx, block := range b.list {
+		if block.spilled != nil {
+			spilled, err := b.readSpilled(ctx, idx, ts, candidate)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, spilled...)
+			continue
+		}
 		result = append(result, block.ListAfter(ts)...)
 	}
-	return result
+	return result, nil
+}
+
+// readSpilled reads the entries after ts of the spilled block at idx,
+// the entries are filtered by the candidate if it's not nil.
+// The deletes can't be dropped silently, so the read error is returned to fail the request or the load.
+func (b *listDeleteBuffer[T]) readSpilled(ctx context.Context, idx int, ts uint64, candidate PkCandidate) ([]T, error) {
+	// all the entries of the block are before the head of the next block
+	if idx+1 < len(b.list) && b.list[idx+1].headTs <= ts {
+		return nil, nil
+	}
+	var result []T
+	var err error
+	if candidate != nil {
+		result, err = b.spiller.ReadFor(b.list[idx], ts, candidate)
+	} else {
+		result, err = b.spiller.Read(b.list[idx], ts)
+	}
+	if err != nil {
+		mlog.Warn(ctx, "failed to read spilled delete buffer", mlog.Err(err))
+		return nil, errors.Wrap(err, "failed to read spilled delete buffer")
+	}
+	return result, nil
 }
 
 func (b *listDeleteBuffer[T]) SafeTs() uint64 {
@@ -191,12 +275,37 @@ func (b *listDeleteBuffer[T]) tryCleanDelete(ts uint64) {
 		for idx := 0; idx < nextHead; idx++ {
 			rowNum, memSize := b.list[idx].Size()
 			b.rowNum -= rowNum
-			b.size -= memSize
+			if b.list[idx].spilled != nil {
+				if err := b.spiller.Remove(b.list[idx]); err != nil {
+					mlog.Warn(context.TODO(), "failed to remove spilled delete buffer block", mlog.Err(err))
+				}
+				b.spilledSize -= memSize
+			} else {
+				b.size -= memSize
+			}
 			b.list[idx] = nil
 		}
 		b.list = b.list[nextHead:]
 		b.updateMetrics()
 	}
+	b.tryCompactSpilled(ts)
+}
+
+// tryCompactSpilled drops the entries before ts of the spilled head block,
+// which are no longer needed once the L0 segments before ts are applied.
+func (b *listDeleteBuffer[T]) tryCompactSpilled(ts uint64) {
+	head := b.list[0]
+	if head.spilled == nil || head.headTs >= ts {
+		return
+	}
+	rowNum, memSize, err := b.spiller.Compact(head, ts)
+	if err != nil {
+		mlog.Warn(context.TODO(), "failed to compact spilled delete buffer block", mlog.Err(err))
+		return
+	}
+	b.rowNum -= rowNum
+	b.spilledSize -= memSize
+	b.updateMetrics()
 }
 
 // check if any records is pinned before the cleanTs
@@ -17,6 +17,7 @@
 package deletebuffer
 
 import (
+	"context"
 	"sync"
 	"testing"
 
@@ -45,7 +46,7 @@ func (s *ListDeleteBufferSuite) TestNewBuffer() {
 
 func (s *ListDeleteBufferSuite) TestCache() {
 	buffer := NewListDeleteBuffer[*Item](10, 1000, []string{"1", "dml-1"})
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 11,
 		Data: []BufferItem{
 			{
@@ -55,7 +56,7 @@ func (s *ListDeleteBufferSuite) TestCache() {
 		},
 	})
 
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 12,
 		Data: []BufferItem{
 			{
@@ -65,8 +66,8 @@ func (s *ListDeleteBufferSuite) TestCache() {
 		},
 	})
 
-	s.Equal(2, len(buffer.ListAfter(11)))
-	s.Equal(1, len(buffer.ListAfter(12)))
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 11)))
+	s.Equal(1, len(mustListAfter(s.T(), buffer, 12)))
 	entryNum, memorySize := buffer.Size()
 	s.EqualValues(0, entryNum)
 	s.EqualValues(192, memorySize)
@@ -74,7 +75,7 @@ func (s *ListDeleteBufferSuite) TestCache() {
 
 func (s *ListDeleteBufferSuite) TestTryDiscard() {
 	buffer := NewListDeleteBuffer[*Item](10, 1, []string{"1", "dml-1"})
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 10,
 		Data: []BufferItem{
 			{
@@ -88,7 +89,7 @@ func (s *ListDeleteBufferSuite) TestTryDiscard() {
 		},
 	})
 
-	buffer.Put(&Item{
+	buffer.Put(context.Background(), &Item{
 		Ts: 20,
 		Data: []BufferItem{
 			{
@@ -102,31 +103,31 @@ func (s *ListDeleteBufferSuite) TestTryDiscard() {
 		},
 	})
 
-	s.Equal(2, len(buffer.ListAfter(10)))
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 10)))
 	entryNum, memorySize := buffer.Size()
 	s.EqualValues(2, entryNum)
 	s.EqualValues(240, memorySize)
 
 	buffer.TryDiscard(10)
-	s.Equal(2, len(buffer.ListAfter(10)), "equal ts shall not discard block")
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 10)), "equal ts shall not discard block")
 	entryNum, memorySize = buffer.Size()
 	s.EqualValues(2, entryNum)
 	s.EqualValues(240, memorySize)
 
 	buffer.TryDiscard(9)
-	s.Equal(2, len(buffer.ListAfter(10)), "history ts shall not discard any block")
+	s.Equal(2, len(mustListAfter(s.T(), buffer, 10)), "history ts shall not discard any block")
 	entryNum, memorySize = buffer.Size()
 	s.EqualValues(2, entryNum)
 	s.EqualValues(240, memorySize)
 
 	buffer.TryDiscard(20)
-	s.Equal(1, len(buffer.ListAfter(10)), "first block shall be discarded")
+	s.Equal(1, len(mustListAfter(s.T(), buffer, 10)), "first block shall be discarded")
 	entryNum, memorySize = buffer.Size()
 	s.EqualValues(1, entryNum)
 	s.EqualValues(120, memorySize)
 
 	buffer.TryDiscard(20)
-	s.Equal(1, len(buffer.ListAfter(10)), "discard will not happen if there is only one block")
+	s.Equal(1, len(mustListAfter(s.T(), buffer, 10)), "discard will not happen if there is only one block")
 	s.EqualValues(1, entryNum)
 	s.EqualValues(120, memorySize)
 }
@@ -239,9 +240,9 @@ func TestListDeleteBuffer_PinUnpinBasic(t *testing.T) {
 		},
 	}}
 
-	buffer.Put(item1)
-	buffer.Put(item2)
-	buffer.Put(item3)
+	buffer.Put(context.Background(), item1)
+	buffer.Put(context.Background(), item2)
+	buffer.Put(context.Background(), item3)
 
 	// Verify initial state
 	entryNum, _ := buffer.Size()
@@ -296,8 +297,8 @@ func TestListDeleteBuffer_MultipleSegmentsPinSameTimestamp(t *testing.T) {
 		},
 	}}
 
-	buffer.Put(item1)
-	buffer.Put(item2)
+	buffer.Put(context.Background(), item1)
+	buffer.Put(context.Background(), item2)
 
 	// Multiple segments pin the same timestamp
 	buffer.Pin(1500, 123) // Protects data after 1500
@@ -370,9 +371,9 @@ func TestListDeleteBuffer_PinAfterCleanTs(t *testing.T) {
 		},
 	}}
 
-	buffer.Put(item1)
-	buffer.Put(item2)
-	buffer.Put(item3)
+	buffer.Put(context.Background(), item1)
+	buffer.Put(context.Background(), item2)
+	buffer.Put(context.Background(), item3)
 
 	// Pin a timestamp that is AFTER the cleanTs
 	buffer.Pin(2500, 123) // Protects data after 2500
@@ -408,7 +409,7 @@ func TestListDeleteBuffer_EdgeCases(t *testing.T) {
 		},
 	}}
 
-	buffer.Put(item1)
+	buffer.Put(context.Background(), item1)
 
 	// Pin timestamp equal to data timestamp
 	buffer.Pin(1000, 123)
@@ -464,8 +465,8 @@ func TestListDeleteBuffer_PinUnpinConcurrent(t *testing.T) {
 			},
 		},
 	}}
-	buffer.Put(item1)
-	buffer.Put(item2)
+	buffer.Put(context.Background(), item1)
+	buffer.Put(context.Background(), item2)
 
 	// Test concurrent pin operations
 	var wg sync.WaitGroup
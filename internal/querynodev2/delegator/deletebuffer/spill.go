@@ -0,0 +1,355 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletebuffer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// PkCandidate is the pk oracle of the segment which the delete records are read for.
+type PkCandidate interface {
+	Partition() int64
+	PkCandidateExist() bool
+	GetMinPk() *storage.PrimaryKey
+	GetMaxPk() *storage.PrimaryKey
+	BatchPkExist(lc *storage.BatchLocationsCache) []bool
+}
+
+// spillSummary is kept in memory for each spilled block,
+// so the blocks unrelated to the candidate are skipped without reading from disk.
+type spillSummary struct {
+	partitions typeutil.UniqueSet
+	minPK      storage.PrimaryKey
+	maxPK      storage.PrimaryKey
+}
+
+// mightContain returns whether the block might contain the deletes of the candidate.
+func (s *spillSummary) mightContain(candidate PkCandidate) bool {
+	if !s.partitions.Contain(common.AllPartitionsID) && !s.partitions.Contain(candidate.Partition()) {
+		return false
+	}
+	if !candidate.PkCandidateExist() || s.minPK == nil || s.maxPK == nil {
+		return true
+	}
+	minPk, maxPk := candidate.GetMinPk(), candidate.GetMaxPk()
+	if minPk == nil || maxPk == nil {
+		return true
+	}
+	return !s.maxPK.LT(*minPk) && !s.minPK.GT(*maxPk)
+}
+
+// spillCodec encodes the entries of the spilled blocks.
+type spillCodec[T timed] interface {
+	Encode(entries []T) ([]byte, error)
+	Decode(data []byte) ([]T, error)
+	Summarize(entries []T) *spillSummary
+	// Filter returns the entries which might delete the rows of the candidate by its bloom filter.
+	Filter(entries []T, candidate PkCandidate) []T
+}
+
+// spilledBlock records where the entries of a spilled cache block are stored.
+type spilledBlock struct {
+	file    string
+	summary *spillSummary
+}
+
+// blockSpiller spills the cache blocks of the delete buffer to local disk,
+// once the memory size of the delete buffer exceeds the limit.
+type blockSpiller[T timed] struct {
+	dir         string
+	memoryLimit int64
+	codec       spillCodec[T]
+	nextID      int64
+}
+
+func newBlockSpiller[T timed](dir string, memoryLimit int64, codec spillCodec[T]) (*blockSpiller[T], error) {
+	// the spilled files of the previous run are useless
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &blockSpiller[T]{
+		dir:         dir,
+		memoryLimit: memoryLimit,
+		codec:       codec,
+	}, nil
+}
+
+// Spill writes the entries of the block to disk and releases them from memory.
+func (s *blockSpiller[T]) Spill(block *cacheBlock[T]) error {
+	block.mut.Lock()
+	defer block.mut.Unlock()
+
+	data, err := s.codec.Encode(block.data)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return err
+	}
+	s.nextID++
+	file := path.Join(s.dir, fmt.Sprintf("%d-%d", block.headTs, s.nextID))
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		return err
+	}
+	block.spilled = &spilledBlock{
+		file:    file,
+		summary: s.codec.Summarize(block.data),
+	}
+	block.data = nil
+	return nil
+}
+
+// Read reads the entries of the spilled block of which ts after provided value.
+func (s *blockSpiller[T]) Read(block *cacheBlock[T], ts uint64) ([]T, error) {
+	block.mut.RLock()
+	defer block.mut.RUnlock()
+
+	data, err := os.ReadFile(block.spilled.file)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.codec.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	idx := sort.Search(len(entries), func(idx int) bool {
+		return entries[idx].Timestamp() >= ts
+	})
+	return entries[idx:], nil
+}
+
+// ReadFor reads the entries of the spilled block of which ts after provided value,
+// and which might delete the rows of the candidate.
+func (s *blockSpiller[T]) ReadFor(block *cacheBlock[T], ts uint64, candidate PkCandidate) ([]T, error) {
+	if !block.spilled.summary.mightContain(candidate) {
+		return nil, nil
+	}
+	entries, err := s.Read(block, ts)
+	if err != nil {
+		return nil, err
+	}
+	return s.codec.Filter(entries, candidate), nil
+}
+
+// Compact drops the entries of the spilled block before ts,
+// the block is loaded back into memory if all its entries are dropped.
+// returns the entry number and memory size dropped.
+func (s *blockSpiller[T]) Compact(block *cacheBlock[T], ts uint64) (entryNum, memorySize int64, err error) {
+	entries, err := s.Read(block, ts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	block.mut.Lock()
+	defer block.mut.Unlock()
+	var remainEntryNum, remainSize int64
+	for _, entry := range entries {
+		remainEntryNum += entry.EntryNum()
+		remainSize += entry.Size()
+	}
+	if remainEntryNum == block.entryNum {
+		return 0, 0, nil
+	}
+	entryNum, memorySize = block.entryNum-remainEntryNum, block.size-remainSize
+
+	if len(entries) == 0 {
+		if err := os.Remove(block.spilled.file); err != nil && !os.IsNotExist(err) {
+			return 0, 0, err
+		}
+		block.spilled = nil
+	} else {
+		data, err := s.codec.Encode(entries)
+		if err != nil {
+			return 0, 0, err
+		}
+		// write to a new file and rename, the block is readable at any time
+		tmp := block.spilled.file + ".tmp"
+		if err := os.WriteFile(tmp, data, 0o600); err != nil {
+			return 0, 0, err
+		}
+		if err := os.Rename(tmp, block.spilled.file); err != nil {
+			return 0, 0, err
+		}
+		block.spilled.summary = s.codec.Summarize(entries)
+		block.headTs = entries[0].Timestamp()
+	}
+	block.entryNum, block.size = remainEntryNum, remainSize
+	return entryNum, memorySize, nil
+}
+
+// Remove removes the spilled file of the block.
+func (s *blockSpiller[T]) Remove(block *cacheBlock[T]) error {
+	if err := os.Remove(block.spilled.file); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Clear removes all the spilled files.
+func (s *blockSpiller[T]) Clear() error {
+	return os.RemoveAll(s.dir)
+}
+
+// itemSpillCodec encodes the delete items,
+// each item is encoded as its timestamp, the record number and the length-prefixed records.
+type itemSpillCodec struct{}
+
+var _ spillCodec[*Item] = itemSpillCodec{}
+
+func (itemSpillCodec) Encode(entries []*Item) ([]byte, error) {
+	var buf []byte
+	for _, entry := range entries {
+		buf = binary.AppendUvarint(buf, entry.Ts)
+		buf = binary.AppendUvarint(buf, uint64(len(entry.Data)))
+		for _, record := range entry.Data {
+			bs, err := proto.Marshal(&msgpb.DeleteRequest{
+				PartitionID: record.PartitionID,
+				PrimaryKeys: storage.ParsePrimaryKeys2IDs(record.DeleteData.Pks),
+				Timestamps:  record.DeleteData.Tss,
+				NumRows:     record.DeleteData.RowCount,
+			})
+			if err != nil {
+				return nil, err
+			}
+			buf = binary.AppendUvarint(buf, uint64(len(bs)))
+			buf = append(buf, bs...)
+		}
+	}
+	return buf, nil
+}
+
+func (itemSpillCodec) Decode(data []byte) ([]*Item, error) {
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, merr.WrapErrServiceInternal("corrupted spilled delete buffer")
+		}
+		data = data[n:]
+		return v, nil
+	}
+
+	var entries []*Item
+	for len(data) > 0 {
+		ts, err := readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		num, err := readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		// each record takes one byte for its length at least, so a larger num is corrupted.
+		if num > uint64(len(data)) {
+			return nil, merr.WrapErrServiceInternal("corrupted spilled delete buffer")
+		}
+		item := &Item{Ts: ts, Data: make([]BufferItem, 0, num)}
+		for i := uint64(0); i < num; i++ {
+			length, err := readUvarint()
+			if err != nil {
+				return nil, err
+			}
+			if uint64(len(data)) < length {
+				return nil, merr.WrapErrServiceInternal("corrupted spilled delete buffer")
+			}
+			record := &msgpb.DeleteRequest{}
+			if err := proto.Unmarshal(data[:length], record); err != nil {
+				return nil, err
+			}
+			data = data[length:]
+			item.Data = append(item.Data, BufferItem{
+				PartitionID: record.GetPartitionID(),
+				DeleteData: storage.DeleteData{
+					Pks:      storage.ParseIDs2PrimaryKeys(record.GetPrimaryKeys()),
+					Tss:      record.GetTimestamps(),
+					RowCount: record.GetNumRows(),
+				},
+			})
+		}
+		entries = append(entries, item)
+	}
+	return entries, nil
+}
+
+func (itemSpillCodec) Summarize(entries []*Item) *spillSummary {
+	summary := &spillSummary{partitions: typeutil.NewUniqueSet()}
+	for _, entry := range entries {
+		for _, record := range entry.Data {
+			summary.partitions.Insert(record.PartitionID)
+			for _, pk := range record.DeleteData.Pks {
+				if summary.minPK == nil || pk.LT(summary.minPK) {
+					summary.minPK = pk
+				}
+				if summary.maxPK == nil || pk.GT(summary.maxPK) {
+					summary.maxPK = pk
+				}
+			}
+		}
+	}
+	return summary
+}
+
+func (itemSpillCodec) Filter(entries []*Item, candidate PkCandidate) []*Item {
+	if !candidate.PkCandidateExist() {
+		return entries
+	}
+	batchSize := paramtable.Get().CommonCfg.BloomFilterApplyBatchSize.GetAsInt()
+	result := make([]*Item, 0, len(entries))
+	for _, entry := range entries {
+		item := &Item{Ts: entry.Ts}
+		for _, record := range entry.Data {
+			if record.PartitionID != common.AllPartitionsID && record.PartitionID != candidate.Partition() {
+				continue
+			}
+			pks := record.DeleteData.Pks
+			filtered := BufferItem{PartitionID: record.PartitionID}
+			for idx := 0; idx < len(pks); idx += batchSize {
+				endIdx := min(idx+batchSize, len(pks))
+				hits := candidate.BatchPkExist(storage.NewBatchLocationsCache(pks[idx:endIdx]))
+				for i, hit := range hits {
+					if hit {
+						filtered.DeleteData.Pks = append(filtered.DeleteData.Pks, pks[idx+i])
+						filtered.DeleteData.Tss = append(filtered.DeleteData.Tss, record.DeleteData.Tss[idx+i])
+					}
+				}
+			}
+			if len(filtered.DeleteData.Pks) > 0 {
+				filtered.DeleteData.RowCount = int64(len(filtered.DeleteData.Pks))
+				item.Data = append(item.Data, filtered)
+			}
+		}
+		if len(item.Data) > 0 {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletebuffer
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type fakePkCandidate struct {
+	partitionID int64
+	pks         []storage.PrimaryKey
+}
+
+func (c *fakePkCandidate) Partition() int64 {
+	return c.partitionID
+}
+
+func (c *fakePkCandidate) PkCandidateExist() bool {
+	return true
+}
+
+func (c *fakePkCandidate) GetMinPk() *storage.PrimaryKey {
+	return &c.pks[0]
+}
+
+func (c *fakePkCandidate) GetMaxPk() *storage.PrimaryKey {
+	return &c.pks[len(c.pks)-1]
+}
+
+func (c *fakePkCandidate) BatchPkExist(lc *storage.BatchLocationsCache) []bool {
+	return lo.Map(lc.PKs(), func(pk storage.PrimaryKey, _ int) bool {
+		return lo.ContainsBy(c.pks, func(candidate storage.PrimaryKey) bool { return candidate.EQ(pk) })
+	})
+}
+
+type SpillDeleteBufferSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (s *SpillDeleteBufferSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *SpillDeleteBufferSuite) SetupTest() {
+	s.dir = path.Join(s.T().TempDir(), "delete_buffer")
+}
+
+func (s *SpillDeleteBufferSuite) newItem(ts uint64, partitionID int64, pk int64) *Item {
+	return &Item{
+		Ts: ts,
+		Data: []BufferItem{
+			{
+				PartitionID: partitionID,
+				DeleteData: storage.DeleteData{
+					Pks:      []storage.PrimaryKey{storage.NewInt64PrimaryKey(pk)},
+					Tss:      []uint64{ts},
+					RowCount: 1,
+				},
+			},
+		},
+	}
+}
+
+func (s *SpillDeleteBufferSuite) spilledFiles() []string {
+	entries, err := os.ReadDir(s.dir)
+	s.Require().NoError(err)
+	return lo.Map(entries, func(entry os.DirEntry, _ int) string { return entry.Name() })
+}
+
+func (s *SpillDeleteBufferSuite) TestCodec() {
+	codec := itemSpillCodec{}
+	items := []*Item{
+		s.newItem(11, 100, 1),
+		{
+			Ts: 12,
+			Data: []BufferItem{
+				{
+					PartitionID: 200,
+					DeleteData: storage.DeleteData{
+						Pks:      []storage.PrimaryKey{storage.NewVarCharPrimaryKey("a"), storage.NewVarCharPrimaryKey("b")},
+						Tss:      []uint64{12, 12},
+						RowCount: 2,
+					},
+				},
+			},
+		},
+	}
+	data, err := codec.Encode(items)
+	s.Require().NoError(err)
+	decoded, err := codec.Decode(data)
+	s.Require().NoError(err)
+	s.Require().Len(decoded, 2)
+	for i := range items {
+		s.Equal(items[i].Ts, decoded[i].Ts)
+		s.Equal(items[i].Size(), decoded[i].Size())
+		s.Equal(items[i].EntryNum(), decoded[i].EntryNum())
+		s.Equal(items[i].Data[0].PartitionID, decoded[i].Data[0].PartitionID)
+		s.Equal(items[i].Data[0].DeleteData.Tss, decoded[i].Data[0].DeleteData.Tss)
+	}
+	s.True(decoded[1].Data[0].DeleteData.Pks[1].EQ(storage.NewVarCharPrimaryKey("b")))
+
+	_, err = codec.Decode(data[:len(data)-1])
+	s.Error(err)
+
+	// the record number larger than the remaining data is rejected before allocation.
+	corrupted := binary.AppendUvarint(nil, 10)
+	corrupted = binary.AppendUvarint(corrupted, math.MaxUint64)
+	_, err = codec.Decode(corrupted)
+	s.ErrorIs(err, merr.ErrServiceInternal)
+}
+
+func (s *SpillDeleteBufferSuite) TestSpillAndRead() {
+	buffer, err := NewSpillableListDeleteBuffer(10, 1, []string{"1", "dml-1"}, s.dir, 0)
+	s.Require().NoError(err)
+	for ts := uint64(11); ts <= 14; ts++ {
+		buffer.Put(context.Background(), s.newItem(ts, 100, int64(ts-10)))
+	}
+
+	// all the blocks except the tail are spilled
+	s.Len(s.spilledFiles(), 3)
+	entryNum, memorySize := buffer.Size()
+	s.EqualValues(4, entryNum)
+	s.Equal(s.newItem(14, 100, 4).Size(), memorySize)
+
+	records := mustListAfter(s.T(), buffer, 11)
+	s.Equal([]uint64{11, 12, 13, 14}, lo.Map(records, func(item *Item, _ int) uint64 { return item.Ts }))
+	s.True(records[1].Data[0].DeleteData.Pks[0].EQ(storage.NewInt64PrimaryKey(2)))
+	s.Len(mustListAfter(s.T(), buffer, 13), 2)
+
+	// the spilled records are filtered by the candidate, the records in memory are not
+	candidate := &fakePkCandidate{partitionID: 100, pks: []storage.PrimaryKey{storage.NewInt64PrimaryKey(2)}}
+	records, err = buffer.ListAfterFor(context.Background(), 11, candidate)
+	s.Require().NoError(err)
+	s.Equal([]uint64{12, 14}, lo.Map(records, func(item *Item, _ int) uint64 { return item.Ts }))
+	candidate = &fakePkCandidate{partitionID: 200, pks: []storage.PrimaryKey{storage.NewInt64PrimaryKey(2)}}
+	records, err = buffer.ListAfterFor(context.Background(), 11, candidate)
+	s.Require().NoError(err)
+	s.Equal([]uint64{14}, lo.Map(records, func(item *Item, _ int) uint64 { return item.Ts }))
+
+	// the spilled files are removed along with the blocks
+	buffer.TryDiscard(13)
+	s.Len(s.spilledFiles(), 1)
+	entryNum, _ = buffer.Size()
+	s.EqualValues(2, entryNum)
+	s.Len(mustListAfter(s.T(), buffer, 0), 2)
+
+	buffer.Clear()
+	_, err = os.Stat(s.dir)
+	s.True(os.IsNotExist(err))
+}
+
+func (s *SpillDeleteBufferSuite) TestReadSpilledFailed() {
+	buffer, err := NewSpillableListDeleteBuffer(10, 1, []string{"1", "dml-1"}, s.dir, 0)
+	s.Require().NoError(err)
+	for ts := uint64(11); ts <= 12; ts++ {
+		buffer.Put(context.Background(), s.newItem(ts, 100, int64(ts-10)))
+	}
+	files := s.spilledFiles()
+	s.Require().Len(files, 1)
+	s.Require().NoError(os.WriteFile(path.Join(s.dir, files[0]), []byte("corrupted"), 0o600))
+
+	// the deletes can't be dropped silently, the error is returned to fail the request
+	_, err = buffer.ListAfter(context.Background(), 11)
+	s.Error(err)
+	candidate := &fakePkCandidate{partitionID: 100, pks: []storage.PrimaryKey{storage.NewInt64PrimaryKey(1)}}
+	_, err = buffer.ListAfterFor(context.Background(), 11, candidate)
+	s.Error(err)
+
+	// the spilled block before ts is not read
+	s.Len(mustListAfter(s.T(), buffer, 12), 1)
+}
+
+func (s *SpillDeleteBufferSuite) TestCompact() {
+	itemSize := s.newItem(11, 100, 1).Size()
+	buffer, err := NewSpillableListDeleteBuffer(10, 2*itemSize, []string{"1", "dml-1"}, s.dir, 0)
+	s.Require().NoError(err)
+	for ts := uint64(11); ts <= 13; ts++ {
+		buffer.Put(context.Background(), s.newItem(ts, 100, int64(ts-10)))
+	}
+	s.Len(s.spilledFiles(), 1)
+
+	// the records before ts are dropped from the spilled block
+	buffer.TryDiscard(12)
+	entryNum, _ := buffer.Size()
+	s.EqualValues(2, entryNum)
+	s.Equal([]uint64{12, 13}, lo.Map(mustListAfter(s.T(), buffer, 0), func(item *Item, _ int) uint64 { return item.Ts }))
+	ldb := buffer.(*listDeleteBuffer[*Item])
+	s.EqualValues(12, ldb.list[0].headTs)
+	s.Equal(itemSize, ldb.spilledSize)
+
+	buffer.TryDiscard(13)
+	s.Empty(s.spilledFiles())
+	s.EqualValues(0, ldb.spilledSize)
+	s.Equal([]uint64{13}, lo.Map(mustListAfter(s.T(), buffer, 0), func(item *Item, _ int) uint64 { return item.Ts }))
+}
+
+func TestSpillDeleteBuffer(t *testing.T) {
+	suite.Run(t, new(SpillDeleteBufferSuite))
+}
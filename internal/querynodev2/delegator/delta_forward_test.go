@@ -98,7 +98,7 @@ func (s *StreamingForwardSuite) SetupTest() {
 	}, nil)
 
 	// init schema
-	s.manager.Collection.PutOrRef(s.collectionID, &schemapb.CollectionSchema{
+	s.manager.Collection.PutOrRef(context.Background(), s.collectionID, &schemapb.CollectionSchema{
 		Name: "TestCollection",
 		Fields: []*schemapb.FieldSchema{
 			{
@@ -361,7 +361,7 @@ func (s *GrowingMergeL0Suite) SetupTest() {
 			},
 		},
 	}
-	s.manager.Collection.PutOrRef(s.collectionID, s.schema, &segcorepb.CollectionIndexMeta{
+	s.manager.Collection.PutOrRef(context.Background(), s.collectionID, s.schema, &segcorepb.CollectionIndexMeta{
 		MaxIndexRowCount: 100,
 		IndexMetas: []*segcorepb.FieldIndexMeta{
 			{
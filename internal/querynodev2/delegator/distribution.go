@@ -133,6 +133,17 @@ type distribution struct {
 	// distribution info
 	channelName string
 	queryView   *channelQueryView
+	// pendingSync is the sync action waiting for the sealed segments in its target to be loaded,
+	// the current query view keeps serving until it's applied.
+	pendingSync *pendingTargetSync
+	// onTargetSynced is called with the deferred sync action after it's applied.
+	onTargetSynced func(action *querypb.SyncAction)
+}
+
+// pendingTargetSync is a target version sync deferred until the distribution is ready for it.
+type pendingTargetSync struct {
+	action     *querypb.SyncAction
+	partitions []int64
 }
 
 // SegmentEntry stores the segment meta information.
@@ -193,9 +204,13 @@ func (d *distribution) snapshotLoop() {
 			return
 		case <-d.snapshotNotifier:
 			d.mut.Lock()
-			d.genSnapshot()
-			d.updateServiceable("snapshotLoop")
+			applied := d.applyPendingSyncLocked()
+			if applied == nil {
+				d.genSnapshot()
+				d.updateServiceable("snapshotLoop")
+			}
 			d.mut.Unlock()
+			d.notifyTargetSynced(applied)
 		}
 	}
 }
@@ -206,6 +221,27 @@ func (d *distribution) SetIDFOracle(idfOracle IDFOracle) {
 	d.idfOracle = idfOracle
 }
 
+// SetTargetSyncedCallback sets the callback called with the deferred sync action after it's applied.
+// The callback is called without holding the distribution lock.
+func (d *distribution) SetTargetSyncedCallback(onTargetSynced func(action *querypb.SyncAction)) {
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	d.onTargetSynced = onTargetSynced
+}
+
+// notifyTargetSynced calls the target synced callback if the deferred sync action is applied.
+func (d *distribution) notifyTargetSynced(applied *querypb.SyncAction) {
+	if applied == nil {
+		return
+	}
+	d.mut.RLock()
+	onTargetSynced := d.onTargetSynced
+	d.mut.RUnlock()
+	if onTargetSynced != nil {
+		onTargetSynced(applied)
+	}
+}
+
 // return segment distribution in query view
 func (d *distribution) PinReadableSegments(requiredLoadRatio float64, partitions ...int64) (sealed []SnapshotItem, growing []SegmentEntry, sealedRowCount map[int64]int64, version int64, err error) {
 	d.mut.RLock()
@@ -486,10 +522,59 @@ func (d *distribution) MarkOfflineSegments(segmentIDs ...int64) {
 // 2. update readable channel view to support full result after new distribution is serviceable
 // Notice: if we don't need to be compatible with 2.5.x, we can just update new query view to support query,
 // and new query view will become serviceable automatically, a sync action after distribution is serviceable is unnecessary
-func (d *distribution) SyncTargetVersion(action *querypb.SyncAction, partitions []int64) {
+//
+// If the current query view is serviceable but some sealed segments of the new target are not loaded yet,
+// e.g. the compaction result is not loaded while the compaction sources are still serving,
+// the sync is deferred until they are loaded, then the segments are swapped in one snapshot,
+// so a search never sees both or neither of the compaction sources and result.
+// It returns whether the new query view is applied.
+func (d *distribution) SyncTargetVersion(action *querypb.SyncAction, partitions []int64) bool {
 	d.mut.Lock()
 	defer d.mut.Unlock()
 
+	if d.queryView.Serviceable() && !d.readyForTargetLocked(action) {
+		d.pendingSync = &pendingTargetSync{action: action, partitions: partitions}
+		mlog.Info(context.TODO(), "defer channel query view update until the sealed segments in target are loaded",
+			mlog.String("channel", d.channelName),
+			mlog.Int64("currentVersion", d.queryView.version),
+			mlog.Int64("newVersion", action.GetTargetVersion()),
+		)
+		return false
+	}
+	d.pendingSync = nil
+	d.syncTargetVersionLocked(action, partitions)
+	return true
+}
+
+// readyForTargetLocked checks whether all the sealed segments with data in the target are loaded and online.
+func (d *distribution) readyForTargetLocked(action *querypb.SyncAction) bool {
+	for id, rowCount := range action.GetSealedSegmentRowCount() {
+		if rowCount <= 0 {
+			continue
+		}
+		if entry, ok := d.sealedSegments[id]; !ok || entry.Offline {
+			return false
+		}
+	}
+	return true
+}
+
+// applyPendingSyncLocked applies the pending sync action if the distribution is ready for it,
+// or the current query view is not serviceable any more, it returns the applied sync action or nil.
+func (d *distribution) applyPendingSyncLocked() *querypb.SyncAction {
+	if d.pendingSync == nil {
+		return nil
+	}
+	if d.queryView.Serviceable() && !d.readyForTargetLocked(d.pendingSync.action) {
+		return nil
+	}
+	pending := d.pendingSync
+	d.pendingSync = nil
+	d.syncTargetVersionLocked(pending.action, pending.partitions)
+	return pending.action
+}
+
+func (d *distribution) syncTargetVersionLocked(action *querypb.SyncAction, partitions []int64) {
 	oldValue := d.queryView.version
 	d.queryView = &channelQueryView{
 		growingSegments:       typeutil.NewUniqueSet(action.GetGrowingInTarget()...),
@@ -803,9 +888,13 @@ func BatchGetFromSegments(pks []storage.PrimaryKey, partitionID int64, sealed []
 // This is useful in tests and in scenarios that require immediate consistency.
 func (d *distribution) Flush() {
 	d.mut.Lock()
-	d.genSnapshot()
-	d.updateServiceable("Flush")
+	applied := d.applyPendingSyncLocked()
+	if applied == nil {
+		d.genSnapshot()
+		d.updateServiceable("Flush")
+	}
 	d.mut.Unlock()
+	d.notifyTargetSynced(applied)
 }
 
 // Close stops the background snapshot loop and waits for it to exit.
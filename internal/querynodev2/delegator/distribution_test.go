@@ -777,13 +777,50 @@ func (s *DistributionSuite) Test_SyncTargetVersion() {
 	s.Len(s1[0].Segments, 3)
 	s.Len(s2, 3)
 
-	s.dist.SyncTargetVersion(&querypb.SyncAction{
-		TargetVersion:         2,
+	// the new target is deferred until segment 333 is loaded, the current query view keeps serving
+	applied := s.dist.SyncTargetVersion(&querypb.SyncAction{
+		TargetVersion:         3,
 		GrowingInTarget:       []int64{1},
 		SealedSegmentRowCount: map[int64]int64{333: 100},
 		DroppedInTarget:       []int64{},
 	}, []int64{1})
+	s.False(applied)
+	s.True(s.dist.Serviceable())
+	s.Equal(int64(2), s.dist.GetQueryView().GetVersion())
+	s1, _, _, _, err = s.dist.PinReadableSegments(1.0, 1)
+	s.Require().NoError(err)
+	s.ElementsMatch([]int64{4, 5}, lo.Map(s1[0].Segments, func(e SegmentEntry, _ int) int64 { return e.SegmentID }))
+
+	var synced *querypb.SyncAction
+	s.dist.SetTargetSyncedCallback(func(action *querypb.SyncAction) {
+		synced = action
+	})
+	s.dist.Flush()
+	s.Nil(synced)
+
+	// segment 333 is swapped in and segment 4, 5 are swapped out in one snapshot
+	s.dist.AddDistributions(SegmentEntry{NodeID: 1, SegmentID: 333, PartitionID: 1, Version: 1})
+	s.dist.Flush()
+	s.Equal(int64(3), s.dist.GetQueryView().GetVersion())
+	// the deferred sync action is notified after it's applied
+	s.Equal(int64(3), synced.GetTargetVersion())
+	s.True(s.dist.Serviceable())
+	s1, _, _, _, err = s.dist.PinReadableSegments(1.0, 1)
+	s.Require().NoError(err)
+	readable := lo.FlatMap(s1, func(item SnapshotItem, _ int) []int64 {
+		return lo.Map(item.Segments, func(e SegmentEntry, _ int) int64 { return e.SegmentID })
+	})
+	s.ElementsMatch([]int64{333}, readable)
+
+	// the sync is applied at once if the current query view is not serviceable
+	s.dist.MarkOfflineSegments(333)
+	s.dist.Flush()
 	s.False(s.dist.Serviceable())
+	s.True(s.dist.SyncTargetVersion(&querypb.SyncAction{
+		TargetVersion:         4,
+		SealedSegmentRowCount: map[int64]int64{444: 100},
+	}, []int64{1}))
+	s.Equal(int64(4), s.dist.GetQueryView().GetVersion())
 	_, _, _, _, err = s.dist.PinReadableSegments(1.0, 1)
 	s.Error(err)
 }
@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"crypto/sha256"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// searchResultCache caches the search results of the delegator,
+// so the identical searches issued repeatedly, e.g. by dashboards, are served without searching the segments again.
+// A cached result is only valid for the same distribution snapshot and data version it's computed on,
+// and the search with an older mvcc timestamp can't reuse it.
+// The cache is only built if it's enabled, and the expired entry is dropped when it's got.
+type searchResultCache struct {
+	entries *lru.Cache[string, *searchResultCacheEntry]
+	ttl     time.Duration
+	// dataVersion is increased once the streaming data is applied to the delegator.
+	dataVersion *atomic.Uint64
+}
+
+type searchResultCacheEntry struct {
+	snapshotVersion int64
+	dataVersion     uint64
+	mvccTimestamp   uint64
+	expireAt        time.Time
+	results         []*internalpb.SearchResults
+}
+
+// newSearchResultCache creates the search result cache if it's enabled, returns nil otherwise.
+func newSearchResultCache() *searchResultCache {
+	params := paramtable.Get().QueryNodeCfg
+	if !params.SearchResultCacheEnabled.GetAsBool() {
+		return nil
+	}
+	entries, err := lru.New[string, *searchResultCacheEntry](params.SearchResultCacheCapacity.GetAsInt())
+	if err != nil {
+		// the capacity is not positive, the cache is disabled.
+		return nil
+	}
+	return &searchResultCache{
+		entries:     entries,
+		ttl:         params.SearchResultCacheTTL.GetAsDuration(time.Second),
+		dataVersion: atomic.NewUint64(0),
+	}
+}
+
+// Enabled returns whether the search results should be cached.
+func (c *searchResultCache) Enabled() bool {
+	return c != nil && paramtable.Get().QueryNodeCfg.SearchResultCacheEnabled.GetAsBool()
+}
+
+// DataVersion returns the current data version, it should be read before the search.
+func (c *searchResultCache) DataVersion() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.dataVersion.Load()
+}
+
+// Invalidate invalidates all the cached results, it should be called after the data is changed.
+func (c *searchResultCache) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.dataVersion.Inc()
+}
+
+// Release drops all the cached results.
+func (c *searchResultCache) Release() {
+	if c == nil {
+		return
+	}
+	c.entries.Purge()
+}
+
+// Get returns the cached results of the key if they are still valid.
+func (c *searchResultCache) Get(key string, snapshotVersion int64, dataVersion uint64, mvccTimestamp uint64) ([]*internalpb.SearchResults, bool) {
+	entry, ok := c.entries.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expireAt) {
+		c.entries.Remove(key)
+		return nil, false
+	}
+	if entry.snapshotVersion != snapshotVersion || entry.dataVersion != dataVersion || mvccTimestamp < entry.mvccTimestamp {
+		return nil, false
+	}
+	results := make([]*internalpb.SearchResults, 0, len(entry.results))
+	for _, result := range entry.results {
+		results = append(results, proto.Clone(result).(*internalpb.SearchResults))
+	}
+	return results, true
+}
+
+// Put caches the results of the key.
+func (c *searchResultCache) Put(key string, snapshotVersion int64, dataVersion uint64, mvccTimestamp uint64, results []*internalpb.SearchResults) {
+	cloned := make([]*internalpb.SearchResults, 0, len(results))
+	for _, result := range results {
+		cloned = append(cloned, proto.Clone(result).(*internalpb.SearchResults))
+	}
+	c.entries.Add(key, &searchResultCacheEntry{
+		snapshotVersion: snapshotVersion,
+		dataVersion:     dataVersion,
+		mvccTimestamp:   mvccTimestamp,
+		expireAt:        time.Now().Add(c.ttl),
+		results:         cloned,
+	})
+}
+
+// searchResultCacheKey returns the signature of the search request,
+// which consists of the plan, the placeholder group and the search params, the per request fields are excluded.
+func searchResultCacheKey(req *internalpb.SearchRequest) (string, error) {
+	signature := proto.Clone(req).(*internalpb.SearchRequest)
+	signature.Base = nil
+	signature.ReqID = 0
+	signature.MvccTimestamp = 0
+	signature.GuaranteeTimestamp = 0
+	signature.TimeoutTimestamp = 0
+	signature.ConsistencyLevel = 0
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(signature)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bs)
+	return string(sum[:]), nil
+}
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestSearchResultCacheKey(t *testing.T) {
+	req := &internalpb.SearchRequest{
+		Base:               &commonpb.MsgBase{MsgID: 1},
+		ReqID:              1,
+		CollectionID:       100,
+		SerializedExprPlan: []byte("plan"),
+		PlaceholderGroup:   []byte("vectors"),
+		MvccTimestamp:      1000,
+		GuaranteeTimestamp: 1000,
+		Nq:                 1,
+		Topk:               10,
+	}
+	key, err := searchResultCacheKey(req)
+	require.NoError(t, err)
+
+	// the per request fields are not in the signature
+	same := &internalpb.SearchRequest{
+		Base:               &commonpb.MsgBase{MsgID: 2},
+		ReqID:              2,
+		CollectionID:       100,
+		SerializedExprPlan: []byte("plan"),
+		PlaceholderGroup:   []byte("vectors"),
+		MvccTimestamp:      2000,
+		GuaranteeTimestamp: 2000,
+		TimeoutTimestamp:   3000,
+		ConsistencyLevel:   commonpb.ConsistencyLevel_Strong,
+		Nq:                 1,
+		Topk:               10,
+	}
+	sameKey, err := searchResultCacheKey(same)
+	require.NoError(t, err)
+	assert.Equal(t, key, sameKey)
+	// the request is not modified
+	assert.Equal(t, int64(1), req.GetReqID())
+
+	different := &internalpb.SearchRequest{
+		CollectionID:       100,
+		SerializedExprPlan: []byte("plan"),
+		PlaceholderGroup:   []byte("vectors"),
+		Nq:                 1,
+		Topk:               20,
+	}
+	differentKey, err := searchResultCacheKey(different)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, differentKey)
+}
+
+func TestSearchResultCache(t *testing.T) {
+	params := paramtable.Get()
+	// the cache is not built if it's disabled.
+	params.Save(params.QueryNodeCfg.SearchResultCacheEnabled.Key, "false")
+	defer params.Reset(params.QueryNodeCfg.SearchResultCacheEnabled.Key)
+	assert.Nil(t, newSearchResultCache())
+
+	params.Save(params.QueryNodeCfg.SearchResultCacheEnabled.Key, "true")
+	params.Save(params.QueryNodeCfg.SearchResultCacheCapacity.Key, "8")
+	defer params.Reset(params.QueryNodeCfg.SearchResultCacheCapacity.Key)
+	params.Save(params.QueryNodeCfg.SearchResultCacheTTL.Key, "60")
+	defer params.Reset(params.QueryNodeCfg.SearchResultCacheTTL.Key)
+	cache := newSearchResultCache()
+	require.NotNil(t, cache)
+	results := []*internalpb.SearchResults{{NumQueries: 1, TopK: 10}}
+	dataVersion := cache.DataVersion()
+	cache.Put("key", 1, dataVersion, 1000, results)
+
+	cached, ok := cache.Get("key", 1, dataVersion, 1000)
+	assert.True(t, ok)
+	assert.Len(t, cached, 1)
+	assert.Equal(t, int64(10), cached[0].GetTopK())
+	// the cached result is not shared with the caller
+	cached[0].TopK = 20
+	cached, ok = cache.Get("key", 1, dataVersion, 2000)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), cached[0].GetTopK())
+
+	// older mvcc timestamp
+	_, ok = cache.Get("key", 1, dataVersion, 999)
+	assert.False(t, ok)
+	// distribution changed
+	_, ok = cache.Get("key", 2, dataVersion, 1000)
+	assert.False(t, ok)
+	// data changed
+	cache.Invalidate()
+	_, ok = cache.Get("key", 1, cache.DataVersion(), 1000)
+	assert.False(t, ok)
+	_, ok = cache.Get("other", 1, dataVersion, 1000)
+	assert.False(t, ok)
+
+	// expired
+	dataVersion = cache.DataVersion()
+	cache.Put("key", 1, dataVersion, 1000, results)
+	_, ok = cache.Get("key", 1, dataVersion, 1000)
+	assert.True(t, ok)
+	cache.ttl = 0
+	cache.Put("key", 1, dataVersion, 1000, results)
+	time.Sleep(time.Millisecond)
+	_, ok = cache.Get("key", 1, dataVersion, 1000)
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.entries.Len())
+
+	// released
+	cache.ttl = time.Minute
+	cache.Put("key", 1, dataVersion, 1000, results)
+	cache.Release()
+	_, ok = cache.Get("key", 1, dataVersion, 1000)
+	assert.False(t, ok)
+
+	var nilCache *searchResultCache
+	assert.False(t, nilCache.Enabled())
+	nilCache.Invalidate()
+	nilCache.Release()
+	assert.Equal(t, uint64(0), nilCache.DataVersion())
+}
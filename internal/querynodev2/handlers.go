@@ -372,9 +372,12 @@ func (node *QueryNode) queryStreamSegments(ctx context.Context, req *querypb.Que
 		node.manager.Collection.Unref(req.GetReq().GetCollectionID(), 1)
 	}()
 
-	// Send task to scheduler and wait until it finished.
+	// Pin the schema version the request is planned with, so a concurrent schema update doesn't change the schema of plan.
+	schema, schemaVersion := collection.PinSchemaVersionOf(req.GetReq().SchemaVersion)
+	defer collection.UnpinSchemaVersion(schemaVersion)
 
-	task := tasks.NewQueryStreamTask(ctx, collection, node.manager, req, srv,
+	// Send task to scheduler and wait until it finished.
+	task := tasks.NewQueryStreamTask(ctx, collection, schema, schemaVersion, node.manager, req, srv,
 		paramtable.Get().QueryNodeCfg.QueryStreamBatchSize.GetAsInt(),
 		paramtable.Get().QueryNodeCfg.QueryStreamMaxBatchSize.GetAsInt())
 	if err := node.scheduler.Add(task); err != nil {
@@ -107,7 +107,7 @@ func (suite *LocalWorkerTestSuite) BeforeTest(suiteName, testName string) {
 		LoadType:     querypb.LoadType_LoadCollection,
 		CollectionID: suite.collectionID,
 	}
-	suite.node.manager.Collection.PutOrRef(suite.collectionID, collection.Schema(), suite.indexMeta, loadMata)
+	suite.node.manager.Collection.PutOrRef(context.Background(), suite.collectionID, collection.Schema(), suite.indexMeta, loadMata)
 
 	suite.mockLoader = segments.NewMockLoader(suite.T())
 	suite.node.loader = suite.mockLoader
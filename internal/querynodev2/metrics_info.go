@@ -216,6 +216,64 @@ func getChannelJSON(node *QueryNode, collectionID int64) string {
 	return string(ret)
 }
 
+// getCollectionRefJSON returns the JSON string of the reference info of collections
+func getCollectionRefJSON(node *QueryNode, collectionID int64) string {
+	numSegments := make(map[int64]int)
+	for _, s := range node.manager.Segment.GetBy() {
+		numSegments[s.Collection()]++
+	}
+	numChannels := make(map[int64]int)
+	node.delegators.Range(func(_ string, sd delegator.ShardDelegator) bool {
+		numChannels[sd.Collection()]++
+		return true
+	})
+
+	var refs []*metricsinfo.CollectionRef
+	for _, info := range node.manager.Collection.RefInfos() {
+		if collectionID > 0 && info.CollectionID != collectionID {
+			continue
+		}
+
+		refs = append(refs, &metricsinfo.CollectionRef{
+			CollectionID:  info.CollectionID,
+			NodeID:        node.GetNodeID(),
+			RefCount:      info.RefCount,
+			RefSources:    info.RefSources,
+			UnrefCount:    info.UnrefCount,
+			NumSegments:   numSegments[info.CollectionID],
+			NumChannels:   numChannels[info.CollectionID],
+			SuspectedLeak: info.RefCount > 0 && numSegments[info.CollectionID] == 0 && numChannels[info.CollectionID] == 0,
+		})
+	}
+
+	ret, err := json.Marshal(refs)
+	if err != nil {
+		mlog.Warn(context.TODO(), "failed to marshal collection refs", mlog.Err(err))
+		return ""
+	}
+	return string(ret)
+}
+
+// getSegmentMemoryJSON returns the JSON string of the memory breakdown of segments
+func getSegmentMemoryJSON(node *QueryNode, collectionID int64) string {
+	var ms []*metricsinfo.SegmentMemory
+	for _, s := range node.manager.Segment.GetBy() {
+		if collectionID > 0 && s.Collection() != collectionID {
+			continue
+		}
+		m := segments.GetMemoryBreakdown(s)
+		m.NodeID = node.GetNodeID()
+		ms = append(ms, m)
+	}
+
+	ret, err := json.Marshal(ms)
+	if err != nil {
+		mlog.Warn(context.TODO(), "failed to marshal segment memory", mlog.Err(err))
+		return ""
+	}
+	return string(ret)
+}
+
 // getSegmentJSON returns the JSON string of segments
 func getSegmentJSON(node *QueryNode, collectionID int64) string {
 	allSegments := node.manager.Segment.GetBy()
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/pipeline"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/pkg/v3/mq/msgdispatcher"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/metricsinfo"
@@ -135,6 +136,73 @@ func TestGetSegmentJSON(t *testing.T) {
 	assert.Equal(t, int64(100), segments[0].LoadedInsertRowCount)
 }
 
+func TestGetCollectionRefJSON(t *testing.T) {
+	segment := segments.NewMockSegment(t)
+	segment.EXPECT().Collection().Return(int64(1001))
+
+	node := &QueryNode{delegators: typeutil.NewConcurrentMap[string, delegator.ShardDelegator]()}
+	mockedSegmentManager := segments.NewMockSegmentManager(t)
+	mockedSegmentManager.EXPECT().GetBy().Return([]segments.Segment{segment})
+	mockedCollectionManager := segments.NewMockCollectionManager(t)
+	mockedCollectionManager.EXPECT().RefInfos().Return([]segments.CollectionRefInfo{
+		{CollectionID: 1001, RefCount: 2, RefSources: map[string]uint64{"load": 2}},
+		{CollectionID: 1002, RefCount: 1, RefSources: map[string]uint64{"search": 1}},
+	})
+	node.manager = &segments.Manager{Segment: mockedSegmentManager, Collection: mockedCollectionManager}
+
+	jsonStr := getCollectionRefJSON(node, 0)
+	var refs []*metricsinfo.CollectionRef
+	err := json.Unmarshal([]byte(jsonStr), &refs)
+	assert.NoError(t, err)
+	assert.Len(t, refs, 2)
+	assert.Equal(t, int64(1001), refs[0].CollectionID)
+	assert.Equal(t, 1, refs[0].NumSegments)
+	assert.False(t, refs[0].SuspectedLeak)
+	assert.Equal(t, int64(1002), refs[1].CollectionID)
+	assert.Equal(t, uint64(1), refs[1].RefSources["search"])
+	assert.True(t, refs[1].SuspectedLeak)
+
+	jsonStr = getCollectionRefJSON(node, 1002)
+	refs = nil
+	err = json.Unmarshal([]byte(jsonStr), &refs)
+	assert.NoError(t, err)
+	assert.Len(t, refs, 1)
+}
+
+func TestGetSegmentMemoryJSON(t *testing.T) {
+	segment := segments.NewMockSegment(t)
+	segment.EXPECT().ID().Return(int64(1))
+	segment.EXPECT().Collection().Return(int64(1001))
+	segment.EXPECT().Partition().Return(int64(2001))
+	segment.EXPECT().Type().Return(segments.SegmentTypeSealed)
+	segment.EXPECT().Level().Return(datapb.SegmentLevel_L0)
+	segment.EXPECT().MemSize().Return(int64(1024))
+
+	node := &QueryNode{}
+	mockedSegmentManager := segments.NewMockSegmentManager(t)
+	mockedSegmentManager.EXPECT().GetBy().Return([]segments.Segment{segment})
+	node.manager = &segments.Manager{Segment: mockedSegmentManager}
+
+	jsonStr := getSegmentMemoryJSON(node, 0)
+	var ms []*metricsinfo.SegmentMemory
+	err := json.Unmarshal([]byte(jsonStr), &ms)
+	assert.NoError(t, err)
+	assert.Len(t, ms, 1)
+	assert.Equal(t, int64(1), ms[0].SegmentID)
+	assert.Equal(t, int64(1001), ms[0].CollectionID)
+	assert.Equal(t, int64(2001), ms[0].PartitionID)
+	assert.Equal(t, "Sealed", ms[0].State)
+	assert.Equal(t, "L0", ms[0].Level)
+	assert.Equal(t, int64(1024), ms[0].MemSize)
+	assert.Empty(t, ms[0].Columns)
+
+	jsonStr = getSegmentMemoryJSON(node, 1002)
+	ms = nil
+	err = json.Unmarshal([]byte(jsonStr), &ms)
+	assert.NoError(t, err)
+	assert.Empty(t, ms)
+}
+
 func TestStreamingQuotaMetrics(t *testing.T) {
 	paramtable.Init()
 
@@ -19,6 +19,9 @@ package segments
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
+	"runtime"
+	"sort"
 	"sync"
 
 	"github.com/samber/lo"
@@ -28,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/internal/util/segcore"
+	"github.com/milvus-io/milvus/pkg/v3/common"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
@@ -41,7 +45,7 @@ type CollectionManager interface {
 	List() []int64
 	ListWithName() map[int64]string
 	Get(collectionID int64) *Collection
-	PutOrRef(collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo) error
+	PutOrRef(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo) error
 	Ref(collectionID int64, count uint32) bool
 	// unref the collection,
 	// returns true if the collection ref count goes 0, or the collection not exists,
@@ -51,7 +55,20 @@ type CollectionManager interface {
 	// schemaBarrierTs is the DDL/update barrier timestamp, not the logical schema
 	// version. The manager derives the logical schema version from schema.Version
 	// when a schema payload is present.
-	UpdateSchema(collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64) error
+	UpdateSchema(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64) error
+	// RefInfos returns the reference info of the collections for diagnostics.
+	RefInfos() []CollectionRefInfo
+}
+
+// CollectionRefInfo is the reference info of a collection.
+type CollectionRefInfo struct {
+	CollectionID int64
+	RefCount     uint32
+	// RefSources is the number of references taken by each caller since the collection is put,
+	// keyed by the function name of the caller.
+	RefSources map[string]uint64
+	// UnrefCount is the number of references returned since the collection is put.
+	UnrefCount uint64
 }
 
 type collectionManager struct {
@@ -102,7 +119,7 @@ func (m *collectionManager) Get(collectionID int64) *Collection {
 	return m.collections[collectionID]
 }
 
-func (m *collectionManager) PutOrRef(collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo) error {
+func (m *collectionManager) PutOrRef(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo) error {
 	m.mut.Lock()
 	defer m.mut.Unlock()
 	logicalSchemaVersion := getLoadMetaSchemaVersion(schema, loadMeta)
@@ -113,43 +130,45 @@ func (m *collectionManager) PutOrRef(collectionID int64, schema *schemapb.Collec
 		// separate from the barrier timestamp so stale schema payloads cannot roll
 		// back fields, while newer properties-only payloads can still refresh.
 		if plan, shouldUpdate := prepareCollectionSchemaUpdate(collection, logicalSchemaVersion, schemaBarrierTs); shouldUpdate {
-			if err := collection.ccollection.UpdateSchema(schema, plan.segcoreSchemaVersion); err != nil {
+			// The schema and the index meta are applied together,
+			// the schema is rolled back if the index meta can not be applied.
+			if err := collection.applySchemaUpdate(ctx, schema, meta, plan); err != nil {
 				return err
 			}
-			collection.setSchema(schema, plan.logicalSchemaVersion, plan.schemaBarrierTs, plan.segcoreSchemaVersion)
-			mlog.Info(context.TODO(), "update collection schema",
+			mlog.Info(ctx, "update collection schema",
 				mlog.Int64("collectionID", collectionID),
 				mlog.Uint64("schemaVersion", plan.logicalSchemaVersion),
 				mlog.Uint64("schemaBarrierTs", plan.schemaBarrierTs),
 				mlog.Uint64("segcoreSchemaVersion", plan.segcoreSchemaVersion),
 				mlog.Any("schema", schema),
 			)
-		}
-		// Always update index meta to ensure newly indexed fields are visible
-		// for search plan creation (CollectionIndexMeta::HasField check).
-		if meta != nil {
+		} else if meta != nil {
+			// Always update index meta to ensure newly indexed fields are visible
+			// for search plan creation (CollectionIndexMeta::HasField check).
 			if err := collection.ccollection.UpdateIndexMeta(meta); err != nil {
 				return err
 			}
 		}
 		collection.Ref(1)
+		collection.recordRef(runtime.Caller(1))
 		return nil
 	}
 
-	mlog.Info(context.TODO(), "put new collection", mlog.Int64("collectionID", collectionID), mlog.Any("schema", schema))
+	mlog.Info(ctx, "put new collection", mlog.Int64("collectionID", collectionID), mlog.Any("schema", schema))
 	collection, err := NewCollection(collectionID, schema, meta, loadMeta)
-	mlog.Info(context.TODO(), "new collection created", mlog.Int64("collectionID", collectionID), mlog.Any("schema", schema), mlog.Err(err))
+	mlog.Info(ctx, "new collection created", mlog.Int64("collectionID", collectionID), mlog.Any("schema", schema), mlog.Err(err))
 	if err != nil {
 		return err
 	}
 
 	collection.Ref(1)
+	collection.recordRef(runtime.Caller(1))
 	m.collections[collectionID] = collection
 	m.updateMetric()
 	return nil
 }
 
-func (m *collectionManager) UpdateSchema(collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64) error {
+func (m *collectionManager) UpdateSchema(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64) error {
 	m.mut.Lock()
 	defer m.mut.Unlock()
 
@@ -168,12 +187,7 @@ func (m *collectionManager) UpdateSchema(collectionID int64, schema *schemapb.Co
 	if !shouldUpdate {
 		return nil
 	}
-
-	if err := collection.ccollection.UpdateSchema(schema, plan.segcoreSchemaVersion); err != nil {
-		return err
-	}
-	collection.setSchema(schema, plan.logicalSchemaVersion, plan.schemaBarrierTs, plan.segcoreSchemaVersion)
-	return nil
+	return collection.applySchemaUpdate(ctx, schema, nil, plan)
 }
 
 // ShouldUpdateCollectionSchema reports whether an UpdateSchema payload would
@@ -266,6 +280,9 @@ func (m *collectionManager) Ref(collectionID int64, count uint32) bool {
 
 	if collection, ok := m.collections[collectionID]; ok {
 		collection.Ref(count)
+		for i := uint32(0); i < count; i++ {
+			collection.recordRef(runtime.Caller(1))
+		}
 		return true
 	}
 
@@ -277,6 +294,7 @@ func (m *collectionManager) Unref(collectionID int64, count uint32) bool {
 	defer m.mut.Unlock()
 
 	if collection, ok := m.collections[collectionID]; ok {
+		collection.unrefCount += uint64(count)
 		if collection.Unref(count) == 0 {
 			mlog.Info(context.TODO(), "release collection due to ref count to 0",
 				mlog.Int64("nodeID", paramtable.GetNodeID()), mlog.Int64("collectionID", collectionID))
@@ -294,6 +312,32 @@ func (m *collectionManager) Unref(collectionID int64, count uint32) bool {
 	return true
 }
 
+// RefInfos returns the reference info of the collections for diagnostics.
+func (m *collectionManager) RefInfos() []CollectionRefInfo {
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+
+	infos := make([]CollectionRefInfo, 0, len(m.collections))
+	for collectionID, collection := range m.collections {
+		sources := make(map[string]uint64, len(collection.refSources))
+		for pc, count := range collection.refSources {
+			name := "unknown"
+			if fn := runtime.FuncForPC(pc); fn != nil {
+				name = fn.Name()
+			}
+			sources[name] += count
+		}
+		infos = append(infos, CollectionRefInfo{
+			CollectionID: collectionID,
+			RefCount:     collection.refCount.Load(),
+			RefSources:   sources,
+			UnrefCount:   collection.unrefCount,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].CollectionID < infos[j].CollectionID })
+	return infos
+}
+
 type collectionSchemaSnapshot struct {
 	schema               *schemapb.CollectionSchema
 	logicalSchemaVersion uint64
@@ -304,6 +348,16 @@ type collectionSchemaSnapshot struct {
 	segcoreSchemaVersion uint64
 }
 
+// schemaVersionRef is a schema version installed into the collection, it's tracked for the segments and requests pinning it.
+// It doesn't own any segcore resource: segcore holds the schema of each version by shared_ptr,
+// so the old schema is freed by segcore itself once the last segment or plan created with it is released.
+// The ref only keeps the go schema of the version for the pinned requests, and it's dropped once it's not pinned.
+type schemaVersionRef struct {
+	schema               *schemapb.CollectionSchema
+	logicalSchemaVersion uint64
+	refCount             int
+}
+
 // Collection is a wrapper of the underlying C-structure C.CCollection
 // In a query node, `Collection` is a replica info of a collection in these query node.
 type Collection struct {
@@ -323,8 +377,15 @@ type Collection struct {
 	schema     atomic.Pointer[collectionSchemaSnapshot]
 	isGpuIndex bool
 	loadFields typeutil.Set[int64]
+	lazyFields *lazyFieldTracker // the fields of the sealed segments loaded on demand
 
 	refCount *atomic.Uint32
+	// refSources and unrefCount are guarded by the lock of collectionManager, for diagnostics only.
+	refSources map[uintptr]uint64 // caller pc -> number of references taken
+	unrefCount uint64
+
+	versionMu      sync.Mutex                   // protects schemaVersions
+	schemaVersions map[uint64]*schemaVersionRef // segcore schema version -> schema version ref
 }
 
 // GetDBName returns the database name of collection.
@@ -358,6 +419,148 @@ func (c *Collection) setSchema(schema *schemapb.CollectionSchema, logicalSchemaV
 		schemaBarrierTs:      schemaBarrierTs,
 		segcoreSchemaVersion: segcoreSchemaVersion,
 	})
+
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	c.getOrCreateSchemaVersionLocked()
+	c.dropUnpinnedSchemaVersionsLocked()
+}
+
+// applySchemaUpdate applies the schema and the index meta of the plan into the C collection, and then publishes the schema snapshot.
+// It's all or nothing: if the index meta fails to apply, the C collection is rolled back to the previous schema,
+// and the previous snapshot is kept, so the caller can retry the update.
+func (c *Collection) applySchemaUpdate(ctx context.Context, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, plan collectionSchemaUpdatePlan) error {
+	oldSchema, oldLogicalSchemaVersion, oldSchemaBarrierTs, _ := c.schemaSnapshotWithSegcoreSchemaVersion()
+	if err := c.ccollection.UpdateSchema(schema, plan.segcoreSchemaVersion); err != nil {
+		return err
+	}
+	if meta != nil {
+		if err := c.ccollection.UpdateIndexMeta(meta); err != nil {
+			c.rollbackSchemaUpdate(ctx, schema, oldSchema, oldLogicalSchemaVersion, oldSchemaBarrierTs, plan)
+			return err
+		}
+	}
+	c.setSchema(schema, plan.logicalSchemaVersion, plan.schemaBarrierTs, plan.segcoreSchemaVersion)
+	return nil
+}
+
+// rollbackSchemaUpdate rolls back the schema applied into the C collection by the plan.
+// Segcore only accepts an increasing schema version, so the previous schema is re-applied with the next segcore schema version.
+func (c *Collection) rollbackSchemaUpdate(ctx context.Context, schema *schemapb.CollectionSchema, oldSchema *schemapb.CollectionSchema, oldLogicalSchemaVersion uint64, oldSchemaBarrierTs uint64, plan collectionSchemaUpdatePlan) {
+	rollbackSegcoreSchemaVersion := plan.segcoreSchemaVersion + 1
+	if err := c.ccollection.UpdateSchema(oldSchema, rollbackSegcoreSchemaVersion); err != nil {
+		// The C collection keeps the new schema, so the snapshot follows it to keep the go side consistent with segcore.
+		// The stale index meta is refreshed by the retry, which only applies the index meta.
+		mlog.Warn(ctx, "failed to rollback collection schema, keep the new schema",
+			mlog.Int64("collectionID", c.id),
+			mlog.Uint64("schemaVersion", plan.logicalSchemaVersion),
+			mlog.Uint64("segcoreSchemaVersion", plan.segcoreSchemaVersion),
+			mlog.Err(err))
+		c.setSchema(schema, plan.logicalSchemaVersion, plan.schemaBarrierTs, plan.segcoreSchemaVersion)
+		return
+	}
+	c.setSchema(oldSchema, oldLogicalSchemaVersion, oldSchemaBarrierTs, rollbackSegcoreSchemaVersion)
+	mlog.Info(ctx, "collection schema is rolled back",
+		mlog.Int64("collectionID", c.id),
+		mlog.Uint64("schemaVersion", oldLogicalSchemaVersion),
+		mlog.Uint64("segcoreSchemaVersion", rollbackSegcoreSchemaVersion))
+}
+
+// PinSchemaVersion pins the current schema version of the collection,
+// the returned segcore schema version should be unpinned by UnpinSchemaVersion once it's not used.
+func (c *Collection) PinSchemaVersion() (*schemapb.CollectionSchema, uint64) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	ref, version := c.getOrCreateSchemaVersionLocked()
+	ref.refCount++
+	return ref.schema, version
+}
+
+// PinSchemaVersionOf pins the schema version a search or query request is planned with,
+// so the request started before a schema update keeps running against the old schema.
+// The newest live version of the logical schema version is pinned,
+// the current version is pinned if the logical schema version is not set, newer than the current one or already dropped.
+// The returned segcore schema version should be unpinned by UnpinSchemaVersion once the request is done.
+func (c *Collection) PinSchemaVersionOf(logicalSchemaVersion *int32) (*schemapb.CollectionSchema, uint64) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	ref, version := c.getOrCreateSchemaVersionLocked()
+	if logicalSchemaVersion != nil && *logicalSchemaVersion >= 0 && uint64(*logicalSchemaVersion) < ref.logicalSchemaVersion {
+		var found bool
+		for v, r := range c.schemaVersions {
+			if r.logicalSchemaVersion == uint64(*logicalSchemaVersion) && (!found || v > version) {
+				ref, version, found = r, v, true
+			}
+		}
+	}
+	ref.refCount++
+	return ref.schema, version
+}
+
+// PlanSchema returns the schema to create the segcore plan of a request with, by the schema version pinned for the request.
+// Nil is returned if the pinned version is the current one, so the plan is created with the current schema of segcore directly.
+func (c *Collection) PlanSchema(schema *schemapb.CollectionSchema, version uint64) *segcore.PlanSchema {
+	if schema == nil {
+		return nil
+	}
+	if _, _, _, current := c.schemaSnapshotWithSegcoreSchemaVersion(); current == version {
+		return nil
+	}
+	return &segcore.PlanSchema{Schema: schema, Version: version}
+}
+
+// UnpinSchemaVersion unpins the schema version pinned by PinSchemaVersion,
+// the old schema version is dropped from the collection once it's not pinned.
+func (c *Collection) UnpinSchemaVersion(version uint64) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	ref, ok := c.schemaVersions[version]
+	if !ok || ref.refCount <= 0 {
+		mlog.Warn(context.TODO(), "unpin a schema version that is not pinned",
+			mlog.Int64("collectionID", c.id), mlog.Uint64("segcoreSchemaVersion", version))
+		return
+	}
+	ref.refCount--
+	c.dropUnpinnedSchemaVersionsLocked()
+}
+
+// LiveSchemaVersionNum returns the number of the pinned schema versions, including the current one.
+func (c *Collection) LiveSchemaVersionNum() int {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	return len(c.schemaVersions)
+}
+
+// getOrCreateSchemaVersionLocked returns the ref of the current schema version.
+func (c *Collection) getOrCreateSchemaVersionLocked() (*schemaVersionRef, uint64) {
+	schema, logicalSchemaVersion, _, version := c.schemaSnapshotWithSegcoreSchemaVersion()
+	if c.schemaVersions == nil {
+		c.schemaVersions = make(map[uint64]*schemaVersionRef)
+	}
+	ref, ok := c.schemaVersions[version]
+	if !ok {
+		ref = &schemaVersionRef{schema: schema, logicalSchemaVersion: logicalSchemaVersion}
+		c.schemaVersions[version] = ref
+	}
+	return ref, version
+}
+
+// dropUnpinnedSchemaVersionsLocked drops the old schema versions that are not pinned,
+// and reports the number of the versions still pinned, the segcore schema of dropped version is freed by segcore itself.
+func (c *Collection) dropUnpinnedSchemaVersionsLocked() {
+	_, _, _, current := c.schemaSnapshotWithSegcoreSchemaVersion()
+	for version, ref := range c.schemaVersions {
+		if version != current && ref.refCount <= 0 {
+			delete(c.schemaVersions, version)
+			mlog.Info(context.TODO(), "old schema version of collection is not pinned any more",
+				mlog.Int64("collectionID", c.id), mlog.Uint64("segcoreSchemaVersion", version))
+		}
+	}
+	metrics.QueryNodeSchemaVersionNum.WithLabelValues(paramtable.GetStringNodeID(), fmt.Sprint(c.id)).Set(float64(len(c.schemaVersions)))
 }
 
 func (c *Collection) SchemaSnapshot() (*schemapb.CollectionSchema, uint64, uint64) {
@@ -403,6 +606,15 @@ func (c *Collection) IsGpuIndex() bool {
 	return c.isGpuIndex
 }
 
+// SearchCPUShare returns the share of the read worker pool the search and query tasks of the collection could take.
+func (c *Collection) SearchCPUShare() float64 {
+	share, err := common.GetCollectionSearchCPUShare(c.Schema().GetProperties())
+	if err != nil {
+		return 1
+	}
+	return share
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) GetPartitions() []int64 {
 	return c.partitions.Collect()
@@ -442,6 +654,17 @@ func (c *Collection) Unref(count uint32) uint32 {
 	return refCount
 }
 
+// recordRef records the caller taking a reference, the arguments are the result of runtime.Caller.
+func (c *Collection) recordRef(pc uintptr, _ string, _ int, ok bool) {
+	if !ok {
+		pc = 0
+	}
+	if c.refSources == nil {
+		c.refSources = make(map[uintptr]uint64)
+	}
+	c.refSources[pc]++
+}
+
 // newCollection returns a new Collection
 func NewCollection(collectionID int64, schema *schemapb.CollectionSchema, indexMeta *segcorepb.CollectionIndexMeta, loadMetaInfo *querypb.LoadMetaInfo) (*Collection, error) {
 	/*
@@ -495,6 +718,7 @@ func NewCollection(collectionID int64, schema *schemapb.CollectionSchema, indexM
 		refCount:      atomic.NewUint32(0),
 		isGpuIndex:    isGpuIndex,
 		loadFields:    loadFieldIDs,
+		lazyFields:    newLazyFieldTracker(),
 	}
 	for _, partitionID := range loadMetaInfo.GetPartitionIDs() {
 		coll.partitions.Insert(partitionID)
@@ -513,6 +737,7 @@ func NewTestCollection(collectionID int64, loadType querypb.LoadType, schema *sc
 		partitions: typeutil.NewConcurrentSet[int64](),
 		loadType:   loadType,
 		refCount:   atomic.NewUint32(0),
+		lazyFields: newLazyFieldTracker(),
 	}
 	col.setSchema(schema, 0, 0, initialSegcoreSchemaVersion(0, 0))
 	return col
@@ -525,6 +750,7 @@ func NewCollectionWithoutSegcoreForTest(collectionID int64, schema *schemapb.Col
 		id:         collectionID,
 		partitions: typeutil.NewConcurrentSet[int64](),
 		refCount:   atomic.NewUint32(0),
+		lazyFields: newLazyFieldTracker(),
 	}
 	logicalSchemaVersion := uint64(schema.GetVersion())
 	coll.setSchema(schema, logicalSchemaVersion, 0, initialSegcoreSchemaVersion(logicalSchemaVersion, 0))
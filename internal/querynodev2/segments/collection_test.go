@@ -17,15 +17,20 @@
 package segments
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
 
+	"github.com/bytedance/mockey"
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks/util/mock_segcore"
+	"github.com/milvus-io/milvus/internal/util/segcore"
 	"github.com/milvus-io/milvus/pkg/v3/common"
 	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/segcorepb"
@@ -44,12 +49,48 @@ func (s *CollectionManagerSuite) SetupSuite() {
 func (s *CollectionManagerSuite) SetupTest() {
 	s.cm = NewCollectionManager()
 	schema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
-	err := s.cm.PutOrRef(1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
+	err := s.cm.PutOrRef(context.Background(), 1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
 		LoadType: querypb.LoadType_LoadCollection,
 	})
 	s.Require().NoError(err)
 }
 
+func (s *CollectionManagerSuite) TestSchemaVersionGC() {
+	collection := s.cm.Get(1)
+	s.Equal(1, collection.LiveSchemaVersionNum())
+
+	// the old version pinned by segment is kept after update.
+	oldSchema, oldVersion := collection.PinSchemaVersion()
+	newSchema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
+	newSchema.Version = 1
+	s.NoError(s.cm.UpdateSchema(context.Background(), 1, newSchema, 1))
+	s.Equal(2, collection.LiveSchemaVersionNum())
+
+	pinnedSchema, newVersion := collection.PinSchemaVersion()
+	s.Same(newSchema, pinnedSchema)
+	s.NotEqual(oldVersion, newVersion)
+	s.NotSame(oldSchema, pinnedSchema)
+
+	// the plan of the old version is created with the pinned schema, the current version uses the schema of segcore.
+	s.Nil(collection.PlanSchema(pinnedSchema, newVersion))
+	planSchema := collection.PlanSchema(oldSchema, oldVersion)
+	s.Require().NotNil(planSchema)
+	s.Same(oldSchema, planSchema.Schema)
+	s.Equal(oldVersion, planSchema.Version)
+
+	// the old version is dropped once it's unpinned.
+	collection.UnpinSchemaVersion(oldVersion)
+	s.Equal(1, collection.LiveSchemaVersionNum())
+
+	// the current version is never dropped.
+	collection.UnpinSchemaVersion(newVersion)
+	s.Equal(1, collection.LiveSchemaVersionNum())
+
+	// unpin an unknown version is ignored.
+	collection.UnpinSchemaVersion(oldVersion)
+	s.Equal(1, collection.LiveSchemaVersionNum())
+}
+
 func (s *CollectionManagerSuite) TestUpdateSchema() {
 	s.Run("normal_case", func() {
 		schema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
@@ -61,7 +102,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 			Nullable: true,
 		})
 
-		err := s.cm.UpdateSchema(1, schema, 100)
+		err := s.cm.UpdateSchema(context.Background(), 1, schema, 100)
 		s.NoError(err)
 		s.Equal(uint64(100), s.cm.Get(1).SchemaVersion())
 	})
@@ -71,7 +112,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 		staleSchema := mock_segcore.GenTestCollectionSchema("stale_collection", schemapb.DataType_Int64, false)
 		staleSchema.Version = int32(currentVersion - 1)
 
-		err := s.cm.UpdateSchema(1, staleSchema, currentVersion+1)
+		err := s.cm.UpdateSchema(context.Background(), 1, staleSchema, currentVersion+1)
 		s.NoError(err)
 
 		updatedSchema, updatedVersion := s.cm.Get(1).SchemaAndVersion()
@@ -83,7 +124,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 		cm := NewCollectionManager()
 		baseSchema := mock_segcore.GenTestCollectionSchema("collection_v7", schemapb.DataType_Int64, false)
 		baseSchema.Version = 7
-		err := cm.PutOrRef(10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
+		err := cm.PutOrRef(context.Background(), 10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
 			LoadType:        querypb.LoadType_LoadCollection,
 			SchemaBarrierTs: 50,
 		})
@@ -99,14 +140,14 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 			Nullable: true,
 		})
 
-		err = cm.UpdateSchema(10, schemaV8, 8)
+		err = cm.UpdateSchema(context.Background(), 10, schemaV8, 8)
 		s.NoError(err)
 		s.Equal(uint64(8), cm.Get(10).SchemaVersion())
 
 		schemaV7 := mock_segcore.GenTestCollectionSchema("collection_v7", schemapb.DataType_Int64, false)
 		schemaV7.Version = 7
 
-		err = cm.UpdateSchema(10, schemaV7, 200)
+		err = cm.UpdateSchema(context.Background(), 10, schemaV7, 200)
 		s.NoError(err)
 
 		updatedSchema, updatedVersion := cm.Get(10).SchemaAndVersion()
@@ -117,7 +158,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 	s.Run("same_schema_version_with_newer_barrier_updates_properties", func() {
 		cm := NewCollectionManager()
 		baseSchema := mock_segcore.GenTestCollectionSchema("collection_v0", schemapb.DataType_Int64, false)
-		err := cm.PutOrRef(10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
+		err := cm.PutOrRef(context.Background(), 10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
 			LoadType:        querypb.LoadType_LoadCollection,
 			SchemaBarrierTs: 50,
 		})
@@ -130,7 +171,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 			{Key: common.CollectionTTLFieldKey, Value: "int64Field"},
 		}
 
-		err = cm.UpdateSchema(10, updatedSchema, 100)
+		err = cm.UpdateSchema(context.Background(), 10, updatedSchema, 100)
 		s.NoError(err)
 
 		schema, version := cm.Get(10).SchemaAndVersion()
@@ -142,7 +183,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 	s.Run("higher_schema_version_after_high_barrier_refresh_uses_monotonic_segcore_schema_version", func() {
 		cm := NewCollectionManager()
 		baseSchema := mock_segcore.GenTestCollectionSchema("collection_v0", schemapb.DataType_Int64, false)
-		err := cm.PutOrRef(10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
+		err := cm.PutOrRef(context.Background(), 10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
 			LoadType:        querypb.LoadType_LoadCollection,
 			SchemaBarrierTs: 100,
 		})
@@ -170,7 +211,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 	s.Run("manager_uses_schema_version_from_caller", func() {
 		cm := NewCollectionManager()
 		baseSchema := mock_segcore.GenTestCollectionSchema("collection_v0", schemapb.DataType_Int64, false)
-		err := cm.PutOrRef(10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
+		err := cm.PutOrRef(context.Background(), 10, baseSchema, mock_segcore.GenTestIndexMeta(10, baseSchema), &querypb.LoadMetaInfo{
 			LoadType: querypb.LoadType_LoadCollection,
 		})
 		s.Require().NoError(err)
@@ -178,7 +219,7 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 
 		schema := mock_segcore.GenTestCollectionSchema("collection_v2", schemapb.DataType_Int64, false)
 		schema.Version = 2
-		err = cm.UpdateSchema(10, schema, 2)
+		err = cm.UpdateSchema(context.Background(), 10, schema, 2)
 		s.NoError(err)
 
 		_, version := cm.Get(10).SchemaAndVersion()
@@ -194,13 +235,13 @@ func (s *CollectionManagerSuite) TestUpdateSchema() {
 			Nullable: true,
 		})
 
-		err := s.cm.UpdateSchema(2, schema, 100)
+		err := s.cm.UpdateSchema(context.Background(), 2, schema, 100)
 		s.Error(err)
 	})
 
 	s.Run("nil_schema", func() {
 		s.NotPanics(func() {
-			err := s.cm.UpdateSchema(1, nil, 101)
+			err := s.cm.UpdateSchema(context.Background(), 1, nil, 101)
 			s.Error(err)
 		})
 	})
@@ -286,7 +327,7 @@ func (s *CollectionManagerSuite) TestPutOrRefUpdateIndexMeta() {
 	s.Require().True(hasNewField, "precondition: new IndexMeta should contain field %d", newVecFieldID)
 
 	// PutOrRef on an existing collection should update its IndexMeta.
-	err := s.cm.PutOrRef(1, schema, newIndexMeta, &querypb.LoadMetaInfo{
+	err := s.cm.PutOrRef(context.Background(), 1, schema, newIndexMeta, &querypb.LoadMetaInfo{
 		LoadType:        querypb.LoadType_LoadCollection,
 		SchemaBarrierTs: 100,
 	})
@@ -312,10 +353,124 @@ func (s *CollectionManagerSuite) TestPutOrRefUpdateIndexMeta() {
 		newVecFieldID)
 }
 
+func (s *CollectionManagerSuite) TestPinSchemaVersionOf() {
+	collection := s.cm.Get(1)
+	oldSchema, oldVersion := collection.PinSchemaVersion()
+
+	newSchema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
+	newSchema.Version = 1
+	s.NoError(s.cm.UpdateSchema(context.Background(), 1, newSchema, 1))
+
+	// the request planned with the old schema version runs against it.
+	schema, version := collection.PinSchemaVersionOf(proto.Int32(0))
+	s.Same(oldSchema, schema)
+	s.Equal(oldVersion, version)
+	collection.UnpinSchemaVersion(version)
+
+	// the request without schema version or with a newer one runs against the current version.
+	for _, v := range []*int32{nil, proto.Int32(1), proto.Int32(2)} {
+		schema, version = collection.PinSchemaVersionOf(v)
+		s.Same(newSchema, schema)
+		s.NotEqual(oldVersion, version)
+		collection.UnpinSchemaVersion(version)
+	}
+
+	// the dropped version falls back to the current version.
+	collection.UnpinSchemaVersion(oldVersion)
+	s.Equal(1, collection.LiveSchemaVersionNum())
+	schema, version = collection.PinSchemaVersionOf(proto.Int32(0))
+	s.Same(newSchema, schema)
+	collection.UnpinSchemaVersion(version)
+}
+
+func (s *CollectionManagerSuite) TestPutOrRefRollbackSchemaOnIndexMetaFailure() {
+	coll := s.cm.Get(1)
+	oldSchema, oldVersion, oldBarrierTs := coll.SchemaSnapshot()
+	_, oldSegcoreVersion := coll.SchemaAndSegcoreVersion()
+	oldIndexMeta := coll.GetCCollection().IndexMeta()
+
+	schema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
+	schema.Version = 2
+	mockIndexMeta := mockey.Mock((*segcore.CCollection).UpdateIndexMeta).Return(errors.New("mock error")).Build()
+	err := s.cm.PutOrRef(context.Background(), 1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
+		LoadType:        querypb.LoadType_LoadCollection,
+		SchemaBarrierTs: 100,
+	})
+	mockIndexMeta.UnPatch()
+	s.Error(err)
+
+	// the schema is rolled back with a newer segcore schema version.
+	rolledBackSchema, version, barrierTs := coll.SchemaSnapshot()
+	_, segcoreVersion := coll.SchemaAndSegcoreVersion()
+	s.Same(oldSchema, rolledBackSchema)
+	s.Equal(oldVersion, version)
+	s.Equal(oldBarrierTs, barrierTs)
+	s.Equal(oldSegcoreVersion+2, segcoreVersion)
+	s.Same(oldIndexMeta, coll.GetCCollection().IndexMeta())
+	s.Equal(uint32(1), coll.refCount.Load())
+
+	// the retry applies the schema and the index meta.
+	err = s.cm.PutOrRef(context.Background(), 1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
+		LoadType:        querypb.LoadType_LoadCollection,
+		SchemaBarrierTs: 100,
+	})
+	s.NoError(err)
+	defer s.cm.Unref(1, 1)
+	updatedSchema, version := coll.SchemaAndVersion()
+	s.Same(schema, updatedSchema)
+	s.Equal(uint64(2), version)
+}
+
+func (s *CollectionManagerSuite) TestPutOrRefKeepNewSchemaOnRollbackFailure() {
+	coll := s.cm.Get(1)
+	_, oldSegcoreVersion := coll.SchemaAndSegcoreVersion()
+
+	schema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
+	schema.Version = 2
+	var originUpdateSchema func(*segcore.CCollection, *schemapb.CollectionSchema, uint64) error
+	calls := 0
+	mockUpdateSchema := mockey.Mock((*segcore.CCollection).UpdateSchema).Origin(&originUpdateSchema).To(
+		func(c *segcore.CCollection, sch *schemapb.CollectionSchema, version uint64) error {
+			calls++
+			if calls > 1 {
+				return errors.New("mock error")
+			}
+			return originUpdateSchema(c, sch, version)
+		}).Build()
+	mockIndexMeta := mockey.Mock((*segcore.CCollection).UpdateIndexMeta).Return(errors.New("mock error")).Build()
+	err := s.cm.PutOrRef(context.Background(), 1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
+		LoadType:        querypb.LoadType_LoadCollection,
+		SchemaBarrierTs: 100,
+	})
+	mockIndexMeta.UnPatch()
+	mockUpdateSchema.UnPatch()
+	s.Error(err)
+	s.Equal(2, calls)
+
+	// the snapshot follows the schema kept by segcore.
+	currentSchema, version := coll.SchemaAndVersion()
+	_, segcoreVersion := coll.SchemaAndSegcoreVersion()
+	s.Same(schema, currentSchema)
+	s.Equal(uint64(2), version)
+	s.Equal(oldSegcoreVersion+1, segcoreVersion)
+
+	// the retry only applies the index meta.
+	newIndexMeta := mock_segcore.GenTestIndexMeta(1, schema)
+	err = s.cm.PutOrRef(context.Background(), 1, schema, newIndexMeta, &querypb.LoadMetaInfo{
+		LoadType:        querypb.LoadType_LoadCollection,
+		SchemaBarrierTs: 100,
+	})
+	s.NoError(err)
+	defer s.cm.Unref(1, 1)
+	s.Same(newIndexMeta, coll.GetCCollection().IndexMeta())
+	_, segcoreVersion = coll.SchemaAndSegcoreVersion()
+	s.Equal(oldSegcoreVersion+1, segcoreVersion)
+}
+
 func (s *CollectionManagerSuite) TestPutOrRefKeepsFreshCollectionInSchemaVersionDomain() {
 	cm := NewCollectionManager()
 	initialSchema := mock_segcore.GenTestCollectionSchema("collection_v0", schemapb.DataType_Int64, false)
-	err := cm.PutOrRef(10, initialSchema, mock_segcore.GenTestIndexMeta(10, initialSchema), &querypb.LoadMetaInfo{
+	err := cm.PutOrRef(context.Background(), 10, initialSchema, mock_segcore.GenTestIndexMeta(10, initialSchema), &querypb.LoadMetaInfo{
 		LoadType:        querypb.LoadType_LoadCollection,
 		SchemaBarrierTs: 100,
 	})
@@ -327,7 +482,7 @@ func (s *CollectionManagerSuite) TestPutOrRefKeepsFreshCollectionInSchemaVersion
 
 	updatedSchema := mock_segcore.GenTestCollectionSchema("collection_v1", schemapb.DataType_Int64, false)
 	updatedSchema.Version = 1
-	err = cm.UpdateSchema(10, updatedSchema, 200)
+	err = cm.UpdateSchema(context.Background(), 10, updatedSchema, 200)
 	s.Require().NoError(err)
 
 	schema, version := cm.Get(10).SchemaAndVersion()
@@ -509,7 +664,7 @@ func (s *CollectionManagerSuite) TestUnref() {
 		// Create a new collection manager for this test
 		cm := NewCollectionManager()
 		schema := mock_segcore.GenTestCollectionSchema("collection_2", schemapb.DataType_Int64, false)
-		err := cm.PutOrRef(2, schema, mock_segcore.GenTestIndexMeta(2, schema), &querypb.LoadMetaInfo{
+		err := cm.PutOrRef(context.Background(), 2, schema, mock_segcore.GenTestIndexMeta(2, schema), &querypb.LoadMetaInfo{
 			LoadType: querypb.LoadType_LoadCollection,
 		})
 		s.Require().NoError(err)
@@ -524,6 +679,23 @@ func (s *CollectionManagerSuite) TestUnref() {
 	})
 }
 
+func (s *CollectionManagerSuite) TestRefInfos() {
+	s.cm.Ref(1, 2)
+	s.cm.Unref(1, 1)
+
+	infos := s.cm.RefInfos()
+	s.Require().Len(infos, 1)
+	s.Equal(int64(1), infos[0].CollectionID)
+	s.Equal(uint32(2), infos[0].RefCount)
+	s.Equal(uint64(1), infos[0].UnrefCount)
+	total := uint64(0)
+	for source, count := range infos[0].RefSources {
+		s.Contains(source, "segments.")
+		total += count
+	}
+	s.Equal(uint64(3), total)
+}
+
 func (s *CollectionManagerSuite) TestList() {
 	ids := s.cm.List()
 	s.Contains(ids, int64(1))
@@ -539,7 +711,7 @@ func (s *CollectionManagerSuite) TestPutOrRef() {
 	s.Run("put_new_collection", func() {
 		cm := NewCollectionManager()
 		schema := mock_segcore.GenTestCollectionSchema("collection_new", schemapb.DataType_Int64, false)
-		err := cm.PutOrRef(100, schema, mock_segcore.GenTestIndexMeta(100, schema), &querypb.LoadMetaInfo{
+		err := cm.PutOrRef(context.Background(), 100, schema, mock_segcore.GenTestIndexMeta(100, schema), &querypb.LoadMetaInfo{
 			LoadType: querypb.LoadType_LoadCollection,
 		})
 		s.NoError(err)
@@ -550,7 +722,7 @@ func (s *CollectionManagerSuite) TestPutOrRef() {
 	s.Run("ref_existing_collection", func() {
 		// Ref existing collection (id=1)
 		schema := mock_segcore.GenTestCollectionSchema("collection_1", schemapb.DataType_Int64, false)
-		err := s.cm.PutOrRef(1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
+		err := s.cm.PutOrRef(context.Background(), 1, schema, mock_segcore.GenTestIndexMeta(1, schema), &querypb.LoadMetaInfo{
 			LoadType: querypb.LoadType_LoadCollection,
 		})
 		s.NoError(err)
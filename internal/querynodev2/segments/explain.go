@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const (
+	explainSegmentSealed  = "sealed"
+	explainSegmentGrowing = "growing"
+
+	explainStrategyIndex        = "index"
+	explainStrategyInterimIndex = "interim_index"
+	explainStrategyBruteForce   = "brute_force"
+	explainStrategyScalarIndex  = "scalar_index"
+	explainStrategyScan         = "scan"
+
+	explainFilterPre       = "pre_filter"
+	explainFilterIterative = "iterative_filter"
+)
+
+// ExplainSearch estimates how the search plan is executed on each searched segment.
+// segcore does not report the strategy it chooses, so the strategy is estimated from the loaded indexes,
+// the interim index config and the search hints, and is labeled as estimated in the output.
+// The cost is a rough estimation to compare the segments, not a prediction of latency.
+func ExplainSearch(collection *Collection, segments []Segment, plan *planpb.PlanNode, nq int64, topk int64) []*internalpb.SegmentExplain {
+	anns := plan.GetVectorAnns()
+	fieldID := anns.GetFieldId()
+	filterFields := collectFilterFieldIDs(anns.GetPredicates())
+	filterOrder := ""
+	if anns.GetPredicates() != nil {
+		filterOrder = explainFilterPre
+		queryInfo := anns.GetQueryInfo()
+		if queryInfo.GetHints() == explainFilterIterative || strings.Contains(queryInfo.GetSearchParams(), explainFilterIterative) {
+			filterOrder = explainFilterIterative
+		}
+	}
+	interimThreshold := interimIndexBuildThreshold(collection, fieldID)
+
+	explains := make([]*internalpb.SegmentExplain, 0, len(segments))
+	for _, segment := range segments {
+		explain := newSegmentExplain(segment, filterFields)
+		explain.EstimatedFilterOrder = filterOrder
+		explain.EstimatedStrategy = explainStrategyBruteForce
+		if indexType, ok := loadedIndexType(segment, fieldID); ok {
+			explain.EstimatedStrategy = explainStrategyIndex
+			explain.IndexType = indexType
+		} else if interimThreshold > 0 && explain.GetNumRows() >= interimThreshold {
+			explain.EstimatedStrategy = explainStrategyInterimIndex
+		}
+		explain.EstimatedCost = estimateSearchCost(explain.GetEstimatedStrategy(), explain.GetNumRows(), nq, topk, filterOrder)
+		explains = append(explains, explain)
+	}
+	return explains
+}
+
+// ExplainRetrieve estimates how the query plan is executed on each retrieved segment, see ExplainSearch.
+func ExplainRetrieve(segments []Segment, plan *planpb.PlanNode) []*internalpb.SegmentExplain {
+	filterFields := collectFilterFieldIDs(plan.GetQuery().GetPredicates())
+
+	explains := make([]*internalpb.SegmentExplain, 0, len(segments))
+	for _, segment := range segments {
+		explain := newSegmentExplain(segment, filterFields)
+		explain.EstimatedStrategy = explainStrategyScan
+		explain.EstimatedCost = explain.GetNumRows()
+		if len(filterFields) > 0 && len(explain.GetIndexedFilterFields()) == len(filterFields) {
+			explain.EstimatedStrategy = explainStrategyScalarIndex
+			explain.EstimatedCost = int64(len(filterFields)) * log2Ceil(explain.GetNumRows())
+		}
+		explains = append(explains, explain)
+	}
+	return explains
+}
+
+func newSegmentExplain(segment Segment, filterFields []int64) *internalpb.SegmentExplain {
+	explain := &internalpb.SegmentExplain{
+		SegmentID:    segment.ID(),
+		NodeID:       paramtable.GetNodeID(),
+		SegmentType:  explainSegmentSealed,
+		NumRows:      segment.RowNum(),
+		FilterFields: filterFields,
+	}
+	if segment.Type() == SegmentTypeGrowing {
+		explain.SegmentType = explainSegmentGrowing
+	}
+	for _, fieldID := range filterFields {
+		if _, ok := loadedIndexType(segment, fieldID); ok {
+			explain.IndexedFilterFields = append(explain.IndexedFilterFields, fieldID)
+		}
+	}
+	return explain
+}
+
+// loadedIndexType returns the index type of the field if its index is loaded on the segment.
+func loadedIndexType(segment Segment, fieldID int64) (string, bool) {
+	for _, index := range segment.GetIndex(fieldID) {
+		if index.IsLoaded {
+			indexType, _ := funcutil.TryGetAttrByKeyFromRepeatedKV(common.IndexTypeKey, index.IndexInfo.GetIndexParams())
+			return indexType, true
+		}
+	}
+	return "", false
+}
+
+// interimIndexBuildThreshold returns the row count from which segcore builds the interim index of the field,
+// returns 0 if the interim index is not built for the field.
+func interimIndexBuildThreshold(collection *Collection, fieldID int64) int64 {
+	params := paramtable.Get().QueryNodeCfg
+	if !params.EnableInterminSegmentIndex.GetAsBool() {
+		return 0
+	}
+	field := typeutil.GetFieldByID(collection.Schema(), fieldID)
+	if field == nil || !typeutil.IsFloatVectorType(field.GetDataType()) {
+		return 0
+	}
+	maxRowCount, err := estimateMaxIndexRowCount(collection.Schema())
+	if err != nil {
+		return 0
+	}
+	return max(int64(float64(maxRowCount)*params.InterimIndexBuildRatio.GetAsFloat()),
+		params.InterimIndexNlist.GetAsInt64()*39)
+}
+
+// estimateSearchCost estimates the number of vectors and rows visited by the search on a segment.
+// The brute force search compares all the vectors, while the index visits about topk*log2(rows) vectors per query.
+// The pre filter evaluates the filter on all the rows, and the iterative filter evaluates it on the visited vectors only.
+func estimateSearchCost(strategy string, rows, nq, topk int64, filterOrder string) int64 {
+	visited := rows
+	if strategy != explainStrategyBruteForce {
+		visited = min(rows, max(topk, 1)*log2Ceil(rows))
+	}
+	cost := nq * visited
+	if filterOrder == explainFilterPre {
+		cost += rows
+	}
+	return cost
+}
+
+func log2Ceil(n int64) int64 {
+	return int64(math.Ceil(math.Log2(float64(n) + 1)))
+}
+
+// collectFilterFieldIDs returns the sorted IDs of the fields referenced by the filter expression.
+func collectFilterFieldIDs(expr *planpb.Expr) []int64 {
+	if expr == nil {
+		return nil
+	}
+	fieldIDs := typeutil.NewSet[int64]()
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		if info, ok := m.Interface().(*planpb.ColumnInfo); ok {
+			fieldIDs.Insert(info.GetFieldId())
+			return
+		}
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsMap():
+				if fd.MapValue().Kind() == protoreflect.MessageKind {
+					v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+						walk(value.Message())
+						return true
+					})
+				}
+			case fd.Kind() != protoreflect.MessageKind:
+			case fd.IsList():
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					walk(list.Get(i).Message())
+				}
+			default:
+				walk(v.Message())
+			}
+			return true
+		})
+	}
+	walk(expr.ProtoReflect())
+	ids := fieldIDs.Collect()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type ExplainSuite struct {
+	suite.Suite
+}
+
+func (s *ExplainSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ExplainSuite) newSegment(id int64, segmentType SegmentType, rows int64, indexes map[int64]string) Segment {
+	segment := NewMockSegment(s.T())
+	segment.EXPECT().ID().Return(id).Maybe()
+	segment.EXPECT().Type().Return(segmentType).Maybe()
+	segment.EXPECT().RowNum().Return(rows).Maybe()
+	segment.EXPECT().GetIndex(mock.Anything).RunAndReturn(func(fieldID int64) []*IndexedFieldInfo {
+		indexType, ok := indexes[fieldID]
+		if !ok {
+			return nil
+		}
+		return []*IndexedFieldInfo{{
+			IndexInfo: &querypb.FieldIndexInfo{
+				FieldID:     fieldID,
+				IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexType}},
+			},
+			IsLoaded: true,
+		}}
+	}).Maybe()
+	return segment
+}
+
+func (s *ExplainSuite) filter(fieldIDs ...int64) *planpb.Expr {
+	exprs := make([]*planpb.Expr, 0, len(fieldIDs))
+	for _, fieldID := range fieldIDs {
+		exprs = append(exprs, &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+					Op:         planpb.OpType_GreaterThan,
+				},
+			},
+		})
+	}
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{Left: exprs[0], Right: exprs[1], Op: planpb.BinaryExpr_LogicalAnd},
+		},
+	}
+}
+
+func (s *ExplainSuite) TestCollectFilterFieldIDs() {
+	s.Nil(collectFilterFieldIDs(nil))
+	s.Equal([]int64{101, 102}, collectFilterFieldIDs(s.filter(102, 101)))
+	s.Equal([]int64{101}, collectFilterFieldIDs(s.filter(101, 101)))
+}
+
+func (s *ExplainSuite) TestExplainSearch() {
+	segments := []Segment{
+		s.newSegment(1, SegmentTypeSealed, 10000, map[int64]string{100: "HNSW", 101: "INVERTED"}),
+		s.newSegment(2, SegmentTypeGrowing, 100, nil),
+	}
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId:    100,
+				Predicates: s.filter(101),
+				QueryInfo:  &planpb.QueryInfo{Topk: 10},
+			},
+		},
+	}
+
+	explains := ExplainSearch(nil, segments, plan, 2, 10)
+	s.Require().Len(explains, 2)
+	s.EqualValues(1, explains[0].GetSegmentID())
+	s.Equal(explainSegmentSealed, explains[0].GetSegmentType())
+	s.Equal(explainStrategyIndex, explains[0].GetEstimatedStrategy())
+	s.Equal("HNSW", explains[0].GetIndexType())
+	s.Equal(explainFilterPre, explains[0].GetEstimatedFilterOrder())
+	s.Equal([]int64{101}, explains[0].GetIndexedFilterFields())
+	s.EqualValues(2*10*14+10000, explains[0].GetEstimatedCost())
+
+	s.Equal(explainSegmentGrowing, explains[1].GetSegmentType())
+	s.Equal(explainStrategyBruteForce, explains[1].GetEstimatedStrategy())
+	s.Empty(explains[1].GetIndexType())
+	s.Empty(explains[1].GetIndexedFilterFields())
+	s.EqualValues(2*100+100, explains[1].GetEstimatedCost())
+
+	// the iterative filter evaluates the filter on the visited vectors only
+	plan.GetVectorAnns().QueryInfo.Hints = explainFilterIterative
+	explains = ExplainSearch(nil, segments, plan, 2, 10)
+	s.Equal(explainFilterIterative, explains[1].GetEstimatedFilterOrder())
+	s.EqualValues(2*100, explains[1].GetEstimatedCost())
+}
+
+func (s *ExplainSuite) TestExplainRetrieve() {
+	segments := []Segment{
+		s.newSegment(1, SegmentTypeSealed, 1000, map[int64]string{101: "INVERTED", 102: "STL_SORT"}),
+		s.newSegment(2, SegmentTypeSealed, 1000, map[int64]string{101: "INVERTED"}),
+	}
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{
+			Query: &planpb.QueryPlanNode{Predicates: s.filter(101, 102)},
+		},
+	}
+
+	explains := ExplainRetrieve(segments, plan)
+	s.Require().Len(explains, 2)
+	s.Equal([]int64{101, 102}, explains[0].GetFilterFields())
+	s.Equal(explainStrategyScalarIndex, explains[0].GetEstimatedStrategy())
+	s.EqualValues(2*10, explains[0].GetEstimatedCost())
+	s.Equal([]int64{101}, explains[1].GetIndexedFilterFields())
+	s.Equal(explainStrategyScan, explains[1].GetEstimatedStrategy())
+	s.EqualValues(1000, explains[1].GetEstimatedCost())
+}
+
+func TestExplain(t *testing.T) {
+	suite.Run(t, new(ExplainSuite))
+}
@@ -0,0 +1,322 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// gpuIndexEvictor evicts the GPU indexes of the loaded segments to host memory.
+type gpuIndexEvictor interface {
+	// AccessQPS returns the access rate of the segment, the least accessed segments are evicted first.
+	AccessQPS(segmentID int64) float64
+	// Evict releases the GPU indexes of the segment, the segment keeps serving with the raw data in host memory.
+	// The evicted indexes are still reported in the data distribution, so the querycoord doesn't load them back.
+	Evict(ctx context.Context, segmentID int64) error
+}
+
+// GPUMemoryPool pools the GPU memory across the GPU indexes loaded by the querynode.
+// The free memory reported by CUDA doesn't count the indexes being loaded,
+// so the pool accounts the memory reserved by each segment,
+// places each index onto the device with the least room left that still fits it (best fit),
+// which keeps the large free blocks for the large indexes,
+// and evicts the least accessed indexes to host memory if there's no room at all.
+// A nil pool only checks the free memory of the devices without accounting.
+type GPUMemoryPool struct {
+	mu sync.Mutex
+	// segmentID -> indexID -> reservation
+	reserved map[int64]map[int64]gpuReservation
+
+	getMemoryInfo func() ([]hardware.GPUMemoryInfo, error)
+	evictor       gpuIndexEvictor
+}
+
+// gpuReservation is the GPU memory reserved by an index on the device.
+type gpuReservation struct {
+	device int
+	size   uint64
+}
+
+func newGPUMemoryPool(evictor gpuIndexEvictor) *GPUMemoryPool {
+	return &GPUMemoryPool{
+		reserved:      make(map[int64]map[int64]gpuReservation),
+		getMemoryInfo: hardware.GetAllGPUMemoryInfo,
+		evictor:       evictor,
+	}
+}
+
+// Reserve reserves the GPU memory of the given segments, segmentID -> indexID -> GPU memory size of the index.
+// The reservation is all or nothing, and is kept until the segment is released.
+// The index already reserved by the segment is skipped,
+// so requesting the resource again for the loaded segment, e.g. reopen or load delta logs, doesn't reserve it twice.
+func (p *GPUMemoryPool) Reserve(ctx context.Context, requests map[int64]map[int64]uint64) error {
+	if p == nil {
+		p = newGPUMemoryPool(nil)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	requests = p.filterReserved(requests)
+	requestNum := 0
+	for _, sizes := range requests {
+		requestNum += len(sizes)
+	}
+	if requestNum == 0 {
+		return nil
+	}
+
+	evicted := false
+	for {
+		infos, err := p.getMemoryInfo()
+		if err != nil {
+			return err
+		}
+		placement, ok := p.place(infos, requests)
+		if ok {
+			for segmentID, indexes := range placement {
+				if p.reserved[segmentID] == nil {
+					p.reserved[segmentID] = make(map[int64]gpuReservation)
+				}
+				for indexID, reservation := range indexes {
+					p.reserved[segmentID][indexID] = reservation
+				}
+			}
+			p.updateMetrics(infos)
+			return nil
+		}
+
+		if !paramtable.Get().GpuConfig.MemoryPoolEvictionEnabled.GetAsBool() || !p.evictOne(ctx, requests) {
+			mlog.Warn(ctx, "load segment failed, GPU OOM if loaded",
+				mlog.String("resourceType", "GPU"),
+				mlog.Int("indexNum", requestNum),
+				mlog.Bool("evicted", evicted),
+				mlog.Any("gpuMemoryInfos", infos),
+			)
+			return merr.WrapErrSegmentRequestResourceFailed("GPU")
+		}
+		evicted = true
+	}
+}
+
+// filterReserved returns the requests without the indexes already reserved, must be called with the lock held.
+func (p *GPUMemoryPool) filterReserved(requests map[int64]map[int64]uint64) map[int64]map[int64]uint64 {
+	result := make(map[int64]map[int64]uint64, len(requests))
+	for segmentID, sizes := range requests {
+		for indexID, size := range sizes {
+			if _, ok := p.reserved[segmentID][indexID]; ok {
+				continue
+			}
+			if result[segmentID] == nil {
+				result[segmentID] = make(map[int64]uint64)
+			}
+			result[segmentID][indexID] = size
+		}
+	}
+	return result
+}
+
+// Release releases the GPU memory reserved by the segment.
+func (p *GPUMemoryPool) Release(segmentID int64) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.reserved[segmentID]; !ok {
+		return
+	}
+	delete(p.reserved, segmentID)
+	if infos, err := p.getMemoryInfo(); err == nil {
+		p.updateMetrics(infos)
+	}
+}
+
+// Reserved returns the GPU memory reserved on each device.
+func (p *GPUMemoryPool) Reserved() map[int]uint64 {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.reservedByDevice()
+}
+
+func (p *GPUMemoryPool) reservedByDevice() map[int]uint64 {
+	result := make(map[int]uint64)
+	for _, indexes := range p.reserved {
+		for _, reservation := range indexes {
+			result[reservation.device] += reservation.size
+		}
+	}
+	return result
+}
+
+// place assigns each index to the device with the least room left that still fits it,
+// the larger indexes are placed first.
+func (p *GPUMemoryPool) place(infos []hardware.GPUMemoryInfo, requests map[int64]map[int64]uint64) (map[int64]map[int64]gpuReservation, bool) {
+	threshold := paramtable.Get().GpuConfig.OverloadedMemoryThresholdPercentage.GetAsFloat()
+	reserved := p.reservedByDevice()
+	free := make([]int64, len(infos))
+	for i, info := range infos {
+		// the reserved memory of the indexes being loaded is not allocated yet,
+		// while the memory allocated out of the pool is not reserved
+		used := info.TotalMemory - info.FreeMemory
+		if reserved[i] > used {
+			used = reserved[i]
+		}
+		free[i] = int64(float64(info.TotalMemory)*threshold) - int64(used)
+	}
+
+	type indexRequest struct {
+		segmentID int64
+		indexID   int64
+		size      uint64
+	}
+	var indexes []indexRequest
+	for segmentID, sizes := range requests {
+		for indexID, size := range sizes {
+			indexes = append(indexes, indexRequest{segmentID: segmentID, indexID: indexID, size: size})
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		if indexes[i].size != indexes[j].size {
+			return indexes[i].size > indexes[j].size
+		}
+		return indexes[i].indexID < indexes[j].indexID
+	})
+
+	placement := make(map[int64]map[int64]gpuReservation)
+	for _, index := range indexes {
+		device := -1
+		for i := range free {
+			if free[i] < int64(index.size) {
+				continue
+			}
+			if device == -1 || free[i] < free[device] {
+				device = i
+			}
+		}
+		if device == -1 {
+			return nil, false
+		}
+		free[device] -= int64(index.size)
+		if placement[index.segmentID] == nil {
+			placement[index.segmentID] = make(map[int64]gpuReservation)
+		}
+		placement[index.segmentID][index.indexID] = gpuReservation{device: device, size: index.size}
+	}
+	return placement, true
+}
+
+// evictOne evicts the GPU indexes of the least accessed segment which is not requesting,
+// returns false if there's no segment could be evicted.
+func (p *GPUMemoryPool) evictOne(ctx context.Context, requests map[int64]map[int64]uint64) bool {
+	if p.evictor == nil {
+		return false
+	}
+	candidates := make([]int64, 0, len(p.reserved))
+	for segmentID := range p.reserved {
+		if _, ok := requests[segmentID]; !ok {
+			candidates = append(candidates, segmentID)
+		}
+	}
+	qps := make(map[int64]float64, len(candidates))
+	for _, segmentID := range candidates {
+		qps[segmentID] = p.evictor.AccessQPS(segmentID)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return qps[candidates[i]] < qps[candidates[j]]
+	})
+
+	for _, segmentID := range candidates {
+		if err := p.evictor.Evict(ctx, segmentID); err != nil {
+			mlog.Debug(ctx, "segment is not evictable from GPU", mlog.Int64("segmentID", segmentID), mlog.Err(err))
+			continue
+		}
+		mlog.Info(ctx, "evict GPU indexes of segment to host memory",
+			mlog.Int64("segmentID", segmentID),
+			mlog.Float64("qps", qps[segmentID]),
+			mlog.Int("indexNum", len(p.reserved[segmentID])),
+		)
+		delete(p.reserved, segmentID)
+		metrics.QueryNodeGPUIndexEvictTotal.WithLabelValues(paramtable.GetStringNodeID()).Inc()
+		return true
+	}
+	return false
+}
+
+func (p *GPUMemoryPool) updateMetrics(infos []hardware.GPUMemoryInfo) {
+	threshold := paramtable.Get().GpuConfig.OverloadedMemoryThresholdPercentage.GetAsFloat()
+	reserved := p.reservedByDevice()
+	nodeID := paramtable.GetStringNodeID()
+	for i, info := range infos {
+		device := strconv.Itoa(i)
+		metrics.QueryNodeGPUMemoryReservedBytes.WithLabelValues(nodeID, device).Set(float64(reserved[i]))
+		if capacity := float64(info.TotalMemory) * threshold; capacity > 0 {
+			metrics.QueryNodeGPUMemoryUtilization.WithLabelValues(nodeID, device).Set(float64(reserved[i]) / capacity)
+		}
+	}
+}
+
+// segmentGPUIndexEvictor evicts the GPU indexes of the sealed segments managed by the segment manager.
+type segmentGPUIndexEvictor struct {
+	segments SegmentManager
+}
+
+func (e *segmentGPUIndexEvictor) AccessQPS(segmentID int64) float64 {
+	segment := e.segments.GetSealed(segmentID)
+	if segment == nil {
+		return 0
+	}
+	return segment.AccessQPS()
+}
+
+func (e *segmentGPUIndexEvictor) Evict(ctx context.Context, segmentID int64) error {
+	segment, ok := e.segments.GetSealed(segmentID).(*LocalSegment)
+	if !ok {
+		return merr.WrapErrSegmentNotLoaded(segmentID)
+	}
+
+	var gpuIndexes []*IndexedFieldInfo
+	for _, index := range segment.Indexes() {
+		if !gpuIndexRequiresGpu(index.IndexInfo.GetIndexParams()) {
+			continue
+		}
+		// the segment must be able to serve without the GPU index
+		if !segment.HasFieldData(index.IndexInfo.GetFieldID()) {
+			return merr.WrapErrServiceInternal(fmt.Sprintf("raw data of field %d not loaded", index.IndexInfo.GetFieldID()))
+		}
+		gpuIndexes = append(gpuIndexes, index)
+	}
+	for _, index := range gpuIndexes {
+		if err := segment.EvictIndex(ctx, index.IndexInfo.GetIndexID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
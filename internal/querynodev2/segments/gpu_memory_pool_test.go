@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/hardware"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type fakeGPUIndexEvictor struct {
+	qps       map[int64]float64
+	evictable map[int64]bool
+	evicted   []int64
+}
+
+func (e *fakeGPUIndexEvictor) AccessQPS(segmentID int64) float64 {
+	return e.qps[segmentID]
+}
+
+func (e *fakeGPUIndexEvictor) Evict(ctx context.Context, segmentID int64) error {
+	if !e.evictable[segmentID] {
+		return errors.New("not evictable")
+	}
+	e.evicted = append(e.evicted, segmentID)
+	return nil
+}
+
+func newTestGPUMemoryPool(evictor gpuIndexEvictor, totals ...uint64) *GPUMemoryPool {
+	pool := newGPUMemoryPool(evictor)
+	pool.getMemoryInfo = func() ([]hardware.GPUMemoryInfo, error) {
+		infos := make([]hardware.GPUMemoryInfo, 0, len(totals))
+		for _, total := range totals {
+			infos = append(infos, hardware.GPUMemoryInfo{TotalMemory: total, FreeMemory: total})
+		}
+		return infos, nil
+	}
+	return pool
+}
+
+func TestGPUMemoryPool_BestFit(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.GpuConfig.OverloadedMemoryThresholdPercentage.Key, "100")
+	defer params.Reset(params.GpuConfig.OverloadedMemoryThresholdPercentage.Key)
+
+	ctx := context.Background()
+	pool := newTestGPUMemoryPool(nil, 100, 60)
+
+	// placed onto the device with the least room left
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{1: {101: 50}}))
+	assert.Equal(t, map[int]uint64{1: 50}, pool.Reserved())
+	// the large free block is kept for the large index
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{2: {201: 90}}))
+	assert.Equal(t, map[int]uint64{0: 90, 1: 50}, pool.Reserved())
+
+	// the index already reserved is not reserved again
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{1: {101: 50}, 2: {201: 90}}))
+	assert.Equal(t, map[int]uint64{0: 90, 1: 50}, pool.Reserved())
+
+	// all or nothing
+	err := pool.Reserve(ctx, map[int64]map[int64]uint64{3: {301: 10}, 4: {401: 20}})
+	assert.ErrorIs(t, err, merr.ErrSegmentRequestResourceFailed)
+	assert.Equal(t, map[int]uint64{0: 90, 1: 50}, pool.Reserved())
+
+	pool.Release(1)
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{3: {301: 10}, 4: {401: 20}}))
+	assert.Equal(t, map[int]uint64{0: 100, 1: 20}, pool.Reserved())
+
+	// no index requires GPU
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{5: nil}))
+
+	pool.getMemoryInfo = func() ([]hardware.GPUMemoryInfo, error) {
+		return nil, errors.New("mock error")
+	}
+	assert.Error(t, pool.Reserve(ctx, map[int64]map[int64]uint64{5: {501: 1}}))
+}
+
+func TestGPUMemoryPool_Evict(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.GpuConfig.OverloadedMemoryThresholdPercentage.Key, "100")
+	defer params.Reset(params.GpuConfig.OverloadedMemoryThresholdPercentage.Key)
+
+	ctx := context.Background()
+	evictor := &fakeGPUIndexEvictor{
+		qps:       map[int64]float64{1: 0.1, 2: 10, 3: 1},
+		evictable: map[int64]bool{2: true, 3: true},
+	}
+	pool := newTestGPUMemoryPool(evictor, 100)
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{1: {101: 30}, 2: {201: 30}, 3: {301: 30}}))
+
+	// eviction disabled
+	err := pool.Reserve(ctx, map[int64]map[int64]uint64{4: {401: 40}})
+	assert.ErrorIs(t, err, merr.ErrSegmentRequestResourceFailed)
+	assert.Empty(t, evictor.evicted)
+
+	params.Save(params.GpuConfig.MemoryPoolEvictionEnabled.Key, "true")
+	defer params.Reset(params.GpuConfig.MemoryPoolEvictionEnabled.Key)
+
+	// the least accessed evictable segment is evicted
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{4: {401: 40}}))
+	assert.Equal(t, []int64{3}, evictor.evicted)
+	assert.Equal(t, map[int]uint64{0: 100}, pool.Reserved())
+
+	// segment 1 is not evictable, segment 4 is requesting
+	assert.NoError(t, pool.Reserve(ctx, map[int64]map[int64]uint64{4: {402: 30}}))
+	assert.Equal(t, []int64{3, 2}, evictor.evicted)
+
+	err = pool.Reserve(ctx, map[int64]map[int64]uint64{4: {403: 10}})
+	assert.ErrorIs(t, err, merr.ErrSegmentRequestResourceFailed)
+}
+
+func TestGPUMemoryPool_Nil(t *testing.T) {
+	var pool *GPUMemoryPool
+	assert.NoError(t, pool.Reserve(context.Background(), nil))
+	pool.Release(1)
+	assert.Nil(t, pool.Reserved())
+}
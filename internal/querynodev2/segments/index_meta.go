@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -41,14 +42,9 @@ func ComposeIndexMeta(ctx context.Context, indexInfos []*indexpb.IndexInfo, sche
 			UserIndexParams: info.GetUserIndexParams(),
 		})
 	}
-	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
-	maxIndexRecordPerSegment := int64(0)
-	if err != nil || sizePerRecord == 0 {
+	maxIndexRecordPerSegment, err := estimateMaxIndexRowCount(schema)
+	if err != nil {
 		mlog.Warn(ctx, "failed to transfer segment size to collection, because failed to estimate size per record", mlog.Err(err))
-	} else {
-		threshold := paramtable.Get().DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
-		proportion := paramtable.Get().DataCoordCfg.SegmentSealProportion.GetAsFloat()
-		maxIndexRecordPerSegment = int64(threshold * proportion / float64(sizePerRecord))
 	}
 
 	return &segcorepb.CollectionIndexMeta{
@@ -56,3 +52,17 @@ func ComposeIndexMeta(ctx context.Context, indexInfos []*indexpb.IndexInfo, sche
 		MaxIndexRowCount: maxIndexRecordPerSegment,
 	}
 }
+
+// estimateMaxIndexRowCount estimates the max row count of a segment by the configured segment size.
+func estimateMaxIndexRowCount(schema *schemapb.CollectionSchema) (int64, error) {
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+	if sizePerRecord == 0 {
+		return 0, merr.WrapErrServiceInternal("estimated size per record is zero")
+	}
+	threshold := paramtable.Get().DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
+	proportion := paramtable.Get().DataCoordCfg.SegmentSealProportion.GetAsFloat()
+	return int64(threshold * proportion / float64(sizePerRecord)), nil
+}
@@ -0,0 +1,426 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// randomScoreFieldIDKey is the param of the random score function that keeps the id of the seed field.
+const randomScoreFieldIDKey = "field_id"
+
+// lazyFieldTracker tracks the fields referenced by the searches and queries of a collection,
+// and the fields of its sealed segments that are not loaded yet.
+// The hot fields, referenced by at least hotFieldMinAccessCount requests, are loaded with the new segments,
+// the other scalar fields without index are left in the object storage,
+// and loaded into the segment when a request references them.
+type lazyFieldTracker struct {
+	mu       sync.Mutex
+	accessed map[int64]int64 // fieldID -> number of requests referencing the field
+	segments map[Segment]*lazySegmentFields
+}
+
+// lazySegmentFields is the binlogs of a sealed segment not loaded yet.
+type lazySegmentFields struct {
+	mu       sync.Mutex // serializes the reopening of the segment
+	unloaded map[int64]*datapb.FieldBinlog
+}
+
+func newLazyFieldTracker() *lazyFieldTracker {
+	return &lazyFieldTracker{
+		accessed: make(map[int64]int64),
+		segments: make(map[Segment]*lazySegmentFields),
+	}
+}
+
+func lazyFieldEnabled() bool {
+	return paramtable.Get().QueryNodeCfg.LazyFieldEnabled.GetAsBool()
+}
+
+// recordAccess records the fields referenced by a request.
+func (t *lazyFieldTracker) recordAccess(fields typeutil.Set[int64]) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for fieldID := range fields {
+		t.accessed[fieldID]++
+	}
+}
+
+// hotFields returns the fields referenced by at least hotFieldMinAccessCount requests.
+func (t *lazyFieldTracker) hotFields() typeutil.Set[int64] {
+	minAccessCount := paramtable.Get().QueryNodeCfg.LazyFieldHotFieldMinAccessCount.GetAsInt64()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hot := typeutil.NewSet[int64]()
+	for fieldID, count := range t.accessed {
+		if count >= minAccessCount {
+			hot.Insert(fieldID)
+		}
+	}
+	return hot
+}
+
+// strip returns the load info without the binlogs of the cold fields, and the stripped binlogs.
+// A binlog of a column group is stripped only if all fields of the group are cold.
+func (t *lazyFieldTracker) strip(schema *schemapb.CollectionSchema, loadInfo *querypb.SegmentLoadInfo) (*querypb.SegmentLoadInfo, []*datapb.FieldBinlog) {
+	if t == nil || !lazyFieldEnabled() || loadInfo.GetLevel() == datapb.SegmentLevel_L0 ||
+		loadInfo.GetManifestPath() != "" || typeutil.IsExternalCollection(schema) {
+		return loadInfo, nil
+	}
+	candidates := lazyFieldCandidates(schema, loadInfo)
+	hot := t.hotFields()
+	return splitBinlogs(loadInfo, func(fieldIDs []int64) bool {
+		for _, fieldID := range fieldIDs {
+			if !candidates.Contain(fieldID) || hot.Contain(fieldID) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// track starts tracking the stripped binlogs of the segment, it must be called before the segment is put into the segment manager.
+func (t *lazyFieldTracker) track(segment Segment, unloaded []*datapb.FieldBinlog) {
+	if t == nil || len(unloaded) == 0 {
+		return
+	}
+	record := &lazySegmentFields{unloaded: make(map[int64]*datapb.FieldBinlog, len(unloaded))}
+	for _, binlog := range unloaded {
+		record.unloaded[binlog.GetFieldID()] = binlog
+	}
+	addUnloadedBytes(unloaded, 1)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if old, ok := t.segments[segment]; ok {
+		addUnloadedBytes(old.binlogs(), -1)
+	}
+	t.segments[segment] = record
+}
+
+// untrack stops tracking the segment, it's called when the segment is released.
+func (t *lazyFieldTracker) untrack(segment Segment) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	record, ok := t.segments[segment]
+	delete(t.segments, segment)
+	t.mu.Unlock()
+	if !ok {
+		return
+	}
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	addUnloadedBytes(record.binlogs(), -1)
+	record.unloaded = nil
+}
+
+func (t *lazyFieldTracker) get(segment Segment) *lazySegmentFields {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.segments[segment]
+}
+
+// UnloadedFields returns the fields of the segment not loaded yet.
+func (t *lazyFieldTracker) UnloadedFields(segment Segment) []int64 {
+	record := t.get(segment)
+	if record == nil {
+		return nil
+	}
+	record.mu.Lock()
+	defer record.mu.Unlock()
+	fields := make([]int64, 0, len(record.unloaded))
+	for _, binlog := range record.unloaded {
+		fields = append(fields, binlogFieldIDs(binlog)...)
+	}
+	return fields
+}
+
+// load loads the binlogs of the referenced fields not loaded yet into the segment.
+func (t *lazyFieldTracker) load(ctx context.Context, segment Segment, fields typeutil.Set[int64]) error {
+	record := t.get(segment)
+	if record == nil {
+		return nil
+	}
+	record.mu.Lock()
+	defer record.mu.Unlock()
+
+	var binlogs []*datapb.FieldBinlog
+	for _, binlog := range record.unloaded {
+		for _, fieldID := range binlogFieldIDs(binlog) {
+			if fields.Contain(fieldID) {
+				binlogs = append(binlogs, binlog)
+				break
+			}
+		}
+	}
+	if len(binlogs) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	loadInfo := typeutil.Clone(segment.LoadInfo())
+	loadInfo.BinlogPaths = append(loadInfo.BinlogPaths, binlogs...)
+	if err := segment.Reopen(ctx, loadInfo); err != nil {
+		mlog.Warn(ctx, "failed to load the lazy fields of segment", mlog.FieldSegmentID(segment.ID()), mlog.Err(err))
+		return err
+	}
+	for _, binlog := range binlogs {
+		delete(record.unloaded, binlog.GetFieldID())
+	}
+	addUnloadedBytes(binlogs, -1)
+	nodeID := paramtable.GetStringNodeID()
+	metrics.QueryNodeLazyFieldLoadTotal.WithLabelValues(nodeID).Add(float64(len(binlogs)))
+	metrics.QueryNodeLazyFieldLoadLatency.WithLabelValues(nodeID).Observe(float64(time.Since(start).Milliseconds()))
+	return nil
+}
+
+// reopen reopens the segment with the new load info, the binlogs of the fields not loaded yet are stripped from it,
+// so the reopening doesn't load them, and the fields loaded on demand are kept.
+// The fields not loadable on demand any more, e.g. an index is built on them, are loaded by the reopening.
+func (t *lazyFieldTracker) reopen(ctx context.Context, schema *schemapb.CollectionSchema, segment Segment, loadInfo *querypb.SegmentLoadInfo) error {
+	record := t.get(segment)
+	if record == nil {
+		return segment.Reopen(ctx, loadInfo)
+	}
+	record.mu.Lock()
+	defer record.mu.Unlock()
+
+	candidates := lazyFieldCandidates(schema, loadInfo)
+	stripped, unloaded := splitBinlogsBy(loadInfo, func(binlog *datapb.FieldBinlog) bool {
+		if _, ok := record.unloaded[binlog.GetFieldID()]; !ok {
+			return false
+		}
+		for _, fieldID := range binlogFieldIDs(binlog) {
+			if !candidates.Contain(fieldID) {
+				return false
+			}
+		}
+		return true
+	})
+	if err := segment.Reopen(ctx, stripped); err != nil {
+		return err
+	}
+	addUnloadedBytes(record.binlogs(), -1)
+	addUnloadedBytes(unloaded, 1)
+	record.unloaded = make(map[int64]*datapb.FieldBinlog, len(unloaded))
+	for _, binlog := range unloaded {
+		record.unloaded[binlog.GetFieldID()] = binlog
+	}
+	return nil
+}
+
+func (r *lazySegmentFields) binlogs() []*datapb.FieldBinlog {
+	binlogs := make([]*datapb.FieldBinlog, 0, len(r.unloaded))
+	for _, binlog := range r.unloaded {
+		binlogs = append(binlogs, binlog)
+	}
+	return binlogs
+}
+
+// loadLazyFields records the fields referenced by the request,
+// and loads the ones not loaded yet into the pinned sealed segments before the request is executed on them.
+func loadLazyFields(ctx context.Context, manager *Manager, collectionID int64, segments []Segment, serializedPlan []byte) error {
+	if !lazyFieldEnabled() {
+		return nil
+	}
+	collection := manager.Collection.Get(collectionID)
+	if collection == nil {
+		return nil
+	}
+	fields, err := referencedFields(serializedPlan)
+	if err != nil {
+		return err
+	}
+	collection.lazyFields.recordAccess(fields)
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(paramtable.Get().QueryNodeCfg.LoadMaxConcurrency.GetAsInt())
+	for _, segment := range segments {
+		if segment.Type() != SegmentTypeSealed {
+			continue
+		}
+		group.Go(func() error {
+			return collection.lazyFields.load(ctx, segment, fields)
+		})
+	}
+	return group.Wait()
+}
+
+// referencedFields returns the fields referenced by the plan:
+// the output fields, the fields in the filter, the search field, and the fields to group, order and aggregate by.
+func referencedFields(serializedPlan []byte) (typeutil.Set[int64], error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	fields := typeutil.NewSet[int64]()
+	collectFieldIDs(plan.ProtoReflect(), fields)
+	for _, scorer := range plan.GetScorers() {
+		for _, param := range scorer.GetParams() {
+			if param.GetKey() != randomScoreFieldIDKey {
+				continue
+			}
+			if fieldID, err := strconv.ParseInt(param.GetValue(), 10, 64); err == nil {
+				fields.Insert(fieldID)
+			}
+		}
+	}
+	return fields, nil
+}
+
+// collectFieldIDs collects the field ids in the message of the plan recursively,
+// every int64 field of the plan named as a field id refers to a field of the collection.
+func collectFieldIDs(msg protoreflect.Message, fields typeutil.Set[int64]) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.Message() != nil && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				collectFieldIDs(list.Get(i).Message(), fields)
+			}
+		case fd.Message() != nil:
+			collectFieldIDs(v.Message(), fields)
+		case fd.Kind() == protoreflect.Int64Kind && isFieldIDName(fd.Name()):
+			if fd.IsList() {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					fields.Insert(list.Get(i).Int())
+				}
+			} else {
+				fields.Insert(v.Int())
+			}
+		}
+		return true
+	})
+}
+
+func isFieldIDName(name protoreflect.Name) bool {
+	switch name {
+	case "field_id", "query_field_id", "group_by_field_id", "group_by_field_ids", "output_field_ids":
+		return true
+	default:
+		return false
+	}
+}
+
+// lazyFieldCandidates returns the fields that can be loaded on demand.
+// The fields needed by the segment itself, the indexed fields,
+// and the fields whose text index or json stats are built or loaded with the segment are always loaded.
+func lazyFieldCandidates(schema *schemapb.CollectionSchema, loadInfo *querypb.SegmentLoadInfo) typeutil.Set[int64] {
+	excluded := typeutil.NewSet[int64]()
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		excluded.Insert(indexInfo.GetFieldID())
+	}
+	for fieldID := range loadInfo.GetTextStatsLogs() {
+		excluded.Insert(fieldID)
+	}
+	for fieldID := range loadInfo.GetJsonKeyStatsLogs() {
+		excluded.Insert(fieldID)
+	}
+	ttlField := ""
+	for _, kv := range schema.GetProperties() {
+		if kv.GetKey() == common.CollectionTTLFieldKey {
+			ttlField = kv.GetValue()
+		}
+	}
+
+	candidates := typeutil.NewSet[int64]()
+	for _, field := range typeutil.GetAllFieldSchemas(schema) {
+		if field.GetFieldID() < common.StartOfUserFieldID ||
+			excluded.Contain(field.GetFieldID()) ||
+			field.GetIsPrimaryKey() ||
+			field.GetIsPartitionKey() ||
+			field.GetIsClusteringKey() ||
+			field.GetIsFunctionOutput() ||
+			field.GetIsDynamic() ||
+			field.GetName() == common.NamespaceFieldName ||
+			field.GetName() == ttlField ||
+			typeutil.IsVectorType(field.GetDataType()) ||
+			typeutil.CreateFieldSchemaHelper(field).EnableMatch() {
+			continue
+		}
+		candidates.Insert(field.GetFieldID())
+	}
+	return candidates
+}
+
+// splitBinlogs splits the binlogs of the load info by the fields of each binlog.
+func splitBinlogs(loadInfo *querypb.SegmentLoadInfo, strip func(fieldIDs []int64) bool) (*querypb.SegmentLoadInfo, []*datapb.FieldBinlog) {
+	return splitBinlogsBy(loadInfo, func(binlog *datapb.FieldBinlog) bool {
+		return strip(binlogFieldIDs(binlog))
+	})
+}
+
+// splitBinlogsBy returns the load info without the binlogs to strip, and the stripped binlogs.
+func splitBinlogsBy(loadInfo *querypb.SegmentLoadInfo, strip func(binlog *datapb.FieldBinlog) bool) (*querypb.SegmentLoadInfo, []*datapb.FieldBinlog) {
+	var kept, stripped []*datapb.FieldBinlog
+	for _, binlog := range loadInfo.GetBinlogPaths() {
+		if strip(binlog) {
+			stripped = append(stripped, binlog)
+		} else {
+			kept = append(kept, binlog)
+		}
+	}
+	if len(stripped) == 0 {
+		return loadInfo, nil
+	}
+	result := typeutil.Clone(loadInfo)
+	result.BinlogPaths = kept
+	return result, stripped
+}
+
+// binlogFieldIDs returns the fields of the binlog, the child fields if it's a column group.
+func binlogFieldIDs(binlog *datapb.FieldBinlog) []int64 {
+	if len(binlog.GetChildFields()) > 0 {
+		return binlog.GetChildFields()
+	}
+	return []int64{binlog.GetFieldID()}
+}
+
+func addUnloadedBytes(binlogs []*datapb.FieldBinlog, sign int) {
+	size := int64(0)
+	for _, binlog := range binlogs {
+		size += getBinlogDataDiskSize(binlog)
+	}
+	metrics.QueryNodeLazyFieldUnloadedBytes.WithLabelValues(paramtable.GetStringNodeID()).Add(float64(int64(sign) * size))
+}
@@ -0,0 +1,281 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+func enableLazyField(t *testing.T, minAccess string) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.LazyFieldEnabled.Key, "true")
+	params.Save(params.QueryNodeCfg.LazyFieldHotFieldMinAccessCount.Key, minAccess)
+	t.Cleanup(func() {
+		params.Reset(params.QueryNodeCfg.LazyFieldEnabled.Key)
+		params.Reset(params.QueryNodeCfg.LazyFieldHotFieldMinAccessCount.Key)
+	})
+}
+
+// newLazyFieldTestSchema returns the schema with pk 100, vector 101, scalars 102, 103, 104, partition key 105 and dynamic field 106.
+func newLazyFieldTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "lazy_field",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "8"}}},
+			{FieldID: 102, Name: "title", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "price", DataType: schemapb.DataType_Int64},
+			{FieldID: 104, Name: "stock", DataType: schemapb.DataType_Int64},
+			{FieldID: 105, Name: "tenant", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+			{FieldID: 106, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+}
+
+func newLazyFieldBinlog(fieldID int64, childFields ...int64) *datapb.FieldBinlog {
+	return &datapb.FieldBinlog{
+		FieldID:     fieldID,
+		ChildFields: childFields,
+		Binlogs:     []*datapb.Binlog{{LogID: fieldID, LogSize: 10, MemorySize: 10}},
+	}
+}
+
+func newLazyFieldTestLoadInfo() *querypb.SegmentLoadInfo {
+	info := &querypb.SegmentLoadInfo{
+		SegmentID:    1,
+		CollectionID: 10,
+		Level:        datapb.SegmentLevel_L1,
+		IndexInfos:   []*querypb.FieldIndexInfo{{FieldID: 104}},
+	}
+	for _, fieldID := range []int64{0, 1, 100, 101, 102, 103, 104, 105, 106} {
+		info.BinlogPaths = append(info.BinlogPaths, newLazyFieldBinlog(fieldID))
+	}
+	return info
+}
+
+func binlogFields(info *querypb.SegmentLoadInfo) []int64 {
+	fields := make([]int64, 0, len(info.GetBinlogPaths()))
+	for _, binlog := range info.GetBinlogPaths() {
+		fields = append(fields, binlog.GetFieldID())
+	}
+	return fields
+}
+
+func unloadedFieldIDs(binlogs []*datapb.FieldBinlog) []int64 {
+	fields := make([]int64, 0, len(binlogs))
+	for _, binlog := range binlogs {
+		fields = append(fields, binlog.GetFieldID())
+	}
+	return fields
+}
+
+func sortedFields(fields []int64) []int64 {
+	sort.Slice(fields, func(i, j int) bool { return fields[i] < fields[j] })
+	return fields
+}
+
+func TestReferencedFields(t *testing.T) {
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId: 101,
+				Predicates: &planpb.Expr{
+					Expr: &planpb.Expr_UnaryRangeExpr{
+						UnaryRangeExpr: &planpb.UnaryRangeExpr{
+							ColumnInfo: &planpb.ColumnInfo{FieldId: 102, DataType: schemapb.DataType_VarChar},
+							Op:         planpb.OpType_Equal,
+						},
+					},
+				},
+				QueryInfo: &planpb.QueryInfo{
+					GroupByFieldIds: []int64{104},
+				},
+			},
+		},
+		OutputFieldIds: []int64{100, 103},
+		Scorers: []*planpb.ScoreFunction{
+			{Params: []*commonpb.KeyValuePair{{Key: randomScoreFieldIDKey, Value: "105"}, {Key: "seed", Value: "7"}}},
+		},
+	}
+	bytes, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+
+	fields, err := referencedFields(bytes)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{100, 101, 102, 103, 104, 105}, fields.Collect())
+
+	_, err = referencedFields([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestLazyFieldTracker_Strip(t *testing.T) {
+	schema := newLazyFieldTestSchema()
+	tracker := newLazyFieldTracker()
+
+	// nothing is stripped if disabled
+	paramtable.Init()
+	info := newLazyFieldTestLoadInfo()
+	stripped, unloaded := tracker.strip(schema, info)
+	assert.Same(t, info, stripped)
+	assert.Empty(t, unloaded)
+
+	// only the scalar fields without index are stripped
+	enableLazyField(t, "2")
+	stripped, unloaded = tracker.strip(schema, info)
+	assert.Equal(t, []int64{0, 1, 100, 101, 104, 105, 106}, binlogFields(stripped))
+	assert.ElementsMatch(t, []int64{102, 103}, unloadedFieldIDs(unloaded))
+	assert.Len(t, info.GetBinlogPaths(), 9)
+
+	// the hot field is loaded
+	tracker.recordAccess(typeutil.NewSet[int64](103))
+	_, unloaded = tracker.strip(schema, info)
+	assert.ElementsMatch(t, []int64{102, 103}, unloadedFieldIDs(unloaded))
+	tracker.recordAccess(typeutil.NewSet[int64](103))
+	_, unloaded = tracker.strip(schema, info)
+	assert.ElementsMatch(t, []int64{102}, unloadedFieldIDs(unloaded))
+
+	// the column group is stripped only if all its fields are cold
+	grouped := newLazyFieldTestLoadInfo()
+	grouped.BinlogPaths = []*datapb.FieldBinlog{newLazyFieldBinlog(0, 0, 1, 100), newLazyFieldBinlog(200, 102, 103), newLazyFieldBinlog(201, 102, 104)}
+	tracker = newLazyFieldTracker()
+	_, unloaded = tracker.strip(schema, grouped)
+	assert.ElementsMatch(t, []int64{200}, unloadedFieldIDs(unloaded))
+
+	// the L0 segments and the segments with manifest are not stripped
+	l0 := newLazyFieldTestLoadInfo()
+	l0.Level = datapb.SegmentLevel_L0
+	_, unloaded = tracker.strip(schema, l0)
+	assert.Empty(t, unloaded)
+	manifest := newLazyFieldTestLoadInfo()
+	manifest.ManifestPath = "manifest"
+	_, unloaded = tracker.strip(schema, manifest)
+	assert.Empty(t, unloaded)
+
+	// the ttl field and the fields with text stats are loaded
+	withTTL := proto.Clone(schema).(*schemapb.CollectionSchema)
+	withTTL.Properties = []*commonpb.KeyValuePair{{Key: "ttl_field", Value: "price"}}
+	withStats := newLazyFieldTestLoadInfo()
+	withStats.TextStatsLogs = map[int64]*datapb.TextIndexStats{102: {FieldID: 102}}
+	_, unloaded = tracker.strip(withTTL, withStats)
+	assert.Empty(t, unloaded)
+}
+
+func TestLazyFieldTracker_Load(t *testing.T) {
+	enableLazyField(t, "1")
+	ctx := context.Background()
+	schema := newLazyFieldTestSchema()
+	tracker := newLazyFieldTracker()
+
+	stripped, unloaded := tracker.strip(schema, newLazyFieldTestLoadInfo())
+	segment := NewMockSegment(t)
+	segment.EXPECT().ID().Return(int64(1)).Maybe()
+	segment.EXPECT().LoadInfo().Return(stripped).Maybe()
+	tracker.track(segment, unloaded)
+	assert.ElementsMatch(t, []int64{102, 103}, tracker.UnloadedFields(segment))
+
+	// the fields not referenced are not loaded
+	assert.NoError(t, tracker.load(ctx, segment, typeutil.NewSet[int64](100, 101)))
+
+	// the failed load keeps the fields unloaded
+	segment.EXPECT().Reopen(mock.Anything, mock.Anything).Return(errors.New("mock")).Once()
+	assert.Error(t, tracker.load(ctx, segment, typeutil.NewSet[int64](102)))
+	assert.ElementsMatch(t, []int64{102, 103}, tracker.UnloadedFields(segment))
+
+	// the referenced field is loaded into the segment
+	segment.EXPECT().Reopen(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, info *querypb.SegmentLoadInfo) error {
+		assert.Equal(t, []int64{0, 1, 100, 101, 104, 105, 106, 102}, binlogFields(info))
+		return nil
+	}).Once()
+	assert.NoError(t, tracker.load(ctx, segment, typeutil.NewSet[int64](100, 102)))
+	assert.ElementsMatch(t, []int64{103}, tracker.UnloadedFields(segment))
+	assert.Len(t, stripped.GetBinlogPaths(), 7)
+
+	// the loaded field is not loaded again
+	assert.NoError(t, tracker.load(ctx, segment, typeutil.NewSet[int64](102)))
+
+	// the untracked segment loads nothing
+	tracker.untrack(segment)
+	assert.Empty(t, tracker.UnloadedFields(segment))
+	assert.NoError(t, tracker.load(ctx, segment, typeutil.NewSet[int64](103)))
+	tracker.untrack(segment)
+}
+
+func TestLazyFieldTracker_Reopen(t *testing.T) {
+	enableLazyField(t, "1")
+	ctx := context.Background()
+	schema := newLazyFieldTestSchema()
+	tracker := newLazyFieldTracker()
+
+	_, unloaded := tracker.strip(schema, newLazyFieldTestLoadInfo())
+	segment := NewMockSegment(t)
+	tracker.track(segment, unloaded)
+
+	// the unloaded fields are kept unloaded, the field with a new index is loaded
+	full := newLazyFieldTestLoadInfo()
+	full.IndexInfos = append(full.IndexInfos, &querypb.FieldIndexInfo{FieldID: 103})
+	segment.EXPECT().Reopen(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, info *querypb.SegmentLoadInfo) error {
+		assert.Equal(t, []int64{0, 1, 100, 101, 103, 104, 105, 106}, binlogFields(info))
+		return nil
+	}).Once()
+	assert.NoError(t, tracker.reopen(ctx, schema, segment, full))
+	assert.Equal(t, []int64{102}, sortedFields(tracker.UnloadedFields(segment)))
+	assert.Len(t, full.GetBinlogPaths(), 9)
+
+	// the failed reopen keeps the record
+	segment.EXPECT().Reopen(mock.Anything, mock.Anything).Return(errors.New("mock")).Once()
+	assert.Error(t, tracker.reopen(ctx, schema, segment, newLazyFieldTestLoadInfo()))
+	assert.Equal(t, []int64{102}, tracker.UnloadedFields(segment))
+
+	// the untracked segment is reopened with the full load info
+	other := NewMockSegment(t)
+	other.EXPECT().Reopen(mock.Anything, full).Return(nil).Once()
+	assert.NoError(t, tracker.reopen(ctx, schema, other, full))
+}
+
+func TestLazyFieldTracker_Nil(t *testing.T) {
+	enableLazyField(t, "1")
+	var tracker *lazyFieldTracker
+	segment := NewMockSegment(t)
+	info := newLazyFieldTestLoadInfo()
+
+	stripped, unloaded := tracker.strip(newLazyFieldTestSchema(), info)
+	assert.Same(t, info, stripped)
+	assert.Empty(t, unloaded)
+	tracker.recordAccess(typeutil.NewSet[int64](102))
+	tracker.track(segment, []*datapb.FieldBinlog{newLazyFieldBinlog(102)})
+	assert.Empty(t, tracker.UnloadedFields(segment))
+	assert.NoError(t, tracker.load(context.Background(), segment, typeutil.NewSet[int64](102)))
+	tracker.untrack(segment)
+}
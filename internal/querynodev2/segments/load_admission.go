@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// loadAdmission admits the concurrent load requests of the segment loader.
+// The requests exceeding the concurrency limit wait in a queue ordered by the load priority, then by the arrival time,
+// and a collection can't occupy more than the per-collection concurrency limit,
+// so the urgent loads are not stuck behind a bulk load of cold collections.
+type loadAdmission struct {
+	mu                  sync.Mutex
+	running             int
+	runningByCollection map[int64]int
+	waiters             *list.List // the waiting requests, ordered by the load priority, then by the arrival time.
+}
+
+// loadWaiter is a load request waiting to be admitted.
+type loadWaiter struct {
+	collectionID int64
+	priority     commonpb.LoadPriority
+	ready        chan struct{}
+}
+
+func newLoadAdmission() *loadAdmission {
+	return &loadAdmission{
+		runningByCollection: make(map[int64]int),
+		waiters:             list.New(),
+	}
+}
+
+// Acquire blocks until the load request of the collection is admitted.
+// The returned release function should be called once the load is done.
+func (a *loadAdmission) Acquire(ctx context.Context, collectionID int64, priority commonpb.LoadPriority) (func(), error) {
+	a.mu.Lock()
+	waiter := &loadWaiter{
+		collectionID: collectionID,
+		priority:     priority,
+		ready:        make(chan struct{}),
+	}
+	elem := a.enqueueLocked(waiter)
+	a.dispatchLocked()
+	a.mu.Unlock()
+
+	release := func() { a.release(collectionID) }
+	select {
+	case <-waiter.ready:
+		return release, nil
+	case <-ctx.Done():
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	select {
+	case <-waiter.ready:
+		// admitted concurrently with the cancellation, give the admission back.
+		a.releaseLocked(collectionID)
+	default:
+		a.waiters.Remove(elem)
+		a.updateMetricLocked()
+	}
+	return nil, ctx.Err()
+}
+
+// release releases the admission of the collection, and admits the waiting requests.
+func (a *loadAdmission) release(collectionID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.releaseLocked(collectionID)
+}
+
+func (a *loadAdmission) releaseLocked(collectionID int64) {
+	a.running--
+	if a.runningByCollection[collectionID]--; a.runningByCollection[collectionID] <= 0 {
+		delete(a.runningByCollection, collectionID)
+	}
+	a.dispatchLocked()
+}
+
+// enqueueLocked inserts the waiter after the waiters with the same or higher priority.
+func (a *loadAdmission) enqueueLocked(waiter *loadWaiter) *list.Element {
+	for e := a.waiters.Back(); e != nil; e = e.Prev() {
+		// the smaller value of load priority is the higher priority.
+		if e.Value.(*loadWaiter).priority <= waiter.priority {
+			return a.waiters.InsertAfter(waiter, e)
+		}
+	}
+	return a.waiters.PushFront(waiter)
+}
+
+// dispatchLocked admits the waiters in order while there's concurrency left.
+// The waiter blocked by the per-collection limit is skipped, so the waiters of other collections are not blocked by it.
+func (a *loadAdmission) dispatchLocked() {
+	maxConcurrency := paramtable.Get().QueryNodeCfg.LoadMaxConcurrency.GetAsInt()
+	maxConcurrencyPerCollection := paramtable.Get().QueryNodeCfg.LoadMaxConcurrencyPerCollection.GetAsInt()
+	for e := a.waiters.Front(); e != nil; {
+		if maxConcurrency > 0 && a.running >= maxConcurrency {
+			break
+		}
+		next := e.Next()
+		waiter := e.Value.(*loadWaiter)
+		if maxConcurrencyPerCollection <= 0 || a.runningByCollection[waiter.collectionID] < maxConcurrencyPerCollection {
+			a.waiters.Remove(e)
+			a.running++
+			a.runningByCollection[waiter.collectionID]++
+			close(waiter.ready)
+		}
+		e = next
+	}
+	a.updateMetricLocked()
+}
+
+func (a *loadAdmission) updateMetricLocked() {
+	metrics.QueryNodeLoadSegmentConcurrency.WithLabelValues(paramtable.GetStringNodeID(), "WaitLoadAdmission").Set(float64(a.waiters.Len()))
+}
+
+// getLoadPriority returns the highest load priority of the segments.
+func getLoadPriority(infos []*querypb.SegmentLoadInfo) commonpb.LoadPriority {
+	priority := commonpb.LoadPriority_LOW
+	for _, info := range infos {
+		if info.GetPriority() < priority {
+			priority = info.GetPriority()
+		}
+	}
+	return priority
+}
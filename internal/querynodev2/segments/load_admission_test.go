@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestLoadAdmission(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.LoadMaxConcurrency.Key, "2")
+	params.Save(params.QueryNodeCfg.LoadMaxConcurrencyPerCollection.Key, "1")
+	defer params.Reset(params.QueryNodeCfg.LoadMaxConcurrency.Key)
+	defer params.Reset(params.QueryNodeCfg.LoadMaxConcurrencyPerCollection.Key)
+
+	ctx := context.Background()
+	admission := newLoadAdmission()
+
+	acquire := func(collectionID int64, priority commonpb.LoadPriority) <-chan func() {
+		ch := make(chan func(), 1)
+		go func() {
+			release, err := admission.Acquire(ctx, collectionID, priority)
+			assert.NoError(t, err)
+			ch <- release
+		}()
+		return ch
+	}
+	waitQueued := func(n int) {
+		assert.Eventually(t, func() bool {
+			admission.mu.Lock()
+			defer admission.mu.Unlock()
+			return admission.waiters.Len() == n
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	release1, err := admission.Acquire(ctx, 1, commonpb.LoadPriority_LOW)
+	assert.NoError(t, err)
+
+	// blocked by the per-collection limit, the other collection is admitted.
+	blocked := acquire(1, commonpb.LoadPriority_LOW)
+	waitQueued(1)
+	release2, err := admission.Acquire(ctx, 2, commonpb.LoadPriority_LOW)
+	assert.NoError(t, err)
+
+	// blocked by the global limit, the high priority load goes first.
+	low := acquire(3, commonpb.LoadPriority_LOW)
+	waitQueued(2)
+	high := acquire(4, commonpb.LoadPriority_HIGH)
+	waitQueued(3)
+
+	release2()
+	select {
+	case release := <-high:
+		release()
+	case <-low:
+		t.Fatal("low priority load admitted before the high priority one")
+	case <-time.After(time.Second):
+		t.Fatal("high priority load not admitted")
+	}
+	(<-low)()
+
+	// the waiter of the collection is admitted once the running load of the collection is done.
+	release1()
+	(<-blocked)()
+
+	// the canceled waiter is removed from the queue.
+	release1, err = admission.Acquire(ctx, 1, commonpb.LoadPriority_LOW)
+	assert.NoError(t, err)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = admission.Acquire(cancelCtx, 1, commonpb.LoadPriority_LOW)
+	assert.ErrorIs(t, err, context.Canceled)
+	release1()
+	assert.Equal(t, 0, admission.waiters.Len())
+	assert.Equal(t, 0, admission.running)
+	assert.Empty(t, admission.runningByCollection)
+}
+
+func TestGetLoadPriority(t *testing.T) {
+	assert.Equal(t, commonpb.LoadPriority_LOW, getLoadPriority(nil))
+	assert.Equal(t, commonpb.LoadPriority_HIGH, getLoadPriority([]*querypb.SegmentLoadInfo{
+		{Priority: commonpb.LoadPriority_LOW},
+		{Priority: commonpb.LoadPriority_HIGH},
+	}))
+}
@@ -71,14 +71,28 @@ type Manager struct {
 	Collection CollectionManager
 	Segment    SegmentManager
 	Loader     Loader
+	GPUMemory  *GPUMemoryPool
+	Cache      *SegmentCache
 }
 
 func NewManager() *Manager {
 	segMgr := NewSegmentManager()
+	gpuMemory := newGPUMemoryPool(&segmentGPUIndexEvictor{segments: segMgr})
 	manager := &Manager{
 		Collection: NewCollectionManager(),
 		Segment:    segMgr,
-	}
+		GPUMemory:  gpuMemory,
+	}
+	cache := newSegmentCache(&segmentCacheBackendImpl{manager: manager})
+	segMgr.cache = cache
+	segMgr.registerReleaseCallback(func(s Segment) {
+		gpuMemory.Release(s.ID())
+		cache.Remove(s)
+		if collection := manager.Collection.Get(s.Collection()); collection != nil {
+			collection.lazyFields.untrack(s)
+		}
+	})
+	manager.Cache = cache
 
 	return manager
 }
@@ -296,6 +310,9 @@ type segmentManager struct {
 	// releaseCallback is the callback function when a segment is released.
 	releaseCallback func(s Segment)
 
+	// cache loads the evicted sealed segments back when they're pinned.
+	cache *SegmentCache
+
 	growingOnReleasingSegments *typeutil.ConcurrentSet[int64]
 	sealedOnReleasingSegments  *typeutil.ConcurrentSet[int64]
 
@@ -510,7 +527,11 @@ func (mgr *segmentManager) GetAndPinBy(filters ...SegmentFilter) ([]Segment, err
 		ret = append(ret, segment)
 		return true
 	}, filters...)
+	if err != nil {
+		return ret, err
+	}
 
+	err = mgr.cache.Acquire(context.TODO(), ret)
 	return ret, err
 }
 
@@ -572,6 +593,9 @@ func (mgr *segmentManager) GetAndPin(segments []int64, filters ...SegmentFilter)
 		}
 	}
 
+	if err = mgr.cache.Acquire(context.TODO(), lockedSegments); err != nil {
+		return nil, err
+	}
 	return lockedSegments, nil
 }
 
@@ -579,6 +603,7 @@ func (mgr *segmentManager) Unpin(segments []Segment) {
 	for _, segment := range segments {
 		segment.Unpin()
 	}
+	mgr.cache.Release(context.TODO(), segments)
 }
 
 func (mgr *segmentManager) rangeWithFilter(process func(id int64, segType SegmentType, segment Segment) bool, filters ...SegmentFilter) {
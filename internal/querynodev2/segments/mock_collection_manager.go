@@ -3,10 +3,14 @@
 package segments
 
 import (
-	schemapb "github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	context "context"
+
 	querypb "github.com/milvus-io/milvus/pkg/v3/proto/querypb"
-	segcorepb "github.com/milvus-io/milvus/pkg/v3/proto/segcorepb"
 	mock "github.com/stretchr/testify/mock"
+
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+
+	segcorepb "github.com/milvus-io/milvus/pkg/v3/proto/segcorepb"
 )
 
 // MockCollectionManager is an autogenerated mock type for the CollectionManager type
@@ -164,17 +168,17 @@ func (_c *MockCollectionManager_ListWithName_Call) RunAndReturn(run func() map[i
 	return _c
 }
 
-// PutOrRef provides a mock function with given fields: collectionID, schema, meta, loadMeta
-func (_m *MockCollectionManager) PutOrRef(collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo) error {
-	ret := _m.Called(collectionID, schema, meta, loadMeta)
+// PutOrRef provides a mock function with given fields: ctx, collectionID, schema, meta, loadMeta
+func (_m *MockCollectionManager) PutOrRef(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo) error {
+	ret := _m.Called(ctx, collectionID, schema, meta, loadMeta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PutOrRef")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, *schemapb.CollectionSchema, *segcorepb.CollectionIndexMeta, *querypb.LoadMetaInfo) error); ok {
-		r0 = rf(collectionID, schema, meta, loadMeta)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *schemapb.CollectionSchema, *segcorepb.CollectionIndexMeta, *querypb.LoadMetaInfo) error); ok {
+		r0 = rf(ctx, collectionID, schema, meta, loadMeta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -188,17 +192,18 @@ type MockCollectionManager_PutOrRef_Call struct {
 }
 
 // PutOrRef is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collectionID int64
 //   - schema *schemapb.CollectionSchema
 //   - meta *segcorepb.CollectionIndexMeta
 //   - loadMeta *querypb.LoadMetaInfo
-func (_e *MockCollectionManager_Expecter) PutOrRef(collectionID interface{}, schema interface{}, meta interface{}, loadMeta interface{}) *MockCollectionManager_PutOrRef_Call {
-	return &MockCollectionManager_PutOrRef_Call{Call: _e.mock.On("PutOrRef", collectionID, schema, meta, loadMeta)}
+func (_e *MockCollectionManager_Expecter) PutOrRef(ctx interface{}, collectionID interface{}, schema interface{}, meta interface{}, loadMeta interface{}) *MockCollectionManager_PutOrRef_Call {
+	return &MockCollectionManager_PutOrRef_Call{Call: _e.mock.On("PutOrRef", ctx, collectionID, schema, meta, loadMeta)}
 }
 
-func (_c *MockCollectionManager_PutOrRef_Call) Run(run func(collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo)) *MockCollectionManager_PutOrRef_Call {
+func (_c *MockCollectionManager_PutOrRef_Call) Run(run func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, meta *segcorepb.CollectionIndexMeta, loadMeta *querypb.LoadMetaInfo)) *MockCollectionManager_PutOrRef_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int64), args[1].(*schemapb.CollectionSchema), args[2].(*segcorepb.CollectionIndexMeta), args[3].(*querypb.LoadMetaInfo))
+		run(args[0].(context.Context), args[1].(int64), args[2].(*schemapb.CollectionSchema), args[3].(*segcorepb.CollectionIndexMeta), args[4].(*querypb.LoadMetaInfo))
 	})
 	return _c
 }
@@ -208,7 +213,7 @@ func (_c *MockCollectionManager_PutOrRef_Call) Return(_a0 error) *MockCollection
 	return _c
 }
 
-func (_c *MockCollectionManager_PutOrRef_Call) RunAndReturn(run func(int64, *schemapb.CollectionSchema, *segcorepb.CollectionIndexMeta, *querypb.LoadMetaInfo) error) *MockCollectionManager_PutOrRef_Call {
+func (_c *MockCollectionManager_PutOrRef_Call) RunAndReturn(run func(context.Context, int64, *schemapb.CollectionSchema, *segcorepb.CollectionIndexMeta, *querypb.LoadMetaInfo) error) *MockCollectionManager_PutOrRef_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -260,6 +265,53 @@ func (_c *MockCollectionManager_Ref_Call) RunAndReturn(run func(int64, uint32) b
 	return _c
 }
 
+// RefInfos provides a mock function with no fields
+func (_m *MockCollectionManager) RefInfos() []CollectionRefInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefInfos")
+	}
+
+	var r0 []CollectionRefInfo
+	if rf, ok := ret.Get(0).(func() []CollectionRefInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]CollectionRefInfo)
+		}
+	}
+
+	return r0
+}
+
+// MockCollectionManager_RefInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefInfos'
+type MockCollectionManager_RefInfos_Call struct {
+	*mock.Call
+}
+
+// RefInfos is a helper method to define mock.On call
+func (_e *MockCollectionManager_Expecter) RefInfos() *MockCollectionManager_RefInfos_Call {
+	return &MockCollectionManager_RefInfos_Call{Call: _e.mock.On("RefInfos")}
+}
+
+func (_c *MockCollectionManager_RefInfos_Call) Run(run func()) *MockCollectionManager_RefInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCollectionManager_RefInfos_Call) Return(_a0 []CollectionRefInfo) *MockCollectionManager_RefInfos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCollectionManager_RefInfos_Call) RunAndReturn(run func() []CollectionRefInfo) *MockCollectionManager_RefInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unref provides a mock function with given fields: collectionID, count
 func (_m *MockCollectionManager) Unref(collectionID int64, count uint32) bool {
 	ret := _m.Called(collectionID, count)
@@ -307,17 +359,17 @@ func (_c *MockCollectionManager_Unref_Call) RunAndReturn(run func(int64, uint32)
 	return _c
 }
 
-// UpdateSchema provides a mock function with given fields: collectionID, schema, schemaBarrierTs
-func (_m *MockCollectionManager) UpdateSchema(collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64) error {
-	ret := _m.Called(collectionID, schema, schemaBarrierTs)
+// UpdateSchema provides a mock function with given fields: ctx, collectionID, schema, schemaBarrierTs
+func (_m *MockCollectionManager) UpdateSchema(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64) error {
+	ret := _m.Called(ctx, collectionID, schema, schemaBarrierTs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSchema")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, *schemapb.CollectionSchema, uint64) error); ok {
-		r0 = rf(collectionID, schema, schemaBarrierTs)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *schemapb.CollectionSchema, uint64) error); ok {
+		r0 = rf(ctx, collectionID, schema, schemaBarrierTs)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -331,16 +383,17 @@ type MockCollectionManager_UpdateSchema_Call struct {
 }
 
 // UpdateSchema is a helper method to define mock.On call
+//   - ctx context.Context
 //   - collectionID int64
 //   - schema *schemapb.CollectionSchema
 //   - schemaBarrierTs uint64
-func (_e *MockCollectionManager_Expecter) UpdateSchema(collectionID interface{}, schema interface{}, schemaBarrierTs interface{}) *MockCollectionManager_UpdateSchema_Call {
-	return &MockCollectionManager_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", collectionID, schema, schemaBarrierTs)}
+func (_e *MockCollectionManager_Expecter) UpdateSchema(ctx interface{}, collectionID interface{}, schema interface{}, schemaBarrierTs interface{}) *MockCollectionManager_UpdateSchema_Call {
+	return &MockCollectionManager_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", ctx, collectionID, schema, schemaBarrierTs)}
 }
 
-func (_c *MockCollectionManager_UpdateSchema_Call) Run(run func(collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64)) *MockCollectionManager_UpdateSchema_Call {
+func (_c *MockCollectionManager_UpdateSchema_Call) Run(run func(ctx context.Context, collectionID int64, schema *schemapb.CollectionSchema, schemaBarrierTs uint64)) *MockCollectionManager_UpdateSchema_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int64), args[1].(*schemapb.CollectionSchema), args[2].(uint64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(*schemapb.CollectionSchema), args[3].(uint64))
 	})
 	return _c
 }
@@ -350,7 +403,7 @@ func (_c *MockCollectionManager_UpdateSchema_Call) Return(_a0 error) *MockCollec
 	return _c
 }
 
-func (_c *MockCollectionManager_UpdateSchema_Call) RunAndReturn(run func(int64, *schemapb.CollectionSchema, uint64) error) *MockCollectionManager_UpdateSchema_Call {
+func (_c *MockCollectionManager_UpdateSchema_Call) RunAndReturn(run func(context.Context, int64, *schemapb.CollectionSchema, uint64) error) *MockCollectionManager_UpdateSchema_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -323,6 +323,53 @@ func (_c *MockLoader_LoadIndex_Call) RunAndReturn(run func(context.Context, Segm
 	return _c
 }
 
+// ReloadSegmentData provides a mock function with given fields: ctx, segment
+func (_m *MockLoader) ReloadSegmentData(ctx context.Context, segment Segment) error {
+	ret := _m.Called(ctx, segment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadSegmentData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, Segment) error); ok {
+		r0 = rf(ctx, segment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoader_ReloadSegmentData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadSegmentData'
+type MockLoader_ReloadSegmentData_Call struct {
+	*mock.Call
+}
+
+// ReloadSegmentData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - segment Segment
+func (_e *MockLoader_Expecter) ReloadSegmentData(ctx interface{}, segment interface{}) *MockLoader_ReloadSegmentData_Call {
+	return &MockLoader_ReloadSegmentData_Call{Call: _e.mock.On("ReloadSegmentData", ctx, segment)}
+}
+
+func (_c *MockLoader_ReloadSegmentData_Call) Run(run func(ctx context.Context, segment Segment)) *MockLoader_ReloadSegmentData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(Segment))
+	})
+	return _c
+}
+
+func (_c *MockLoader_ReloadSegmentData_Call) Return(_a0 error) *MockLoader_ReloadSegmentData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoader_ReloadSegmentData_Call) RunAndReturn(run func(context.Context, Segment) error) *MockLoader_ReloadSegmentData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReopenSegments provides a mock function with given fields: ctx, loadInfos
 func (_m *MockLoader) ReopenSegments(ctx context.Context, loadInfos []*querypb.SegmentLoadInfo) error {
 	ret := _m.Called(ctx, loadInfos)
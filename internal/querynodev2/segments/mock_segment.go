@@ -39,6 +39,51 @@ func (_m *MockSegment) EXPECT() *MockSegment_Expecter {
 	return &MockSegment_Expecter{mock: &_m.Mock}
 }
 
+// AccessQPS provides a mock function with no fields
+func (_m *MockSegment) AccessQPS() float64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccessQPS")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// MockSegment_AccessQPS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessQPS'
+type MockSegment_AccessQPS_Call struct {
+	*mock.Call
+}
+
+// AccessQPS is a helper method to define mock.On call
+func (_e *MockSegment_Expecter) AccessQPS() *MockSegment_AccessQPS_Call {
+	return &MockSegment_AccessQPS_Call{Call: _e.mock.On("AccessQPS")}
+}
+
+func (_c *MockSegment_AccessQPS_Call) Run(run func()) *MockSegment_AccessQPS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSegment_AccessQPS_Call) Return(_a0 float64) *MockSegment_AccessQPS_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSegment_AccessQPS_Call) RunAndReturn(run func() float64) *MockSegment_AccessQPS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BatchPkExist provides a mock function with given fields: lc
 func (_m *MockSegment) BatchPkExist(lc *storage.BatchLocationsCache) []bool {
 	ret := _m.Called(lc)
@@ -1565,6 +1610,38 @@ func (_c *MockSegment_PkCandidateExist_Call) RunAndReturn(run func() bool) *Mock
 	return _c
 }
 
+// RecordAccess provides a mock function with no fields
+func (_m *MockSegment) RecordAccess() {
+	_m.Called()
+}
+
+// MockSegment_RecordAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAccess'
+type MockSegment_RecordAccess_Call struct {
+	*mock.Call
+}
+
+// RecordAccess is a helper method to define mock.On call
+func (_e *MockSegment_Expecter) RecordAccess() *MockSegment_RecordAccess_Call {
+	return &MockSegment_RecordAccess_Call{Call: _e.mock.On("RecordAccess")}
+}
+
+func (_c *MockSegment_RecordAccess_Call) Run(run func()) *MockSegment_RecordAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSegment_RecordAccess_Call) Return() *MockSegment_RecordAccess_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSegment_RecordAccess_Call) RunAndReturn(run func()) *MockSegment_RecordAccess_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Refund provides a mock function with no fields
 func (_m *MockSegment) Refund() {
 	_m.Called()
@@ -130,6 +130,9 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	searchResults.IsRecallEvaluation = isRecallEvaluation
 	searchResults.ScannedRemoteBytes = storageCost.ScannedRemoteBytes
 	searchResults.ScannedTotalBytes = storageCost.ScannedTotalBytes
+	searchResults.SegmentExplains = lo.FlatMap(results, func(result *internalpb.SearchResults, _ int) []*internalpb.SegmentExplain {
+		return result.GetSegmentExplains()
+	})
 	return searchResults, nil
 }
 
@@ -184,6 +187,9 @@ func ReduceAdvancedSearchResults(ctx context.Context, results []*internalpb.Sear
 	searchResults.IsTopkReduce = isTopkReduce
 	searchResults.ScannedRemoteBytes = storageCost.ScannedRemoteBytes
 	searchResults.ScannedTotalBytes = storageCost.ScannedTotalBytes
+	searchResults.SegmentExplains = lo.FlatMap(results, func(result *internalpb.SearchResults, _ int) []*internalpb.SegmentExplain {
+		return result.GetSegmentExplains()
+	})
 	return searchResults, nil
 }
 
@@ -53,6 +53,7 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 
 	retriever := func(ctx context.Context, s Segment) error {
 		tr := timerecord.NewTimeRecorder("retrieveOnSegments")
+		s.RecordAccess()
 		result, err := s.Retrieve(ctx, plan)
 		if err != nil {
 			return err
@@ -129,6 +130,7 @@ func retrieveOnSegmentsWithStream(ctx context.Context, mgr *Manager, segments []
 			var result *segcorepb.RetrieveResults
 			err := doOnSegment(ctx, mgr, segment, func(ctx context.Context, segment Segment) error {
 				var err error
+				segment.RecordAccess()
 				result, err = segment.Retrieve(ctx, plan)
 				return err
 			})
@@ -181,6 +183,9 @@ func Retrieve(ctx context.Context, manager *Manager, plan *RetrievePlan, req *qu
 	if req.GetScope() == querypb.DataScope_Historical {
 		SegType = SegmentTypeSealed
 		retrieveSegments, err = validateOnHistorical(ctx, manager, collID, req.GetReq().GetPartitionIDs(), segIDs)
+		if err == nil {
+			err = loadLazyFields(ctx, manager, collID, retrieveSegments, req.GetReq().GetSerializedExprPlan())
+		}
 	} else {
 		SegType = SegmentTypeGrowing
 		retrieveSegments, err = validateOnStream(ctx, manager, collID, req.GetReq().GetPartitionIDs(), segIDs)
@@ -207,6 +212,9 @@ func RetrieveStream(ctx context.Context, manager *Manager, plan *RetrievePlan, r
 	if req.GetScope() == querypb.DataScope_Historical {
 		SegType = SegmentTypeSealed
 		retrieveSegments, err = validateOnHistorical(ctx, manager, collID, req.GetReq().GetPartitionIDs(), segIDs)
+		if err == nil {
+			err = loadLazyFields(ctx, manager, collID, retrieveSegments, req.GetReq().GetSerializedExprPlan())
+		}
 	} else {
 		SegType = SegmentTypeGrowing
 		retrieveSegments, err = validateOnStream(ctx, manager, collID, req.GetReq().GetPartitionIDs(), segIDs)
@@ -84,7 +84,7 @@ func (suite *RetrieveSuite) SetupTest() {
 	suite.manager = NewManager()
 	suite.schema = mock_segcore.GenTestCollectionSchema("test-reduce", schemapb.DataType_Int64, true)
 	indexMeta := mock_segcore.GenTestIndexMeta(suite.collectionID, suite.schema)
-	suite.manager.Collection.PutOrRef(suite.collectionID,
+	suite.manager.Collection.PutOrRef(context.Background(), suite.collectionID,
 		suite.schema,
 		indexMeta,
 		&querypb.LoadMetaInfo{
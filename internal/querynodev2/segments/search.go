@@ -41,6 +41,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 	searchResults := make([]*SearchResult, len(segments))
 	searcher := func(ctx context.Context, s Segment, idx int) error {
 		tr := timerecord.NewTimeRecorder("searchOnSegments")
+		s.RecordAccess()
 		searchResult, err := s.Search(ctx, searchReq)
 		if err != nil {
 			return err
@@ -123,6 +124,9 @@ func SearchHistorical(ctx context.Context, manager *Manager, searchReq *SearchRe
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := loadLazyFields(ctx, manager, collID, segments, searchReq.SerializedPlan()); err != nil {
+		return nil, segments, err
+	}
 	searchResults, err := searchSegments(ctx, manager, segments, SegmentTypeSealed, searchReq)
 	return searchResults, segments, err
 }
@@ -97,6 +97,8 @@ type baseSegment struct {
 	resourceUsageCache *atomic.Pointer[ResourceUsage]
 
 	needUpdatedVersion *atomic.Int64 // only for lazy load mode update index
+
+	accessStats *accessStats
 }
 
 func newBaseSegment(collection *Collection, segmentType SegmentType, version int64, loadInfo *querypb.SegmentLoadInfo) (baseSegment, error) {
@@ -115,6 +117,7 @@ func newBaseSegment(collection *Collection, segmentType SegmentType, version int
 
 		resourceUsageCache: atomic.NewPointer[ResourceUsage](nil),
 		needUpdatedVersion: atomic.NewInt64(0),
+		accessStats:        newAccessStats(),
 	}
 	return bs, nil
 }
@@ -351,6 +354,16 @@ func (s *baseSegment) ResourceUsageEstimate() ResourceUsage {
 	return *usage
 }
 
+// RecordAccess records a search or query on the segment.
+func (s *baseSegment) RecordAccess() {
+	s.accessStats.Record()
+}
+
+// AccessQPS returns the recent search and query rate of the segment.
+func (s *baseSegment) AccessQPS() float64 {
+	return s.accessStats.QPS()
+}
+
 func (s *baseSegment) NeedUpdatedVersion() int64 {
 	return s.needUpdatedVersion.Load()
 }
@@ -390,9 +403,12 @@ type LocalSegment struct {
 	deltaMut           sync.Mutex
 	lastDeltaTimestamp *atomic.Uint64
 	fields             *typeutil.ConcurrentMap[int64, *FieldInfo]
-	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo] // indexID -> IndexedFieldInfo
+	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo]       // indexID -> IndexedFieldInfo
+	evictedIndexes     *typeutil.ConcurrentMap[int64, *querypb.FieldIndexInfo] // indexID -> index evicted from GPU
 	fieldJSONStats     map[int64]*querypb.JsonStatsInfo
 	fieldJSONStatsMu   sync.RWMutex
+
+	schemaVersion atomic.Uint64 // the segcore schema version of collection pinned by the segment.
 }
 
 func NewSegment(ctx context.Context,
@@ -433,6 +449,8 @@ func NewSegment(ctx context.Context,
 		mlog.String("level", loadInfo.GetLevel().String()),
 	)
 
+	// pin the schema version that the segment is created with, so it's tracked as live until the segment is released.
+	_, schemaVersion := collection.PinSchemaVersion()
 	var csegment segcore.CSegment
 	if _, err := GetDynamicPool().Submit(func() (any, error) {
 		var err error
@@ -446,6 +464,7 @@ func NewSegment(ctx context.Context,
 		return nil, err
 	}).Await(); err != nil {
 		logger.Warn(ctx, "create segment failed", mlog.Err(err))
+		collection.UnpinSchemaVersion(schemaVersion)
 		return nil, err
 	}
 	logger.Info(ctx, "create segment done")
@@ -460,6 +479,7 @@ func NewSegment(ctx context.Context,
 		lastDeltaTimestamp: atomic.NewUint64(0),
 		fields:             typeutil.NewConcurrentMap[int64, *FieldInfo](),
 		fieldIndexes:       typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
+		evictedIndexes:     typeutil.NewConcurrentMap[int64, *querypb.FieldIndexInfo](),
 		fieldJSONStats:     make(map[int64]*querypb.JsonStatsInfo),
 
 		memSize:     atomic.NewInt64(-1),
@@ -467,9 +487,11 @@ func NewSegment(ctx context.Context,
 		rowNum:      atomic.NewInt64(-1),
 		insertCount: atomic.NewInt64(0),
 	}
+	segment.schemaVersion.Store(schemaVersion)
 
 	if err := segment.initializeSegment(); err != nil {
 		csegment.Release()
+		collection.UnpinSchemaVersion(schemaVersion)
 		return nil, err
 	}
 	return segment, nil
@@ -683,6 +705,30 @@ func (s *LocalSegment) DropIndex(ctx context.Context, indexID int64) error {
 	return nil
 }
 
+// EvictIndex drops the GPU index from the segment to free the GPU memory, the segment serves with the raw data instead.
+// The evicted index is kept in EvictedIndexes until it's loaded again.
+func (s *LocalSegment) EvictIndex(ctx context.Context, indexID int64) error {
+	indexInfo := s.GetIndexByID(indexID)
+	if indexInfo == nil {
+		return nil
+	}
+	if err := s.DropIndex(ctx, indexID); err != nil {
+		return err
+	}
+	s.evictedIndexes.Insert(indexID, indexInfo.IndexInfo)
+	return nil
+}
+
+// EvictedIndexes returns the indexes evicted from GPU.
+func (s *LocalSegment) EvictedIndexes() []*querypb.FieldIndexInfo {
+	var result []*querypb.FieldIndexInfo
+	s.evictedIndexes.Range(func(key int64, value *querypb.FieldIndexInfo) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
 func (s *LocalSegment) Indexes() []*IndexedFieldInfo {
 	var result []*IndexedFieldInfo
 	s.fieldIndexes.Range(func(key int64, value *IndexedFieldInfo) bool {
@@ -729,6 +775,7 @@ func (s *LocalSegment) syncFieldIndexes(indexInfos []*querypb.FieldIndexInfo) {
 			IndexInfo: indexInfo,
 			IsLoaded:  isLoaded,
 		})
+		s.evictedIndexes.Remove(indexID)
 	}
 
 	// QueryCoord builds reopen LoadInfo from DataCoord's full finished-index list for this segment.
@@ -739,6 +786,12 @@ func (s *LocalSegment) syncFieldIndexes(indexInfos []*querypb.FieldIndexInfo) {
 		}
 		return true
 	})
+	s.evictedIndexes.Range(func(indexID int64, _ *querypb.FieldIndexInfo) bool {
+		if !indexIDs.Contain(indexID) {
+			s.evictedIndexes.Remove(indexID)
+		}
+		return true
+	})
 }
 
 // Search executes a search on the segment.
@@ -1382,6 +1435,7 @@ func (s *LocalSegment) UpdateIndexInfo(ctx context.Context, indexInfo *querypb.F
 		IndexInfo: indexInfo,
 		IsLoaded:  true,
 	})
+	s.evictedIndexes.Remove(indexInfo.GetIndexID())
 	log.Info(ctx, "updateSegmentIndex done")
 	return nil
 }
@@ -1467,15 +1521,18 @@ func (s *LocalSegment) Reopen(ctx context.Context, newLoadInfo *querypb.SegmentL
 	}
 	defer s.ptrLock.Unpin()
 
-	schema, schemaVersion := s.collection.SchemaAndSegcoreVersion()
+	schema, schemaVersion := s.collection.PinSchemaVersion()
 	err := s.csegment.Reopen(ctx, &segcore.ReopenRequest{
 		LoadInfo:      newLoadInfo,
 		Schema:        schema,
 		SchemaVersion: schemaVersion,
 	})
 	if err != nil {
+		s.collection.UnpinSchemaVersion(schemaVersion)
 		return err
 	}
+	// the segment is reopened with the new schema version, so the old one is unpinned.
+	s.collection.UnpinSchemaVersion(s.schemaVersion.Swap(schemaVersion))
 	s.syncFieldIndexes(newLoadInfo.GetIndexInfos())
 	s.loadInfo.Store(newLoadInfo)
 	s.syncFieldJSONStatsFromLoadInfo(ctx, newLoadInfo)
@@ -1543,6 +1600,7 @@ func (s *LocalSegment) Release(ctx context.Context, opts ...releaseOption) {
 		C.DeleteSegment(ptr)
 		return nil, nil
 	}).Await()
+	s.collection.UnpinSchemaVersion(s.schemaVersion.Load())
 
 	// TODO: disable logical resource handling for now
 	// usage := s.ResourceUsageEstimate()
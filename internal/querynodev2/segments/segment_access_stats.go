@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// accessStatsWindow is the minimal interval to refresh the access QPS.
+const accessStatsWindow = time.Second
+
+// accessStats tracks the search/query access rate of a segment,
+// which is reported to querycoord to spread the hot segments out.
+type accessStats struct {
+	count atomic.Uint64
+
+	mu        sync.Mutex
+	lastCount uint64
+	lastTime  time.Time
+	qps       float64
+}
+
+func newAccessStats() *accessStats {
+	return &accessStats{
+		lastTime: time.Now(),
+	}
+}
+
+// Record records an access of the segment.
+func (s *accessStats) Record() {
+	s.count.Inc()
+}
+
+// QPS returns the access rate since the last refresh,
+// the rate is refreshed at most once per accessStatsWindow.
+func (s *accessStats) QPS() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	elapsed := now.Sub(s.lastTime)
+	if elapsed < accessStatsWindow {
+		return s.qps
+	}
+	count := s.count.Load()
+	s.qps = float64(count-s.lastCount) / elapsed.Seconds()
+	s.lastCount = count
+	s.lastTime = now
+	return s.qps
+}
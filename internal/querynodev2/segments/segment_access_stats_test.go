@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessStats(t *testing.T) {
+	stats := newAccessStats()
+	for i := 0; i < 10; i++ {
+		stats.Record()
+	}
+	// not refreshed within the window
+	assert.Equal(t, 0.0, stats.QPS())
+
+	stats.lastTime = time.Now().Add(-2 * time.Second)
+	qps := stats.QPS()
+	assert.InDelta(t, 5.0, qps, 0.5)
+	// cached until the next window
+	stats.Record()
+	assert.Equal(t, qps, stats.QPS())
+}
@@ -0,0 +1,368 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+const (
+	segmentCacheResidentLabel = "resident"
+	segmentCacheEvictedLabel  = "evicted"
+)
+
+// segmentCacheBackend evicts and reloads the data of the sealed segments.
+type segmentCacheBackend interface {
+	// Evict releases the data of the segment, only the meta of the segment is kept.
+	// The evicted segment is still reported in the data distribution, so the querycoord doesn't load it again.
+	Evict(ctx context.Context, segment Segment) error
+	// Reload loads the data of the evicted segment back from the object storage.
+	Reload(ctx context.Context, segment Segment) error
+}
+
+// SegmentCache keeps the hot sealed segments resident on the querynode,
+// and evicts the data of the least recently used ones if the resident segments exceed the capacity.
+// The evicted segment keeps its meta and its deletes, its data is loaded back when it's pinned by a search or query.
+// An evicted segment is admitted into the cache again after it's accessed admissionMinAccessCount times,
+// before that it's loaded for the request only and evicted after it,
+// so a scan over the cold segments doesn't flush the hot segments out of the cache.
+// All methods are no-op if the segment cache is disabled.
+type SegmentCache struct {
+	mu      sync.Mutex
+	entries map[Segment]*segmentCacheEntry
+	// lru keeps the admitted resident segments, the front is the most recently used one.
+	lru         *list.List
+	residentNum int
+	resident    int64
+
+	backend segmentCacheBackend
+}
+
+// segmentCacheEntry is a sealed segment tracked by the segment cache.
+type segmentCacheEntry struct {
+	segment  Segment
+	size     int64
+	resident bool
+	elem     *list.Element // nil if the segment is evicted or resident for the running requests only.
+	inUse    int           // the number of running requests of the segment, the segment in use is never evicted.
+	accessed int           // the number of accesses since the segment is evicted.
+	busy     chan struct{} // not nil if the segment is being evicted or reloaded, closed when it's done.
+	removed  bool
+}
+
+func newSegmentCache(backend segmentCacheBackend) *SegmentCache {
+	return &SegmentCache{
+		entries: make(map[Segment]*segmentCacheEntry),
+		lru:     list.New(),
+		backend: backend,
+	}
+}
+
+func (c *SegmentCache) enabled() bool {
+	return c != nil && paramtable.Get().QueryNodeCfg.SegmentCacheEnabled.GetAsBool()
+}
+
+// Reserve evicts the least recently used segments to make room for the segments to be loaded.
+// The reservation is best effort, the loading is not blocked if the segments in use can not be evicted.
+func (c *SegmentCache) Reserve(ctx context.Context, size int64) {
+	if !c.enabled() || size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	victims := c.selectVictims(size, nil)
+	c.mu.Unlock()
+	c.evict(ctx, victims)
+}
+
+// Admit tracks the loaded sealed segment as a resident one, size is the binlog and index size of the segment.
+func (c *SegmentCache) Admit(ctx context.Context, segment Segment, size int64) {
+	if !c.enabled() || segment.Type() != SegmentTypeSealed {
+		return
+	}
+	c.mu.Lock()
+	if _, ok := c.entries[segment]; ok {
+		c.mu.Unlock()
+		return
+	}
+	entry := &segmentCacheEntry{segment: segment, size: size}
+	c.entries[segment] = entry
+	c.setResident(entry)
+	entry.elem = c.lru.PushFront(entry)
+	victims := c.selectVictims(0, nil)
+	c.updateMetrics()
+	c.mu.Unlock()
+	c.evict(ctx, victims)
+}
+
+// Remove stops tracking the segment, it's called when the segment is released.
+func (c *SegmentCache) Remove(segment Segment) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[segment]
+	if !ok {
+		return
+	}
+	delete(c.entries, segment)
+	entry.removed = true
+	if entry.elem != nil {
+		c.lru.Remove(entry.elem)
+		entry.elem = nil
+	}
+	if entry.resident {
+		c.setEvicted(entry)
+	}
+	c.updateMetrics()
+}
+
+// Acquire marks the pinned segments in use and loads the evicted ones back.
+// The acquisition is all or nothing, the acquired segments must be released by Release.
+func (c *SegmentCache) Acquire(ctx context.Context, segments []Segment) error {
+	if !c.enabled() {
+		return nil
+	}
+	for i, segment := range segments {
+		if err := c.acquire(ctx, segment); err != nil {
+			c.Release(ctx, segments[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *SegmentCache) acquire(ctx context.Context, segment Segment) error {
+	c.mu.Lock()
+	entry, ok := c.entries[segment]
+	if !ok {
+		c.mu.Unlock()
+		return nil
+	}
+	entry.inUse++
+	// wait for the running eviction or reloading of the segment.
+	for entry.busy != nil {
+		busy := entry.busy
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			c.mu.Lock()
+			entry.inUse--
+			c.mu.Unlock()
+			return merr.WrapErrSegmentNotLoaded(segment.ID(), context.Cause(ctx).Error())
+		case <-busy:
+		}
+		c.mu.Lock()
+	}
+	if entry.removed {
+		c.mu.Unlock()
+		return nil
+	}
+
+	entry.accessed++
+	nodeID := paramtable.GetStringNodeID()
+	if entry.resident {
+		if entry.elem != nil {
+			c.lru.MoveToFront(entry.elem)
+		} else if c.admissible(entry) {
+			entry.elem = c.lru.PushFront(entry)
+		}
+		c.mu.Unlock()
+		metrics.QueryNodeSegmentCacheAccessTotal.WithLabelValues(nodeID, metrics.CacheHitLabel).Inc()
+		return nil
+	}
+
+	metrics.QueryNodeSegmentCacheAccessTotal.WithLabelValues(nodeID, metrics.CacheMissLabel).Inc()
+	busy := make(chan struct{})
+	entry.busy = busy
+	victims := c.selectVictims(entry.size, entry)
+	c.mu.Unlock()
+
+	c.evict(ctx, victims)
+	start := time.Now()
+	err := c.backend.Reload(ctx, segment)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.busy = nil
+	close(busy)
+	if err != nil {
+		entry.inUse--
+		mlog.Warn(ctx, "failed to reload the evicted segment", mlog.FieldSegmentID(segment.ID()), mlog.Err(err))
+		return err
+	}
+	metrics.QueryNodeSegmentCacheReloadLatency.WithLabelValues(nodeID).Observe(float64(time.Since(start).Milliseconds()))
+	if entry.removed {
+		return nil
+	}
+	c.setResident(entry)
+	if c.admissible(entry) {
+		entry.elem = c.lru.PushFront(entry)
+	}
+	c.updateMetrics()
+	return nil
+}
+
+// Release marks the segments not in use by the request any more,
+// the segment that is not admitted into the cache is evicted once no request uses it.
+func (c *SegmentCache) Release(ctx context.Context, segments []Segment) {
+	if !c.enabled() {
+		return
+	}
+	var victims []*segmentCacheEntry
+	c.mu.Lock()
+	for _, segment := range segments {
+		entry, ok := c.entries[segment]
+		if !ok || entry.inUse == 0 {
+			continue
+		}
+		entry.inUse--
+		if entry.inUse == 0 && entry.resident && entry.elem == nil && entry.busy == nil {
+			c.markEvicting(entry)
+			victims = append(victims, entry)
+		}
+	}
+	c.mu.Unlock()
+	c.evict(ctx, victims)
+}
+
+// admissible returns whether the segment can be kept in the cache after the request.
+func (c *SegmentCache) admissible(entry *segmentCacheEntry) bool {
+	return entry.accessed >= paramtable.Get().QueryNodeCfg.SegmentCacheAdmissionMinAccessCount.GetAsInt() &&
+		entry.size <= c.capacity()
+}
+
+func (c *SegmentCache) capacity() int64 {
+	return paramtable.Get().QueryNodeCfg.SegmentCacheCapacity.GetAsSize()
+}
+
+// selectVictims selects the least recently used segments to free the room of need bytes,
+// the segments in use, being evicted or reloaded, and the excluded one are skipped.
+// The selected segments are marked as evicting, must be evicted by evict.
+func (c *SegmentCache) selectVictims(need int64, exclude *segmentCacheEntry) []*segmentCacheEntry {
+	capacity := c.capacity()
+	var victims []*segmentCacheEntry
+	for elem := c.lru.Back(); elem != nil && c.resident+need > capacity; {
+		entry := elem.Value.(*segmentCacheEntry)
+		elem = elem.Prev()
+		if entry == exclude || entry.inUse > 0 || entry.busy != nil {
+			continue
+		}
+		c.markEvicting(entry)
+		victims = append(victims, entry)
+	}
+	return victims
+}
+
+// markEvicting takes the segment out of the resident ones before it's evicted.
+func (c *SegmentCache) markEvicting(entry *segmentCacheEntry) {
+	entry.busy = make(chan struct{})
+	if entry.elem != nil {
+		c.lru.Remove(entry.elem)
+		entry.elem = nil
+	}
+	c.setEvicted(entry)
+}
+
+// evict evicts the data of the segments marked as evicting,
+// the segment is kept resident if the eviction fails.
+func (c *SegmentCache) evict(ctx context.Context, victims []*segmentCacheEntry) {
+	if len(victims) == 0 {
+		return
+	}
+	nodeID := paramtable.GetStringNodeID()
+	for _, entry := range victims {
+		err := c.backend.Evict(ctx, entry.segment)
+		c.mu.Lock()
+		close(entry.busy)
+		entry.busy = nil
+		if err != nil {
+			mlog.Warn(ctx, "failed to evict segment", mlog.FieldSegmentID(entry.segment.ID()), mlog.Err(err))
+			if !entry.removed {
+				c.setResident(entry)
+				entry.elem = c.lru.PushBack(entry)
+			}
+		} else {
+			entry.accessed = 0
+			metrics.QueryNodeSegmentCacheEvictTotal.WithLabelValues(nodeID).Inc()
+			mlog.Info(ctx, "segment evicted by segment cache", mlog.FieldSegmentID(entry.segment.ID()), mlog.Int64("size", entry.size))
+		}
+		c.updateMetrics()
+		c.mu.Unlock()
+	}
+}
+
+func (c *SegmentCache) setResident(entry *segmentCacheEntry) {
+	if entry.resident {
+		return
+	}
+	entry.resident = true
+	c.resident += entry.size
+	c.residentNum++
+}
+
+func (c *SegmentCache) setEvicted(entry *segmentCacheEntry) {
+	if !entry.resident {
+		return
+	}
+	entry.resident = false
+	c.resident -= entry.size
+	c.residentNum--
+}
+
+func (c *SegmentCache) updateMetrics() {
+	nodeID := paramtable.GetStringNodeID()
+	metrics.QueryNodeSegmentCacheResidentBytes.WithLabelValues(nodeID).Set(float64(c.resident))
+	metrics.QueryNodeSegmentCacheSegmentNum.WithLabelValues(nodeID, segmentCacheResidentLabel).Set(float64(c.residentNum))
+	metrics.QueryNodeSegmentCacheSegmentNum.WithLabelValues(nodeID, segmentCacheEvictedLabel).Set(float64(len(c.entries) - c.residentNum))
+}
+
+// segmentCacheSize returns the size of the segment accounted by the segment cache.
+func segmentCacheSize(loadInfo *querypb.SegmentLoadInfo) int64 {
+	size := calculateSegmentMemorySize(loadInfo)
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		size += indexInfo.GetIndexSize()
+	}
+	return size
+}
+
+// segmentCacheBackendImpl evicts and reloads the data of the sealed segments managed by the segment manager.
+type segmentCacheBackendImpl struct {
+	manager *Manager
+}
+
+func (b *segmentCacheBackendImpl) Evict(ctx context.Context, segment Segment) error {
+	local, ok := segment.(*LocalSegment)
+	if !ok {
+		return merr.WrapErrSegmentNotLoaded(segment.ID(), "not a local segment")
+	}
+	local.Release(ctx, WithReleaseScope(ReleaseScopeData))
+	return nil
+}
+
+func (b *segmentCacheBackendImpl) Reload(ctx context.Context, segment Segment) error {
+	return b.manager.Loader.ReloadSegmentData(ctx, segment)
+}
@@ -0,0 +1,284 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type fakeSegmentCacheBackend struct {
+	mu        sync.Mutex
+	evicted   []int64
+	reloaded  []int64
+	reloadErr error
+	evictErr  error
+}
+
+func (b *fakeSegmentCacheBackend) Evict(ctx context.Context, segment Segment) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.evictErr != nil {
+		return b.evictErr
+	}
+	b.evicted = append(b.evicted, segment.ID())
+	return nil
+}
+
+func (b *fakeSegmentCacheBackend) Reload(ctx context.Context, segment Segment) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.reloadErr != nil {
+		return b.reloadErr
+	}
+	b.reloaded = append(b.reloaded, segment.ID())
+	return nil
+}
+
+func newTestCacheSegment(t *testing.T, id int64, typ SegmentType) Segment {
+	segment := NewMockSegment(t)
+	segment.EXPECT().ID().Return(id).Maybe()
+	segment.EXPECT().Type().Return(typ).Maybe()
+	return segment
+}
+
+func enableSegmentCache(t *testing.T, capacity string, minAccess string) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.SegmentCacheEnabled.Key, "true")
+	params.Save(params.QueryNodeCfg.SegmentCacheCapacity.Key, capacity)
+	params.Save(params.QueryNodeCfg.SegmentCacheAdmissionMinAccessCount.Key, minAccess)
+	t.Cleanup(func() {
+		params.Reset(params.QueryNodeCfg.SegmentCacheEnabled.Key)
+		params.Reset(params.QueryNodeCfg.SegmentCacheCapacity.Key)
+		params.Reset(params.QueryNodeCfg.SegmentCacheAdmissionMinAccessCount.Key)
+	})
+}
+
+func TestSegmentCache_LRU(t *testing.T) {
+	enableSegmentCache(t, "100", "1")
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	s2 := newTestCacheSegment(t, 2, SegmentTypeSealed)
+	s3 := newTestCacheSegment(t, 3, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 40)
+	cache.Admit(ctx, s2, 40)
+
+	// s1 is the most recently used one
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	cache.Release(ctx, []Segment{s1})
+
+	// s2 is evicted to make room for s3
+	cache.Reserve(ctx, 40)
+	cache.Admit(ctx, s3, 40)
+	assert.Equal(t, []int64{2}, backend.evicted)
+	assert.Equal(t, int64(80), cache.resident)
+	assert.Equal(t, 2, cache.residentNum)
+
+	// s2 is loaded back on access, s1 is the least recently used one now
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s2}))
+	assert.Equal(t, []int64{2}, backend.reloaded)
+	assert.Equal(t, []int64{2, 1}, backend.evicted)
+	cache.Release(ctx, []Segment{s2})
+
+	// the hit doesn't reload
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s2}))
+	cache.Release(ctx, []Segment{s2})
+	assert.Equal(t, []int64{2}, backend.reloaded)
+	assert.Equal(t, int64(80), cache.resident)
+}
+
+func TestSegmentCache_InUse(t *testing.T) {
+	enableSegmentCache(t, "100", "1")
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	s2 := newTestCacheSegment(t, 2, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 60)
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+
+	// s1 is in use, the cache is over the capacity
+	cache.Admit(ctx, s2, 60)
+	assert.Equal(t, []int64{2}, backend.evicted)
+
+	// s1 is evicted after it's released
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s2}))
+	assert.Equal(t, []int64{2}, backend.evicted)
+	cache.Release(ctx, []Segment{s1})
+	cache.Reserve(ctx, 60)
+	assert.Equal(t, []int64{2, 1}, backend.evicted)
+	cache.Release(ctx, []Segment{s2})
+}
+
+func TestSegmentCache_Admission(t *testing.T) {
+	enableSegmentCache(t, "100", "2")
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	s2 := newTestCacheSegment(t, 2, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 60)
+	cache.Admit(ctx, s2, 60)
+	assert.Equal(t, []int64{1}, backend.evicted)
+
+	// the first access loads s1 for the request only, it's evicted after the request
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	assert.Equal(t, []int64{1, 2}, backend.evicted)
+	cache.Release(ctx, []Segment{s1})
+	assert.Equal(t, []int64{1, 2, 1}, backend.evicted)
+
+	// the access count is reset by the eviction, so s1 is not admitted yet
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	cache.Release(ctx, []Segment{s1})
+	cache.Release(ctx, []Segment{s1})
+	assert.Equal(t, []int64{1, 2, 1}, backend.evicted)
+	assert.Equal(t, 1, cache.lru.Len())
+
+	// the segment larger than the capacity is never admitted
+	s3 := newTestCacheSegment(t, 3, SegmentTypeSealed)
+	cache.Admit(ctx, s3, 200)
+	assert.Equal(t, []int64{1, 2, 1, 1, 3}, backend.evicted)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, cache.Acquire(ctx, []Segment{s3}))
+		cache.Release(ctx, []Segment{s3})
+	}
+	assert.Equal(t, 0, cache.lru.Len())
+	assert.Equal(t, int64(0), cache.resident)
+}
+
+func TestSegmentCache_Failure(t *testing.T) {
+	enableSegmentCache(t, "100", "1")
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	s2 := newTestCacheSegment(t, 2, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 60)
+	cache.Admit(ctx, s2, 60)
+	assert.Equal(t, []int64{1}, backend.evicted)
+
+	// the acquisition is all or nothing
+	backend.reloadErr = errors.New("mock")
+	assert.Error(t, cache.Acquire(ctx, []Segment{s2, s1}))
+	assert.Equal(t, 0, cache.entries[s1].inUse)
+	assert.Equal(t, 0, cache.entries[s2].inUse)
+	assert.False(t, cache.entries[s1].resident)
+
+	// the segment failed to be evicted is kept resident
+	backend.reloadErr = nil
+	backend.evictErr = errors.New("mock")
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	cache.Release(ctx, []Segment{s1})
+	assert.True(t, cache.entries[s1].resident)
+	assert.True(t, cache.entries[s2].resident)
+	assert.Equal(t, int64(120), cache.resident)
+}
+
+func TestSegmentCache_Remove(t *testing.T) {
+	enableSegmentCache(t, "100", "1")
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	s2 := newTestCacheSegment(t, 2, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 60)
+	cache.Admit(ctx, s2, 60)
+
+	cache.Remove(s1)
+	cache.Remove(s2)
+	cache.Remove(s2)
+	assert.Empty(t, cache.entries)
+	assert.Equal(t, 0, cache.lru.Len())
+	assert.Equal(t, int64(0), cache.resident)
+	assert.Equal(t, 0, cache.residentNum)
+
+	// the removed segment is not tracked any more
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	cache.Release(ctx, []Segment{s1})
+	assert.Empty(t, backend.reloaded)
+}
+
+func TestSegmentCache_Disabled(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 60)
+	cache.Reserve(ctx, 100)
+	assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+	cache.Release(ctx, []Segment{s1})
+	cache.Remove(s1)
+	assert.Empty(t, cache.entries)
+	assert.Empty(t, backend.evicted)
+
+	// growing segments are not tracked
+	enableSegmentCache(t, "100", "1")
+	cache.Admit(ctx, newTestCacheSegment(t, 2, SegmentTypeGrowing), 60)
+	assert.Empty(t, cache.entries)
+
+	var nilCache *SegmentCache
+	nilCache.Reserve(ctx, 100)
+	nilCache.Admit(ctx, s1, 60)
+	assert.NoError(t, nilCache.Acquire(ctx, []Segment{s1}))
+	nilCache.Release(ctx, []Segment{s1})
+	nilCache.Remove(s1)
+}
+
+func TestSegmentCache_Concurrent(t *testing.T) {
+	enableSegmentCache(t, "100", "1")
+	ctx := context.Background()
+	backend := &fakeSegmentCacheBackend{}
+	cache := newSegmentCache(backend)
+
+	s1 := newTestCacheSegment(t, 1, SegmentTypeSealed)
+	s2 := newTestCacheSegment(t, 2, SegmentTypeSealed)
+	cache.Admit(ctx, s1, 60)
+	cache.Admit(ctx, s2, 60)
+
+	// the concurrent accesses of the evicted segment reload it once
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, cache.Acquire(ctx, []Segment{s1}))
+			cache.Release(ctx, []Segment{s1})
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []int64{1}, backend.reloaded)
+	assert.Equal(t, 0, cache.entries[s1].inUse)
+	assert.True(t, cache.entries[s1].resident)
+	assert.Equal(t, int64(60), cache.resident)
+}
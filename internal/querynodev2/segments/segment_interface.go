@@ -41,7 +41,7 @@ type ResourceUsage struct {
 	MemorySize         uint64
 	DiskSize           uint64
 	MmapFieldCount     int
-	FieldGpuMemorySize []uint64
+	FieldGpuMemorySize map[int64]uint64 // indexID -> GPU memory size
 }
 
 // Segment is the interface of a segment implementation.
@@ -77,6 +77,10 @@ type Segment interface {
 	MemSize() int64
 	// ResourceUsageEstimate returns the estimated resource usage of the segment
 	ResourceUsageEstimate() ResourceUsage
+	// RecordAccess records a search or query on the segment
+	RecordAccess()
+	// AccessQPS returns the recent search and query rate of the segment
+	AccessQPS() float64
 
 	// Index related
 	GetIndexByID(indexID int64) *IndexedFieldInfo
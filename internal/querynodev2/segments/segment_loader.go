@@ -27,7 +27,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"math"
 	"path"
 	"strconv"
 	"sync"
@@ -99,6 +98,9 @@ type Loader interface {
 	ReopenSegments(ctx context.Context,
 		loadInfos []*querypb.SegmentLoadInfo,
 	) error
+
+	// ReloadSegmentData loads the data of the sealed segment evicted by the segment cache back.
+	ReloadSegmentData(ctx context.Context, segment Segment) error
 }
 
 type ResourceEstimate struct {
@@ -178,6 +180,7 @@ func NewLoader(
 		loadingSegments:           typeutil.NewConcurrentMap[int64, *loadResult](),
 		committedResourceNotifier: syncutil.NewVersionedNotifier(),
 		duf:                       duf,
+		admission:                 newLoadAdmission(),
 	}
 
 	return loader
@@ -222,6 +225,9 @@ type segmentLoader struct {
 	committedResourceNotifier *syncutil.VersionedNotifier
 
 	duf *diskUsageFetcher
+
+	// admission orders the concurrent loads by priority, and limits the loads of a single collection
+	admission *loadAdmission
 }
 
 var _ Loader = (*segmentLoader)(nil)
@@ -256,9 +262,28 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	var err error
 	var requestResourceResult requestResourceResult
 
+	// Wait for the admission, the urgent loads (recovery, evicting stopping nodes) go first
+	if len(infos) > 0 {
+		release, err := loader.admission.Acquire(ctx, collectionID, getLoadPriority(infos))
+		if err != nil {
+			mlog.Warn(context.TODO(), "failed to wait for load admission", mlog.Err(err))
+			return nil, err
+		}
+		defer release()
+	}
+
+	// Evict the cold sealed segments to make room for the new ones
+	if segmentType == SegmentTypeSealed {
+		size := int64(0)
+		for _, info := range infos {
+			size += segmentCacheSize(info)
+		}
+		loader.manager.Cache.Reserve(ctx, size)
+	}
+
 	// Check memory & storage limit
 	// no need to check resource for lazy load here
-	requestResourceResult, err = loader.requestResource(ctx, infos...)
+	requestResourceResult, err = loader.requestResourceWithWait(ctx, infos...)
 	if err != nil {
 		mlog.Warn(context.TODO(), "request resource failed", mlog.Err(err))
 		return nil, err
@@ -267,40 +292,59 @@ func (loader *segmentLoader) Load(ctx context.Context,
 
 	newSegments := typeutil.NewConcurrentMap[int64, Segment]()
 	loaded := typeutil.NewConcurrentMap[int64, Segment]()
+	defer func() {
+		// the GPU memory reserved for the loaded segments is released along with them
+		for _, info := range infos {
+			if !loaded.Contain(info.GetSegmentID()) {
+				loader.manager.GPUMemory.Release(info.GetSegmentID())
+			}
+		}
+	}()
 	defer func() {
 		newSegments.Range(func(segmentID int64, s Segment) bool {
 			mlog.Warn(context.TODO(), "release new segment created due to load failure",
 				mlog.Int64("segmentID", segmentID),
 				mlog.Err(err),
 			)
+			collection.lazyFields.untrack(s)
 			s.Release(context.Background())
 			return true
 		})
 	}()
 
-	for _, info := range infos {
-		loadInfo := info
-
-		for _, indexInfo := range loadInfo.IndexInfos {
-			indexParams := funcutil.KeyValuePair2Map(indexInfo.IndexParams)
-
-			// some build params also exist in indexParams, which are useless during loading process
-			if vecindexmgr.GetVecIndexMgrInstance().IsDiskANN(indexParams["index_type"]) {
-				if err := indexparams.SetDiskIndexLoadParams(paramtable.Get(), indexParams, indexInfo.GetNumRows()); err != nil {
-					return nil, err
-				}
+	// the large vector indexes are loaded in background after the segments are loaded with the raw vectors
+	var deferredInfos []*querypb.SegmentLoadInfo
+	if segmentType == SegmentTypeSealed {
+		for i, info := range infos {
+			if stripped := stripDeferredIndexes(collection.Schema(), info); stripped != nil {
+				deferredInfos = append(deferredInfos, info)
+				infos[i] = stripped
 			}
+		}
+	}
 
-			// set whether enable offset cache for bitmap index
-			if indexParams["index_type"] == indexparamcheck.IndexBitmap {
-				indexparams.SetBitmapIndexLoadParams(paramtable.Get(), indexParams)
-			}
+	for _, info := range deferredInfos {
+		if err := prepareIndexLoadParams(info); err != nil {
+			return nil, err
+		}
+	}
 
-			if err := indexparams.AppendPrepareLoadParams(paramtable.Get(), indexParams); err != nil {
-				return nil, err
+	// the cold fields are loaded on demand, the deferred infos keep them to reopen the segments later
+	unloadedFields := make(map[int64][]*datapb.FieldBinlog)
+	if segmentType == SegmentTypeSealed {
+		for i, info := range infos {
+			if stripped, unloaded := collection.lazyFields.strip(collection.Schema(), info); len(unloaded) > 0 {
+				unloadedFields[info.GetSegmentID()] = unloaded
+				infos[i] = stripped
 			}
+		}
+	}
+
+	for _, info := range infos {
+		loadInfo := info
 
-			indexInfo.IndexParams = funcutil.Map2KeyValuePair(indexParams)
+		if err := prepareIndexLoadParams(loadInfo); err != nil {
+			return nil, err
 		}
 
 		segment, err := NewSegment(
@@ -320,6 +364,7 @@ func (loader *segmentLoader) Load(ctx context.Context,
 			return nil, err
 		}
 
+		collection.lazyFields.track(segment, unloadedFields[loadInfo.GetSegmentID()])
 		newSegments.Insert(loadInfo.GetSegmentID(), segment)
 	}
 
@@ -391,6 +436,7 @@ func (loader *segmentLoader) Load(ctx context.Context,
 
 		if segment.Level() != datapb.SegmentLevel_L0 {
 			loader.manager.Segment.Put(ctx, segmentType, segment)
+			loader.manager.Cache.Admit(ctx, segment, segmentCacheSize(loadInfo))
 		}
 		newSegments.GetAndRemove(segmentID)
 		loaded.Insert(segmentID, segment)
@@ -421,6 +467,9 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	}
 
 	mlog.Info(context.TODO(), "all segment load done")
+	if len(deferredInfos) > 0 {
+		go loader.loadDeferredIndexes(deferredInfos)
+	}
 	var result []Segment
 	loaded.Range(func(_ int64, s Segment) bool {
 		result = append(result, s)
@@ -509,9 +558,11 @@ func (loader *segmentLoader) requestResource(ctx context.Context, infos ...*quer
 	}
 
 	if loader.committedResource.MemorySize+physicalMemoryUsage >= totalMemory {
-		return result, merr.WrapErrServiceMemoryLimitExceeded(float32(loader.committedResource.MemorySize+physicalMemoryUsage), float32(totalMemory))
+		return result, merr.WrapErrSegmentRequestResourceFailed("Memory",
+			merr.WrapErrServiceMemoryLimitExceeded(float32(loader.committedResource.MemorySize+physicalMemoryUsage), float32(totalMemory)).Error())
 	} else if loader.committedResource.DiskSize+uint64(physicalDiskUsage) >= diskCap {
-		return result, merr.WrapErrServiceDiskLimitExceeded(float32(loader.committedResource.DiskSize+uint64(physicalDiskUsage)), float32(diskCap))
+		return result, merr.WrapErrSegmentRequestResourceFailed("Disk",
+			merr.WrapErrServiceDiskLimitExceeded(float32(loader.committedResource.DiskSize+uint64(physicalDiskUsage)), float32(diskCap)).Error())
 	}
 
 	result.ConcurrencyLevel = funcutil.Min(hardware.GetCPUNum(), len(infos))
@@ -547,6 +598,34 @@ func (loader *segmentLoader) requestResource(ctx context.Context, infos ...*quer
 	return result, nil
 }
 
+// requestResourceWithWait requests memory & storage to load segments like requestResource.
+// If the predicted usage exceeds the watermark while other segments are loading,
+// it waits for them to free their loading resource and retries, at most queryNode.loadAdmission.resourceWaitTimeout.
+func (loader *segmentLoader) requestResourceWithWait(ctx context.Context, infos ...*querypb.SegmentLoadInfo) (requestResourceResult, error) {
+	// listen before requesting, so the resource freed in between is not missed
+	listener := loader.committedResourceNotifier.Listen(syncutil.VersionedListenAtLatest)
+	result, err := loader.requestResource(ctx, infos...)
+	timeout := paramtable.Get().QueryNodeCfg.LoadResourceWaitTimeout.GetAsDuration(time.Second)
+	if timeout <= 0 {
+		return result, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for errors.Is(err, merr.ErrSegmentRequestResourceFailed) {
+		// only the loading segments free the committed resource, no need to wait if there's none
+		if result.CommittedResource.MemorySize == 0 && result.CommittedResource.DiskSize == 0 {
+			break
+		}
+		mlog.Info(context.TODO(), "wait for the loading segments to free resource", mlog.Err(err))
+		if waitErr := listener.Wait(waitCtx); waitErr != nil {
+			break
+		}
+		result, err = loader.requestResource(ctx, infos...)
+	}
+	return result, err
+}
+
 // freeRequestResource returns request memory & storage usage request.
 func (loader *segmentLoader) freeRequestResource(requestResourceResult requestResourceResult) {
 	loader.mut.Lock()
@@ -1061,6 +1140,16 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	return nil
 }
 
+// ReloadSegmentData loads the data of the sealed segment evicted by the segment cache back,
+// the meta, the pk candidate and the deletes of the segment are kept by the eviction, so only the data is loaded.
+func (loader *segmentLoader) ReloadSegmentData(ctx context.Context, seg Segment) error {
+	segment, ok := seg.(*LocalSegment)
+	if !ok {
+		return merr.WrapErrParameterInvalid("LocalSegment", fmt.Sprintf("%T", seg))
+	}
+	return loader.loadSealedSegment(ctx, segment.LoadInfo(), segment)
+}
+
 func (loader *segmentLoader) LoadSegment(ctx context.Context,
 	seg Segment,
 	loadInfo *querypb.SegmentLoadInfo,
@@ -1625,7 +1714,7 @@ func (loader *segmentLoader) checkSegmentSize(ctx context.Context, segmentLoadIn
 	maxSegmentSize := uint64(0)
 	predictMemUsage := memUsage
 	predictDiskUsage := diskUsage
-	var predictGpuMemUsage []uint64
+	predictGpuMemUsage := make(map[int64]map[int64]uint64)
 	mmapFieldCount := 0
 	for _, loadInfo := range segmentLoadInfos {
 		collection := loader.manager.Collection.Get(loadInfo.GetCollectionID())
@@ -1647,7 +1736,9 @@ func (loader *segmentLoader) checkSegmentSize(ctx context.Context, segmentLoadIn
 		mmapFieldCount += loadingUsage.MmapFieldCount
 		predictDiskUsage += loadingUsage.DiskSize
 		predictMemUsage += loadingUsage.MemorySize
-		predictGpuMemUsage = loadingUsage.FieldGpuMemorySize
+		if len(loadingUsage.FieldGpuMemorySize) > 0 {
+			predictGpuMemUsage[loadInfo.GetSegmentID()] = loadingUsage.FieldGpuMemorySize
+		}
 		if loadingUsage.MemorySize > maxSegmentSize {
 			maxSegmentSize = loadingUsage.MemorySize
 		}
@@ -1707,7 +1798,8 @@ func (loader *segmentLoader) checkSegmentSize(ctx context.Context, segmentLoadIn
 		}
 	}
 
-	err := checkSegmentGpuMemSize(predictGpuMemUsage, float32(paramtable.Get().GpuConfig.OverloadedMemoryThresholdPercentage.GetAsFloat()))
+	// reserve GPU memory at last, it's kept until the segments are released
+	err := loader.manager.GPUMemory.Reserve(ctx, predictGpuMemUsage)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -1917,7 +2009,7 @@ func estimateLoadingResourceUsageOfSegment(schema *schemapb.CollectionSchema, lo
 	var segMemoryLoadingSize, segDiskLoadingSize uint64
 	var indexMemorySize uint64
 	var mmapFieldCount int
-	var fieldGpuMemorySize []uint64
+	fieldGpuMemorySize := make(map[int64]uint64)
 
 	id2Binlogs := lo.SliceToMap(loadInfo.BinlogPaths, func(fieldBinlog *datapb.FieldBinlog) (int64, *datapb.FieldBinlog) {
 		return fieldBinlog.GetFieldID(), fieldBinlog
@@ -1967,7 +2059,7 @@ func estimateLoadingResourceUsageOfSegment(schema *schemapb.CollectionSchema, lo
 			}
 
 			if gpuIndexRequiresGpu(fieldIndexInfo.IndexParams) {
-				fieldGpuMemorySize = append(fieldGpuMemorySize, estimateResult.MaxMemoryCost)
+				fieldGpuMemorySize[fieldIndexInfo.GetIndexID()] = estimateResult.MaxMemoryCost
 			}
 
 			// could skip binlog or
@@ -2330,11 +2422,13 @@ func (loader *segmentLoader) ReopenSegments(ctx context.Context,
 			continue
 		}
 		collection := loader.manager.Collection.Get(info.GetCollectionID())
-		if collection != nil {
+		if collection == nil {
+			err = segment.Reopen(ctx, info)
+		} else {
 			configureUseTakeForOutput(info, collection.Schema())
+			// keep the fields not loaded yet unloaded
+			err = collection.lazyFields.reopen(ctx, collection.Schema(), segment, info)
 		}
-
-		err := segment.Reopen(ctx, info)
 		if err != nil {
 			mlog.Warn(context.TODO(), "failed to reopen segment", mlog.Int64("segmentID", info.GetSegmentID()), mlog.Err(err))
 			return err
@@ -2344,6 +2438,78 @@ func (loader *segmentLoader) ReopenSegments(ctx context.Context,
 	return nil
 }
 
+// prepareIndexLoadParams sets the load params of the indexes in the load info.
+func prepareIndexLoadParams(loadInfo *querypb.SegmentLoadInfo) error {
+	for _, indexInfo := range loadInfo.IndexInfos {
+		indexParams := funcutil.KeyValuePair2Map(indexInfo.IndexParams)
+
+		// some build params also exist in indexParams, which are useless during loading process
+		if vecindexmgr.GetVecIndexMgrInstance().IsDiskANN(indexParams["index_type"]) {
+			if err := indexparams.SetDiskIndexLoadParams(paramtable.Get(), indexParams, indexInfo.GetNumRows()); err != nil {
+				return err
+			}
+		}
+
+		// set whether enable offset cache for bitmap index
+		if indexParams["index_type"] == indexparamcheck.IndexBitmap {
+			indexparams.SetBitmapIndexLoadParams(paramtable.Get(), indexParams)
+		}
+
+		if err := indexparams.AppendPrepareLoadParams(paramtable.Get(), indexParams); err != nil {
+			return err
+		}
+
+		indexInfo.IndexParams = funcutil.Map2KeyValuePair(indexParams)
+	}
+	return nil
+}
+
+// stripDeferredIndexes returns the load info without the vector indexes to be loaded in background,
+// or nil if no index is deferred.
+// The index is deferred only if it's not smaller than queryNode.deferredIndexLoad.minIndexSizeMB
+// and the raw vectors are in the binlogs, so the segment is searchable before the index is loaded.
+func stripDeferredIndexes(schema *schemapb.CollectionSchema, loadInfo *querypb.SegmentLoadInfo) *querypb.SegmentLoadInfo {
+	minIndexSize := paramtable.Get().QueryNodeCfg.DeferredIndexLoadMinSize.GetAsInt64() * 1024 * 1024
+	if minIndexSize <= 0 || typeutil.IsExternalCollection(schema) {
+		return nil
+	}
+
+	binlogFields := typeutil.NewSet[int64]()
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		binlogFields.Insert(fieldBinlog.GetFieldID())
+		binlogFields.Insert(fieldBinlog.GetChildFields()...)
+	}
+	indexInfos := make([]*querypb.FieldIndexInfo, 0, len(loadInfo.GetIndexInfos()))
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		field := typeutil.GetField(schema, indexInfo.GetFieldID())
+		if field != nil && typeutil.IsVectorType(field.GetDataType()) &&
+			indexInfo.GetIndexSize() >= minIndexSize && binlogFields.Contain(indexInfo.GetFieldID()) {
+			continue
+		}
+		indexInfos = append(indexInfos, indexInfo)
+	}
+	if len(indexInfos) == len(loadInfo.GetIndexInfos()) {
+		return nil
+	}
+
+	stripped := typeutil.Clone(loadInfo)
+	stripped.IndexInfos = indexInfos
+	return stripped
+}
+
+// loadDeferredIndexes loads the deferred indexes by reopening the loaded segments with the full load infos.
+func (loader *segmentLoader) loadDeferredIndexes(infos []*querypb.SegmentLoadInfo) {
+	segmentIDs := lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) int64 { return info.GetSegmentID() })
+	tr := timerecord.NewTimeRecorder("segmentLoader.loadDeferredIndexes")
+	mlog.Info(context.TODO(), "start to load deferred indexes", mlog.Int64s("segmentIDs", segmentIDs))
+	if err := loader.ReopenSegments(context.Background(), infos); err != nil {
+		// the index checker of querycoord loads the missing indexes later
+		mlog.Warn(context.TODO(), "failed to load deferred indexes", mlog.Int64s("segmentIDs", segmentIDs), mlog.Err(err))
+		return
+	}
+	mlog.Info(context.TODO(), "load deferred indexes done", mlog.Int64s("segmentIDs", segmentIDs), mlog.Duration("elapse", tr.ElapseSpan()))
+}
+
 func getBinlogDataDiskSize(fieldBinlog *datapb.FieldBinlog) int64 {
 	fieldSize := int64(0)
 	for _, binlog := range fieldBinlog.Binlogs {
@@ -2392,42 +2558,3 @@ func gpuIndexRequiresGpu(indexParams []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
-
-func checkSegmentGpuMemSize(fieldGpuMemSizeList []uint64, OverloadedMemoryThresholdPercentage float32) error {
-	gpuInfos, err := hardware.GetAllGPUMemoryInfo()
-	if err != nil {
-		if len(fieldGpuMemSizeList) == 0 {
-			return nil
-		}
-		return err
-	}
-	var usedGpuMem []uint64
-	var maxGpuMemSize []uint64
-	for _, gpuInfo := range gpuInfos {
-		usedGpuMem = append(usedGpuMem, gpuInfo.TotalMemory-gpuInfo.FreeMemory)
-		maxGpuMemSize = append(maxGpuMemSize, uint64(float32(gpuInfo.TotalMemory)*OverloadedMemoryThresholdPercentage))
-	}
-	currentGpuMem := usedGpuMem
-	for _, fieldGpuMem := range fieldGpuMemSizeList {
-		var minId int = -1
-		var minGpuMem uint64 = math.MaxUint64
-		for i := int(0); i < len(gpuInfos); i++ {
-			GpuiMem := currentGpuMem[i] + fieldGpuMem
-			if GpuiMem < maxGpuMemSize[i] && GpuiMem < minGpuMem {
-				minId = i
-				minGpuMem = GpuiMem
-			}
-		}
-		if minId == -1 {
-			mlog.Warn(context.TODO(), "load segment failed, GPU OOM if loaded",
-				mlog.String("resourceType", "GPU"),
-				mlog.Uint64("gpuMemUsageBytes", fieldGpuMem),
-				mlog.Any("usedGpuMemBytes", usedGpuMem),
-				mlog.Any("maxGpuMemBytes", maxGpuMemSize),
-			)
-			return merr.WrapErrSegmentRequestResourceFailed("GPU")
-		}
-		currentGpuMem[minId] += minGpuMem
-	}
-	return nil
-}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/bytedance/mockey"
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -103,7 +104,7 @@ func (suite *SegmentLoaderSuite) SetupTest() {
 		CollectionID: suite.collectionID,
 		PartitionIDs: []int64{suite.partitionID},
 	}
-	suite.manager.Collection.PutOrRef(suite.collectionID, suite.schema, indexMeta, loadMeta)
+	suite.manager.Collection.PutOrRef(context.Background(), suite.collectionID, suite.schema, indexMeta, loadMeta)
 }
 
 func (suite *SegmentLoaderSuite) TearDownTest() {
@@ -1235,6 +1236,31 @@ func (suite *SegmentLoaderDetailSuite) TestRequestResource() {
 		suite.NoError(err)
 		suite.EqualValues(1100000, resource.Resource.MemorySize)
 	})
+
+	suite.Run("wait_for_loading_segments", func() {
+		thresholdKey := paramtable.Get().QueryNodeCfg.OverloadedMemoryThresholdPercentage.Key
+		paramtable.Get().Save(thresholdKey, "0")
+		defer paramtable.Get().Reset(thresholdKey)
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.LoadResourceWaitTimeout.Key, "10")
+		defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.LoadResourceWaitTimeout.Key)
+
+		// no loading segment to free resource, reject at once.
+		_, err := suite.loader.requestResourceWithWait(context.Background(), loadInfo)
+		suite.ErrorIs(err, merr.ErrSegmentRequestResourceFailed)
+
+		loading := requestResourceResult{Resource: LoadResource{MemorySize: 1024}}
+		suite.loader.mut.Lock()
+		suite.loader.committedResource.Add(loading.Resource)
+		suite.loader.mut.Unlock()
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			paramtable.Get().Reset(thresholdKey)
+			suite.loader.freeRequestResource(loading)
+		}()
+		resource, err := suite.loader.requestResourceWithWait(context.Background(), loadInfo)
+		suite.NoError(err)
+		suite.loader.freeRequestResource(resource)
+	})
 }
 
 func (suite *SegmentLoaderDetailSuite) TestCheckSegmentSizeWithDiskLimit() {
@@ -2013,3 +2039,40 @@ func TestSegmentLoader(t *testing.T) {
 	suite.Run(t, &SegmentLoaderTextIndexEstimateSuite{})
 	suite.Run(t, &ExternalSegmentEstimateSuite{})
 }
+
+func TestStripDeferredIndexes(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "small_vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	loadInfo := &querypb.SegmentLoadInfo{
+		SegmentID: 1,
+		BinlogPaths: []*datapb.FieldBinlog{
+			{FieldID: 100},
+			{FieldID: 0, ChildFields: []int64{101, 102}},
+		},
+		IndexInfos: []*querypb.FieldIndexInfo{
+			{FieldID: 100, IndexSize: 1024 * 1024 * 1024},
+			{FieldID: 101, IndexSize: 1024 * 1024 * 1024},
+			{FieldID: 102, IndexSize: 1024},
+		},
+	}
+
+	// disabled by default.
+	assert.Nil(t, stripDeferredIndexes(schema, loadInfo))
+
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.DeferredIndexLoadMinSize.Key, "512")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.DeferredIndexLoadMinSize.Key)
+	stripped := stripDeferredIndexes(schema, loadInfo)
+	assert.NotNil(t, stripped)
+	assert.ElementsMatch(t, []int64{100, 102}, lo.Map(stripped.GetIndexInfos(), func(info *querypb.FieldIndexInfo, _ int) int64 { return info.GetFieldID() }))
+	assert.Len(t, loadInfo.GetIndexInfos(), 3)
+
+	// no raw vectors to search before the index is loaded.
+	loadInfo.BinlogPaths = []*datapb.FieldBinlog{{FieldID: 100}}
+	assert.Nil(t, stripDeferredIndexes(schema, loadInfo))
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// GetMemoryBreakdown returns the memory breakdown of the segment.
+// The sizes of the raw columns and indexes come from the load info, which are the sizes in memory after loading,
+// and the MemSize is the total size reported by segcore for comparison.
+func GetMemoryBreakdown(segment Segment) *metricsinfo.SegmentMemory {
+	m := &metricsinfo.SegmentMemory{
+		SegmentID:    segment.ID(),
+		CollectionID: segment.Collection(),
+		PartitionID:  segment.Partition(),
+		State:        segment.Type().String(),
+		Level:        segment.Level().String(),
+		MemSize:      segment.MemSize(),
+	}
+	local, ok := segment.(*LocalSegment)
+	if !ok {
+		return m
+	}
+
+	if bfs, ok := local.pkCandidate.(*pkoracle.BloomFilterSet); ok {
+		m.BloomFilterSize = bfs.MemSize()
+		m.HeapSize += m.BloomFilterSize
+	}
+
+	// growing segment holds the inserted data in segcore only
+	if segment.Type() == SegmentTypeGrowing {
+		if isGrowingMmapEnable() {
+			m.MmapSize += m.MemSize
+		} else {
+			m.HeapSize += m.MemSize
+		}
+		return m
+	}
+
+	schemaHelper, err := typeutil.CreateSchemaHelper(local.collection.Schema())
+	if err != nil {
+		return m
+	}
+	for _, fieldBinlog := range local.LoadInfo().GetBinlogPaths() {
+		fieldID := fieldBinlog.GetFieldID()
+		fieldIDs := fieldBinlog.GetChildFields()
+		// legacy default split
+		if len(fieldIDs) == 0 {
+			fieldIDs = []int64{fieldID}
+		}
+		loaded := false
+		mmap := true
+		for _, childID := range fieldIDs {
+			if common.IsSystemField(childID) || local.HasFieldData(childID) {
+				loaded = true
+			}
+			field, err := schemaHelper.GetFieldFromID(childID)
+			if err != nil || !isDataMmapEnable(field) {
+				mmap = false
+			}
+		}
+		if !loaded {
+			continue
+		}
+		m.Columns = append(m.Columns, &metricsinfo.SegmentComponentMemory{
+			FieldID: fieldID,
+			Size:    getBinlogDataMemorySize(fieldBinlog),
+			Mmap:    mmap,
+		})
+	}
+
+	for _, index := range local.Indexes() {
+		if !index.IsLoaded {
+			continue
+		}
+		mmap := false
+		if field, err := schemaHelper.GetFieldFromID(index.IndexInfo.GetFieldID()); err == nil {
+			mmap = isIndexMmapEnable(field, index.IndexInfo)
+		}
+		m.Indexes = append(m.Indexes, &metricsinfo.SegmentComponentMemory{
+			FieldID: index.IndexInfo.GetFieldID(),
+			IndexID: index.IndexInfo.GetIndexID(),
+			Size:    index.IndexInfo.GetIndexSize(),
+			Mmap:    mmap,
+		})
+	}
+
+	for _, components := range [][]*metricsinfo.SegmentComponentMemory{m.Columns, m.Indexes} {
+		sort.Slice(components, func(i, j int) bool {
+			if components[i].FieldID != components[j].FieldID {
+				return components[i].FieldID < components[j].FieldID
+			}
+			return components[i].IndexID < components[j].IndexID
+		})
+		for _, component := range components {
+			if component.Mmap {
+				m.MmapSize += component.Size
+			} else {
+				m.HeapSize += component.Size
+			}
+		}
+	}
+	return m
+}
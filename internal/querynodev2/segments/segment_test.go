@@ -65,7 +65,7 @@ func (suite *SegmentSuite) SetupTest() {
 	suite.manager = NewManager()
 	schema := mock_segcore.GenTestCollectionSchema("test-reduce", schemapb.DataType_Int64, true)
 	indexMeta := mock_segcore.GenTestIndexMeta(suite.collectionID, schema)
-	suite.manager.Collection.PutOrRef(suite.collectionID,
+	suite.manager.Collection.PutOrRef(context.Background(), suite.collectionID,
 		schema,
 		indexMeta,
 		&querypb.LoadMetaInfo{
@@ -241,6 +241,24 @@ func (suite *SegmentSuite) TestResourceUsageEstimate() {
 	suite.Zero(usage.MmapFieldCount)
 }
 
+func (suite *SegmentSuite) TestMemoryBreakdown() {
+	m := GetMemoryBreakdown(suite.sealed)
+	suite.Equal(suite.segmentID, m.SegmentID)
+	suite.Equal(suite.partitionID, m.PartitionID)
+	suite.Equal("Sealed", m.State)
+	suite.Require().Len(m.Columns, 1)
+	suite.EqualValues(101, m.Columns[0].FieldID)
+	suite.EqualValues(10086, m.Columns[0].Size)
+	suite.Empty(m.Indexes)
+	suite.EqualValues(10086, m.HeapSize+m.MmapSize)
+
+	// growing segment holds all the data in segcore
+	m = GetMemoryBreakdown(suite.growing)
+	suite.Equal("Growing", m.State)
+	suite.Empty(m.Columns)
+	suite.Equal(suite.growing.MemSize(), m.HeapSize+m.MmapSize)
+}
+
 func (suite *SegmentSuite) TestDelete() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -321,6 +321,18 @@ func (node *QueryNode) registerMetricsRequest() {
 			collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
 			return getChannelJSON(node, collectionID), nil
 		})
+
+	node.metricsRequest.RegisterMetricsRequest(metricsinfo.CollectionRefKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
+			return getCollectionRefJSON(node, collectionID), nil
+		})
+
+	node.metricsRequest.RegisterMetricsRequest(metricsinfo.SegmentMemoryKey,
+		func(ctx context.Context, req *milvuspb.GetMetricsRequest, jsonReq gjson.Result) (string, error) {
+			collectionID := metricsinfo.GetCollectionIDFromRequest(jsonReq)
+			return getSegmentMemoryJSON(node, collectionID), nil
+		})
 	mlog.Info(node.ctx, "register metrics actions finished")
 }
 
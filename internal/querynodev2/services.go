@@ -222,7 +222,7 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, req *querypb.WatchDm
 		return merr.Success(), nil
 	}
 
-	err := node.manager.Collection.PutOrRef(req.GetCollectionID(), req.GetSchema(),
+	err := node.manager.Collection.PutOrRef(ctx, req.GetCollectionID(), req.GetSchema(),
 		segments.ComposeIndexMeta(ctx, req.GetIndexInfoList(), req.Schema), req.GetLoadMeta())
 	if err != nil {
 		log.Warn(ctx, "failed to ref collection", mlog.Err(err))
@@ -525,7 +525,7 @@ func (node *QueryNode) LoadSegments(ctx context.Context, req *querypb.LoadSegmen
 		return merr.Success(), nil
 	}
 
-	err := node.manager.Collection.PutOrRef(req.GetCollectionID(), req.GetSchema(),
+	err := node.manager.Collection.PutOrRef(ctx, req.GetCollectionID(), req.GetSchema(),
 		segments.ComposeIndexMeta(ctx, req.GetIndexInfoList(), req.GetSchema()), req.GetLoadMeta())
 	if err != nil {
 		log.Warn(ctx, "failed to ref collection", mlog.Err(err))
@@ -588,7 +588,7 @@ func (node *QueryNode) UpdateSchema(ctx context.Context, req *querypb.UpdateSche
 
 	// Pass the barrier timestamp through; collectionManager derives the logical
 	// schema version from the schema payload when it is present.
-	err := node.manager.Collection.UpdateSchema(req.GetCollectionID(), req.GetSchema(), req.GetSchemaBarrierTs())
+	err := node.manager.Collection.UpdateSchema(ctx, req.GetCollectionID(), req.GetSchema(), req.GetSchemaBarrierTs())
 	if err != nil {
 		log.Warn(ctx, "failed to update schema", mlog.Err(err))
 	}
@@ -844,7 +844,11 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 		node.manager.Collection.Unref(req.GetReq().GetCollectionID(), 1)
 	}()
 
-	task := tasks.NewSearchTask(searchCtx, collection, node.manager, req, node.serverID)
+	// Pin the schema version the request is planned with, so a concurrent schema update doesn't change the schema of plan.
+	schema, schemaVersion := collection.PinSchemaVersionOf(req.GetReq().SchemaVersion)
+	defer collection.UnpinSchemaVersion(schemaVersion)
+
+	task := tasks.NewSearchTask(searchCtx, collection, schema, schemaVersion, node.manager, req, node.serverID)
 
 	if err := node.scheduler.Add(task); err != nil {
 		log.Warn(ctx, "failed to search channel", mlog.Err(err))
@@ -992,8 +996,12 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 	defer func() {
 		node.manager.Collection.Unref(req.GetReq().GetCollectionID(), 1)
 	}()
+	// Pin the schema version the request is planned with, so a concurrent schema update doesn't change the schema of plan and reduce.
+	schema, schemaVersion := collection.PinSchemaVersionOf(req.GetReq().SchemaVersion)
+	defer collection.UnpinSchemaVersion(schemaVersion)
+
 	// Send task to scheduler and wait until it finished.
-	task := tasks.NewQueryTask(queryCtx, collection, node.manager, req)
+	task := tasks.NewQueryTask(queryCtx, collection, schema, schemaVersion, node.manager, req)
 	if err := node.scheduler.Add(task); err != nil {
 		log.Warn(ctx, "failed to add query task into scheduler", mlog.Err(err))
 		resp.Status = merr.Status(err)
@@ -1254,6 +1262,22 @@ func (node *QueryNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsR
 	return resp, nil
 }
 
+// distIndexInfos returns the indexes of the segment reported in the data distribution.
+// The indexes evicted from GPU are reported too, so the querycoord doesn't load them back.
+func distIndexInfos(s segments.Segment) map[int64]*querypb.FieldIndexInfo {
+	infos := lo.SliceToMap(s.Indexes(), func(info *segments.IndexedFieldInfo) (int64, *querypb.FieldIndexInfo) {
+		return info.IndexInfo.IndexID, info.IndexInfo
+	})
+	if local, ok := s.(*segments.LocalSegment); ok {
+		for _, info := range local.EvictedIndexes() {
+			if _, ok := infos[info.GetIndexID()]; !ok {
+				infos[info.GetIndexID()] = info
+			}
+		}
+	}
+	return infos
+}
+
 func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.GetDataDistributionRequest) (*querypb.GetDataDistributionResponse, error) {
 	log := mlog.With(
 		mlog.Int64("msgID", req.GetBase().GetMsgID()),
@@ -1270,6 +1294,12 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 	defer node.lifetime.Done()
 
 	lastModifyTs := node.getDistributionModifyTS()
+	// the segment qps changes without distribution change, refresh the distribution periodically to report it.
+	if interval := paramtable.Get().QueryNodeCfg.SegmentQPSReportInterval.GetAsDuration(time.Second); interval > 0 &&
+		time.Since(time.Unix(0, lastModifyTs)) > interval {
+		node.updateDistributionModifyTS()
+		lastModifyTs = node.getDistributionModifyTS()
+	}
 	distributionChange := func() bool {
 		if req.GetLastUpdateTs() == 0 {
 			return true
@@ -1298,12 +1328,11 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			Level:              s.Level(),
 			IsSorted:           s.IsSorted(),
 			LastDeltaTimestamp: s.LastDeltaTimestamp(),
-			IndexInfo: lo.SliceToMap(s.Indexes(), func(info *segments.IndexedFieldInfo) (int64, *querypb.FieldIndexInfo) {
-				return info.IndexInfo.IndexID, info.IndexInfo
-			}),
-			JsonStatsInfo: s.GetFieldJSONIndexStats(),
-			ManifestPath:  s.LoadInfo().GetManifestPath(),
-			DataVersion:   proto.Int32(s.LoadInfo().GetDataVersion()),
+			IndexInfo:          distIndexInfos(s),
+			JsonStatsInfo:      s.GetFieldJSONIndexStats(),
+			ManifestPath:       s.LoadInfo().GetManifestPath(),
+			DataVersion:        proto.Int32(s.LoadInfo().GetDataVersion()),
+			Qps:                s.AccessQPS(),
 		})
 	}
 
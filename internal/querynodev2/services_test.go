@@ -716,7 +716,7 @@ func (suite *ServiceSuite) TestLoadSegments_VarChar() {
 		PartitionIDs: suite.partitionIDs,
 	}
 	suite.node.manager.Collection = segments.NewCollectionManager()
-	suite.node.manager.Collection.PutOrRef(suite.collectionID, schema, nil, loadMeta)
+	suite.node.manager.Collection.PutOrRef(context.Background(), suite.collectionID, schema, nil, loadMeta)
 
 	infos := suite.genSegmentLoadInfos(schema, nil)
 	for _, info := range infos {
@@ -753,7 +753,7 @@ func (suite *ServiceSuite) TestLoadSegments_BadIndexMeta() {
 		PartitionIDs: suite.partitionIDs,
 	}
 	suite.node.manager.Collection = segments.NewCollectionManager()
-	// suite.node.manager.Collection.PutOrRef(suite.collectionID, schema, nil, loadMeta)
+	// suite.node.manager.Collection.PutOrRef(context.Background(), suite.collectionID, schema, nil, loadMeta)
 
 	infos := suite.genSegmentLoadInfos(schema, nil)
 	for _, info := range infos {
@@ -1418,7 +1418,7 @@ func (suite *ServiceSuite) TestSearch_Failed() {
 		PartitionIDs: suite.partitionIDs,
 	}
 	indexMeta := segments.ComposeIndexMeta(ctx, mock_segcore.GenTestIndexInfoList(suite.collectionID, schema), schema)
-	suite.node.manager.Collection.PutOrRef(suite.collectionID, schema, indexMeta, LoadMeta)
+	suite.node.manager.Collection.PutOrRef(context.Background(), suite.collectionID, schema, indexMeta, LoadMeta)
 
 	// Delegator not found
 	resp, err = suite.node.Search(ctx, req)
@@ -1644,7 +1644,7 @@ func (suite *ServiceSuite) TestQuery_Failed() {
 
 	// data
 	schema := mock_segcore.GenTestCollectionSchema(suite.collectionName, schemapb.DataType_Int64, false)
-	suite.node.manager.Collection.PutOrRef(suite.collectionID, schema, nil, nil)
+	suite.node.manager.Collection.PutOrRef(context.Background(), suite.collectionID, schema, nil, nil)
 	defer suite.node.manager.Collection.Unref(suite.collectionID, 1)
 	creq, err := suite.genCQueryRequest(10, IndexFaissIDMap, schema)
 	suite.NoError(err)
@@ -2497,7 +2497,7 @@ func (suite *ServiceSuite) TestUpdateSchema() {
 	suite.node.manager.Collection = mockManager
 
 	suite.Run("normal", func() {
-		mockManager.EXPECT().UpdateSchema(suite.collectionID, schema, uint64(100)).Return(nil).Once()
+		mockManager.EXPECT().UpdateSchema(mock.Anything, suite.collectionID, schema, uint64(100)).Return(nil).Once()
 
 		status, err := suite.node.UpdateSchema(ctx, req)
 		suite.NoError(merr.CheckRPCCall(status, err))
@@ -2511,14 +2511,14 @@ func (suite *ServiceSuite) TestUpdateSchema() {
 			Schema:          schema,
 			SchemaBarrierTs: uint64(100),
 		}
-		mockManager.EXPECT().UpdateSchema(suite.collectionID, schema, uint64(100)).Return(nil).Once()
+		mockManager.EXPECT().UpdateSchema(mock.Anything, suite.collectionID, schema, uint64(100)).Return(nil).Once()
 
 		status, err := suite.node.UpdateSchema(ctx, req)
 		suite.NoError(merr.CheckRPCCall(status, err))
 	})
 
 	suite.Run("manager_returns_error", func() {
-		mockManager.EXPECT().UpdateSchema(suite.collectionID, schema, uint64(100)).Return(merr.WrapErrServiceInternal("mocked")).Once()
+		mockManager.EXPECT().UpdateSchema(mock.Anything, suite.collectionID, schema, uint64(100)).Return(merr.WrapErrServiceInternal("mocked")).Once()
 
 		status, err := suite.node.UpdateSchema(ctx, req)
 		suite.Error(merr.CheckRPCCall(status, err))
@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	"github.com/milvus-io/milvus/internal/util/segcore"
@@ -13,8 +14,12 @@ import (
 
 var _ scheduler.Task = &QueryStreamTask{}
 
+// NewQueryStreamTask creates a query stream task, the schema and schemaVersion are the schema version pinned for the request,
+// which is used to create the plan.
 func NewQueryStreamTask(ctx context.Context,
 	collection *segments.Collection,
+	schema *schemapb.CollectionSchema,
+	schemaVersion uint64,
 	manager *segments.Manager,
 	req *querypb.QueryRequest,
 	srv streamrpc.QueryStreamServer,
@@ -24,6 +29,8 @@ func NewQueryStreamTask(ctx context.Context,
 	return &QueryStreamTask{
 		ctx:            ctx,
 		collection:     collection,
+		schema:         schema,
+		schemaVersion:  schemaVersion,
 		segmentManager: manager,
 		req:            req,
 		srv:            srv,
@@ -36,6 +43,8 @@ func NewQueryStreamTask(ctx context.Context,
 type QueryStreamTask struct {
 	ctx            context.Context
 	collection     *segments.Collection
+	schema         *schemapb.CollectionSchema
+	schemaVersion  uint64 // the segcore schema version pinned for the request.
 	segmentManager *segments.Manager
 	req            *querypb.QueryRequest
 	srv            streamrpc.QueryStreamServer
@@ -54,6 +63,14 @@ func (t *QueryStreamTask) IsGpuIndex() bool {
 	return false
 }
 
+func (t *QueryStreamTask) CollectionID() int64 {
+	return t.collection.ID()
+}
+
+func (t *QueryStreamTask) CPUShare() float64 {
+	return t.collection.SearchCPUShare()
+}
+
 func (t *QueryStreamTask) Context() context.Context {
 	return t.ctx
 }
@@ -64,8 +81,9 @@ func (t *QueryStreamTask) PreExecute() error {
 }
 
 func (t *QueryStreamTask) Execute() error {
-	retrievePlan, err := segcore.NewRetrievePlan(
+	retrievePlan, err := segcore.NewRetrievePlanWithSchema(
 		t.collection.GetCCollection(),
+		t.collection.PlanSchema(t.schema, t.schemaVersion),
 		t.req.Req.GetSerializedExprPlan(),
 		t.req.Req.GetMvccTimestamp(),
 		t.req.Req.Base.GetMsgID(),
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	"github.com/milvus-io/milvus/internal/util/segcore"
@@ -28,8 +29,12 @@ import (
 
 var _ scheduler.Task = &QueryTask{}
 
+// NewQueryTask creates a query task, the schema and schemaVersion are the schema version pinned for the request,
+// which is used to create the plan and reduce the results.
 func NewQueryTask(ctx context.Context,
 	collection *segments.Collection,
+	schema *schemapb.CollectionSchema,
+	schemaVersion uint64,
 	manager *segments.Manager,
 	req *querypb.QueryRequest,
 ) *QueryTask {
@@ -37,6 +42,8 @@ func NewQueryTask(ctx context.Context,
 	return &QueryTask{
 		ctx:            ctx,
 		collection:     collection,
+		schema:         schema,
+		schemaVersion:  schemaVersion,
 		segmentManager: manager,
 		plan:           &planpb.PlanNode{},
 		req:            req,
@@ -49,6 +56,8 @@ func NewQueryTask(ctx context.Context,
 type QueryTask struct {
 	ctx            context.Context
 	collection     *segments.Collection
+	schema         *schemapb.CollectionSchema
+	schemaVersion  uint64 // the segcore schema version pinned for the request.
 	segmentManager *segments.Manager
 	req            *querypb.QueryRequest
 	plan           *planpb.PlanNode // used by RunQNQueryPipeline for reduce
@@ -68,6 +77,14 @@ func (t *QueryTask) IsGpuIndex() bool {
 	return false
 }
 
+func (t *QueryTask) CollectionID() int64 {
+	return t.collection.ID()
+}
+
+func (t *QueryTask) CPUShare() float64 {
+	return t.collection.SearchCPUShare()
+}
+
 func (t *QueryTask) Context() context.Context {
 	return t.ctx
 }
@@ -115,8 +132,9 @@ func (t *QueryTask) Execute() error {
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "QueryTask")
 
-	retrievePlan, err := segcore.NewRetrievePlan(
+	retrievePlan, err := segcore.NewRetrievePlanWithSchema(
 		t.collection.GetCCollection(),
+		t.collection.PlanSchema(t.schema, t.schemaVersion),
 		t.req.Req.GetSerializedExprPlan(),
 		t.req.Req.GetMvccTimestamp(),
 		t.req.Req.Base.GetMsgID(),
@@ -144,7 +162,7 @@ func (t *QueryTask) Execute() error {
 		querySegments = append(querySegments, result.Segment)
 	}
 	reducedResult, err := segments.RunQNQueryPipeline(
-		t.ctx, t.req, t.collection.Schema(), t.plan,
+		t.ctx, t.req, t.schema, t.plan,
 		reduceResults, querySegments, t.segmentManager, retrievePlan,
 	)
 
@@ -179,6 +197,9 @@ func (t *QueryTask) Execute() error {
 		ElementLevel:       reducedResult.GetElementLevel(),
 		ElementIndices:     convertSegcoreElementIndicesToInternal(reducedResult.GetElementIndices()),
 	}
+	if t.req.GetReq().GetExplain() {
+		t.result.SegmentExplains = segments.ExplainRetrieve(pinnedSegments, t.plan)
+	}
 	return nil
 }
 
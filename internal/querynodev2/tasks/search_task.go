@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
@@ -37,6 +38,8 @@ var (
 type SearchTask struct {
 	ctx              context.Context
 	collection       *segments.Collection
+	schema           *schemapb.CollectionSchema
+	schemaVersion    uint64 // the segcore schema version pinned for the request.
 	segmentManager   *segments.Manager
 	req              *querypb.SearchRequest
 	result           *internalpb.SearchResults
@@ -50,13 +53,18 @@ type SearchTask struct {
 	others           []*SearchTask
 	notifier         chan error
 	serverID         int64
+	explains         []*internalpb.SegmentExplain
 
 	tr           *timerecord.TimeRecorder
 	scheduleSpan trace.Span
 }
 
+// NewSearchTask creates a search task, the schema and schemaVersion are the schema version pinned for the request,
+// which is used to create the plan. The current schema of the collection is used if the schema is nil.
 func NewSearchTask(ctx context.Context,
 	collection *segments.Collection,
+	schema *schemapb.CollectionSchema,
+	schemaVersion uint64,
 	manager *segments.Manager,
 	req *querypb.SearchRequest,
 	serverID int64,
@@ -65,6 +73,8 @@ func NewSearchTask(ctx context.Context,
 	return &SearchTask{
 		ctx:              ctx,
 		collection:       collection,
+		schema:           schema,
+		schemaVersion:    schemaVersion,
 		segmentManager:   manager,
 		req:              req,
 		merged:           false,
@@ -104,6 +114,14 @@ func (t *SearchTask) IsGpuIndex() bool {
 	return t.collection.IsGpuIndex()
 }
 
+func (t *SearchTask) CollectionID() int64 {
+	return t.collection.ID()
+}
+
+func (t *SearchTask) CPUShare() float64 {
+	return t.collection.SearchCPUShare()
+}
+
 func (t *SearchTask) Context() context.Context {
 	return t.ctx
 }
@@ -153,7 +171,7 @@ func (t *SearchTask) Execute() error {
 	if err != nil {
 		return err
 	}
-	searchReq, err := segcore.NewSearchRequest(t.collection.GetCCollection(), req, t.placeholderGroup)
+	searchReq, err := segcore.NewSearchRequestWithSchema(t.collection.GetCCollection(), t.collection.PlanSchema(t.schema, t.schemaVersion), req, t.placeholderGroup)
 	if err != nil {
 		return err
 	}
@@ -188,6 +206,12 @@ func (t *SearchTask) Execute() error {
 	}
 	defer segments.DeleteSearchResults(results)
 
+	if req.GetReq().GetExplain() {
+		if err := t.explain(searchedSegments); err != nil {
+			return err
+		}
+	}
+
 	// In filter-only mode, extract filter statistics and return early.
 	// This supports two-stage search: stage-1 collects per-segment valid
 	// counts so the delegator can optimize search params for stage-2.
@@ -310,6 +334,18 @@ func (t *SearchTask) Execute() error {
 	return nil
 }
 
+// explain records how the search is executed on the searched segments for each sub task.
+func (t *SearchTask) explain(searchedSegments []segments.Segment) error {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.req.GetReq().GetSerializedExprPlan(), plan); err != nil {
+		return err
+	}
+	for i := range t.originNqs {
+		t.subTaskAt(i).explains = segments.ExplainSearch(t.collection, searchedSegments, plan, t.originNqs[i], t.originTopks[i])
+	}
+	return nil
+}
+
 func emptySearchResultData(nq, topK int64) *schemapb.SearchResultData {
 	return &schemapb.SearchResultData{
 		NumQueries: nq,
@@ -342,6 +378,8 @@ func (t *SearchTask) Merge(other *SearchTask) bool {
 		t.req.GetReq().GetCollectionID() != other.req.GetReq().GetCollectionID() ||
 		t.req.GetReq().GetMvccTimestamp() != other.req.GetReq().GetMvccTimestamp() ||
 		t.req.GetReq().GetDslType() != other.req.GetReq().GetDslType() ||
+		t.req.GetReq().GetExplain() != other.req.GetReq().GetExplain() ||
+		t.schemaVersion != other.schemaVersion ||
 		t.req.GetDmlChannels()[0] != other.req.GetDmlChannels()[0] ||
 		(diffTopk && ratio > paramtable.Get().QueryNodeCfg.TopKMergeRatio.GetAsFloat()) ||
 		!funcutil.SliceSetEqual(t.req.GetReq().GetPartitionIDs(), other.req.GetReq().GetPartitionIDs()) ||
@@ -385,6 +423,7 @@ func (t *SearchTask) SearchResult() *internalpb.SearchResults {
 			channelsMvcc[ch] = t.req.GetReq().GetMvccTimestamp()
 		}
 		t.result.ChannelsMvcc = channelsMvcc
+		t.result.SegmentExplains = t.explains
 	}
 	return t.result
 }
@@ -65,7 +65,7 @@ func TestSearchTaskGlobalRefineRatiosPassThrough(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	task := NewSearchTask(context.Background(), ts.collection, ts.manager, queryReq, 1)
+	task := NewSearchTask(context.Background(), ts.collection, nil, 0, ts.manager, queryReq, 1)
 	require.NoError(t, task.PreExecute())
 	require.NoError(t, task.Execute(),
 		"Execute should succeed end-to-end with refine ratios in QueryInfo")
@@ -111,7 +111,7 @@ func TestSearchTaskGlobalRefineDisabled(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	task := NewSearchTask(context.Background(), ts.collection, ts.manager, queryReq, 1)
+	task := NewSearchTask(context.Background(), ts.collection, nil, 0, ts.manager, queryReq, 1)
 	require.NoError(t, task.PreExecute())
 	require.NoError(t, task.Execute())
 
@@ -144,7 +144,7 @@ func TestSearchTaskGlobalRefineInvalidRatio(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	task := NewSearchTask(context.Background(), ts.collection, ts.manager, queryReq, 1)
+	task := NewSearchTask(context.Background(), ts.collection, nil, 0, ts.manager, queryReq, 1)
 	require.NoError(t, task.PreExecute())
 	err = task.Execute()
 	require.Error(t, err, "invalid search_topk_ratio must be rejected")
@@ -175,7 +175,7 @@ func TestSearchTaskGlobalRefineInvalidRefineRatio(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	task := NewSearchTask(context.Background(), ts.collection, ts.manager, queryReq, 1)
+	task := NewSearchTask(context.Background(), ts.collection, nil, 0, ts.manager, queryReq, 1)
 	require.NoError(t, task.PreExecute())
 	err = task.Execute()
 	require.Error(t, err, "invalid refine_topk_ratio must be rejected")
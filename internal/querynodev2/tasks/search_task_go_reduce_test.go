@@ -188,7 +188,7 @@ func setupTestSegments(t *testing.T, numSegments int, msgLength int, opts setupO
 	indexMeta := mock_segcore.GenTestIndexMeta(collectionID, schema)
 
 	manager := segments.NewManager()
-	manager.Collection.PutOrRef(collectionID, schema, indexMeta, &querypb.LoadMetaInfo{
+	manager.Collection.PutOrRef(context.Background(), collectionID, schema, indexMeta, &querypb.LoadMetaInfo{
 		LoadType:     querypb.LoadType_LoadCollection,
 		CollectionID: collectionID,
 		PartitionIDs: []int64{partitionID},
@@ -819,7 +819,7 @@ func TestExecuteFilterOnly(t *testing.T) {
 	require.NoError(t, err)
 	defer searchReqFilterOnly.Delete()
 
-	task := NewSearchTask(ctx, ts.collection, ts.manager, queryReq, 1)
+	task := NewSearchTask(ctx, ts.collection, nil, 0, ts.manager, queryReq, 1)
 	require.NoError(t, task.PreExecute())
 	require.NoError(t, task.Execute())
 
@@ -852,7 +852,7 @@ func TestExecuteEmptySearchReturnsNQEmptyResult(t *testing.T) {
 	schema := mock_segcore.GenTestCollectionSchema("test-empty-search", schemapb.DataType_Int64, true)
 	indexMeta := mock_segcore.GenTestIndexMeta(testCollectionID, schema)
 	manager := segments.NewManager()
-	manager.Collection.PutOrRef(testCollectionID, schema, indexMeta, &querypb.LoadMetaInfo{
+	manager.Collection.PutOrRef(context.Background(), testCollectionID, schema, indexMeta, &querypb.LoadMetaInfo{
 		LoadType:     querypb.LoadType_LoadCollection,
 		CollectionID: testCollectionID,
 		PartitionIDs: []int64{testPartitionID},
@@ -865,7 +865,7 @@ func TestExecuteEmptySearchReturnsNQEmptyResult(t *testing.T) {
 		collection.GetCCollection(), nil, nq, topK, testCollectionID)
 	require.NoError(t, err)
 
-	task := NewSearchTask(ctx, collection, manager, queryReq, 1)
+	task := NewSearchTask(ctx, collection, nil, 0, manager, queryReq, 1)
 	require.NoError(t, task.PreExecute())
 	require.NoError(t, task.Execute())
 
@@ -913,7 +913,7 @@ func TestExecuteMergedSubTasks(t *testing.T) {
 		queryReq, err := mock_segcore.GenQueryRequest(
 			ts.collection.GetCCollection(), ts.segIDs, nq, topK, testCollectionID)
 		require.NoError(t, err)
-		tasks[i] = NewSearchTask(ctx, ts.collection, ts.manager, queryReq, 1)
+		tasks[i] = NewSearchTask(ctx, ts.collection, nil, 0, ts.manager, queryReq, 1)
 	}
 
 	require.True(t, tasks[0].Merge(tasks[1]),
@@ -981,13 +981,13 @@ func TestExecuteMergedSubTasks_MixedTopK(t *testing.T) {
 	receiverReq, err := mock_segcore.GenQueryRequest(
 		ts.collection.GetCCollection(), ts.segIDs, totalNq, maxTopK, testCollectionID)
 	require.NoError(t, err)
-	receiver := NewSearchTask(ctx, ts.collection, ts.manager, receiverReq, 1)
+	receiver := NewSearchTask(ctx, ts.collection, nil, 0, ts.manager, receiverReq, 1)
 
 	// Second sub-task exists only so subTaskAt(1) can write its result.
 	otherReq, err := mock_segcore.GenQueryRequest(
 		ts.collection.GetCCollection(), ts.segIDs, subTaskNqs[1], subTaskTopks[1], testCollectionID)
 	require.NoError(t, err)
-	other := NewSearchTask(ctx, ts.collection, ts.manager, otherReq, 1)
+	other := NewSearchTask(ctx, ts.collection, nil, 0, ts.manager, otherReq, 1)
 	other.merged = true
 
 	// Post-merge state with mixed topKs.
@@ -1081,7 +1081,7 @@ func TestExecuteGoReduceFastPathUsesOriginTopKWhenPlanTopKReduced(t *testing.T)
 	schema := mock_segcore.GenTestCollectionSchema("test-reduced-plan-topk", schemapb.DataType_Int64, true)
 	indexMeta := mock_segcore.GenTestIndexMeta(testCollectionID, schema)
 	manager := segments.NewManager()
-	require.NoError(t, manager.Collection.PutOrRef(testCollectionID, schema, indexMeta, &querypb.LoadMetaInfo{
+	require.NoError(t, manager.Collection.PutOrRef(context.Background(), testCollectionID, schema, indexMeta, &querypb.LoadMetaInfo{
 		LoadType:     querypb.LoadType_LoadCollection,
 		CollectionID: testCollectionID,
 		PartitionIDs: []int64{testPartitionID},
@@ -1159,7 +1159,7 @@ func TestExecuteGoReduceHonorsEnableResultZeroCopy(t *testing.T) {
 	queryReq, err := mock_segcore.GenQueryRequest(
 		ts.collection.GetCCollection(), ts.segIDs, nq, topK, testCollectionID)
 	require.NoError(t, err)
-	task := NewSearchTask(ctx, ts.collection, ts.manager, queryReq, 1)
+	task := NewSearchTask(ctx, ts.collection, nil, 0, ts.manager, queryReq, 1)
 
 	allSearchCount, err := segcore.PrepareSearchResultsForExport(
 		ctx,
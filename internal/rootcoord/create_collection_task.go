@@ -590,6 +590,7 @@ func (t *createCollectionTask) assignChannels(ctx context.Context) error {
 	vchannels, err := snmanager.StaticStreamingNodeManager.AllocVirtualChannels(ctx, balancer.AllocVChannelParam{
 		CollectionID: t.header.GetCollectionId(),
 		Num:          int(t.Req.GetShardsNum()),
+		DBName:       t.Req.GetDbName(),
 	})
 	if err != nil {
 		return merr.Wrapf(err, "failed to allocate vchannels for collection %d (shards=%d)",
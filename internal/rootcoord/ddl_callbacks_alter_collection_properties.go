@@ -72,6 +72,11 @@ func (c *Core) broadcastAlterCollectionForAlterCollection(ctx context.Context, r
 		return c.broadcastAlterCollectionForAlterDynamicField(ctx, req, targetValue)
 	}
 
+	if _, ok := funcutil.TryGetAttrByKeyFromRepeatedKV(common.CollectionShardsNumKey, req.GetProperties()); ok {
+		// the shard number is applied to the vchannels of the collection, it cannot be seen at collection properties.
+		return c.broadcastAlterCollectionForAlterShards(ctx, req)
+	}
+
 	broadcaster, err := c.startBroadcastWithAliasOrCollectionLock(ctx, req.GetDbName(), req.GetCollectionName())
 	if err != nil {
 		return err
@@ -144,11 +149,14 @@ func (c *Core) broadcastAlterCollectionForAlterCollection(ctx context.Context, r
 		header.UpdateMask.Paths = append(header.UpdateMask.Paths, message.FieldMaskCollectionProperties)
 	}
 
-	// If TTL field is changed through properties, also broadcast an updated schema snapshot and mark it as schema change,
+	// If TTL field or its retention is changed through properties, also broadcast an updated schema snapshot and mark it as schema change,
 	// so QueryNode can refresh runtime schema properties without requiring release/load.
 	ttlOld, okOld := oldProperties[common.CollectionTTLFieldKey]
 	ttlNew, okNew := newProperties[common.CollectionTTLFieldKey]
-	needTTLFieldSchemaRefresh := (okOld != okNew) || (okOld && okNew && ttlOld != ttlNew)
+	retentionOld, okRetentionOld := oldProperties[common.CollectionTTLFieldRetentionKey]
+	retentionNew, okRetentionNew := newProperties[common.CollectionTTLFieldRetentionKey]
+	needTTLFieldSchemaRefresh := (okOld != okNew) || (okOld && okNew && ttlOld != ttlNew) ||
+		(okRetentionOld != okRetentionNew) || (okRetentionOld && okRetentionNew && retentionOld != retentionNew)
 	if needTTLFieldSchemaRefresh {
 		// validate ttl field name exists in schema fields when setting it
 		if okNew {
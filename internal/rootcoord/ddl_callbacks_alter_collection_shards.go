@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/coordinator/snmanager"
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/ce"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// broadcastAlterCollectionForAlterShards changes the shard number of an existing collection.
+// The expanding is broadcasted as a create collection message to the new vchannels,
+// and the shrinking is broadcasted as a drop collection message to the dropped vchannels,
+// so the streaming node and datacoord create or drop the vchannels just like creating or dropping a collection.
+func (c *Core) broadcastAlterCollectionForAlterShards(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+	if len(req.GetProperties()) != 1 {
+		return merr.WrapErrParameterInvalidMsg("cannot alter %s with other properties at the same time", common.CollectionShardsNumKey)
+	}
+	shardsNum, err := strconv.Atoi(req.GetProperties()[0].GetValue())
+	if err != nil || shardsNum <= 0 {
+		return merr.WrapErrParameterInvalidMsg("invalid %s value: %s", common.CollectionShardsNumKey, req.GetProperties()[0].GetValue())
+	}
+
+	broadcaster, err := c.startBroadcastWithAliasOrCollectionLock(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	defer broadcaster.Close()
+
+	coll, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetCollectionName(), typeutil.MaxTimestamp, false)
+	if err != nil {
+		return err
+	}
+	if shardsNum == len(coll.VirtualChannelNames) {
+		return errIgnoredAlterCollection
+	}
+
+	vchannels, err := snmanager.StaticStreamingNodeManager.ReallocVirtualChannels(ctx, balancer.ReallocVChannelParam{
+		CollectionID: coll.CollectionID,
+		DBName:       coll.DBName,
+		VChannels:    coll.VirtualChannelNames,
+		Num:          shardsNum,
+	})
+	if err != nil {
+		return merr.Wrapf(err, "failed to reallocate vchannels for collection %d (shards=%d)", coll.CollectionID, shardsNum)
+	}
+	reshard := &messagespb.CollectionReshardInfo{
+		VirtualChannelNames:  vchannels,
+		PhysicalChannelNames: make([]string, 0, len(vchannels)),
+	}
+	for _, vchannel := range vchannels {
+		reshard.PhysicalChannelNames = append(reshard.PhysicalChannelNames, funcutil.ToPhysicalChannel(vchannel))
+	}
+
+	if len(vchannels) > len(coll.VirtualChannelNames) {
+		return c.broadcastExpandCollectionShards(ctx, broadcaster, coll, reshard)
+	}
+	return c.broadcastShrinkCollectionShards(ctx, broadcaster, coll, reshard)
+}
+
+// broadcastExpandCollectionShards broadcasts the create collection message to the new vchannels of the collection.
+func (c *Core) broadcastExpandCollectionShards(ctx context.Context, broadcaster broadcaster.BroadcastAPI, coll *model.Collection, reshard *messagespb.CollectionReshardInfo) error {
+	partitionIDs := make([]int64, 0, len(coll.Partitions))
+	partitionNames := make([]string, 0, len(coll.Partitions))
+	for _, partition := range coll.Partitions {
+		if partition.Available() {
+			partitionIDs = append(partitionIDs, partition.PartitionID)
+			partitionNames = append(partitionNames, partition.PartitionName)
+		}
+	}
+
+	channels := make([]string, 0, len(reshard.VirtualChannelNames)-len(coll.VirtualChannelNames)+1)
+	channels = append(channels, streaming.WAL().ControlChannel())
+	channels = append(channels, reshard.VirtualChannelNames[len(coll.VirtualChannelNames):]...)
+	msg := message.NewCreateCollectionMessageBuilderV1().
+		WithHeader(&message.CreateCollectionMessageHeader{
+			CollectionId: coll.CollectionID,
+			PartitionIds: partitionIDs,
+			DbId:         coll.DBID,
+			Reshard:      reshard,
+		}).
+		WithBody(&message.CreateCollectionRequest{
+			Base:                 &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+			DbName:               coll.DBName,
+			CollectionName:       coll.Name,
+			DbID:                 coll.DBID,
+			CollectionID:         coll.CollectionID,
+			PartitionIDs:         partitionIDs,
+			PartitionNames:       partitionNames,
+			VirtualChannelNames:  reshard.VirtualChannelNames,
+			PhysicalChannelNames: reshard.PhysicalChannelNames,
+			CollectionSchema:     coll.ToCollectionSchemaPB(),
+		}).
+		WithBroadcast(channels).
+		MustBuildBroadcast()
+	if _, err := broadcaster.Broadcast(ctx, msg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// broadcastShrinkCollectionShards broadcasts the drop collection message to the dropped vchannels of the collection.
+// The dropped vchannels should hold no data, otherwise the data of them will be lost.
+func (c *Core) broadcastShrinkCollectionShards(ctx context.Context, broadcaster broadcaster.BroadcastAPI, coll *model.Collection, reshard *messagespb.CollectionReshardInfo) error {
+	droppedVChannels := coll.VirtualChannelNames[len(reshard.VirtualChannelNames):]
+	for _, vchannel := range droppedVChannels {
+		resp, err := c.mixCoord.GetChannelRecoveryInfo(ctx, &datapb.GetChannelRecoveryInfoRequest{Vchannel: vchannel})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return merr.Wrapf(err, "failed to get the segments of vchannel %s", vchannel)
+		}
+		info := resp.GetInfo()
+		if len(info.GetUnflushedSegmentIds())+len(info.GetFlushedSegmentIds())+len(info.GetLevelZeroSegmentIds()) > 0 {
+			return merr.WrapErrParameterInvalidMsg("cannot shrink the shards of collection %s, vchannel %s still holds data", coll.Name, vchannel)
+		}
+	}
+
+	channels := make([]string, 0, len(droppedVChannels)+1)
+	channels = append(channels, streaming.WAL().ControlChannel())
+	channels = append(channels, droppedVChannels...)
+	msg := message.NewDropCollectionMessageBuilderV1().
+		WithHeader(&message.DropCollectionMessageHeader{
+			CollectionId: coll.CollectionID,
+			DbId:         coll.DBID,
+			Reshard:      reshard,
+		}).
+		WithBody(&message.DropCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+			DbName:         coll.DBName,
+			CollectionName: coll.Name,
+			DbID:           coll.DBID,
+			CollectionID:   coll.CollectionID,
+		}).
+		WithBroadcast(channels, message.OptBuildBroadcastAckSyncUp()).
+		MustBuildBroadcast()
+	if _, err := broadcaster.Broadcast(ctx, msg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// expandCollectionShardsAckCallback watches the new vchannels and applies them to the collection meta.
+func (c *DDLCallback) expandCollectionShardsAckCallback(ctx context.Context, result message.BroadcastResultCreateCollectionMessageV1) error {
+	header := result.Message.Header()
+	body := result.Message.MustBody()
+	startPositions := make(map[string][]byte, len(result.Results))
+	for vchannel, appendResult := range result.Results {
+		if funcutil.IsControlChannel(vchannel) {
+			continue
+		}
+		if err := c.createCollectionShard(ctx, header, body, vchannel, appendResult); err != nil {
+			return merr.Wrap(err, "failed to create collection shard")
+		}
+		startPositions[funcutil.ToPhysicalChannel(vchannel)] = adaptor.MustGetMQWrapperIDFromMessage(appendResult.MessageID).Serialize()
+	}
+	return c.reshardCollection(ctx, header.CollectionId, header.Reshard, startPositions, result.GetControlChannelResult().TimeTick, body.DbName, body.CollectionName)
+}
+
+// shrinkCollectionShardsAckCallback drops the data of the dropped vchannels and applies the remaining vchannels to the collection meta.
+func (c *DDLCallback) shrinkCollectionShardsAckCallback(ctx context.Context, result message.BroadcastResultDropCollectionMessageV1) error {
+	header := result.Message.Header()
+	body := result.Message.MustBody()
+	for vchannel := range result.Results {
+		if funcutil.IsControlChannel(vchannel) {
+			continue
+		}
+		if err := c.dropVirtualChannel(ctx, vchannel); err != nil {
+			return err
+		}
+	}
+	return c.reshardCollection(ctx, header.CollectionId, header.Reshard, nil, result.GetControlChannelResult().TimeTick, body.DbName, body.CollectionName)
+}
+
+// reshardCollection applies the vchannels of the collection after the shard number is changed,
+// and notifies datacoord and proxy to refresh the collection meta.
+func (c *DDLCallback) reshardCollection(ctx context.Context, collectionID int64, reshard *messagespb.CollectionReshardInfo, startPositions map[string][]byte, ts uint64, dbName string, collectionName string) error {
+	if err := c.meta.ReshardCollection(ctx, collectionID, reshard, startPositions, ts); err != nil {
+		if errors.Is(err, errAlterCollectionNotFound) {
+			mlog.Warn(ctx, "reshard a non-existent collection, ignore it", mlog.Int64("collectionID", collectionID))
+			return nil
+		}
+		return merr.Wrap(err, "failed to reshard collection")
+	}
+	if err := c.broker.BroadcastAlteredCollection(ctx, collectionID); err != nil {
+		return merr.Wrap(err, "failed to broadcast altered collection")
+	}
+	// the vchannels cached by the proxy are removed by the alter collection cache expiration.
+	return c.ExpireCaches(ctx, ce.NewBuilder().WithLegacyProxyCollectionMetaCache(
+		ce.OptLPCMDBName(dbName),
+		ce.OptLPCMCollectionName(collectionName),
+		ce.OptLPCMCollectionID(collectionID),
+		ce.OptLPCMMsgType(commonpb.MsgType_AlterCollection)))
+}
@@ -0,0 +1,144 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+func TestDDLCallbacksAlterCollectionShards(t *testing.T) {
+	core := initStreamingSystemAndCore(t)
+
+	ctx := context.Background()
+	dbName := "testDB" + funcutil.RandomString(10)
+	collectionName := "testCollection" + funcutil.RandomString(10)
+	testSchema := &schemapb.CollectionSchema{
+		Name: collectionName,
+		Fields: []*schemapb.FieldSchema{
+			{
+				Name:     "field1",
+				DataType: schemapb.DataType_Int64,
+			},
+		},
+	}
+	schemaBytes, err := proto.Marshal(testSchema)
+	require.NoError(t, err)
+	status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: dbName})
+	require.NoError(t, merr.CheckRPCCall(status, err))
+	status, err = core.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+		Schema:         schemaBytes,
+		ShardsNum:      1,
+	})
+	require.NoError(t, merr.CheckRPCCall(status, err))
+	coll, err := core.meta.GetCollectionByName(ctx, dbName, collectionName, typeutil.MaxTimestamp, false)
+	require.NoError(t, err)
+	require.Len(t, coll.VirtualChannelNames, 1)
+	firstVChannel := coll.VirtualChannelNames[0]
+
+	alterShards := func(value string, props ...*commonpb.KeyValuePair) error {
+		status, err := core.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Properties:     append([]*commonpb.KeyValuePair{{Key: common.CollectionShardsNumKey, Value: value}}, props...),
+		})
+		return merr.CheckRPCCall(status, err)
+	}
+	vchannelCount := func(vchannel string) int {
+		return channel.StaticPChannelStatsManager.MustGet().GetPChannelStats(channel.ChannelID{Name: funcutil.ToPhysicalChannel(vchannel)}).VChannelCount()
+	}
+
+	// invalid shard number or altered with other properties should return error.
+	require.Error(t, alterShards("abc"))
+	require.Error(t, alterShards("0"))
+	require.Error(t, alterShards("2", &commonpb.KeyValuePair{Key: common.CollectionDescription, Value: "desc"}))
+
+	// the same shard number should be ignored.
+	require.NoError(t, alterShards("1"))
+
+	// expand the shards, the new vchannels are appended.
+	require.NoError(t, alterShards("3"))
+	coll, err = core.meta.GetCollectionByName(ctx, dbName, collectionName, typeutil.MaxTimestamp, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), coll.ShardsNum)
+	require.Len(t, coll.VirtualChannelNames, 3)
+	require.Len(t, coll.PhysicalChannelNames, 3)
+	require.Len(t, coll.StartPositions, 3)
+	require.Len(t, coll.ShardInfos, 3)
+	require.Equal(t, firstVChannel, coll.VirtualChannelNames[0])
+	startPositions := toMap(coll.StartPositions)
+	for idx, vchannel := range coll.VirtualChannelNames {
+		require.Equal(t, funcutil.ToPhysicalChannel(vchannel), coll.PhysicalChannelNames[idx])
+		require.Contains(t, coll.ShardInfos, vchannel)
+		require.Contains(t, startPositions, coll.PhysicalChannelNames[idx])
+	}
+	require.Equal(t, 1, vchannelCount(coll.VirtualChannelNames[1]))
+	require.Equal(t, 1, vchannelCount(coll.VirtualChannelNames[2]))
+	require.Equal(t, 3, channel.StaticPChannelStatsManager.MustGet().DatabaseVChannelCount(dbName, func(channel.ChannelID) bool { return true }))
+	droppedVChannel := coll.VirtualChannelNames[2]
+
+	// the vchannel holding data cannot be dropped by shrinking.
+	mixc := core.mixCoord.(*mocks.MixCoord)
+	mixc.EXPECT().GetChannelRecoveryInfo(mock.Anything, mock.Anything).Return(&datapb.GetChannelRecoveryInfoResponse{
+		Status: merr.Success(),
+		Info:   &datapb.VchannelInfo{FlushedSegmentIds: []int64{1}},
+	}, nil).Once()
+	require.Error(t, alterShards("2"))
+	coll, err = core.meta.GetCollectionByName(ctx, dbName, collectionName, typeutil.MaxTimestamp, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), coll.ShardsNum)
+
+	// shrink the shards, the vchannels with largest shard index are dropped.
+	mixc.EXPECT().GetChannelRecoveryInfo(mock.Anything, mock.Anything).Return(&datapb.GetChannelRecoveryInfoResponse{
+		Status: merr.Success(),
+		Info:   &datapb.VchannelInfo{},
+	}, nil)
+	require.NoError(t, alterShards("2"))
+	coll, err = core.meta.GetCollectionByName(ctx, dbName, collectionName, typeutil.MaxTimestamp, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), coll.ShardsNum)
+	require.Len(t, coll.VirtualChannelNames, 2)
+	require.Len(t, coll.StartPositions, 2)
+	require.Len(t, coll.ShardInfos, 2)
+	require.NotContains(t, coll.VirtualChannelNames, droppedVChannel)
+	require.NotContains(t, coll.ShardInfos, droppedVChannel)
+	require.Equal(t, 0, vchannelCount(droppedVChannel))
+	// the remaining vchannels are still counted into the database.
+	require.Equal(t, 2, channel.StaticPChannelStatsManager.MustGet().DatabaseVChannelCount(dbName, func(channel.ChannelID) bool { return true }))
+
+	// alter the shards of a dropped collection should return error.
+	status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{DbName: dbName, CollectionName: collectionName})
+	require.NoError(t, merr.CheckRPCCall(status, err))
+	require.Error(t, alterShards("1"))
+}
@@ -106,6 +106,10 @@ func (c *DDLCallback) createCollectionV1AckCallback(ctx context.Context, result
 	msg := result.Message
 	header := msg.Header()
 	body := msg.MustBody()
+	if header.Reshard != nil {
+		// the message only creates the new vchannels of the collection when expanding its shards.
+		return c.expandCollectionShardsAckCallback(ctx, result)
+	}
 	for vchannel, result := range result.Results {
 		if !funcutil.IsControlChannel(vchannel) {
 			// create shard info when virtual channel is created.
@@ -71,6 +71,10 @@ func (c *DDLCallback) dropCollectionV1AckCallback(ctx context.Context, result me
 	msg := result.Message
 	header := msg.Header()
 	body := msg.MustBody()
+	if header.Reshard != nil {
+		// the message only drops the vchannels of the collection when shrinking its shards.
+		return c.shrinkCollectionShardsAckCallback(ctx, result)
+	}
 	for vchannel, result := range result.Results {
 		collectionID := msg.Header().CollectionId
 		if funcutil.IsControlChannel(vchannel) {
@@ -132,14 +136,8 @@ func (c *DDLCallback) dropCollectionV1AckCallback(ctx context.Context, result me
 			continue
 		}
 		// Drop virtual channel data when the vchannel is acknowledged.
-		resp, err := c.mixCoord.DropVirtualChannel(ctx, &datapb.DropVirtualChannelRequest{
-			Base: commonpbutil.NewMsgBase(
-				commonpbutil.WithSourceID(paramtable.GetNodeID()),
-			),
-			ChannelName: vchannel,
-		})
-		if err := merr.CheckRPCCall(resp, err); err != nil {
-			return merr.Wrap(err, "failed to drop virtual channel")
+		if err := c.dropVirtualChannel(ctx, vchannel); err != nil {
+			return err
 		}
 	}
 	// add the collection tombstone to the sweeper.
@@ -160,6 +158,20 @@ func (c *DDLCallback) dropCollectionV1AckCallback(ctx context.Context, result me
 		ce.OptLPCMMsgType(commonpb.MsgType_DropCollection)).Build())
 }
 
+// dropVirtualChannel drops the data of the virtual channel at datacoord.
+func (c *DDLCallback) dropVirtualChannel(ctx context.Context, vchannel string) error {
+	resp, err := c.mixCoord.DropVirtualChannel(ctx, &datapb.DropVirtualChannelRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		ChannelName: vchannel,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return merr.Wrap(err, "failed to drop virtual channel")
+	}
+	return nil
+}
+
 // newCollectionTombstone creates a new collection tombstone.
 func newCollectionTombstone(meta IMetaTable, broker Broker, collectionID int64) *collectionTombstone {
 	return &collectionTombstone{
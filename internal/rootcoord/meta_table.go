@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	pb "github.com/milvus-io/milvus/pkg/v3/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/util"
@@ -118,6 +119,8 @@ type IMetaTable interface {
 	BeginTruncateCollection(ctx context.Context, collectionID UniqueID) error
 	// TruncateCollection is called when the truncate collection message is acknowledged.
 	TruncateCollection(ctx context.Context, result message.BroadcastResultTruncateCollectionMessageV2) error
+	// ReshardCollection applies the vchannels of the collection after its shard number is changed.
+	ReshardCollection(ctx context.Context, collectionID UniqueID, reshard *messagespb.CollectionReshardInfo, startPositions map[string][]byte, ts Timestamp) error
 	CheckIfCollectionRenamable(ctx context.Context, dbName string, oldName string, newDBName string, newName string) error
 	GetGeneralCount(ctx context.Context) int
 
@@ -317,6 +320,11 @@ func (mt *MetaTable) reload() error {
 		}
 	}
 	channel.RecoverPChannelStatsManager(vchannels)
+	for _, coll := range mt.collID2Meta {
+		if coll.Available() {
+			channel.StaticPChannelStatsManager.MustGet().SetCollectionDatabase(coll.CollectionID, coll.DBName)
+		}
+	}
 
 	// reload file resources
 	resources, version, err := mt.catalog.ListFileResource(mt.ctx)
@@ -588,6 +596,7 @@ func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection)
 	metrics.RootCoordNumOfCollections.WithLabelValues(coll.DBName).Inc()
 	metrics.RootCoordNumOfPartitions.WithLabelValues().Add(float64(pn))
 
+	channel.StaticPChannelStatsManager.MustGet().SetCollectionDatabase(coll.CollectionID, coll.DBName)
 	channel.StaticPChannelStatsManager.MustGet().AddVChannel(coll.VirtualChannelNames...)
 	mlog.Info(ctx, "add collection to meta table",
 		mlog.Int64("dbID", coll.DBID),
@@ -1094,6 +1103,10 @@ func (mt *MetaTable) AlterCollection(ctx context.Context, result message.Broadca
 	mt.names.remove(oldColl.DBName, oldColl.Name)
 	mt.names.insert(newColl.DBName, newColl.Name, newColl.CollectionID)
 	mt.collID2Meta[header.CollectionId] = newColl
+	if dbChanged {
+		// the vchannels of the collection are counted into the vchannel quota of new database.
+		channel.StaticPChannelStatsManager.MustGet().SetCollectionDatabase(newColl.CollectionID, newColl.DBName)
+	}
 	mlog.Info(ctx, "alter collection finished",
 		mlog.String("oldDBName", oldColl.DBName),
 		mlog.String("newDBName", newColl.DBName),
@@ -1171,6 +1184,78 @@ func (mt *MetaTable) TruncateCollection(ctx context.Context, result message.Broa
 	return nil
 }
 
+// ReshardCollection applies the vchannels of the collection after its shard number is changed.
+// The start positions of the new vchannels are keyed by their pchannels,
+// the vchannel count of the pchannels is updated with the added and removed vchannels.
+func (mt *MetaTable) ReshardCollection(ctx context.Context, collectionID UniqueID, reshard *messagespb.CollectionReshardInfo, startPositions map[string][]byte, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok {
+		return errAlterCollectionNotFound
+	}
+	if len(reshard.GetVirtualChannelNames()) != len(reshard.GetPhysicalChannelNames()) {
+		return merr.WrapErrServiceInternalMsg("the vchannels and pchannels of collection %d are mismatched", collectionID)
+	}
+
+	oldVChannels := typeutil.NewSet(coll.VirtualChannelNames...)
+	newVChannels := typeutil.NewSet(reshard.GetVirtualChannelNames()...)
+	addedVChannels := newVChannels.Complement(oldVChannels).Collect()
+	removedVChannels := oldVChannels.Complement(newVChannels).Collect()
+	if len(addedVChannels) == 0 && len(removedVChannels) == 0 {
+		// the reshard is already applied, return directly to promise idempotent.
+		return nil
+	}
+
+	oldStartPositions := toMap(coll.StartPositions)
+	newStartPositions := make(map[string][]byte, len(reshard.GetPhysicalChannelNames()))
+	shardInfos := make(map[string]*model.ShardInfo, len(reshard.GetVirtualChannelNames()))
+	for idx, vchannel := range reshard.GetVirtualChannelNames() {
+		pchannel := reshard.GetPhysicalChannelNames()[idx]
+		if position, ok := oldStartPositions[pchannel]; ok && oldVChannels.Contain(vchannel) {
+			newStartPositions[pchannel] = position
+		} else {
+			newStartPositions[pchannel] = startPositions[pchannel]
+		}
+		shardInfo := &model.ShardInfo{VChannelName: vchannel, PChannelName: pchannel}
+		if oldShardInfo, ok := coll.ShardInfos[vchannel]; ok {
+			shardInfo.LastTruncateTimeTick = oldShardInfo.LastTruncateTimeTick
+		}
+		shardInfos[vchannel] = shardInfo
+	}
+
+	oldColl := coll.Clone()
+	newColl := coll.Clone()
+	newColl.VirtualChannelNames = reshard.GetVirtualChannelNames()
+	newColl.PhysicalChannelNames = reshard.GetPhysicalChannelNames()
+	newColl.ShardsNum = int32(len(reshard.GetVirtualChannelNames()))
+	newColl.StartPositions = toKeyDataPairs(newStartPositions)
+	newColl.ShardInfos = shardInfos
+	newColl.UpdateTimestamp = ts
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, oldColl, newColl, metastore.MODIFY, ts, false); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID] = newColl
+	mt.generalCnt += coll.GetPartitionNum(true) * int(newColl.ShardsNum-oldColl.ShardsNum)
+
+	// removing the vchannels forgets the database of the collection, so it's recorded again.
+	channel.StaticPChannelStatsManager.MustGet().RemoveVChannel(removedVChannels...)
+	channel.StaticPChannelStatsManager.MustGet().SetCollectionDatabase(collectionID, newColl.DBName)
+	channel.StaticPChannelStatsManager.MustGet().AddVChannel(addedVChannels...)
+	mlog.Info(ctx, "reshard collection finished",
+		mlog.Int64("collectionID", collectionID),
+		mlog.Int32("oldShardsNum", oldColl.ShardsNum),
+		mlog.Int32("newShardsNum", newColl.ShardsNum),
+		mlog.Strings("addedVChannels", addedVChannels),
+		mlog.Strings("removedVChannels", removedVChannels),
+		mlog.Uint64("ts", ts),
+	)
+	return nil
+}
+
 func (mt *MetaTable) CheckIfCollectionRenamable(ctx context.Context, dbName string, oldName string, newDBName string, newName string) error {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/common"
 	pb "github.com/milvus-io/milvus/pkg/v3/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/util"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
@@ -3107,6 +3108,82 @@ func TestMetaTable_TruncateCollection(t *testing.T) {
 	require.Equal(t, uint64(1000), coll.ShardInfos["vchannel1"].LastTruncateTimeTick)
 }
 
+func TestMetaTable_ReshardCollection(t *testing.T) {
+	channel.ResetStaticPChannelStatsManager()
+
+	kv, _ := kvfactory.GetEtcdAndPath()
+	path := funcutil.RandomString(10) + "/meta"
+	catalogKV := etcdkv.NewEtcdKV(kv, path)
+	catalog := rootcoord.NewCatalog(catalogKV)
+
+	allocator := mocktso.NewAllocator(t)
+	allocator.EXPECT().GenerateTSO(mock.Anything).Return(1000, nil)
+
+	meta, err := NewMetaTable(context.Background(), catalog, allocator)
+	require.NoError(t, err)
+
+	err = meta.AddCollection(context.Background(), &model.Collection{
+		CollectionID:         1,
+		PhysicalChannelNames: []string{"pchannel1"},
+		VirtualChannelNames:  []string{"pchannel1_1v0"},
+		ShardsNum:            1,
+		StartPositions:       []*commonpb.KeyDataPair{{Key: "pchannel1", Data: []byte("p1")}},
+		State:                pb.CollectionState_CollectionCreated,
+		DBID:                 util.DefaultDBID,
+		DBName:               util.DefaultDBName,
+		ShardInfos: map[string]*model.ShardInfo{
+			"pchannel1_1v0": {VChannelName: "pchannel1_1v0", PChannelName: "pchannel1", LastTruncateTimeTick: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// reshard a non-existent collection.
+	err = meta.ReshardCollection(context.Background(), 2, &messagespb.CollectionReshardInfo{}, nil, 1000)
+	require.ErrorIs(t, err, errAlterCollectionNotFound)
+
+	// expand the collection.
+	expand := &messagespb.CollectionReshardInfo{
+		VirtualChannelNames:  []string{"pchannel1_1v0", "pchannel2_1v1"},
+		PhysicalChannelNames: []string{"pchannel1", "pchannel2"},
+	}
+	err = meta.ReshardCollection(context.Background(), 1, expand, map[string][]byte{"pchannel2": []byte("p2")}, 1000)
+	require.NoError(t, err)
+	// the reshard is idempotent.
+	err = meta.ReshardCollection(context.Background(), 1, expand, map[string][]byte{"pchannel2": []byte("p3")}, 1001)
+	require.NoError(t, err)
+	stats := channel.StaticPChannelStatsManager.MustGet()
+	require.Equal(t, 1, stats.GetPChannelStats(channel.ChannelID{Name: "pchannel2"}).VChannelCount())
+
+	// reload the meta
+	channel.ResetStaticPChannelStatsManager()
+	meta, err = NewMetaTable(context.Background(), catalog, allocator)
+	require.NoError(t, err)
+	coll, err := meta.GetCollectionByID(context.Background(), util.DefaultDBName, 1, typeutil.MaxTimestamp, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), coll.ShardsNum)
+	require.Equal(t, expand.VirtualChannelNames, coll.VirtualChannelNames)
+	require.Equal(t, expand.PhysicalChannelNames, coll.PhysicalChannelNames)
+	require.Equal(t, map[string][]byte{"pchannel1": []byte("p1"), "pchannel2": []byte("p2")}, toMap(coll.StartPositions))
+	require.Equal(t, uint64(100), coll.ShardInfos["pchannel1_1v0"].LastTruncateTimeTick)
+	require.Equal(t, uint64(1000), coll.UpdateTimestamp)
+
+	// shrink the collection.
+	err = meta.ReshardCollection(context.Background(), 1, &messagespb.CollectionReshardInfo{
+		VirtualChannelNames:  []string{"pchannel1_1v0"},
+		PhysicalChannelNames: []string{"pchannel1"},
+	}, nil, 1002)
+	require.NoError(t, err)
+	coll, err = meta.GetCollectionByID(context.Background(), util.DefaultDBName, 1, typeutil.MaxTimestamp, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), coll.ShardsNum)
+	require.Equal(t, []string{"pchannel1_1v0"}, coll.VirtualChannelNames)
+	require.Equal(t, map[string][]byte{"pchannel1": []byte("p1")}, toMap(coll.StartPositions))
+	require.Len(t, coll.ShardInfos, 1)
+	stats = channel.StaticPChannelStatsManager.MustGet()
+	require.Equal(t, 0, stats.GetPChannelStats(channel.ChannelID{Name: "pchannel2"}).VChannelCount())
+	require.Equal(t, 1, stats.GetPChannelStats(channel.ChannelID{Name: "pchannel1"}).VChannelCount())
+}
+
 func TestMetaTableReloadNormalizesMaxFieldIDProperty(t *testing.T) {
 	channel.ResetStaticPChannelStatsManager()
 
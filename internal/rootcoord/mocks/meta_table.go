@@ -3808,6 +3808,56 @@ func (_c *IMetaTable_RemovePartition_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// ReshardCollection provides a mock function with given fields: ctx, collectionID, reshard, startPositions, ts
+func (_m *IMetaTable) ReshardCollection(ctx context.Context, collectionID int64, reshard *messagespb.CollectionReshardInfo, startPositions map[string][]byte, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, reshard, startPositions, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReshardCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *messagespb.CollectionReshardInfo, map[string][]byte, uint64) error); ok {
+		r0 = rf(ctx, collectionID, reshard, startPositions, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_ReshardCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReshardCollection'
+type IMetaTable_ReshardCollection_Call struct {
+	*mock.Call
+}
+
+// ReshardCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - reshard *messagespb.CollectionReshardInfo
+//   - startPositions map[string][]byte
+//   - ts uint64
+func (_e *IMetaTable_Expecter) ReshardCollection(ctx interface{}, collectionID interface{}, reshard interface{}, startPositions interface{}, ts interface{}) *IMetaTable_ReshardCollection_Call {
+	return &IMetaTable_ReshardCollection_Call{Call: _e.mock.On("ReshardCollection", ctx, collectionID, reshard, startPositions, ts)}
+}
+
+func (_c *IMetaTable_ReshardCollection_Call) Run(run func(ctx context.Context, collectionID int64, reshard *messagespb.CollectionReshardInfo, startPositions map[string][]byte, ts uint64)) *IMetaTable_ReshardCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*messagespb.CollectionReshardInfo), args[3].(map[string][]byte), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ReshardCollection_Call) Return(_a0 error) *IMetaTable_ReshardCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_ReshardCollection_Call) RunAndReturn(run func(context.Context, int64, *messagespb.CollectionReshardInfo, map[string][]byte, uint64) error) *IMetaTable_ReshardCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreRBAC provides a mock function with given fields: ctx, tenant, meta
 func (_m *IMetaTable) RestoreRBAC(ctx context.Context, tenant string, meta *milvuspb.RBACMeta) error {
 	ret := _m.Called(ctx, tenant, meta)
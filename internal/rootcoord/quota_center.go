@@ -33,18 +33,21 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/quota"
 	rlinternal "github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v3/common"
 	"github.com/milvus-io/milvus/pkg/v3/config"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/metricsinfo"
@@ -178,6 +181,10 @@ type QuotaCenter struct {
 
 	keyManager *KeyManager
 
+	// walQuotaPushed is true if the append quotas have been pushed to streaming nodes,
+	// an empty snapshot should be pushed to clear them after the wal quota is disabled.
+	walQuotaPushed bool
+
 	stopOnce sync.Once
 	stopChan chan struct{}
 	wg       sync.WaitGroup
@@ -363,6 +370,10 @@ func (q *QuotaCenter) run() {
 			if err != nil {
 				mlog.Warn(q.ctx, "quotaCenter send rates to proxy failed", mlog.Err(err))
 			}
+			err = q.sendQuotasToStreamingNode()
+			if err != nil {
+				mlog.Warn(q.ctx, "quotaCenter send append quotas to streaming node failed", mlog.Err(err))
+			}
 			q.recordMetrics()
 		}
 	}
@@ -1687,6 +1698,82 @@ func (q *QuotaCenter) sendRatesToProxy() error {
 	return q.proxies.SetRates(ctx, q.toRatesRequest())
 }
 
+// toAppendQuotas converts the collection rate limiters into the append quotas of the wal.
+// The insert rate of collection is the limit of the whole cluster, but the wal enforces the quota at every vchannel,
+// so the rates are divided by the vchannel number of the collection.
+func (q *QuotaCenter) toAppendQuotas(ctx context.Context) []*streamingpb.CollectionAppendQuota {
+	maxRows := Params.QuotaConfig.DMLWALMaxRowsPerCollection.GetAsFloat()
+	clusterRateLimiter := q.rateLimiter.GetRootLimiters()
+	clusterDenyReason, clusterDenied := getDenyWritingReason(clusterRateLimiter)
+
+	quotas := make([]*streamingpb.CollectionAppendQuota, 0)
+	clusterRateLimiter.GetChildren().Range(func(dbID int64, dbRateLimiters *rlinternal.RateLimiterNode) bool {
+		dbDenyReason, dbDenied := getDenyWritingReason(dbRateLimiters)
+		dbRateLimiters.GetChildren().Range(func(collectionID int64, collectionRateLimiters *rlinternal.RateLimiterNode) bool {
+			coll, err := q.meta.GetCollectionByIDWithMaxTs(ctx, collectionID)
+			if err != nil || len(coll.VirtualChannelNames) == 0 {
+				return true
+			}
+			vchannelNum := float64(len(coll.VirtualChannelNames))
+			quota := &streamingpb.CollectionAppendQuota{CollectionId: collectionID}
+			if limiter, ok := collectionRateLimiters.GetLimiters().Get(internalpb.RateType_DMLInsert); ok && limiter.Limit() != Inf {
+				quota.MaxBytesPerSecond = float64(limiter.Limit()) / vchannelNum
+			}
+			if maxRows > 0 {
+				quota.MaxRowsPerSecond = maxRows / vchannelNum
+			}
+			switch collectionDenyReason, collectionDenied := getDenyWritingReason(collectionRateLimiters); {
+			case clusterDenied:
+				quota.DenyWriting, quota.Reason = true, clusterDenyReason
+			case dbDenied:
+				quota.DenyWriting, quota.Reason = true, dbDenyReason
+			case collectionDenied:
+				quota.DenyWriting, quota.Reason = true, collectionDenyReason
+			}
+			if quota.MaxBytesPerSecond > 0 || quota.MaxRowsPerSecond > 0 || quota.DenyWriting {
+				quotas = append(quotas, quota)
+			}
+			return true
+		})
+		return true
+	})
+	return quotas
+}
+
+// getDenyWritingReason returns the reason if the writing is denied by the rate limiter.
+func getDenyWritingReason(limiter *rlinternal.RateLimiterNode) (string, bool) {
+	stateInfo, ok := limiter.GetQuotaStates().Get(milvuspb.QuotaState_DenyToWrite)
+	if !ok {
+		return "", false
+	}
+	return stateInfo.Reason, true
+}
+
+// sendQuotasToStreamingNode pushes the append quotas of collections to streaming nodes,
+// so the wal rejects the appends of a collection writing beyond its quota before it starves other collections on the same pchannel.
+func (q *QuotaCenter) sendQuotasToStreamingNode() error {
+	enabled := Params.QuotaConfig.DMLWALQuotaEnabled.GetAsBool() && streamingutil.IsStreamingServiceEnabled()
+	if !enabled && !q.walQuotaPushed {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), SetRatesTimeout)
+	defer cancel()
+
+	var quotas []*streamingpb.CollectionAppendQuota
+	if enabled {
+		quotas = q.toAppendQuotas(ctx)
+	}
+	b, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if err := b.UpdateCollectionAppendQuotas(ctx, quotas); err != nil {
+		return err
+	}
+	q.walQuotaPushed = enabled
+	return nil
+}
+
 // recordMetrics records metrics of quota states.
 func (q *QuotaCenter) recordMetrics() {
 	metrics.RootCoordQuotaStates.Reset()
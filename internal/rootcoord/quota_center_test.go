@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/common"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
@@ -1796,3 +1797,58 @@ func TestDatabaseForceDenyDDL(t *testing.T) {
 		}
 	})
 }
+
+func TestToAppendQuotas(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	qc := mocks.NewMixCoord(t)
+	meta := mockrootcoord.NewIMetaTable(t)
+	pcm := proxyutil.NewMockProxyClientManager(t)
+	core, _ := NewCore(ctx, nil)
+	core.tsoAllocator = newMockTsoAllocator()
+
+	quotaCenter := NewQuotaCenter(pcm, qc, core.tsoAllocator, meta)
+	meta.EXPECT().GetCollectionByIDWithMaxTs(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, collectionID int64) (*model.Collection, error) {
+		if collectionID == 4 {
+			return nil, merr.ErrCollectionNotFound
+		}
+		return &model.Collection{CollectionID: collectionID, VirtualChannelNames: []string{"v1", "v2"}}, nil
+	})
+	newCollectionLimiter := func(dbID, collectionID int64, insertRate Limit) *rlinternal.RateLimiterNode {
+		node := quotaCenter.rateLimiter.GetOrCreateCollectionLimiters(dbID, collectionID,
+			func() *rlinternal.RateLimiterNode {
+				return rlinternal.NewRateLimiterNode(internalpb.RateScope_Database)
+			},
+			func() *rlinternal.RateLimiterNode {
+				return rlinternal.NewRateLimiterNode(internalpb.RateScope_Collection)
+			})
+		node.GetLimiters().Insert(internalpb.RateType_DMLInsert, ratelimitutil.NewLimiter(insertRate, float64(insertRate)))
+		return node
+	}
+	newCollectionLimiter(0, 1, 200)
+	newCollectionLimiter(0, 2, Inf)
+	newCollectionLimiter(1, 3, Inf).GetQuotaStates().Insert(milvuspb.QuotaState_DenyToWrite, &rlinternal.QuotaStateInfo{Reason: "test"})
+	newCollectionLimiter(1, 4, 200)
+
+	// the collection without limit is skipped, the rate is divided by the vchannel number.
+	quotas := quotaCenter.toAppendQuotas(ctx)
+	assert.Len(t, quotas, 2)
+	quotaByCollection := lo.SliceToMap(quotas, func(quota *streamingpb.CollectionAppendQuota) (int64, *streamingpb.CollectionAppendQuota) {
+		return quota.GetCollectionId(), quota
+	})
+	assert.Equal(t, float64(100), quotaByCollection[1].GetMaxBytesPerSecond())
+	assert.False(t, quotaByCollection[1].GetDenyWriting())
+	assert.True(t, quotaByCollection[3].GetDenyWriting())
+	assert.Equal(t, "test", quotaByCollection[3].GetReason())
+
+	// the rows quota and the deny writing of database is applied.
+	paramtable.Get().Save(Params.QuotaConfig.DMLWALMaxRowsPerCollection.Key, "1000")
+	defer paramtable.Get().Reset(Params.QuotaConfig.DMLWALMaxRowsPerCollection.Key)
+	quotaCenter.rateLimiter.GetDatabaseLimiters(0).GetQuotaStates().Insert(milvuspb.QuotaState_DenyToWrite, &rlinternal.QuotaStateInfo{Reason: "db"})
+	quotas = quotaCenter.toAppendQuotas(ctx)
+	assert.Len(t, quotas, 3)
+	for _, quota := range quotas {
+		assert.Equal(t, float64(500), quota.GetMaxRowsPerSecond())
+		assert.True(t, quota.GetDenyWriting())
+	}
+}
@@ -277,17 +277,31 @@ func (c *Core) sendMinDdlTsAsTt() {
 func (c *Core) startTimeTickLoop() {
 	defer c.wg.Done()
 
+	for {
+		if !c.runTimeTickLoopUntilStreamingEnabled() {
+			return
+		}
+		// the ddl timetick from rootcoord is resumed if the streaming service is rolled back to the legacy path.
+		if !c.waitUntilStreamingDisabled() {
+			return
+		}
+	}
+}
+
+// runTimeTickLoopUntilStreamingEnabled sends the ddl timetick until the streaming service is enabled,
+// returns false if the loop is quit for other reasons.
+func (c *Core) runTimeTickLoopUntilStreamingEnabled() bool {
 	streamingNotifier := snmanager.NewStreamingReadyNotifier()
 	defer streamingNotifier.Release()
 
 	if streamingutil.IsStreamingServiceEnabled() {
 		if err := snmanager.StaticStreamingNodeManager.RegisterStreamingEnabledListener(c.ctx, streamingNotifier); err != nil {
-			mlog.Info(context.TODO(), "register streaming enabled listener failed", mlog.Err(err))
-			return
+			mlog.Info(c.ctx, "register streaming enabled listener failed", mlog.Err(err))
+			return false
 		}
 		if streamingNotifier.IsReady() {
-			mlog.Info(context.TODO(), "streaming service has been enabled, ddl timetick from rootcoord should not start")
-			return
+			mlog.Info(c.ctx, "streaming service has been enabled, ddl timetick from rootcoord should not start")
+			return true
 		}
 	}
 
@@ -296,17 +310,37 @@ func (c *Core) startTimeTickLoop() {
 	for {
 		select {
 		case <-streamingNotifier.Ready():
-			mlog.Info(context.TODO(), "streaming service has been enabled, ddl timetick from rootcoord should stop")
-			return
+			mlog.Info(c.ctx, "streaming service has been enabled, ddl timetick from rootcoord should stop")
+			return true
 		case <-c.ctx.Done():
-			mlog.Info(context.TODO(), "rootcoord's timetick loop quit!")
-			return
+			mlog.Info(c.ctx, "rootcoord's timetick loop quit!")
+			return false
 		case <-ticker.C:
 			c.sendMinDdlTsAsTt()
 		}
 	}
 }
 
+// waitUntilStreamingDisabled blocks until the streaming service is disabled,
+// returns false if the rootcoord is stopped.
+func (c *Core) waitUntilStreamingDisabled() bool {
+	disabledNotifier := snmanager.NewStreamingDisabledNotifier()
+	defer disabledNotifier.Release()
+
+	if err := snmanager.StaticStreamingNodeManager.RegisterStreamingDisabledListener(c.ctx, disabledNotifier); err != nil {
+		mlog.Info(c.ctx, "register streaming disabled listener failed", mlog.Err(err))
+		return false
+	}
+	select {
+	case <-disabledNotifier.Disabled():
+		mlog.Info(c.ctx, "streaming service has been disabled, ddl timetick from rootcoord should resume")
+		return true
+	case <-c.ctx.Done():
+		mlog.Info(c.ctx, "rootcoord's timetick loop quit!")
+		return false
+	}
+}
+
 func (c *Core) tsLoop() {
 	defer c.wg.Done()
 	tsoTicker := time.NewTicker(tso2.UpdateTimestampStep)
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
@@ -53,6 +54,7 @@ import (
 	mocktso "github.com/milvus-io/milvus/internal/tso/mocks"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v3/common"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/etcdpb"
@@ -68,6 +70,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/retry"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -172,6 +175,16 @@ func initStreamingSystemAndCore(t *testing.T) *Core {
 		}
 		return vchannels, nil
 	}).Maybe()
+	b.EXPECT().ReallocVirtualChannels(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, param balancer.ReallocVChannelParam) ([]string, error) {
+		if param.Num <= len(param.VChannels) {
+			return param.VChannels[:param.Num], nil
+		}
+		vchannels := append([]string{}, param.VChannels...)
+		for i := len(param.VChannels); i < param.Num; i++ {
+			vchannels = append(vchannels, funcutil.GetVirtualChannel(fmt.Sprintf("%s-rootcoord-dml_%d_100v0", path, i), param.CollectionID, i))
+		}
+		return vchannels, nil
+	}).Maybe()
 	b.EXPECT().WaitUntilWALbasedDDLReady(mock.Anything).Return(nil).Maybe()
 	b.EXPECT().WaitUntilSchemaDropReady(mock.Anything).Return(nil).Maybe()
 	b.EXPECT().WatchChannelAssignments(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, callback balancer.WatchChannelAssignmentsCallback) error {
@@ -1269,6 +1282,67 @@ func TestCore_startTimeTickLoop(t *testing.T) {
 	c.wg.Wait()
 }
 
+func TestCore_startTimeTickLoopResumeAfterStreamingDisabled(t *testing.T) {
+	streamingutil.SetStreamingServiceEnabled()
+	defer streamingutil.UnsetStreamingServiceEnabled()
+
+	enabledRegistered := atomic.NewInt32(0)
+	disabledNotifiers := make(chan *syncutil.AsyncTaskNotifier[struct{}], 1)
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().RegisterStreamingEnabledNotifier(mock.Anything).Run(func(n *syncutil.AsyncTaskNotifier[struct{}]) {
+		// the streaming service is enabled at the first registration, and not enabled again after disabling.
+		if enabledRegistered.Inc() == 1 {
+			n.Cancel()
+		}
+	}).Return()
+	b.EXPECT().RegisterStreamingDisabledNotifier(mock.Anything).Run(func(n *syncutil.AsyncTaskNotifier[struct{}]) {
+		disabledNotifiers <- n
+	}).Return()
+	b.EXPECT().Close().Return().Maybe()
+	balance.ResetBalancer()
+	balance.Register(b)
+	defer balance.ResetBalancer()
+
+	ticks := atomic.NewInt32(0)
+	ddlManager := newMockDdlTsLockManager()
+	ddlManager.GetMinDdlTsFunc = func() Timestamp {
+		ticks.Inc()
+		return 100
+	}
+	sched := newMockScheduler()
+	sched.GetMinDdlTsFunc = func() Timestamp {
+		return 100
+	}
+	ticker := newRocksMqTtSynchronizer()
+	ticker.addSession(&sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: TestRootCoordID}})
+	c := newTestCore(
+		withTtSynchronizer(ticker),
+		withDdlTsLockManager(ddlManager),
+		withScheduler(sched))
+	ctx, cancel := context.WithCancel(context.Background())
+	c.ctx = ctx
+	paramtable.Get().Save(Params.ProxyCfg.TimeTickInterval.Key, "1")
+	defer paramtable.Get().Reset(Params.ProxyCfg.TimeTickInterval.Key)
+	c.wg.Add(1)
+	c.UpdateStateCode(commonpb.StateCode_Healthy)
+	go c.startTimeTickLoop()
+
+	// the ddl timetick is not sent when the streaming service is enabled.
+	n := <-disabledNotifiers
+	assert.Zero(t, ticks.Load())
+
+	// the ddl timetick is resumed after the streaming service is disabled.
+	n.Cancel()
+	n.BlockUntilFinish()
+	assert.Eventually(t, func() bool {
+		return ticks.Load() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), enabledRegistered.Load())
+
+	cancel()
+	c.wg.Wait()
+}
+
 // make sure the main functions work well when EnableActiveStandby=true
 // func TestRootcoord_EnableActiveStandby(t *testing.T) {
 // 	randVal := rand.Int()
@@ -6,13 +6,17 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/lazygrpc"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
@@ -82,6 +86,305 @@ func (c *AssignmentServiceImpl) UpdateWALBalancePolicy(ctx context.Context, req
 	return service.UpdateWALBalancePolicy(ctx, req)
 }
 
+// UpdatePChannelPools creates, replaces or drops the pchannel pools bound to databases.
+func (c *AssignmentServiceImpl) UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.UpdatePChannelPools(ctx, req)
+}
+
+// UpdatePChannelAntiAffinityGroups creates, replaces or drops the pchannel anti-affinity groups.
+func (c *AssignmentServiceImpl) UpdatePChannelAntiAffinityGroups(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.UpdatePChannelAntiAffinityGroups(ctx, req)
+}
+
+// MoveControlChannel moves the control channel to another pchannel.
+func (c *AssignmentServiceImpl) MoveControlChannel(ctx context.Context, req *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.MoveControlChannel(ctx, req)
+}
+
+// MigratePChannelTopics migrates the wal of pchannels to other topics of mq.
+func (c *AssignmentServiceImpl) MigratePChannelTopics(ctx context.Context, req *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.MigratePChannelTopics(ctx, req)
+}
+
+// ExportState exports a consistent snapshot of the channel manager state.
+func (c *AssignmentServiceImpl) ExportState(ctx context.Context, req *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.ExportState(ctx, req)
+}
+
+// UpdateReplicatingTaskState pauses or resumes the replicating tasks to a target cluster.
+func (c *AssignmentServiceImpl) UpdateReplicatingTaskState(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.UpdateReplicatingTaskState(ctx, req)
+}
+
+// ResetReplicateCheckpoint resets the start position of a replicating task.
+func (c *AssignmentServiceImpl) ResetReplicateCheckpoint(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.ResetReplicateCheckpoint(ctx, req)
+}
+
+// UpdateReplicateClusterConnection updates the connection param of a cluster in the replicate configuration without broadcast.
+func (c *AssignmentServiceImpl) UpdateReplicateClusterConnection(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.UpdateReplicateClusterConnection(ctx, req)
+}
+
+// CheckReplicateSchemaConsistency compares the collection schemas and vchannel mappings between current cluster and its target clusters.
+func (c *AssignmentServiceImpl) CheckReplicateSchemaConsistency(ctx context.Context, req *streamingpb.CheckReplicateSchemaConsistencyRequest) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.CheckReplicateSchemaConsistency(ctx, req)
+}
+
+// ListReplicatingTasks lists the replicating tasks of current cluster with the progress confirmed by the target clusters.
+func (c *AssignmentServiceImpl) ListReplicatingTasks(ctx context.Context, req *streamingpb.ListReplicatingTasksRequest) (*streamingpb.ListReplicatingTasksResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.ListReplicatingTasks(ctx, req)
+}
+
+// ListReplicateDeadLetters lists the dead letters of the parked replicating tasks.
+func (c *AssignmentServiceImpl) ListReplicateDeadLetters(ctx context.Context, req *streamingpb.ListReplicateDeadLettersRequest) (*streamingpb.ListReplicateDeadLettersResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.ListReplicateDeadLetters(ctx, req)
+}
+
+// RequeueReplicateDeadLetters requeues the dead letters of the parked replicating tasks.
+func (c *AssignmentServiceImpl) RequeueReplicateDeadLetters(ctx context.Context, req *streamingpb.RequeueReplicateDeadLettersRequest) (*streamingpb.RequeueReplicateDeadLettersResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.RequeueReplicateDeadLetters(ctx, req)
+}
+
+// GetReplicateConfigurationHistory gets the accepted replicate configurations ordered by version.
+func (c *AssignmentServiceImpl) GetReplicateConfigurationHistory(ctx context.Context, req *streamingpb.GetReplicateConfigurationHistoryRequest) (*streamingpb.GetReplicateConfigurationHistoryResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.GetReplicateConfigurationHistory(ctx, req)
+}
+
+// GetClusterChannels gets the cchannel and pchannels of current cluster.
+func (c *AssignmentServiceImpl) GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := service.GetClusterChannels(ctx, &streamingpb.GetClusterChannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetClusterInfo(), nil
+}
+
+// UpdatePChannelPins pins the pchannels to the streaming nodes or unpins them.
+func (c *AssignmentServiceImpl) UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.UpdatePChannelPins(ctx, req)
+}
+
+// UpdatePChannelRetentionPolicies sets or clears the retention policies of the wal of pchannels.
+func (c *AssignmentServiceImpl) UpdatePChannelRetentionPolicies(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.UpdatePChannelRetentionPolicies(ctx, req)
+}
+
+// GetPChannelRetentions gets the retention of the wal of the pchannels.
+func (c *AssignmentServiceImpl) GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := service.GetPChannelRetentions(ctx, &streamingpb.GetPChannelRetentionsRequest{Pchannels: pchannels})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRetentions(), nil
+}
+
+// DrainNode drains the pchannels from a streaming node for maintenance or cancels the draining.
+func (c *AssignmentServiceImpl) DrainNode(ctx context.Context, req *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.DrainNode(ctx, req)
+}
+
+// GetAssignmentHistory gets the ownership history of a pchannel.
+func (c *AssignmentServiceImpl) GetAssignmentHistory(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.GetAssignmentHistory(ctx, req)
+}
+
+// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the streaming node.
+func (c *AssignmentServiceImpl) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := service.RenewPChannelLease(ctx, &streamingpb.RenewPChannelLeaseRequest{
+		Node: types.NewProtoFromStreamingNodeInfo(node),
+		Channels: lo.Map(channels, func(channel types.PChannelInfo, _ int) *streamingpb.PChannelInfo {
+			return types.NewProtoFromPChannelInfo(channel)
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(resp.GetRevokedChannels(), func(channel *streamingpb.PChannelInfo, _ int) types.PChannelInfo {
+		return types.NewPChannelInfoFromProto(channel)
+	}), nil
+}
+
 // AssignmentDiscover watches the assignment discovery.
 func (c *AssignmentServiceImpl) AssignmentDiscover(ctx context.Context, cb func(*types.VersionedStreamingNodeAssignments) error) error {
 	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -135,13 +438,115 @@ func (c *AssignmentServiceImpl) UpdateReplicateConfiguration(ctx context.Context
 	if err != nil {
 		return err
 	}
+	// the user is recorded as the operator in the history of the replicate configuration.
+	operator, _ := contextutil.GetCurUserFromContext(ctx)
 	_, err = service.UpdateReplicateConfiguration(ctx, &streamingpb.UpdateReplicateConfigurationRequest{
 		Configuration: req.GetReplicateConfiguration(),
 		ForcePromote:  req.GetForcePromote(),
+		Operator:      operator,
+	})
+	return err
+}
+
+// UpdateRBACReplication updates whether the users, roles and privileges are replicated into the secondary clusters,
+// the current replicate configuration is kept.
+func (c *AssignmentServiceImpl) UpdateRBACReplication(ctx context.Context, disabled bool) error {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	config, err := c.getFreshReplicateConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return err
+	}
+	operator, _ := contextutil.GetCurUserFromContext(ctx)
+	_, err = service.UpdateReplicateConfiguration(ctx, &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration:           config.GetReplicateConfiguration(),
+		Operator:                operator,
+		RbacReplicationDisabled: &disabled,
+	})
+	return err
+}
+
+// UpdateReplicateCollectionFilters updates the collection filters of the target clusters,
+// the current replicate configuration is kept.
+func (c *AssignmentServiceImpl) UpdateReplicateCollectionFilters(ctx context.Context, filters map[string]*messagespb.ReplicateCollectionFilter) error {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	config, err := c.getFreshReplicateConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return err
+	}
+	operator, _ := contextutil.GetCurUserFromContext(ctx)
+	_, err = service.UpdateReplicateConfiguration(ctx, &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration:     config.GetReplicateConfiguration(),
+		Operator:          operator,
+		CollectionFilters: &streamingpb.ReplicateCollectionFilters{Filters: filters},
 	})
 	return err
 }
 
+// DryRunReplicateConfiguration computes the changes made by applying the replicate configuration without applying it.
+func (c *AssignmentServiceImpl) DryRunReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := service.UpdateReplicateConfiguration(ctx, &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: config,
+		DryRun:        true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPlan(), nil
+}
+
+// ValidateReplicateConfiguration validates the replicate configuration without applying it.
+func (c *AssignmentServiceImpl) ValidateReplicateConfiguration(ctx context.Context, req *streamingpb.ValidateReplicateConfigurationRequest) (*streamingpb.ValidateReplicateConfigurationResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.ValidateReplicateConfiguration(ctx, req)
+}
+
+// PromoteSecondary switches the primary of the replication from current cluster to a direct secondary cluster.
+func (c *AssignmentServiceImpl) PromoteSecondary(ctx context.Context, req *streamingpb.PromoteSecondaryRequest) (*streamingpb.PromoteSecondaryResponse, error) {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("assignment service client is closing")
+	}
+	defer c.lifetime.Done()
+
+	service, err := c.service.GetService(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.PromoteSecondary(ctx, req)
+}
+
 func (c *AssignmentServiceImpl) GetReplicateConfiguration(ctx context.Context, opts ...GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error) {
 	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
 		return nil, status.NewOnShutdownError("assignment service client is closing")
@@ -166,9 +166,14 @@ func (c *assignmentDiscoverClient) recvLoop() (err error) {
 				for _, channel := range assignment.Channels {
 					channels[channel.Name] = types.NewPChannelInfoFromProto(channel)
 				}
+				readReplicas := make(map[string]types.PChannelInfo, len(assignment.ReadReplicas))
+				for _, channel := range assignment.ReadReplicas {
+					readReplicas[channel.Name] = types.NewPChannelInfoFromProto(channel)
+				}
 				newIncomingAssignments[assignment.GetNode().GetServerId()] = types.StreamingNodeAssignment{
-					NodeInfo: types.NewStreamingNodeInfoFromProto(assignment.Node),
-					Channels: channels,
+					NodeInfo:     types.NewStreamingNodeInfoFromProto(assignment.Node),
+					Channels:     channels,
+					ReadReplicas: readReplicas,
 				}
 			}
 			c.w.Update(types.VersionedStreamingNodeAssignments{
@@ -2,8 +2,12 @@ package broadcast
 
 import (
 	"context"
+	"time"
+
+	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/lazygrpc"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -33,8 +37,95 @@ func (c *GRPCBroadcastServiceImpl) Broadcast(ctx context.Context, msg message.Br
 	if err != nil {
 		return nil, err
 	}
-	results := make(map[string]*types.AppendResult, len(resp.Results))
-	for channel, result := range resp.Results {
+	return newBroadcastAppendResult(resp.BroadcastId, resp.Results)
+}
+
+func (c *GRPCBroadcastServiceImpl) Ack(ctx context.Context, msg message.ImmutableMessage) error {
+	client, err := c.service.GetService(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.Ack(ctx, &streamingpb.BroadcastAckRequest{
+		BroadcastId: msg.BroadcastHeader().BroadcastID,
+		Vchannel:    msg.VChannel(),
+		Message:     msg.IntoImmutableMessageProto(),
+	})
+	return err
+}
+
+func (c *GRPCBroadcastServiceImpl) Watch(ctx context.Context, token *streamingpb.BroadcastWatchResumeToken, messageTypes []message.MessageType, cb func(*streamingpb.BroadcastWatchResponse) error) error {
+	client, err := c.service.GetService(ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.Watch(ctx, &streamingpb.BroadcastWatchRequest{
+		ResumeToken: token,
+		MessageTypes: lo.Map(messageTypes, func(t message.MessageType, _ int) messagespb.MessageType {
+			return messagespb.MessageType(t)
+		}),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := cb(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *GRPCBroadcastServiceImpl) BeginCrossVChannelTxn(ctx context.Context, collectionID int64, vchannels []string, keepalive time.Duration) (*streamingpb.CrossVChannelTxnMeta, error) {
+	client, err := c.service.GetService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.BeginCrossVChannelTxn(ctx, &streamingpb.BeginCrossVChannelTxnRequest{
+		CollectionId:          collectionID,
+		Vchannels:             vchannels,
+		KeepaliveMilliseconds: keepalive.Milliseconds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTxn(), nil
+}
+
+func (c *GRPCBroadcastServiceImpl) CommitCrossVChannelTxn(ctx context.Context, txnID int64) (*types.BroadcastAppendResult, error) {
+	client, err := c.service.GetService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.CommitCrossVChannelTxn(ctx, &streamingpb.CommitCrossVChannelTxnRequest{
+		TxnId: txnID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBroadcastAppendResult(resp.BroadcastId, resp.Results)
+}
+
+func (c *GRPCBroadcastServiceImpl) RollbackCrossVChannelTxn(ctx context.Context, txnID int64) error {
+	client, err := c.service.GetService(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.RollbackCrossVChannelTxn(ctx, &streamingpb.RollbackCrossVChannelTxnRequest{
+		TxnId: txnID,
+	})
+	return err
+}
+
+// newBroadcastAppendResult converts the append results of the broadcast response into the broadcast append result.
+func newBroadcastAppendResult(broadcastID uint64, protoResults map[string]*streamingpb.ProduceMessageResponseResult) (*types.BroadcastAppendResult, error) {
+	results := make(map[string]*types.AppendResult, len(protoResults))
+	for channel, result := range protoResults {
 		msgID, err := message.UnmarshalMessageID(result.Id)
 		if err != nil {
 			return nil, err
@@ -47,20 +138,7 @@ func (c *GRPCBroadcastServiceImpl) Broadcast(ctx context.Context, msg message.Br
 		}
 	}
 	return &types.BroadcastAppendResult{
-		BroadcastID:   resp.BroadcastId,
+		BroadcastID:   broadcastID,
 		AppendResults: results,
 	}, nil
 }
-
-func (c *GRPCBroadcastServiceImpl) Ack(ctx context.Context, msg message.ImmutableMessage) error {
-	client, err := c.service.GetService(ctx)
-	if err != nil {
-		return err
-	}
-	_, err = client.Ack(ctx, &streamingpb.BroadcastAckRequest{
-		BroadcastId: msg.BroadcastHeader().BroadcastID,
-		Vchannel:    msg.VChannel(),
-		Message:     msg.IntoImmutableMessageProto(),
-	})
-	return err
-}
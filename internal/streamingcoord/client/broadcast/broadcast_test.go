@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
 	"github.com/milvus-io/milvus/internal/mocks/util/streamingutil/service/mock_lazygrpc"
@@ -34,6 +35,44 @@ func TestBroadcast(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCrossVChannelTxn(t *testing.T) {
+	s := mock_lazygrpc.NewMockService[streamingpb.StreamingCoordBroadcastServiceClient](t)
+	c := mock_streamingpb.NewMockStreamingCoordBroadcastServiceClient(t)
+	s.EXPECT().GetService(mock.Anything).Return(c, nil)
+	c.EXPECT().BeginCrossVChannelTxn(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *streamingpb.BeginCrossVChannelTxnRequest, _ ...grpc.CallOption) (*streamingpb.BeginCrossVChannelTxnResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionId())
+			assert.Equal(t, []string{"v1", "v2"}, req.GetVchannels())
+			assert.Equal(t, int64(1000), req.GetKeepaliveMilliseconds())
+			return &streamingpb.BeginCrossVChannelTxnResponse{
+				Txn: &streamingpb.CrossVChannelTxnMeta{TxnId: 2, VchannelTxnIds: map[string]int64{"v1": 3, "v2": 4}},
+			}, nil
+		})
+	c.EXPECT().CommitCrossVChannelTxn(mock.Anything, mock.Anything).Return(&streamingpb.CommitCrossVChannelTxnResponse{
+		Results: map[string]*streamingpb.ProduceMessageResponseResult{
+			"v1": {Id: walimplstest.NewTestMessageID(1).IntoProto(), Timetick: 10},
+			"v2": {Id: walimplstest.NewTestMessageID(2).IntoProto(), Timetick: 10},
+		},
+		BroadcastId: 5,
+	}, nil)
+	c.EXPECT().RollbackCrossVChannelTxn(mock.Anything, mock.Anything).Return(&streamingpb.RollbackCrossVChannelTxnResponse{}, nil)
+
+	bs := NewGRPCBroadcastService(s)
+	txn, err := bs.BeginCrossVChannelTxn(context.Background(), 1, []string{"v1", "v2"}, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), txn.GetTxnId())
+	assert.Equal(t, map[string]int64{"v1": 3, "v2": 4}, txn.GetVchannelTxnIds())
+
+	result, err := bs.CommitCrossVChannelTxn(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), result.BroadcastID)
+	assert.Len(t, result.AppendResults, 2)
+	assert.Equal(t, uint64(10), result.AppendResults["v1"].TimeTick)
+
+	err = bs.RollbackCrossVChannelTxn(context.Background(), 2)
+	assert.NoError(t, err)
+}
+
 func newMockServer(t *testing.T, sendDelay time.Duration) lazygrpc.Service[streamingpb.StreamingCoordBroadcastServiceClient] {
 	s := mock_lazygrpc.NewMockService[streamingpb.StreamingCoordBroadcastServiceClient](t)
 	c := mock_streamingpb.NewMockStreamingCoordBroadcastServiceClient(t)
@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/streamingcoord/client/assignment"
@@ -19,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/lazygrpc"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/resolver"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -42,6 +44,24 @@ type AssignmentService interface {
 	// UpdateReplicateConfiguration updates the replicate configuration to the milvus cluster.
 	UpdateReplicateConfiguration(ctx context.Context, req *milvuspb.UpdateReplicateConfigurationRequest) error
 
+	// ValidateReplicateConfiguration validates the replicate configuration without applying it.
+	ValidateReplicateConfiguration(ctx context.Context, req *streamingpb.ValidateReplicateConfigurationRequest) (*streamingpb.ValidateReplicateConfigurationResponse, error)
+
+	// UpdateRBACReplication updates whether the users, roles and privileges are replicated into the secondary clusters,
+	// the current replicate configuration is kept.
+	UpdateRBACReplication(ctx context.Context, disabled bool) error
+
+	// UpdateReplicateCollectionFilters updates the collection filters of the target clusters, keyed by the target cluster id,
+	// the current replicate configuration is kept. The target cluster without filter replicates all databases and collections.
+	UpdateReplicateCollectionFilters(ctx context.Context, filters map[string]*messagespb.ReplicateCollectionFilter) error
+
+	// DryRunReplicateConfiguration computes the changes made by applying the replicate configuration without applying it.
+	DryRunReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error)
+
+	// PromoteSecondary switches the primary of the replication from current cluster to a direct secondary cluster,
+	// returns after the switchover is confirmed by the secondary cluster.
+	PromoteSecondary(ctx context.Context, req *streamingpb.PromoteSecondaryRequest) (*streamingpb.PromoteSecondaryResponse, error)
+
 	// GetReplicateConfiguration returns the replicate configuration of the milvus cluster.
 	// Pass assignment.WithFreshRead() to force reading the latest state from the coord.
 	GetReplicateConfiguration(ctx context.Context, opts ...assignment.GetReplicateConfigurationOpt) (*replicateutil.ConfigHelper, error)
@@ -49,6 +69,82 @@ type AssignmentService interface {
 	// GetLatestAssignments returns the latest assignment discovery result.
 	GetLatestAssignments(ctx context.Context) (*types.VersionedStreamingNodeAssignments, error)
 
+	// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the streaming node.
+	// Return the pchannels that are not owned by the streaming node any more.
+	RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error)
+
+	// UpdatePChannelPools creates, replaces or drops the pchannel pools bound to databases.
+	// Return all pchannel pools after the update, an empty request can be used to list the pools.
+	UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error)
+
+	// UpdatePChannelAntiAffinityGroups creates, replaces or drops the pchannel anti-affinity groups.
+	// Return all groups after the update, an empty request can be used to list the groups.
+	UpdatePChannelAntiAffinityGroups(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)
+
+	// MoveControlChannel moves the control channel to another pchannel.
+	// All DDL/DCL are fenced until the move is done.
+	MoveControlChannel(ctx context.Context, req *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error)
+
+	// ExportState exports a consistent snapshot of the channel manager state for the support bundle.
+	ExportState(ctx context.Context, req *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error)
+
+	// UpdateReplicatingTaskState pauses or resumes the replicating tasks to a target cluster.
+	// Return the replicating tasks matched by the request after the update.
+	UpdateReplicatingTaskState(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error)
+
+	// ResetReplicateCheckpoint resets the start position of a replicating task,
+	// e.g. the target cluster is restored from backup.
+	ResetReplicateCheckpoint(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error)
+
+	// UpdateReplicateClusterConnection updates the connection param of a cluster in the replicate configuration of current cluster,
+	// e.g. the endpoint of the target cluster is changed, without broadcasting the AlterReplicateConfig message.
+	UpdateReplicateClusterConnection(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)
+
+	// CheckReplicateSchemaConsistency compares the collection schemas and vchannel mappings between current cluster and its target clusters,
+	// and returns the drifts found.
+	CheckReplicateSchemaConsistency(ctx context.Context, req *streamingpb.CheckReplicateSchemaConsistencyRequest) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error)
+
+	// ListReplicatingTasks lists the replicating tasks of current cluster with the checkpoint and lag confirmed by the target clusters.
+	ListReplicatingTasks(ctx context.Context, req *streamingpb.ListReplicatingTasksRequest) (*streamingpb.ListReplicatingTasksResponse, error)
+
+	// ListReplicateDeadLetters lists the dead letters of the replicating tasks parked after too many rejections by the target clusters.
+	ListReplicateDeadLetters(ctx context.Context, req *streamingpb.ListReplicateDeadLettersRequest) (*streamingpb.ListReplicateDeadLettersResponse, error)
+
+	// RequeueReplicateDeadLetters requeues the dead letters, so the parked replicating tasks retry the rejected messages.
+	RequeueReplicateDeadLetters(ctx context.Context, req *streamingpb.RequeueReplicateDeadLettersRequest) (*streamingpb.RequeueReplicateDeadLettersResponse, error)
+
+	// GetReplicateConfigurationHistory gets the accepted replicate configurations ordered by version,
+	// to audit the changes of the topology or find a known-good version to roll back.
+	GetReplicateConfigurationHistory(ctx context.Context, req *streamingpb.GetReplicateConfigurationHistoryRequest) (*streamingpb.GetReplicateConfigurationHistoryResponse, error)
+
+	// GetClusterChannels gets the cchannel and pchannels of current cluster,
+	// which are used by the other clusters to discover the pchannels of current cluster in replicate configuration.
+	GetClusterChannels(ctx context.Context) (*milvuspb.ClusterInfo, error)
+
+	// UpdatePChannelPins pins the pchannels to the streaming nodes or unpins them.
+	// Return all pins after the update, an empty request can be used to list the pins.
+	UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error)
+
+	// UpdatePChannelRetentionPolicies sets or clears the retention policies of the wal of pchannels.
+	// Return all retention policies after the update, an empty request can be used to list the policies.
+	UpdatePChannelRetentionPolicies(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)
+
+	// GetPChannelRetentions gets the retention of the wal of the pchannels,
+	// including the retention policy and the checkpoints confirmed by the replicating tasks from the pchannels.
+	GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error)
+
+	// MigratePChannelTopics migrates the wal of pchannels to other topics of mq, e.g. the cluster is moved to another pulsar namespace.
+	// It returns after the pchannels are fenced, the wal is switched to the new topic after all data is flushed.
+	MigratePChannelTopics(ctx context.Context, req *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error)
+
+	// DrainNode drains the pchannels from a streaming node for maintenance or cancels the draining.
+	// Return the progress of draining, it can be called repeatedly until no pchannel is remaining.
+	DrainNode(ctx context.Context, req *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error)
+
+	// GetAssignmentHistory gets the ownership history of a pchannel,
+	// to see which streaming node owned the pchannel at a given term.
+	GetAssignmentHistory(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error)
+
 	// UpdateWALBalancePolicy is used to update the WAL balance policy.
 	// Return the WAL balance policy after the update.
 	// Deprecated: This function is deprecated and will be removed in the future.
@@ -62,6 +158,23 @@ type BroadcastService interface {
 
 	// Ack sends a broadcast ack to the streaming service.
 	Ack(ctx context.Context, msg message.ImmutableMessage) error
+
+	// Watch subscribes the broadcast messages applied at control channel, such as DDL applied or replicate configuration changed.
+	// The watching starts after the resume token, or from the latest one if the resume token is nil.
+	// Only the given message types are delivered, all message types are delivered if it's empty.
+	// It blocks until the context is done, the stream is broken or the callback returns an error,
+	// the caller can watch again with the resume token of last received response.
+	Watch(ctx context.Context, token *streamingpb.BroadcastWatchResumeToken, messageTypes []message.MessageType, cb func(*streamingpb.BroadcastWatchResponse) error) error
+
+	// BeginCrossVChannelTxn begins a transaction across the vchannels of a collection at streamingcoord.
+	// The returned meta carries the txn id of every vchannel, which should be used to begin the transaction at the vchannel.
+	BeginCrossVChannelTxn(ctx context.Context, collectionID int64, vchannels []string, keepalive time.Duration) (*streamingpb.CrossVChannelTxnMeta, error)
+
+	// CommitCrossVChannelTxn commits the cross vchannel transaction by a broadcast commit message.
+	CommitCrossVChannelTxn(ctx context.Context, txnID int64) (*types.BroadcastAppendResult, error)
+
+	// RollbackCrossVChannelTxn rollbacks the cross vchannel transaction by a broadcast rollback message.
+	RollbackCrossVChannelTxn(ctx context.Context, txnID int64) error
 }
 
 // Client is the interface of log service client.
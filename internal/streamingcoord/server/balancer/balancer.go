@@ -5,6 +5,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
@@ -20,8 +21,12 @@ var (
 
 type (
 	AllocVChannelParam                   = channel.AllocVChannelParam
+	ReallocVChannelParam                 = channel.ReallocVChannelParam
 	WatchChannelAssignmentsCallbackParam = channel.WatchChannelAssignmentsCallbackParam
 	WatchChannelAssignmentsCallback      = channel.WatchChannelAssignmentsCallback
+	RebalanceMove                        = channel.RebalanceMove
+	AssignmentDiff                       = channel.AssignmentDiff
+	PChannelView                         = channel.PChannelView
 )
 
 // Balancer is a load balancer to balance the load of log node.
@@ -48,6 +53,79 @@ type Balancer interface {
 	// AllocVirtualChannels allocates virtual channels for a collection.
 	AllocVirtualChannels(ctx context.Context, param AllocVChannelParam) ([]string, error)
 
+	// ReallocVirtualChannels expands or shrinks the vchannels of an existing collection to the expected shard number.
+	// The current vchannels are kept in order, only the new vchannels are allocated.
+	ReallocVirtualChannels(ctx context.Context, param ReallocVChannelParam) ([]string, error)
+
+	// UpdatePChannelPools creates, replaces or drops the pchannel pools bound to databases.
+	// An empty request returns all pchannel pools.
+	UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error)
+
+	// UpdatePChannelAntiAffinityGroups creates, replaces or drops the pchannel anti-affinity groups,
+	// the pchannels in the same group are never placed on the same streaming node if there're enough nodes.
+	// An empty request returns all groups.
+	UpdatePChannelAntiAffinityGroups(ctx context.Context, req *types.UpdatePChannelAntiAffinityGroupsRequest) (*types.UpdatePChannelAntiAffinityGroupsResponse, error)
+
+	// MoveControlChannel moves the control channel to another pchannel.
+	// The caller should fence the control channel first, so no broadcast is in-flight at the old control channel.
+	MoveControlChannel(ctx context.Context, req *types.MoveControlChannelRequest) (*types.MoveControlChannelResponse, error)
+
+	// ExportState exports a consistent snapshot of the channel manager state.
+	ExportState(ctx context.Context, req *types.ExportStateRequest) (*types.ExportStateResponse, error)
+
+	// UpdateReplicatingTaskState pauses or resumes the replicating tasks to the target cluster.
+	UpdateReplicatingTaskState(ctx context.Context, req *types.UpdateReplicatingTaskStateRequest) (*types.UpdateReplicatingTaskStateResponse, error)
+
+	// ResetReplicateCheckpoint resets the start position of a replicating task.
+	ResetReplicateCheckpoint(ctx context.Context, req *types.ResetReplicateCheckpointRequest) (*types.ResetReplicateCheckpointResponse, error)
+
+	// UpdateReplicateClusterConnection updates the connection param of a cluster in the replicate configuration without broadcast.
+	UpdateReplicateClusterConnection(ctx context.Context, req *types.UpdateReplicateClusterConnectionRequest) (*types.UpdateReplicateClusterConnectionResponse, error)
+
+	// PlanReplicateConfiguration computes the changes of current cluster made by applying the replicate configuration without applying it.
+	PlanReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*types.ReplicateConfigurationPlan, error)
+
+	// DrainNode drains the pchannels from the streaming node or cancels the draining, and returns the progress of draining.
+	// The draining node is frozen, the pchannels on it are moved away by the balance respecting the reassign throttle.
+	DrainNode(ctx context.Context, req *types.DrainNodeRequest) (*types.DrainNodeResponse, error)
+
+	// GetAssignmentHistory returns the ownership history of the pchannel,
+	// which records the streaming node that owned the pchannel at each finished term.
+	GetAssignmentHistory(ctx context.Context, req *types.GetAssignmentHistoryRequest) (*types.GetAssignmentHistoryResponse, error)
+
+	// RegisterPChannels registers the pchannels into the channel provider.
+	// The unknown pchannels are added and balanced asynchronously.
+	RegisterPChannels(ctx context.Context, names []string) error
+
+	// GetPChannelsView returns the current view of all pchannels, including the assignment, terms and stats of pchannels.
+	// The returned view is a snapshot and should be read only.
+	GetPChannelsView(ctx context.Context) (*PChannelView, error)
+
+	// PreviewRebalance computes the moves that the next balance round would apply without applying them.
+	PreviewRebalance(ctx context.Context) ([]RebalanceMove, error)
+
+	// UpdatePChannelPins pins the pchannels to the streaming nodes or unpins them.
+	// The pinned pchannel is never moved by the balance policy, an empty request returns all pins.
+	UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error)
+
+	// UpdatePChannelRetentionPolicies sets or clears the retention policies of the wal of pchannels.
+	// An empty request returns all retention policies.
+	UpdatePChannelRetentionPolicies(ctx context.Context, req *types.UpdatePChannelRetentionPoliciesRequest) (*types.UpdatePChannelRetentionPoliciesResponse, error)
+
+	// GetPChannelRetentionPolicies returns the retention policies of the wal of the pchannels, keyed by pchannel.
+	// The pchannel without retention policy is absent in the result.
+	GetPChannelRetentionPolicies(ctx context.Context, pchannels []string) (map[string]*streamingpb.WALRetentionPolicy, error)
+
+	// CheckPChannelTopicMigration checks if the pchannels can be migrated to the target topics, keyed by pchannel.
+	CheckPChannelTopicMigration(ctx context.Context, pchannelTopics map[string]string) error
+
+	// UpdatePChannelTopics persists the topics of the pchannels after the wal is fenced for the topic migration.
+	UpdatePChannelTopics(ctx context.Context, pchannelTopics map[string]string) error
+
+	// UpdateCollectionAppendQuotas pushes the snapshot of the collection append quotas to all streaming nodes,
+	// the collections not in the snapshot are not limited any more.
+	UpdateCollectionAppendQuotas(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota) error
+
 	// UpdateBalancePolicy update the balance policy.
 	UpdateBalancePolicy(ctx context.Context, req *streamingpb.UpdateWALBalancePolicyRequest) (*streamingpb.UpdateWALBalancePolicyResponse, error)
 
@@ -70,15 +148,41 @@ type Balancer interface {
 	// 3. The caller should call the notifier finish method, after the caller see notification and finish its work.
 	RegisterStreamingEnabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}])
 
+	// RegisterStreamingDisabledNotifier registers a notifier that is notified when the streaming service is disabled.
+	// The disabling is blocked until the caller calls the notifier finish method.
+	RegisterStreamingDisabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}])
+
+	// DisableStreaming rolls back the streaming service to the legacy path, it's designed for the test clusters.
+	// It's rejected if there's any state that can only be served by the streaming based WAL,
+	// such as the WAL based DDL or the replication.
+	DisableStreaming(ctx context.Context) error
+
 	// GetLatestWALLocated returns the server id of the node that the wal of the vChannel is located.
 	GetLatestWALLocated(ctx context.Context, pchannel string) (int64, bool)
 
+	// GetLatestWALLocatedBatch returns the server ids of the nodes that the wals of the pchannels are located, keyed by pchannel.
+	// The pchannel that is not found or not assigned is absent in the result.
+	GetLatestWALLocatedBatch(ctx context.Context, pchannels []string) map[string]int64
+
 	// WatchChannelAssignments watches the balance result.
 	WatchChannelAssignments(ctx context.Context, cb WatchChannelAssignmentsCallback) error
 
+	// WatchChannelAssignmentDiffs watches the balance result with the incremental diff of relations.
+	// The Diff of callback param is always set, the watcher can apply the diff only instead of the full relations.
+	WatchChannelAssignmentDiffs(ctx context.Context, cb WatchChannelAssignmentsCallback) error
+
+	// RegisterAssignmentWatcher registers a named watcher of the balance result, which runs in background with its own buffer,
+	// so the slow watcher doesn't block the other watchers.
+	// The watcher is stopped when the ctx is done, the watcher is closed, or the balancer is closed.
+	RegisterAssignmentWatcher(ctx context.Context, id string, cb WatchChannelAssignmentsCallback, opts ...channel.WatchAssignmentOption) (*channel.AssignmentWatcher, error)
+
 	// MarkAsAvailable marks the pchannels as available, and trigger a rebalance.
 	MarkAsUnavailable(ctx context.Context, pChannels []types.PChannelInfo) error
 
+	// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the streaming node.
+	// Return the pchannels that are not owned by the streaming node any more.
+	RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, pChannels []types.PChannelInfo) ([]types.PChannelInfo, error)
+
 	// UpdateReplicateConfiguration updates the replicate configuration.
 	UpdateReplicateConfiguration(ctx context.Context, result message.BroadcastResultAlterReplicateConfigMessageV2) error
 
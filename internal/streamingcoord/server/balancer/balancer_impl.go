@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -15,6 +17,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/resolver"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/contextutil"
@@ -41,10 +44,8 @@ func RecoverBalancer(
 	ctx context.Context,
 	provider ChannelProvider,
 ) (Balancer, error) {
-	policyBuilder := mustGetPolicy(paramtable.Get().StreamingCfg.WALBalancerPolicyName.GetValue())
-	policy := policyBuilder.Build()
-	logger := resource.Resource().Logger().With(mlog.FieldComponent("balancer"), mlog.String("policy", policyBuilder.Name()))
-	policy.SetLogger(logger)
+	policy := buildPolicy(mustGetPolicy(paramtable.Get().StreamingCfg.WALBalancerPolicyName.GetValue()))
+	logger := resource.Resource().Logger().With(mlog.FieldComponent("balancer"))
 
 	// Recover the channel view from catalog.
 	manager, err := channel.RecoverChannelManager(ctx, provider.GetInitialChannels()...)
@@ -52,6 +53,10 @@ func RecoverBalancer(
 		return nil, merr.Wrap(err, "fail to recover channel manager")
 	}
 	manager.SetLogger(resource.Resource().Logger().With(mlog.FieldComponent("channel-manager")))
+	balancerConfig, err := resource.Resource().StreamingCatalog().GetBalancerConfig(ctx)
+	if err != nil {
+		return nil, merr.Wrap(err, "fail to recover balancer config")
+	}
 	ctx, cancel := context.WithCancelCause(context.Background())
 	b := &balancerImpl{
 		ctx:                    ctx,
@@ -63,8 +68,14 @@ func RecoverBalancer(
 		reqCh:                  make(chan *request, 5),
 		backgroundTaskNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
 		freezeNodes:            typeutil.NewConcurrentSet[int64](),
+		balanceFrozen:          balancerConfig.GetBalanceFrozen(),
+		leaseExpired:           make(chan struct{}, 1),
+		pchannelScaled:         make(chan struct{}, 1),
 	}
 	b.SetLogger(logger)
+	if b.balanceFrozen {
+		logger.Info(ctx, "balancer is recovered with balance frozen, the automatic reassignment is suspended")
+	}
 	ready260Future, err := b.checkIfAllNodeGreaterThan260AndWatch(ctx)
 	if err != nil {
 		return nil, err
@@ -82,10 +93,13 @@ type balancerImpl struct {
 	lifetime               *typeutil.Lifetime
 	provider               ChannelProvider
 	channelMetaManager     *channel.ChannelManager
-	policy                 Policy                                // policy is the balance policy, TODO: should be dynamic in future.
+	policy                 Policy                                // policy is the balance policy, it's switched if the configured policy name is changed.
 	reqCh                  chan *request                         // reqCh is the request channel, send the operation to background task.
 	backgroundTaskNotifier *syncutil.AsyncTaskNotifier[struct{}] // backgroundTaskNotifier is used to conmunicate with the background task.
 	freezeNodes            *typeutil.ConcurrentSet[int64]        // freezeNodes is the nodes that will be frozen, no more wal will be assigned to these nodes and wal will be removed from these nodes.
+	balanceFrozen          bool                                  // balanceFrozen suspends the automatic reassignment, it's persisted and only accessed by the background task.
+	leaseExpired           chan struct{}                         // leaseExpired is used to notify the background task that some wal lease is expired.
+	pchannelScaled         chan struct{}                         // pchannelScaled is used to notify the background task that some pchannels are added by auto scale.
 
 	fileResourceChecker FileResourceChecker
 	checkerMu           sync.RWMutex
@@ -108,6 +122,11 @@ func (b *balancerImpl) RegisterStreamingEnabledNotifier(notifier *syncutil.Async
 	b.channelMetaManager.RegisterStreamingEnabledNotifier(notifier)
 }
 
+// RegisterStreamingDisabledNotifier registers a notifier that is notified when the streaming service is disabled.
+func (b *balancerImpl) RegisterStreamingDisabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}]) {
+	b.channelMetaManager.RegisterStreamingDisabledNotifier(notifier)
+}
+
 func (b *balancerImpl) GetLatestChannelAssignment() (*WatchChannelAssignmentsCallbackParam, error) {
 	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
 		return nil, status.NewOnShutdownError("balancer is closing")
@@ -188,6 +207,11 @@ func (b *balancerImpl) GetLatestWALLocated(ctx context.Context, pchannel string)
 	return b.channelMetaManager.GetLatestWALLocated(ctx, pchannel)
 }
 
+// GetLatestWALLocatedBatch returns the server ids of the nodes that the wals of the pchannels are located.
+func (b *balancerImpl) GetLatestWALLocatedBatch(ctx context.Context, pchannels []string) map[string]int64 {
+	return b.channelMetaManager.GetLatestWALLocatedBatch(ctx, pchannels)
+}
+
 // WaitUntilWALbasedDDLReady waits until the WAL based DDL is ready.
 func (b *balancerImpl) WaitUntilWALbasedDDLReady(ctx context.Context) error {
 	if b.channelMetaManager.IsStreamingVersionAtLeast(channel.StreamingVersion265) {
@@ -229,6 +253,38 @@ func (b *balancerImpl) WatchChannelAssignments(ctx context.Context, cb WatchChan
 	return b.channelMetaManager.WatchAssignmentResult(ctx, cb)
 }
 
+// WatchChannelAssignmentDiffs watches the balance result with the incremental diff of relations.
+func (b *balancerImpl) WatchChannelAssignmentDiffs(ctx context.Context, cb WatchChannelAssignmentsCallback) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	return b.channelMetaManager.WatchAssignmentResult(ctx, cb, channel.OptIncrementalDiff())
+}
+
+// RegisterAssignmentWatcher registers a named watcher of the balance result.
+func (b *balancerImpl) RegisterAssignmentWatcher(ctx context.Context, id string, cb WatchChannelAssignmentsCallback, opts ...channel.WatchAssignmentOption) (*channel.AssignmentWatcher, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	w, err := b.channelMetaManager.RegisterAssignmentWatcher(ctx, id, cb, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	go func() {
+		<-w.Done()
+		cancel()
+	}()
+	return w, nil
+}
+
 // UpdateReplicateConfiguration updates the replicate configuration.
 func (b *balancerImpl) UpdateReplicateConfiguration(ctx context.Context, result message.BroadcastResultAlterReplicateConfigMessageV2) error {
 	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -250,6 +306,290 @@ func (b *balancerImpl) AllocVirtualChannels(ctx context.Context, param AllocVCha
 	return b.channelMetaManager.AllocVirtualChannels(ctx, param)
 }
 
+// ReallocVirtualChannels expands or shrinks the vchannels of an existing collection to the expected shard number.
+func (b *balancerImpl) ReallocVirtualChannels(ctx context.Context, param ReallocVChannelParam) ([]string, error) {
+	return b.channelMetaManager.ReallocVirtualChannels(ctx, param)
+}
+
+// UpdatePChannelPools creates, replaces or drops the pchannel pools bound to databases.
+func (b *balancerImpl) UpdatePChannelPools(ctx context.Context, req *types.UpdatePChannelPoolsRequest) (*types.UpdatePChannelPoolsResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.UpdatePChannelPools(ctx, req)
+}
+
+// DisableStreaming rolls back the streaming service to the legacy path if there's no state only served by the streaming based WAL.
+func (b *balancerImpl) DisableStreaming(ctx context.Context) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	_, err := b.sendRequestAndWaitFinish(ctx, newOpDisableStreaming(ctx))
+	return err
+}
+
+// UpdatePChannelPins pins the pchannels to the streaming nodes or unpins them.
+func (b *balancerImpl) UpdatePChannelPins(ctx context.Context, req *types.UpdatePChannelPinsRequest) (*types.UpdatePChannelPinsResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	resp, err := b.sendRequestAndWaitFinish(ctx, newOpUpdatePChannelPins(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*types.UpdatePChannelPinsResponse), nil
+}
+
+// UpdatePChannelRetentionPolicies sets or clears the retention policies of the wal of pchannels.
+func (b *balancerImpl) UpdatePChannelRetentionPolicies(ctx context.Context, req *types.UpdatePChannelRetentionPoliciesRequest) (*types.UpdatePChannelRetentionPoliciesResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.UpdatePChannelRetentionPolicies(ctx, req)
+}
+
+// GetPChannelRetentionPolicies returns the retention policies of the wal of the pchannels.
+func (b *balancerImpl) GetPChannelRetentionPolicies(ctx context.Context, pchannels []string) (map[string]*streamingpb.WALRetentionPolicy, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.GetPChannelRetentionPolicies(pchannels), nil
+}
+
+// CheckPChannelTopicMigration checks if the pchannels can be migrated to the target topics.
+func (b *balancerImpl) CheckPChannelTopicMigration(ctx context.Context, pchannelTopics map[string]string) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.CheckPChannelTopicMigration(pchannelTopics)
+}
+
+// UpdatePChannelTopics persists the topics of the pchannels after the wal is fenced for the topic migration.
+func (b *balancerImpl) UpdatePChannelTopics(ctx context.Context, pchannelTopics map[string]string) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.UpdatePChannelTopics(ctx, pchannelTopics)
+}
+
+// UpdateCollectionAppendQuotas pushes the snapshot of the collection append quotas to all streaming nodes.
+func (b *balancerImpl) UpdateCollectionAppendQuotas(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return resource.Resource().StreamingNodeManagerClient().UpdateAppendQuotas(ctx, quotas)
+}
+
+// UpdatePChannelAntiAffinityGroups creates, replaces or drops the pchannel anti-affinity groups.
+func (b *balancerImpl) UpdatePChannelAntiAffinityGroups(ctx context.Context, req *types.UpdatePChannelAntiAffinityGroupsRequest) (*types.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	resp, err := b.sendRequestAndWaitFinish(ctx, newOpUpdatePChannelAntiAffinityGroups(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*types.UpdatePChannelAntiAffinityGroupsResponse), nil
+}
+
+// MoveControlChannel moves the control channel to another pchannel.
+func (b *balancerImpl) MoveControlChannel(ctx context.Context, req *types.MoveControlChannelRequest) (*types.MoveControlChannelResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	meta, err := b.channelMetaManager.MoveControlChannel(ctx, req.GetPchannel())
+	if err != nil {
+		return nil, err
+	}
+	return &types.MoveControlChannelResponse{Meta: meta}, nil
+}
+
+// ExportState exports a consistent snapshot of the channel manager state.
+func (b *balancerImpl) ExportState(ctx context.Context, req *types.ExportStateRequest) (*types.ExportStateResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	state, err := b.channelMetaManager.ExportState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ExportStateResponse{State: state}, nil
+}
+
+// UpdateReplicatingTaskState pauses or resumes the replicating tasks to the target cluster.
+func (b *balancerImpl) UpdateReplicatingTaskState(ctx context.Context, req *types.UpdateReplicatingTaskStateRequest) (*types.UpdateReplicatingTaskStateResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	tasks, err := b.channelMetaManager.UpdateReplicatingTaskState(ctx, req.GetTargetClusterId(), req.GetSourceChannelName(), req.GetState())
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateReplicatingTaskStateResponse{Tasks: tasks}, nil
+}
+
+// ResetReplicateCheckpoint resets the start position of a replicating task.
+func (b *balancerImpl) ResetReplicateCheckpoint(ctx context.Context, req *types.ResetReplicateCheckpointRequest) (*types.ResetReplicateCheckpointResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	task, err := b.channelMetaManager.ResetReplicateCheckpoint(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ResetReplicateCheckpointResponse{Task: task}, nil
+}
+
+// UpdateReplicateClusterConnection updates the connection param of a cluster in the replicate configuration without broadcast.
+func (b *balancerImpl) UpdateReplicateClusterConnection(ctx context.Context, req *types.UpdateReplicateClusterConnectionRequest) (*types.UpdateReplicateClusterConnectionResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	version, tasks, err := b.channelMetaManager.UpdateReplicateClusterConnection(ctx, req.GetClusterId(), req.GetConnectionParam())
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateReplicateClusterConnectionResponse{Version: version, Tasks: tasks}, nil
+}
+
+// PlanReplicateConfiguration computes the changes of current cluster made by applying the replicate configuration without applying it.
+func (b *balancerImpl) PlanReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*types.ReplicateConfigurationPlan, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.PlanReplicateConfiguration(ctx, config)
+}
+
+// DrainNode drains the pchannels from the streaming node or cancels the draining, and returns the progress of draining.
+func (b *balancerImpl) DrainNode(ctx context.Context, req *types.DrainNodeRequest) (*types.DrainNodeResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	if req.GetServerId() <= 0 {
+		return nil, status.NewInvalidArgument("invalid server id %d", req.GetServerId())
+	}
+	if !req.GetCancel() {
+		// the unknown node is never drained, otherwise it will be removed from the frozen nodes at next balance.
+		nodes, err := resource.Resource().StreamingNodeManagerClient().GetAllStreamingNodes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := nodes[req.GetServerId()]; !ok {
+			return nil, status.NewInvalidArgument("streaming node %d not found", req.GetServerId())
+		}
+	}
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	resp, err := b.sendRequestAndWaitFinish(ctx, newOpDrainNode(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*types.DrainNodeResponse), nil
+}
+
+// GetAssignmentHistory returns the ownership history of the pchannel.
+func (b *balancerImpl) GetAssignmentHistory(ctx context.Context, req *types.GetAssignmentHistoryRequest) (*types.GetAssignmentHistoryResponse, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	histories, err := b.channelMetaManager.GetOwnershipHistories(req.GetPchannel(), req.GetTerm())
+	if errors.Is(err, channel.ErrChannelNotExist) {
+		return nil, status.NewInvalidArgument("pchannel %s not found", req.GetPchannel())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetAssignmentHistoryResponse{
+		Pchannel:  req.GetPchannel(),
+		Histories: histories,
+	}, nil
+}
+
+// RegisterPChannels registers the pchannels into the channel provider.
+func (b *balancerImpl) RegisterPChannels(ctx context.Context, names []string) error {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	registry, ok := b.provider.(ChannelRegistry)
+	if !ok {
+		return status.NewInner("the channel provider doesn't support registering pchannels")
+	}
+	if err := registry.RegisterChannels(ctx, names); err != nil {
+		return err
+	}
+	b.Logger().Info(ctx, "pchannels registered", mlog.Strings("pchannels", names))
+	return nil
+}
+
+// GetPChannelsView returns the current view of all pchannels.
+func (b *balancerImpl) GetPChannelsView(ctx context.Context) (*PChannelView, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	return b.channelMetaManager.CurrentPChannelsView(), nil
+}
+
+// PreviewRebalance computes the moves that the next balance round would apply without persisting or broadcasting them.
+func (b *balancerImpl) PreviewRebalance(ctx context.Context) ([]RebalanceMove, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	ctx, cancel := contextutil.MergeContext(ctx, b.ctx)
+	defer cancel()
+	resp, err := b.sendRequestAndWaitFinish(ctx, newOpPreviewRebalance(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]RebalanceMove), nil
+}
+
 // UpdateBalancePolicy update the balance policy.
 func (b *balancerImpl) UpdateBalancePolicy(ctx context.Context, req *types.UpdateWALBalancePolicyRequest) (*types.UpdateWALBalancePolicyResponse, error) {
 	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -279,6 +619,18 @@ func (b *balancerImpl) MarkAsUnavailable(ctx context.Context, pChannels []types.
 	return err
 }
 
+// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the streaming node.
+func (b *balancerImpl) RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, pChannels []types.PChannelInfo) ([]types.PChannelInfo, error) {
+	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return nil, status.NewOnShutdownError("balancer is closing")
+	}
+	defer b.lifetime.Done()
+
+	// The lease renewal should not be blocked by the background balance task,
+	// because the balance task may be waiting for the ack of the streaming node.
+	return b.channelMetaManager.RenewPChannelLeases(ctx, node.ServerID, pChannels), nil
+}
+
 // Trigger trigger a re-balance.
 func (b *balancerImpl) Trigger(ctx context.Context) error {
 	if !b.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -310,6 +662,7 @@ func (b *balancerImpl) Close() {
 	// cancel all watch opeartion by context.
 	b.cancel(ErrBalancerClosed)
 	b.lifetime.Wait()
+	b.channelMetaManager.CloseAssignmentWatchers()
 
 	b.backgroundTaskNotifier.Cancel()
 	b.backgroundTaskNotifier.BlockUntilFinish()
@@ -341,6 +694,43 @@ func (b *balancerImpl) execute(ready260Future *syncutil.Future[error]) {
 	}
 	channelChanged := statsManager.WatchAtChannelCountChanged()
 
+	if paramtable.Get().StreamingCfg.WALBalancerLeaseEnabled.GetAsBool() {
+		leaseCheckerDone := make(chan struct{})
+		go func() {
+			defer close(leaseCheckerDone)
+			b.checkLeaseExpiration(b.backgroundTaskNotifier.Context())
+		}()
+		defer func() { <-leaseCheckerDone }()
+	}
+
+	autoScalerDone := make(chan struct{})
+	go func() {
+		defer close(autoScalerDone)
+		b.autoScalePChannels(b.backgroundTaskNotifier.Context())
+	}()
+	defer func() { <-autoScalerDone }()
+
+	replicateTaskGCDone := make(chan struct{})
+	go func() {
+		defer close(replicateTaskGCDone)
+		b.gcRemovedReplicatingTasks(b.backgroundTaskNotifier.Context())
+	}()
+	defer func() { <-replicateTaskGCDone }()
+
+	replicateSchemaCheckDone := make(chan struct{})
+	go func() {
+		defer close(replicateSchemaCheckDone)
+		b.checkReplicateSchemaConsistency(b.backgroundTaskNotifier.Context())
+	}()
+	defer func() { <-replicateSchemaCheckDone }()
+
+	replicateHealthProbeDone := make(chan struct{})
+	go func() {
+		defer close(replicateHealthProbeDone)
+		b.probeReplicateTargetClusters(b.backgroundTaskNotifier.Context())
+	}()
+	defer func() { <-replicateHealthProbeDone }()
+
 	for {
 		// Wait for next balance trigger.
 		// Maybe trigger by timer or by request.
@@ -374,6 +764,10 @@ func (b *balancerImpl) execute(ready260Future *syncutil.Future[error]) {
 			// trigger the watch update.
 			b.channelMetaManager.TriggerWatchUpdate()
 			// balance triggered by new streaming node changed.
+		case <-b.leaseExpired:
+			// balance triggered by wal lease expired.
+		case <-b.pchannelScaled:
+			// balance triggered by new pchannels added by auto scale.
 		case <-channelChanged.WaitChan():
 			// balance triggered by channel changed.
 			channelChanged.Sync()
@@ -526,6 +920,34 @@ func (b *balancerImpl) blockUntilRoleGreaterThanVersion(ctx context.Context, rol
 	return nil
 }
 
+// checkLeaseExpiration checks the lease of the assigned wal periodically,
+// the wal will be marked as unavailable if the lease is not renewed by the streaming node in time.
+func (b *balancerImpl) checkLeaseExpiration(ctx context.Context) {
+	ticker := time.NewTicker(paramtable.Get().StreamingCfg.WALBalancerLeaseRenewInterval.GetAsDurationByParse())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		expired := b.channelMetaManager.ExpiredLeases()
+		if len(expired) == 0 {
+			continue
+		}
+		b.Logger().Warn(ctx, "wal lease expired, mark as unavailable", mlog.Int("expiredCount", len(expired)))
+		if err := b.channelMetaManager.MarkAsUnavailable(ctx, expired); err != nil {
+			b.Logger().Warn(ctx, "fail to mark expired wal as unavailable", mlog.Err(err))
+			continue
+		}
+		select {
+		case b.leaseExpired <- struct{}{}:
+		default:
+		}
+	}
+}
+
 // applyAllRequest apply all request in the request channel.
 func (b *balancerImpl) applyAllRequest() {
 	for {
@@ -546,7 +968,8 @@ func (b *balancerImpl) balanceUntilNoChanged(ctx context.Context) error {
 			return err
 		}
 		if !layoutChanged {
-			return nil
+			// the read-write assignment is stable, balance the read replicas.
+			return b.balanceReadReplicas(ctx)
 		}
 	}
 }
@@ -556,38 +979,123 @@ func (b *balancerImpl) balanceUntilNoChanged(ctx context.Context) error {
 // Return a channel to notify the balance trigger again.
 func (b *balancerImpl) balance(ctx context.Context) (bool, error) {
 	b.Logger().Info(ctx, "start to balance")
-	pchannelView := b.channelMetaManager.CurrentPChannelsView()
+	expectedLayout, err := b.generateExpectedLayout(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	b.Logger().Info(ctx, "balance policy generate result success, try to assign...", mlog.Stringer("expectedLayout", expectedLayout))
+	// bookkeeping the meta assignment started.
+	modifiedChannels, err := b.channelMetaManager.AssignPChannels(ctx, expectedLayout.ChannelAssignment, channel.OptBypassThrottle(expectedLayout.OperatorChannels...))
+	if err != nil {
+		return false, merr.Wrap(err, "fail to assign pchannels")
+	}
+
+	if len(modifiedChannels) == 0 {
+		b.Logger().Info(ctx, "no change of balance result need to be applied")
+		return false, nil
+	}
+	return true, b.applyBalanceResultToStreamingNode(ctx, modifiedChannels)
+}
 
+// previewRebalance computes the moves that the next balance round would apply without applying them.
+func (b *balancerImpl) previewRebalance(ctx context.Context) ([]RebalanceMove, error) {
+	expectedLayout, err := b.generateExpectedLayout(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.channelMetaManager.PreviewRebalance(ctx, expectedLayout.ChannelAssignment)
+}
+
+// generateExpectedLayout collects the status of streaming nodes and calls the balance policy to generate the expected layout.
+func (b *balancerImpl) generateExpectedLayout(ctx context.Context) (ExpectedLayout, error) {
 	rgName := paramtable.Get().StreamingCfg.PrimaryResourceGroup.GetValue()
 	b.Logger().Info(ctx, "collect all status...", mlog.String("resourceGroupHint", rgName))
 	nodeStatus, err := b.fetchStreamingNodeStatus(ctx, rgName)
 	if err != nil {
-		return false, err
+		return ExpectedLayout{}, err
 	}
+	// the append traffic reported by the streaming nodes should be collected before generating the pchannel view.
+	updateAppendMetrics(nodeStatus)
+	pchannelView := b.channelMetaManager.CurrentPChannelsView()
 
 	// call the balance strategy to generate the expected layout.
+	b.switchPolicyIfChanged(ctx)
 	accessMode := types.AccessModeRO
 	if b.channelMetaManager.IsStreamingEnabledOnce() {
 		accessMode = types.AccessModeRW
 	}
 	currentLayout := generateCurrentLayout(pchannelView, nodeStatus, accessMode)
+	currentLayout.AntiAffinityGroups = generateAntiAffinityGroups(b.channelMetaManager.ListPChannelAntiAffinityGroups())
 	expectedLayout, err := b.policy.Balance(currentLayout)
 	if err != nil {
-		return false, merr.Wrap(err, "fail to balance")
+		return ExpectedLayout{}, merr.Wrap(err, "fail to balance")
 	}
+	// the anti-affinity is applied before the frozen check, so the frozen balancer never moves the channels for anti-affinity.
+	expectedLayout = currentLayout.applyAntiAffinity(expectedLayout)
+	if b.balanceFrozen {
+		expectedLayout = currentLayout.applyBalanceFrozen(expectedLayout)
+	}
+	expectedLayout = currentLayout.applyPinnedChannels(expectedLayout)
+	expectedLayout.OperatorChannels = b.operatorChannels(pchannelView, currentLayout)
+	return expectedLayout, nil
+}
 
-	b.Logger().Info(ctx, "balance policy generate result success, try to assign...", mlog.Stringer("expectedLayout", expectedLayout))
-	// bookkeeping the meta assignment started.
-	modifiedChannels, err := b.channelMetaManager.AssignPChannels(ctx, expectedLayout.ChannelAssignment)
-	if err != nil {
-		return false, merr.Wrap(err, "fail to assign pchannels")
+// operatorChannels returns the channels that are moved by operator,
+// which are the pinned channels and the channels located at the draining nodes.
+func (b *balancerImpl) operatorChannels(view *channel.PChannelView, layout CurrentLayout) []types.ChannelID {
+	channels := make([]types.ChannelID, 0, len(layout.PinnedChannels))
+	for id := range layout.PinnedChannels {
+		channels = append(channels, id)
+	}
+	for id, meta := range view.Channels {
+		if _, ok := layout.PinnedChannels[id]; ok {
+			continue
+		}
+		if meta.IsAssigned() && b.freezeNodes.Contain(meta.CurrentServerID()) {
+			channels = append(channels, id)
+		}
 	}
+	return channels
+}
 
-	if len(modifiedChannels) == 0 {
-		b.Logger().Info(ctx, "no change of balance result need to be applied")
-		return false, nil
+// switchPolicyIfChanged switches the balance policy if the configured policy name is changed.
+// The current policy is kept if the configured policy is not registered.
+func (b *balancerImpl) switchPolicyIfChanged(ctx context.Context) {
+	name := paramtable.Get().StreamingCfg.WALBalancerPolicyName.GetValue()
+	if name == b.policy.Name() {
+		return
 	}
-	return true, b.applyBalanceResultToStreamingNode(ctx, modifiedChannels)
+	builder, ok := getPolicy(name)
+	if !ok {
+		b.Logger().Warn(ctx, "balance policy not found, keep the current policy", mlog.String("policy", name), mlog.String("current", b.policy.Name()))
+		return
+	}
+	b.Logger().Info(ctx, "balance policy switched", mlog.String("from", b.policy.Name()), mlog.String("to", name))
+	b.policy = buildPolicy(builder)
+}
+
+// buildPolicy builds the balance policy with its logger.
+func buildPolicy(builder PolicyBuilder) Policy {
+	policy := builder.Build()
+	policy.SetLogger(resource.Resource().Logger().With(mlog.FieldComponent("balancer"), mlog.String("policy", builder.Name())))
+	return policy
+}
+
+// updateAppendMetrics updates the append traffic of the pchannels held by the healthy streaming nodes into the pchannel stats.
+func updateAppendMetrics(nodeStatus map[int64]*types.StreamingNodeStatus) {
+	metrics := make([]types.RWWALMetrics, 0)
+	for _, node := range nodeStatus {
+		if !node.IsHealthy() {
+			continue
+		}
+		for _, m := range node.Metrics.WALMetrics {
+			if rw, ok := m.(types.RWWALMetrics); ok {
+				metrics = append(metrics, rw)
+			}
+		}
+	}
+	channel.StaticPChannelStatsManager.Get().UpdateAppendMetrics(metrics...)
 }
 
 // fetchStreamingNodeStatus fetch the streaming node status.
@@ -637,13 +1145,9 @@ func (b *balancerImpl) applyBalanceResultToStreamingNode(ctx context.Context, mo
 		g.Go(func() error {
 			// all history channels should be remove from related nodes.
 			for _, assignment := range channel.AssignHistories() {
-				opCtx, cancel := context.WithTimeout(ctx, opTimeout)
-				defer cancel()
-				if err := resource.Resource().StreamingNodeManagerClient().Remove(opCtx, assignment); err != nil {
-					b.Logger().Warn(ctx, "fail to remove channel", mlog.String("assignment", assignment.String()), mlog.Err(err))
+				if err := b.removeChannelFromStreamingNode(ctx, assignment, opTimeout); err != nil {
 					return err
 				}
-				b.Logger().Info(ctx, "remove channel success", mlog.String("assignment", assignment.String()))
 			}
 
 			// assign the channel to the target node.
@@ -655,6 +1159,17 @@ func (b *balancerImpl) applyBalanceResultToStreamingNode(ctx context.Context, mo
 			}
 			b.Logger().Info(ctx, "assign channel success", mlog.String("assignment", channel.CurrentAssignment().String()))
 
+			if paramtable.Get().StreamingCfg.WALBalancerLeaseEnabled.GetAsBool() {
+				// the assignment is done only if the streaming node acknowledges it.
+				ackCtx, cancel := context.WithTimeout(ctx, paramtable.Get().StreamingCfg.WALBalancerLeaseTTL.GetAsDurationByParse())
+				defer cancel()
+				if err := b.channelMetaManager.WaitUntilAssignmentAcked(ackCtx, channel.CurrentAssignment()); err != nil {
+					b.Logger().Warn(ctx, "fail to wait for the ack of channel assignment", mlog.String("assignment", channel.CurrentAssignment().String()), mlog.Err(err))
+					return err
+				}
+				b.Logger().Info(ctx, "channel assignment acked", mlog.String("assignment", channel.CurrentAssignment().String()))
+			}
+
 			// bookkeeping the meta assignment done.
 			if err := b.channelMetaManager.AssignPChannelsDone(ctx, []types.ChannelID{channel.ChannelID()}); err != nil {
 				b.Logger().Warn(ctx, "fail to bookkeep pchannel assignment done", mlog.String("assignment", channel.CurrentAssignment().String()))
@@ -669,15 +1184,41 @@ func (b *balancerImpl) applyBalanceResultToStreamingNode(ctx context.Context, mo
 	return g.Wait()
 }
 
+// removeChannelFromStreamingNode removes a history assignment of the channel from its streaming node.
+func (b *balancerImpl) removeChannelFromStreamingNode(ctx context.Context, assignment types.PChannelInfoAssigned, opTimeout time.Duration) error {
+	opCtx, cancel := context.WithTimeout(ctx, opTimeout)
+	defer cancel()
+	if err := resource.Resource().StreamingNodeManagerClient().Remove(opCtx, assignment); err != nil {
+		b.Logger().Warn(ctx, "fail to remove channel", mlog.String("assignment", assignment.String()), mlog.Err(err))
+		return err
+	}
+	b.Logger().Info(ctx, "remove channel success", mlog.String("assignment", assignment.String()))
+	return nil
+}
+
 // generateCurrentLayout generate layout from all nodes info and meta.
 func generateCurrentLayout(view *channel.PChannelView, allNodesStatus map[int64]*types.StreamingNodeStatus, accessMode types.AccessMode) (layout CurrentLayout) {
 	channelsToNodes := make(map[types.ChannelID]int64, len(view.Channels))
 	channels := make(map[channel.ChannelID]types.PChannelInfo, len(view.Channels))
 	expectedAccessMode := make(map[types.ChannelID]types.AccessMode, len(view.Channels))
+	pinnedChannels := make(map[types.ChannelID]int64)
 
 	for id, meta := range view.Channels {
 		expectedAccessMode[id] = accessMode
 		channels[id] = meta.ChannelInfo()
+		if pinnedServerID := meta.PinnedServerID(); pinnedServerID > 0 {
+			// The pinned channel is always located at the pinned node if the node is available,
+			// otherwise the pin is ignored until the node comes back to keep the wal available.
+			if nodeStatus, ok := allNodesStatus[pinnedServerID]; ok && nodeStatus.IsHealthy() {
+				pinnedChannels[id] = pinnedServerID
+				channelsToNodes[id] = pinnedServerID
+				continue
+			}
+			mlog.Warn(context.TODO(), "the pinned server of channel is not healthy or not alive, ignore the pin",
+				mlog.Stringer("channel", id),
+				mlog.Int64("pinnedServerID", pinnedServerID),
+			)
+		}
 		if !meta.IsAssigned() {
 			// dead or expired relationship.
 			mlog.Warn(context.TODO(), "channel is not assigned to any server",
@@ -714,7 +1255,20 @@ func generateCurrentLayout(view *channel.PChannelView, allNodesStatus map[int64]
 		AllNodesInfo:       allNodesInfo,
 		ChannelsToNodes:    channelsToNodes,
 		ExpectedAccessMode: expectedAccessMode,
+		PinnedChannels:     pinnedChannels,
+	}
+}
+
+// generateAntiAffinityGroups converts the pchannel anti-affinity groups into the channel ids.
+func generateAntiAffinityGroups(groups []*streamingpb.PChannelAntiAffinityGroupMeta) [][]types.ChannelID {
+	if len(groups) == 0 {
+		return nil
 	}
+	return lo.Map(groups, func(group *streamingpb.PChannelAntiAffinityGroupMeta, _ int) []types.ChannelID {
+		return lo.Map(group.GetPchannels(), func(pchannel string, _ int) types.ChannelID {
+			return types.ChannelID{Name: pchannel}
+		})
+	})
 }
 
 type backoffConfigFetcher struct{}
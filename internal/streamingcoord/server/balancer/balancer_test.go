@@ -128,6 +128,9 @@ func TestBalancer(t *testing.T) {
 	})
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetBalancerConfig(mock.Anything).Return(nil, nil)
 
 	// Test for lower datanode and proxy version protection.
 	metaRoot := paramtable.Get().EtcdCfg.MetaRootPath.GetValue()
@@ -281,6 +284,77 @@ func TestBalancer(t *testing.T) {
 	assert.Contains(t, nodes, int64(2))
 	assert.Contains(t, nodes, int64(3))
 
+	// Drain node 2, the progress is reported until all pchannels are moved away.
+	_, err = b.DrainNode(ctx, &streamingpb.DrainNodeRequest{ServerId: 5})
+	assert.Error(t, err)
+	drainResp, err := b.DrainNode(ctx, &streamingpb.DrainNodeRequest{ServerId: 2})
+	assert.NoError(t, err)
+	assert.True(t, drainResp.GetDraining())
+	assert.Eventually(t, func() bool {
+		drainResp, err = b.DrainNode(ctx, &streamingpb.DrainNodeRequest{ServerId: 2})
+		return err == nil && len(drainResp.GetRemainingPchannels()) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+	nodes, err = b.GetAvailableStreamingNodes(ctx)
+	assert.NoError(t, err)
+	assert.NotContains(t, nodes, int64(2))
+	drainResp, err = b.DrainNode(ctx, &streamingpb.DrainNodeRequest{ServerId: 2, Cancel: true})
+	assert.NoError(t, err)
+	assert.False(t, drainResp.GetDraining())
+
+	view, err := b.GetPChannelsView(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, view.Channels, 3)
+	assert.Len(t, view.Stats, 3)
+
+	// The finished assignments are recorded into the ownership history.
+	_, err = b.GetAssignmentHistory(ctx, &streamingpb.GetAssignmentHistoryRequest{Pchannel: "not-exist"})
+	assert.Error(t, err)
+	var historyResp *streamingpb.GetAssignmentHistoryResponse
+	assert.Eventually(t, func() bool {
+		historyResp, err = b.GetAssignmentHistory(ctx, &streamingpb.GetAssignmentHistoryRequest{Pchannel: "test-channel-2"})
+		return err == nil && len(historyResp.GetHistories()) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	last := historyResp.GetHistories()[len(historyResp.GetHistories())-1]
+	historyResp, err = b.GetAssignmentHistory(ctx, &streamingpb.GetAssignmentHistoryRequest{Pchannel: "test-channel-2", Term: last.GetTerm()})
+	assert.NoError(t, err)
+	assert.Len(t, historyResp.GetHistories(), 1)
+	assert.Equal(t, last.GetNode().GetServerId(), historyResp.GetHistories()[0].GetNode().GetServerId())
+
+	// Freeze the balance, the flag is persisted into the catalog.
+	catalog.EXPECT().SaveBalancerConfig(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, config *streamingpb.BalancerConfigMeta) error {
+		assert.True(t, config.GetBalanceFrozen())
+		return nil
+	}).Once()
+	resp, err = b.UpdateBalancePolicy(ctx, &streamingpb.UpdateWALBalancePolicyRequest{
+		Config: &streamingpb.WALBalancePolicyConfig{
+			BalanceFrozen: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{types.UpdateMaskPathWALBalancePolicyBalanceFrozen},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Config.BalanceFrozen)
+	assert.True(t, resp.Config.AllowRebalance)
+	moves, err := b.PreviewRebalance(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, moves)
+
+	catalog.EXPECT().SaveBalancerConfig(mock.Anything, mock.Anything).Return(errors.New("test")).Once()
+	_, err = b.UpdateBalancePolicy(ctx, &streamingpb.UpdateWALBalancePolicyRequest{
+		Config: &streamingpb.WALBalancePolicyConfig{},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{types.UpdateMaskPathWALBalancePolicyBalanceFrozen},
+		},
+	})
+	assert.Error(t, err)
+	resp, err = b.UpdateBalancePolicy(ctx, &streamingpb.UpdateWALBalancePolicyRequest{
+		Config:     &streamingpb.WALBalancePolicyConfig{},
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	})
+	assert.NoError(t, err)
+	assert.True(t, resp.Config.BalanceFrozen)
+
 	b.Close()
 	assert.ErrorIs(t, f.Get(), balancer.ErrBalancerClosed)
 }
@@ -324,6 +398,9 @@ func TestBalancerWaitUntilSchemaDropReady(t *testing.T) {
 	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetBalancerConfig(mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	b, err := balancer.RecoverBalancer(ctx, newStaticChannelProvider())
@@ -405,6 +482,9 @@ func TestBalancerWaitUntilSchemaDropReadySkipsAfterPersistedVersion(t *testing.T
 	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetBalancerConfig(mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	legacyProxyKey := path.Join(metaRoot, sessionutil.DefaultServiceRoot, typeutil.ProxyRole+"-legacy")
@@ -519,6 +599,9 @@ func TestBalancer_WithRecoveryLag(t *testing.T) {
 	})
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetBalancerConfig(mock.Anything).Return(nil, nil)
 
 	ctx := context.Background()
 	b, err := balancer.RecoverBalancer(ctx, newStaticChannelProvider("test-channel-1"))
@@ -605,6 +688,9 @@ func TestBalancer_DynamicChannelFromProvider(t *testing.T) {
 	}, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetBalancerConfig(mock.Anything).Return(nil, nil)
 
 	provider := newStaticChannelProvider("initial-channel")
 	ctx := context.Background()
@@ -687,6 +773,9 @@ func TestBalancer_DynamicChannelProviderClosed(t *testing.T) {
 	}, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetBalancerConfig(mock.Anything).Return(nil, nil)
 
 	provider := newStaticChannelProvider("ch1")
 	ctx := context.Background()
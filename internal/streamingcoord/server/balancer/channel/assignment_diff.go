@@ -0,0 +1,92 @@
+package channel
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// WatchAssignmentOption is the option of WatchAssignmentResult and RegisterAssignmentWatcher.
+type WatchAssignmentOption func(opt *watchAssignmentOptions)
+
+type watchAssignmentOptions struct {
+	incrementalDiff bool
+	bufferSize      int // only used by the named assignment watcher.
+}
+
+// OptIncrementalDiff makes the watcher receive the incremental diff of relations in WatchChannelAssignmentsCallbackParam.Diff,
+// so the watcher with a large assignment doesn't need to reprocess the full relations at every callback.
+func OptIncrementalDiff() WatchAssignmentOption {
+	return func(opt *watchAssignmentOptions) {
+		opt.incrementalDiff = true
+	}
+}
+
+// AssignmentDiff is the incremental change of relations from PrevVersion to Version.
+// The first diff of a watcher is generated from an empty assignment, so all relations are added and the PrevVersion is zero.
+// The diff may be empty if only the other parts of assignment (pchannel view, replicate configuration) are changed.
+type AssignmentDiff struct {
+	PrevVersion typeutil.VersionInt64Pair
+	Version     typeutil.VersionInt64Pair
+	Added       []types.PChannelInfoAssigned // the pchannels that are newly assigned.
+	Removed     []types.PChannelInfoAssigned // the pchannels that are not assigned any more, the previous relation is kept.
+	Updated     []types.PChannelInfoAssigned // the pchannels that the node, term or access mode is changed.
+}
+
+// IsEmpty returns true if there's no relation changed.
+func (d *AssignmentDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Updated) == 0
+}
+
+// assignmentDiffer generates the incremental diff for a watcher by keeping the relations of last callback.
+type assignmentDiffer struct {
+	version   typeutil.VersionInt64Pair
+	relations map[types.ChannelID]types.PChannelInfoAssigned
+}
+
+// newAssignmentDiffer creates a new assignment differ.
+func newAssignmentDiffer() *assignmentDiffer {
+	return &assignmentDiffer{
+		relations: make(map[types.ChannelID]types.PChannelInfoAssigned),
+	}
+}
+
+// apply generates the diff from last applied relations to the given ones and applies them.
+// The relations in the diff are ordered by the pchannel name.
+func (d *assignmentDiffer) apply(version typeutil.VersionInt64Pair, relations []types.PChannelInfoAssigned) *AssignmentDiff {
+	diff := &AssignmentDiff{
+		PrevVersion: d.version,
+		Version:     version,
+	}
+	current := make(map[types.ChannelID]types.PChannelInfoAssigned, len(relations))
+	for _, relation := range relations {
+		id := relation.Channel.ChannelID()
+		current[id] = relation
+		prev, ok := d.relations[id]
+		if !ok {
+			diff.Added = append(diff.Added, relation)
+		} else if prev != relation {
+			diff.Updated = append(diff.Updated, relation)
+		}
+	}
+	for id, relation := range d.relations {
+		if _, ok := current[id]; !ok {
+			diff.Removed = append(diff.Removed, relation)
+		}
+	}
+	sortRelations(diff.Added)
+	sortRelations(diff.Removed)
+	sortRelations(diff.Updated)
+
+	d.version = version
+	d.relations = current
+	return diff
+}
+
+// sortRelations sorts the relations by the pchannel name.
+func sortRelations(relations []types.PChannelInfoAssigned) {
+	sort.Slice(relations, func(i, j int) bool {
+		return relations[i].Channel.Name < relations[j].Channel.Name
+	})
+}
@@ -0,0 +1,47 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+func TestAssignmentDiffer(t *testing.T) {
+	relation := func(name string, term int64, serverID int64) types.PChannelInfoAssigned {
+		return types.PChannelInfoAssigned{
+			Channel: types.PChannelInfo{Name: name, Term: term, AccessMode: types.AccessModeRW},
+			Node:    types.StreamingNodeInfo{ServerID: serverID},
+		}
+	}
+	version := func(local int64) typeutil.VersionInt64Pair {
+		return typeutil.VersionInt64Pair{Global: 1, Local: local}
+	}
+
+	// All relations are added at the first diff.
+	d := newAssignmentDiffer()
+	diff := d.apply(version(1), []types.PChannelInfoAssigned{relation("ch2", 1, 1), relation("ch1", 1, 1)})
+	assert.Equal(t, typeutil.VersionInt64Pair{}, diff.PrevVersion)
+	assert.Equal(t, version(1), diff.Version)
+	assert.Equal(t, []types.PChannelInfoAssigned{relation("ch1", 1, 1), relation("ch2", 1, 1)}, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Updated)
+
+	// Nothing is changed.
+	diff = d.apply(version(2), []types.PChannelInfoAssigned{relation("ch1", 1, 1), relation("ch2", 1, 1)})
+	assert.Equal(t, version(1), diff.PrevVersion)
+	assert.True(t, diff.IsEmpty())
+
+	// ch1 is moved, ch2 is removed and ch3 is added.
+	diff = d.apply(version(3), []types.PChannelInfoAssigned{relation("ch1", 2, 2), relation("ch3", 1, 1)})
+	assert.Equal(t, version(2), diff.PrevVersion)
+	assert.Equal(t, []types.PChannelInfoAssigned{relation("ch3", 1, 1)}, diff.Added)
+	assert.Equal(t, []types.PChannelInfoAssigned{relation("ch2", 1, 1)}, diff.Removed)
+	assert.Equal(t, []types.PChannelInfoAssigned{relation("ch1", 2, 2)}, diff.Updated)
+
+	diff = d.apply(version(4), nil)
+	assert.Equal(t, []types.PChannelInfoAssigned{relation("ch1", 2, 2), relation("ch3", 1, 1)}, diff.Removed)
+	assert.False(t, diff.IsEmpty())
+}
@@ -0,0 +1,278 @@
+package channel
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// defaultAssignmentWatcherBufferSize is the default count of assignments that can be buffered by a watcher.
+const defaultAssignmentWatcherBufferSize = 16
+
+// OptWatcherBufferSize sets the count of pending assignments buffered by a named assignment watcher.
+// The oldest pending assignment is dropped if the buffer is full,
+// it's safe because every assignment is a full snapshot and supersedes the previous ones.
+func OptWatcherBufferSize(size int) WatchAssignmentOption {
+	return func(opt *watchAssignmentOptions) {
+		opt.bufferSize = size
+	}
+}
+
+// AssignmentWatcherInfo is the runtime info of a named assignment watcher.
+type AssignmentWatcherInfo struct {
+	ID               string
+	DeliveredVersion typeutil.VersionInt64Pair // the version of the last assignment delivered to the callback.
+	Delivered        uint64                    // the count of assignments delivered to the callback.
+	Dropped          uint64                    // the count of assignments dropped because the buffer is full.
+	Pending          int                       // the count of assignments waiting in the buffer.
+}
+
+// newAssignmentWatchers creates a new registry of named assignment watchers.
+func newAssignmentWatchers() *assignmentWatchers {
+	return &assignmentWatchers{
+		watchers: make(map[string]*AssignmentWatcher),
+	}
+}
+
+// assignmentWatchers is the registry of the named assignment watchers.
+type assignmentWatchers struct {
+	mu       sync.Mutex
+	watchers map[string]*AssignmentWatcher
+}
+
+// RegisterAssignmentWatcher registers a named assignment watcher and starts it in background.
+// Every watcher has its own goroutine and buffer, so a slow callback only delays itself and never blocks the other watchers.
+// The watcher is stopped and unregistered when the ctx is done, the watcher is closed, or the callback returns an error.
+// An error is returned if there's already a running watcher with the same id.
+func (cm *ChannelManager) RegisterAssignmentWatcher(ctx context.Context, id string, cb WatchChannelAssignmentsCallback, opts ...WatchAssignmentOption) (*AssignmentWatcher, error) {
+	if id == "" {
+		return nil, status.NewInvalidArgument("assignment watcher id is empty")
+	}
+	opt := &watchAssignmentOptions{bufferSize: defaultAssignmentWatcherBufferSize}
+	for _, o := range opts {
+		o(opt)
+	}
+	if opt.bufferSize <= 0 {
+		return nil, status.NewInvalidArgument("invalid buffer size %d of assignment watcher %s", opt.bufferSize, id)
+	}
+
+	cm.watchers.mu.Lock()
+	defer cm.watchers.mu.Unlock()
+	if _, ok := cm.watchers.watchers[id]; ok {
+		return nil, status.NewInvalidArgument("assignment watcher %s already registered", id)
+	}
+	w := &AssignmentWatcher{
+		id:         id,
+		cm:         cm,
+		cb:         cb,
+		bufferSize: opt.bufferSize,
+		notifier:   syncutil.NewAsyncTaskNotifier[error](),
+		signal:     make(chan struct{}, 1),
+	}
+	if opt.incrementalDiff {
+		w.differ = newAssignmentDiffer()
+	}
+	w.SetLogger(cm.Logger().With(mlog.FieldComponent("assignment-watcher"), mlog.String("watcher", id)))
+	cm.watchers.watchers[id] = w
+	go w.execute(ctx)
+	return w, nil
+}
+
+// ListAssignmentWatchers returns the info of all running named assignment watchers ordered by id.
+func (cm *ChannelManager) ListAssignmentWatchers() []AssignmentWatcherInfo {
+	cm.watchers.mu.Lock()
+	defer cm.watchers.mu.Unlock()
+
+	infos := make([]AssignmentWatcherInfo, 0, len(cm.watchers.watchers))
+	for _, w := range cm.watchers.watchers {
+		infos = append(infos, w.Info())
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// CloseAssignmentWatchers closes all running named assignment watchers.
+func (cm *ChannelManager) CloseAssignmentWatchers() {
+	cm.watchers.mu.Lock()
+	watchers := make([]*AssignmentWatcher, 0, len(cm.watchers.watchers))
+	for _, w := range cm.watchers.watchers {
+		watchers = append(watchers, w)
+	}
+	cm.watchers.mu.Unlock()
+
+	for _, w := range watchers {
+		w.Close()
+	}
+}
+
+// unregisterAssignmentWatcher removes the watcher from the registry.
+func (cm *ChannelManager) unregisterAssignmentWatcher(w *AssignmentWatcher) {
+	cm.watchers.mu.Lock()
+	defer cm.watchers.mu.Unlock()
+
+	if cm.watchers.watchers[w.id] == w {
+		delete(cm.watchers.watchers, w.id)
+	}
+}
+
+// AssignmentWatcher is a named watcher of the assignment changes with its own buffer.
+// The assignments are collected from the channel manager by a producer goroutine into the buffer,
+// and delivered to the callback by a consumer goroutine in order.
+type AssignmentWatcher struct {
+	mlog.Binder
+
+	id         string
+	cm         *ChannelManager
+	cb         WatchChannelAssignmentsCallback
+	differ     *assignmentDiffer // only set if the watcher is registered with OptIncrementalDiff.
+	bufferSize int
+	notifier   *syncutil.AsyncTaskNotifier[error]
+	signal     chan struct{} // notified when a new assignment is pushed into the buffer.
+
+	mu               sync.Mutex
+	pending          []WatchChannelAssignmentsCallbackParam
+	deliveredVersion typeutil.VersionInt64Pair
+	delivered        uint64
+	dropped          uint64
+}
+
+// ID returns the id of the watcher.
+func (w *AssignmentWatcher) ID() string {
+	return w.id
+}
+
+// Info returns the runtime info of the watcher.
+func (w *AssignmentWatcher) Info() AssignmentWatcherInfo {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return AssignmentWatcherInfo{
+		ID:               w.id,
+		DeliveredVersion: w.deliveredVersion,
+		Delivered:        w.delivered,
+		Dropped:          w.dropped,
+		Pending:          len(w.pending),
+	}
+}
+
+// Done returns a channel that is closed when the watcher is stopped.
+func (w *AssignmentWatcher) Done() <-chan struct{} {
+	return w.notifier.FinishChan()
+}
+
+// Err blocks until the watcher is stopped and returns the reason.
+// A context error is returned if the watcher is stopped by the context or Close.
+func (w *AssignmentWatcher) Err() error {
+	return w.notifier.BlockAndGetResult()
+}
+
+// Close stops the watcher and waits until the running callback returns.
+func (w *AssignmentWatcher) Close() {
+	w.notifier.Cancel()
+	w.notifier.BlockUntilFinish()
+}
+
+// execute runs the producer and consumer of the watcher until the watcher is stopped.
+func (w *AssignmentWatcher) execute(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(w.notifier.Context(), cancel)
+
+	produceErr := make(chan error, 1)
+	go func() {
+		produceErr <- w.produce(ctx)
+		// stop the consumer if the producer is stopped.
+		cancel()
+	}()
+	consumeErr := w.consume(ctx)
+	cancel()
+	err := <-produceErr
+	if consumeErr != nil && !errors.Is(consumeErr, context.Canceled) {
+		// the failure of callback is preferred to be returned.
+		err = consumeErr
+	}
+	stop()
+
+	w.cm.unregisterAssignmentWatcher(w)
+	w.Logger().Info(ctx, "assignment watcher stopped", mlog.Err(err))
+	w.notifier.Finish(err)
+}
+
+// produce collects the latest assignment into the buffer at every change.
+func (w *AssignmentWatcher) produce(ctx context.Context) error {
+	version, err := w.cm.applyAssignments(w.push)
+	if err != nil {
+		return err
+	}
+	for {
+		if err := w.cm.waitChanges(ctx, version); err != nil {
+			return err
+		}
+		if version, err = w.cm.applyAssignments(w.push); err != nil {
+			return err
+		}
+	}
+}
+
+// push pushes the assignment into the buffer, the oldest pending assignment is dropped if the buffer is full.
+func (w *AssignmentWatcher) push(param WatchChannelAssignmentsCallbackParam) error {
+	w.mu.Lock()
+	if len(w.pending) >= w.bufferSize {
+		w.pending = w.pending[1:]
+		w.dropped++
+	}
+	w.pending = append(w.pending, param)
+	w.mu.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// consume delivers the buffered assignments to the callback in order.
+func (w *AssignmentWatcher) consume(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.signal:
+		}
+		for {
+			param, ok := w.pop()
+			if !ok {
+				break
+			}
+			if w.differ != nil {
+				param.Diff = w.differ.apply(param.Version, param.Relations)
+			}
+			if err := w.cb(param); err != nil {
+				return err
+			}
+			w.mu.Lock()
+			w.deliveredVersion = param.Version
+			w.delivered++
+			w.mu.Unlock()
+		}
+	}
+}
+
+// pop pops the oldest pending assignment from the buffer.
+func (w *AssignmentWatcher) pop() (WatchChannelAssignmentsCallbackParam, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.pending) == 0 {
+		return WatchChannelAssignmentsCallbackParam{}, false
+	}
+	param := w.pending[0]
+	w.pending = w.pending[1:]
+	return param, true
+}
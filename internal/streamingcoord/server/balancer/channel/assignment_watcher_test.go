@@ -0,0 +1,112 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestAssignmentWatchers(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1")
+	assert.NoError(t, err)
+
+	// Invalid registrations.
+	_, err = m.RegisterAssignmentWatcher(ctx, "", func(param WatchChannelAssignmentsCallbackParam) error { return nil })
+	assert.Error(t, err)
+	_, err = m.RegisterAssignmentWatcher(ctx, "w", func(param WatchChannelAssignmentsCallbackParam) error { return nil }, OptWatcherBufferSize(0))
+	assert.Error(t, err)
+
+	// A blocked watcher doesn't block the other watchers.
+	unblock := make(chan struct{})
+	slow, err := m.RegisterAssignmentWatcher(ctx, "slow", func(param WatchChannelAssignmentsCallbackParam) error {
+		<-unblock
+		return nil
+	}, OptWatcherBufferSize(2))
+	assert.NoError(t, err)
+	received := make(chan WatchChannelAssignmentsCallbackParam, 100)
+	fast, err := m.RegisterAssignmentWatcher(ctx, "fast", func(param WatchChannelAssignmentsCallbackParam) error {
+		received <- param
+		return nil
+	}, OptIncrementalDiff())
+	assert.NoError(t, err)
+	_, err = m.RegisterAssignmentWatcher(ctx, "fast", func(param WatchChannelAssignmentsCallbackParam) error { return nil })
+	assert.Error(t, err)
+
+	param := <-received
+	assert.NotNil(t, param.Diff)
+	for i := 0; i < 5; i++ {
+		m.TriggerWatchUpdate()
+		param = <-received
+		assert.Equal(t, int64(i+1), param.Version.Local)
+		// wait until the slow watcher buffers the version, so no version is coalesced.
+		assert.Eventually(t, func() bool {
+			slow.mu.Lock()
+			defer slow.mu.Unlock()
+			return len(slow.pending) > 0 && slow.pending[len(slow.pending)-1].Version.Local == int64(i+1)
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	assert.Eventually(t, func() bool {
+		return fast.Info().Delivered == 6 && slow.Info().Dropped == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	infos := m.ListAssignmentWatchers()
+	assert.Len(t, infos, 2)
+	assert.Equal(t, "fast", infos[0].ID)
+	assert.Equal(t, int64(5), infos[0].DeliveredVersion.Local)
+	assert.Equal(t, "slow", infos[1].ID)
+	assert.Equal(t, 2, infos[1].Pending)
+
+	// The slow watcher catches up with the latest assignment after unblocked.
+	close(unblock)
+	assert.Eventually(t, func() bool {
+		return slow.Info().DeliveredVersion.Local == 5 && slow.Info().Pending == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The failure of callback stops the watcher and unregisters it.
+	failure := errors.New("callback failure")
+	failed, err := m.RegisterAssignmentWatcher(ctx, "failed", func(param WatchChannelAssignmentsCallbackParam) error {
+		return failure
+	})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, failed.Err(), failure)
+	assert.Len(t, m.ListAssignmentWatchers(), 2)
+
+	// The watcher can be stopped by the context or closed, then the id can be registered again.
+	wctx, cancel := context.WithCancel(ctx)
+	w, err := m.RegisterAssignmentWatcher(wctx, "ctx", func(param WatchChannelAssignmentsCallbackParam) error { return nil })
+	assert.NoError(t, err)
+	cancel()
+	assert.ErrorIs(t, w.Err(), context.Canceled)
+	fast.Close()
+	assert.ErrorIs(t, fast.Err(), context.Canceled)
+	fast, err = m.RegisterAssignmentWatcher(ctx, "fast", func(param WatchChannelAssignmentsCallbackParam) error { return nil })
+	assert.NoError(t, err)
+
+	m.CloseAssignmentWatchers()
+	<-fast.Done()
+	<-slow.Done()
+	assert.Empty(t, m.ListAssignmentWatchers())
+}
@@ -0,0 +1,55 @@
+package channel
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+// MoveControlChannel moves the control channel to another pchannel and returns the control channel meta after the move.
+// The caller should fence the control channel before moving, so no broadcast is in-flight at the old control channel.
+// The new pchannel and the handoff marker are persisted in one control channel meta, so the move is atomic,
+// and the assignment version is increased to notify the clients to use the new control channel.
+func (cm *ChannelManager) MoveControlChannel(ctx context.Context, pchannel string) (*streamingpb.CChannelMeta, error) {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	if pchannel == cm.cchannelMeta.GetPchannel() {
+		return proto.Clone(cm.cchannelMeta).(*streamingpb.CChannelMeta), nil
+	}
+	target, ok := cm.channels[ChannelID{Name: pchannel}]
+	if !ok {
+		return nil, status.NewInvalidArgument("pchannel %s not found", pchannel)
+	}
+	if !target.AvailableInReplication() {
+		return nil, status.NewInvalidArgument("pchannel %s is not available in replication", pchannel)
+	}
+	if cm.replicateConfig != nil && cm.replicateConfig.IsJoinReplication() {
+		return nil, status.NewInvalidArgument("control channel can not be moved when the cluster joins the replication")
+	}
+
+	newMeta := proto.Clone(cm.cchannelMeta).(*streamingpb.CChannelMeta)
+	newMeta.Pchannel = pchannel
+	newMeta.Handoffs = append(newMeta.Handoffs, &streamingpb.CChannelHandoffMarker{
+		FromPchannel:            cm.cchannelMeta.GetPchannel(),
+		ToPchannel:              pchannel,
+		HandoffTimestampSeconds: time.Now().Unix(),
+	})
+	if err := resource.Resource().StreamingCatalog().SaveCChannel(ctx, newMeta); err != nil {
+		cm.Logger().Warn(ctx, "failed to save control channel meta", mlog.Err(err))
+		return nil, err
+	}
+	cm.Logger().Info(ctx, "control channel is moved",
+		mlog.String("from", cm.cchannelMeta.GetPchannel()),
+		mlog.String("to", pchannel))
+	cm.cchannelMeta = newMeta
+	cm.version.Local++
+	cm.metrics.UpdateAssignmentVersion(cm.version.Local)
+	return proto.Clone(newMeta).(*streamingpb.CChannelMeta), nil
+}
@@ -0,0 +1,75 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+)
+
+func TestChannelManagerMoveControlChannel(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2")
+	assert.NoError(t, err)
+	latest, err := m.GetLatestChannelAssignment()
+	assert.NoError(t, err)
+	version := latest.Version
+
+	// move to the current control channel is a no-op.
+	meta, err := m.MoveControlChannel(ctx, "ch1")
+	assert.NoError(t, err)
+	assert.Equal(t, "ch1", meta.GetPchannel())
+	assert.Empty(t, meta.GetHandoffs())
+
+	_, err = m.MoveControlChannel(ctx, "non-exist")
+	assert.Error(t, err)
+
+	// the failure of catalog keeps the control channel.
+	catalog.EXPECT().SaveCChannel(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.MoveControlChannel(ctx, "ch2")
+	assert.Error(t, err)
+	latest, err = m.GetLatestChannelAssignment()
+	assert.NoError(t, err)
+	assert.Equal(t, "ch1", latest.CChannelAssignment.GetMeta().GetPchannel())
+	assert.True(t, version.EQ(latest.Version))
+
+	catalog.EXPECT().SaveCChannel(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, meta *streamingpb.CChannelMeta) error {
+		assert.Equal(t, "ch2", meta.GetPchannel())
+		assert.Len(t, meta.GetHandoffs(), 1)
+		return nil
+	})
+	meta, err = m.MoveControlChannel(ctx, "ch2")
+	assert.NoError(t, err)
+	assert.Equal(t, "ch2", meta.GetPchannel())
+	assert.Len(t, meta.GetHandoffs(), 1)
+	assert.Equal(t, "ch1", meta.GetHandoffs()[0].GetFromPchannel())
+	assert.Equal(t, "ch2", meta.GetHandoffs()[0].GetToPchannel())
+	assert.NotZero(t, meta.GetHandoffs()[0].GetHandoffTimestampSeconds())
+	assert.Equal(t, funcutil.GetControlChannel("ch2"), m.getClusterChannels().ControlChannel)
+	latest, err = m.GetLatestChannelAssignment()
+	assert.NoError(t, err)
+	assert.Equal(t, "ch2", latest.CChannelAssignment.GetMeta().GetPchannel())
+	assert.True(t, latest.Version.GT(version))
+}
@@ -0,0 +1,44 @@
+package channel
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// getDatabaseVChannelQuota returns the vchannel quota of the database on the shared pchannels, 0 means no limit.
+// The per-database override takes precedence over the default quota.
+func getDatabaseVChannelQuota(ctx context.Context, dbName string) int {
+	params := paramtable.Get().StreamingCfg
+	if override, ok := params.WALBalancerDatabaseVChannelQuotaOverrides.GetAsJSONMap()[dbName]; ok {
+		quota, err := strconv.Atoi(override)
+		if err == nil && quota >= 0 {
+			return quota
+		}
+		mlog.Warn(ctx, "invalid vchannel quota override of database, use the default quota", mlog.String("dbName", dbName), mlog.String("quota", override))
+	}
+	return params.WALBalancerDatabaseVChannelQuota.GetAsInt()
+}
+
+// checkDatabaseVChannelQuota checks if the vchannels to be allocated exceed the vchannel quota of the database, should be called with lock.
+// The database bound to a pchannel pool owns its pchannels exclusively, so it's not limited by the quota.
+func (cm *ChannelManager) checkDatabaseVChannelQuota(ctx context.Context, param AllocVChannelParam) error {
+	dbName := normalizeDBName(param.DBName)
+	if cm.isBoundToPool(dbName) {
+		return nil
+	}
+	quota := getDatabaseVChannelQuota(ctx, dbName)
+	if quota <= 0 {
+		return nil
+	}
+	used := StaticPChannelStatsManager.Get().DatabaseVChannelCount(dbName, func(id ChannelID) bool {
+		return cm.isAllocatableForDatabase(dbName, id.Name)
+	})
+	if used+param.Num > quota {
+		return merr.WrapErrServiceQuotaExceededMsg("vchannel quota of database %s is exceeded on shared pchannels, quota: %d, used: %d, requested: %d", dbName, quota, used, param.Num)
+	}
+	return nil
+}
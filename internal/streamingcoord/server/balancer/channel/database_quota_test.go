@@ -0,0 +1,84 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestDatabaseVChannelQuota(t *testing.T) {
+	paramtable.Init()
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return([]*streamingpb.PChannelPoolMeta{
+		{Name: "pool1", Pchannels: []string{"ch1"}, Databases: []string{"db2"}},
+	}, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetIDAllocator(mock.Anything, mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).Return(nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+
+	params := paramtable.Get()
+	params.Save(params.StreamingCfg.WALBalancerDatabaseVChannelQuota.Key, "3")
+	defer params.Reset(params.StreamingCfg.WALBalancerDatabaseVChannelQuota.Key)
+	params.Save(params.StreamingCfg.WALBalancerDatabaseVChannelQuotaOverrides.Key, `{"db3": "0", "db4": "invalid"}`)
+	defer params.Reset(params.StreamingCfg.WALBalancerDatabaseVChannelQuotaOverrides.Key)
+
+	// The vchannels of the database on the shared pchannels are counted.
+	stats := StaticPChannelStatsManager.Get()
+	stats.SetCollectionDatabase(1, "")
+	stats.AddVChannel("ch2_1v0", "ch3_1v1")
+	stats.SetCollectionDatabase(2, "db1")
+	stats.AddVChannel("ch2_2v2")
+	assert.Equal(t, 2, stats.DatabaseVChannelCount("default", func(id ChannelID) bool { return true }))
+
+	vchannels, err := m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 3, Num: 1})
+	assert.NoError(t, err)
+	stats.SetCollectionDatabase(3, "default")
+	stats.AddVChannel(vchannels...)
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 1})
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 2, DBName: "db1"})
+	assert.NoError(t, err)
+	stats.SetCollectionDatabase(5, "db1")
+	stats.AddVChannel("ch3_5v3")
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 2, DBName: "db1"})
+	assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 1, DBName: "db1"})
+	assert.NoError(t, err)
+
+	// The database bound to a pool is not limited, the override takes precedence over the default quota.
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 1, DBName: "db2"})
+	assert.NoError(t, err)
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 2, DBName: "db3"})
+	assert.NoError(t, err)
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 2, DBName: "db4"})
+	assert.NoError(t, err)
+
+	// The quota is released after the collection is dropped.
+	stats.RemoveVChannel("ch2_1v0", "ch3_1v1")
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 2})
+	assert.NoError(t, err)
+}
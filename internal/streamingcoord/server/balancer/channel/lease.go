@@ -0,0 +1,103 @@
+package channel
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// pchannelLease is the lease of a pchannel granted to the streaming node.
+// The lease is only kept in memory, a new lease with full ttl will be granted to
+// all assigned pchannels after the streamingcoord is recovered.
+type pchannelLease struct {
+	serverID int64
+	term     int64
+	expireAt time.Time
+}
+
+// isOwnedBy returns whether the lease is owned by the given assignment.
+func (l pchannelLease) isOwnedBy(serverID int64, term int64) bool {
+	return l.serverID == serverID && l.term == term
+}
+
+// RenewPChannelLeases acknowledges the assignment and renews the lease of the pchannels held by the streaming node.
+// The channels that are not owned by the streaming node (moved to other node or term fenced) are returned as revoked.
+func (cm *ChannelManager) RenewPChannelLeases(ctx context.Context, serverID int64, channels []types.PChannelInfo) []types.PChannelInfo {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	expireAt := time.Now().Add(paramtable.Get().StreamingCfg.WALBalancerLeaseTTL.GetAsDurationByParse())
+	revoked := make([]types.PChannelInfo, 0)
+	for _, channel := range channels {
+		if cm.isReadReplica(serverID, channel) {
+			// the read-only replica never writes, so no lease is required.
+			continue
+		}
+		meta, ok := cm.channels[channel.ChannelID()]
+		if !ok || !meta.IsAssignedOrAssigning() || meta.CurrentServerID() != serverID || meta.CurrentTerm() != channel.Term {
+			revoked = append(revoked, channel)
+			continue
+		}
+		if lease, ok := cm.leases[channel.ChannelID()]; !ok || !lease.isOwnedBy(serverID, channel.Term) {
+			cm.Logger().Info(ctx, "pchannel assignment acknowledged by streaming node",
+				mlog.Stringer("channel", channel), mlog.Int64("serverID", serverID))
+		}
+		cm.leases[channel.ChannelID()] = pchannelLease{
+			serverID: serverID,
+			term:     channel.Term,
+			expireAt: expireAt,
+		}
+	}
+	return revoked
+}
+
+// WaitUntilAssignmentAcked waits until the assignment of the pchannel at the given term is acknowledged by the streaming node.
+func (cm *ChannelManager) WaitUntilAssignmentAcked(ctx context.Context, assignment types.PChannelInfoAssigned) error {
+	cm.cond.L.Lock()
+	for {
+		if lease, ok := cm.leases[assignment.Channel.ChannelID()]; ok && lease.isOwnedBy(assignment.Node.ServerID, assignment.Channel.Term) {
+			break
+		}
+		if err := cm.cond.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	cm.cond.L.Unlock()
+	return nil
+}
+
+// ExpiredLeases returns the assigned pchannels whose lease is expired.
+// The assigned pchannel without any lease (e.g. the streamingcoord is just recovered) will be granted a new lease with full ttl.
+func (cm *ChannelManager) ExpiredLeases() []types.PChannelInfo {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	now := time.Now()
+	expired := make([]types.PChannelInfo, 0)
+	for id, meta := range cm.channels {
+		if !meta.IsAssignedOrAssigning() {
+			delete(cm.leases, id)
+			continue
+		}
+		if !meta.IsAssigned() {
+			// the assigning pchannel is waiting for the ack of streaming node.
+			continue
+		}
+		lease, ok := cm.leases[id]
+		if !ok || !lease.isOwnedBy(meta.CurrentServerID(), meta.CurrentTerm()) {
+			cm.leases[id] = pchannelLease{
+				serverID: meta.CurrentServerID(),
+				term:     meta.CurrentTerm(),
+				expireAt: now.Add(paramtable.Get().StreamingCfg.WALBalancerLeaseTTL.GetAsDurationByParse()),
+			}
+			continue
+		}
+		if lease.expireAt.Before(now) {
+			expired = append(expired, meta.ChannelInfo())
+		}
+	}
+	return expired
+}
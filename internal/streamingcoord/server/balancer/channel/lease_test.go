@@ -0,0 +1,85 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestChannelManagerLease(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "test-channel"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		{
+			Channel: &streamingpb.PChannelInfo{Name: "test-channel", Term: 1},
+			Node:    &streamingpb.StreamingNodeInfo{ServerId: 1},
+			State:   streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNED,
+		},
+	}, nil)
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx)
+	assert.NoError(t, err)
+
+	// The recovered assigned pchannel is granted a new lease.
+	assert.Empty(t, m.ExpiredLeases())
+
+	// Renew by a stale node or a stale term is revoked.
+	revoked := m.RenewPChannelLeases(ctx, 2, []types.PChannelInfo{{Name: "test-channel", Term: 1}})
+	assert.Len(t, revoked, 1)
+	revoked = m.RenewPChannelLeases(ctx, 1, []types.PChannelInfo{{Name: "test-channel", Term: 0}, {Name: "non-exist", Term: 1}})
+	assert.Len(t, revoked, 2)
+
+	// Assign the pchannel to a new node, the assignment is acked by the renewal of the new node.
+	modified, err := m.AssignPChannels(ctx, map[ChannelID]types.PChannelInfoAssigned{newChannelID("test-channel"): {
+		Channel: types.PChannelInfo{Name: "test-channel", Term: 1, AccessMode: types.AccessModeRW},
+		Node:    types.StreamingNodeInfo{ServerID: 2},
+	}})
+	assert.NoError(t, err)
+	assignment := modified[newChannelID("test-channel")].CurrentAssignment()
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, m.WaitUntilAssignmentAcked(ctxWithTimeout, assignment), context.DeadlineExceeded)
+
+	go func() {
+		m.RenewPChannelLeases(ctx, 2, []types.PChannelInfo{assignment.Channel})
+	}()
+	assert.NoError(t, m.WaitUntilAssignmentAcked(ctx, assignment))
+	assert.NoError(t, m.AssignPChannelsDone(ctx, []ChannelID{newChannelID("test-channel")}))
+	assert.Empty(t, m.ExpiredLeases())
+
+	// The lease is expired if it's not renewed in time.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALBalancerLeaseTTL.Key, "1ms")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALBalancerLeaseTTL.Key)
+	revoked = m.RenewPChannelLeases(ctx, 2, []types.PChannelInfo{assignment.Channel})
+	assert.Empty(t, revoked)
+	time.Sleep(5 * time.Millisecond)
+	expired := m.ExpiredLeases()
+	assert.Len(t, expired, 1)
+	assert.Equal(t, assignment.Channel.Term, expired[0].Term)
+
+	assert.NoError(t, m.MarkAsUnavailable(ctx, expired))
+	assert.Empty(t, m.ExpiredLeases())
+}
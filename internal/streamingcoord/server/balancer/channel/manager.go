@@ -4,15 +4,18 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/idalloc"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -29,21 +32,38 @@ const (
 	StreamingVersion300 = 3 // streaming version that since 3.0.0, schema-drop DDL is available.
 )
 
+// vchannelSuffixAllocatorName is the name of id allocator that generates the suffix of vchannel name.
+const vchannelSuffixAllocatorName = "vchannel-suffix"
+
 var ErrChannelNotExist = errors.New("channel not exist")
 
 type (
 	AllocVChannelParam struct {
 		CollectionID int64
 		Num          int
+		DBName       string                 // the database of the collection, used to select the pchannels from the bound pchannel pool.
+		Hints        VChannelPlacementHints // the hints of pchannel selection.
+	}
+
+	ReallocVChannelParam struct {
+		CollectionID int64
+		DBName       string   // the database of the collection, used to select the pchannels from the bound pchannel pool.
+		VChannels    []string // the current vchannels of the collection ordered by shard index.
+		Num          int      // the expected shard number of the collection.
 	}
 
 	WatchChannelAssignmentsCallbackParam struct {
-		StreamingVersion       *streamingpb.StreamingVersion
-		Version                typeutil.VersionInt64Pair
-		CChannelAssignment     *streamingpb.CChannelAssignment
-		PChannelView           *PChannelView
-		Relations              []types.PChannelInfoAssigned
-		ReplicateConfiguration *commonpb.ReplicateConfiguration
+		StreamingVersion        *streamingpb.StreamingVersion
+		Version                 typeutil.VersionInt64Pair
+		CChannelAssignment      *streamingpb.CChannelAssignment
+		PChannelView            *PChannelView
+		Relations               []types.PChannelInfoAssigned
+		ReadReplicas            []types.PChannelInfoAssigned
+		ReplicateConfiguration  *commonpb.ReplicateConfiguration
+		RBACReplicationDisabled bool                                             // the users, roles and privileges are not replicated into the secondary clusters.
+		CollectionFilters       map[string]*messagespb.ReplicateCollectionFilter // the collection filters of the target clusters, keyed by the target cluster id.
+		TargetClusterHealth     []*streamingpb.ReplicateTargetClusterHealth      // the reachability of the target clusters, the change of it doesn't change the version.
+		Diff                    *AssignmentDiff                                  // only set if the watcher is started with OptIncrementalDiff.
 	}
 	WatchChannelAssignmentsCallback func(param WatchChannelAssignmentsCallbackParam) error
 )
@@ -60,7 +80,7 @@ func RecoverChannelManager(ctx context.Context, incomingChannel ...string) (*Cha
 	if err != nil {
 		return nil, err
 	}
-	replicateConfig, err := recoverReplicateConfiguration(ctx)
+	replicateConfig, replicateConfigMeta, err := recoverReplicateConfiguration(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -68,21 +88,40 @@ func RecoverChannelManager(ctx context.Context, incomingChannel ...string) (*Cha
 	if err != nil {
 		return nil, err
 	}
+	pools, err := recoverPChannelPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	antiAffinityGroups, err := recoverPChannelAntiAffinityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	globalVersion := resource.Resource().Session().GetRegisteredRevision()
 	cm := &ChannelManager{
-		cond:     syncutil.NewContextCond(&sync.Mutex{}),
-		channels: channels,
+		cond:         syncutil.NewContextCond(&sync.Mutex{}),
+		channels:     channels,
+		leases:       make(map[ChannelID]pchannelLease),
+		readReplicas: make(map[ChannelID][]types.PChannelInfoAssigned),
+		pools:        pools,
+		antiAffinity: antiAffinityGroups,
+		suffixAlloc:  idalloc.NewAllocator(vchannelSuffixAllocatorName),
 		version: typeutil.VersionInt64Pair{
 			Global: globalVersion, // global version should be keep increasing globally, use revision of session to promise it.
 			Local:  0,
 		},
-		metrics:          metrics,
-		cchannelMeta:     cchannelMeta,
-		streamingVersion: streamingVersion,
-		replicateConfig:  replicateConfig,
+		metrics:                 metrics,
+		cchannelMeta:            cchannelMeta,
+		streamingVersion:        streamingVersion,
+		replicateConfig:         replicateConfig,
+		replicateConfigVersion:  replicateConfigMeta.GetVersion(),
+		rbacReplicationDisabled: replicateConfigMeta.GetRbacReplicationDisabled(),
+		collectionFilters:       replicateConfigMeta.GetCollectionFilters(),
+		watchers:                newAssignmentWatchers(),
 	}
 
+	cm.metrics.UpdatePChannelStateTotal(cm.channels)
+
 	// Register the channel manager singleton after recovery.
 	register(cm)
 
@@ -172,15 +211,16 @@ func recoverFromConfigurationAndMeta(ctx context.Context, streamingVersion *stre
 	return channels, metrics, nil
 }
 
-func recoverReplicateConfiguration(ctx context.Context) (*replicateutil.ConfigHelper, error) {
+// recoverReplicateConfiguration recovers the replicate configuration and its meta.
+func recoverReplicateConfiguration(ctx context.Context) (*replicateutil.ConfigHelper, *streamingpb.ReplicateConfigurationMeta, error) {
 	config, err := resource.Resource().StreamingCatalog().GetReplicateConfiguration(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	return replicateutil.MustNewConfigHelper(
 		paramtable.Get().CommonCfg.ClusterPrefix.GetValue(),
 		config.GetReplicateConfiguration(),
-	), nil
+	), config, nil
 }
 
 // isChannelAvailableInReplication returns whether a channel is available for replication.
@@ -209,14 +249,26 @@ type ChannelManager struct {
 
 	cond             *syncutil.ContextCond
 	channels         map[ChannelID]*PChannelMeta
+	leases           map[ChannelID]pchannelLease                           // leases of the pchannels granted to the streaming node, only used if lease is enabled.
+	readReplicas     map[ChannelID][]types.PChannelInfoAssigned            // the read-only replicas of the pchannels, only kept in memory.
+	pools            map[string]*streamingpb.PChannelPoolMeta              // the pchannel pools bound to databases, keyed by pool name.
+	antiAffinity     map[string]*streamingpb.PChannelAntiAffinityGroupMeta // the pchannel anti-affinity groups, keyed by group name.
+	suffixAlloc      *idalloc.Allocator                                    // allocates the globally unique suffix of vchannel name.
+	throttle         reassignThrottle                                      // bounds the reassignment churn of the serving pchannels.
 	version          typeutil.VersionInt64Pair
 	metrics          *channelMetrics
 	cchannelMeta     *streamingpb.CChannelMeta
 	streamingVersion *streamingpb.StreamingVersion // used to identify the current streaming service version.
 	// null if no streaming service has been run.
 	// 1 if streaming service has been run once.
-	streamingEnableNotifiers []*syncutil.AsyncTaskNotifier[struct{}]
-	replicateConfig          *replicateutil.ConfigHelper
+	streamingEnableNotifiers  []*syncutil.AsyncTaskNotifier[struct{}]
+	streamingDisableNotifiers []*syncutil.AsyncTaskNotifier[struct{}]
+	replicateConfig           *replicateutil.ConfigHelper
+	replicateConfigVersion    int64                                            // the version of the replicate configuration, increased by one at every accepted change.
+	rbacReplicationDisabled   bool                                             // the users, roles and privileges are not replicated into the secondary clusters.
+	collectionFilters         map[string]*messagespb.ReplicateCollectionFilter // the collection filters of the target clusters, keyed by the target cluster id.
+	targetClusterHealth       []*streamingpb.ReplicateTargetClusterHealth      // the reachability of the target clusters probed by the balancer.
+	watchers                  *assignmentWatchers                              // the named assignment watchers.
 }
 
 // RegisterStreamingEnabledNotifier registers a notifier into the balancer.
@@ -232,6 +284,15 @@ func (cm *ChannelManager) RegisterStreamingEnabledNotifier(notifier *syncutil.As
 	cm.streamingEnableNotifiers = append(cm.streamingEnableNotifiers, notifier)
 }
 
+// RegisterStreamingDisabledNotifier registers a notifier that is notified when the streaming service is disabled.
+// The notifier is notified at most once, and the disabling is blocked until the listener of notifier is finished.
+func (cm *ChannelManager) RegisterStreamingDisabledNotifier(notifier *syncutil.AsyncTaskNotifier[struct{}]) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	cm.streamingDisableNotifiers = append(cm.streamingDisableNotifiers, notifier)
+}
+
 // IsStreamingEnabledOnce returns true if streaming is enabled once.
 func (cm *ChannelManager) IsStreamingEnabledOnce() bool {
 	cm.cond.L.Lock()
@@ -310,6 +371,7 @@ func (cm *ChannelManager) AddPChannels(ctx context.Context, newChannels []string
 		return err
 	}
 
+	cm.metrics.UpdatePChannelStateTotal(cm.channels)
 	cm.Logger().Info(ctx, "dynamically added new pchannels",
 		mlog.Int("count", len(newMetas)),
 		mlog.Strings("channels", newChannels))
@@ -376,6 +438,60 @@ func (cm *ChannelManager) MarkStreamingVersion(ctx context.Context, version int6
 	return nil
 }
 
+// DisableStreaming rolls back the streaming service to the legacy path by clearing the streaming version.
+// It's only allowed if there's no state that can only be served by the streaming based WAL:
+// the streaming version is not upgraded beyond 2.6.0 (no WAL based DDL is persisted),
+// the cluster doesn't join any replication, and no pchannel is being assigned.
+// After disabling, all pchannels are reassigned as read-only by the balancer, and the streaming service is enabled again
+// by the balancer when it's restarted and all nodes are upgraded.
+// The registered disabled notifiers are notified, such as the ddl timetick of rootcoord, which is resumed for the legacy path.
+// The other running components choose the legacy path at startup by the streaming version, so they should be restarted.
+func (cm *ChannelManager) DisableStreaming(ctx context.Context) error {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	if cm.streamingVersion == nil {
+		return nil
+	}
+	if err := cm.checkNoWALOnlyState(); err != nil {
+		return err
+	}
+
+	if err := resource.Resource().StreamingCatalog().RemoveVersion(ctx); err != nil {
+		cm.Logger().Error(ctx, "failed to remove streaming version", mlog.Err(err))
+		return err
+	}
+	cm.streamingVersion = nil
+
+	// notify all notifiers that the streaming service has been disabled.
+	for _, notifier := range cm.streamingDisableNotifiers {
+		notifier.Cancel()
+	}
+	// and block until the listener of notifiers are finished.
+	for _, notifier := range cm.streamingDisableNotifiers {
+		notifier.BlockUntilFinish()
+	}
+	cm.streamingDisableNotifiers = nil
+	cm.Logger().Info(ctx, "streaming service is disabled, rollback to the legacy path")
+	return nil
+}
+
+// checkNoWALOnlyState checks if there's any state that can only be served by the streaming based WAL, should be called with lock.
+func (cm *ChannelManager) checkNoWALOnlyState() error {
+	if cm.streamingVersion.Version > StreamingVersion260 {
+		return status.NewInvalidArgument("streaming version %d is persisted, the WAL based DDL can not be rolled back", cm.streamingVersion.Version)
+	}
+	if cm.replicateConfig != nil && cm.replicateConfig.IsJoinReplication() {
+		return status.NewInvalidArgument("the cluster joins the replication, which can not be served without streaming")
+	}
+	for _, channel := range cm.channels {
+		if channel.State() == streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNING {
+			return status.NewInvalidArgument("pchannel %s is being assigned, retry after the assignment is done", channel.Name())
+		}
+	}
+	return nil
+}
+
 // CurrentPChannelsView returns the current view of pchannels.
 func (cm *ChannelManager) CurrentPChannelsView() *PChannelView {
 	cm.cond.L.Lock()
@@ -390,21 +506,81 @@ func (cm *ChannelManager) CurrentPChannelsView() *PChannelView {
 
 // AllocVirtualChannels allocates virtual channels for a collection.
 // Only channels that are available in replication are considered.
+// If the database is bound to a pchannel pool, only the pchannels of the pool are considered,
+// otherwise the pchannels that are not in any pool are considered,
+// and the vchannels of the database on these shared pchannels are limited by the database vchannel quota.
+// The considered pchannels are selected by the vchannel count in ascending order, adjusted by the placement hints.
 func (cm *ChannelManager) AllocVirtualChannels(ctx context.Context, param AllocVChannelParam) ([]string, error) {
 	cm.cond.L.Lock()
 	defer cm.cond.L.Unlock()
 
-	availableChannels := cm.sortAvailableChannelsByVChannelCount()
+	availableChannels := cm.applyPlacementHints(lo.Filter(cm.sortAvailableChannelsByVChannelCount(), func(channel withVChannelCount, _ int) bool {
+		return cm.isAllocatableForDatabase(param.DBName, channel.id.Name)
+	}), param.Hints)
 	if len(availableChannels) < param.Num {
-		return nil, status.NewInner("not enough pchannels to allocate, expected: %d, got: %d", param.Num, len(availableChannels))
+		return nil, status.NewInner("not enough pchannels to allocate for database %s, expected: %d, got: %d", param.DBName, param.Num, len(availableChannels))
+	}
+	if err := cm.checkDatabaseVChannelQuota(ctx, param); err != nil {
+		return nil, err
 	}
 
+	// the suffix is allocated from the persistent allocator,
+	// so the vchannel name keeps unique and monotonic even if the streamingcoord fails over.
+	start, err := cm.suffixAlloc.Allocate(ctx, param.Num)
+	if err != nil {
+		return nil, err
+	}
 	vchannels := make([]string, 0, param.Num)
 	for _, channel := range availableChannels {
 		if len(vchannels) >= param.Num {
 			break
 		}
-		vchannels = append(vchannels, funcutil.GetVirtualChannel(channel.id.Name, param.CollectionID, len(vchannels)))
+		vchannels = append(vchannels, funcutil.GetVirtualChannel(channel.id.Name, param.CollectionID, int(start)+len(vchannels)))
+	}
+	return vchannels, nil
+}
+
+// ReallocVirtualChannels expands or shrinks the vchannels of an existing collection to the expected shard number.
+// The current vchannels are always kept in order, so the shrinking drops the vchannels with the largest shard index,
+// and the expanding appends the new vchannels allocated by the same rules of AllocVirtualChannels.
+// The pchannels that are already used by the collection are not used by the new vchannels.
+// The vchannel count of the pchannels is updated by the caller when the new vchannels are applied to the collection.
+func (cm *ChannelManager) ReallocVirtualChannels(ctx context.Context, param ReallocVChannelParam) ([]string, error) {
+	if param.Num <= 0 {
+		return nil, status.NewInvalidArgument("invalid shard number %d of collection %d", param.Num, param.CollectionID)
+	}
+	usedPChannels := typeutil.NewSet[string]()
+	for _, vchannel := range param.VChannels {
+		if funcutil.GetCollectionIDFromVChannel(vchannel) != param.CollectionID {
+			return nil, status.NewInvalidArgument("vchannel %s is not belong to collection %d", vchannel, param.CollectionID)
+		}
+		usedPChannels.Insert(funcutil.ToPhysicalChannel(vchannel))
+	}
+	if param.Num <= len(param.VChannels) {
+		return append([]string{}, param.VChannels[:param.Num]...), nil
+	}
+
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	num := param.Num - len(param.VChannels)
+	availableChannels := lo.Filter(cm.sortAvailableChannelsByVChannelCount(), func(channel withVChannelCount, _ int) bool {
+		return !usedPChannels.Contain(channel.id.Name) && cm.isAllocatableForDatabase(param.DBName, channel.id.Name)
+	})
+	if len(availableChannels) < num {
+		return nil, status.NewInner("not enough pchannels to reallocate for collection %d, expected: %d, got: %d", param.CollectionID, num, len(availableChannels))
+	}
+	if err := cm.checkDatabaseVChannelQuota(ctx, AllocVChannelParam{CollectionID: param.CollectionID, Num: num, DBName: param.DBName}); err != nil {
+		return nil, err
+	}
+
+	start, err := cm.suffixAlloc.Allocate(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	vchannels := append(make([]string, 0, param.Num), param.VChannels...)
+	for i := 0; i < num; i++ {
+		vchannels = append(vchannels, funcutil.GetVirtualChannel(availableChannels[i].id.Name, param.CollectionID, int(start)+i))
 	}
 	return vchannels, nil
 }
@@ -442,11 +618,20 @@ func (cm *ChannelManager) sortAvailableChannelsByVChannelCount() []withVChannelC
 // When the balancer want to assign a pchannel into a new server.
 // It should always call this function to update the pchannel assignment first.
 // Otherwise, the pchannel assignment tracing is lost at meta.
-func (cm *ChannelManager) AssignPChannels(ctx context.Context, pChannelToStreamingNode map[ChannelID]types.PChannelInfoAssigned) (map[ChannelID]*PChannelMeta, error) {
+func (cm *ChannelManager) AssignPChannels(ctx context.Context, pChannelToStreamingNode map[ChannelID]types.PChannelInfoAssigned, opts ...AssignPChannelsOption) (map[ChannelID]*PChannelMeta, error) {
+	o := &assignPChannelsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	cm.cond.LockAndBroadcast()
 	defer cm.cond.L.Unlock()
 
 	// modified channels.
+	now := time.Now()
+	quota := cm.throttle.quota(now)
+	reassigned := 0
+	throttled := make([]string, 0)
 	pChannelMetas := make([]*streamingpb.PChannelMeta, 0, len(pChannelToStreamingNode))
 	for id, assign := range pChannelToStreamingNode {
 		pchannel, ok := cm.channels[id]
@@ -454,20 +639,36 @@ func (cm *ChannelManager) AssignPChannels(ctx context.Context, pChannelToStreami
 			return nil, ErrChannelNotExist
 		}
 		mutablePchannel := pchannel.CopyForWrite()
-		if mutablePchannel.TryAssignToServerID(assign.Channel.AccessMode, assign.Node) {
-			pChannelMetas = append(pChannelMetas, mutablePchannel.IntoRawMeta())
+		if !mutablePchannel.TryAssignToServerID(assign.Channel.AccessMode, assign.Node) {
+			continue
 		}
+		// The reassignment of serving pchannel is throttled to bound the term churn,
+		// the throttled pchannel will be reassigned at the next balance round.
+		// The reassignment requested by operator is never throttled.
+		if !o.bypassThrottle.Contain(id) && cm.throttle.isThrottled(pchannel, quota-reassigned, now) {
+			throttled = append(throttled, id.Name)
+			continue
+		}
+		if pchannel.IsAssigned() {
+			reassigned++
+		}
+		pChannelMetas = append(pChannelMetas, mutablePchannel.IntoRawMeta())
+	}
+	if len(throttled) > 0 {
+		cm.Logger().Info(ctx, "reassignment of pchannels is throttled", mlog.Strings("pchannels", throttled), mlog.Int("reassigned", reassigned))
 	}
 
 	err := cm.updatePChannelMeta(ctx, pChannelMetas)
 	if err != nil {
 		return nil, err
 	}
+	cm.throttle.consume(reassigned, now)
 	updates := make(map[ChannelID]*PChannelMeta, len(pChannelMetas))
 	for _, pchannel := range pChannelMetas {
 		meta := newPChannelMetaFromProto(pchannel, cm.replicateConfig)
 		updates[meta.ChannelID()] = meta
 		cm.metrics.AssignPChannelStatus(meta)
+		cm.metrics.ObserveAssigning(meta)
 	}
 	return updates, nil
 }
@@ -498,7 +699,9 @@ func (cm *ChannelManager) AssignPChannelsDone(ctx context.Context, pChannels []C
 
 	// Update metrics.
 	for _, pchannel := range pChannelMetas {
-		cm.metrics.AssignPChannelStatus(newPChannelMetaFromProto(pchannel, cm.replicateConfig))
+		meta := newPChannelMetaFromProto(pchannel, cm.replicateConfig)
+		cm.metrics.AssignPChannelStatus(meta)
+		cm.metrics.ObserveAssigned(meta)
 	}
 	return nil
 }
@@ -524,7 +727,9 @@ func (cm *ChannelManager) MarkAsUnavailable(ctx context.Context, pChannels []typ
 		return err
 	}
 	for _, pchannel := range pChannelMetas {
-		cm.metrics.AssignPChannelStatus(newPChannelMetaFromProto(pchannel, cm.replicateConfig))
+		meta := newPChannelMetaFromProto(pchannel, cm.replicateConfig)
+		cm.metrics.AssignPChannelStatus(meta)
+		cm.metrics.ObserveUnavailable(meta)
 	}
 	return nil
 }
@@ -548,6 +753,7 @@ func (cm *ChannelManager) updatePChannelMeta(ctx context.Context, pChannelMetas
 	cm.version.Local++
 	// update metrics.
 	cm.metrics.UpdateAssignmentVersion(cm.version.Local)
+	cm.metrics.UpdatePChannelStateTotal(cm.channels)
 	return nil
 }
 
@@ -556,6 +762,26 @@ func (cm *ChannelManager) GetLatestWALLocated(ctx context.Context, pchannel stri
 	cm.cond.L.Lock()
 	defer cm.cond.L.Unlock()
 
+	return cm.getLatestWALLocated(pchannel)
+}
+
+// GetLatestWALLocatedBatch returns the server ids of the nodes that the wals of the pchannels are located, keyed by pchannel.
+// All pchannels are resolved in one lock acquisition, the pchannel that is not found or not assigned is absent in the result.
+func (cm *ChannelManager) GetLatestWALLocatedBatch(ctx context.Context, pchannels []string) map[string]int64 {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	located := make(map[string]int64, len(pchannels))
+	for _, pchannel := range pchannels {
+		if serverID, ok := cm.getLatestWALLocated(pchannel); ok {
+			located[pchannel] = serverID
+		}
+	}
+	return located
+}
+
+// getLatestWALLocated returns the server id of the node that the wal of the pchannel is located, the lock should be held.
+func (cm *ChannelManager) getLatestWALLocated(pchannel string) (int64, bool) {
 	pChannelMeta, ok := cm.channels[types.ChannelID{Name: pchannel}]
 	if !ok {
 		return 0, false
@@ -566,6 +792,49 @@ func (cm *ChannelManager) GetLatestWALLocated(ctx context.Context, pchannel stri
 	return 0, false
 }
 
+// ListPChannelsOnServer returns the pchannels that the wal may be still located on the server, ordered by name.
+// The pchannel that is assigning away from the server is included until the assignment is done,
+// because the wal is removed from the old server before the assignment is done.
+func (cm *ChannelManager) ListPChannelsOnServer(serverID int64) []string {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	pchannels := make([]string, 0)
+	for _, pchannel := range cm.channels {
+		if !pchannel.IsAssignedOrAssigning() {
+			continue
+		}
+		located := pchannel.CurrentServerID() == serverID
+		for _, history := range pchannel.AssignHistories() {
+			located = located || history.Node.ServerID == serverID
+		}
+		if located {
+			pchannels = append(pchannels, pchannel.Name())
+		}
+	}
+	sort.Strings(pchannels)
+	return pchannels
+}
+
+// GetOwnershipHistories returns the finished assignments of the pchannel ordered by term.
+// Only the assignment at the given term is returned if the term is not zero.
+func (cm *ChannelManager) GetOwnershipHistories(pchannel string, term int64) ([]*streamingpb.PChannelAssignmentLog, error) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	channel, ok := cm.channels[types.ChannelID{Name: pchannel}]
+	if !ok {
+		return nil, ErrChannelNotExist
+	}
+	histories := channel.OwnershipHistories()
+	if term == 0 {
+		return histories, nil
+	}
+	return lo.Filter(histories, func(h *streamingpb.PChannelAssignmentLog, _ int) bool {
+		return h.GetTerm() == term
+	}), nil
+}
+
 // GetLatestChannelAssignment returns the latest channel assignment.
 func (cm *ChannelManager) GetLatestChannelAssignment() (*WatchChannelAssignmentsCallbackParam, error) {
 	var result WatchChannelAssignmentsCallbackParam
@@ -578,7 +847,22 @@ func (cm *ChannelManager) GetLatestChannelAssignment() (*WatchChannelAssignments
 	return &result, nil
 }
 
-func (cm *ChannelManager) WatchAssignmentResult(ctx context.Context, cb WatchChannelAssignmentsCallback) error {
+// WatchAssignmentResult watches the assignment result and calls the callback with the latest assignment at every change.
+// If OptIncrementalDiff is given, the relation changes since last callback are also delivered in the Diff of callback param.
+func (cm *ChannelManager) WatchAssignmentResult(ctx context.Context, cb WatchChannelAssignmentsCallback, opts ...WatchAssignmentOption) error {
+	opt := &watchAssignmentOptions{}
+	for _, o := range opts {
+		o(opt)
+	}
+	if opt.incrementalDiff {
+		differ := newAssignmentDiffer()
+		fullCallback := cb
+		cb = func(param WatchChannelAssignmentsCallbackParam) error {
+			param.Diff = differ.apply(param.Version, param.Relations)
+			return fullCallback(param)
+		}
+	}
+
 	// push the first balance result to watcher callback function if balance result is ready.
 	version, err := cm.applyAssignments(cb)
 	if err != nil {
@@ -602,7 +886,10 @@ func (cm *ChannelManager) UpdateReplicateConfiguration(ctx context.Context, resu
 	cm.cond.L.Lock()
 	defer cm.cond.L.Unlock()
 
-	if cm.replicateConfig != nil && proto.Equal(config.GetReplicateConfiguration(), cm.replicateConfig.GetReplicateConfiguration()) {
+	rbacReplicationDisabled := msg.Header().GetRbacReplicationDisabled()
+	collectionFilters := msg.Header().GetCollectionFilters()
+	if cm.replicateConfig != nil && proto.Equal(config.GetReplicateConfiguration(), cm.replicateConfig.GetReplicateConfiguration()) &&
+		rbacReplicationDisabled == cm.rbacReplicationDisabled && replicateutil.CollectionFiltersEqual(collectionFilters, cm.collectionFilters) {
 		// check if the replicate configuration is changed.
 		// if not changed, return it directly.
 		return nil
@@ -611,17 +898,28 @@ func (cm *ChannelManager) UpdateReplicateConfiguration(ctx context.Context, resu
 	appendResults := lo.MapKeys(result.Results, func(_ *message.AppendResult, key string) string {
 		return funcutil.ToPhysicalChannel(key)
 	})
-	newIncomingCDCTasks := cm.getNewIncomingTask(config, appendResults)
+	newIncomingCDCTasks := cm.getNewIncomingTask(config, collectionFilters, appendResults)
+	// The replicating tasks of the target clusters whose collection filter is changed are updated with the new filter,
+	// the CDC restarts the replication of the updated task with the new filter.
+	filterUpdatedCDCTasks, err := cm.getCollectionFilterUpdatedTasks(ctx, config, collectionFilters)
+	if err != nil {
+		return err
+	}
+	newIncomingCDCTasks = append(newIncomingCDCTasks, filterUpdatedCDCTasks...)
 
 	// Check if this is a force promote based on message header
 	isForcePromote := msg.Header().ForcePromote
 
+	version := cm.replicateConfigVersion + 1
 	var configMeta *streamingpb.ReplicateConfigurationMeta
 	if isForcePromote {
 		// For force promotes, mark the config with force flags
 		configMeta = &streamingpb.ReplicateConfigurationMeta{
-			ReplicateConfiguration: config.GetReplicateConfiguration(),
-			ForcePromoted:          true,
+			ReplicateConfiguration:  config.GetReplicateConfiguration(),
+			ForcePromoted:           true,
+			Version:                 version,
+			RbacReplicationDisabled: rbacReplicationDisabled,
+			CollectionFilters:       collectionFilters,
 		}
 		cm.Logger().Info(ctx, "Applying force promote to replicate configuration",
 			replicateutil.ConfigLogField(config.GetReplicateConfiguration()),
@@ -629,19 +927,41 @@ func (cm *ChannelManager) UpdateReplicateConfiguration(ctx context.Context, resu
 	} else {
 		// For normal replicate configuration updates, don't set force flags
 		configMeta = &streamingpb.ReplicateConfigurationMeta{
-			ReplicateConfiguration: config.GetReplicateConfiguration(),
-			ForcePromoted:          false,
+			ReplicateConfiguration:  config.GetReplicateConfiguration(),
+			ForcePromoted:           false,
+			Version:                 version,
+			RbacReplicationDisabled: rbacReplicationDisabled,
+			CollectionFilters:       collectionFilters,
+		}
+	}
+	history := newReplicateConfigurationHistory(configMeta, result)
+
+	// The replicating tasks of the removed replication edges are archived before the configuration is saved,
+	// the tasks are removed by the CDC after the change is confirmed by the target cluster, or garbage-collected after timeout.
+	// Archiving an edge that is not removed finally is harmless, the garbage collector never removes the task of an existing edge.
+	if removedCDCTasks := cm.getRemovedReplicatingTasks(config, appendResults); len(removedCDCTasks) > 0 {
+		if err := resource.Resource().StreamingCatalog().SaveReplicatePChannelArchives(ctx, removedCDCTasks); err != nil {
+			cm.Logger().Error(ctx, "failed to archive replicating tasks of removed replication edges", mlog.Err(err))
+			return err
 		}
+		cm.Logger().Info(ctx, "replicating tasks of removed replication edges are archived", mlog.Int("count", len(removedCDCTasks)))
 	}
 
-	if err := resource.Resource().StreamingCatalog().SaveReplicateConfiguration(ctx, configMeta, newIncomingCDCTasks); err != nil {
+	if err := resource.Resource().StreamingCatalog().SaveReplicateConfiguration(ctx, configMeta, history, newIncomingCDCTasks); err != nil {
 		cm.Logger().Error(ctx, "failed to save replicate configuration", mlog.Err(err))
 		return err
 	}
 
-	cm.Logger().Info(ctx, "Saved replicate configuration", replicateutil.ConfigLogField(config.GetReplicateConfiguration()))
+	cm.Logger().Info(ctx, "Saved replicate configuration",
+		mlog.Int64("version", version),
+		mlog.String("operator", history.GetOperator()),
+		mlog.Bool("rbacReplicationDisabled", rbacReplicationDisabled),
+		replicateutil.ConfigLogField(config.GetReplicateConfiguration()))
 
 	cm.replicateConfig = config
+	cm.replicateConfigVersion = version
+	cm.rbacReplicationDisabled = rbacReplicationDisabled
+	cm.collectionFilters = collectionFilters
 	// Recompute availableInReplication for all channels after config update
 	for _, ch := range cm.channels {
 		ch.availableInReplication = isChannelAvailableInReplication(ch.Name(), cm.replicateConfig)
@@ -652,8 +972,72 @@ func (cm *ChannelManager) UpdateReplicateConfiguration(ctx context.Context, resu
 	return nil
 }
 
+// newReplicateConfigurationHistory creates the history of the accepted replicate configuration with its broadcast result.
+func newReplicateConfigurationHistory(configMeta *streamingpb.ReplicateConfigurationMeta, result message.BroadcastResultAlterReplicateConfigMessageV2) *streamingpb.ReplicateConfigurationHistoryMeta {
+	history := &streamingpb.ReplicateConfigurationHistoryMeta{
+		Version:                 configMeta.GetVersion(),
+		ReplicateConfiguration:  configMeta.GetReplicateConfiguration(),
+		ForcePromoted:           configMeta.GetForcePromoted(),
+		Operator:                result.Message.Header().GetOperator(),
+		AppliedTimestampSeconds: time.Now().Unix(),
+		RbacReplicationDisabled: configMeta.GetRbacReplicationDisabled(),
+		CollectionFilters:       configMeta.GetCollectionFilters(),
+	}
+	if broadcastHeader := result.Message.BroadcastHeader(); broadcastHeader != nil {
+		history.BroadcastId = broadcastHeader.BroadcastID
+	}
+	for channel, appendResult := range result.Results {
+		r := &streamingpb.ReplicateConfigurationAppendResult{
+			ChannelName: funcutil.ToPhysicalChannel(channel),
+			TimeTick:    appendResult.TimeTick,
+		}
+		if appendResult.MessageID != nil {
+			r.MessageId = appendResult.MessageID.IntoProto()
+		}
+		history.AppendResults = append(history.AppendResults, r)
+	}
+	sort.Slice(history.AppendResults, func(i, j int) bool {
+		return history.AppendResults[i].GetChannelName() < history.AppendResults[j].GetChannelName()
+	})
+	return history
+}
+
+// PlanReplicateConfiguration computes the changes of current cluster made by applying the replicate configuration,
+// nothing is persisted. The configuration should be validated before planning.
+func (cm *ChannelManager) PlanReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.ReplicateConfigurationPlan, error) {
+	helper, err := replicateutil.NewConfigHelper(paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), config)
+	if err != nil {
+		return nil, status.NewInvalidArgument("invalid replicate configuration: %v", err)
+	}
+
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	plan := &streamingpb.ReplicateConfigurationPlan{}
+	if cm.replicateConfig != nil && proto.Equal(config, cm.replicateConfig.GetReplicateConfiguration()) {
+		plan.SameAsCurrent = true
+		return plan, nil
+	}
+	plan.CreatedTasks = cm.getNewIncomingTask(helper, cm.collectionFilters, nil)
+	plan.RemovedTasks = lo.Map(cm.getRemovedReplicatingTasks(helper, nil), func(archive *streamingpb.ReplicatePChannelArchiveMeta, _ int) *streamingpb.ReplicatePChannelMeta {
+		return archive.GetTask()
+	})
+	for _, ch := range cm.channels {
+		if available := isChannelAvailableInReplication(ch.Name(), helper); available != ch.availableInReplication {
+			plan.AvailabilityChanges = append(plan.AvailabilityChanges, &streamingpb.ReplicationAvailabilityChange{
+				ChannelName:            ch.Name(),
+				AvailableInReplication: available,
+			})
+		}
+	}
+	sort.Slice(plan.AvailabilityChanges, func(i, j int) bool {
+		return plan.AvailabilityChanges[i].GetChannelName() < plan.AvailabilityChanges[j].GetChannelName()
+	})
+	return plan, nil
+}
+
 // getNewIncomingTask gets the new incoming task from replicatingTasks.
-func (cm *ChannelManager) getNewIncomingTask(newConfig *replicateutil.ConfigHelper, appendResults map[string]*message.AppendResult) []*streamingpb.ReplicatePChannelMeta {
+func (cm *ChannelManager) getNewIncomingTask(newConfig *replicateutil.ConfigHelper, collectionFilters map[string]*messagespb.ReplicateCollectionFilter, appendResults map[string]*message.AppendResult) []*streamingpb.ReplicatePChannelMeta {
 	incoming := newConfig.GetCurrentCluster()
 	var current *replicateutil.MilvusCluster
 	if cm.replicateConfig != nil {
@@ -683,31 +1067,42 @@ func (cm *ChannelManager) getNewIncomingTask(newConfig *replicateutil.ConfigHelp
 		for _, pchannel := range newPchannels {
 			sourceClusterID := targetCluster.SourceCluster().ClusterId
 			sourcePChannel := targetCluster.MustGetSourceChannel(pchannel)
-			checkpointTimeTick := appendResults[sourcePChannel].TimeTick
-			if skipGetReplicateCheckpoint {
-				// For pchannel-increasing tasks, the CDC scanner uses DeliverFilterTimeTickGT
-				// (strictly greater than). Subtract 1 so the AlterReplicateConfig message itself
-				// (whose TimeTick == appendResults.TimeTick) is included in the scan.
-				// The secondary needs this message on ALL pchannels for the broadcast to complete.
-				checkpointTimeTick--
+			task := &streamingpb.ReplicatePChannelMeta{
+				SourceChannelName:          sourcePChannel,
+				TargetChannelName:          pchannel,
+				TargetCluster:              targetCluster.MilvusCluster,
+				SkipGetReplicateCheckpoint: skipGetReplicateCheckpoint,
+				CollectionFilter:           collectionFilters[targetCluster.GetClusterId()],
 			}
-			incomingReplicatingTasks = append(incomingReplicatingTasks, &streamingpb.ReplicatePChannelMeta{
-				SourceChannelName: sourcePChannel,
-				TargetChannelName: pchannel,
-				TargetCluster:     targetCluster.MilvusCluster,
+			if !skipGetReplicateCheckpoint && paramtable.Get().StreamingCfg.ReplicationBootstrapEnabled.GetAsBool() {
+				// The existing data of a new target cluster is copied by the bootstrap replicator of the cdc before the replication starts,
+				// the cdc switches the task into running after the copy is confirmed by the target cluster.
+				// the new pchannels of an existing target cluster have no existing data, so they are not bootstrapped.
+				task.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING
+			}
+			// The append result is absent when the configuration is planned without applying it.
+			if result, ok := appendResults[sourcePChannel]; ok {
+				checkpointTimeTick := result.TimeTick
+				if skipGetReplicateCheckpoint {
+					// For pchannel-increasing tasks, the CDC scanner uses DeliverFilterTimeTickGT
+					// (strictly greater than). Subtract 1 so the AlterReplicateConfig message itself
+					// (whose TimeTick == appendResults.TimeTick) is included in the scan.
+					// The secondary needs this message on ALL pchannels for the broadcast to complete.
+					checkpointTimeTick--
+				}
 				// The checkpoint is set as the initialized checkpoint for one cdc-task,
 				// when the startup of one cdc-task, the checkpoint returned from the target cluster is nil,
 				// so we set the initialized checkpoint here to start operation from here.
 				// the InitializedCheckpoint is always keep same semantic with the checkpoint at target cluster.
 				// so the cluster id is the source cluster id (aka. current cluster id)
-				InitializedCheckpoint: &commonpb.ReplicateCheckpoint{
+				task.InitializedCheckpoint = &commonpb.ReplicateCheckpoint{
 					ClusterId: sourceClusterID,
 					Pchannel:  sourcePChannel,
-					MessageId: appendResults[sourcePChannel].LastConfirmedMessageID.IntoProto(),
+					MessageId: result.LastConfirmedMessageID.IntoProto(),
 					TimeTick:  checkpointTimeTick,
-				},
-				SkipGetReplicateCheckpoint: skipGetReplicateCheckpoint,
-			})
+				}
+			}
+			incomingReplicatingTasks = append(incomingReplicatingTasks, task)
 		}
 	}
 	return incomingReplicatingTasks
@@ -722,9 +1117,16 @@ func (cm *ChannelManager) applyAssignments(cb WatchChannelAssignmentsCallback) (
 			assignments = append(assignments, c.CurrentAssignment())
 		}
 	}
+	readReplicas := make([]types.PChannelInfoAssigned, 0)
+	for _, replicas := range cm.readReplicas {
+		readReplicas = append(readReplicas, replicas...)
+	}
 	version := cm.version
 	cchannelAssignment := proto.Clone(cm.cchannelMeta).(*streamingpb.CChannelMeta)
 	pchannelViews := newPChannelView(cm.channels)
+	targetClusterHealth := cm.cloneTargetClusterHealth()
+	rbacReplicationDisabled := cm.rbacReplicationDisabled
+	collectionFilters := cm.collectionFilters
 	cm.cond.L.Unlock()
 
 	var replicateConfig *commonpb.ReplicateConfiguration
@@ -737,9 +1139,28 @@ func (cm *ChannelManager) applyAssignments(cb WatchChannelAssignmentsCallback) (
 		CChannelAssignment: &streamingpb.CChannelAssignment{
 			Meta: cchannelAssignment,
 		},
-		PChannelView:           pchannelViews,
-		Relations:              assignments,
-		ReplicateConfiguration: replicateConfig,
+		PChannelView:            pchannelViews,
+		Relations:               assignments,
+		ReadReplicas:            readReplicas,
+		ReplicateConfiguration:  replicateConfig,
+		RBACReplicationDisabled: rbacReplicationDisabled,
+		CollectionFilters:       collectionFilters,
+		TargetClusterHealth:     targetClusterHealth,
+	})
+}
+
+// UpdateTargetClusterHealth updates the reachability of the target clusters.
+// The watchers are not notified, because the reachability doesn't change the assignment.
+func (cm *ChannelManager) UpdateTargetClusterHealth(health []*streamingpb.ReplicateTargetClusterHealth) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+	cm.targetClusterHealth = health
+}
+
+// cloneTargetClusterHealth returns a copy of the reachability of the target clusters, should be called with lock.
+func (cm *ChannelManager) cloneTargetClusterHealth() []*streamingpb.ReplicateTargetClusterHealth {
+	return lo.Map(cm.targetClusterHealth, func(health *streamingpb.ReplicateTargetClusterHealth, _ int) *streamingpb.ReplicateTargetClusterHealth {
+		return proto.Clone(health).(*streamingpb.ReplicateTargetClusterHealth)
 	})
 }
 
@@ -0,0 +1,59 @@
+package channel
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+// ExportState exports a consistent snapshot of the channel manager state for the support bundle.
+// All the state is collected under the lock of channel manager, so the snapshot is never torn by a concurrent update.
+// The pchannels and stats are ordered by the pchannel name, so two exports of the same state are comparable.
+func (cm *ChannelManager) ExportState(ctx context.Context) (*streamingpb.ChannelManagerState, error) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	state := &streamingpb.ChannelManagerState{
+		ExportTimestampSeconds: time.Now().Unix(),
+		Version: &streamingpb.VersionPair{
+			Global: cm.version.Global,
+			Local:  cm.version.Local,
+		},
+		Pchannels:           make([]*streamingpb.PChannelMeta, 0, len(cm.channels)),
+		Stats:               make([]*streamingpb.PChannelStatsSnapshot, 0, len(cm.channels)),
+		Pools:               cm.listPChannelPools(),
+		AntiAffinityGroups:  cm.listPChannelAntiAffinityGroups(),
+		TargetClusterHealth: cm.cloneTargetClusterHealth(),
+	}
+	if cm.streamingVersion != nil {
+		state.StreamingVersion = proto.Clone(cm.streamingVersion).(*streamingpb.StreamingVersion)
+	}
+	if cm.cchannelMeta != nil {
+		state.Cchannel = proto.Clone(cm.cchannelMeta).(*streamingpb.CChannelMeta)
+	}
+	if cm.replicateConfig != nil {
+		state.ReplicateConfiguration = cm.replicateConfig.GetReplicateConfiguration()
+	}
+
+	names := make([]string, 0, len(cm.channels))
+	for id := range cm.channels {
+		names = append(names, id.Name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		id := ChannelID{Name: name}
+		state.Pchannels = append(state.Pchannels, proto.Clone(cm.channels[id].inner).(*streamingpb.PChannelMeta))
+		stats := StaticPChannelStatsManager.Get().GetPChannelStats(id).View()
+		state.Stats = append(state.Stats, &streamingpb.PChannelStatsSnapshot{
+			Pchannel:             name,
+			Vchannels:            stats.VChannels,
+			AppendBytesPerSecond: stats.AppendBytesPerSecond,
+			AppendBytesTotal:     stats.AppendBytesTotal,
+		})
+	}
+	return state, nil
+}
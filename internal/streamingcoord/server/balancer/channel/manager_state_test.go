@@ -0,0 +1,72 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestChannelManagerExportState(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{"ch2_100v0", "ch2_101v1"})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return([]*streamingpb.PChannelPoolMeta{
+		{Name: "pool1", Pchannels: []string{"ch3"}, Databases: []string{"db1"}},
+	}, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return([]*streamingpb.PChannelAntiAffinityGroupMeta{
+		{Name: "group1", Pchannels: []string{"ch1", "ch2"}},
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch3", "ch1", "ch2")
+	assert.NoError(t, err)
+	StaticPChannelStatsManager.Get().GetPChannelStats(ChannelID{Name: "ch2"}).UpdateAppendMetrics(1024, 4096)
+
+	state, err := m.ExportState(ctx)
+	assert.NoError(t, err)
+	assert.NotZero(t, state.GetExportTimestampSeconds())
+	assert.Equal(t, int64(1), state.GetStreamingVersion().GetVersion())
+	assert.Equal(t, "ch1", state.GetCchannel().GetPchannel())
+	assert.Nil(t, state.GetReplicateConfiguration())
+	assert.Equal(t, m.version.Global, state.GetVersion().GetGlobal())
+	assert.Equal(t, m.version.Local, state.GetVersion().GetLocal())
+
+	// The pchannels and stats are ordered by name.
+	assert.Len(t, state.GetPchannels(), 3)
+	assert.Len(t, state.GetStats(), 3)
+	for i, name := range []string{"ch1", "ch2", "ch3"} {
+		assert.Equal(t, name, state.GetPchannels()[i].GetChannel().GetName())
+		assert.Equal(t, name, state.GetStats()[i].GetPchannel())
+	}
+	assert.Equal(t, map[string]int64{"ch2_100v0": 100, "ch2_101v1": 101}, state.GetStats()[1].GetVchannels())
+	assert.Equal(t, float64(1024), state.GetStats()[1].GetAppendBytesPerSecond())
+	assert.Equal(t, uint64(4096), state.GetStats()[1].GetAppendBytesTotal())
+	assert.Empty(t, state.GetStats()[0].GetVchannels())
+
+	assert.Len(t, state.GetPools(), 1)
+	assert.Equal(t, "pool1", state.GetPools()[0].GetName())
+	assert.Len(t, state.GetAntiAffinityGroups(), 1)
+	assert.Equal(t, "group1", state.GetAntiAffinityGroups()[0].GetName())
+
+	// The exported state is a copy, modifying it doesn't affect the channel manager.
+	state.GetCchannel().Pchannel = "ch3"
+	state.GetPchannels()[0].Channel.Name = "modified"
+	assert.Equal(t, "ch1", m.cchannelMeta.GetPchannel())
+	assert.Contains(t, m.channels, ChannelID{Name: "ch1"})
+	assert.Equal(t, "ch1", m.channels[ChannelID{Name: "ch1"}].Name())
+}
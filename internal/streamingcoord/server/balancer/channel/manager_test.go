@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/proto"
@@ -19,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 )
@@ -42,6 +44,8 @@ func TestChannelManager(t *testing.T) {
 	}, nil)
 	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, errors.New("recover failure"))
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 	m, err := RecoverChannelManager(ctx)
 	assert.Nil(t, m)
 	assert.Error(t, err)
@@ -113,6 +117,8 @@ func TestChannelManager(t *testing.T) {
 	nodeID, ok := m.GetLatestWALLocated(ctx, "test-channel")
 	assert.True(t, ok)
 	assert.NotZero(t, nodeID)
+	located := m.GetLatestWALLocatedBatch(ctx, []string{"test-channel", "not-exist"})
+	assert.Equal(t, map[string]int64{"test-channel": nodeID}, located)
 
 	err = m.MarkAsUnavailable(ctx, []types.PChannelInfo{{
 		Name: "test-channel",
@@ -130,6 +136,7 @@ func TestChannelManager(t *testing.T) {
 	nodeID, ok = m.GetLatestWALLocated(ctx, "test-channel")
 	assert.False(t, ok)
 	assert.Zero(t, nodeID)
+	assert.Empty(t, m.GetLatestWALLocatedBatch(ctx, []string{"test-channel"}))
 
 	t.Run("UpdateReplicateConfiguration", func(t *testing.T) {
 		param, err := m.GetLatestChannelAssignment()
@@ -150,6 +157,7 @@ func TestChannelManager(t *testing.T) {
 		msg := message.NewAlterReplicateConfigMessageBuilderV2().
 			WithHeader(&message.AlterReplicateConfigMessageHeader{
 				ReplicateConfiguration: cfg,
+				Operator:               "root",
 			}).
 			WithBody(&message.AlterReplicateConfigMessageBody{}).
 			WithBroadcast([]string{"by-dev-test-channel-1", "by-dev-test-channel-2"}).
@@ -171,9 +179,15 @@ func TestChannelManager(t *testing.T) {
 			},
 		}
 
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
 				assert.True(t, proto.Equal(config.ReplicateConfiguration, cfg))
+				assert.Equal(t, int64(1), config.GetVersion())
+				assert.Equal(t, int64(1), history.GetVersion())
+				assert.Equal(t, "root", history.GetOperator())
+				assert.True(t, proto.Equal(history.GetReplicateConfiguration(), cfg))
+				assert.Len(t, history.GetAppendResults(), 2)
+				assert.Equal(t, "by-dev-test-channel-1", history.GetAppendResults()[0].GetChannelName())
 				assert.Len(t, replicatingTasks, 2)
 				for _, task := range replicatingTasks {
 					result := result.Results[task.GetSourceChannelName()]
@@ -236,10 +250,11 @@ func TestChannelManager(t *testing.T) {
 				},
 			},
 		}
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).Unset()
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Unset()
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
 				assert.True(t, proto.Equal(config.ReplicateConfiguration, cfg))
+				assert.Equal(t, int64(2), history.GetVersion())
 				assert.Len(t, replicatingTasks, 1) // only one new pchannel task for the appended channel.
 				for _, task := range replicatingTasks {
 					assert.Equal(t, task.GetSourceChannelName(), "by-dev-test-channel-3")
@@ -295,9 +310,9 @@ func TestChannelManager(t *testing.T) {
 				},
 			},
 		}
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).Unset()
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Unset()
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
 				assert.True(t, proto.Equal(config.ReplicateConfiguration, cfg))
 				assert.Len(t, replicatingTasks, 2) // here should be two new incoming tasks.
 				for _, task := range replicatingTasks {
@@ -354,9 +369,22 @@ func TestChannelManager(t *testing.T) {
 				},
 			},
 		}
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).Unset()
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+		catalog.EXPECT().SaveReplicatePChannelArchives(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, archives []*streamingpb.ReplicatePChannelArchiveMeta) error {
+				// the replication edges from by-dev to by-dev2 and by-dev3 are removed.
+				assert.Len(t, archives, 4)
+				for _, archive := range archives {
+					assert.False(t, archive.GetTaskRemoved())
+					assert.NotZero(t, archive.GetRemovedTimestampSeconds())
+					assert.Equal(t, "by-dev", archive.GetFinalCheckpoint().GetClusterId())
+					assert.Equal(t, archive.GetTask().GetSourceChannelName(), archive.GetFinalCheckpoint().GetPchannel())
+					assert.Equal(t, result.Results[archive.GetTask().GetSourceChannelName()].TimeTick, archive.GetFinalCheckpoint().GetTimeTick())
+				}
+				return nil
+			}).Once()
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Unset()
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
 				assert.True(t, proto.Equal(config.ReplicateConfiguration, cfg))
 				assert.Len(t, replicatingTasks, 0) // here should be two new incoming tasks.
 				return nil
@@ -401,9 +429,9 @@ func TestChannelManager(t *testing.T) {
 				},
 			},
 		}
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).Unset()
-		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Unset()
+		catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
 				assert.True(t, proto.Equal(config.ReplicateConfiguration, forcePromoteCfg))
 				assert.True(t, config.ForcePromoted)
 				assert.Len(t, replicatingTasks, 0) // standalone primary has no replication targets
@@ -436,6 +464,10 @@ func TestAllocVirtualChannels(t *testing.T) {
 	catalog.EXPECT().SaveVersion(mock.Anything, mock.Anything).Return(nil).Maybe()
 	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil).Maybe()
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil).Maybe()
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil).Maybe()
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil).Maybe()
+	catalog.EXPECT().GetIDAllocator(mock.Anything, mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).Return(nil)
 
 	ctx := context.Background()
 	newIncomingTopics := util.GetAllTopicsFromConfiguration()
@@ -462,6 +494,35 @@ func TestAllocVirtualChannels(t *testing.T) {
 	assert.Equal(t, allocVChannels[1], "by-dev-rootcoord-dml_11_1v1")
 	assert.Equal(t, allocVChannels[2], "by-dev-rootcoord-dml_12_1v2")
 	assert.Equal(t, allocVChannels[3], "by-dev-rootcoord-dml_13_1v3")
+
+	// The suffix keeps increasing across collections.
+	allocVChannels, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{
+		CollectionID: 2,
+		Num:          1,
+	})
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(allocVChannels[0], "_2v4"))
+
+	// Realloc the vchannels of collection 1.
+	collection1VChannels := []string{"by-dev-rootcoord-dml_10_1v0", "by-dev-rootcoord-dml_11_1v1", "by-dev-rootcoord-dml_12_1v2", "by-dev-rootcoord-dml_13_1v3"}
+	_, err = m.ReallocVirtualChannels(ctx, ReallocVChannelParam{CollectionID: 1, VChannels: collection1VChannels, Num: 0})
+	assert.Error(t, err)
+	_, err = m.ReallocVirtualChannels(ctx, ReallocVChannelParam{CollectionID: 2, VChannels: collection1VChannels, Num: 5})
+	assert.Error(t, err)
+	_, err = m.ReallocVirtualChannels(ctx, ReallocVChannelParam{CollectionID: 1, VChannels: collection1VChannels, Num: 256})
+	assert.Error(t, err)
+
+	reallocVChannels, err := m.ReallocVirtualChannels(ctx, ReallocVChannelParam{CollectionID: 1, VChannels: collection1VChannels, Num: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, collection1VChannels[:2], reallocVChannels)
+
+	// The expanded vchannels are not located at the pchannels used by the collection.
+	reallocVChannels, err = m.ReallocVirtualChannels(ctx, ReallocVChannelParam{CollectionID: 1, VChannels: collection1VChannels, Num: 6})
+	assert.NoError(t, err)
+	assert.Len(t, reallocVChannels, 6)
+	assert.Equal(t, collection1VChannels, reallocVChannels[:4])
+	assert.Equal(t, "by-dev-rootcoord-dml_14_1v5", reallocVChannels[4])
+	assert.Equal(t, "by-dev-rootcoord-dml_15_1v6", reallocVChannels[5])
 }
 
 func TestStreamingEnableChecker(t *testing.T) {
@@ -481,6 +542,8 @@ func TestStreamingEnableChecker(t *testing.T) {
 	catalog.EXPECT().SaveVersion(mock.Anything, mock.Anything).Return(nil)
 	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 
 	m, err := RecoverChannelManager(ctx, "test-channel")
 	assert.NoError(t, err)
@@ -505,6 +568,65 @@ func TestStreamingEnableChecker(t *testing.T) {
 	assert.Error(t, n2.Context().Err())
 }
 
+func TestStreamingDisable(t *testing.T) {
+	ctx := context.Background()
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{
+		Pchannel: "test-channel",
+	}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: StreamingVersion260}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	m, err := RecoverChannelManager(ctx, "test-channel")
+	assert.NoError(t, err)
+	assert.True(t, m.IsStreamingEnabledOnce())
+
+	// the pchannel that is being assigned rejects the disabling.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	_, err = m.AssignPChannels(ctx, map[ChannelID]types.PChannelInfoAssigned{
+		newChannelID("test-channel"): {
+			Channel: types.PChannelInfo{Name: "test-channel", Term: 1, AccessMode: types.AccessModeRW},
+			Node:    types.StreamingNodeInfo{ServerID: 1},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Error(t, m.DisableStreaming(ctx))
+	assert.NoError(t, m.AssignPChannelsDone(ctx, []ChannelID{newChannelID("test-channel")}))
+
+	// the upgraded streaming version rejects the disabling.
+	m.streamingVersion.Version = StreamingVersion265
+	assert.Error(t, m.DisableStreaming(ctx))
+	m.streamingVersion.Version = StreamingVersion260
+
+	// the failure of catalog keeps the streaming enabled.
+	catalog.EXPECT().RemoveVersion(mock.Anything).Return(errors.New("remove failure")).Once()
+	assert.Error(t, m.DisableStreaming(ctx))
+	assert.True(t, m.IsStreamingEnabledOnce())
+
+	n := syncutil.NewAsyncTaskNotifier[struct{}]()
+	m.RegisterStreamingDisabledNotifier(n)
+	go func() {
+		defer n.Finish(struct{}{})
+		<-n.Context().Done()
+	}()
+	catalog.EXPECT().RemoveVersion(mock.Anything).Return(nil)
+	assert.NoError(t, m.DisableStreaming(ctx))
+	assert.False(t, m.IsStreamingEnabledOnce())
+	assert.Error(t, n.Context().Err())
+
+	// disable again is a no-op.
+	assert.NoError(t, m.DisableStreaming(ctx))
+}
+
 func TestChannelManagerWatch(t *testing.T) {
 	ResetStaticPChannelStatsManager()
 	RecoverPChannelStatsManager([]string{})
@@ -536,6 +658,8 @@ func TestChannelManagerWatch(t *testing.T) {
 	})
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 
 	manager, err := RecoverChannelManager(context.Background())
 	assert.NoError(t, err)
@@ -598,6 +722,8 @@ func TestChannelManager_AddPChannels(t *testing.T) {
 		},
 	}, nil)
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
 
 	m, err := RecoverChannelManager(ctx, "test-channel")
@@ -646,6 +772,8 @@ func TestChannelManager_AddPChannels_ROWhenStreamingNotEnabled(t *testing.T) {
 	catalog.EXPECT().GetVersion(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
 
 	m, err := RecoverChannelManager(ctx, "test-channel")
@@ -683,6 +811,8 @@ func TestChannelManager_AddPChannels_PersistFailureRollback(t *testing.T) {
 		},
 	}, nil)
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 
 	persistErr := errors.New("persist failure")
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(persistErr)
@@ -732,6 +862,8 @@ func TestAddPChannels_UnavailableInReplication(t *testing.T) {
 	}
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(
 		&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: replicateCfg}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
 
 	m, err := RecoverChannelManager(ctx, "ch1", "ch2")
@@ -763,6 +895,8 @@ func TestRecovery_NoReplicateConfig_AllAvailable(t *testing.T) {
 		{Channel: &streamingpb.PChannelInfo{Name: "ch1", Term: 1}, Node: &streamingpb.StreamingNodeInfo{ServerId: 1}},
 	}, nil)
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 
 	m, err := RecoverChannelManager(ctx, "ch1")
 	assert.NoError(t, err)
@@ -797,6 +931,11 @@ func TestAllocVirtualChannels_SkipsUnavailableChannels(t *testing.T) {
 	}
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(
 		&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: replicateCfg}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	catalog.EXPECT().GetIDAllocator(mock.Anything, mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).Return(nil)
 
 	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
 	assert.NoError(t, err)
@@ -842,6 +981,8 @@ func TestGetClusterChannels_ExcludesUnavailable(t *testing.T) {
 	}
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(
 		&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: replicateCfg}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 
 	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
 	assert.NoError(t, err)
@@ -886,6 +1027,8 @@ func TestUpdateReplicateConfiguration_FlipsAvailability(t *testing.T) {
 	}
 	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(
 		&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: replicateCfg}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
 
 	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
 	assert.NoError(t, err)
@@ -918,7 +1061,7 @@ func TestUpdateReplicateConfiguration_FlipsAvailability(t *testing.T) {
 			"ch3": {MessageID: walimplstest.NewTestMessageID(5), LastConfirmedMessageID: walimplstest.NewTestMessageID(6), TimeTick: 1},
 		},
 	}
-	catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	err = m.UpdateReplicateConfiguration(ctx, result)
 	assert.NoError(t, err)
@@ -930,6 +1073,202 @@ func TestUpdateReplicateConfiguration_FlipsAvailability(t *testing.T) {
 	assert.True(t, m.channels[ChannelID{Name: "ch2"}].AvailableInReplication())
 }
 
+func TestPlanReplicateConfiguration(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+
+	ctx := context.Background()
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		{Channel: &streamingpb.PChannelInfo{Name: "ch1", Term: 1}},
+		{Channel: &streamingpb.PChannelInfo{Name: "ch2", Term: 1}},
+		{Channel: &streamingpb.PChannelInfo{Name: "ch3", Term: 1}},
+	}, nil)
+	replicateCfg := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2"}},
+			{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+		},
+	}
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(
+		&streamingpb.ReplicateConfigurationMeta{ReplicateConfiguration: replicateCfg}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+
+	// Invalid or same configuration.
+	_, err = m.PlanReplicateConfiguration(ctx, &commonpb.ReplicateConfiguration{})
+	assert.Error(t, err)
+	plan, err := m.PlanReplicateConfiguration(ctx, replicateCfg)
+	assert.NoError(t, err)
+	assert.True(t, plan.GetSameAsCurrent())
+
+	// Append ch3 and add a new target cluster.
+	plan, err = m.PlanReplicateConfiguration(ctx, &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2", "ch3"}},
+			{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5", "ch6"}},
+			{ClusterId: "by-dev3", Pchannels: []string{"ch7", "ch8", "ch9"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev3"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.False(t, plan.GetSameAsCurrent())
+	created := lo.Map(plan.GetCreatedTasks(), func(task *streamingpb.ReplicatePChannelMeta, _ int) string {
+		assert.Nil(t, task.GetInitializedCheckpoint())
+		return task.GetSourceChannelName() + "->" + task.GetTargetChannelName()
+	})
+	assert.ElementsMatch(t, []string{"ch3->ch6", "ch1->ch7", "ch2->ch8", "ch3->ch9"}, created)
+	for _, task := range plan.GetCreatedTasks() {
+		assert.Equal(t, streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING, task.GetState())
+	}
+	assert.Empty(t, plan.GetRemovedTasks())
+	assert.Len(t, plan.GetAvailabilityChanges(), 1)
+	assert.Equal(t, "ch3", plan.GetAvailabilityChanges()[0].GetChannelName())
+	assert.True(t, plan.GetAvailabilityChanges()[0].GetAvailableInReplication())
+
+	// Only the tasks of the new target cluster are bootstrapped.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.ReplicationBootstrapEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.ReplicationBootstrapEnabled.Key)
+	plan, err = m.PlanReplicateConfiguration(ctx, &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2", "ch3"}},
+			{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5", "ch6"}},
+			{ClusterId: "by-dev3", Pchannels: []string{"ch7", "ch8", "ch9"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev3"},
+		},
+	})
+	assert.NoError(t, err)
+	for _, task := range plan.GetCreatedTasks() {
+		if task.GetTargetCluster().GetClusterId() == "by-dev3" {
+			assert.Equal(t, streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING, task.GetState())
+		} else {
+			assert.Equal(t, streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING, task.GetState())
+		}
+	}
+
+	// Remove the replication, nothing is applied by the previous plan.
+	plan, err = m.PlanReplicateConfiguration(ctx, &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, plan.GetCreatedTasks())
+	removed := lo.Map(plan.GetRemovedTasks(), func(task *streamingpb.ReplicatePChannelMeta, _ int) string {
+		return task.GetSourceChannelName() + "->" + task.GetTargetChannelName()
+	})
+	assert.ElementsMatch(t, []string{"ch1->ch4", "ch2->ch5"}, removed)
+	assert.Len(t, plan.GetAvailabilityChanges(), 1)
+	assert.False(t, m.channels[ChannelID{Name: "ch3"}].AvailableInReplication())
+}
+
+func TestUpdateReplicateConfiguration_IncreasePChannelsWithNewCluster(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+
+	ctx := context.Background()
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(&streamingpb.ReplicateConfigurationMeta{
+		ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2"}},
+				{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5"}},
+			},
+			CrossClusterTopology: []*commonpb.CrossClusterTopology{
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			},
+		},
+	}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+
+	// Append a pchannel into the existing clusters and add a new target cluster at the same time.
+	newCfg := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2", "ch3"}},
+			{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5", "ch6"}},
+			{ClusterId: "by-dev3", Pchannels: []string{"ch7", "ch8", "ch9"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev3"},
+		},
+	}
+	msg := message.NewAlterReplicateConfigMessageBuilderV2().
+		WithHeader(&message.AlterReplicateConfigMessageHeader{ReplicateConfiguration: newCfg}).
+		WithBody(&message.AlterReplicateConfigMessageBody{}).
+		WithBroadcast([]string{"ch1", "ch2", "ch3"}).
+		MustBuildBroadcast()
+	result := message.BroadcastResultAlterReplicateConfigMessageV2{
+		Message: message.MustAsBroadcastAlterReplicateConfigMessageV2(msg),
+		Results: map[string]*message.AppendResult{
+			"ch1": {MessageID: walimplstest.NewTestMessageID(1), LastConfirmedMessageID: walimplstest.NewTestMessageID(2), TimeTick: 10},
+			"ch2": {MessageID: walimplstest.NewTestMessageID(3), LastConfirmedMessageID: walimplstest.NewTestMessageID(4), TimeTick: 10},
+			"ch3": {MessageID: walimplstest.NewTestMessageID(5), LastConfirmedMessageID: walimplstest.NewTestMessageID(6), TimeTick: 10},
+		},
+	}
+	catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+			// one task for the appended pchannel of by-dev2, and the tasks for all pchannels of the new cluster by-dev3.
+			assert.Len(t, replicatingTasks, 4)
+			targets := make(map[string]*streamingpb.ReplicatePChannelMeta)
+			for _, task := range replicatingTasks {
+				targets[task.GetTargetChannelName()] = task
+			}
+			assert.NotContains(t, targets, "ch4")
+			assert.NotContains(t, targets, "ch5")
+
+			appended := targets["ch6"]
+			assert.Equal(t, "ch3", appended.GetSourceChannelName())
+			assert.True(t, appended.GetSkipGetReplicateCheckpoint())
+			assert.Equal(t, uint64(9), appended.GetInitializedCheckpoint().GetTimeTick())
+
+			for source, target := range map[string]string{"ch1": "ch7", "ch2": "ch8", "ch3": "ch9"} {
+				task := targets[target]
+				assert.Equal(t, source, task.GetSourceChannelName())
+				assert.Equal(t, "by-dev3", task.GetTargetCluster().GetClusterId())
+				assert.False(t, task.GetSkipGetReplicateCheckpoint())
+				assert.Equal(t, uint64(10), task.GetInitializedCheckpoint().GetTimeTick())
+			}
+			return nil
+		}).Once()
+	err = m.UpdateReplicateConfiguration(ctx, result)
+	assert.NoError(t, err)
+	assert.True(t, m.channels[ChannelID{Name: "ch3"}].AvailableInReplication())
+
+	// The same configuration is not applied again, so no task is resent.
+	err = m.UpdateReplicateConfiguration(ctx, result)
+	assert.NoError(t, err)
+}
+
 func TestIsChannelAvailableInReplication(t *testing.T) {
 	// No replicateConfig → always available
 	assert.True(t, isChannelAvailableInReplication("ch1", nil))
@@ -2,26 +2,41 @@ package channel
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 func newPChannelMetrics() *channelMetrics {
 	constLabel := prometheus.Labels{metrics.NodeIDLabelName: paramtable.GetStringNodeID()}
 	return &channelMetrics{
-		pchannelInfo:      metrics.StreamingCoordPChannelInfo.MustCurryWith(constLabel),
-		vchannelTotal:     metrics.StreamingCoordVChannelTotal.MustCurryWith(constLabel),
-		assignmentVersion: metrics.StreamingCoordAssignmentVersion.With(constLabel),
+		pchannelInfo:        metrics.StreamingCoordPChannelInfo.MustCurryWith(constLabel),
+		vchannelTotal:       metrics.StreamingCoordVChannelTotal.MustCurryWith(constLabel),
+		assignmentVersion:   metrics.StreamingCoordAssignmentVersion.With(constLabel),
+		stateTotal:          metrics.StreamingCoordPChannelStateTotal.MustCurryWith(constLabel),
+		termBumpTotal:       metrics.StreamingCoordPChannelTermBumpTotal.MustCurryWith(constLabel),
+		assignDuration:      metrics.StreamingCoordPChannelAssignDurationSeconds.With(constLabel),
+		unavailableDuration: metrics.StreamingCoordPChannelUnavailableDurationSeconds.With(constLabel),
+		assigningSince:      make(map[string]time.Time),
+		unavailableSince:    make(map[string]time.Time),
 	}
 }
 
+// channelMetrics is the metrics of pchannels, it's always accessed with the lock of channel manager.
 type channelMetrics struct {
-	pchannelInfo      *prometheus.GaugeVec
-	vchannelTotal     *prometheus.GaugeVec
-	assignmentVersion prometheus.Gauge
+	pchannelInfo        *prometheus.GaugeVec
+	vchannelTotal       *prometheus.GaugeVec
+	assignmentVersion   prometheus.Gauge
+	stateTotal          *prometheus.GaugeVec
+	termBumpTotal       *prometheus.CounterVec
+	assignDuration      prometheus.Observer
+	unavailableDuration prometheus.Observer
+	assigningSince      map[string]time.Time // the time when the pchannel starts assigning, kept until the assignment is done.
+	unavailableSince    map[string]time.Time // the time when the pchannel is marked as unavailable, kept until the reassignment is done.
 }
 
 // UpdateVChannelTotal updates the vchannel total metric
@@ -57,3 +72,47 @@ func (m *channelMetrics) AssignPChannelStatus(meta *PChannelMeta) {
 func (m *channelMetrics) UpdateAssignmentVersion(version int64) {
 	m.assignmentVersion.Set(float64(version))
 }
+
+// ObserveAssigning observes the pchannel that is assigning to a new term.
+func (m *channelMetrics) ObserveAssigning(meta *PChannelMeta) {
+	m.termBumpTotal.WithLabelValues(meta.Name()).Inc()
+	if _, ok := m.assigningSince[meta.Name()]; !ok {
+		m.assigningSince[meta.Name()] = time.Now()
+	}
+}
+
+// ObserveUnavailable observes the pchannel that is marked as unavailable.
+func (m *channelMetrics) ObserveUnavailable(meta *PChannelMeta) {
+	if meta.State() != streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNAVAILABLE {
+		return
+	}
+	if _, ok := m.unavailableSince[meta.Name()]; !ok {
+		m.unavailableSince[meta.Name()] = time.Now()
+	}
+}
+
+// ObserveAssigned observes the pchannel that the assignment is done.
+func (m *channelMetrics) ObserveAssigned(meta *PChannelMeta) {
+	if since, ok := m.assigningSince[meta.Name()]; ok {
+		m.assignDuration.Observe(time.Since(since).Seconds())
+		delete(m.assigningSince, meta.Name())
+	}
+	if since, ok := m.unavailableSince[meta.Name()]; ok {
+		m.unavailableDuration.Observe(time.Since(since).Seconds())
+		delete(m.unavailableSince, meta.Name())
+	}
+}
+
+// UpdatePChannelStateTotal updates the count of pchannels in each state.
+func (m *channelMetrics) UpdatePChannelStateTotal(channels map[ChannelID]*PChannelMeta) {
+	counts := make(map[streamingpb.PChannelMetaState]int, len(streamingpb.PChannelMetaState_name))
+	for state := range streamingpb.PChannelMetaState_name {
+		counts[streamingpb.PChannelMetaState(state)] = 0
+	}
+	for _, channel := range channels {
+		counts[channel.State()]++
+	}
+	for state, count := range counts {
+		m.stateTotal.WithLabelValues(state.String()).Set(float64(count))
+	}
+}
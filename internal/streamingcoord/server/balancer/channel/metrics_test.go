@@ -0,0 +1,52 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+func TestChannelMetrics(t *testing.T) {
+	m := newPChannelMetrics()
+	node := types.StreamingNodeInfo{ServerID: 1}
+
+	uninitialized := NewPChannelMeta("metrics-ch1", types.AccessModeRW)
+	mutable := NewPChannelMeta("metrics-ch2", types.AccessModeRW).CopyForWrite()
+	mutable.TryAssignToServerID(types.AccessModeRW, node)
+	assigning := mutable.PChannelMeta
+
+	m.UpdatePChannelStateTotal(map[ChannelID]*PChannelMeta{
+		uninitialized.ChannelID(): uninitialized,
+		assigning.ChannelID():     assigning,
+	})
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.stateTotal.WithLabelValues(streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNINITIALIZED.String())))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.stateTotal.WithLabelValues(streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNING.String())))
+	assert.Equal(t, float64(0), testutil.ToFloat64(m.stateTotal.WithLabelValues(streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNED.String())))
+
+	// every assigning bumps the term, the assign duration is kept from the first assigning.
+	m.ObserveAssigning(assigning)
+	since := m.assigningSince[assigning.Name()]
+	m.ObserveAssigning(assigning)
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.termBumpTotal.WithLabelValues(assigning.Name())))
+	assert.Equal(t, since, m.assigningSince[assigning.Name()])
+
+	mutable = assigning.CopyForWrite()
+	mutable.AssignToServerDone()
+	assigned := mutable.PChannelMeta
+
+	// only the unavailable pchannel is observed.
+	m.ObserveUnavailable(assigned)
+	assert.NotContains(t, m.unavailableSince, assigned.Name())
+	mutable = assigned.CopyForWrite()
+	mutable.MarkAsUnavailable(assigned.CurrentTerm())
+	m.ObserveUnavailable(mutable.PChannelMeta)
+	assert.Contains(t, m.unavailableSince, assigned.Name())
+
+	m.ObserveAssigned(assigned)
+	assert.NotContains(t, m.assigningSince, assigned.Name())
+	assert.NotContains(t, m.unavailableSince, assigned.Name())
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
 )
 
@@ -105,6 +106,16 @@ func (c *PChannelMeta) AssignHistories() []types.PChannelInfoAssigned {
 	return history
 }
 
+// OwnershipHistories returns the finished assignments of the channel ordered by term.
+// The returned logs are copied, so it's safe to modify them.
+func (c *PChannelMeta) OwnershipHistories() []*streamingpb.PChannelAssignmentLog {
+	histories := make([]*streamingpb.PChannelAssignmentLog, 0, len(c.inner.OwnershipHistories))
+	for _, h := range c.inner.OwnershipHistories {
+		histories = append(histories, proto.Clone(h).(*streamingpb.PChannelAssignmentLog))
+	}
+	return histories
+}
+
 // IsAssigned returns if the channel is assigned to a server.
 func (c *PChannelMeta) IsAssigned() bool {
 	return c.inner.State == streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNED
@@ -120,6 +131,18 @@ func (c *PChannelMeta) LastAssignTimestamp() time.Time {
 	return time.Unix(int64(c.inner.LastAssignTimestampSeconds), 0)
 }
 
+// PinnedServerID returns the server id of the streaming node that the channel is pinned to.
+// If the channel is not pinned, return 0.
+func (c *PChannelMeta) PinnedServerID() int64 {
+	return c.inner.GetPinnedServerId()
+}
+
+// RetentionPolicy returns the retention policy of the wal of the channel.
+// If the policy is not set, return nil.
+func (c *PChannelMeta) RetentionPolicy() *streamingpb.WALRetentionPolicy {
+	return c.inner.GetRetentionPolicy()
+}
+
 // State returns the state of the channel.
 func (c *PChannelMeta) State() streamingpb.PChannelMetaState {
 	return c.inner.State
@@ -193,7 +216,51 @@ func (m *mutablePChannel) AssignToServerDone() {
 		m.inner.Histories = make([]*streamingpb.PChannelAssignmentLog, 0)
 		m.inner.State = streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNED
 		m.inner.LastAssignTimestampSeconds = uint64(time.Now().Unix())
+		m.appendOwnershipHistory()
+	}
+}
+
+// appendOwnershipHistory records the finished assignment into the ownership history,
+// and trims the history by the configured retention.
+func (m *mutablePChannel) appendOwnershipHistory() {
+	m.inner.OwnershipHistories = append(m.inner.OwnershipHistories, &streamingpb.PChannelAssignmentLog{
+		Term:                     m.inner.Channel.Term,
+		Node:                     m.inner.Node,
+		AccessMode:               m.inner.Channel.AccessMode,
+		AssignedTimestampSeconds: m.inner.LastAssignTimestampSeconds,
+	})
+
+	maxCount := paramtable.Get().StreamingCfg.WALBalancerAssignmentHistoryMaxCount.GetAsInt()
+	maxAge := paramtable.Get().StreamingCfg.WALBalancerAssignmentHistoryMaxAge.GetAsDurationByParse()
+	histories := m.inner.OwnershipHistories
+	if maxCount <= 0 {
+		histories = nil
+	} else if len(histories) > maxCount {
+		histories = histories[len(histories)-maxCount:]
 	}
+	if maxAge > 0 {
+		// the last finished assignment is kept even if it's expired.
+		deadline := uint64(time.Now().Add(-maxAge).Unix())
+		for len(histories) > 1 && histories[0].AssignedTimestampSeconds < deadline {
+			histories = histories[1:]
+		}
+	}
+	m.inner.OwnershipHistories = append([]*streamingpb.PChannelAssignmentLog(nil), histories...)
+}
+
+// PinToServerID pins the channel to the server, 0 to unpin.
+func (m *mutablePChannel) PinToServerID(serverID int64) {
+	m.inner.PinnedServerId = serverID
+}
+
+// SetRetentionPolicy sets the retention policy of the wal of the channel, nil to clear.
+func (m *mutablePChannel) SetRetentionPolicy(policy *streamingpb.WALRetentionPolicy) {
+	m.inner.RetentionPolicy = policy
+}
+
+// SetTopic sets the topic of mq that backs the channel.
+func (m *mutablePChannel) SetTopic(topic string) {
+	m.inner.Channel.Topic = topic
 }
 
 // MarkAsUnavailable marks the channel as unavailable.
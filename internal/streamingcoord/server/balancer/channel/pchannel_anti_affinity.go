@@ -0,0 +1,112 @@
+package channel
+
+import (
+	"context"
+	"sort"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// recoverPChannelAntiAffinityGroups recovers the pchannel anti-affinity groups from the catalog.
+func recoverPChannelAntiAffinityGroups(ctx context.Context) (map[string]*streamingpb.PChannelAntiAffinityGroupMeta, error) {
+	groups, err := resource.Resource().StreamingCatalog().ListPChannelAntiAffinityGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*streamingpb.PChannelAntiAffinityGroupMeta, len(groups))
+	for _, group := range groups {
+		result[group.GetName()] = group
+	}
+	return result, nil
+}
+
+// ListPChannelAntiAffinityGroups returns all pchannel anti-affinity groups ordered by name.
+func (cm *ChannelManager) ListPChannelAntiAffinityGroups() []*streamingpb.PChannelAntiAffinityGroupMeta {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	return cm.listPChannelAntiAffinityGroups()
+}
+
+// UpdatePChannelAntiAffinityGroups creates, replaces or drops the pchannel anti-affinity groups,
+// and returns all groups after the update.
+// The update is applied atomically, any invalid group in the request fails the whole update.
+// A pchannel can belong to multiple groups.
+func (cm *ChannelManager) UpdatePChannelAntiAffinityGroups(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	if len(req.GetUpsertGroups()) == 0 && len(req.GetDropGroups()) == 0 {
+		return &streamingpb.UpdatePChannelAntiAffinityGroupsResponse{Groups: cm.listPChannelAntiAffinityGroups()}, nil
+	}
+
+	newGroups := make(map[string]*streamingpb.PChannelAntiAffinityGroupMeta, len(cm.antiAffinity))
+	for name, group := range cm.antiAffinity {
+		newGroups[name] = group
+	}
+	dropped := make([]string, 0, len(req.GetDropGroups()))
+	for _, name := range req.GetDropGroups() {
+		if _, ok := newGroups[name]; ok {
+			delete(newGroups, name)
+			dropped = append(dropped, name)
+		}
+	}
+	upserted := make([]*streamingpb.PChannelAntiAffinityGroupMeta, 0, len(req.GetUpsertGroups()))
+	for _, group := range req.GetUpsertGroups() {
+		group, err := cm.normalizePChannelAntiAffinityGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		newGroups[group.GetName()] = group
+		upserted = append(upserted, group)
+	}
+
+	if err := resource.Resource().StreamingCatalog().SavePChannelAntiAffinityGroups(ctx, upserted, dropped); err != nil {
+		return nil, err
+	}
+	cm.antiAffinity = newGroups
+	cm.Logger().Info(ctx, "pchannel anti-affinity groups updated",
+		mlog.Strings("upserted", lo.Map(upserted, func(group *streamingpb.PChannelAntiAffinityGroupMeta, _ int) string { return group.GetName() })),
+		mlog.Strings("dropped", dropped),
+		mlog.Int("groupCount", len(cm.antiAffinity)))
+	return &streamingpb.UpdatePChannelAntiAffinityGroupsResponse{Groups: cm.listPChannelAntiAffinityGroups()}, nil
+}
+
+// listPChannelAntiAffinityGroups returns all pchannel anti-affinity groups ordered by name, should be called with lock.
+func (cm *ChannelManager) listPChannelAntiAffinityGroups() []*streamingpb.PChannelAntiAffinityGroupMeta {
+	groups := make([]*streamingpb.PChannelAntiAffinityGroupMeta, 0, len(cm.antiAffinity))
+	for _, group := range cm.antiAffinity {
+		groups = append(groups, proto.Clone(group).(*streamingpb.PChannelAntiAffinityGroupMeta))
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].GetName() < groups[j].GetName() })
+	return groups
+}
+
+// normalizePChannelAntiAffinityGroup checks the group and returns a deduplicated and sorted copy of it, should be called with lock.
+func (cm *ChannelManager) normalizePChannelAntiAffinityGroup(group *streamingpb.PChannelAntiAffinityGroupMeta) (*streamingpb.PChannelAntiAffinityGroupMeta, error) {
+	if group.GetName() == "" {
+		return nil, status.NewInvalidArgument("the name of pchannel anti-affinity group should not be empty")
+	}
+	pchannels := typeutil.NewSet(group.GetPchannels()...)
+	if pchannels.Len() < 2 {
+		return nil, status.NewInvalidArgument("pchannel anti-affinity group %s should contain at least two pchannels", group.GetName())
+	}
+	for pchannel := range pchannels {
+		if _, ok := cm.channels[ChannelID{Name: pchannel}]; !ok {
+			return nil, status.NewInvalidArgument("pchannel %s of anti-affinity group %s not exist", pchannel, group.GetName())
+		}
+	}
+	normalized := &streamingpb.PChannelAntiAffinityGroupMeta{
+		Name:      group.GetName(),
+		Pchannels: pchannels.Collect(),
+	}
+	sort.Strings(normalized.Pchannels)
+	return normalized, nil
+}
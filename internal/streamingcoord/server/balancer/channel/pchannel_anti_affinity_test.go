@@ -0,0 +1,81 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestChannelManagerPChannelAntiAffinityGroup(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return([]*streamingpb.PChannelAntiAffinityGroupMeta{
+		{Name: "group1", Pchannels: []string{"ch1", "ch2"}},
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+	groups := m.ListPChannelAntiAffinityGroups()
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "group1", groups[0].GetName())
+
+	// Invalid update is rejected without persisting.
+	_, err = m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{
+		UpsertGroups: []*streamingpb.PChannelAntiAffinityGroupMeta{{Pchannels: []string{"ch1", "ch2"}}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{
+		UpsertGroups: []*streamingpb.PChannelAntiAffinityGroupMeta{{Name: "group2", Pchannels: []string{"ch1", "ch1"}}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{
+		UpsertGroups: []*streamingpb.PChannelAntiAffinityGroupMeta{{Name: "group2", Pchannels: []string{"ch1", "non-exist"}}},
+	})
+	assert.Error(t, err)
+
+	// The failure of catalog is returned and the groups are not changed.
+	catalog.EXPECT().SavePChannelAntiAffinityGroups(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{DropGroups: []string{"group1"}})
+	assert.Error(t, err)
+	assert.Len(t, m.ListPChannelAntiAffinityGroups(), 1)
+
+	// Replace group1 and create group2, a pchannel can belong to multiple groups.
+	catalog.EXPECT().SavePChannelAntiAffinityGroups(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	resp, err := m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{
+		UpsertGroups: []*streamingpb.PChannelAntiAffinityGroupMeta{
+			{Name: "group1", Pchannels: []string{"ch3", "ch1", "ch1"}},
+			{Name: "group2", Pchannels: []string{"ch2", "ch1"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetGroups(), 2)
+	assert.Equal(t, []string{"ch1", "ch3"}, resp.GetGroups()[0].GetPchannels())
+	assert.Equal(t, []string{"ch1", "ch2"}, resp.GetGroups()[1].GetPchannels())
+
+	// Drop the groups, the empty request lists the groups.
+	resp, err = m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{DropGroups: []string{"group1", "group2", "non-exist"}})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetGroups())
+	resp, err = m.UpdatePChannelAntiAffinityGroups(ctx, &streamingpb.UpdatePChannelAntiAffinityGroupsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetGroups())
+}
@@ -0,0 +1,69 @@
+package channel
+
+import (
+	"context"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+// UpdatePChannelPins pins the pchannels to the streaming nodes or unpins them, and returns all pins after the update.
+// The pins are persisted in the pchannel meta, so they survive the restart of streamingcoord.
+// The update is applied atomically, any invalid pin in the request fails the whole update.
+func (cm *ChannelManager) UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error) {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	pins := make(map[ChannelID]int64, len(req.GetPins())+len(req.GetUnpinPchannels()))
+	for _, name := range req.GetUnpinPchannels() {
+		pins[ChannelID{Name: name}] = 0
+	}
+	for _, pin := range req.GetPins() {
+		if pin.GetServerId() <= 0 {
+			return nil, status.NewInvalidArgument("invalid server id %d of pin for pchannel %s", pin.GetServerId(), pin.GetPchannel())
+		}
+		id := ChannelID{Name: pin.GetPchannel()}
+		if serverID, ok := pins[id]; ok && serverID != pin.GetServerId() {
+			return nil, status.NewInvalidArgument("pchannel %s is pinned or unpinned more than once", pin.GetPchannel())
+		}
+		pins[id] = pin.GetServerId()
+	}
+
+	pChannelMetas := make([]*streamingpb.PChannelMeta, 0, len(pins))
+	for id, serverID := range pins {
+		pchannel, ok := cm.channels[id]
+		if !ok {
+			return nil, status.NewInvalidArgument("pchannel %s not found", id.Name)
+		}
+		if pchannel.PinnedServerID() == serverID {
+			continue
+		}
+		mutablePChannel := pchannel.CopyForWrite()
+		mutablePChannel.PinToServerID(serverID)
+		pChannelMetas = append(pChannelMetas, mutablePChannel.IntoRawMeta())
+	}
+	if err := cm.updatePChannelMeta(ctx, pChannelMetas); err != nil {
+		return nil, err
+	}
+	result := cm.listPChannelPins()
+	if len(pChannelMetas) > 0 {
+		cm.Logger().Info(ctx, "pchannel pins updated", mlog.Int("modified", len(pChannelMetas)), mlog.Int("pinCount", len(result)))
+	}
+	return &streamingpb.UpdatePChannelPinsResponse{Pins: result}, nil
+}
+
+// listPChannelPins returns all pins ordered by the pchannel name.
+func (cm *ChannelManager) listPChannelPins() []*streamingpb.PChannelPin {
+	pins := make([]*streamingpb.PChannelPin, 0)
+	for _, pchannel := range cm.channels {
+		if serverID := pchannel.PinnedServerID(); serverID > 0 {
+			pins = append(pins, &streamingpb.PChannelPin{Pchannel: pchannel.Name(), ServerId: serverID})
+		}
+	}
+	sort.Slice(pins, func(i, j int) bool {
+		return pins[i].GetPchannel() < pins[j].GetPchannel()
+	})
+	return pins
+}
@@ -0,0 +1,85 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestChannelManagerPChannelPin(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	// The pin is recovered from the pchannel meta.
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		{
+			Channel:        &streamingpb.PChannelInfo{Name: "ch1", Term: 1},
+			State:          streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNINITIALIZED,
+			PinnedServerId: 1,
+		},
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+	resp, err := m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*streamingpb.PChannelPin{{Pchannel: "ch1", ServerId: 1}}, resp.GetPins())
+
+	// Invalid update is rejected without persisting.
+	_, err = m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{
+		Pins: []*streamingpb.PChannelPin{{Pchannel: "non-exist", ServerId: 1}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{
+		Pins: []*streamingpb.PChannelPin{{Pchannel: "ch2", ServerId: 0}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{
+		Pins:           []*streamingpb.PChannelPin{{Pchannel: "ch2", ServerId: 2}},
+		UnpinPchannels: []string{"ch2"},
+	})
+	assert.Error(t, err)
+
+	// The failure of catalog is returned and the pins are not changed.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{UnpinPchannels: []string{"ch1"}})
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), m.channels[newChannelID("ch1")].PinnedServerID())
+
+	// Pin and unpin the pchannels, the version is increased if the pins are modified.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	version := m.version.Local
+	resp, err = m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{
+		Pins:           []*streamingpb.PChannelPin{{Pchannel: "ch3", ServerId: 3}, {Pchannel: "ch2", ServerId: 2}},
+		UnpinPchannels: []string{"ch1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*streamingpb.PChannelPin{{Pchannel: "ch2", ServerId: 2}, {Pchannel: "ch3", ServerId: 3}}, resp.GetPins())
+	assert.Equal(t, version+1, m.version.Local)
+	assert.Equal(t, int64(0), m.channels[newChannelID("ch1")].PinnedServerID())
+
+	// Nothing is persisted if the pins are not modified.
+	_, err = m.UpdatePChannelPins(ctx, &streamingpb.UpdatePChannelPinsRequest{
+		Pins: []*streamingpb.PChannelPin{{Pchannel: "ch2", ServerId: 2}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, version+1, m.version.Local)
+}
@@ -0,0 +1,175 @@
+package channel
+
+import (
+	"context"
+	"sort"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// recoverPChannelPools recovers the pchannel pools from the catalog.
+func recoverPChannelPools(ctx context.Context) (map[string]*streamingpb.PChannelPoolMeta, error) {
+	pools, err := resource.Resource().StreamingCatalog().ListPChannelPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*streamingpb.PChannelPoolMeta, len(pools))
+	for _, pool := range pools {
+		result[pool.GetName()] = pool
+	}
+	return result, nil
+}
+
+// ListPChannelPools returns all pchannel pools ordered by name.
+func (cm *ChannelManager) ListPChannelPools() []*streamingpb.PChannelPoolMeta {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	return cm.listPChannelPools()
+}
+
+// UpdatePChannelPools creates, replaces or drops the pchannel pools, and returns all pchannel pools after the update.
+// The update is applied atomically, any invalid pool in the request fails the whole update.
+func (cm *ChannelManager) UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	if len(req.GetUpsertPools()) == 0 && len(req.GetDropPools()) == 0 {
+		return &streamingpb.UpdatePChannelPoolsResponse{Pools: cm.listPChannelPools()}, nil
+	}
+
+	newPools := make(map[string]*streamingpb.PChannelPoolMeta, len(cm.pools))
+	for name, pool := range cm.pools {
+		newPools[name] = pool
+	}
+	dropped := make([]string, 0, len(req.GetDropPools()))
+	for _, name := range req.GetDropPools() {
+		if _, ok := newPools[name]; ok {
+			delete(newPools, name)
+			dropped = append(dropped, name)
+		}
+	}
+	upserted := make([]*streamingpb.PChannelPoolMeta, 0, len(req.GetUpsertPools()))
+	for _, pool := range req.GetUpsertPools() {
+		pool, err := cm.normalizePChannelPool(pool)
+		if err != nil {
+			return nil, err
+		}
+		newPools[pool.GetName()] = pool
+		upserted = append(upserted, pool)
+	}
+	if err := checkPChannelPoolsDisjoint(newPools); err != nil {
+		return nil, err
+	}
+
+	if err := resource.Resource().StreamingCatalog().SavePChannelPools(ctx, upserted, dropped); err != nil {
+		return nil, err
+	}
+	cm.pools = newPools
+	cm.Logger().Info(ctx, "pchannel pools updated",
+		mlog.Strings("upserted", lo.Map(upserted, func(pool *streamingpb.PChannelPoolMeta, _ int) string { return pool.GetName() })),
+		mlog.Strings("dropped", dropped),
+		mlog.Int("poolCount", len(cm.pools)))
+	return &streamingpb.UpdatePChannelPoolsResponse{Pools: cm.listPChannelPools()}, nil
+}
+
+// listPChannelPools returns all pchannel pools ordered by name, should be called with lock.
+func (cm *ChannelManager) listPChannelPools() []*streamingpb.PChannelPoolMeta {
+	pools := make([]*streamingpb.PChannelPoolMeta, 0, len(cm.pools))
+	for _, pool := range cm.pools {
+		pools = append(pools, proto.Clone(pool).(*streamingpb.PChannelPoolMeta))
+	}
+	sort.Slice(pools, func(i, j int) bool { return pools[i].GetName() < pools[j].GetName() })
+	return pools
+}
+
+// normalizePChannelPool checks the pool and returns a deduplicated and sorted copy of it, should be called with lock.
+func (cm *ChannelManager) normalizePChannelPool(pool *streamingpb.PChannelPoolMeta) (*streamingpb.PChannelPoolMeta, error) {
+	if pool.GetName() == "" {
+		return nil, status.NewInvalidArgument("the name of pchannel pool should not be empty")
+	}
+	if len(pool.GetPchannels()) == 0 {
+		return nil, status.NewInvalidArgument("pchannel pool %s should contain at least one pchannel", pool.GetName())
+	}
+	pchannels := typeutil.NewSet(pool.GetPchannels()...)
+	for pchannel := range pchannels {
+		if _, ok := cm.channels[ChannelID{Name: pchannel}]; !ok {
+			return nil, status.NewInvalidArgument("pchannel %s of pool %s not exist", pchannel, pool.GetName())
+		}
+	}
+	databases := typeutil.NewSet(pool.GetDatabases()...)
+	if databases.Contain("") {
+		return nil, status.NewInvalidArgument("database of pchannel pool %s should not be empty", pool.GetName())
+	}
+	normalized := &streamingpb.PChannelPoolMeta{
+		Name:      pool.GetName(),
+		Pchannels: pchannels.Collect(),
+		Databases: databases.Collect(),
+	}
+	sort.Strings(normalized.Pchannels)
+	sort.Strings(normalized.Databases)
+	return normalized, nil
+}
+
+// checkPChannelPoolsDisjoint checks that a pchannel or a database belongs to at most one pool.
+func checkPChannelPoolsDisjoint(pools map[string]*streamingpb.PChannelPoolMeta) error {
+	pchannelOwners := make(map[string]string)
+	databaseOwners := make(map[string]string)
+	for name, pool := range pools {
+		for _, pchannel := range pool.GetPchannels() {
+			if owner, ok := pchannelOwners[pchannel]; ok {
+				return status.NewInvalidArgument("pchannel %s is shared by pool %s and %s", pchannel, owner, name)
+			}
+			pchannelOwners[pchannel] = name
+		}
+		for _, database := range pool.GetDatabases() {
+			if owner, ok := databaseOwners[database]; ok {
+				return status.NewInvalidArgument("database %s is bound to pool %s and %s", database, owner, name)
+			}
+			databaseOwners[database] = name
+		}
+	}
+	return nil
+}
+
+// isAllocatableForDatabase checks if the vchannel of the database can be allocated on the pchannel, should be called with lock.
+// The database bound to a pool can only use the pchannels of the pool,
+// and the pchannels of a pool are reserved for the bound databases.
+func (cm *ChannelManager) isAllocatableForDatabase(dbName string, pchannel string) bool {
+	dbName = normalizeDBName(dbName)
+	for _, pool := range cm.pools {
+		bound := typeutil.NewSet(pool.GetDatabases()...).Contain(dbName)
+		inPool := typeutil.NewSet(pool.GetPchannels()...).Contain(pchannel)
+		if bound || inPool {
+			return bound && inPool
+		}
+	}
+	return true
+}
+
+// isBoundToPool checks if the database is bound to a pchannel pool, should be called with lock.
+func (cm *ChannelManager) isBoundToPool(dbName string) bool {
+	dbName = normalizeDBName(dbName)
+	for _, pool := range cm.pools {
+		if typeutil.NewSet(pool.GetDatabases()...).Contain(dbName) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeDBName returns the default database name if the database name is empty.
+func normalizeDBName(dbName string) string {
+	if dbName == "" {
+		return util.DefaultDBName
+	}
+	return dbName
+}
@@ -0,0 +1,103 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestChannelManagerPChannelPool(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return([]*streamingpb.PChannelPoolMeta{
+		{Name: "pool1", Pchannels: []string{"ch1"}, Databases: []string{"db1"}},
+	}, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetIDAllocator(mock.Anything, mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).Return(nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+
+	// The database bound to the pool only allocates from the pool,
+	// the other databases never allocate from the pchannels of the pool.
+	vchannels, err := m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 1, Num: 1, DBName: "db1"})
+	assert.NoError(t, err)
+	assert.Contains(t, vchannels[0], "ch1")
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 1, Num: 2, DBName: "db1"})
+	assert.Error(t, err)
+	vchannels, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 2, Num: 2})
+	assert.NoError(t, err)
+	assert.NotContains(t, vchannels[0], "ch1")
+	assert.NotContains(t, vchannels[1], "ch1")
+
+	// Invalid update is rejected without persisting.
+	_, err = m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{
+		UpsertPools: []*streamingpb.PChannelPoolMeta{{Name: "pool2", Pchannels: []string{"non-exist"}}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{
+		UpsertPools: []*streamingpb.PChannelPoolMeta{{Name: "pool2", Pchannels: []string{"ch1", "ch2"}}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{
+		UpsertPools: []*streamingpb.PChannelPoolMeta{{Name: "pool2", Pchannels: []string{"ch2"}, Databases: []string{"db1"}}},
+	})
+	assert.Error(t, err)
+
+	// The failure of catalog is returned and the pools are not changed.
+	catalog.EXPECT().SavePChannelPools(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{DropPools: []string{"pool1"}})
+	assert.Error(t, err)
+	assert.Len(t, m.ListPChannelPools(), 1)
+
+	// Replace pool1 and create pool2, the pchannels and databases are deduplicated and sorted.
+	catalog.EXPECT().SavePChannelPools(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	resp, err := m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{
+		UpsertPools: []*streamingpb.PChannelPoolMeta{
+			{Name: "pool1", Pchannels: []string{"ch2", "ch1", "ch1"}, Databases: []string{"db1"}},
+			{Name: "pool2", Pchannels: []string{"ch3"}, Databases: []string{"db3", "db2"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetPools(), 2)
+	assert.Equal(t, []string{"ch1", "ch2"}, resp.GetPools()[0].GetPchannels())
+	assert.Equal(t, []string{"db2", "db3"}, resp.GetPools()[1].GetDatabases())
+
+	// No pchannel is left for the unbound database.
+	_, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 3, Num: 1, DBName: "default"})
+	assert.Error(t, err)
+	vchannels, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 3, Num: 1, DBName: "db2"})
+	assert.NoError(t, err)
+	assert.Contains(t, vchannels[0], "ch3")
+
+	// Drop the pools, the empty request lists the pools.
+	resp, err = m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{DropPools: []string{"pool1", "pool2", "non-exist"}})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetPools())
+	resp, err = m.UpdatePChannelPools(ctx, &streamingpb.UpdatePChannelPoolsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetPools())
+	vchannels, err = m.AllocVirtualChannels(ctx, AllocVChannelParam{CollectionID: 4, Num: 3, DBName: "db1"})
+	assert.NoError(t, err)
+	assert.Len(t, vchannels, 3)
+}
@@ -0,0 +1,89 @@
+package channel
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+// UpdatePChannelRetentionPolicies sets or clears the retention policies of the wal of pchannels, and returns all policies after the update.
+// The policies are persisted in the pchannel meta, and synced by the streamingnode to truncate the wal.
+// The update is applied atomically, any invalid policy in the request fails the whole update.
+func (cm *ChannelManager) UpdatePChannelRetentionPolicies(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	policies := make(map[ChannelID]*streamingpb.WALRetentionPolicy, len(req.GetPolicies())+len(req.GetClearPchannels()))
+	for _, name := range req.GetClearPchannels() {
+		policies[ChannelID{Name: name}] = nil
+	}
+	for _, policy := range req.GetPolicies() {
+		if policy.GetPolicy().GetTtlSeconds() == 0 && policy.GetPolicy().GetMaxBytes() == 0 {
+			return nil, status.NewInvalidArgument("retention policy of pchannel %s has neither ttl nor max bytes", policy.GetPchannel())
+		}
+		id := ChannelID{Name: policy.GetPchannel()}
+		if _, ok := policies[id]; ok {
+			return nil, status.NewInvalidArgument("retention policy of pchannel %s is set or cleared more than once", policy.GetPchannel())
+		}
+		policies[id] = policy.GetPolicy()
+	}
+
+	pChannelMetas := make([]*streamingpb.PChannelMeta, 0, len(policies))
+	for id, policy := range policies {
+		pchannel, ok := cm.channels[id]
+		if !ok {
+			return nil, status.NewInvalidArgument("pchannel %s not found", id.Name)
+		}
+		if proto.Equal(pchannel.RetentionPolicy(), policy) {
+			continue
+		}
+		mutablePChannel := pchannel.CopyForWrite()
+		mutablePChannel.SetRetentionPolicy(policy)
+		pChannelMetas = append(pChannelMetas, mutablePChannel.IntoRawMeta())
+	}
+	if err := cm.updatePChannelMeta(ctx, pChannelMetas); err != nil {
+		return nil, err
+	}
+	result := cm.listPChannelRetentionPolicies()
+	if len(pChannelMetas) > 0 {
+		cm.Logger().Info(ctx, "pchannel retention policies updated", mlog.Int("modified", len(pChannelMetas)), mlog.Int("policyCount", len(result)))
+	}
+	return &streamingpb.UpdatePChannelRetentionPoliciesResponse{Policies: result}, nil
+}
+
+// GetPChannelRetentionPolicies returns the retention policies of the given pchannels.
+// The pchannel without retention policy is not in the result.
+func (cm *ChannelManager) GetPChannelRetentionPolicies(pchannels []string) map[string]*streamingpb.WALRetentionPolicy {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	policies := make(map[string]*streamingpb.WALRetentionPolicy, len(pchannels))
+	for _, name := range pchannels {
+		if pchannel, ok := cm.channels[ChannelID{Name: name}]; ok && pchannel.RetentionPolicy() != nil {
+			policies[name] = proto.Clone(pchannel.RetentionPolicy()).(*streamingpb.WALRetentionPolicy)
+		}
+	}
+	return policies
+}
+
+// listPChannelRetentionPolicies returns all retention policies ordered by the pchannel name.
+func (cm *ChannelManager) listPChannelRetentionPolicies() []*streamingpb.PChannelRetentionPolicy {
+	policies := make([]*streamingpb.PChannelRetentionPolicy, 0)
+	for _, pchannel := range cm.channels {
+		if policy := pchannel.RetentionPolicy(); policy != nil {
+			policies = append(policies, &streamingpb.PChannelRetentionPolicy{
+				Pchannel: pchannel.Name(),
+				Policy:   proto.Clone(policy).(*streamingpb.WALRetentionPolicy),
+			})
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].GetPchannel() < policies[j].GetPchannel()
+	})
+	return policies
+}
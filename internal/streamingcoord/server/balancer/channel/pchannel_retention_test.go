@@ -0,0 +1,97 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestChannelManagerPChannelRetentionPolicy(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	// The policy is recovered from the pchannel meta.
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		{
+			Channel:         &streamingpb.PChannelInfo{Name: "ch1", Term: 1},
+			State:           streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNINITIALIZED,
+			RetentionPolicy: &streamingpb.WALRetentionPolicy{TtlSeconds: 3600},
+		},
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+	resp, err := m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetPolicies(), 1)
+	assert.Equal(t, "ch1", resp.GetPolicies()[0].GetPchannel())
+	assert.Equal(t, uint64(3600), resp.GetPolicies()[0].GetPolicy().GetTtlSeconds())
+
+	// Invalid update is rejected without persisting.
+	_, err = m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{
+		Policies: []*streamingpb.PChannelRetentionPolicy{{Pchannel: "non-exist", Policy: &streamingpb.WALRetentionPolicy{TtlSeconds: 1}}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{
+		Policies: []*streamingpb.PChannelRetentionPolicy{{Pchannel: "ch2", Policy: &streamingpb.WALRetentionPolicy{}}},
+	})
+	assert.Error(t, err)
+	_, err = m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{
+		Policies:       []*streamingpb.PChannelRetentionPolicy{{Pchannel: "ch2", Policy: &streamingpb.WALRetentionPolicy{MaxBytes: 1024}}},
+		ClearPchannels: []string{"ch2"},
+	})
+	assert.Error(t, err)
+
+	// The failure of catalog is returned and the policies are not changed.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{ClearPchannels: []string{"ch1"}})
+	assert.Error(t, err)
+	assert.NotNil(t, m.channels[newChannelID("ch1")].RetentionPolicy())
+
+	// Set and clear the policies, the version is increased if the policies are modified.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	version := m.version.Local
+	resp, err = m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{
+		Policies: []*streamingpb.PChannelRetentionPolicy{
+			{Pchannel: "ch3", Policy: &streamingpb.WALRetentionPolicy{MaxBytes: 1024}},
+			{Pchannel: "ch2", Policy: &streamingpb.WALRetentionPolicy{TtlSeconds: 60, MaxBytes: 2048}},
+		},
+		ClearPchannels: []string{"ch1"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetPolicies(), 2)
+	assert.Equal(t, "ch2", resp.GetPolicies()[0].GetPchannel())
+	assert.Equal(t, "ch3", resp.GetPolicies()[1].GetPchannel())
+	assert.Equal(t, version+1, m.version.Local)
+	assert.Nil(t, m.channels[newChannelID("ch1")].RetentionPolicy())
+
+	// Nothing is persisted if the policies are not modified.
+	_, err = m.UpdatePChannelRetentionPolicies(ctx, &streamingpb.UpdatePChannelRetentionPoliciesRequest{
+		Policies: []*streamingpb.PChannelRetentionPolicy{{Pchannel: "ch3", Policy: &streamingpb.WALRetentionPolicy{MaxBytes: 1024}}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, version+1, m.version.Local)
+
+	policies := m.GetPChannelRetentionPolicies([]string{"ch1", "ch2", "non-exist"})
+	assert.Len(t, policies, 1)
+	assert.True(t, proto.Equal(&streamingpb.WALRetentionPolicy{TtlSeconds: 60, MaxBytes: 2048}, policies["ch2"]))
+}
@@ -13,9 +13,10 @@ var StaticPChannelStatsManager = syncutil.NewFuture[*PchannelStatsManager]()
 // RecoverPChannelStatsManager recovers the pchannel stats manager.
 func RecoverPChannelStatsManager(vchannels []string) {
 	m := &PchannelStatsManager{
-		mu:    sync.Mutex{},
-		n:     syncutil.NewVersionedNotifier(),
-		stats: make(map[ChannelID]*pchannelStats),
+		mu:                  sync.Mutex{},
+		n:                   syncutil.NewVersionedNotifier(),
+		stats:               make(map[ChannelID]*pchannelStats),
+		collectionDatabases: make(map[int64]string),
 	}
 	m.AddVChannel(vchannels...)
 	StaticPChannelStatsManager.Set(m)
@@ -28,6 +29,8 @@ type PchannelStatsManager struct {
 	mu    sync.Mutex
 	n     *syncutil.VersionedNotifier
 	stats map[ChannelID]*pchannelStats
+
+	collectionDatabases map[int64]string // collectionDatabases maps the collection id to the database name, used to count the vchannels of database.
 }
 
 // WatchAtChannelCountChanged returns a channel that will be notified when the channel count changed.
@@ -69,14 +72,55 @@ func (pm *PchannelStatsManager) RemoveVChannel(vchannels ...string) {
 			Name: pchannel,
 		})
 		p.RemoveVChannel(vchannel)
+		pm.mu.Lock()
+		delete(pm.collectionDatabases, funcutil.GetCollectionIDFromVChannel(vchannel))
+		pm.mu.Unlock()
 	}
 	pm.n.NotifyAll()
 }
 
+// SetCollectionDatabase records the database of the collection,
+// so the vchannels of the collection are counted into the vchannel quota of the database.
+func (pm *PchannelStatsManager) SetCollectionDatabase(collectionID int64, dbName string) {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	pm.collectionDatabases[collectionID] = normalizeDBName(dbName)
+}
+
+// DatabaseVChannelCount returns the count of vchannels of the database on the pchannels that are accepted by the filter.
+// The vchannel of the collection without recorded database is not counted.
+func (pm *PchannelStatsManager) DatabaseVChannelCount(dbName string, filter func(id ChannelID) bool) int {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	dbName = normalizeDBName(dbName)
+	cnt := 0
+	for id, stats := range pm.stats {
+		if !filter(id) {
+			continue
+		}
+		for _, collectionID := range stats.CollectionIDs() {
+			if db, ok := pm.collectionDatabases[collectionID]; ok && db == dbName {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
+
+// UpdateAppendMetrics updates the append traffic of the pchannels reported by the streaming nodes.
+// The channel count changed notification is not triggered, the traffic is only used by the next balance round.
+func (pm *PchannelStatsManager) UpdateAppendMetrics(metrics ...types.RWWALMetrics) {
+	for _, m := range metrics {
+		pm.GetPChannelStats(m.ChannelInfo.ChannelID()).UpdateAppendMetrics(m.AppendBytesPerSecond, m.AppendBytesTotal)
+	}
+}
+
 // pchannelStats is the stats of the pchannel.
 type pchannelStats struct {
-	mu        sync.Mutex
-	vchannels map[string]int64 // indicate how much vchannel is available at current pchannel.
+	mu                   sync.Mutex
+	vchannels            map[string]int64 // indicate how much vchannel is available at current pchannel.
+	appendBytesPerSecond float64          // the average append throughput reported by the streaming node.
+	appendBytesTotal     uint64           // the total appended bytes reported by the streaming node.
 }
 
 // VChannelCount returns the count of vchannel in the pchannel.
@@ -103,6 +147,14 @@ func (s *pchannelStats) RemoveVChannel(name string) {
 	delete(s.vchannels, name)
 }
 
+// UpdateAppendMetrics updates the append traffic of the pchannel.
+func (s *pchannelStats) UpdateAppendMetrics(bytesPerSecond float64, bytesTotal uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.appendBytesPerSecond = bytesPerSecond
+	s.appendBytesTotal = bytesTotal
+}
+
 // View returns the View of the pchannel stats.
 func (s *pchannelStats) View() PChannelStatsView {
 	s.mu.Lock()
@@ -112,7 +164,9 @@ func (s *pchannelStats) View() PChannelStatsView {
 		vchannels[k] = v
 	}
 	return PChannelStatsView{
-		VChannels: vchannels,
+		VChannels:            vchannels,
+		AppendBytesPerSecond: s.appendBytesPerSecond,
+		AppendBytesTotal:     s.appendBytesTotal,
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
 )
 
@@ -184,3 +185,73 @@ func TestPChannel(t *testing.T) {
 		}
 	}
 }
+
+func TestPChannelOwnershipHistories(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.StreamingCfg.WALBalancerAssignmentHistoryMaxCount.Key, "3")
+	defer params.Reset(params.StreamingCfg.WALBalancerAssignmentHistoryMaxCount.Key)
+
+	assignDone := func(pchannel *PChannelMeta, serverID int64) *PChannelMeta {
+		m := pchannel.CopyForWrite()
+		m.TryAssignToServerID(types.AccessModeRW, types.StreamingNodeInfo{ServerID: serverID})
+		m.AssignToServerDone()
+		return newPChannelMetaFromProto(m.IntoRawMeta(), nil)
+	}
+
+	pchannel := NewPChannelMeta("test-channel", types.AccessModeRW)
+	assert.Empty(t, pchannel.OwnershipHistories())
+
+	// The failed assignment is not recorded.
+	m := pchannel.CopyForWrite()
+	m.TryAssignToServerID(types.AccessModeRW, types.StreamingNodeInfo{ServerID: 1})
+	pchannel = newPChannelMetaFromProto(m.IntoRawMeta(), nil)
+	assert.Empty(t, pchannel.OwnershipHistories())
+
+	pchannel = assignDone(pchannel, 2)
+	histories := pchannel.OwnershipHistories()
+	assert.Len(t, histories, 1)
+	assert.Equal(t, int64(3), histories[0].GetTerm())
+	assert.Equal(t, int64(2), histories[0].GetNode().GetServerId())
+	assert.Equal(t, streamingpb.PChannelAccessMode_PCHANNEL_ACCESS_READWRITE, histories[0].GetAccessMode())
+	assert.NotZero(t, histories[0].GetAssignedTimestampSeconds())
+
+	// The returned histories are copied.
+	histories[0].Term = 100
+	assert.Equal(t, int64(3), pchannel.OwnershipHistories()[0].GetTerm())
+
+	// Trimmed by the max count.
+	for i := int64(3); i <= 6; i++ {
+		pchannel = assignDone(pchannel, i)
+	}
+	histories = pchannel.OwnershipHistories()
+	assert.Len(t, histories, 3)
+	assert.Equal(t, []int64{4, 5, 6}, []int64{
+		histories[0].GetNode().GetServerId(),
+		histories[1].GetNode().GetServerId(),
+		histories[2].GetNode().GetServerId(),
+	})
+	assert.Equal(t, int64(5), histories[0].GetTerm())
+
+	// Trimmed by the max age, the last finished assignment is kept.
+	for _, h := range pchannel.inner.OwnershipHistories {
+		h.AssignedTimestampSeconds = 1
+	}
+	pchannel = assignDone(pchannel, 7)
+	histories = pchannel.OwnershipHistories()
+	assert.Len(t, histories, 1)
+	assert.Equal(t, int64(7), histories[0].GetNode().GetServerId())
+
+	params.Save(params.StreamingCfg.WALBalancerAssignmentHistoryMaxAge.Key, "1s")
+	defer params.Reset(params.StreamingCfg.WALBalancerAssignmentHistoryMaxAge.Key)
+	pchannel.inner.OwnershipHistories[0].AssignedTimestampSeconds = 1
+	pchannel = assignDone(pchannel, 8)
+	histories = pchannel.OwnershipHistories()
+	assert.Len(t, histories, 1)
+	assert.Equal(t, int64(8), histories[0].GetNode().GetServerId())
+
+	// No history is kept if the max count is zero.
+	params.Save(params.StreamingCfg.WALBalancerAssignmentHistoryMaxCount.Key, "0")
+	pchannel = assignDone(pchannel, 9)
+	assert.Empty(t, pchannel.OwnershipHistories())
+}
@@ -0,0 +1,80 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+// CheckPChannelTopicMigration checks if the pchannels can be migrated to the target topics.
+// The target topic should be a new topic, it's never the default topic or current topic of any pchannel,
+// because the wal is started from the earliest message of the target topic. The cluster should not be replicating,
+// because the checkpoints confirmed by the target clusters are the message ids of the current topic.
+func (cm *ChannelManager) CheckPChannelTopicMigration(pchannelTopics map[string]string) error {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	if len(pchannelTopics) == 0 {
+		return status.NewInvalidArgument("no pchannel to migrate")
+	}
+	if cm.replicateConfig != nil && cm.replicateConfig.IsJoinReplication() {
+		return status.NewInvalidArgument("the topic of pchannel cannot be migrated when the cluster is replicating")
+	}
+	usedTopics := make(map[string]string, len(cm.channels)*2)
+	for _, pchannel := range cm.channels {
+		usedTopics[pchannel.Name()] = pchannel.Name()
+		usedTopics[pchannel.ChannelInfo().TopicName()] = pchannel.Name()
+	}
+	targetTopics := make(map[string]string, len(pchannelTopics))
+	for name, topic := range pchannelTopics {
+		pchannel, ok := cm.channels[ChannelID{Name: name}]
+		if !ok {
+			return status.NewInvalidArgument("pchannel %s not found", name)
+		}
+		if topic == "" {
+			return status.NewInvalidArgument("target topic of pchannel %s is empty", name)
+		}
+		if topic == pchannel.ChannelInfo().TopicName() {
+			return status.NewInvalidArgument("pchannel %s is already on topic %s", name, topic)
+		}
+		if owner, ok := usedTopics[topic]; ok {
+			return status.NewInvalidArgument("target topic %s of pchannel %s is used by pchannel %s", topic, name, owner)
+		}
+		if owner, ok := targetTopics[topic]; ok {
+			return status.NewInvalidArgument("target topic %s is requested by both pchannel %s and %s", topic, name, owner)
+		}
+		targetTopics[topic] = name
+	}
+	return nil
+}
+
+// UpdatePChannelTopics persists the topics of the pchannels after the wal is fenced for migration.
+// The topic of wal is determined by the consume checkpoint at streamingnode, the topic in meta is used by the new pchannel and the operator.
+// It's idempotent, so it can be called again when the ack callback of the migration is retried.
+func (cm *ChannelManager) UpdatePChannelTopics(ctx context.Context, pchannelTopics map[string]string) error {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	pChannelMetas := make([]*streamingpb.PChannelMeta, 0, len(pchannelTopics))
+	for name, topic := range pchannelTopics {
+		pchannel, ok := cm.channels[ChannelID{Name: name}]
+		if !ok {
+			return status.NewInvalidArgument("pchannel %s not found", name)
+		}
+		if pchannel.ChannelInfo().Topic == topic {
+			continue
+		}
+		mutablePChannel := pchannel.CopyForWrite()
+		mutablePChannel.SetTopic(topic)
+		pChannelMetas = append(pChannelMetas, mutablePChannel.IntoRawMeta())
+	}
+	if err := cm.updatePChannelMeta(ctx, pChannelMetas); err != nil {
+		return err
+	}
+	if len(pChannelMetas) > 0 {
+		cm.Logger().Info(ctx, "pchannel topics updated", mlog.Int("modified", len(pChannelMetas)), mlog.Any("pchannelTopics", pchannelTopics))
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+func TestChannelManagerPChannelTopic(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	// The topic is recovered from the pchannel meta.
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		{
+			Channel: &streamingpb.PChannelInfo{Name: "ch1", Term: 1, Topic: "topic1"},
+			State:   streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNINITIALIZED,
+		},
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3")
+	assert.NoError(t, err)
+	assert.Equal(t, "topic1", m.channels[newChannelID("ch1")].ChannelInfo().TopicName())
+	assert.Equal(t, "ch2", m.channels[newChannelID("ch2")].ChannelInfo().TopicName())
+
+	// Invalid migrations.
+	for _, pchannelTopics := range []map[string]string{
+		{},
+		{"non-exist": "topic"},
+		{"ch2": ""},
+		{"ch1": "topic1"},                  // already on the topic.
+		{"ch1": "ch1"},                     // the default topic of ch1 may be not empty.
+		{"ch2": "topic1"},                  // used by ch1.
+		{"ch2": "ch3"},                     // used by ch3 as the default topic.
+		{"ch2": "topic2", "ch3": "topic2"}, // requested twice.
+	} {
+		assert.Error(t, m.CheckPChannelTopicMigration(pchannelTopics))
+	}
+	assert.NoError(t, m.CheckPChannelTopicMigration(map[string]string{"ch1": "topic3", "ch2": "topic2"}))
+
+	// The migration is rejected when the cluster is replicating.
+	m.replicateConfig = replicateutil.MustNewConfigHelper("by-dev", &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2", "ch3"}},
+			{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5", "ch6"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+		},
+	})
+	assert.Error(t, m.CheckPChannelTopicMigration(map[string]string{"ch2": "topic2"}))
+	m.replicateConfig = nil
+
+	// The failure of catalog is returned and the topics are not changed.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	assert.Error(t, m.UpdatePChannelTopics(ctx, map[string]string{"ch2": "topic2"}))
+	assert.Equal(t, "ch2", m.channels[newChannelID("ch2")].ChannelInfo().TopicName())
+
+	// Update the topics, the update is idempotent.
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, metas []*streamingpb.PChannelMeta) error {
+		assert.Len(t, metas, 1)
+		assert.Equal(t, "topic2", metas[0].GetChannel().GetTopic())
+		return nil
+	}).Once()
+	version := m.version.Local
+	assert.NoError(t, m.UpdatePChannelTopics(ctx, map[string]string{"ch1": "topic1", "ch2": "topic2"}))
+	assert.Equal(t, "topic2", m.channels[newChannelID("ch2")].ChannelInfo().TopicName())
+	assert.Greater(t, m.version.Local, version)
+	version = m.version.Local
+	assert.NoError(t, m.UpdatePChannelTopics(ctx, map[string]string{"ch2": "topic2"}))
+	assert.Equal(t, version, m.version.Local)
+	assert.Error(t, m.UpdatePChannelTopics(ctx, map[string]string{"non-exist": "topic"}))
+
+	// The old topic of the migrated pchannel is never reused, the messages in it are not consumed any more.
+	assert.Error(t, m.CheckPChannelTopicMigration(map[string]string{"ch2": "ch2"}))
+	assert.Error(t, m.CheckPChannelTopicMigration(map[string]string{"ch3": "topic2"}))
+}
@@ -37,6 +37,8 @@ type PChannelView struct {
 
 // PChannelStatsView is the view of the pchannel stats.
 type PChannelStatsView struct {
-	LastAssignTimestamp time.Time
-	VChannels           map[string]int64
+	LastAssignTimestamp  time.Time
+	VChannels            map[string]int64
+	AppendBytesPerSecond float64 // the average append throughput of the pchannel, 0 if not reported.
+	AppendBytesTotal     uint64  // the total appended bytes of the pchannel since the wal is opened.
 }
@@ -39,4 +39,15 @@ func TestPChannelView(t *testing.T) {
 		"by-dev-rootcoord-dml_0_101v0",
 	)
 	StaticPChannelStatsManager.Get().WatchAtChannelCountChanged()
+
+	// The append traffic reported by the streaming node is kept in the view.
+	StaticPChannelStatsManager.Get().UpdateAppendMetrics(types.RWWALMetrics{
+		ChannelInfo:          types.PChannelInfo{Name: "test", Term: 1},
+		AppendBytesPerSecond: 1024,
+		AppendBytesTotal:     4096,
+	})
+	view = newPChannelView(metas)
+	assert.Equal(t, float64(1024), view.Stats[types.ChannelID{Name: "test"}].AppendBytesPerSecond)
+	assert.Equal(t, uint64(4096), view.Stats[types.ChannelID{Name: "test"}].AppendBytesTotal)
+	assert.Zero(t, view.Stats[types.ChannelID{Name: "test2"}].AppendBytesPerSecond)
 }
@@ -0,0 +1,50 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+// CurrentReadReplicas returns the read-only replicas of all pchannels.
+func (cm *ChannelManager) CurrentReadReplicas() map[ChannelID][]types.PChannelInfoAssigned {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	replicas := make(map[ChannelID][]types.PChannelInfoAssigned, len(cm.readReplicas))
+	for id, assignments := range cm.readReplicas {
+		replicas[id] = append([]types.PChannelInfoAssigned(nil), assignments...)
+	}
+	return replicas
+}
+
+// UpdateReadReplicas replaces the read-only replicas of all pchannels and notifies the watchers.
+// The read replicas are only kept in memory, they will be regenerated by the balancer after the streamingcoord is recovered,
+// the stale replicas left on the streaming node will be revoked by the lease or the next rebalance.
+func (cm *ChannelManager) UpdateReadReplicas(ctx context.Context, replicas map[ChannelID][]types.PChannelInfoAssigned) {
+	cm.cond.LockAndBroadcast()
+	defer cm.cond.L.Unlock()
+
+	total := 0
+	for _, assignments := range replicas {
+		total += len(assignments)
+	}
+	cm.readReplicas = replicas
+	cm.version.Local++
+	cm.metrics.UpdateAssignmentVersion(cm.version.Local)
+	cm.Logger().Info(ctx, "read replicas of pchannels updated", mlog.Int("readReplicaCount", total), mlog.Int64("version", cm.version.Local))
+}
+
+// isReadReplica checks if the channel is a read-only replica at the given streaming node, should be called with lock.
+func (cm *ChannelManager) isReadReplica(serverID int64, channel types.PChannelInfo) bool {
+	if channel.AccessMode != types.AccessModeRO {
+		return false
+	}
+	for _, replica := range cm.readReplicas[channel.ChannelID()] {
+		if replica.Node.ServerID == serverID && replica.Channel.Term == channel.Term {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package channel
+
+import (
+	"math"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// AssignPChannelsOption is the option of AssignPChannels.
+type AssignPChannelsOption func(opt *assignPChannelsOptions)
+
+type assignPChannelsOptions struct {
+	bypassThrottle typeutil.Set[ChannelID]
+}
+
+// OptBypassThrottle makes the reassignment of the pchannels bypass the reassign throttle.
+// It's used by the reassignment requested by operator (pin or drain), which should never be delayed.
+// The bypassed reassignment still consumes the quota of current window.
+func OptBypassThrottle(channels ...ChannelID) AssignPChannelsOption {
+	return func(opt *assignPChannelsOptions) {
+		if opt.bypassThrottle == nil {
+			opt.bypassThrottle = typeutil.NewSet[ChannelID]()
+		}
+		opt.bypassThrottle.Insert(channels...)
+	}
+}
+
+// reassignThrottle bounds the reassignment churn of the serving pchannels.
+// When many streaming nodes flap, the balancer may reassign all pchannels at once,
+// every reassignment fences the wal at the old node and increases the term.
+// The throttle limits the count of reassignments in one interval and keeps a cooldown for each pchannel after assignment.
+// The pchannel that is not serving (never assigned, assigning or unavailable) is never throttled,
+// so the recovery of unavailable wal will not be blocked.
+type reassignThrottle struct {
+	windowStart time.Time
+	count       int
+}
+
+// quota returns the remaining reassignment count of current window, math.MaxInt if there's no limit.
+func (t *reassignThrottle) quota(now time.Time) int {
+	limit := paramtable.Get().StreamingCfg.WALBalancerReassignThrottleMaxReassignments.GetAsInt()
+	if limit <= 0 {
+		return math.MaxInt
+	}
+	if t.isWindowExpired(now) {
+		return limit
+	}
+	return max(limit-t.count, 0)
+}
+
+// consume consumes the reassignment count of current window, a new window is started if current one is expired.
+func (t *reassignThrottle) consume(n int, now time.Time) {
+	if t.isWindowExpired(now) {
+		t.windowStart = now
+		t.count = 0
+	}
+	t.count += n
+}
+
+// isWindowExpired returns true if current throttle window is expired.
+func (t *reassignThrottle) isWindowExpired(now time.Time) bool {
+	return now.Sub(t.windowStart) >= paramtable.Get().StreamingCfg.WALBalancerReassignThrottleInterval.GetAsDurationByParse()
+}
+
+// isThrottled returns true if the reassignment of the pchannel should be throttled.
+// quota is the remaining reassignment count of current window.
+func (t *reassignThrottle) isThrottled(pchannel *PChannelMeta, quota int, now time.Time) bool {
+	if !pchannel.IsAssigned() {
+		return false
+	}
+	if cooldown := paramtable.Get().StreamingCfg.WALBalancerReassignThrottleCooldown.GetAsDurationByParse(); cooldown > 0 && now.Sub(pchannel.LastAssignTimestamp()) < cooldown {
+		return true
+	}
+	return quota <= 0
+}
@@ -0,0 +1,103 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestChannelManagerReassignThrottle(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+	params := paramtable.Get()
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleMaxReassignments.Key, "1")
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleInterval.Key, "1h")
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleCooldown.Key, "1h")
+	defer params.Reset(params.StreamingCfg.WALBalancerReassignThrottleMaxReassignments.Key)
+	defer params.Reset(params.StreamingCfg.WALBalancerReassignThrottleInterval.Key)
+	defer params.Reset(params.StreamingCfg.WALBalancerReassignThrottleCooldown.Key)
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	assigned := func(name string, lastAssign time.Time) *streamingpb.PChannelMeta {
+		return &streamingpb.PChannelMeta{
+			Channel:                    &streamingpb.PChannelInfo{Name: name, Term: 1, AccessMode: streamingpb.PChannelAccessMode_PCHANNEL_ACCESS_READWRITE},
+			Node:                       &streamingpb.StreamingNodeInfo{ServerId: 1},
+			State:                      streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNED,
+			LastAssignTimestampSeconds: uint64(lastAssign.Unix()),
+		}
+	}
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		assigned("ch1", time.Now().Add(-2*time.Hour)),
+		assigned("ch2", time.Now().Add(-2*time.Hour)),
+		assigned("ch3", time.Now()),
+		{
+			Channel: &streamingpb.PChannelInfo{Name: "ch4", Term: 1},
+			State:   streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNINITIALIZED,
+		},
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3", "ch4")
+	assert.NoError(t, err)
+
+	moveAll := func() map[ChannelID]types.PChannelInfoAssigned {
+		assignments := make(map[ChannelID]types.PChannelInfoAssigned)
+		for _, name := range []string{"ch1", "ch2", "ch3", "ch4"} {
+			assignments[newChannelID(name)] = types.PChannelInfoAssigned{
+				Channel: types.PChannelInfo{Name: name, AccessMode: types.AccessModeRW},
+				Node:    types.StreamingNodeInfo{ServerID: 2},
+			}
+		}
+		return assignments
+	}
+
+	// Only one serving pchannel is reassigned in one interval, the pchannel in cooldown is never reassigned,
+	// the pchannel that is never assigned is not throttled.
+	modified, err := m.AssignPChannels(ctx, moveAll())
+	assert.NoError(t, err)
+	assert.Len(t, modified, 2)
+	assert.Contains(t, modified, newChannelID("ch4"))
+	assert.NotContains(t, modified, newChannelID("ch3"))
+
+	// The assigning pchannels are retried without throttling, but the quota is exhausted for the serving ones.
+	modified, err = m.AssignPChannels(ctx, moveAll())
+	assert.NoError(t, err)
+	assert.Len(t, modified, 2)
+
+	// The cooldown is applied even if there's no limit of reassignment count.
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleMaxReassignments.Key, "0")
+	modified, err = m.AssignPChannels(ctx, moveAll())
+	assert.NoError(t, err)
+	assert.Len(t, modified, 3)
+	assert.NotContains(t, modified, newChannelID("ch3"))
+
+	// The reassignment requested by operator bypasses the throttle.
+	modified, err = m.AssignPChannels(ctx, moveAll(), OptBypassThrottle(newChannelID("ch3")))
+	assert.NoError(t, err)
+	assert.Len(t, modified, 4)
+	assert.Contains(t, modified, newChannelID("ch3"))
+
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleCooldown.Key, "0s")
+	modified, err = m.AssignPChannels(ctx, moveAll())
+	assert.NoError(t, err)
+	assert.Len(t, modified, 4)
+}
@@ -0,0 +1,61 @@
+package channel
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+// RebalanceMove is a move of pchannel that the balancer would apply.
+type RebalanceMove struct {
+	Channel        string
+	FromServerID   int64 // 0 if the pchannel is never assigned.
+	ToServerID     int64
+	FromAccessMode types.AccessMode
+	ToAccessMode   types.AccessMode
+	Throttled      bool // the move would be deferred by the reassign throttle.
+}
+
+// PreviewRebalance returns the moves that would be applied if the expected assignment is applied by AssignPChannels.
+// Nothing is persisted or broadcasted, the reassign throttle is evaluated but not consumed.
+// The moves are ordered by the pchannel name.
+func (cm *ChannelManager) PreviewRebalance(ctx context.Context, pChannelToStreamingNode map[ChannelID]types.PChannelInfoAssigned) ([]RebalanceMove, error) {
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	now := time.Now()
+	quota := cm.throttle.quota(now)
+	reassigned := 0
+	ids := make([]ChannelID, 0, len(pChannelToStreamingNode))
+	for id := range pChannelToStreamingNode {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].LT(ids[j]) })
+
+	moves := make([]RebalanceMove, 0)
+	for _, id := range ids {
+		assign := pChannelToStreamingNode[id]
+		pchannel, ok := cm.channels[id]
+		if !ok {
+			return nil, ErrChannelNotExist
+		}
+		if !pchannel.CopyForWrite().TryAssignToServerID(assign.Channel.AccessMode, assign.Node) {
+			continue
+		}
+		throttled := cm.throttle.isThrottled(pchannel, quota-reassigned, now)
+		if !throttled && pchannel.IsAssigned() {
+			reassigned++
+		}
+		moves = append(moves, RebalanceMove{
+			Channel:        id.Name,
+			FromServerID:   pchannel.CurrentServerID(),
+			ToServerID:     assign.Node.ServerID,
+			FromAccessMode: pchannel.ChannelInfo().AccessMode,
+			ToAccessMode:   assign.Channel.AccessMode,
+			Throttled:      throttled,
+		})
+	}
+	return moves, nil
+}
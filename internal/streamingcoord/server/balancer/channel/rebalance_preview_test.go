@@ -0,0 +1,96 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestChannelManagerPreviewRebalance(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+	params := paramtable.Get()
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleMaxReassignments.Key, "1")
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleInterval.Key, "1h")
+	params.Save(params.StreamingCfg.WALBalancerReassignThrottleCooldown.Key, "1h")
+	defer params.Reset(params.StreamingCfg.WALBalancerReassignThrottleMaxReassignments.Key)
+	defer params.Reset(params.StreamingCfg.WALBalancerReassignThrottleInterval.Key)
+	defer params.Reset(params.StreamingCfg.WALBalancerReassignThrottleCooldown.Key)
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().SavePChannels(mock.Anything, mock.Anything).Return(nil)
+	assigned := func(name string, lastAssign time.Time) *streamingpb.PChannelMeta {
+		return &streamingpb.PChannelMeta{
+			Channel:                    &streamingpb.PChannelInfo{Name: name, Term: 1, AccessMode: streamingpb.PChannelAccessMode_PCHANNEL_ACCESS_READWRITE},
+			Node:                       &streamingpb.StreamingNodeInfo{ServerId: 1},
+			State:                      streamingpb.PChannelMetaState_PCHANNEL_META_STATE_ASSIGNED,
+			LastAssignTimestampSeconds: uint64(lastAssign.Unix()),
+		}
+	}
+	catalog.EXPECT().ListPChannel(mock.Anything).Return([]*streamingpb.PChannelMeta{
+		assigned("ch1", time.Now().Add(-2*time.Hour)),
+		assigned("ch2", time.Now().Add(-2*time.Hour)),
+		assigned("ch3", time.Now()),
+		{
+			Channel: &streamingpb.PChannelInfo{Name: "ch4", Term: 1},
+			State:   streamingpb.PChannelMetaState_PCHANNEL_META_STATE_UNINITIALIZED,
+		},
+		assigned("ch5", time.Now().Add(-2*time.Hour)),
+	}, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2", "ch3", "ch4", "ch5")
+	assert.NoError(t, err)
+
+	assignTo := func(serverID int64, names ...string) map[ChannelID]types.PChannelInfoAssigned {
+		assignments := make(map[ChannelID]types.PChannelInfoAssigned)
+		for _, name := range names {
+			assignments[newChannelID(name)] = types.PChannelInfoAssigned{
+				Channel: types.PChannelInfo{Name: name, AccessMode: types.AccessModeRW},
+				Node:    types.StreamingNodeInfo{ServerID: serverID},
+			}
+		}
+		return assignments
+	}
+
+	// The unchanged pchannel is not a move, the throttled moves are reported but marked.
+	expected := assignTo(2, "ch1", "ch2", "ch3", "ch4")
+	expected[newChannelID("ch5")] = assignTo(1, "ch5")[newChannelID("ch5")]
+	for i := 0; i < 2; i++ {
+		moves, err := m.PreviewRebalance(ctx, expected)
+		assert.NoError(t, err)
+		assert.Equal(t, []RebalanceMove{
+			{Channel: "ch1", FromServerID: 1, ToServerID: 2, FromAccessMode: types.AccessModeRW, ToAccessMode: types.AccessModeRW},
+			{Channel: "ch2", FromServerID: 1, ToServerID: 2, FromAccessMode: types.AccessModeRW, ToAccessMode: types.AccessModeRW, Throttled: true},
+			{Channel: "ch3", FromServerID: 1, ToServerID: 2, FromAccessMode: types.AccessModeRW, ToAccessMode: types.AccessModeRW, Throttled: true},
+			{Channel: "ch4", FromServerID: 0, ToServerID: 2, FromAccessMode: types.AccessModeRW, ToAccessMode: types.AccessModeRW},
+		}, moves)
+	}
+
+	// Nothing is applied by the preview.
+	for _, name := range []string{"ch1", "ch2", "ch3", "ch5"} {
+		assert.Equal(t, int64(1), m.channels[newChannelID(name)].CurrentServerID())
+	}
+	assert.False(t, m.channels[newChannelID("ch4")].IsAssigned())
+
+	_, err = m.PreviewRebalance(ctx, assignTo(2, "non-exist"))
+	assert.ErrorIs(t, err, ErrChannelNotExist)
+}
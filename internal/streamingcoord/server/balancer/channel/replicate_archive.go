@@ -0,0 +1,104 @@
+package channel
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// getRemovedReplicatingTasks gets the archives of the replicating tasks whose replication edge is removed by the new configuration.
+// The edge is removed if the target cluster is dropped from the configuration or is not a target of current cluster any more.
+// The final checkpoint of the archive is the position of the AlterReplicateConfig message at the source pchannel,
+// which is the last message that should be replicated to the target cluster.
+func (cm *ChannelManager) getRemovedReplicatingTasks(newConfig *replicateutil.ConfigHelper, appendResults map[string]*message.AppendResult) []*streamingpb.ReplicatePChannelArchiveMeta {
+	if cm.replicateConfig == nil {
+		return nil
+	}
+	current := cm.replicateConfig.GetCurrentCluster()
+	incoming := newConfig.GetCurrentCluster()
+	removedAt := time.Now().Unix()
+	archives := make([]*streamingpb.ReplicatePChannelArchiveMeta, 0)
+	for _, targetCluster := range current.TargetClusters() {
+		if incoming.TargetCluster(targetCluster.GetClusterId()) != nil {
+			continue
+		}
+		sourceClusterID := targetCluster.SourceCluster().ClusterId
+		for _, pchannel := range targetCluster.GetPchannels() {
+			sourcePChannel := targetCluster.MustGetSourceChannel(pchannel)
+			archive := &streamingpb.ReplicatePChannelArchiveMeta{
+				Task: &streamingpb.ReplicatePChannelMeta{
+					SourceChannelName: sourcePChannel,
+					TargetChannelName: pchannel,
+					TargetCluster:     targetCluster.MilvusCluster,
+				},
+				RemovedTimestampSeconds: removedAt,
+			}
+			if result, ok := appendResults[sourcePChannel]; ok {
+				archive.FinalCheckpoint = &commonpb.ReplicateCheckpoint{
+					ClusterId: sourceClusterID,
+					Pchannel:  sourcePChannel,
+					MessageId: result.LastConfirmedMessageID.IntoProto(),
+					TimeTick:  result.TimeTick,
+				}
+			}
+			archives = append(archives, archive)
+		}
+	}
+	return archives
+}
+
+// GCRemovedReplicatingTasks removes the replicating tasks of the removed replication edges that are not removed in time.
+// The CDC removes the replicating task by itself after the target cluster confirms the AlterReplicateConfig message,
+// but the task is kept forever if the target cluster is unreachable, so it's removed forcibly after the timeout.
+// The archive is kept after the task is removed, and the task is never removed if the replication edge is added back.
+func (cm *ChannelManager) GCRemovedReplicatingTasks(ctx context.Context, timeout time.Duration) error {
+	archives, err := resource.Resource().StreamingCatalog().ListReplicatePChannelArchive(ctx)
+	if err != nil {
+		return err
+	}
+
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	var current *replicateutil.MilvusCluster
+	if cm.replicateConfig != nil {
+		current = cm.replicateConfig.GetCurrentCluster()
+	}
+	now := time.Now()
+	expired := make([]*streamingpb.ReplicatePChannelArchiveMeta, 0)
+	for _, archive := range archives {
+		if archive.GetTaskRemoved() || now.Sub(time.Unix(archive.GetRemovedTimestampSeconds(), 0)) < timeout {
+			continue
+		}
+		if current != nil && current.TargetCluster(archive.GetTask().GetTargetCluster().GetClusterId()) != nil {
+			// the replication edge is added back, the task belongs to the new replication.
+			continue
+		}
+		archive = proto.Clone(archive).(*streamingpb.ReplicatePChannelArchiveMeta)
+		archive.TaskRemoved = true
+		expired = append(expired, archive)
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	if err := resource.Resource().StreamingCatalog().SaveReplicatePChannelArchives(ctx, expired); err != nil {
+		cm.Logger().Warn(ctx, "failed to remove the replicating tasks of removed replication edges", mlog.Err(err))
+		return err
+	}
+	for _, archive := range expired {
+		cm.Logger().Info(ctx, "replicating task of removed replication edge is garbage-collected",
+			mlog.String("targetCluster", archive.GetTask().GetTargetCluster().GetClusterId()),
+			mlog.String("sourceChannel", archive.GetTask().GetSourceChannelName()),
+			mlog.String("targetChannel", archive.GetTask().GetTargetChannelName()),
+			mlog.Uint64("finalTimeTick", archive.GetFinalCheckpoint().GetTimeTick()))
+	}
+	return nil
+}
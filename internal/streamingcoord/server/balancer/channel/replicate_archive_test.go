@@ -0,0 +1,88 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+func TestGCRemovedReplicatingTasks(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "by-dev-ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(&streamingpb.ReplicateConfigurationMeta{
+		ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"by-dev-ch1"}},
+				{ClusterId: "by-dev2", Pchannels: []string{"by-dev2-ch1"}},
+				{ClusterId: "by-dev3", Pchannels: []string{"by-dev3-ch1"}},
+			},
+			CrossClusterTopology: []*commonpb.CrossClusterTopology{
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			},
+		},
+	}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "by-dev-ch1")
+	assert.NoError(t, err)
+
+	newArchive := func(targetClusterID string, removedAt time.Time, taskRemoved bool) *streamingpb.ReplicatePChannelArchiveMeta {
+		return &streamingpb.ReplicatePChannelArchiveMeta{
+			Task: &streamingpb.ReplicatePChannelMeta{
+				SourceChannelName: "by-dev-ch1",
+				TargetChannelName: targetClusterID + "-ch1",
+				TargetCluster:     &commonpb.MilvusCluster{ClusterId: targetClusterID},
+			},
+			RemovedTimestampSeconds: removedAt.Unix(),
+			TaskRemoved:             taskRemoved,
+		}
+	}
+
+	// The failure of catalog is returned.
+	catalog.EXPECT().ListReplicatePChannelArchive(mock.Anything).Return(nil, errors.New("list failure")).Once()
+	assert.Error(t, m.GCRemovedReplicatingTasks(ctx, time.Hour))
+
+	// Only the expired task of the removed replication edge is removed.
+	expired := newArchive("by-dev3", time.Now().Add(-2*time.Hour), false)
+	catalog.EXPECT().ListReplicatePChannelArchive(mock.Anything).Return([]*streamingpb.ReplicatePChannelArchiveMeta{
+		expired,
+		newArchive("by-dev4", time.Now(), false), // not expired.
+		newArchive("by-dev5", time.Now().Add(-2*time.Hour), true),  // already removed.
+		newArchive("by-dev2", time.Now().Add(-2*time.Hour), false), // the edge is added back.
+	}, nil)
+	catalog.EXPECT().SaveReplicatePChannelArchives(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	assert.Error(t, m.GCRemovedReplicatingTasks(ctx, time.Hour))
+	assert.False(t, expired.GetTaskRemoved())
+
+	catalog.EXPECT().SaveReplicatePChannelArchives(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, archives []*streamingpb.ReplicatePChannelArchiveMeta) error {
+			assert.Len(t, archives, 1)
+			assert.Equal(t, "by-dev3", archives[0].GetTask().GetTargetCluster().GetClusterId())
+			assert.True(t, archives[0].GetTaskRemoved())
+			return nil
+		}).Once()
+	assert.NoError(t, m.GCRemovedReplicatingTasks(ctx, time.Hour))
+
+	// Nothing to remove.
+	assert.NoError(t, m.GCRemovedReplicatingTasks(ctx, 24*time.Hour))
+}
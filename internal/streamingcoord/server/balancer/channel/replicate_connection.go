@@ -0,0 +1,98 @@
+package channel
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// UpdateReplicateClusterConnection updates the connection param of the cluster in the replicate configuration of current cluster,
+// and the target cluster of the replicating tasks to the cluster, e.g. the endpoint of the target cluster is changed.
+// The change is only applied to current cluster without broadcasting the AlterReplicateConfig message,
+// the replicating tasks are watched by the cdc, so the cdc restarts the replicators with the new connection param.
+// Return the version of the replicate configuration and the replicating tasks to the cluster ordered by the source channel after the update.
+func (cm *ChannelManager) UpdateReplicateClusterConnection(ctx context.Context, clusterID string, connectionParam *commonpb.ConnectionParam) (int64, []*streamingpb.ReplicatePChannelMeta, error) {
+	if clusterID == "" {
+		return 0, nil, status.NewInvalidArgument("cluster id of the connection param is empty")
+	}
+
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	if cm.replicateConfig == nil || cm.replicateConfig.GetCluster(clusterID) == nil {
+		return 0, nil, status.NewInvalidArgument("cluster %s is not in the replicate configuration of current cluster", clusterID)
+	}
+	config := proto.Clone(cm.replicateConfig.GetReplicateConfiguration()).(*commonpb.ReplicateConfiguration)
+	for _, cluster := range config.GetClusters() {
+		if cluster.GetClusterId() == clusterID {
+			cluster.ConnectionParam = proto.Clone(connectionParam).(*commonpb.ConnectionParam)
+		}
+	}
+	currentClusterID := paramtable.Get().CommonCfg.ClusterPrefix.GetValue()
+	validator := replicateutil.NewReplicateConfigValidator(config, nil, currentClusterID, cm.replicateConfig.GetCurrentCluster().GetPchannels())
+	if err := validator.Validate(); err != nil {
+		return 0, nil, status.NewInvalidArgument("invalid connection param of cluster %s: %s", clusterID, err.Error())
+	}
+
+	tasks, err := resource.Resource().StreamingCatalog().ListReplicatePChannels(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	matched := make([]*streamingpb.ReplicatePChannelMeta, 0, len(tasks))
+	for _, task := range tasks {
+		if task.GetTargetCluster().GetClusterId() == clusterID {
+			matched = append(matched, task)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].GetSourceChannelName() < matched[j].GetSourceChannelName()
+	})
+	if proto.Equal(config, cm.replicateConfig.GetReplicateConfiguration()) {
+		return cm.replicateConfigVersion, matched, nil
+	}
+
+	configMeta, err := resource.Resource().StreamingCatalog().GetReplicateConfiguration(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	version := cm.replicateConfigVersion + 1
+	configMeta = proto.Clone(configMeta).(*streamingpb.ReplicateConfigurationMeta)
+	configMeta.ReplicateConfiguration = config
+	configMeta.Version = version
+	history := &streamingpb.ReplicateConfigurationHistoryMeta{
+		Version:                 version,
+		ReplicateConfiguration:  config,
+		ForcePromoted:           configMeta.GetForcePromoted(),
+		AppliedTimestampSeconds: time.Now().Unix(),
+		RbacReplicationDisabled: configMeta.GetRbacReplicationDisabled(),
+		CollectionFilters:       configMeta.GetCollectionFilters(),
+	}
+	for i, task := range matched {
+		task = proto.Clone(task).(*streamingpb.ReplicatePChannelMeta)
+		task.TargetCluster.ConnectionParam = proto.Clone(connectionParam).(*commonpb.ConnectionParam)
+		matched[i] = task
+	}
+	if err := resource.Resource().StreamingCatalog().SaveReplicateConfiguration(ctx, configMeta, history, matched); err != nil {
+		cm.Logger().Warn(ctx, "failed to update the connection param of replication cluster", mlog.Err(err))
+		return 0, nil, err
+	}
+	cm.Logger().Info(ctx, "connection param of replication cluster updated",
+		mlog.String("cluster", clusterID),
+		mlog.String("uri", connectionParam.GetUri()),
+		mlog.Int64("version", version),
+		mlog.Int("tasks", len(matched)))
+
+	cm.replicateConfig = replicateutil.MustNewConfigHelper(currentClusterID, config)
+	cm.replicateConfigVersion = version
+	return version, matched, nil
+}
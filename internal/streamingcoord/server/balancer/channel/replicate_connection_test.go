@@ -0,0 +1,116 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestUpdateReplicateClusterConnection(t *testing.T) {
+	paramtable.Init()
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "by-dev-ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	configMeta := &streamingpb.ReplicateConfigurationMeta{
+		ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"by-dev-ch1", "by-dev-ch2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://by-dev:19530"}},
+				{ClusterId: "by-dev2", Pchannels: []string{"by-dev2-ch1", "by-dev2-ch2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://by-dev2:19530"}},
+				{ClusterId: "by-dev3", Pchannels: []string{"by-dev3-ch1", "by-dev3-ch2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://by-dev3:19530"}},
+			},
+			CrossClusterTopology: []*commonpb.CrossClusterTopology{
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev3"},
+			},
+		},
+		ForcePromoted: true,
+		Version:       3,
+	}
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(configMeta, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "by-dev-ch1")
+	assert.NoError(t, err)
+
+	newTask := func(targetClusterID string, index string) *streamingpb.ReplicatePChannelMeta {
+		return &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: "by-dev-ch" + index,
+			TargetChannelName: targetClusterID + "-ch" + index,
+			TargetCluster: &commonpb.MilvusCluster{
+				ClusterId:       targetClusterID,
+				ConnectionParam: &commonpb.ConnectionParam{Uri: "http://" + targetClusterID + ":19530"},
+			},
+		}
+	}
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask("by-dev2", "2"),
+		newTask("by-dev2", "1"),
+		newTask("by-dev3", "1"),
+	}, nil)
+
+	// Invalid requests.
+	newParam := &commonpb.ConnectionParam{Uri: "http://by-dev2-new:19530", Token: "root:Milvus"}
+	_, _, err = m.UpdateReplicateClusterConnection(ctx, "", newParam)
+	assert.Error(t, err)
+	_, _, err = m.UpdateReplicateClusterConnection(ctx, "by-dev4", newParam)
+	assert.Error(t, err)
+	_, _, err = m.UpdateReplicateClusterConnection(ctx, "by-dev2", nil)
+	assert.Error(t, err)
+	_, _, err = m.UpdateReplicateClusterConnection(ctx, "by-dev2", &commonpb.ConnectionParam{Uri: "invalid uri"})
+	assert.Error(t, err)
+	_, _, err = m.UpdateReplicateClusterConnection(ctx, "by-dev2", &commonpb.ConnectionParam{Uri: "http://by-dev3:19530"})
+	assert.ErrorContains(t, err, "duplicate URI")
+
+	// Nothing is saved if the connection param is not changed.
+	version, tasks, err := m.UpdateReplicateClusterConnection(ctx, "by-dev2", &commonpb.ConnectionParam{Uri: "http://by-dev2:19530"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), version)
+	assert.Len(t, tasks, 2)
+
+	// The configuration and the replicating tasks to the cluster are saved together.
+	catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, _, err = m.UpdateReplicateClusterConnection(ctx, "by-dev2", newParam)
+	assert.Error(t, err)
+	catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, tasks []*streamingpb.ReplicatePChannelMeta) error {
+			assert.Equal(t, int64(4), config.GetVersion())
+			assert.True(t, config.GetForcePromoted())
+			assert.Equal(t, "http://by-dev2-new:19530", config.GetReplicateConfiguration().GetClusters()[1].GetConnectionParam().GetUri())
+			assert.Equal(t, "http://by-dev3:19530", config.GetReplicateConfiguration().GetClusters()[2].GetConnectionParam().GetUri())
+			assert.Equal(t, int64(4), history.GetVersion())
+			assert.Len(t, tasks, 2)
+			for _, task := range tasks {
+				assert.Equal(t, "by-dev2", task.GetTargetCluster().GetClusterId())
+				assert.Equal(t, "root:Milvus", task.GetTargetCluster().GetConnectionParam().GetToken())
+			}
+			return nil
+		}).Once()
+	version, tasks, err = m.UpdateReplicateClusterConnection(ctx, "by-dev2", newParam)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), version)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "by-dev-ch1", tasks[0].GetSourceChannelName())
+	assert.Equal(t, "http://by-dev2-new:19530", tasks[0].GetTargetCluster().GetConnectionParam().GetUri())
+	assert.Equal(t, "http://by-dev2-new:19530", m.replicateConfig.GetCluster("by-dev2").GetConnectionParam().GetUri())
+	// the stored configuration is not modified in place.
+	assert.Equal(t, "http://by-dev2:19530", configMeta.GetReplicateConfiguration().GetClusters()[1].GetConnectionParam().GetUri())
+}
@@ -0,0 +1,41 @@
+package channel
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// getCollectionFilterUpdatedTasks gets the replicating tasks whose collection filter is changed by the new configuration.
+// Only the tasks of the target clusters kept by the new configuration are updated,
+// the tasks of the new target clusters are created with the filter and the tasks of the removed ones are archived.
+func (cm *ChannelManager) getCollectionFilterUpdatedTasks(ctx context.Context, newConfig *replicateutil.ConfigHelper, collectionFilters map[string]*messagespb.ReplicateCollectionFilter) ([]*streamingpb.ReplicatePChannelMeta, error) {
+	if cm.replicateConfig == nil || replicateutil.CollectionFiltersEqual(collectionFilters, cm.collectionFilters) {
+		return nil, nil
+	}
+	current := cm.replicateConfig.GetCurrentCluster()
+	incoming := newConfig.GetCurrentCluster()
+	tasks, err := resource.Resource().StreamingCatalog().ListReplicatePChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated := make([]*streamingpb.ReplicatePChannelMeta, 0)
+	for _, task := range tasks {
+		clusterID := task.GetTargetCluster().GetClusterId()
+		if current.TargetCluster(clusterID) == nil || incoming.TargetCluster(clusterID) == nil {
+			continue
+		}
+		if proto.Equal(task.GetCollectionFilter(), collectionFilters[clusterID]) {
+			continue
+		}
+		task = proto.Clone(task).(*streamingpb.ReplicatePChannelMeta)
+		task.CollectionFilter = collectionFilters[clusterID]
+		updated = append(updated, task)
+	}
+	return updated, nil
+}
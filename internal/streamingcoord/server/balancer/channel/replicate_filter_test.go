@@ -0,0 +1,114 @@
+package channel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+func TestUpdateReplicateConfigurationCollectionFilters(t *testing.T) {
+	paramtable.Init()
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+
+	ctx := context.Background()
+	cfg := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"ch1", "ch2"}},
+			{ClusterId: "by-dev2", Pchannels: []string{"ch4", "ch5"}},
+			{ClusterId: "by-dev3", Pchannels: []string{"ch7", "ch8"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			{SourceClusterId: "by-dev", TargetClusterId: "by-dev3"},
+		},
+	}
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(&streamingpb.ReplicateConfigurationMeta{
+		ReplicateConfiguration: cfg,
+		Version:                1,
+	}, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	m, err := RecoverChannelManager(ctx, "ch1", "ch2")
+	assert.NoError(t, err)
+
+	filter := &messagespb.ReplicateCollectionFilter{Includes: []string{"db1"}, Excludes: []string{"db1.tmp"}}
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		{SourceChannelName: "ch1", TargetChannelName: "ch4", TargetCluster: cfg.Clusters[1]},
+		{SourceChannelName: "ch2", TargetChannelName: "ch5", TargetCluster: cfg.Clusters[1], CollectionFilter: filter},
+		{SourceChannelName: "ch1", TargetChannelName: "ch7", TargetCluster: cfg.Clusters[2]},
+		{SourceChannelName: "ch2", TargetChannelName: "ch8", TargetCluster: cfg.Clusters[2]},
+	}, nil).Once()
+	catalog.EXPECT().SaveReplicateConfiguration(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, config *streamingpb.ReplicateConfigurationMeta, history *streamingpb.ReplicateConfigurationHistoryMeta, replicatingTasks []*streamingpb.ReplicatePChannelMeta) error {
+			assert.Equal(t, int64(2), config.GetVersion())
+			assert.Equal(t, filter, config.GetCollectionFilters()["by-dev2"])
+			assert.Equal(t, filter, history.GetCollectionFilters()["by-dev2"])
+			// only the task of by-dev2 without the new filter is updated.
+			assert.Len(t, replicatingTasks, 1)
+			assert.Equal(t, "ch4", replicatingTasks[0].GetTargetChannelName())
+			assert.Equal(t, filter, replicatingTasks[0].GetCollectionFilter())
+			return nil
+		}).Once()
+
+	newAlterReplicateConfigResult := func(filters map[string]*messagespb.ReplicateCollectionFilter) message.BroadcastResultAlterReplicateConfigMessageV2 {
+		msg := message.NewAlterReplicateConfigMessageBuilderV2().
+			WithHeader(&message.AlterReplicateConfigMessageHeader{ReplicateConfiguration: cfg, CollectionFilters: filters}).
+			WithBody(&message.AlterReplicateConfigMessageBody{}).
+			WithBroadcast([]string{"ch1", "ch2"}).
+			MustBuildBroadcast()
+		return message.BroadcastResultAlterReplicateConfigMessageV2{
+			Message: message.MustAsBroadcastAlterReplicateConfigMessageV2(msg),
+			Results: map[string]*message.AppendResult{
+				"ch1": {MessageID: walimplstest.NewTestMessageID(1), LastConfirmedMessageID: walimplstest.NewTestMessageID(1), TimeTick: 10},
+				"ch2": {MessageID: walimplstest.NewTestMessageID(2), LastConfirmedMessageID: walimplstest.NewTestMessageID(2), TimeTick: 10},
+			},
+		}
+	}
+	result := newAlterReplicateConfigResult(map[string]*messagespb.ReplicateCollectionFilter{"by-dev2": filter})
+	err = m.UpdateReplicateConfiguration(ctx, result)
+	assert.NoError(t, err)
+
+	// the same filters are not applied again.
+	err = m.UpdateReplicateConfiguration(ctx, result)
+	assert.NoError(t, err)
+
+	// the filters are watched with the assignment.
+	param, err := m.GetLatestChannelAssignment()
+	assert.NoError(t, err)
+	assert.Equal(t, filter, param.CollectionFilters["by-dev2"])
+
+	// the task of the new target cluster is created with the filter of the cluster.
+	newCfg := proto.Clone(cfg).(*commonpb.ReplicateConfiguration)
+	newCfg.Clusters = append(newCfg.Clusters, &commonpb.MilvusCluster{ClusterId: "by-dev4", Pchannels: []string{"ch10", "ch11"}})
+	newCfg.CrossClusterTopology = append(newCfg.CrossClusterTopology, &commonpb.CrossClusterTopology{SourceClusterId: "by-dev", TargetClusterId: "by-dev4"})
+	tasks := m.getNewIncomingTask(replicateutil.MustNewConfigHelper("by-dev", newCfg), map[string]*messagespb.ReplicateCollectionFilter{"by-dev4": filter}, nil)
+	assert.Len(t, tasks, 2)
+	for _, task := range tasks {
+		assert.Equal(t, "by-dev4", task.GetTargetCluster().GetClusterId())
+		assert.Equal(t, filter, task.GetCollectionFilter())
+	}
+}
@@ -0,0 +1,179 @@
+package channel
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+// UpdateReplicatingTaskState pauses or resumes the replicating tasks from current cluster to the target cluster.
+// All replicating tasks to the target cluster are updated if the source channel is empty.
+// The state is persisted into the replicating task, which is watched by the cdc,
+// so the cdc stops the replicator of the paused task and restarts it from the checkpoint of the target cluster after resumed.
+// The bootstrapping task is copied and finished by the cdc, and it can not be paused before the bootstrap is finished.
+// Resuming a bootstrapping task skips the rest of the copy, e.g. the existing data is restored into the target cluster by the operator,
+// the task is running from its initialized checkpoint with the checkpoint reset as the bootstrap finished by the cdc.
+// Return the matched replicating tasks ordered by the source channel after the update.
+func (cm *ChannelManager) UpdateReplicatingTaskState(ctx context.Context, targetClusterID string, sourceChannel string, state streamingpb.ReplicatePChannelTaskState) ([]*streamingpb.ReplicatePChannelMeta, error) {
+	if targetClusterID == "" {
+		return nil, status.NewInvalidArgument("target cluster id of replicating task is empty")
+	}
+	if _, ok := streamingpb.ReplicatePChannelTaskState_name[int32(state)]; !ok {
+		return nil, status.NewInvalidArgument("unknown replicating task state %d", state)
+	}
+	if state == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING {
+		return nil, status.NewInvalidArgument("replicating task can only be bootstrapping when it's created")
+	}
+
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	tasks, err := cm.listReplicatingTasks(ctx, targetClusterID, sourceChannel)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]*streamingpb.ReplicatePChannelMeta, 0, len(tasks))
+	updates := make([]*streamingpb.ReplicatePChannelMeta, 0, len(tasks))
+	for _, task := range tasks {
+		if task.GetState() == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING &&
+			state == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED {
+			return nil, status.NewInvalidArgument("replicating task of source channel %s to cluster %s is bootstrapping, resume it after the bootstrap is finished",
+				task.GetSourceChannelName(), targetClusterID)
+		}
+	}
+	for _, task := range tasks {
+		if task.GetState() != state {
+			task = proto.Clone(task).(*streamingpb.ReplicatePChannelMeta)
+			if task.GetState() == streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING {
+				// the checkpoint of the target cluster may be written by the interrupted copy, which is older than the initialized checkpoint.
+				task.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT
+			}
+			task.State = state
+			updates = append(updates, task)
+		}
+		matched = append(matched, task)
+	}
+	if len(updates) > 0 {
+		if err := resource.Resource().StreamingCatalog().SaveReplicatePChannels(ctx, updates); err != nil {
+			cm.Logger().Warn(ctx, "failed to update the state of replicating tasks", mlog.Err(err))
+			return nil, err
+		}
+		for _, task := range updates {
+			cm.Logger().Info(ctx, "state of replicating task updated",
+				mlog.String("targetCluster", targetClusterID),
+				mlog.String("sourceChannel", task.GetSourceChannelName()),
+				mlog.String("targetChannel", task.GetTargetChannelName()),
+				mlog.String("state", state.String()))
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].GetSourceChannelName() < matched[j].GetSourceChannelName()
+	})
+	return matched, nil
+}
+
+// ResetReplicateCheckpoint resets the start position of the replicating task from the source channel to the target cluster,
+// e.g. the target cluster is restored from backup and the checkpoint stored in it is wrong.
+// The reset is persisted into the replicating task, so the cdc restarts the replicator from the new position.
+// The reset is applied only once, the cdc clears it after the target cluster confirms the first message replicated from the new position,
+// then the replicator restarts from the checkpoint of the target cluster with the conflict check as usual.
+// The target cluster still ignores the messages that are not greater than its own checkpoint.
+func (cm *ChannelManager) ResetReplicateCheckpoint(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ReplicatePChannelMeta, error) {
+	if req.GetTargetClusterId() == "" || req.GetSourceChannelName() == "" {
+		return nil, status.NewInvalidArgument("target cluster id and source channel of replicating task are required")
+	}
+	if _, ok := streamingpb.ReplicateCheckpointResetPolicy_name[int32(req.GetPolicy())]; !ok {
+		return nil, status.NewInvalidArgument("unknown replicate checkpoint reset policy %d", req.GetPolicy())
+	}
+	var checkpoint *commonpb.ReplicateCheckpoint
+	if req.GetPolicy() == streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT {
+		var err error
+		if checkpoint, err = checkResetCheckpoint(req); err != nil {
+			return nil, err
+		}
+	}
+
+	cm.cond.L.Lock()
+	defer cm.cond.L.Unlock()
+
+	tasks, err := cm.listReplicatingTasks(ctx, req.GetTargetClusterId(), req.GetSourceChannelName())
+	if err != nil {
+		return nil, err
+	}
+	task := proto.Clone(tasks[0]).(*streamingpb.ReplicatePChannelMeta)
+	task.CheckpointResetPolicy = req.GetPolicy()
+	if checkpoint != nil {
+		task.InitializedCheckpoint = checkpoint
+	}
+	if err := resource.Resource().StreamingCatalog().SaveReplicatePChannels(ctx, []*streamingpb.ReplicatePChannelMeta{task}); err != nil {
+		cm.Logger().Warn(ctx, "failed to reset the checkpoint of replicating task", mlog.Err(err))
+		return nil, err
+	}
+	cm.Logger().Info(ctx, "checkpoint of replicating task reset",
+		mlog.String("targetCluster", req.GetTargetClusterId()),
+		mlog.String("sourceChannel", task.GetSourceChannelName()),
+		mlog.String("targetChannel", task.GetTargetChannelName()),
+		mlog.String("policy", req.GetPolicy().String()),
+		mlog.Uint64("timeTick", checkpoint.GetTimeTick()))
+	return task, nil
+}
+
+// checkResetCheckpoint checks the checkpoint of the reset request and fills the cluster and pchannel of it.
+// The checkpoint older than the retention window of the source wal is rejected unless it's confirmed by the request.
+func checkResetCheckpoint(req *streamingpb.ResetReplicateCheckpointRequest) (*commonpb.ReplicateCheckpoint, error) {
+	checkpoint := req.GetCheckpoint()
+	if len(checkpoint.GetMessageId().GetId()) == 0 || checkpoint.GetTimeTick() == 0 {
+		return nil, status.NewInvalidArgument("message id and time tick of the checkpoint are required")
+	}
+	currentClusterID := paramtable.Get().CommonCfg.ClusterPrefix.GetValue()
+	if checkpoint.GetClusterId() != "" && checkpoint.GetClusterId() != currentClusterID {
+		return nil, status.NewInvalidArgument("checkpoint of cluster %s is not at current cluster %s", checkpoint.GetClusterId(), currentClusterID)
+	}
+	if checkpoint.GetPchannel() != "" && checkpoint.GetPchannel() != req.GetSourceChannelName() {
+		return nil, status.NewInvalidArgument("checkpoint of pchannel %s is not at the source channel %s", checkpoint.GetPchannel(), req.GetSourceChannelName())
+	}
+	window := paramtable.Get().StreamingCfg.ReplicationSourceRetentionWindow.GetAsDurationByParse()
+	if age := time.Since(tsoutil.PhysicalTime(checkpoint.GetTimeTick())); age > window && !req.GetIgnoreRetentionWindow() {
+		return nil, status.NewInvalidArgument("checkpoint is %s old, older than the retention window %s of the source wal, "+
+			"the messages may be truncated, set ignore_retention_window to confirm the reset", age.Truncate(time.Second), window)
+	}
+	checkpoint = proto.Clone(checkpoint).(*commonpb.ReplicateCheckpoint)
+	checkpoint.ClusterId = currentClusterID
+	checkpoint.Pchannel = req.GetSourceChannelName()
+	return checkpoint, nil
+}
+
+// listReplicatingTasks lists the replicating tasks to the target cluster, filtered by the source channel if it's not empty.
+// The replicating task of a removed replication edge may be removed by the cdc concurrently,
+// so only the task of current replication edge is listed to avoid resurrecting the removed task by the update.
+// An error is returned if no task is found, must be called with the lock held.
+func (cm *ChannelManager) listReplicatingTasks(ctx context.Context, targetClusterID string, sourceChannel string) ([]*streamingpb.ReplicatePChannelMeta, error) {
+	if cm.replicateConfig == nil || cm.replicateConfig.GetCurrentCluster().TargetCluster(targetClusterID) == nil {
+		return nil, status.NewInvalidArgument("cluster %s is not a replication target of current cluster", targetClusterID)
+	}
+	tasks, err := resource.Resource().StreamingCatalog().ListReplicatePChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]*streamingpb.ReplicatePChannelMeta, 0)
+	for _, task := range tasks {
+		if task.GetTargetCluster().GetClusterId() != targetClusterID || (sourceChannel != "" && task.GetSourceChannelName() != sourceChannel) {
+			continue
+		}
+		matched = append(matched, task)
+	}
+	if len(matched) == 0 {
+		return nil, status.NewInvalidArgument("replicating task of source channel %q to cluster %s not found", sourceChannel, targetClusterID)
+	}
+	return matched, nil
+}
@@ -0,0 +1,233 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+func TestUpdateReplicatingTaskState(t *testing.T) {
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "by-dev-ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(&streamingpb.ReplicateConfigurationMeta{
+		ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"by-dev-ch1", "by-dev-ch2"}},
+				{ClusterId: "by-dev2", Pchannels: []string{"by-dev2-ch1", "by-dev2-ch2"}},
+				{ClusterId: "by-dev3", Pchannels: []string{"by-dev3-ch1", "by-dev3-ch2"}},
+			},
+			CrossClusterTopology: []*commonpb.CrossClusterTopology{
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev3"},
+			},
+		},
+	}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "by-dev-ch1")
+	assert.NoError(t, err)
+
+	newTask := func(targetClusterID string, index string, state streamingpb.ReplicatePChannelTaskState) *streamingpb.ReplicatePChannelMeta {
+		return &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: "by-dev-ch" + index,
+			TargetChannelName: targetClusterID + "-ch" + index,
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: targetClusterID},
+			State:             state,
+		}
+	}
+	running := streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING
+	paused := streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED
+	bootstrapping := streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask("by-dev2", "2", running),
+		newTask("by-dev2", "1", paused),
+		newTask("by-dev3", "1", running),
+		newTask("by-dev3", "2", running),
+	}, nil).Times(5)
+
+	// Invalid requests.
+	_, err = m.UpdateReplicatingTaskState(ctx, "", "", paused)
+	assert.Error(t, err)
+	_, err = m.UpdateReplicatingTaskState(ctx, "by-dev2", "", streamingpb.ReplicatePChannelTaskState(100))
+	assert.Error(t, err)
+	_, err = m.UpdateReplicatingTaskState(ctx, "by-dev2", "", bootstrapping)
+	assert.Error(t, err)
+	_, err = m.UpdateReplicatingTaskState(ctx, "by-dev4", "", paused)
+	assert.Error(t, err)
+	_, err = m.UpdateReplicatingTaskState(ctx, "by-dev2", "by-dev-ch3", paused)
+	assert.Error(t, err)
+
+	// Pause all tasks of the target cluster, only the running task is saved.
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.UpdateReplicatingTaskState(ctx, "by-dev2", "", paused)
+	assert.Error(t, err)
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error {
+			assert.Len(t, tasks, 1)
+			assert.Equal(t, "by-dev-ch2", tasks[0].GetSourceChannelName())
+			assert.Equal(t, paused, tasks[0].GetState())
+			return nil
+		}).Once()
+	tasks, err := m.UpdateReplicatingTaskState(ctx, "by-dev2", "", paused)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "by-dev-ch1", tasks[0].GetSourceChannelName())
+	assert.Equal(t, "by-dev-ch2", tasks[1].GetSourceChannelName())
+	assert.Equal(t, paused, tasks[0].GetState())
+	assert.Equal(t, paused, tasks[1].GetState())
+
+	// Resume a single task.
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error {
+			assert.Len(t, tasks, 1)
+			assert.Equal(t, "by-dev2-ch1", tasks[0].GetTargetChannelName())
+			assert.Equal(t, running, tasks[0].GetState())
+			return nil
+		}).Once()
+	tasks, err = m.UpdateReplicatingTaskState(ctx, "by-dev2", "by-dev-ch1", running)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 1)
+
+	// Nothing is saved if the state is not changed.
+	tasks, err = m.UpdateReplicatingTaskState(ctx, "by-dev3", "", running)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+
+	// The bootstrapping task can not be paused, and resuming it skips the copy with the checkpoint reset.
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask("by-dev3", "1", bootstrapping),
+		newTask("by-dev3", "2", running),
+	}, nil)
+	_, err = m.UpdateReplicatingTaskState(ctx, "by-dev3", "", paused)
+	assert.ErrorContains(t, err, "is bootstrapping")
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error {
+			assert.Len(t, tasks, 1)
+			assert.Equal(t, "by-dev-ch1", tasks[0].GetSourceChannelName())
+			assert.Equal(t, running, tasks[0].GetState())
+			assert.Equal(t, streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT, tasks[0].GetCheckpointResetPolicy())
+			return nil
+		}).Once()
+	tasks, err = m.UpdateReplicatingTaskState(ctx, "by-dev3", "", running)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+}
+
+func TestResetReplicateCheckpoint(t *testing.T) {
+	paramtable.Init()
+	ResetStaticPChannelStatsManager()
+	RecoverPChannelStatsManager([]string{})
+
+	s := sessionutil.NewMockSession(t)
+	s.EXPECT().GetRegisteredRevision().Return(int64(1))
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptSession(s))
+	catalog.EXPECT().GetCChannel(mock.Anything).Return(&streamingpb.CChannelMeta{Pchannel: "by-dev-ch1"}, nil)
+	catalog.EXPECT().GetVersion(mock.Anything).Return(&streamingpb.StreamingVersion{Version: 1}, nil)
+	catalog.EXPECT().GetReplicateConfiguration(mock.Anything).Return(&streamingpb.ReplicateConfigurationMeta{
+		ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"by-dev-ch1", "by-dev-ch2"}},
+				{ClusterId: "by-dev2", Pchannels: []string{"by-dev2-ch1", "by-dev2-ch2"}},
+			},
+			CrossClusterTopology: []*commonpb.CrossClusterTopology{
+				{SourceClusterId: "by-dev", TargetClusterId: "by-dev2"},
+			},
+		},
+	}, nil)
+	catalog.EXPECT().ListPChannel(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelPool(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListPChannelAntiAffinityGroup(mock.Anything).Return(nil, nil)
+
+	ctx := context.Background()
+	m, err := RecoverChannelManager(ctx, "by-dev-ch1")
+	assert.NoError(t, err)
+
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		{
+			SourceChannelName: "by-dev-ch1",
+			TargetChannelName: "by-dev2-ch1",
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: "by-dev2"},
+		},
+	}, nil)
+
+	earliest := streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST
+	byCheckpoint := streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT
+	newCheckpoint := func(ts time.Time) *commonpb.ReplicateCheckpoint {
+		return &commonpb.ReplicateCheckpoint{
+			MessageId: &commonpb.MessageID{Id: "1", WALName: commonpb.WALName_Pulsar},
+			TimeTick:  tsoutil.ComposeTSByTime(ts, 0),
+		}
+	}
+
+	// Invalid requests.
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{SourceChannelName: "by-dev-ch1", Policy: earliest})
+	assert.Error(t, err)
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{
+		TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: streamingpb.ReplicateCheckpointResetPolicy(100),
+	})
+	assert.Error(t, err)
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch2", Policy: earliest})
+	assert.Error(t, err)
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: byCheckpoint})
+	assert.Error(t, err)
+	wrongChannel := newCheckpoint(time.Now())
+	wrongChannel.Pchannel = "by-dev-ch2"
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{
+		TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: byCheckpoint, Checkpoint: wrongChannel,
+	})
+	assert.Error(t, err)
+
+	// The checkpoint out of the retention window is rejected unless it's confirmed.
+	stale := newCheckpoint(time.Now().Add(-2 * paramtable.Get().StreamingCfg.ReplicationSourceRetentionWindow.GetAsDurationByParse()))
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{
+		TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: byCheckpoint, Checkpoint: stale,
+	})
+	assert.Error(t, err)
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, tasks []*streamingpb.ReplicatePChannelMeta) error {
+			assert.Len(t, tasks, 1)
+			assert.Equal(t, byCheckpoint, tasks[0].GetCheckpointResetPolicy())
+			assert.Equal(t, stale.GetTimeTick(), tasks[0].GetInitializedCheckpoint().GetTimeTick())
+			assert.Equal(t, "by-dev-ch1", tasks[0].GetInitializedCheckpoint().GetPchannel())
+			assert.Equal(t, paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), tasks[0].GetInitializedCheckpoint().GetClusterId())
+			return nil
+		}).Once()
+	task, err := m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{
+		TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: byCheckpoint, Checkpoint: stale, IgnoreRetentionWindow: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, byCheckpoint, task.GetCheckpointResetPolicy())
+
+	// Reset to the earliest position.
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).Return(errors.New("save failure")).Once()
+	_, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: earliest})
+	assert.Error(t, err)
+	catalog.EXPECT().SaveReplicatePChannels(mock.Anything, mock.Anything).Return(nil).Once()
+	task, err = m.ResetReplicateCheckpoint(ctx, &streamingpb.ResetReplicateCheckpointRequest{TargetClusterId: "by-dev2", SourceChannelName: "by-dev-ch1", Policy: earliest})
+	assert.NoError(t, err)
+	assert.Equal(t, earliest, task.GetCheckpointResetPolicy())
+	assert.Nil(t, task.GetInitializedCheckpoint())
+}
@@ -26,9 +26,11 @@ func RegisterTestChannelManager(pchannels []string, controlChannelPchannel strin
 	cm := &ChannelManager{
 		cond:     syncutil.NewContextCond(&sync.Mutex{}),
 		channels: channels,
+		leases:   make(map[ChannelID]pchannelLease),
 		cchannelMeta: &streamingpb.CChannelMeta{
 			Pchannel: controlChannelPchannel,
 		},
+		watchers: newAssignmentWatchers(),
 	}
 	register(cm)
 }
@@ -0,0 +1,88 @@
+package channel
+
+import (
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// VChannelPlacementHints is the hints of pchannel selection when allocating the vchannels.
+// The hints never break the rules of allocation (replication availability, pchannel pool and vchannel quota),
+// so the allocation may still fail if the candidates are not enough after applying the avoid list.
+type VChannelPlacementHints struct {
+	PreferredPChannels []string // the pchannels that are selected first in the given order, e.g. co-locate the collections that are frequently joined.
+	AvoidPChannels     []string // the pchannels that are never selected, e.g. isolate the noisy tenants.
+	SpreadAcrossNodes  bool     // select the pchannels located at different streaming nodes first.
+}
+
+// applyPlacementHints reorders and filters the candidate pchannels by the placement hints, should be called with lock.
+// The candidates should be sorted by the vchannel count, the order is kept for the pchannels that are not affected by the hints.
+func (cm *ChannelManager) applyPlacementHints(candidates []withVChannelCount, hints VChannelPlacementHints) []withVChannelCount {
+	if len(hints.AvoidPChannels) > 0 {
+		avoid := typeutil.NewSet(hints.AvoidPChannels...)
+		filtered := make([]withVChannelCount, 0, len(candidates))
+		for _, candidate := range candidates {
+			if !avoid.Contain(candidate.id.Name) {
+				filtered = append(filtered, candidate)
+			}
+		}
+		candidates = filtered
+	}
+
+	preferred := make([]withVChannelCount, 0, len(hints.PreferredPChannels))
+	if len(hints.PreferredPChannels) > 0 {
+		indexes := make(map[string]int, len(candidates))
+		for i, candidate := range candidates {
+			indexes[candidate.id.Name] = i
+		}
+		picked := typeutil.NewSet[string]()
+		for _, name := range hints.PreferredPChannels {
+			if i, ok := indexes[name]; ok && !picked.Contain(name) {
+				picked.Insert(name)
+				preferred = append(preferred, candidates[i])
+			}
+		}
+		others := make([]withVChannelCount, 0, len(candidates)-len(preferred))
+		for _, candidate := range candidates {
+			if !picked.Contain(candidate.id.Name) {
+				others = append(others, candidate)
+			}
+		}
+		candidates = others
+	}
+
+	if hints.SpreadAcrossNodes {
+		candidates = cm.spreadAcrossNodes(candidates)
+	}
+	return append(preferred, candidates...)
+}
+
+// spreadAcrossNodes interleaves the candidates by the located streaming node, should be called with lock.
+// The pchannel that is not assigned to any node is treated as located at a distinct node.
+func (cm *ChannelManager) spreadAcrossNodes(candidates []withVChannelCount) []withVChannelCount {
+	groups := make([][]withVChannelCount, 0, len(candidates))
+	groupIndexes := make(map[int64]int)
+	for _, candidate := range candidates {
+		meta := cm.channels[candidate.id]
+		if !meta.IsAssignedOrAssigning() {
+			groups = append(groups, []withVChannelCount{candidate})
+			continue
+		}
+		serverID := meta.CurrentServerID()
+		i, ok := groupIndexes[serverID]
+		if !ok {
+			i = len(groups)
+			groupIndexes[serverID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], candidate)
+	}
+
+	spread := make([]withVChannelCount, 0, len(candidates))
+	for round := 0; len(spread) < len(candidates); round++ {
+		for _, group := range groups {
+			if round < len(group) {
+				spread = append(spread, group[round])
+			}
+		}
+	}
+	return spread
+}
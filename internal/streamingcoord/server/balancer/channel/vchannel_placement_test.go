@@ -0,0 +1,68 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+func TestApplyPlacementHints(t *testing.T) {
+	newMeta := func(name string, serverID int64) *PChannelMeta {
+		meta := NewPChannelMeta(name, types.AccessModeRW)
+		if serverID <= 0 {
+			return meta
+		}
+		mutable := meta.CopyForWrite()
+		mutable.TryAssignToServerID(types.AccessModeRW, types.StreamingNodeInfo{ServerID: serverID})
+		mutable.AssignToServerDone()
+		return mutable.PChannelMeta
+	}
+	cm := &ChannelManager{channels: map[ChannelID]*PChannelMeta{
+		newChannelID("ch1"): newMeta("ch1", 1),
+		newChannelID("ch2"): newMeta("ch2", 1),
+		newChannelID("ch3"): newMeta("ch3", 2),
+		newChannelID("ch4"): newMeta("ch4", 2),
+		newChannelID("ch5"): newMeta("ch5", 0),
+	}}
+	candidates := []withVChannelCount{
+		{id: newChannelID("ch1")},
+		{id: newChannelID("ch2")},
+		{id: newChannelID("ch3")},
+		{id: newChannelID("ch4")},
+		{id: newChannelID("ch5")},
+	}
+	names := func(channels []withVChannelCount) []string {
+		return lo.Map(channels, func(channel withVChannelCount, _ int) string { return channel.id.Name })
+	}
+
+	// no hints keeps the order.
+	assert.Equal(t, []string{"ch1", "ch2", "ch3", "ch4", "ch5"}, names(cm.applyPlacementHints(candidates, VChannelPlacementHints{})))
+
+	// the avoided pchannels are filtered out, the unknown pchannels in hints are ignored.
+	assert.Equal(t, []string{"ch1", "ch3", "ch5"}, names(cm.applyPlacementHints(candidates, VChannelPlacementHints{
+		AvoidPChannels: []string{"ch2", "ch4", "unknown"},
+	})))
+
+	// the preferred pchannels are selected first in the given order.
+	assert.Equal(t, []string{"ch4", "ch2", "ch1", "ch3", "ch5"}, names(cm.applyPlacementHints(candidates, VChannelPlacementHints{
+		PreferredPChannels: []string{"ch4", "unknown", "ch2", "ch4"},
+	})))
+
+	// the avoid list wins over the preferred list.
+	assert.Equal(t, []string{"ch2", "ch1", "ch3", "ch5"}, names(cm.applyPlacementHints(candidates, VChannelPlacementHints{
+		PreferredPChannels: []string{"ch4", "ch2"},
+		AvoidPChannels:     []string{"ch4"},
+	})))
+
+	// the pchannels are interleaved by the located node, the unassigned pchannel is treated as a distinct node.
+	assert.Equal(t, []string{"ch1", "ch3", "ch5", "ch2", "ch4"}, names(cm.applyPlacementHints(candidates, VChannelPlacementHints{
+		SpreadAcrossNodes: true,
+	})))
+	assert.Equal(t, []string{"ch2", "ch1", "ch3", "ch5", "ch4"}, names(cm.applyPlacementHints(candidates, VChannelPlacementHints{
+		PreferredPChannels: []string{"ch2"},
+		SpreadAcrossNodes:  true,
+	})))
+}
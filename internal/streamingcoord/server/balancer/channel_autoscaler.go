@@ -0,0 +1,100 @@
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// pchannelAutoScaler decides when to add the pchannels by the append throughput of the pchannels.
+// The pchannels are added if the average append throughput of all pchannels is sustained over the threshold,
+// so the operator doesn't need to edit the DmlChannelNum and restart the cluster when the write load grows.
+type pchannelAutoScaler struct {
+	overloadedSince time.Time // the time when the average append throughput is over the threshold, zero if not overloaded.
+}
+
+// check checks the pchannel view and returns the names of pchannels that should be added.
+func (s *pchannelAutoScaler) check(view *channel.PChannelView, now time.Time) []string {
+	cfg := paramtable.Get().StreamingCfg
+	if !cfg.WALBalancerAutoScaleEnabled.GetAsBool() || len(view.Channels) == 0 {
+		s.overloadedSince = time.Time{}
+		return nil
+	}
+
+	var total float64
+	for _, stats := range view.Stats {
+		total += stats.AppendBytesPerSecond
+	}
+	if total/float64(len(view.Channels)) <= cfg.WALBalancerAutoScaleAppendBytesPerSecThreshold.GetAsFloat() {
+		s.overloadedSince = time.Time{}
+		return nil
+	}
+	if s.overloadedSince.IsZero() {
+		s.overloadedSince = now
+	}
+	if now.Sub(s.overloadedSince) < cfg.WALBalancerAutoScaleSustainedDuration.GetAsDurationByParse() {
+		return nil
+	}
+
+	num := min(cfg.WALBalancerAutoScaleStep.GetAsInt(), cfg.WALBalancerAutoScaleMaxPChannelNum.GetAsInt()-len(view.Channels))
+	if num <= 0 {
+		return nil
+	}
+	// restart the sustained duration after scaling,
+	// so the throughput of the new pchannels can be observed before next scaling.
+	s.overloadedSince = time.Time{}
+	return generateNewPChannelNames(view, num)
+}
+
+// generateNewPChannelNames generates the names of new pchannels with the dml channel prefix,
+// the smallest indexes that are not used by current pchannels are picked.
+func generateNewPChannelNames(view *channel.PChannelView, num int) []string {
+	prefix := paramtable.Get().CommonCfg.RootCoordDml.GetValue()
+	names := make([]string, 0, num)
+	for idx := 0; len(names) < num; idx++ {
+		name := fmt.Sprintf("%s_%d", prefix, idx)
+		if _, ok := view.Channels[types.ChannelID{Name: name}]; !ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// autoScalePChannels checks the append throughput periodically and adds the pchannels if needed.
+func (b *balancerImpl) autoScalePChannels(ctx context.Context) {
+	scaler := &pchannelAutoScaler{}
+	timer := time.NewTimer(paramtable.Get().StreamingCfg.WALBalancerAutoScaleCheckInterval.GetAsDurationByParse())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		timer.Reset(paramtable.Get().StreamingCfg.WALBalancerAutoScaleCheckInterval.GetAsDurationByParse())
+
+		newChannels := scaler.check(b.channelMetaManager.CurrentPChannelsView(), time.Now())
+		if len(newChannels) == 0 {
+			continue
+		}
+		if paramtable.Get().CommonCfg.PreCreatedTopicEnabled.GetAsBool() {
+			b.Logger().Warn(ctx, "append throughput is over the threshold, but the pre-created topics can not be scaled automatically")
+			continue
+		}
+		if err := b.channelMetaManager.AddPChannels(ctx, newChannels); err != nil {
+			b.Logger().Warn(ctx, "fail to add pchannels by auto scale", mlog.Err(err), mlog.Strings("channels", newChannels))
+			continue
+		}
+		b.Logger().Info(ctx, "pchannels added by auto scale", mlog.Strings("channels", newChannels))
+		select {
+		case b.pchannelScaled <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package balancer
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestPChannelAutoScaler(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	prefix := params.CommonCfg.RootCoordDml.GetValue()
+	newView := func(bytesPerSecond float64, indexes ...int) *channel.PChannelView {
+		view := &channel.PChannelView{
+			Channels: make(map[channel.ChannelID]*channel.PChannelMeta),
+			Stats:    make(map[channel.ChannelID]channel.PChannelStatsView),
+		}
+		for _, idx := range indexes {
+			name := fmt.Sprintf("%s_%d", prefix, idx)
+			view.Channels[types.ChannelID{Name: name}] = channel.NewPChannelMeta(name, types.AccessModeRW)
+			view.Stats[types.ChannelID{Name: name}] = channel.PChannelStatsView{AppendBytesPerSecond: bytesPerSecond}
+		}
+		return view
+	}
+
+	scaler := &pchannelAutoScaler{}
+	now := time.Now()
+
+	// disabled by default.
+	assert.Empty(t, scaler.check(newView(1<<30, 0, 1), now))
+
+	params.Save(params.StreamingCfg.WALBalancerAutoScaleEnabled.Key, "true")
+	params.Save(params.StreamingCfg.WALBalancerAutoScaleAppendBytesPerSecThreshold.Key, "100")
+	params.Save(params.StreamingCfg.WALBalancerAutoScaleSustainedDuration.Key, "1m")
+	params.Save(params.StreamingCfg.WALBalancerAutoScaleMaxPChannelNum.Key, "4")
+	params.Save(params.StreamingCfg.WALBalancerAutoScaleStep.Key, "2")
+	defer func() {
+		params.Reset(params.StreamingCfg.WALBalancerAutoScaleEnabled.Key)
+		params.Reset(params.StreamingCfg.WALBalancerAutoScaleAppendBytesPerSecThreshold.Key)
+		params.Reset(params.StreamingCfg.WALBalancerAutoScaleSustainedDuration.Key)
+		params.Reset(params.StreamingCfg.WALBalancerAutoScaleMaxPChannelNum.Key)
+		params.Reset(params.StreamingCfg.WALBalancerAutoScaleStep.Key)
+	}()
+
+	// under the threshold.
+	assert.Empty(t, scaler.check(newView(100, 0, 1), now))
+	assert.True(t, scaler.overloadedSince.IsZero())
+
+	// over the threshold but not sustained.
+	assert.Empty(t, scaler.check(newView(101, 0, 2), now))
+	assert.Empty(t, scaler.check(newView(101, 0, 2), now.Add(30*time.Second)))
+
+	// the overload is interrupted, the sustained duration is restarted.
+	assert.Empty(t, scaler.check(newView(50, 0, 2), now.Add(40*time.Second)))
+	assert.Empty(t, scaler.check(newView(101, 0, 2), now.Add(50*time.Second)))
+	assert.Empty(t, scaler.check(newView(101, 0, 2), now.Add(time.Minute)))
+
+	// sustained, the smallest unused indexes are picked.
+	newChannels := scaler.check(newView(101, 0, 2), now.Add(2*time.Minute))
+	assert.Equal(t, []string{prefix + "_1", prefix + "_3"}, newChannels)
+	assert.True(t, scaler.overloadedSince.IsZero())
+
+	// honor the max pchannel num.
+	assert.Empty(t, scaler.check(newView(101, 0, 1, 2), now.Add(3*time.Minute)))
+	newChannels = scaler.check(newView(101, 0, 1, 2), now.Add(5*time.Minute))
+	assert.Equal(t, []string{prefix + "_3"}, newChannels)
+	assert.Empty(t, scaler.check(newView(101, 0, 1, 2, 3), now.Add(6*time.Minute)))
+	assert.Empty(t, scaler.check(newView(101, 0, 1, 2, 3), now.Add(8*time.Minute)))
+}
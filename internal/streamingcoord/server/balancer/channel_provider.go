@@ -1,5 +1,7 @@
 package balancer
 
+import "context"
+
 // ChannelProvider provides initial channels and ongoing notification
 // of dynamically added PChannels.
 type ChannelProvider interface {
@@ -15,3 +17,11 @@ type ChannelProvider interface {
 	// Close stops the provider and closes the notification channel.
 	Close()
 }
+
+// ChannelRegistry is implemented by the ChannelProvider that supports
+// registering new PChannels on a running cluster.
+type ChannelRegistry interface {
+	// RegisterChannels registers the channel names, the unknown ones
+	// will be delivered by NewIncomingChannels later.
+	RegisterChannels(ctx context.Context, names []string) error
+}
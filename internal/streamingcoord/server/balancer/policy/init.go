@@ -2,9 +2,15 @@ package vchannelfair
 
 import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/roundrobin"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/sticky"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/vchannelfair"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy/weightedload"
 )
 
 func init() {
 	balancer.RegisterPolicy(&vchannelfair.PolicyBuilder{})
+	balancer.RegisterPolicy(&roundrobin.PolicyBuilder{})
+	balancer.RegisterPolicy(&weightedload.PolicyBuilder{})
+	balancer.RegisterPolicy(&sticky.PolicyBuilder{})
 }
@@ -0,0 +1,22 @@
+package roundrobin
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+)
+
+const (
+	policyName = "roundRobin"
+)
+
+// PolicyBuilder is a builder to build round robin policy.
+type PolicyBuilder struct{}
+
+// Name returns the name of the round robin policy.
+func (b *PolicyBuilder) Name() string {
+	return policyName
+}
+
+// Build creates a new round robin policy.
+func (b *PolicyBuilder) Build() balancer.Policy {
+	return &policy{}
+}
@@ -0,0 +1,105 @@
+package roundrobin
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+var _ balancer.Policy = &policy{}
+
+// policy is a policy to balance the pchannel count of streaming node.
+// The new incoming pchannels are dealt to the streaming nodes one by one in the order of server id,
+// the node with fewer pchannels is dealt first, so the pchannel count of each node differs by at most one after balancing.
+// The vchannel count and the traffic of pchannel are ignored.
+type policy struct {
+	mlog.Binder
+}
+
+// Name returns the name of the policy.
+func (p *policy) Name() string {
+	return policyName
+}
+
+// Balance deals the pchannels to the streaming nodes in round robin.
+func (p *policy) Balance(currentLayout balancer.CurrentLayout) (balancer.ExpectedLayout, error) {
+	if currentLayout.TotalNodes() == 0 {
+		return balancer.ExpectedLayout{}, status.NewInner("no available streaming node")
+	}
+	nodeIDs := currentLayout.SortedNodeIDs()
+
+	// 1. Keep the current layout first to make the balance result more stable.
+	nodes := make(map[int64][]types.ChannelID, len(nodeIDs))
+	newIncomingChannels := make([]types.ChannelID, 0)
+	for _, channelID := range currentLayout.SortedChannelIDs() {
+		if serverID, ok := currentLayout.ChannelsToNodes[channelID]; ok {
+			nodes[serverID] = append(nodes[serverID], channelID)
+			continue
+		}
+		newIncomingChannels = append(newIncomingChannels, channelID)
+	}
+
+	// 2. Deal the new incoming channels to the node with fewest channels.
+	for _, channelID := range newIncomingChannels {
+		target := leastLoadedNode(nodeIDs, nodes)
+		nodes[target] = append(nodes[target], channelID)
+	}
+
+	// 3. Move the channels from the most loaded node to the least loaded node until the count is even.
+	if currentLayout.Config.AllowRebalance {
+		for {
+			from, to := mostLoadedNode(nodeIDs, nodes), leastLoadedNode(nodeIDs, nodes)
+			if len(nodes[from])-len(nodes[to]) <= 1 {
+				break
+			}
+			idx := lastMovableChannel(currentLayout, nodes[from])
+			if idx < 0 {
+				break
+			}
+			channelID := nodes[from][idx]
+			nodes[from] = append(nodes[from][:idx], nodes[from][idx+1:]...)
+			nodes[to] = append(nodes[to], channelID)
+		}
+	}
+
+	assignments := make(map[types.ChannelID]types.PChannelInfoAssigned, currentLayout.TotalChannels())
+	for serverID, channelIDs := range nodes {
+		for _, channelID := range channelIDs {
+			assignments[channelID] = currentLayout.AssignTo(channelID, serverID)
+		}
+	}
+	return balancer.ExpectedLayout{ChannelAssignment: assignments}, nil
+}
+
+// leastLoadedNode returns the node with fewest channels, the node with lower server id is preferred.
+func leastLoadedNode(nodeIDs []int64, nodes map[int64][]types.ChannelID) int64 {
+	target := nodeIDs[0]
+	for _, nodeID := range nodeIDs[1:] {
+		if len(nodes[nodeID]) < len(nodes[target]) {
+			target = nodeID
+		}
+	}
+	return target
+}
+
+// mostLoadedNode returns the node with most channels, the node with lower server id is preferred.
+func mostLoadedNode(nodeIDs []int64, nodes map[int64][]types.ChannelID) int64 {
+	target := nodeIDs[0]
+	for _, nodeID := range nodeIDs[1:] {
+		if len(nodes[nodeID]) > len(nodes[target]) {
+			target = nodeID
+		}
+	}
+	return target
+}
+
+// lastMovableChannel returns the index of last channel that is allowed to rebalance, -1 if not found.
+func lastMovableChannel(currentLayout balancer.CurrentLayout, channelIDs []types.ChannelID) int {
+	for i := len(channelIDs) - 1; i >= 0; i-- {
+		if currentLayout.AllowRebalance(channelIDs[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,22 @@
+package sticky
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+)
+
+const (
+	policyName = "sticky"
+)
+
+// PolicyBuilder is a builder to build sticky policy.
+type PolicyBuilder struct{}
+
+// Name returns the name of the sticky policy.
+func (b *PolicyBuilder) Name() string {
+	return policyName
+}
+
+// Build creates a new sticky policy.
+func (b *PolicyBuilder) Build() balancer.Policy {
+	return &policy{}
+}
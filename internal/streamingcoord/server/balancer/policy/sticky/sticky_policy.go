@@ -0,0 +1,51 @@
+package sticky
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+var _ balancer.Policy = &policy{}
+
+// policy is a policy that never moves a pchannel away from a healthy streaming node.
+// Only the unassigned pchannels and the pchannels on the lost or frozen streaming nodes are assigned,
+// they are assigned to the node with fewest pchannels.
+// It's useful to avoid the wal fencing caused by rebalance, but the layout may be unbalanced after the node is added.
+type policy struct {
+	mlog.Binder
+}
+
+// Name returns the name of the policy.
+func (p *policy) Name() string {
+	return policyName
+}
+
+// Balance keeps all current assignments and assigns the new incoming pchannels.
+func (p *policy) Balance(currentLayout balancer.CurrentLayout) (balancer.ExpectedLayout, error) {
+	if currentLayout.TotalNodes() == 0 {
+		return balancer.ExpectedLayout{}, status.NewInner("no available streaming node")
+	}
+	nodeIDs := currentLayout.SortedNodeIDs()
+	channelCount := make(map[int64]int, len(nodeIDs))
+	for _, serverID := range currentLayout.ChannelsToNodes {
+		channelCount[serverID]++
+	}
+
+	assignments := make(map[types.ChannelID]types.PChannelInfoAssigned, currentLayout.TotalChannels())
+	for _, channelID := range currentLayout.SortedChannelIDs() {
+		serverID, ok := currentLayout.ChannelsToNodes[channelID]
+		if !ok {
+			serverID = nodeIDs[0]
+			for _, nodeID := range nodeIDs[1:] {
+				if channelCount[nodeID] < channelCount[serverID] {
+					serverID = nodeID
+				}
+			}
+			channelCount[serverID]++
+		}
+		assignments[channelID] = currentLayout.AssignTo(channelID, serverID)
+	}
+	return balancer.ExpectedLayout{ChannelAssignment: assignments}, nil
+}
@@ -0,0 +1,95 @@
+package sticky
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+func TestStickyPolicy(t *testing.T) {
+	p := &policy{}
+	assert.Equal(t, policyName, p.Name())
+
+	_, err := p.Balance(newLayout(map[string]int{"c1": -1}, nil, nil))
+	assert.Error(t, err)
+
+	// The current assignments are never moved even if the layout is unbalanced,
+	// the new incoming channels are assigned to the node with fewest channels.
+	expected, err := p.Balance(newLayout(map[string]int{
+		"c1": 1,
+		"c2": 1,
+		"c3": 1,
+		"c4": 2,
+		"c5": -1,
+		"c6": -1,
+		"c7": -1,
+	}, nil, []int64{1, 2, 3}))
+	assert.NoError(t, err)
+	assert.Len(t, expected.ChannelAssignment, 7)
+	for _, c := range []string{"c1", "c2", "c3"} {
+		assert.Equal(t, int64(1), expected.ChannelAssignment[newChannelID(c)].Node.ServerID)
+	}
+	assert.Equal(t, int64(3), expected.ChannelAssignment[newChannelID("c5")].Node.ServerID)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[newChannelID("c6")].Node.ServerID)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[newChannelID("c7")].Node.ServerID)
+	assert.Equal(t, map[int64]int{1: 3, 2: 2, 3: 2}, countByServerID(expected))
+}
+
+func newChannelID(channel string) types.ChannelID {
+	return types.ChannelID{
+		Name: channel,
+	}
+}
+
+func countByServerID(expected balancer.ExpectedLayout) map[int64]int {
+	counts := make(map[int64]int)
+	for _, node := range expected.ChannelAssignment {
+		counts[node.Node.ServerID]++
+	}
+	return counts
+}
+
+// newLayout creates a new layout for test.
+func newLayout(channels map[string]int, vchannels map[string]int, serverID []int64) balancer.CurrentLayout {
+	layout := balancer.CurrentLayout{
+		Config: balancer.CommonBalancePolicyConfig{
+			AllowRebalance:                     true,
+			AllowRebalanceRecoveryLagThreshold: 1 * time.Second,
+			MinRebalanceIntervalThreshold:      1 * time.Second,
+		},
+		Channels:           make(map[channel.ChannelID]types.PChannelInfo),
+		Stats:              make(map[channel.ChannelID]channel.PChannelStatsView),
+		AllNodesInfo:       make(map[int64]types.StreamingNodeStatus),
+		ChannelsToNodes:    make(map[types.ChannelID]int64),
+		ExpectedAccessMode: make(map[channel.ChannelID]types.AccessMode),
+	}
+	for _, id := range serverID {
+		layout.AllNodesInfo[id] = types.StreamingNodeStatus{
+			StreamingNodeInfo: types.StreamingNodeInfo{
+				ServerID: id,
+			},
+		}
+	}
+	for c, node := range channels {
+		vc := make(map[string]int64)
+		for i := 0; i < vchannels[c]; i++ {
+			vc[c+"_v"+string(rune('a'+i))] = 1
+		}
+		layout.Stats[newChannelID(c)] = channel.PChannelStatsView{VChannels: vc}
+		if node > 0 {
+			layout.ChannelsToNodes[newChannelID(c)] = int64(node)
+		}
+		layout.Channels[newChannelID(c)] = types.PChannelInfo{
+			Name:       c,
+			Term:       1,
+			AccessMode: types.AccessModeRW,
+		}
+		layout.ExpectedAccessMode[newChannelID(c)] = types.AccessModeRW
+	}
+	return layout
+}
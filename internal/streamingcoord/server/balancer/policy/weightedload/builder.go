@@ -0,0 +1,22 @@
+package weightedload
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+)
+
+const (
+	policyName = "weightedByLoad"
+)
+
+// PolicyBuilder is a builder to build weighted by load policy.
+type PolicyBuilder struct{}
+
+// Name returns the name of the weighted by load policy.
+func (b *PolicyBuilder) Name() string {
+	return policyName
+}
+
+// Build creates a new weighted by load policy.
+func (b *PolicyBuilder) Build() balancer.Policy {
+	return &policy{}
+}
@@ -0,0 +1,120 @@
+package weightedload
+
+import (
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+var _ balancer.Policy = &policy{}
+
+// policy is a policy to balance the load of streaming node weighted by the vchannel count and append traffic of pchannel.
+// The load of a pchannel is 1 + the count of vchannels on it + the append throughput / throughput unit,
+// the load of a streaming node is the sum of the load of pchannels on it.
+// The heavy pchannels are assigned first to the node with lowest load,
+// and at most one pchannel is moved at one balance round to shrink the load gap between the heaviest and lightest node.
+type policy struct {
+	mlog.Binder
+}
+
+// Name returns the name of the policy.
+func (p *policy) Name() string {
+	return policyName
+}
+
+// Balance balances the load of streaming node weighted by the vchannel count and append traffic.
+func (p *policy) Balance(currentLayout balancer.CurrentLayout) (balancer.ExpectedLayout, error) {
+	if currentLayout.TotalNodes() == 0 {
+		return balancer.ExpectedLayout{}, status.NewInner("no available streaming node")
+	}
+	nodeIDs := currentLayout.SortedNodeIDs()
+	load := make(map[int64]float64, len(nodeIDs))
+	channelLoad := newChannelLoader(currentLayout)
+	target := make(map[types.ChannelID]int64, currentLayout.TotalChannels())
+
+	// 1. Keep the current layout first to make the balance result more stable.
+	newIncomingChannels := make([]types.ChannelID, 0)
+	for _, channelID := range currentLayout.SortedChannelIDs() {
+		if serverID, ok := currentLayout.ChannelsToNodes[channelID]; ok {
+			target[channelID] = serverID
+			load[serverID] += channelLoad(channelID)
+			continue
+		}
+		newIncomingChannels = append(newIncomingChannels, channelID)
+	}
+
+	// 2. Assign the heavy new incoming channels first to the node with lowest load.
+	sort.SliceStable(newIncomingChannels, func(i, j int) bool {
+		return channelLoad(newIncomingChannels[i]) > channelLoad(newIncomingChannels[j])
+	})
+	for _, channelID := range newIncomingChannels {
+		serverID := lightestNode(nodeIDs, load)
+		target[channelID] = serverID
+		load[serverID] += channelLoad(channelID)
+	}
+
+	// 3. Move the heaviest channel that can shrink the load gap from the heaviest node to the lightest node.
+	if currentLayout.Config.AllowRebalance {
+		from, to := heaviestNode(nodeIDs, load), lightestNode(nodeIDs, load)
+		gap := load[from] - load[to]
+		var moved types.ChannelID
+		movedLoad := 0.0
+		for channelID, serverID := range target {
+			l := channelLoad(channelID)
+			if serverID != from || l >= gap || l < movedLoad || !currentLayout.AllowRebalance(channelID) {
+				continue
+			}
+			if l > movedLoad || moved.IsZero() || channelID.LT(moved) {
+				moved, movedLoad = channelID, l
+			}
+		}
+		if !moved.IsZero() {
+			target[moved] = to
+		}
+	}
+
+	assignments := make(map[types.ChannelID]types.PChannelInfoAssigned, len(target))
+	for channelID, serverID := range target {
+		assignments[channelID] = currentLayout.AssignTo(channelID, serverID)
+	}
+	return balancer.ExpectedLayout{ChannelAssignment: assignments}, nil
+}
+
+// newChannelLoader returns a function to get the load of the pchannel.
+func newChannelLoader(currentLayout balancer.CurrentLayout) func(channelID types.ChannelID) float64 {
+	unit := float64(paramtable.Get().StreamingCfg.WALBalancerPolicyWeightedByLoadThroughputUnit.GetAsSize())
+	return func(channelID types.ChannelID) float64 {
+		stats := currentLayout.Stats[channelID]
+		load := 1 + float64(len(stats.VChannels))
+		if unit > 0 {
+			load += stats.AppendBytesPerSecond / unit
+		}
+		return load
+	}
+}
+
+// lightestNode returns the node with lowest load, the node with lower server id is preferred.
+func lightestNode(nodeIDs []int64, load map[int64]float64) int64 {
+	target := nodeIDs[0]
+	for _, nodeID := range nodeIDs[1:] {
+		if load[nodeID] < load[target] {
+			target = nodeID
+		}
+	}
+	return target
+}
+
+// heaviestNode returns the node with highest load, the node with lower server id is preferred.
+func heaviestNode(nodeIDs []int64, load map[int64]float64) int64 {
+	target := nodeIDs[0]
+	for _, nodeID := range nodeIDs[1:] {
+		if load[nodeID] > load[target] {
+			target = nodeID
+		}
+	}
+	return target
+}
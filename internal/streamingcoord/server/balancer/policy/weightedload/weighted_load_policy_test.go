@@ -0,0 +1,135 @@
+package weightedload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestWeightedLoadPolicy(t *testing.T) {
+	paramtable.Init()
+	p := &policy{}
+	assert.Equal(t, policyName, p.Name())
+
+	_, err := p.Balance(newLayout(map[string]int{"c1": -1}, nil, nil))
+	assert.Error(t, err)
+
+	// The heavy new incoming channels are assigned first to the node with lowest load.
+	expected, err := p.Balance(newLayout(map[string]int{
+		"c1": -1,
+		"c2": -1,
+		"c3": -1,
+		"c4": -1,
+	}, map[string]int{"c1": 4, "c2": 1, "c3": 1, "c4": 1}, []int64{1, 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), expected.ChannelAssignment[newChannelID("c1")].Node.ServerID)
+	for _, c := range []string{"c2", "c3", "c4"} {
+		assert.Equal(t, int64(2), expected.ChannelAssignment[newChannelID(c)].Node.ServerID)
+	}
+
+	// At most one channel is moved at one round to shrink the load gap.
+	layout := newLayout(map[string]int{
+		"c1": 1,
+		"c2": 1,
+		"c3": 1,
+		"c4": 2,
+	}, map[string]int{"c1": 3, "c2": 1, "c3": 0, "c4": 0}, []int64{1, 2})
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[newChannelID("c1")].Node.ServerID)
+	assert.Equal(t, map[int64]int{1: 2, 2: 2}, countByServerID(expected))
+
+	// The channel that can not shrink the load gap is never moved.
+	expected, err = p.Balance(newLayout(map[string]int{
+		"c1": 1,
+		"c2": 2,
+	}, map[string]int{"c1": 3}, []int64{1, 2}))
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int{1: 1, 2: 1}, countByServerID(expected))
+
+	layout.Config.AllowRebalance = false
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]int{1: 3, 2: 1}, countByServerID(expected))
+
+	// The hot channel is placed on the lightly loaded node.
+	layout = newLayout(map[string]int{
+		"c1": 1,
+		"c2": 2,
+		"c3": -1,
+		"c4": -1,
+	}, map[string]int{"c1": 2, "c2": 0}, []int64{1, 2})
+	setThroughput(layout, "c2", 4*1024*1024)
+	setThroughput(layout, "c4", 2*1024*1024)
+	expected, err = p.Balance(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), expected.ChannelAssignment[newChannelID("c4")].Node.ServerID)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[newChannelID("c3")].Node.ServerID)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[newChannelID("c2")].Node.ServerID)
+}
+
+func setThroughput(layout balancer.CurrentLayout, c string, bytesPerSecond float64) {
+	stats := layout.Stats[newChannelID(c)]
+	stats.AppendBytesPerSecond = bytesPerSecond
+	layout.Stats[newChannelID(c)] = stats
+}
+
+func newChannelID(channel string) types.ChannelID {
+	return types.ChannelID{
+		Name: channel,
+	}
+}
+
+func countByServerID(expected balancer.ExpectedLayout) map[int64]int {
+	counts := make(map[int64]int)
+	for _, node := range expected.ChannelAssignment {
+		counts[node.Node.ServerID]++
+	}
+	return counts
+}
+
+// newLayout creates a new layout for test.
+func newLayout(channels map[string]int, vchannels map[string]int, serverID []int64) balancer.CurrentLayout {
+	layout := balancer.CurrentLayout{
+		Config: balancer.CommonBalancePolicyConfig{
+			AllowRebalance:                     true,
+			AllowRebalanceRecoveryLagThreshold: 1 * time.Second,
+			MinRebalanceIntervalThreshold:      1 * time.Second,
+		},
+		Channels:           make(map[channel.ChannelID]types.PChannelInfo),
+		Stats:              make(map[channel.ChannelID]channel.PChannelStatsView),
+		AllNodesInfo:       make(map[int64]types.StreamingNodeStatus),
+		ChannelsToNodes:    make(map[types.ChannelID]int64),
+		ExpectedAccessMode: make(map[channel.ChannelID]types.AccessMode),
+	}
+	for _, id := range serverID {
+		layout.AllNodesInfo[id] = types.StreamingNodeStatus{
+			StreamingNodeInfo: types.StreamingNodeInfo{
+				ServerID: id,
+			},
+		}
+	}
+	for c, node := range channels {
+		vc := make(map[string]int64)
+		for i := 0; i < vchannels[c]; i++ {
+			vc[c+"_v"+string(rune('a'+i))] = 1
+		}
+		layout.Stats[newChannelID(c)] = channel.PChannelStatsView{VChannels: vc}
+		if node > 0 {
+			layout.ChannelsToNodes[newChannelID(c)] = int64(node)
+		}
+		layout.Channels[newChannelID(c)] = types.PChannelInfo{
+			Name:       c,
+			Term:       1,
+			AccessMode: types.AccessModeRW,
+		}
+		layout.ExpectedAccessMode[newChannelID(c)] = types.AccessModeRW
+	}
+	return layout
+}
@@ -42,6 +42,8 @@ type CurrentLayout struct {
 	AllNodesInfo       map[int64]types.StreamingNodeStatus    // AllNodesInfo is the full information of all available streaming nodes and related pchannels (contain the node not assign anything on it).
 	ChannelsToNodes    map[types.ChannelID]int64              // ChannelsToNodes maps assigned channel name to node id.
 	ExpectedAccessMode map[channel.ChannelID]types.AccessMode // ExpectedAccessMode is the expected access mode of all channel.
+	PinnedChannels     map[types.ChannelID]int64              // PinnedChannels maps the channel pinned by operator to the pinned node id, only the pins to available nodes are kept.
+	AntiAffinityGroups [][]types.ChannelID                    // AntiAffinityGroups is the groups of channels that should not be located at the same node.
 }
 
 // TotalChannels returns the total number of channels in the layout.
@@ -81,6 +83,11 @@ func (layout *CurrentLayout) AllowRebalance(channelID channel.ChannelID) bool {
 		return false
 	}
 
+	// The pinned channel is never moved by the balance policy.
+	if _, ok := layout.PinnedChannels[channelID]; ok {
+		return false
+	}
+
 	// If the last assign timestamp is too close to the current time, rebalance is not allowed.
 	if time.Since(layout.Stats[channelID].LastAssignTimestamp) < layout.Config.MinRebalanceIntervalThreshold {
 		return false
@@ -112,6 +119,128 @@ func (layout *CurrentLayout) GetWALMetrics(channelID channel.ChannelID) types.WA
 	return layout.AllNodesInfo[node].Metrics.WALMetrics[channelID]
 }
 
+// SortedNodeIDs returns the server ids of all available streaming nodes in ascending order.
+func (layout *CurrentLayout) SortedNodeIDs() []int64 {
+	nodeIDs := lo.Keys(layout.AllNodesInfo)
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+	return nodeIDs
+}
+
+// SortedChannelIDs returns the ids of all pchannels in ascending order.
+func (layout *CurrentLayout) SortedChannelIDs() []types.ChannelID {
+	channelIDs := lo.Keys(layout.Channels)
+	sort.Slice(channelIDs, func(i, j int) bool { return channelIDs[i].LT(channelIDs[j]) })
+	return channelIDs
+}
+
+// AssignTo generates the assignment of the pchannel to the streaming node with the expected access mode and a new term.
+func (layout *CurrentLayout) AssignTo(channelID types.ChannelID, serverID int64) types.PChannelInfoAssigned {
+	info := layout.Channels[channelID]
+	info.AccessMode = layout.ExpectedAccessMode[channelID]
+	info.Term++
+	return types.PChannelInfoAssigned{
+		Channel: info,
+		Node:    layout.AllNodesInfo[serverID].StreamingNodeInfo,
+	}
+}
+
+// applyPinnedChannels overrides the expected assignment of the pinned channels with the pinned node.
+func (layout *CurrentLayout) applyPinnedChannels(expected ExpectedLayout) ExpectedLayout {
+	if len(layout.PinnedChannels) == 0 {
+		return expected
+	}
+	if expected.ChannelAssignment == nil {
+		expected.ChannelAssignment = make(map[types.ChannelID]types.PChannelInfoAssigned, len(layout.PinnedChannels))
+	}
+	for channelID, serverID := range layout.PinnedChannels {
+		expected.ChannelAssignment[channelID] = layout.AssignTo(channelID, serverID)
+	}
+	return expected
+}
+
+// applyAntiAffinity moves the channels that conflict with the anti-affinity groups to another node.
+// A channel conflicts if another channel of its groups is located at the same node in the expected layout.
+// The conflicting channel is moved to the node with the fewest channels that does not conflict,
+// and the conflict is kept if there's no such node, so the anti-affinity is best-effort when the nodes are not enough.
+// The pinned channels are never moved.
+func (layout *CurrentLayout) applyAntiAffinity(expected ExpectedLayout) ExpectedLayout {
+	if len(layout.AntiAffinityGroups) == 0 || len(layout.AllNodesInfo) == 0 {
+		return expected
+	}
+	placement := make(map[types.ChannelID]int64, len(layout.ChannelsToNodes))
+	for channelID, serverID := range layout.ChannelsToNodes {
+		placement[channelID] = serverID
+	}
+	for channelID, assign := range expected.ChannelAssignment {
+		placement[channelID] = assign.Node.ServerID
+	}
+	peers := make(map[types.ChannelID][]types.ChannelID)
+	for _, group := range layout.AntiAffinityGroups {
+		for _, channelID := range group {
+			for _, peer := range group {
+				if peer != channelID {
+					peers[channelID] = append(peers[channelID], peer)
+				}
+			}
+		}
+	}
+	conflictAt := func(channelID types.ChannelID, serverID int64) bool {
+		for _, peer := range peers[channelID] {
+			if peerServerID, ok := placement[peer]; ok && peerServerID == serverID {
+				return true
+			}
+		}
+		return false
+	}
+	channelCount := make(map[int64]int, len(layout.AllNodesInfo))
+	for _, serverID := range placement {
+		channelCount[serverID]++
+	}
+
+	nodeIDs := layout.SortedNodeIDs()
+	for _, channelID := range layout.SortedChannelIDs() {
+		serverID, ok := placement[channelID]
+		if !ok || !conflictAt(channelID, serverID) {
+			continue
+		}
+		if _, ok := layout.PinnedChannels[channelID]; ok {
+			continue
+		}
+		targetServerID := int64(-1)
+		for _, nodeID := range nodeIDs {
+			if conflictAt(channelID, nodeID) {
+				continue
+			}
+			if targetServerID == -1 || channelCount[nodeID] < channelCount[targetServerID] {
+				targetServerID = nodeID
+			}
+		}
+		if targetServerID == -1 {
+			continue
+		}
+		if expected.ChannelAssignment == nil {
+			expected.ChannelAssignment = make(map[types.ChannelID]types.PChannelInfoAssigned)
+		}
+		expected.ChannelAssignment[channelID] = layout.AssignTo(channelID, targetServerID)
+		placement[channelID] = targetServerID
+		channelCount[serverID]--
+		channelCount[targetServerID]++
+	}
+	return expected
+}
+
+// applyBalanceFrozen drops the moves of the channels that are served by an available node from the expected layout.
+// Only the channels that are not assigned or located at an unavailable node are still assigned by the policy to keep the wal available,
+// and the access mode of a channel can still be changed in place.
+func (layout *CurrentLayout) applyBalanceFrozen(expected ExpectedLayout) ExpectedLayout {
+	for channelID, assign := range expected.ChannelAssignment {
+		if serverID, ok := layout.ChannelsToNodes[channelID]; ok && serverID != assign.Node.ServerID {
+			delete(expected.ChannelAssignment, channelID)
+		}
+	}
+	return expected
+}
+
 // GetAllPChannelsSortedByVChannelCountDesc returns all pchannels sorted by vchannel count in descending order.
 func (layout *CurrentLayout) GetAllPChannelsSortedByVChannelCountDesc() []types.ChannelID {
 	sorter := make(byVChannelCountDesc, 0, layout.TotalChannels())
@@ -145,6 +274,7 @@ func (a byVChannelCountDesc) Less(i, j int) bool {
 // ExpectedLayout is the expected layout of streaming node and pChannel.
 type ExpectedLayout struct {
 	ChannelAssignment map[types.ChannelID]types.PChannelInfoAssigned // ChannelAssignment is the assignment of channel to node.
+	OperatorChannels  []types.ChannelID                              // OperatorChannels is the channels moved by operator (pin or drain), the reassignment of them is never throttled.
 }
 
 // String returns the string representation of the expected layout.
@@ -191,11 +321,16 @@ func RegisterPolicy(b PolicyBuilder) {
 	}
 }
 
-// mustGetPolicy returns the walimpls builder by name.
+// mustGetPolicy returns the policy builder by name.
 func mustGetPolicy(name string) PolicyBuilder {
-	b, ok := policiesBuilders.Get(name)
+	b, ok := getPolicy(name)
 	if !ok {
 		panic("policy not found: " + name)
 	}
 	return b
 }
+
+// getPolicy returns the policy builder by name.
+func getPolicy(name string) (PolicyBuilder, bool) {
+	return policiesBuilders.Get(name)
+}
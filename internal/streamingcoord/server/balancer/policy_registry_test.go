@@ -0,0 +1,196 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type testPolicyBuilder struct {
+	name string
+}
+
+func (b *testPolicyBuilder) Name() string {
+	return b.name
+}
+
+func (b *testPolicyBuilder) Build() Policy {
+	return &testPolicy{name: b.name}
+}
+
+type testPolicy struct {
+	mlog.Binder
+	name string
+}
+
+func (p *testPolicy) Name() string {
+	return p.name
+}
+
+func (p *testPolicy) Balance(currentLayout CurrentLayout) (ExpectedLayout, error) {
+	return ExpectedLayout{}, nil
+}
+
+func TestSwitchPolicyIfChanged(t *testing.T) {
+	paramtable.Init()
+	resource.InitForTest()
+	RegisterPolicy(&testPolicyBuilder{name: "testPolicyA"})
+	RegisterPolicy(&testPolicyBuilder{name: "testPolicyB"})
+	assert.Panics(t, func() {
+		RegisterPolicy(&testPolicyBuilder{name: "testPolicyA"})
+	})
+
+	key := paramtable.Get().StreamingCfg.WALBalancerPolicyName.Key
+	paramtable.Get().Save(key, "testPolicyA")
+	defer paramtable.Get().Reset(key)
+
+	b := &balancerImpl{policy: buildPolicy(mustGetPolicy("testPolicyA"))}
+	b.switchPolicyIfChanged(context.Background())
+	assert.Equal(t, "testPolicyA", b.policy.Name())
+
+	// The policy is switched at runtime.
+	paramtable.Get().Save(key, "testPolicyB")
+	b.switchPolicyIfChanged(context.Background())
+	assert.Equal(t, "testPolicyB", b.policy.Name())
+
+	// The unknown policy is ignored.
+	paramtable.Get().Save(key, "non-exist")
+	b.switchPolicyIfChanged(context.Background())
+	assert.Equal(t, "testPolicyB", b.policy.Name())
+}
+
+func TestPinnedChannels(t *testing.T) {
+	paramtable.Init()
+	pinned := func(m *channel.PChannelMeta, serverID int64) *channel.PChannelMeta {
+		mutable := m.CopyForWrite()
+		mutable.PinToServerID(serverID)
+		return mutable.PChannelMeta
+	}
+	view := &channel.PChannelView{
+		Channels: map[channel.ChannelID]*channel.PChannelMeta{
+			{Name: "ch1"}: pinned(newAssignedPChannelMeta("ch1", 1), 2),
+			{Name: "ch2"}: pinned(channel.NewPChannelMeta("ch2", types.AccessModeRW), 2),
+			{Name: "ch3"}: pinned(newAssignedPChannelMeta("ch3", 1), 4),
+			{Name: "ch4"}: newAssignedPChannelMeta("ch4", 1),
+		},
+	}
+	nodeStatus := map[int64]*types.StreamingNodeStatus{
+		1: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 1}},
+		2: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 2}},
+		4: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 4}, Err: types.ErrFrozen},
+	}
+
+	// The pin to the unavailable node is ignored.
+	layout := generateCurrentLayout(view, nodeStatus, types.AccessModeRW)
+	assert.Equal(t, map[types.ChannelID]int64{{Name: "ch1"}: 2, {Name: "ch2"}: 2}, layout.PinnedChannels)
+	assert.Equal(t, int64(2), layout.ChannelsToNodes[types.ChannelID{Name: "ch1"}])
+	assert.Equal(t, int64(2), layout.ChannelsToNodes[types.ChannelID{Name: "ch2"}])
+	assert.Equal(t, int64(1), layout.ChannelsToNodes[types.ChannelID{Name: "ch3"}])
+	assert.False(t, layout.AllowRebalance(types.ChannelID{Name: "ch1"}))
+	assert.True(t, layout.AllowRebalance(types.ChannelID{Name: "ch4"}))
+
+	// The pinned channels always go to the pinned node whatever the policy decides.
+	expected := layout.applyPinnedChannels(ExpectedLayout{ChannelAssignment: map[types.ChannelID]types.PChannelInfoAssigned{
+		{Name: "ch1"}: layout.AssignTo(types.ChannelID{Name: "ch1"}, 1),
+		{Name: "ch3"}: layout.AssignTo(types.ChannelID{Name: "ch3"}, 2),
+		{Name: "ch4"}: layout.AssignTo(types.ChannelID{Name: "ch4"}, 1),
+	}})
+	assert.Len(t, expected.ChannelAssignment, 4)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[types.ChannelID{Name: "ch1"}].Node.ServerID)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[types.ChannelID{Name: "ch2"}].Node.ServerID)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[types.ChannelID{Name: "ch3"}].Node.ServerID)
+	assert.Equal(t, int64(1), expected.ChannelAssignment[types.ChannelID{Name: "ch4"}].Node.ServerID)
+}
+
+func TestApplyBalanceFrozen(t *testing.T) {
+	paramtable.Init()
+	view := &channel.PChannelView{
+		Channels: map[channel.ChannelID]*channel.PChannelMeta{
+			{Name: "ch1"}: newAssignedPChannelMeta("ch1", 1),
+			{Name: "ch2"}: newAssignedPChannelMeta("ch2", 3),
+			{Name: "ch3"}: channel.NewPChannelMeta("ch3", types.AccessModeRW),
+			{Name: "ch4"}: newAssignedPChannelMeta("ch4", 2),
+		},
+	}
+	nodeStatus := map[int64]*types.StreamingNodeStatus{
+		1: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 1}},
+		2: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 2}},
+		3: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 3}, Err: types.ErrFrozen},
+	}
+	layout := generateCurrentLayout(view, nodeStatus, types.AccessModeRW)
+	expected := layout.applyBalanceFrozen(ExpectedLayout{ChannelAssignment: map[types.ChannelID]types.PChannelInfoAssigned{
+		{Name: "ch1"}: layout.AssignTo(types.ChannelID{Name: "ch1"}, 2),
+		{Name: "ch2"}: layout.AssignTo(types.ChannelID{Name: "ch2"}, 1),
+		{Name: "ch3"}: layout.AssignTo(types.ChannelID{Name: "ch3"}, 1),
+		{Name: "ch4"}: layout.AssignTo(types.ChannelID{Name: "ch4"}, 2),
+	}})
+
+	// The serving channel ch1 is not moved, the channel at the unavailable node and the unassigned channel are still assigned.
+	assert.Len(t, expected.ChannelAssignment, 3)
+	assert.NotContains(t, expected.ChannelAssignment, types.ChannelID{Name: "ch1"})
+	assert.Equal(t, int64(1), expected.ChannelAssignment[types.ChannelID{Name: "ch2"}].Node.ServerID)
+	assert.Equal(t, int64(1), expected.ChannelAssignment[types.ChannelID{Name: "ch3"}].Node.ServerID)
+	assert.Equal(t, int64(2), expected.ChannelAssignment[types.ChannelID{Name: "ch4"}].Node.ServerID)
+}
+
+func TestApplyAntiAffinity(t *testing.T) {
+	paramtable.Init()
+	view := &channel.PChannelView{
+		Channels: map[channel.ChannelID]*channel.PChannelMeta{
+			{Name: "ch1"}: newAssignedPChannelMeta("ch1", 1),
+			{Name: "ch2"}: newAssignedPChannelMeta("ch2", 1),
+			{Name: "ch3"}: newAssignedPChannelMeta("ch3", 2),
+			{Name: "ch4"}: channel.NewPChannelMeta("ch4", types.AccessModeRW),
+		},
+	}
+	nodeStatus := map[int64]*types.StreamingNodeStatus{
+		1: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 1}},
+		2: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 2}},
+		3: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 3}},
+	}
+	layout := generateCurrentLayout(view, nodeStatus, types.AccessModeRW)
+
+	// no anti-affinity group, the expected layout is not changed.
+	expected := layout.applyAntiAffinity(ExpectedLayout{})
+	assert.Empty(t, expected.ChannelAssignment)
+
+	// ch1 conflicts with ch2 at node 1, it's moved to the node with the fewest channels.
+	// ch4 is expected to be assigned to node 2 by the policy, which conflicts with ch3.
+	layout.AntiAffinityGroups = generateAntiAffinityGroups([]*streamingpb.PChannelAntiAffinityGroupMeta{
+		{Name: "g1", Pchannels: []string{"ch1", "ch2"}},
+		{Name: "g2", Pchannels: []string{"ch3", "ch4"}},
+	})
+	expected = layout.applyAntiAffinity(ExpectedLayout{ChannelAssignment: map[types.ChannelID]types.PChannelInfoAssigned{
+		{Name: "ch4"}: layout.AssignTo(types.ChannelID{Name: "ch4"}, 2),
+	}})
+	assert.Len(t, expected.ChannelAssignment, 2)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[types.ChannelID{Name: "ch1"}].Node.ServerID)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[types.ChannelID{Name: "ch4"}].Node.ServerID)
+
+	// the conflict is kept if there's no available node.
+	layout.AntiAffinityGroups = generateAntiAffinityGroups([]*streamingpb.PChannelAntiAffinityGroupMeta{
+		{Name: "g1", Pchannels: []string{"ch1", "ch2", "ch3", "ch4"}},
+	})
+	expected = layout.applyAntiAffinity(ExpectedLayout{ChannelAssignment: map[types.ChannelID]types.PChannelInfoAssigned{
+		{Name: "ch4"}: layout.AssignTo(types.ChannelID{Name: "ch4"}, 3),
+	}})
+	assert.Len(t, expected.ChannelAssignment, 1)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[types.ChannelID{Name: "ch4"}].Node.ServerID)
+
+	// the pinned channel is never moved.
+	layout.AntiAffinityGroups = generateAntiAffinityGroups([]*streamingpb.PChannelAntiAffinityGroupMeta{
+		{Name: "g1", Pchannels: []string{"ch1", "ch2"}},
+	})
+	layout.PinnedChannels = map[types.ChannelID]int64{{Name: "ch1"}: 1}
+	expected = layout.applyAntiAffinity(ExpectedLayout{})
+	assert.Len(t, expected.ChannelAssignment, 1)
+	assert.Equal(t, int64(3), expected.ChannelAssignment[types.ChannelID{Name: "ch2"}].Node.ServerID)
+}
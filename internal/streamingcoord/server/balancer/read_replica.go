@@ -0,0 +1,218 @@
+package balancer
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// balanceReadReplicas assigns the read-only replicas of the assigned pchannels to the streaming nodes other than the read-write owner.
+// The read-write assignment should be stable before the read replicas are balanced.
+func (b *balancerImpl) balanceReadReplicas(ctx context.Context) error {
+	replicaNum := paramtable.Get().StreamingCfg.WALBalancerReadReplicaNum.GetAsInt()
+	current := b.channelMetaManager.CurrentReadReplicas()
+	if replicaNum <= 0 && len(current) == 0 {
+		return nil
+	}
+	if !b.channelMetaManager.IsStreamingEnabledOnce() {
+		// the wal is opened as read-only before streaming is enabled, no replica is required.
+		return nil
+	}
+
+	nodeStatus, err := b.fetchStreamingNodeStatus(ctx, paramtable.Get().StreamingCfg.PrimaryResourceGroup.GetValue())
+	if err != nil {
+		return err
+	}
+	expected := generateExpectedReadReplicas(b.channelMetaManager.CurrentPChannelsView(), nodeStatus, current, replicaNum)
+	added, removed := diffReadReplicas(current, expected)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	b.Logger().Info(ctx, "read replicas need to be applied...", mlog.Int("addedCount", len(added)), mlog.Int("removedCount", len(removed)))
+
+	// open the new replicas first, the replica is visible to the consumers only after it's opened.
+	mu := sync.Mutex{}
+	g, _ := errgroup.WithContext(ctx)
+	opTimeout := paramtable.Get().StreamingCfg.WALBalancerOperationTimeout.GetAsDurationByParse()
+	for _, replica := range added {
+		replica := replica
+		g.Go(func() error {
+			opCtx, cancel := context.WithTimeout(ctx, opTimeout)
+			defer cancel()
+			if err := resource.Resource().StreamingNodeManagerClient().Assign(opCtx, replica); err != nil {
+				// the failed replica will be retried at next balance.
+				b.Logger().Warn(ctx, "fail to assign read replica", mlog.String("assignment", replica.String()), mlog.Err(err))
+				mu.Lock()
+				expected[replica.Channel.ChannelID()] = removeReadReplica(expected[replica.Channel.ChannelID()], replica)
+				mu.Unlock()
+				return nil
+			}
+			b.Logger().Info(ctx, "assign read replica success", mlog.String("assignment", replica.String()))
+			return nil
+		})
+	}
+	_ = g.Wait()
+	b.channelMetaManager.UpdateReadReplicas(ctx, expected)
+
+	// close the stale replicas after they are invisible to the consumers.
+	for _, replica := range removed {
+		replica := replica
+		g.Go(func() error {
+			opCtx, cancel := context.WithTimeout(ctx, opTimeout)
+			defer cancel()
+			if err := resource.Resource().StreamingNodeManagerClient().Remove(opCtx, replica); err != nil {
+				// the stale replica never writes, it will be revoked by the lease if the removal is failed.
+				b.Logger().Warn(ctx, "fail to remove read replica", mlog.String("assignment", replica.String()), mlog.Err(err))
+				return nil
+			}
+			b.Logger().Info(ctx, "remove read replica success", mlog.String("assignment", replica.String()))
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// generateExpectedReadReplicas generates the expected read replicas of the assigned pchannels.
+// The existing replicas that are still valid are kept to avoid the churn,
+// the missing replicas are assigned to the healthy nodes with the least load.
+func generateExpectedReadReplicas(
+	view *channel.PChannelView,
+	nodeStatus map[int64]*types.StreamingNodeStatus,
+	current map[channel.ChannelID][]types.PChannelInfoAssigned,
+	replicaNum int,
+) map[channel.ChannelID][]types.PChannelInfoAssigned {
+	healthyNodes := make([]int64, 0, len(nodeStatus))
+	for serverID, status := range nodeStatus {
+		if status.IsHealthy() {
+			healthyNodes = append(healthyNodes, serverID)
+		}
+	}
+	sort.Slice(healthyNodes, func(i, j int) bool { return healthyNodes[i] < healthyNodes[j] })
+
+	// the load of node is the count of read-write channels and read replicas on it.
+	load := make(map[int64]int, len(healthyNodes))
+	assigned := make([]*channel.PChannelMeta, 0, len(view.Channels))
+	for _, meta := range view.Channels {
+		if meta.IsAssigned() {
+			assigned = append(assigned, meta)
+			load[meta.CurrentServerID()]++
+		}
+	}
+	sort.Slice(assigned, func(i, j int) bool { return assigned[i].Name() < assigned[j].Name() })
+
+	expected := make(map[channel.ChannelID][]types.PChannelInfoAssigned, len(assigned))
+	if replicaNum <= 0 {
+		return expected
+	}
+	// keep the valid replicas.
+	for _, meta := range assigned {
+		replicas := make([]types.PChannelInfoAssigned, 0, replicaNum)
+		for _, replica := range current[meta.ChannelID()] {
+			if len(replicas) >= replicaNum {
+				break
+			}
+			status, ok := nodeStatus[replica.Node.ServerID]
+			if !ok || !status.IsHealthy() || replica.Node.ServerID == meta.CurrentServerID() || replica.Channel.Term != meta.CurrentTerm() {
+				continue
+			}
+			replicas = append(replicas, replica)
+			load[replica.Node.ServerID]++
+		}
+		expected[meta.ChannelID()] = replicas
+	}
+	// fill up the missing replicas.
+	for _, meta := range assigned {
+		replicas := expected[meta.ChannelID()]
+		for len(replicas) < replicaNum {
+			target := int64(-1)
+			for _, serverID := range healthyNodes {
+				if serverID == meta.CurrentServerID() || hasReadReplicaOn(replicas, serverID) {
+					continue
+				}
+				if target == -1 || load[serverID] < load[target] {
+					target = serverID
+				}
+			}
+			if target == -1 {
+				// no more node can hold the replica.
+				break
+			}
+			info := meta.ChannelInfo()
+			info.AccessMode = types.AccessModeRO
+			replicas = append(replicas, types.PChannelInfoAssigned{
+				Channel: info,
+				Node:    nodeStatus[target].StreamingNodeInfo,
+			})
+			load[target]++
+		}
+		if len(replicas) == 0 {
+			delete(expected, meta.ChannelID())
+			continue
+		}
+		expected[meta.ChannelID()] = replicas
+	}
+	return expected
+}
+
+// diffReadReplicas returns the replicas that should be opened and removed.
+func diffReadReplicas(current, expected map[channel.ChannelID][]types.PChannelInfoAssigned) (added []types.PChannelInfoAssigned, removed []types.PChannelInfoAssigned) {
+	for id, replicas := range expected {
+		for _, replica := range replicas {
+			if !containsReadReplica(current[id], replica) {
+				added = append(added, replica)
+			}
+		}
+	}
+	for id, replicas := range current {
+		for _, replica := range replicas {
+			if containsReadReplica(expected[id], replica) {
+				continue
+			}
+			if hasReadReplicaOn(expected[id], replica.Node.ServerID) {
+				// the replica is replaced by the one with new term on the same node, so no need to remove it.
+				continue
+			}
+			removed = append(removed, replica)
+		}
+	}
+	return added, removed
+}
+
+// containsReadReplica checks if the replica is in the replicas.
+func containsReadReplica(replicas []types.PChannelInfoAssigned, target types.PChannelInfoAssigned) bool {
+	for _, replica := range replicas {
+		if replica.Node.ServerID == target.Node.ServerID && replica.Channel.Term == target.Channel.Term {
+			return true
+		}
+	}
+	return false
+}
+
+// hasReadReplicaOn checks if there's a replica on the streaming node.
+func hasReadReplicaOn(replicas []types.PChannelInfoAssigned, serverID int64) bool {
+	for _, replica := range replicas {
+		if replica.Node.ServerID == serverID {
+			return true
+		}
+	}
+	return false
+}
+
+// removeReadReplica removes the replica from the replicas.
+func removeReadReplica(replicas []types.PChannelInfoAssigned, target types.PChannelInfoAssigned) []types.PChannelInfoAssigned {
+	result := make([]types.PChannelInfoAssigned, 0, len(replicas))
+	for _, replica := range replicas {
+		if replica.Node.ServerID != target.Node.ServerID || replica.Channel.Term != target.Channel.Term {
+			result = append(result, replica)
+		}
+	}
+	return result
+}
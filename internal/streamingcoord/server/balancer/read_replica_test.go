@@ -0,0 +1,87 @@
+package balancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+)
+
+func newAssignedPChannelMeta(name string, serverID int64) *channel.PChannelMeta {
+	m := channel.NewPChannelMeta(name, types.AccessModeRW).CopyForWrite()
+	m.TryAssignToServerID(types.AccessModeRW, types.StreamingNodeInfo{ServerID: serverID})
+	m.AssignToServerDone()
+	return m.PChannelMeta
+}
+
+func TestGenerateExpectedReadReplicas(t *testing.T) {
+	view := &channel.PChannelView{
+		Channels: map[channel.ChannelID]*channel.PChannelMeta{
+			{Name: "ch1"}: newAssignedPChannelMeta("ch1", 1),
+			{Name: "ch2"}: newAssignedPChannelMeta("ch2", 2),
+			{Name: "ch3"}: channel.NewPChannelMeta("ch3", types.AccessModeRW),
+		},
+	}
+	nodeStatus := map[int64]*types.StreamingNodeStatus{
+		1: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 1}},
+		2: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 2}},
+		3: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 3}},
+		4: {StreamingNodeInfo: types.StreamingNodeInfo{ServerID: 4}, Err: types.ErrFrozen},
+	}
+
+	// no replica is required.
+	expected := generateExpectedReadReplicas(view, nodeStatus, nil, 0)
+	assert.Empty(t, expected)
+
+	expected = generateExpectedReadReplicas(view, nodeStatus, nil, 1)
+	assert.Len(t, expected, 2)
+	for id, replicas := range expected {
+		assert.Len(t, replicas, 1)
+		assert.Equal(t, types.AccessModeRO, replicas[0].Channel.AccessMode)
+		assert.Equal(t, view.Channels[id].CurrentTerm(), replicas[0].Channel.Term)
+		assert.NotEqual(t, view.Channels[id].CurrentServerID(), replicas[0].Node.ServerID)
+		assert.NotEqual(t, int64(4), replicas[0].Node.ServerID)
+	}
+	// the least loaded node 3 should be picked first.
+	assert.Equal(t, int64(3), expected[channel.ChannelID{Name: "ch1"}][0].Node.ServerID)
+
+	// the valid replicas are kept, the replica on unhealthy node or with stale term is replaced.
+	current := map[channel.ChannelID][]types.PChannelInfoAssigned{
+		{Name: "ch1"}: {
+			{Channel: types.PChannelInfo{Name: "ch1", Term: 2, AccessMode: types.AccessModeRO}, Node: types.StreamingNodeInfo{ServerID: 2}},
+		},
+		{Name: "ch2"}: {
+			{Channel: types.PChannelInfo{Name: "ch2", Term: 1, AccessMode: types.AccessModeRO}, Node: types.StreamingNodeInfo{ServerID: 1}},
+			{Channel: types.PChannelInfo{Name: "ch2", Term: 2, AccessMode: types.AccessModeRO}, Node: types.StreamingNodeInfo{ServerID: 4}},
+		},
+		{Name: "ch3"}: {
+			{Channel: types.PChannelInfo{Name: "ch3", Term: 1, AccessMode: types.AccessModeRO}, Node: types.StreamingNodeInfo{ServerID: 3}},
+		},
+	}
+	expected = generateExpectedReadReplicas(view, nodeStatus, current, 1)
+	assert.Equal(t, int64(2), expected[channel.ChannelID{Name: "ch1"}][0].Node.ServerID)
+	assert.Equal(t, int64(2), expected[channel.ChannelID{Name: "ch2"}][0].Channel.Term)
+	assert.NotContains(t, expected, channel.ChannelID{Name: "ch3"})
+
+	added, removed := diffReadReplicas(current, expected)
+	assert.Len(t, added, 1)
+	assert.Equal(t, "ch2", added[0].Channel.Name)
+	assert.Equal(t, int64(3), added[0].Node.ServerID)
+	assert.Len(t, removed, 3)
+
+	// the stale replica replaced by the new term one on the same node is not removed.
+	added, removed = diffReadReplicas(current, map[channel.ChannelID][]types.PChannelInfoAssigned{
+		{Name: "ch2"}: {
+			{Channel: types.PChannelInfo{Name: "ch2", Term: 2, AccessMode: types.AccessModeRO}, Node: types.StreamingNodeInfo{ServerID: 1}},
+		},
+	})
+	assert.Len(t, added, 1)
+	assert.Len(t, removed, 3)
+
+	// all replicas are removed if the replica num is set to 0.
+	added, removed = diffReadReplicas(current, generateExpectedReadReplicas(view, nodeStatus, current, 0))
+	assert.Empty(t, added)
+	assert.Len(t, removed, 4)
+}
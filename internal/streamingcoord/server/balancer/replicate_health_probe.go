@@ -0,0 +1,89 @@
+package balancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/replicatecheck"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// replicateHealthProbeDisabledInterval is the interval of re-reading the configuration when the health probe is disabled.
+const replicateHealthProbeDisabledInterval = time.Minute
+
+// probeReplicateTargetClusters probes the reachability of the target clusters of current cluster periodically.
+func (b *balancerImpl) probeReplicateTargetClusters(ctx context.Context) {
+	prober := replicatecheck.NewHealthProber()
+	defer prober.Close(context.Background())
+
+	nextInterval := func() (time.Duration, bool) {
+		interval := paramtable.Get().StreamingCfg.ReplicationHealthProbeInterval.GetAsDurationByParse()
+		if interval <= 0 {
+			return replicateHealthProbeDisabledInterval, false
+		}
+		return interval, true
+	}
+	interval, _ := nextInterval()
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	defer b.reportReplicateTargetClusterHealth(ctx, nil)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		interval, enabled := nextInterval()
+		timer.Reset(interval)
+		if !enabled {
+			b.reportReplicateTargetClusterHealth(ctx, nil)
+			continue
+		}
+
+		latestAssignment, err := b.channelMetaManager.GetLatestChannelAssignment()
+		if err != nil {
+			continue
+		}
+		if latestAssignment.ReplicateConfiguration == nil {
+			b.reportReplicateTargetClusterHealth(ctx, nil)
+			continue
+		}
+		config, err := replicateutil.NewConfigHelper(paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), latestAssignment.ReplicateConfiguration)
+		if err != nil {
+			b.Logger().Warn(ctx, "fail to parse the replicate configuration for health probe", mlog.Err(err))
+			continue
+		}
+		b.reportReplicateTargetClusterHealth(ctx, prober.Probe(ctx, config))
+	}
+}
+
+// reportReplicateTargetClusterHealth reports the health of the target clusters by logs, metrics and the channel manager.
+func (b *balancerImpl) reportReplicateTargetClusterHealth(ctx context.Context, healths []*streamingpb.ReplicateTargetClusterHealth) {
+	b.channelMetaManager.UpdateTargetClusterHealth(healths)
+
+	nodeID := paramtable.GetStringNodeID()
+	labels := prometheus.Labels{metrics.NodeIDLabelName: nodeID}
+	metrics.StreamingCoordReplicateTargetReachable.DeletePartialMatch(labels)
+	metrics.StreamingCoordReplicateTargetProbeLatencySeconds.DeletePartialMatch(labels)
+	for _, health := range healths {
+		reachable := 0.0
+		if health.GetReachable() {
+			reachable = 1.0
+		} else {
+			b.Logger().Warn(ctx, "replicate target cluster is unreachable",
+				mlog.String("targetCluster", health.GetClusterId()),
+				mlog.Int64("consecutiveFailures", health.GetConsecutiveFailures()),
+				mlog.Int64("lastReachableTimestampSeconds", health.GetLastReachableTimestampSeconds()),
+				mlog.String("error", health.GetError()))
+		}
+		metrics.StreamingCoordReplicateTargetReachable.WithLabelValues(nodeID, health.GetClusterId()).Set(reachable)
+		metrics.StreamingCoordReplicateTargetProbeLatencySeconds.WithLabelValues(nodeID, health.GetClusterId()).Set(float64(health.GetLatencyMilliseconds()) / 1000)
+	}
+}
@@ -0,0 +1,101 @@
+package balancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/replicatecheck"
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// replicateSchemaCheckDisabledInterval is the interval of re-reading the configuration when the periodic check is disabled.
+const replicateSchemaCheckDisabledInterval = time.Minute
+
+// checkReplicateSchemaConsistency checks the schema consistency between current cluster and its target clusters periodically.
+func (b *balancerImpl) checkReplicateSchemaConsistency(ctx context.Context) {
+	checker := replicatecheck.NewSchemaChecker()
+	nextInterval := func() (time.Duration, bool) {
+		interval := paramtable.Get().StreamingCfg.ReplicationSchemaCheckInterval.GetAsDurationByParse()
+		if interval <= 0 {
+			return replicateSchemaCheckDisabledInterval, false
+		}
+		return interval, true
+	}
+	interval, _ := nextInterval()
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	defer metrics.StreamingCoordReplicateSchemaDriftTotal.DeletePartialMatch(prometheus.Labels{
+		metrics.NodeIDLabelName: paramtable.GetStringNodeID(),
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		interval, enabled := nextInterval()
+		timer.Reset(interval)
+		if !enabled {
+			continue
+		}
+
+		latestAssignment, err := b.channelMetaManager.GetLatestChannelAssignment()
+		if err != nil || latestAssignment.ReplicateConfiguration == nil {
+			continue
+		}
+		config, err := replicateutil.NewConfigHelper(paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), latestAssignment.ReplicateConfiguration)
+		if err != nil {
+			b.Logger().Warn(ctx, "fail to parse the replicate configuration for schema consistency check", mlog.Err(err))
+			continue
+		}
+		if len(config.GetCurrentCluster().TargetClusters()) == 0 {
+			continue
+		}
+		drifts, err := checker.Check(ctx, config, "")
+		if err != nil {
+			b.Logger().Warn(ctx, "fail to check the schema consistency of replication", mlog.Err(err))
+			continue
+		}
+		b.reportReplicateSchemaDrifts(ctx, config, drifts)
+	}
+}
+
+// reportReplicateSchemaDrifts reports the schema drifts by logs and metrics.
+func (b *balancerImpl) reportReplicateSchemaDrifts(ctx context.Context, config *replicateutil.ConfigHelper, drifts []*streamingpb.ReplicateSchemaDrift) {
+	nodeID := paramtable.GetStringNodeID()
+	metrics.StreamingCoordReplicateSchemaDriftTotal.DeletePartialMatch(prometheus.Labels{
+		metrics.NodeIDLabelName: nodeID,
+	})
+	counts := make(map[string]map[streamingpb.ReplicateSchemaDriftType]int)
+	for _, target := range config.GetCurrentCluster().TargetClusters() {
+		counts[target.GetClusterId()] = make(map[streamingpb.ReplicateSchemaDriftType]int)
+	}
+	for _, drift := range drifts {
+		b.Logger().Warn(ctx, "schema drift found between current cluster and the target cluster",
+			mlog.String("targetCluster", drift.GetTargetClusterId()),
+			mlog.String("dbName", drift.GetDbName()),
+			mlog.String("collectionName", drift.GetCollectionName()),
+			mlog.Stringer("type", drift.GetType()),
+			mlog.String("detail", drift.GetDetail()))
+		if _, ok := counts[drift.GetTargetClusterId()]; ok {
+			counts[drift.GetTargetClusterId()][drift.GetType()]++
+		}
+	}
+	for targetClusterID, countByType := range counts {
+		for typ := range streamingpb.ReplicateSchemaDriftType_name {
+			driftType := streamingpb.ReplicateSchemaDriftType(typ)
+			if driftType == streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_UNKNOWN {
+				continue
+			}
+			metrics.StreamingCoordReplicateSchemaDriftTotal.WithLabelValues(nodeID, targetClusterID, driftType.String()).Set(float64(countByType[driftType]))
+		}
+	}
+	b.Logger().Info(ctx, "schema consistency check of replication done", mlog.Int("drifts", len(drifts)))
+}
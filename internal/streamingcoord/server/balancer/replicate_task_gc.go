@@ -0,0 +1,29 @@
+package balancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// gcRemovedReplicatingTasks garbage-collects the replicating tasks of the removed replication edges periodically.
+func (b *balancerImpl) gcRemovedReplicatingTasks(ctx context.Context) {
+	timer := time.NewTimer(paramtable.Get().StreamingCfg.ReplicationRemovedTaskGCInterval.GetAsDurationByParse())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		timer.Reset(paramtable.Get().StreamingCfg.ReplicationRemovedTaskGCInterval.GetAsDurationByParse())
+
+		timeout := paramtable.Get().StreamingCfg.ReplicationRemovedTaskGCTimeout.GetAsDurationByParse()
+		if err := b.channelMetaManager.GCRemovedReplicatingTasks(ctx, timeout); err != nil {
+			b.Logger().Warn(ctx, "fail to garbage-collect the replicating tasks of removed replication edges", mlog.Err(err))
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -38,11 +39,20 @@ func newOpUpdateBalancePolicy(ctx context.Context, req *types.UpdateWALBalancePo
 					switch field {
 					case types.UpdateMaskPathWALBalancePolicyAllowRebalance:
 						updateAllowRebalance(ctx, impl, req.GetConfig().GetAllowRebalance())
+					case types.UpdateMaskPathWALBalancePolicyBalanceFrozen:
+						if err := updateBalanceFrozen(ctx, impl, req.GetConfig().GetBalanceFrozen()); err != nil {
+							future.Set(response{err: err})
+							return
+						}
 					}
 				}
 			} else {
 				// otherwise update all fields.
 				updateAllowRebalance(ctx, impl, req.GetConfig().GetAllowRebalance())
+				if err := updateBalanceFrozen(ctx, impl, req.GetConfig().GetBalanceFrozen()); err != nil {
+					future.Set(response{err: err})
+					return
+				}
 			}
 			// apply the freeze streaming nodes.
 			if len(req.GetNodes().GetFreezeNodeIds()) > 0 || len(req.GetNodes().GetDefreezeNodeIds()) > 0 {
@@ -53,6 +63,7 @@ func newOpUpdateBalancePolicy(ctx context.Context, req *types.UpdateWALBalancePo
 			future.Set(response{resp: &types.UpdateWALBalancePolicyResponse{
 				Config: &streamingpb.WALBalancePolicyConfig{
 					AllowRebalance: paramtable.Get().StreamingCfg.WALBalancerPolicyAllowRebalance.GetAsBool(),
+					BalanceFrozen:  impl.balanceFrozen,
 				},
 				FreezeNodeIds: impl.freezeNodes.Collect(),
 			}, err: nil})
@@ -67,6 +78,21 @@ func updateAllowRebalance(ctx context.Context, impl *balancerImpl, allowRebalanc
 	impl.Logger().Info(ctx, "update allow_rebalance", mlog.Bool("new", allowRebalance), mlog.String("old", old))
 }
 
+// updateBalanceFrozen persists the balance frozen flag and applies it to the balancer.
+func updateBalanceFrozen(ctx context.Context, impl *balancerImpl, balanceFrozen bool) error {
+	if impl.balanceFrozen == balanceFrozen {
+		return nil
+	}
+	if err := resource.Resource().StreamingCatalog().SaveBalancerConfig(ctx, &streamingpb.BalancerConfigMeta{
+		BalanceFrozen: balanceFrozen,
+	}); err != nil {
+		return err
+	}
+	impl.balanceFrozen = balanceFrozen
+	impl.Logger().Info(ctx, "update balance_frozen", mlog.Bool("new", balanceFrozen))
+	return nil
+}
+
 // newOpMarkAsUnavailable is a operation to mark some channels as unavailable.
 func newOpMarkAsUnavailable(ctx context.Context, pChannels []types.PChannelInfo) *request {
 	future := syncutil.NewFuture[response]()
@@ -80,6 +106,84 @@ func newOpMarkAsUnavailable(ctx context.Context, pChannels []types.PChannelInfo)
 	}
 }
 
+// newOpDisableStreaming is a operation to roll back the streaming service to the legacy path.
+// It's applied at the background balance loop, so no new assignment is generated while disabling.
+func newOpDisableStreaming(ctx context.Context) *request {
+	future := syncutil.NewFuture[response]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			err := impl.channelMetaManager.DisableStreaming(ctx)
+			future.Set(response{err: err})
+		},
+		future: future,
+	}
+}
+
+// newOpUpdatePChannelPins is a operation to pin the pchannels to the streaming nodes or unpin them.
+// The balance is triggered after the pins are updated, so the pins take effect immediately.
+func newOpUpdatePChannelPins(ctx context.Context, req *types.UpdatePChannelPinsRequest) *request {
+	future := syncutil.NewFuture[response]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			resp, err := impl.channelMetaManager.UpdatePChannelPins(ctx, req)
+			future.Set(response{resp: resp, err: err})
+		},
+		future: future,
+	}
+}
+
+// newOpUpdatePChannelAntiAffinityGroups is a operation to create, replace or drop the pchannel anti-affinity groups.
+// The balance is triggered after the groups are updated, so the conflicting pchannels are moved away immediately.
+func newOpUpdatePChannelAntiAffinityGroups(ctx context.Context, req *types.UpdatePChannelAntiAffinityGroupsRequest) *request {
+	future := syncutil.NewFuture[response]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			resp, err := impl.channelMetaManager.UpdatePChannelAntiAffinityGroups(ctx, req)
+			future.Set(response{resp: resp, err: err})
+		},
+		future: future,
+	}
+}
+
+// newOpDrainNode is a operation to drain the pchannels from a streaming node or cancel the draining.
+// The draining node is frozen, so the balance triggered after the operation moves the pchannels away from it.
+func newOpDrainNode(ctx context.Context, req *types.DrainNodeRequest) *request {
+	future := syncutil.NewFuture[response]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			serverID := req.GetServerId()
+			if req.GetCancel() {
+				impl.freezeNodes.Remove(serverID)
+			} else if impl.freezeNodes.Insert(serverID) {
+				impl.Logger().Info(ctx, "start to drain streaming node", mlog.Int64("serverID", serverID))
+			}
+			future.Set(response{resp: &types.DrainNodeResponse{
+				ServerId:           serverID,
+				Draining:           impl.freezeNodes.Contain(serverID),
+				RemainingPchannels: impl.channelMetaManager.ListPChannelsOnServer(serverID),
+			}})
+		},
+		future: future,
+	}
+}
+
+// newOpPreviewRebalance is a operation to compute the moves of the next balance round without applying them.
+func newOpPreviewRebalance(ctx context.Context) *request {
+	future := syncutil.NewFuture[response]()
+	return &request{
+		ctx: ctx,
+		apply: func(impl *balancerImpl) {
+			moves, err := impl.previewRebalance(ctx)
+			future.Set(response{resp: moves, err: err})
+		},
+		future: future,
+	}
+}
+
 // newOpTrigger is a operation to trigger a re-balance operation.
 func newOpTrigger(ctx context.Context) *request {
 	future := syncutil.NewFuture[response]()
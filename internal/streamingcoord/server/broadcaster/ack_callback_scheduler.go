@@ -25,6 +25,7 @@ func newAckCallbackScheduler(logger *mlog.Logger) *ackCallbackScheduler {
 		rkLockerMu:         sync.Mutex{},
 		rkLocker:           newResourceKeyLocker(),
 		tombstoneScheduler: newTombstoneScheduler(logger),
+		watchHub:           newWatchEventHub(),
 	}
 	s.SetLogger(logger)
 	return s
@@ -37,6 +38,7 @@ type ackCallbackScheduler struct {
 	pending            chan *broadcastTask
 	triggerChan        chan struct{}
 	tombstoneScheduler *tombstoneScheduler
+	watchHub           *watchEventHub   // deliver the applied broadcast messages to the watchers.
 	pendingAckedTasks  []*broadcastTask // should already sorted by the broadcastID
 	// For the task that hold the conflicted resource-key (which is protected by the resource-key lock),
 	// broadcastID is always increasing,
@@ -302,6 +304,7 @@ func (s *ackCallbackScheduler) doAckCallback(bt *broadcastTask, g *lockGuards) (
 		// The catalog is reliable to write, so we can mark the ack callback done without retrying.
 		return err
 	}
+	s.watchHub.Publish(bt.Header().BroadcastID, msg)
 	s.tombstoneScheduler.AddPending(bt.Header().BroadcastID)
 	return nil
 }
@@ -5,6 +5,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
@@ -219,6 +221,17 @@ func (bm *broadcastTaskManager) broadcast(ctx context.Context, msg message.Broad
 	// Validation is now done before calling broadcast (in DataCoord for import operations)
 	// CheckCallback mechanism has been removed as part of the import refactoring
 
+	if msg.BroadcastHeader().Barrier {
+		// The barrier time tick is allocated after the resource key locks are held,
+		// and persisted with the task, so the recovered task keeps the same barrier.
+		barrierTimeTick, err := bm.allocateBarrierTimeTick(ctx)
+		if err != nil {
+			guards.Unlock()
+			return nil, err
+		}
+		msg = msg.WithBroadcastBarrierTimeTick(barrierTimeTick)
+	}
+
 	task := bm.addBroadcastTask(msg, broadcastID, guards)
 	pendingTask := newPendingBroadcastTask(task)
 
@@ -226,6 +239,18 @@ func (bm *broadcastTaskManager) broadcast(ctx context.Context, msg message.Broad
 	return bm.broadcastScheduler.AddTask(ctx, pendingTask)
 }
 
+// allocateBarrierTimeTick allocates a barrier time tick from the remote tso allocator.
+// The local cache of allocator is expired first, so the barrier is greater than the time ticks already allocated by the wals.
+func (bm *broadcastTaskManager) allocateBarrierTimeTick(ctx context.Context) (uint64, error) {
+	allocator := resource.Resource().TSOAllocator()
+	allocator.Sync()
+	barrierTimeTick, err := allocator.Allocate(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to allocate barrier time tick of broadcast")
+	}
+	return barrierTimeTick, nil
+}
+
 // LegacyAck is the legacy ack function for the broadcast task.
 // It will not be used after upgrading to 2.6.1, only used for compatibility.
 func (bm *broadcastTaskManager) LegacyAck(ctx context.Context, broadcastID uint64, vchannel string) error {
@@ -265,6 +290,16 @@ func (bm *broadcastTaskManager) Ack(ctx context.Context, msg message.ImmutableMe
 	return t.Ack(ctx, msg)
 }
 
+// Watch watches the broadcast messages whose ack callback is done.
+func (bm *broadcastTaskManager) Watch(ctx context.Context, token *WatchResumeToken, cb WatchCallback) error {
+	if !bm.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("broadcaster is closing")
+	}
+	defer bm.lifetime.Done()
+
+	return bm.ackScheduler.watchHub.Watch(ctx, token, cb)
+}
+
 // DropTombstone drops the tombstone task from the manager.
 func (bm *broadcastTaskManager) DropTombstone(ctx context.Context, broadcastID uint64) error {
 	if !bm.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -289,6 +324,8 @@ func (bm *broadcastTaskManager) DropTombstone(ctx context.Context, broadcastID u
 // Close closes the broadcast task manager.
 func (bm *broadcastTaskManager) Close() {
 	bm.lifetime.SetState(typeutil.LifetimeStateStopped)
+	// the watchers are blocked until the hub is closed, so close it before waiting.
+	bm.ackScheduler.watchHub.Close()
 	bm.lifetime.Wait()
 
 	bm.broadcastScheduler.Close()
@@ -388,7 +425,8 @@ func (bm *broadcastTaskManager) GetPendingCreateCollectionResources() map[int64]
 			continue
 		}
 		createMsg, err := message.AsMutableCreateCollectionMessageV1(task.msg)
-		if err != nil {
+		if err != nil || createMsg.Header().Reshard != nil {
+			// the reshard message never adds the collection, so the file resources are already counted.
 			continue
 		}
 		body := createMsg.MustBody()
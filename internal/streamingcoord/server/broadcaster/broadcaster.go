@@ -37,6 +37,11 @@ type Broadcaster interface {
 	// their ack callback yet. Used during recovery to rebuild file resource refCnt.
 	GetPendingCreateCollectionResources() map[int64][]int64
 
+	// Watch watches the broadcast messages whose ack callback is done in the applied order.
+	// Watch from the latest message if the resume token is nil.
+	// It blocks until the context is done, the broadcaster is closed or the callback returns an error.
+	Watch(ctx context.Context, token *WatchResumeToken, cb WatchCallback) error
+
 	// Close closes the broadcaster.
 	Close()
 }
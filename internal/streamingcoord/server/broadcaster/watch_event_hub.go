@@ -0,0 +1,147 @@
+package broadcaster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+// WatchResumeToken is the position of the applied broadcast event stream.
+// The sequence is only comparable in the same epoch,
+// a new epoch is generated when the broadcaster is recovered.
+type WatchResumeToken struct {
+	Epoch    int64
+	Sequence uint64
+}
+
+// WatchEvent is the event that a broadcast message is applied (the ack callback is done).
+type WatchEvent struct {
+	ResumeToken WatchResumeToken
+	// Resync is true if the events after the requested resume token are not available any more,
+	// the watcher should reload the state from meta, the following events are delivered after ResumeToken.
+	Resync      bool
+	BroadcastID uint64
+	Message     message.BroadcastMutableMessage
+}
+
+// WatchCallback is the callback of the broadcast watch.
+// The watch will be stopped if the callback returns an error.
+type WatchCallback func(WatchEvent) error
+
+// newWatchEventHub creates a new watch event hub.
+func newWatchEventHub() *watchEventHub {
+	return &watchEventHub{
+		cond:     syncutil.NewContextCond(&sync.Mutex{}),
+		epoch:    time.Now().UnixNano(),
+		capacity: paramtable.Get().StreamingCfg.WALBroadcasterWatchBufferSize.GetAsInt(),
+		events:   make([]WatchEvent, 0),
+	}
+}
+
+// watchEventHub keeps the recent applied broadcast events in memory and delivers them to the watchers.
+type watchEventHub struct {
+	cond         *syncutil.ContextCond
+	epoch        int64
+	capacity     int
+	events       []WatchEvent // the recent events ordered by sequence.
+	lastSequence uint64
+	closed       bool
+}
+
+// Publish publishes a new applied broadcast message into the hub.
+func (h *watchEventHub) Publish(broadcastID uint64, msg message.BroadcastMutableMessage) {
+	h.cond.LockAndBroadcast()
+	defer h.cond.L.Unlock()
+
+	h.lastSequence++
+	h.events = append(h.events, WatchEvent{
+		ResumeToken: WatchResumeToken{Epoch: h.epoch, Sequence: h.lastSequence},
+		BroadcastID: broadcastID,
+		Message:     msg,
+	})
+	if len(h.events) > h.capacity {
+		h.events = h.events[len(h.events)-h.capacity:]
+	}
+}
+
+// Watch delivers the events after the resume token to the callback until the context is done or the callback returns an error.
+// Watch from the latest event if the resume token is nil.
+// A resync event is delivered if the events after the resume token are evicted or the token comes from another epoch.
+func (h *watchEventHub) Watch(ctx context.Context, token *WatchResumeToken, cb WatchCallback) error {
+	h.cond.L.Lock()
+	cursor := h.lastSequence
+	resync := false
+	if token != nil {
+		cursor = token.Sequence
+		resync = !h.isResumable(*token)
+	}
+
+	for {
+		if resync {
+			// the watcher is too slow or comes from another epoch, ask it to reload the state.
+			cursor = h.lastSequence
+			h.cond.L.Unlock()
+			if err := cb(WatchEvent{
+				ResumeToken: WatchResumeToken{Epoch: h.epoch, Sequence: cursor},
+				Resync:      true,
+			}); err != nil {
+				return err
+			}
+			h.cond.L.Lock()
+		}
+		for cursor >= h.lastSequence {
+			if h.closed {
+				h.cond.L.Unlock()
+				return status.NewOnShutdownError("broadcaster is closing")
+			}
+			if err := h.cond.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		if resync = !h.isResumable(WatchResumeToken{Epoch: h.epoch, Sequence: cursor}); resync {
+			continue
+		}
+		events := h.eventsAfter(cursor)
+		h.cond.L.Unlock()
+		for _, event := range events {
+			if err := cb(event); err != nil {
+				return err
+			}
+			cursor = event.ResumeToken.Sequence
+		}
+		h.cond.L.Lock()
+	}
+}
+
+// Close closes the hub and stops all the watchers.
+func (h *watchEventHub) Close() {
+	h.cond.LockAndBroadcast()
+	defer h.cond.L.Unlock()
+
+	h.closed = true
+}
+
+// isResumable checks if all the events after the resume token are still kept in the hub, should be called with lock.
+func (h *watchEventHub) isResumable(token WatchResumeToken) bool {
+	if token.Epoch != h.epoch || token.Sequence > h.lastSequence {
+		return false
+	}
+	if token.Sequence == h.lastSequence {
+		return true
+	}
+	return len(h.events) > 0 && h.events[0].ResumeToken.Sequence <= token.Sequence+1
+}
+
+// eventsAfter returns the events after the sequence, should be called with lock.
+func (h *watchEventHub) eventsAfter(sequence uint64) []WatchEvent {
+	first := h.events[0].ResumeToken.Sequence
+	events := h.events[sequence+1-first:]
+	result := make([]WatchEvent, len(events))
+	copy(result, events)
+	return result
+}
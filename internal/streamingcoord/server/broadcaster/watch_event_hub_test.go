@@ -0,0 +1,84 @@
+package broadcaster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestWatchEventHub(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALBroadcasterWatchBufferSize.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALBroadcasterWatchBufferSize.Key)
+
+	errStop := errors.New("stop")
+	h := newWatchEventHub()
+	msg := message.NewCreateCollectionMessageBuilderV1().
+		WithHeader(&message.CreateCollectionMessageHeader{}).
+		WithBody(&msgpb.CreateCollectionRequest{}).
+		WithBroadcast([]string{"v1"}).
+		MustBuildBroadcast()
+
+	// watch from latest.
+	events := make(chan WatchEvent, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	watchDone := make(chan error)
+	go func() {
+		watchDone <- h.Watch(ctx, nil, func(event WatchEvent) error {
+			events <- event
+			return nil
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	h.Publish(1, msg)
+	h.Publish(2, msg)
+	event := <-events
+	assert.False(t, event.Resync)
+	assert.Equal(t, uint64(1), event.BroadcastID)
+	assert.Equal(t, uint64(1), event.ResumeToken.Sequence)
+	first := event.ResumeToken
+	event = <-events
+	assert.Equal(t, uint64(2), event.BroadcastID)
+	cancel()
+	assert.ErrorIs(t, <-watchDone, context.Canceled)
+
+	// resume from the token.
+	err := h.Watch(context.Background(), &first, func(event WatchEvent) error {
+		assert.False(t, event.Resync)
+		assert.Equal(t, uint64(2), event.BroadcastID)
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+
+	// resume from an evicted token or another epoch should resync.
+	h.Publish(3, msg)
+	for _, token := range []*WatchResumeToken{
+		{Epoch: first.Epoch, Sequence: 0},
+		{Epoch: first.Epoch + 1, Sequence: 3},
+		{Epoch: first.Epoch, Sequence: 100},
+	} {
+		err = h.Watch(context.Background(), token, func(event WatchEvent) error {
+			assert.True(t, event.Resync)
+			assert.Equal(t, uint64(3), event.ResumeToken.Sequence)
+			return errStop
+		})
+		assert.ErrorIs(t, err, errStop)
+	}
+
+	// the watcher should be stopped when the hub is closed.
+	go func() {
+		watchDone <- h.Watch(context.Background(), nil, func(event WatchEvent) error {
+			return nil
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	h.Close()
+	assert.Error(t, <-watchDone)
+}
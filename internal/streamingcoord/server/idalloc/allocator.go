@@ -0,0 +1,87 @@
+package idalloc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// NewAllocator creates a new id allocator with the unique name.
+// The allocator is recovered from the streaming catalog lazily at the first allocation.
+func NewAllocator(name string) *Allocator {
+	a := &Allocator{name: name}
+	a.SetLogger(resource.Resource().Logger().With(mlog.FieldComponent("id-allocator"), mlog.String("name", name)))
+	return a
+}
+
+// Allocator allocates the globally unique and monotonic increasing ids.
+// The ids are reserved into the streaming catalog by batch before they are allocated,
+// so the ids will never be allocated twice even if the streamingcoord crashes or fails over,
+// the reserved but unused ids are skipped after recovery.
+type Allocator struct {
+	mlog.Binder
+
+	mu        sync.Mutex
+	name      string
+	recovered bool
+	next      uint64 // the next id to be allocated.
+	watermark uint64 // the ids in [next, watermark) are reserved in the catalog.
+}
+
+// Allocate allocates count contiguous ids and returns the first one.
+func (a *Allocator) Allocate(ctx context.Context, count int) (uint64, error) {
+	if count <= 0 {
+		return 0, status.NewInvalidArgument("the count of ids to allocate should be positive, got %d", count)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.recover(ctx); err != nil {
+		return 0, err
+	}
+	if a.next+uint64(count) > a.watermark {
+		if err := a.reserve(ctx, a.next+uint64(count)); err != nil {
+			return 0, err
+		}
+	}
+	start := a.next
+	a.next += uint64(count)
+	return start, nil
+}
+
+// recover recovers the allocator from the catalog if it's not recovered, should be called with lock.
+func (a *Allocator) recover(ctx context.Context) error {
+	if a.recovered {
+		return nil
+	}
+	meta, err := resource.Resource().StreamingCatalog().GetIDAllocator(ctx, a.name)
+	if err != nil {
+		return err
+	}
+	// skip all reserved ids, they may be allocated by the previous streamingcoord.
+	a.next = meta.GetWatermark()
+	a.watermark = meta.GetWatermark()
+	a.recovered = true
+	a.Logger().Info(ctx, "id allocator recovered", mlog.Uint64("watermark", a.watermark))
+	return nil
+}
+
+// reserve reserves the ids until the least watermark and one more batch into the catalog, should be called with lock.
+func (a *Allocator) reserve(ctx context.Context, leastWatermark uint64) error {
+	watermark := leastWatermark + uint64(paramtable.Get().StreamingCfg.WALBalancerIDAllocatorBatchSize.GetAsInt64())
+	if err := resource.Resource().StreamingCatalog().SaveIDAllocator(ctx, &streamingpb.IDAllocatorMeta{
+		Name:      a.name,
+		Watermark: watermark,
+	}); err != nil {
+		return err
+	}
+	a.Logger().Info(ctx, "id allocator reserved", mlog.Uint64("next", a.next), mlog.Uint64("watermark", watermark))
+	a.watermark = watermark
+	return nil
+}
@@ -0,0 +1,76 @@
+package idalloc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestAllocator(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALBalancerIDAllocatorBatchSize.Key, "10")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALBalancerIDAllocatorBatchSize.Key)
+
+	var saved *streamingpb.IDAllocatorMeta
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	catalog.EXPECT().GetIDAllocator(mock.Anything, "test").RunAndReturn(func(ctx context.Context, name string) (*streamingpb.IDAllocatorMeta, error) {
+		return saved, nil
+	})
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, meta *streamingpb.IDAllocatorMeta) error {
+		saved = meta
+		return nil
+	})
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+	ctx := context.Background()
+
+	a := NewAllocator("test")
+	_, err := a.Allocate(ctx, 0)
+	assert.Error(t, err)
+
+	// The ids are reserved by batch.
+	start, err := a.Allocate(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), start)
+	assert.Equal(t, uint64(13), saved.GetWatermark())
+	start, err = a.Allocate(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), start)
+	assert.Equal(t, uint64(13), saved.GetWatermark())
+	start, err = a.Allocate(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(13), start)
+	assert.Equal(t, uint64(24), saved.GetWatermark())
+
+	// The recovered allocator skips all reserved ids.
+	a = NewAllocator("test")
+	start, err = a.Allocate(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(24), start)
+	assert.Equal(t, uint64(35), saved.GetWatermark())
+
+	// The failure of catalog is returned and no id is allocated.
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).Unset()
+	catalog.EXPECT().SaveIDAllocator(mock.Anything, mock.Anything).Return(errors.New("save failure"))
+	_, err = a.Allocate(ctx, 20)
+	assert.Error(t, err)
+	start, err = a.Allocate(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(25), start)
+
+	catalog.EXPECT().GetIDAllocator(mock.Anything, "test").Unset()
+	catalog.EXPECT().GetIDAllocator(mock.Anything, "test").Return(nil, errors.New("get failure"))
+	_, err = NewAllocator("test").Allocate(ctx, 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,127 @@
+package replicatecheck
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/client/v2/milvusclient"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// NewHealthProber creates a new health prober of the target clusters.
+func NewHealthProber() *HealthProber {
+	return &HealthProber{
+		createTargetClient: cluster.NewMilvusClient,
+		clients:            make(map[string]*probeClient),
+		health:             make(map[string]*streamingpb.ReplicateTargetClusterHealth),
+	}
+}
+
+// HealthProber probes the reachability of the target clusters of current cluster.
+// The clients of the target clusters are kept across the probes, and recreated after the probe fails
+// or the connection param of the target cluster is changed. It's not concurrent safe.
+type HealthProber struct {
+	createTargetClient cluster.CreateMilvusClientFunc
+	clients            map[string]*probeClient
+	health             map[string]*streamingpb.ReplicateTargetClusterHealth
+}
+
+// probeClient is the client of a target cluster with the cluster info that creates it.
+type probeClient struct {
+	cluster *commonpb.MilvusCluster
+	client  cluster.MilvusClient
+}
+
+// Probe probes all target clusters of current cluster, and returns the health of them ordered by the cluster id.
+// The clients and health of the clusters that are not the target cluster any more are dropped.
+func (p *HealthProber) Probe(ctx context.Context, config *replicateutil.ConfigHelper) []*streamingpb.ReplicateTargetClusterHealth {
+	targets := config.GetCurrentCluster().TargetClusters()
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].GetClusterId() < targets[j].GetClusterId()
+	})
+
+	probed := make(map[string]*streamingpb.ReplicateTargetClusterHealth, len(targets))
+	healths := make([]*streamingpb.ReplicateTargetClusterHealth, 0, len(targets))
+	for _, target := range targets {
+		health := p.probe(ctx, target.MilvusCluster)
+		probed[target.GetClusterId()] = health
+		healths = append(healths, proto.Clone(health).(*streamingpb.ReplicateTargetClusterHealth))
+	}
+	for clusterID := range p.clients {
+		if _, ok := probed[clusterID]; !ok {
+			p.closeClient(ctx, clusterID)
+		}
+	}
+	p.health = probed
+	return healths
+}
+
+// probe probes the target cluster by getting its version.
+func (p *HealthProber) probe(ctx context.Context, target *commonpb.MilvusCluster) *streamingpb.ReplicateTargetClusterHealth {
+	clusterID := target.GetClusterId()
+	health, ok := p.health[clusterID]
+	if !ok {
+		health = &streamingpb.ReplicateTargetClusterHealth{ClusterId: clusterID}
+	}
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().StreamingCfg.ReplicationHealthProbeTimeout.GetAsDurationByParse())
+	defer cancel()
+
+	start := time.Now()
+	err := p.ping(ctx, target)
+	health.LatencyMilliseconds = time.Since(start).Milliseconds()
+	health.LastProbeTimestampSeconds = start.Unix()
+	if err != nil {
+		// the client is recreated at next probe, so the target cluster is reconnected.
+		p.closeClient(ctx, clusterID)
+		health.Reachable = false
+		health.Error = err.Error()
+		health.ConsecutiveFailures++
+		return health
+	}
+	health.Reachable = true
+	health.Error = ""
+	health.ConsecutiveFailures = 0
+	health.LastReachableTimestampSeconds = start.Unix()
+	return health
+}
+
+// ping gets the version of the target cluster.
+func (p *HealthProber) ping(ctx context.Context, target *commonpb.MilvusCluster) error {
+	clusterID := target.GetClusterId()
+	if c, ok := p.clients[clusterID]; ok && !proto.Equal(c.cluster.GetConnectionParam(), target.GetConnectionParam()) {
+		p.closeClient(ctx, clusterID)
+	}
+	c, ok := p.clients[clusterID]
+	if !ok {
+		cli, err := p.createTargetClient(ctx, target)
+		if err != nil {
+			return err
+		}
+		c = &probeClient{cluster: target, client: cli}
+		p.clients[clusterID] = c
+	}
+	_, err := c.client.GetServerVersion(ctx, milvusclient.NewGetServerVersionOption())
+	return err
+}
+
+// closeClient closes the client of the target cluster.
+func (p *HealthProber) closeClient(ctx context.Context, clusterID string) {
+	if c, ok := p.clients[clusterID]; ok {
+		c.client.Close(ctx)
+		delete(p.clients, clusterID)
+	}
+}
+
+// Close closes all the clients of the target clusters.
+func (p *HealthProber) Close(ctx context.Context) {
+	for clusterID := range p.clients {
+		p.closeClient(ctx, clusterID)
+	}
+}
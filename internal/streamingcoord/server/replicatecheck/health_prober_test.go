@@ -0,0 +1,111 @@
+package replicatecheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/client/v2/milvusclient"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+func TestHealthProber(t *testing.T) {
+	paramtable.Init()
+	token := "token"
+	newConfig := func(targets ...string) *replicateutil.ConfigHelper {
+		config := &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"by-dev-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test:19530", Token: "by-dev"}},
+			},
+		}
+		for _, target := range targets {
+			config.Clusters = append(config.Clusters, &commonpb.MilvusCluster{
+				ClusterId:       target,
+				Pchannels:       []string{target + "-1"},
+				ConnectionParam: &commonpb.ConnectionParam{Uri: "http://" + target + ":19530", Token: token},
+			})
+			config.CrossClusterTopology = append(config.CrossClusterTopology, &commonpb.CrossClusterTopology{
+				SourceClusterId: "by-dev",
+				TargetClusterId: target,
+			})
+		}
+		return replicateutil.MustNewConfigHelper("by-dev", config)
+	}
+
+	reachable := map[string]bool{"test2": true, "test3": true}
+	created := make(map[string]int)
+	closed := make(map[string]int)
+	prober := NewHealthProber()
+	prober.createTargetClient = func(ctx context.Context, c *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+		clusterID := c.GetClusterId()
+		created[clusterID]++
+		cli := cluster.NewMockMilvusClient(t)
+		cli.EXPECT().GetServerVersion(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, option milvusclient.GetServerVersionOption, opts ...grpc.CallOption) (string, error) {
+				if !reachable[clusterID] {
+					return "", errors.New("connection refused")
+				}
+				return "v3.0.0", nil
+			}).Maybe()
+		cli.EXPECT().Close(mock.Anything).RunAndReturn(func(ctx context.Context) error {
+			closed[clusterID]++
+			return nil
+		}).Maybe()
+		return cli, nil
+	}
+
+	// the health is ordered by the cluster id, and the clients are kept across the probes.
+	healths := prober.Probe(context.Background(), newConfig("test3", "test2"))
+	assert.Len(t, healths, 2)
+	assert.Equal(t, "test2", healths[0].GetClusterId())
+	assert.Equal(t, "test3", healths[1].GetClusterId())
+	for _, health := range healths {
+		assert.True(t, health.GetReachable())
+		assert.Empty(t, health.GetError())
+		assert.NotZero(t, health.GetLastReachableTimestampSeconds())
+	}
+	prober.Probe(context.Background(), newConfig("test2", "test3"))
+	assert.Equal(t, 1, created["test2"])
+	assert.Equal(t, 1, created["test3"])
+
+	// the client is recreated after the probe fails.
+	reachable["test3"] = false
+	for i := 1; i <= 2; i++ {
+		healths = prober.Probe(context.Background(), newConfig("test2", "test3"))
+		assert.True(t, healths[0].GetReachable())
+		assert.False(t, healths[1].GetReachable())
+		assert.Contains(t, healths[1].GetError(), "connection refused")
+		assert.Equal(t, int64(i), healths[1].GetConsecutiveFailures())
+		assert.NotZero(t, healths[1].GetLastReachableTimestampSeconds())
+	}
+	assert.Equal(t, 2, created["test3"])
+	assert.Equal(t, 2, closed["test3"])
+
+	reachable["test3"] = true
+	healths = prober.Probe(context.Background(), newConfig("test2", "test3"))
+	assert.True(t, healths[1].GetReachable())
+	assert.Equal(t, 3, created["test3"])
+	assert.Zero(t, healths[1].GetConsecutiveFailures())
+
+	// the client is dropped if the cluster is not the target cluster any more.
+	healths = prober.Probe(context.Background(), newConfig("test2"))
+	assert.Len(t, healths, 1)
+	assert.Equal(t, 3, closed["test3"])
+
+	// the client is recreated if the connection param is changed.
+	token = "new-token"
+	prober.Probe(context.Background(), newConfig("test2"))
+	assert.Equal(t, 2, created["test2"])
+	assert.Equal(t, 1, closed["test2"])
+
+	prober.Close(context.Background())
+	assert.Equal(t, 2, closed["test2"])
+	assert.Empty(t, prober.clients)
+}
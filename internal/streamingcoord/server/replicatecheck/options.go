@@ -0,0 +1,41 @@
+package replicatecheck
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/milvusclient"
+)
+
+var (
+	_ milvusclient.ListDatabaseOption       = (*listDatabaseOption)(nil)
+	_ milvusclient.ListCollectionOption     = (*listCollectionOption)(nil)
+	_ milvusclient.DescribeCollectionOption = (*describeCollectionOption)(nil)
+)
+
+// listDatabaseOption builds the request to list the databases of the target cluster.
+type listDatabaseOption struct{}
+
+func (opt *listDatabaseOption) Request() *milvuspb.ListDatabasesRequest {
+	return &milvuspb.ListDatabasesRequest{}
+}
+
+// listCollectionOption builds the request to list the collections of a database of the target cluster.
+type listCollectionOption struct {
+	dbName string
+}
+
+func (opt *listCollectionOption) Request() *milvuspb.ShowCollectionsRequest {
+	return &milvuspb.ShowCollectionsRequest{DbName: opt.dbName}
+}
+
+// describeCollectionOption builds the request to describe a collection of the target cluster.
+type describeCollectionOption struct {
+	dbName         string
+	collectionName string
+}
+
+func (opt *describeCollectionOption) Request() *milvuspb.DescribeCollectionRequest {
+	return &milvuspb.DescribeCollectionRequest{
+		DbName:         opt.dbName,
+		CollectionName: opt.collectionName,
+	}
+}
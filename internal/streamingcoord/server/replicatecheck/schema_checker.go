@@ -0,0 +1,306 @@
+package replicatecheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+)
+
+// NewSchemaChecker creates a new schema checker.
+func NewSchemaChecker() *SchemaChecker {
+	return &SchemaChecker{
+		createTargetClient: cluster.NewMilvusClient,
+	}
+}
+
+// SchemaChecker compares the collection schemas and vchannel mappings between current cluster and its target clusters.
+// The drifts are found before they fail the replicated messages on the target cluster.
+// The collections filtered by the collection filter of the target cluster are reported as missing too.
+type SchemaChecker struct {
+	createTargetClient cluster.CreateMilvusClientFunc
+}
+
+// collectionInfo is the schema and vchannels of a collection.
+type collectionInfo struct {
+	schema    *schemapb.CollectionSchema
+	vchannels []string
+}
+
+// collectionKey is the key of a collection in the cluster.
+type collectionKey struct {
+	dbName         string
+	collectionName string
+}
+
+// Check checks the schema consistency between current cluster and the target cluster.
+// All target clusters of current cluster are checked if the target cluster id is empty.
+func (c *SchemaChecker) Check(ctx context.Context, config *replicateutil.ConfigHelper, targetClusterID string) ([]*streamingpb.ReplicateSchemaDrift, error) {
+	current := config.GetCurrentCluster()
+	targets := current.TargetClusters()
+	if targetClusterID != "" {
+		target := current.TargetCluster(targetClusterID)
+		if target == nil {
+			return nil, merr.WrapErrParameterInvalidMsg("cluster %s is not the target cluster of current cluster %s", targetClusterID, current.GetClusterId())
+		}
+		targets = []*replicateutil.MilvusCluster{target}
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].GetClusterId() < targets[j].GetClusterId()
+	})
+
+	sources, err := c.describeSourceCollections(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe the collections of current cluster")
+	}
+	drifts := make([]*streamingpb.ReplicateSchemaDrift, 0)
+	for _, target := range targets {
+		targetCollections, err := c.describeTargetCollections(ctx, target)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe the collections of target cluster %s", target.GetClusterId())
+		}
+		drifts = append(drifts, compareCollections(current, target.GetClusterId(), sources, targetCollections)...)
+	}
+	return drifts, nil
+}
+
+// describeSourceCollections describes all collections of current cluster.
+func (c *SchemaChecker) describeSourceCollections(ctx context.Context) (map[collectionKey]*collectionInfo, error) {
+	mixCoordClient, err := resource.Resource().MixCoordClient().GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dbResp, err := mixCoordClient.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+	if err := merr.CheckRPCCall(dbResp, err); err != nil {
+		return nil, err
+	}
+	collections := make(map[collectionKey]*collectionInfo)
+	for _, dbName := range dbResp.GetDbNames() {
+		showResp, err := mixCoordClient.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{DbName: dbName})
+		if err := merr.CheckRPCCall(showResp, err); err != nil {
+			return nil, err
+		}
+		for _, collectionName := range showResp.GetCollectionNames() {
+			describeResp, err := mixCoordClient.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+				DbName:         dbName,
+				CollectionName: collectionName,
+			})
+			if err := merr.CheckRPCCall(describeResp, err); err != nil {
+				if errors.Is(err, merr.ErrCollectionNotFound) {
+					// the collection is dropped after listing.
+					continue
+				}
+				return nil, err
+			}
+			collections[collectionKey{dbName: dbName, collectionName: collectionName}] = &collectionInfo{
+				schema:    describeResp.GetSchema(),
+				vchannels: describeResp.GetVirtualChannelNames(),
+			}
+		}
+	}
+	return collections, nil
+}
+
+// describeTargetCollections describes all collections of the target cluster.
+func (c *SchemaChecker) describeTargetCollections(ctx context.Context, target *replicateutil.MilvusCluster) (map[collectionKey]*collectionInfo, error) {
+	cli, err := c.createTargetClient(ctx, target.MilvusCluster)
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close(ctx)
+
+	dbNames, err := cli.ListDatabase(ctx, &listDatabaseOption{})
+	if err != nil {
+		return nil, err
+	}
+	collections := make(map[collectionKey]*collectionInfo)
+	for _, dbName := range dbNames {
+		collectionNames, err := cli.ListCollections(ctx, &listCollectionOption{dbName: dbName})
+		if err != nil {
+			return nil, err
+		}
+		for _, collectionName := range collectionNames {
+			coll, err := cli.DescribeCollection(ctx, &describeCollectionOption{dbName: dbName, collectionName: collectionName})
+			if err != nil {
+				if errors.Is(err, merr.ErrCollectionNotFound) {
+					continue
+				}
+				return nil, err
+			}
+			collections[collectionKey{dbName: dbName, collectionName: collectionName}] = &collectionInfo{
+				schema:    coll.Schema.ProtoMessage(),
+				vchannels: coll.VirtualChannels,
+			}
+		}
+	}
+	return collections, nil
+}
+
+// compareCollections compares the collections of current cluster and the target cluster.
+func compareCollections(current *replicateutil.MilvusCluster, targetClusterID string, sources map[collectionKey]*collectionInfo, targets map[collectionKey]*collectionInfo) []*streamingpb.ReplicateSchemaDrift {
+	newDrift := func(key collectionKey, typ streamingpb.ReplicateSchemaDriftType, detail string) *streamingpb.ReplicateSchemaDrift {
+		return &streamingpb.ReplicateSchemaDrift{
+			TargetClusterId: targetClusterID,
+			DbName:          key.dbName,
+			CollectionName:  key.collectionName,
+			Type:            typ,
+			Detail:          detail,
+		}
+	}
+
+	drifts := make([]*streamingpb.ReplicateSchemaDrift, 0)
+	for _, key := range sortedKeys(sources) {
+		source := sources[key]
+		target, ok := targets[key]
+		if !ok {
+			drifts = append(drifts, newDrift(key, streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_MISSING,
+				"collection is not found in the target cluster"))
+			continue
+		}
+		if detail := compareSchema(source.schema, target.schema); detail != "" {
+			drifts = append(drifts, newDrift(key, streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_SCHEMA_MISMATCH, detail))
+		}
+		if detail := compareVChannels(current, targetClusterID, source.vchannels, target.vchannels); detail != "" {
+			drifts = append(drifts, newDrift(key, streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_VCHANNEL_MISMATCH, detail))
+		}
+	}
+	for _, key := range sortedKeys(targets) {
+		if _, ok := sources[key]; !ok {
+			drifts = append(drifts, newDrift(key, streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_UNEXPECTED,
+				"collection is not found in the source cluster"))
+		}
+	}
+	return drifts
+}
+
+// compareSchema compares the user fields of the collection schemas, returns the description of the first difference.
+// The schema of target cluster is converted by the milvus client, so the source schema is converted in the same way before comparing.
+func compareSchema(source *schemapb.CollectionSchema, target *schemapb.CollectionSchema) string {
+	source = entity.NewSchema().ReadProto(source).ProtoMessage()
+	if source.GetEnableDynamicField() != target.GetEnableDynamicField() {
+		return fmt.Sprintf("enable dynamic field mismatch, source: %t, target: %t", source.GetEnableDynamicField(), target.GetEnableDynamicField())
+	}
+	sourceFields := userFields(source)
+	targetFields := userFields(target)
+	targetFieldsByName := make(map[string]*schemapb.FieldSchema, len(targetFields))
+	for _, field := range targetFields {
+		targetFieldsByName[field.GetName()] = field
+	}
+	for _, sourceField := range sourceFields {
+		targetField, ok := targetFieldsByName[sourceField.GetName()]
+		if !ok {
+			return fmt.Sprintf("field %s is not found in the target cluster", sourceField.GetName())
+		}
+		delete(targetFieldsByName, sourceField.GetName())
+		if detail := compareField(sourceField, targetField); detail != "" {
+			return fmt.Sprintf("field %s mismatch, %s", sourceField.GetName(), detail)
+		}
+	}
+	for _, field := range targetFields {
+		if _, ok := targetFieldsByName[field.GetName()]; ok {
+			return fmt.Sprintf("field %s is not found in the source cluster", field.GetName())
+		}
+	}
+	return ""
+}
+
+// userFields returns the fields created by user, the system fields and dynamic field are hidden by the proxy,
+// so they are never returned by the target cluster.
+func userFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetIsDynamic() || field.GetName() == common.NamespaceFieldName || field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// compareField compares the field schemas, returns the description of the difference.
+func compareField(source *schemapb.FieldSchema, target *schemapb.FieldSchema) string {
+	switch {
+	case source.GetFieldID() != target.GetFieldID():
+		return fmt.Sprintf("field id, source: %d, target: %d", source.GetFieldID(), target.GetFieldID())
+	case source.GetDataType() != target.GetDataType():
+		return fmt.Sprintf("data type, source: %s, target: %s", source.GetDataType(), target.GetDataType())
+	case source.GetElementType() != target.GetElementType():
+		return fmt.Sprintf("element type, source: %s, target: %s", source.GetElementType(), target.GetElementType())
+	case source.GetIsPrimaryKey() != target.GetIsPrimaryKey():
+		return fmt.Sprintf("primary key, source: %t, target: %t", source.GetIsPrimaryKey(), target.GetIsPrimaryKey())
+	case source.GetAutoID() != target.GetAutoID():
+		return fmt.Sprintf("auto id, source: %t, target: %t", source.GetAutoID(), target.GetAutoID())
+	case source.GetNullable() != target.GetNullable():
+		return fmt.Sprintf("nullable, source: %t, target: %t", source.GetNullable(), target.GetNullable())
+	case source.GetIsPartitionKey() != target.GetIsPartitionKey():
+		return fmt.Sprintf("partition key, source: %t, target: %t", source.GetIsPartitionKey(), target.GetIsPartitionKey())
+	case !proto.Equal(source.GetDefaultValue(), target.GetDefaultValue()):
+		return fmt.Sprintf("default value, source: %v, target: %v", source.GetDefaultValue(), target.GetDefaultValue())
+	}
+	sourceParams := funcutil.KeyValuePair2Map(source.GetTypeParams())
+	targetParams := funcutil.KeyValuePair2Map(target.GetTypeParams())
+	if len(sourceParams) != len(targetParams) {
+		return fmt.Sprintf("type params, source: %v, target: %v", sourceParams, targetParams)
+	}
+	for key, value := range sourceParams {
+		if targetValue, ok := targetParams[key]; !ok || targetValue != value {
+			return fmt.Sprintf("type params, source: %v, target: %v", sourceParams, targetParams)
+		}
+	}
+	return ""
+}
+
+// compareVChannels checks that the vchannels of the target collection are mapped from the source vchannels
+// by the pchannel mapping of the replicate configuration, returns the description of the difference.
+func compareVChannels(current *replicateutil.MilvusCluster, targetClusterID string, source []string, target []string) string {
+	expected := make([]string, 0, len(source))
+	for _, vchannel := range source {
+		pchannel := funcutil.ToPhysicalChannel(vchannel)
+		targetPChannel, err := current.GetTargetChannel(pchannel, targetClusterID)
+		if err != nil {
+			return fmt.Sprintf("vchannel %s cannot be mapped to the target cluster, %s", vchannel, err.Error())
+		}
+		expected = append(expected, strings.Replace(vchannel, pchannel, targetPChannel, 1))
+	}
+	if len(expected) != len(target) {
+		return fmt.Sprintf("expected vchannels: %v, target: %v", expected, target)
+	}
+	for i := range expected {
+		if expected[i] != target[i] {
+			return fmt.Sprintf("expected vchannels: %v, target: %v", expected, target)
+		}
+	}
+	return ""
+}
+
+// sortedKeys returns the sorted collection keys, so the drifts are reported in a stable order.
+func sortedKeys(collections map[collectionKey]*collectionInfo) []collectionKey {
+	keys := make([]collectionKey, 0, len(collections))
+	for key := range collections {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].dbName != keys[j].dbName {
+			return keys[i].dbName < keys[j].dbName
+		}
+		return keys[i].collectionName < keys[j].collectionName
+	})
+	return keys
+}
@@ -0,0 +1,165 @@
+package replicatecheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/milvusclient"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	internaltypes "github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+func TestSchemaChecker(t *testing.T) {
+	config := replicateutil.MustNewConfigHelper("by-dev", &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"by-dev-1", "by-dev-2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test:19530", Token: "by-dev"}},
+			{ClusterId: "test2", Pchannels: []string{"test2-1", "test2-2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test2:19530", Token: "test2"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "test2"},
+		},
+	})
+
+	sourceSchemas := map[string]*schemapb.CollectionSchema{
+		"same":     newTestSchema("same", schemapb.DataType_Int64),
+		"mismatch": newTestSchema("mismatch", schemapb.DataType_Int64),
+		"vchannel": newTestSchema("vchannel", schemapb.DataType_Int64),
+		"missing":  newTestSchema("missing", schemapb.DataType_Int64),
+		"dropped":  newTestSchema("dropped", schemapb.DataType_Int64),
+	}
+	sourceVChannels := []string{"by-dev-1_100v0", "by-dev-2_100v1"}
+
+	mc := mocks.NewMockMixCoordClient(t)
+	mc.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+		Status:  merr.Success(),
+		DbNames: []string{"default"},
+	}, nil)
+	mc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&milvuspb.ShowCollectionsResponse{
+		Status:          merr.Success(),
+		CollectionNames: []string{"same", "mismatch", "vchannel", "missing", "dropped"},
+	}, nil)
+	mc.EXPECT().DescribeCollection(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+			if req.GetCollectionName() == "dropped" {
+				return &milvuspb.DescribeCollectionResponse{Status: merr.Status(merr.WrapErrCollectionNotFound("dropped"))}, nil
+			}
+			schema := sourceSchemas[req.GetCollectionName()]
+			// the system fields and dynamic field are returned by the coordinator.
+			schema.Fields = append(schema.Fields,
+				&schemapb.FieldSchema{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+				&schemapb.FieldSchema{FieldID: 102, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+			)
+			return &milvuspb.DescribeCollectionResponse{
+				Status:              merr.Success(),
+				Schema:              schema,
+				VirtualChannelNames: sourceVChannels,
+			}, nil
+		})
+	f := syncutil.NewFuture[internaltypes.MixCoordClient]()
+	f.Set(mc)
+	resource.InitForTest(resource.OptMixCoordClient(f))
+
+	targetSchemas := map[string]*schemapb.CollectionSchema{
+		"same":       newTestSchema("same", schemapb.DataType_Int64),
+		"mismatch":   newTestSchema("mismatch", schemapb.DataType_VarChar),
+		"vchannel":   newTestSchema("vchannel", schemapb.DataType_Int64),
+		"unexpected": newTestSchema("unexpected", schemapb.DataType_Int64),
+	}
+	cli := cluster.NewMockMilvusClient(t)
+	cli.EXPECT().ListDatabase(mock.Anything, mock.Anything).Return([]string{"default"}, nil)
+	cli.EXPECT().ListCollections(mock.Anything, mock.Anything).Return([]string{"same", "mismatch", "vchannel", "unexpected"}, nil)
+	cli.EXPECT().DescribeCollection(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, option milvusclient.DescribeCollectionOption, opts ...grpc.CallOption) (*entity.Collection, error) {
+			req := option.Request()
+			assert.Equal(t, "default", req.GetDbName())
+			vchannels := []string{"test2-1_100v0", "test2-2_100v1"}
+			if req.GetCollectionName() == "vchannel" {
+				vchannels = []string{"test2-2_100v0", "test2-1_100v1"}
+			}
+			return &entity.Collection{
+				Name:            req.GetCollectionName(),
+				Schema:          entity.NewSchema().ReadProto(targetSchemas[req.GetCollectionName()]),
+				VirtualChannels: vchannels,
+			}, nil
+		})
+	cli.EXPECT().Close(mock.Anything).Return(nil)
+
+	checker := &SchemaChecker{
+		createTargetClient: func(ctx context.Context, c *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+			assert.Equal(t, "test2", c.GetClusterId())
+			return cli, nil
+		},
+	}
+	drifts, err := checker.Check(context.Background(), config, "")
+	assert.NoError(t, err)
+	assert.Len(t, drifts, 4)
+	assertDrift(t, drifts[0], "mismatch", streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_SCHEMA_MISMATCH)
+	assert.Contains(t, drifts[0].GetDetail(), "data type")
+	assertDrift(t, drifts[1], "missing", streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_MISSING)
+	assertDrift(t, drifts[2], "vchannel", streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_VCHANNEL_MISMATCH)
+	assertDrift(t, drifts[3], "unexpected", streamingpb.ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_UNEXPECTED)
+
+	_, err = checker.Check(context.Background(), config, "test3")
+	assert.Error(t, err)
+}
+
+func TestCompareSchema(t *testing.T) {
+	source := newTestSchema("coll", schemapb.DataType_Int64)
+	assert.Empty(t, compareSchema(source, newTestSchema("coll", schemapb.DataType_Int64)))
+
+	target := newTestSchema("coll", schemapb.DataType_Int64)
+	target.EnableDynamicField = true
+	assert.Contains(t, compareSchema(source, target), "dynamic field")
+
+	target = newTestSchema("coll", schemapb.DataType_Int64)
+	target.Fields = target.Fields[:1]
+	assert.Contains(t, compareSchema(source, target), "not found in the target cluster")
+
+	target = newTestSchema("coll", schemapb.DataType_Int64)
+	target.Fields = append(target.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "extra", DataType: schemapb.DataType_Int64})
+	assert.Contains(t, compareSchema(source, target), "not found in the source cluster")
+
+	target = newTestSchema("coll", schemapb.DataType_Int64)
+	target.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}}
+	assert.Contains(t, compareSchema(source, target), "type params")
+
+	target = newTestSchema("coll", schemapb.DataType_Int64)
+	target.Fields[1].FieldID = 103
+	assert.Contains(t, compareSchema(source, target), "field id")
+
+	target = newTestSchema("coll", schemapb.DataType_Int64)
+	target.Fields[0].Nullable = true
+	assert.Contains(t, compareSchema(source, target), "nullable")
+}
+
+func newTestSchema(name string, pkType schemapb.DataType) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: name,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: pkType, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+		},
+	}
+}
+
+func assertDrift(t *testing.T, drift *streamingpb.ReplicateSchemaDrift, collectionName string, typ streamingpb.ReplicateSchemaDriftType) {
+	assert.Equal(t, "test2", drift.GetTargetClusterId())
+	assert.Equal(t, "default", drift.GetDbName())
+	assert.Equal(t, collectionName, drift.GetCollectionName())
+	assert.Equal(t, typ, drift.GetType())
+}
@@ -33,6 +33,7 @@ func OptMixCoordClient(mixCoordClient *syncutil.Future[types.MixCoordClient]) op
 	return func(r *resourceImpl) {
 		r.mixCoordClient = mixCoordClient
 		r.idAllocator = idalloc.NewIDAllocator(r.mixCoordClient)
+		r.tsoAllocator = idalloc.NewTSOAllocator(r.mixCoordClient)
 	}
 }
 
@@ -60,6 +61,7 @@ func Init(opts ...optResourceInit) {
 		opt(newR)
 	}
 	assertNotNil(newR.IDAllocator())
+	assertNotNil(newR.TSOAllocator())
 	assertNotNil(newR.MixCoordClient())
 	assertNotNil(newR.ETCD())
 	assertNotNil(newR.StreamingCatalog())
@@ -84,6 +86,7 @@ func Resource() *resourceImpl {
 // All utility on it is concurrent-safe and singleton.
 type resourceImpl struct {
 	idAllocator                idalloc.Allocator
+	tsoAllocator               idalloc.Allocator
 	mixCoordClient             *syncutil.Future[types.MixCoordClient]
 	session                    sessionutil.SessionInterface
 	etcdClient                 *clientv3.Client
@@ -102,6 +105,11 @@ func (r *resourceImpl) IDAllocator() idalloc.Allocator {
 	return r.idAllocator
 }
 
+// TSOAllocator returns the TSOAllocator client.
+func (r *resourceImpl) TSOAllocator() idalloc.Allocator {
+	return r.tsoAllocator
+}
+
 // StreamingCatalog returns the StreamingCatalog client.
 func (r *resourceImpl) StreamingCatalog() metastore.StreamingCoordCataLog {
 	return r.streamingCatalog
@@ -2,9 +2,11 @@ package server
 
 import (
 	"context"
+	"path"
 
 	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus/internal/metastore/kv/streamingcoord"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
 	_ "github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/policy" // register the balancer policy
@@ -12,11 +14,13 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/service"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/txncoord"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/util/conc"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 // Server is the streamingcoord server.
@@ -49,9 +53,14 @@ func (s *Server) initBasicComponent(ctx context.Context) (err error) {
 	futures := make([]*conc.Future[struct{}], 0)
 	futures = append(futures, conc.Go(func() (struct{}, error) {
 		s.logger.Info(ctx, "start recovery balancer...")
-		// Create a provider that reads channel names from configuration
-		// and polls for dynamic changes.
-		provider := util.NewConfigChannelProvider()
+		// Create a provider that reads channel names from configuration and the pchannel registry,
+		// and watches both of them for dynamic changes.
+		provider, err := util.NewMetastoreChannelProvider(ctx, resource.Resource().ETCD(),
+			path.Join(paramtable.Get().EtcdCfg.MetaRootPath.GetValue(), streamingcoord.PChannelRegistryPrefix)+"/")
+		if err != nil {
+			s.logger.Warn(ctx, "create channel provider failed", mlog.Err(err))
+			return struct{}{}, err
+		}
 		balancer, err := balancer.RecoverBalancer(ctx, provider)
 		if err != nil {
 			provider.Close()
@@ -75,6 +84,17 @@ func (s *Server) initBasicComponent(ctx context.Context) (err error) {
 		s.logger.Info(ctx, "recover broadcaster done")
 		return struct{}{}, nil
 	}))
+	futures = append(futures, conc.Go(func() (struct{}, error) {
+		s.logger.Info(ctx, "start recovery cross vchannel txn coordinator...")
+		coordinator, err := txncoord.RecoverCoordinator(ctx)
+		if err != nil {
+			s.logger.Warn(ctx, "recover cross vchannel txn coordinator failed", mlog.Err(err))
+			return struct{}{}, err
+		}
+		txncoord.Register(coordinator)
+		s.logger.Info(ctx, "recover cross vchannel txn coordinator done")
+		return struct{}{}, nil
+	}))
 	return conc.AwaitAll(futures...)
 }
 
@@ -88,6 +108,8 @@ func (s *Server) RegisterGRPCService(grpcServer *grpc.Server) {
 func (s *Server) Stop() {
 	s.logger.Info(context.TODO(), "start close balancer...")
 	balance.Release()
+	s.logger.Info(context.TODO(), "start close cross vchannel txn coordinator...")
+	txncoord.Release()
 	s.logger.Info(context.TODO(), "start close broadcaster...")
 	broadcast.Release()
 	s.logger.Info(context.TODO(), "release streamingcoord resource...")
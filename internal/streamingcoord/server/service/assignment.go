@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -10,21 +12,27 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/replicatecheck"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/service/discover"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/replicateutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
 var _ streamingpb.StreamingCoordAssignmentServiceServer = (*assignmentServiceImpl)(nil)
@@ -35,6 +43,12 @@ var (
 	// signal "target configuration reached, stop watching". The outer call site
 	// identifies it via errors.Is and treats it as success.
 	errAssignmentDone = errors.New("done")
+
+	// promoteSecondaryCheckInterval is the interval of checking whether the switchover is confirmed by the target cluster.
+	promoteSecondaryCheckInterval = time.Second
+
+	// createTargetClusterClient creates the client of the target cluster to fetch the replicate checkpoint.
+	createTargetClusterClient cluster.CreateMilvusClientFunc = cluster.NewMilvusClient
 )
 
 // NewAssignmentService returns a new assignment service.
@@ -75,18 +89,37 @@ func (s *assignmentServiceImpl) UpdateReplicateConfiguration(ctx context.Context
 
 	mlog.Info(ctx, "UpdateReplicateConfiguration received",
 		mlog.Bool("forcePromote", req.GetForcePromote()),
+		mlog.Bool("dryRun", req.GetDryRun()),
+		mlog.Any("rbacReplicationDisabled", req.RbacReplicationDisabled),
+		mlog.Any("collectionFilters", req.GetCollectionFilters().GetFilters()),
 		replicateutil.ConfigLogField(config),
 	)
 
+	// The empty pchannels are discovered from the clusters, the force promote configuration contains current cluster only.
+	if !req.GetForcePromote() {
+		var err error
+		if config, err = s.discoverReplicatePChannels(ctx, config); err != nil {
+			return nil, err
+		}
+	}
+
+	// Dry run path - computes the plan of the configuration change without applying it.
+	if req.GetDryRun() {
+		if req.GetForcePromote() {
+			return nil, status.NewInvalidArgument("dry run is not supported by force promote")
+		}
+		return s.planReplicateConfiguration(ctx, config)
+	}
+
 	// Force promote path - promotes secondary cluster to standalone primary
 	// Check this BEFORE normal validation since force promote requires empty config
 	if req.GetForcePromote() {
-		return s.handleForcePromote(ctx, config)
+		return s.handleForcePromote(ctx, config, req.GetOperator())
 	}
 
 	// check if the configuration is same.
 	// so even if current cluster is not primary, we can still make a idempotent success result.
-	if _, err := s.validateReplicateConfiguration(ctx, config); err != nil {
+	if _, err := s.validateReplicateConfiguration(ctx, config, req.RbacReplicationDisabled, req.GetCollectionFilters()); err != nil {
 		if errors.Is(err, errReplicateConfigurationSame) {
 			mlog.Info(ctx, "configuration is same, ignored")
 			return &streamingpb.UpdateReplicateConfigurationResponse{}, nil
@@ -103,13 +136,13 @@ func (s *assignmentServiceImpl) UpdateReplicateConfiguration(ctx context.Context
 			// if all clusters returne success, we can consider the UpdateReplicateConfiguration is successful and sync up between A/B/C.
 			// so if current cluster is not primary, its UpdateReplicateConfiguration will be replicated by CDC,
 			// so we should wait until the replication configuration is changed into the same one.
-			return &streamingpb.UpdateReplicateConfigurationResponse{}, s.waitUntilPrimaryChangeOrConfigurationSame(ctx, config)
+			return &streamingpb.UpdateReplicateConfigurationResponse{}, s.waitUntilPrimaryChangeOrConfigurationSame(ctx, config, req.RbacReplicationDisabled, req.GetCollectionFilters())
 		}
 		return nil, err
 	}
 	defer broadcaster.Close()
 
-	msg, err := s.validateReplicateConfiguration(ctx, config)
+	msg, err := s.validateReplicateConfiguration(ctx, config, req.RbacReplicationDisabled, req.GetCollectionFilters())
 	if err != nil {
 		if errors.Is(err, errReplicateConfigurationSame) {
 			mlog.Info(ctx, "configuration is same after cluster resource key is acquired, ignored")
@@ -117,6 +150,11 @@ func (s *assignmentServiceImpl) UpdateReplicateConfiguration(ctx context.Context
 		}
 		return nil, err
 	}
+	// the operator is recorded in the history of the replicate configuration.
+	alterMsg := message.MustAsBroadcastAlterReplicateConfigMessageV2(msg)
+	header := alterMsg.Header()
+	header.Operator = req.GetOperator()
+	alterMsg.OverwriteHeader(header)
 	_, err = broadcaster.Broadcast(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -124,14 +162,211 @@ func (s *assignmentServiceImpl) UpdateReplicateConfiguration(ctx context.Context
 	return &streamingpb.UpdateReplicateConfigurationResponse{}, nil
 }
 
+// planReplicateConfiguration computes the changes of current cluster made by applying the replicate configuration,
+// e.g. the replicating tasks created or removed and the channels whose availability in replication is flipped.
+func (s *assignmentServiceImpl) planReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.UpdateReplicateConfigurationResponse, error) {
+	msg, err := s.validateReplicateConfiguration(ctx, config, nil, nil)
+	if err != nil {
+		if errors.Is(err, errReplicateConfigurationSame) {
+			return &streamingpb.UpdateReplicateConfigurationResponse{
+				Plan: &streamingpb.ReplicateConfigurationPlan{SameAsCurrent: true},
+			}, nil
+		}
+		return nil, err
+	}
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := balancer.PlanReplicateConfiguration(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	plan.IsPchannelIncreasing = message.MustAsBroadcastAlterReplicateConfigMessageV2(msg).Header().GetIsPchannelIncreasing()
+	return &streamingpb.UpdateReplicateConfigurationResponse{Plan: plan}, nil
+}
+
+// ValidateReplicateConfiguration validates the replicate configuration without applying it.
+func (s *assignmentServiceImpl) ValidateReplicateConfiguration(ctx context.Context, req *streamingpb.ValidateReplicateConfigurationRequest) (*streamingpb.ValidateReplicateConfigurationResponse, error) {
+	config, err := s.discoverReplicatePChannels(ctx, req.GetConfiguration())
+	if err != nil {
+		return nil, err
+	}
+	msg, err := s.validateReplicateConfiguration(ctx, config, nil, nil)
+	if err != nil {
+		if errors.Is(err, errReplicateConfigurationSame) {
+			return &streamingpb.ValidateReplicateConfigurationResponse{SameAsCurrent: true}, nil
+		}
+		return nil, err
+	}
+	return &streamingpb.ValidateReplicateConfigurationResponse{
+		IsPchannelIncreasing: message.MustAsBroadcastAlterReplicateConfigMessageV2(msg).Header().GetIsPchannelIncreasing(),
+	}, nil
+}
+
+// PromoteSecondary switches the primary of the replication from current cluster to a direct secondary cluster.
+func (s *assignmentServiceImpl) PromoteSecondary(ctx context.Context, req *streamingpb.PromoteSecondaryRequest) (*streamingpb.PromoteSecondaryResponse, error) {
+	targetClusterID := req.GetTargetClusterId()
+	if targetClusterID == "" {
+		return nil, status.NewInvalidArgument("target cluster id of promote secondary is empty")
+	}
+	mlog.Info(ctx, "PromoteSecondary received", mlog.String("targetCluster", targetClusterID))
+
+	config, err := s.switchPrimary(ctx, targetClusterID)
+	if err != nil {
+		mlog.Warn(ctx, "PromoteSecondary fail", mlog.String("targetCluster", targetClusterID), mlog.Err(err))
+		return nil, err
+	}
+	// The switchover message is the last message replicated to the target cluster,
+	// so the replication lag is zero after the target cluster confirms it.
+	if err := s.waitUntilReplicatingTasksRemoved(ctx, targetClusterID); err != nil {
+		mlog.Warn(ctx, "PromoteSecondary is not confirmed by the target cluster", mlog.String("targetCluster", targetClusterID), mlog.Err(err))
+		return nil, err
+	}
+	mlog.Info(ctx, "PromoteSecondary done", mlog.String("targetCluster", targetClusterID), replicateutil.ConfigLogField(config))
+	return &streamingpb.PromoteSecondaryResponse{Configuration: config}, nil
+}
+
+// switchPrimary broadcasts the configuration that swaps the roles of current cluster and the target cluster.
+// The configuration applied by the previous call is returned if current cluster is already the secondary of the target cluster.
+func (s *assignmentServiceImpl) switchPrimary(ctx context.Context, targetClusterID string) (*commonpb.ReplicateConfiguration, error) {
+	broadcaster, err := broadcast.StartBroadcastWithResourceKeys(ctx, message.NewExclusiveClusterResourceKey())
+	if err != nil {
+		if errors.Is(err, broadcast.ErrNotPrimary) {
+			return s.getSwitchedConfiguration(ctx, targetClusterID)
+		}
+		return nil, err
+	}
+	defer broadcaster.Close()
+
+	currentConfig, err := s.getReplicateConfigHelper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := currentConfig.GetCurrentCluster()
+	if current.TargetCluster(targetClusterID) == nil {
+		return nil, status.NewInvalidArgument("cluster %s is not a direct secondary of current cluster %s", targetClusterID, current.GetClusterId())
+	}
+	// The paused or bootstrapping replicating task never confirms the switchover, so no cluster can be written after the switchover.
+	tasks, err := listReplicatingTasksTo(ctx, targetClusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.GetState() != streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING {
+			return nil, status.NewInvalidArgument("replicating task of source channel %s to cluster %s is %s, resume it before switchover",
+				task.GetSourceChannelName(), targetClusterID, strings.ToLower(strings.TrimPrefix(task.GetState().String(), "REPLICATE_PCHANNEL_TASK_STATE_")))
+		}
+	}
+
+	config := swapClusterRoles(currentConfig.GetReplicateConfiguration(), current.GetClusterId(), targetClusterID)
+	msg, err := s.validateReplicateConfiguration(ctx, config, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := broadcaster.Broadcast(ctx, msg); err != nil {
+		return nil, err
+	}
+	mlog.Info(ctx, "switchover configuration broadcasted", mlog.String("targetCluster", targetClusterID), replicateutil.ConfigLogField(config))
+	return config, nil
+}
+
+// getSwitchedConfiguration returns current configuration if current cluster is the direct secondary of the target cluster.
+func (s *assignmentServiceImpl) getSwitchedConfiguration(ctx context.Context, targetClusterID string) (*commonpb.ReplicateConfiguration, error) {
+	currentConfig, err := s.getReplicateConfigHelper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := currentConfig.GetCurrentCluster()
+	if current.Role() != replicateutil.RoleSecondary || current.SourceCluster().GetClusterId() != targetClusterID {
+		return nil, status.NewInvalidArgument("promote secondary can only be used on the primary cluster, current cluster %s is not primary", current.GetClusterId())
+	}
+	mlog.Info(ctx, "current cluster is already the secondary of the target cluster", mlog.String("targetCluster", targetClusterID))
+	return currentConfig.GetReplicateConfiguration(), nil
+}
+
+// getReplicateConfigHelper returns the helper of the latest replicate configuration of current cluster.
+func (s *assignmentServiceImpl) getReplicateConfigHelper(ctx context.Context) (*replicateutil.ConfigHelper, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latestAssignment, err := balancer.GetLatestChannelAssignment()
+	if err != nil {
+		return nil, err
+	}
+	if latestAssignment.ReplicateConfiguration == nil {
+		return nil, status.NewInvalidArgument("current cluster has no replicate configuration")
+	}
+	return replicateutil.NewConfigHelper(paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), latestAssignment.ReplicateConfiguration)
+}
+
+// waitUntilReplicatingTasksRemoved waits until all replicating tasks to the target cluster are removed.
+// The CDC removes the replicating task after the target cluster confirms the configuration that removes the replication edge.
+func (s *assignmentServiceImpl) waitUntilReplicatingTasksRemoved(ctx context.Context, targetClusterID string) error {
+	ticker := time.NewTicker(promoteSecondaryCheckInterval)
+	defer ticker.Stop()
+	for {
+		tasks, err := listReplicatingTasksTo(ctx, targetClusterID)
+		if err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+		mlog.Info(ctx, "waiting for the switchover to be confirmed by the target cluster",
+			mlog.String("targetCluster", targetClusterID),
+			mlog.Int("pendingTasks", len(tasks)))
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "switchover is not confirmed by %d replicating tasks to cluster %s", len(tasks), targetClusterID)
+		case <-ticker.C:
+		}
+	}
+}
+
+// listReplicatingTasksTo lists the replicating tasks from current cluster to the target cluster.
+func listReplicatingTasksTo(ctx context.Context, targetClusterID string) ([]*streamingpb.ReplicatePChannelMeta, error) {
+	tasks, err := resource.Resource().StreamingCatalog().ListReplicatePChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Filter(tasks, func(task *streamingpb.ReplicatePChannelMeta, _ int) bool {
+		return task.GetTargetCluster().GetClusterId() == targetClusterID
+	}), nil
+}
+
+// swapClusterRoles returns a copy of the configuration with the roles of the two clusters swapped in the topology,
+// e.g. A->B, A->C becomes B->A, B->C when A and B are swapped.
+func swapClusterRoles(config *commonpb.ReplicateConfiguration, a string, b string) *commonpb.ReplicateConfiguration {
+	swapped := proto.Clone(config).(*commonpb.ReplicateConfiguration)
+	swap := func(clusterID string) string {
+		switch clusterID {
+		case a:
+			return b
+		case b:
+			return a
+		default:
+			return clusterID
+		}
+	}
+	for _, edge := range swapped.GetCrossClusterTopology() {
+		edge.SourceClusterId = swap(edge.GetSourceClusterId())
+		edge.TargetClusterId = swap(edge.GetTargetClusterId())
+	}
+	return swapped
+}
+
 // waitUntilPrimaryChangeOrConfigurationSame waits until the primary changes or the configuration is same.
-func (s *assignmentServiceImpl) waitUntilPrimaryChangeOrConfigurationSame(ctx context.Context, config *commonpb.ReplicateConfiguration) error {
+func (s *assignmentServiceImpl) waitUntilPrimaryChangeOrConfigurationSame(ctx context.Context, config *commonpb.ReplicateConfiguration, rbacReplicationDisabled *bool, collectionFilters *streamingpb.ReplicateCollectionFilters) error {
 	b, err := balance.GetWithContext(ctx)
 	if err != nil {
 		return err
 	}
 	err = b.WatchChannelAssignments(ctx, func(param balancer.WatchChannelAssignmentsCallbackParam) error {
-		if proto.Equal(config, param.ReplicateConfiguration) {
+		if proto.Equal(config, param.ReplicateConfiguration) &&
+			(rbacReplicationDisabled == nil || *rbacReplicationDisabled == param.RBACReplicationDisabled) &&
+			(collectionFilters == nil || replicateutil.CollectionFiltersEqual(collectionFilters.GetFilters(), param.CollectionFilters)) {
 			return errAssignmentDone
 		}
 		return nil
@@ -143,7 +378,9 @@ func (s *assignmentServiceImpl) waitUntilPrimaryChangeOrConfigurationSame(ctx co
 }
 
 // validateReplicateConfiguration validates the replicate configuration.
-func (s *assignmentServiceImpl) validateReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration) (message.BroadcastMutableMessage, error) {
+// The current rbac replication setting is kept if rbacReplicationDisabled is nil,
+// and the current collection filters are kept if collectionFilters is nil.
+func (s *assignmentServiceImpl) validateReplicateConfiguration(ctx context.Context, config *commonpb.ReplicateConfiguration, rbacReplicationDisabled *bool, collectionFilters *streamingpb.ReplicateCollectionFilters) (message.BroadcastMutableMessage, error) {
 	balancer, err := balance.GetWithContext(ctx)
 	if err != nil {
 		return nil, err
@@ -155,8 +392,19 @@ func (s *assignmentServiceImpl) validateReplicateConfiguration(ctx context.Conte
 		return nil, err
 	}
 
+	disabled := latestAssignment.RBACReplicationDisabled
+	if rbacReplicationDisabled != nil {
+		disabled = *rbacReplicationDisabled
+	}
+
+	filters := latestAssignment.CollectionFilters
+	if collectionFilters != nil {
+		filters = collectionFilters.GetFilters()
+	}
+
 	// double check if the configuration is same after resource key is acquired.
-	if proto.Equal(config, latestAssignment.ReplicateConfiguration) {
+	if proto.Equal(config, latestAssignment.ReplicateConfiguration) && disabled == latestAssignment.RBACReplicationDisabled &&
+		replicateutil.CollectionFiltersEqual(filters, latestAssignment.CollectionFilters) {
 		return nil, errReplicateConfigurationSame
 	}
 
@@ -173,13 +421,20 @@ func (s *assignmentServiceImpl) validateReplicateConfiguration(ctx context.Conte
 	}
 
 	// TODO: validate the incoming configuration is compatible with the current config.
-	if _, err := replicateutil.NewConfigHelper(paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), config); err != nil {
+	helper, err := replicateutil.NewConfigHelper(paramtable.Get().CommonCfg.ClusterPrefix.GetValue(), config)
+	if err != nil {
+		return nil, err
+	}
+	if filters, err = resolveCollectionFilters(helper, filters, collectionFilters != nil); err != nil {
+		mlog.Warn(ctx, "UpdateReplicateConfiguration fail", mlog.Err(err))
 		return nil, err
 	}
 	b := message.NewAlterReplicateConfigMessageBuilderV2().
 		WithHeader(&message.AlterReplicateConfigMessageHeader{
-			ReplicateConfiguration: config,
-			IsPchannelIncreasing:   validator.IsPChannelIncreasing(),
+			ReplicateConfiguration:  config,
+			IsPchannelIncreasing:    validator.IsPChannelIncreasing(),
+			RbacReplicationDisabled: disabled,
+			CollectionFilters:       filters,
 		}).
 		WithBody(&message.AlterReplicateConfigMessageBody{}).
 		WithClusterLevelBroadcast(cc).
@@ -187,6 +442,30 @@ func (s *assignmentServiceImpl) validateReplicateConfiguration(ctx context.Conte
 	return b, nil
 }
 
+// resolveCollectionFilters validates the collection filters of the target clusters against the configuration.
+// The filters without any rule are removed, and the kept filters of the clusters that are not the target of current cluster any more are removed too.
+// The explicitly requested filter of a cluster that is not a target of current cluster is rejected.
+func resolveCollectionFilters(config *replicateutil.ConfigHelper, filters map[string]*messagespb.ReplicateCollectionFilter, explicit bool) (map[string]*messagespb.ReplicateCollectionFilter, error) {
+	current := config.GetCurrentCluster()
+	resolved := make(map[string]*messagespb.ReplicateCollectionFilter, len(filters))
+	for clusterID, filter := range filters {
+		if len(filter.GetIncludes()) == 0 && len(filter.GetExcludes()) == 0 {
+			continue
+		}
+		if current.TargetCluster(clusterID) == nil {
+			if explicit {
+				return nil, status.NewInvalidArgument("collection filter of cluster %s is set, but it's not a target cluster of current cluster %s", clusterID, current.GetClusterId())
+			}
+			continue
+		}
+		if _, err := replicateutil.NewCollectionFilterFromProto(filter); err != nil {
+			return nil, status.NewInvalidArgument("invalid collection filter of cluster %s: %s", clusterID, err.Error())
+		}
+		resolved[clusterID] = filter
+	}
+	return resolved, nil
+}
+
 // validateForcePromoteConfiguration validates that the force promote configuration is safe.
 // Requirements:
 // 1. Must contain ONLY the current cluster
@@ -230,7 +509,7 @@ func validateForcePromoteConfiguration(config *commonpb.ReplicateConfiguration,
 
 // handleForcePromote handles force promote logic for replicate configuration.
 // It promotes a secondary cluster to standalone primary immediately without waiting for CDC replication.
-func (s *assignmentServiceImpl) handleForcePromote(ctx context.Context, config *commonpb.ReplicateConfiguration) (*streamingpb.UpdateReplicateConfigurationResponse, error) {
+func (s *assignmentServiceImpl) handleForcePromote(ctx context.Context, config *commonpb.ReplicateConfiguration, operator string) (*streamingpb.UpdateReplicateConfigurationResponse, error) {
 	mlog.Warn(ctx, "Force promote replicate configuration requested")
 
 	// Use WithSecondaryClusterResourceKey to:
@@ -274,6 +553,7 @@ func (s *assignmentServiceImpl) handleForcePromote(ctx context.Context, config *
 		WithHeader(&message.AlterReplicateConfigMessageHeader{
 			ReplicateConfiguration: forcePromoteConfig,
 			ForcePromote:           true, // marks as force promote
+			Operator:               operator,
 		}).
 		WithBody(&message.AlterReplicateConfigMessageBody{}).
 		WithBroadcast(broadcastPChannels, message.OptBuildBroadcastAckSyncUp()). // Disable fast DDL ack
@@ -388,3 +668,272 @@ func (s *assignmentServiceImpl) UpdateWALBalancePolicy(ctx context.Context, req
 
 	return balancer.UpdateBalancePolicy(ctx, req)
 }
+
+// UpdatePChannelPools is used to create, update or drop the pchannel pools.
+func (s *assignmentServiceImpl) UpdatePChannelPools(ctx context.Context, req *streamingpb.UpdatePChannelPoolsRequest) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.UpdatePChannelPools(ctx, req)
+}
+
+// MoveControlChannel is used to move the control channel to another pchannel.
+// The exclusive cluster resource key is held during the move to fence the old control channel,
+// so there's no in-flight broadcast at the old control channel and no new DDL/DCL is accepted until the move is done.
+func (s *assignmentServiceImpl) MoveControlChannel(ctx context.Context, req *streamingpb.MoveControlChannelRequest) (*streamingpb.MoveControlChannelResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	broadcaster, err := broadcast.StartBroadcastWithResourceKeys(ctx, message.NewExclusiveClusterResourceKey())
+	if err != nil {
+		return nil, err
+	}
+	defer broadcaster.Close()
+
+	return balancer.MoveControlChannel(ctx, req)
+}
+
+// ExportState is used to export a consistent snapshot of the channel manager state.
+func (s *assignmentServiceImpl) ExportState(ctx context.Context, req *streamingpb.ExportStateRequest) (*streamingpb.ExportStateResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.ExportState(ctx, req)
+}
+
+// UpdateReplicatingTaskState is used to pause or resume the replicating tasks to a target cluster.
+func (s *assignmentServiceImpl) UpdateReplicatingTaskState(ctx context.Context, req *streamingpb.UpdateReplicatingTaskStateRequest) (*streamingpb.UpdateReplicatingTaskStateResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.UpdateReplicatingTaskState(ctx, req)
+}
+
+// ResetReplicateCheckpoint is used to reset the start position of a replicating task.
+func (s *assignmentServiceImpl) ResetReplicateCheckpoint(ctx context.Context, req *streamingpb.ResetReplicateCheckpointRequest) (*streamingpb.ResetReplicateCheckpointResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.ResetReplicateCheckpoint(ctx, req)
+}
+
+// UpdateReplicateClusterConnection is used to update the connection param of a cluster in the replicate configuration without broadcast.
+func (s *assignmentServiceImpl) UpdateReplicateClusterConnection(ctx context.Context, req *streamingpb.UpdateReplicateClusterConnectionRequest) (*streamingpb.UpdateReplicateClusterConnectionResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.UpdateReplicateClusterConnection(ctx, req)
+}
+
+// CheckReplicateSchemaConsistency compares the collection schemas and vchannel mappings between current cluster and its target clusters.
+func (s *assignmentServiceImpl) CheckReplicateSchemaConsistency(ctx context.Context, req *streamingpb.CheckReplicateSchemaConsistencyRequest) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error) {
+	config, err := s.getReplicateConfigHelper(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drifts, err := replicatecheck.NewSchemaChecker().Check(ctx, config, req.GetTargetClusterId())
+	if err != nil {
+		return nil, err
+	}
+	return &streamingpb.CheckReplicateSchemaConsistencyResponse{Drifts: drifts}, nil
+}
+
+// ListReplicatingTasks lists the replicating tasks of current cluster with the progress confirmed by the target clusters.
+func (s *assignmentServiceImpl) ListReplicatingTasks(ctx context.Context, req *streamingpb.ListReplicatingTasksRequest) (*streamingpb.ListReplicatingTasksResponse, error) {
+	tasks, err := resource.Resource().StreamingCatalog().ListReplicatePChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetTargetClusterId() != "" {
+		tasks = lo.Filter(tasks, func(task *streamingpb.ReplicatePChannelMeta, _ int) bool {
+			return task.GetTargetCluster().GetClusterId() == req.GetTargetClusterId()
+		})
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].GetTargetCluster().GetClusterId() != tasks[j].GetTargetCluster().GetClusterId() {
+			return tasks[i].GetTargetCluster().GetClusterId() < tasks[j].GetTargetCluster().GetClusterId()
+		}
+		return tasks[i].GetSourceChannelName() < tasks[j].GetSourceChannelName()
+	})
+
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latestAssignment, err := balancer.GetLatestChannelAssignment()
+	if err != nil {
+		return nil, err
+	}
+	var pchannels map[channel.ChannelID]*channel.PChannelMeta
+	if latestAssignment.PChannelView != nil {
+		pchannels = latestAssignment.PChannelView.Channels
+	}
+	deadLetters, err := s.listReplicateDeadLetters(ctx, req.GetTargetClusterId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	parked := lo.SliceToMap(deadLetters, func(deadLetter *streamingpb.ReplicateDeadLetterMeta) (string, *streamingpb.ReplicateDeadLetterMeta) {
+		return deadLetter.GetTargetClusterId() + "/" + deadLetter.GetSourceChannelName(), deadLetter
+	})
+
+	clients := newTargetClusterClients()
+	defer clients.Close(ctx)
+	progresses := make([]*streamingpb.ReplicatingTaskProgress, 0, len(tasks))
+	for _, task := range tasks {
+		progress := clients.GetProgress(ctx, task)
+		if pchannel, ok := pchannels[channel.ChannelID{Name: task.GetSourceChannelName()}]; ok {
+			progress.StreamingNodeId = pchannel.CurrentServerID()
+		}
+		switch task.GetState() {
+		case streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED:
+			progress.RuntimeState = streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PAUSED
+		case streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING:
+			progress.RuntimeState = streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_BOOTSTRAPPING
+		}
+		if deadLetter, ok := parked[task.GetTargetCluster().GetClusterId()+"/"+task.GetSourceChannelName()]; ok {
+			progress.RuntimeState = streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PARKED
+			progress.DeadLetter = deadLetter
+		}
+		progresses = append(progresses, progress)
+	}
+	return &streamingpb.ListReplicatingTasksResponse{Tasks: progresses}, nil
+}
+
+// ListReplicateDeadLetters lists the dead letters of the parked replicating tasks of current cluster.
+func (s *assignmentServiceImpl) ListReplicateDeadLetters(ctx context.Context, req *streamingpb.ListReplicateDeadLettersRequest) (*streamingpb.ListReplicateDeadLettersResponse, error) {
+	deadLetters, err := s.listReplicateDeadLetters(ctx, req.GetTargetClusterId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingpb.ListReplicateDeadLettersResponse{DeadLetters: deadLetters}, nil
+}
+
+// RequeueReplicateDeadLetters removes the dead letters of the parked replicating tasks,
+// the cdc resumes the parked tasks after the dead letters are removed.
+func (s *assignmentServiceImpl) RequeueReplicateDeadLetters(ctx context.Context, req *streamingpb.RequeueReplicateDeadLettersRequest) (*streamingpb.RequeueReplicateDeadLettersResponse, error) {
+	if req.GetTargetClusterId() == "" {
+		return nil, status.NewInvalidArgument("target cluster id of requeue is empty")
+	}
+	deadLetters, err := s.listReplicateDeadLetters(ctx, req.GetTargetClusterId(), req.GetSourceChannelNames())
+	if err != nil {
+		return nil, err
+	}
+	if len(deadLetters) > 0 {
+		if err := resource.Resource().StreamingCatalog().RemoveReplicateDeadLetters(ctx, deadLetters); err != nil {
+			return nil, err
+		}
+	}
+	return &streamingpb.RequeueReplicateDeadLettersResponse{DeadLetters: deadLetters}, nil
+}
+
+// GetReplicateConfigurationHistory gets the accepted replicate configurations of current cluster ordered by version.
+func (s *assignmentServiceImpl) GetReplicateConfigurationHistory(ctx context.Context, req *streamingpb.GetReplicateConfigurationHistoryRequest) (*streamingpb.GetReplicateConfigurationHistoryResponse, error) {
+	histories, err := resource.Resource().StreamingCatalog().ListReplicateConfigurationHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetVersion() != 0 {
+		histories = lo.Filter(histories, func(history *streamingpb.ReplicateConfigurationHistoryMeta, _ int) bool {
+			return history.GetVersion() == req.GetVersion()
+		})
+		if len(histories) == 0 {
+			return nil, status.NewInvalidArgument("replicate configuration of version %d is not found in history", req.GetVersion())
+		}
+	}
+	return &streamingpb.GetReplicateConfigurationHistoryResponse{Histories: histories}, nil
+}
+
+// listReplicateDeadLetters lists the dead letters filtered by the target cluster and source channels, empty filter selects all.
+func (s *assignmentServiceImpl) listReplicateDeadLetters(ctx context.Context, targetClusterID string, sourceChannelNames []string) ([]*streamingpb.ReplicateDeadLetterMeta, error) {
+	deadLetters, err := resource.Resource().StreamingCatalog().ListReplicateDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sourceChannels := typeutil.NewSet(sourceChannelNames...)
+	deadLetters = lo.Filter(deadLetters, func(deadLetter *streamingpb.ReplicateDeadLetterMeta, _ int) bool {
+		if targetClusterID != "" && deadLetter.GetTargetClusterId() != targetClusterID {
+			return false
+		}
+		return sourceChannels.Len() == 0 || sourceChannels.Contain(deadLetter.GetSourceChannelName())
+	})
+	sort.Slice(deadLetters, func(i, j int) bool {
+		if deadLetters[i].GetTargetClusterId() != deadLetters[j].GetTargetClusterId() {
+			return deadLetters[i].GetTargetClusterId() < deadLetters[j].GetTargetClusterId()
+		}
+		return deadLetters[i].GetSourceChannelName() < deadLetters[j].GetSourceChannelName()
+	})
+	return deadLetters, nil
+}
+
+// UpdatePChannelAntiAffinityGroups is used to create, update or drop the pchannel anti-affinity groups.
+func (s *assignmentServiceImpl) UpdatePChannelAntiAffinityGroups(ctx context.Context, req *streamingpb.UpdatePChannelAntiAffinityGroupsRequest) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.UpdatePChannelAntiAffinityGroups(ctx, req)
+}
+
+// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the streamingnode.
+func (s *assignmentServiceImpl) RenewPChannelLease(ctx context.Context, req *streamingpb.RenewPChannelLeaseRequest) (*streamingpb.RenewPChannelLeaseResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := lo.Map(req.GetChannels(), func(channel *streamingpb.PChannelInfo, _ int) types.PChannelInfo {
+		return types.NewPChannelInfoFromProto(channel)
+	})
+	revoked, err := balancer.RenewPChannelLease(ctx, types.NewStreamingNodeInfoFromProto(req.GetNode()), channels)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingpb.RenewPChannelLeaseResponse{
+		RevokedChannels: lo.Map(revoked, func(channel types.PChannelInfo, _ int) *streamingpb.PChannelInfo {
+			return types.NewProtoFromPChannelInfo(channel)
+		}),
+		LeaseTtlMs: paramtable.Get().StreamingCfg.WALBalancerLeaseTTL.GetAsDurationByParse().Milliseconds(),
+	}, nil
+}
+
+// DrainNode is used to drain the pchannels from a streaming node for maintenance.
+func (s *assignmentServiceImpl) DrainNode(ctx context.Context, req *streamingpb.DrainNodeRequest) (*streamingpb.DrainNodeResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.DrainNode(ctx, req)
+}
+
+// GetAssignmentHistory is used to get the ownership history of a pchannel.
+func (s *assignmentServiceImpl) GetAssignmentHistory(ctx context.Context, req *streamingpb.GetAssignmentHistoryRequest) (*streamingpb.GetAssignmentHistoryResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.GetAssignmentHistory(ctx, req)
+}
+
+// UpdatePChannelPins is used to pin the pchannels to the streaming nodes or unpin them.
+func (s *assignmentServiceImpl) UpdatePChannelPins(ctx context.Context, req *streamingpb.UpdatePChannelPinsRequest) (*streamingpb.UpdatePChannelPinsResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.UpdatePChannelPins(ctx, req)
+}
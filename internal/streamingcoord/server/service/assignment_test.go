@@ -10,11 +10,16 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
 	"github.com/milvus-io/milvus/internal/coordinator/snmanager"
 	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/mocks/distributed/mock_streaming"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
 	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_balancer"
 	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_broadcaster"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
@@ -24,11 +29,15 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/registry"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
 	"github.com/milvus-io/milvus/pkg/v3/mocks/proto/mock_streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
 )
 
 func TestAssignmentService(t *testing.T) {
@@ -110,6 +119,42 @@ func TestAssignmentService(t *testing.T) {
 		},
 	}
 
+	// Test validate replicate configuration
+	validateResp, err := as.ValidateReplicateConfiguration(context.Background(), &streamingpb.ValidateReplicateConfigurationRequest{
+		Configuration: cfg,
+	})
+	assert.NoError(t, err)
+	assert.False(t, validateResp.GetSameAsCurrent())
+	assert.False(t, validateResp.GetIsPchannelIncreasing())
+
+	// Test dry run of update replicate configuration
+	b.EXPECT().PlanReplicateConfiguration(mock.Anything, cfg).Return(&streamingpb.ReplicateConfigurationPlan{
+		CreatedTasks: []*streamingpb.ReplicatePChannelMeta{{SourceChannelName: "by-dev-1", TargetChannelName: "test2"}},
+	}, nil).Once()
+	dryRunResp, err := as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+		DryRun:        true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, dryRunResp.GetPlan().GetCreatedTasks(), 1)
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+		DryRun:        true,
+		ForcePromote:  true,
+	})
+	assert.Error(t, err)
+
+	cycleCfg := proto.Clone(cfg).(*commonpb.ReplicateConfiguration)
+	cycleCfg.CrossClusterTopology = append(cycleCfg.CrossClusterTopology, &commonpb.CrossClusterTopology{SourceClusterId: "test2", TargetClusterId: "by-dev"})
+	_, err = as.ValidateReplicateConfiguration(context.Background(), &streamingpb.ValidateReplicateConfigurationRequest{
+		Configuration: cycleCfg,
+	})
+	assert.ErrorContains(t, err, "replication cycle found: by-dev -> test2 -> by-dev")
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cycleCfg,
+	})
+	assert.ErrorContains(t, err, "replication cycle found")
+
 	// Test update pass.
 	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
 		Configuration: cfg,
@@ -130,6 +175,11 @@ func TestAssignmentService(t *testing.T) {
 		Configuration: cfg,
 	})
 	assert.NoError(t, err)
+	validateResp, err = as.ValidateReplicateConfiguration(context.Background(), &streamingpb.ValidateReplicateConfigurationRequest{
+		Configuration: cfg,
+	})
+	assert.NoError(t, err)
+	assert.True(t, validateResp.GetSameAsCurrent())
 
 	// Test secondary path.
 	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Unset()
@@ -870,6 +920,184 @@ func TestUpdateReplicateConfigSecondValidateSameConfig(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestUpdateReplicateConfigRBACReplication(t *testing.T) {
+	resource.InitForTest()
+
+	mw := mock_streaming.NewMockWALAccesser(t)
+	mw.EXPECT().ControlChannel().Return("by-dev-1_vcchan").Maybe()
+	streaming.SetWALForTest(mw)
+
+	broadcast.ResetBroadcaster()
+	snmanager.ResetStreamingNodeManager()
+
+	mockGetClusterChannels := mockey.Mock(channel.GetClusterChannels).Return(message.ClusterChannels{
+		Channels:       []string{"by-dev-1"},
+		ControlChannel: "by-dev-1_vcchan",
+	}).Build()
+	defer mockGetClusterChannels.UnPatch()
+
+	cfg := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"by-dev-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test:19530", Token: "by-dev"}},
+			{ClusterId: "test2", Pchannels: []string{"test2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test2:19530", Token: "test2"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "test2"},
+		},
+	}
+
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().WaitUntilWALbasedDDLReady(mock.Anything).Return(nil).Maybe()
+	b.EXPECT().Close().Return().Maybe()
+	b.EXPECT().GetLatestChannelAssignment().Return(&balancer.WatchChannelAssignmentsCallbackParam{
+		PChannelView: &channel.PChannelView{
+			Channels: map[channel.ChannelID]*channel.PChannelMeta{
+				{Name: "by-dev-1"}: channel.NewPChannelMeta("by-dev-1", types.AccessModeRW),
+			},
+		},
+		ReplicateConfiguration: cfg,
+	}, nil)
+	balance.Register(b)
+
+	var broadcasted []message.BroadcastMutableMessage
+	mba := mock_broadcaster.NewMockBroadcastAPI(t)
+	mba.EXPECT().Close().Return().Maybe()
+	mba.EXPECT().Broadcast(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
+		broadcasted = append(broadcasted, msg)
+		return &types.BroadcastAppendResult{}, nil
+	}).Maybe()
+
+	mb := mock_broadcaster.NewMockBroadcaster(t)
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(mba, nil).Maybe()
+	mb.EXPECT().Close().Return().Maybe()
+	broadcast.Register(mb)
+
+	as := NewAssignmentService()
+
+	// the same configuration without the rbac setting keeps the current setting, so nothing is broadcasted.
+	_, err := as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, broadcasted)
+
+	// the rbac opt-out is broadcasted with the same configuration.
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration:           cfg,
+		RbacReplicationDisabled: proto.Bool(true),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, broadcasted, 1)
+	header := message.MustAsBroadcastAlterReplicateConfigMessageV2(broadcasted[0]).Header()
+	assert.True(t, header.GetRbacReplicationDisabled())
+	assert.True(t, proto.Equal(cfg, header.GetReplicateConfiguration()))
+}
+
+func TestUpdateReplicateConfigCollectionFilters(t *testing.T) {
+	resource.InitForTest()
+
+	mw := mock_streaming.NewMockWALAccesser(t)
+	mw.EXPECT().ControlChannel().Return("by-dev-1_vcchan").Maybe()
+	streaming.SetWALForTest(mw)
+
+	broadcast.ResetBroadcaster()
+	snmanager.ResetStreamingNodeManager()
+
+	mockGetClusterChannels := mockey.Mock(channel.GetClusterChannels).Return(message.ClusterChannels{
+		Channels:       []string{"by-dev-1"},
+		ControlChannel: "by-dev-1_vcchan",
+	}).Build()
+	defer mockGetClusterChannels.UnPatch()
+
+	cfg := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"by-dev-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test:19530", Token: "by-dev"}},
+			{ClusterId: "test2", Pchannels: []string{"test2"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test2:19530", Token: "test2"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "test2"},
+		},
+	}
+
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().WaitUntilWALbasedDDLReady(mock.Anything).Return(nil).Maybe()
+	b.EXPECT().Close().Return().Maybe()
+	b.EXPECT().GetLatestChannelAssignment().Return(&balancer.WatchChannelAssignmentsCallbackParam{
+		PChannelView: &channel.PChannelView{
+			Channels: map[channel.ChannelID]*channel.PChannelMeta{
+				{Name: "by-dev-1"}: channel.NewPChannelMeta("by-dev-1", types.AccessModeRW),
+			},
+		},
+		ReplicateConfiguration: cfg,
+	}, nil)
+	balance.Register(b)
+
+	var broadcasted []message.BroadcastMutableMessage
+	mba := mock_broadcaster.NewMockBroadcastAPI(t)
+	mba.EXPECT().Close().Return().Maybe()
+	mba.EXPECT().Broadcast(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
+		broadcasted = append(broadcasted, msg)
+		return &types.BroadcastAppendResult{}, nil
+	}).Maybe()
+
+	mb := mock_broadcaster.NewMockBroadcaster(t)
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(mba, nil).Maybe()
+	mb.EXPECT().Close().Return().Maybe()
+	broadcast.Register(mb)
+
+	as := NewAssignmentService()
+
+	// the same configuration without the collection filters keeps the current filters, so nothing is broadcasted.
+	_, err := as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, broadcasted)
+
+	// the filter without any rule is same as no filter.
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+		CollectionFilters: &streamingpb.ReplicateCollectionFilters{
+			Filters: map[string]*messagespb.ReplicateCollectionFilter{"test2": {}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, broadcasted)
+
+	// the filter of a cluster that is not a target cluster is rejected.
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+		CollectionFilters: &streamingpb.ReplicateCollectionFilters{
+			Filters: map[string]*messagespb.ReplicateCollectionFilter{"test3": {Includes: []string{"db1"}}},
+		},
+	})
+	assert.Error(t, err)
+
+	// the invalid rule is rejected.
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+		CollectionFilters: &streamingpb.ReplicateCollectionFilters{
+			Filters: map[string]*messagespb.ReplicateCollectionFilter{"test2": {Includes: []string{"db1.c1.c2"}}},
+		},
+	})
+	assert.Error(t, err)
+	assert.Empty(t, broadcasted)
+
+	// the collection filter is broadcasted with the same configuration.
+	_, err = as.UpdateReplicateConfiguration(context.Background(), &streamingpb.UpdateReplicateConfigurationRequest{
+		Configuration: cfg,
+		CollectionFilters: &streamingpb.ReplicateCollectionFilters{
+			Filters: map[string]*messagespb.ReplicateCollectionFilter{"test2": {Includes: []string{"db1"}, Excludes: []string{"db1.tmp"}}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, broadcasted, 1)
+	header := message.MustAsBroadcastAlterReplicateConfigMessageV2(broadcasted[0]).Header()
+	assert.Equal(t, []string{"db1"}, header.GetCollectionFilters()["test2"].GetIncludes())
+	assert.Equal(t, []string{"db1.tmp"}, header.GetCollectionFilters()["test2"].GetExcludes())
+	assert.True(t, proto.Equal(cfg, header.GetReplicateConfiguration()))
+}
+
 func TestAssignmentDiscoverBalanceError(t *testing.T) {
 	resource.InitForTest()
 
@@ -943,7 +1171,7 @@ func TestWaitUntilPrimaryChangeBalanceError(t *testing.T) {
 			{ClusterId: "by-dev", Pchannels: []string{"by-dev-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test:19530", Token: "by-dev"}},
 		},
 	}
-	err := as.waitUntilPrimaryChangeOrConfigurationSame(ctx, cfg)
+	err := as.waitUntilPrimaryChangeOrConfigurationSame(ctx, cfg, nil, nil)
 	assert.Error(t, err)
 }
 
@@ -1428,3 +1656,563 @@ func TestForcePromoteMultiplePChannels(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+func TestPromoteSecondary(t *testing.T) {
+	oldInterval := promoteSecondaryCheckInterval
+	promoteSecondaryCheckInterval = 10 * time.Millisecond
+	defer func() { promoteSecondaryCheckInterval = oldInterval }()
+
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+
+	mw := mock_streaming.NewMockWALAccesser(t)
+	streaming.SetWALForTest(mw)
+
+	broadcast.ResetBroadcaster()
+	snmanager.ResetStreamingNodeManager()
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().WaitUntilWALbasedDDLReady(mock.Anything).Return(nil).Maybe()
+	b.EXPECT().Close().Return().Maybe()
+	currentConfig := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"by-dev-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test:19530", Token: "by-dev"}},
+			{ClusterId: "test2", Pchannels: []string{"test2-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test2:19530", Token: "test2"}},
+			{ClusterId: "test3", Pchannels: []string{"test3-1"}, ConnectionParam: &commonpb.ConnectionParam{Uri: "http://test3:19530", Token: "test3"}},
+		},
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{
+			{SourceClusterId: "by-dev", TargetClusterId: "test2"},
+			{SourceClusterId: "by-dev", TargetClusterId: "test3"},
+		},
+	}
+	b.EXPECT().GetLatestChannelAssignment().RunAndReturn(func() (*balancer.WatchChannelAssignmentsCallbackParam, error) {
+		return &balancer.WatchChannelAssignmentsCallbackParam{
+			PChannelView: &channel.PChannelView{
+				Channels: map[channel.ChannelID]*channel.PChannelMeta{
+					{Name: "by-dev-1"}: channel.NewPChannelMeta("by-dev-1", types.AccessModeRW),
+				},
+			},
+			ReplicateConfiguration: currentConfig,
+		}, nil
+	})
+	balance.Register(b)
+
+	mockGetClusterChannels := mockey.Mock(channel.GetClusterChannels).Return(message.ClusterChannels{
+		Channels:       []string{"by-dev-1"},
+		ControlChannel: "by-dev-1_vcchan",
+	}).Build()
+	defer mockGetClusterChannels.UnPatch()
+
+	var broadcasted message.BroadcastMutableMessage
+	mba := mock_broadcaster.NewMockBroadcastAPI(t)
+	mba.EXPECT().Broadcast(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
+		broadcasted = msg
+		return &types.BroadcastAppendResult{}, nil
+	}).Maybe()
+	mba.EXPECT().Close().Return().Maybe()
+	mb := mock_broadcaster.NewMockBroadcaster(t)
+	mb.EXPECT().Close().Return().Maybe()
+	broadcast.Register(mb)
+
+	as := NewAssignmentService()
+	ctx := context.Background()
+
+	newTask := func(state streamingpb.ReplicatePChannelTaskState) *streamingpb.ReplicatePChannelMeta {
+		return &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: "by-dev-1",
+			TargetChannelName: "test2-1",
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: "test2"},
+			State:             state,
+		}
+	}
+
+	// Invalid requests.
+	_, err := as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{})
+	assert.Error(t, err)
+
+	// The switchover is rejected if any replicating task to the target cluster is paused or bootstrapping.
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(mba, nil).Times(3)
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask(streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED),
+	}, nil).Once()
+	_, err = as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test2"})
+	assert.ErrorContains(t, err, "is paused")
+	assert.Nil(t, broadcasted)
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask(streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING),
+	}, nil).Once()
+	_, err = as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test2"})
+	assert.ErrorContains(t, err, "is bootstrapping")
+	assert.Nil(t, broadcasted)
+	_, err = as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test4"})
+	assert.Error(t, err)
+
+	// Switch the primary to test2, and wait until the replicating task is removed.
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(mba, nil).Once()
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask(streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING),
+	}, nil).Times(2)
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return(nil, nil).Once()
+	resp, err := as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test2"})
+	assert.NoError(t, err)
+	expected := []*commonpb.CrossClusterTopology{
+		{SourceClusterId: "test2", TargetClusterId: "by-dev"},
+		{SourceClusterId: "test2", TargetClusterId: "test3"},
+	}
+	assert.Len(t, resp.GetConfiguration().GetCrossClusterTopology(), 2)
+	for i, edge := range resp.GetConfiguration().GetCrossClusterTopology() {
+		assert.True(t, proto.Equal(expected[i], edge))
+	}
+	assert.NotNil(t, broadcasted)
+	assert.True(t, proto.Equal(resp.GetConfiguration(),
+		message.MustAsBroadcastAlterReplicateConfigMessageV2(broadcasted).Header().GetReplicateConfiguration()))
+
+	// The retry after the switchover is applied is idempotent.
+	currentConfig = resp.GetConfiguration()
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(nil, broadcaster.ErrNotPrimary).Times(2)
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return(nil, nil).Once()
+	resp, err = as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test2"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(currentConfig, resp.GetConfiguration()))
+	_, err = as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test3"})
+	assert.Error(t, err)
+
+	// The wait is canceled by the context.
+	currentConfig = &commonpb.ReplicateConfiguration{
+		Clusters:             currentConfig.GetClusters(),
+		CrossClusterTopology: []*commonpb.CrossClusterTopology{{SourceClusterId: "by-dev", TargetClusterId: "test2"}, {SourceClusterId: "by-dev", TargetClusterId: "test3"}},
+	}
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(mba, nil).Once()
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask(streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING),
+	}, nil)
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = as.PromoteSecondary(ctx, &streamingpb.PromoteSecondaryRequest{TargetClusterId: "test2"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestListReplicatingTasks(t *testing.T) {
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+
+	newTask := func(targetClusterID string, sourceChannel string) *streamingpb.ReplicatePChannelMeta {
+		return &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName: sourceChannel,
+			TargetChannelName: targetClusterID + "-1",
+			TargetCluster:     &commonpb.MilvusCluster{ClusterId: targetClusterID},
+		}
+	}
+	pausedTask := newTask("test4", "by-dev-1")
+	pausedTask.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED
+	bootstrappingTask := newTask("test4", "by-dev-2")
+	bootstrappingTask.State = streamingpb.ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING
+	checkpointTimeTick := tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0)
+	conflictTask := newTask("test5", "by-dev-1")
+	conflictTask.InitializedCheckpoint = &commonpb.ReplicateCheckpoint{TimeTick: checkpointTimeTick + 1}
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask("test3", "by-dev-1"),
+		newTask("test2", "by-dev-2"),
+		newTask("test2", "by-dev-1"),
+		pausedTask,
+		bootstrappingTask,
+		conflictTask,
+	}, nil)
+	catalog.EXPECT().ListReplicateDeadLetters(mock.Anything).Return([]*streamingpb.ReplicateDeadLetterMeta{
+		{SourceChannelName: "by-dev-2", TargetChannelName: "test2-1", TargetClusterId: "test2", Error: "collection not found"},
+	}, nil)
+
+	pchannel := channel.NewPChannelMeta("by-dev-1", types.AccessModeRW).CopyForWrite()
+	pchannel.TryAssignToServerID(types.AccessModeRW, types.StreamingNodeInfo{ServerID: 2})
+	pchannel.AssignToServerDone()
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().GetLatestChannelAssignment().Return(&balancer.WatchChannelAssignmentsCallbackParam{
+		PChannelView: &channel.PChannelView{
+			Channels: map[channel.ChannelID]*channel.PChannelMeta{
+				{Name: "by-dev-1"}: pchannel.PChannelMeta,
+				{Name: "by-dev-2"}: channel.NewPChannelMeta("by-dev-2", types.AccessModeRW),
+			},
+		},
+	}, nil)
+	b.EXPECT().Close().Return().Maybe()
+	balance.Register(b)
+	defer balance.ResetBalancer()
+
+	cli := cluster.NewMockMilvusClient(t)
+	cli.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.GetReplicateInfoRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicateInfoResponse, error) {
+			if req.GetTargetPchannel() == "test2-1" || req.GetTargetPchannel() == "test5-1" {
+				return &milvuspb.GetReplicateInfoResponse{
+					Checkpoint: &commonpb.ReplicateCheckpoint{
+						MessageId: &commonpb.MessageID{Id: "1"},
+						TimeTick:  checkpointTimeTick,
+					},
+				}, nil
+			}
+			return &milvuspb.GetReplicateInfoResponse{}, nil
+		})
+	cli.EXPECT().Close(mock.Anything).Return(nil)
+	oldCreateClient := createTargetClusterClient
+	createTargetClusterClient = func(ctx context.Context, c *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+		if c.GetClusterId() == "test3" {
+			return nil, errors.New("unreachable")
+		}
+		return cli, nil
+	}
+	defer func() { createTargetClusterClient = oldCreateClient }()
+
+	as := NewAssignmentService()
+	resp, err := as.ListReplicatingTasks(context.Background(), &streamingpb.ListReplicatingTasksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetTasks(), 6)
+	assert.Equal(t, "test2", resp.GetTasks()[0].GetTask().GetTargetCluster().GetClusterId())
+	assert.Equal(t, "by-dev-1", resp.GetTasks()[0].GetTask().GetSourceChannelName())
+	assert.Equal(t, checkpointTimeTick, resp.GetTasks()[0].GetCheckpoint().GetTimeTick())
+	assert.GreaterOrEqual(t, resp.GetTasks()[0].GetLagMilliseconds(), time.Minute.Milliseconds())
+	assert.Equal(t, streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_REPLICATING, resp.GetTasks()[0].GetRuntimeState())
+	assert.Equal(t, int64(2), resp.GetTasks()[0].GetStreamingNodeId())
+	assert.Equal(t, "by-dev-2", resp.GetTasks()[1].GetTask().GetSourceChannelName())
+	assert.Nil(t, resp.GetTasks()[1].GetCheckpoint())
+	assert.Zero(t, resp.GetTasks()[1].GetLagMilliseconds())
+	assert.Equal(t, streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PARKED, resp.GetTasks()[1].GetRuntimeState())
+	assert.Equal(t, "collection not found", resp.GetTasks()[1].GetDeadLetter().GetError())
+	assert.Zero(t, resp.GetTasks()[1].GetStreamingNodeId())
+	assert.Equal(t, "test3", resp.GetTasks()[2].GetTask().GetTargetCluster().GetClusterId())
+	assert.Contains(t, resp.GetTasks()[2].GetError(), "unreachable")
+	assert.Equal(t, streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_UNKNOWN, resp.GetTasks()[2].GetRuntimeState())
+	assert.Equal(t, "test4", resp.GetTasks()[3].GetTask().GetTargetCluster().GetClusterId())
+	assert.Equal(t, streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PAUSED, resp.GetTasks()[3].GetRuntimeState())
+	assert.Equal(t, streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_BOOTSTRAPPING, resp.GetTasks()[4].GetRuntimeState())
+	assert.Equal(t, "test5", resp.GetTasks()[5].GetTask().GetTargetCluster().GetClusterId())
+	assert.Equal(t, streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_CONFLICT, resp.GetTasks()[5].GetRuntimeState())
+	assert.Contains(t, resp.GetTasks()[5].GetError(), "older than the initialized checkpoint")
+	assert.Zero(t, resp.GetTasks()[5].GetLagMilliseconds())
+
+	resp, err = as.ListReplicatingTasks(context.Background(), &streamingpb.ListReplicatingTasksRequest{TargetClusterId: "test3"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetTasks(), 1)
+}
+
+func TestGetPChannelRetentions(t *testing.T) {
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+
+	newTask := func(targetClusterID string, sourceChannel string) *streamingpb.ReplicatePChannelMeta {
+		return &streamingpb.ReplicatePChannelMeta{
+			SourceChannelName:     sourceChannel,
+			TargetChannelName:     targetClusterID + "-1",
+			TargetCluster:         &commonpb.MilvusCluster{ClusterId: targetClusterID},
+			InitializedCheckpoint: &commonpb.ReplicateCheckpoint{MessageId: &commonpb.MessageID{Id: "initialized"}},
+		}
+	}
+	latestTask := newTask("test3", "by-dev-2")
+	latestTask.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_LATEST
+	earliestTask := newTask("test2", "by-dev-3")
+	earliestTask.CheckpointResetPolicy = streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST
+	catalog.EXPECT().ListReplicatePChannels(mock.Anything).Return([]*streamingpb.ReplicatePChannelMeta{
+		newTask("test2", "by-dev-1"),
+		newTask("test3", "by-dev-1"),
+		newTask("test2", "by-dev-2"),
+		latestTask,
+		earliestTask,
+		newTask("unreachable", "by-dev-4"),
+		newTask("test2", "by-dev-4"),
+		newTask("test2", "by-dev-5"),
+	}, nil)
+
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().GetPChannelRetentionPolicies(mock.Anything, mock.Anything).Return(map[string]*streamingpb.WALRetentionPolicy{
+		"by-dev-1": {TtlSeconds: 3600},
+	}, nil)
+	b.EXPECT().Close().Return().Maybe()
+	balance.Register(b)
+	defer balance.ResetBalancer()
+
+	cli := cluster.NewMockMilvusClient(t)
+	cli.EXPECT().GetReplicateInfo(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *milvuspb.GetReplicateInfoRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicateInfoResponse, error) {
+			if req.GetTargetPchannel() == "test2-1" {
+				return &milvuspb.GetReplicateInfoResponse{
+					Checkpoint: &commonpb.ReplicateCheckpoint{
+						MessageId: &commonpb.MessageID{Id: "confirmed"},
+						TimeTick:  tsoutil.ComposeTSByTime(time.Now(), 0),
+					},
+				}, nil
+			}
+			return &milvuspb.GetReplicateInfoResponse{}, nil
+		})
+	cli.EXPECT().Close(mock.Anything).Return(nil)
+	oldCreateClient := createTargetClusterClient
+	createTargetClusterClient = func(ctx context.Context, c *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+		if c.GetClusterId() == "unreachable" {
+			return nil, errors.New("unreachable")
+		}
+		return cli, nil
+	}
+	defer func() { createTargetClusterClient = oldCreateClient }()
+
+	as := NewAssignmentService()
+	resp, err := as.GetPChannelRetentions(context.Background(), &streamingpb.GetPChannelRetentionsRequest{
+		Pchannels: []string{"by-dev-1", "by-dev-2", "by-dev-3", "by-dev-4", "by-dev-6"},
+	})
+	assert.NoError(t, err)
+	retentions := resp.GetRetentions()
+	assert.Len(t, retentions, 5)
+
+	// the confirmed checkpoint is used if the target cluster confirms any message, otherwise the initialized checkpoint is used.
+	assert.Equal(t, "by-dev-1", retentions[0].GetPchannel())
+	assert.Equal(t, uint64(3600), retentions[0].GetPolicy().GetTtlSeconds())
+	assert.False(t, retentions[0].GetReplicateCheckpointUnknown())
+	assert.Len(t, retentions[0].GetReplicateCheckpoints(), 2)
+	assert.Equal(t, "confirmed", retentions[0].GetReplicateCheckpoints()[0].GetId())
+	assert.Equal(t, "initialized", retentions[0].GetReplicateCheckpoints()[1].GetId())
+	// the time tick of the replicate checkpoint is used to measure the lag of the replicating tasks.
+	assert.NotZero(t, retentions[0].GetReplicateCheckpointTimeTick())
+
+	// the task replicated from the latest message doesn't retain the wal.
+	assert.Nil(t, retentions[1].GetPolicy())
+	assert.False(t, retentions[1].GetReplicateCheckpointUnknown())
+	assert.Len(t, retentions[1].GetReplicateCheckpoints(), 1)
+
+	// the task replicated from the earliest message or to an unreachable target cluster holds the truncation.
+	assert.True(t, retentions[2].GetReplicateCheckpointUnknown())
+	assert.True(t, retentions[3].GetReplicateCheckpointUnknown())
+	assert.Empty(t, retentions[3].GetReplicateCheckpoints())
+	assert.Zero(t, retentions[3].GetReplicateCheckpointTimeTick())
+
+	// the pchannel without any replicating task.
+	assert.Equal(t, "by-dev-6", retentions[4].GetPchannel())
+	assert.False(t, retentions[4].GetReplicateCheckpointUnknown())
+	assert.Empty(t, retentions[4].GetReplicateCheckpoints())
+}
+
+func TestReplicateDeadLetters(t *testing.T) {
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+
+	newDeadLetter := func(targetClusterID string, sourceChannel string) *streamingpb.ReplicateDeadLetterMeta {
+		return &streamingpb.ReplicateDeadLetterMeta{
+			SourceChannelName: sourceChannel,
+			TargetChannelName: targetClusterID + "-1",
+			TargetClusterId:   targetClusterID,
+			Error:             "collection not found",
+		}
+	}
+	catalog.EXPECT().ListReplicateDeadLetters(mock.Anything).Return([]*streamingpb.ReplicateDeadLetterMeta{
+		newDeadLetter("test3", "by-dev-1"),
+		newDeadLetter("test2", "by-dev-2"),
+		newDeadLetter("test2", "by-dev-1"),
+	}, nil)
+
+	as := NewAssignmentService()
+	listResp, err := as.ListReplicateDeadLetters(context.Background(), &streamingpb.ListReplicateDeadLettersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.GetDeadLetters(), 3)
+	assert.Equal(t, "test2", listResp.GetDeadLetters()[0].GetTargetClusterId())
+	assert.Equal(t, "by-dev-1", listResp.GetDeadLetters()[0].GetSourceChannelName())
+	assert.Equal(t, "test3", listResp.GetDeadLetters()[2].GetTargetClusterId())
+
+	listResp, err = as.ListReplicateDeadLetters(context.Background(), &streamingpb.ListReplicateDeadLettersRequest{TargetClusterId: "test3"})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.GetDeadLetters(), 1)
+
+	_, err = as.RequeueReplicateDeadLetters(context.Background(), &streamingpb.RequeueReplicateDeadLettersRequest{})
+	assert.Error(t, err)
+
+	var removed []*streamingpb.ReplicateDeadLetterMeta
+	catalog.EXPECT().RemoveReplicateDeadLetters(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, deadLetters []*streamingpb.ReplicateDeadLetterMeta) error {
+			removed = deadLetters
+			return nil
+		})
+	requeueResp, err := as.RequeueReplicateDeadLetters(context.Background(), &streamingpb.RequeueReplicateDeadLettersRequest{
+		TargetClusterId:    "test2",
+		SourceChannelNames: []string{"by-dev-2"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, requeueResp.GetDeadLetters(), 1)
+	assert.Equal(t, "by-dev-2", requeueResp.GetDeadLetters()[0].GetSourceChannelName())
+	assert.Equal(t, requeueResp.GetDeadLetters(), removed)
+
+	// nothing to requeue.
+	removed = nil
+	requeueResp, err = as.RequeueReplicateDeadLetters(context.Background(), &streamingpb.RequeueReplicateDeadLettersRequest{TargetClusterId: "test4"})
+	assert.NoError(t, err)
+	assert.Empty(t, requeueResp.GetDeadLetters())
+	assert.Nil(t, removed)
+}
+
+func TestGetReplicateConfigurationHistory(t *testing.T) {
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog))
+
+	catalog.EXPECT().ListReplicateConfigurationHistory(mock.Anything).Return([]*streamingpb.ReplicateConfigurationHistoryMeta{
+		{Version: 1, Operator: "root"},
+		{Version: 2, Operator: "admin", ForcePromoted: true},
+	}, nil)
+
+	as := NewAssignmentService()
+	resp, err := as.GetReplicateConfigurationHistory(context.Background(), &streamingpb.GetReplicateConfigurationHistoryRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetHistories(), 2)
+
+	resp, err = as.GetReplicateConfigurationHistory(context.Background(), &streamingpb.GetReplicateConfigurationHistoryRequest{Version: 2})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetHistories(), 1)
+	assert.Equal(t, "admin", resp.GetHistories()[0].GetOperator())
+	assert.True(t, resp.GetHistories()[0].GetForcePromoted())
+
+	_, err = as.GetReplicateConfigurationHistory(context.Background(), &streamingpb.GetReplicateConfigurationHistoryRequest{Version: 3})
+	assert.Error(t, err)
+}
+
+func TestDiscoverReplicatePChannels(t *testing.T) {
+	resource.InitForTest()
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().GetLatestChannelAssignment().Return(&balancer.WatchChannelAssignmentsCallbackParam{
+		ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+			Clusters: []*commonpb.MilvusCluster{
+				{ClusterId: "by-dev", Pchannels: []string{"by-dev-2", "by-dev-1"}},
+				{ClusterId: "test2", Pchannels: []string{"test2-2", "test2-1"}},
+			},
+		},
+	}, nil).Maybe()
+	b.EXPECT().Close().Return().Maybe()
+	balance.Register(b)
+	defer balance.ResetBalancer()
+
+	mockGetClusterChannels := mockey.Mock(channel.GetClusterChannels).Return(message.ClusterChannels{
+		Channels:       []string{"by-dev-10", "by-dev-2", "by-dev-1"},
+		ControlChannel: "by-dev-1_vcchan",
+	}).Build()
+	defer mockGetClusterChannels.UnPatch()
+
+	cli := cluster.NewMockMilvusClient(t)
+	cli.EXPECT().GetClusterChannels(mock.Anything).Return(&milvuspb.ClusterInfo{
+		ClusterId: "test2",
+		Pchannels: []string{"test2-3", "test2-1", "test2-2"},
+	}, nil)
+	cli.EXPECT().Close(mock.Anything).Return(nil)
+	oldCreateClient := createTargetClusterClient
+	createTargetClusterClient = func(ctx context.Context, c *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+		if c.GetClusterId() == "test3" {
+			return nil, errors.New("unreachable")
+		}
+		return cli, nil
+	}
+	defer func() { createTargetClusterClient = oldCreateClient }()
+
+	as := NewAssignmentService().(*assignmentServiceImpl)
+	resp, err := as.GetClusterChannels(context.Background(), &streamingpb.GetClusterChannelsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "by-dev", resp.GetClusterInfo().GetClusterId())
+	assert.Equal(t, "by-dev-1_vcchan", resp.GetClusterInfo().GetCchannel())
+	assert.Equal(t, []string{"by-dev-1", "by-dev-2", "by-dev-10"}, resp.GetClusterInfo().GetPchannels())
+
+	// the configured pchannels are not changed.
+	cfg := &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev", Pchannels: []string{"by-dev-1"}},
+		},
+	}
+	discovered, err := as.discoverReplicatePChannels(context.Background(), cfg)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(cfg, discovered))
+
+	// the empty pchannels are discovered, and the configured positions are kept.
+	cfg = &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{
+			{ClusterId: "by-dev"},
+			{ClusterId: "test2"},
+		},
+	}
+	discovered, err = as.discoverReplicatePChannels(context.Background(), cfg)
+	assert.NoError(t, err)
+	assert.Empty(t, cfg.GetClusters()[0].GetPchannels())
+	assert.Equal(t, []string{"by-dev-2", "by-dev-1", "by-dev-10"}, discovered.GetClusters()[0].GetPchannels())
+	assert.Equal(t, []string{"test2-2", "test2-1", "test2-3"}, discovered.GetClusters()[1].GetPchannels())
+
+	// the unreachable cluster can not be discovered.
+	_, err = as.discoverReplicatePChannels(context.Background(), &commonpb.ReplicateConfiguration{
+		Clusters: []*commonpb.MilvusCluster{{ClusterId: "test3"}},
+	})
+	assert.ErrorContains(t, err, "unreachable")
+}
+
+func TestDiscoverPChannelsOfWrongCluster(t *testing.T) {
+	cli := cluster.NewMockMilvusClient(t)
+	cli.EXPECT().GetClusterChannels(mock.Anything).Return(&milvuspb.ClusterInfo{ClusterId: "test3"}, nil).Once()
+	cli.EXPECT().GetClusterChannels(mock.Anything).Return(&milvuspb.ClusterInfo{ClusterId: "test2"}, nil).Once()
+	cli.EXPECT().Close(mock.Anything).Return(nil)
+	oldCreateClient := createTargetClusterClient
+	createTargetClusterClient = func(ctx context.Context, c *commonpb.MilvusCluster) (cluster.MilvusClient, error) {
+		return cli, nil
+	}
+	defer func() { createTargetClusterClient = oldCreateClient }()
+
+	as := NewAssignmentService().(*assignmentServiceImpl)
+	_, err := as.discoverPChannels(context.Background(), &commonpb.MilvusCluster{ClusterId: "test2"})
+	assert.ErrorContains(t, err, "the connected cluster is test3")
+	_, err = as.discoverPChannels(context.Background(), &commonpb.MilvusCluster{ClusterId: "test2"})
+	assert.ErrorContains(t, err, "no pchannel found")
+}
+
+func TestSortPChannels(t *testing.T) {
+	assert.Equal(t, []string{"dml_1", "dml_2", "dml_10"}, sortPChannels([]string{"dml_10", "dml_2", "dml_1"}))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, mergeDiscoveredPChannels([]string{"a", "b"}, []string{"d", "b", "c"}))
+}
+
+func TestMigratePChannelTopics(t *testing.T) {
+	paramtable.Init()
+	resource.InitForTest()
+
+	mockGetClusterChannels := mockey.Mock(channel.GetClusterChannels).Return(message.ClusterChannels{
+		Channels:       []string{"by-dev-1", "by-dev-2", "by-dev-3"},
+		ControlChannel: "by-dev-1_vcchan",
+	}).Build()
+	defer mockGetClusterChannels.UnPatch()
+
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().CheckPChannelTopicMigration(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, pchannelTopics map[string]string) error {
+		if _, ok := pchannelTopics["by-dev-3"]; ok {
+			return errors.New("invalid migration")
+		}
+		return nil
+	})
+	b.EXPECT().Close().Return().Maybe()
+	balance.ResetBalancer()
+	balance.Register(b)
+	defer balance.ResetBalancer()
+
+	broadcast.ResetBroadcaster()
+	var broadcasted message.BroadcastMutableMessage
+	mba := mock_broadcaster.NewMockBroadcastAPI(t)
+	mba.EXPECT().Broadcast(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
+		broadcasted = msg
+		return &types.BroadcastAppendResult{BroadcastID: 10}, nil
+	})
+	mba.EXPECT().Close().Return()
+	mb := mock_broadcaster.NewMockBroadcaster(t)
+	mb.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(mba, nil)
+	mb.EXPECT().Close().Return().Maybe()
+	broadcast.Register(mb)
+	defer broadcast.ResetBroadcaster()
+
+	as := NewAssignmentService()
+	_, err := as.MigratePChannelTopics(context.Background(), &streamingpb.MigratePChannelTopicsRequest{
+		PchannelTopics: map[string]string{"by-dev-3": "topic-3"},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, broadcasted)
+
+	pchannelTopics := map[string]string{"by-dev-1": "topic-1", "by-dev-2": "topic-2"}
+	resp, err := as.MigratePChannelTopics(context.Background(), &streamingpb.MigratePChannelTopicsRequest{PchannelTopics: pchannelTopics})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), resp.GetBroadcastId())
+
+	// the AlterWAL message targeting current wal is broadcasted to the migrated pchannels only.
+	assert.True(t, broadcasted.IsPChannelLevel())
+	assert.ElementsMatch(t, []string{"by-dev-1_vcchan", "by-dev-2"}, broadcasted.BroadcastHeader().VChannels)
+	header := message.MustAsBroadcastAlterWALMessageV2(broadcasted).Header()
+	assert.Equal(t, pchannelTopics, header.GetPchannelTopics())
+	assert.Equal(t, commonpb.WALName(util.MustSelectWALName()), header.GetTargetWalName())
+}
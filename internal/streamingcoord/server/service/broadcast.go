@@ -6,14 +6,19 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/txncoord"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
 // BroadcastService is the interface of the broadcast service.
@@ -59,17 +64,9 @@ func (s *broadcastServceImpl) Broadcast(ctx context.Context, req *streamingpb.Br
 	if err != nil {
 		return nil, err
 	}
-	protoResult := make(map[string]*streamingpb.ProduceMessageResponseResult, len(results.AppendResults))
-	for vchannel, result := range results.AppendResults {
-		protoResult[vchannel] = &streamingpb.ProduceMessageResponseResult{
-			Id:              result.MessageID.IntoProto(),
-			Timetick:        result.TimeTick,
-			LastConfirmedId: result.LastConfirmedMessageID.IntoProto(),
-		}
-	}
 	return &streamingpb.BroadcastResponse{
 		BroadcastId: results.BroadcastID,
-		Results:     protoResult,
+		Results:     intoProtoResults(results),
 	}, nil
 }
 
@@ -161,3 +158,98 @@ func (s *broadcastServceImpl) Ack(ctx context.Context, req *streamingpb.Broadcas
 	}
 	return &streamingpb.BroadcastAckResponse{}, nil
 }
+
+// BeginCrossVChannelTxn begins a transaction across the vchannels of a collection.
+func (s *broadcastServceImpl) BeginCrossVChannelTxn(ctx context.Context, req *streamingpb.BeginCrossVChannelTxnRequest) (*streamingpb.BeginCrossVChannelTxnResponse, error) {
+	coordinator, err := txncoord.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txn, err := coordinator.Begin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &streamingpb.BeginCrossVChannelTxnResponse{Txn: txn}, nil
+}
+
+// CommitCrossVChannelTxn commits the cross vchannel transaction.
+func (s *broadcastServceImpl) CommitCrossVChannelTxn(ctx context.Context, req *streamingpb.CommitCrossVChannelTxnRequest) (*streamingpb.CommitCrossVChannelTxnResponse, error) {
+	coordinator, err := txncoord.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := coordinator.Commit(ctx, req.GetTxnId())
+	if err != nil {
+		return nil, err
+	}
+	return &streamingpb.CommitCrossVChannelTxnResponse{
+		BroadcastId: results.BroadcastID,
+		Results:     intoProtoResults(results),
+	}, nil
+}
+
+// RollbackCrossVChannelTxn rollbacks the cross vchannel transaction.
+func (s *broadcastServceImpl) RollbackCrossVChannelTxn(ctx context.Context, req *streamingpb.RollbackCrossVChannelTxnRequest) (*streamingpb.RollbackCrossVChannelTxnResponse, error) {
+	coordinator, err := txncoord.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := coordinator.Rollback(ctx, req.GetTxnId()); err != nil {
+		return nil, err
+	}
+	return &streamingpb.RollbackCrossVChannelTxnResponse{}, nil
+}
+
+// intoProtoResults converts the append results of the broadcast into proto.
+func intoProtoResults(results *types.BroadcastAppendResult) map[string]*streamingpb.ProduceMessageResponseResult {
+	protoResult := make(map[string]*streamingpb.ProduceMessageResponseResult, len(results.AppendResults))
+	for vchannel, result := range results.AppendResults {
+		protoResult[vchannel] = &streamingpb.ProduceMessageResponseResult{
+			Id:              result.MessageID.IntoProto(),
+			Timetick:        result.TimeTick,
+			LastConfirmedId: result.LastConfirmedMessageID.IntoProto(),
+		}
+	}
+	return protoResult
+}
+
+// Watch subscribes the broadcast messages applied at control channel.
+func (s *broadcastServceImpl) Watch(req *streamingpb.BroadcastWatchRequest, server streamingpb.StreamingCoordBroadcastService_WatchServer) error {
+	b, err := broadcast.GetWithContext(server.Context())
+	if err != nil {
+		return err
+	}
+	var token *broadcaster.WatchResumeToken
+	if req.GetResumeToken() != nil {
+		token = &broadcaster.WatchResumeToken{
+			Epoch:    req.GetResumeToken().GetEpoch(),
+			Sequence: req.GetResumeToken().GetSequence(),
+		}
+	}
+	messageTypes := typeutil.NewSet(req.GetMessageTypes()...)
+	return b.Watch(server.Context(), token, func(event broadcaster.WatchEvent) error {
+		resumeToken := &streamingpb.BroadcastWatchResumeToken{
+			Epoch:    event.ResumeToken.Epoch,
+			Sequence: event.ResumeToken.Sequence,
+		}
+		if event.Resync {
+			return server.Send(&streamingpb.BroadcastWatchResponse{
+				Response: &streamingpb.BroadcastWatchResponse_Resync{
+					Resync: &streamingpb.BroadcastWatchResync{ResumeToken: resumeToken},
+				},
+			})
+		}
+		if messageTypes.Len() > 0 && !messageTypes.Contain(messagespb.MessageType(event.Message.MessageType())) {
+			return nil
+		}
+		return server.Send(&streamingpb.BroadcastWatchResponse{
+			Response: &streamingpb.BroadcastWatchResponse_Event{
+				Event: &streamingpb.BroadcastWatchEvent{
+					ResumeToken: resumeToken,
+					BroadcastId: event.BroadcastID,
+					Message:     event.Message.IntoMessageProto(),
+				},
+			},
+		})
+	})
+}
@@ -21,7 +21,9 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
 	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v3/mocks/proto/mock_streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	streamtypes "github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -222,3 +224,53 @@ func TestBroadcastService_ForwardImportToDataCoord_Error(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Contains(t, err.Error(), "test error")
 }
+
+func TestBroadcastServiceWatch(t *testing.T) {
+	broadcast.ResetBroadcaster()
+	mb := mock_broadcaster.NewMockBroadcaster(t)
+	broadcast.Register(mb)
+
+	msg := message.NewCreateCollectionMessageBuilderV1().
+		WithHeader(&message.CreateCollectionMessageHeader{}).
+		WithBody(&msgpb.CreateCollectionRequest{}).
+		WithBroadcast([]string{"v1"}).
+		MustBuildBroadcast()
+	mb.EXPECT().Watch(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, token *broadcaster.WatchResumeToken, cb broadcaster.WatchCallback) error {
+			assert.Equal(t, int64(1), token.Epoch)
+			assert.Equal(t, uint64(1), token.Sequence)
+			if err := cb(broadcaster.WatchEvent{ResumeToken: broadcaster.WatchResumeToken{Epoch: 2, Sequence: 1}, Resync: true}); err != nil {
+				return err
+			}
+			if err := cb(broadcaster.WatchEvent{ResumeToken: broadcaster.WatchResumeToken{Epoch: 2, Sequence: 2}, BroadcastID: 1, Message: msg}); err != nil {
+				return err
+			}
+			return ctx.Err()
+		})
+
+	var responses []*streamingpb.BroadcastWatchResponse
+	server := mock_streamingpb.NewMockStreamingCoordBroadcastService_WatchServer(t)
+	server.EXPECT().Context().Return(context.Background())
+	server.EXPECT().Send(mock.Anything).RunAndReturn(func(resp *streamingpb.BroadcastWatchResponse) error {
+		responses = append(responses, resp)
+		return nil
+	})
+
+	service := NewBroadcastService()
+	err := service.Watch(&streamingpb.BroadcastWatchRequest{
+		ResumeToken:  &streamingpb.BroadcastWatchResumeToken{Epoch: 1, Sequence: 1},
+		MessageTypes: []messagespb.MessageType{messagespb.MessageType_DropCollection},
+	}, server)
+	assert.NoError(t, err)
+	// the create collection event is filtered out.
+	assert.Len(t, responses, 1)
+	assert.Equal(t, uint64(1), responses[0].GetResync().GetResumeToken().GetSequence())
+
+	responses = nil
+	err = service.Watch(&streamingpb.BroadcastWatchRequest{
+		ResumeToken: &streamingpb.BroadcastWatchResumeToken{Epoch: 1, Sequence: 1},
+	}, server)
+	assert.NoError(t, err)
+	assert.Len(t, responses, 2)
+	assert.Equal(t, uint64(1), responses[1].GetEvent().GetBroadcastId())
+}
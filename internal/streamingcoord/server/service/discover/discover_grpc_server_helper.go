@@ -31,6 +31,16 @@ func (h *discoverGrpcServerHelper) SendFullAssignment(param balancer.WatchChanne
 		assignmentsMap[relation.Node.ServerID].Channels = append(
 			assignmentsMap[relation.Node.ServerID].Channels, types.NewProtoFromPChannelInfo(relation.Channel))
 	}
+	for _, replica := range param.ReadReplicas {
+		if assignmentsMap[replica.Node.ServerID] == nil {
+			assignmentsMap[replica.Node.ServerID] = &streamingpb.StreamingNodeAssignment{
+				Node:     types.NewProtoFromStreamingNodeInfo(replica.Node),
+				Channels: make([]*streamingpb.PChannelInfo, 0),
+			}
+		}
+		assignmentsMap[replica.Node.ServerID].ReadReplicas = append(
+			assignmentsMap[replica.Node.ServerID].ReadReplicas, types.NewProtoFromPChannelInfo(replica.Channel))
+	}
 	for _, node := range nodes {
 		if assignmentsMap[node.ServerID] == nil {
 			// if current streaming node is not assigned to any channel, add it to the assignments with empty assignments.
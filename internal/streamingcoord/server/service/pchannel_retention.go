@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+)
+
+// UpdatePChannelRetentionPolicies is used to set or clear the retention policies of the wal of pchannels.
+func (s *assignmentServiceImpl) UpdatePChannelRetentionPolicies(ctx context.Context, req *streamingpb.UpdatePChannelRetentionPoliciesRequest) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return balancer.UpdatePChannelRetentionPolicies(ctx, req)
+}
+
+// GetPChannelRetentions gets the retention of the wal of pchannels,
+// the replicate checkpoints are fetched from the target clusters of the replicating tasks from the pchannels.
+func (s *assignmentServiceImpl) GetPChannelRetentions(ctx context.Context, req *streamingpb.GetPChannelRetentionsRequest) (*streamingpb.GetPChannelRetentionsResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := balancer.GetPChannelRetentionPolicies(ctx, req.GetPchannels())
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := resource.Resource().StreamingCatalog().ListReplicatePChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retentions := make([]*streamingpb.PChannelRetention, 0, len(req.GetPchannels()))
+	retentionsByPChannel := make(map[string]*streamingpb.PChannelRetention, len(req.GetPchannels()))
+	for _, pchannel := range req.GetPchannels() {
+		retention := &streamingpb.PChannelRetention{
+			Pchannel: pchannel,
+			Policy:   policies[pchannel],
+		}
+		retentions = append(retentions, retention)
+		retentionsByPChannel[pchannel] = retention
+	}
+
+	clients := newTargetClusterClients()
+	defer clients.Close(ctx)
+	for _, task := range tasks {
+		retention, ok := retentionsByPChannel[task.GetSourceChannelName()]
+		if !ok || retention.GetReplicateCheckpointUnknown() {
+			continue
+		}
+		checkpoint, known := clients.GetReplicateCheckpoint(ctx, task)
+		if !known {
+			retention.ReplicateCheckpointUnknown = true
+			retention.ReplicateCheckpoints = nil
+			retention.ReplicateCheckpointTimeTick = 0
+			continue
+		}
+		if checkpoint.GetMessageId() != nil {
+			retention.ReplicateCheckpoints = append(retention.ReplicateCheckpoints, checkpoint.GetMessageId())
+		}
+		if tt := checkpoint.GetTimeTick(); tt != 0 && (retention.ReplicateCheckpointTimeTick == 0 || tt < retention.ReplicateCheckpointTimeTick) {
+			retention.ReplicateCheckpointTimeTick = tt
+		}
+	}
+	return &streamingpb.GetPChannelRetentionsResponse{Retentions: retentions}, nil
+}
+
+// GetReplicateCheckpoint returns the source checkpoint that the replication of the task will be resumed from.
+// Return false if the position is unknown, and return nil if the task doesn't need any message of the source wal.
+func (c *targetClusterClients) GetReplicateCheckpoint(ctx context.Context, task *streamingpb.ReplicatePChannelMeta) (*commonpb.ReplicateCheckpoint, bool) {
+	switch task.GetCheckpointResetPolicy() {
+	case streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST:
+		// the task is replicated from the earliest message of the source wal.
+		return nil, false
+	case streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT:
+		// the task is always replicated from the checkpoint reset by the operator.
+		return task.GetInitializedCheckpoint(), task.GetInitializedCheckpoint().GetMessageId() != nil
+	}
+
+	progress := c.GetProgress(ctx, task)
+	switch progress.GetRuntimeState() {
+	case streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_REPLICATING:
+		return progress.GetCheckpoint(), true
+	case streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PENDING:
+		if task.GetCheckpointResetPolicy() == streamingpb.ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_LATEST {
+			// the task is replicated from the latest message of the source wal.
+			return nil, true
+		}
+		return task.GetInitializedCheckpoint(), task.GetInitializedCheckpoint().GetMessageId() != nil
+	default:
+		// the target cluster is unreachable or the checkpoint conflicts with the task.
+		return nil, false
+	}
+}
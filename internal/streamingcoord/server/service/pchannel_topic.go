@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/util"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+// MigratePChannelTopics is used to migrate the wal of pchannels to other topics of mq.
+// The exclusive cluster resource key is held during the migration, so no DDL/DCL is in-flight.
+// An AlterWAL message targeting current wal is broadcasted to the pchannels to fence the wal,
+// the streamingnode flushes all data of the fenced wal, then advances the checkpoints to the earliest message of the new topic.
+// The topics in pchannel meta are updated by the ack callback of the AlterWAL message.
+func (s *assignmentServiceImpl) MigratePChannelTopics(ctx context.Context, req *streamingpb.MigratePChannelTopicsRequest) (*streamingpb.MigratePChannelTopicsResponse, error) {
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	broadcaster, err := broadcast.StartBroadcastWithResourceKeys(ctx, message.NewExclusiveClusterResourceKey())
+	if err != nil {
+		return nil, err
+	}
+	defer broadcaster.Close()
+
+	if err := balancer.CheckPChannelTopicMigration(ctx, req.GetPchannelTopics()); err != nil {
+		return nil, err
+	}
+	currentWALName := util.MustSelectWALName()
+	msg := message.NewAlterWALMessageBuilderV2().
+		WithHeader(&message.AlterWALMessageHeader{
+			TargetWalName:  commonpb.WALName(currentWALName),
+			PchannelTopics: req.GetPchannelTopics(),
+		}).
+		WithBody(&message.AlterWALMessageBody{}).
+		WithPChannelLevelBroadcast(channel.GetClusterChannels(), lo.Keys(req.GetPchannelTopics())).
+		MustBuildBroadcast()
+	result, err := broadcaster.Broadcast(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	mlog.Info(ctx, "pchannels are fenced for topic migration",
+		mlog.Any("pchannelTopics", req.GetPchannelTopics()),
+		mlog.Stringer("walName", currentWALName),
+		mlog.Uint64("broadcastID", result.BroadcastID))
+	return &streamingpb.MigratePChannelTopicsResponse{BroadcastId: result.BroadcastID}, nil
+}
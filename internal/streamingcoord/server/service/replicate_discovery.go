@@ -0,0 +1,130 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/channel"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// discoverPChannelsTimeout is the timeout of discovering the pchannels of a cluster.
+const discoverPChannelsTimeout = 30 * time.Second
+
+// GetClusterChannels gets the control channel and all pchannels of current cluster.
+func (s *assignmentServiceImpl) GetClusterChannels(ctx context.Context, req *streamingpb.GetClusterChannelsRequest) (*streamingpb.GetClusterChannelsResponse, error) {
+	if _, err := balance.GetWithContext(ctx); err != nil {
+		return nil, err
+	}
+	cc := channel.GetClusterChannels(channel.OptIncludeUnavailableInReplication())
+	return &streamingpb.GetClusterChannelsResponse{
+		ClusterInfo: &milvuspb.ClusterInfo{
+			ClusterId: paramtable.Get().CommonCfg.ClusterPrefix.GetValue(),
+			Cchannel:  cc.ControlChannel,
+			Pchannels: sortPChannels(cc.Channels),
+		},
+	}, nil
+}
+
+// discoverReplicatePChannels fills the empty pchannels of the clusters in the replicate configuration with the pchannels discovered from the clusters.
+// The pchannels in current configuration keep their positions and the new ones are appended,
+// so the configuration is refreshed into a pchannel-increasing one after the clusters scale out.
+func (s *assignmentServiceImpl) discoverReplicatePChannels(ctx context.Context, config *commonpb.ReplicateConfiguration) (*commonpb.ReplicateConfiguration, error) {
+	needDiscover := slices.ContainsFunc(config.GetClusters(), func(c *commonpb.MilvusCluster) bool {
+		return c != nil && len(c.GetPchannels()) == 0
+	})
+	if !needDiscover {
+		return config, nil
+	}
+	balancer, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latestAssignment, err := balancer.GetLatestChannelAssignment()
+	if err != nil {
+		return nil, err
+	}
+	configuredPChannels := make(map[string][]string)
+	for _, c := range latestAssignment.ReplicateConfiguration.GetClusters() {
+		configuredPChannels[c.GetClusterId()] = c.GetPchannels()
+	}
+
+	config = proto.Clone(config).(*commonpb.ReplicateConfiguration)
+	for _, c := range config.GetClusters() {
+		if c == nil || len(c.GetPchannels()) > 0 {
+			continue
+		}
+		discovered, err := s.discoverPChannels(ctx, c)
+		if err != nil {
+			return nil, status.NewInvalidArgument("fail to discover the pchannels of cluster %s, %s", c.GetClusterId(), err.Error())
+		}
+		c.Pchannels = mergeDiscoveredPChannels(configuredPChannels[c.GetClusterId()], discovered)
+		mlog.Info(ctx, "pchannels of cluster discovered",
+			mlog.String("clusterID", c.GetClusterId()),
+			mlog.Strings("pchannels", c.GetPchannels()))
+	}
+	return config, nil
+}
+
+// discoverPChannels gets the pchannels of the cluster, the pchannels of current cluster are got from the channel manager directly.
+func (s *assignmentServiceImpl) discoverPChannels(ctx context.Context, c *commonpb.MilvusCluster) ([]string, error) {
+	if c.GetClusterId() == paramtable.Get().CommonCfg.ClusterPrefix.GetValue() {
+		return channel.GetClusterChannels(channel.OptIncludeUnavailableInReplication()).Channels, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, discoverPChannelsTimeout)
+	defer cancel()
+	cli, err := createTargetClusterClient(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close(ctx)
+
+	info, err := cli.GetClusterChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// protect the configuration from the connection param pointing to another cluster.
+	if info.GetClusterId() != c.GetClusterId() {
+		return nil, status.NewInvalidArgument("the connected cluster is %s", info.GetClusterId())
+	}
+	if len(info.GetPchannels()) == 0 {
+		return nil, status.NewInvalidArgument("no pchannel found")
+	}
+	return info.GetPchannels(), nil
+}
+
+// mergeDiscoveredPChannels appends the discovered pchannels that are not configured to the configured ones.
+func mergeDiscoveredPChannels(configured []string, discovered []string) []string {
+	merged := slices.Clone(configured)
+	existing := typeutil.NewSet(configured...)
+	for _, pchannel := range sortPChannels(discovered) {
+		if !existing.Contain(pchannel) {
+			merged = append(merged, pchannel)
+		}
+	}
+	return merged
+}
+
+// sortPChannels sorts the pchannels by the index of the channel name, e.g. dml_2 is before dml_10.
+func sortPChannels(pchannels []string) []string {
+	sorted := slices.Clone(pchannels)
+	slices.SortFunc(sorted, func(a, b string) int {
+		if len(a) != len(b) {
+			return cmp.Compare(len(a), len(b))
+		}
+		return strings.Compare(a, b)
+	})
+	return sorted
+}
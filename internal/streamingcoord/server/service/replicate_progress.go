@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc/cluster"
+	"github.com/milvus-io/milvus/internal/cdc/util"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+// newTargetClusterClients creates a new cache of the target cluster clients.
+func newTargetClusterClients() *targetClusterClients {
+	return &targetClusterClients{
+		clients: make(map[string]cluster.MilvusClient),
+		errs:    make(map[string]error),
+	}
+}
+
+// targetClusterClients caches the clients of the target clusters,
+// so the target cluster is connected only once when listing the progress of its replicating tasks.
+type targetClusterClients struct {
+	clients map[string]cluster.MilvusClient
+	errs    map[string]error
+}
+
+// GetProgress fetches the checkpoint of the replicating task from the target cluster and returns the progress of the task.
+func (c *targetClusterClients) GetProgress(ctx context.Context, task *streamingpb.ReplicatePChannelMeta) *streamingpb.ReplicatingTaskProgress {
+	progress := &streamingpb.ReplicatingTaskProgress{
+		Task:         task,
+		RuntimeState: streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_UNKNOWN,
+	}
+	cli, err := c.getClient(ctx, task)
+	if err != nil {
+		progress.Error = err.Error()
+		return progress
+	}
+	sourceClusterID := paramtable.Get().CommonCfg.ClusterPrefix.GetValue()
+	resp, err := cli.GetReplicateInfo(ctx, &milvuspb.GetReplicateInfoRequest{
+		SourceClusterId: sourceClusterID,
+		TargetPchannel:  task.GetTargetChannelName(),
+	})
+	if err != nil {
+		progress.Error = err.Error()
+		return progress
+	}
+	checkpoint := resp.GetCheckpoint()
+	if checkpoint.GetMessageId() == nil {
+		// the target cluster has not received any replicated message of the task yet.
+		progress.RuntimeState = streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PENDING
+		return progress
+	}
+	progress.Checkpoint = checkpoint
+	if err := util.CheckReplicateCheckpointConflict(task, sourceClusterID, checkpoint); err != nil {
+		// the cdc doesn't replicate from the conflicting checkpoint, so there's no lag to report.
+		progress.RuntimeState = streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_CONFLICT
+		progress.Error = err.Error()
+		return progress
+	}
+	progress.RuntimeState = streamingpb.ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_REPLICATING
+	if lag := time.Since(tsoutil.PhysicalTime(checkpoint.GetTimeTick())); lag > 0 {
+		progress.LagMilliseconds = lag.Milliseconds()
+	}
+	return progress
+}
+
+// getClient returns the client of the target cluster of the task, the failure of creating client is cached too.
+func (c *targetClusterClients) getClient(ctx context.Context, task *streamingpb.ReplicatePChannelMeta) (cluster.MilvusClient, error) {
+	clusterID := task.GetTargetCluster().GetClusterId()
+	if cli, ok := c.clients[clusterID]; ok {
+		return cli, nil
+	}
+	if err, ok := c.errs[clusterID]; ok {
+		return nil, err
+	}
+	cli, err := createTargetClusterClient(ctx, task.GetTargetCluster())
+	if err != nil {
+		c.errs[clusterID] = err
+		return nil, err
+	}
+	c.clients[clusterID] = cli
+	return cli, nil
+}
+
+// Close closes all the clients.
+func (c *targetClusterClients) Close(ctx context.Context) {
+	for _, cli := range c.clients {
+		cli.Close(ctx)
+	}
+}
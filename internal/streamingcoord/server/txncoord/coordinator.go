@@ -0,0 +1,347 @@
+package txncoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+// backgroundInterval is the interval to rollback the expired transactions and finish the recovered ones.
+var backgroundInterval = 10 * time.Second
+
+// RecoverCoordinator recovers the cross vchannel transactions from the catalog,
+// and starts a background task to finish the decided transactions and rollback the expired ones.
+func RecoverCoordinator(ctx context.Context) (*Coordinator, error) {
+	txns, err := resource.Resource().StreamingCatalog().ListCrossVChannelTxn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := &Coordinator{
+		notifier:  syncutil.NewAsyncTaskNotifier[struct{}](),
+		mu:        sync.Mutex{},
+		txns:      make(map[int64]*streamingpb.CrossVChannelTxnMeta, len(txns)),
+		finishing: typeutil.NewSet[int64](),
+	}
+	for _, txn := range txns {
+		c.txns[txn.GetTxnId()] = txn
+	}
+	c.SetLogger(resource.Resource().Logger().With(mlog.FieldComponent("cross-vchannel-txn")))
+	c.Logger().Info(ctx, "cross vchannel txn coordinator recovered", mlog.Int("txnCount", len(txns)))
+	go c.background()
+	return c, nil
+}
+
+// Coordinator coordinates the transactions across the vchannels of a collection with a two-phase protocol.
+// The transaction is begun at every vchannel with the txn id allocated by the coordinator,
+// and the pieces never expire at the wal until the decision of the coordinator is broadcast to all vchannels.
+// The decision is persisted before broadcasting, so it's never changed and will be broadcast again after restart.
+type Coordinator struct {
+	mlog.Binder
+
+	notifier  *syncutil.AsyncTaskNotifier[struct{}]
+	mu        sync.Mutex
+	txns      map[int64]*streamingpb.CrossVChannelTxnMeta
+	finishing typeutil.Set[int64] // the transactions whose decision is being broadcast.
+}
+
+// Begin begins a transaction across the vchannels of the collection.
+func (c *Coordinator) Begin(ctx context.Context, req *streamingpb.BeginCrossVChannelTxnRequest) (*streamingpb.CrossVChannelTxnMeta, error) {
+	if err := checkNotReplicating(ctx); err != nil {
+		return nil, err
+	}
+	keepalive := time.Duration(req.GetKeepaliveMilliseconds()) * time.Millisecond
+	if keepalive == 0 {
+		keepalive = paramtable.Get().StreamingCfg.TxnDefaultKeepaliveTimeout.GetAsDurationByParse()
+	}
+	if keepalive < 1*time.Millisecond {
+		return nil, status.NewInvalidArgument("keepalive must be greater than 1ms")
+	}
+	collection, err := describeCollection(ctx, req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVChannels(collection, req.GetVchannels()); err != nil {
+		return nil, err
+	}
+
+	txnID, err := resource.Resource().IDAllocator().Allocate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vchannelTxnIDs := make(map[string]int64, len(req.GetVchannels()))
+	for _, vchannel := range req.GetVchannels() {
+		id, err := resource.Resource().IDAllocator().Allocate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		vchannelTxnIDs[vchannel] = int64(id)
+	}
+	// The deadline should be computed from a fresh timestamp, the cached one may be far behind the wall clock.
+	resource.Resource().TSOAllocator().SyncIfExpired(50 * time.Millisecond)
+	now, err := resource.Resource().TSOAllocator().Allocate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txn := &streamingpb.CrossVChannelTxnMeta{
+		TxnId:          int64(txnID),
+		CollectionId:   req.GetCollectionId(),
+		VchannelTxnIds: vchannelTxnIDs,
+		State:          streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_PREPARING,
+		Deadline:       tsoutil.AddPhysicalDurationOnTs(now, keepalive),
+		DbName:         collection.GetDbName(),
+		CollectionName: collection.GetCollectionName(),
+	}
+	if err := resource.Resource().StreamingCatalog().SaveCrossVChannelTxn(ctx, txn); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.txns[txn.GetTxnId()] = txn
+	c.mu.Unlock()
+	c.Logger().Info(ctx, "cross vchannel txn begun",
+		mlog.Int64("txnID", txn.GetTxnId()),
+		mlog.FieldCollectionID(txn.GetCollectionId()),
+		mlog.Any("vchannelTxnIDs", txn.GetVchannelTxnIds()),
+		mlog.Uint64("deadline", txn.GetDeadline()))
+	return proto.Clone(txn).(*streamingpb.CrossVChannelTxnMeta), nil
+}
+
+// Commit commits the transaction by broadcasting the commit message to all its vchannels.
+func (c *Coordinator) Commit(ctx context.Context, txnID int64) (*types.BroadcastAppendResult, error) {
+	txn, err := c.decide(ctx, txnID, streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_COMMITTING)
+	if err != nil {
+		return nil, err
+	}
+	return c.finish(ctx, txn)
+}
+
+// Rollback rollbacks the transaction by broadcasting the rollback message to all its vchannels.
+func (c *Coordinator) Rollback(ctx context.Context, txnID int64) error {
+	txn, err := c.decide(ctx, txnID, streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_ROLLINGBACK)
+	if err != nil {
+		return err
+	}
+	_, err = c.finish(ctx, txn)
+	return err
+}
+
+// Close closes the coordinator.
+func (c *Coordinator) Close() {
+	c.notifier.Cancel()
+	c.notifier.BlockUntilFinish()
+}
+
+// decide persists the decision of the preparing transaction.
+// The repeated decision is allowed to broadcast the decided message again, but the decision can never be changed.
+func (c *Coordinator) decide(ctx context.Context, txnID int64, state streamingpb.CrossVChannelTxnState) (*streamingpb.CrossVChannelTxnMeta, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	txn, ok := c.txns[txnID]
+	if !ok {
+		return nil, status.NewTransactionExpired("cross vchannel txn %d is not found, it may be already done", txnID)
+	}
+	if txn.GetState() == state {
+		return txn, nil
+	}
+	if txn.GetState() != streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_PREPARING {
+		if txn.GetState() == streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_ROLLINGBACK {
+			return nil, status.NewTransactionExpired("cross vchannel txn %d is rollbacked", txnID)
+		}
+		return nil, status.NewInvalidArgument("cross vchannel txn %d is %s, cannot be %s", txnID, txn.GetState(), state)
+	}
+	newTxn := proto.Clone(txn).(*streamingpb.CrossVChannelTxnMeta)
+	newTxn.State = state
+	if err := resource.Resource().StreamingCatalog().SaveCrossVChannelTxn(ctx, newTxn); err != nil {
+		return nil, err
+	}
+	c.txns[txnID] = newTxn
+	return newTxn, nil
+}
+
+// finish broadcasts the decided commit or rollback message to all vchannels of the transaction, then marks the transaction done.
+func (c *Coordinator) finish(ctx context.Context, txn *streamingpb.CrossVChannelTxnMeta) (*types.BroadcastAppendResult, error) {
+	c.mu.Lock()
+	if c.finishing.Contain(txn.GetTxnId()) {
+		c.mu.Unlock()
+		return nil, status.NewResourceAcquired("cross vchannel txn %d is being finished", txn.GetTxnId())
+	}
+	c.finishing.Insert(txn.GetTxnId())
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.finishing.Remove(txn.GetTxnId())
+		c.mu.Unlock()
+	}()
+
+	api, err := broadcast.StartBroadcastWithResourceKeys(ctx, message.NewSharedCollectionNameResourceKey(txn.GetDbName(), txn.GetCollectionName()))
+	if err != nil {
+		return nil, err
+	}
+	defer api.Close()
+
+	// The barrier makes the message placed after all begin messages before the deadline at every vchannel,
+	// so no begin message of the transaction can be accepted by the wal after it.
+	vchannels := lo.Keys(txn.GetVchannelTxnIds())
+	var msg message.BroadcastMutableMessage
+	if txn.GetState() == streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_COMMITTING {
+		msg = message.NewCommitTxnMessageBuilderV2().
+			WithHeader(&message.CommitTxnMessageHeader{VchannelTxnIds: txn.GetVchannelTxnIds()}).
+			WithBody(&message.CommitTxnMessageBody{}).
+			WithBroadcast(vchannels, message.OptBuildBroadcastBarrier()).
+			MustBuildBroadcast()
+	} else {
+		msg = message.NewRollbackTxnMessageBuilderV2().
+			WithHeader(&message.RollbackTxnMessageHeader{VchannelTxnIds: txn.GetVchannelTxnIds()}).
+			WithBody(&message.RollbackTxnMessageBody{}).
+			WithBroadcast(vchannels, message.OptBuildBroadcastBarrier()).
+			MustBuildBroadcast()
+	}
+	result, err := api.Broadcast(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	doneTxn := proto.Clone(txn).(*streamingpb.CrossVChannelTxnMeta)
+	doneTxn.State = streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_DONE
+	if err := resource.Resource().StreamingCatalog().SaveCrossVChannelTxn(ctx, doneTxn); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	delete(c.txns, txn.GetTxnId())
+	c.mu.Unlock()
+	c.Logger().Info(ctx, "cross vchannel txn done",
+		mlog.Int64("txnID", txn.GetTxnId()),
+		mlog.String("decision", txn.GetState().String()),
+		mlog.Uint64("broadcastID", result.BroadcastID))
+	return result, nil
+}
+
+// background finishes the decided transactions and rollbacks the expired ones periodically.
+func (c *Coordinator) background() {
+	defer c.notifier.Finish(struct{}{})
+
+	ticker := time.NewTicker(backgroundInterval)
+	defer ticker.Stop()
+	for {
+		c.finishPendingTxns(c.notifier.Context())
+		select {
+		case <-c.notifier.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// finishPendingTxns finishes the decided transactions that are not being finished, and rollbacks the preparing transactions after the deadline.
+func (c *Coordinator) finishPendingTxns(ctx context.Context) {
+	c.mu.Lock()
+	txns := make([]*streamingpb.CrossVChannelTxnMeta, 0, len(c.txns))
+	for _, txn := range c.txns {
+		if !c.finishing.Contain(txn.GetTxnId()) {
+			txns = append(txns, txn)
+		}
+	}
+	c.mu.Unlock()
+	if len(txns) == 0 {
+		return
+	}
+
+	resource.Resource().TSOAllocator().SyncIfExpired(50 * time.Millisecond)
+	now, err := resource.Resource().TSOAllocator().Allocate(ctx)
+	if err != nil {
+		c.Logger().Warn(ctx, "failed to allocate timestamp to check the expired cross vchannel txn", mlog.Err(err))
+		return
+	}
+	for _, txn := range txns {
+		var err error
+		switch txn.GetState() {
+		case streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_PREPARING:
+			if now < txn.GetDeadline() {
+				continue
+			}
+			c.Logger().Info(ctx, "cross vchannel txn expired, rollback it", mlog.Int64("txnID", txn.GetTxnId()), mlog.Uint64("deadline", txn.GetDeadline()))
+			err = c.Rollback(ctx, txn.GetTxnId())
+		default:
+			_, err = c.finish(ctx, txn)
+		}
+		if err != nil && ctx.Err() == nil {
+			c.Logger().Warn(ctx, "failed to finish cross vchannel txn", mlog.Int64("txnID", txn.GetTxnId()), mlog.Err(err))
+		}
+	}
+}
+
+// checkNotReplicating checks the cluster is not replicating.
+// The replicated transaction is never rollbacked at the secondary cluster, so the cross vchannel transaction is not supported when replicating.
+func checkNotReplicating(ctx context.Context) error {
+	b, err := balance.GetWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	assignment, err := b.GetLatestChannelAssignment()
+	if err != nil {
+		return err
+	}
+	if assignment != nil && isReplicatingCluster(assignment.ReplicateConfiguration) {
+		return status.NewInvalidArgument("cross vchannel txn in replicating cluster is not supported yet")
+	}
+	return nil
+}
+
+// isReplicatingCluster returns whether the cluster is replicating with other clusters.
+func isReplicatingCluster(cfg *commonpb.ReplicateConfiguration) bool {
+	return cfg != nil && (len(cfg.GetCrossClusterTopology()) > 0 || len(cfg.GetClusters()) > 1)
+}
+
+// describeCollection describes the collection by id from mixcoord.
+func describeCollection(ctx context.Context, collectionID int64) (*milvuspb.DescribeCollectionResponse, error) {
+	mixCoordClient, err := resource.Resource().MixCoordClient().GetWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := mixCoordClient.DescribeCollectionInternal(ctx, &milvuspb.DescribeCollectionRequest{
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// checkVChannels checks the vchannels are not empty, not repeated and belong to the collection.
+func checkVChannels(collection *milvuspb.DescribeCollectionResponse, vchannels []string) error {
+	if len(vchannels) == 0 {
+		return status.NewInvalidArgument("cross vchannel txn should span at least one vchannel")
+	}
+	collectionVChannels := typeutil.NewSet(collection.GetVirtualChannelNames()...)
+	seen := typeutil.NewSet[string]()
+	for _, vchannel := range vchannels {
+		if !collectionVChannels.Contain(vchannel) {
+			return status.NewInvalidArgument("vchannel %s doesn't belong to collection %d", vchannel, collection.GetCollectionID())
+		}
+		if seen.Contain(vchannel) {
+			return status.NewInvalidArgument("vchannel %s is repeated", vchannel)
+		}
+		seen.Insert(vchannel)
+	}
+	return nil
+}
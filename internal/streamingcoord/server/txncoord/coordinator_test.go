@@ -0,0 +1,197 @@
+package txncoord
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_balancer"
+	"github.com/milvus-io/milvus/internal/mocks/streamingcoord/server/mock_broadcaster"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/balancer/balance"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/broadcaster/broadcast"
+	"github.com/milvus-io/milvus/internal/streamingcoord/server/resource"
+	internaltypes "github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/idalloc"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	m.Run()
+}
+
+func TestCoordinator(t *testing.T) {
+	backgroundInterval = 10 * time.Millisecond
+	replicating := atomic.NewBool(false)
+	catalogTxns, broadcasted := initForTest(t, replicating)
+
+	// the decided txn is finished after recovery.
+	catalogTxns.Store(int64(1000), &streamingpb.CrossVChannelTxnMeta{
+		TxnId:          1000,
+		CollectionId:   100,
+		VchannelTxnIds: map[string]int64{"v1": 2, "v2": 3},
+		State:          streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_COMMITTING,
+	})
+	c, err := RecoverCoordinator(context.Background())
+	assert.NoError(t, err)
+	defer c.Close()
+	assert.Eventually(t, func() bool {
+		_, ok := catalogTxns.Load(int64(1000))
+		return !ok
+	}, 10*time.Second, 10*time.Millisecond)
+	msg := <-broadcasted
+	assert.Equal(t, message.MessageTypeCommitTxn, msg.MessageType())
+	assert.True(t, msg.BroadcastHeader().Barrier)
+	assert.ElementsMatch(t, []string{"v1", "v2"}, msg.BroadcastHeader().VChannels)
+	assert.Equal(t, map[string]int64{"v1": 2, "v2": 3}, message.MustAsBroadcastCommitTxnMessageV2(msg).Header().GetVchannelTxnIds())
+
+	// invalid vchannels.
+	for _, vchannels := range [][]string{{}, {"v1", "v4"}, {"v1", "v1"}} {
+		_, err = c.Begin(context.Background(), &streamingpb.BeginCrossVChannelTxnRequest{CollectionId: 100, Vchannels: vchannels})
+		assert.Error(t, err)
+	}
+	// the cross vchannel txn is not supported in replicating cluster.
+	replicating.Store(true)
+	_, err = c.Begin(context.Background(), &streamingpb.BeginCrossVChannelTxnRequest{CollectionId: 100, Vchannels: []string{"v1", "v2"}})
+	assert.Error(t, err)
+	replicating.Store(false)
+
+	// commit.
+	txn, err := c.Begin(context.Background(), &streamingpb.BeginCrossVChannelTxnRequest{
+		CollectionId:          100,
+		Vchannels:             []string{"v1", "v3"},
+		KeepaliveMilliseconds: time.Hour.Milliseconds(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_PREPARING, txn.GetState())
+	assert.Len(t, txn.GetVchannelTxnIds(), 2)
+	assert.NotEqual(t, txn.GetVchannelTxnIds()["v1"], txn.GetVchannelTxnIds()["v3"])
+	assert.Equal(t, "db", txn.GetDbName())
+	assert.Equal(t, "coll", txn.GetCollectionName())
+	_, ok := catalogTxns.Load(txn.GetTxnId())
+	assert.True(t, ok)
+
+	result, err := c.Commit(context.Background(), txn.GetTxnId())
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	msg = <-broadcasted
+	assert.Equal(t, message.MessageTypeCommitTxn, msg.MessageType())
+	assert.Equal(t, txn.GetVchannelTxnIds(), message.MustAsBroadcastCommitTxnMessageV2(msg).Header().GetVchannelTxnIds())
+	_, ok = catalogTxns.Load(txn.GetTxnId())
+	assert.False(t, ok)
+
+	// the done txn cannot be decided again.
+	err = c.Rollback(context.Background(), txn.GetTxnId())
+	assert.True(t, status.AsStreamingError(err).IsTxnExpired())
+
+	// rollback.
+	txn, err = c.Begin(context.Background(), &streamingpb.BeginCrossVChannelTxnRequest{
+		CollectionId:          100,
+		Vchannels:             []string{"v2"},
+		KeepaliveMilliseconds: time.Hour.Milliseconds(),
+	})
+	assert.NoError(t, err)
+	err = c.Rollback(context.Background(), txn.GetTxnId())
+	assert.NoError(t, err)
+	msg = <-broadcasted
+	assert.Equal(t, message.MessageTypeRollbackTxn, msg.MessageType())
+	assert.Equal(t, txn.GetVchannelTxnIds(), message.MustAsBroadcastRollbackTxnMessageV2(msg).Header().GetVchannelTxnIds())
+	_, err = c.Commit(context.Background(), txn.GetTxnId())
+	assert.Error(t, err)
+
+	// the expired txn is rollbacked by the background task.
+	txn, err = c.Begin(context.Background(), &streamingpb.BeginCrossVChannelTxnRequest{
+		CollectionId:          100,
+		Vchannels:             []string{"v1", "v2", "v3"},
+		KeepaliveMilliseconds: 1,
+	})
+	assert.NoError(t, err)
+	msg = <-broadcasted
+	assert.Equal(t, message.MessageTypeRollbackTxn, msg.MessageType())
+	assert.Len(t, msg.BroadcastHeader().VChannels, 3)
+	assert.Eventually(t, func() bool {
+		_, ok := catalogTxns.Load(txn.GetTxnId())
+		return !ok
+	}, 10*time.Second, 10*time.Millisecond)
+	_, err = c.Commit(context.Background(), txn.GetTxnId())
+	assert.True(t, status.AsStreamingError(err).IsTxnExpired())
+}
+
+func initForTest(t *testing.T, replicating *atomic.Bool) (*sync.Map, chan message.BroadcastMutableMessage) {
+	balance.ResetBalancer()
+	broadcast.ResetBroadcaster()
+
+	b := mock_balancer.NewMockBalancer(t)
+	b.EXPECT().WaitUntilWALbasedDDLReady(mock.Anything).Return(nil).Maybe()
+	b.EXPECT().GetLatestChannelAssignment().RunAndReturn(func() (*balancer.WatchChannelAssignmentsCallbackParam, error) {
+		if replicating.Load() {
+			return &balancer.WatchChannelAssignmentsCallbackParam{
+				ReplicateConfiguration: &commonpb.ReplicateConfiguration{
+					Clusters: []*commonpb.MilvusCluster{{ClusterId: "a"}, {ClusterId: "b"}},
+				},
+			}, nil
+		}
+		return &balancer.WatchChannelAssignmentsCallbackParam{}, nil
+	}).Maybe()
+	balance.Register(b)
+
+	broadcasted := make(chan message.BroadcastMutableMessage, 10)
+	bapi := mock_broadcaster.NewMockBroadcastAPI(t)
+	bapi.EXPECT().Broadcast(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, msg message.BroadcastMutableMessage) (*types.BroadcastAppendResult, error) {
+		broadcasted <- msg
+		return &types.BroadcastAppendResult{BroadcastID: 1}, nil
+	}).Maybe()
+	bapi.EXPECT().Close().Return().Maybe()
+	bc := mock_broadcaster.NewMockBroadcaster(t)
+	bc.EXPECT().WithResourceKeys(mock.Anything, mock.Anything).Return(bapi, nil).Maybe()
+	bc.EXPECT().Close().Return().Maybe()
+	broadcast.Register(bc)
+
+	catalogTxns := &sync.Map{}
+	catalog := mock_metastore.NewMockStreamingCoordCataLog(t)
+	catalog.EXPECT().ListCrossVChannelTxn(mock.Anything).RunAndReturn(func(ctx context.Context) ([]*streamingpb.CrossVChannelTxnMeta, error) {
+		txns := make([]*streamingpb.CrossVChannelTxnMeta, 0)
+		catalogTxns.Range(func(key, value any) bool {
+			txns = append(txns, proto.Clone(value.(*streamingpb.CrossVChannelTxnMeta)).(*streamingpb.CrossVChannelTxnMeta))
+			return true
+		})
+		return txns, nil
+	})
+	catalog.EXPECT().SaveCrossVChannelTxn(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, txn *streamingpb.CrossVChannelTxnMeta) error {
+		if txn.GetState() == streamingpb.CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_DONE {
+			catalogTxns.Delete(txn.GetTxnId())
+			return nil
+		}
+		catalogTxns.Store(txn.GetTxnId(), proto.Clone(txn))
+		return nil
+	}).Maybe()
+
+	rc := idalloc.NewMockRootCoordClient(t)
+	rc.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:              merr.Success(),
+		CollectionID:        100,
+		DbName:              "db",
+		CollectionName:      "coll",
+		VirtualChannelNames: []string{"v1", "v2", "v3"},
+	}, nil).Maybe()
+	f := syncutil.NewFuture[internaltypes.MixCoordClient]()
+	f.Set(rc)
+	resource.InitForTest(resource.OptStreamingCatalog(catalog), resource.OptMixCoordClient(f))
+	return catalogTxns, broadcasted
+}
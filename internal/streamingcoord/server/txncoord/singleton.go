@@ -0,0 +1,27 @@
+package txncoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+var singleton = syncutil.NewFuture[*Coordinator]()
+
+// Register registers the cross vchannel txn coordinator.
+func Register(coordinator *Coordinator) {
+	singleton.Set(coordinator)
+}
+
+// GetWithContext gets the cross vchannel txn coordinator with context.
+func GetWithContext(ctx context.Context) (*Coordinator, error) {
+	return singleton.GetWithContext(ctx)
+}
+
+// Release releases the cross vchannel txn coordinator.
+func Release() {
+	if !singleton.Ready() {
+		return
+	}
+	singleton.Get().Close()
+}
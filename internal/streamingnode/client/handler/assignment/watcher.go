@@ -13,6 +13,11 @@ type Watcher interface {
 	// Get gets the channel assignment.
 	Get(ctx context.Context, channel string) *types.PChannelInfoAssigned
 
+	// GetForRead gets the channel assignment for reading.
+	// The read-only replicas of the channel are picked in round-robin to balance the read load without contending with the writer,
+	// the read-write owner is returned if there's no ready replica.
+	GetForRead(ctx context.Context, channel string) *types.PChannelInfoAssigned
+
 	// Watch watches the channel assignment.
 	// Block until new term is coming.
 	Watch(ctx context.Context, channel string, previous *types.PChannelInfoAssigned) error
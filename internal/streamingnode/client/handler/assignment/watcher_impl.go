@@ -3,6 +3,7 @@ package assignment
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/discoverer"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/service/resolver"
@@ -14,11 +15,12 @@ import (
 func NewWatcher(r resolver.Resolver) Watcher {
 	ctx, cancel := context.WithCancel(context.Background())
 	w := &watcherImpl{
-		ctx:         ctx,
-		cancel:      cancel,
-		r:           r,
-		cond:        *syncutil.NewContextCond(&sync.Mutex{}),
-		assignments: make(map[string]types.PChannelInfoAssigned),
+		ctx:          ctx,
+		cancel:       cancel,
+		r:            r,
+		cond:         *syncutil.NewContextCond(&sync.Mutex{}),
+		assignments:  make(map[string]types.PChannelInfoAssigned),
+		readReplicas: make(map[string][]types.PChannelInfoAssigned),
 	}
 	go w.execute()
 	return w
@@ -26,11 +28,13 @@ func NewWatcher(r resolver.Resolver) Watcher {
 
 // watcherImpl is the implementation of the assignment watcher.
 type watcherImpl struct {
-	ctx         context.Context
-	cancel      context.CancelFunc
-	r           resolver.Resolver
-	cond        syncutil.ContextCond
-	assignments map[string]types.PChannelInfoAssigned // map pchannel to node.
+	ctx          context.Context
+	cancel       context.CancelFunc
+	r            resolver.Resolver
+	cond         syncutil.ContextCond
+	assignments  map[string]types.PChannelInfoAssigned   // map pchannel to node.
+	readReplicas map[string][]types.PChannelInfoAssigned // map pchannel to the nodes that hold the read-only replicas.
+	readCounter  atomic.Uint64                           // used to pick the read target in round-robin.
 }
 
 // execute starts the watcher.
@@ -53,6 +57,7 @@ func (w *watcherImpl) execute() {
 // updateAssignment updates the assignment.
 func (w *watcherImpl) updateAssignment(state discoverer.VersionedState) {
 	newAssignments := make(map[string]types.PChannelInfoAssigned)
+	newReadReplicas := make(map[string][]types.PChannelInfoAssigned)
 	for _, assignments := range state.ChannelAssignmentInfo() {
 		for _, pChannelInfo := range assignments.Channels {
 			newAssignments[pChannelInfo.Name] = types.PChannelInfoAssigned{
@@ -60,9 +65,16 @@ func (w *watcherImpl) updateAssignment(state discoverer.VersionedState) {
 				Node:    assignments.NodeInfo,
 			}
 		}
+		for _, pChannelInfo := range assignments.ReadReplicas {
+			newReadReplicas[pChannelInfo.Name] = append(newReadReplicas[pChannelInfo.Name], types.PChannelInfoAssigned{
+				Channel: pChannelInfo,
+				Node:    assignments.NodeInfo,
+			})
+		}
 	}
 	w.cond.LockAndBroadcast()
 	w.assignments = newAssignments
+	w.readReplicas = newReadReplicas
 	w.cond.L.Unlock()
 }
 
@@ -77,6 +89,30 @@ func (w *watcherImpl) Get(ctx context.Context, channel string) *types.PChannelIn
 	return nil
 }
 
+// GetForRead gets the channel assignment for reading.
+func (w *watcherImpl) GetForRead(ctx context.Context, channel string) *types.PChannelInfoAssigned {
+	w.cond.L.Lock()
+	defer w.cond.L.Unlock()
+
+	info, ok := w.assignments[channel]
+	if !ok {
+		return nil
+	}
+	candidates := make([]types.PChannelInfoAssigned, 0, len(w.readReplicas[channel]))
+	for _, replica := range w.readReplicas[channel] {
+		// the replica of stale term is not ready to serve.
+		if replica.Channel.Term == info.Channel.Term {
+			candidates = append(candidates, replica)
+		}
+	}
+	if len(candidates) == 0 {
+		// fallback to the read-write owner if there's no ready replica.
+		return &info
+	}
+	target := candidates[w.readCounter.Add(1)%uint64(len(candidates))]
+	return &target
+}
+
 // Watch watches the channel assignment.
 func (w *watcherImpl) Watch(ctx context.Context, channel string, previous *types.PChannelInfoAssigned) error {
 	w.cond.L.Lock()
@@ -89,3 +89,71 @@ func TestWatcher(t *testing.T) {
 	err = w.Watch(ctx, "test_pchannel", a)
 	assert.ErrorIs(t, err, context.DeadlineExceeded)
 }
+
+func TestWatcherGetForRead(t *testing.T) {
+	r := mock_resolver.NewMockResolver(t)
+	r.EXPECT().Watch(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(s discoverer.VersionedState) error) error {
+		f(discoverer.VersionedState{
+			Version: typeutil.VersionInt64(1),
+			State: resolver.State{
+				Addresses: []resolver.Address{
+					{
+						Addr: "test_addr",
+						BalancerAttributes: attributes.WithChannelAssignmentInfo(
+							new(attributes.Attributes),
+							&types.StreamingNodeAssignment{
+								NodeInfo: types.StreamingNodeInfo{ServerID: 1, Address: "test_addr"},
+								Channels: map[string]types.PChannelInfo{
+									"test_pchannel": {Name: "test_pchannel", Term: 2},
+								},
+							},
+						),
+					},
+					{
+						Addr: "test_addr_2",
+						BalancerAttributes: attributes.WithChannelAssignmentInfo(
+							new(attributes.Attributes),
+							&types.StreamingNodeAssignment{
+								NodeInfo: types.StreamingNodeInfo{ServerID: 2, Address: "test_addr_2"},
+								ReadReplicas: map[string]types.PChannelInfo{
+									"test_pchannel": {Name: "test_pchannel", Term: 2, AccessMode: types.AccessModeRO},
+								},
+							},
+						),
+					},
+					{
+						Addr: "test_addr_3",
+						BalancerAttributes: attributes.WithChannelAssignmentInfo(
+							new(attributes.Attributes),
+							&types.StreamingNodeAssignment{
+								NodeInfo: types.StreamingNodeInfo{ServerID: 3, Address: "test_addr_3"},
+								ReadReplicas: map[string]types.PChannelInfo{
+									// stale replica should be ignored.
+									"test_pchannel": {Name: "test_pchannel", Term: 1, AccessMode: types.AccessModeRO},
+								},
+							},
+						),
+					},
+				},
+			},
+		})
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	w := NewWatcher(r)
+	defer w.Close()
+
+	assert.NoError(t, w.Watch(context.Background(), "test_pchannel", nil))
+	assert.Nil(t, w.GetForRead(context.Background(), "non_exist"))
+
+	servers := make(map[int64]int)
+	for i := 0; i < 10; i++ {
+		a := w.GetForRead(context.Background(), "test_pchannel")
+		assert.NotNil(t, a)
+		servers[a.Node.ServerID]++
+	}
+	// the read-write owner is not picked if there's ready replica.
+	assert.Equal(t, map[int64]int{2: 10}, servers)
+	// the read-write owner is always returned by Get.
+	assert.Equal(t, int64(1), w.Get(context.Background(), "test_pchannel").Node.ServerID)
+}
@@ -69,6 +69,10 @@ type ConsumerOptions struct {
 
 	// IgnorePauseConsumption is the flag to ignore the consumption pause of the consumer.
 	IgnorePauseConsumption bool
+
+	// PreferReadReplica is the flag to allow the consumer to read from the read-only replica of the pchannel,
+	// which is used to balance the read load of the read-heavy consumers, the read-write owner is used if there's no ready replica.
+	PreferReadReplica bool
 }
 
 // HandlerClient is the interface that wraps streamingpb.StreamingNodeHandlerServiceClient.
@@ -86,6 +90,10 @@ type HandlerClient interface {
 	// Returns an empty slice if no force promote has occurred.
 	GetSalvageCheckpoint(ctx context.Context, channelName string) ([]*wal.ReplicateCheckpoint, error)
 
+	// TruncateWAL truncates the wal of the pchannel to the given message id explicitly.
+	// It's rejected if the flusher or any replicating task of the wal doesn't consume beyond the message id.
+	TruncateWAL(ctx context.Context, channelName string, truncateTo message.MessageID) error
+
 	// PrepareReleaseManualFlush prepares process-local release handoff.
 	// Returns false when the current process is not the local flush owner or
 	// the channel does not need growing-source retention.
@@ -20,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
@@ -75,7 +76,7 @@ func (hc *handlerClientImpl) GetReplicateCheckpoint(ctx context.Context, pchanne
 	defer hc.lifetime.Done()
 
 	logger := mlog.With(mlog.FieldPChannel(pchannel), mlog.String("handler", "replicate checkpoint"))
-	cp, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
+	cp, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, false, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
 		if assign.Channel.AccessMode != types.AccessModeRW {
 			return nil, status.NewInvalidArgument("replicate checkpoint can only be read for RW channel")
 		}
@@ -112,7 +113,7 @@ func (hc *handlerClientImpl) GetSalvageCheckpoint(ctx context.Context, pchannel
 	defer hc.lifetime.Done()
 
 	logger := mlog.With(mlog.FieldPChannel(pchannel), mlog.String("handler", "salvage checkpoint"))
-	cps, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
+	cps, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, false, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
 		if assign.Channel.AccessMode != types.AccessModeRW {
 			return nil, status.NewInvalidArgument("salvage checkpoint can only be read for RW channel")
 		}
@@ -149,6 +150,43 @@ func (hc *handlerClientImpl) GetSalvageCheckpoint(ctx context.Context, pchannel
 	return cps.([]*wal.ReplicateCheckpoint), nil
 }
 
+// TruncateWAL truncates the wal of the pchannel to the given message id explicitly.
+func (hc *handlerClientImpl) TruncateWAL(ctx context.Context, pchannel string, truncateTo message.MessageID) error {
+	if !hc.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return ErrClientClosed
+	}
+	defer hc.lifetime.Done()
+
+	logger := mlog.With(mlog.FieldPChannel(pchannel), mlog.String("handler", "truncate wal"), mlog.Stringer("truncateTo", truncateTo))
+	_, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, false, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
+		if assign.Channel.AccessMode != types.AccessModeRW {
+			return nil, status.NewInvalidArgument("wal can only be truncated on RW channel")
+		}
+		localWAL, err := registry.GetLocalAvailableWAL(assign.Channel)
+		if err == nil {
+			if err := localWAL.Truncate(ctx, truncateTo); err != nil {
+				return nil, err
+			}
+			return struct{}{}, nil
+		}
+		if !shouldUseRemoteWAL(err) {
+			return nil, err
+		}
+		handlerService, err := hc.service.GetService(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := handlerService.TruncateWAL(ctx, &streamingpb.TruncateWALRequest{
+			Pchannel:  types.NewProtoFromPChannelInfo(assign.Channel),
+			MessageId: truncateTo.IntoProto(),
+		}); err != nil {
+			return nil, err
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
 // PrepareReleaseManualFlush appends a normal ManualFlush and prepares local growing-source retention.
 func (hc *handlerClientImpl) PrepareReleaseManualFlush(ctx context.Context, collectionID int64, vchannel string, releaseSegmentIDs []int64) (bool, error) {
 	if !hc.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -164,7 +202,7 @@ func (hc *handlerClientImpl) PrepareReleaseManualFlush(ctx context.Context, coll
 		mlog.Int64s("releaseSegmentIDs", releaseSegmentIDs),
 		mlog.String("handler", "prepare release manual flush"),
 	)
-	result, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
+	result, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, pchannel, false, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
 		if assign.Channel.AccessMode != types.AccessModeRW {
 			logger.Info(ctx, "skip release manual flush prepare because channel is not RW",
 				mlog.String("accessMode", assign.Channel.AccessMode.String()))
@@ -215,7 +253,7 @@ func (hc *handlerClientImpl) CreateProducer(ctx context.Context, opts *ProducerO
 	defer hc.lifetime.Done()
 
 	logger := mlog.With(mlog.FieldPChannel(opts.PChannel), mlog.String("handler", "producer"))
-	p, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, opts.PChannel, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
+	p, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, opts.PChannel, false, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
 		if assign.Channel.AccessMode != types.AccessModeRW {
 			return nil, status.NewInvalidArgument("producer can only be created for RW channel")
 		}
@@ -258,7 +296,7 @@ func (hc *handlerClientImpl) CreateConsumer(ctx context.Context, opts *ConsumerO
 	logger := mlog.With(mlog.FieldPChannel(opts.PChannel), mlog.FieldVChannel(opts.VChannel), mlog.String("handler", "consumer"))
 	// Use a local variable to track DeliverPolicy, so modifications in the closure don't affect the original opts
 	deliverPolicy := opts.DeliverPolicy
-	c, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, opts.PChannel, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
+	c, err := hc.createHandlerAfterStreamingNodeReady(ctx, logger, opts.PChannel, opts.PreferReadReplica, func(ctx context.Context, assign *types.PChannelInfoAssigned) (any, error) {
 		// Check if the localWAL is assigned at local
 		localWAL, err := registry.GetLocalAvailableWAL(assign.Channel)
 		if err == nil {
@@ -320,7 +358,8 @@ type handlerCreateFunc func(ctx context.Context, assign *types.PChannelInfoAssig
 
 // createHandlerAfterStreamingNodeReady creates a handler until streaming node ready.
 // If streaming node is not ready, it will block until new assignment term is coming or context timeout.
-func (hc *handlerClientImpl) createHandlerAfterStreamingNodeReady(ctx context.Context, logger *mlog.Logger, pchannel string, create handlerCreateFunc) (any, error) {
+// If preferReadReplica is true, the handler may be created on the read-only replica of the pchannel.
+func (hc *handlerClientImpl) createHandlerAfterStreamingNodeReady(ctx context.Context, logger *mlog.Logger, pchannel string, preferReadReplica bool, create handlerCreateFunc) (any, error) {
 	// TODO: backoff should be configurable.
 	backoff := backoff.NewExponentialBackOff()
 	backoff.InitialInterval = 100 * time.Millisecond
@@ -329,7 +368,12 @@ func (hc *handlerClientImpl) createHandlerAfterStreamingNodeReady(ctx context.Co
 	backoff.Reset()
 
 	for {
-		assign := hc.watcher.Get(ctx, pchannel)
+		var assign *types.PChannelInfoAssigned
+		if preferReadReplica {
+			assign = hc.watcher.GetForRead(ctx, pchannel)
+		} else {
+			assign = hc.watcher.Get(ctx, pchannel)
+		}
 		if assign != nil {
 			// Find assignment, try to create producer on this assignment.
 			// pick the target streaming node to serve.
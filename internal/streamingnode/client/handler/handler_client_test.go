@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message/adaptor"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
@@ -194,6 +195,50 @@ func TestHandlerClient_GetSalvageCheckpoint(t *testing.T) {
 	assert.Nil(t, cps)
 }
 
+func TestHandlerClient_TruncateWAL(t *testing.T) {
+	assignment := &types.PChannelInfoAssigned{
+		Channel: types.PChannelInfo{Name: "pchannel", Term: 1},
+		Node:    types.StreamingNodeInfo{ServerID: 1, Address: "localhost"},
+	}
+
+	service := mock_lazygrpc.NewMockService[streamingpb.StreamingNodeHandlerServiceClient](t)
+	handlerServiceClient := mock_streamingpb.NewMockStreamingNodeHandlerServiceClient(t)
+	service.EXPECT().GetService(mock.Anything).Return(handlerServiceClient, nil)
+	rb := mock_resolver.NewMockBuilder(t)
+	rb.EXPECT().Close().Run(func() {})
+	w := mock_assignment.NewMockWatcher(t)
+	w.EXPECT().Close().Run(func() {})
+	w.EXPECT().Get(mock.Anything, mock.Anything).Return(assignment)
+	rebalanceTrigger := mock_types.NewMockAssignmentRebalanceTrigger(t)
+
+	handler := &handlerClientImpl{
+		lifetime:         typeutil.NewLifetime(),
+		service:          service,
+		rb:               rb,
+		watcher:          w,
+		rebalanceTrigger: rebalanceTrigger,
+	}
+	ctx := context.Background()
+
+	handlerServiceClient.EXPECT().TruncateWAL(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *streamingpb.TruncateWALRequest, opts ...grpc.CallOption) (*streamingpb.TruncateWALResponse, error) {
+			assert.Equal(t, "pchannel", req.GetPchannel().GetName())
+			return &streamingpb.TruncateWALResponse{}, nil
+		}).Once()
+	err := handler.TruncateWAL(ctx, "pchannel", walimplstest.NewTestMessageID(1))
+	assert.NoError(t, err)
+
+	// the unrecoverable rejection is returned without retrying.
+	handlerServiceClient.EXPECT().TruncateWAL(mock.Anything, mock.Anything).Return(nil, status.NewUnrecoverableError("rejected")).Once()
+	err = handler.TruncateWAL(ctx, "pchannel", walimplstest.NewTestMessageID(1))
+	assert.True(t, status.AsStreamingError(err).IsUnrecoverable())
+
+	service.EXPECT().Close().Return()
+	handler.Close()
+	err = handler.TruncateWAL(ctx, "pchannel", walimplstest.NewTestMessageID(1))
+	assert.ErrorIs(t, err, ErrClientClosed)
+}
+
 func TestHandlerClient_PrepareReleaseManualFlush(t *testing.T) {
 	assignment := &types.PChannelInfoAssigned{
 		Channel: types.PChannelInfo{Name: "pchannel", Term: 1, AccessMode: types.AccessModeRO},
@@ -46,6 +46,9 @@ type ManagerClient interface {
 	// Remove the wal instance for the channel on streaming node of given server id.
 	Remove(ctx context.Context, pchannel types.PChannelInfoAssigned) error
 
+	// UpdateAppendQuotas pushes the snapshot of the collection append quotas to all streaming nodes.
+	UpdateAppendQuotas(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota) error
+
 	// Close closes the manager client.
 	// It close the underlying connection, stop the node watcher and release all resources.
 	Close()
@@ -294,6 +294,46 @@ func (c *managerClientImpl) Remove(ctx context.Context, pchannel types.PChannelI
 	return err
 }
 
+// UpdateAppendQuotas pushes the snapshot of the collection append quotas to all discovered streaming nodes.
+// The streaming node that is not alive is skipped, it will get the quotas at next push.
+func (c *managerClientImpl) UpdateAppendQuotas(ctx context.Context, quotas []*streamingpb.CollectionAppendQuota) error {
+	if !c.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("manager client is closing")
+	}
+	defer c.lifetime.Done()
+
+	state, err := c.rb.Resolver().GetLatestState(ctx)
+	if err != nil {
+		return err
+	}
+	if len(state.State.Addresses) == 0 {
+		return nil
+	}
+	// wait for manager service ready.
+	manager, err := c.service.GetService(ctx)
+	if err != nil {
+		return err
+	}
+
+	g, _ := errgroup.WithContext(ctx)
+	g.SetLimit(16)
+	for serverID := range state.Sessions() {
+		serverID := serverID
+		g.Go(func() error {
+			ctx := contextutil.WithPickServerID(ctx, serverID)
+			_, err := manager.UpdateAppendQuotas(ctx, &streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest{
+				Quotas: quotas,
+			})
+			if err == nil || picker.IsErrSubConnNoExist(err) {
+				return nil
+			}
+			mlog.Warn(ctx, "update append quotas failed", mlog.Int64("serverID", serverID), mlog.Err(err))
+			return err
+		})
+	}
+	return g.Wait()
+}
+
 // Close closes the manager client.
 func (c *managerClientImpl) Close() {
 	c.lifetime.SetState(typeutil.LifetimeStateStopped)
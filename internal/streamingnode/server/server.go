@@ -6,6 +6,7 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus/internal/distributed/streaming"
 	"github.com/milvus-io/milvus/internal/streamingnode/client/handler/registry"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/service"
@@ -15,9 +16,11 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	_ "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/kafka"
 	_ "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/pulsar"
 	_ "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
+	_ "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/tiered"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
@@ -32,7 +35,9 @@ type Server struct {
 	managerService service.ManagerService
 
 	// basic component instances.
-	walManager walmanager.Manager
+	walManager      walmanager.Manager
+	leaseKeeper     *walmanager.LeaseKeeper
+	retentionKeeper *walmanager.RetentionKeeper
 }
 
 // Init initializes the streamingnode server.
@@ -58,8 +63,18 @@ func (s *Server) init() {
 // Stop stops the streamingnode server.
 func (s *Server) Stop() {
 	mlog.Info(context.TODO(), "stopping streamingnode server...")
+	if s.leaseKeeper != nil {
+		mlog.Info(context.TODO(), "close wal lease keeper...")
+		s.leaseKeeper.Close()
+	}
+	if s.retentionKeeper != nil {
+		mlog.Info(context.TODO(), "close wal retention keeper...")
+		s.retentionKeeper.Close()
+	}
 	mlog.Info(context.TODO(), "close wal manager...")
-	s.walManager.Close()
+	walmanager.CloseWithHandoff(s.walManager, func() walmanager.HandoffReporter {
+		return streaming.WAL().Local()
+	})
 	mlog.Info(context.TODO(), "release streamingnode resources...")
 	resource.Release()
 	mlog.Info(context.TODO(), "streamingnode server stopped")
@@ -74,6 +89,19 @@ func (s *Server) initBasicComponent() {
 	}
 	// Register the wal manager to the local registry.
 	registry.RegisterLocalWALManager(s.walManager)
+
+	if paramtable.Get().StreamingCfg.WALBalancerLeaseEnabled.GetAsBool() {
+		node := types.StreamingNodeInfo{
+			ServerID: s.session.ServerID,
+			Address:  s.session.Address,
+		}
+		s.leaseKeeper = walmanager.StartLeaseKeeper(s.walManager, node, func() walmanager.LeaseRenewer {
+			return streaming.WAL().Local()
+		})
+	}
+	s.retentionKeeper = walmanager.StartRetentionKeeper(s.walManager, func() walmanager.RetentionFetcher {
+		return streaming.WAL().Local()
+	})
 }
 
 // initService initializes the grpc service.
@@ -7,7 +7,9 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingnode/server/service/handler/consumer"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/service/handler/producer"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/walmanager"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 )
 
@@ -61,6 +63,26 @@ func (hs *handlerServiceImpl) GetSalvageCheckpoint(ctx context.Context, req *str
 	return &streamingpb.GetSalvageCheckpointResponse{Checkpoints: protoCps}, nil
 }
 
+// TruncateWAL truncates the wal to the given message id explicitly.
+func (hs *handlerServiceImpl) TruncateWAL(ctx context.Context, req *streamingpb.TruncateWALRequest) (*streamingpb.TruncateWALResponse, error) {
+	channel := types.NewPChannelInfoFromProto(req.GetPchannel())
+	if channel.AccessMode != types.AccessModeRW {
+		return nil, status.NewInvalidArgument("wal can only be truncated on RW channel")
+	}
+	truncateTo, err := message.UnmarshalMessageID(req.GetMessageId())
+	if err != nil {
+		return nil, status.NewInvalidArgument("invalid truncate position of wal, %s", err.Error())
+	}
+	wal, err := hs.walManager.GetAvailableWAL(channel)
+	if err != nil {
+		return nil, err
+	}
+	if err := wal.Truncate(ctx, truncateTo); err != nil {
+		return nil, err
+	}
+	return &streamingpb.TruncateWALResponse{}, nil
+}
+
 // Produce creates a new producer for the channel on this log node.
 func (hs *handlerServiceImpl) Produce(streamServer streamingpb.StreamingNodeHandlerService_ProduceServer) error {
 	p, err := producer.CreateProduceServer(hs.walManager, streamServer)
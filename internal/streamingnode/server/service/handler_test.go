@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -12,8 +13,10 @@ import (
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
 	"github.com/milvus-io/milvus/internal/mocks/streamingnode/server/mock_wal"
 	"github.com/milvus-io/milvus/internal/mocks/streamingnode/server/mock_walmanager"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
 )
 
 type releaseManualFlushCheckBufferManager struct {
@@ -131,3 +134,37 @@ func TestReleaseManualFlushPreparerSkipNonGrowingSource(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, prepared)
 }
+
+func TestHandlerServiceTruncateWAL(t *testing.T) {
+	ctx := context.Background()
+	wal := mock_wal.NewMockWAL(t)
+	manager := mock_walmanager.NewMockManager(t)
+	hs := NewHandlerService(manager)
+
+	channel := types.PChannelInfo{Name: "pchannel", Term: 1, AccessMode: types.AccessModeRW}
+	req := &streamingpb.TruncateWALRequest{
+		Pchannel:  types.NewProtoFromPChannelInfo(channel),
+		MessageId: walimplstest.NewTestMessageID(1).IntoProto(),
+	}
+
+	// the read-only channel and the invalid message id are rejected.
+	_, err := hs.TruncateWAL(ctx, &streamingpb.TruncateWALRequest{
+		Pchannel:  types.NewProtoFromPChannelInfo(types.PChannelInfo{Name: "pchannel", Term: 1, AccessMode: types.AccessModeRO}),
+		MessageId: req.MessageId,
+	})
+	assert.Error(t, err)
+	_, err = hs.TruncateWAL(ctx, &streamingpb.TruncateWALRequest{Pchannel: req.Pchannel})
+	assert.Error(t, err)
+
+	manager.EXPECT().GetAvailableWAL(mock.Anything).Return(wal, nil)
+	wal.EXPECT().Truncate(mock.Anything, mock.Anything).Return(errors.New("test")).Once()
+	_, err = hs.TruncateWAL(ctx, req)
+	assert.Error(t, err)
+
+	wal.EXPECT().Truncate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, truncateTo message.MessageID) error {
+		assert.True(t, truncateTo.EQ(walimplstest.NewTestMessageID(1)))
+		return nil
+	})
+	_, err = hs.TruncateWAL(ctx, req)
+	assert.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/quota"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/walmanager"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
@@ -58,3 +59,10 @@ func (ms *managerServiceImpl) CollectStatus(ctx context.Context, req *streamingp
 		Metrics: types.NewProtoFromStreamingNodeMetrics(*metrics),
 	}, nil
 }
+
+// UpdateAppendQuotas updates the append quotas of collections on this streamingnode.
+// The quotas are a full snapshot, the collections not in the snapshot are not limited any more.
+func (ms *managerServiceImpl) UpdateAppendQuotas(ctx context.Context, req *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest) (*streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse, error) {
+	quota.UpdateAppendQuotas(req.GetQuotas())
+	return &streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse{}, nil
+}
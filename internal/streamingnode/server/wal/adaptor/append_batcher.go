@@ -0,0 +1,188 @@
+package adaptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+// newAppendBatcher creates a new append batcher.
+func newAppendBatcher(appender walimpls.BatchAppender) *appendBatcher {
+	b := &appendBatcher{
+		notifier:  syncutil.NewAsyncTaskNotifier[struct{}](),
+		appender:  appender,
+		controlCh: make(chan *batchAppendRequest),
+		bulkCh:    make(chan *batchAppendRequest),
+	}
+	go b.execute()
+	return b
+}
+
+// appendBatcher coalesces the concurrent appends of a wal within the batch window into one batch append of the underlying wal.
+// The appends are queued in two lanes, the control lane is always served before the bulk lane,
+// so the control messages (flush, ddl, timetick) are never stuck behind a burst of bulk inserts.
+type appendBatcher struct {
+	notifier  *syncutil.AsyncTaskNotifier[struct{}]
+	appender  walimpls.BatchAppender
+	controlCh chan *batchAppendRequest // the lane of the control messages.
+	bulkCh    chan *batchAppendRequest // the lane of the bulk messages, see streaming.walAppendBatch.bulkMessageTypes.
+}
+
+// batchAppendRequest is the append request of one message in the batch.
+type batchAppendRequest struct {
+	ctx    context.Context
+	msg    message.MutableMessage
+	result chan batchAppendResult
+}
+
+// batchAppendResult is the append result of one message in the batch.
+type batchAppendResult struct {
+	msgID message.MessageID
+	err   error
+}
+
+// Append appends the message with the next batch, and returns the message id of the message.
+func (b *appendBatcher) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	req := &batchAppendRequest{
+		ctx:    ctx,
+		msg:    msg,
+		result: make(chan batchAppendResult, 1),
+	}
+	lane := b.controlCh
+	if isBulkMessageType(msg.MessageType()) {
+		lane = b.bulkCh
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.notifier.Context().Done():
+		return nil, status.NewOnShutdownError("append batcher is closed")
+	case lane <- req:
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-b.notifier.Context().Done():
+		return nil, status.NewOnShutdownError("append batcher is closed")
+	case result := <-req.result:
+		return result.msgID, result.err
+	}
+}
+
+// Close closes the append batcher.
+// It should be called after all append operations are done.
+func (b *appendBatcher) Close() {
+	b.notifier.Cancel()
+	b.notifier.BlockUntilFinish()
+}
+
+// execute collects the append requests into batches and appends them until the batcher is closed.
+func (b *appendBatcher) execute() {
+	defer b.notifier.Finish(struct{}{})
+
+	for {
+		var first *batchAppendRequest
+		// the control lane is always served first.
+		select {
+		case first = <-b.controlCh:
+		default:
+			select {
+			case <-b.notifier.Context().Done():
+				return
+			case first = <-b.controlCh:
+			case first = <-b.bulkCh:
+			}
+		}
+		b.appendBatch(b.collect(first))
+	}
+}
+
+// collect collects the append requests until the batch window is elapsed or the batch is full.
+// The batch is produced immediately once a control message is collected, the control message never waits for the batch window.
+func (b *appendBatcher) collect(first *batchAppendRequest) []*batchAppendRequest {
+	cfg := &paramtable.Get().StreamingCfg
+	maxMessages := cfg.WALAppendBatchMaxMessages.GetAsInt()
+	maxBytes := int(cfg.WALAppendBatchMaxBytes.GetAsSize())
+
+	batch := []*batchAppendRequest{first}
+	size := first.msg.EstimateSize()
+	if !isBulkMessageType(first.msg.MessageType()) {
+		return b.collectPendingControls(batch, size, maxMessages, maxBytes)
+	}
+	timer := time.NewTimer(cfg.WALAppendBatchWindow.GetAsDurationByParse())
+	defer timer.Stop()
+	for len(batch) < maxMessages && size < maxBytes {
+		select {
+		case <-b.notifier.Context().Done():
+			return batch
+		case <-timer.C:
+			return batch
+		case req := <-b.controlCh:
+			batch = append(batch, req)
+			return b.collectPendingControls(batch, size+req.msg.EstimateSize(), maxMessages, maxBytes)
+		case req := <-b.bulkCh:
+			batch = append(batch, req)
+			size += req.msg.EstimateSize()
+		}
+	}
+	return batch
+}
+
+// collectPendingControls collects the control messages that are already pending without waiting,
+// until the batch is full.
+func (b *appendBatcher) collectPendingControls(batch []*batchAppendRequest, size int, maxMessages int, maxBytes int) []*batchAppendRequest {
+	for len(batch) < maxMessages && size < maxBytes {
+		select {
+		case req := <-b.controlCh:
+			batch = append(batch, req)
+			size += req.msg.EstimateSize()
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// isBulkMessageType checks if the message type is appended with the bulk lane of the append batcher.
+func isBulkMessageType(msgType message.MessageType) bool {
+	name := msgType.String()
+	for _, bulk := range paramtable.Get().StreamingCfg.WALAppendBatchBulkMessageTypes.GetAsStrings() {
+		if bulk == name {
+			return true
+		}
+	}
+	return false
+}
+
+// appendBatch appends the batch into the underlying wal and notifies the result to every request.
+// Every message is appended with the context of its own request and gets its own result,
+// so the caller only retries the failed messages, the written messages are never appended twice.
+func (b *appendBatcher) appendBatch(batch []*batchAppendRequest) {
+	// The request that is canceled before the batch is appended doesn't need to be appended.
+	pending := make([]*batchAppendRequest, 0, len(batch))
+	for _, req := range batch {
+		if err := req.ctx.Err(); err != nil {
+			req.result <- batchAppendResult{err: err}
+			continue
+		}
+		pending = append(pending, req)
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	records := make([]walimpls.BatchAppendRecord, 0, len(pending))
+	for _, req := range pending {
+		records = append(records, walimpls.BatchAppendRecord{Ctx: req.ctx, Msg: req.msg})
+	}
+	results := b.appender.AppendBatch(records)
+	for i, req := range pending {
+		req.result <- batchAppendResult{msgID: results[i].MessageID, err: results[i].Err}
+	}
+}
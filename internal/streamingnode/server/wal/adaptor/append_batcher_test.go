@@ -0,0 +1,172 @@
+package adaptor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+type batchAppenderForTest struct {
+	mu      sync.Mutex
+	next    int64
+	batches []int
+	err     error
+	failAt  map[int]error // the index of the message in the batch that fails.
+}
+
+func (a *batchAppenderForTest) AppendBatch(records []walimpls.BatchAppendRecord) []walimpls.BatchAppendResult {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	results := make([]walimpls.BatchAppendResult, len(records))
+	if a.err != nil {
+		for i := range results {
+			results[i].Err = a.err
+		}
+		return results
+	}
+	a.batches = append(a.batches, len(records))
+	for i := range records {
+		if err, ok := a.failAt[i]; ok {
+			results[i].Err = err
+			continue
+		}
+		results[i].MessageID = walimplstest.NewTestMessageID(a.next)
+		a.next++
+	}
+	return results
+}
+
+func TestAppendBatcher(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALAppendBatchWindow.Key, "50ms")
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALAppendBatchMaxMessages.Key, "4")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALAppendBatchWindow.Key)
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALAppendBatchMaxMessages.Key)
+
+	appender := &batchAppenderForTest{}
+	b := newAppendBatcher(appender)
+
+	// concurrent appends are coalesced, and every message gets its own message id.
+	ids := make([]message.MessageID, 8)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := b.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, nil))
+			assert.NoError(t, err)
+			ids[i] = id
+		}()
+	}
+	wg.Wait()
+	uniqueIDs := make(map[string]struct{})
+	for _, id := range ids {
+		uniqueIDs[id.Marshal()] = struct{}{}
+	}
+	assert.Len(t, uniqueIDs, 8)
+	total := 0
+	for _, n := range appender.batches {
+		assert.LessOrEqual(t, n, 4)
+		total += n
+	}
+	assert.Equal(t, 8, total)
+	assert.Less(t, len(appender.batches), 8)
+
+	// the error of batch is returned to every message of the batch.
+	appender.mu.Lock()
+	appender.err = errors.New("test")
+	appender.mu.Unlock()
+	_, err := b.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, nil))
+	assert.ErrorIs(t, err, appender.err)
+
+	// canceled append is not appended.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = b.Append(ctx, message.CreateTestEmptyInsertMesage(1, nil))
+	assert.ErrorIs(t, err, context.Canceled)
+
+	b.Close()
+	_, err = b.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, nil))
+	assert.Error(t, err)
+}
+
+func TestAppendBatcherPartialFailure(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALAppendBatchWindow.Key, "10s")
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALAppendBatchMaxMessages.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALAppendBatchWindow.Key)
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALAppendBatchMaxMessages.Key)
+
+	// only the failed message of the batch gets the error, the written one gets its message id.
+	failErr := errors.New("test")
+	appender := &batchAppenderForTest{failAt: map[int]error{1: failErr}}
+	b := newAppendBatcher(appender)
+	defer b.Close()
+
+	type result struct {
+		id  message.MessageID
+		err error
+	}
+	results := make(chan result, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			id, err := b.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, nil))
+			results <- result{id: id, err: err}
+		}()
+	}
+	r1, r2 := <-results, <-results
+	if r1.err != nil {
+		r1, r2 = r2, r1
+	}
+	assert.NoError(t, r1.err)
+	assert.NotNil(t, r1.id)
+	assert.ErrorIs(t, r2.err, failErr)
+	assert.Nil(t, r2.id)
+
+	appender.mu.Lock()
+	defer appender.mu.Unlock()
+	assert.Equal(t, []int{2}, appender.batches)
+	assert.Equal(t, int64(1), appender.next)
+}
+
+func TestAppendBatcherPriorityLane(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALAppendBatchWindow.Key, "10s")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALAppendBatchWindow.Key)
+
+	assert.True(t, isBulkMessageType(message.MessageTypeInsert))
+	assert.False(t, isBulkMessageType(message.MessageTypeTimeTick))
+
+	appender := &batchAppenderForTest{}
+	b := newAppendBatcher(appender)
+	defer b.Close()
+
+	// the control message never waits for the batch window.
+	_, err := b.Append(context.Background(), message.CreateTestTimeTickSyncMessage(t, 1, 1, walimplstest.NewTestMessageID(1)))
+	assert.NoError(t, err)
+
+	// the control message cuts the collecting batch of bulk messages.
+	bulkDone := make(chan struct{})
+	go func() {
+		defer close(bulkDone)
+		_, err := b.Append(context.Background(), message.CreateTestEmptyInsertMesage(1, nil))
+		assert.NoError(t, err)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	_, err = b.Append(context.Background(), message.CreateTestTimeTickSyncMessage(t, 1, 2, walimplstest.NewTestMessageID(2)))
+	assert.NoError(t, err)
+	<-bulkDone
+
+	appender.mu.Lock()
+	defer appender.mu.Unlock()
+	assert.Equal(t, []int{1, 2}, appender.batches)
+}
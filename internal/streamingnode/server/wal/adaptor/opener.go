@@ -87,13 +87,15 @@ func (o *openerAdaptorImpl) Open(ctx context.Context, opt *wal.OpenOption) (wal.
 	}
 	defer o.lifetime.Done()
 
-	// Determine which walName to use
-	walName, err := o.determineWALName(ctx, opt)
+	// Determine which walName and topic to use
+	walName, topic, err := o.determineWALName(ctx, opt)
 	if err != nil {
 		return nil, err
 	}
+	channel := opt.Channel
+	channel.Topic = topic
 
-	logger := mlog.With(mlog.String("channel", opt.Channel.String()), mlog.Stringer("walName", walName))
+	logger := mlog.With(mlog.String("channel", opt.Channel.String()), mlog.Stringer("walName", walName), mlog.String("topic", channel.TopicName()))
 
 	// Get or create the underlying walimpls.OpenerImpls for this walName
 	openerImpl, err := o.getOrCreateOpenerImpl(ctx, walName)
@@ -104,7 +106,7 @@ func (o *openerAdaptorImpl) Open(ctx context.Context, opt *wal.OpenOption) (wal.
 
 	// Open the underlying WAL implementation
 	l, err := openerImpl.Open(ctx, &walimpls.OpenOption{
-		Channel: opt.Channel,
+		Channel: channel,
 	})
 	if err != nil {
 		logger.Warn(ctx, "open wal impls failed", mlog.Err(err))
@@ -128,13 +130,16 @@ func (o *openerAdaptorImpl) Open(ctx context.Context, opt *wal.OpenOption) (wal.
 	return wal, nil
 }
 
-// determineWALName determines which walName to use for the given channel.
-func (o *openerAdaptorImpl) determineWALName(ctx context.Context, opt *wal.OpenOption) (message.WALName, error) {
+// determineWALName determines which walName and topic to use for the given channel.
+// The topic of the consume checkpoint is used if the checkpoint exists, because the message id of checkpoint belongs to it,
+// otherwise the topic assigned by the streamingcoord is used.
+func (o *openerAdaptorImpl) determineWALName(ctx context.Context, opt *wal.OpenOption) (message.WALName, string, error) {
 	walName := message.WALNameUnknown
+	topic := opt.Channel.Topic
 	catalog := resource.Resource().StreamingNodeCatalog()
 	cpProto, err := catalog.GetConsumeCheckpoint(ctx, opt.Channel.Name)
 	if err != nil {
-		return message.WALNameUnknown, errors.Wrap(err, "failed to get checkpoint from catalog")
+		return message.WALNameUnknown, "", errors.Wrap(err, "failed to get checkpoint from catalog")
 	}
 	if cpProto != nil {
 		checkpoint := utility.NewWALCheckpointFromProto(cpProto)
@@ -143,8 +148,10 @@ func (o *openerAdaptorImpl) determineWALName(ctx context.Context, opt *wal.OpenO
 			mlog.Stringer("checkpoint", checkpoint.MessageID),
 			mlog.Uint64("checkpointTimeTick", checkpoint.TimeTick),
 			mlog.Stringer("currentWAL", checkpoint.MessageID.WALName()),
+			mlog.String("topic", checkpoint.Topic),
 			mlog.Any("AlterWalState", checkpoint.AlterWalState))
 		walName = checkpoint.MessageID.WALName()
+		topic = checkpoint.Topic
 	}
 
 	if walName == message.WALNameUnknown {
@@ -156,7 +163,13 @@ func (o *openerAdaptorImpl) determineWALName(ctx context.Context, opt *wal.OpenO
 		// Use wal selector to choose one
 		walName = util.MustSelectWALName()
 	}
-	return walName, nil
+
+	if cpProto == nil && paramtable.Get().StreamingCfg.WALTieredEnabled.GetAsBool() {
+		// the tiered wal is only enabled for the new pchannel,
+		// the existing pchannel keeps the wal of its checkpoint, the tiered wal is in front of the selected mq.
+		walName = message.WALNameTiered
+	}
+	return walName, topic, nil
 }
 
 // getOrCreateOpenerImpl gets an existing opener from cache or creates a new one.
@@ -305,6 +318,9 @@ func (o *openerAdaptorImpl) handleAlterWAL(ctx context.Context, l walimpls.WALIm
 	}
 
 	targetWALName := snapshot.AlterWALInfo.TargetWALName
+	if targetTopic := snapshot.AlterWALInfo.TargetTopic; targetTopic != "" {
+		return nil, status.NewInner("WAL switch success: %s migrate to topic %s finish, re-opening required", opt.Channel.Name, targetTopic)
+	}
 	return nil, status.NewInner("WAL switch success: %s switch to %s finish, re-opening required", opt.Channel.Name, targetWALName)
 }
 
@@ -479,6 +495,10 @@ func (o *openerAdaptorImpl) handleAlterWALAdvanceCheckpointsStage(ctx context.Co
 	finalCheckpoint := snapshot.Checkpoint.Clone()
 	finalCheckpoint.AlterWalState = nil
 	finalCheckpoint.MessageID = msgadaptor.MustGetMessageIDFromMQWrapperID(newWALInitialMsgID)
+	if targetTopic := snapshot.Checkpoint.AlterWalState.GetTargetTopic(); targetTopic != "" {
+		// the earliest message id is the start of the new topic, the wal is opened on it at next opening.
+		finalCheckpoint.Topic = targetTopic
+	}
 	if finalCheckpoint.ReplicateCheckpoint != nil {
 		finalCheckpoint.ReplicateCheckpoint.MessageID = finalCheckpoint.MessageID
 	}
@@ -498,6 +518,7 @@ func (o *openerAdaptorImpl) handleAlterWALAdvanceCheckpointsStage(ctx context.Co
 		mlog.String("channel", opt.Channel.Name),
 		mlog.String("newCheckpoint", finalCheckpoint.MessageID.String()),
 		mlog.String("newWAL", finalCheckpoint.MessageID.WALName().String()),
+		mlog.String("newTopic", finalCheckpoint.Topic),
 		mlog.Uint64("newCheckpointTS", finalCheckpoint.TimeTick))
 
 	return nil
@@ -58,7 +58,7 @@ func TestOpenerAdaptorFailure(t *testing.T) {
 }
 
 func TestDetermineLastConfirmedMessageID(t *testing.T) {
-	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 	lastConfirmedMessageID := determineLastConfirmedMessageID(rmq.NewRmqID(5), txnBuffer)
 	assert.Equal(t, rmq.NewRmqID(5), lastConfirmedMessageID)
 	beginMsg := message.NewBeginTxnMessageBuilderV2().
@@ -181,3 +181,96 @@ func TestHandleAlterWALFlushingStagePassesRateLimitComponent(t *testing.T) {
 	assert.Same(t, snapshot, capturedParam.RecoverySnapshot)
 	assert.Equal(t, streamingpb.AlterWALStage_ADVANCE_CHECKPOINT, snapshot.Checkpoint.AlterWalState.Stage)
 }
+
+func TestDetermineWALNameTopic(t *testing.T) {
+	catalog := mock_metastore.NewMockStreamingNodeCataLog(t)
+	resource.InitForTest(t, resource.OptStreamingNodeCatalog(catalog))
+	o := &openerAdaptorImpl{}
+	opt := &wal.OpenOption{Channel: types.PChannelInfo{Name: "pchannel", Term: 1, Topic: "topic-1"}}
+
+	// the topic assigned by streamingcoord is used if there's no checkpoint.
+	catalog.EXPECT().GetConsumeCheckpoint(mock.Anything, "pchannel").Return(nil, nil).Once()
+	_, topic, err := o.determineWALName(context.Background(), opt)
+	assert.NoError(t, err)
+	assert.Equal(t, "topic-1", topic)
+
+	// the topic of checkpoint is used, the pchannel name is used if the checkpoint is written before migration.
+	for _, expected := range []string{"topic-2", ""} {
+		catalog.EXPECT().GetConsumeCheckpoint(mock.Anything, "pchannel").Return(&streamingpb.WALCheckpoint{
+			MessageId: rmq.NewRmqID(1).IntoProto(),
+			Topic:     expected,
+		}, nil).Once()
+		walName, topic, err := o.determineWALName(context.Background(), opt)
+		assert.NoError(t, err)
+		assert.Equal(t, message.WALNameRocksmq, walName)
+		assert.Equal(t, expected, topic)
+	}
+
+	catalog.EXPECT().GetConsumeCheckpoint(mock.Anything, "pchannel").Return(nil, errors.New("test")).Once()
+	_, _, err = o.determineWALName(context.Background(), opt)
+	assert.Error(t, err)
+}
+
+func TestDetermineWALNameTiered(t *testing.T) {
+	catalog := mock_metastore.NewMockStreamingNodeCataLog(t)
+	resource.InitForTest(t, resource.OptStreamingNodeCatalog(catalog))
+	o := &openerAdaptorImpl{}
+	opt := &wal.OpenOption{Channel: types.PChannelInfo{Name: "pchannel", Term: 1}}
+
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTieredEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTieredEnabled.Key)
+
+	// the new pchannel uses the tiered wal.
+	catalog.EXPECT().GetConsumeCheckpoint(mock.Anything, "pchannel").Return(nil, nil).Once()
+	walName, _, err := o.determineWALName(context.Background(), opt)
+	assert.NoError(t, err)
+	assert.Equal(t, message.WALNameTiered, walName)
+
+	// the existing pchannel keeps the wal of its checkpoint.
+	catalog.EXPECT().GetConsumeCheckpoint(mock.Anything, "pchannel").Return(&streamingpb.WALCheckpoint{
+		MessageId: rmq.NewRmqID(1).IntoProto(),
+	}, nil).Once()
+	walName, _, err = o.determineWALName(context.Background(), opt)
+	assert.NoError(t, err)
+	assert.Equal(t, message.WALNameRocksmq, walName)
+}
+
+func TestHandleAlterWALAdvanceCheckpointsStageMigratesTopic(t *testing.T) {
+	channel := types.PChannelInfo{
+		Name:       "alter-wal-topic-test",
+		Term:       1,
+		AccessMode: types.AccessModeRW,
+	}
+	catalog := mock_metastore.NewMockStreamingNodeCataLog(t)
+	catalog.EXPECT().ListVChannel(mock.Anything, channel.Name).Return(nil, nil)
+	catalog.EXPECT().
+		SaveConsumeCheckpoint(mock.Anything, channel.Name, mock.MatchedBy(func(checkpoint *streamingpb.WALCheckpoint) bool {
+			return checkpoint.GetAlterWalState() == nil && checkpoint.GetTopic() == "topic-2" && checkpoint.GetTimeTick() == 10
+		})).
+		Return(nil)
+	resource.InitForTest(t, resource.OptStreamingNodeCatalog(catalog))
+
+	snapshot := &recovery.RecoverySnapshot{
+		Checkpoint: &recovery.WALCheckpoint{
+			MessageID: rmq.NewRmqID(100),
+			TimeTick:  10,
+			Topic:     "topic-1",
+			AlterWalState: &streamingpb.AlterWALState{
+				TargetWalName: commonpb.WALName_RocksMQ,
+				TimeTick:      10,
+				Stage:         streamingpb.AlterWALStage_ADVANCE_CHECKPOINT,
+				TargetTopic:   "topic-2",
+			},
+		},
+		AlterWALInfo: &recovery.AlterWALInfo{
+			FoundAlterWALMsg: true,
+			TargetWALName:    commonpb.WALName_RocksMQ,
+			AlterWALTs:       10,
+			TargetTopic:      "topic-2",
+		},
+	}
+	err := (&openerAdaptorImpl{}).handleAlterWALAdvanceCheckpointsStage(context.Background(), &wal.OpenOption{Channel: channel}, snapshot)
+	require.NoError(t, err)
+	// the checkpoint of recovery snapshot is not modified.
+	assert.Equal(t, "topic-1", snapshot.Checkpoint.Topic)
+}
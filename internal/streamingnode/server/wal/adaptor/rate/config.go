@@ -161,6 +161,8 @@ func newAdaptiveRateLimitControllerConfigFetcher(channel types.PChannelInfo, sou
 		config = &paramtable.Get().StreamingCfg.WALRateLimitRecoveryStorageAdaptiveRateLimit
 	case SourceAppendRate:
 		config = &paramtable.Get().StreamingCfg.WALRateLimitAppendRateAdaptiveRateLimit
+	case SourceConsumerLag:
+		config = &paramtable.Get().StreamingCfg.WALRateLimitConsumerLagAdaptiveRateLimit
 	default:
 		panic("unknown source name")
 	}
@@ -32,7 +32,9 @@ const (
 	SourceNodeMemory          = "memory"
 	SourceFlusherRecovering   = "flusherRecovering"
 	SourceAppendRate          = "appendRate"
+	SourceConsumerLag         = "consumerLag"
 	appendRateCheckerInterval = 2 * time.Second
+	consumerLagCheckInterval  = 5 * time.Second
 )
 
 // RateProvider is an interface that provides the current rate.
@@ -40,6 +42,14 @@ type RateProvider interface {
 	Rate() float64
 }
 
+// ConsumerLagProvider is an interface that provides the lag of the slowest consumer of the wal,
+// such as the flusher and the replicating tasks.
+type ConsumerLagProvider interface {
+	// ConsumerLag returns the lag of the slowest consumer,
+	// return false if the lag is not known yet.
+	ConsumerLag() (time.Duration, bool)
+}
+
 type WALRateLimitComponent struct {
 	*ratelimit.MuxRateLimitObserverRegistryImpl
 	channel           types.PChannelInfo
@@ -47,10 +57,14 @@ type WALRateLimitComponent struct {
 	FlusherRecovering *ratelimit.AdaptiveRateLimitController
 	NodeMemory        *ratelimit.AdaptiveRateLimitController
 	AppendRate        *ratelimit.AdaptiveRateLimitController
+	ConsumerLag       *ratelimit.AdaptiveRateLimitController
 	handler           *hardware.SystemMetricsListener
 
 	appendRateStopCh chan struct{}
 	appendRateWg     sync.WaitGroup
+
+	consumerLagStopCh chan struct{}
+	consumerLagWg     sync.WaitGroup
 }
 
 // NewWALRateLimitComponent creates a new WAL rate limit component.
@@ -69,6 +83,8 @@ func NewWALRateLimitComponent(
 			newAdaptiveRateLimitControllerConfigFetcher(channel, SourceNodeMemory)),
 		AppendRate: ratelimit.NewAdaptiveRateLimitController(channel, SourceAppendRate, rateLimitRegistry,
 			newAdaptiveRateLimitControllerConfigFetcher(channel, SourceAppendRate)),
+		ConsumerLag: ratelimit.NewAdaptiveRateLimitController(channel, SourceConsumerLag, rateLimitRegistry,
+			newAdaptiveRateLimitControllerConfigFetcher(channel, SourceConsumerLag)),
 	}
 }
 
@@ -264,6 +280,103 @@ func (c *appendRateSlowdownChecker) SlowdownStartupHWM() int64 {
 	return int64(c.previousRate)
 }
 
+// RegisterConsumerLagObserver registers the consumer lag observer.
+// It starts a background goroutine to monitor the lag of the slowest consumer of the wal,
+// so the appends are slowed down or rejected when the consumers fall far behind.
+func (c *WALRateLimitComponent) RegisterConsumerLagObserver(lagProvider ConsumerLagProvider) {
+	c.consumerLagStopCh = make(chan struct{})
+	c.consumerLagWg.Add(1)
+	go c.consumerLagObserverLoop(lagProvider)
+}
+
+// consumerLagObserverLoop is the background loop that monitors the consumer lag.
+func (c *WALRateLimitComponent) consumerLagObserverLoop(lagProvider ConsumerLagProvider) {
+	defer c.consumerLagWg.Done()
+
+	ticker := time.NewTicker(consumerLagCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.consumerLagStopCh:
+			return
+		case <-ticker.C:
+			c.checkConsumerLag(lagProvider)
+		}
+	}
+}
+
+// checkConsumerLag checks the current consumer lag and triggers rate limiting if needed.
+func (c *WALRateLimitComponent) checkConsumerLag(lagProvider ConsumerLagProvider) {
+	if !paramtable.Get().StreamingCfg.WALRateLimitConsumerLagEnabled.GetAsBool() {
+		// Recover the appends if the limiter is disabled at runtime.
+		c.ConsumerLag.EnterRecoveryMode()
+		return
+	}
+	lag, ok := lagProvider.ConsumerLag()
+	if !ok {
+		return
+	}
+	metrics.WALRateLimitConsumerLagSeconds.WithLabelValues(
+		paramtable.GetStringNodeID(), c.channel.Name,
+	).Set(lag.Seconds())
+
+	slowdownThreshold := paramtable.Get().StreamingCfg.WALRateLimitConsumerLagSlowdownThreshold.GetAsDurationByParse()
+	recoverThreshold := paramtable.Get().StreamingCfg.WALRateLimitConsumerLagRecoverThreshold.GetAsDurationByParse()
+	rejectThreshold := paramtable.Get().StreamingCfg.WALRateLimitConsumerLagRejectThreshold.GetAsDurationByParse()
+
+	if lag > slowdownThreshold {
+		// Create checker that stops slowdown when the consumers catch up.
+		checker := newConsumerLagSlowdownChecker(lagProvider, lag)
+		c.ConsumerLag.EnterSlowdownMode(checker)
+	}
+	if lag < recoverThreshold {
+		c.ConsumerLag.EnterRecoveryMode()
+	}
+	if lag > rejectThreshold {
+		c.ConsumerLag.EnterRejectMode()
+	}
+}
+
+// consumerLagSlowdownChecker implements ratelimit.SlowdownChecker for consumer lag-based slowdown.
+// It returns false when current lag is lower than the previous lag,
+// indicating that the consumers are catching up and slowdown should stop.
+type consumerLagSlowdownChecker struct {
+	mu          sync.Mutex
+	lagProvider ConsumerLagProvider
+	previousLag time.Duration
+}
+
+// newConsumerLagSlowdownChecker creates a new consumer lag slowdown checker.
+func newConsumerLagSlowdownChecker(lagProvider ConsumerLagProvider, initialLag time.Duration) *consumerLagSlowdownChecker {
+	return &consumerLagSlowdownChecker{
+		lagProvider: lagProvider,
+		previousLag: initialLag,
+	}
+}
+
+// Check returns true if slowdown should continue, false if it should stop.
+// Returns false when current lag is lower than previous (consumers catching up).
+func (c *consumerLagSlowdownChecker) Check() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	currentLag, ok := c.lagProvider.ConsumerLag()
+	if !ok {
+		return true // Continue slowdown if we can't get the lag
+	}
+	if currentLag < c.previousLag {
+		return false
+	}
+	c.previousLag = currentLag
+	return true
+}
+
+// SlowdownStartupHWM returns 0 to use the default HWM from config.
+func (c *consumerLagSlowdownChecker) SlowdownStartupHWM() int64 {
+	return 0
+}
+
 // Close closes the WAL rate limit component.
 // The API of WAL rate limit component is not concurrent-safe.
 func (c *WALRateLimitComponent) Close() {
@@ -274,10 +387,15 @@ func (c *WALRateLimitComponent) Close() {
 		close(c.appendRateStopCh)
 		c.appendRateWg.Wait()
 	}
+	if c.consumerLagStopCh != nil {
+		close(c.consumerLagStopCh)
+		c.consumerLagWg.Wait()
+	}
 	c.RecoveryStorage.Close()
 	c.FlusherRecovering.Close()
 	c.NodeMemory.Close()
 	c.AppendRate.Close()
+	c.ConsumerLag.Close()
 	c.clearMetrics()
 }
 
@@ -287,4 +405,5 @@ func (c *WALRateLimitComponent) clearMetrics() {
 	metrics.WALRateLimitNodeMemoryRecoverThreshold.DeleteLabelValues(paramtable.GetStringNodeID(), c.channel.Name)
 	metrics.WALRateLimitAppendRateSlowdownThreshold.DeleteLabelValues(paramtable.GetStringNodeID(), c.channel.Name)
 	metrics.WALRateLimitAppendRateRecoverThreshold.DeleteLabelValues(paramtable.GetStringNodeID(), c.channel.Name)
+	metrics.WALRateLimitConsumerLagSeconds.DeleteLabelValues(paramtable.GetStringNodeID(), c.channel.Name)
 }
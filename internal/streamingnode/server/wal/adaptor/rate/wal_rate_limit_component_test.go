@@ -375,3 +375,108 @@ func TestMemorySlowdownCheckerConcurrency(t *testing.T) {
 		<-done
 	}
 }
+
+// mockConsumerLagProvider is a mock implementation of ConsumerLagProvider for testing.
+type mockConsumerLagProvider struct {
+	lag   atomic.Int64
+	known atomic.Bool
+}
+
+func (m *mockConsumerLagProvider) ConsumerLag() (time.Duration, bool) {
+	return time.Duration(m.lag.Load()), m.known.Load()
+}
+
+func (m *mockConsumerLagProvider) SetLag(lag time.Duration) {
+	m.lag.Store(int64(lag))
+	m.known.Store(true)
+}
+
+func TestConsumerLagRateLimiting(t *testing.T) {
+	paramtable.Init()
+	channel := types.PChannelInfo{Name: "test-channel-consumer-lag"}
+	component := NewWALRateLimitComponent(channel)
+
+	lagProvider := &mockConsumerLagProvider{}
+	component.RegisterConsumerLagObserver(lagProvider)
+	assert.NotNil(t, component.consumerLagStopCh)
+
+	// Test: when disabled (default), rate limiting should not trigger
+	lagProvider.SetLag(time.Hour)
+	component.checkConsumerLag(lagProvider)
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, component.IsRejected())
+
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALRateLimitConsumerLagEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALRateLimitConsumerLagEnabled.Key)
+
+	// Test: unknown lag should not trigger anything
+	lagProvider.known.Store(false)
+	component.checkConsumerLag(lagProvider)
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, component.IsRejected())
+
+	// Test: lag above slowdown(10m) but below reject(30m) should not reject
+	lagProvider.SetLag(15 * time.Minute)
+	component.checkConsumerLag(lagProvider)
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, component.IsRejected())
+
+	// Test: lag above reject(30m) should reject
+	lagProvider.SetLag(time.Hour)
+	component.checkConsumerLag(lagProvider)
+	assert.Eventually(t, func() bool {
+		return component.IsRejected()
+	}, 1*time.Second, 10*time.Millisecond)
+
+	// Test: lag above recover(5m) should keep rejecting
+	lagProvider.SetLag(8 * time.Minute)
+	component.checkConsumerLag(lagProvider)
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, component.IsRejected())
+
+	// Test: lag below recover(5m) should trigger recovery
+	lagProvider.SetLag(time.Minute)
+	component.checkConsumerLag(lagProvider)
+	assert.Eventually(t, func() bool {
+		return !component.IsRejected()
+	}, 1*time.Second, 10*time.Millisecond)
+
+	// Test: disable the limiter at runtime should recover the appends
+	lagProvider.SetLag(time.Hour)
+	component.checkConsumerLag(lagProvider)
+	assert.Eventually(t, func() bool {
+		return component.IsRejected()
+	}, 1*time.Second, 10*time.Millisecond)
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALRateLimitConsumerLagEnabled.Key, "false")
+	component.checkConsumerLag(lagProvider)
+	assert.Eventually(t, func() bool {
+		return !component.IsRejected()
+	}, 1*time.Second, 10*time.Millisecond)
+
+	component.Close()
+	select {
+	case <-component.consumerLagStopCh:
+	default:
+		t.Error("consumerLagStopCh should be closed after Close()")
+	}
+}
+
+func TestConsumerLagSlowdownChecker(t *testing.T) {
+	lagProvider := &mockConsumerLagProvider{}
+	lagProvider.SetLag(20 * time.Minute)
+	checker := newConsumerLagSlowdownChecker(lagProvider, 20*time.Minute)
+
+	// Check should return true when lag stays the same or increases
+	assert.True(t, checker.Check())
+	lagProvider.SetLag(25 * time.Minute)
+	assert.True(t, checker.Check())
+
+	// Unknown lag should continue slowdown
+	lagProvider.known.Store(false)
+	assert.True(t, checker.Check())
+
+	// Decrease the lag - should return false to stop slowdown
+	lagProvider.SetLag(15 * time.Minute)
+	assert.False(t, checker.Check())
+	assert.Equal(t, int64(0), checker.SlowdownStartupHWM())
+}
@@ -29,7 +29,7 @@ type recoveryStreamBuilderImpl struct {
 
 // Build builds a recovery stream.
 func (b *recoveryStreamBuilderImpl) Build(param recovery.BuildRecoveryStreamParam) recovery.RecoveryStream {
-	scanner := newRecoveryScannerAdaptor(b.roWALImpls, param.StartCheckpoint, b.scanMetrics.NewScannerMetrics())
+	scanner := newRecoveryScannerAdaptor(b.roWALImpls, param.StartCheckpoint, b.scanMetrics.NewScannerMetrics("recovery"), b.timeTickIndex)
 	recoveryStream := &recoveryStreamImpl{
 		notifier:  syncutil.NewAsyncTaskNotifier[error](),
 		param:     param,
@@ -12,6 +12,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
@@ -32,6 +33,7 @@ type roWALAdaptorImpl struct {
 	scanners        *typeutil.ConcurrentMap[int64, wal.Scanner]
 	cleanup         func()
 	scanMetrics     *metricsutil.ScanMetrics
+	timeTickIndex   *timeTickIndex
 	forceRecovery   bool
 }
 
@@ -63,6 +65,10 @@ func (w *roWALAdaptorImpl) GetSalvageCheckpoint() []*wal.ReplicateCheckpoint {
 	panic("we cannot get salvage checkpoint from a read only wal")
 }
 
+func (w *roWALAdaptorImpl) Truncate(ctx context.Context, truncateTo message.MessageID) error {
+	panic("we cannot truncate a read only wal")
+}
+
 // Append writes a record to the log.
 func (w *roWALAdaptorImpl) Append(ctx context.Context, msg message.MutableMessage) (*wal.AppendResult, error) {
 	panic("we cannot append message into a read only wal")
@@ -90,6 +96,15 @@ func (w *roWALAdaptorImpl) Read(ctx context.Context, opts wal.ReadOption) (wal.S
 		return nil, mismatchWALNameErr
 	}
 
+	if policy := opts.DeliverPolicy.GetStartFromTimeTick(); policy != nil {
+		// Seek the position of the time tick by the time tick index,
+		// and filter out the messages before the time tick.
+		opts.DeliverPolicy = w.timeTickIndex.Seek(policy.GetTimeTick())
+		filters := make([]options.DeliverFilter, 0, len(opts.MessageFilter)+1)
+		filters = append(filters, opts.MessageFilter...)
+		opts.MessageFilter = append(filters, options.DeliverFilterTimeTickGTE(policy.GetTimeTick()))
+	}
+
 	name, err := w.scannerRegistry.AllocateScannerName()
 	if err != nil {
 		return nil, err
@@ -100,9 +115,10 @@ func (w *roWALAdaptorImpl) Read(ctx context.Context, opts wal.ReadOption) (wal.S
 		name,
 		w.roWALImpls,
 		opts,
-		w.scanMetrics.NewScannerMetrics(),
+		w.scanMetrics.NewScannerMetrics(name),
 		func() { w.scanners.Remove(id) },
-		w.forceRecovery)
+		w.forceRecovery,
+		w.timeTickIndex)
 	w.scanners.Insert(id, s)
 	return s, nil
 }
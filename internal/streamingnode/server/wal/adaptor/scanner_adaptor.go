@@ -51,6 +51,7 @@ var (
 func newRecoveryScannerAdaptor(l walimpls.ROWALImpls,
 	startMessageID message.MessageID,
 	scanMetrics *metricsutil.ScannerMetrics,
+	timeTickIndex *timeTickIndex,
 ) *scannerAdaptorImpl {
 	name := "recovery"
 	logger := resource.Resource().Logger().With(
@@ -71,6 +72,7 @@ func newRecoveryScannerAdaptor(l walimpls.ROWALImpls,
 		innerWAL:        l,
 		readOption:      readOption,
 		filterFunc:      func(message.ImmutableMessage) bool { return true },
+		timeTickIndex:   timeTickIndex,
 		reorderBuffer:   utility.NewReOrderBuffer(),
 		pendingQueue:    utility.NewPendingQueue(),
 		txnBuffer:       utility.NewTxnBuffer(logger, scanMetrics),
@@ -91,6 +93,7 @@ func newScannerAdaptor(
 	scanMetrics *metricsutil.ScannerMetrics,
 	cleanup func(),
 	recovery bool,
+	timeTickIndex *timeTickIndex,
 ) *scannerAdaptorImpl {
 	if readOption.MesasgeHandler == nil {
 		readOption.MesasgeHandler = adaptor.ChanMessageHandler(make(chan message.ImmutableMessage))
@@ -107,6 +110,7 @@ func newScannerAdaptor(
 		innerWAL:        l,
 		readOption:      readOption,
 		filterFunc:      options.GetFilterFunc(readOption.MessageFilter),
+		timeTickIndex:   timeTickIndex,
 		reorderBuffer:   utility.NewReOrderBuffer(),
 		pendingQueue:    utility.NewPendingQueue(),
 		txnBuffer:       utility.NewTxnBuffer(logger, scanMetrics),
@@ -127,6 +131,7 @@ type scannerAdaptorImpl struct {
 	innerWAL      walimpls.ROWALImpls
 	readOption    wal.ReadOption
 	filterFunc    func(message.ImmutableMessage) bool
+	timeTickIndex *timeTickIndex                   // the time tick index of the wal, seeded by the scanned time tick messages.
 	reorderBuffer *utility.ReOrderByTimeTickBuffer // support time tick reorder.
 	pendingQueue  *utility.PendingQueue
 	txnBuffer     *utility.TxnBuffer // txn buffer for txn message.
@@ -167,14 +172,18 @@ func (s *scannerAdaptorImpl) clear() {
 }
 
 func (s *scannerAdaptorImpl) execute() {
+	var finishErr error
 	defer func() {
 		s.readOption.MesasgeHandler.Close()
-		s.Finish(nil)
+		s.Finish(finishErr)
 		s.logger.Info(context.TODO(), "scanner is closed")
 	}()
 	s.logger.Info(context.TODO(), "scanner start background task")
 
 	msgChan := make(chan message.ImmutableMessage)
+	// the consuming event loop is stopped if a corrupted message is found by the produce event loop.
+	consumeCtx, cancelConsume := context.WithCancelCause(s.Context())
+	defer cancelConsume(nil)
 
 	ch := make(chan struct{})
 	defer func() { <-ch }()
@@ -187,9 +196,18 @@ func (s *scannerAdaptorImpl) execute() {
 			return
 		}
 		s.logger.Warn(context.TODO(), "the produce event loop of scanner is closed with unexpected error", mlog.Err(err))
+		var corrupted *message.CorruptedMessageError
+		if errors.As(err, &corrupted) {
+			cancelConsume(err)
+		}
 	}()
 
-	err := s.consumeEventLoop(msgChan)
+	err := s.consumeEventLoop(consumeCtx, msgChan)
+	if cause := context.Cause(consumeCtx); s.Context().Err() == nil && cause != nil {
+		finishErr = cause
+		s.logger.Warn(context.TODO(), "the consuming event loop of scanner is stopped by corrupted message", mlog.Err(cause))
+		return
+	}
 	if errors.Is(err, context.Canceled) {
 		s.logger.Info(context.TODO(), "the consuming event loop of scanner is closed")
 		return
@@ -241,7 +259,7 @@ func (s *scannerAdaptorImpl) produceEventLoop(msgChan chan<- message.ImmutableMe
 }
 
 // consumeEventLoop consumes the message from the message channel and handle it.
-func (s *scannerAdaptorImpl) consumeEventLoop(msgChan <-chan message.ImmutableMessage) error {
+func (s *scannerAdaptorImpl) consumeEventLoop(ctx context.Context, msgChan <-chan message.ImmutableMessage) error {
 	s.waitUntilStartConsumption()
 	for {
 		var upstream <-chan message.ImmutableMessage
@@ -252,15 +270,17 @@ func (s *scannerAdaptorImpl) consumeEventLoop(msgChan <-chan message.ImmutableMe
 			upstream = msgChan
 		}
 		// generate the event channel and do the event loop.
+		next := s.pendingQueue.Next()
 		handleResult := s.readOption.MesasgeHandler.Handle(message.HandleParam{
-			Ctx:      s.Context(),
+			Ctx:      ctx,
 			Upstream: upstream,
-			Message:  s.pendingQueue.Next(),
+			Message:  next,
 		})
 		if handleResult.Error != nil {
 			return handleResult.Error
 		}
 		if handleResult.MessageHandled {
+			s.metrics.ObserveDeliveredTimeTick(next.TimeTick())
 			s.pendingQueue.UnsafeAdvance()
 			s.metrics.UpdatePendingQueueSize(s.pendingQueue.Bytes())
 		}
@@ -350,6 +370,10 @@ func (s *scannerAdaptorImpl) handleUpstream(msg message.ImmutableMessage) {
 	msg, isTailing = isTailingScanImmutableMessage(msg)
 	s.metrics.ObserveMessage(isTailing, msg.MessageType(), msg.EstimateSize())
 	if msg.MessageType() == message.MessageTypeTimeTick {
+		if s.timeTickIndex != nil && msg.IsPersisted() {
+			// seed the time tick index by the persisted time tick messages.
+			s.timeTickIndex.Observe(msg.TimeTick(), msg.LastConfirmedMessageID())
+		}
 		// If the time tick message incoming,
 		// the reorder buffer can be consumed until latest confirmed timetick.
 		messages := s.reorderBuffer.PopUtilTimeTick(msg.TimeTick())
@@ -37,6 +37,7 @@ func TestScannerAdaptorReadError(t *testing.T) {
 
 	operator := mock_inspector.NewMockTimeTickSyncOperator(t)
 	operator.EXPECT().Channel().Return(types.PChannelInfo{})
+	operator.EXPECT().SyncInterval().Return(200 * time.Millisecond).Maybe()
 	operator.EXPECT().Sync(mock.Anything, mock.Anything).Run(func(ctx context.Context, forcePersisted bool) {
 		sig1.Close()
 	})
@@ -60,8 +61,8 @@ func TestScannerAdaptorReadError(t *testing.T) {
 			DeliverPolicy: options.DeliverPolicyAll(),
 			MessageFilter: nil,
 		},
-		metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics(),
-		func() {}, false)
+		metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"),
+		func() {}, false, nil)
 	// wait for timetick inspector first round
 	<-sig1.CloseCh()
 	// wait for scanner backoff 2 rounds
@@ -87,7 +88,7 @@ func TestPauseConsumption(t *testing.T) {
 		pendingQueue:  utility.NewPendingQueue(),
 		cleanup:       func() {},
 		ScannerHelper: helper.NewScannerHelper("test"),
-		metrics:       metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics(),
+		metrics:       metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"),
 	}
 
 	done := make(chan struct{})
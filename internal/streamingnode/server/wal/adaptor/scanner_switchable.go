@@ -127,6 +127,11 @@ func (s *catchupScanner) Do(ctx context.Context) (switchableScanner, error) {
 		}
 		switchedScanner, err := s.consumeWithScanner(ctx, scanner)
 		if err != nil {
+			var corrupted *message.CorruptedMessageError
+			if errors.As(err, &corrupted) {
+				// the corrupted message can never be consumed by retrying, so stop the scanner.
+				return nil, err
+			}
 			s.logger.Warn(ctx, "scanner consuming was interrpurted with error, start a backoff", mlog.Err(err))
 			continue
 		}
@@ -171,6 +176,11 @@ func (s *catchupScanner) consumeWithScanner(ctx context.Context, scanner walimpl
 				}
 			}
 
+			if err := message.VerifyChecksum(s.innerWAL.Channel().Name, msg); err != nil {
+				s.logger.Error(ctx, "corrupted message is found when scanning wal", mlog.Err(err))
+				return nil, err
+			}
+
 			if msg.TimeTick() <= s.exclusiveStartTimeTick {
 				// we should filter out the message that less than or equal to this time tick to remove duplicate message
 				// when we switch from tailing mode to catchup mode.
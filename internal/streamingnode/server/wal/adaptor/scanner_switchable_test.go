@@ -1,11 +1,20 @@
 package adaptor
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/mocks/streaming/mock_walimpls"
 	mock_message "github.com/milvus-io/milvus/pkg/v3/mocks/streaming/util/mock_message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
 )
 
 func TestOldVersionLastConfirmedTracker_DefaultWindowSize(t *testing.T) {
@@ -106,3 +115,25 @@ func TestOldVersionLastConfirmedTracker_LargeWindow(t *testing.T) {
 		}
 	}
 }
+
+func TestCatchupScannerCorruptedMessage(t *testing.T) {
+	paramtable.Init()
+	msg := message.WithChecksum(message.CreateTestEmptyInsertMesage(1, nil), true)
+	corrupted := message.NewImmutableMesasge(walimplstest.NewTestMessageID(1), []byte("corrupted"), msg.Properties().ToRawMap())
+
+	ch := make(chan message.ImmutableMessage, 1)
+	ch <- corrupted
+	scannerImpls := mock_walimpls.NewMockScannerImpls(t)
+	scannerImpls.EXPECT().Chan().Return(ch)
+	scannerImpls.EXPECT().Close().Return(nil)
+	innerWAL := mock_walimpls.NewMockWALImpls(t)
+	innerWAL.EXPECT().Channel().Return(types.PChannelInfo{Name: "p1"})
+	innerWAL.EXPECT().Read(mock.Anything, mock.Anything).Return(scannerImpls, nil)
+
+	scanner := newSwithableScanner("test", mlog.With(), innerWAL, nil, options.DeliverPolicyAll(), make(chan message.ImmutableMessage))
+	_, err := scanner.Do(context.Background())
+	var corruptedErr *message.CorruptedMessageError
+	assert.ErrorAs(t, err, &corruptedErr)
+	assert.Equal(t, "p1", corruptedErr.Channel)
+	assert.True(t, corruptedErr.MessageID.EQ(walimplstest.NewTestMessageID(1)))
+}
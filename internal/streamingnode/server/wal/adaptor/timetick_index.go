@@ -0,0 +1,74 @@
+package adaptor
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+// newTimeTickIndex creates a new time tick index.
+func newTimeTickIndex() *timeTickIndex {
+	return &timeTickIndex{}
+}
+
+// timeTickIndex is a sparse in-memory index from the time tick to the last confirmed message id of the time tick messages of a wal.
+// Consuming from the last confirmed message id of a time tick message never loses the message whose time tick is greater than it,
+// so it can be used to seek the position of the wal by a time tick.
+// The index is fed by the appended time tick messages of the read-write wal and the persisted time tick messages read by the scanners,
+// so it's seeded from the consume checkpoint by the recovery scanner after the wal is reopened.
+type timeTickIndex struct {
+	mu      sync.Mutex
+	entries []timeTickIndexEntry
+}
+
+// timeTickIndexEntry is an entry of the time tick index.
+type timeTickIndexEntry struct {
+	timeTick               uint64
+	lastConfirmedMessageID message.MessageID
+}
+
+// Observe observes a time tick message appended into the wal.
+// The entry is sampled by the interval of the physical time of the time tick.
+func (idx *timeTickIndex) Observe(timeTick uint64, lastConfirmedMessageID message.MessageID) {
+	if lastConfirmedMessageID == nil {
+		return
+	}
+	cfg := &paramtable.Get().StreamingCfg
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if len(idx.entries) > 0 {
+		last := idx.entries[len(idx.entries)-1]
+		if timeTick <= last.timeTick ||
+			tsoutil.PhysicalTime(timeTick).Sub(tsoutil.PhysicalTime(last.timeTick)) < cfg.WALTimeTickIndexInterval.GetAsDurationByParse() {
+			return
+		}
+	}
+	idx.entries = append(idx.entries, timeTickIndexEntry{
+		timeTick:               timeTick,
+		lastConfirmedMessageID: lastConfirmedMessageID,
+	})
+	if maxEntries := cfg.WALTimeTickIndexMaxEntries.GetAsInt(); maxEntries > 0 && len(idx.entries) > maxEntries {
+		idx.entries = append(idx.entries[:0:0], idx.entries[len(idx.entries)-maxEntries:]...)
+	}
+}
+
+// Seek returns the deliver policy to start consuming the messages whose time tick is greater than or equal to the given time tick.
+// All messages are delivered if the time tick is not covered by the index, so the deliver filter of the time tick is always required.
+func (idx *timeTickIndex) Seek(timeTick uint64) options.DeliverPolicy {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	// find the last entry whose time tick is less than the given time tick.
+	i := sort.Search(len(idx.entries), func(i int) bool {
+		return idx.entries[i].timeTick >= timeTick
+	})
+	if i == 0 {
+		return options.DeliverPolicyAll()
+	}
+	return options.DeliverPolicyStartFrom(idx.entries[i-1].lastConfirmedMessageID)
+}
@@ -0,0 +1,100 @@
+package adaptor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/metricsutil"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+func TestTimeTickIndex(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTimeTickIndexMaxEntries.Key, "3")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTimeTickIndexMaxEntries.Key)
+
+	now := time.Now()
+	tt := func(second int) uint64 {
+		return tsoutil.ComposeTSByTime(now.Add(time.Duration(second)*time.Second), 0)
+	}
+
+	idx := newTimeTickIndex()
+	_ = idx.Seek(tt(0)).GetPolicy().(*streamingpb.DeliverPolicy_All)
+
+	idx.Observe(tt(0), nil)
+	idx.Observe(tt(1), walimplstest.NewTestMessageID(1))
+	// sampled by the interval.
+	idx.Observe(tt(1)+1, walimplstest.NewTestMessageID(2))
+	idx.Observe(tt(2), walimplstest.NewTestMessageID(3))
+	idx.Observe(tt(3), walimplstest.NewTestMessageID(4))
+	assert.Len(t, idx.entries, 3)
+	// the earliest entry is evicted.
+	idx.Observe(tt(4), walimplstest.NewTestMessageID(5))
+	assert.Len(t, idx.entries, 3)
+	assert.Equal(t, tt(2), idx.entries[0].timeTick)
+
+	// the time tick before the earliest entry is not covered.
+	_ = idx.Seek(tt(1)).GetPolicy().(*streamingpb.DeliverPolicy_All)
+	_ = idx.Seek(tt(2)).GetPolicy().(*streamingpb.DeliverPolicy_All)
+
+	// start from the last confirmed message id of the last entry less than the time tick.
+	policy := idx.Seek(tt(2) + 1)
+	assert.Equal(t, walimplstest.NewTestMessageID(3).Marshal(), policy.GetStartFrom().GetId())
+	policy = idx.Seek(tt(4))
+	assert.Equal(t, walimplstest.NewTestMessageID(4).Marshal(), policy.GetStartFrom().GetId())
+	policy = idx.Seek(tt(10))
+	assert.Equal(t, walimplstest.NewTestMessageID(5).Marshal(), policy.GetStartFrom().GetId())
+}
+
+func TestScannerSeedTimeTickIndex(t *testing.T) {
+	paramtable.Init()
+
+	now := time.Now()
+	idx := newTimeTickIndex()
+	scanner := &scannerAdaptorImpl{
+		logger:          mlog.With(),
+		filterFunc:      func(message.ImmutableMessage) bool { return true },
+		timeTickIndex:   idx,
+		reorderBuffer:   utility.NewReOrderBuffer(),
+		pendingQueue:    utility.NewPendingQueue(),
+		txnBuffer:       utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test")),
+		cleanup:         func() {},
+		ScannerHelper:   helper.NewScannerHelper("test"),
+		metrics:         metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"),
+		readRateCounter: utility.NewAverageRateCounter(10 * time.Second),
+	}
+	newTimeTickMessage := func(second int, id int64, persisted bool) message.ImmutableMessage {
+		b := message.NewTimeTickMessageBuilderV1().
+			WithAllVChannel().
+			WithHeader(&message.TimeTickMessageHeader{}).
+			WithBody(&msgpb.TimeTickMsg{})
+		if !persisted {
+			b.WithNotPersisted()
+		}
+		return b.MustBuildMutable().
+			WithTimeTick(tsoutil.ComposeTSByTime(now.Add(time.Duration(second)*time.Second), 0)).
+			WithLastConfirmed(walimplstest.NewTestMessageID(id)).
+			IntoImmutableMessage(walimplstest.NewTestMessageID(id))
+	}
+
+	// only the persisted time tick message seeds the index.
+	scanner.handleUpstream(newTimeTickMessage(1, 1, false))
+	assert.Empty(t, idx.entries)
+	scanner.handleUpstream(newTimeTickMessage(2, 2, true))
+	scanner.handleUpstream(newTimeTickMessage(3, 3, true))
+	assert.Len(t, idx.entries, 2)
+
+	policy := idx.Seek(tsoutil.ComposeTSByTime(now.Add(3*time.Second), 0))
+	assert.Equal(t, walimplstest.NewTestMessageID(2).Marshal(), policy.GetStartFrom().GetId())
+}
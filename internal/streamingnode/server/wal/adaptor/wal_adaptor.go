@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/adaptor/rate"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/metricsutil"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/recovery"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
 	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
@@ -23,12 +24,17 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
 	"github.com/milvus-io/milvus/pkg/v3/util/conc"
 	"github.com/milvus-io/milvus/pkg/v3/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
 var _ wal.WAL = (*walAdaptorImpl)(nil)
 
-type gracefulCloseFunc func()
+type (
+	gracefulCloseFunc func()
+	handoffFunc       func(ctx context.Context, append interceptors.Append) (uint64, error)
+)
 
 // adaptImplsToROWAL creates a new readonly wal from wal impls.
 func adaptImplsToROWAL(
@@ -52,9 +58,10 @@ func adaptImplsToROWAL(
 			channel:     basicWAL.Channel(),
 			idAllocator: typeutil.NewIDAllocator(),
 		},
-		scanners:    typeutil.NewConcurrentMap[int64, wal.Scanner](),
-		cleanup:     cleanup,
-		scanMetrics: metricsutil.NewScanMetrics(basicWAL.Channel()),
+		scanners:      typeutil.NewConcurrentMap[int64, wal.Scanner](),
+		cleanup:       cleanup,
+		scanMetrics:   metricsutil.NewScanMetrics(basicWAL.Channel()),
+		timeTickIndex: newTimeTickIndex(),
 	}
 	roWAL.SetLogger(logger)
 	return roWAL
@@ -82,11 +89,16 @@ func adaptImplsToRWWAL(
 		writeMetrics:           metricsutil.NewWriteMetrics(roWAL.Channel(), roWAL.WALName()),
 		isFenced:               atomic.NewBool(false),
 		appendRateCounter:      utility.NewAverageRateCounter(10 * time.Second), // 10 second sliding window
+		appendBytesTotal:       atomic.NewUint64(0),
+	}
+	if appender, ok := wal.rwWALImpls.(walimpls.BatchAppender); ok && paramtable.Get().StreamingCfg.WALAppendBatchEnabled.GetAsBool() {
+		wal.appendBatcher = newAppendBatcher(appender)
 	}
 	wal.writeMetrics.SetLogger(wal.Logger())
 	interceptorParam.WAL.Set(wal)
 	wal.RegisterMemoryObserver()
 	wal.RegisterAppendRateObserver(wal.appendRateCounter)
+	wal.RegisterConsumerLagObserver(wal)
 	return wal
 }
 
@@ -102,6 +114,8 @@ type walAdaptorImpl struct {
 	writeMetrics           *metricsutil.WriteMetrics
 	isFenced               *atomic.Bool
 	appendRateCounter      *utility.AverageRateCounter // tracks append rate (bytes/sec)
+	appendBytesTotal       *atomic.Uint64              // tracks total appended bytes
+	appendBatcher          *appendBatcher              // coalesces the appends into batches, nil if the append batching is disabled
 }
 
 // Metrics returns the metrics of the wal.
@@ -109,10 +123,35 @@ func (w *walAdaptorImpl) Metrics() types.WALMetrics {
 	currentMVCC := w.param.MVCCManager.GetMVCCOfVChannel(w.Channel().Name)
 	recoveryMetrics := w.flusher.Metrics()
 	return types.RWWALMetrics{
-		ChannelInfo:      w.Channel(),
-		MVCCTimeTick:     currentMVCC.Timetick,
-		RecoveryTimeTick: recoveryMetrics.RecoveryTimeTick,
+		ChannelInfo:          w.Channel(),
+		MVCCTimeTick:         currentMVCC.Timetick,
+		RecoveryTimeTick:     recoveryMetrics.RecoveryTimeTick,
+		AppendBytesPerSecond: w.appendRateCounter.Rate(),
+		AppendBytesTotal:     w.appendBytesTotal.Load(),
+	}
+}
+
+// ConsumerLag returns the lag of the slowest consumer of the wal,
+// which is the time between the latest time tick of the wal and the slowest checkpoint of the flusher and the replicating tasks.
+func (w *walAdaptorImpl) ConsumerLag() (time.Duration, bool) {
+	if w.flusher == nil {
+		// only in test, the flusher is nil.
+		return 0, false
 	}
+	checkpoint := w.flusher.GetFlusherCheckpointByTimeTick(w.availableCtx)
+	if checkpoint == nil {
+		// the flusher is not ready.
+		return 0, false
+	}
+	slowest := checkpoint.TimeTick
+	if tt := recovery.GetReplicateCheckpointTimeTick(w.Channel().Name); tt != 0 && tt < slowest {
+		slowest = tt
+	}
+	latest := w.param.MVCCManager.GetMVCCOfVChannel(w.Channel().Name).Timetick
+	if latest <= slowest {
+		return 0, true
+	}
+	return tsoutil.PhysicalTime(latest).Sub(tsoutil.PhysicalTime(slowest)), true
 }
 
 // GetLatestMVCCTimestamp get the latest mvcc timestamp of the wal at vchannel.
@@ -149,6 +188,20 @@ func (w *walAdaptorImpl) GetSalvageCheckpoint() []*utility.ReplicateCheckpoint {
 	return w.param.ReplicateManager.GetSalvageCheckpoint()
 }
 
+// Truncate truncates the wal to the given message id explicitly.
+func (w *walAdaptorImpl) Truncate(ctx context.Context, truncateTo message.MessageID) error {
+	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
+		return status.NewOnShutdownError("wal is on shutdown")
+	}
+	defer w.lifetime.Done()
+
+	if w.flusher == nil {
+		// only in test, the flusher is nil.
+		return status.NewInvalidArgument("wal cannot be truncated without flusher")
+	}
+	return w.flusher.TruncateWAL(ctx, truncateTo)
+}
+
 // Append writes a record to the log.
 func (w *walAdaptorImpl) Append(ctx context.Context, msg message.MutableMessage) (*wal.AppendResult, error) {
 	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -156,6 +209,11 @@ func (w *walAdaptorImpl) Append(ctx context.Context, msg message.MutableMessage)
 	}
 	defer w.lifetime.Done()
 
+	return w.append(ctx, msg)
+}
+
+// append writes a record to the log without the lifetime check.
+func (w *walAdaptorImpl) append(ctx context.Context, msg message.MutableMessage) (*wal.AppendResult, error) {
 	if w.isFenced.Load() {
 		// if the wal is fenced, we should reject all append operations.
 		return nil, status.NewChannelFenced(w.Channel().String())
@@ -199,6 +257,8 @@ func (w *walAdaptorImpl) Append(ctx context.Context, msg message.MutableMessage)
 				// do not persist the message if the hint is set.
 				return notPersistHint.MessageID, nil
 			}
+			// the checksum is calculated after all interceptors, so it covers the payload written into the wal.
+			msg = message.WithChecksum(msg, paramtable.Get().StreamingCfg.WALChecksumEnabled.GetAsBool())
 			metricsGuard.StartWALImplAppend()
 			msgID, err := w.retryAppendWhenRecoverableError(ctx, msg)
 			metricsGuard.FinishWALImplAppend()
@@ -210,6 +270,7 @@ func (w *walAdaptorImpl) Append(ctx context.Context, msg message.MutableMessage)
 		if errors.Is(err, walimpls.ErrFenced) {
 			// if the append operation of wal is fenced, we should report the error to the client.
 			if w.isFenced.CompareAndSwap(false, true) {
+				w.writeMetrics.ObserveFenced()
 				w.forceCancelAfterGracefulTimeout()
 				w.Logger().Warn(ctx, "wal is fenced, mark as unavailable, all append opertions will be rejected", mlog.Err(err))
 			}
@@ -225,7 +286,11 @@ func (w *walAdaptorImpl) Append(ctx context.Context, msg message.MutableMessage)
 		w.forceCancelAfterGracefulTimeout()
 		w.Logger().Info(ctx, "WAL marked as fenced for WAL switch, all append operations will be rejected")
 	}
+	if msg.MessageType() == message.MessageTypeTimeTick {
+		w.timeTickIndex.Observe(extraAppendResult.TimeTick, extraAppendResult.LastConfirmedMessageID)
+	}
 	w.appendRateCounter.Add(int64(msg.EstimateSize()))
+	w.appendBytesTotal.Add(uint64(msg.EstimateSize()))
 
 	var extra *anypb.Any
 	if extraAppendResult.Extra != nil {
@@ -264,7 +329,7 @@ func (w *walAdaptorImpl) retryAppendWhenRecoverableError(ctx context.Context, ms
 
 	// An append operation should be retried until it succeeds or some unrecoverable error occurs.
 	for i := 0; ; i++ {
-		msgID, err := w.rwWALImpls.Append(ctx, msg)
+		msgID, err := w.appendIntoWALImpls(ctx, msg)
 		if err == nil {
 			if msg.MessageType() == message.MessageTypeAlterWAL {
 				// if the append operation is a alter WAL message, we should log the message
@@ -289,6 +354,14 @@ func (w *walAdaptorImpl) retryAppendWhenRecoverableError(ctx context.Context, ms
 	}
 }
 
+// appendIntoWALImpls appends the message into the underlying wal, with the next batch if the append batching is enabled.
+func (w *walAdaptorImpl) appendIntoWALImpls(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	if w.appendBatcher != nil {
+		return w.appendBatcher.Append(ctx, msg)
+	}
+	return w.rwWALImpls.Append(ctx, msg)
+}
+
 // AppendAsync writes a record to the log asynchronously.
 func (w *walAdaptorImpl) AppendAsync(ctx context.Context, msg message.MutableMessage, cb func(*wal.AppendResult, error)) {
 	if !w.lifetime.Add(typeutil.LifetimeStateWorking) {
@@ -317,6 +390,10 @@ func (w *walAdaptorImpl) Close() {
 	w.lifetime.SetState(typeutil.LifetimeStateStopped)
 	w.forceCancelAfterGracefulTimeout()
 	w.lifetime.Wait()
+	if w.appendBatcher != nil {
+		w.appendBatcher.Close()
+	}
+	w.handoff()
 
 	// close the flusher.
 	w.Logger().Info(context.TODO(), "wal begin to close flusher...")
@@ -362,9 +439,46 @@ func (w *walAdaptorImpl) Close() {
 	}
 }
 
+// handoff syncs a persisted time tick after all append operations are finished and marks the wal is handed off cleanly,
+// so the new owner of the wal can skip the recovery from wal stream.
+func (w *walAdaptorImpl) handoff() {
+	if w.flusher == nil || w.isFenced.Load() || !paramtable.Get().StreamingCfg.WALRecoveryHandoffEnabled.GetAsBool() {
+		// only in test, the flusher is nil.
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), paramtable.Get().StreamingCfg.WALRecoveryHandoffTimeout.GetAsDurationByParse())
+	defer cancel()
+
+	timetick, err := w.interceptorBuildResult.HandoffFunc(ctx, func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		result, err := w.append(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		return result.MessageID, nil
+	})
+	if err != nil {
+		w.Logger().Warn(ctx, "wal cannot be handed off cleanly", mlog.Err(err))
+		return
+	}
+	// wait for the flusher to observe the last time tick, otherwise the checkpoint cannot cover all messages of the wal.
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for w.flusher.Metrics().RecoveryTimeTick < timetick {
+		select {
+		case <-ctx.Done():
+			w.Logger().Warn(ctx, "wal cannot be handed off cleanly, the flusher doesn't catch up the last time tick", mlog.Uint64("timetick", timetick))
+			return
+		case <-ticker.C:
+		}
+	}
+	w.flusher.MarkCleanHandoff(timetick)
+	w.Logger().Info(ctx, "wal is handed off cleanly", mlog.Uint64("timetick", timetick))
+}
+
 type interceptorBuildResult struct {
 	Interceptor       interceptors.InterceptorWithReady
 	GracefulCloseFunc gracefulCloseFunc
+	HandoffFunc       handoffFunc
 }
 
 func (r interceptorBuildResult) Close() {
@@ -387,5 +501,13 @@ func buildInterceptor(builders []interceptors.InterceptorBuilder, param *interce
 				}
 			}
 		},
+		HandoffFunc: func(ctx context.Context, append interceptors.Append) (uint64, error) {
+			for _, i := range builtIterceptors {
+				if h, ok := i.(interceptors.InterceptorWithHandoff); ok {
+					return h.Handoff(ctx, append)
+				}
+			}
+			return 0, errors.New("no interceptor can hand off the wal")
+		},
 	}
 }
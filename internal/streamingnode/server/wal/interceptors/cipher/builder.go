@@ -0,0 +1,27 @@
+package cipher
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+)
+
+// NewInterceptorBuilder creates a new cipher interceptor builder.
+func NewInterceptorBuilder() interceptors.InterceptorBuilder {
+	return &interceptorBuilder{}
+}
+
+// interceptorBuilder is the builder for cipher interceptor.
+type interceptorBuilder struct{}
+
+// Build creates a new cipher interceptor.
+func (b *interceptorBuilder) Build(param *interceptors.InterceptorBuildParam) interceptors.Interceptor {
+	schemaGetter, _ := param.ShardManager.(collectionSchemaGetter)
+	return &cipherAppendInterceptor{
+		schemaGetter: schemaGetter,
+		logger: resource.Resource().Logger().With(
+			mlog.FieldComponent("cipher-interceptor"),
+			mlog.String("channel", param.ChannelInfo.String()),
+		),
+	}
+}
@@ -0,0 +1,81 @@
+package cipher
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/internal/util/hookutil"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+var _ interceptors.Interceptor = (*cipherAppendInterceptor)(nil)
+
+// collectionSchemaGetter is the interface to get the latest schema of a collection, implemented by the shard manager.
+type collectionSchemaGetter interface {
+	GetLatestCollectionSchema(collectionID int64) (*schemapb.CollectionSchema, error)
+}
+
+// cipherAppendInterceptor encrypts the payload of the message with the data key of the encryption zone of its collection
+// before the message is handed to the underlying wal, so the user data cannot be read from the wal backend.
+// The message encrypted by its producer is kept as is, and the message is decrypted lazily when its payload is read after scanning.
+type cipherAppendInterceptor struct {
+	schemaGetter collectionSchemaGetter
+	logger       *mlog.Logger
+}
+
+func (impl *cipherAppendInterceptor) DoAppend(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	if impl.schemaGetter == nil ||
+		!hookutil.IsClusterEncryptionEnabled() ||
+		!msg.MessageType().CanEnableCipher() ||
+		message.IsCipherMessage(msg) {
+		return append(ctx, msg)
+	}
+
+	collectionID, err := getCollectionID(msg)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := impl.schemaGetter.GetLatestCollectionSchema(collectionID)
+	if err != nil {
+		// The existence of the collection is checked by the shard interceptor,
+		// so the message of the collection without schema is appended as is.
+		return append(ctx, msg)
+	}
+	ez := hookutil.GetEzByCollProperties(schema.GetProperties(), collectionID)
+	if ez == nil {
+		return append(ctx, msg)
+	}
+	if msg, err = message.WithCipher(msg, ez.AsMessageConfig()); err != nil {
+		// never append the plaintext of the message of the encrypted collection.
+		impl.logger.Warn(ctx, "failed to encrypt message", mlog.FieldCollectionID(collectionID), mlog.Int64("ezID", ez.EzID), mlog.Err(err))
+		return nil, errors.Wrap(err, "failed to encrypt message")
+	}
+	return append(ctx, msg)
+}
+
+// Close closes the cipher interceptor.
+func (impl *cipherAppendInterceptor) Close() {}
+
+// getCollectionID returns the collection id of the message which can enable cipher.
+func getCollectionID(msg message.MutableMessage) (int64, error) {
+	switch msg.MessageType() {
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsMutableInsertMessageV1(msg)
+		if err != nil {
+			return 0, err
+		}
+		return insertMsg.Header().GetCollectionId(), nil
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsMutableDeleteMessageV1(msg)
+		if err != nil {
+			return 0, err
+		}
+		return deleteMsg.Header().GetCollectionId(), nil
+	default:
+		return 0, errors.Errorf("unsupported message type %s for cipher", msg.MessageType())
+	}
+}
@@ -0,0 +1,92 @@
+package cipher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
+	"github.com/milvus-io/milvus/internal/util/hookutil"
+	"github.com/milvus-io/milvus/pkg/v3/common"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+type schemaGetterForTest map[int64]*schemapb.CollectionSchema
+
+func (g schemaGetterForTest) GetLatestCollectionSchema(collectionID int64) (*schemapb.CollectionSchema, error) {
+	schema, ok := g[collectionID]
+	if !ok {
+		return nil, errors.New("collection not found")
+	}
+	return schema, nil
+}
+
+func newTestInsertMessage(collectionID int64) message.MutableMessage {
+	return message.NewInsertMessageBuilderV1().
+		WithVChannel("v1").
+		WithHeader(&message.InsertMessageHeader{CollectionId: collectionID}).
+		WithBody(&msgpb.InsertRequest{ShardName: "v1"}).
+		MustBuildMutable()
+}
+
+func TestCipherAppendInterceptor(t *testing.T) {
+	interceptor := &cipherAppendInterceptor{
+		schemaGetter: schemaGetterForTest{
+			1: {Properties: []*commonpb.KeyValuePair{{Key: common.EncryptionEzIDKey, Value: "10"}}},
+			2: {},
+		},
+		logger: mlog.With(),
+	}
+	var appended message.MutableMessage
+	appendOp := func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		appended = msg
+		return walimplstest.NewTestMessageID(1), nil
+	}
+
+	// the message is never encrypted if the cluster encryption is disabled.
+	_, err := interceptor.DoAppend(context.Background(), newTestInsertMessage(1), appendOp)
+	assert.NoError(t, err)
+	assert.False(t, message.IsCipherMessage(appended))
+
+	hookutil.InitTestCipher()
+	message.RegisterCipher(hookutil.GetCipher())
+
+	// the message of the encrypted collection is encrypted.
+	_, err = interceptor.DoAppend(context.Background(), newTestInsertMessage(1), appendOp)
+	assert.NoError(t, err)
+	assert.True(t, message.IsCipherMessage(appended))
+	insertMsg, err := message.AsMutableInsertMessageV1(appended)
+	assert.NoError(t, err)
+	body, err := insertMsg.Body()
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", body.GetShardName())
+
+	// the message encrypted by its producer is kept as is.
+	encrypted := appended
+	_, err = interceptor.DoAppend(context.Background(), encrypted, appendOp)
+	assert.NoError(t, err)
+	assert.Equal(t, encrypted, appended)
+
+	// the message of the unencrypted or unknown collection is not encrypted.
+	for _, collectionID := range []int64{2, 3} {
+		_, err = interceptor.DoAppend(context.Background(), newTestInsertMessage(collectionID), appendOp)
+		assert.NoError(t, err)
+		assert.False(t, message.IsCipherMessage(appended))
+	}
+
+	// the delete message is also encrypted.
+	deleteMsg := message.NewDeleteMessageBuilderV1().
+		WithVChannel("v1").
+		WithHeader(&message.DeleteMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.DeleteRequest{}).
+		MustBuildMutable()
+	_, err = interceptor.DoAppend(context.Background(), deleteMsg, appendOp)
+	assert.NoError(t, err)
+	assert.True(t, message.IsCipherMessage(appended))
+}
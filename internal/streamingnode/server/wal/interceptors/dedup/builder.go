@@ -0,0 +1,32 @@
+package dedup
+
+import (
+	"container/list"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// NewInterceptorBuilder creates a new dedup interceptor builder.
+func NewInterceptorBuilder() interceptors.InterceptorBuilder {
+	return &interceptorBuilder{}
+}
+
+// interceptorBuilder is the builder for dedup interceptor.
+type interceptorBuilder struct{}
+
+// Build creates a new dedup interceptor.
+func (b *interceptorBuilder) Build(param *interceptors.InterceptorBuildParam) interceptors.Interceptor {
+	return &dedupAppendInterceptor{
+		enabled:   paramtable.Get().StreamingCfg.WALDedupEnabled.GetAsBool(),
+		entries:   make(map[dedupKey]*dedupEntry),
+		order:     list.New(),
+		producers: make(map[producerKey]*producerState),
+		logger: resource.Resource().Logger().With(
+			mlog.FieldComponent("dedup-interceptor"),
+			mlog.String("channel", param.ChannelInfo.String()),
+		),
+	}
+}
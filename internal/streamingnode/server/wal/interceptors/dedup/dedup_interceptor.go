@@ -0,0 +1,244 @@
+package dedup
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+var _ interceptors.Interceptor = (*dedupAppendInterceptor)(nil)
+
+// dedupKey is the key to identify an append of a producer.
+// The vchannel is a part of the key, because the messages split from a broadcast message share the same properties.
+type dedupKey struct {
+	producerID string
+	epoch      uint64
+	sequence   uint64
+	vchannel   string
+}
+
+// producerKey is the key to identify a producer on a vchannel.
+type producerKey struct {
+	producerID string
+	vchannel   string
+}
+
+// producerState is the validation state of a producer on a vchannel.
+type producerState struct {
+	epoch           uint64    // the latest epoch of the producer, the appends of the older epoch are fenced.
+	expiredSequence uint64    // the max sequence of the appended entries expired from the window in the latest epoch.
+	lastSeen        time.Time // the time of the latest append of the producer.
+}
+
+// dedupEntry is an append remembered by the dedup interceptor.
+type dedupEntry struct {
+	key       dedupKey
+	createdAt time.Time
+	elem      *list.Element
+	appended  bool // the entry is appended successfully, protected by the mutex of interceptor.
+	done      chan struct{}
+	msgID     message.MessageID
+	result    utility.ExtraAppendResult
+	err       error
+}
+
+// dedupAppendInterceptor drops the duplicate append with the same producer sequence and vchannel within a sliding window,
+// and returns the append result of the first append to the duplicate one.
+// It also validates the producer sequence out of the window:
+// the append of an older epoch of the producer is fenced,
+// and the append with a sequence not greater than an appended one expired from the window is rejected as duplicate.
+// The state is kept in memory of the wal instance only and is not recovered when the wal is reopened,
+// so the deduplication doesn't cover a retry across the reassignment of the pchannel.
+type dedupAppendInterceptor struct {
+	enabled   bool
+	mu        sync.Mutex
+	entries   map[dedupKey]*dedupEntry
+	order     *list.List // the entries ordered by the created time.
+	producers map[producerKey]*producerState
+	logger    *mlog.Logger
+}
+
+func (impl *dedupAppendInterceptor) DoAppend(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	if !impl.enabled {
+		return append(ctx, msg)
+	}
+	ps, ok := message.GetProducerSequenceOfMessage(msg)
+	if !ok {
+		return append(ctx, msg)
+	}
+	key := dedupKey{producerID: ps.ProducerID, epoch: ps.Epoch, sequence: ps.Sequence, vchannel: msg.VChannel()}
+
+	for {
+		entry, duplicated, err := impl.getOrCreateEntry(key)
+		if err != nil {
+			impl.logger.RatedWarn(ctx, rate.Limit(1), "append is rejected by producer sequence validation",
+				mlog.String("producerID", ps.ProducerID),
+				mlog.Uint64("epoch", ps.Epoch),
+				mlog.Uint64("sequence", ps.Sequence),
+				mlog.String("vchannel", key.vchannel),
+				mlog.Err(err))
+			return nil, err
+		}
+		if !duplicated {
+			msgID, err := append(ctx, msg)
+			impl.finishEntry(ctx, entry, msgID, err)
+			return msgID, err
+		}
+
+		// wait for the first append to be done.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-entry.done:
+		}
+		if entry.err != nil {
+			// the first append is failed and forgotten, so the duplicate one should be appended again.
+			continue
+		}
+		if r := utility.GetExtraAppendResult(ctx); r != nil {
+			*r = entry.result
+		}
+		impl.logger.RatedInfo(ctx, rate.Limit(1), "duplicate append is dropped",
+			mlog.String("producerID", ps.ProducerID),
+			mlog.Uint64("epoch", ps.Epoch),
+			mlog.Uint64("sequence", ps.Sequence),
+			mlog.String("vchannel", key.vchannel),
+			mlog.Any("messageID", entry.msgID))
+		return entry.msgID, nil
+	}
+}
+
+// getOrCreateEntry returns the entry of the key, and whether the append is duplicated.
+// An error is returned if the append is rejected by the validation of the producer sequence.
+func (impl *dedupAppendInterceptor) getOrCreateEntry(key dedupKey) (*dedupEntry, bool, error) {
+	impl.mu.Lock()
+	defer impl.mu.Unlock()
+
+	impl.evictEntries()
+	if entry, ok := impl.entries[key]; ok {
+		return entry, true, nil
+	}
+	if err := impl.validateProducerSequence(key); err != nil {
+		return nil, false, err
+	}
+	entry := &dedupEntry{
+		key:       key,
+		createdAt: time.Now(),
+		done:      make(chan struct{}),
+	}
+	entry.elem = impl.order.PushBack(entry)
+	impl.entries[key] = entry
+	return entry, false, nil
+}
+
+// validateProducerSequence validates the producer sequence of the append that is not in the window.
+func (impl *dedupAppendInterceptor) validateProducerSequence(key dedupKey) error {
+	pk := producerKey{producerID: key.producerID, vchannel: key.vchannel}
+	state, ok := impl.producers[pk]
+	if ok && key.epoch < state.epoch {
+		return status.NewUnrecoverableError("producer %s is fenced, epoch %d is older than %d", key.producerID, key.epoch, state.epoch)
+	}
+	if !ok {
+		impl.pruneProducers()
+	}
+	if !ok || key.epoch > state.epoch {
+		state = &producerState{epoch: key.epoch}
+		impl.producers[pk] = state
+	}
+	state.lastSeen = time.Now()
+	if key.sequence <= state.expiredSequence {
+		return status.NewDuplicateAppend("sequence %d of producer %s is already appended and expired from the deduplication window", key.sequence, key.producerID)
+	}
+	return nil
+}
+
+// finishEntry finishes the entry with the append result.
+// The failed append is forgotten, so the retry of it can be appended.
+func (impl *dedupAppendInterceptor) finishEntry(ctx context.Context, entry *dedupEntry, msgID message.MessageID, err error) {
+	if err != nil {
+		impl.mu.Lock()
+		impl.removeEntry(entry)
+		impl.mu.Unlock()
+		entry.err = err
+		close(entry.done)
+		return
+	}
+	entry.msgID = msgID
+	if r := utility.GetExtraAppendResult(ctx); r != nil {
+		entry.result = *r
+	}
+	impl.mu.Lock()
+	entry.appended = true
+	impl.mu.Unlock()
+	close(entry.done)
+}
+
+// evictEntries evicts the entries out of the window or the capacity.
+func (impl *dedupAppendInterceptor) evictEntries() {
+	cfg := &paramtable.Get().StreamingCfg
+	window := cfg.WALDedupWindow.GetAsDurationByParse()
+	maxEntries := cfg.WALDedupMaxEntries.GetAsInt()
+	now := time.Now()
+	for front := impl.order.Front(); front != nil; front = impl.order.Front() {
+		entry := front.Value.(*dedupEntry)
+		expired := now.Sub(entry.createdAt) >= window
+		if !expired && impl.order.Len() < maxEntries {
+			return
+		}
+		if expired && entry.appended {
+			impl.observeExpiredEntry(entry)
+		}
+		impl.removeEntry(entry)
+	}
+}
+
+// pruneProducers forgets the states of the producers that have no append within the window,
+// it's only triggered when the count of the producers exceeds the capacity.
+func (impl *dedupAppendInterceptor) pruneProducers() {
+	cfg := &paramtable.Get().StreamingCfg
+	if len(impl.producers) < cfg.WALDedupMaxEntries.GetAsInt() {
+		return
+	}
+	window := cfg.WALDedupWindow.GetAsDurationByParse()
+	now := time.Now()
+	for pk, state := range impl.producers {
+		if now.Sub(state.lastSeen) >= window {
+			delete(impl.producers, pk)
+		}
+	}
+}
+
+// observeExpiredEntry advances the expired sequence of the producer by the appended entry expired from the window.
+// The entry evicted by the capacity doesn't advance the expired sequence,
+// because the append with a smaller sequence may still be in flight.
+func (impl *dedupAppendInterceptor) observeExpiredEntry(entry *dedupEntry) {
+	state, ok := impl.producers[producerKey{producerID: entry.key.producerID, vchannel: entry.key.vchannel}]
+	if !ok || state.epoch != entry.key.epoch {
+		return
+	}
+	if entry.key.sequence > state.expiredSequence {
+		state.expiredSequence = entry.key.sequence
+	}
+}
+
+// removeEntry removes the entry from the window.
+func (impl *dedupAppendInterceptor) removeEntry(entry *dedupEntry) {
+	if impl.entries[entry.key] != entry {
+		// already evicted.
+		return
+	}
+	delete(impl.entries, entry.key)
+	impl.order.Remove(entry.elem)
+}
+
+func (impl *dedupAppendInterceptor) Close() {}
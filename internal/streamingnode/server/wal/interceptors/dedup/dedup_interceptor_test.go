@@ -0,0 +1,171 @@
+package dedup
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func newTestInterceptor() *dedupAppendInterceptor {
+	return &dedupAppendInterceptor{
+		enabled:   true,
+		entries:   make(map[dedupKey]*dedupEntry),
+		order:     list.New(),
+		producers: make(map[producerKey]*producerState),
+		logger:    mlog.With(),
+	}
+}
+
+func newTestMessage(vchannel string, sequence uint64) message.MutableMessage {
+	return newTestMessageWithEpoch(vchannel, 1, sequence)
+}
+
+func newTestMessageWithEpoch(vchannel string, epoch uint64, sequence uint64) message.MutableMessage {
+	return message.NewInsertMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.InsertMessageHeader{}).
+		WithBody(&msgpb.InsertRequest{}).
+		WithProducerSequence(message.ProducerSequence{ProducerID: "producer", Epoch: epoch, Sequence: sequence}).
+		MustBuildMutable()
+}
+
+func TestDedupAppendInterceptor(t *testing.T) {
+	paramtable.Init()
+	interceptor := newTestInterceptor()
+
+	appendCount := atomic.NewInt64(0)
+	appendOp := func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		id := appendCount.Inc()
+		utility.ReplaceAppendResultTimeTick(ctx, uint64(id))
+		return walimplstest.NewTestMessageID(id), nil
+	}
+	doAppend := func(msg message.MutableMessage) (message.MessageID, *utility.ExtraAppendResult, error) {
+		result := &utility.ExtraAppendResult{}
+		ctx := utility.WithExtraAppendResult(context.Background(), result)
+		msgID, err := interceptor.DoAppend(ctx, msg, appendOp)
+		return msgID, result, err
+	}
+
+	// the duplicate append gets the result of the first append.
+	msgID, result, err := doAppend(newTestMessage("v1", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), result.TimeTick)
+	dupMsgID, dupResult, err := doAppend(newTestMessage("v1", 1))
+	assert.NoError(t, err)
+	assert.True(t, msgID.EQ(dupMsgID))
+	assert.Equal(t, uint64(1), dupResult.TimeTick)
+	assert.Equal(t, int64(1), appendCount.Load())
+
+	// different sequence or vchannel is not duplicated.
+	_, _, err = doAppend(newTestMessage("v1", 2))
+	assert.NoError(t, err)
+	_, _, err = doAppend(newTestMessage("v2", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), appendCount.Load())
+
+	// the message without producer sequence is never deduplicated.
+	msg := message.CreateTestEmptyInsertMesage(1, nil)
+	_, _, err = doAppend(msg)
+	assert.NoError(t, err)
+	_, _, err = doAppend(msg)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), appendCount.Load())
+
+	// concurrent duplicate appends are appended once.
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := doAppend(newTestMessage("v1", 3))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(6), appendCount.Load())
+
+	// the failed append is forgotten.
+	_, err = interceptor.DoAppend(context.Background(), newTestMessage("v1", 4), func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		return nil, errors.New("test")
+	})
+	assert.Error(t, err)
+	_, _, err = doAppend(newTestMessage("v1", 4))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), appendCount.Load())
+
+	// the append out of the capacity is evicted.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALDedupMaxEntries.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALDedupMaxEntries.Key)
+	_, _, err = doAppend(newTestMessage("v1", 5))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, interceptor.order.Len())
+	_, _, err = doAppend(newTestMessage("v1", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), appendCount.Load())
+
+	// disabled interceptor never deduplicates.
+	interceptor.enabled = false
+	_, _, err = doAppend(newTestMessage("v1", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), appendCount.Load())
+}
+
+func TestDedupAppendInterceptorProducerValidation(t *testing.T) {
+	paramtable.Init()
+	interceptor := newTestInterceptor()
+
+	appendCount := atomic.NewInt64(0)
+	appendOp := func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		return walimplstest.NewTestMessageID(appendCount.Inc()), nil
+	}
+
+	_, err := interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v1", 1, 1), appendOp)
+	assert.NoError(t, err)
+
+	// the retry of the older epoch within the window still gets the result of the first append.
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v1", 2, 1), appendOp)
+	assert.NoError(t, err)
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v1", 1, 1), appendOp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), appendCount.Load())
+
+	// the new append of the older epoch is fenced.
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v1", 1, 2), appendOp)
+	assert.True(t, status.AsStreamingError(err).IsUnrecoverable())
+	// the epoch is validated per vchannel.
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v2", 1, 2), appendOp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), appendCount.Load())
+
+	// the retry of the append expired from the window is rejected as duplicate.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALDedupWindow.Key, "1ms")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALDedupWindow.Key)
+	time.Sleep(5 * time.Millisecond)
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v1", 2, 1), appendOp)
+	assert.True(t, status.AsStreamingError(err).IsDuplicateAppend())
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v1", 2, 2), appendOp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), appendCount.Load())
+
+	// the state of the inactive producer is forgotten if the count of producers exceeds the capacity.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALDedupMaxEntries.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALDedupMaxEntries.Key)
+	time.Sleep(5 * time.Millisecond)
+	_, err = interceptor.DoAppend(context.Background(), newTestMessageWithEpoch("v3", 1, 1), appendOp)
+	assert.NoError(t, err)
+	assert.Len(t, interceptor.producers, 1)
+}
@@ -99,3 +99,14 @@ type InterceptorWithGracefulClose interface {
 	// The interceptor can do some operations before the wal rejects all incoming append operations.
 	GracefulClose()
 }
+
+// Some interceptor may need to sync its state into the wal before the wal is handed off to another streaming node.
+type InterceptorWithHandoff interface {
+	Interceptor
+
+	// Handoff will be called after all append operations of the wal are finished when the wal is closing.
+	// The given append operation goes through all interceptors of the wal but bypasses the lifetime of wal.
+	// Return the time tick that covers all messages appended into the wal,
+	// or error if the wal cannot be handed off cleanly.
+	Handoff(ctx context.Context, append Append) (uint64, error)
+}
@@ -0,0 +1,20 @@
+package quota
+
+import (
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+)
+
+// NewInterceptorBuilder creates a new quota interceptor builder.
+func NewInterceptorBuilder() interceptors.InterceptorBuilder {
+	return &interceptorBuilder{}
+}
+
+// interceptorBuilder is the builder for quota interceptor.
+type interceptorBuilder struct{}
+
+// Build creates a new quota interceptor.
+func (b *interceptorBuilder) Build(param *interceptors.InterceptorBuildParam) interceptors.Interceptor {
+	return &quotaAppendInterceptor{
+		registry: appendQuotas,
+	}
+}
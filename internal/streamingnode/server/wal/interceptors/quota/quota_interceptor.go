@@ -0,0 +1,59 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+var _ interceptors.Interceptor = (*quotaAppendInterceptor)(nil)
+
+// quotaAppendInterceptor enforces the append quotas of collections pushed from the coordinator,
+// so a collection writing too fast cannot starve the other collections sharing the same pchannel.
+type quotaAppendInterceptor struct {
+	registry *quotaRegistry
+}
+
+func (impl *quotaAppendInterceptor) DoAppend(ctx context.Context, msg message.MutableMessage, append interceptors.Append) (message.MessageID, error) {
+	collectionID, rows, ok := getQuotaUsage(msg)
+	if !ok {
+		return append(ctx, msg)
+	}
+	if err := impl.registry.acquire(collectionID, msg.VChannel(), msg.EstimateSize(), rows); err != nil {
+		return nil, err
+	}
+	return append(ctx, msg)
+}
+
+// Close closes the quota interceptor.
+func (impl *quotaAppendInterceptor) Close() {}
+
+// getQuotaUsage returns the collection id and rows of the message that is limited by the append quota.
+// Only the insert and delete messages are limited, the replicated message is already limited by the source cluster.
+func getQuotaUsage(msg message.MutableMessage) (collectionID int64, rows int, ok bool) {
+	if msg.ReplicateHeader() != nil {
+		return 0, 0, false
+	}
+	switch msg.MessageType() {
+	case message.MessageTypeInsert:
+		insertMsg, err := message.AsMutableInsertMessageV1(msg)
+		if err != nil {
+			return 0, 0, false
+		}
+		header := insertMsg.Header()
+		for _, partition := range header.GetPartitions() {
+			rows += int(partition.GetRows())
+		}
+		return header.GetCollectionId(), rows, true
+	case message.MessageTypeDelete:
+		deleteMsg, err := message.AsMutableDeleteMessageV1(msg)
+		if err != nil {
+			return 0, 0, false
+		}
+		header := deleteMsg.Header()
+		return header.GetCollectionId(), int(header.GetRows()), true
+	default:
+		return 0, 0, false
+	}
+}
@@ -0,0 +1,90 @@
+package quota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+func newTestInsertMessage(collectionID int64, vchannel string, rows uint64) message.MutableMessage {
+	return message.NewInsertMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.InsertMessageHeader{
+			CollectionId: collectionID,
+			Partitions:   []*message.PartitionSegmentAssignment{{Rows: rows}},
+		}).
+		WithBody(&msgpb.InsertRequest{}).
+		MustBuildMutable()
+}
+
+func newTestDeleteMessage(collectionID int64, vchannel string, rows uint64) message.MutableMessage {
+	return message.NewDeleteMessageBuilderV1().
+		WithVChannel(vchannel).
+		WithHeader(&message.DeleteMessageHeader{
+			CollectionId: collectionID,
+			Rows:         rows,
+		}).
+		WithBody(&msgpb.DeleteRequest{}).
+		MustBuildMutable()
+}
+
+func TestQuotaAppendInterceptor(t *testing.T) {
+	defer UpdateAppendQuotas(nil)
+
+	interceptor := NewInterceptorBuilder().Build(nil)
+	defer interceptor.Close()
+	appendOp := func(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+		return walimplstest.NewTestMessageID(1), nil
+	}
+	doAppend := func(msg message.MutableMessage) error {
+		_, err := interceptor.DoAppend(context.Background(), msg, appendOp)
+		return err
+	}
+
+	// the collection without quota is never limited.
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, doAppend(newTestInsertMessage(1, "v1", 1000)))
+	}
+
+	UpdateAppendQuotas([]*streamingpb.CollectionAppendQuota{
+		{CollectionId: 1, MaxRowsPerSecond: 10},
+		{CollectionId: 2, DenyWriting: true, Reason: "disk quota exceeded"},
+	})
+
+	// the rows quota is applied to every vchannel of the collection.
+	assert.NoError(t, doAppend(newTestInsertMessage(1, "v1", 10)))
+	assert.NoError(t, doAppend(newTestDeleteMessage(1, "v1", 1)))
+	err := doAppend(newTestInsertMessage(1, "v1", 1))
+	assert.True(t, status.AsStreamingError(err).IsCollectionQuotaExceeded())
+	assert.NoError(t, doAppend(newTestInsertMessage(1, "v2", 10)))
+	// the other collections are not affected.
+	assert.NoError(t, doAppend(newTestInsertMessage(3, "v1", 1000)))
+
+	// the writing denied collection is rejected.
+	err = doAppend(newTestDeleteMessage(2, "v3", 1))
+	assert.True(t, status.AsStreamingError(err).IsCollectionQuotaExceeded())
+
+	// the non-dml message is not limited.
+	assert.NoError(t, doAppend(message.CreateTestDropCollectionMessage(t, 2, 1, walimplstest.NewTestMessageID(1))))
+
+	// the bytes quota is limited, the message is allowed until the quota is exhausted.
+	UpdateAppendQuotas([]*streamingpb.CollectionAppendQuota{
+		{CollectionId: 1, MaxBytesPerSecond: 1},
+	})
+	assert.NoError(t, doAppend(newTestInsertMessage(1, "v1", 1000)))
+	err = doAppend(newTestInsertMessage(1, "v1", 1000))
+	assert.True(t, status.AsStreamingError(err).IsCollectionQuotaExceeded())
+
+	// the quota is removed if the collection is not in the snapshot.
+	UpdateAppendQuotas(nil)
+	assert.NoError(t, doAppend(newTestInsertMessage(1, "v1", 1000)))
+	assert.NoError(t, doAppend(newTestDeleteMessage(2, "v3", 1)))
+	assert.Empty(t, appendQuotas.limiters)
+}
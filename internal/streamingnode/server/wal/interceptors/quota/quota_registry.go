@@ -0,0 +1,108 @@
+package quota
+
+import (
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/util/ratelimitutil"
+)
+
+// appendQuotas keeps the append quotas of collections pushed from the coordinator,
+// it's shared by all wals on current streaming node.
+var appendQuotas = &quotaRegistry{
+	quotas:   make(map[int64]*streamingpb.CollectionAppendQuota),
+	limiters: make(map[string]*vchannelLimiter),
+}
+
+// UpdateAppendQuotas replaces the append quotas of collections with the snapshot pushed from the coordinator.
+// The collection that is not in the snapshot is not limited any more.
+func UpdateAppendQuotas(quotas []*streamingpb.CollectionAppendQuota) {
+	appendQuotas.update(quotas)
+}
+
+// quotaRegistry is the registry of the append quotas of collections.
+// The quota is applied to every vchannel of the collection, so the limiters are kept by vchannel.
+type quotaRegistry struct {
+	mu       sync.Mutex
+	quotas   map[int64]*streamingpb.CollectionAppendQuota
+	limiters map[string]*vchannelLimiter
+}
+
+// vchannelLimiter is the rate limiter of a vchannel.
+type vchannelLimiter struct {
+	collectionID int64
+	bytes        *ratelimitutil.Limiter // nil if the bytes is not limited.
+	rows         *ratelimitutil.Limiter // nil if the rows is not limited.
+}
+
+// update replaces the quotas, the limiters of the vchannels are kept if the quota of its collection is still set.
+func (r *quotaRegistry) update(quotas []*streamingpb.CollectionAppendQuota) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.quotas = make(map[int64]*streamingpb.CollectionAppendQuota, len(quotas))
+	for _, quota := range quotas {
+		r.quotas[quota.GetCollectionId()] = quota
+	}
+	for vchannel, limiter := range r.limiters {
+		quota, ok := r.quotas[limiter.collectionID]
+		if !ok {
+			delete(r.limiters, vchannel)
+			continue
+		}
+		limiter.bytes = updateLimiter(limiter.bytes, quota.GetMaxBytesPerSecond())
+		limiter.rows = updateLimiter(limiter.rows, quota.GetMaxRowsPerSecond())
+	}
+}
+
+// acquire acquires the quota of the collection to append the given bytes and rows into the vchannel.
+// Return a error if the quota of collection is exceeded or the writing is denied.
+func (r *quotaRegistry) acquire(collectionID int64, vchannel string, bytes int, rows int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quota, ok := r.quotas[collectionID]
+	if !ok {
+		return nil
+	}
+	if quota.GetDenyWriting() {
+		return status.NewCollectionQuotaExceeded("writing of collection %d is denied, reason: %s", collectionID, quota.GetReason())
+	}
+	limiter, ok := r.limiters[vchannel]
+	if !ok {
+		limiter = &vchannelLimiter{
+			collectionID: collectionID,
+			bytes:        updateLimiter(nil, quota.GetMaxBytesPerSecond()),
+			rows:         updateLimiter(nil, quota.GetMaxRowsPerSecond()),
+		}
+		r.limiters[vchannel] = limiter
+	}
+
+	now := time.Now()
+	if limiter.bytes != nil && !limiter.bytes.AllowN(now, bytes) {
+		return status.NewCollectionQuotaExceeded("append bytes rate of collection %d exceeds the quota %v/s at vchannel %s", collectionID, quota.GetMaxBytesPerSecond(), vchannel)
+	}
+	if limiter.rows != nil && !limiter.rows.AllowN(now, rows) {
+		if limiter.bytes != nil {
+			limiter.bytes.Cancel(bytes)
+		}
+		return status.NewCollectionQuotaExceeded("append rows rate of collection %d exceeds the quota %v/s at vchannel %s", collectionID, quota.GetMaxRowsPerSecond(), vchannel)
+	}
+	return nil
+}
+
+// updateLimiter updates the limit of the limiter, a nil limiter is returned if the rate is not limited.
+func updateLimiter(limiter *ratelimitutil.Limiter, rate float64) *ratelimitutil.Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	if limiter == nil {
+		return ratelimitutil.NewLimiter(ratelimitutil.Limit(rate), rate)
+	}
+	if limiter.Limit() != ratelimitutil.Limit(rate) {
+		limiter.SetLimit(ratelimitutil.Limit(rate))
+	}
+	return limiter
+}
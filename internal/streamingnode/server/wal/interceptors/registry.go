@@ -0,0 +1,172 @@
+package interceptors
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// The names of the builtin interceptors, which can be used as the ordering constraints of the registered interceptors.
+const (
+	InterceptorNameDedup     = "dedup"
+	InterceptorNameQuota     = "quota"
+	InterceptorNameRedo      = "redo"
+	InterceptorNameLock      = "lock"
+	InterceptorNameReplicate = "replicate"
+	InterceptorNameTimeTick  = "timetick"
+	InterceptorNameShard     = "shard"
+	InterceptorNameCipher    = "cipher"
+)
+
+// registeredBuilders is the registry of the interceptor builders registered by name.
+var registeredBuilders = &builderRegistry{
+	builders: make(map[string]*namedBuilder),
+}
+
+// NamedInterceptorBuilder is a interceptor builder with the unique name of the interceptor.
+type NamedInterceptorBuilder struct {
+	Name    string
+	Builder InterceptorBuilder
+}
+
+// RegisterOption is the option to register a interceptor builder.
+type RegisterOption func(b *namedBuilder)
+
+// OptBefore places the interceptor before the given interceptors in the chain,
+// so the interceptor sees the message before them when appending.
+func OptBefore(names ...string) RegisterOption {
+	return func(b *namedBuilder) {
+		b.before = append(b.before, names...)
+	}
+}
+
+// OptAfter places the interceptor after the given interceptors in the chain,
+// so the interceptor sees the message after them when appending.
+func OptAfter(names ...string) RegisterOption {
+	return func(b *namedBuilder) {
+		b.after = append(b.after, names...)
+	}
+}
+
+// RegisterInterceptorBuilder registers a custom interceptor builder by name.
+// The interceptor is built for every wal opened after the registration,
+// and is placed after all builtin interceptors if no ordering constraint is given.
+//
+// NOTE: this function should only be called during initialization time (i.e. in an init() function).
+// If multiple builders are registered with the same name, panic will occur.
+func RegisterInterceptorBuilder(name string, builder InterceptorBuilder, opts ...RegisterOption) {
+	if name == "" || builder == nil {
+		panic("interceptor builder should have a name and a builder")
+	}
+	b := &namedBuilder{NamedInterceptorBuilder: NamedInterceptorBuilder{Name: name, Builder: builder}}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	registeredBuilders.mu.Lock()
+	defer registeredBuilders.mu.Unlock()
+	if _, ok := registeredBuilders.builders[name]; ok {
+		panic("interceptor builder already registered: " + name)
+	}
+	registeredBuilders.builders[name] = b
+}
+
+// OrderInterceptorBuilders merges the builtin interceptor builders with the registered ones,
+// and returns the builders ordered by the chain, the first one sees the message first when appending.
+// The builtin builders keep the given order, the registered builders are placed by their ordering constraints.
+func OrderInterceptorBuilders(builtins ...NamedInterceptorBuilder) ([]InterceptorBuilder, error) {
+	registeredBuilders.mu.Lock()
+	customs := make([]*namedBuilder, 0, len(registeredBuilders.builders))
+	for _, b := range registeredBuilders.builders {
+		customs = append(customs, b)
+	}
+	registeredBuilders.mu.Unlock()
+	sort.Slice(customs, func(i, j int) bool {
+		return customs[i].Name < customs[j].Name
+	})
+
+	nodes := make([]*namedBuilder, 0, len(builtins)+len(customs))
+	index := make(map[string]int, len(builtins)+len(customs))
+	for _, b := range builtins {
+		if _, ok := index[b.Name]; ok {
+			return nil, errors.Errorf("interceptor %s is duplicated", b.Name)
+		}
+		index[b.Name] = len(nodes)
+		nodes = append(nodes, &namedBuilder{NamedInterceptorBuilder: b})
+	}
+	for _, b := range customs {
+		if _, ok := index[b.Name]; ok {
+			return nil, errors.Errorf("interceptor %s conflicts with the builtin interceptor", b.Name)
+		}
+		index[b.Name] = len(nodes)
+		nodes = append(nodes, b)
+	}
+
+	// edges[i] are the nodes that must be placed after the node i.
+	edges := make([][]int, len(nodes))
+	inDegree := make([]int, len(nodes))
+	addEdge := func(from, to int) {
+		edges[from] = append(edges[from], to)
+		inDegree[to]++
+	}
+	for i := 1; i < len(builtins); i++ {
+		addEdge(i-1, i)
+	}
+	for i := len(builtins); i < len(nodes); i++ {
+		if len(builtins) > 0 && len(nodes[i].before) == 0 && len(nodes[i].after) == 0 {
+			// The interceptor without ordering constraint is placed after all builtin interceptors.
+			addEdge(len(builtins)-1, i)
+		}
+		for _, name := range nodes[i].before {
+			j, ok := index[name]
+			if !ok {
+				return nil, errors.Errorf("interceptor %s should be placed before unknown interceptor %s", nodes[i].Name, name)
+			}
+			addEdge(i, j)
+		}
+		for _, name := range nodes[i].after {
+			j, ok := index[name]
+			if !ok {
+				return nil, errors.Errorf("interceptor %s should be placed after unknown interceptor %s", nodes[i].Name, name)
+			}
+			addEdge(j, i)
+		}
+	}
+
+	// Always pick the available node with the smallest index,
+	// so the builtin interceptors are placed as early as possible and the result is deterministic.
+	ordered := make([]InterceptorBuilder, 0, len(nodes))
+	placed := make([]bool, len(nodes))
+	for len(ordered) < len(nodes) {
+		next := -1
+		for i := range nodes {
+			if !placed[i] && inDegree[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return nil, errors.New("the ordering constraints of interceptors have a cycle")
+		}
+		placed[next] = true
+		ordered = append(ordered, nodes[next].Builder)
+		for _, j := range edges[next] {
+			inDegree[j]--
+		}
+	}
+	return ordered, nil
+}
+
+// builderRegistry is the registry of the interceptor builders.
+type builderRegistry struct {
+	mu       sync.Mutex
+	builders map[string]*namedBuilder
+}
+
+// namedBuilder is the registered interceptor builder with its ordering constraints.
+type namedBuilder struct {
+	NamedInterceptorBuilder
+	before []string
+	after  []string
+}
@@ -0,0 +1,81 @@
+package interceptors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type namedTestBuilder string
+
+func (b namedTestBuilder) Build(param *InterceptorBuildParam) Interceptor {
+	return nil
+}
+
+func resetRegistry() {
+	registeredBuilders.mu.Lock()
+	defer registeredBuilders.mu.Unlock()
+	registeredBuilders.builders = make(map[string]*namedBuilder)
+}
+
+func builtinsForTest() []NamedInterceptorBuilder {
+	names := []string{InterceptorNameRedo, InterceptorNameLock, InterceptorNameReplicate, InterceptorNameTimeTick, InterceptorNameShard}
+	builtins := make([]NamedInterceptorBuilder, 0, len(names))
+	for _, name := range names {
+		builtins = append(builtins, NamedInterceptorBuilder{Name: name, Builder: namedTestBuilder(name)})
+	}
+	return builtins
+}
+
+func orderedNames(t *testing.T, builders []InterceptorBuilder) []string {
+	names := make([]string, 0, len(builders))
+	for _, b := range builders {
+		names = append(names, string(b.(namedTestBuilder)))
+	}
+	return names
+}
+
+func TestOrderInterceptorBuilders(t *testing.T) {
+	defer resetRegistry()
+
+	// builtin only.
+	builders, err := OrderInterceptorBuilders(builtinsForTest()...)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"redo", "lock", "replicate", "timetick", "shard"}, orderedNames(t, builders))
+
+	// registered interceptors are placed by the ordering constraints.
+	RegisterInterceptorBuilder("pii", namedTestBuilder("pii"))
+	RegisterInterceptorBuilder("audit", namedTestBuilder("audit"), OptAfter(InterceptorNameLock), OptBefore(InterceptorNameReplicate))
+	RegisterInterceptorBuilder("scrub", namedTestBuilder("scrub"), OptBefore("audit"))
+	builders, err = OrderInterceptorBuilders(builtinsForTest()...)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"redo", "lock", "scrub", "audit", "replicate", "timetick", "shard", "pii"}, orderedNames(t, builders))
+
+	assert.Panics(t, func() {
+		RegisterInterceptorBuilder("audit", namedTestBuilder("audit"))
+	})
+	assert.Panics(t, func() {
+		RegisterInterceptorBuilder("", namedTestBuilder(""))
+	})
+}
+
+func TestOrderInterceptorBuildersError(t *testing.T) {
+	defer resetRegistry()
+
+	// unknown interceptor.
+	RegisterInterceptorBuilder("audit", namedTestBuilder("audit"), OptBefore("unknown"))
+	_, err := OrderInterceptorBuilders(builtinsForTest()...)
+	assert.Error(t, err)
+	resetRegistry()
+
+	// cycle with the builtin order.
+	RegisterInterceptorBuilder("audit", namedTestBuilder("audit"), OptAfter(InterceptorNameShard), OptBefore(InterceptorNameRedo))
+	_, err = OrderInterceptorBuilders(builtinsForTest()...)
+	assert.Error(t, err)
+	resetRegistry()
+
+	// conflict with the builtin name.
+	RegisterInterceptorBuilder(InterceptorNameLock, namedTestBuilder(InterceptorNameLock))
+	_, err = OrderInterceptorBuilders(builtinsForTest()...)
+	assert.Error(t, err)
+}
@@ -40,11 +40,12 @@ func RecoverReplicateManager(param *ReplicateManagerRecoverParam) (ReplicatesMan
 		salvageCheckpoints[cp.ClusterID] = cp
 	}
 	rm := &replicatesManagerImpl{
-		mu:                    sync.Mutex{},
-		currentClusterID:      param.CurrentClusterID,
-		pchannel:              param.ChannelInfo,
-		replicateConfigHelper: replicateConfigHelper,
-		salvageCheckpoints:    salvageCheckpoints,
+		mu:                      sync.Mutex{},
+		currentClusterID:        param.CurrentClusterID,
+		pchannel:                param.ChannelInfo,
+		replicateConfigHelper:   replicateConfigHelper,
+		salvageCheckpoints:      salvageCheckpoints,
+		rbacReplicationDisabled: param.InitialRecoverSnapshot.Checkpoint.RBACReplicationDisabled,
 	}
 	if !rm.isPrimaryRole() {
 		// if current cluster is not the primary role,
@@ -58,12 +59,13 @@ func RecoverReplicateManager(param *ReplicateManagerRecoverParam) (ReplicatesMan
 
 // replicatesManagerImpl is the implementation of the replicates manager.
 type replicatesManagerImpl struct {
-	mu                    sync.Mutex
-	pchannel              types.PChannelInfo
-	currentClusterID      string
-	replicateConfigHelper *replicateutil.ConfigHelper
-	secondaryState        *secondaryState                         // if the current cluster is not the primary role, it will have secondaryState.
-	salvageCheckpoints    map[string]*utility.ReplicateCheckpoint // captured on force promote, keyed by source clusterID
+	mu                      sync.Mutex
+	pchannel                types.PChannelInfo
+	currentClusterID        string
+	replicateConfigHelper   *replicateutil.ConfigHelper
+	secondaryState          *secondaryState                         // if the current cluster is not the primary role, it will have secondaryState.
+	salvageCheckpoints      map[string]*utility.ReplicateCheckpoint // captured on force promote, keyed by source clusterID
+	rbacReplicationDisabled bool                                    // the rbac is not replicated into current cluster, set by the replicate configuration.
 }
 
 // SwitchReplicateMode switches the replicates manager between replicating mode and non-replicating mode.
@@ -98,6 +100,7 @@ func (impl *replicatesManagerImpl) SwitchReplicateMode(_ context.Context, msg me
 		}
 	}
 	impl.replicateConfigHelper = newGraph
+	impl.rbacReplicationDisabled = msg.Header().RbacReplicationDisabled
 	return nil
 }
 
@@ -126,8 +129,17 @@ func (impl *replicatesManagerImpl) BeginReplicateMessage(ctx context.Context, ms
 		}
 		return nil, ErrNotHandledByReplicateManager
 	case replicateutil.RoleSecondary:
+		if msg.MessageType().IsRBACMessageType() && impl.rbacReplicationDisabled {
+			// the rbac of current cluster is managed independently if the rbac replication is opted out.
+			if rh != nil {
+				return nil, status.NewIgnoreOperation("rbac message %s is not replicated into the secondary cluster %s", msg.MessageType(), impl.currentClusterID)
+			}
+			return nil, ErrNotHandledByReplicateManager
+		}
 		if rh == nil {
-			return nil, status.NewReplicateViolation("non-replicate message cannot be received in secondary role")
+			// the direct write from client is rejected, only the messages replicated from the source cluster can be written.
+			return nil, status.NewSecondaryWriteRejected("non-replicate message %s cannot be written into the secondary cluster %s of source cluster %s",
+				msg.MessageType(), impl.currentClusterID, impl.secondaryState.SourceClusterID())
 		}
 		return impl.beginReplicateMessage(ctx, msg)
 	default:
@@ -85,7 +85,7 @@ func TestSecondaryReplicateManager(t *testing.T) {
 				},
 				ReplicateConfig: newReplicateConfiguration("test2", "test1"),
 			},
-			TxnBuffer: utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics()),
+			TxnBuffer: utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test")),
 		},
 	})
 	assert.NoError(t, err)
@@ -98,7 +98,7 @@ func TestSecondaryReplicateManager(t *testing.T) {
 
 func TestSalvageCheckpointCaptureOnForcePromote(t *testing.T) {
 	// Setup: cluster starts as secondary with a checkpoint
-	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 	rm, err := RecoverReplicateManager(&ReplicateManagerRecoverParam{
 		ChannelInfo:      types.PChannelInfo{Name: "test1-rootcoord-dml_0", Term: 1},
 		CurrentClusterID: "test1",
@@ -139,7 +139,7 @@ func TestSalvageCheckpointCaptureOnForcePromote(t *testing.T) {
 
 func TestSalvageCheckpointNotCapturedOnNormalPromote(t *testing.T) {
 	// Setup: cluster starts as secondary
-	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 	rm, err := RecoverReplicateManager(&ReplicateManagerRecoverParam{
 		ChannelInfo:      types.PChannelInfo{Name: "test1-rootcoord-dml_0", Term: 1},
 		CurrentClusterID: "test1",
@@ -235,7 +235,7 @@ func TestSalvageCheckpointMultipleForcePromotes(t *testing.T) {
 	// Start as secondary of cluster-a, force promote to primary,
 	// then become secondary of cluster-b, force promote again.
 	// Both salvage checkpoints should accumulate (keyed by source cluster).
-	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 	rm, err := RecoverReplicateManager(&ReplicateManagerRecoverParam{
 		ChannelInfo:      types.PChannelInfo{Name: "test1-rootcoord-dml_0", Term: 1},
 		CurrentClusterID: "test1",
@@ -291,7 +291,7 @@ func TestSalvageCheckpointMultipleForcePromotes(t *testing.T) {
 }
 
 func TestSecondaryReplicateManagerWithTxn(t *testing.T) {
-	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	txnBuffer := utility.NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 	txnMsgs := newReplicateTxnMessage("test1", "test2", 2)
 
 	for _, msg := range txnMsgs[0:3] {
@@ -528,7 +528,7 @@ func testMessageOnSecondary(t *testing.T, rm ReplicatesManager) {
 	// Test non-replicate message
 	msg = newNonReplicateMessage("test1")
 	g, err = rm.BeginReplicateMessage(context.Background(), msg)
-	assert.True(t, status.AsStreamingError(err).IsReplicateViolation())
+	assert.True(t, status.AsStreamingError(err).IsSecondaryWriteRejected())
 	assert.Nil(t, g)
 
 	// Test replicate message
@@ -564,6 +564,42 @@ func testMessageOnSecondary(t *testing.T, rm ReplicatesManager) {
 	g, err = rm.BeginReplicateMessage(context.Background(), newReplicateTxnMessage("test1", "test2", 2)[0])
 	assert.True(t, status.AsStreamingError(err).IsIgnoredOperation())
 	assert.Nil(t, g)
+
+	testRBACMessageOnSecondary(t, rm)
+}
+
+func testRBACMessageOnSecondary(t *testing.T, rm ReplicatesManager) {
+	// the rbac is replicated by default, so the direct rbac operation is rejected.
+	g, err := rm.BeginReplicateMessage(context.Background(), newNonReplicateRBACMessage("test1"))
+	assert.True(t, status.AsStreamingError(err).IsSecondaryWriteRejected())
+	assert.Nil(t, g)
+
+	msg := newAlterReplicateConfigMessage("test2", "test1")
+	header := msg.Header()
+	header.RbacReplicationDisabled = true
+	msg.OverwriteHeader(header)
+	err = rm.SwitchReplicateMode(context.Background(), msg)
+	assert.NoError(t, err)
+
+	// the direct rbac operation is allowed and the replicated one is ignored if the rbac replication is opted out.
+	g, err = rm.BeginReplicateMessage(context.Background(), newNonReplicateRBACMessage("test1"))
+	assert.ErrorIs(t, err, ErrNotHandledByReplicateManager)
+	assert.Nil(t, g)
+	g, err = rm.BeginReplicateMessage(context.Background(), newReplicateRBACMessage("test1", "test2", 10))
+	assert.True(t, status.AsStreamingError(err).IsIgnoredOperation())
+	assert.Nil(t, g)
+
+	// the non-rbac message is not affected.
+	g, err = rm.BeginReplicateMessage(context.Background(), newNonReplicateMessage("test1"))
+	assert.True(t, status.AsStreamingError(err).IsSecondaryWriteRejected())
+	assert.Nil(t, g)
+
+	// the rbac is replicated again if the opt-out is removed from the configuration.
+	err = rm.SwitchReplicateMode(context.Background(), newAlterReplicateConfigMessage("test2", "test1"))
+	assert.NoError(t, err)
+	g, err = rm.BeginReplicateMessage(context.Background(), newNonReplicateRBACMessage("test1"))
+	assert.True(t, status.AsStreamingError(err).IsSecondaryWriteRejected())
+	assert.Nil(t, g)
 }
 
 // newReplicateConfiguration creates a valid replicate configuration for testing
@@ -629,6 +665,34 @@ func newReplicateMessage(clusterID string, sourceClusterID string, timetick ...u
 	return replicateMsg
 }
 
+func newNonReplicateRBACMessage(clusterID string) message.MutableMessage {
+	return message.NewAlterRoleMessageBuilderV2().
+		WithHeader(&message.AlterRoleMessageHeader{}).
+		WithBody(&message.AlterRoleMessageBody{}).
+		WithVChannel(clusterID + "-rootcoord-dml_0").
+		MustBuildMutable()
+}
+
+func newReplicateRBACMessage(clusterID string, sourceClusterID string, timetick uint64) message.MutableMessage {
+	msg := message.NewAlterRoleMessageBuilderV2().
+		WithHeader(&message.AlterRoleMessageHeader{}).
+		WithBody(&message.AlterRoleMessageBody{}).
+		WithVChannel(sourceClusterID + "-rootcoord-dml_0").
+		MustBuildMutable().
+		WithTimeTick(timetick).
+		WithLastConfirmed(walimplstest.NewTestMessageID(1)).
+		IntoImmutableMessage(walimplstest.NewTestMessageID(1))
+
+	replicateMsg := message.MustNewReplicateMessage(
+		sourceClusterID,
+		msg.IntoImmutableMessageProto(),
+	)
+	replicateMsg.OverwriteReplicateVChannel(
+		clusterID + "-rootcoord-dml_0",
+	)
+	return replicateMsg
+}
+
 func newImmutableTxnMessage(clusterID string, timetick ...uint64) []message.ImmutableMessage {
 	tt := uint64(1)
 	if len(timetick) > 0 {
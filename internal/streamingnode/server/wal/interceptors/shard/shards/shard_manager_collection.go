@@ -251,6 +251,11 @@ func (m *shardManagerImpl) GetCollectionSchema(collectionID int64, schemaVersion
 	return proto.Clone(collectionInfo.Schema.GetSchema()).(*schemapb.CollectionSchema), nil
 }
 
+// GetLatestCollectionSchema returns the latest schema of the collection.
+func (m *shardManagerImpl) GetLatestCollectionSchema(collectionID int64) (*schemapb.CollectionSchema, error) {
+	return m.GetCollectionSchema(collectionID, latestCollectionSchemaVersion)
+}
+
 func (m *shardManagerImpl) GetAllCollectionSchemaInfos() map[int64]CollectionSchemaInfo {
 	m.mu.Lock()
 	defer m.mu.Unlock()
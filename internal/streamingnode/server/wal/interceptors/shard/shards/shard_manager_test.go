@@ -450,6 +450,8 @@ func TestShardManagerSchemaVersionCheck(t *testing.T) {
 	assert.NoError(t, err)
 	_, err = m.GetCollectionSchema(100, 0)
 	assert.ErrorIs(t, err, ErrCollectionSchemaVersionNotMatch)
+	_, err = m.GetLatestCollectionSchema(100)
+	assert.NoError(t, err)
 
 	// version mismatch should fail
 	ver, err = m.CheckIfCollectionSchemaVersionMatch(&message.InsertMessageHeader{
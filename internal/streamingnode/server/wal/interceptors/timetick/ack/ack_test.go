@@ -89,6 +89,8 @@ func TestAck(t *testing.T) {
 	assert.Equal(t, 2, len(details))
 	assert.Equal(t, uint64(1), details[0].BeginTimestamp)
 	assert.Equal(t, uint64(2), details[1].BeginTimestamp)
+	assert.False(t, details[0].EndTime.Before(details[0].BeginTime))
+	assert.GreaterOrEqual(t, details[1].AckDuration(), time.Duration(0))
 
 	// notAck: [3, 5, ..., 10]
 	// ack: [4]
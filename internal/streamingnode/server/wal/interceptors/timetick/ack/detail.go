@@ -2,6 +2,7 @@ package ack
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/txn"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
@@ -14,6 +15,7 @@ func newAckDetail(ts uint64, lastConfirmedMessageID message.MessageID) *AckDetai
 	}
 	return &AckDetail{
 		BeginTimestamp:         ts,
+		BeginTime:              time.Now(),
 		LastConfirmedMessageID: lastConfirmedMessageID,
 		IsSync:                 false,
 		Err:                    nil,
@@ -22,8 +24,10 @@ func newAckDetail(ts uint64, lastConfirmedMessageID message.MessageID) *AckDetai
 
 // AckDetail records the information of acker.
 type AckDetail struct {
-	BeginTimestamp uint64 // the timestamp when acker is allocated.
-	EndTimestamp   uint64 // the timestamp when acker is acknowledged.
+	BeginTimestamp uint64    // the timestamp when acker is allocated.
+	EndTimestamp   uint64    // the timestamp when acker is acknowledged.
+	BeginTime      time.Time // the wall clock time when acker is allocated.
+	EndTime        time.Time // the wall clock time when acker is acknowledged.
 	// for avoiding allocation of timestamp failure, the timestamp will use the ack manager last allocated timestamp.
 	LastConfirmedMessageID message.MessageID
 	Message                message.ImmutableMessage
@@ -62,3 +66,8 @@ func OptTxnSession(session *txn.TxnSession) AckOption {
 		detail.TxnSession = session
 	}
 }
+
+// AckDuration returns the duration from the acker is allocated to it is acknowledged.
+func (detail *AckDetail) AckDuration() time.Duration {
+	return detail.EndTime.Sub(detail.BeginTime)
+}
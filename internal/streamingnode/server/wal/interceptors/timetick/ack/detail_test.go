@@ -2,6 +2,7 @@ package ack
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +20,9 @@ func TestDetail(t *testing.T) {
 
 	ackDetail := newAckDetail(1, msgID)
 	assert.Equal(t, uint64(1), ackDetail.BeginTimestamp)
+	assert.False(t, ackDetail.BeginTime.IsZero())
+	ackDetail.EndTime = ackDetail.BeginTime.Add(time.Second)
+	assert.Equal(t, time.Second, ackDetail.AckDuration())
 	assert.True(t, ackDetail.LastConfirmedMessageID.EQ(msgID))
 	assert.False(t, ackDetail.IsSync)
 	assert.NoError(t, ackDetail.Err)
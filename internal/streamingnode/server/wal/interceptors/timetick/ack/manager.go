@@ -105,7 +105,8 @@ func (ta *AckManager) ack(acker *Acker) {
 
 	acker.acknowledged = true
 	acker.detail.EndTimestamp = ta.lastAllocatedTimeTick
-	ta.metrics.CountAcknowledgeTimeTick(acker.ackDetail().IsSync)
+	acker.detail.EndTime = time.Now()
+	ta.metrics.CountAcknowledgeTimeTick(acker.ackDetail().IsSync, acker.ackDetail().AckDuration())
 	ta.popUntilLastAllAcknowledged()
 }
 
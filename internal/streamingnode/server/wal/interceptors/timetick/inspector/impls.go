@@ -67,20 +67,38 @@ func (s *timeTickSyncInspectorImpl) background() {
 	defer s.taskNotifier.Finish(struct{}{})
 
 	interval := paramtable.Get().ProxyCfg.TimeTickInterval.GetAsDuration(time.Millisecond)
+	if paramtable.Get().StreamingCfg.WALTimeTickAdaptiveSyncEnabled.GetAsBool() {
+		interval = paramtable.Get().StreamingCfg.WALTimeTickAdaptiveSyncMinInterval.GetAsDurationByParse()
+	}
 	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	// lastSyncTimes records the last sync time of the pchannels, only accessed by the background goroutine.
+	lastSyncTimes := make(map[string]time.Time)
 	for {
 		select {
 		case <-s.taskNotifier.Context().Done():
 			return
-		case <-ticker.C:
-			s.operators.Range(func(name string, _ TimeTickSyncOperator) bool {
+		case now := <-ticker.C:
+			synced := make(map[string]time.Time, len(lastSyncTimes))
+			s.operators.Range(func(name string, operator TimeTickSyncOperator) bool {
+				lastSyncTime := lastSyncTimes[name]
+				// tolerate the jitter of the ticker by half of the tick interval.
+				if now.Sub(lastSyncTime)+interval/2 < operator.SyncInterval() {
+					synced[name] = lastSyncTime
+					return true
+				}
 				s.asyncSync(name, false)
+				synced[name] = now
 				return true
 			})
+			// the pchannels that are unregistered are dropped.
+			lastSyncTimes = synced
 		case <-s.syncNotifier.WaitChan():
 			signals := s.syncNotifier.Get()
+			now := time.Now()
 			for pchannel, persisted := range signals {
 				s.asyncSync(pchannel.Name, persisted)
+				lastSyncTimes[pchannel.Name] = now
 			}
 		}
 	}
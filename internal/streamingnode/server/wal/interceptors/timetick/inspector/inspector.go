@@ -2,6 +2,7 @@ package inspector
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/timetick/mvcc"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/wab"
@@ -18,6 +19,9 @@ type TimeTickSyncOperator interface {
 	// WriteAheadBuffer get the related WriteAhead buffer.
 	WriteAheadBuffer() wab.ROWriteAheadBuffer
 
+	// SyncInterval returns the interval of the periodic sync operation, which may be adaptive to the append rate of the wal.
+	SyncInterval() time.Duration
+
 	// Sync trigger a sync operation, try to send the timetick message into wal.
 	// Sync operation is a blocking operation, and not thread-safe, will only call in one goroutine.
 	Sync(ctx context.Context, forcePersisted bool)
@@ -24,6 +24,7 @@ func TestInsepctor(t *testing.T) {
 		Term: 1,
 	}
 	operator.EXPECT().Channel().Return(pchannel)
+	operator.EXPECT().SyncInterval().Return(200 * time.Millisecond).Maybe()
 	operator.EXPECT().Sync(mock.Anything, mock.Anything).Run(func(ctx context.Context, forcePersisted bool) {})
 
 	i.RegisterSyncOperator(operator)
@@ -0,0 +1,68 @@
+package timetick
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// newAdaptiveSyncInterval creates a new adaptive sync interval.
+func newAdaptiveSyncInterval() *adaptiveSyncInterval {
+	return &adaptiveSyncInterval{}
+}
+
+// adaptiveSyncInterval is the time tick sync interval of a wal adaptive to its append rate.
+// The wal with appended messages since last sync is synced at the min interval,
+// and the interval of the idle wal is doubled at every sync until the max interval.
+type adaptiveSyncInterval struct {
+	appended atomic.Int64 // the count of appended messages since last sync.
+	mu       sync.Mutex
+	interval time.Duration // the current interval of the idle wal, 0 means the min interval.
+}
+
+// ObserveAppend records a message is appended into the wal.
+func (s *adaptiveSyncInterval) ObserveAppend() {
+	s.appended.Add(1)
+}
+
+// ObserveSync records a sync operation is done, and adjusts the interval.
+func (s *adaptiveSyncInterval) ObserveSync() {
+	appended := s.appended.Swap(0)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if appended > 0 {
+		// tighten the interval under load.
+		s.interval = 0
+		return
+	}
+	// relax the interval when idle.
+	s.interval = s.interval * 2
+	if s.interval == 0 {
+		s.interval = paramtable.Get().StreamingCfg.WALTimeTickAdaptiveSyncMinInterval.GetAsDurationByParse()
+	}
+	if maxInterval := paramtable.Get().StreamingCfg.WALTimeTickAdaptiveSyncMaxInterval.GetAsDurationByParse(); s.interval > maxInterval {
+		s.interval = maxInterval
+	}
+}
+
+// Get returns the current sync interval.
+func (s *adaptiveSyncInterval) Get() time.Duration {
+	if !paramtable.Get().StreamingCfg.WALTimeTickAdaptiveSyncEnabled.GetAsBool() {
+		return paramtable.Get().ProxyCfg.TimeTickInterval.GetAsDuration(time.Millisecond)
+	}
+	minInterval := paramtable.Get().StreamingCfg.WALTimeTickAdaptiveSyncMinInterval.GetAsDurationByParse()
+	if s.appended.Load() > 0 {
+		// the new appended message should be synced as soon as possible, even if the wal was idle.
+		return minInterval
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.interval < minInterval {
+		return minInterval
+	}
+	return s.interval
+}
@@ -0,0 +1,51 @@
+package timetick
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestAdaptiveSyncInterval(t *testing.T) {
+	paramtable.Init()
+	s := newAdaptiveSyncInterval()
+
+	// the fixed interval is used if the adaptive sync is disabled.
+	s.ObserveSync()
+	assert.Equal(t, paramtable.Get().ProxyCfg.TimeTickInterval.GetAsDuration(time.Millisecond), s.Get())
+
+	params := paramtable.Get()
+	params.Save(params.StreamingCfg.WALTimeTickAdaptiveSyncEnabled.Key, "true")
+	params.Save(params.StreamingCfg.WALTimeTickAdaptiveSyncMinInterval.Key, "100ms")
+	params.Save(params.StreamingCfg.WALTimeTickAdaptiveSyncMaxInterval.Key, "500ms")
+	defer func() {
+		params.Reset(params.StreamingCfg.WALTimeTickAdaptiveSyncEnabled.Key)
+		params.Reset(params.StreamingCfg.WALTimeTickAdaptiveSyncMinInterval.Key)
+		params.Reset(params.StreamingCfg.WALTimeTickAdaptiveSyncMaxInterval.Key)
+	}()
+
+	// the interval of the idle wal is doubled until the max interval.
+	s = newAdaptiveSyncInterval()
+	assert.Equal(t, 100*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 100*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 200*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 400*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 500*time.Millisecond, s.Get())
+
+	// the appended message is synced at the min interval immediately.
+	s.ObserveAppend()
+	assert.Equal(t, 100*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 100*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 100*time.Millisecond, s.Get())
+	s.ObserveSync()
+	assert.Equal(t, 200*time.Millisecond, s.Get())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/timetick/ack"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/txn"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 )
@@ -20,6 +21,7 @@ const interceptorName = "timetick"
 var (
 	_ interceptors.InterceptorWithMetrics       = (*timeTickAppendInterceptor)(nil)
 	_ interceptors.InterceptorWithGracefulClose = (*timeTickAppendInterceptor)(nil)
+	_ interceptors.InterceptorWithHandoff       = (*timeTickAppendInterceptor)(nil)
 )
 
 // timeTickAppendInterceptor is a append interceptor.
@@ -46,6 +48,7 @@ func (impl *timeTickAppendInterceptor) DoAppend(ctx context.Context, msg message
 	var txnSession *txn.TxnSession
 	var immutableMsg message.ImmutableMessage
 	if msg.MessageType() != message.MessageTypeTimeTick {
+		impl.operator.syncInterval.ObserveAppend()
 		// Allocate new timestamp acker for message.
 		var acker *ack.Acker
 		if msg.BarrierTimeTick() == 0 {
@@ -81,15 +84,19 @@ func (impl *timeTickAppendInterceptor) DoAppend(ctx context.Context, msg message
 			return nil, err
 		}
 	case message.MessageTypeCommitTxn:
-		if txnSession, err = impl.handleCommit(ctx, msg); err != nil {
+		if txnSession, msg, err = impl.handleCommit(ctx, msg); err != nil {
 			return nil, err
 		}
-		defer txnSession.CommitDone()
+		if txnSession != nil {
+			defer txnSession.CommitDone()
+		}
 	case message.MessageTypeRollbackTxn:
-		if txnSession, err = impl.handleRollback(ctx, msg); err != nil {
+		if txnSession, msg, err = impl.handleRollback(ctx, msg); err != nil {
 			return nil, err
 		}
-		defer txnSession.RollbackDone()
+		if txnSession != nil {
+			defer txnSession.RollbackDone()
+		}
 	case message.MessageTypeTimeTick:
 		// cleanup the expired transaction sessions and the already done transaction.
 		impl.txnManager.CleanupTxnUntil(msg.TimeTick())
@@ -134,6 +141,16 @@ func (impl *timeTickAppendInterceptor) GracefulClose() {
 	logger.Info(context.TODO(), "txnManager of timeTickAppendInterceptor is graceful closed")
 }
 
+// Handoff implements InterceptorWithHandoff.
+// A persisted time tick is synced after all append operations are finished, so the time tick covers all messages of the wal.
+// The wal with in-flight transactions cannot be handed off cleanly, the uncommitted messages should be recovered from the wal stream.
+func (impl *timeTickAppendInterceptor) Handoff(ctx context.Context, append interceptors.Append) (uint64, error) {
+	if n := impl.txnManager.InFlightTxnCount(); n > 0 {
+		return 0, errors.Errorf("wal has %d in-flight transactions", n)
+	}
+	return impl.operator.syncForHandoff(ctx, append)
+}
+
 // Close implements AppendInterceptor.
 func (impl *timeTickAppendInterceptor) Close() {
 	resource.Resource().TimeTickInspector().UnregisterSyncOperator(impl.operator)
@@ -155,39 +172,71 @@ func (impl *timeTickAppendInterceptor) handleBegin(ctx context.Context, msg mess
 }
 
 // handleCommit handle the commit transaction message.
-func (impl *timeTickAppendInterceptor) handleCommit(ctx context.Context, msg message.MutableMessage) (*txn.TxnSession, error) {
+// A nil session is returned if the commit message of cross vchannel transaction is repeated.
+func (impl *timeTickAppendInterceptor) handleCommit(ctx context.Context, msg message.MutableMessage) (session *txn.TxnSession, _ message.MutableMessage, err error) {
 	commitTxnMsg, err := message.AsMutableCommitTxnMessageV2(msg)
 	if err != nil {
-		return nil, err
+		return nil, msg, err
 	}
-	session, err := impl.txnManager.GetSessionOfTxn(commitTxnMsg.TxnContext().TxnID)
-	if err != nil {
-		return nil, err
+	if msg.BroadcastHeader() != nil {
+		if session, msg, err = impl.getCoordinatedTxnSession(msg, commitTxnMsg.Header().GetVchannelTxnIds()); err != nil || session == nil {
+			return nil, msg, err
+		}
+	} else if session, err = impl.txnManager.GetSessionOfTxn(commitTxnMsg.TxnContext().TxnID); err != nil {
+		return nil, msg, err
 	}
 
 	// Start commit the message.
 	if err = session.RequestCommitAndWait(ctx, msg.TimeTick()); err != nil {
-		return nil, err
+		return nil, msg, err
 	}
-	return session, nil
+	return session, msg, nil
 }
 
 // handleRollback handle the rollback transaction message.
-func (impl *timeTickAppendInterceptor) handleRollback(ctx context.Context, msg message.MutableMessage) (session *txn.TxnSession, err error) {
+// A nil session is returned if the rollback message of cross vchannel transaction is repeated.
+func (impl *timeTickAppendInterceptor) handleRollback(ctx context.Context, msg message.MutableMessage) (session *txn.TxnSession, _ message.MutableMessage, err error) {
 	rollbackTxnMsg, err := message.AsMutableRollbackTxnMessageV2(msg)
 	if err != nil {
-		return nil, err
+		return nil, msg, err
 	}
-	session, err = impl.txnManager.GetSessionOfTxn(rollbackTxnMsg.TxnContext().TxnID)
-	if err != nil {
-		return nil, err
+	if msg.BroadcastHeader() != nil {
+		if session, msg, err = impl.getCoordinatedTxnSession(msg, rollbackTxnMsg.Header().GetVchannelTxnIds()); err != nil || session == nil {
+			return nil, msg, err
+		}
+	} else if session, err = impl.txnManager.GetSessionOfTxn(rollbackTxnMsg.TxnContext().TxnID); err != nil {
+		return nil, msg, err
 	}
 
 	// Start commit the message.
 	if err = session.RequestRollback(ctx, msg.TimeTick()); err != nil {
-		return nil, err
+		return nil, msg, err
 	}
-	return session, nil
+	return session, msg, nil
+}
+
+// getCoordinatedTxnSession gets the session of cross vchannel transaction for the commit or rollback message broadcast by streamingcoord.
+// The txn context of the message is resolved by the txn id of current vchannel.
+// The broadcast message may be repeated after streamingcoord restarts,
+// so a nil session is returned and the message is appended as a no-op one if the session is already done.
+func (impl *timeTickAppendInterceptor) getCoordinatedTxnSession(msg message.MutableMessage, vchannelTxnIDs map[string]int64) (*txn.TxnSession, message.MutableMessage, error) {
+	txnID, ok := vchannelTxnIDs[msg.VChannel()]
+	if !ok {
+		return nil, msg, status.NewInvalidArgument("txn id of vchannel %s is not found in the %s message", msg.VChannel(), msg.MessageType())
+	}
+	msg = msg.WithTxnContext(message.TxnContext{
+		TxnID:     message.TxnID(txnID),
+		Keepalive: message.TxnKeepaliveInfinite,
+	})
+	session, err := impl.txnManager.GetSessionOfTxn(message.TxnID(txnID))
+	if err != nil || session.State() != message.TxnStateInFlight {
+		impl.operator.logger.Info(context.TODO(), "the session of cross vchannel txn is already done, append the message as a no-op one",
+			mlog.FieldVChannel(msg.VChannel()),
+			mlog.Int64("txnID", txnID),
+			mlog.String("messageType", msg.MessageType().String()))
+		return nil, msg, nil
+	}
+	return session, msg, nil
 }
 
 // handleTxnMessage handle the transaction body message.
@@ -2,7 +2,9 @@ package timetick
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -37,6 +39,7 @@ func newTimeTickSyncOperator(param *interceptors.InterceptorBuildParam) *timeTic
 		ackDetails:            ack.NewAckDetails(),
 		sourceID:              paramtable.GetNodeID(),
 		metrics:               metrics,
+		syncInterval:          newAdaptiveSyncInterval(),
 	}
 }
 
@@ -48,7 +51,9 @@ type timeTickSyncOperator struct {
 	ackDetails            *ack.AckDetails                     // all acknowledged details, all acked messages but not sent to wal will be kept here.
 	sourceID              int64                               // source id of the time tick sync operator.
 	metrics               *metricsutil.TimeTickMetrics
+	syncInterval          *adaptiveSyncInterval // the adaptive sync interval of the wal.
 	walShutdownOrFenced   atomic.Bool
+	syncMu                sync.Mutex // serializes the periodic sync and the sync for handoff.
 }
 
 // Channel returns the pchannel info.
@@ -66,9 +71,17 @@ func (impl *timeTickSyncOperator) MVCCManager() *mvcc.MVCCManager {
 	return impl.interceptorBuildParam.MVCCManager
 }
 
+// SyncInterval returns the interval of the periodic sync operation.
+func (impl *timeTickSyncOperator) SyncInterval() time.Duration {
+	return impl.syncInterval.Get()
+}
+
 // Sync trigger a sync operation.
 // Sync operation is not thread safe, so call it in a single goroutine.
 func (impl *timeTickSyncOperator) Sync(ctx context.Context, persisted bool) {
+	impl.syncMu.Lock()
+	defer impl.syncMu.Unlock()
+
 	if impl.walShutdownOrFenced.Load() {
 		// skip append tt msg to a shutdown or fenced wal
 		return
@@ -93,7 +106,27 @@ func (impl *timeTickSyncOperator) Sync(ctx context.Context, persisted bool) {
 		if s := status.AsStreamingError(err); s.IsFenced() || s.IsOnShutdown() {
 			impl.walShutdownOrFenced.Store(true)
 		}
+		return
+	}
+	impl.syncInterval.ObserveSync()
+}
+
+// syncForHandoff syncs a persisted time tick with the given append operation when the wal is handed off,
+// and returns the synced time tick.
+// It should be called after all append operations of the wal are finished, so the time tick covers all messages of the wal.
+func (impl *timeTickSyncOperator) syncForHandoff(ctx context.Context, append interceptors.Append) (uint64, error) {
+	impl.syncMu.Lock()
+	defer impl.syncMu.Unlock()
+
+	impl.syncAcknowledgedDetails(ctx)
+	if impl.ackDetails.Empty() {
+		return 0, errors.New("no acknowledged time tick can be synced")
+	}
+	ts := impl.ackDetails.LastAllAcknowledgedTimestamp()
+	if err := impl.sendTsMsgToWAL(ctx, ts, impl.ackDetails.EarliestLastConfirmedMessageID(), true, append); err != nil {
+		return 0, err
 	}
+	return ts, nil
 }
 
 // AckManager returns the ack manager.
@@ -156,8 +189,9 @@ func (impl *timeTickSyncOperator) sendTsMsgToWAL(ctx context.Context,
 	// metrics updates
 	impl.metrics.CountTimeTickSync(ts, persist)
 	msgs := make([]message.ImmutableMessage, 0, impl.ackDetails.Len())
+	syncTime := time.Now()
 	impl.ackDetails.Range(func(detail *ack.AckDetail) bool {
-		impl.metrics.CountSyncTimeTick(detail.IsSync)
+		impl.metrics.CountSyncTimeTick(detail.IsSync, syncTime.Sub(detail.BeginTime))
 		if !detail.IsSync && detail.Err == nil {
 			msgs = append(msgs, detail.Message)
 		}
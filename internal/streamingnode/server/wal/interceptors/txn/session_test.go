@@ -2,6 +2,7 @@ package txn
 
 import (
 	"context"
+	"math"
 	"sync"
 	"testing"
 	"time"
@@ -367,6 +368,44 @@ func TestManagerFromReplcateMessage(t *testing.T) {
 	assert.Equal(t, message.TxnKeepaliveInfinite, session.TxnContext().Keepalive)
 }
 
+func TestManagerCoordinatedTxn(t *testing.T) {
+	resource.InitForTest(t)
+	m := NewTxnManager(types.PChannelInfo{Name: "test"}, nil)
+	<-m.RecoverDone()
+
+	deadline := tsoutil.ComposeTSByTime(time.Now().Add(time.Minute), 0)
+	newCoordinatedBeginMessage := func(timetick uint64) message.MutableBeginTxnMessageV2 {
+		return message.MustAsMutableBeginTxnMessageV2(message.NewBeginTxnMessageBuilderV2().
+			WithVChannel("v1").
+			WithHeader(&message.BeginTxnMessageHeader{
+				CoordinatedTxnId:    100,
+				CoordinatedDeadline: deadline,
+			}).
+			WithBody(&message.BeginTxnMessageBody{}).
+			MustBuildMutable().
+			WithTimeTick(timetick))
+	}
+
+	// the begin message after the deadline is rejected.
+	_, err := m.BeginNewTxn(context.Background(), newCoordinatedBeginMessage(deadline))
+	assert.True(t, status.AsStreamingError(err).IsTxnExpired())
+
+	session, err := m.BeginNewTxn(context.Background(), newCoordinatedBeginMessage(deadline-1))
+	assert.NoError(t, err)
+	assert.Equal(t, message.TxnID(100), session.TxnContext().TxnID)
+	assert.Equal(t, message.TxnKeepaliveInfinite, session.TxnContext().Keepalive)
+
+	// the repeated begin never overwrites the in-flight session.
+	_, err = m.BeginNewTxn(context.Background(), newCoordinatedBeginMessage(deadline-1))
+	assert.Error(t, err)
+
+	// the coordinated session never expires.
+	m.CleanupTxnUntil(math.MaxUint64 - 1)
+	s, err := m.GetSessionOfTxn(100)
+	assert.NoError(t, err)
+	assert.Equal(t, session, s)
+}
+
 func TestBeginNewTxnManagerClosed(t *testing.T) {
 	// Covers BeginNewTxn lines 92-94: manager closed returns error
 	resource.InitForTest(t)
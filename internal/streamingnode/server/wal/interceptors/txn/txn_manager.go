@@ -55,8 +55,9 @@ func NewTxnManager(pchannel types.PChannelInfo, uncommittedTxnBuilders map[messa
 }
 
 // TxnManager is the manager of transactions.
-// We don't support cross wal transaction by now and
-// We don't support the transaction lives after the wal transferred to another streaming node.
+// The cross wal transaction is coordinated by streamingcoord, the manager only keeps the piece of it at current wal.
+// We don't support the transaction lives after the wal transferred to another streaming node,
+// except the replicated and coordinated transaction that never expires and is recovered from the wal.
 type TxnManager struct {
 	mlog.Binder
 
@@ -92,6 +93,11 @@ func (m *TxnManager) BeginNewTxn(ctx context.Context, msg message.MutableBeginTx
 	if m.closed != nil {
 		return nil, status.NewTransactionExpired("manager closed")
 	}
+	if _, ok := m.sessions[txnCtx.TxnID]; ok {
+		// The txn id of cross vchannel transaction is allocated by streamingcoord,
+		// a repeated begin should never overwrite the in-flight session.
+		return nil, status.NewUnrecoverableError("txn %d is already begun", txnCtx.TxnID)
+	}
 	session := newTxnSession(vchannel, *txnCtx, timetick, m.metrics.BeginTxn())
 	m.sessions[session.TxnContext().TxnID] = session
 	return session, nil
@@ -108,6 +114,20 @@ func (m *TxnManager) buildTxnContext(ctx context.Context, msg message.MutableBeg
 		}, nil
 	}
 
+	if header := msg.Header(); header.CoordinatedTxnId != 0 {
+		// The transaction is a piece of cross vchannel transaction coordinated by streamingcoord,
+		// so it never expires at current wal, it's committed or rollbacked by the broadcast message of streamingcoord.
+		// The begin message after the deadline is rejected, because streamingcoord may rollback the transaction after the deadline,
+		// and the rollback message is always placed after the begin message before the deadline in the wal.
+		if msg.TimeTick() >= header.CoordinatedDeadline {
+			return nil, status.NewTransactionExpired("cross vchannel txn %d expired at %d, current %d", header.CoordinatedTxnId, header.CoordinatedDeadline, msg.TimeTick())
+		}
+		return &message.TxnContext{
+			TxnID:     message.TxnID(header.CoordinatedTxnId),
+			Keepalive: message.TxnKeepaliveInfinite,
+		}, nil
+	}
+
 	keepalive := time.Duration(msg.Header().KeepaliveMilliseconds) * time.Millisecond
 	if keepalive == 0 {
 		// If keepalive is 0, the txn set the keepalive with default keepalive.
@@ -190,6 +210,14 @@ func (m *TxnManager) GetSessionOfTxn(id message.TxnID) (*TxnSession, error) {
 	return session, nil
 }
 
+// InFlightTxnCount returns the count of the in-flight transactions.
+func (m *TxnManager) InFlightTxnCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.sessions)
+}
+
 // RollbackAllInFlightTransactions rolls back all active transaction sessions.
 // Called ONLY in the failover scenario.
 func (m *TxnManager) RollbackAllInFlightTransactions() {
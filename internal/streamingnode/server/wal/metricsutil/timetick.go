@@ -21,6 +21,10 @@ type TimeTickMetrics struct {
 	syncTimeTickCounterForSync         prometheus.Counter
 	acknowledgedTimeTickCounter        prometheus.Counter
 	syncTimeTickCounter                prometheus.Counter
+	acknowledgeDurationForSync         prometheus.Observer
+	syncDurationForSync                prometheus.Observer
+	acknowledgeDuration                prometheus.Observer
+	syncDuration                       prometheus.Observer
 	lastAllocatedTimeTick              prometheus.Gauge
 	lastConfirmedTimeTick              prometheus.Gauge
 	persistentTimeTickSyncCounter      prometheus.Counter
@@ -44,6 +48,10 @@ func NewTimeTickMetrics(pchannel string) *TimeTickMetrics {
 		syncTimeTickCounterForSync:         metrics.WALSyncTimeTickTotal.MustCurryWith(constLabel).WithLabelValues("sync"),
 		acknowledgedTimeTickCounter:        metrics.WALAcknowledgeTimeTickTotal.MustCurryWith(constLabel).WithLabelValues("common"),
 		syncTimeTickCounter:                metrics.WALSyncTimeTickTotal.MustCurryWith(constLabel).WithLabelValues("common"),
+		acknowledgeDurationForSync:         metrics.WALAcknowledgeTimeTickDurationSeconds.MustCurryWith(constLabel).WithLabelValues("sync"),
+		syncDurationForSync:                metrics.WALSyncTimeTickDurationSeconds.MustCurryWith(constLabel).WithLabelValues("sync"),
+		acknowledgeDuration:                metrics.WALAcknowledgeTimeTickDurationSeconds.MustCurryWith(constLabel).WithLabelValues("common"),
+		syncDuration:                       metrics.WALSyncTimeTickDurationSeconds.MustCurryWith(constLabel).WithLabelValues("common"),
 		lastAllocatedTimeTick:              metrics.WALLastAllocatedTimeTick.With(constLabel),
 		lastConfirmedTimeTick:              metrics.WALLastConfirmedTimeTick.With(constLabel),
 		persistentTimeTickSyncCounter:      metrics.WALTimeTickSyncTotal.MustCurryWith(constLabel).WithLabelValues("persistent"),
@@ -82,26 +90,34 @@ func (g *AllocateTimeTickMetricsGuard) Done(ts uint64, err error) {
 	g.inner.mu.Unlock()
 }
 
-func (m *TimeTickMetrics) CountAcknowledgeTimeTick(isSync bool) {
+// CountAcknowledgeTimeTick counts the acknowledged time tick,
+// the duration is the latency from the time tick is allocated to it is acknowledged.
+func (m *TimeTickMetrics) CountAcknowledgeTimeTick(isSync bool, duration time.Duration) {
 	if !m.mu.LockIfNotClosed() {
 		return
 	}
 	if isSync {
 		m.acknowledgedTimeTickCounterForSync.Inc()
+		m.acknowledgeDurationForSync.Observe(duration.Seconds())
 	} else {
 		m.acknowledgedTimeTickCounter.Inc()
+		m.acknowledgeDuration.Observe(duration.Seconds())
 	}
 	m.mu.Unlock()
 }
 
-func (m *TimeTickMetrics) CountSyncTimeTick(isSync bool) {
+// CountSyncTimeTick counts the synced time tick,
+// the duration is the latency from the time tick is allocated to it is synced into the wal by the time tick message.
+func (m *TimeTickMetrics) CountSyncTimeTick(isSync bool, duration time.Duration) {
 	if !m.mu.LockIfNotClosed() {
 		return
 	}
 	if isSync {
 		m.syncTimeTickCounterForSync.Inc()
+		m.syncDurationForSync.Observe(duration.Seconds())
 	} else {
 		m.syncTimeTickCounter.Inc()
+		m.syncDuration.Observe(duration.Seconds())
 	}
 	m.mu.Unlock()
 }
@@ -137,6 +153,8 @@ func (m *TimeTickMetrics) Close() {
 	metrics.WALLastConfirmedTimeTick.Delete(m.constLabel)
 	metrics.WALAcknowledgeTimeTickTotal.DeletePartialMatch(m.constLabel)
 	metrics.WALSyncTimeTickTotal.DeletePartialMatch(m.constLabel)
+	metrics.WALAcknowledgeTimeTickDurationSeconds.DeletePartialMatch(m.constLabel)
+	metrics.WALSyncTimeTickDurationSeconds.DeletePartialMatch(m.constLabel)
 	metrics.WALTimeTickSyncTimeTick.DeletePartialMatch(m.constLabel)
 	metrics.WALTimeTickSyncTotal.DeletePartialMatch(m.constLabel)
 }
@@ -1,12 +1,15 @@
 package metricsutil
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/milvus-io/milvus/pkg/v3/metrics"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
 )
 
 func NewScanMetrics(pchannel types.PChannelInfo) *ScanMetrics {
@@ -43,6 +46,7 @@ func NewScanMetrics(pchannel types.PChannelInfo) *ScanMetrics {
 		pendingQueueSize: metrics.WALScannerPendingQueueBytes.With(constLabel),
 		timeTickBufSize:  metrics.WALScannerTimeTickBufBytes.With(constLabel),
 		txnBufSize:       metrics.WALScannerTxnBufBytes.With(constLabel),
+		scannerLag:       metrics.WALScannerLagSeconds.MustCurryWith(constLabel),
 	}
 }
 
@@ -56,6 +60,7 @@ type ScanMetrics struct {
 	timeTickBufSize  prometheus.Gauge
 	txnBufSize       prometheus.Gauge
 	pendingQueueSize prometheus.Gauge
+	scannerLag       *prometheus.GaugeVec
 }
 
 type underlyingScannerMetrics struct {
@@ -86,11 +91,13 @@ func (m *ScanMetrics) ObserveExpiredTxn() {
 	m.txnTotal.WithLabelValues("expired").Inc()
 }
 
-// NewScannerMetrics creates a new scanner metrics.
-func (m *ScanMetrics) NewScannerMetrics() *ScannerMetrics {
+// NewScannerMetrics creates a new scanner metrics with the unique name of the scanner.
+func (m *ScanMetrics) NewScannerMetrics(name string) *ScannerMetrics {
 	m.scannerTotal.WithLabelValues(metrics.WALScannerModelCatchup).Inc()
 	return &ScannerMetrics{
 		ScanMetrics:              m,
+		name:                     name,
+		lag:                      m.scannerLag.WithLabelValues(name),
 		scannerModel:             metrics.WALScannerModelCatchup,
 		previousTxnBufSize:       0,
 		previousTimeTickBufSize:  0,
@@ -111,10 +118,13 @@ func (m *ScanMetrics) Close() {
 	metrics.WALScannerTimeTickBufBytes.Delete(m.constLabel)
 	metrics.WALScannerTxnBufBytes.Delete(m.constLabel)
 	metrics.WALScannerPendingQueueBytes.Delete(m.constLabel)
+	metrics.WALScannerLagSeconds.DeletePartialMatch(m.constLabel)
 }
 
 type ScannerMetrics struct {
 	*ScanMetrics
+	name                     string
+	lag                      prometheus.Gauge
 	scannerModel             string
 	previousTxnBufSize       int
 	previousTimeTickBufSize  int
@@ -167,6 +177,11 @@ func (m *ScannerMetrics) ObserveTimeTickViolation(tailing bool, msgType message.
 	underlying.timeTickViolationTotal.WithLabelValues(msgType.String()).Inc()
 }
 
+// ObserveDeliveredTimeTick observes the lag of the scanner by the time tick of the delivered message.
+func (m *ScannerMetrics) ObserveDeliveredTimeTick(timetick uint64) {
+	m.lag.Set(time.Since(tsoutil.PhysicalTime(timetick)).Seconds())
+}
+
 func (m *ScannerMetrics) UpdatePendingQueueSize(size int) {
 	diff := size - m.previousPendingQueueSize
 	m.pendingQueueSize.Add(float64(diff))
@@ -190,4 +205,5 @@ func (m *ScannerMetrics) Close() {
 	m.UpdateTimeTickBufSize(0)
 	m.UpdateTxnBufSize(0)
 	m.scannerTotal.WithLabelValues(m.scannerModel).Dec()
+	m.scannerLag.DeleteLabelValues(m.name)
 }
@@ -26,6 +26,8 @@ func NewWriteMetrics(pchannel types.PChannelInfo, walName message.WALName) *Writ
 		pchannel.Name,
 		strconv.FormatInt(pchannel.Term, 10),
 		walName.String()).Set(1)
+	// the wal is always opened with a new term on current streaming node.
+	metrics.WALTermChangeTotal.With(constLabel).Inc()
 
 	slowLogThreshold := paramtable.Get().StreamingCfg.LoggingAppendSlowThreshold.GetAsDurationByParse()
 	if slowLogThreshold <= 0 {
@@ -43,6 +45,8 @@ func NewWriteMetrics(pchannel types.PChannelInfo, walName message.WALName) *Writ
 		total:                        metrics.WALAppendMessageTotal.MustCurryWith(constLabel),
 		walDuration:                  metrics.WALAppendMessageDurationSeconds.MustCurryWith(constLabel),
 		walimplsRetryTotal:           metrics.WALImplsAppendRetryTotal.With(constLabel),
+		appendBytesTotal:             metrics.WALAppendBytesTotal.With(constLabel),
+		fencedTotal:                  metrics.WALFencedTotal.With(constLabel),
 		walimplsDuration:             metrics.WALImplsAppendMessageDurationSeconds.MustCurryWith(constLabel),
 		walBeforeInterceptorDuration: metrics.WALAppendMessageBeforeInterceptorDurationSeconds.MustCurryWith(constLabel),
 		walAfterInterceptorDuration:  metrics.WALAppendMessageAfterInterceptorDurationSeconds.MustCurryWith(constLabel),
@@ -60,6 +64,8 @@ type WriteMetrics struct {
 	total                        *prometheus.CounterVec
 	walDuration                  prometheus.ObserverVec
 	walimplsRetryTotal           prometheus.Counter
+	appendBytesTotal             prometheus.Counter
+	fencedTotal                  prometheus.Counter
 	walimplsDuration             prometheus.ObserverVec
 	walBeforeInterceptorDuration prometheus.ObserverVec
 	walAfterInterceptorDuration  prometheus.ObserverVec
@@ -85,6 +91,9 @@ func (m *WriteMetrics) done(appendMetrics *AppendMetrics) {
 	m.bytes.WithLabelValues(status).Observe(float64(appendMetrics.msg.EstimateSize()))
 	m.total.WithLabelValues(appendMetrics.msg.MessageType().String(), status).Inc()
 	m.walDuration.WithLabelValues(status).Observe(appendMetrics.appendDuration.Seconds())
+	if appendMetrics.err == nil {
+		m.appendBytesTotal.Add(float64(appendMetrics.msg.EstimateSize()))
+	}
 	for name, ims := range appendMetrics.interceptors {
 		for _, im := range ims {
 			if im.Before != 0 {
@@ -119,6 +128,11 @@ func (m *WriteMetrics) ObserveRetry() {
 	m.walimplsRetryTotal.Inc()
 }
 
+// ObserveFenced observes the wal is fenced by the underlying wal.
+func (m *WriteMetrics) ObserveFenced() {
+	m.fencedTotal.Inc()
+}
+
 func (m *WriteMetrics) Close() {
 	metrics.WALAppendMessageBeforeInterceptorDurationSeconds.DeletePartialMatch(m.constLabel)
 	metrics.WALAppendMessageAfterInterceptorDurationSeconds.DeletePartialMatch(m.constLabel)
@@ -126,6 +140,7 @@ func (m *WriteMetrics) Close() {
 	metrics.WALAppendMessageTotal.DeletePartialMatch(m.constLabel)
 	metrics.WALAppendMessageDurationSeconds.DeletePartialMatch(m.constLabel)
 	metrics.WALImplsAppendRetryTotal.DeletePartialMatch(m.constLabel)
+	metrics.WALAppendBytesTotal.DeletePartialMatch(m.constLabel)
 	metrics.WALImplsAppendMessageDurationSeconds.DeletePartialMatch(m.constLabel)
 	metrics.WALInfo.DeleteLabelValues(
 		paramtable.GetStringNodeID(),
@@ -143,5 +158,8 @@ func parseError(err error) string {
 	if status.IsCanceled(err) {
 		return metrics.WALStatusCancel
 	}
+	if status.AsStreamingError(err).IsSecondaryWriteRejected() {
+		return metrics.WALStatusSecondaryRejected
+	}
 	return metrics.WALStatusError
 }
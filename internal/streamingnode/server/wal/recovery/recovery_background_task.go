@@ -54,6 +54,9 @@ func (rs *recoveryStorageImpl) backgroundTask() {
 		select {
 		case <-rs.backgroundTaskNotifier.Context().Done():
 			return
+		case req := <-rs.truncateRequests:
+			req.result <- rs.truncateByRequest(rs.backgroundTaskNotifier.Context(), req.truncateTo)
+			continue
 		case <-rs.persistNotifier:
 		case <-ticker.C:
 		}
@@ -73,6 +76,9 @@ func (rs *recoveryStorageImpl) persistDritySnapshotWhenClosing() error {
 			return err
 		}
 	}
+	if err := rs.persistCleanHandoffWhenClosing(ctx); err != nil {
+		return err
+	}
 	rs.gracefulClosed = true
 	return nil
 }
@@ -155,8 +161,10 @@ func (rs *recoveryStorageImpl) persistDirtySnapshot(ctx context.Context, lvl mlo
 		return err
 	}
 
+	rs.persistedCheckpoint = snapshot.Checkpoint.Clone()
 	// sample the checkpoint for truncator to make wal truncation.
 	rs.metrics.ObServePersistedMetrics(snapshot.Checkpoint.TimeTick)
+	rs.sampleTruncatePoint(snapshot.Checkpoint, snapshot.observedBytes)
 	rs.simpleTruncateCheckpoint(ctx, snapshot.Checkpoint)
 	return
 }
@@ -167,11 +175,19 @@ func (rs *recoveryStorageImpl) simpleTruncateCheckpoint(ctx context.Context, che
 		return
 	}
 	// use the smaller one to truncate the wal.
+	truncateTo := checkpoint.MessageID
 	if flusherCP.MessageID.LTE(checkpoint.MessageID) {
-		_ = rs.truncator.Truncate(ctx, flusherCP.MessageID)
-	} else {
-		_ = rs.truncator.Truncate(ctx, checkpoint.MessageID)
+		truncateTo = flusherCP.MessageID
+	}
+	// the replicating tasks and the retention policy of the pchannel may hold the truncation further.
+	truncateTo, ok := rs.applyRetention(ctx, truncateTo)
+	if !ok {
+		return
+	}
+	if err := rs.truncator.Truncate(ctx, truncateTo); err != nil {
+		return
 	}
+	rs.pruneTruncateSamples(truncateTo)
 }
 
 // dropAllVirtualChannel drops all virtual channels that are in the dropped state.
@@ -0,0 +1,124 @@
+package recovery
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/utility"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+// MarkCleanHandoff marks the wal is closed cleanly, no message is appended into the wal after the time tick.
+// The clean handoff marker is persisted with the checkpoint when closing the recovery storage,
+// only if the checkpoint of the recovery storage covers the time tick.
+func (r *recoveryStorageImpl) MarkCleanHandoff(timetick uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handoffTimeTick = timetick
+}
+
+// persistCleanHandoffWhenClosing persists the clean handoff marker with the checkpoint if the wal is closed cleanly.
+// It should be called after all dirty snapshots are persisted.
+// TODO: the marker written by a stale owner may overwrite the checkpoint of the new owner,
+// it shares the same issue of the missing compare-and-swap operation of the recovery persist.
+func (rs *recoveryStorageImpl) persistCleanHandoffWhenClosing(ctx context.Context) error {
+	rs.mu.Lock()
+	handoffTimeTick := rs.handoffTimeTick
+	rs.mu.Unlock()
+
+	if handoffTimeTick == 0 || rs.persistedCheckpoint == nil {
+		return nil
+	}
+	logger := rs.Logger().With(
+		mlog.Uint64("handoffTimeTick", handoffTimeTick),
+		mlog.Uint64("checkpointTimeTick", rs.persistedCheckpoint.TimeTick),
+	)
+	if rs.persistedCheckpoint.TimeTick < handoffTimeTick {
+		logger.Info(ctx, "checkpoint doesn't cover the handoff time tick, skip the clean handoff marker")
+		return nil
+	}
+	checkpoint := rs.persistedCheckpoint.Clone()
+	checkpoint.CleanHandoff = true
+	if err := rs.retryOperationWithBackoff(ctx, logger.With(mlog.String("op", "persistCleanHandoff")), func(ctx context.Context) error {
+		return resource.Resource().StreamingNodeCatalog().SaveConsumeCheckpoint(ctx, rs.channel.Name, checkpoint.IntoProto())
+	}); err != nil {
+		return err
+	}
+	rs.persistedCheckpoint = checkpoint
+	logger.Info(ctx, "persist clean handoff marker of wal")
+	return nil
+}
+
+// recoverFromCleanHandoff recovers the recovery storage without replaying the wal stream,
+// the wal is closed cleanly by the previous owner, so the state recovered from meta is complete.
+// The marker is cleared before any new message is appended by current owner,
+// so the next owner never skips the messages appended by current owner.
+// The marker may be written by a stale owner after the new owner appends messages,
+// so the replay is skipped only if no message except the time tick is appended after the checkpoint,
+// otherwise it falls back to the recovery from the wal stream.
+func (r *recoveryStorageImpl) recoverFromCleanHandoff(
+	ctx context.Context,
+	recoveryStreamBuilder RecoveryStreamBuilder,
+	lastTimeTickMessage message.ImmutableMessage,
+) (*RecoverySnapshot, error) {
+	r.checkpoint.CleanHandoff = false
+	if err := r.retryOperationWithBackoff(ctx, r.Logger().With(mlog.String("op", "clearCleanHandoff")), func(ctx context.Context) error {
+		return resource.Resource().StreamingNodeCatalog().SaveConsumeCheckpoint(ctx, r.channel.Name, r.checkpoint.IntoProto())
+	}); err != nil {
+		return nil, err
+	}
+	r.persistedCheckpoint = r.checkpoint.Clone()
+
+	clean, err := r.isHandedOffCleanly(ctx, recoveryStreamBuilder, lastTimeTickMessage)
+	if err != nil {
+		return nil, err
+	}
+	if !clean {
+		r.Logger().Warn(ctx, "messages are appended after the clean handoff, recover from wal stream",
+			mlog.String("checkpoint", r.checkpoint.MessageID.String()),
+			mlog.Uint64("checkpointTimeTick", r.checkpoint.TimeTick))
+		return r.recoverFromStream(ctx, recoveryStreamBuilder, lastTimeTickMessage)
+	}
+	r.Logger().Info(ctx, "wal is handed off cleanly, skip the recovery from wal stream",
+		mlog.String("checkpoint", r.checkpoint.MessageID.String()),
+		mlog.Uint64("checkpointTimeTick", r.checkpoint.TimeTick))
+
+	snapshot := r.getSnapshot()
+	// the previous owner never hands off the wal with in-flight transactions.
+	snapshot.TxnBuffer = utility.NewTxnBuffer(r.Logger(), nil)
+	return snapshot, nil
+}
+
+// isHandedOffCleanly checks if the last message of the wal before the first time tick of current owner is covered by the checkpoint.
+// The time tick messages are ignored, they are appended by the owners that fail to open the wal and change nothing.
+func (r *recoveryStorageImpl) isHandedOffCleanly(
+	ctx context.Context,
+	recoveryStreamBuilder RecoveryStreamBuilder,
+	lastTimeTickMessage message.ImmutableMessage,
+) (bool, error) {
+	rs := recoveryStreamBuilder.Build(BuildRecoveryStreamParam{
+		StartCheckpoint: r.checkpoint.MessageID,
+		EndTimeTick:     lastTimeTickMessage.TimeTick(),
+	})
+	defer rs.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return false, errors.Wrap(ctx.Err(), "failed to check the clean handoff of wal")
+		case msg, ok := <-rs.Chan():
+			if !ok {
+				if err := rs.Error(); err != nil {
+					return false, errors.Wrap(err, "failed to check the clean handoff of wal")
+				}
+				return true, nil
+			}
+			if msg.MessageType() != message.MessageTypeTimeTick &&
+				msg.TimeTick() > r.checkpoint.TimeTick && msg.TimeTick() < lastTimeTickMessage.TimeTick() {
+				return false, nil
+			}
+		}
+	}
+}
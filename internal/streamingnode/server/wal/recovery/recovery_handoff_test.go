@@ -0,0 +1,105 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/mocks/mock_metastore"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/mocks/streaming/util/mock_message"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+func TestCleanHandoff(t *testing.T) {
+	var saved []*streamingpb.WALCheckpoint
+	snCatalog := mock_metastore.NewMockStreamingNodeCataLog(t)
+	snCatalog.EXPECT().SaveConsumeCheckpoint(mock.Anything, "test-pchannel", mock.Anything).RunAndReturn(
+		func(ctx context.Context, pchannel string, cp *streamingpb.WALCheckpoint) error {
+			saved = append(saved, cp)
+			return nil
+		})
+	resource.InitForTest(t, resource.OptStreamingNodeCatalog(snCatalog))
+
+	newRS := func() *recoveryStorageImpl {
+		return &recoveryStorageImpl{
+			cfg:     newConfig(),
+			channel: types.PChannelInfo{Name: "test-pchannel"},
+			checkpoint: &WALCheckpoint{
+				MessageID: walimplstest.NewTestMessageID(10),
+				TimeTick:  10,
+			},
+			persistedCheckpoint: &WALCheckpoint{
+				MessageID: walimplstest.NewTestMessageID(10),
+				TimeTick:  10,
+			},
+			segments:  map[int64]*segmentRecoveryInfo{},
+			vchannels: map[string]*vchannelRecoveryInfo{},
+			metrics:   newRecoveryStorageMetrics(types.PChannelInfo{Name: "test-pchannel"}),
+		}
+	}
+
+	// the marker is not persisted if the wal is not handed off.
+	rs := newRS()
+	assert.NoError(t, rs.persistCleanHandoffWhenClosing(context.Background()))
+	assert.Empty(t, saved)
+
+	// the marker is not persisted if the checkpoint doesn't cover the handoff time tick.
+	rs.MarkCleanHandoff(11)
+	assert.NoError(t, rs.persistCleanHandoffWhenClosing(context.Background()))
+	assert.Empty(t, saved)
+
+	rs.MarkCleanHandoff(10)
+	assert.NoError(t, rs.persistCleanHandoffWhenClosing(context.Background()))
+	assert.Len(t, saved, 1)
+	assert.True(t, saved[0].GetCleanHandoff())
+	assert.Equal(t, uint64(10), saved[0].GetTimeTick())
+	assert.True(t, rs.persistedCheckpoint.CleanHandoff)
+
+	// the new owner clears the marker before recovering from it.
+	rs = newRS()
+	rs.checkpoint.CleanHandoff = true
+	builder := &streamBuilder{
+		channel: types.PChannelInfo{Name: "test-pchannel"},
+		histories: []message.ImmutableMessage{
+			newHandoffTestMessage(t, 10, 10, message.MessageTypeTimeTick),
+			// the time tick appended by the owner that fails to open the wal.
+			newHandoffTestMessage(t, 11, 11, message.MessageTypeTimeTick),
+			newHandoffTestMessage(t, 12, 12, message.MessageTypeTimeTick),
+		},
+	}
+	lastTimeTickMessage := builder.histories[2]
+	snapshot, err := rs.recoverFromCleanHandoff(context.Background(), builder, lastTimeTickMessage)
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.False(t, saved[1].GetCleanHandoff())
+	assert.False(t, rs.checkpoint.CleanHandoff)
+	assert.False(t, rs.persistedCheckpoint.CleanHandoff)
+	assert.False(t, snapshot.Checkpoint.CleanHandoff)
+	assert.NotNil(t, snapshot.TxnBuffer)
+	assert.Empty(t, snapshot.TxnBuffer.GetUncommittedMessageBuilder())
+
+	// the message appended after the handoff is found, e.g. the marker is written by a stale owner.
+	rs = newRS()
+	builder.histories = []message.ImmutableMessage{
+		newHandoffTestMessage(t, 10, 10, message.MessageTypeTimeTick),
+		newHandoffTestMessage(t, 11, 11, message.MessageTypeInsert),
+		newHandoffTestMessage(t, 12, 12, message.MessageTypeTimeTick),
+	}
+	clean, err := rs.isHandedOffCleanly(context.Background(), builder, builder.histories[2])
+	assert.NoError(t, err)
+	assert.False(t, clean)
+}
+
+func newHandoffTestMessage(t *testing.T, id int64, timetick uint64, msgType message.MessageType) message.ImmutableMessage {
+	msg := mock_message.NewMockImmutableMessage(t)
+	msg.EXPECT().MessageID().Return(walimplstest.NewTestMessageID(id)).Maybe()
+	msg.EXPECT().TimeTick().Return(timetick).Maybe()
+	msg.EXPECT().MessageType().Return(msgType).Maybe()
+	return msg
+}
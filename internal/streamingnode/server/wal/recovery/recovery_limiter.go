@@ -0,0 +1,42 @@
+package recovery
+
+import (
+	"context"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+var (
+	recoverySemaphore         *syncutil.Semaphore
+	recoverySemaphoreInitOnce sync.Once
+)
+
+// acquireRecoverySlot acquires a slot to recover the wal,
+// it bounds the number of wals replaying the recovery stream concurrently on the streaming node,
+// so the streaming node taking over many pchannels recovers them in parallel without exhausting the resources.
+// The returned function should be called to release the slot after the recovery is done.
+func acquireRecoverySlot(ctx context.Context) (func(), error) {
+	sem := getRecoverySemaphore()
+	if err := sem.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	return sem.Release, nil
+}
+
+// getRecoverySemaphore returns the node-wide recovery semaphore,
+// the capacity is refreshed by the configuration every time it's acquired.
+func getRecoverySemaphore() *syncutil.Semaphore {
+	capacity := paramtable.Get().StreamingCfg.WALRecoveryMaxConcurrency.GetAsInt()
+	if capacity <= 0 {
+		capacity = 1
+	}
+	recoverySemaphoreInitOnce.Do(func() {
+		recoverySemaphore = syncutil.NewSemaphore(capacity)
+	})
+	if recoverySemaphore.Cap() != capacity {
+		recoverySemaphore.SetCapacity(capacity)
+	}
+	return recoverySemaphore
+}
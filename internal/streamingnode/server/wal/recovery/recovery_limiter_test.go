@@ -0,0 +1,43 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestAcquireRecoverySlot(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALRecoveryMaxConcurrency.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALRecoveryMaxConcurrency.Key)
+
+	release1, err := acquireRecoverySlot(context.Background())
+	assert.NoError(t, err)
+	release2, err := acquireRecoverySlot(context.Background())
+	assert.NoError(t, err)
+
+	// the recovery exceeds the concurrency waits until a running one is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = acquireRecoverySlot(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release1()
+	release3, err := acquireRecoverySlot(context.Background())
+	assert.NoError(t, err)
+
+	// the concurrency is refreshed by the configuration.
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALRecoveryMaxConcurrency.Key, "3")
+	release4, err := acquireRecoverySlot(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, recoverySemaphore.Current())
+
+	release2()
+	release3()
+	release4()
+	assert.Equal(t, 0, recoverySemaphore.Current())
+}
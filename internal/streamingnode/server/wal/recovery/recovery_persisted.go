@@ -155,6 +155,7 @@ func (r *recoveryStorageImpl) initializeRecoverInfo(ctx context.Context, channel
 		MessageId:     untilMessage.LastConfirmedMessageID().IntoProto(),
 		TimeTick:      untilMessage.TimeTick(),
 		RecoveryMagic: utility.RecoveryMagicStreamingInitialized,
+		Topic:         channelInfo.Topic,
 	}
 	if err := resource.Resource().StreamingNodeCatalog().SaveConsumeCheckpoint(ctx, channelInfo.Name, checkpoint); err != nil {
 		return nil, errors.Wrap(err, "failed to save checkpoint to catalog")
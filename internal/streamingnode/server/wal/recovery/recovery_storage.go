@@ -27,6 +27,8 @@ type RecoverySnapshot struct {
 	// SalvageCheckpoint captures the replicate checkpoint at force-promote time.
 	// It must be persisted before the consume checkpoint so that the ordering guarantee holds.
 	SalvageCheckpoint *utility.ReplicateCheckpoint
+	// observedBytes is the estimated bytes of the messages observed before the checkpoint.
+	observedBytes uint64
 }
 
 // AlterWALInfo contains information about WAL alteration process.
@@ -35,6 +37,7 @@ type AlterWALInfo struct {
 	TargetWALName    commonpb.WALName
 	AlterWALConfig   map[string]string
 	AlterWALTs       uint64
+	TargetTopic      string // the topic that the pchannel is migrated to, empty if the topic is not changed.
 }
 
 type BuildRecoveryStreamParam struct {
@@ -101,6 +104,14 @@ type RecoveryStorage interface {
 	// GetFlusherCheckpointByTimeTick returns the minimum flush checkpoint among all vchannels based on time tick.
 	GetFlusherCheckpointByTimeTick(ctx context.Context) *WALCheckpoint
 
+	// TruncateWAL truncates the wal to the given message id explicitly.
+	// It's rejected if the recovery storage, the flusher or any replicating task of the wal doesn't consume beyond the message id.
+	TruncateWAL(ctx context.Context, truncateTo message.MessageID) error
+
+	// MarkCleanHandoff marks the wal is closed cleanly, no message is appended into the wal after the time tick.
+	// The new owner of the wal skips the recovery from wal stream if the marker is persisted.
+	MarkCleanHandoff(timetick uint64)
+
 	// Close closes the recovery storage.
 	Close()
 }
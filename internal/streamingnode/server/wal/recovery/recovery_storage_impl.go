@@ -37,13 +37,27 @@ func RecoverRecoveryStorage(
 	cp *utility.WALCheckpoint,
 	lastTimeTickMessage message.ImmutableMessage,
 ) (RecoveryStorage, *RecoverySnapshot, error) {
+	// The recovery of different pchannels runs in parallel with a bounded concurrency,
+	// the recovery of a pchannel is always done in one goroutine, so the messages are applied in order.
+	release, err := acquireRecoverySlot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
 	rs := newRecoveryStorage(recoveryStreamBuilder.Channel(), cp)
 	if err := rs.recoverRecoveryInfoFromMeta(ctx, recoveryStreamBuilder.Channel(), lastTimeTickMessage); err != nil {
 		rs.Logger().Warn(ctx, "recovery storage failed", mlog.Err(err))
 		return nil, nil, err
 	}
+	rs.persistedCheckpoint = rs.checkpoint.Clone()
 	// recover the state from wal and start the background task to persist the state.
-	snapshot, err := rs.recoverFromStream(ctx, recoveryStreamBuilder, lastTimeTickMessage)
+	var snapshot *RecoverySnapshot
+	if cp.CleanHandoff {
+		snapshot, err = rs.recoverFromCleanHandoff(ctx, recoveryStreamBuilder, lastTimeTickMessage)
+	} else {
+		snapshot, err = rs.recoverFromStream(ctx, recoveryStreamBuilder, lastTimeTickMessage)
+	}
 	if err != nil {
 		rs.Logger().Warn(ctx, "recovery storage failed", mlog.Err(err))
 		return nil, nil, err
@@ -56,6 +70,7 @@ func RecoverRecoveryStorage(
 		mlog.String("channel", recoveryStreamBuilder.Channel().String()),
 		mlog.String("state", recoveryStorageStateWorking)))
 	rs.truncator = recoveryStreamBuilder.RWWALImpls()
+	rs.sampleTruncatePoint(rs.checkpoint, rs.observedBytes)
 	go rs.backgroundTask()
 	return rs, snapshot, nil
 }
@@ -72,6 +87,7 @@ func newRecoveryStorage(channel types.PChannelInfo, cp *utility.WALCheckpoint) *
 		checkpoint:             cp,
 		dirtyCounter:           0,
 		persistNotifier:        make(chan struct{}, 1),
+		truncateRequests:       make(chan *truncateRequest),
 		gracefulClosed:         false,
 		metrics:                newRecoveryStorageMetrics(channel),
 	}
@@ -89,9 +105,11 @@ type recoveryStorageImpl struct {
 	segments               map[int64]*segmentRecoveryInfo
 	vchannels              map[string]*vchannelRecoveryInfo
 	checkpoint             *WALCheckpoint
-	dirtyCounter           int // records the message count since last persist snapshot.
+	dirtyCounter           int    // records the message count since last persist snapshot.
+	observedBytes          uint64 // records the estimated bytes of the observed messages, used to apply the size based retention.
 	// used to trigger the recovery persist operation.
 	persistNotifier        chan struct{}
+	truncateRequests       chan *truncateRequest // the explicit truncation requests handled by the background task.
 	gracefulClosed         bool
 	truncator              walimpls.WALImpls
 	metrics                *recoveryMetrics
@@ -101,6 +119,14 @@ type recoveryStorageImpl struct {
 	// pendingSalvageCheckpoint holds the salvage checkpoint captured during force promote.
 	// Set under r.mu; consumed and persisted by the background task to avoid holding the lock.
 	pendingSalvageCheckpoint *utility.ReplicateCheckpoint
+	// truncateSamples are the persisted checkpoints ordered by message id, used as the truncation points of the retention policy.
+	// Only accessed by the background task.
+	truncateSamples []truncateSample
+	// persistedCheckpoint is the latest checkpoint persisted into the catalog.
+	// Only accessed by the background task after recovery.
+	persistedCheckpoint *WALCheckpoint
+	// handoffTimeTick is the time tick that covers all messages of the wal when the wal is closed cleanly.
+	handoffTimeTick uint64
 }
 
 // Metrics gets the metrics of the wal.
@@ -218,6 +244,7 @@ func (r *recoveryStorageImpl) consumeDirtySnapshot() *RecoverySnapshot {
 		SegmentAssignments: segments,
 		Checkpoint:         r.checkpoint.Clone(),
 		SalvageCheckpoint:  salvageCP,
+		observedBytes:      r.observedBytes,
 	}
 }
 
@@ -234,6 +261,7 @@ func (r *recoveryStorageImpl) observeMessage(msg message.ImmutableMessage) {
 		return
 	}
 	r.handleMessage(msg)
+	r.observedBytes += uint64(msg.EstimateSize())
 
 	r.updateCheckpoint(msg)
 	r.metrics.ObServeInMemMetrics(r.checkpoint.TimeTick)
@@ -261,6 +289,7 @@ func (r *recoveryStorageImpl) updateCheckpoint(msg message.ImmutableMessage) {
 				mlog.Bool("forcePromote", header.ForcePromote))
 		} else {
 			r.checkpoint.ReplicateConfig = header.ReplicateConfiguration
+			r.checkpoint.RBACReplicationDisabled = header.RbacReplicationDisabled
 			clusterRole := replicateutil.MustNewConfigHelper(r.currentClusterID, header.ReplicateConfiguration).GetCurrentCluster()
 			switch clusterRole.Role() {
 			case replicateutil.RolePrimary:
@@ -293,6 +322,7 @@ func (r *recoveryStorageImpl) updateCheckpoint(msg message.ImmutableMessage) {
 			TimeTick:      r.alterWALInfo.AlterWALTs,
 			Configs:       r.alterWALInfo.AlterWALConfig,
 			Stage:         streamingpb.AlterWALStage_FLUSHING,
+			TargetTopic:   r.alterWALInfo.TargetTopic,
 		}
 	}
 
@@ -421,6 +451,7 @@ func (r *recoveryStorageImpl) handleAlterWAL(msg message.ImmutableAlterWALMessag
 		TargetWALName:    header.TargetWalName,
 		AlterWALConfig:   header.Config,
 		AlterWALTs:       msg.TimeTick(),
+		TargetTopic:      header.GetPchannelTopics()[r.channel.Name],
 	}
 }
 
@@ -0,0 +1,96 @@
+package recovery
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+// truncateRequest is the request to truncate the wal explicitly.
+type truncateRequest struct {
+	truncateTo message.MessageID
+	result     chan error
+}
+
+// TruncateWAL truncates the wal to the given message id explicitly.
+// The request is handled by the background task, so it never runs concurrently with the truncation after persisting.
+func (r *recoveryStorageImpl) TruncateWAL(ctx context.Context, truncateTo message.MessageID) error {
+	if truncateTo == nil {
+		return status.NewInvalidArgument("truncate position of wal is not set")
+	}
+	req := &truncateRequest{
+		truncateTo: truncateTo,
+		result:     make(chan error, 1),
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.backgroundTaskNotifier.Context().Done():
+		return status.NewOnShutdownError("recovery storage is on shutdown")
+	case r.truncateRequests <- req:
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-req.result:
+		return err
+	}
+}
+
+// truncateByRequest validates the truncation position against all consumers of the wal and truncates the wal.
+func (rs *recoveryStorageImpl) truncateByRequest(ctx context.Context, truncateTo message.MessageID) error {
+	logger := rs.Logger().With(mlog.Stringer("truncateTo", truncateTo))
+	if err := rs.checkTruncatePosition(truncateTo); err != nil {
+		logger.Warn(ctx, "explicit truncation of wal is rejected", mlog.Err(err))
+		return err
+	}
+	if err := rs.truncator.Truncate(ctx, truncateTo); err != nil {
+		logger.Warn(ctx, "failed to truncate wal explicitly", mlog.Err(err))
+		return err
+	}
+	rs.pruneTruncateSamples(truncateTo)
+	logger.Info(ctx, "truncate wal explicitly")
+	return nil
+}
+
+// checkTruncatePosition checks if the checkpoints of all consumers of the wal are not less than the truncation position,
+// includes the persisted checkpoint of recovery storage, the checkpoint of the flusher and the checkpoints of the replicating tasks.
+// The rejection is unrecoverable, so the client reports it to the caller immediately instead of retrying.
+func (rs *recoveryStorageImpl) checkTruncatePosition(truncateTo message.MessageID) error {
+	if rs.persistedCheckpoint == nil {
+		return status.NewUnrecoverableError("checkpoint of wal is not persisted")
+	}
+	if truncateTo.WALName() != rs.persistedCheckpoint.MessageID.WALName() {
+		return status.NewUnrecoverableError("truncate position %s is not belong to current wal %s", truncateTo, rs.persistedCheckpoint.MessageID.WALName())
+	}
+	if rs.persistedCheckpoint.MessageID.LT(truncateTo) {
+		return status.NewUnrecoverableError("truncate position %s is beyond the persisted checkpoint %s", truncateTo, rs.persistedCheckpoint.MessageID)
+	}
+
+	flusherCP := rs.getFlusherCheckpoint()
+	if flusherCP == nil {
+		return status.NewUnrecoverableError("flusher checkpoint of wal is not ready")
+	}
+	if flusherCP.MessageID.LT(truncateTo) {
+		return status.NewUnrecoverableError("truncate position %s is beyond the flusher checkpoint %s", truncateTo, flusherCP.MessageID)
+	}
+
+	constraint, ok := retentions.get(rs.channel.Name)
+	if !ok {
+		return status.NewUnrecoverableError("retention of wal is not synced")
+	}
+	if constraint == nil {
+		return nil
+	}
+	if constraint.ReplicateCheckpointUnknown {
+		return status.NewUnrecoverableError("replicate checkpoint of wal is unknown")
+	}
+	for _, checkpoint := range constraint.ReplicateCheckpoints {
+		if checkpoint.WALName() == truncateTo.WALName() && checkpoint.LT(truncateTo) {
+			return status.NewUnrecoverableError("truncate position %s is beyond the replicate checkpoint %s", truncateTo, checkpoint)
+		}
+	}
+	return nil
+}
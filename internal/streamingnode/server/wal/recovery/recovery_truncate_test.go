@@ -0,0 +1,79 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/pkg/v3/mocks/streaming/mock_walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+func TestTruncateWAL(t *testing.T) {
+	defer resetRetentions()
+	resetRetentions()
+
+	truncator := mock_walimpls.NewMockWALImpls(t)
+	rs := &recoveryStorageImpl{
+		backgroundTaskNotifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+		channel:                types.PChannelInfo{Name: "test1-rootcoord-dml_0"},
+		truncateRequests:       make(chan *truncateRequest),
+		truncator:              truncator,
+		vchannels: map[string]*vchannelRecoveryInfo{
+			"v1": {flusherCheckpoint: &WALCheckpoint{MessageID: walimplstest.NewTestMessageID(8)}},
+		},
+	}
+	for i := 0; i < 10; i++ {
+		rs.sampleTruncatePoint(&WALCheckpoint{MessageID: walimplstest.NewTestMessageID(int64(i))}, uint64(i))
+	}
+	go func() {
+		for {
+			select {
+			case <-rs.backgroundTaskNotifier.Context().Done():
+				return
+			case req := <-rs.truncateRequests:
+				req.result <- rs.truncateByRequest(context.Background(), req.truncateTo)
+			}
+		}
+	}()
+	defer rs.backgroundTaskNotifier.Cancel()
+
+	ctx := context.Background()
+	assert.Error(t, rs.TruncateWAL(ctx, nil))
+	// the checkpoint is not persisted.
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(5)))
+
+	// the position beyond the persisted checkpoint or the flusher checkpoint is rejected.
+	rs.persistedCheckpoint = &WALCheckpoint{MessageID: walimplstest.NewTestMessageID(9)}
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(10)))
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(9)))
+
+	// the position beyond the replicate checkpoint is rejected.
+	EnableRetentionSync()
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(5)))
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{ReplicateCheckpointUnknown: true})
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(5)))
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{
+		ReplicateCheckpoints: []message.MessageID{walimplstest.NewTestMessageID(4)},
+	})
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(5)))
+
+	// the error of the underlying wal is returned.
+	truncator.EXPECT().Truncate(mock.Anything, mock.Anything).Return(errors.New("test")).Once()
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(4)))
+	assert.Len(t, rs.truncateSamples, 10)
+
+	truncator.EXPECT().Truncate(mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(4)))
+	assert.True(t, rs.truncateSamples[0].checkpoint.MessageID.EQ(walimplstest.NewTestMessageID(4)))
+
+	// the request is rejected after the recovery storage is closed.
+	rs.backgroundTaskNotifier.Cancel()
+	assert.Error(t, rs.TruncateWAL(ctx, walimplstest.NewTestMessageID(4)))
+}
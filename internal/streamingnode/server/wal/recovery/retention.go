@@ -0,0 +1,215 @@
+package recovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+// maxTruncateSamples is the max count of the sampled truncation points kept by the recovery storage,
+// the samples are thinned out by half if the count exceeds it, so the samples always cover the whole retention window.
+const maxTruncateSamples = 1024
+
+// retentions keeps the retention constraints of the wal synced from streamingcoord.
+var retentions = &retentionRegistry{
+	constraints: make(map[string]*RetentionConstraint),
+}
+
+// RetentionConstraint is the retention constraint of the wal of a pchannel.
+// The wal is never truncated beyond the constraint.
+type RetentionConstraint struct {
+	// Policy is the retention policy of the wal, nil if not set.
+	Policy *streamingpb.WALRetentionPolicy
+	// ReplicateCheckpoints are the message ids that the replicating tasks from the pchannel will be resumed from.
+	ReplicateCheckpoints []message.MessageID
+	// ReplicateCheckpointUnknown is true if the checkpoint of some replicating task is unknown.
+	ReplicateCheckpointUnknown bool
+	// ReplicateCheckpointTimeTick is the minimum time tick of the replicate checkpoints, 0 if not known.
+	ReplicateCheckpointTimeTick uint64
+}
+
+// NewRetentionConstraintFromProto creates a retention constraint from the retention got from streamingcoord.
+func NewRetentionConstraintFromProto(retention *streamingpb.PChannelRetention) *RetentionConstraint {
+	constraint := &RetentionConstraint{
+		Policy:                      retention.GetPolicy(),
+		ReplicateCheckpoints:        make([]message.MessageID, 0, len(retention.GetReplicateCheckpoints())),
+		ReplicateCheckpointUnknown:  retention.GetReplicateCheckpointUnknown(),
+		ReplicateCheckpointTimeTick: retention.GetReplicateCheckpointTimeTick(),
+	}
+	for _, checkpoint := range retention.GetReplicateCheckpoints() {
+		msgID, err := message.UnmarshalMessageID(checkpoint)
+		if err != nil {
+			// the checkpoint cannot be compared with the wal, so the truncation is held.
+			constraint.ReplicateCheckpointUnknown = true
+			continue
+		}
+		constraint.ReplicateCheckpoints = append(constraint.ReplicateCheckpoints, msgID)
+	}
+	return constraint
+}
+
+// EnableRetentionSync marks the retention of wal is synced from streamingcoord,
+// the wal is not truncated until the retention constraint of its pchannel is synced.
+func EnableRetentionSync() {
+	retentions.mu.Lock()
+	defer retentions.mu.Unlock()
+	retentions.enabled = true
+}
+
+// UpdateRetentionConstraint updates the retention constraint of the wal of the pchannel.
+func UpdateRetentionConstraint(pchannel string, constraint *RetentionConstraint) {
+	retentions.mu.Lock()
+	defer retentions.mu.Unlock()
+	retentions.constraints[pchannel] = constraint
+}
+
+// RemoveRetentionConstraint removes the retention constraint of the wal of the pchannel,
+// e.g. the wal is not opened on current streaming node any more.
+func RemoveRetentionConstraint(pchannel string) {
+	retentions.mu.Lock()
+	defer retentions.mu.Unlock()
+	delete(retentions.constraints, pchannel)
+}
+
+// GetReplicateCheckpointTimeTick returns the minimum time tick of the replicate checkpoints of the wal of the pchannel.
+// Return 0 if there's no replicating task from the pchannel or the time tick is not synced yet.
+func GetReplicateCheckpointTimeTick(pchannel string) uint64 {
+	retentions.mu.Lock()
+	defer retentions.mu.Unlock()
+	if constraint, ok := retentions.constraints[pchannel]; ok && constraint != nil {
+		return constraint.ReplicateCheckpointTimeTick
+	}
+	return 0
+}
+
+// RetentionConstraintPChannels returns the pchannels that have retention constraint.
+func RetentionConstraintPChannels() []string {
+	retentions.mu.Lock()
+	defer retentions.mu.Unlock()
+	pchannels := make([]string, 0, len(retentions.constraints))
+	for pchannel := range retentions.constraints {
+		pchannels = append(pchannels, pchannel)
+	}
+	return pchannels
+}
+
+// retentionRegistry is the registry of the retention constraints of the wal, keyed by the pchannel name.
+type retentionRegistry struct {
+	mu          sync.Mutex
+	enabled     bool
+	constraints map[string]*RetentionConstraint
+}
+
+// get returns the retention constraint of the pchannel.
+// Return false if the retention sync is enabled but the constraint of the pchannel is not synced yet.
+func (r *retentionRegistry) get(pchannel string) (*RetentionConstraint, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.enabled {
+		return nil, true
+	}
+	constraint, ok := r.constraints[pchannel]
+	return constraint, ok
+}
+
+// truncateSample is a sampled truncation point of the wal.
+type truncateSample struct {
+	checkpoint    *WALCheckpoint
+	observedBytes uint64 // the estimated bytes of the messages observed by the recovery storage before the checkpoint.
+}
+
+// sampleTruncatePoint records the persisted checkpoint as a candidate truncation point of the retention policy.
+func (rs *recoveryStorageImpl) sampleTruncatePoint(checkpoint *WALCheckpoint, observedBytes uint64) {
+	if len(rs.truncateSamples) > 0 && rs.truncateSamples[len(rs.truncateSamples)-1].checkpoint.MessageID.EQ(checkpoint.MessageID) {
+		return
+	}
+	rs.truncateSamples = append(rs.truncateSamples, truncateSample{
+		checkpoint:    checkpoint.Clone(),
+		observedBytes: observedBytes,
+	})
+	if len(rs.truncateSamples) > maxTruncateSamples {
+		// keep the latest sample, and drop every other sample before it.
+		thinned := make([]truncateSample, 0, len(rs.truncateSamples)/2+1)
+		for i := len(rs.truncateSamples) - 1; i >= 0; i -= 2 {
+			thinned = append(thinned, rs.truncateSamples[i])
+		}
+		for i, j := 0, len(thinned)-1; i < j; i, j = i+1, j-1 {
+			thinned[i], thinned[j] = thinned[j], thinned[i]
+		}
+		rs.truncateSamples = thinned
+	}
+}
+
+// applyRetention bounds the truncation point by the retention constraint of the wal.
+// Return false if the wal should not be truncated.
+func (rs *recoveryStorageImpl) applyRetention(ctx context.Context, truncateTo message.MessageID) (message.MessageID, bool) {
+	constraint, ok := retentions.get(rs.channel.Name)
+	if !ok {
+		rs.Logger().Debug(ctx, "retention of wal is not synced, skip truncation")
+		return nil, false
+	}
+	if constraint == nil {
+		return truncateTo, true
+	}
+	if constraint.ReplicateCheckpointUnknown {
+		rs.Logger().Debug(ctx, "replicate checkpoint of wal is unknown, skip truncation")
+		return nil, false
+	}
+	for _, checkpoint := range constraint.ReplicateCheckpoints {
+		if checkpoint.WALName() != truncateTo.WALName() {
+			// the checkpoint belongs to the wal before the wal is altered, it cannot be truncated by current wal.
+			continue
+		}
+		if checkpoint.LT(truncateTo) {
+			truncateTo = checkpoint
+		}
+	}
+	if constraint.Policy == nil {
+		return truncateTo, true
+	}
+	cut := rs.getRetentionCut(constraint.Policy, time.Now())
+	if cut == nil {
+		return nil, false
+	}
+	if cut.LT(truncateTo) {
+		truncateTo = cut
+	}
+	return truncateTo, true
+}
+
+// getRetentionCut returns the latest sampled truncation point that is out of the retention window of the policy.
+// Return nil if all sampled points are in the window.
+func (rs *recoveryStorageImpl) getRetentionCut(policy *streamingpb.WALRetentionPolicy, now time.Time) message.MessageID {
+	if len(rs.truncateSamples) == 0 {
+		return nil
+	}
+	ttl := time.Duration(policy.GetTtlSeconds()) * time.Second
+	latestBytes := rs.truncateSamples[len(rs.truncateSamples)-1].observedBytes
+	for i := len(rs.truncateSamples) - 1; i >= 0; i-- {
+		sample := rs.truncateSamples[i]
+		if ttl > 0 && now.Sub(tsoutil.PhysicalTime(sample.checkpoint.TimeTick)) >= ttl {
+			return sample.checkpoint.MessageID
+		}
+		if policy.GetMaxBytes() > 0 && latestBytes-sample.observedBytes >= policy.GetMaxBytes() {
+			return sample.checkpoint.MessageID
+		}
+	}
+	return nil
+}
+
+// pruneTruncateSamples removes the sampled truncation points before the truncated position.
+func (rs *recoveryStorageImpl) pruneTruncateSamples(truncated message.MessageID) {
+	i := 0
+	for i < len(rs.truncateSamples) && rs.truncateSamples[i].checkpoint.MessageID.LT(truncated) {
+		i++
+	}
+	if i > 0 {
+		rs.Logger().Debug(context.TODO(), "prune truncate samples", mlog.Int("count", i), mlog.Stringer("truncated", truncated))
+		rs.truncateSamples = rs.truncateSamples[i:]
+	}
+}
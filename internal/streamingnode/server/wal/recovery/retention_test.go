@@ -0,0 +1,153 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/util/tsoutil"
+)
+
+func resetRetentions() {
+	retentions = &retentionRegistry{
+		constraints: make(map[string]*RetentionConstraint),
+	}
+}
+
+func TestSampleTruncatePoint(t *testing.T) {
+	rs := &recoveryStorageImpl{}
+	for i := 0; i < maxTruncateSamples+1; i++ {
+		rs.sampleTruncatePoint(&WALCheckpoint{MessageID: walimplstest.NewTestMessageID(int64(i))}, uint64(i))
+		// the duplicated checkpoint is ignored.
+		rs.sampleTruncatePoint(&WALCheckpoint{MessageID: walimplstest.NewTestMessageID(int64(i))}, uint64(i))
+	}
+	// the samples are thinned out by half, and the latest sample is always kept.
+	assert.Len(t, rs.truncateSamples, maxTruncateSamples/2+1)
+	assert.True(t, rs.truncateSamples[0].checkpoint.MessageID.EQ(walimplstest.NewTestMessageID(0)))
+	assert.True(t, rs.truncateSamples[len(rs.truncateSamples)-1].checkpoint.MessageID.EQ(walimplstest.NewTestMessageID(maxTruncateSamples)))
+	for i := 1; i < len(rs.truncateSamples); i++ {
+		assert.True(t, rs.truncateSamples[i-1].checkpoint.MessageID.LT(rs.truncateSamples[i].checkpoint.MessageID))
+	}
+
+	rs.pruneTruncateSamples(walimplstest.NewTestMessageID(100))
+	assert.True(t, rs.truncateSamples[0].checkpoint.MessageID.EQ(walimplstest.NewTestMessageID(100)))
+}
+
+func TestGetRetentionCut(t *testing.T) {
+	now := time.Now()
+	rs := &recoveryStorageImpl{}
+	assert.Nil(t, rs.getRetentionCut(&streamingpb.WALRetentionPolicy{TtlSeconds: 1}, now))
+
+	for i := 0; i < 10; i++ {
+		rs.sampleTruncatePoint(&WALCheckpoint{
+			MessageID: walimplstest.NewTestMessageID(int64(i)),
+			TimeTick:  tsoutil.ComposeTSByTime(now.Add(time.Duration(i-10)*time.Minute), 0),
+		}, uint64(i*100))
+	}
+
+	// the latest sample out of the ttl is the cut.
+	cut := rs.getRetentionCut(&streamingpb.WALRetentionPolicy{TtlSeconds: 300}, now)
+	assert.True(t, cut.EQ(walimplstest.NewTestMessageID(5)))
+	// the latest sample out of the max bytes is the cut.
+	cut = rs.getRetentionCut(&streamingpb.WALRetentionPolicy{MaxBytes: 250}, now)
+	assert.True(t, cut.EQ(walimplstest.NewTestMessageID(6)))
+	// the policy is exceeded if any bound is exceeded.
+	cut = rs.getRetentionCut(&streamingpb.WALRetentionPolicy{TtlSeconds: 300, MaxBytes: 250}, now)
+	assert.True(t, cut.EQ(walimplstest.NewTestMessageID(6)))
+	// all samples are in the window.
+	assert.Nil(t, rs.getRetentionCut(&streamingpb.WALRetentionPolicy{TtlSeconds: 3600, MaxBytes: 10000}, now))
+}
+
+func TestApplyRetention(t *testing.T) {
+	defer resetRetentions()
+	resetRetentions()
+
+	ctx := context.Background()
+	now := time.Now()
+	rs := &recoveryStorageImpl{channel: types.PChannelInfo{Name: "test1-rootcoord-dml_0"}}
+	for i := 0; i < 10; i++ {
+		rs.sampleTruncatePoint(&WALCheckpoint{
+			MessageID: walimplstest.NewTestMessageID(int64(i)),
+			TimeTick:  tsoutil.ComposeTSByTime(now.Add(time.Duration(i-10)*time.Minute), 0),
+		}, uint64(i*100))
+	}
+	truncateTo := walimplstest.NewTestMessageID(8)
+
+	// the wal is truncated directly if the retention sync is not enabled.
+	msgID, ok := rs.applyRetention(ctx, truncateTo)
+	assert.True(t, ok)
+	assert.True(t, msgID.EQ(truncateTo))
+
+	// the truncation is held until the retention is synced.
+	EnableRetentionSync()
+	_, ok = rs.applyRetention(ctx, truncateTo)
+	assert.False(t, ok)
+
+	UpdateRetentionConstraint(rs.channel.Name, nil)
+	msgID, ok = rs.applyRetention(ctx, truncateTo)
+	assert.True(t, ok)
+	assert.True(t, msgID.EQ(truncateTo))
+
+	// the truncation is held if the replicate checkpoint is unknown.
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{ReplicateCheckpointUnknown: true})
+	_, ok = rs.applyRetention(ctx, truncateTo)
+	assert.False(t, ok)
+
+	// the truncation is bounded by the replicate checkpoints.
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{
+		ReplicateCheckpoints: []message.MessageID{walimplstest.NewTestMessageID(7), walimplstest.NewTestMessageID(3)},
+	})
+	msgID, ok = rs.applyRetention(ctx, truncateTo)
+	assert.True(t, ok)
+	assert.True(t, msgID.EQ(walimplstest.NewTestMessageID(3)))
+
+	// the truncation is bounded by the retention policy.
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{
+		Policy:               &streamingpb.WALRetentionPolicy{TtlSeconds: 300},
+		ReplicateCheckpoints: []message.MessageID{walimplstest.NewTestMessageID(7)},
+	})
+	msgID, ok = rs.applyRetention(ctx, truncateTo)
+	assert.True(t, ok)
+	assert.True(t, msgID.EQ(walimplstest.NewTestMessageID(5)))
+
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{Policy: &streamingpb.WALRetentionPolicy{TtlSeconds: 3600}})
+	_, ok = rs.applyRetention(ctx, truncateTo)
+	assert.False(t, ok)
+
+	UpdateRetentionConstraint(rs.channel.Name, &RetentionConstraint{ReplicateCheckpointTimeTick: 100})
+	assert.Equal(t, uint64(100), GetReplicateCheckpointTimeTick(rs.channel.Name))
+	assert.Zero(t, GetReplicateCheckpointTimeTick("unknown"))
+
+	assert.Equal(t, []string{rs.channel.Name}, RetentionConstraintPChannels())
+	RemoveRetentionConstraint(rs.channel.Name)
+	assert.Empty(t, RetentionConstraintPChannels())
+}
+
+func TestNewRetentionConstraintFromProto(t *testing.T) {
+	constraint := NewRetentionConstraintFromProto(&streamingpb.PChannelRetention{
+		Pchannel: "test1-rootcoord-dml_0",
+		Policy:   &streamingpb.WALRetentionPolicy{TtlSeconds: 60},
+		ReplicateCheckpoints: []*commonpb.MessageID{
+			walimplstest.NewTestMessageID(1).IntoProto(),
+		},
+		ReplicateCheckpointTimeTick: 100,
+	})
+	assert.Equal(t, uint64(60), constraint.Policy.GetTtlSeconds())
+	assert.Len(t, constraint.ReplicateCheckpoints, 1)
+	assert.False(t, constraint.ReplicateCheckpointUnknown)
+	assert.Equal(t, uint64(100), constraint.ReplicateCheckpointTimeTick)
+
+	// the checkpoint that cannot be unmarshaled is unknown.
+	constraint = NewRetentionConstraintFromProto(&streamingpb.PChannelRetention{
+		ReplicateCheckpoints: []*commonpb.MessageID{{WALName: commonpb.WALName(-1), Id: "invalid"}},
+	})
+	assert.Empty(t, constraint.ReplicateCheckpoints)
+	assert.True(t, constraint.ReplicateCheckpointUnknown)
+}
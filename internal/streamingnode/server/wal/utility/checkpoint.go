@@ -17,23 +17,30 @@ func NewWALCheckpointFromProto(cp *streamingpb.WALCheckpoint) *WALCheckpoint {
 		return nil
 	}
 	return &WALCheckpoint{
-		MessageID:           message.MustUnmarshalMessageID(cp.MessageId),
-		TimeTick:            cp.TimeTick,
-		Magic:               cp.RecoveryMagic,
-		ReplicateConfig:     cp.ReplicateConfig,
-		ReplicateCheckpoint: NewReplicateCheckpointFromProto(cp.ReplicateCheckpoint),
-		AlterWalState:       cp.AlterWalState,
+		MessageID:               message.MustUnmarshalMessageID(cp.MessageId),
+		TimeTick:                cp.TimeTick,
+		Magic:                   cp.RecoveryMagic,
+		ReplicateConfig:         cp.ReplicateConfig,
+		ReplicateCheckpoint:     NewReplicateCheckpointFromProto(cp.ReplicateCheckpoint),
+		AlterWalState:           cp.AlterWalState,
+		CleanHandoff:            cp.CleanHandoff,
+		RBACReplicationDisabled: cp.RbacReplicationDisabled,
+		Topic:                   cp.Topic,
 	}
 }
 
 // WALCheckpoint represents a consume checkpoint in the Write-Ahead Log (WAL).
 type WALCheckpoint struct {
-	MessageID           message.MessageID // should always be not nil.
-	TimeTick            uint64
-	Magic               int64
-	ReplicateCheckpoint *ReplicateCheckpoint
-	ReplicateConfig     *commonpb.ReplicateConfiguration
-	AlterWalState       *streamingpb.AlterWALState
+	MessageID               message.MessageID // should always be not nil.
+	TimeTick                uint64
+	Magic                   int64
+	ReplicateCheckpoint     *ReplicateCheckpoint
+	ReplicateConfig         *commonpb.ReplicateConfiguration
+	AlterWalState           *streamingpb.AlterWALState
+	CleanHandoff            bool // the wal is closed cleanly by the previous owner, see streamingpb.WALCheckpoint.
+	RBACReplicationDisabled bool // the rbac is not replicated into current cluster, set by the replicate configuration.
+	// the topic that the message id belongs to, the pchannel name is used if empty.
+	Topic string
 }
 
 // IntoProto converts the WALCheckpoint to a protobuf message.
@@ -42,24 +49,30 @@ func (c *WALCheckpoint) IntoProto() *streamingpb.WALCheckpoint {
 		return nil
 	}
 	return &streamingpb.WALCheckpoint{
-		MessageId:           message.MustMarshalMessageID(c.MessageID),
-		TimeTick:            c.TimeTick,
-		RecoveryMagic:       c.Magic,
-		ReplicateConfig:     c.ReplicateConfig,
-		ReplicateCheckpoint: c.ReplicateCheckpoint.IntoProto(),
-		AlterWalState:       c.AlterWalState,
+		MessageId:               message.MustMarshalMessageID(c.MessageID),
+		TimeTick:                c.TimeTick,
+		RecoveryMagic:           c.Magic,
+		ReplicateConfig:         c.ReplicateConfig,
+		ReplicateCheckpoint:     c.ReplicateCheckpoint.IntoProto(),
+		AlterWalState:           c.AlterWalState,
+		CleanHandoff:            c.CleanHandoff,
+		RbacReplicationDisabled: c.RBACReplicationDisabled,
+		Topic:                   c.Topic,
 	}
 }
 
 // Clone creates a new WALCheckpoint with the same values as the original.
 func (c *WALCheckpoint) Clone() *WALCheckpoint {
 	return &WALCheckpoint{
-		MessageID:           c.MessageID,
-		TimeTick:            c.TimeTick,
-		Magic:               c.Magic,
-		ReplicateConfig:     c.ReplicateConfig,
-		ReplicateCheckpoint: c.ReplicateCheckpoint.Clone(),
-		AlterWalState:       c.AlterWalState,
+		MessageID:               c.MessageID,
+		TimeTick:                c.TimeTick,
+		Magic:                   c.Magic,
+		ReplicateConfig:         c.ReplicateConfig,
+		ReplicateCheckpoint:     c.ReplicateCheckpoint.Clone(),
+		AlterWalState:           c.AlterWalState,
+		CleanHandoff:            c.CleanHandoff,
+		RBACReplicationDisabled: c.RBACReplicationDisabled,
+		Topic:                   c.Topic,
 	}
 }
 
@@ -83,4 +83,16 @@ func TestNewWALCheckpointFromProto(t *testing.T) {
 	assert.Equal(t, uint64(123456), checkpoint2.ReplicateCheckpoint.TimeTick)
 	assert.True(t, rmq.NewRmqID(2).EQ(checkpoint2.ReplicateCheckpoint.MessageID))
 	assert.NotNil(t, checkpoint2.ReplicateConfig)
+
+	protoCheckpoint.CleanHandoff = true
+	newCheckpoint = NewWALCheckpointFromProto(protoCheckpoint)
+	assert.True(t, newCheckpoint.CleanHandoff)
+	assert.True(t, newCheckpoint.IntoProto().CleanHandoff)
+	assert.True(t, newCheckpoint.Clone().CleanHandoff)
+
+	protoCheckpoint.RbacReplicationDisabled = true
+	newCheckpoint = NewWALCheckpointFromProto(protoCheckpoint)
+	assert.True(t, newCheckpoint.RBACReplicationDisabled)
+	assert.True(t, newCheckpoint.IntoProto().RbacReplicationDisabled)
+	assert.True(t, newCheckpoint.Clone().RBACReplicationDisabled)
 }
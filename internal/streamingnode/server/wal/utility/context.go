@@ -52,6 +52,15 @@ func WithExtraAppendResult(ctx context.Context, r *ExtraAppendResult) context.Co
 	return context.WithValue(ctx, extraAppendResultValue, r)
 }
 
+// GetExtraAppendResult get extra append result from context, nil if not set.
+func GetExtraAppendResult(ctx context.Context) *ExtraAppendResult {
+	val := ctx.Value(extraAppendResultValue)
+	if val == nil {
+		return nil
+	}
+	return val.(*ExtraAppendResult)
+}
+
 // ModifyAppendResultExtra modify extra in context
 func ModifyAppendResultExtra[M protoreflect.ProtoMessage](ctx context.Context, modifier func(old M) (new M)) {
 	result := ctx.Value(extraAppendResultValue)
@@ -19,7 +19,7 @@ import (
 var idAllocator = typeutil.NewIDAllocator()
 
 func TestTxnBuffer(t *testing.T) {
-	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 
 	baseTso := tsoutil.GetCurrentTime()
 
@@ -173,7 +173,7 @@ func newAlterReplicateConfigMessage(t *testing.T, forcePromote bool, ignore bool
 }
 
 func TestRollbackAllUncommittedTxn(t *testing.T) {
-	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 
 	baseTso := tsoutil.GetCurrentTime()
 
@@ -215,7 +215,7 @@ func TestRollbackAllUncommittedTxn(t *testing.T) {
 }
 
 func TestRollbackAllUncommittedTxn_Empty(t *testing.T) {
-	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 
 	// Rollback on empty buffer should be a no-op
 	b.rollbackAllUncommittedTxn()
@@ -224,7 +224,7 @@ func TestRollbackAllUncommittedTxn_Empty(t *testing.T) {
 }
 
 func TestForcePromoteRollsBackUncommittedTxn(t *testing.T) {
-	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 
 	baseTso := tsoutil.GetCurrentTime()
 
@@ -260,7 +260,7 @@ func TestForcePromoteRollsBackUncommittedTxn(t *testing.T) {
 }
 
 func TestForcePromoteIgnored_DoesNotRollback(t *testing.T) {
-	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 
 	baseTso := tsoutil.GetCurrentTime()
 
@@ -296,7 +296,7 @@ func TestForcePromoteIgnored_DoesNotRollback(t *testing.T) {
 }
 
 func TestNonForcePromoteAlterReplicateConfig_DoesNotRollback(t *testing.T) {
-	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics())
+	b := NewTxnBuffer(mlog.With(), metricsutil.NewScanMetrics(types.PChannelInfo{}).NewScannerMetrics("test"))
 
 	baseTso := tsoutil.GetCurrentTime()
 
@@ -35,6 +35,10 @@ type WAL interface {
 	// Returns an empty slice if no force promote has occurred.
 	GetSalvageCheckpoint() []*ReplicateCheckpoint
 
+	// Truncate truncates the wal to the given message id explicitly.
+	// It's rejected if any consumer of the wal, e.g. the flusher or the replicating tasks, doesn't consume beyond the message id.
+	Truncate(ctx context.Context, truncateTo message.MessageID) error
+
 	// Append writes a record to the log.
 	Append(ctx context.Context, msg message.MutableMessage) (*AppendResult, error)
 
@@ -0,0 +1,53 @@
+package walmanager
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+// HandoffReporter reports the wals closed by the streaming node to streamingcoord.
+type HandoffReporter interface {
+	// ReportPChannelHandoff reports the pchannels closed by the streaming node when shutting down.
+	ReportPChannelHandoff(ctx context.Context, channels []types.PChannelInfo) error
+}
+
+// CloseWithHandoff closes the wal manager and reports all closed wals to streamingcoord,
+// so streamingcoord reassigns them right away instead of waiting for the session or the lease of the streaming node to expire.
+// The report is best-effort, the wals are reassigned by the session or lease expiration if the report fails.
+func CloseWithHandoff(m Manager, reporter func() HandoffReporter) {
+	if !paramtable.Get().StreamingCfg.WALRecoveryHandoffEnabled.GetAsBool() {
+		m.Close()
+		return
+	}
+
+	var channels []types.PChannelInfo
+	if metrics, err := m.Metrics(); err == nil {
+		channels = make([]types.PChannelInfo, 0, len(metrics.WALMetrics))
+		for _, wm := range metrics.WALMetrics {
+			switch wm := wm.(type) {
+			case types.RWWALMetrics:
+				channels = append(channels, wm.ChannelInfo)
+			case types.ROWALMetrics:
+				channels = append(channels, wm.ChannelInfo)
+			}
+		}
+	}
+	// the wal should be closed before reporting, otherwise the new owner may recover the wal before the last time tick is synced.
+	m.Close()
+	if len(channels) == 0 {
+		return
+	}
+
+	logger := resource.Resource().Logger().With(mlog.FieldComponent("wal-handoff"))
+	ctx, cancel := context.WithTimeout(context.Background(), paramtable.Get().StreamingCfg.WALRecoveryHandoffTimeout.GetAsDurationByParse())
+	defer cancel()
+	if err := reporter().ReportPChannelHandoff(ctx, channels); err != nil {
+		logger.Warn(ctx, "fail to report wal handoff to streamingcoord", mlog.Err(err))
+		return
+	}
+	logger.Info(ctx, "report wal handoff to streamingcoord", mlog.Int("channelCount", len(channels)))
+}
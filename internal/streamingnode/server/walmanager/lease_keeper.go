@@ -0,0 +1,99 @@
+package walmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+// LeaseRenewer renews the lease of the wal held by the streaming node at streamingcoord.
+type LeaseRenewer interface {
+	// RenewPChannelLease acknowledges the assignment and renews the lease of the pchannels held by the streaming node.
+	// Return the pchannels that are not owned by the streaming node any more.
+	RenewPChannelLease(ctx context.Context, node types.StreamingNodeInfo, channels []types.PChannelInfo) ([]types.PChannelInfo, error)
+}
+
+// StartLeaseKeeper starts a lease keeper to acknowledge the assignment and renew the lease of all opened wal periodically.
+func StartLeaseKeeper(m Manager, node types.StreamingNodeInfo, renewer func() LeaseRenewer) *LeaseKeeper {
+	k := &LeaseKeeper{
+		notifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+		manager:  m,
+		node:     node,
+		renewer:  renewer,
+		logger:   resource.Resource().Logger().With(mlog.FieldComponent("wal-lease-keeper"), mlog.Int64("serverID", node.ServerID)),
+	}
+	go k.execute()
+	return k
+}
+
+// LeaseKeeper keeps the lease of the wal opened on current streaming node.
+type LeaseKeeper struct {
+	notifier *syncutil.AsyncTaskNotifier[struct{}]
+	manager  Manager
+	node     types.StreamingNodeInfo
+	renewer  func() LeaseRenewer
+	logger   *mlog.Logger
+}
+
+// Close stops the lease keeper.
+func (k *LeaseKeeper) Close() {
+	k.notifier.Cancel()
+	k.notifier.BlockUntilFinish()
+}
+
+// execute renews the lease periodically until the keeper is closed.
+func (k *LeaseKeeper) execute() {
+	defer k.notifier.Finish(struct{}{})
+
+	ctx := k.notifier.Context()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if err := k.renew(ctx); err != nil {
+			k.logger.Warn(ctx, "fail to renew wal lease", mlog.Err(err))
+		}
+		timer.Reset(paramtable.Get().StreamingCfg.WALBalancerLeaseRenewInterval.GetAsDurationByParse())
+	}
+}
+
+// renew renews the lease of all opened wal, and removes the wal whose lease is revoked by streamingcoord.
+func (k *LeaseKeeper) renew(ctx context.Context) error {
+	metrics, err := k.manager.Metrics()
+	if err != nil {
+		return err
+	}
+	channels := make([]types.PChannelInfo, 0, len(metrics.WALMetrics))
+	for _, m := range metrics.WALMetrics {
+		switch m := m.(type) {
+		case types.RWWALMetrics:
+			channels = append(channels, m.ChannelInfo)
+		case types.ROWALMetrics:
+			channels = append(channels, m.ChannelInfo)
+		}
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, paramtable.Get().StreamingCfg.WALBalancerLeaseRenewInterval.GetAsDurationByParse())
+	defer cancel()
+	revoked, err := k.renewer().RenewPChannelLease(opCtx, k.node, channels)
+	if err != nil {
+		return err
+	}
+	for _, channel := range revoked {
+		// The wal is not owned by current node any more, remove it to fence the stale write operation.
+		k.logger.Warn(ctx, "wal lease is revoked by streamingcoord, remove it", mlog.Stringer("channel", channel))
+		if err := k.manager.Remove(ctx, channel); err != nil {
+			k.logger.Warn(ctx, "fail to remove the revoked wal", mlog.Stringer("channel", channel), mlog.Err(err))
+		}
+	}
+	return nil
+}
@@ -2,12 +2,16 @@ package walmanager
 
 import (
 	"context"
+	"sync"
 
 	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/adaptor"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/cipher"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/dedup"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/lock"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/quota"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/redo"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/replicate"
 	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/interceptors/shard"
@@ -26,16 +30,21 @@ func OpenManager() (Manager, error) {
 	resource.Resource().Logger().Info(context.TODO(),
 
 		"open wal manager with dynamic opener")
-	// Create dynamic opener directly with interceptors
-	opener := adaptor.NewOpenerAdaptor(
-		[]interceptors.InterceptorBuilder{
-			redo.NewInterceptorBuilder(),
-			lock.NewInterceptorBuilder(),
-			replicate.NewInterceptorBuilder(),
-			timetick.NewInterceptorBuilder(),
-			shard.NewInterceptorBuilder(),
-		},
+	// Create dynamic opener with the builtin interceptors and the registered ones.
+	builders, err := interceptors.OrderInterceptorBuilders(
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameDedup, Builder: dedup.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameQuota, Builder: quota.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameRedo, Builder: redo.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameLock, Builder: lock.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameReplicate, Builder: replicate.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameTimeTick, Builder: timetick.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameShard, Builder: shard.NewInterceptorBuilder()},
+		interceptors.NamedInterceptorBuilder{Name: interceptors.InterceptorNameCipher, Builder: cipher.NewInterceptorBuilder()},
 	)
+	if err != nil {
+		return nil, err
+	}
+	opener := adaptor.NewOpenerAdaptor(builders)
 	return newManager(opener), nil
 }
 
@@ -139,11 +148,18 @@ func (m *managerImpl) Metrics() (*types.StreamingNodeMetrics, error) {
 func (m *managerImpl) Close() {
 	m.lifetime.SetState(managerRemoveable)
 	m.lifetime.Wait()
-	// close all underlying walLifetime.
+	// close all underlying walLifetime in parallel,
+	// so the shutdown is not blocked by the clean handoff of the wals one by one.
+	wg := sync.WaitGroup{}
 	m.wltMap.Range(func(channel string, wlt *walLifetime) bool {
-		wlt.Close()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wlt.Close()
+		}()
 		return true
 	})
+	wg.Wait()
 	m.lifetime.SetState(managerStopped)
 	m.lifetime.Wait()
 
@@ -0,0 +1,118 @@
+package walmanager
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/milvus-io/milvus/internal/streamingnode/server/resource"
+	"github.com/milvus-io/milvus/internal/streamingnode/server/wal/recovery"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+// RetentionFetcher fetches the retention of the wal from streamingcoord.
+type RetentionFetcher interface {
+	// GetPChannelRetentions gets the retention policies and the replicate checkpoints of the wal of pchannels.
+	GetPChannelRetentions(ctx context.Context, pchannels []string) ([]*streamingpb.PChannelRetention, error)
+}
+
+// StartRetentionKeeper starts a retention keeper to sync the retention of all opened wal periodically.
+// The wal is not truncated by the recovery storage until the retention of its pchannel is synced.
+func StartRetentionKeeper(m Manager, fetcher func() RetentionFetcher) *RetentionKeeper {
+	recovery.EnableRetentionSync()
+	k := &RetentionKeeper{
+		notifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+		manager:  m,
+		fetcher:  fetcher,
+		logger:   resource.Resource().Logger().With(mlog.FieldComponent("wal-retention-keeper")),
+	}
+	go k.execute()
+	return k
+}
+
+// RetentionKeeper keeps the retention of the wal opened on current streaming node synced with streamingcoord.
+type RetentionKeeper struct {
+	notifier *syncutil.AsyncTaskNotifier[struct{}]
+	manager  Manager
+	fetcher  func() RetentionFetcher
+	logger   *mlog.Logger
+}
+
+// Close stops the retention keeper.
+func (k *RetentionKeeper) Close() {
+	k.notifier.Cancel()
+	k.notifier.BlockUntilFinish()
+}
+
+// execute syncs the retention periodically until the keeper is closed.
+func (k *RetentionKeeper) execute() {
+	defer k.notifier.Finish(struct{}{})
+
+	ctx := k.notifier.Context()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if err := k.sync(ctx); err != nil {
+			k.logger.Warn(ctx, "fail to sync wal retention", mlog.Err(err))
+		}
+		timer.Reset(paramtable.Get().StreamingCfg.WALRecoveryRetentionSyncInterval.GetAsDurationByParse())
+	}
+}
+
+// sync fetches the retention of all opened read-write wal, and removes the retention of the wal that is not opened any more.
+func (k *RetentionKeeper) sync(ctx context.Context) error {
+	metrics, err := k.manager.Metrics()
+	if err != nil {
+		return err
+	}
+	pchannels := make([]string, 0, len(metrics.WALMetrics))
+	opened := make(map[string]struct{}, len(metrics.WALMetrics))
+	for _, m := range metrics.WALMetrics {
+		if m, ok := m.(types.RWWALMetrics); ok {
+			pchannels = append(pchannels, m.ChannelInfo.Name)
+			opened[m.ChannelInfo.Name] = struct{}{}
+		}
+	}
+	for _, pchannel := range recovery.RetentionConstraintPChannels() {
+		if _, ok := opened[pchannel]; !ok {
+			recovery.RemoveRetentionConstraint(pchannel)
+		}
+	}
+	if len(pchannels) == 0 {
+		return nil
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, paramtable.Get().StreamingCfg.WALRecoveryRetentionSyncInterval.GetAsDurationByParse())
+	defer cancel()
+	retentions, err := k.fetcher().GetPChannelRetentions(opCtx, pchannels)
+	if err != nil {
+		if !funcutil.IsGrpcErr(err, codes.Unimplemented) {
+			return err
+		}
+		// streamingcoord is not upgraded yet, the wal is retained by the checkpoints of the flusher only.
+		retentions = nil
+	}
+	constraints := make(map[string]*recovery.RetentionConstraint, len(pchannels))
+	for _, retention := range retentions {
+		constraints[retention.GetPchannel()] = recovery.NewRetentionConstraintFromProto(retention)
+	}
+	for _, pchannel := range pchannels {
+		constraint := constraints[pchannel]
+		if constraint != nil && constraint.Policy == nil && len(constraint.ReplicateCheckpoints) == 0 && !constraint.ReplicateCheckpointUnknown && constraint.ReplicateCheckpointTimeTick == 0 {
+			constraint = nil
+		}
+		recovery.UpdateRetentionConstraint(pchannel, constraint)
+	}
+	return nil
+}
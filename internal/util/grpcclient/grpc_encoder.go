@@ -22,12 +22,14 @@ import (
 	"io"
 
 	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
 	"google.golang.org/grpc/encoding"
 )
 
 const (
 	None = ""
 	Zstd = "zstd"
+	Lz4  = "lz4"
 )
 
 type grpcCompressor struct {
@@ -43,6 +45,7 @@ func init() {
 		decoder: dec,
 	}
 	encoding.RegisterCompressor(c)
+	encoding.RegisterCompressor(&lz4Compressor{})
 }
 
 func (c *grpcCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
@@ -84,3 +87,18 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 func (c *grpcCompressor) Name() string {
 	return Zstd
 }
+
+// lz4Compressor is the grpc compressor of lz4, which is faster but has lower compression ratio than zstd.
+type lz4Compressor struct{}
+
+func (c *lz4Compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return lz4.NewWriter(w), nil
+}
+
+func (c *lz4Compressor) Decompress(r io.Reader) (io.Reader, error) {
+	return lz4.NewReader(r), nil
+}
+
+func (c *lz4Compressor) Name() string {
+	return Lz4
+}
@@ -17,6 +17,7 @@ package grpcclient
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,10 +25,15 @@ import (
 )
 
 func TestGrpcEncoder(t *testing.T) {
-	data := "hello zstd algorithm!"
+	testGrpcEncoder(t, Zstd)
+	testGrpcEncoder(t, Lz4)
+}
+
+func testGrpcEncoder(t *testing.T, name string) {
+	data := "hello " + name + " algorithm!"
 	var buf bytes.Buffer
 
-	compressor := encoding.GetCompressor(Zstd)
+	compressor := encoding.GetCompressor(name)
 	writer, err := compressor.Compress(&buf)
 	assert.NoError(t, err)
 	written, err := writer.Write([]byte(data))
@@ -38,7 +44,7 @@ func TestGrpcEncoder(t *testing.T) {
 
 	reader, err := compressor.Decompress(bytes.NewReader(buf.Bytes()))
 	assert.NoError(t, err)
-	result := make([]byte, len(data))
-	reader.Read(result)
+	result, err := io.ReadAll(reader)
+	assert.NoError(t, err)
 	assert.Equal(t, data, string(result))
 }
@@ -706,6 +706,12 @@ func InitInterminIndexConfig(params *paramtable.ComponentParam) error {
 	memExpansionRate := C.float(params.QueryNodeCfg.InterimIndexMemExpandRate.GetAsFloat())
 	C.SegcoreSetInterimIndexMemExpansionRate(memExpansionRate)
 
+	asyncBuild := C.bool(params.QueryNodeCfg.InterimIndexAsyncBuild.GetAsBool())
+	C.SegcoreSetInterimIndexAsyncBuild(asyncBuild)
+
+	asyncBuildConcurrency := C.int64_t(params.QueryNodeCfg.InterimIndexAsyncConcurrency.GetAsInt64())
+	C.SegcoreSetInterimIndexAsyncBuildConcurrency(asyncBuildConcurrency)
+
 	nlist := C.int64_t(params.QueryNodeCfg.InterimIndexNlist.GetAsInt64())
 	C.SegcoreSetNlist(nlist)
 
@@ -38,6 +38,7 @@ func newScheduler(policy schedulePolicy) Scheduler {
 		execChan:         make(chan Task),
 		pool:             conc.NewPool[any](maxReadConcurrency, conc.WithPreAlloc(true)),
 		gpuPool:          conc.NewPool[any](paramtable.Get().QueryNodeCfg.MaxGpuReadConcurrency.GetAsInt(), conc.WithPreAlloc(true)),
+		quota:            newCPUQuota(),
 		schedulerCounter: schedulerCounter{},
 		lifetime:         lifetime.NewLifetime(lifetime.Initializing),
 	}
@@ -67,6 +68,7 @@ type scheduler struct {
 	execChan    chan Task
 	pool        *conc.Pool[any]
 	gpuPool     *conc.Pool[any]
+	quota       *cpuQuota
 
 	// wg is the waitgroup for internal worker goroutine
 	wg sync.WaitGroup
@@ -297,29 +299,52 @@ func (s *scheduler) exec() {
 			continue
 		}
 
-		s.getPool(t).Submit(func() (any, error) {
-			// Update concurrency metric and notify task done.
-			metrics.QueryNodeReadTaskConcurrency.WithLabelValues(paramtable.GetStringNodeID()).Inc()
-			collector.Counter.Inc(metricsinfo.ExecuteQueueType)
-
-			executeStart := time.Now()
-			err := t.Execute()
-			metrics.QueryNodeReadTaskExecuteDuration.WithLabelValues(
-				paramtable.GetStringNodeID(),
-				readTaskExecuteOutcome(err),
-			).Observe(float64(time.Since(executeStart).Microseconds()) / 1000.0)
-
-			// Update all metric after task finished.
-			metrics.QueryNodeReadTaskConcurrency.WithLabelValues(paramtable.GetStringNodeID()).Dec()
-			collector.Counter.Dec(metricsinfo.ExecuteQueueType)
+		pool := s.getPool(t)
+		if !s.quota.acquire(t, pool.Cap()) {
+			// The task is held by the cpu quota of its collection, count it as waiting.
+			s.updateWaitingTaskCounter(1, t.NQ())
+			continue
+		}
 
-			// Notify task done.
-			t.Done(err)
+		pool.Submit(func() (any, error) {
+			err := s.execute(t)
+			// The worker runs the held tasks of the same collection once the task finished,
+			// so the collection takes no more workers than its quota.
+			for next := s.quota.release(t, pool.Cap()); next != nil; next = s.quota.release(next, pool.Cap()) {
+				s.updateWaitingTaskCounter(-1, -next.NQ())
+				if ctxErr := next.Context().Err(); ctxErr != nil {
+					next.Done(ctxErr)
+					continue
+				}
+				s.execute(next)
+			}
 			return nil, err
 		})
 	}
 }
 
+// execute the task and notify task done.
+func (s *scheduler) execute(t Task) error {
+	// Update concurrency metric and notify task done.
+	metrics.QueryNodeReadTaskConcurrency.WithLabelValues(paramtable.GetStringNodeID()).Inc()
+	collector.Counter.Inc(metricsinfo.ExecuteQueueType)
+
+	executeStart := time.Now()
+	err := t.Execute()
+	metrics.QueryNodeReadTaskExecuteDuration.WithLabelValues(
+		paramtable.GetStringNodeID(),
+		readTaskExecuteOutcome(err),
+	).Observe(float64(time.Since(executeStart).Microseconds()) / 1000.0)
+
+	// Update all metric after task finished.
+	metrics.QueryNodeReadTaskConcurrency.WithLabelValues(paramtable.GetStringNodeID()).Dec()
+	collector.Counter.Dec(metricsinfo.ExecuteQueueType)
+
+	// Notify task done.
+	t.Done(err)
+	return err
+}
+
 func (s *scheduler) getPool(t Task) *conc.Pool[any] {
 	if t.IsGpuIndex() {
 		return s.gpuPool
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"math"
+	"sync"
+)
+
+// cpuQuota limits the running tasks of each collection by the cpu share of the collection,
+// so the heavy searches of one collection cannot monopolize the worker pool.
+// The tasks exceeding the quota are held,
+// and run by the worker of the same collection once its running task finished.
+type cpuQuota struct {
+	mu      sync.Mutex
+	running map[int64]int
+	held    map[int64][]Task
+}
+
+func newCPUQuota() *cpuQuota {
+	return &cpuQuota{
+		running: make(map[int64]int),
+		held:    make(map[int64][]Task),
+	}
+}
+
+// cpuQuotaLimit returns the max number of running tasks of the collection in the pool.
+func cpuQuotaLimit(share float64, poolSize int) int {
+	if share <= 0 || share >= 1 {
+		return poolSize
+	}
+	return max(1, int(math.Ceil(share*float64(poolSize))))
+}
+
+// acquire returns true if the task could run now,
+// otherwise the task is held until a running task of the same collection finished.
+func (q *cpuQuota) acquire(t Task, poolSize int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	collectionID := t.CollectionID()
+	if q.running[collectionID] >= cpuQuotaLimit(t.CPUShare(), poolSize) {
+		q.held[collectionID] = append(q.held[collectionID], t)
+		return false
+	}
+	q.running[collectionID]++
+	return true
+}
+
+// release releases the quota of the finished task,
+// returns the held task of the same collection which could run now, nil if there's none.
+func (q *cpuQuota) release(t Task, poolSize int) Task {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	collectionID := t.CollectionID()
+	q.running[collectionID]--
+	held := q.held[collectionID]
+	if len(held) > 0 && q.running[collectionID] < cpuQuotaLimit(held[0].CPUShare(), poolSize) {
+		next := held[0]
+		held[0] = nil
+		if len(held) == 1 {
+			delete(q.held, collectionID)
+		} else {
+			q.held[collectionID] = held[1:]
+		}
+		q.running[collectionID]++
+		return next
+	}
+	if q.running[collectionID] <= 0 {
+		delete(q.running, collectionID)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestCPUQuotaLimit(t *testing.T) {
+	assert.Equal(t, 8, cpuQuotaLimit(1, 8))
+	assert.Equal(t, 8, cpuQuotaLimit(0, 8))
+	assert.Equal(t, 2, cpuQuotaLimit(0.25, 8))
+	assert.Equal(t, 3, cpuQuotaLimit(0.3, 8))
+	assert.Equal(t, 1, cpuQuotaLimit(0.01, 8))
+}
+
+func TestCPUQuota(t *testing.T) {
+	quota := newCPUQuota()
+	newTask := func(collection int64, share float64) Task {
+		return newMockTask(mockTaskConfig{collection: collection, cpuShare: share})
+	}
+
+	t1, t2, t3 := newTask(1, 0.5), newTask(1, 0.5), newTask(1, 0.5)
+	assert.True(t, quota.acquire(t1, 4))
+	assert.True(t, quota.acquire(t2, 4))
+	// collection 1 reaches its quota, the task is held
+	assert.False(t, quota.acquire(t3, 4))
+	// the other collections are not affected
+	t4 := newTask(2, 1)
+	assert.True(t, quota.acquire(t4, 4))
+	assert.Nil(t, quota.release(t4, 4))
+
+	// the held task runs after a running task finished
+	assert.Equal(t, t3, quota.release(t1, 4))
+	assert.Nil(t, quota.release(t2, 4))
+	assert.Nil(t, quota.release(t3, 4))
+	assert.Empty(t, quota.running)
+	assert.Empty(t, quota.held)
+}
+
+func TestSchedulerCPUQuota(t *testing.T) {
+	paramtable.Init()
+	s := newScheduler(newFIFOPolicy()).(*scheduler)
+	s.Start()
+	defer s.Stop()
+
+	limit := cpuQuotaLimit(0.25, s.pool.Cap())
+	var running, maxRunning atomic.Int32
+	var others atomic.Int32
+	n := 4 * s.pool.Cap()
+	tasks := make([]Task, 0, n+1)
+	for i := 0; i < n; i++ {
+		tasks = append(tasks, newMockTask(mockTaskConfig{
+			collection:  1,
+			cpuShare:    0.25,
+			executeCost: 5 * time.Millisecond,
+			execution: func(ctx context.Context) error {
+				cur := running.Inc()
+				defer running.Dec()
+				for {
+					old := maxRunning.Load()
+					if cur <= old || maxRunning.CompareAndSwap(old, cur) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			},
+		}))
+	}
+	tasks = append(tasks, newMockTask(mockTaskConfig{
+		collection:  2,
+		executeCost: time.Millisecond,
+		execution: func(ctx context.Context) error {
+			others.Inc()
+			return nil
+		},
+	}))
+	for _, task := range tasks {
+		assert.NoError(t, s.Add(task))
+	}
+	for _, task := range tasks {
+		assert.NoError(t, task.Wait())
+	}
+	assert.LessOrEqual(t, int(maxRunning.Load()), limit)
+	assert.EqualValues(t, 1, others.Load())
+	assert.EqualValues(t, 0, s.GetWaitingTaskTotal())
+	assert.EqualValues(t, 0, s.GetWaitingTaskTotalNQ())
+}
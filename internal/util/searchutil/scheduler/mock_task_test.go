@@ -19,6 +19,8 @@ type mockTaskConfig struct {
 	mergeAble   bool
 	nq          int64
 	username    string
+	collection  int64
+	cpuShare    float64
 	executeCost time.Duration
 	execution   func(ctx context.Context) error
 }
@@ -30,6 +32,9 @@ func newMockTask(c mockTaskConfig) Task {
 	if c.nq == 0 {
 		c.nq = 1
 	}
+	if c.cpuShare == 0 {
+		c.cpuShare = 1
+	}
 	if c.executeCost == 0 {
 		c.executeCost = time.Duration((rand.Int31n(4) + 1) * int32(time.Second))
 	}
@@ -41,6 +46,8 @@ func newMockTask(c mockTaskConfig) Task {
 		nq:          c.nq,
 		minNQ:       c.nq,
 		username:    c.username,
+		collection:  c.collection,
+		cpuShare:    c.cpuShare,
 		execution:   c.execution,
 		tr:          timerecord.NewTimeRecorderWithTrace(c.ctx, "searchTask"),
 	}
@@ -54,6 +61,8 @@ type MockTask struct {
 	nq          int64
 	minNQ       int64
 	username    string
+	collection  int64
+	cpuShare    float64
 	execution   func(ctx context.Context) error
 	tr          *timerecord.TimeRecorder
 }
@@ -71,6 +80,14 @@ func (t *MockTask) IsGpuIndex() bool {
 	return false
 }
 
+func (t *MockTask) CollectionID() int64 {
+	return t.collection
+}
+
+func (t *MockTask) CPUShare() float64 {
+	return t.cpuShare
+}
+
 func (t *MockTask) TimeRecorder() *timerecord.TimeRecorder {
 	return t.tr
 }
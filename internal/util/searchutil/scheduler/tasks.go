@@ -156,6 +156,12 @@ type Task interface {
 	// Return whether the task would be running on GPU.
 	IsGpuIndex() bool
 
+	// Return the collection which task is belong to.
+	CollectionID() int64
+
+	// Return the share of the worker pool the tasks of the collection could take, in (0, 1].
+	CPUShare() float64
+
 	// PreExecute the task, only call once.
 	PreExecute() error
 
@@ -29,7 +29,10 @@ import "C"
 import (
 	"unsafe"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v3/schemapb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
@@ -45,12 +48,41 @@ func deletePlaceholderGroup(group unsafe.Pointer) {
 	C.DeletePlaceholderGroup(C.CPlaceholderGroup(group))
 }
 
-func createSearchPlanByExpr(col *CCollection, expr []byte) (*SearchPlan, error) {
+// PlanSchema is the schema that a search or query request is planned with,
+// it's used to create the plan instead of the current schema of the collection,
+// so the request planned before a schema update keeps running against the old schema.
+type PlanSchema struct {
+	Schema  *schemapb.CollectionSchema
+	Version uint64 // the segcore schema version of the schema.
+}
+
+// marshal marshals the schema of the plan.
+func (s *PlanSchema) marshal() ([]byte, error) {
+	schemaBlob, err := proto.Marshal(s.Schema)
+	if err != nil {
+		return nil, merr.WrapErrSegcoreMsg("marshal plan schema failed")
+	}
+	return schemaBlob, nil
+}
+
+// createSearchPlanByExpr creates the search plan, the current schema of the collection is used if the schema is nil.
+func createSearchPlanByExpr(col *CCollection, schema *PlanSchema, expr []byte) (*SearchPlan, error) {
 	if len(expr) == 0 {
 		return nil, merr.WrapErrParameterInvalidMsg("empty expression plan")
 	}
 	var cPlan C.CSearchPlan
-	status := C.CreateSearchPlanByExpr(col.rawPointer(), unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
+	var status C.CStatus
+	if schema == nil {
+		status = C.CreateSearchPlanByExpr(col.rawPointer(), unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
+	} else {
+		schemaBlob, err := schema.marshal()
+		if err != nil {
+			return nil, err
+		}
+		status = C.CreateSearchPlanByExprWithSchema(col.rawPointer(),
+			unsafe.Pointer(&schemaBlob[0]), (C.int64_t)(len(schemaBlob)), (C.uint64_t)(schema.Version),
+			unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
+	}
 	if err := ConsumeCStatusIntoError(&status); err != nil {
 		return nil, merr.Wrap(err, "Create Plan by expr failed")
 	}
@@ -90,12 +122,19 @@ type SearchRequest struct {
 	entityTTLPhysicalTime typeutil.Timestamp
 	filterOnly            bool // If true, only execute filter and return valid count (for two-stage search Stage 1)
 	enableExprCache       bool // If true, enable expression filter cache for two-stage search
+	serializedPlan        []byte
 }
 
 func NewSearchRequest(collection *CCollection, req *querypb.SearchRequest, placeholderGrp []byte) (*SearchRequest, error) {
+	return NewSearchRequestWithSchema(collection, nil, req, placeholderGrp)
+}
+
+// NewSearchRequestWithSchema creates the search request with the plan created by the given schema,
+// the current schema of the collection is used if the schema is nil.
+func NewSearchRequestWithSchema(collection *CCollection, schema *PlanSchema, req *querypb.SearchRequest, placeholderGrp []byte) (*SearchRequest, error) {
 	metricType := req.GetReq().GetMetricType()
 	expr := req.Req.SerializedExprPlan
-	plan, err := createSearchPlanByExpr(collection, expr)
+	plan, err := createSearchPlanByExpr(collection, schema, expr)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +179,7 @@ func NewSearchRequest(collection *CCollection, req *querypb.SearchRequest, place
 		entityTTLPhysicalTime: req.GetReq().GetEntityTtlPhysicalTime(),
 		filterOnly:            req.GetFilterOnly(),
 		enableExprCache:       req.GetEnableExprCache(),
+		serializedPlan:        expr,
 	}, nil
 }
 
@@ -172,6 +212,11 @@ func (req *SearchRequest) EnableExprCache() bool {
 	return req.enableExprCache
 }
 
+// SerializedPlan returns the serialized plan the search request is created by.
+func (req *SearchRequest) SerializedPlan() []byte {
+	return req.serializedPlan
+}
+
 func (req *SearchRequest) Delete() {
 	if req.plan != nil {
 		req.plan.delete()
@@ -198,12 +243,37 @@ func NewRetrievePlan(col *CCollection,
 	consistencylevel commonpb.ConsistencyLevel,
 	collectionTTL typeutil.Timestamp,
 	entityTTLPhysicalTime typeutil.Timestamp,
+) (*RetrievePlan, error) {
+	return NewRetrievePlanWithSchema(col, nil, expr, timestamp, msgID, consistencylevel, collectionTTL, entityTTLPhysicalTime)
+}
+
+// NewRetrievePlanWithSchema creates the retrieve plan by the given schema,
+// the current schema of the collection is used if the schema is nil.
+func NewRetrievePlanWithSchema(col *CCollection,
+	schema *PlanSchema,
+	expr []byte,
+	timestamp typeutil.Timestamp,
+	msgID int64,
+	consistencylevel commonpb.ConsistencyLevel,
+	collectionTTL typeutil.Timestamp,
+	entityTTLPhysicalTime typeutil.Timestamp,
 ) (*RetrievePlan, error) {
 	if col.rawPointer() == nil {
 		return nil, merr.WrapErrServiceInternalMsg("collection is released")
 	}
 	var cPlan C.CRetrievePlan
-	status := C.CreateRetrievePlanByExpr(col.rawPointer(), unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
+	var status C.CStatus
+	if schema == nil {
+		status = C.CreateRetrievePlanByExpr(col.rawPointer(), unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
+	} else {
+		schemaBlob, err := schema.marshal()
+		if err != nil {
+			return nil, err
+		}
+		status = C.CreateRetrievePlanByExprWithSchema(col.rawPointer(),
+			unsafe.Pointer(&schemaBlob[0]), (C.int64_t)(len(schemaBlob)), (C.uint64_t)(schema.Version),
+			unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
+	}
 	if err := ConsumeCStatusIntoError(&status); err != nil {
 		return nil, merr.Wrap(err, "Create retrieve plan by expr failed")
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/internalpb"
@@ -43,6 +44,7 @@ func TestShallowCopySearchRequest(t *testing.T) {
 			AnalyzerName:            "analyzer",
 			CollectionTtlTimestamps: 999,
 			EntityTtlPhysicalTime:   888,
+			SchemaVersion:           proto.Int32(3),
 		}
 
 		dst := ShallowCopySearchRequest(src, 42)
@@ -60,6 +62,7 @@ func TestShallowCopySearchRequest(t *testing.T) {
 		assert.Equal(t, src.IsIterator, dst.IsIterator)
 		assert.Equal(t, src.AnalyzerName, dst.AnalyzerName)
 		assert.Equal(t, src.EntityTtlPhysicalTime, dst.EntityTtlPhysicalTime)
+		assert.Equal(t, src.GetSchemaVersion(), dst.GetSchemaVersion())
 
 		// Slices share underlying array (shallow copy)
 		assert.Equal(t, src.PartitionIDs, dst.PartitionIDs)
@@ -91,6 +94,7 @@ func TestShallowCopyRetrieveRequest(t *testing.T) {
 			CollectionTtlTimestamps: 999,
 			EntityTtlPhysicalTime:   888,
 			QueryLabel:              "query",
+			SchemaVersion:           proto.Int32(3),
 		}
 
 		dst := ShallowCopyRetrieveRequest(src, 42)
@@ -103,6 +107,7 @@ func TestShallowCopyRetrieveRequest(t *testing.T) {
 		assert.Equal(t, src.IsIterator, dst.IsIterator)
 		assert.Equal(t, src.EntityTtlPhysicalTime, dst.EntityTtlPhysicalTime)
 		assert.Equal(t, src.QueryLabel, dst.QueryLabel)
+		assert.Equal(t, src.GetSchemaVersion(), dst.GetSchemaVersion())
 		assert.Equal(t, src.PartitionIDs, dst.PartitionIDs)
 	})
 }
@@ -56,15 +56,25 @@ func (e *StreamingError) IsSkippedOperation() bool {
 // Stop resuming retry and report to user.
 func (e *StreamingError) IsUnrecoverable() bool {
 	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_UNRECOVERABLE ||
-		e.IsReplicateViolation() ||
+		e.IsReplicateViolation() || e.IsSecondaryWriteRejected() ||
 		e.IsTxnUnavilable() || e.IsSchemaVersionMismatch()
 }
 
+// IsInvalidArgument returns true if the error is caused by invalid argument.
+func (e *StreamingError) IsInvalidArgument() bool {
+	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_INVAILD_ARGUMENT
+}
+
 // IsReplicateViolation returns true if the error is caused by replicate violation.
 func (e *StreamingError) IsReplicateViolation() bool {
 	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_REPLICATE_VIOLATION
 }
 
+// IsSecondaryWriteRejected returns true if the non-replicate write is rejected by the secondary cluster of replication.
+func (e *StreamingError) IsSecondaryWriteRejected() bool {
+	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_SECONDARY_WRITE_REJECTED
+}
+
 // IsWALNameMismatch returns true if the error is caused by wal name mismatch.
 func (e *StreamingError) IsWALNameMismatch() bool {
 	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_WALNAME_MISMATCH
@@ -101,6 +111,16 @@ func (e *StreamingError) IsRateLimitRejected() bool {
 	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_RATE_LIMIT_REJECTED
 }
 
+// IsCollectionQuotaExceeded returns true if the error is caused by the append quota of collection exceeded.
+func (e *StreamingError) IsCollectionQuotaExceeded() bool {
+	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED
+}
+
+// IsDuplicateAppend returns true if the error is caused by the append is a duplicate of an append out of the deduplication window.
+func (e *StreamingError) IsDuplicateAppend() bool {
+	return e.Code == streamingpb.StreamingCode_STREAMING_CODE_DUPLICATE_APPEND
+}
+
 // NewOnShutdownError creates a new StreamingError with code STREAMING_CODE_ON_SHUTDOWN.
 func NewOnShutdownError(format string, args ...interface{}) *StreamingError {
 	return New(streamingpb.StreamingCode_STREAMING_CODE_ON_SHUTDOWN, format, args...)
@@ -172,6 +192,11 @@ func NewReplicateViolation(format string, args ...interface{}) *StreamingError {
 	return New(streamingpb.StreamingCode_STREAMING_CODE_REPLICATE_VIOLATION, format, args...)
 }
 
+// NewSecondaryWriteRejected creates a new StreamingError with code STREAMING_CODE_SECONDARY_WRITE_REJECTED.
+func NewSecondaryWriteRejected(format string, args ...interface{}) *StreamingError {
+	return New(streamingpb.StreamingCode_STREAMING_CODE_SECONDARY_WRITE_REJECTED, format, args...)
+}
+
 // NewWALNameMismatchError creates a new StreamingError with code STREAMING_CODE_WALNAME_MISMATCH.
 func NewWALNameMismatchError(expectedWALName string, actualWALName string) *StreamingError {
 	return New(streamingpb.StreamingCode_STREAMING_CODE_WALNAME_MISMATCH, "wal name mismatch, expected %s, actual %s", expectedWALName, actualWALName)
@@ -187,6 +212,16 @@ func NewRateLimitRejected(format string, args ...interface{}) *StreamingError {
 	return New(streamingpb.StreamingCode_STREAMING_CODE_RATE_LIMIT_REJECTED, format, args...)
 }
 
+// NewCollectionQuotaExceeded creates a new StreamingError with code STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED.
+func NewCollectionQuotaExceeded(format string, args ...interface{}) *StreamingError {
+	return New(streamingpb.StreamingCode_STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED, format, args...)
+}
+
+// NewDuplicateAppend creates a new StreamingError with code STREAMING_CODE_DUPLICATE_APPEND.
+func NewDuplicateAppend(format string, args ...interface{}) *StreamingError {
+	return New(streamingpb.StreamingCode_STREAMING_CODE_DUPLICATE_APPEND, format, args...)
+}
+
 // New creates a new StreamingError with the given code and cause.
 func New(code streamingpb.StreamingCode, format string, args ...interface{}) *StreamingError {
 	if len(args) == 0 {
@@ -70,4 +70,22 @@ func TestStreamingError(t *testing.T) {
 	assert.True(t, streamingErr.IsUnrecoverable())
 	pbErr = streamingErr.AsPBError()
 	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_TRANSACTION_EXPIRED, pbErr.Code)
+
+	streamingErr = NewSecondaryWriteRejected("test, %d", 1)
+	assert.Contains(t, streamingErr.Error(), "code: STREAMING_CODE_SECONDARY_WRITE_REJECTED, cause: test, 1")
+	assert.True(t, streamingErr.IsSecondaryWriteRejected())
+	assert.True(t, streamingErr.IsUnrecoverable())
+	pbErr = streamingErr.AsPBError()
+	assert.Equal(t, streamingpb.StreamingCode_STREAMING_CODE_SECONDARY_WRITE_REJECTED, pbErr.Code)
+
+	streamingErr = NewCollectionQuotaExceeded("test, %d", 1)
+	assert.Contains(t, streamingErr.Error(), "code: STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED, cause: test, 1")
+	assert.True(t, streamingErr.IsCollectionQuotaExceeded())
+	assert.False(t, streamingErr.IsRateLimitRejected())
+	assert.False(t, streamingErr.IsUnrecoverable())
+
+	streamingErr = NewDuplicateAppend("test, %d", 1)
+	assert.Contains(t, streamingErr.Error(), "code: STREAMING_CODE_DUPLICATE_APPEND, cause: test, 1")
+	assert.True(t, streamingErr.IsDuplicateAppend())
+	assert.False(t, streamingErr.IsUnrecoverable())
 }
@@ -0,0 +1,182 @@
+package util
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/util/streamingutil/status"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
+)
+
+const metastoreChannelProviderRetryInterval = time.Second
+
+// MetastoreChannelProvider implements channel.ChannelProvider by watching
+// the pchannel registry (an etcd prefix) in addition to the Milvus configuration,
+// so the pchannels can be added on a running cluster without a config rollout.
+// Every key under the prefix is a registered pchannel, the key suffix is the pchannel name.
+type MetastoreChannelProvider struct {
+	notifier        *syncutil.AsyncTaskNotifier[struct{}]
+	etcdCli         *clientv3.Client
+	prefix          string
+	config          *ConfigChannelProvider
+	known           typeutil.Set[string]
+	initialChannels []string
+	revision        int64
+	ch              chan []string
+}
+
+// NewMetastoreChannelProvider creates a MetastoreChannelProvider that reads the
+// current set of topics from configuration and the registry prefix, and watches both of them
+// to detect any newly added topics.
+func NewMetastoreChannelProvider(ctx context.Context, etcdCli *clientv3.Client, prefix string) (*MetastoreChannelProvider, error) {
+	config := NewConfigChannelProvider()
+	p := &MetastoreChannelProvider{
+		notifier: syncutil.NewAsyncTaskNotifier[struct{}](),
+		etcdCli:  etcdCli,
+		prefix:   prefix,
+		config:   config,
+		known:    typeutil.NewSet(config.GetInitialChannels()...),
+		ch:       make(chan []string),
+	}
+	registered, err := p.listRegistered(ctx)
+	if err != nil {
+		config.Close()
+		return nil, err
+	}
+	p.known.Insert(registered...)
+	p.initialChannels = p.known.Collect()
+	sort.Strings(p.initialChannels)
+	go p.background()
+	return p, nil
+}
+
+// GetInitialChannels returns the channel names known at startup time.
+func (p *MetastoreChannelProvider) GetInitialChannels() []string {
+	return p.initialChannels
+}
+
+// NewIncomingChannels returns a read-only channel that delivers slices
+// of newly discovered channel names.
+func (p *MetastoreChannelProvider) NewIncomingChannels() <-chan []string {
+	return p.ch
+}
+
+// RegisterChannels registers the pchannels into the registry,
+// the registered pchannels will be delivered by NewIncomingChannels if they are not known.
+// Registering a known pchannel is a no-op.
+func (p *MetastoreChannelProvider) RegisterChannels(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return status.NewInvalidArgument("no pchannel to register")
+	}
+	ops := make([]clientv3.Op, 0, len(names))
+	for _, name := range names {
+		if name == "" || strings.Contains(name, "/") {
+			return status.NewInvalidArgument("invalid pchannel name %q", name)
+		}
+		ops = append(ops, clientv3.OpPut(p.prefix+name, name))
+	}
+	_, err := p.etcdCli.Txn(ctx).Then(ops...).Commit()
+	return err
+}
+
+// Close stops the provider and closes the notification channel.
+func (p *MetastoreChannelProvider) Close() {
+	p.notifier.Cancel()
+	p.notifier.BlockUntilFinish()
+	p.config.Close()
+	close(p.ch)
+}
+
+// background is the single goroutine that processes the config changes and registry events.
+func (p *MetastoreChannelProvider) background() {
+	defer p.notifier.Finish(struct{}{})
+	ctx := p.notifier.Context()
+	for {
+		err := p.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// The watch may be broken by the compaction of etcd,
+		// list the registry again to catch up the missing events and restart the watch.
+		mlog.Warn(ctx, "MetastoreChannelProvider watch registry failed, retry later", mlog.Err(err))
+		select {
+		case <-time.After(metastoreChannelProviderRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+		registered, err := p.listRegistered(ctx)
+		if err != nil {
+			continue
+		}
+		p.notify(ctx, registered)
+	}
+}
+
+// watch watches the registry from the last listed revision until an error happens.
+func (p *MetastoreChannelProvider) watch(ctx context.Context) error {
+	eventCh := p.etcdCli.Watch(ctx, p.prefix, clientv3.WithPrefix(), clientv3.WithRev(p.revision+1))
+	configCh := p.config.NewIncomingChannels()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case names := <-configCh:
+			p.notify(ctx, names)
+		case resp, ok := <-eventCh:
+			if !ok {
+				return status.NewInner("etcd watch channel closed unexpectedly")
+			}
+			if err := resp.Err(); err != nil {
+				return err
+			}
+			names := make([]string, 0, len(resp.Events))
+			for _, ev := range resp.Events {
+				if ev.Type == clientv3.EventTypePut {
+					names = append(names, strings.TrimPrefix(string(ev.Kv.Key), p.prefix))
+				}
+			}
+			p.revision = resp.Header.Revision
+			p.notify(ctx, names)
+		}
+	}
+}
+
+// listRegistered lists all registered pchannels and records the revision for the following watch.
+func (p *MetastoreChannelProvider) listRegistered(ctx context.Context) ([]string, error) {
+	resp, err := p.etcdCli.Get(ctx, p.prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		names = append(names, strings.TrimPrefix(string(kv.Key), p.prefix))
+	}
+	p.revision = resp.Header.Revision
+	return names, nil
+}
+
+// notify delivers the channels that are not known yet.
+func (p *MetastoreChannelProvider) notify(ctx context.Context, names []string) {
+	var newChannels []string
+	for _, name := range names {
+		if !p.known.Contain(name) {
+			newChannels = append(newChannels, name)
+			p.known.Insert(name)
+		}
+	}
+	if len(newChannels) == 0 {
+		return
+	}
+	sort.Strings(newChannels)
+	mlog.Info(ctx, "MetastoreChannelProvider detected new channels", mlog.Strings("newChannels", newChannels))
+	select {
+	case p.ch <- newChannels:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMetastoreChannelProvider(t *testing.T) {
+	paramtable.Init()
+	etcdClient, _ := kvfactory.GetEtcdAndPath()
+	prefix := funcutil.RandomString(10) + "/"
+	ctx := context.Background()
+
+	_, err := etcdClient.Put(ctx, prefix+"registered-1", "registered-1")
+	assert.NoError(t, err)
+
+	provider, err := NewMetastoreChannelProvider(ctx, etcdClient, prefix)
+	assert.NoError(t, err)
+	defer provider.Close()
+
+	// The initial channels contain both the configured and registered channels.
+	initial := provider.GetInitialChannels()
+	assert.Contains(t, initial, "registered-1")
+	for _, name := range GetAllTopicsFromConfiguration().Collect() {
+		assert.Contains(t, initial, name)
+	}
+
+	// Invalid names are rejected.
+	assert.Error(t, provider.RegisterChannels(ctx, nil))
+	assert.Error(t, provider.RegisterChannels(ctx, []string{""}))
+	assert.Error(t, provider.RegisterChannels(ctx, []string{"a/b"}))
+
+	// Only the unknown channels are delivered.
+	assert.NoError(t, provider.RegisterChannels(ctx, []string{"registered-1", "registered-3", "registered-2"}))
+	select {
+	case newChannels := <-provider.NewIncomingChannels():
+		assert.Equal(t, []string{"registered-2", "registered-3"}, newChannels)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for new channel notification")
+	}
+
+	assert.NoError(t, provider.RegisterChannels(ctx, []string{"registered-3"}))
+	_, err = etcdClient.Put(ctx, prefix+"registered-4", "registered-4")
+	assert.NoError(t, err)
+	select {
+	case newChannels := <-provider.NewIncomingChannels():
+		assert.Equal(t, []string{"registered-4"}, newChannels)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for new channel notification")
+	}
+}
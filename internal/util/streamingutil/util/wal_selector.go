@@ -84,6 +84,10 @@ func validateWALName(standalone bool, mqType string) (message.WALName, error) {
 	if mqName == message.WALNameUnknown || mqName == message.WALNameTest {
 		return mqName, errors.Wrapf(errInvalidWALConfig, "mq %s is not valid", mqType)
 	}
+	if mqName == message.WALNameTiered {
+		// the tiered wal is in front of the mq, it's enabled by streaming.walTiered.enabled.
+		return mqName, errors.Wrapf(errInvalidWALConfig, "mq %s is not valid, set streaming.walTiered.enabled to true to enable it", mqType)
+	}
 
 	// we may register more mq type by plugin.
 	// so we should not check all mq type here.
@@ -12,6 +12,8 @@ import (
 func TestValidateWALType(t *testing.T) {
 	_, err := validateWALName(false, message.WALNameRocksmq.String())
 	assert.Error(t, err)
+	_, err = validateWALName(true, message.WALNameTiered.String())
+	assert.Error(t, err)
 }
 
 func TestSelectWALType(t *testing.T) {
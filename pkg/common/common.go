@@ -259,7 +259,14 @@ const (
 	CollectionExternalSource    = "collection.external_source"
 	CollectionExternalSpec      = "collection.external_spec"
 	CollectionTTLFieldKey       = "ttl_field"
-	MaxTTLSeconds               = 3155760000 // 100 years
+	// CollectionTTLFieldRetentionKey makes the ttl field hold the event time of the entity instead of the expiration time,
+	// the entity is expired at the value of ttl field plus the retention.
+	CollectionTTLFieldRetentionKey = "ttl_field.retention.seconds"
+	MaxTTLSeconds                  = 3155760000 // 100 years
+
+	// CollectionShardsNumKey changes the shard number of an existing collection by the alter collection request,
+	// it's applied to the vchannels of the collection and never saved into the collection properties.
+	CollectionShardsNumKey = "collection.shards_num"
 
 	// Deprecated: will be removed in the 3.0 after implementing ack sync up semantic.
 	CollectionOnTruncatingKey = "collection.on.truncating" // when collection is on truncating, forbid the compaction of current collection.
@@ -270,6 +277,11 @@ const (
 	// and is not controlled by this option.
 	CollectionAllowInsertNonBM25FunctionOutputs = "collection.function.allowInsertNonBM25FunctionOutputs"
 
+	// CollectionCompactionPolicyKey selects the policy of the mix compaction of the collection.
+	CollectionCompactionPolicyKey = "collection.compaction.policy"
+	// CollectionCompactionTimeWindowKey is the time window in seconds of the time_window mix compaction policy.
+	CollectionCompactionTimeWindowKey = "collection.compaction.timewindow.seconds"
+
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
 	CollectionInsertRateMinKey   = "collection.insertRate.min.mb"
@@ -283,6 +295,10 @@ const (
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
 
+	// CollectionSearchCPUShareKey is the share of the read worker pool of each querynode
+	// the search and query tasks of the collection could take, in (0, 1].
+	CollectionSearchCPUShareKey = "collection.search.cpu.share"
+
 	PartitionDiskQuotaKey = "partition.diskProtection.diskQuota.mb"
 
 	// database level properties
@@ -348,6 +364,13 @@ const (
 	WarmupDisable        = "disable"
 	WarmupSync           = "sync"
 	WarmupAsync          = "async"
+
+	// WarmupPolicyKey is the collection-level warmup preset,
+	// which is expanded into the granular warmup keys and mmap key that are not set explicitly.
+	WarmupPolicyKey       = "warmup.policy"
+	WarmupPolicyLazy      = "lazy"       // mmap all the data and load nothing until it's accessed.
+	WarmupPolicyEager     = "eager"      // load all the field data and indexes into memory when the segment is loaded.
+	WarmupPolicyIndexOnly = "index_only" // load the indexes when the segment is loaded, the raw field data is loaded lazily.
 )
 
 const (
@@ -426,7 +449,8 @@ func IsCollectionWarmupKey(key string) bool {
 	return key == WarmupScalarFieldKey ||
 		key == WarmupScalarIndexKey ||
 		key == WarmupVectorFieldKey ||
-		key == WarmupVectorIndexKey
+		key == WarmupVectorIndexKey ||
+		key == WarmupPolicyKey
 }
 
 // ValidateWarmupPolicy validates that the warmup policy value is valid
@@ -437,6 +461,62 @@ func ValidateWarmupPolicy(value string) error {
 	return nil
 }
 
+// ValidateCollectionWarmupPolicy validates the value of a collection-level warmup key.
+func ValidateCollectionWarmupPolicy(key string, value string) error {
+	if key != WarmupPolicyKey {
+		return ValidateWarmupPolicy(value)
+	}
+	if _, ok := warmupPolicyPresets[value]; !ok {
+		return merr.WrapErrParameterInvalidMsg("invalid warmup policy: %s, must be '%s', '%s' or '%s'", value, WarmupPolicyLazy, WarmupPolicyEager, WarmupPolicyIndexOnly)
+	}
+	return nil
+}
+
+// warmupPolicyPresets is the granular settings of the collection-level warmup presets.
+var warmupPolicyPresets = map[string][]*commonpb.KeyValuePair{
+	WarmupPolicyLazy: {
+		{Key: MmapEnabledKey, Value: "true"},
+		{Key: WarmupScalarFieldKey, Value: WarmupDisable},
+		{Key: WarmupVectorFieldKey, Value: WarmupDisable},
+		{Key: WarmupScalarIndexKey, Value: WarmupDisable},
+		{Key: WarmupVectorIndexKey, Value: WarmupDisable},
+	},
+	WarmupPolicyEager: {
+		{Key: MmapEnabledKey, Value: "false"},
+		{Key: WarmupScalarFieldKey, Value: WarmupSync},
+		{Key: WarmupVectorFieldKey, Value: WarmupSync},
+		{Key: WarmupScalarIndexKey, Value: WarmupSync},
+		{Key: WarmupVectorIndexKey, Value: WarmupSync},
+	},
+	WarmupPolicyIndexOnly: {
+		{Key: WarmupScalarFieldKey, Value: WarmupDisable},
+		{Key: WarmupVectorFieldKey, Value: WarmupDisable},
+		{Key: WarmupScalarIndexKey, Value: WarmupSync},
+		{Key: WarmupVectorIndexKey, Value: WarmupSync},
+	},
+}
+
+// ExpandWarmupPolicy expands the collection-level warmup preset into the granular warmup keys and mmap key.
+// The key set explicitly has higher priority than the preset, the input key-value pairs are not modified.
+func ExpandWarmupPolicy(kvs ...*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	policy, ok := GetWarmupPolicyByKey(WarmupPolicyKey, kvs...)
+	if !ok {
+		return kvs
+	}
+	preset, ok := warmupPolicyPresets[policy]
+	if !ok {
+		return kvs
+	}
+	result := make([]*commonpb.KeyValuePair, 0, len(kvs)+len(preset))
+	result = append(result, kvs...)
+	for _, kv := range preset {
+		if _, exist := GetWarmupPolicyByKey(kv.GetKey(), kvs...); !exist {
+			result = append(result, &commonpb.KeyValuePair{Key: kv.GetKey(), Value: kv.GetValue()})
+		}
+	}
+	return result
+}
+
 // FieldHasWarmupKey checks if a field has warmup key set in its TypeParams
 func FieldHasWarmupKey(schema *schemapb.CollectionSchema, fieldID int64) bool {
 	for _, field := range schema.GetFields() {
@@ -932,6 +1012,54 @@ func GetCollectionTTL(kvs []*commonpb.KeyValuePair) (time.Duration, error) {
 	return time.Duration(value) * time.Second, nil
 }
 
+// GetCollectionSearchCPUShare returns the share of the read worker pool the collection could take, 1 if it's not set.
+func GetCollectionSearchCPUShare(kvs []*commonpb.KeyValuePair) (float64, error) {
+	value, exist := GetStringValue(kvs, CollectionSearchCPUShareKey)
+	if !exist {
+		return 1, nil
+	}
+	share, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if share <= 0 || share > 1 {
+		return 0, merr.WrapErrParameterInvalidMsg("search cpu share is out of range, expect (0, 1], got %s", value)
+	}
+	return share, nil
+}
+
+// GetTTLFieldRetention returns the retention of the ttl field, 0 if it's not set.
+func GetTTLFieldRetention(kvs []*commonpb.KeyValuePair) (time.Duration, error) {
+	value, parseErr, exist := GetInt64Value(kvs, CollectionTTLFieldRetentionKey)
+	if parseErr != nil {
+		return 0, parseErr
+	}
+	if !exist {
+		return 0, nil
+	}
+	return parseTTLFieldRetention(value)
+}
+
+// GetTTLFieldRetentionFromMap returns the retention of the ttl field, 0 if it's not set.
+func GetTTLFieldRetentionFromMap(kvs map[string]string) (time.Duration, error) {
+	value, exist := kvs[CollectionTTLFieldRetentionKey]
+	if !exist {
+		return 0, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return parseTTLFieldRetention(seconds)
+}
+
+func parseTTLFieldRetention(seconds int64) (time.Duration, error) {
+	if seconds < 0 || seconds > MaxTTLSeconds {
+		return 0, merr.WrapErrParameterInvalidMsg("ttl field retention is out of range, expect [0, %d], got %d", MaxTTLSeconds, seconds)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 func GetCollectionTTLFromMap(kvs map[string]string) (time.Duration, error) {
 	value, exist := kvs[CollectionTTLConfigKey]
 	if !exist {
@@ -353,6 +353,44 @@ func TestGetCollectionTTL(t *testing.T) {
 	})
 }
 
+func TestGetTTLFieldRetention(t *testing.T) {
+	result, err := GetTTLFieldRetention([]*commonpb.KeyValuePair{{Key: CollectionTTLFieldRetentionKey, Value: "3600"}})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, result)
+	result, err = GetTTLFieldRetentionFromMap(map[string]string{CollectionTTLFieldRetentionKey: "3600"})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, result)
+
+	result, err = GetTTLFieldRetention(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, result)
+	result, err = GetTTLFieldRetentionFromMap(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, result)
+
+	for _, value := range []string{"-1", "3155760001", "error value"} {
+		_, err = GetTTLFieldRetention([]*commonpb.KeyValuePair{{Key: CollectionTTLFieldRetentionKey, Value: value}})
+		assert.Error(t, err)
+		_, err = GetTTLFieldRetentionFromMap(map[string]string{CollectionTTLFieldRetentionKey: value})
+		assert.Error(t, err)
+	}
+}
+
+func TestGetCollectionSearchCPUShare(t *testing.T) {
+	result, err := GetCollectionSearchCPUShare([]*commonpb.KeyValuePair{{Key: CollectionSearchCPUShareKey, Value: "0.25"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.25, result)
+
+	result, err = GetCollectionSearchCPUShare(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+
+	for _, value := range []string{"0", "-0.5", "1.5", "error value"} {
+		_, err = GetCollectionSearchCPUShare([]*commonpb.KeyValuePair{{Key: CollectionSearchCPUShareKey, Value: value}})
+		assert.Error(t, err)
+	}
+}
+
 func TestWarmupPolicy(t *testing.T) {
 	t.Run("GetWarmupPolicy", func(t *testing.T) {
 		// Test when warmup key exists
@@ -410,6 +448,58 @@ func TestWarmupPolicy(t *testing.T) {
 		assert.Error(t, ValidateWarmupPolicy(""))
 	})
 
+	t.Run("ValidateCollectionWarmupPolicy", func(t *testing.T) {
+		assert.NoError(t, ValidateCollectionWarmupPolicy(WarmupScalarFieldKey, WarmupSync))
+		assert.Error(t, ValidateCollectionWarmupPolicy(WarmupScalarFieldKey, WarmupPolicyLazy))
+
+		assert.NoError(t, ValidateCollectionWarmupPolicy(WarmupPolicyKey, WarmupPolicyLazy))
+		assert.NoError(t, ValidateCollectionWarmupPolicy(WarmupPolicyKey, WarmupPolicyEager))
+		assert.NoError(t, ValidateCollectionWarmupPolicy(WarmupPolicyKey, WarmupPolicyIndexOnly))
+		assert.Error(t, ValidateCollectionWarmupPolicy(WarmupPolicyKey, WarmupSync))
+	})
+
+	t.Run("ExpandWarmupPolicy", func(t *testing.T) {
+		// no preset
+		props := []*commonpb.KeyValuePair{{Key: WarmupScalarFieldKey, Value: WarmupSync}}
+		assert.Equal(t, props, ExpandWarmupPolicy(props...))
+
+		// lazy preset
+		props = []*commonpb.KeyValuePair{{Key: WarmupPolicyKey, Value: WarmupPolicyLazy}}
+		expanded := ExpandWarmupPolicy(props...)
+		assert.Len(t, props, 1)
+		mmap, exist := IsMmapDataEnabled(expanded...)
+		assert.True(t, exist)
+		assert.True(t, mmap)
+		for _, key := range []string{WarmupScalarFieldKey, WarmupVectorFieldKey, WarmupScalarIndexKey, WarmupVectorIndexKey} {
+			policy, exist := GetWarmupPolicyByKey(key, expanded...)
+			assert.True(t, exist)
+			assert.Equal(t, WarmupDisable, policy)
+		}
+
+		// index only preset, the explicit key has higher priority.
+		props = []*commonpb.KeyValuePair{
+			{Key: WarmupPolicyKey, Value: WarmupPolicyIndexOnly},
+			{Key: WarmupScalarFieldKey, Value: WarmupAsync},
+		}
+		expanded = ExpandWarmupPolicy(props...)
+		_, exist = IsMmapDataEnabled(expanded...)
+		assert.False(t, exist)
+		policy, _ := GetWarmupPolicyByKey(WarmupScalarFieldKey, expanded...)
+		assert.Equal(t, WarmupAsync, policy)
+		policy, _ = GetWarmupPolicyByKey(WarmupVectorFieldKey, expanded...)
+		assert.Equal(t, WarmupDisable, policy)
+		policy, _ = GetWarmupPolicyByKey(WarmupVectorIndexKey, expanded...)
+		assert.Equal(t, WarmupSync, policy)
+
+		// eager preset
+		expanded = ExpandWarmupPolicy(&commonpb.KeyValuePair{Key: WarmupPolicyKey, Value: WarmupPolicyEager})
+		mmap, exist = IsMmapDataEnabled(expanded...)
+		assert.True(t, exist)
+		assert.False(t, mmap)
+		policy, _ = GetWarmupPolicyByKey(WarmupVectorFieldKey, expanded...)
+		assert.Equal(t, WarmupSync, policy)
+	})
+
 	t.Run("IsWarmupKey", func(t *testing.T) {
 		// Valid warmup keys
 		assert.True(t, IsWarmupKey(WarmupKey))
@@ -417,6 +507,7 @@ func TestWarmupPolicy(t *testing.T) {
 		assert.True(t, IsWarmupKey(WarmupScalarIndexKey))
 		assert.True(t, IsWarmupKey(WarmupVectorFieldKey))
 		assert.True(t, IsWarmupKey(WarmupVectorIndexKey))
+		assert.True(t, IsWarmupKey(WarmupPolicyKey))
 
 		// Invalid keys
 		assert.False(t, IsWarmupKey("warmup.invalid"))
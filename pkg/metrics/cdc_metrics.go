@@ -24,12 +24,17 @@ import (
 
 const (
 	// CDC metric names
-	CDCMetricReplicatedMessagesTotal  = "replicated_messages_total"
-	CDCMetricReplicatedBytesTotal     = "replicated_bytes_total"
-	CDCMetricReplicateEndToEndLatency = "replicate_end_to_end_latency"
-	CDCMetricLastReplicatedTimeTick   = "last_replicated_time_tick"
-	CDCMetricStreamRPCConnections     = "stream_rpc_connections"
-	CDCMetricStreamRPCReconnectTimes  = "stream_rpc_reconnect_times"
+	CDCMetricReplicatedMessagesTotal   = "replicated_messages_total"
+	CDCMetricReplicatedBytesTotal      = "replicated_bytes_total"
+	CDCMetricReplicateEndToEndLatency  = "replicate_end_to_end_latency"
+	CDCMetricLastReplicatedTimeTick    = "last_replicated_time_tick"
+	CDCMetricStreamRPCConnections      = "stream_rpc_connections"
+	CDCMetricStreamRPCReconnectTimes   = "stream_rpc_reconnect_times"
+	CDCMetricReplicateLagSeconds       = "replicate_lag_seconds"
+	CDCMetricReplicatePendingMessages  = "replicate_pending_messages"
+	CDCMetricClusterMaxLagSeconds      = "cluster_max_replicate_lag_seconds"
+	CDCMetricClusterPendingMessages    = "cluster_replicate_pending_messages"
+	CDCMetricReplicateThrottledSeconds = "replicate_throttled_seconds"
 
 	// CDC metric labels
 	CDCLabelTargetCluster     = "target_cluster"
@@ -117,6 +122,65 @@ var CDCStreamRPCReconnectTimes = prometheus.NewCounterVec(
 	},
 )
 
+var CDCReplicateLagSeconds = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: milvusNamespace,
+		Subsystem: typeutil.CDCRole,
+		Name:      CDCMetricReplicateLagSeconds,
+		Help:      "The time tick delta in seconds between the latest message read from the source channel and the last replicated message",
+	}, []string{
+		CDCLabelTargetCluster,
+		CDCLabelSourceChannelName,
+		CDCLabelTargetChannelName,
+	},
+)
+
+var CDCReplicatePendingMessages = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: milvusNamespace,
+		Subsystem: typeutil.CDCRole,
+		Name:      CDCMetricReplicatePendingMessages,
+		Help:      "The count of messages read from the source channel but not confirmed by the target cluster",
+	}, []string{
+		CDCLabelTargetCluster,
+		CDCLabelSourceChannelName,
+		CDCLabelTargetChannelName,
+	},
+)
+
+var CDCClusterMaxLagSeconds = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: milvusNamespace,
+		Subsystem: typeutil.CDCRole,
+		Name:      CDCMetricClusterMaxLagSeconds,
+		Help:      "The max replication lag in seconds of all replicating channels to the target cluster",
+	}, []string{
+		CDCLabelTargetCluster,
+	},
+)
+
+var CDCClusterPendingMessages = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: milvusNamespace,
+		Subsystem: typeutil.CDCRole,
+		Name:      CDCMetricClusterPendingMessages,
+		Help:      "The total count of unconfirmed messages of all replicating channels to the target cluster",
+	}, []string{
+		CDCLabelTargetCluster,
+	},
+)
+
+var CDCReplicateThrottledSeconds = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: milvusNamespace,
+		Subsystem: typeutil.CDCRole,
+		Name:      CDCMetricReplicateThrottledSeconds,
+		Help:      "The total seconds of replication throttled by the rate limit of the target cluster",
+	}, []string{
+		CDCLabelTargetCluster,
+	},
+)
+
 func RegisterCDC(registry *prometheus.Registry) {
 	registry.MustRegister(CDCReplicatedMessagesTotal)
 	registry.MustRegister(CDCReplicatedBytesTotal)
@@ -124,4 +188,9 @@ func RegisterCDC(registry *prometheus.Registry) {
 	registry.MustRegister(CDCLastReplicatedTimeTick)
 	registry.MustRegister(CDCStreamRPCConnections)
 	registry.MustRegister(CDCStreamRPCReconnectTimes)
+	registry.MustRegister(CDCReplicateLagSeconds)
+	registry.MustRegister(CDCReplicatePendingMessages)
+	registry.MustRegister(CDCClusterMaxLagSeconds)
+	registry.MustRegister(CDCClusterPendingMessages)
+	registry.MustRegister(CDCReplicateThrottledSeconds)
 }
@@ -160,6 +160,7 @@ const (
 	queueTypeLabelName             = `queue_type`
 	poolNameLabelName              = "pool_name"
 	outcomeLabelName               = "outcome"
+	gpuDeviceLabelName             = "gpu_device"
 
 	// model function/UDF labels
 	functionTypeName = "function_type_name"
@@ -533,6 +533,17 @@ var (
 			segmentStateLabelName,
 		})
 
+	QueryNodeSchemaVersionNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "schema_version_num",
+			Help:      "number of live schema versions of the collection, including the current one and the old ones still pinned by segments or requests",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
 	QueryNodeLevelZeroSize = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -842,6 +853,133 @@ var (
 			nodeIDLabelName,
 		})
 
+	// QueryNodeGPUMemoryReservedBytes records the GPU memory reserved by the loaded GPU indexes of each device.
+	QueryNodeGPUMemoryReservedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "gpu_memory_reserved_bytes",
+			Help:      "GPU memory reserved by the loaded GPU indexes (in bytes)",
+		}, []string{
+			nodeIDLabelName,
+			gpuDeviceLabelName,
+		})
+
+	// QueryNodeGPUMemoryUtilization records the ratio of the reserved GPU memory to the usable GPU memory of each device.
+	QueryNodeGPUMemoryUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "gpu_memory_utilization",
+			Help:      "ratio of the reserved GPU memory to the usable GPU memory",
+		}, []string{
+			nodeIDLabelName,
+			gpuDeviceLabelName,
+		})
+
+	// QueryNodeGPUIndexEvictTotal records the number of segments whose GPU indexes are evicted to host memory.
+	QueryNodeGPUIndexEvictTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "gpu_index_evict_total",
+			Help:      "number of segments whose GPU indexes are evicted to host memory",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeSegmentCacheAccessTotal records the accesses of the sealed segments tracked by the segment cache.
+	QueryNodeSegmentCacheAccessTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_cache_access_total",
+			Help:      "number of accesses of the sealed segments tracked by the segment cache",
+		}, []string{
+			nodeIDLabelName,
+			cacheStateLabelName,
+		})
+
+	// QueryNodeSegmentCacheEvictTotal records the number of sealed segments whose data is evicted by the segment cache.
+	QueryNodeSegmentCacheEvictTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_cache_evict_total",
+			Help:      "number of sealed segments whose data is evicted by the segment cache",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeSegmentCacheResidentBytes records the size of the resident sealed segments of the segment cache.
+	QueryNodeSegmentCacheResidentBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_cache_resident_bytes",
+			Help:      "size of the resident sealed segments of the segment cache (in bytes)",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeSegmentCacheSegmentNum records the number of the resident and evicted sealed segments of the segment cache.
+	QueryNodeSegmentCacheSegmentNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_cache_segment_num",
+			Help:      "number of the resident and evicted sealed segments of the segment cache",
+		}, []string{
+			nodeIDLabelName,
+			segmentStateLabelName,
+		})
+
+	// QueryNodeSegmentCacheReloadLatency records the latency of loading the evicted segment back.
+	QueryNodeSegmentCacheReloadLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_cache_reload_latency",
+			Help:      "latency of loading the data of the evicted segment back from the object storage (in milliseconds)",
+			Buckets:   longTaskBuckets,
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeLazyFieldLoadTotal records the number of fields of the sealed segments loaded on demand.
+	QueryNodeLazyFieldLoadTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "lazy_field_load_total",
+			Help:      "number of fields of the sealed segments loaded on demand",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeLazyFieldLoadLatency records the latency of loading the fields of a sealed segment on demand.
+	QueryNodeLazyFieldLoadLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "lazy_field_load_latency",
+			Help:      "latency of loading the fields of a sealed segment on demand (in milliseconds)",
+			Buckets:   longTaskBuckets,
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeLazyFieldUnloadedBytes records the binlog size of the fields of the sealed segments not loaded yet.
+	QueryNodeLazyFieldUnloadedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "lazy_field_unloaded_bytes",
+			Help:      "binlog size of the fields of the sealed segments not loaded yet (in bytes)",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	QueryNodeDeleteBufferSize = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -854,6 +992,18 @@ var (
 		},
 	)
 
+	QueryNodeDeleteBufferSpilledSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "delete_buffer_spilled_size",
+			Help:      "delegator delete buffer size spilled to disk (in bytes)",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		},
+	)
+
 	QueryNodeDeleteBufferRowNum = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -988,6 +1138,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeNumEntities)
 	registry.MustRegister(QueryNodeEntitiesSize)
 	registry.MustRegister(QueryNodeLevelZeroSize)
+	registry.MustRegister(QueryNodeSchemaVersionNum)
 	registry.MustRegister(QueryNodeConsumeCounter)
 	registry.MustRegister(QueryNodeExecuteCounter)
 	registry.MustRegister(QueryNodeConsumerMsgCount)
@@ -1026,8 +1177,20 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSegmentFilterHitSegmentNum)
 	registry.MustRegister(QueryNodeSegmentFilterSkippedSegmentNum)
 	registry.MustRegister(QueryNodeSegmentFilterTotalSegmentNum)
+	registry.MustRegister(QueryNodeGPUMemoryReservedBytes)
+	registry.MustRegister(QueryNodeGPUMemoryUtilization)
+	registry.MustRegister(QueryNodeGPUIndexEvictTotal)
+	registry.MustRegister(QueryNodeSegmentCacheAccessTotal)
+	registry.MustRegister(QueryNodeSegmentCacheEvictTotal)
+	registry.MustRegister(QueryNodeSegmentCacheResidentBytes)
+	registry.MustRegister(QueryNodeSegmentCacheSegmentNum)
+	registry.MustRegister(QueryNodeSegmentCacheReloadLatency)
+	registry.MustRegister(QueryNodeLazyFieldLoadTotal)
+	registry.MustRegister(QueryNodeLazyFieldLoadLatency)
+	registry.MustRegister(QueryNodeLazyFieldUnloadedBytes)
 	registry.MustRegister(QueryNodeDeleteBufferSize)
 	registry.MustRegister(QueryNodeDeleteBufferRowNum)
+	registry.MustRegister(QueryNodeDeleteBufferSpilledSize)
 	registry.MustRegister(QueryNodeCGOCallLatency)
 	registry.MustRegister(QueryNodePartialResultCount)
 	registry.MustRegister(QueryNodeTwoStageFilterLatency)
@@ -1064,6 +1227,7 @@ func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
 	QueryNodeSegmentPruneBias.DeletePartialMatch(labels)
 	QueryNodeSegmentPruneLatency.DeletePartialMatch(labels)
 	QueryNodeLevelZeroSize.DeletePartialMatch(labels)
+	QueryNodeSchemaVersionNum.DeletePartialMatch(labels)
 	QueryNodeTwoStageFilterLatency.DeletePartialMatch(labels)
 	QueryNodeTwoStageSearchLatency.DeletePartialMatch(labels)
 	QueryNodeTwoStageSearchFallbackCount.DeletePartialMatch(labels)
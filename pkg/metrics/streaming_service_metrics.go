@@ -20,6 +20,7 @@ const (
 	WALStatusOK                             = "ok"
 	WALStatusCancel                         = "cancel"
 	WALStatusError                          = "error"
+	WALStatusSecondaryRejected              = "secondary_rejected"
 
 	BroadcasterTaskStateLabelName         = "state"
 	ResourceKeyLockLabelName              = "rk_lock"
@@ -41,8 +42,11 @@ const (
 	WALChannelTermLabelName               = "term"
 	WALNameLabelName                      = "wal_name"
 	WALTxnTypeLabelName                   = "txn_type"
+	WALScannerNameLabelName               = "scanner_name"
 	StatusLabelName                       = statusLabelName
 	StreamingNodeLabelName                = "streaming_node"
+	ReplicateTargetClusterLabelName       = "target_cluster"
+	ReplicateSchemaDriftTypeLabelName     = "drift_type"
 	NodeIDLabelName                       = nodeIDLabelName
 )
 
@@ -53,6 +57,8 @@ var (
 	messageBytesBuckets = prometheus.ExponentialBucketsRange(64, 8388608, 10)
 	// from 1ms to 5s
 	secondsBuckets = prometheus.ExponentialBucketsRange(0.001, 5, 10)
+	// from 10ms to 10m
+	pchannelRecoverySecondsBuckets = prometheus.ExponentialBucketsRange(0.01, 600, 12)
 
 	// Streaming Service Client Producer Metrics.
 	StreamingServiceClientResumingProducerTotal = newStreamingServiceClientGaugeVec(prometheus.GaugeOpts{
@@ -131,6 +137,28 @@ var (
 		Help: "Info of assignment",
 	})
 
+	StreamingCoordPChannelStateTotal = newStreamingCoordGaugeVec(prometheus.GaugeOpts{
+		Name: "pchannel_state_total",
+		Help: "Total of pchannels in each state",
+	}, WALStateLabelName)
+
+	StreamingCoordPChannelTermBumpTotal = newStreamingCoordCounterVec(prometheus.CounterOpts{
+		Name: "pchannel_term_bump_total",
+		Help: "Total of term bumps of pchannels",
+	}, WALChannelLabelName)
+
+	StreamingCoordPChannelAssignDurationSeconds = newStreamingCoordHistogramVec(prometheus.HistogramOpts{
+		Name:    "pchannel_assign_duration_seconds",
+		Help:    "Duration from the pchannel starts assigning to the assignment is done",
+		Buckets: pchannelRecoverySecondsBuckets,
+	})
+
+	StreamingCoordPChannelUnavailableDurationSeconds = newStreamingCoordHistogramVec(prometheus.HistogramOpts{
+		Name:    "pchannel_unavailable_duration_seconds",
+		Help:    "Duration from the pchannel is marked as unavailable to the reassignment is done",
+		Buckets: pchannelRecoverySecondsBuckets,
+	})
+
 	StreamingCoordAssignmentListenerTotal = newStreamingCoordGaugeVec(prometheus.GaugeOpts{
 		Name: "assignment_listener_total",
 		Help: "Total of assignment listener",
@@ -165,6 +193,21 @@ var (
 		Buckets: secondsBuckets,
 	}, WALMessageTypeLabelName)
 
+	StreamingCoordReplicateSchemaDriftTotal = newStreamingCoordGaugeVec(prometheus.GaugeOpts{
+		Name: "replicate_schema_drift_total",
+		Help: "Total of schema drifts between current cluster and the target cluster found by the last consistency check",
+	}, ReplicateTargetClusterLabelName, ReplicateSchemaDriftTypeLabelName)
+
+	StreamingCoordReplicateTargetReachable = newStreamingCoordGaugeVec(prometheus.GaugeOpts{
+		Name: "replicate_target_reachable",
+		Help: "Whether the target cluster is reachable by the last health probe, 1 is reachable and 0 is unreachable",
+	}, ReplicateTargetClusterLabelName)
+
+	StreamingCoordReplicateTargetProbeLatencySeconds = newStreamingCoordGaugeVec(prometheus.GaugeOpts{
+		Name: "replicate_target_probe_latency_seconds",
+		Help: "Latency of the last health probe of the target cluster",
+	}, ReplicateTargetClusterLabelName)
+
 	// StreamingNode Producer Server Metrics.
 	StreamingNodeProducerTotal = newStreamingNodeGaugeVec(prometheus.GaugeOpts{
 		Name: "producer_total",
@@ -230,6 +273,18 @@ var (
 		Help: "Total of sync time tick on wal",
 	}, WALChannelLabelName, TimeTickAckTypeLabelName)
 
+	WALAcknowledgeTimeTickDurationSeconds = newWALHistogramVec(prometheus.HistogramOpts{
+		Name:    "acknowledge_time_tick_duration_seconds",
+		Help:    "Duration from the time tick is allocated to it is acknowledged on wal",
+		Buckets: secondsBuckets,
+	}, WALChannelLabelName, TimeTickAckTypeLabelName)
+
+	WALSyncTimeTickDurationSeconds = newWALHistogramVec(prometheus.HistogramOpts{
+		Name:    "sync_time_tick_duration_seconds",
+		Help:    "Duration from the time tick is allocated to it is synced by the time tick message on wal",
+		Buckets: secondsBuckets,
+	}, WALChannelLabelName, TimeTickAckTypeLabelName)
+
 	WALTimeTickSyncTotal = newWALCounterVec(prometheus.CounterOpts{
 		Name: "sync_total",
 		Help: "Total of time tick sync sent",
@@ -350,6 +405,21 @@ var (
 		Help: "Total of append message retry",
 	}, WALChannelLabelName)
 
+	WALAppendBytesTotal = newWALCounterVec(prometheus.CounterOpts{
+		Name: "append_bytes_total",
+		Help: "Total bytes of message appended into wal successfully",
+	}, WALChannelLabelName)
+
+	WALTermChangeTotal = newWALCounterVec(prometheus.CounterOpts{
+		Name: "term_change_total",
+		Help: "Total of wal opened with a new term on current streaming node",
+	}, WALChannelLabelName)
+
+	WALFencedTotal = newWALCounterVec(prometheus.CounterOpts{
+		Name: "fenced_total",
+		Help: "Total of wal fenced by the underlying wal when appending",
+	}, WALChannelLabelName)
+
 	WALAppendMessageDurationSeconds = newWALHistogramVec(prometheus.HistogramOpts{
 		Name:    "append_message_duration_seconds",
 		Help:    "Duration of wal append message",
@@ -387,6 +457,17 @@ var (
 		Help: "Latest time tick of write ahead buffer in wal",
 	}, WALChannelLabelName)
 
+	// Tiered WAL Related Metrics
+	WALTieredPendingEntries = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "tiered_pending_entries",
+		Help: "Total of messages of tiered wal which are acknowledged but not uploaded into the remote mq",
+	}, WALChannelLabelName)
+
+	WALTieredDiscardedEntriesTotal = newWALCounterVec(prometheus.CounterOpts{
+		Name: "tiered_discarded_entries_total",
+		Help: "Total of messages of tiered wal which are discarded from the local log because the pchannel is written by a newer term",
+	}, WALChannelLabelName)
+
 	// Scanner Related Metrics
 	WALScannerTotal = newWALGaugeVec(prometheus.GaugeOpts{
 		Name: "scanner_total",
@@ -445,6 +526,11 @@ var (
 		Help: "Size of txn buffer in wal scanner",
 	}, WALChannelLabelName)
 
+	WALScannerLagSeconds = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "scanner_lag_seconds",
+		Help: "Lag seconds between now and the time tick of the last message delivered by wal scanner",
+	}, WALChannelLabelName, WALScannerNameLabelName)
+
 	WALFlusherInfo = newWALGaugeVec(prometheus.GaugeOpts{
 		Name: "flusher_info",
 		Help: "Current info of flusher on current wal",
@@ -577,6 +663,12 @@ var (
 		Name: "rate_limit_append_rate_recover_threshold_bytes",
 		Help: "Append rate bytes threshold to trigger recovery",
 	}, WALChannelLabelName)
+
+	// Rate Limit Observed Metrics - Consumer Lag
+	WALRateLimitConsumerLagSeconds = newWALGaugeVec(prometheus.GaugeOpts{
+		Name: "rate_limit_consumer_lag_seconds",
+		Help: "Lag seconds of the slowest consumer of wal observed by the consumer lag rate limiter",
+	}, WALChannelLabelName)
 )
 
 // RegisterStreamingServiceClient registers streaming service client metrics
@@ -601,12 +693,19 @@ func registerStreamingCoord(registry *prometheus.Registry) {
 	registry.MustRegister(StreamingCoordPChannelInfo)
 	registry.MustRegister(StreamingCoordVChannelTotal)
 	registry.MustRegister(StreamingCoordAssignmentVersion)
+	registry.MustRegister(StreamingCoordPChannelStateTotal)
+	registry.MustRegister(StreamingCoordPChannelTermBumpTotal)
+	registry.MustRegister(StreamingCoordPChannelAssignDurationSeconds)
+	registry.MustRegister(StreamingCoordPChannelUnavailableDurationSeconds)
 	registry.MustRegister(StreamingCoordAssignmentListenerTotal)
 	registry.MustRegister(StreamingCoordBroadcasterTaskTotal)
 	registry.MustRegister(StreamingCoordBroadcasterTaskExecutionDurationSeconds)
 	registry.MustRegister(StreamingCoordBroadcasterTaskBroadcastDurationSeconds)
 	registry.MustRegister(StreamingCoordBroadcasterTaskAcquireLockDurationSeconds)
 	registry.MustRegister(StreamingCoordBroadcasterTaskAckCallbackDurationSeconds)
+	registry.MustRegister(StreamingCoordReplicateSchemaDriftTotal)
+	registry.MustRegister(StreamingCoordReplicateTargetReachable)
+	registry.MustRegister(StreamingCoordReplicateTargetProbeLatencySeconds)
 }
 
 // RegisterStreamingNode registers streaming node metrics
@@ -634,6 +733,8 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALLastConfirmedTimeTick)
 	registry.MustRegister(WALAcknowledgeTimeTickTotal)
 	registry.MustRegister(WALSyncTimeTickTotal)
+	registry.MustRegister(WALAcknowledgeTimeTickDurationSeconds)
+	registry.MustRegister(WALSyncTimeTickDurationSeconds)
 	registry.MustRegister(WALTimeTickSyncTotal)
 	registry.MustRegister(WALTimeTickSyncTimeTick)
 	registry.MustRegister(WALInflightTxn)
@@ -656,6 +757,9 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALAppendMessageBeforeInterceptorDurationSeconds)
 	registry.MustRegister(WALAppendMessageAfterInterceptorDurationSeconds)
 	registry.MustRegister(WALImplsAppendRetryTotal)
+	registry.MustRegister(WALAppendBytesTotal)
+	registry.MustRegister(WALTermChangeTotal)
+	registry.MustRegister(WALFencedTotal)
 	registry.MustRegister(WALAppendMessageDurationSeconds)
 	registry.MustRegister(WALImplsAppendMessageDurationSeconds)
 	registry.MustRegister(WALWriteAheadBufferEntryTotal)
@@ -663,6 +767,8 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALWriteAheadBufferCapacityBytes)
 	registry.MustRegister(WALWriteAheadBufferEarliestTimeTick)
 	registry.MustRegister(WALWriteAheadBufferLatestTimeTick)
+	registry.MustRegister(WALTieredPendingEntries)
+	registry.MustRegister(WALTieredDiscardedEntriesTotal)
 	registry.MustRegister(WALScannerTotal)
 	registry.MustRegister(WALScannerPauseConsumption)
 	registry.MustRegister(WALScanMessageBytes)
@@ -674,6 +780,7 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALScannerPendingQueueBytes)
 	registry.MustRegister(WALScannerTimeTickBufBytes)
 	registry.MustRegister(WALScannerTxnBufBytes)
+	registry.MustRegister(WALScannerLagSeconds)
 	registry.MustRegister(WALFlusherInfo)
 	registry.MustRegister(WALFlusherTimeTick)
 	registry.MustRegister(WALRecoveryInfo)
@@ -700,6 +807,7 @@ func registerWAL(registry *prometheus.Registry) {
 	registry.MustRegister(WALRateLimitNodeMemoryRecoverThreshold)
 	registry.MustRegister(WALRateLimitAppendRateSlowdownThreshold)
 	registry.MustRegister(WALRateLimitAppendRateRecoverThreshold)
+	registry.MustRegister(WALRateLimitConsumerLagSeconds)
 }
 
 func newStreamingCoordGaugeVec(opts prometheus.GaugeOpts, extra ...string) *prometheus.GaugeVec {
@@ -709,6 +817,13 @@ func newStreamingCoordGaugeVec(opts prometheus.GaugeOpts, extra ...string) *prom
 	return prometheus.NewGaugeVec(opts, labels)
 }
 
+func newStreamingCoordCounterVec(opts prometheus.CounterOpts, extra ...string) *prometheus.CounterVec {
+	opts.Namespace = milvusNamespace
+	opts.Subsystem = typeutil.StreamingCoordRole
+	labels := mergeLabel(extra...)
+	return prometheus.NewCounterVec(opts, labels)
+}
+
 func newStreamingCoordHistogramVec(opts prometheus.HistogramOpts, extra ...string) *prometheus.HistogramVec {
 	opts.Namespace = milvusNamespace
 	opts.Subsystem = typeutil.StreamingCoordRole
@@ -98,6 +98,1486 @@ func (_c *MockStreamingCoordAssignmentServiceClient_AssignmentDiscover_Call) Run
 	return _c
 }
 
+// CheckReplicateSchemaConsistency provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) CheckReplicateSchemaConsistency(ctx context.Context, in *streamingpb.CheckReplicateSchemaConsistencyRequest, opts ...grpc.CallOption) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckReplicateSchemaConsistency")
+	}
+
+	var r0 *streamingpb.CheckReplicateSchemaConsistencyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest, ...grpc.CallOption) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest, ...grpc.CallOption) *streamingpb.CheckReplicateSchemaConsistencyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.CheckReplicateSchemaConsistencyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckReplicateSchemaConsistency'
+type MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call struct {
+	*mock.Call
+}
+
+// CheckReplicateSchemaConsistency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.CheckReplicateSchemaConsistencyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) CheckReplicateSchemaConsistency(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call {
+	return &MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call{Call: _e.mock.On("CheckReplicateSchemaConsistency",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call) Run(run func(ctx context.Context, in *streamingpb.CheckReplicateSchemaConsistencyRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.CheckReplicateSchemaConsistencyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call) Return(_a0 *streamingpb.CheckReplicateSchemaConsistencyResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call) RunAndReturn(run func(context.Context, *streamingpb.CheckReplicateSchemaConsistencyRequest, ...grpc.CallOption) (*streamingpb.CheckReplicateSchemaConsistencyResponse, error)) *MockStreamingCoordAssignmentServiceClient_CheckReplicateSchemaConsistency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DrainNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) DrainNode(ctx context.Context, in *streamingpb.DrainNodeRequest, opts ...grpc.CallOption) (*streamingpb.DrainNodeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DrainNode")
+	}
+
+	var r0 *streamingpb.DrainNodeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.DrainNodeRequest, ...grpc.CallOption) (*streamingpb.DrainNodeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.DrainNodeRequest, ...grpc.CallOption) *streamingpb.DrainNodeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.DrainNodeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.DrainNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_DrainNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DrainNode'
+type MockStreamingCoordAssignmentServiceClient_DrainNode_Call struct {
+	*mock.Call
+}
+
+// DrainNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.DrainNodeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) DrainNode(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_DrainNode_Call {
+	return &MockStreamingCoordAssignmentServiceClient_DrainNode_Call{Call: _e.mock.On("DrainNode",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_DrainNode_Call) Run(run func(ctx context.Context, in *streamingpb.DrainNodeRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_DrainNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.DrainNodeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_DrainNode_Call) Return(_a0 *streamingpb.DrainNodeResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_DrainNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_DrainNode_Call) RunAndReturn(run func(context.Context, *streamingpb.DrainNodeRequest, ...grpc.CallOption) (*streamingpb.DrainNodeResponse, error)) *MockStreamingCoordAssignmentServiceClient_DrainNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportState provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) ExportState(ctx context.Context, in *streamingpb.ExportStateRequest, opts ...grpc.CallOption) (*streamingpb.ExportStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportState")
+	}
+
+	var r0 *streamingpb.ExportStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ExportStateRequest, ...grpc.CallOption) (*streamingpb.ExportStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ExportStateRequest, ...grpc.CallOption) *streamingpb.ExportStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ExportStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ExportStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_ExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportState'
+type MockStreamingCoordAssignmentServiceClient_ExportState_Call struct {
+	*mock.Call
+}
+
+// ExportState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.ExportStateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) ExportState(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_ExportState_Call {
+	return &MockStreamingCoordAssignmentServiceClient_ExportState_Call{Call: _e.mock.On("ExportState",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ExportState_Call) Run(run func(ctx context.Context, in *streamingpb.ExportStateRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_ExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.ExportStateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ExportState_Call) Return(_a0 *streamingpb.ExportStateResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_ExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ExportState_Call) RunAndReturn(run func(context.Context, *streamingpb.ExportStateRequest, ...grpc.CallOption) (*streamingpb.ExportStateResponse, error)) *MockStreamingCoordAssignmentServiceClient_ExportState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssignmentHistory provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) GetAssignmentHistory(ctx context.Context, in *streamingpb.GetAssignmentHistoryRequest, opts ...grpc.CallOption) (*streamingpb.GetAssignmentHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignmentHistory")
+	}
+
+	var r0 *streamingpb.GetAssignmentHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest, ...grpc.CallOption) (*streamingpb.GetAssignmentHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest, ...grpc.CallOption) *streamingpb.GetAssignmentHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetAssignmentHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetAssignmentHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssignmentHistory'
+type MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call struct {
+	*mock.Call
+}
+
+// GetAssignmentHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.GetAssignmentHistoryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) GetAssignmentHistory(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call {
+	return &MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call{Call: _e.mock.On("GetAssignmentHistory",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call) Run(run func(ctx context.Context, in *streamingpb.GetAssignmentHistoryRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.GetAssignmentHistoryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call) Return(_a0 *streamingpb.GetAssignmentHistoryResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call) RunAndReturn(run func(context.Context, *streamingpb.GetAssignmentHistoryRequest, ...grpc.CallOption) (*streamingpb.GetAssignmentHistoryResponse, error)) *MockStreamingCoordAssignmentServiceClient_GetAssignmentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClusterChannels provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) GetClusterChannels(ctx context.Context, in *streamingpb.GetClusterChannelsRequest, opts ...grpc.CallOption) (*streamingpb.GetClusterChannelsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterChannels")
+	}
+
+	var r0 *streamingpb.GetClusterChannelsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetClusterChannelsRequest, ...grpc.CallOption) (*streamingpb.GetClusterChannelsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetClusterChannelsRequest, ...grpc.CallOption) *streamingpb.GetClusterChannelsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetClusterChannelsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetClusterChannelsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClusterChannels'
+type MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call struct {
+	*mock.Call
+}
+
+// GetClusterChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.GetClusterChannelsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) GetClusterChannels(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call {
+	return &MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call{Call: _e.mock.On("GetClusterChannels",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call) Run(run func(ctx context.Context, in *streamingpb.GetClusterChannelsRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.GetClusterChannelsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call) Return(_a0 *streamingpb.GetClusterChannelsResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call) RunAndReturn(run func(context.Context, *streamingpb.GetClusterChannelsRequest, ...grpc.CallOption) (*streamingpb.GetClusterChannelsResponse, error)) *MockStreamingCoordAssignmentServiceClient_GetClusterChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPChannelRetentions provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) GetPChannelRetentions(ctx context.Context, in *streamingpb.GetPChannelRetentionsRequest, opts ...grpc.CallOption) (*streamingpb.GetPChannelRetentionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPChannelRetentions")
+	}
+
+	var r0 *streamingpb.GetPChannelRetentionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetPChannelRetentionsRequest, ...grpc.CallOption) (*streamingpb.GetPChannelRetentionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetPChannelRetentionsRequest, ...grpc.CallOption) *streamingpb.GetPChannelRetentionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetPChannelRetentionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetPChannelRetentionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPChannelRetentions'
+type MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call struct {
+	*mock.Call
+}
+
+// GetPChannelRetentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.GetPChannelRetentionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) GetPChannelRetentions(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call {
+	return &MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call{Call: _e.mock.On("GetPChannelRetentions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call) Run(run func(ctx context.Context, in *streamingpb.GetPChannelRetentionsRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.GetPChannelRetentionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call) Return(_a0 *streamingpb.GetPChannelRetentionsResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call) RunAndReturn(run func(context.Context, *streamingpb.GetPChannelRetentionsRequest, ...grpc.CallOption) (*streamingpb.GetPChannelRetentionsResponse, error)) *MockStreamingCoordAssignmentServiceClient_GetPChannelRetentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReplicateConfigurationHistory provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) GetReplicateConfigurationHistory(ctx context.Context, in *streamingpb.GetReplicateConfigurationHistoryRequest, opts ...grpc.CallOption) (*streamingpb.GetReplicateConfigurationHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReplicateConfigurationHistory")
+	}
+
+	var r0 *streamingpb.GetReplicateConfigurationHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest, ...grpc.CallOption) (*streamingpb.GetReplicateConfigurationHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest, ...grpc.CallOption) *streamingpb.GetReplicateConfigurationHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.GetReplicateConfigurationHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReplicateConfigurationHistory'
+type MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call struct {
+	*mock.Call
+}
+
+// GetReplicateConfigurationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.GetReplicateConfigurationHistoryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) GetReplicateConfigurationHistory(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call {
+	return &MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call{Call: _e.mock.On("GetReplicateConfigurationHistory",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call) Run(run func(ctx context.Context, in *streamingpb.GetReplicateConfigurationHistoryRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.GetReplicateConfigurationHistoryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call) Return(_a0 *streamingpb.GetReplicateConfigurationHistoryResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call) RunAndReturn(run func(context.Context, *streamingpb.GetReplicateConfigurationHistoryRequest, ...grpc.CallOption) (*streamingpb.GetReplicateConfigurationHistoryResponse, error)) *MockStreamingCoordAssignmentServiceClient_GetReplicateConfigurationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicateDeadLetters provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) ListReplicateDeadLetters(ctx context.Context, in *streamingpb.ListReplicateDeadLettersRequest, opts ...grpc.CallOption) (*streamingpb.ListReplicateDeadLettersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicateDeadLetters")
+	}
+
+	var r0 *streamingpb.ListReplicateDeadLettersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicateDeadLettersRequest, ...grpc.CallOption) (*streamingpb.ListReplicateDeadLettersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicateDeadLettersRequest, ...grpc.CallOption) *streamingpb.ListReplicateDeadLettersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ListReplicateDeadLettersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ListReplicateDeadLettersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicateDeadLetters'
+type MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListReplicateDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.ListReplicateDeadLettersRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) ListReplicateDeadLetters(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call {
+	return &MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call{Call: _e.mock.On("ListReplicateDeadLetters",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call) Run(run func(ctx context.Context, in *streamingpb.ListReplicateDeadLettersRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.ListReplicateDeadLettersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call) Return(_a0 *streamingpb.ListReplicateDeadLettersResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call) RunAndReturn(run func(context.Context, *streamingpb.ListReplicateDeadLettersRequest, ...grpc.CallOption) (*streamingpb.ListReplicateDeadLettersResponse, error)) *MockStreamingCoordAssignmentServiceClient_ListReplicateDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReplicatingTasks provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) ListReplicatingTasks(ctx context.Context, in *streamingpb.ListReplicatingTasksRequest, opts ...grpc.CallOption) (*streamingpb.ListReplicatingTasksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReplicatingTasks")
+	}
+
+	var r0 *streamingpb.ListReplicatingTasksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicatingTasksRequest, ...grpc.CallOption) (*streamingpb.ListReplicatingTasksResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ListReplicatingTasksRequest, ...grpc.CallOption) *streamingpb.ListReplicatingTasksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ListReplicatingTasksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ListReplicatingTasksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicatingTasks'
+type MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call struct {
+	*mock.Call
+}
+
+// ListReplicatingTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.ListReplicatingTasksRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) ListReplicatingTasks(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call {
+	return &MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call{Call: _e.mock.On("ListReplicatingTasks",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call) Run(run func(ctx context.Context, in *streamingpb.ListReplicatingTasksRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.ListReplicatingTasksRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call) Return(_a0 *streamingpb.ListReplicatingTasksResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call) RunAndReturn(run func(context.Context, *streamingpb.ListReplicatingTasksRequest, ...grpc.CallOption) (*streamingpb.ListReplicatingTasksResponse, error)) *MockStreamingCoordAssignmentServiceClient_ListReplicatingTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigratePChannelTopics provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) MigratePChannelTopics(ctx context.Context, in *streamingpb.MigratePChannelTopicsRequest, opts ...grpc.CallOption) (*streamingpb.MigratePChannelTopicsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigratePChannelTopics")
+	}
+
+	var r0 *streamingpb.MigratePChannelTopicsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MigratePChannelTopicsRequest, ...grpc.CallOption) (*streamingpb.MigratePChannelTopicsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MigratePChannelTopicsRequest, ...grpc.CallOption) *streamingpb.MigratePChannelTopicsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.MigratePChannelTopicsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.MigratePChannelTopicsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigratePChannelTopics'
+type MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call struct {
+	*mock.Call
+}
+
+// MigratePChannelTopics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.MigratePChannelTopicsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) MigratePChannelTopics(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call {
+	return &MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call{Call: _e.mock.On("MigratePChannelTopics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call) Run(run func(ctx context.Context, in *streamingpb.MigratePChannelTopicsRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.MigratePChannelTopicsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call) Return(_a0 *streamingpb.MigratePChannelTopicsResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call) RunAndReturn(run func(context.Context, *streamingpb.MigratePChannelTopicsRequest, ...grpc.CallOption) (*streamingpb.MigratePChannelTopicsResponse, error)) *MockStreamingCoordAssignmentServiceClient_MigratePChannelTopics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveControlChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) MoveControlChannel(ctx context.Context, in *streamingpb.MoveControlChannelRequest, opts ...grpc.CallOption) (*streamingpb.MoveControlChannelResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveControlChannel")
+	}
+
+	var r0 *streamingpb.MoveControlChannelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MoveControlChannelRequest, ...grpc.CallOption) (*streamingpb.MoveControlChannelResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.MoveControlChannelRequest, ...grpc.CallOption) *streamingpb.MoveControlChannelResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.MoveControlChannelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.MoveControlChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveControlChannel'
+type MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call struct {
+	*mock.Call
+}
+
+// MoveControlChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.MoveControlChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) MoveControlChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call {
+	return &MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call{Call: _e.mock.On("MoveControlChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call) Run(run func(ctx context.Context, in *streamingpb.MoveControlChannelRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.MoveControlChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call) Return(_a0 *streamingpb.MoveControlChannelResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call) RunAndReturn(run func(context.Context, *streamingpb.MoveControlChannelRequest, ...grpc.CallOption) (*streamingpb.MoveControlChannelResponse, error)) *MockStreamingCoordAssignmentServiceClient_MoveControlChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteSecondary provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) PromoteSecondary(ctx context.Context, in *streamingpb.PromoteSecondaryRequest, opts ...grpc.CallOption) (*streamingpb.PromoteSecondaryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteSecondary")
+	}
+
+	var r0 *streamingpb.PromoteSecondaryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.PromoteSecondaryRequest, ...grpc.CallOption) (*streamingpb.PromoteSecondaryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.PromoteSecondaryRequest, ...grpc.CallOption) *streamingpb.PromoteSecondaryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.PromoteSecondaryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.PromoteSecondaryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteSecondary'
+type MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call struct {
+	*mock.Call
+}
+
+// PromoteSecondary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.PromoteSecondaryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) PromoteSecondary(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call {
+	return &MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call{Call: _e.mock.On("PromoteSecondary",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call) Run(run func(ctx context.Context, in *streamingpb.PromoteSecondaryRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.PromoteSecondaryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call) Return(_a0 *streamingpb.PromoteSecondaryResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call) RunAndReturn(run func(context.Context, *streamingpb.PromoteSecondaryRequest, ...grpc.CallOption) (*streamingpb.PromoteSecondaryResponse, error)) *MockStreamingCoordAssignmentServiceClient_PromoteSecondary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenewPChannelLease provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) RenewPChannelLease(ctx context.Context, in *streamingpb.RenewPChannelLeaseRequest, opts ...grpc.CallOption) (*streamingpb.RenewPChannelLeaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewPChannelLease")
+	}
+
+	var r0 *streamingpb.RenewPChannelLeaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RenewPChannelLeaseRequest, ...grpc.CallOption) (*streamingpb.RenewPChannelLeaseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RenewPChannelLeaseRequest, ...grpc.CallOption) *streamingpb.RenewPChannelLeaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.RenewPChannelLeaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.RenewPChannelLeaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenewPChannelLease'
+type MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call struct {
+	*mock.Call
+}
+
+// RenewPChannelLease is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.RenewPChannelLeaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) RenewPChannelLease(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call {
+	return &MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call{Call: _e.mock.On("RenewPChannelLease",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call) Run(run func(ctx context.Context, in *streamingpb.RenewPChannelLeaseRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.RenewPChannelLeaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call) Return(_a0 *streamingpb.RenewPChannelLeaseResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call) RunAndReturn(run func(context.Context, *streamingpb.RenewPChannelLeaseRequest, ...grpc.CallOption) (*streamingpb.RenewPChannelLeaseResponse, error)) *MockStreamingCoordAssignmentServiceClient_RenewPChannelLease_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequeueReplicateDeadLetters provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) RequeueReplicateDeadLetters(ctx context.Context, in *streamingpb.RequeueReplicateDeadLettersRequest, opts ...grpc.CallOption) (*streamingpb.RequeueReplicateDeadLettersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueReplicateDeadLetters")
+	}
+
+	var r0 *streamingpb.RequeueReplicateDeadLettersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest, ...grpc.CallOption) (*streamingpb.RequeueReplicateDeadLettersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest, ...grpc.CallOption) *streamingpb.RequeueReplicateDeadLettersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.RequeueReplicateDeadLettersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequeueReplicateDeadLetters'
+type MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call struct {
+	*mock.Call
+}
+
+// RequeueReplicateDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.RequeueReplicateDeadLettersRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) RequeueReplicateDeadLetters(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call {
+	return &MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call{Call: _e.mock.On("RequeueReplicateDeadLetters",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call) Run(run func(ctx context.Context, in *streamingpb.RequeueReplicateDeadLettersRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.RequeueReplicateDeadLettersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call) Return(_a0 *streamingpb.RequeueReplicateDeadLettersResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call) RunAndReturn(run func(context.Context, *streamingpb.RequeueReplicateDeadLettersRequest, ...grpc.CallOption) (*streamingpb.RequeueReplicateDeadLettersResponse, error)) *MockStreamingCoordAssignmentServiceClient_RequeueReplicateDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetReplicateCheckpoint provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) ResetReplicateCheckpoint(ctx context.Context, in *streamingpb.ResetReplicateCheckpointRequest, opts ...grpc.CallOption) (*streamingpb.ResetReplicateCheckpointResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetReplicateCheckpoint")
+	}
+
+	var r0 *streamingpb.ResetReplicateCheckpointResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest, ...grpc.CallOption) (*streamingpb.ResetReplicateCheckpointResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest, ...grpc.CallOption) *streamingpb.ResetReplicateCheckpointResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ResetReplicateCheckpointResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ResetReplicateCheckpointRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetReplicateCheckpoint'
+type MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call struct {
+	*mock.Call
+}
+
+// ResetReplicateCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.ResetReplicateCheckpointRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) ResetReplicateCheckpoint(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call {
+	return &MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call{Call: _e.mock.On("ResetReplicateCheckpoint",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call) Run(run func(ctx context.Context, in *streamingpb.ResetReplicateCheckpointRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.ResetReplicateCheckpointRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call) Return(_a0 *streamingpb.ResetReplicateCheckpointResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call) RunAndReturn(run func(context.Context, *streamingpb.ResetReplicateCheckpointRequest, ...grpc.CallOption) (*streamingpb.ResetReplicateCheckpointResponse, error)) *MockStreamingCoordAssignmentServiceClient_ResetReplicateCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelAntiAffinityGroups provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) UpdatePChannelAntiAffinityGroups(ctx context.Context, in *streamingpb.UpdatePChannelAntiAffinityGroupsRequest, opts ...grpc.CallOption) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelAntiAffinityGroups")
+	}
+
+	var r0 *streamingpb.UpdatePChannelAntiAffinityGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest, ...grpc.CallOption) *streamingpb.UpdatePChannelAntiAffinityGroupsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelAntiAffinityGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelAntiAffinityGroups'
+type MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelAntiAffinityGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.UpdatePChannelAntiAffinityGroupsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) UpdatePChannelAntiAffinityGroups(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call {
+	return &MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call{Call: _e.mock.On("UpdatePChannelAntiAffinityGroups",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call) Run(run func(ctx context.Context, in *streamingpb.UpdatePChannelAntiAffinityGroupsRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelAntiAffinityGroupsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call) Return(_a0 *streamingpb.UpdatePChannelAntiAffinityGroupsResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelAntiAffinityGroupsRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelAntiAffinityGroupsResponse, error)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelAntiAffinityGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelPins provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) UpdatePChannelPins(ctx context.Context, in *streamingpb.UpdatePChannelPinsRequest, opts ...grpc.CallOption) (*streamingpb.UpdatePChannelPinsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelPins")
+	}
+
+	var r0 *streamingpb.UpdatePChannelPinsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelPinsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest, ...grpc.CallOption) *streamingpb.UpdatePChannelPinsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelPinsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelPinsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelPins'
+type MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelPins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.UpdatePChannelPinsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) UpdatePChannelPins(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call {
+	return &MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call{Call: _e.mock.On("UpdatePChannelPins",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call) Run(run func(ctx context.Context, in *streamingpb.UpdatePChannelPinsRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelPinsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call) Return(_a0 *streamingpb.UpdatePChannelPinsResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelPinsRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelPinsResponse, error)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelPools provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) UpdatePChannelPools(ctx context.Context, in *streamingpb.UpdatePChannelPoolsRequest, opts ...grpc.CallOption) (*streamingpb.UpdatePChannelPoolsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelPools")
+	}
+
+	var r0 *streamingpb.UpdatePChannelPoolsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelPoolsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest, ...grpc.CallOption) *streamingpb.UpdatePChannelPoolsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelPoolsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelPoolsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelPools'
+type MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelPools is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.UpdatePChannelPoolsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) UpdatePChannelPools(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call {
+	return &MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call{Call: _e.mock.On("UpdatePChannelPools",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call) Run(run func(ctx context.Context, in *streamingpb.UpdatePChannelPoolsRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelPoolsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call) Return(_a0 *streamingpb.UpdatePChannelPoolsResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelPoolsRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelPoolsResponse, error)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelPools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePChannelRetentionPolicies provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) UpdatePChannelRetentionPolicies(ctx context.Context, in *streamingpb.UpdatePChannelRetentionPoliciesRequest, opts ...grpc.CallOption) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePChannelRetentionPolicies")
+	}
+
+	var r0 *streamingpb.UpdatePChannelRetentionPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest, ...grpc.CallOption) *streamingpb.UpdatePChannelRetentionPoliciesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdatePChannelRetentionPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePChannelRetentionPolicies'
+type MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call struct {
+	*mock.Call
+}
+
+// UpdatePChannelRetentionPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.UpdatePChannelRetentionPoliciesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) UpdatePChannelRetentionPolicies(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call {
+	return &MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call{Call: _e.mock.On("UpdatePChannelRetentionPolicies",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call) Run(run func(ctx context.Context, in *streamingpb.UpdatePChannelRetentionPoliciesRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdatePChannelRetentionPoliciesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call) Return(_a0 *streamingpb.UpdatePChannelRetentionPoliciesResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdatePChannelRetentionPoliciesRequest, ...grpc.CallOption) (*streamingpb.UpdatePChannelRetentionPoliciesResponse, error)) *MockStreamingCoordAssignmentServiceClient_UpdatePChannelRetentionPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReplicateClusterConnection provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) UpdateReplicateClusterConnection(ctx context.Context, in *streamingpb.UpdateReplicateClusterConnectionRequest, opts ...grpc.CallOption) (*streamingpb.UpdateReplicateClusterConnectionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReplicateClusterConnection")
+	}
+
+	var r0 *streamingpb.UpdateReplicateClusterConnectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest, ...grpc.CallOption) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest, ...grpc.CallOption) *streamingpb.UpdateReplicateClusterConnectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdateReplicateClusterConnectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicateClusterConnection'
+type MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call struct {
+	*mock.Call
+}
+
+// UpdateReplicateClusterConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.UpdateReplicateClusterConnectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) UpdateReplicateClusterConnection(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call {
+	return &MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call{Call: _e.mock.On("UpdateReplicateClusterConnection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call) Run(run func(ctx context.Context, in *streamingpb.UpdateReplicateClusterConnectionRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateReplicateClusterConnectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call) Return(_a0 *streamingpb.UpdateReplicateClusterConnectionResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateReplicateClusterConnectionRequest, ...grpc.CallOption) (*streamingpb.UpdateReplicateClusterConnectionResponse, error)) *MockStreamingCoordAssignmentServiceClient_UpdateReplicateClusterConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateReplicateConfiguration provides a mock function with given fields: ctx, in, opts
 func (_m *MockStreamingCoordAssignmentServiceClient) UpdateReplicateConfiguration(ctx context.Context, in *streamingpb.UpdateReplicateConfigurationRequest, opts ...grpc.CallOption) (*streamingpb.UpdateReplicateConfigurationResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -172,6 +1652,80 @@ func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicateConfiguration
 	return _c
 }
 
+// UpdateReplicatingTaskState provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) UpdateReplicatingTaskState(ctx context.Context, in *streamingpb.UpdateReplicatingTaskStateRequest, opts ...grpc.CallOption) (*streamingpb.UpdateReplicatingTaskStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReplicatingTaskState")
+	}
+
+	var r0 *streamingpb.UpdateReplicatingTaskStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest, ...grpc.CallOption) (*streamingpb.UpdateReplicatingTaskStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest, ...grpc.CallOption) *streamingpb.UpdateReplicatingTaskStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.UpdateReplicatingTaskStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReplicatingTaskState'
+type MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call struct {
+	*mock.Call
+}
+
+// UpdateReplicatingTaskState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.UpdateReplicatingTaskStateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) UpdateReplicatingTaskState(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call {
+	return &MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call{Call: _e.mock.On("UpdateReplicatingTaskState",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call) Run(run func(ctx context.Context, in *streamingpb.UpdateReplicatingTaskStateRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.UpdateReplicatingTaskStateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call) Return(_a0 *streamingpb.UpdateReplicatingTaskStateResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call) RunAndReturn(run func(context.Context, *streamingpb.UpdateReplicatingTaskStateRequest, ...grpc.CallOption) (*streamingpb.UpdateReplicatingTaskStateResponse, error)) *MockStreamingCoordAssignmentServiceClient_UpdateReplicatingTaskState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWALBalancePolicy provides a mock function with given fields: ctx, in, opts
 func (_m *MockStreamingCoordAssignmentServiceClient) UpdateWALBalancePolicy(ctx context.Context, in *streamingpb.UpdateWALBalancePolicyRequest, opts ...grpc.CallOption) (*streamingpb.UpdateWALBalancePolicyResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -246,6 +1800,80 @@ func (_c *MockStreamingCoordAssignmentServiceClient_UpdateWALBalancePolicy_Call)
 	return _c
 }
 
+// ValidateReplicateConfiguration provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordAssignmentServiceClient) ValidateReplicateConfiguration(ctx context.Context, in *streamingpb.ValidateReplicateConfigurationRequest, opts ...grpc.CallOption) (*streamingpb.ValidateReplicateConfigurationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateReplicateConfiguration")
+	}
+
+	var r0 *streamingpb.ValidateReplicateConfigurationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest, ...grpc.CallOption) (*streamingpb.ValidateReplicateConfigurationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest, ...grpc.CallOption) *streamingpb.ValidateReplicateConfigurationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.ValidateReplicateConfigurationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateReplicateConfiguration'
+type MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call struct {
+	*mock.Call
+}
+
+// ValidateReplicateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.ValidateReplicateConfigurationRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordAssignmentServiceClient_Expecter) ValidateReplicateConfiguration(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call {
+	return &MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call{Call: _e.mock.On("ValidateReplicateConfiguration",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call) Run(run func(ctx context.Context, in *streamingpb.ValidateReplicateConfigurationRequest, opts ...grpc.CallOption)) *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.ValidateReplicateConfigurationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call) Return(_a0 *streamingpb.ValidateReplicateConfigurationResponse, _a1 error) *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call) RunAndReturn(run func(context.Context, *streamingpb.ValidateReplicateConfigurationRequest, ...grpc.CallOption) (*streamingpb.ValidateReplicateConfigurationResponse, error)) *MockStreamingCoordAssignmentServiceClient_ValidateReplicateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStreamingCoordAssignmentServiceClient creates a new instance of MockStreamingCoordAssignmentServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStreamingCoordAssignmentServiceClient(t interface {
@@ -99,6 +99,80 @@ func (_c *MockStreamingCoordBroadcastServiceClient_Ack_Call) RunAndReturn(run fu
 	return _c
 }
 
+// BeginCrossVChannelTxn provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordBroadcastServiceClient) BeginCrossVChannelTxn(ctx context.Context, in *streamingpb.BeginCrossVChannelTxnRequest, opts ...grpc.CallOption) (*streamingpb.BeginCrossVChannelTxnResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginCrossVChannelTxn")
+	}
+
+	var r0 *streamingpb.BeginCrossVChannelTxnResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.BeginCrossVChannelTxnRequest, ...grpc.CallOption) (*streamingpb.BeginCrossVChannelTxnResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.BeginCrossVChannelTxnRequest, ...grpc.CallOption) *streamingpb.BeginCrossVChannelTxnResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.BeginCrossVChannelTxnResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.BeginCrossVChannelTxnRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginCrossVChannelTxn'
+type MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// BeginCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.BeginCrossVChannelTxnRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordBroadcastServiceClient_Expecter) BeginCrossVChannelTxn(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call {
+	return &MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call{Call: _e.mock.On("BeginCrossVChannelTxn",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call) Run(run func(ctx context.Context, in *streamingpb.BeginCrossVChannelTxnRequest, opts ...grpc.CallOption)) *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.BeginCrossVChannelTxnRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call) Return(_a0 *streamingpb.BeginCrossVChannelTxnResponse, _a1 error) *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, *streamingpb.BeginCrossVChannelTxnRequest, ...grpc.CallOption) (*streamingpb.BeginCrossVChannelTxnResponse, error)) *MockStreamingCoordBroadcastServiceClient_BeginCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Broadcast provides a mock function with given fields: ctx, in, opts
 func (_m *MockStreamingCoordBroadcastServiceClient) Broadcast(ctx context.Context, in *streamingpb.BroadcastRequest, opts ...grpc.CallOption) (*streamingpb.BroadcastResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -173,6 +247,228 @@ func (_c *MockStreamingCoordBroadcastServiceClient_Broadcast_Call) RunAndReturn(
 	return _c
 }
 
+// CommitCrossVChannelTxn provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordBroadcastServiceClient) CommitCrossVChannelTxn(ctx context.Context, in *streamingpb.CommitCrossVChannelTxnRequest, opts ...grpc.CallOption) (*streamingpb.CommitCrossVChannelTxnResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitCrossVChannelTxn")
+	}
+
+	var r0 *streamingpb.CommitCrossVChannelTxnResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CommitCrossVChannelTxnRequest, ...grpc.CallOption) (*streamingpb.CommitCrossVChannelTxnResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.CommitCrossVChannelTxnRequest, ...grpc.CallOption) *streamingpb.CommitCrossVChannelTxnResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.CommitCrossVChannelTxnResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.CommitCrossVChannelTxnRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitCrossVChannelTxn'
+type MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// CommitCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.CommitCrossVChannelTxnRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordBroadcastServiceClient_Expecter) CommitCrossVChannelTxn(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call {
+	return &MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call{Call: _e.mock.On("CommitCrossVChannelTxn",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call) Run(run func(ctx context.Context, in *streamingpb.CommitCrossVChannelTxnRequest, opts ...grpc.CallOption)) *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.CommitCrossVChannelTxnRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call) Return(_a0 *streamingpb.CommitCrossVChannelTxnResponse, _a1 error) *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, *streamingpb.CommitCrossVChannelTxnRequest, ...grpc.CallOption) (*streamingpb.CommitCrossVChannelTxnResponse, error)) *MockStreamingCoordBroadcastServiceClient_CommitCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackCrossVChannelTxn provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordBroadcastServiceClient) RollbackCrossVChannelTxn(ctx context.Context, in *streamingpb.RollbackCrossVChannelTxnRequest, opts ...grpc.CallOption) (*streamingpb.RollbackCrossVChannelTxnResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackCrossVChannelTxn")
+	}
+
+	var r0 *streamingpb.RollbackCrossVChannelTxnResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RollbackCrossVChannelTxnRequest, ...grpc.CallOption) (*streamingpb.RollbackCrossVChannelTxnResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.RollbackCrossVChannelTxnRequest, ...grpc.CallOption) *streamingpb.RollbackCrossVChannelTxnResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.RollbackCrossVChannelTxnResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.RollbackCrossVChannelTxnRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackCrossVChannelTxn'
+type MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call struct {
+	*mock.Call
+}
+
+// RollbackCrossVChannelTxn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.RollbackCrossVChannelTxnRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordBroadcastServiceClient_Expecter) RollbackCrossVChannelTxn(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call {
+	return &MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call{Call: _e.mock.On("RollbackCrossVChannelTxn",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call) Run(run func(ctx context.Context, in *streamingpb.RollbackCrossVChannelTxnRequest, opts ...grpc.CallOption)) *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.RollbackCrossVChannelTxnRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call) Return(_a0 *streamingpb.RollbackCrossVChannelTxnResponse, _a1 error) *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call) RunAndReturn(run func(context.Context, *streamingpb.RollbackCrossVChannelTxnRequest, ...grpc.CallOption) (*streamingpb.RollbackCrossVChannelTxnResponse, error)) *MockStreamingCoordBroadcastServiceClient_RollbackCrossVChannelTxn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Watch provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingCoordBroadcastServiceClient) Watch(ctx context.Context, in *streamingpb.BroadcastWatchRequest, opts ...grpc.CallOption) (streamingpb.StreamingCoordBroadcastService_WatchClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 streamingpb.StreamingCoordBroadcastService_WatchClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.BroadcastWatchRequest, ...grpc.CallOption) (streamingpb.StreamingCoordBroadcastService_WatchClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.BroadcastWatchRequest, ...grpc.CallOption) streamingpb.StreamingCoordBroadcastService_WatchClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(streamingpb.StreamingCoordBroadcastService_WatchClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.BroadcastWatchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingCoordBroadcastServiceClient_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type MockStreamingCoordBroadcastServiceClient_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.BroadcastWatchRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingCoordBroadcastServiceClient_Expecter) Watch(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingCoordBroadcastServiceClient_Watch_Call {
+	return &MockStreamingCoordBroadcastServiceClient_Watch_Call{Call: _e.mock.On("Watch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_Watch_Call) Run(run func(ctx context.Context, in *streamingpb.BroadcastWatchRequest, opts ...grpc.CallOption)) *MockStreamingCoordBroadcastServiceClient_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.BroadcastWatchRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_Watch_Call) Return(_a0 streamingpb.StreamingCoordBroadcastService_WatchClient, _a1 error) *MockStreamingCoordBroadcastServiceClient_Watch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingCoordBroadcastServiceClient_Watch_Call) RunAndReturn(run func(context.Context, *streamingpb.BroadcastWatchRequest, ...grpc.CallOption) (streamingpb.StreamingCoordBroadcastService_WatchClient, error)) *MockStreamingCoordBroadcastServiceClient_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStreamingCoordBroadcastServiceClient creates a new instance of MockStreamingCoordBroadcastServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStreamingCoordBroadcastServiceClient(t interface {
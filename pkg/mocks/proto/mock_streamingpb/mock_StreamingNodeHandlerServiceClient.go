@@ -319,6 +319,80 @@ func (_c *MockStreamingNodeHandlerServiceClient_Produce_Call) RunAndReturn(run f
 	return _c
 }
 
+// TruncateWAL provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingNodeHandlerServiceClient) TruncateWAL(ctx context.Context, in *streamingpb.TruncateWALRequest, opts ...grpc.CallOption) (*streamingpb.TruncateWALResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TruncateWAL")
+	}
+
+	var r0 *streamingpb.TruncateWALResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.TruncateWALRequest, ...grpc.CallOption) (*streamingpb.TruncateWALResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.TruncateWALRequest, ...grpc.CallOption) *streamingpb.TruncateWALResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.TruncateWALResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.TruncateWALRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingNodeHandlerServiceClient_TruncateWAL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateWAL'
+type MockStreamingNodeHandlerServiceClient_TruncateWAL_Call struct {
+	*mock.Call
+}
+
+// TruncateWAL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.TruncateWALRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingNodeHandlerServiceClient_Expecter) TruncateWAL(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call {
+	return &MockStreamingNodeHandlerServiceClient_TruncateWAL_Call{Call: _e.mock.On("TruncateWAL",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call) Run(run func(ctx context.Context, in *streamingpb.TruncateWALRequest, opts ...grpc.CallOption)) *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.TruncateWALRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call) Return(_a0 *streamingpb.TruncateWALResponse, _a1 error) *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call) RunAndReturn(run func(context.Context, *streamingpb.TruncateWALRequest, ...grpc.CallOption) (*streamingpb.TruncateWALResponse, error)) *MockStreamingNodeHandlerServiceClient_TruncateWAL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStreamingNodeHandlerServiceClient creates a new instance of MockStreamingNodeHandlerServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStreamingNodeHandlerServiceClient(t interface {
@@ -247,6 +247,80 @@ func (_c *MockStreamingNodeManagerServiceClient_Remove_Call) RunAndReturn(run fu
 	return _c
 }
 
+// UpdateAppendQuotas provides a mock function with given fields: ctx, in, opts
+func (_m *MockStreamingNodeManagerServiceClient) UpdateAppendQuotas(ctx context.Context, in *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest, opts ...grpc.CallOption) (*streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppendQuotas")
+	}
+
+	var r0 *streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest, ...grpc.CallOption) (*streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest, ...grpc.CallOption) *streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAppendQuotas'
+type MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call struct {
+	*mock.Call
+}
+
+// UpdateAppendQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest
+//   - opts ...grpc.CallOption
+func (_e *MockStreamingNodeManagerServiceClient_Expecter) UpdateAppendQuotas(ctx interface{}, in interface{}, opts ...interface{}) *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call {
+	return &MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call{Call: _e.mock.On("UpdateAppendQuotas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call) Run(run func(ctx context.Context, in *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest, opts ...grpc.CallOption)) *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call) Return(_a0 *streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse, _a1 error) *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call) RunAndReturn(run func(context.Context, *streamingpb.StreamingNodeManagerUpdateAppendQuotasRequest, ...grpc.CallOption) (*streamingpb.StreamingNodeManagerUpdateAppendQuotasResponse, error)) *MockStreamingNodeManagerServiceClient_UpdateAppendQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStreamingNodeManagerServiceClient creates a new instance of MockStreamingNodeManagerServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStreamingNodeManagerServiceClient(t interface {
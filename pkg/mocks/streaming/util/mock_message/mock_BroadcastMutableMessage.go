@@ -821,6 +821,54 @@ func (_c *MockBroadcastMutableMessage_Version_Call) RunAndReturn(run func() mess
 	return _c
 }
 
+// WithBroadcastBarrierTimeTick provides a mock function with given fields: tt
+func (_m *MockBroadcastMutableMessage) WithBroadcastBarrierTimeTick(tt uint64) message.BroadcastMutableMessage {
+	ret := _m.Called(tt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithBroadcastBarrierTimeTick")
+	}
+
+	var r0 message.BroadcastMutableMessage
+	if rf, ok := ret.Get(0).(func(uint64) message.BroadcastMutableMessage); ok {
+		r0 = rf(tt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(message.BroadcastMutableMessage)
+		}
+	}
+
+	return r0
+}
+
+// MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithBroadcastBarrierTimeTick'
+type MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call struct {
+	*mock.Call
+}
+
+// WithBroadcastBarrierTimeTick is a helper method to define mock.On call
+//   - tt uint64
+func (_e *MockBroadcastMutableMessage_Expecter) WithBroadcastBarrierTimeTick(tt interface{}) *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call {
+	return &MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call{Call: _e.mock.On("WithBroadcastBarrierTimeTick", tt)}
+}
+
+func (_c *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call) Run(run func(tt uint64)) *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call) Return(_a0 message.BroadcastMutableMessage) *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call) RunAndReturn(run func(uint64) message.BroadcastMutableMessage) *MockBroadcastMutableMessage_WithBroadcastBarrierTimeTick_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithBroadcastID provides a mock function with given fields: broadcastID
 func (_m *MockBroadcastMutableMessage) WithBroadcastID(broadcastID uint64) message.BroadcastMutableMessage {
 	ret := _m.Called(broadcastID)
@@ -31,6 +31,9 @@ type Producer interface {
 	// publish a message for new streaming service.
 	SendForStreamingService(message *common.ProducerMessage) (UniqueID, error)
 
+	// publish a batch of messages with one produce for new streaming service.
+	SendBatchForStreamingService(messages []*common.ProducerMessage) ([]UniqueID, error)
+
 	// Close a producer
 	Close()
 }
@@ -77,19 +77,25 @@ func (p *producer) Send(message *common.ProducerMessage) (UniqueID, error) {
 }
 
 func (p *producer) SendForStreamingService(message *common.ProducerMessage) (UniqueID, error) {
-	payload, err := marshalStreamingMessage(message)
-	if err != nil {
-		return 0, err
-	}
-	ids, err := p.c.server.Produce(p.topic, []server.ProducerMessage{{
-		Payload: payload,
-	}})
+	ids, err := p.SendBatchForStreamingService([]*common.ProducerMessage{message})
 	if err != nil {
 		return 0, err
 	}
 	return ids[0], nil
 }
 
+func (p *producer) SendBatchForStreamingService(messages []*common.ProducerMessage) ([]UniqueID, error) {
+	msgs := make([]server.ProducerMessage, 0, len(messages))
+	for _, message := range messages {
+		payload, err := marshalStreamingMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, server.ProducerMessage{Payload: payload})
+	}
+	return p.c.server.Produce(p.topic, msgs)
+}
+
 // Close destroy the topic of this producer in rocksmq
 func (p *producer) Close() {
 	err := p.c.server.DestroyTopic(p.topic)
@@ -191,6 +191,184 @@ func (BroadcastTaskState) EnumDescriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{2}
 }
 
+// CrossVChannelTxnState is the state of the cross vchannel transaction.
+type CrossVChannelTxnState int32
+
+const (
+	CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_UNKNOWN     CrossVChannelTxnState = 0 // should never used.
+	CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_PREPARING   CrossVChannelTxnState = 1 // the transaction is begun, the messages are appended into the vchannels.
+	CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_COMMITTING  CrossVChannelTxnState = 2 // the transaction is decided to commit, the commit message is being broadcast.
+	CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_ROLLINGBACK CrossVChannelTxnState = 3 // the transaction is decided to rollback, the rollback message is being broadcast.
+	CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_DONE        CrossVChannelTxnState = 4 // the commit or rollback message is broadcast, the persisted transaction can be cleared.
+)
+
+// Enum value maps for CrossVChannelTxnState.
+var (
+	CrossVChannelTxnState_name = map[int32]string{
+		0: "CROSS_VCHANNEL_TXN_STATE_UNKNOWN",
+		1: "CROSS_VCHANNEL_TXN_STATE_PREPARING",
+		2: "CROSS_VCHANNEL_TXN_STATE_COMMITTING",
+		3: "CROSS_VCHANNEL_TXN_STATE_ROLLINGBACK",
+		4: "CROSS_VCHANNEL_TXN_STATE_DONE",
+	}
+	CrossVChannelTxnState_value = map[string]int32{
+		"CROSS_VCHANNEL_TXN_STATE_UNKNOWN":     0,
+		"CROSS_VCHANNEL_TXN_STATE_PREPARING":   1,
+		"CROSS_VCHANNEL_TXN_STATE_COMMITTING":  2,
+		"CROSS_VCHANNEL_TXN_STATE_ROLLINGBACK": 3,
+		"CROSS_VCHANNEL_TXN_STATE_DONE":        4,
+	}
+)
+
+func (x CrossVChannelTxnState) Enum() *CrossVChannelTxnState {
+	p := new(CrossVChannelTxnState)
+	*p = x
+	return p
+}
+
+func (x CrossVChannelTxnState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CrossVChannelTxnState) Descriptor() protoreflect.EnumDescriptor {
+	return file_streaming_proto_enumTypes[3].Descriptor()
+}
+
+func (CrossVChannelTxnState) Type() protoreflect.EnumType {
+	return &file_streaming_proto_enumTypes[3]
+}
+
+func (x CrossVChannelTxnState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CrossVChannelTxnState.Descriptor instead.
+func (CrossVChannelTxnState) EnumDescriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{3}
+}
+
+// ReplicatingTaskRuntimeState is the runtime state of a replicating task observed by the streamingcoord.
+type ReplicatingTaskRuntimeState int32
+
+const (
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_UNKNOWN       ReplicatingTaskRuntimeState = 0 // the checkpoint cannot be fetched from the target cluster.
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PENDING       ReplicatingTaskRuntimeState = 1 // no replicated message is confirmed by the target cluster yet.
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_REPLICATING   ReplicatingTaskRuntimeState = 2 // the replicated messages are confirmed by the target cluster.
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PAUSED        ReplicatingTaskRuntimeState = 3 // the task is paused by the operator.
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_PARKED        ReplicatingTaskRuntimeState = 4 // the task is parked with a dead letter, waiting for requeue.
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_BOOTSTRAPPING ReplicatingTaskRuntimeState = 5 // the existing data is being copied into the target cluster, waiting for resume.
+	ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_CONFLICT      ReplicatingTaskRuntimeState = 6 // the checkpoint of the target cluster conflicts with the task, the checkpoint should be reset.
+)
+
+// Enum value maps for ReplicatingTaskRuntimeState.
+var (
+	ReplicatingTaskRuntimeState_name = map[int32]string{
+		0: "REPLICATING_TASK_RUNTIME_STATE_UNKNOWN",
+		1: "REPLICATING_TASK_RUNTIME_STATE_PENDING",
+		2: "REPLICATING_TASK_RUNTIME_STATE_REPLICATING",
+		3: "REPLICATING_TASK_RUNTIME_STATE_PAUSED",
+		4: "REPLICATING_TASK_RUNTIME_STATE_PARKED",
+		5: "REPLICATING_TASK_RUNTIME_STATE_BOOTSTRAPPING",
+		6: "REPLICATING_TASK_RUNTIME_STATE_CONFLICT",
+	}
+	ReplicatingTaskRuntimeState_value = map[string]int32{
+		"REPLICATING_TASK_RUNTIME_STATE_UNKNOWN":       0,
+		"REPLICATING_TASK_RUNTIME_STATE_PENDING":       1,
+		"REPLICATING_TASK_RUNTIME_STATE_REPLICATING":   2,
+		"REPLICATING_TASK_RUNTIME_STATE_PAUSED":        3,
+		"REPLICATING_TASK_RUNTIME_STATE_PARKED":        4,
+		"REPLICATING_TASK_RUNTIME_STATE_BOOTSTRAPPING": 5,
+		"REPLICATING_TASK_RUNTIME_STATE_CONFLICT":      6,
+	}
+)
+
+func (x ReplicatingTaskRuntimeState) Enum() *ReplicatingTaskRuntimeState {
+	p := new(ReplicatingTaskRuntimeState)
+	*p = x
+	return p
+}
+
+func (x ReplicatingTaskRuntimeState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplicatingTaskRuntimeState) Descriptor() protoreflect.EnumDescriptor {
+	return file_streaming_proto_enumTypes[4].Descriptor()
+}
+
+func (ReplicatingTaskRuntimeState) Type() protoreflect.EnumType {
+	return &file_streaming_proto_enumTypes[4]
+}
+
+func (x ReplicatingTaskRuntimeState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicatingTaskRuntimeState.Descriptor instead.
+func (ReplicatingTaskRuntimeState) EnumDescriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{4}
+}
+
+// ReplicateSchemaDriftType is the type of the drift between the source and target cluster.
+type ReplicateSchemaDriftType int32
+
+const (
+	ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_UNKNOWN ReplicateSchemaDriftType = 0
+	// the collection of source cluster is not found in the target cluster.
+	ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_MISSING ReplicateSchemaDriftType = 1
+	// the collection of target cluster is not found in the source cluster.
+	ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_UNEXPECTED ReplicateSchemaDriftType = 2
+	// the schema of the collection is different.
+	ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_SCHEMA_MISMATCH ReplicateSchemaDriftType = 3
+	// the vchannels of the collection are not mapped by the pchannel mapping of the replicate configuration.
+	ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_VCHANNEL_MISMATCH ReplicateSchemaDriftType = 4
+)
+
+// Enum value maps for ReplicateSchemaDriftType.
+var (
+	ReplicateSchemaDriftType_name = map[int32]string{
+		0: "REPLICATE_SCHEMA_DRIFT_TYPE_UNKNOWN",
+		1: "REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_MISSING",
+		2: "REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_UNEXPECTED",
+		3: "REPLICATE_SCHEMA_DRIFT_TYPE_SCHEMA_MISMATCH",
+		4: "REPLICATE_SCHEMA_DRIFT_TYPE_VCHANNEL_MISMATCH",
+	}
+	ReplicateSchemaDriftType_value = map[string]int32{
+		"REPLICATE_SCHEMA_DRIFT_TYPE_UNKNOWN":               0,
+		"REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_MISSING":    1,
+		"REPLICATE_SCHEMA_DRIFT_TYPE_COLLECTION_UNEXPECTED": 2,
+		"REPLICATE_SCHEMA_DRIFT_TYPE_SCHEMA_MISMATCH":       3,
+		"REPLICATE_SCHEMA_DRIFT_TYPE_VCHANNEL_MISMATCH":     4,
+	}
+)
+
+func (x ReplicateSchemaDriftType) Enum() *ReplicateSchemaDriftType {
+	p := new(ReplicateSchemaDriftType)
+	*p = x
+	return p
+}
+
+func (x ReplicateSchemaDriftType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplicateSchemaDriftType) Descriptor() protoreflect.EnumDescriptor {
+	return file_streaming_proto_enumTypes[5].Descriptor()
+}
+
+func (ReplicateSchemaDriftType) Type() protoreflect.EnumType {
+	return &file_streaming_proto_enumTypes[5]
+}
+
+func (x ReplicateSchemaDriftType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicateSchemaDriftType.Descriptor instead.
+func (ReplicateSchemaDriftType) EnumDescriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{5}
+}
+
 // StreamingCode is the error code for log internal component.
 type StreamingCode int32
 
@@ -212,6 +390,9 @@ const (
 	StreamingCode_STREAMING_CODE_WALNAME_MISMATCH          StreamingCode = 14  // walName mismatch
 	StreamingCode_STREAMING_CODE_SCHEMA_VERSION_MISMATCH   StreamingCode = 15  // wrong schema version
 	StreamingCode_STREAMING_CODE_RATE_LIMIT_REJECTED       StreamingCode = 16  // rate limit rejected
+	StreamingCode_STREAMING_CODE_SECONDARY_WRITE_REJECTED  StreamingCode = 17  // direct write is rejected on secondary cluster
+	StreamingCode_STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED StreamingCode = 18  // append quota of collection exceeded
+	StreamingCode_STREAMING_CODE_DUPLICATE_APPEND          StreamingCode = 19  // append is a duplicate of an append out of the deduplication window
 	StreamingCode_STREAMING_CODE_UNKNOWN                   StreamingCode = 999 // unknown error
 )
 
@@ -235,6 +416,9 @@ var (
 		14:  "STREAMING_CODE_WALNAME_MISMATCH",
 		15:  "STREAMING_CODE_SCHEMA_VERSION_MISMATCH",
 		16:  "STREAMING_CODE_RATE_LIMIT_REJECTED",
+		17:  "STREAMING_CODE_SECONDARY_WRITE_REJECTED",
+		18:  "STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED",
+		19:  "STREAMING_CODE_DUPLICATE_APPEND",
 		999: "STREAMING_CODE_UNKNOWN",
 	}
 	StreamingCode_value = map[string]int32{
@@ -255,6 +439,9 @@ var (
 		"STREAMING_CODE_WALNAME_MISMATCH":          14,
 		"STREAMING_CODE_SCHEMA_VERSION_MISMATCH":   15,
 		"STREAMING_CODE_RATE_LIMIT_REJECTED":       16,
+		"STREAMING_CODE_SECONDARY_WRITE_REJECTED":  17,
+		"STREAMING_CODE_COLLECTION_QUOTA_EXCEEDED": 18,
+		"STREAMING_CODE_DUPLICATE_APPEND":          19,
 		"STREAMING_CODE_UNKNOWN":                   999,
 	}
 )
@@ -270,11 +457,11 @@ func (x StreamingCode) String() string {
 }
 
 func (StreamingCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_streaming_proto_enumTypes[3].Descriptor()
+	return file_streaming_proto_enumTypes[6].Descriptor()
 }
 
 func (StreamingCode) Type() protoreflect.EnumType {
-	return &file_streaming_proto_enumTypes[3]
+	return &file_streaming_proto_enumTypes[6]
 }
 
 func (x StreamingCode) Number() protoreflect.EnumNumber {
@@ -283,7 +470,7 @@ func (x StreamingCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StreamingCode.Descriptor instead.
 func (StreamingCode) EnumDescriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{3}
+	return file_streaming_proto_rawDescGZIP(), []int{6}
 }
 
 type WALRateLimitState int32
@@ -322,11 +509,11 @@ func (x WALRateLimitState) String() string {
 }
 
 func (WALRateLimitState) Descriptor() protoreflect.EnumDescriptor {
-	return file_streaming_proto_enumTypes[4].Descriptor()
+	return file_streaming_proto_enumTypes[7].Descriptor()
 }
 
 func (WALRateLimitState) Type() protoreflect.EnumType {
-	return &file_streaming_proto_enumTypes[4]
+	return &file_streaming_proto_enumTypes[7]
 }
 
 func (x WALRateLimitState) Number() protoreflect.EnumNumber {
@@ -335,7 +522,7 @@ func (x WALRateLimitState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WALRateLimitState.Descriptor instead.
 func (WALRateLimitState) EnumDescriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{4}
+	return file_streaming_proto_rawDescGZIP(), []int{7}
 }
 
 // VChannelState is the state of vchannel
@@ -372,11 +559,11 @@ func (x VChannelState) String() string {
 }
 
 func (VChannelState) Descriptor() protoreflect.EnumDescriptor {
-	return file_streaming_proto_enumTypes[5].Descriptor()
+	return file_streaming_proto_enumTypes[8].Descriptor()
 }
 
 func (VChannelState) Type() protoreflect.EnumType {
-	return &file_streaming_proto_enumTypes[5]
+	return &file_streaming_proto_enumTypes[8]
 }
 
 func (x VChannelState) Number() protoreflect.EnumNumber {
@@ -385,7 +572,7 @@ func (x VChannelState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VChannelState.Descriptor instead.
 func (VChannelState) EnumDescriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{5}
+	return file_streaming_proto_rawDescGZIP(), []int{8}
 }
 
 // VChannelSchemaState is the state of vchannel schema.
@@ -422,11 +609,11 @@ func (x VChannelSchemaState) String() string {
 }
 
 func (VChannelSchemaState) Descriptor() protoreflect.EnumDescriptor {
-	return file_streaming_proto_enumTypes[6].Descriptor()
+	return file_streaming_proto_enumTypes[9].Descriptor()
 }
 
 func (VChannelSchemaState) Type() protoreflect.EnumType {
-	return &file_streaming_proto_enumTypes[6]
+	return &file_streaming_proto_enumTypes[9]
 }
 
 func (x VChannelSchemaState) Number() protoreflect.EnumNumber {
@@ -435,7 +622,7 @@ func (x VChannelSchemaState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VChannelSchemaState.Descriptor instead.
 func (VChannelSchemaState) EnumDescriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{6}
+	return file_streaming_proto_rawDescGZIP(), []int{9}
 }
 
 type SegmentAssignmentState int32
@@ -471,11 +658,11 @@ func (x SegmentAssignmentState) String() string {
 }
 
 func (SegmentAssignmentState) Descriptor() protoreflect.EnumDescriptor {
-	return file_streaming_proto_enumTypes[7].Descriptor()
+	return file_streaming_proto_enumTypes[10].Descriptor()
 }
 
 func (SegmentAssignmentState) Type() protoreflect.EnumType {
-	return &file_streaming_proto_enumTypes[7]
+	return &file_streaming_proto_enumTypes[10]
 }
 
 func (x SegmentAssignmentState) Number() protoreflect.EnumNumber {
@@ -484,7 +671,7 @@ func (x SegmentAssignmentState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SegmentAssignmentState.Descriptor instead.
 func (SegmentAssignmentState) EnumDescriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{7}
+	return file_streaming_proto_rawDescGZIP(), []int{10}
 }
 
 type AlterWALStage int32
@@ -520,11 +707,11 @@ func (x AlterWALStage) String() string {
 }
 
 func (AlterWALStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_streaming_proto_enumTypes[8].Descriptor()
+	return file_streaming_proto_enumTypes[11].Descriptor()
 }
 
 func (AlterWALStage) Type() protoreflect.EnumType {
-	return &file_streaming_proto_enumTypes[8]
+	return &file_streaming_proto_enumTypes[11]
 }
 
 func (x AlterWALStage) Number() protoreflect.EnumNumber {
@@ -533,7 +720,117 @@ func (x AlterWALStage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AlterWALStage.Descriptor instead.
 func (AlterWALStage) EnumDescriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{8}
+	return file_streaming_proto_rawDescGZIP(), []int{11}
+}
+
+// ReplicateCheckpointResetPolicy is the policy to reset the start position of a replicating task.
+type ReplicateCheckpointResetPolicy int32
+
+const (
+	// no reset, the replication starts from the checkpoint of the target cluster,
+	// or the initialized checkpoint if the target cluster has no checkpoint.
+	ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_NONE ReplicateCheckpointResetPolicy = 0
+	// the replication starts from the initialized checkpoint, which is set by the reset.
+	ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT ReplicateCheckpointResetPolicy = 1
+	// the replication starts from the earliest message retained by the source wal.
+	ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST ReplicateCheckpointResetPolicy = 2
+	// the replication starts from the latest message of the source wal.
+	ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_LATEST ReplicateCheckpointResetPolicy = 3
+)
+
+// Enum value maps for ReplicateCheckpointResetPolicy.
+var (
+	ReplicateCheckpointResetPolicy_name = map[int32]string{
+		0: "REPLICATE_CHECKPOINT_RESET_POLICY_NONE",
+		1: "REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT",
+		2: "REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST",
+		3: "REPLICATE_CHECKPOINT_RESET_POLICY_LATEST",
+	}
+	ReplicateCheckpointResetPolicy_value = map[string]int32{
+		"REPLICATE_CHECKPOINT_RESET_POLICY_NONE":       0,
+		"REPLICATE_CHECKPOINT_RESET_POLICY_CHECKPOINT": 1,
+		"REPLICATE_CHECKPOINT_RESET_POLICY_EARLIEST":   2,
+		"REPLICATE_CHECKPOINT_RESET_POLICY_LATEST":     3,
+	}
+)
+
+func (x ReplicateCheckpointResetPolicy) Enum() *ReplicateCheckpointResetPolicy {
+	p := new(ReplicateCheckpointResetPolicy)
+	*p = x
+	return p
+}
+
+func (x ReplicateCheckpointResetPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplicateCheckpointResetPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_streaming_proto_enumTypes[12].Descriptor()
+}
+
+func (ReplicateCheckpointResetPolicy) Type() protoreflect.EnumType {
+	return &file_streaming_proto_enumTypes[12]
+}
+
+func (x ReplicateCheckpointResetPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicateCheckpointResetPolicy.Descriptor instead.
+func (ReplicateCheckpointResetPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{12}
+}
+
+// ReplicatePChannelTaskState is the state of a replicating task.
+type ReplicatePChannelTaskState int32
+
+const (
+	ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING ReplicatePChannelTaskState = 0 // the task is replicated by the cdc.
+	ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_PAUSED  ReplicatePChannelTaskState = 1 // the task is paused by the operator, the replication is resumed from the checkpoint of the target cluster.
+	// the existing data of the source cluster is being copied into the target cluster, the task is created in this state if the bootstrap is enabled.
+	// the cdc doesn't replicate the task until it's resumed, then the replication starts from the initialized checkpoint.
+	ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING ReplicatePChannelTaskState = 2
+)
+
+// Enum value maps for ReplicatePChannelTaskState.
+var (
+	ReplicatePChannelTaskState_name = map[int32]string{
+		0: "REPLICATE_PCHANNEL_TASK_STATE_RUNNING",
+		1: "REPLICATE_PCHANNEL_TASK_STATE_PAUSED",
+		2: "REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING",
+	}
+	ReplicatePChannelTaskState_value = map[string]int32{
+		"REPLICATE_PCHANNEL_TASK_STATE_RUNNING":       0,
+		"REPLICATE_PCHANNEL_TASK_STATE_PAUSED":        1,
+		"REPLICATE_PCHANNEL_TASK_STATE_BOOTSTRAPPING": 2,
+	}
+)
+
+func (x ReplicatePChannelTaskState) Enum() *ReplicatePChannelTaskState {
+	p := new(ReplicatePChannelTaskState)
+	*p = x
+	return p
+}
+
+func (x ReplicatePChannelTaskState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplicatePChannelTaskState) Descriptor() protoreflect.EnumDescriptor {
+	return file_streaming_proto_enumTypes[13].Descriptor()
+}
+
+func (ReplicatePChannelTaskState) Type() protoreflect.EnumType {
+	return &file_streaming_proto_enumTypes[13]
+}
+
+func (x ReplicatePChannelTaskState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicatePChannelTaskState.Descriptor instead.
+func (ReplicatePChannelTaskState) EnumDescriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{13}
 }
 
 // PChannelInfo is the information of a pchannel info, should only keep the
@@ -548,6 +845,7 @@ type PChannelInfo struct {
 	// recovered or moved to another streamingnode, the term
 	// will increase by meta server.
 	AccessMode PChannelAccessMode `protobuf:"varint,3,opt,name=access_mode,json=accessMode,proto3,enum=milvus.proto.streaming.PChannelAccessMode" json:"access_mode,omitempty"` // access mode of the channel.
+	Topic      string             `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`                                                                             // the topic of mq that backs the channel, the channel name is used as the topic if empty.
 }
 
 func (x *PChannelInfo) Reset() {
@@ -603,6 +901,13 @@ func (x *PChannelInfo) GetAccessMode() PChannelAccessMode {
 	return PChannelAccessMode_PCHANNEL_ACCESS_READWRITE
 }
 
+func (x *PChannelInfo) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
 // PChannelAssignmentLog is the log of meta information of a pchannel, should
 // only keep the data that is necessary to persistent.
 type PChannelAssignmentLog struct {
@@ -610,9 +915,10 @@ type PChannelAssignmentLog struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Term       int64              `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`                                                                              // term when server assigned.
-	Node       *StreamingNodeInfo `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                                                                               // streaming node that the channel is assigned to.
-	AccessMode PChannelAccessMode `protobuf:"varint,3,opt,name=access_mode,json=accessMode,proto3,enum=milvus.proto.streaming.PChannelAccessMode" json:"access_mode,omitempty"` // access mode of the channel.
+	Term                     int64              `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`                                                                              // term when server assigned.
+	Node                     *StreamingNodeInfo `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`                                                                               // streaming node that the channel is assigned to.
+	AccessMode               PChannelAccessMode `protobuf:"varint,3,opt,name=access_mode,json=accessMode,proto3,enum=milvus.proto.streaming.PChannelAccessMode" json:"access_mode,omitempty"` // access mode of the channel.
+	AssignedTimestampSeconds uint64             `protobuf:"varint,4,opt,name=assigned_timestamp_seconds,json=assignedTimestampSeconds,proto3" json:"assigned_timestamp_seconds,omitempty"`    // the timestamp in seconds when the assignment is done, only set in ownership histories.
 }
 
 func (x *PChannelAssignmentLog) Reset() {
@@ -668,6 +974,13 @@ func (x *PChannelAssignmentLog) GetAccessMode() PChannelAccessMode {
 	return PChannelAccessMode_PCHANNEL_ACCESS_READWRITE
 }
 
+func (x *PChannelAssignmentLog) GetAssignedTimestampSeconds() uint64 {
+	if x != nil {
+		return x.AssignedTimestampSeconds
+	}
+	return 0
+}
+
 // PChannelMeta is the meta information of a pchannel, should only keep the data
 // that is necessary to persistent. It's only used in meta, so do not use it in
 // rpc.
@@ -681,6 +994,9 @@ type PChannelMeta struct {
 	State                      PChannelMetaState        `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.streaming.PChannelMetaState" json:"state,omitempty"`                                   // state of the channel.
 	Histories                  []*PChannelAssignmentLog `protobuf:"bytes,4,rep,name=histories,proto3" json:"histories,omitempty"`                                                                          // keep the meta info assignment log that used to be assigned to.
 	LastAssignTimestampSeconds uint64                   `protobuf:"varint,5,opt,name=last_assign_timestamp_seconds,json=lastAssignTimestampSeconds,proto3" json:"last_assign_timestamp_seconds,omitempty"` // The last assigned timestamp in seconds.
+	PinnedServerId             int64                    `protobuf:"varint,6,opt,name=pinned_server_id,json=pinnedServerId,proto3" json:"pinned_server_id,omitempty"`                                       // The server id of streaming node that the pchannel is pinned to by operator, 0 if not pinned.
+	OwnershipHistories         []*PChannelAssignmentLog `protobuf:"bytes,7,rep,name=ownership_histories,json=ownershipHistories,proto3" json:"ownership_histories,omitempty"`                              // the finished assignments of the channel ordered by term, trimmed by the retention of assignment history.
+	RetentionPolicy            *WALRetentionPolicy      `protobuf:"bytes,8,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`                                       // the retention policy of the wal of the pchannel set by operator, nil if not set.
 }
 
 func (x *PChannelMeta) Reset() {
@@ -750,17 +1066,43 @@ func (x *PChannelMeta) GetLastAssignTimestampSeconds() uint64 {
 	return 0
 }
 
-// CChannelMeta is the meta information of a control channel.
-type CChannelMeta struct {
+func (x *PChannelMeta) GetPinnedServerId() int64 {
+	if x != nil {
+		return x.PinnedServerId
+	}
+	return 0
+}
+
+func (x *PChannelMeta) GetOwnershipHistories() []*PChannelAssignmentLog {
+	if x != nil {
+		return x.OwnershipHistories
+	}
+	return nil
+}
+
+func (x *PChannelMeta) GetRetentionPolicy() *WALRetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+// WALRetentionPolicy is the retention policy of the wal of a pchannel.
+// The wal is truncated once the messages are out of the retention window
+// and all consumers (flusher, replicating tasks) have confirmed the checkpoints beyond the cut point.
+// A zero bound doesn't limit the window, a message is out of the window once any non-zero bound is exceeded.
+// The wal without retention policy is truncated as soon as all consumers confirm the checkpoints.
+type WALRetentionPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel string `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // the pchannel that control channel locate on.
+	TtlSeconds uint64 `protobuf:"varint,1,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // the messages older than the ttl are out of the window, 0 if not bounded by time.
+	MaxBytes   uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`       // the messages beyond the latest max bytes of the wal are out of the window, 0 if not bounded by size.
 }
 
-func (x *CChannelMeta) Reset() {
-	*x = CChannelMeta{}
+func (x *WALRetentionPolicy) Reset() {
+	*x = WALRetentionPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -768,13 +1110,13 @@ func (x *CChannelMeta) Reset() {
 	}
 }
 
-func (x *CChannelMeta) String() string {
+func (x *WALRetentionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CChannelMeta) ProtoMessage() {}
+func (*WALRetentionPolicy) ProtoMessage() {}
 
-func (x *CChannelMeta) ProtoReflect() protoreflect.Message {
+func (x *WALRetentionPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -786,29 +1128,37 @@ func (x *CChannelMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CChannelMeta.ProtoReflect.Descriptor instead.
-func (*CChannelMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use WALRetentionPolicy.ProtoReflect.Descriptor instead.
+func (*WALRetentionPolicy) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CChannelMeta) GetPchannel() string {
+func (x *WALRetentionPolicy) GetTtlSeconds() uint64 {
 	if x != nil {
-		return x.Pchannel
+		return x.TtlSeconds
 	}
-	return ""
+	return 0
 }
 
-// StreamingVersion is the version of the streaming service.
-type StreamingVersion struct {
+func (x *WALRetentionPolicy) GetMaxBytes() uint64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+// PChannelRetentionPolicy is the retention policy of a pchannel.
+type PChannelRetentionPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // version of the streaming,
+	Pchannel string              `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // the name of pchannel.
+	Policy   *WALRetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`     // the retention policy of the pchannel.
 }
 
-func (x *StreamingVersion) Reset() {
-	*x = StreamingVersion{}
+func (x *PChannelRetentionPolicy) Reset() {
+	*x = PChannelRetentionPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -816,13 +1166,13 @@ func (x *StreamingVersion) Reset() {
 	}
 }
 
-func (x *StreamingVersion) String() string {
+func (x *PChannelRetentionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingVersion) ProtoMessage() {}
+func (*PChannelRetentionPolicy) ProtoMessage() {}
 
-func (x *StreamingVersion) ProtoReflect() protoreflect.Message {
+func (x *PChannelRetentionPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -834,30 +1184,37 @@ func (x *StreamingVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingVersion.ProtoReflect.Descriptor instead.
-func (*StreamingVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use PChannelRetentionPolicy.ProtoReflect.Descriptor instead.
+func (*PChannelRetentionPolicy) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *StreamingVersion) GetVersion() int64 {
+func (x *PChannelRetentionPolicy) GetPchannel() string {
 	if x != nil {
-		return x.Version
+		return x.Pchannel
 	}
-	return 0
+	return ""
 }
 
-// VersionPair is the version pair of global and local.
-type VersionPair struct {
+func (x *PChannelRetentionPolicy) GetPolicy() *WALRetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// PChannelPin is the pin of a pchannel to a streaming node.
+type PChannelPin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Global int64 `protobuf:"varint,1,opt,name=global,proto3" json:"global,omitempty"`
-	Local  int64 `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	Pchannel string `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`                  // the name of pchannel.
+	ServerId int64  `protobuf:"varint,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"` // the server id of streaming node that the pchannel is pinned to.
 }
 
-func (x *VersionPair) Reset() {
-	*x = VersionPair{}
+func (x *PChannelPin) Reset() {
+	*x = PChannelPin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,13 +1222,13 @@ func (x *VersionPair) Reset() {
 	}
 }
 
-func (x *VersionPair) String() string {
+func (x *PChannelPin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionPair) ProtoMessage() {}
+func (*PChannelPin) ProtoMessage() {}
 
-func (x *VersionPair) ProtoReflect() protoreflect.Message {
+func (x *PChannelPin) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -883,40 +1240,40 @@ func (x *VersionPair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionPair.ProtoReflect.Descriptor instead.
-func (*VersionPair) Descriptor() ([]byte, []int) {
+// Deprecated: Use PChannelPin.ProtoReflect.Descriptor instead.
+func (*PChannelPin) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *VersionPair) GetGlobal() int64 {
+func (x *PChannelPin) GetPchannel() string {
 	if x != nil {
-		return x.Global
+		return x.Pchannel
 	}
-	return 0
+	return ""
 }
 
-func (x *VersionPair) GetLocal() int64 {
+func (x *PChannelPin) GetServerId() int64 {
 	if x != nil {
-		return x.Local
+		return x.ServerId
 	}
 	return 0
 }
 
-// BroadcastTask is the task to broadcast the messake.
-type BroadcastTask struct {
+// PChannelPoolMeta is the meta information of a pchannel pool.
+// The vchannels of the collections in the bound databases are only allocated from the pchannels of the pool,
+// and the pchannels of the pool are reserved for the bound databases.
+type PChannelPoolMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *messagespb.Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                                             // message to be broadcast.
-	State   BroadcastTaskState  `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.streaming.BroadcastTaskState" json:"state,omitempty"` // state of the task.
-	// Deprecated: Marked as deprecated in streaming.proto.
-	AckedVchannelBitmap []byte             `protobuf:"bytes,3,opt,name=acked_vchannel_bitmap,json=ackedVchannelBitmap,proto3" json:"acked_vchannel_bitmap,omitempty"` // deprecated, use acked_checkpoints instead.
-	AckedCheckpoints    []*AckedCheckpoint `protobuf:"bytes,4,rep,name=acked_checkpoints,json=ackedCheckpoints,proto3" json:"acked_checkpoints,omitempty"`            // given vchannels that have been acked, the size of bitmap is same with message.BroadcastHeader().VChannels.
+	Name      string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // unique name of the pool.
+	Pchannels []string `protobuf:"bytes,2,rep,name=pchannels,proto3" json:"pchannels,omitempty"` // the pchannels that belong to the pool.
+	Databases []string `protobuf:"bytes,3,rep,name=databases,proto3" json:"databases,omitempty"` // the database names that are bound to the pool.
 }
 
-func (x *BroadcastTask) Reset() {
-	*x = BroadcastTask{}
+func (x *PChannelPoolMeta) Reset() {
+	*x = PChannelPoolMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,13 +1281,13 @@ func (x *BroadcastTask) Reset() {
 	}
 }
 
-func (x *BroadcastTask) String() string {
+func (x *PChannelPoolMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastTask) ProtoMessage() {}
+func (*PChannelPoolMeta) ProtoMessage() {}
 
-func (x *BroadcastTask) ProtoReflect() protoreflect.Message {
+func (x *PChannelPoolMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,53 +1299,46 @@ func (x *BroadcastTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastTask.ProtoReflect.Descriptor instead.
-func (*BroadcastTask) Descriptor() ([]byte, []int) {
+// Deprecated: Use PChannelPoolMeta.ProtoReflect.Descriptor instead.
+func (*PChannelPoolMeta) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BroadcastTask) GetMessage() *messagespb.Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-func (x *BroadcastTask) GetState() BroadcastTaskState {
+func (x *PChannelPoolMeta) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
-	return BroadcastTaskState_BROADCAST_TASK_STATE_UNKNOWN
+	return ""
 }
 
-// Deprecated: Marked as deprecated in streaming.proto.
-func (x *BroadcastTask) GetAckedVchannelBitmap() []byte {
+func (x *PChannelPoolMeta) GetPchannels() []string {
 	if x != nil {
-		return x.AckedVchannelBitmap
+		return x.Pchannels
 	}
 	return nil
 }
 
-func (x *BroadcastTask) GetAckedCheckpoints() []*AckedCheckpoint {
+func (x *PChannelPoolMeta) GetDatabases() []string {
 	if x != nil {
-		return x.AckedCheckpoints
+		return x.Databases
 	}
 	return nil
 }
 
-// AckedResult is the result of the ack.
-// It's a helper proto to help managing the consuming of broadcast message at coordinator.
-type AckedResult struct {
+// PChannelAntiAffinityGroupMeta is the meta information of a pchannel anti-affinity group.
+// The pchannels in the same group are never placed on the same streaming node by the balancer,
+// unless there's not enough available streaming nodes.
+type PChannelAntiAffinityGroupMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channels         []string           `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`                                         // may be pchannel name or vchannel name.
-	AckedCheckpoints []*AckedCheckpoint `protobuf:"bytes,2,rep,name=acked_checkpoints,json=ackedCheckpoints,proto3" json:"acked_checkpoints,omitempty"` // always same length with channels, not nil if acked.
+	Name      string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // unique name of the group.
+	Pchannels []string `protobuf:"bytes,2,rep,name=pchannels,proto3" json:"pchannels,omitempty"` // the pchannels that belong to the group.
 }
 
-func (x *AckedResult) Reset() {
-	*x = AckedResult{}
+func (x *PChannelAntiAffinityGroupMeta) Reset() {
+	*x = PChannelAntiAffinityGroupMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,13 +1346,13 @@ func (x *AckedResult) Reset() {
 	}
 }
 
-func (x *AckedResult) String() string {
+func (x *PChannelAntiAffinityGroupMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AckedResult) ProtoMessage() {}
+func (*PChannelAntiAffinityGroupMeta) ProtoMessage() {}
 
-func (x *AckedResult) ProtoReflect() protoreflect.Message {
+func (x *PChannelAntiAffinityGroupMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1014,38 +1364,37 @@ func (x *AckedResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AckedResult.ProtoReflect.Descriptor instead.
-func (*AckedResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use PChannelAntiAffinityGroupMeta.ProtoReflect.Descriptor instead.
+func (*PChannelAntiAffinityGroupMeta) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AckedResult) GetChannels() []string {
+func (x *PChannelAntiAffinityGroupMeta) GetName() string {
 	if x != nil {
-		return x.Channels
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AckedResult) GetAckedCheckpoints() []*AckedCheckpoint {
+func (x *PChannelAntiAffinityGroupMeta) GetPchannels() []string {
 	if x != nil {
-		return x.AckedCheckpoints
+		return x.Pchannels
 	}
 	return nil
 }
 
-// AckedCheckpoint is the checkpoint that has been acked.
-type AckedCheckpoint struct {
+// IDAllocatorMeta is the meta information of an id allocator hosted by streamingcoord.
+type IDAllocatorMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageId              *commonpb.MessageID `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                                            // the message id that has been acked.
-	LastConfirmedMessageId *commonpb.MessageID `protobuf:"bytes,2,opt,name=last_confirmed_message_id,json=lastConfirmedMessageId,proto3" json:"last_confirmed_message_id,omitempty"` // the last confirmed message id that has been acked.
-	TimeTick               uint64              `protobuf:"varint,3,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`                                              // the time tick of the message that has been acked.
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`            // unique name of the allocator.
+	Watermark uint64 `protobuf:"varint,2,opt,name=watermark,proto3" json:"watermark,omitempty"` // the ids less than watermark may be allocated, the next allocation starts from watermark after recovery.
 }
 
-func (x *AckedCheckpoint) Reset() {
-	*x = AckedCheckpoint{}
+func (x *IDAllocatorMeta) Reset() {
+	*x = IDAllocatorMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1053,13 +1402,13 @@ func (x *AckedCheckpoint) Reset() {
 	}
 }
 
-func (x *AckedCheckpoint) String() string {
+func (x *IDAllocatorMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AckedCheckpoint) ProtoMessage() {}
+func (*IDAllocatorMeta) ProtoMessage() {}
 
-func (x *AckedCheckpoint) ProtoReflect() protoreflect.Message {
+func (x *IDAllocatorMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1071,43 +1420,36 @@ func (x *AckedCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AckedCheckpoint.ProtoReflect.Descriptor instead.
-func (*AckedCheckpoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use IDAllocatorMeta.ProtoReflect.Descriptor instead.
+func (*IDAllocatorMeta) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AckedCheckpoint) GetMessageId() *commonpb.MessageID {
-	if x != nil {
-		return x.MessageId
-	}
-	return nil
-}
-
-func (x *AckedCheckpoint) GetLastConfirmedMessageId() *commonpb.MessageID {
+func (x *IDAllocatorMeta) GetName() string {
 	if x != nil {
-		return x.LastConfirmedMessageId
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AckedCheckpoint) GetTimeTick() uint64 {
+func (x *IDAllocatorMeta) GetWatermark() uint64 {
 	if x != nil {
-		return x.TimeTick
+		return x.Watermark
 	}
 	return 0
 }
 
-// BroadcastRequest is the request of the Broadcast RPC.
-type BroadcastRequest struct {
+// BalancerConfigMeta is the persisted configuration of the wal balancer.
+type BalancerConfigMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *messagespb.Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // message to be broadcast.
+	BalanceFrozen bool `protobuf:"varint,1,opt,name=balance_frozen,json=balanceFrozen,proto3" json:"balance_frozen,omitempty"` // the automatic reassignment is suspended, only the pinned pchannels are moved.
 }
 
-func (x *BroadcastRequest) Reset() {
-	*x = BroadcastRequest{}
+func (x *BalancerConfigMeta) Reset() {
+	*x = BalancerConfigMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1115,13 +1457,13 @@ func (x *BroadcastRequest) Reset() {
 	}
 }
 
-func (x *BroadcastRequest) String() string {
+func (x *BalancerConfigMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastRequest) ProtoMessage() {}
+func (*BalancerConfigMeta) ProtoMessage() {}
 
-func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
+func (x *BalancerConfigMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,30 +1475,30 @@ func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
-func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalancerConfigMeta.ProtoReflect.Descriptor instead.
+func (*BalancerConfigMeta) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BroadcastRequest) GetMessage() *messagespb.Message {
+func (x *BalancerConfigMeta) GetBalanceFrozen() bool {
 	if x != nil {
-		return x.Message
+		return x.BalanceFrozen
 	}
-	return nil
+	return false
 }
 
-// BroadcastResponse is the response of the Broadcast RPC.
-type BroadcastResponse struct {
+// CChannelMeta is the meta information of a control channel.
+type CChannelMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results     map[string]*ProduceMessageResponseResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	BroadcastId uint64                                   `protobuf:"varint,2,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"`
+	Pchannel string                   `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // the pchannel that control channel locate on.
+	Handoffs []*CChannelHandoffMarker `protobuf:"bytes,2,rep,name=handoffs,proto3" json:"handoffs,omitempty"` // the handoff markers of the control channel, ordered by the handoff time.
 }
 
-func (x *BroadcastResponse) Reset() {
-	*x = BroadcastResponse{}
+func (x *CChannelMeta) Reset() {
+	*x = CChannelMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1164,13 +1506,13 @@ func (x *BroadcastResponse) Reset() {
 	}
 }
 
-func (x *BroadcastResponse) String() string {
+func (x *CChannelMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastResponse) ProtoMessage() {}
+func (*CChannelMeta) ProtoMessage() {}
 
-func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
+func (x *CChannelMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1182,39 +1524,38 @@ func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
-func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CChannelMeta.ProtoReflect.Descriptor instead.
+func (*CChannelMeta) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BroadcastResponse) GetResults() map[string]*ProduceMessageResponseResult {
+func (x *CChannelMeta) GetPchannel() string {
 	if x != nil {
-		return x.Results
+		return x.Pchannel
 	}
-	return nil
+	return ""
 }
 
-func (x *BroadcastResponse) GetBroadcastId() uint64 {
+func (x *CChannelMeta) GetHandoffs() []*CChannelHandoffMarker {
 	if x != nil {
-		return x.BroadcastId
+		return x.Handoffs
 	}
-	return 0
+	return nil
 }
 
-type BroadcastAckRequest struct {
+// CChannelHandoffMarker records a move of the control channel from one pchannel to another.
+type CChannelHandoffMarker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Marked as deprecated in streaming.proto.
-	BroadcastId uint64 `protobuf:"varint,1,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"` // broadcast id.
-	// Deprecated: Marked as deprecated in streaming.proto.
-	Vchannel string                     `protobuf:"bytes,2,opt,name=vchannel,proto3" json:"vchannel,omitempty"` // the vchannel that acked the message.
-	Message  *commonpb.ImmutableMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`   // the message that to be acked.
+	FromPchannel            string `protobuf:"bytes,1,opt,name=from_pchannel,json=fromPchannel,proto3" json:"from_pchannel,omitempty"`                                     // the pchannel that control channel located on before the move.
+	ToPchannel              string `protobuf:"bytes,2,opt,name=to_pchannel,json=toPchannel,proto3" json:"to_pchannel,omitempty"`                                           // the pchannel that control channel located on after the move.
+	HandoffTimestampSeconds int64  `protobuf:"varint,3,opt,name=handoff_timestamp_seconds,json=handoffTimestampSeconds,proto3" json:"handoff_timestamp_seconds,omitempty"` // the unix timestamp of the move in seconds.
 }
 
-func (x *BroadcastAckRequest) Reset() {
-	*x = BroadcastAckRequest{}
+func (x *CChannelHandoffMarker) Reset() {
+	*x = CChannelHandoffMarker{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1222,13 +1563,13 @@ func (x *BroadcastAckRequest) Reset() {
 	}
 }
 
-func (x *BroadcastAckRequest) String() string {
+func (x *CChannelHandoffMarker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastAckRequest) ProtoMessage() {}
+func (*CChannelHandoffMarker) ProtoMessage() {}
 
-func (x *BroadcastAckRequest) ProtoReflect() protoreflect.Message {
+func (x *CChannelHandoffMarker) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1240,42 +1581,43 @@ func (x *BroadcastAckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastAckRequest.ProtoReflect.Descriptor instead.
-func (*BroadcastAckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CChannelHandoffMarker.ProtoReflect.Descriptor instead.
+func (*CChannelHandoffMarker) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{11}
 }
 
-// Deprecated: Marked as deprecated in streaming.proto.
-func (x *BroadcastAckRequest) GetBroadcastId() uint64 {
+func (x *CChannelHandoffMarker) GetFromPchannel() string {
 	if x != nil {
-		return x.BroadcastId
+		return x.FromPchannel
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Marked as deprecated in streaming.proto.
-func (x *BroadcastAckRequest) GetVchannel() string {
+func (x *CChannelHandoffMarker) GetToPchannel() string {
 	if x != nil {
-		return x.Vchannel
+		return x.ToPchannel
 	}
 	return ""
 }
 
-func (x *BroadcastAckRequest) GetMessage() *commonpb.ImmutableMessage {
+func (x *CChannelHandoffMarker) GetHandoffTimestampSeconds() int64 {
 	if x != nil {
-		return x.Message
+		return x.HandoffTimestampSeconds
 	}
-	return nil
+	return 0
 }
 
-type BroadcastAckResponse struct {
+// StreamingVersion is the version of the streaming service.
+type StreamingVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // version of the streaming,
 }
 
-func (x *BroadcastAckResponse) Reset() {
-	*x = BroadcastAckResponse{}
+func (x *StreamingVersion) Reset() {
+	*x = StreamingVersion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,13 +1625,13 @@ func (x *BroadcastAckResponse) Reset() {
 	}
 }
 
-func (x *BroadcastAckResponse) String() string {
+func (x *StreamingVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BroadcastAckResponse) ProtoMessage() {}
+func (*StreamingVersion) ProtoMessage() {}
 
-func (x *BroadcastAckResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamingVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1301,22 +1643,30 @@ func (x *BroadcastAckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BroadcastAckResponse.ProtoReflect.Descriptor instead.
-func (*BroadcastAckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamingVersion.ProtoReflect.Descriptor instead.
+func (*StreamingVersion) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{12}
 }
 
-type UpdateReplicateConfigurationRequest struct {
+func (x *StreamingVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// VersionPair is the version pair of global and local.
+type VersionPair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Configuration *commonpb.ReplicateConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	ForcePromote  bool                             `protobuf:"varint,2,opt,name=force_promote,json=forcePromote,proto3" json:"force_promote,omitempty"`
+	Global int64 `protobuf:"varint,1,opt,name=global,proto3" json:"global,omitempty"`
+	Local  int64 `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *UpdateReplicateConfigurationRequest) Reset() {
-	*x = UpdateReplicateConfigurationRequest{}
+func (x *VersionPair) Reset() {
+	*x = VersionPair{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1324,13 +1674,13 @@ func (x *UpdateReplicateConfigurationRequest) Reset() {
 	}
 }
 
-func (x *UpdateReplicateConfigurationRequest) String() string {
+func (x *VersionPair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReplicateConfigurationRequest) ProtoMessage() {}
+func (*VersionPair) ProtoMessage() {}
 
-func (x *UpdateReplicateConfigurationRequest) ProtoReflect() protoreflect.Message {
+func (x *VersionPair) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1342,34 +1692,42 @@ func (x *UpdateReplicateConfigurationRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReplicateConfigurationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReplicateConfigurationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VersionPair.ProtoReflect.Descriptor instead.
+func (*VersionPair) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateReplicateConfigurationRequest) GetConfiguration() *commonpb.ReplicateConfiguration {
+func (x *VersionPair) GetGlobal() int64 {
 	if x != nil {
-		return x.Configuration
+		return x.Global
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateReplicateConfigurationRequest) GetForcePromote() bool {
+func (x *VersionPair) GetLocal() int64 {
 	if x != nil {
-		return x.ForcePromote
+		return x.Local
 	}
-	return false
+	return 0
 }
 
-// UpdateReplicateConfigurationResponse is the response of UpdateReplicateConfiguration service
-type UpdateReplicateConfigurationResponse struct {
+// CrossVChannelTxnMeta is the meta of the transaction across the vchannels of a collection coordinated by streamingcoord.
+type CrossVChannelTxnMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TxnId          int64                 `protobuf:"varint,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`                                                                                                                      // the id of the cross vchannel transaction.
+	CollectionId   int64                 `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`                                                                                                 // the collection that the transaction belongs to.
+	VchannelTxnIds map[string]int64      `protobuf:"bytes,3,rep,name=vchannel_txn_ids,json=vchannelTxnIds,proto3" json:"vchannel_txn_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // the txn id of the transaction at every vchannel.
+	State          CrossVChannelTxnState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.streaming.CrossVChannelTxnState" json:"state,omitempty"`                                                                                 // the state of the transaction.
+	Deadline       uint64                `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`                                                                                                                             // the deadline (tso) of the transaction, it's rollbacked if it's still preparing after the deadline.
+	DbName         string                `protobuf:"bytes,6,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`                                                                                                                    // the db name of the collection, used to acquire the resource key of the collection.
+	CollectionName string                `protobuf:"bytes,7,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`                                                                                            // the name of the collection, used to acquire the resource key of the collection.
 }
 
-func (x *UpdateReplicateConfigurationResponse) Reset() {
-	*x = UpdateReplicateConfigurationResponse{}
+func (x *CrossVChannelTxnMeta) Reset() {
+	*x = CrossVChannelTxnMeta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1377,13 +1735,13 @@ func (x *UpdateReplicateConfigurationResponse) Reset() {
 	}
 }
 
-func (x *UpdateReplicateConfigurationResponse) String() string {
+func (x *CrossVChannelTxnMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReplicateConfigurationResponse) ProtoMessage() {}
+func (*CrossVChannelTxnMeta) ProtoMessage() {}
 
-func (x *UpdateReplicateConfigurationResponse) ProtoReflect() protoreflect.Message {
+func (x *CrossVChannelTxnMeta) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1395,85 +1753,147 @@ func (x *UpdateReplicateConfigurationResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReplicateConfigurationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateReplicateConfigurationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CrossVChannelTxnMeta.ProtoReflect.Descriptor instead.
+func (*CrossVChannelTxnMeta) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{14}
 }
 
-// UpdateWALBalancePolicyRequest is the request to update the WAL balance policy.
-type UpdateWALBalancePolicyRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Config     *WALBalancePolicyConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Nodes      *WALBalancePolicyNodes  `protobuf:"bytes,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
-	UpdateMask *fieldmaskpb.FieldMask  `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+func (x *CrossVChannelTxnMeta) GetTxnId() int64 {
+	if x != nil {
+		return x.TxnId
+	}
+	return 0
 }
 
-func (x *UpdateWALBalancePolicyRequest) Reset() {
-	*x = UpdateWALBalancePolicyRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CrossVChannelTxnMeta) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
 	}
+	return 0
 }
 
-func (x *UpdateWALBalancePolicyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CrossVChannelTxnMeta) GetVchannelTxnIds() map[string]int64 {
+	if x != nil {
+		return x.VchannelTxnIds
+	}
+	return nil
 }
 
-func (*UpdateWALBalancePolicyRequest) ProtoMessage() {}
-
-func (x *UpdateWALBalancePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CrossVChannelTxnMeta) GetState() CrossVChannelTxnState {
+	if x != nil {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return CrossVChannelTxnState_CROSS_VCHANNEL_TXN_STATE_UNKNOWN
 }
 
-// Deprecated: Use UpdateWALBalancePolicyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWALBalancePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{15}
+func (x *CrossVChannelTxnMeta) GetDeadline() uint64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
 }
 
-func (x *UpdateWALBalancePolicyRequest) GetConfig() *WALBalancePolicyConfig {
+func (x *CrossVChannelTxnMeta) GetDbName() string {
 	if x != nil {
-		return x.Config
+		return x.DbName
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWALBalancePolicyRequest) GetNodes() *WALBalancePolicyNodes {
+func (x *CrossVChannelTxnMeta) GetCollectionName() string {
 	if x != nil {
-		return x.Nodes
+		return x.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWALBalancePolicyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+// BroadcastTask is the task to broadcast the messake.
+type BroadcastTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *messagespb.Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                                             // message to be broadcast.
+	State   BroadcastTaskState  `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.streaming.BroadcastTaskState" json:"state,omitempty"` // state of the task.
+	// Deprecated: Marked as deprecated in streaming.proto.
+	AckedVchannelBitmap []byte             `protobuf:"bytes,3,opt,name=acked_vchannel_bitmap,json=ackedVchannelBitmap,proto3" json:"acked_vchannel_bitmap,omitempty"` // deprecated, use acked_checkpoints instead.
+	AckedCheckpoints    []*AckedCheckpoint `protobuf:"bytes,4,rep,name=acked_checkpoints,json=ackedCheckpoints,proto3" json:"acked_checkpoints,omitempty"`            // given vchannels that have been acked, the size of bitmap is same with message.BroadcastHeader().VChannels.
+}
+
+func (x *BroadcastTask) Reset() {
+	*x = BroadcastTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastTask) ProtoMessage() {}
+
+func (x *BroadcastTask) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastTask.ProtoReflect.Descriptor instead.
+func (*BroadcastTask) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BroadcastTask) GetMessage() *messagespb.Message {
 	if x != nil {
-		return x.UpdateMask
+		return x.Message
 	}
 	return nil
 }
 
-type WALBalancePolicyConfig struct {
+func (x *BroadcastTask) GetState() BroadcastTaskState {
+	if x != nil {
+		return x.State
+	}
+	return BroadcastTaskState_BROADCAST_TASK_STATE_UNKNOWN
+}
+
+// Deprecated: Marked as deprecated in streaming.proto.
+func (x *BroadcastTask) GetAckedVchannelBitmap() []byte {
+	if x != nil {
+		return x.AckedVchannelBitmap
+	}
+	return nil
+}
+
+func (x *BroadcastTask) GetAckedCheckpoints() []*AckedCheckpoint {
+	if x != nil {
+		return x.AckedCheckpoints
+	}
+	return nil
+}
+
+// AckedResult is the result of the ack.
+// It's a helper proto to help managing the consuming of broadcast message at coordinator.
+type AckedResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllowRebalance bool `protobuf:"varint,1,opt,name=allow_rebalance,json=allowRebalance,proto3" json:"allow_rebalance,omitempty"`
+	Channels         []string           `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`                                         // may be pchannel name or vchannel name.
+	AckedCheckpoints []*AckedCheckpoint `protobuf:"bytes,2,rep,name=acked_checkpoints,json=ackedCheckpoints,proto3" json:"acked_checkpoints,omitempty"` // always same length with channels, not nil if acked.
 }
 
-func (x *WALBalancePolicyConfig) Reset() {
-	*x = WALBalancePolicyConfig{}
+func (x *AckedResult) Reset() {
+	*x = AckedResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1481,13 +1901,13 @@ func (x *WALBalancePolicyConfig) Reset() {
 	}
 }
 
-func (x *WALBalancePolicyConfig) String() string {
+func (x *AckedResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WALBalancePolicyConfig) ProtoMessage() {}
+func (*AckedResult) ProtoMessage() {}
 
-func (x *WALBalancePolicyConfig) ProtoReflect() protoreflect.Message {
+func (x *AckedResult) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1499,29 +1919,38 @@ func (x *WALBalancePolicyConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WALBalancePolicyConfig.ProtoReflect.Descriptor instead.
-func (*WALBalancePolicyConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use AckedResult.ProtoReflect.Descriptor instead.
+func (*AckedResult) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WALBalancePolicyConfig) GetAllowRebalance() bool {
+func (x *AckedResult) GetChannels() []string {
 	if x != nil {
-		return x.AllowRebalance
+		return x.Channels
 	}
-	return false
+	return nil
 }
 
-type WALBalancePolicyNodes struct {
+func (x *AckedResult) GetAckedCheckpoints() []*AckedCheckpoint {
+	if x != nil {
+		return x.AckedCheckpoints
+	}
+	return nil
+}
+
+// AckedCheckpoint is the checkpoint that has been acked.
+type AckedCheckpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FreezeNodeIds   []int64 `protobuf:"varint,1,rep,packed,name=freeze_node_ids,json=freezeNodeIds,proto3" json:"freeze_node_ids,omitempty"`       // nodes that will be frozen.
-	DefreezeNodeIds []int64 `protobuf:"varint,2,rep,packed,name=defreeze_node_ids,json=defreezeNodeIds,proto3" json:"defreeze_node_ids,omitempty"` // nodes that will be defrozen.
+	MessageId              *commonpb.MessageID `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                                            // the message id that has been acked.
+	LastConfirmedMessageId *commonpb.MessageID `protobuf:"bytes,2,opt,name=last_confirmed_message_id,json=lastConfirmedMessageId,proto3" json:"last_confirmed_message_id,omitempty"` // the last confirmed message id that has been acked.
+	TimeTick               uint64              `protobuf:"varint,3,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`                                              // the time tick of the message that has been acked.
 }
 
-func (x *WALBalancePolicyNodes) Reset() {
-	*x = WALBalancePolicyNodes{}
+func (x *AckedCheckpoint) Reset() {
+	*x = AckedCheckpoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1529,13 +1958,13 @@ func (x *WALBalancePolicyNodes) Reset() {
 	}
 }
 
-func (x *WALBalancePolicyNodes) String() string {
+func (x *AckedCheckpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WALBalancePolicyNodes) ProtoMessage() {}
+func (*AckedCheckpoint) ProtoMessage() {}
 
-func (x *WALBalancePolicyNodes) ProtoReflect() protoreflect.Message {
+func (x *AckedCheckpoint) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,36 +1976,45 @@ func (x *WALBalancePolicyNodes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WALBalancePolicyNodes.ProtoReflect.Descriptor instead.
-func (*WALBalancePolicyNodes) Descriptor() ([]byte, []int) {
+// Deprecated: Use AckedCheckpoint.ProtoReflect.Descriptor instead.
+func (*AckedCheckpoint) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WALBalancePolicyNodes) GetFreezeNodeIds() []int64 {
+func (x *AckedCheckpoint) GetMessageId() *commonpb.MessageID {
 	if x != nil {
-		return x.FreezeNodeIds
+		return x.MessageId
 	}
 	return nil
 }
 
-func (x *WALBalancePolicyNodes) GetDefreezeNodeIds() []int64 {
+func (x *AckedCheckpoint) GetLastConfirmedMessageId() *commonpb.MessageID {
 	if x != nil {
-		return x.DefreezeNodeIds
+		return x.LastConfirmedMessageId
 	}
 	return nil
 }
 
-type UpdateWALBalancePolicyResponse struct {
+func (x *AckedCheckpoint) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+// BeginCrossVChannelTxnRequest is the request of the BeginCrossVChannelTxn RPC.
+type BeginCrossVChannelTxnRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config        *WALBalancePolicyConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`                                              // return current configuration of WAL balance policy.
-	FreezeNodeIds []int64                 `protobuf:"varint,2,rep,packed,name=freeze_node_ids,json=freezeNodeIds,proto3" json:"freeze_node_ids,omitempty"` // nodes that are frozen.
+	CollectionId          int64    `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`                            // the collection that the transaction belongs to.
+	Vchannels             []string `protobuf:"bytes,2,rep,name=vchannels,proto3" json:"vchannels,omitempty"`                                                       // the vchannels of the collection that the transaction spans.
+	KeepaliveMilliseconds int64    `protobuf:"varint,3,opt,name=keepalive_milliseconds,json=keepaliveMilliseconds,proto3" json:"keepalive_milliseconds,omitempty"` // the transaction is rollbacked if it's not committed before the keepalive.
 }
 
-func (x *UpdateWALBalancePolicyResponse) Reset() {
-	*x = UpdateWALBalancePolicyResponse{}
+func (x *BeginCrossVChannelTxnRequest) Reset() {
+	*x = BeginCrossVChannelTxnRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1584,13 +2022,13 @@ func (x *UpdateWALBalancePolicyResponse) Reset() {
 	}
 }
 
-func (x *UpdateWALBalancePolicyResponse) String() string {
+func (x *BeginCrossVChannelTxnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWALBalancePolicyResponse) ProtoMessage() {}
+func (*BeginCrossVChannelTxnRequest) ProtoMessage() {}
 
-func (x *UpdateWALBalancePolicyResponse) ProtoReflect() protoreflect.Message {
+func (x *BeginCrossVChannelTxnRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1602,40 +2040,43 @@ func (x *UpdateWALBalancePolicyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWALBalancePolicyResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWALBalancePolicyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginCrossVChannelTxnRequest.ProtoReflect.Descriptor instead.
+func (*BeginCrossVChannelTxnRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateWALBalancePolicyResponse) GetConfig() *WALBalancePolicyConfig {
+func (x *BeginCrossVChannelTxnRequest) GetCollectionId() int64 {
 	if x != nil {
-		return x.Config
+		return x.CollectionId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateWALBalancePolicyResponse) GetFreezeNodeIds() []int64 {
+func (x *BeginCrossVChannelTxnRequest) GetVchannels() []string {
 	if x != nil {
-		return x.FreezeNodeIds
+		return x.Vchannels
 	}
 	return nil
 }
 
-// AssignmentDiscoverRequest is the request of Discovery
-type AssignmentDiscoverRequest struct {
+func (x *BeginCrossVChannelTxnRequest) GetKeepaliveMilliseconds() int64 {
+	if x != nil {
+		return x.KeepaliveMilliseconds
+	}
+	return 0
+}
+
+// BeginCrossVChannelTxnResponse is the response of the BeginCrossVChannelTxn RPC.
+type BeginCrossVChannelTxnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Command:
-	//
-	//	*AssignmentDiscoverRequest_ReportError
-	//	*AssignmentDiscoverRequest_Close
-	Command isAssignmentDiscoverRequest_Command `protobuf_oneof:"command"`
+	Txn *CrossVChannelTxnMeta `protobuf:"bytes,1,opt,name=txn,proto3" json:"txn,omitempty"`
 }
 
-func (x *AssignmentDiscoverRequest) Reset() {
-	*x = AssignmentDiscoverRequest{}
+func (x *BeginCrossVChannelTxnResponse) Reset() {
+	*x = BeginCrossVChannelTxnResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1643,13 +2084,13 @@ func (x *AssignmentDiscoverRequest) Reset() {
 	}
 }
 
-func (x *AssignmentDiscoverRequest) String() string {
+func (x *BeginCrossVChannelTxnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignmentDiscoverRequest) ProtoMessage() {}
+func (*BeginCrossVChannelTxnResponse) ProtoMessage() {}
 
-func (x *AssignmentDiscoverRequest) ProtoReflect() protoreflect.Message {
+func (x *BeginCrossVChannelTxnResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,61 +2102,29 @@ func (x *AssignmentDiscoverRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignmentDiscoverRequest.ProtoReflect.Descriptor instead.
-func (*AssignmentDiscoverRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginCrossVChannelTxnResponse.ProtoReflect.Descriptor instead.
+func (*BeginCrossVChannelTxnResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *AssignmentDiscoverRequest) GetCommand() isAssignmentDiscoverRequest_Command {
-	if m != nil {
-		return m.Command
-	}
-	return nil
-}
-
-func (x *AssignmentDiscoverRequest) GetReportError() *ReportAssignmentErrorRequest {
-	if x, ok := x.GetCommand().(*AssignmentDiscoverRequest_ReportError); ok {
-		return x.ReportError
-	}
-	return nil
-}
-
-func (x *AssignmentDiscoverRequest) GetClose() *CloseAssignmentDiscoverRequest {
-	if x, ok := x.GetCommand().(*AssignmentDiscoverRequest_Close); ok {
-		return x.Close
+func (x *BeginCrossVChannelTxnResponse) GetTxn() *CrossVChannelTxnMeta {
+	if x != nil {
+		return x.Txn
 	}
 	return nil
 }
 
-type isAssignmentDiscoverRequest_Command interface {
-	isAssignmentDiscoverRequest_Command()
-}
-
-type AssignmentDiscoverRequest_ReportError struct {
-	ReportError *ReportAssignmentErrorRequest `protobuf:"bytes,1,opt,name=report_error,json=reportError,proto3,oneof"` // report streaming error, trigger reassign right now.
-}
-
-type AssignmentDiscoverRequest_Close struct {
-	Close *CloseAssignmentDiscoverRequest `protobuf:"bytes,2,opt,name=close,proto3,oneof"` // close the stream.
-}
-
-func (*AssignmentDiscoverRequest_ReportError) isAssignmentDiscoverRequest_Command() {}
-
-func (*AssignmentDiscoverRequest_Close) isAssignmentDiscoverRequest_Command() {}
-
-// ReportAssignmentErrorRequest is the request to report assignment error
-// happens.
-type ReportAssignmentErrorRequest struct {
+// CommitCrossVChannelTxnRequest is the request of the CommitCrossVChannelTxn RPC.
+type CommitCrossVChannelTxnRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo   `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // channel
-	Err      *StreamingError `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`           // error happend on log node
+	TxnId int64 `protobuf:"varint,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
 }
 
-func (x *ReportAssignmentErrorRequest) Reset() {
-	*x = ReportAssignmentErrorRequest{}
+func (x *CommitCrossVChannelTxnRequest) Reset() {
+	*x = CommitCrossVChannelTxnRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1723,13 +2132,13 @@ func (x *ReportAssignmentErrorRequest) Reset() {
 	}
 }
 
-func (x *ReportAssignmentErrorRequest) String() string {
+func (x *CommitCrossVChannelTxnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportAssignmentErrorRequest) ProtoMessage() {}
+func (*CommitCrossVChannelTxnRequest) ProtoMessage() {}
 
-func (x *ReportAssignmentErrorRequest) ProtoReflect() protoreflect.Message {
+func (x *CommitCrossVChannelTxnRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1741,34 +2150,30 @@ func (x *ReportAssignmentErrorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportAssignmentErrorRequest.ProtoReflect.Descriptor instead.
-func (*ReportAssignmentErrorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitCrossVChannelTxnRequest.ProtoReflect.Descriptor instead.
+func (*CommitCrossVChannelTxnRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ReportAssignmentErrorRequest) GetPchannel() *PChannelInfo {
-	if x != nil {
-		return x.Pchannel
-	}
-	return nil
-}
-
-func (x *ReportAssignmentErrorRequest) GetErr() *StreamingError {
+func (x *CommitCrossVChannelTxnRequest) GetTxnId() int64 {
 	if x != nil {
-		return x.Err
+		return x.TxnId
 	}
-	return nil
+	return 0
 }
 
-// CloseAssignmentDiscoverRequest is the request to close the stream.
-type CloseAssignmentDiscoverRequest struct {
+// CommitCrossVChannelTxnResponse is the response of the CommitCrossVChannelTxn RPC.
+type CommitCrossVChannelTxnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Results     map[string]*ProduceMessageResponseResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BroadcastId uint64                                   `protobuf:"varint,2,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"`
 }
 
-func (x *CloseAssignmentDiscoverRequest) Reset() {
-	*x = CloseAssignmentDiscoverRequest{}
+func (x *CommitCrossVChannelTxnResponse) Reset() {
+	*x = CommitCrossVChannelTxnResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1776,13 +2181,13 @@ func (x *CloseAssignmentDiscoverRequest) Reset() {
 	}
 }
 
-func (x *CloseAssignmentDiscoverRequest) String() string {
+func (x *CommitCrossVChannelTxnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseAssignmentDiscoverRequest) ProtoMessage() {}
+func (*CommitCrossVChannelTxnResponse) ProtoMessage() {}
 
-func (x *CloseAssignmentDiscoverRequest) ProtoReflect() protoreflect.Message {
+func (x *CommitCrossVChannelTxnResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1794,26 +2199,36 @@ func (x *CloseAssignmentDiscoverRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseAssignmentDiscoverRequest.ProtoReflect.Descriptor instead.
-func (*CloseAssignmentDiscoverRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitCrossVChannelTxnResponse.ProtoReflect.Descriptor instead.
+func (*CommitCrossVChannelTxnResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{21}
 }
 
-// AssignmentDiscoverResponse is the response of Discovery
-type AssignmentDiscoverResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Response:
-	//
-	//	*AssignmentDiscoverResponse_FullAssignment
-	//	*AssignmentDiscoverResponse_Close
-	Response isAssignmentDiscoverResponse_Response `protobuf_oneof:"response"`
+func (x *CommitCrossVChannelTxnResponse) GetResults() map[string]*ProduceMessageResponseResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
 }
 
-func (x *AssignmentDiscoverResponse) Reset() {
-	*x = AssignmentDiscoverResponse{}
+func (x *CommitCrossVChannelTxnResponse) GetBroadcastId() uint64 {
+	if x != nil {
+		return x.BroadcastId
+	}
+	return 0
+}
+
+// RollbackCrossVChannelTxnRequest is the request of the RollbackCrossVChannelTxn RPC.
+type RollbackCrossVChannelTxnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxnId int64 `protobuf:"varint,1,opt,name=txn_id,json=txnId,proto3" json:"txn_id,omitempty"`
+}
+
+func (x *RollbackCrossVChannelTxnRequest) Reset() {
+	*x = RollbackCrossVChannelTxnRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1821,13 +2236,13 @@ func (x *AssignmentDiscoverResponse) Reset() {
 	}
 }
 
-func (x *AssignmentDiscoverResponse) String() string {
+func (x *RollbackCrossVChannelTxnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignmentDiscoverResponse) ProtoMessage() {}
+func (*RollbackCrossVChannelTxnRequest) ProtoMessage() {}
 
-func (x *AssignmentDiscoverResponse) ProtoReflect() protoreflect.Message {
+func (x *RollbackCrossVChannelTxnRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1839,67 +2254,27 @@ func (x *AssignmentDiscoverResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignmentDiscoverResponse.ProtoReflect.Descriptor instead.
-func (*AssignmentDiscoverResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackCrossVChannelTxnRequest.ProtoReflect.Descriptor instead.
+func (*RollbackCrossVChannelTxnRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{22}
 }
 
-func (m *AssignmentDiscoverResponse) GetResponse() isAssignmentDiscoverResponse_Response {
-	if m != nil {
-		return m.Response
-	}
-	return nil
-}
-
-func (x *AssignmentDiscoverResponse) GetFullAssignment() *FullStreamingNodeAssignmentWithVersion {
-	if x, ok := x.GetResponse().(*AssignmentDiscoverResponse_FullAssignment); ok {
-		return x.FullAssignment
-	}
-	return nil
-}
-
-func (x *AssignmentDiscoverResponse) GetClose() *CloseAssignmentDiscoverResponse {
-	if x, ok := x.GetResponse().(*AssignmentDiscoverResponse_Close); ok {
-		return x.Close
+func (x *RollbackCrossVChannelTxnRequest) GetTxnId() int64 {
+	if x != nil {
+		return x.TxnId
 	}
-	return nil
-}
-
-type isAssignmentDiscoverResponse_Response interface {
-	isAssignmentDiscoverResponse_Response()
-}
-
-type AssignmentDiscoverResponse_FullAssignment struct {
-	FullAssignment *FullStreamingNodeAssignmentWithVersion `protobuf:"bytes,1,opt,name=full_assignment,json=fullAssignment,proto3,oneof"` // all assignment info.
-}
-
-type AssignmentDiscoverResponse_Close struct {
-	// TODO: may be support partial assignment info in future.
-	Close *CloseAssignmentDiscoverResponse `protobuf:"bytes,2,opt,name=close,proto3,oneof"`
+	return 0
 }
 
-func (*AssignmentDiscoverResponse_FullAssignment) isAssignmentDiscoverResponse_Response() {}
-
-func (*AssignmentDiscoverResponse_Close) isAssignmentDiscoverResponse_Response() {}
-
-// FullStreamingNodeAssignmentWithVersion is the full assignment info of a log
-// node with version.
-type FullStreamingNodeAssignmentWithVersion struct {
+// RollbackCrossVChannelTxnResponse is the response of the RollbackCrossVChannelTxn RPC.
+type RollbackCrossVChannelTxnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Deprecated: Marked as deprecated in streaming.proto.
-	Version                *VersionPair                     `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	Assignments            []*StreamingNodeAssignment       `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	Cchannel               *CChannelAssignment              `protobuf:"bytes,3,opt,name=cchannel,proto3" json:"cchannel,omitempty"` // Where the control channel located.
-	ReplicateConfiguration *commonpb.ReplicateConfiguration `protobuf:"bytes,4,opt,name=replicate_configuration,json=replicateConfiguration,proto3" json:"replicate_configuration,omitempty"`
-	StreamingVersion       *StreamingVersion                `protobuf:"bytes,5,opt,name=streaming_version,json=streamingVersion,proto3" json:"streaming_version,omitempty"`
-	VersionByRevision      *VersionPair                     `protobuf:"bytes,6,opt,name=version_by_revision,json=versionByRevision,proto3" json:"version_by_revision,omitempty"`
 }
 
-func (x *FullStreamingNodeAssignmentWithVersion) Reset() {
-	*x = FullStreamingNodeAssignmentWithVersion{}
+func (x *RollbackCrossVChannelTxnResponse) Reset() {
+	*x = RollbackCrossVChannelTxnResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,13 +2282,13 @@ func (x *FullStreamingNodeAssignmentWithVersion) Reset() {
 	}
 }
 
-func (x *FullStreamingNodeAssignmentWithVersion) String() string {
+func (x *RollbackCrossVChannelTxnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FullStreamingNodeAssignmentWithVersion) ProtoMessage() {}
+func (*RollbackCrossVChannelTxnResponse) ProtoMessage() {}
 
-func (x *FullStreamingNodeAssignmentWithVersion) ProtoReflect() protoreflect.Message {
+func (x *RollbackCrossVChannelTxnResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1925,65 +2300,22 @@ func (x *FullStreamingNodeAssignmentWithVersion) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FullStreamingNodeAssignmentWithVersion.ProtoReflect.Descriptor instead.
-func (*FullStreamingNodeAssignmentWithVersion) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackCrossVChannelTxnResponse.ProtoReflect.Descriptor instead.
+func (*RollbackCrossVChannelTxnResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{23}
 }
 
-// Deprecated: Marked as deprecated in streaming.proto.
-func (x *FullStreamingNodeAssignmentWithVersion) GetVersion() *VersionPair {
-	if x != nil {
-		return x.Version
-	}
-	return nil
-}
-
-func (x *FullStreamingNodeAssignmentWithVersion) GetAssignments() []*StreamingNodeAssignment {
-	if x != nil {
-		return x.Assignments
-	}
-	return nil
-}
-
-func (x *FullStreamingNodeAssignmentWithVersion) GetCchannel() *CChannelAssignment {
-	if x != nil {
-		return x.Cchannel
-	}
-	return nil
-}
-
-func (x *FullStreamingNodeAssignmentWithVersion) GetReplicateConfiguration() *commonpb.ReplicateConfiguration {
-	if x != nil {
-		return x.ReplicateConfiguration
-	}
-	return nil
-}
-
-func (x *FullStreamingNodeAssignmentWithVersion) GetStreamingVersion() *StreamingVersion {
-	if x != nil {
-		return x.StreamingVersion
-	}
-	return nil
-}
-
-func (x *FullStreamingNodeAssignmentWithVersion) GetVersionByRevision() *VersionPair {
-	if x != nil {
-		return x.VersionByRevision
-	}
-	return nil
-}
-
-// CChannelAssignment is the assignment info of a control channel.
-type CChannelAssignment struct {
+// BroadcastRequest is the request of the Broadcast RPC.
+type BroadcastRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta *CChannelMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Message *messagespb.Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // message to be broadcast.
 }
 
-func (x *CChannelAssignment) Reset() {
-	*x = CChannelAssignment{}
+func (x *BroadcastRequest) Reset() {
+	*x = BroadcastRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1991,13 +2323,13 @@ func (x *CChannelAssignment) Reset() {
 	}
 }
 
-func (x *CChannelAssignment) String() string {
+func (x *BroadcastRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CChannelAssignment) ProtoMessage() {}
+func (*BroadcastRequest) ProtoMessage() {}
 
-func (x *CChannelAssignment) ProtoReflect() protoreflect.Message {
+func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2009,26 +2341,30 @@ func (x *CChannelAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CChannelAssignment.ProtoReflect.Descriptor instead.
-func (*CChannelAssignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CChannelAssignment) GetMeta() *CChannelMeta {
+func (x *BroadcastRequest) GetMessage() *messagespb.Message {
 	if x != nil {
-		return x.Meta
+		return x.Message
 	}
 	return nil
 }
 
-type CloseAssignmentDiscoverResponse struct {
+// BroadcastResponse is the response of the Broadcast RPC.
+type BroadcastResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Results     map[string]*ProduceMessageResponseResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BroadcastId uint64                                   `protobuf:"varint,2,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"`
 }
 
-func (x *CloseAssignmentDiscoverResponse) Reset() {
-	*x = CloseAssignmentDiscoverResponse{}
+func (x *BroadcastResponse) Reset() {
+	*x = BroadcastResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2036,13 +2372,13 @@ func (x *CloseAssignmentDiscoverResponse) Reset() {
 	}
 }
 
-func (x *CloseAssignmentDiscoverResponse) String() string {
+func (x *BroadcastResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseAssignmentDiscoverResponse) ProtoMessage() {}
+func (*BroadcastResponse) ProtoMessage() {}
 
-func (x *CloseAssignmentDiscoverResponse) ProtoReflect() protoreflect.Message {
+func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2054,23 +2390,39 @@ func (x *CloseAssignmentDiscoverResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseAssignmentDiscoverResponse.ProtoReflect.Descriptor instead.
-func (*CloseAssignmentDiscoverResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{25}
 }
 
-// StreamingNodeInfo is the information of a streaming node.
-type StreamingNodeInfo struct {
+func (x *BroadcastResponse) GetResults() map[string]*ProduceMessageResponseResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BroadcastResponse) GetBroadcastId() uint64 {
+	if x != nil {
+		return x.BroadcastId
+	}
+	return 0
+}
+
+type BroadcastAckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerId int64  `protobuf:"varint,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Deprecated: Marked as deprecated in streaming.proto.
+	BroadcastId uint64 `protobuf:"varint,1,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"` // broadcast id.
+	// Deprecated: Marked as deprecated in streaming.proto.
+	Vchannel string                     `protobuf:"bytes,2,opt,name=vchannel,proto3" json:"vchannel,omitempty"` // the vchannel that acked the message.
+	Message  *commonpb.ImmutableMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`   // the message that to be acked.
 }
 
-func (x *StreamingNodeInfo) Reset() {
-	*x = StreamingNodeInfo{}
+func (x *BroadcastAckRequest) Reset() {
+	*x = BroadcastAckRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2078,13 +2430,13 @@ func (x *StreamingNodeInfo) Reset() {
 	}
 }
 
-func (x *StreamingNodeInfo) String() string {
+func (x *BroadcastAckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeInfo) ProtoMessage() {}
+func (*BroadcastAckRequest) ProtoMessage() {}
 
-func (x *StreamingNodeInfo) ProtoReflect() protoreflect.Message {
+func (x *BroadcastAckRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2096,37 +2448,42 @@ func (x *StreamingNodeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeInfo.ProtoReflect.Descriptor instead.
-func (*StreamingNodeInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastAckRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastAckRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StreamingNodeInfo) GetServerId() int64 {
+// Deprecated: Marked as deprecated in streaming.proto.
+func (x *BroadcastAckRequest) GetBroadcastId() uint64 {
 	if x != nil {
-		return x.ServerId
+		return x.BroadcastId
 	}
 	return 0
 }
 
-func (x *StreamingNodeInfo) GetAddress() string {
+// Deprecated: Marked as deprecated in streaming.proto.
+func (x *BroadcastAckRequest) GetVchannel() string {
 	if x != nil {
-		return x.Address
+		return x.Vchannel
 	}
 	return ""
 }
 
-// StreamingNodeAssignment is the assignment info of a streaming node.
-type StreamingNodeAssignment struct {
+func (x *BroadcastAckRequest) GetMessage() *commonpb.ImmutableMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type BroadcastAckResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Node     *StreamingNodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Channels []*PChannelInfo    `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-func (x *StreamingNodeAssignment) Reset() {
-	*x = StreamingNodeAssignment{}
+func (x *BroadcastAckResponse) Reset() {
+	*x = BroadcastAckResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2134,13 +2491,13 @@ func (x *StreamingNodeAssignment) Reset() {
 	}
 }
 
-func (x *StreamingNodeAssignment) String() string {
+func (x *BroadcastAckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeAssignment) ProtoMessage() {}
+func (*BroadcastAckResponse) ProtoMessage() {}
 
-func (x *StreamingNodeAssignment) ProtoReflect() protoreflect.Message {
+func (x *BroadcastAckResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2152,42 +2509,23 @@ func (x *StreamingNodeAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeAssignment.ProtoReflect.Descriptor instead.
-func (*StreamingNodeAssignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastAckResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastAckResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StreamingNodeAssignment) GetNode() *StreamingNodeInfo {
-	if x != nil {
-		return x.Node
-	}
-	return nil
-}
-
-func (x *StreamingNodeAssignment) GetChannels() []*PChannelInfo {
-	if x != nil {
-		return x.Channels
-	}
-	return nil
-}
-
-// DeliverPolicy is the policy to deliver message.
-type DeliverPolicy struct {
+// BroadcastWatchRequest is the request of the Watch RPC.
+type BroadcastWatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Policy:
-	//
-	//	*DeliverPolicy_All
-	//	*DeliverPolicy_Latest
-	//	*DeliverPolicy_StartFrom
-	//	*DeliverPolicy_StartAfter
-	Policy isDeliverPolicy_Policy `protobuf_oneof:"policy"`
+	ResumeToken  *BroadcastWatchResumeToken `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                                                   // watch after the resume token, watch from latest if not set.
+	MessageTypes []messagespb.MessageType   `protobuf:"varint,2,rep,packed,name=message_types,json=messageTypes,proto3,enum=milvus.proto.messages.MessageType" json:"message_types,omitempty"` // only watch the given message types, watch all if empty.
 }
 
-func (x *DeliverPolicy) Reset() {
-	*x = DeliverPolicy{}
+func (x *BroadcastWatchRequest) Reset() {
+	*x = BroadcastWatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2195,13 +2533,13 @@ func (x *DeliverPolicy) Reset() {
 	}
 }
 
-func (x *DeliverPolicy) String() string {
+func (x *BroadcastWatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliverPolicy) ProtoMessage() {}
+func (*BroadcastWatchRequest) ProtoMessage() {}
 
-func (x *DeliverPolicy) ProtoReflect() protoreflect.Message {
+func (x *BroadcastWatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2213,90 +2551,37 @@ func (x *DeliverPolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliverPolicy.ProtoReflect.Descriptor instead.
-func (*DeliverPolicy) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastWatchRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastWatchRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{28}
 }
 
-func (m *DeliverPolicy) GetPolicy() isDeliverPolicy_Policy {
-	if m != nil {
-		return m.Policy
-	}
-	return nil
-}
-
-func (x *DeliverPolicy) GetAll() *emptypb.Empty {
-	if x, ok := x.GetPolicy().(*DeliverPolicy_All); ok {
-		return x.All
-	}
-	return nil
-}
-
-func (x *DeliverPolicy) GetLatest() *emptypb.Empty {
-	if x, ok := x.GetPolicy().(*DeliverPolicy_Latest); ok {
-		return x.Latest
-	}
-	return nil
-}
-
-func (x *DeliverPolicy) GetStartFrom() *commonpb.MessageID {
-	if x, ok := x.GetPolicy().(*DeliverPolicy_StartFrom); ok {
-		return x.StartFrom
+func (x *BroadcastWatchRequest) GetResumeToken() *BroadcastWatchResumeToken {
+	if x != nil {
+		return x.ResumeToken
 	}
 	return nil
 }
 
-func (x *DeliverPolicy) GetStartAfter() *commonpb.MessageID {
-	if x, ok := x.GetPolicy().(*DeliverPolicy_StartAfter); ok {
-		return x.StartAfter
+func (x *BroadcastWatchRequest) GetMessageTypes() []messagespb.MessageType {
+	if x != nil {
+		return x.MessageTypes
 	}
 	return nil
 }
 
-type isDeliverPolicy_Policy interface {
-	isDeliverPolicy_Policy()
-}
-
-type DeliverPolicy_All struct {
-	All *emptypb.Empty `protobuf:"bytes,1,opt,name=all,proto3,oneof"` // deliver all messages.
-}
-
-type DeliverPolicy_Latest struct {
-	Latest *emptypb.Empty `protobuf:"bytes,2,opt,name=latest,proto3,oneof"` // deliver the latest message.
-}
-
-type DeliverPolicy_StartFrom struct {
-	StartFrom *commonpb.MessageID `protobuf:"bytes,3,opt,name=start_from,json=startFrom,proto3,oneof"` // deliver message from this message id. [startFrom, ...]
-}
-
-type DeliverPolicy_StartAfter struct {
-	StartAfter *commonpb.MessageID `protobuf:"bytes,4,opt,name=start_after,json=startAfter,proto3,oneof"` // deliver message after this message id. (startAfter, ...]
-}
-
-func (*DeliverPolicy_All) isDeliverPolicy_Policy() {}
-
-func (*DeliverPolicy_Latest) isDeliverPolicy_Policy() {}
-
-func (*DeliverPolicy_StartFrom) isDeliverPolicy_Policy() {}
-
-func (*DeliverPolicy_StartAfter) isDeliverPolicy_Policy() {}
-
-// DeliverFilter is the filter to deliver message.
-type DeliverFilter struct {
+// BroadcastWatchResumeToken is the position of the applied broadcast event stream.
+type BroadcastWatchResumeToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Filter:
-	//
-	//	*DeliverFilter_TimeTickGt
-	//	*DeliverFilter_TimeTickGte
-	//	*DeliverFilter_MessageType
-	Filter isDeliverFilter_Filter `protobuf_oneof:"filter"`
+	Epoch    int64  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`       // the epoch of streamingcoord, the sequence is only comparable in same epoch.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"` // the sequence of the event in the epoch.
 }
 
-func (x *DeliverFilter) Reset() {
-	*x = DeliverFilter{}
+func (x *BroadcastWatchResumeToken) Reset() {
+	*x = BroadcastWatchResumeToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2304,13 +2589,13 @@ func (x *DeliverFilter) Reset() {
 	}
 }
 
-func (x *DeliverFilter) String() string {
+func (x *BroadcastWatchResumeToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliverFilter) ProtoMessage() {}
+func (*BroadcastWatchResumeToken) ProtoMessage() {}
 
-func (x *DeliverFilter) ProtoReflect() protoreflect.Message {
+func (x *BroadcastWatchResumeToken) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2322,73 +2607,40 @@ func (x *DeliverFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliverFilter.ProtoReflect.Descriptor instead.
-func (*DeliverFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastWatchResumeToken.ProtoReflect.Descriptor instead.
+func (*BroadcastWatchResumeToken) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{29}
 }
 
-func (m *DeliverFilter) GetFilter() isDeliverFilter_Filter {
-	if m != nil {
-		return m.Filter
-	}
-	return nil
-}
-
-func (x *DeliverFilter) GetTimeTickGt() *DeliverFilterTimeTickGT {
-	if x, ok := x.GetFilter().(*DeliverFilter_TimeTickGt); ok {
-		return x.TimeTickGt
-	}
-	return nil
-}
-
-func (x *DeliverFilter) GetTimeTickGte() *DeliverFilterTimeTickGTE {
-	if x, ok := x.GetFilter().(*DeliverFilter_TimeTickGte); ok {
-		return x.TimeTickGte
+func (x *BroadcastWatchResumeToken) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *DeliverFilter) GetMessageType() *DeliverFilterMessageType {
-	if x, ok := x.GetFilter().(*DeliverFilter_MessageType); ok {
-		return x.MessageType
+func (x *BroadcastWatchResumeToken) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
 	}
-	return nil
-}
-
-type isDeliverFilter_Filter interface {
-	isDeliverFilter_Filter()
-}
-
-type DeliverFilter_TimeTickGt struct {
-	TimeTickGt *DeliverFilterTimeTickGT `protobuf:"bytes,1,opt,name=time_tick_gt,json=timeTickGt,proto3,oneof"`
-}
-
-type DeliverFilter_TimeTickGte struct {
-	TimeTickGte *DeliverFilterTimeTickGTE `protobuf:"bytes,2,opt,name=time_tick_gte,json=timeTickGte,proto3,oneof"`
-}
-
-type DeliverFilter_MessageType struct {
-	MessageType *DeliverFilterMessageType `protobuf:"bytes,3,opt,name=message_type,json=messageType,proto3,oneof"`
+	return 0
 }
 
-func (*DeliverFilter_TimeTickGt) isDeliverFilter_Filter() {}
-
-func (*DeliverFilter_TimeTickGte) isDeliverFilter_Filter() {}
-
-func (*DeliverFilter_MessageType) isDeliverFilter_Filter() {}
-
-// DeliverFilterTimeTickGT is the filter to deliver message with time tick
-// greater than this value.
-type DeliverFilterTimeTickGT struct {
+// BroadcastWatchResponse is the response of the Watch RPC.
+type BroadcastWatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimeTick uint64 `protobuf:"varint,1,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"` // deliver message with time tick greater than this value.
+	// Types that are assignable to Response:
+	//
+	//	*BroadcastWatchResponse_Event
+	//	*BroadcastWatchResponse_Resync
+	Response isBroadcastWatchResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *DeliverFilterTimeTickGT) Reset() {
-	*x = DeliverFilterTimeTickGT{}
+func (x *BroadcastWatchResponse) Reset() {
+	*x = BroadcastWatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2396,13 +2648,13 @@ func (x *DeliverFilterTimeTickGT) Reset() {
 	}
 }
 
-func (x *DeliverFilterTimeTickGT) String() string {
+func (x *BroadcastWatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliverFilterTimeTickGT) ProtoMessage() {}
+func (*BroadcastWatchResponse) ProtoMessage() {}
 
-func (x *DeliverFilterTimeTickGT) ProtoReflect() protoreflect.Message {
+func (x *BroadcastWatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2414,30 +2666,61 @@ func (x *DeliverFilterTimeTickGT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliverFilterTimeTickGT.ProtoReflect.Descriptor instead.
-func (*DeliverFilterTimeTickGT) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastWatchResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastWatchResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeliverFilterTimeTickGT) GetTimeTick() uint64 {
-	if x != nil {
-		return x.TimeTick
+func (m *BroadcastWatchResponse) GetResponse() isBroadcastWatchResponse_Response {
+	if m != nil {
+		return m.Response
 	}
-	return 0
+	return nil
 }
 
-// DeliverFilterTimeTickGTE is the filter to deliver message with time tick
-// greater than or equal to this value.
-type DeliverFilterTimeTickGTE struct {
+func (x *BroadcastWatchResponse) GetEvent() *BroadcastWatchEvent {
+	if x, ok := x.GetResponse().(*BroadcastWatchResponse_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *BroadcastWatchResponse) GetResync() *BroadcastWatchResync {
+	if x, ok := x.GetResponse().(*BroadcastWatchResponse_Resync); ok {
+		return x.Resync
+	}
+	return nil
+}
+
+type isBroadcastWatchResponse_Response interface {
+	isBroadcastWatchResponse_Response()
+}
+
+type BroadcastWatchResponse_Event struct {
+	Event *BroadcastWatchEvent `protobuf:"bytes,1,opt,name=event,proto3,oneof"`
+}
+
+type BroadcastWatchResponse_Resync struct {
+	Resync *BroadcastWatchResync `protobuf:"bytes,2,opt,name=resync,proto3,oneof"`
+}
+
+func (*BroadcastWatchResponse_Event) isBroadcastWatchResponse_Response() {}
+
+func (*BroadcastWatchResponse_Resync) isBroadcastWatchResponse_Response() {}
+
+// BroadcastWatchEvent is the event that a broadcast message is applied.
+type BroadcastWatchEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimeTick uint64 `protobuf:"varint,1,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"` // deliver message with time tick greater than or
+	ResumeToken *BroadcastWatchResumeToken `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	BroadcastId uint64                     `protobuf:"varint,2,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"`
+	Message     *messagespb.Message        `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *DeliverFilterTimeTickGTE) Reset() {
-	*x = DeliverFilterTimeTickGTE{}
+func (x *BroadcastWatchEvent) Reset() {
+	*x = BroadcastWatchEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2445,13 +2728,13 @@ func (x *DeliverFilterTimeTickGTE) Reset() {
 	}
 }
 
-func (x *DeliverFilterTimeTickGTE) String() string {
+func (x *BroadcastWatchEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliverFilterTimeTickGTE) ProtoMessage() {}
+func (*BroadcastWatchEvent) ProtoMessage() {}
 
-func (x *DeliverFilterTimeTickGTE) ProtoReflect() protoreflect.Message {
+func (x *BroadcastWatchEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2463,29 +2746,44 @@ func (x *DeliverFilterTimeTickGTE) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliverFilterTimeTickGTE.ProtoReflect.Descriptor instead.
-func (*DeliverFilterTimeTickGTE) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastWatchEvent.ProtoReflect.Descriptor instead.
+func (*BroadcastWatchEvent) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeliverFilterTimeTickGTE) GetTimeTick() uint64 {
+func (x *BroadcastWatchEvent) GetResumeToken() *BroadcastWatchResumeToken {
 	if x != nil {
-		return x.TimeTick
+		return x.ResumeToken
+	}
+	return nil
+}
+
+func (x *BroadcastWatchEvent) GetBroadcastId() uint64 {
+	if x != nil {
+		return x.BroadcastId
 	}
 	return 0
 }
 
-type DeliverFilterMessageType struct {
+func (x *BroadcastWatchEvent) GetMessage() *messagespb.Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// BroadcastWatchResync asks the watcher to reload the state from meta,
+// the following events are delivered after the resume token.
+type BroadcastWatchResync struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// deliver message with message type.
-	MessageTypes []messagespb.MessageType `protobuf:"varint,1,rep,packed,name=message_types,json=messageTypes,proto3,enum=milvus.proto.messages.MessageType" json:"message_types,omitempty"`
+	ResumeToken *BroadcastWatchResumeToken `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (x *DeliverFilterMessageType) Reset() {
-	*x = DeliverFilterMessageType{}
+func (x *BroadcastWatchResync) Reset() {
+	*x = BroadcastWatchResync{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2493,13 +2791,13 @@ func (x *DeliverFilterMessageType) Reset() {
 	}
 }
 
-func (x *DeliverFilterMessageType) String() string {
+func (x *BroadcastWatchResync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeliverFilterMessageType) ProtoMessage() {}
+func (*BroadcastWatchResync) ProtoMessage() {}
 
-func (x *DeliverFilterMessageType) ProtoReflect() protoreflect.Message {
+func (x *BroadcastWatchResync) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2511,30 +2809,29 @@ func (x *DeliverFilterMessageType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeliverFilterMessageType.ProtoReflect.Descriptor instead.
-func (*DeliverFilterMessageType) Descriptor() ([]byte, []int) {
+// Deprecated: Use BroadcastWatchResync.ProtoReflect.Descriptor instead.
+func (*BroadcastWatchResync) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeliverFilterMessageType) GetMessageTypes() []messagespb.MessageType {
+func (x *BroadcastWatchResync) GetResumeToken() *BroadcastWatchResumeToken {
 	if x != nil {
-		return x.MessageTypes
+		return x.ResumeToken
 	}
 	return nil
 }
 
-// StreamingError is the error type for log internal component.
-type StreamingError struct {
+// MigratePChannelTopicsRequest is the request to migrate the wal of pchannels to other topics.
+type MigratePChannelTopicsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  StreamingCode `protobuf:"varint,1,opt,name=code,proto3,enum=milvus.proto.streaming.StreamingCode" json:"code,omitempty"`
-	Cause string        `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	PchannelTopics map[string]string `protobuf:"bytes,1,rep,name=pchannel_topics,json=pchannelTopics,proto3" json:"pchannel_topics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the target topics keyed by the pchannel name.
 }
 
-func (x *StreamingError) Reset() {
-	*x = StreamingError{}
+func (x *MigratePChannelTopicsRequest) Reset() {
+	*x = MigratePChannelTopicsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2542,13 +2839,13 @@ func (x *StreamingError) Reset() {
 	}
 }
 
-func (x *StreamingError) String() string {
+func (x *MigratePChannelTopicsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingError) ProtoMessage() {}
+func (*MigratePChannelTopicsRequest) ProtoMessage() {}
 
-func (x *StreamingError) ProtoReflect() protoreflect.Message {
+func (x *MigratePChannelTopicsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2560,36 +2857,29 @@ func (x *StreamingError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingError.ProtoReflect.Descriptor instead.
-func (*StreamingError) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigratePChannelTopicsRequest.ProtoReflect.Descriptor instead.
+func (*MigratePChannelTopicsRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *StreamingError) GetCode() StreamingCode {
-	if x != nil {
-		return x.Code
-	}
-	return StreamingCode_STREAMING_CODE_OK
-}
-
-func (x *StreamingError) GetCause() string {
+func (x *MigratePChannelTopicsRequest) GetPchannelTopics() map[string]string {
 	if x != nil {
-		return x.Cause
+		return x.PchannelTopics
 	}
-	return ""
+	return nil
 }
 
-// GetReplicateCheckpointRequest is the request of GetReplicateCheckpoint service.
-type GetReplicateCheckpointRequest struct {
+// MigratePChannelTopicsResponse is the response of MigratePChannelTopics.
+type MigratePChannelTopicsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	BroadcastId uint64 `protobuf:"varint,1,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"` // the broadcast id of the AlterWAL message that fences the pchannels.
 }
 
-func (x *GetReplicateCheckpointRequest) Reset() {
-	*x = GetReplicateCheckpointRequest{}
+func (x *MigratePChannelTopicsResponse) Reset() {
+	*x = MigratePChannelTopicsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2597,13 +2887,13 @@ func (x *GetReplicateCheckpointRequest) Reset() {
 	}
 }
 
-func (x *GetReplicateCheckpointRequest) String() string {
+func (x *MigratePChannelTopicsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicateCheckpointRequest) ProtoMessage() {}
+func (*MigratePChannelTopicsResponse) ProtoMessage() {}
 
-func (x *GetReplicateCheckpointRequest) ProtoReflect() protoreflect.Message {
+func (x *MigratePChannelTopicsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2615,29 +2905,30 @@ func (x *GetReplicateCheckpointRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicateCheckpointRequest.ProtoReflect.Descriptor instead.
-func (*GetReplicateCheckpointRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigratePChannelTopicsResponse.ProtoReflect.Descriptor instead.
+func (*MigratePChannelTopicsResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetReplicateCheckpointRequest) GetPchannel() *PChannelInfo {
+func (x *MigratePChannelTopicsResponse) GetBroadcastId() uint64 {
 	if x != nil {
-		return x.Pchannel
+		return x.BroadcastId
 	}
-	return nil
+	return 0
 }
 
-// GetReplicateCheckpointResponse is the response of GetReplicateCheckpoint service.
-type GetReplicateCheckpointResponse struct {
+// UpdatePChannelRetentionPoliciesRequest is the request to update the retention policies of pchannels.
+type UpdatePChannelRetentionPoliciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Checkpoint *commonpb.ReplicateCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Policies       []*PChannelRetentionPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`                                   // the policies to be set or replaced.
+	ClearPchannels []string                   `protobuf:"bytes,2,rep,name=clear_pchannels,json=clearPchannels,proto3" json:"clear_pchannels,omitempty"` // the pchannels whose policy is cleared.
 }
 
-func (x *GetReplicateCheckpointResponse) Reset() {
-	*x = GetReplicateCheckpointResponse{}
+func (x *UpdatePChannelRetentionPoliciesRequest) Reset() {
+	*x = UpdatePChannelRetentionPoliciesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2645,13 +2936,13 @@ func (x *GetReplicateCheckpointResponse) Reset() {
 	}
 }
 
-func (x *GetReplicateCheckpointResponse) String() string {
+func (x *UpdatePChannelRetentionPoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicateCheckpointResponse) ProtoMessage() {}
+func (*UpdatePChannelRetentionPoliciesRequest) ProtoMessage() {}
 
-func (x *GetReplicateCheckpointResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelRetentionPoliciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2663,29 +2954,36 @@ func (x *GetReplicateCheckpointResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicateCheckpointResponse.ProtoReflect.Descriptor instead.
-func (*GetReplicateCheckpointResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelRetentionPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetReplicateCheckpointResponse) GetCheckpoint() *commonpb.ReplicateCheckpoint {
+func (x *UpdatePChannelRetentionPoliciesRequest) GetPolicies() []*PChannelRetentionPolicy {
 	if x != nil {
-		return x.Checkpoint
+		return x.Policies
 	}
 	return nil
 }
 
-// GetSalvageCheckpointRequest is the request of GetSalvageCheckpoint service.
-type GetSalvageCheckpointRequest struct {
+func (x *UpdatePChannelRetentionPoliciesRequest) GetClearPchannels() []string {
+	if x != nil {
+		return x.ClearPchannels
+	}
+	return nil
+}
+
+// UpdatePChannelRetentionPoliciesResponse is the response of UpdatePChannelRetentionPolicies.
+type UpdatePChannelRetentionPoliciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	Policies []*PChannelRetentionPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"` // all policies after the update, ordered by the pchannel name.
 }
 
-func (x *GetSalvageCheckpointRequest) Reset() {
-	*x = GetSalvageCheckpointRequest{}
+func (x *UpdatePChannelRetentionPoliciesResponse) Reset() {
+	*x = UpdatePChannelRetentionPoliciesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2693,13 +2991,13 @@ func (x *GetSalvageCheckpointRequest) Reset() {
 	}
 }
 
-func (x *GetSalvageCheckpointRequest) String() string {
+func (x *UpdatePChannelRetentionPoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSalvageCheckpointRequest) ProtoMessage() {}
+func (*UpdatePChannelRetentionPoliciesResponse) ProtoMessage() {}
 
-func (x *GetSalvageCheckpointRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelRetentionPoliciesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2711,29 +3009,29 @@ func (x *GetSalvageCheckpointRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSalvageCheckpointRequest.ProtoReflect.Descriptor instead.
-func (*GetSalvageCheckpointRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelRetentionPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetSalvageCheckpointRequest) GetPchannel() *PChannelInfo {
+func (x *UpdatePChannelRetentionPoliciesResponse) GetPolicies() []*PChannelRetentionPolicy {
 	if x != nil {
-		return x.Pchannel
+		return x.Policies
 	}
 	return nil
 }
 
-// GetSalvageCheckpointResponse is the response of GetSalvageCheckpoint service.
-type GetSalvageCheckpointResponse struct {
+// GetPChannelRetentionsRequest is the request to get the retention of the wal of pchannels.
+type GetPChannelRetentionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Checkpoints []*commonpb.ReplicateCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	Pchannels []string `protobuf:"bytes,1,rep,name=pchannels,proto3" json:"pchannels,omitempty"` // the pchannels to get.
 }
 
-func (x *GetSalvageCheckpointResponse) Reset() {
-	*x = GetSalvageCheckpointResponse{}
+func (x *GetPChannelRetentionsRequest) Reset() {
+	*x = GetPChannelRetentionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2741,13 +3039,13 @@ func (x *GetSalvageCheckpointResponse) Reset() {
 	}
 }
 
-func (x *GetSalvageCheckpointResponse) String() string {
+func (x *GetPChannelRetentionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSalvageCheckpointResponse) ProtoMessage() {}
+func (*GetPChannelRetentionsRequest) ProtoMessage() {}
 
-func (x *GetSalvageCheckpointResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPChannelRetentionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2759,35 +3057,29 @@ func (x *GetSalvageCheckpointResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSalvageCheckpointResponse.ProtoReflect.Descriptor instead.
-func (*GetSalvageCheckpointResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPChannelRetentionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPChannelRetentionsRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetSalvageCheckpointResponse) GetCheckpoints() []*commonpb.ReplicateCheckpoint {
+func (x *GetPChannelRetentionsRequest) GetPchannels() []string {
 	if x != nil {
-		return x.Checkpoints
+		return x.Pchannels
 	}
 	return nil
 }
 
-// ProduceRequest is the request of the Produce RPC.
-// Channel name will be passthrough in the header of stream bu not in the
-// request body.
-type ProduceRequest struct {
+// GetPChannelRetentionsResponse is the response of GetPChannelRetentions.
+type GetPChannelRetentionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Request:
-	//
-	//	*ProduceRequest_Produce
-	//	*ProduceRequest_Close
-	Request isProduceRequest_Request `protobuf_oneof:"request"`
+	Retentions []*PChannelRetention `protobuf:"bytes,1,rep,name=retentions,proto3" json:"retentions,omitempty"` // one retention for each requested pchannel.
 }
 
-func (x *ProduceRequest) Reset() {
-	*x = ProduceRequest{}
+func (x *GetPChannelRetentionsResponse) Reset() {
+	*x = GetPChannelRetentionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2795,13 +3087,13 @@ func (x *ProduceRequest) Reset() {
 	}
 }
 
-func (x *ProduceRequest) String() string {
+func (x *GetPChannelRetentionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProduceRequest) ProtoMessage() {}
+func (*GetPChannelRetentionsResponse) ProtoMessage() {}
 
-func (x *ProduceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPChannelRetentionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2813,60 +3105,39 @@ func (x *ProduceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProduceRequest.ProtoReflect.Descriptor instead.
-func (*ProduceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPChannelRetentionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPChannelRetentionsResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{38}
 }
 
-func (m *ProduceRequest) GetRequest() isProduceRequest_Request {
-	if m != nil {
-		return m.Request
-	}
-	return nil
-}
-
-func (x *ProduceRequest) GetProduce() *ProduceMessageRequest {
-	if x, ok := x.GetRequest().(*ProduceRequest_Produce); ok {
-		return x.Produce
-	}
-	return nil
-}
-
-func (x *ProduceRequest) GetClose() *CloseProducerRequest {
-	if x, ok := x.GetRequest().(*ProduceRequest_Close); ok {
-		return x.Close
+func (x *GetPChannelRetentionsResponse) GetRetentions() []*PChannelRetention {
+	if x != nil {
+		return x.Retentions
 	}
 	return nil
 }
 
-type isProduceRequest_Request interface {
-	isProduceRequest_Request()
-}
-
-type ProduceRequest_Produce struct {
-	Produce *ProduceMessageRequest `protobuf:"bytes,1,opt,name=produce,proto3,oneof"`
-}
-
-type ProduceRequest_Close struct {
-	Close *CloseProducerRequest `protobuf:"bytes,2,opt,name=close,proto3,oneof"`
-}
-
-func (*ProduceRequest_Produce) isProduceRequest_Request() {}
-
-func (*ProduceRequest_Close) isProduceRequest_Request() {}
-
-// CreateProducerRequest is the request of the CreateProducer RPC.
-// CreateProducerRequest is passed in the header of stream.
-type CreateProducerRequest struct {
+// PChannelRetention is the retention of the wal of a pchannel.
+type PChannelRetention struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
-}
-
-func (x *CreateProducerRequest) Reset() {
-	*x = CreateProducerRequest{}
+	Pchannel string              `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // the name of pchannel.
+	Policy   *WALRetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`     // the retention policy of the pchannel, nil if not set.
+	// the source message ids confirmed by the replicating tasks from the pchannel,
+	// the initialized checkpoint is used if the target cluster has not confirmed any message of the task.
+	ReplicateCheckpoints []*commonpb.MessageID `protobuf:"bytes,3,rep,name=replicate_checkpoints,json=replicateCheckpoints,proto3" json:"replicate_checkpoints,omitempty"`
+	// the checkpoint of some replicating task from the pchannel is unknown, e.g. the target cluster is unreachable,
+	// the wal should not be truncated until it's known.
+	ReplicateCheckpointUnknown bool `protobuf:"varint,4,opt,name=replicate_checkpoint_unknown,json=replicateCheckpointUnknown,proto3" json:"replicate_checkpoint_unknown,omitempty"`
+	// the minimum time tick of the replicate checkpoints, 0 if no replicate checkpoint is known,
+	// used to measure the lag of the replicating tasks from the pchannel.
+	ReplicateCheckpointTimeTick uint64 `protobuf:"varint,5,opt,name=replicate_checkpoint_time_tick,json=replicateCheckpointTimeTick,proto3" json:"replicate_checkpoint_time_tick,omitempty"`
+}
+
+func (x *PChannelRetention) Reset() {
+	*x = PChannelRetention{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2874,13 +3145,13 @@ func (x *CreateProducerRequest) Reset() {
 	}
 }
 
-func (x *CreateProducerRequest) String() string {
+func (x *PChannelRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProducerRequest) ProtoMessage() {}
+func (*PChannelRetention) ProtoMessage() {}
 
-func (x *CreateProducerRequest) ProtoReflect() protoreflect.Message {
+func (x *PChannelRetention) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2892,30 +3163,55 @@ func (x *CreateProducerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProducerRequest.ProtoReflect.Descriptor instead.
-func (*CreateProducerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PChannelRetention.ProtoReflect.Descriptor instead.
+func (*PChannelRetention) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CreateProducerRequest) GetPchannel() *PChannelInfo {
+func (x *PChannelRetention) GetPchannel() string {
 	if x != nil {
 		return x.Pchannel
 	}
+	return ""
+}
+
+func (x *PChannelRetention) GetPolicy() *WALRetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
 	return nil
 }
 
-// ProduceMessageRequest is the request of the Produce RPC.
-type ProduceMessageRequest struct {
+func (x *PChannelRetention) GetReplicateCheckpoints() []*commonpb.MessageID {
+	if x != nil {
+		return x.ReplicateCheckpoints
+	}
+	return nil
+}
+
+func (x *PChannelRetention) GetReplicateCheckpointUnknown() bool {
+	if x != nil {
+		return x.ReplicateCheckpointUnknown
+	}
+	return false
+}
+
+func (x *PChannelRetention) GetReplicateCheckpointTimeTick() uint64 {
+	if x != nil {
+		return x.ReplicateCheckpointTimeTick
+	}
+	return 0
+}
+
+// GetClusterChannelsRequest is the request to get the channels of current cluster.
+type GetClusterChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	RequestId int64               `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // request id for reply.
-	Message   *messagespb.Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                       // message to be sent.
 }
 
-func (x *ProduceMessageRequest) Reset() {
-	*x = ProduceMessageRequest{}
+func (x *GetClusterChannelsRequest) Reset() {
+	*x = GetClusterChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2923,13 +3219,13 @@ func (x *ProduceMessageRequest) Reset() {
 	}
 }
 
-func (x *ProduceMessageRequest) String() string {
+func (x *GetClusterChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProduceMessageRequest) ProtoMessage() {}
+func (*GetClusterChannelsRequest) ProtoMessage() {}
 
-func (x *ProduceMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *GetClusterChannelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2941,35 +3237,22 @@ func (x *ProduceMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProduceMessageRequest.ProtoReflect.Descriptor instead.
-func (*ProduceMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterChannelsRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ProduceMessageRequest) GetRequestId() int64 {
-	if x != nil {
-		return x.RequestId
-	}
-	return 0
-}
-
-func (x *ProduceMessageRequest) GetMessage() *messagespb.Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-// CloseProducerRequest is the request of the CloseProducer RPC.
-// After CloseProducerRequest is requested, no more ProduceRequest can be sent.
-type CloseProducerRequest struct {
+// GetClusterChannelsResponse is the response of GetClusterChannels.
+type GetClusterChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ClusterInfo *milvuspb.ClusterInfo `protobuf:"bytes,1,opt,name=cluster_info,json=clusterInfo,proto3" json:"cluster_info,omitempty"` // the pchannels are ordered by the index of the channel name.
 }
 
-func (x *CloseProducerRequest) Reset() {
-	*x = CloseProducerRequest{}
+func (x *GetClusterChannelsResponse) Reset() {
+	*x = GetClusterChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2977,13 +3260,13 @@ func (x *CloseProducerRequest) Reset() {
 	}
 }
 
-func (x *CloseProducerRequest) String() string {
+func (x *GetClusterChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseProducerRequest) ProtoMessage() {}
+func (*GetClusterChannelsResponse) ProtoMessage() {}
 
-func (x *CloseProducerRequest) ProtoReflect() protoreflect.Message {
+func (x *GetClusterChannelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2995,28 +3278,29 @@ func (x *CloseProducerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseProducerRequest.ProtoReflect.Descriptor instead.
-func (*CloseProducerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetClusterChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterChannelsResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{41}
 }
 
-// ProduceResponse is the response of the Produce RPC.
-type ProduceResponse struct {
+func (x *GetClusterChannelsResponse) GetClusterInfo() *milvuspb.ClusterInfo {
+	if x != nil {
+		return x.ClusterInfo
+	}
+	return nil
+}
+
+// GetReplicateConfigurationHistoryRequest is the request to get the history of the replicate configuration.
+type GetReplicateConfigurationHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*ProduceResponse_Create
-	//	*ProduceResponse_Produce
-	//	*ProduceResponse_Close
-	//	*ProduceResponse_RateLimit
-	Response isProduceResponse_Response `protobuf_oneof:"response"`
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // only return the configuration at the version if it's not zero.
 }
 
-func (x *ProduceResponse) Reset() {
-	*x = ProduceResponse{}
+func (x *GetReplicateConfigurationHistoryRequest) Reset() {
+	*x = GetReplicateConfigurationHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3024,13 +3308,13 @@ func (x *ProduceResponse) Reset() {
 	}
 }
 
-func (x *ProduceResponse) String() string {
+func (x *GetReplicateConfigurationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProduceResponse) ProtoMessage() {}
+func (*GetReplicateConfigurationHistoryRequest) ProtoMessage() {}
 
-func (x *ProduceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetReplicateConfigurationHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3042,102 +3326,92 @@ func (x *ProduceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProduceResponse.ProtoReflect.Descriptor instead.
-func (*ProduceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReplicateConfigurationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicateConfigurationHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{42}
 }
 
-func (m *ProduceResponse) GetResponse() isProduceResponse_Response {
-	if m != nil {
-		return m.Response
-	}
-	return nil
-}
-
-func (x *ProduceResponse) GetCreate() *CreateProducerResponse {
-	if x, ok := x.GetResponse().(*ProduceResponse_Create); ok {
-		return x.Create
+func (x *GetReplicateConfigurationHistoryRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *ProduceResponse) GetProduce() *ProduceMessageResponse {
-	if x, ok := x.GetResponse().(*ProduceResponse_Produce); ok {
-		return x.Produce
-	}
-	return nil
-}
+// GetReplicateConfigurationHistoryResponse is the response of GetReplicateConfigurationHistory.
+type GetReplicateConfigurationHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ProduceResponse) GetClose() *CloseProducerResponse {
-	if x, ok := x.GetResponse().(*ProduceResponse_Close); ok {
-		return x.Close
-	}
-	return nil
+	Histories []*ReplicateConfigurationHistoryMeta `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"` // ordered by version.
 }
 
-func (x *ProduceResponse) GetRateLimit() *ProduceRateLimitResponse {
-	if x, ok := x.GetResponse().(*ProduceResponse_RateLimit); ok {
-		return x.RateLimit
+func (x *GetReplicateConfigurationHistoryResponse) Reset() {
+	*x = GetReplicateConfigurationHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isProduceResponse_Response interface {
-	isProduceResponse_Response()
+func (x *GetReplicateConfigurationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ProduceResponse_Create struct {
-	Create *CreateProducerResponse `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
-}
+func (*GetReplicateConfigurationHistoryResponse) ProtoMessage() {}
 
-type ProduceResponse_Produce struct {
-	Produce *ProduceMessageResponse `protobuf:"bytes,2,opt,name=produce,proto3,oneof"`
+func (x *GetReplicateConfigurationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ProduceResponse_Close struct {
-	Close *CloseProducerResponse `protobuf:"bytes,3,opt,name=close,proto3,oneof"`
+// Deprecated: Use GetReplicateConfigurationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicateConfigurationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{43}
 }
 
-type ProduceResponse_RateLimit struct {
-	RateLimit *ProduceRateLimitResponse `protobuf:"bytes,4,opt,name=rate_limit,json=rateLimit,proto3,oneof"`
+func (x *GetReplicateConfigurationHistoryResponse) GetHistories() []*ReplicateConfigurationHistoryMeta {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
 }
 
-func (*ProduceResponse_Create) isProduceResponse_Response() {}
-
-func (*ProduceResponse_Produce) isProduceResponse_Response() {}
-
-func (*ProduceResponse_Close) isProduceResponse_Response() {}
-
-func (*ProduceResponse_RateLimit) isProduceResponse_Response() {}
-
-// CreateProducerResponse is the result of the CreateProducer RPC.
-type CreateProducerResponse struct {
+// ListReplicateDeadLettersRequest is the request to list the dead letters of the replicating tasks.
+type ListReplicateDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Marked as deprecated in streaming.proto.
-	WalName          string `protobuf:"bytes,1,opt,name=wal_name,json=walName,proto3" json:"wal_name,omitempty"`                               // wal name at server side.
-	ProducerServerId int64  `protobuf:"varint,2,opt,name=producer_server_id,json=producerServerId,proto3" json:"producer_server_id,omitempty"` // A unique producer server id on streamingnode
+	TargetClusterId string `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"` // all target clusters of current cluster are listed if empty.
 }
 
-func (x *CreateProducerResponse) Reset() {
-	*x = CreateProducerResponse{}
+func (x *ListReplicateDeadLettersRequest) Reset() {
+	*x = ListReplicateDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[43]
+		mi := &file_streaming_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateProducerResponse) String() string {
+func (x *ListReplicateDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProducerResponse) ProtoMessage() {}
+func (*ListReplicateDeadLettersRequest) ProtoMessage() {}
 
-func (x *CreateProducerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[43]
+func (x *ListReplicateDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3148,57 +3422,44 @@ func (x *CreateProducerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProducerResponse.ProtoReflect.Descriptor instead.
-func (*CreateProducerResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListReplicateDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListReplicateDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{44}
 }
 
-// Deprecated: Marked as deprecated in streaming.proto.
-func (x *CreateProducerResponse) GetWalName() string {
+func (x *ListReplicateDeadLettersRequest) GetTargetClusterId() string {
 	if x != nil {
-		return x.WalName
+		return x.TargetClusterId
 	}
 	return ""
 }
 
-func (x *CreateProducerResponse) GetProducerServerId() int64 {
-	if x != nil {
-		return x.ProducerServerId
-	}
-	return 0
-}
-
-// ProduceMessageResponse is the response of the ProduceMessage RPC.
-type ProduceMessageResponse struct {
+// ListReplicateDeadLettersResponse is the response of listing the dead letters of the replicating tasks.
+type ListReplicateDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestId int64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Types that are assignable to Response:
-	//
-	//	*ProduceMessageResponse_Result
-	//	*ProduceMessageResponse_Error
-	Response isProduceMessageResponse_Response `protobuf_oneof:"response"`
+	DeadLetters []*ReplicateDeadLetterMeta `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"` // ordered by the target cluster id and source channel name.
 }
 
-func (x *ProduceMessageResponse) Reset() {
-	*x = ProduceMessageResponse{}
+func (x *ListReplicateDeadLettersResponse) Reset() {
+	*x = ListReplicateDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[44]
+		mi := &file_streaming_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProduceMessageResponse) String() string {
+func (x *ListReplicateDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProduceMessageResponse) ProtoMessage() {}
+func (*ListReplicateDeadLettersResponse) ProtoMessage() {}
 
-func (x *ProduceMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[44]
+func (x *ListReplicateDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3209,82 +3470,45 @@ func (x *ProduceMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProduceMessageResponse.ProtoReflect.Descriptor instead.
-func (*ProduceMessageResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ListReplicateDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListReplicateDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ProduceMessageResponse) GetRequestId() int64 {
+func (x *ListReplicateDeadLettersResponse) GetDeadLetters() []*ReplicateDeadLetterMeta {
 	if x != nil {
-		return x.RequestId
-	}
-	return 0
-}
-
-func (m *ProduceMessageResponse) GetResponse() isProduceMessageResponse_Response {
-	if m != nil {
-		return m.Response
-	}
-	return nil
-}
-
-func (x *ProduceMessageResponse) GetResult() *ProduceMessageResponseResult {
-	if x, ok := x.GetResponse().(*ProduceMessageResponse_Result); ok {
-		return x.Result
-	}
-	return nil
-}
-
-func (x *ProduceMessageResponse) GetError() *StreamingError {
-	if x, ok := x.GetResponse().(*ProduceMessageResponse_Error); ok {
-		return x.Error
+		return x.DeadLetters
 	}
 	return nil
 }
 
-type isProduceMessageResponse_Response interface {
-	isProduceMessageResponse_Response()
-}
-
-type ProduceMessageResponse_Result struct {
-	Result *ProduceMessageResponseResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
-}
-
-type ProduceMessageResponse_Error struct {
-	Error *StreamingError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
-}
-
-func (*ProduceMessageResponse_Result) isProduceMessageResponse_Response() {}
-
-func (*ProduceMessageResponse_Error) isProduceMessageResponse_Response() {}
-
-// ProduceRateLimitResponse is the response to ask the client to slowdown/reject/recover the produce rate.
-type ProduceRateLimitResponse struct {
+// RequeueReplicateDeadLettersRequest is the request to requeue the dead letters of the replicating tasks.
+type RequeueReplicateDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State WALRateLimitState `protobuf:"varint,1,opt,name=state,proto3,enum=milvus.proto.streaming.WALRateLimitState" json:"state,omitempty"` // the state of the rate limit.
-	Rate  int64             `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`                                                 // the rate to slowdown current produce rate, bytes/s.
+	TargetClusterId    string   `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`          // required.
+	SourceChannelNames []string `protobuf:"bytes,2,rep,name=source_channel_names,json=sourceChannelNames,proto3" json:"source_channel_names,omitempty"` // all dead letters of the target cluster are requeued if empty.
 }
 
-func (x *ProduceRateLimitResponse) Reset() {
-	*x = ProduceRateLimitResponse{}
+func (x *RequeueReplicateDeadLettersRequest) Reset() {
+	*x = RequeueReplicateDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[45]
+		mi := &file_streaming_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProduceRateLimitResponse) String() string {
+func (x *RequeueReplicateDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProduceRateLimitResponse) ProtoMessage() {}
+func (*RequeueReplicateDeadLettersRequest) ProtoMessage() {}
 
-func (x *ProduceRateLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[45]
+func (x *RequeueReplicateDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3295,56 +3519,51 @@ func (x *ProduceRateLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProduceRateLimitResponse.ProtoReflect.Descriptor instead.
-func (*ProduceRateLimitResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RequeueReplicateDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*RequeueReplicateDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ProduceRateLimitResponse) GetState() WALRateLimitState {
+func (x *RequeueReplicateDeadLettersRequest) GetTargetClusterId() string {
 	if x != nil {
-		return x.State
+		return x.TargetClusterId
 	}
-	return WALRateLimitState_WAL_RATE_LIMIT_STATE_UNKNOWN
+	return ""
 }
 
-func (x *ProduceRateLimitResponse) GetRate() int64 {
+func (x *RequeueReplicateDeadLettersRequest) GetSourceChannelNames() []string {
 	if x != nil {
-		return x.Rate
+		return x.SourceChannelNames
 	}
-	return 0
+	return nil
 }
 
-// ProduceMessageResponseResult is the result of the produce message streaming
-// RPC.
-type ProduceMessageResponseResult struct {
+// RequeueReplicateDeadLettersResponse is the response of requeuing the dead letters of the replicating tasks.
+type RequeueReplicateDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              *commonpb.MessageID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                    // the offset of the message in the channel.
-	Timetick        uint64                 `protobuf:"varint,2,opt,name=timetick,proto3" json:"timetick,omitempty"`                                       // the timetick of that message sent.
-	TxnContext      *messagespb.TxnContext `protobuf:"bytes,3,opt,name=txnContext,proto3" json:"txnContext,omitempty"`                                    // the txn context of the message.
-	Extra           *anypb.Any             `protobuf:"bytes,4,opt,name=extra,proto3" json:"extra,omitempty"`                                              // the extra message.
-	LastConfirmedId *commonpb.MessageID    `protobuf:"bytes,5,opt,name=last_confirmed_id,json=lastConfirmedId,proto3" json:"last_confirmed_id,omitempty"` // the last confirmed message id.
+	DeadLetters []*ReplicateDeadLetterMeta `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"` // the requeued dead letters.
 }
 
-func (x *ProduceMessageResponseResult) Reset() {
-	*x = ProduceMessageResponseResult{}
+func (x *RequeueReplicateDeadLettersResponse) Reset() {
+	*x = RequeueReplicateDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[46]
+		mi := &file_streaming_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProduceMessageResponseResult) String() string {
+func (x *RequeueReplicateDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProduceMessageResponseResult) ProtoMessage() {}
+func (*RequeueReplicateDeadLettersResponse) ProtoMessage() {}
 
-func (x *ProduceMessageResponseResult) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[46]
+func (x *RequeueReplicateDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3355,70 +3574,92 @@ func (x *ProduceMessageResponseResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProduceMessageResponseResult.ProtoReflect.Descriptor instead.
-func (*ProduceMessageResponseResult) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use RequeueReplicateDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*RequeueReplicateDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ProduceMessageResponseResult) GetId() *commonpb.MessageID {
+func (x *RequeueReplicateDeadLettersResponse) GetDeadLetters() []*ReplicateDeadLetterMeta {
 	if x != nil {
-		return x.Id
+		return x.DeadLetters
 	}
 	return nil
 }
 
-func (x *ProduceMessageResponseResult) GetTimetick() uint64 {
-	if x != nil {
-		return x.Timetick
-	}
-	return 0
+// ListReplicatingTasksRequest is the request to list the replicating tasks.
+type ListReplicatingTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetClusterId string `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"` // all target clusters of current cluster are listed if empty.
 }
 
-func (x *ProduceMessageResponseResult) GetTxnContext() *messagespb.TxnContext {
-	if x != nil {
-		return x.TxnContext
+func (x *ListReplicatingTasksRequest) Reset() {
+	*x = ListReplicatingTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ProduceMessageResponseResult) GetExtra() *anypb.Any {
-	if x != nil {
-		return x.Extra
+func (x *ListReplicatingTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplicatingTasksRequest) ProtoMessage() {}
+
+func (x *ListReplicatingTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ProduceMessageResponseResult) GetLastConfirmedId() *commonpb.MessageID {
+// Deprecated: Use ListReplicatingTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListReplicatingTasksRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListReplicatingTasksRequest) GetTargetClusterId() string {
 	if x != nil {
-		return x.LastConfirmedId
+		return x.TargetClusterId
 	}
-	return nil
+	return ""
 }
 
-// CloseProducerResponse is the result of the CloseProducer RPC.
-type CloseProducerResponse struct {
+// ListReplicatingTasksResponse is the response of listing the replicating tasks.
+type ListReplicatingTasksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tasks []*ReplicatingTaskProgress `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // ordered by the target cluster id and source channel name.
 }
 
-func (x *CloseProducerResponse) Reset() {
-	*x = CloseProducerResponse{}
+func (x *ListReplicatingTasksResponse) Reset() {
+	*x = ListReplicatingTasksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[47]
+		mi := &file_streaming_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseProducerResponse) String() string {
+func (x *ListReplicatingTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseProducerResponse) ProtoMessage() {}
+func (*ListReplicatingTasksResponse) ProtoMessage() {}
 
-func (x *CloseProducerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[47]
+func (x *ListReplicatingTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3429,44 +3670,55 @@ func (x *CloseProducerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseProducerResponse.ProtoReflect.Descriptor instead.
-func (*CloseProducerResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListReplicatingTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListReplicatingTasksResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{49}
 }
 
-// ConsumeRequest is the request of the Consume RPC.
-// Add more control block in future.
-type ConsumeRequest struct {
+func (x *ListReplicatingTasksResponse) GetTasks() []*ReplicatingTaskProgress {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// ReplicatingTaskProgress is the replicating task with its progress.
+type ReplicatingTaskProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Request:
-	//
-	//	*ConsumeRequest_CreateVchannelConsumer
-	//	*ConsumeRequest_CreateVchannelConsumers
-	//	*ConsumeRequest_CloseVchannel
-	//	*ConsumeRequest_Close
-	Request isConsumeRequest_Request `protobuf_oneof:"request"`
-}
-
-func (x *ConsumeRequest) Reset() {
-	*x = ConsumeRequest{}
+	Task *ReplicatePChannelMeta `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// the checkpoint confirmed by the target cluster, null if the target cluster has no checkpoint or is unreachable.
+	Checkpoint *commonpb.ReplicateCheckpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// the lag between now and the time tick of the checkpoint in milliseconds, 0 if the checkpoint is null.
+	LagMilliseconds int64                       `protobuf:"varint,3,opt,name=lag_milliseconds,json=lagMilliseconds,proto3" json:"lag_milliseconds,omitempty"`
+	Error           string                      `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // the reason why the checkpoint cannot be fetched from the target cluster.
+	RuntimeState    ReplicatingTaskRuntimeState `protobuf:"varint,5,opt,name=runtime_state,json=runtimeState,proto3,enum=milvus.proto.streaming.ReplicatingTaskRuntimeState" json:"runtime_state,omitempty"`
+	// the streaming node that serves the source pchannel of the task, 0 if the pchannel is not assigned.
+	StreamingNodeId int64 `protobuf:"varint,6,opt,name=streaming_node_id,json=streamingNodeId,proto3" json:"streaming_node_id,omitempty"`
+	// the dead letter of the task, null if the task is not parked.
+	// the error of the dead letter is the recent rejection of the target cluster.
+	DeadLetter *ReplicateDeadLetterMeta `protobuf:"bytes,7,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+}
+
+func (x *ReplicatingTaskProgress) Reset() {
+	*x = ReplicatingTaskProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[48]
+		mi := &file_streaming_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConsumeRequest) String() string {
+func (x *ReplicatingTaskProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeRequest) ProtoMessage() {}
+func (*ReplicatingTaskProgress) ProtoMessage() {}
 
-func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[48]
+func (x *ReplicatingTaskProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3477,99 +3729,86 @@ func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeRequest.ProtoReflect.Descriptor instead.
-func (*ConsumeRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ReplicatingTaskProgress.ProtoReflect.Descriptor instead.
+func (*ReplicatingTaskProgress) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{50}
 }
 
-func (m *ConsumeRequest) GetRequest() isConsumeRequest_Request {
-	if m != nil {
-		return m.Request
+func (x *ReplicatingTaskProgress) GetTask() *ReplicatePChannelMeta {
+	if x != nil {
+		return x.Task
 	}
 	return nil
 }
 
-func (x *ConsumeRequest) GetCreateVchannelConsumer() *CreateVChannelConsumerRequest {
-	if x, ok := x.GetRequest().(*ConsumeRequest_CreateVchannelConsumer); ok {
-		return x.CreateVchannelConsumer
+func (x *ReplicatingTaskProgress) GetCheckpoint() *commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.Checkpoint
 	}
 	return nil
 }
 
-func (x *ConsumeRequest) GetCreateVchannelConsumers() *CreateVChannelConsumersRequest {
-	if x, ok := x.GetRequest().(*ConsumeRequest_CreateVchannelConsumers); ok {
-		return x.CreateVchannelConsumers
+func (x *ReplicatingTaskProgress) GetLagMilliseconds() int64 {
+	if x != nil {
+		return x.LagMilliseconds
 	}
-	return nil
+	return 0
 }
 
-func (x *ConsumeRequest) GetCloseVchannel() *CloseVChannelConsumerRequest {
-	if x, ok := x.GetRequest().(*ConsumeRequest_CloseVchannel); ok {
-		return x.CloseVchannel
+func (x *ReplicatingTaskProgress) GetError() string {
+	if x != nil {
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *ConsumeRequest) GetClose() *CloseConsumerRequest {
-	if x, ok := x.GetRequest().(*ConsumeRequest_Close); ok {
-		return x.Close
+func (x *ReplicatingTaskProgress) GetRuntimeState() ReplicatingTaskRuntimeState {
+	if x != nil {
+		return x.RuntimeState
 	}
-	return nil
+	return ReplicatingTaskRuntimeState_REPLICATING_TASK_RUNTIME_STATE_UNKNOWN
 }
 
-type isConsumeRequest_Request interface {
-	isConsumeRequest_Request()
+func (x *ReplicatingTaskProgress) GetStreamingNodeId() int64 {
+	if x != nil {
+		return x.StreamingNodeId
+	}
+	return 0
 }
 
-type ConsumeRequest_CreateVchannelConsumer struct {
-	CreateVchannelConsumer *CreateVChannelConsumerRequest `protobuf:"bytes,1,opt,name=create_vchannel_consumer,json=createVchannelConsumer,proto3,oneof"`
+func (x *ReplicatingTaskProgress) GetDeadLetter() *ReplicateDeadLetterMeta {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
 }
 
-type ConsumeRequest_CreateVchannelConsumers struct {
-	CreateVchannelConsumers *CreateVChannelConsumersRequest `protobuf:"bytes,2,opt,name=create_vchannel_consumers,json=createVchannelConsumers,proto3,oneof"` // Create multiple vchannel consumers, used for recovery in future.
-}
+// CheckReplicateSchemaConsistencyRequest is the request to check the schema consistency of the replication.
+type CheckReplicateSchemaConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type ConsumeRequest_CloseVchannel struct {
-	CloseVchannel *CloseVChannelConsumerRequest `protobuf:"bytes,3,opt,name=close_vchannel,json=closeVchannel,proto3,oneof"`
+	TargetClusterId string `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"` // all target clusters of current cluster are checked if empty.
 }
 
-type ConsumeRequest_Close struct {
-	Close *CloseConsumerRequest `protobuf:"bytes,4,opt,name=close,proto3,oneof"`
-}
-
-func (*ConsumeRequest_CreateVchannelConsumer) isConsumeRequest_Request() {}
-
-func (*ConsumeRequest_CreateVchannelConsumers) isConsumeRequest_Request() {}
-
-func (*ConsumeRequest_CloseVchannel) isConsumeRequest_Request() {}
-
-func (*ConsumeRequest_Close) isConsumeRequest_Request() {}
-
-// CloseConsumerRequest is the request of the CloseConsumer RPC.
-// After CloseConsumerRequest is requested, no more ConsumeRequest can be sent.
-type CloseConsumerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *CloseConsumerRequest) Reset() {
-	*x = CloseConsumerRequest{}
+func (x *CheckReplicateSchemaConsistencyRequest) Reset() {
+	*x = CheckReplicateSchemaConsistencyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[49]
+		mi := &file_streaming_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseConsumerRequest) String() string {
+func (x *CheckReplicateSchemaConsistencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseConsumerRequest) ProtoMessage() {}
+func (*CheckReplicateSchemaConsistencyRequest) ProtoMessage() {}
 
-func (x *CloseConsumerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[49]
+func (x *CheckReplicateSchemaConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3580,38 +3819,44 @@ func (x *CloseConsumerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseConsumerRequest.ProtoReflect.Descriptor instead.
-func (*CloseConsumerRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use CheckReplicateSchemaConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckReplicateSchemaConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{51}
 }
 
-// CreateConsumerRequest is the request of the CreateConsumer RPC.
-// CreateConsumerRequest is passed in the header of stream.
-type CreateConsumerRequest struct {
+func (x *CheckReplicateSchemaConsistencyRequest) GetTargetClusterId() string {
+	if x != nil {
+		return x.TargetClusterId
+	}
+	return ""
+}
+
+// CheckReplicateSchemaConsistencyResponse is the response of checking the schema consistency of the replication.
+type CheckReplicateSchemaConsistencyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	Drifts []*ReplicateSchemaDrift `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"` // empty if the schemas are consistent.
 }
 
-func (x *CreateConsumerRequest) Reset() {
-	*x = CreateConsumerRequest{}
+func (x *CheckReplicateSchemaConsistencyResponse) Reset() {
+	*x = CheckReplicateSchemaConsistencyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[50]
+		mi := &file_streaming_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateConsumerRequest) String() string {
+func (x *CheckReplicateSchemaConsistencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConsumerRequest) ProtoMessage() {}
+func (*CheckReplicateSchemaConsistencyResponse) ProtoMessage() {}
 
-func (x *CreateConsumerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[50]
+func (x *CheckReplicateSchemaConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,43 +3867,48 @@ func (x *CreateConsumerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConsumerRequest.ProtoReflect.Descriptor instead.
-func (*CreateConsumerRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use CheckReplicateSchemaConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckReplicateSchemaConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateConsumerRequest) GetPchannel() *PChannelInfo {
+func (x *CheckReplicateSchemaConsistencyResponse) GetDrifts() []*ReplicateSchemaDrift {
 	if x != nil {
-		return x.Pchannel
+		return x.Drifts
 	}
 	return nil
 }
 
-type CreateVChannelConsumersRequest struct {
+// ReplicateSchemaDrift is a drift of a collection between the source and target cluster.
+type ReplicateSchemaDrift struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreateVchannels []*CreateVChannelConsumerRequest `protobuf:"bytes,1,rep,name=create_vchannels,json=createVchannels,proto3" json:"create_vchannels,omitempty"`
+	TargetClusterId string                   `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	DbName          string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName  string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Type            ReplicateSchemaDriftType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.streaming.ReplicateSchemaDriftType" json:"type,omitempty"`
+	Detail          string                   `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"` // the human readable description of the drift.
 }
 
-func (x *CreateVChannelConsumersRequest) Reset() {
-	*x = CreateVChannelConsumersRequest{}
+func (x *ReplicateSchemaDrift) Reset() {
+	*x = ReplicateSchemaDrift{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[51]
+		mi := &file_streaming_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateVChannelConsumersRequest) String() string {
+func (x *ReplicateSchemaDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVChannelConsumersRequest) ProtoMessage() {}
+func (*ReplicateSchemaDrift) ProtoMessage() {}
 
-func (x *CreateVChannelConsumersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[51]
+func (x *ReplicateSchemaDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3669,48 +3919,78 @@ func (x *CreateVChannelConsumersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVChannelConsumersRequest.ProtoReflect.Descriptor instead.
-func (*CreateVChannelConsumersRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ReplicateSchemaDrift.ProtoReflect.Descriptor instead.
+func (*ReplicateSchemaDrift) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CreateVChannelConsumersRequest) GetCreateVchannels() []*CreateVChannelConsumerRequest {
+func (x *ReplicateSchemaDrift) GetTargetClusterId() string {
 	if x != nil {
-		return x.CreateVchannels
+		return x.TargetClusterId
 	}
-	return nil
+	return ""
 }
 
-// CreateVChannelConsumerRequest is the request of the CreateVChannelConsumer
-// RPC. It's used to create a new vchannel consumer at server side.
-type CreateVChannelConsumerRequest struct {
+func (x *ReplicateSchemaDrift) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ReplicateSchemaDrift) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *ReplicateSchemaDrift) GetType() ReplicateSchemaDriftType {
+	if x != nil {
+		return x.Type
+	}
+	return ReplicateSchemaDriftType_REPLICATE_SCHEMA_DRIFT_TYPE_UNKNOWN
+}
+
+func (x *ReplicateSchemaDrift) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// ResetReplicateCheckpointRequest is the request to reset the start position of a replicating task.
+type ResetReplicateCheckpointRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vchannel               string           `protobuf:"bytes,1,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
-	DeliverPolicy          *DeliverPolicy   `protobuf:"bytes,2,opt,name=deliver_policy,json=deliverPolicy,proto3" json:"deliver_policy,omitempty"`    // deliver policy.
-	DeliverFilters         []*DeliverFilter `protobuf:"bytes,3,rep,name=deliver_filters,json=deliverFilters,proto3" json:"deliver_filters,omitempty"` // deliver filter.
-	IgnorePauseConsumption bool             `protobuf:"varint,4,opt,name=ignore_pause_consumption,json=ignorePauseConsumption,proto3" json:"ignore_pause_consumption,omitempty"`
+	TargetClusterId   string                         `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	SourceChannelName string                         `protobuf:"bytes,2,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"`
+	Policy            ReplicateCheckpointResetPolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=milvus.proto.streaming.ReplicateCheckpointResetPolicy" json:"policy,omitempty"`
+	// the checkpoint at the source channel to start from, required by the CHECKPOINT policy.
+	Checkpoint *commonpb.ReplicateCheckpoint `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	// confirm to reset the checkpoint older than the retention window of the source wal.
+	IgnoreRetentionWindow bool `protobuf:"varint,5,opt,name=ignore_retention_window,json=ignoreRetentionWindow,proto3" json:"ignore_retention_window,omitempty"`
 }
 
-func (x *CreateVChannelConsumerRequest) Reset() {
-	*x = CreateVChannelConsumerRequest{}
+func (x *ResetReplicateCheckpointRequest) Reset() {
+	*x = ResetReplicateCheckpointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[52]
+		mi := &file_streaming_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateVChannelConsumerRequest) String() string {
+func (x *ResetReplicateCheckpointRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVChannelConsumerRequest) ProtoMessage() {}
+func (*ResetReplicateCheckpointRequest) ProtoMessage() {}
 
-func (x *CreateVChannelConsumerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[52]
+func (x *ResetReplicateCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3721,65 +4001,72 @@ func (x *CreateVChannelConsumerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVChannelConsumerRequest.ProtoReflect.Descriptor instead.
-func (*CreateVChannelConsumerRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ResetReplicateCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*ResetReplicateCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CreateVChannelConsumerRequest) GetVchannel() string {
+func (x *ResetReplicateCheckpointRequest) GetTargetClusterId() string {
 	if x != nil {
-		return x.Vchannel
+		return x.TargetClusterId
 	}
 	return ""
 }
 
-func (x *CreateVChannelConsumerRequest) GetDeliverPolicy() *DeliverPolicy {
+func (x *ResetReplicateCheckpointRequest) GetSourceChannelName() string {
 	if x != nil {
-		return x.DeliverPolicy
+		return x.SourceChannelName
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateVChannelConsumerRequest) GetDeliverFilters() []*DeliverFilter {
+func (x *ResetReplicateCheckpointRequest) GetPolicy() ReplicateCheckpointResetPolicy {
 	if x != nil {
-		return x.DeliverFilters
+		return x.Policy
+	}
+	return ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_NONE
+}
+
+func (x *ResetReplicateCheckpointRequest) GetCheckpoint() *commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.Checkpoint
 	}
 	return nil
 }
 
-func (x *CreateVChannelConsumerRequest) GetIgnorePauseConsumption() bool {
+func (x *ResetReplicateCheckpointRequest) GetIgnoreRetentionWindow() bool {
 	if x != nil {
-		return x.IgnorePauseConsumption
+		return x.IgnoreRetentionWindow
 	}
 	return false
 }
 
-// ConsumeMessageRequest is the request of the Consume RPC.
-type CreateVChannelConsumersResponse struct {
+// ResetReplicateCheckpointResponse is the response of resetting the start position of a replicating task.
+type ResetReplicateCheckpointResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreateVchannels []*CreateVChannelConsumerResponse `protobuf:"bytes,1,rep,name=create_vchannels,json=createVchannels,proto3" json:"create_vchannels,omitempty"`
+	Task *ReplicatePChannelMeta `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"` // the replicating task after the reset.
 }
 
-func (x *CreateVChannelConsumersResponse) Reset() {
-	*x = CreateVChannelConsumersResponse{}
+func (x *ResetReplicateCheckpointResponse) Reset() {
+	*x = ResetReplicateCheckpointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[53]
+		mi := &file_streaming_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateVChannelConsumersResponse) String() string {
+func (x *ResetReplicateCheckpointResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVChannelConsumersResponse) ProtoMessage() {}
+func (*ResetReplicateCheckpointResponse) ProtoMessage() {}
 
-func (x *CreateVChannelConsumersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[53]
+func (x *ResetReplicateCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3790,49 +4077,45 @@ func (x *CreateVChannelConsumersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVChannelConsumersResponse.ProtoReflect.Descriptor instead.
-func (*CreateVChannelConsumersResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ResetReplicateCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*ResetReplicateCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateVChannelConsumersResponse) GetCreateVchannels() []*CreateVChannelConsumerResponse {
+func (x *ResetReplicateCheckpointResponse) GetTask() *ReplicatePChannelMeta {
 	if x != nil {
-		return x.CreateVchannels
+		return x.Task
 	}
 	return nil
 }
 
-// CreateVChannelConsumerResponse is the response of the CreateVChannelConsumer
-// RPC.
-type CreateVChannelConsumerResponse struct {
+// UpdateReplicateClusterConnectionRequest is the request to update the connection param of a cluster in the replicate configuration.
+type UpdateReplicateClusterConnectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*CreateVChannelConsumerResponse_ConsumerId
-	//	*CreateVChannelConsumerResponse_Error
-	Response isCreateVChannelConsumerResponse_Response `protobuf_oneof:"response"`
+	ClusterId       string                    `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ConnectionParam *commonpb.ConnectionParam `protobuf:"bytes,2,opt,name=connection_param,json=connectionParam,proto3" json:"connection_param,omitempty"`
 }
 
-func (x *CreateVChannelConsumerResponse) Reset() {
-	*x = CreateVChannelConsumerResponse{}
+func (x *UpdateReplicateClusterConnectionRequest) Reset() {
+	*x = UpdateReplicateClusterConnectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[54]
+		mi := &file_streaming_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateVChannelConsumerResponse) String() string {
+func (x *UpdateReplicateClusterConnectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVChannelConsumerResponse) ProtoMessage() {}
+func (*UpdateReplicateClusterConnectionRequest) ProtoMessage() {}
 
-func (x *CreateVChannelConsumerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[54]
+func (x *UpdateReplicateClusterConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3843,74 +4126,52 @@ func (x *CreateVChannelConsumerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVChannelConsumerResponse.ProtoReflect.Descriptor instead.
-func (*CreateVChannelConsumerResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{54}
-}
-
-func (m *CreateVChannelConsumerResponse) GetResponse() isCreateVChannelConsumerResponse_Response {
-	if m != nil {
-		return m.Response
-	}
-	return nil
+// Deprecated: Use UpdateReplicateClusterConnectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReplicateClusterConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateVChannelConsumerResponse) GetConsumerId() int64 {
-	if x, ok := x.GetResponse().(*CreateVChannelConsumerResponse_ConsumerId); ok {
-		return x.ConsumerId
+func (x *UpdateReplicateClusterConnectionRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateVChannelConsumerResponse) GetError() *StreamingError {
-	if x, ok := x.GetResponse().(*CreateVChannelConsumerResponse_Error); ok {
-		return x.Error
+func (x *UpdateReplicateClusterConnectionRequest) GetConnectionParam() *commonpb.ConnectionParam {
+	if x != nil {
+		return x.ConnectionParam
 	}
 	return nil
 }
 
-type isCreateVChannelConsumerResponse_Response interface {
-	isCreateVChannelConsumerResponse_Response()
-}
-
-type CreateVChannelConsumerResponse_ConsumerId struct {
-	ConsumerId int64 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3,oneof"`
-}
-
-type CreateVChannelConsumerResponse_Error struct {
-	Error *StreamingError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
-}
-
-func (*CreateVChannelConsumerResponse_ConsumerId) isCreateVChannelConsumerResponse_Response() {}
-
-func (*CreateVChannelConsumerResponse_Error) isCreateVChannelConsumerResponse_Response() {}
-
-// CloseVChannelConsumerRequest is the request of the CloseVChannelConsumer RPC.
-type CloseVChannelConsumerRequest struct {
+// UpdateReplicateClusterConnectionResponse is the response of updating the connection param of a cluster.
+type UpdateReplicateClusterConnectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConsumerId int64 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	Version int64                    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // the version of the replicate configuration after the update.
+	Tasks   []*ReplicatePChannelMeta `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`      // the replicating tasks to the cluster after the update.
 }
 
-func (x *CloseVChannelConsumerRequest) Reset() {
-	*x = CloseVChannelConsumerRequest{}
+func (x *UpdateReplicateClusterConnectionResponse) Reset() {
+	*x = UpdateReplicateClusterConnectionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[55]
+		mi := &file_streaming_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseVChannelConsumerRequest) String() string {
+func (x *UpdateReplicateClusterConnectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseVChannelConsumerRequest) ProtoMessage() {}
+func (*UpdateReplicateClusterConnectionResponse) ProtoMessage() {}
 
-func (x *CloseVChannelConsumerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[55]
+func (x *UpdateReplicateClusterConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3921,45 +4182,53 @@ func (x *CloseVChannelConsumerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseVChannelConsumerRequest.ProtoReflect.Descriptor instead.
-func (*CloseVChannelConsumerRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use UpdateReplicateClusterConnectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReplicateClusterConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CloseVChannelConsumerRequest) GetConsumerId() int64 {
+func (x *UpdateReplicateClusterConnectionResponse) GetVersion() int64 {
 	if x != nil {
-		return x.ConsumerId
+		return x.Version
 	}
 	return 0
 }
 
-// CloseVChannelConsumerResponse is the response of the CloseVChannelConsumer
-// RPC.
-type CloseVChannelConsumerResponse struct {
+func (x *UpdateReplicateClusterConnectionResponse) GetTasks() []*ReplicatePChannelMeta {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// UpdateReplicatingTaskStateRequest is the request to pause or resume the replicating tasks.
+type UpdateReplicatingTaskStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConsumerId int64 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	TargetClusterId   string                     `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	SourceChannelName string                     `protobuf:"bytes,2,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"` // all replicating tasks to the target cluster are updated if empty.
+	State             ReplicatePChannelTaskState `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.streaming.ReplicatePChannelTaskState" json:"state,omitempty"`
 }
 
-func (x *CloseVChannelConsumerResponse) Reset() {
-	*x = CloseVChannelConsumerResponse{}
+func (x *UpdateReplicatingTaskStateRequest) Reset() {
+	*x = UpdateReplicatingTaskStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[56]
+		mi := &file_streaming_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseVChannelConsumerResponse) String() string {
+func (x *UpdateReplicatingTaskStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseVChannelConsumerResponse) ProtoMessage() {}
+func (*UpdateReplicatingTaskStateRequest) ProtoMessage() {}
 
-func (x *CloseVChannelConsumerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[56]
+func (x *UpdateReplicatingTaskStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3970,52 +4239,58 @@ func (x *CloseVChannelConsumerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseVChannelConsumerResponse.ProtoReflect.Descriptor instead.
-func (*CloseVChannelConsumerResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use UpdateReplicatingTaskStateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReplicatingTaskStateRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *CloseVChannelConsumerResponse) GetConsumerId() int64 {
+func (x *UpdateReplicatingTaskStateRequest) GetTargetClusterId() string {
 	if x != nil {
-		return x.ConsumerId
+		return x.TargetClusterId
 	}
-	return 0
+	return ""
 }
 
-// ConsumeResponse is the reponse of the Consume RPC.
-type ConsumeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UpdateReplicatingTaskStateRequest) GetSourceChannelName() string {
+	if x != nil {
+		return x.SourceChannelName
+	}
+	return ""
+}
 
-	// Types that are assignable to Response:
-	//
-	//	*ConsumeResponse_Create
-	//	*ConsumeResponse_Consume
-	//	*ConsumeResponse_CreateVchannel
-	//	*ConsumeResponse_CreateVchannels
-	//	*ConsumeResponse_CloseVchannel
-	//	*ConsumeResponse_Close
-	Response isConsumeResponse_Response `protobuf_oneof:"response"`
+func (x *UpdateReplicatingTaskStateRequest) GetState() ReplicatePChannelTaskState {
+	if x != nil {
+		return x.State
+	}
+	return ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING
 }
 
-func (x *ConsumeResponse) Reset() {
-	*x = ConsumeResponse{}
+// UpdateReplicatingTaskStateResponse is the response of updating the state of the replicating tasks.
+type UpdateReplicatingTaskStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks []*ReplicatePChannelMeta `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // the replicating tasks matched by the request after the update.
+}
+
+func (x *UpdateReplicatingTaskStateResponse) Reset() {
+	*x = UpdateReplicatingTaskStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[57]
+		mi := &file_streaming_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConsumeResponse) String() string {
+func (x *UpdateReplicatingTaskStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeResponse) ProtoMessage() {}
+func (*UpdateReplicatingTaskStateResponse) ProtoMessage() {}
 
-func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[57]
+func (x *UpdateReplicatingTaskStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,129 +4301,83 @@ func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeResponse.ProtoReflect.Descriptor instead.
-func (*ConsumeResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{57}
-}
-
-func (m *ConsumeResponse) GetResponse() isConsumeResponse_Response {
-	if m != nil {
-		return m.Response
-	}
-	return nil
+// Deprecated: Use UpdateReplicatingTaskStateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReplicatingTaskStateResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ConsumeResponse) GetCreate() *CreateConsumerResponse {
-	if x, ok := x.GetResponse().(*ConsumeResponse_Create); ok {
-		return x.Create
+func (x *UpdateReplicatingTaskStateResponse) GetTasks() []*ReplicatePChannelMeta {
+	if x != nil {
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *ConsumeResponse) GetConsume() *ConsumeMessageReponse {
-	if x, ok := x.GetResponse().(*ConsumeResponse_Consume); ok {
-		return x.Consume
-	}
-	return nil
+// ExportStateRequest is the request to export the channel manager state.
+type ExportStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ConsumeResponse) GetCreateVchannel() *CreateVChannelConsumerResponse {
-	if x, ok := x.GetResponse().(*ConsumeResponse_CreateVchannel); ok {
-		return x.CreateVchannel
+func (x *ExportStateRequest) Reset() {
+	*x = ExportStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ConsumeResponse) GetCreateVchannels() *CreateVChannelConsumersResponse {
-	if x, ok := x.GetResponse().(*ConsumeResponse_CreateVchannels); ok {
-		return x.CreateVchannels
-	}
-	return nil
+func (x *ExportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ConsumeResponse) GetCloseVchannel() *CloseVChannelConsumerResponse {
-	if x, ok := x.GetResponse().(*ConsumeResponse_CloseVchannel); ok {
-		return x.CloseVchannel
-	}
-	return nil
-}
+func (*ExportStateRequest) ProtoMessage() {}
 
-func (x *ConsumeResponse) GetClose() *CloseConsumerResponse {
-	if x, ok := x.GetResponse().(*ConsumeResponse_Close); ok {
-		return x.Close
+func (x *ExportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type isConsumeResponse_Response interface {
-	isConsumeResponse_Response()
-}
-
-type ConsumeResponse_Create struct {
-	Create *CreateConsumerResponse `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
-}
-
-type ConsumeResponse_Consume struct {
-	Consume *ConsumeMessageReponse `protobuf:"bytes,2,opt,name=consume,proto3,oneof"`
-}
-
-type ConsumeResponse_CreateVchannel struct {
-	CreateVchannel *CreateVChannelConsumerResponse `protobuf:"bytes,3,opt,name=create_vchannel,json=createVchannel,proto3,oneof"`
-}
-
-type ConsumeResponse_CreateVchannels struct {
-	CreateVchannels *CreateVChannelConsumersResponse `protobuf:"bytes,4,opt,name=create_vchannels,json=createVchannels,proto3,oneof"`
-}
-
-type ConsumeResponse_CloseVchannel struct {
-	CloseVchannel *CloseVChannelConsumerResponse `protobuf:"bytes,5,opt,name=close_vchannel,json=closeVchannel,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type ConsumeResponse_Close struct {
-	Close *CloseConsumerResponse `protobuf:"bytes,6,opt,name=close,proto3,oneof"`
+// Deprecated: Use ExportStateRequest.ProtoReflect.Descriptor instead.
+func (*ExportStateRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{60}
 }
 
-func (*ConsumeResponse_Create) isConsumeResponse_Response() {}
-
-func (*ConsumeResponse_Consume) isConsumeResponse_Response() {}
-
-func (*ConsumeResponse_CreateVchannel) isConsumeResponse_Response() {}
-
-func (*ConsumeResponse_CreateVchannels) isConsumeResponse_Response() {}
-
-func (*ConsumeResponse_CloseVchannel) isConsumeResponse_Response() {}
-
-func (*ConsumeResponse_Close) isConsumeResponse_Response() {}
-
-type CreateConsumerResponse struct {
+// ExportStateResponse is the response of exporting the channel manager state.
+type ExportStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Marked as deprecated in streaming.proto.
-	WalName string `protobuf:"bytes,1,opt,name=wal_name,json=walName,proto3" json:"wal_name,omitempty"` // wal name at server side.
-	// A unique consumer id on streamingnode for this
-	// consumer in streamingnode lifetime.
-	ConsumerServerId int64 `protobuf:"varint,2,opt,name=consumer_server_id,json=consumerServerId,proto3" json:"consumer_server_id,omitempty"`
+	State *ChannelManagerState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *CreateConsumerResponse) Reset() {
-	*x = CreateConsumerResponse{}
+func (x *ExportStateResponse) Reset() {
+	*x = ExportStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[58]
+		mi := &file_streaming_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateConsumerResponse) String() string {
+func (x *ExportStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConsumerResponse) ProtoMessage() {}
+func (*ExportStateResponse) ProtoMessage() {}
 
-func (x *CreateConsumerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[58]
+func (x *ExportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4159,52 +4388,53 @@ func (x *CreateConsumerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConsumerResponse.ProtoReflect.Descriptor instead.
-func (*CreateConsumerResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{58}
-}
-
-// Deprecated: Marked as deprecated in streaming.proto.
-func (x *CreateConsumerResponse) GetWalName() string {
-	if x != nil {
-		return x.WalName
-	}
-	return ""
+// Deprecated: Use ExportStateResponse.ProtoReflect.Descriptor instead.
+func (*ExportStateResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CreateConsumerResponse) GetConsumerServerId() int64 {
+func (x *ExportStateResponse) GetState() *ChannelManagerState {
 	if x != nil {
-		return x.ConsumerServerId
+		return x.State
 	}
-	return 0
+	return nil
 }
 
-type ConsumeMessageReponse struct {
+// ChannelManagerState is a consistent snapshot of the channel manager state.
+type ChannelManagerState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConsumerId int64                      `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
-	Message    *commonpb.ImmutableMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ExportTimestampSeconds int64                            `protobuf:"varint,1,opt,name=export_timestamp_seconds,json=exportTimestampSeconds,proto3" json:"export_timestamp_seconds,omitempty"` // the unix timestamp of the export in seconds.
+	StreamingVersion       *StreamingVersion                `protobuf:"bytes,2,opt,name=streaming_version,json=streamingVersion,proto3" json:"streaming_version,omitempty"`                      // null if the streaming service is never enabled.
+	Version                *VersionPair                     `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`                                                                // the version of the channel assignment.
+	Cchannel               *CChannelMeta                    `protobuf:"bytes,4,opt,name=cchannel,proto3" json:"cchannel,omitempty"`
+	Pchannels              []*PChannelMeta                  `protobuf:"bytes,5,rep,name=pchannels,proto3" json:"pchannels,omitempty"`                                                         // ordered by the pchannel name.
+	Stats                  []*PChannelStatsSnapshot         `protobuf:"bytes,6,rep,name=stats,proto3" json:"stats,omitempty"`                                                                 // ordered by the pchannel name.
+	ReplicateConfiguration *commonpb.ReplicateConfiguration `protobuf:"bytes,7,opt,name=replicate_configuration,json=replicateConfiguration,proto3" json:"replicate_configuration,omitempty"` // null if there's no replicate configuration.
+	Pools                  []*PChannelPoolMeta              `protobuf:"bytes,8,rep,name=pools,proto3" json:"pools,omitempty"`
+	AntiAffinityGroups     []*PChannelAntiAffinityGroupMeta `protobuf:"bytes,9,rep,name=anti_affinity_groups,json=antiAffinityGroups,proto3" json:"anti_affinity_groups,omitempty"`
+	TargetClusterHealth    []*ReplicateTargetClusterHealth  `protobuf:"bytes,10,rep,name=target_cluster_health,json=targetClusterHealth,proto3" json:"target_cluster_health,omitempty"` // ordered by the cluster id, empty if the health probe is disabled.
 }
 
-func (x *ConsumeMessageReponse) Reset() {
-	*x = ConsumeMessageReponse{}
+func (x *ChannelManagerState) Reset() {
+	*x = ChannelManagerState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[59]
+		mi := &file_streaming_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConsumeMessageReponse) String() string {
+func (x *ChannelManagerState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeMessageReponse) ProtoMessage() {}
+func (*ChannelManagerState) ProtoMessage() {}
 
-func (x *ConsumeMessageReponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[59]
+func (x *ChannelManagerState) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4215,89 +4445,113 @@ func (x *ConsumeMessageReponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeMessageReponse.ProtoReflect.Descriptor instead.
-func (*ConsumeMessageReponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ChannelManagerState.ProtoReflect.Descriptor instead.
+func (*ChannelManagerState) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ConsumeMessageReponse) GetConsumerId() int64 {
+func (x *ChannelManagerState) GetExportTimestampSeconds() int64 {
 	if x != nil {
-		return x.ConsumerId
+		return x.ExportTimestampSeconds
 	}
 	return 0
 }
 
-func (x *ConsumeMessageReponse) GetMessage() *commonpb.ImmutableMessage {
+func (x *ChannelManagerState) GetStreamingVersion() *StreamingVersion {
 	if x != nil {
-		return x.Message
+		return x.StreamingVersion
 	}
 	return nil
 }
 
-type CloseConsumerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ChannelManagerState) GetVersion() *VersionPair {
+	if x != nil {
+		return x.Version
+	}
+	return nil
 }
 
-func (x *CloseConsumerResponse) Reset() {
-	*x = CloseConsumerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[60]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChannelManagerState) GetCchannel() *CChannelMeta {
+	if x != nil {
+		return x.Cchannel
 	}
+	return nil
 }
 
-func (x *CloseConsumerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChannelManagerState) GetPchannels() []*PChannelMeta {
+	if x != nil {
+		return x.Pchannels
+	}
+	return nil
 }
 
-func (*CloseConsumerResponse) ProtoMessage() {}
+func (x *ChannelManagerState) GetStats() []*PChannelStatsSnapshot {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
 
-func (x *CloseConsumerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[60]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChannelManagerState) GetReplicateConfiguration() *commonpb.ReplicateConfiguration {
+	if x != nil {
+		return x.ReplicateConfiguration
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CloseConsumerResponse.ProtoReflect.Descriptor instead.
-func (*CloseConsumerResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{60}
+func (x *ChannelManagerState) GetPools() []*PChannelPoolMeta {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
 }
 
-// StreamingManagerAssignRequest is the request message of Assign RPC.
-type StreamingNodeManagerAssignRequest struct {
+func (x *ChannelManagerState) GetAntiAffinityGroups() []*PChannelAntiAffinityGroupMeta {
+	if x != nil {
+		return x.AntiAffinityGroups
+	}
+	return nil
+}
+
+func (x *ChannelManagerState) GetTargetClusterHealth() []*ReplicateTargetClusterHealth {
+	if x != nil {
+		return x.TargetClusterHealth
+	}
+	return nil
+}
+
+// ReplicateTargetClusterHealth is the reachability of a target cluster of current cluster probed by the streamingcoord.
+type ReplicateTargetClusterHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	ClusterId                     string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Reachable                     bool   `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	Error                         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                                                         // the error of the last probe, empty if the target cluster is reachable.
+	LatencyMilliseconds           int64  `protobuf:"varint,4,opt,name=latency_milliseconds,json=latencyMilliseconds,proto3" json:"latency_milliseconds,omitempty"` // the latency of the last probe.
+	ConsecutiveFailures           int64  `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"` // the number of the consecutive failed probes.
+	LastProbeTimestampSeconds     int64  `protobuf:"varint,6,opt,name=last_probe_timestamp_seconds,json=lastProbeTimestampSeconds,proto3" json:"last_probe_timestamp_seconds,omitempty"`
+	LastReachableTimestampSeconds int64  `protobuf:"varint,7,opt,name=last_reachable_timestamp_seconds,json=lastReachableTimestampSeconds,proto3" json:"last_reachable_timestamp_seconds,omitempty"` // zero if the target cluster is never reachable since the streamingcoord starts.
 }
 
-func (x *StreamingNodeManagerAssignRequest) Reset() {
-	*x = StreamingNodeManagerAssignRequest{}
+func (x *ReplicateTargetClusterHealth) Reset() {
+	*x = ReplicateTargetClusterHealth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[61]
+		mi := &file_streaming_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamingNodeManagerAssignRequest) String() string {
+func (x *ReplicateTargetClusterHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeManagerAssignRequest) ProtoMessage() {}
+func (*ReplicateTargetClusterHealth) ProtoMessage() {}
 
-func (x *StreamingNodeManagerAssignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[61]
+func (x *ReplicateTargetClusterHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4308,81 +4562,89 @@ func (x *StreamingNodeManagerAssignRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeManagerAssignRequest.ProtoReflect.Descriptor instead.
-func (*StreamingNodeManagerAssignRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ReplicateTargetClusterHealth.ProtoReflect.Descriptor instead.
+func (*ReplicateTargetClusterHealth) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StreamingNodeManagerAssignRequest) GetPchannel() *PChannelInfo {
+func (x *ReplicateTargetClusterHealth) GetClusterId() string {
 	if x != nil {
-		return x.Pchannel
+		return x.ClusterId
 	}
-	return nil
+	return ""
 }
 
-type StreamingNodeManagerAssignResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReplicateTargetClusterHealth) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
 }
 
-func (x *StreamingNodeManagerAssignResponse) Reset() {
-	*x = StreamingNodeManagerAssignResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[62]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReplicateTargetClusterHealth) GetError() string {
+	if x != nil {
+		return x.Error
 	}
+	return ""
 }
 
-func (x *StreamingNodeManagerAssignResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReplicateTargetClusterHealth) GetLatencyMilliseconds() int64 {
+	if x != nil {
+		return x.LatencyMilliseconds
+	}
+	return 0
 }
 
-func (*StreamingNodeManagerAssignResponse) ProtoMessage() {}
+func (x *ReplicateTargetClusterHealth) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
 
-func (x *StreamingNodeManagerAssignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[62]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReplicateTargetClusterHealth) GetLastProbeTimestampSeconds() int64 {
+	if x != nil {
+		return x.LastProbeTimestampSeconds
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StreamingNodeManagerAssignResponse.ProtoReflect.Descriptor instead.
-func (*StreamingNodeManagerAssignResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{62}
+func (x *ReplicateTargetClusterHealth) GetLastReachableTimestampSeconds() int64 {
+	if x != nil {
+		return x.LastReachableTimestampSeconds
+	}
+	return 0
 }
 
-type StreamingNodeManagerRemoveRequest struct {
+// PChannelStatsSnapshot is the snapshot of the stats of a pchannel.
+type PChannelStatsSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	Pchannel             string           `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	Vchannels            map[string]int64 `protobuf:"bytes,2,rep,name=vchannels,proto3" json:"vchannels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // the vchannels on the pchannel, vchannel -> collection id.
+	AppendBytesPerSecond float64          `protobuf:"fixed64,3,opt,name=append_bytes_per_second,json=appendBytesPerSecond,proto3" json:"append_bytes_per_second,omitempty"`
+	AppendBytesTotal     uint64           `protobuf:"varint,4,opt,name=append_bytes_total,json=appendBytesTotal,proto3" json:"append_bytes_total,omitempty"`
 }
 
-func (x *StreamingNodeManagerRemoveRequest) Reset() {
-	*x = StreamingNodeManagerRemoveRequest{}
+func (x *PChannelStatsSnapshot) Reset() {
+	*x = PChannelStatsSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[63]
+		mi := &file_streaming_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamingNodeManagerRemoveRequest) String() string {
+func (x *PChannelStatsSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeManagerRemoveRequest) ProtoMessage() {}
+func (*PChannelStatsSnapshot) ProtoMessage() {}
 
-func (x *StreamingNodeManagerRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[63]
+func (x *PChannelStatsSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4393,64 +4655,50 @@ func (x *StreamingNodeManagerRemoveRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeManagerRemoveRequest.ProtoReflect.Descriptor instead.
-func (*StreamingNodeManagerRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use PChannelStatsSnapshot.ProtoReflect.Descriptor instead.
+func (*PChannelStatsSnapshot) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *StreamingNodeManagerRemoveRequest) GetPchannel() *PChannelInfo {
+func (x *PChannelStatsSnapshot) GetPchannel() string {
 	if x != nil {
 		return x.Pchannel
 	}
-	return nil
-}
-
-type StreamingNodeManagerRemoveResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return ""
 }
 
-func (x *StreamingNodeManagerRemoveResponse) Reset() {
-	*x = StreamingNodeManagerRemoveResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[64]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PChannelStatsSnapshot) GetVchannels() map[string]int64 {
+	if x != nil {
+		return x.Vchannels
 	}
+	return nil
 }
 
-func (x *StreamingNodeManagerRemoveResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*StreamingNodeManagerRemoveResponse) ProtoMessage() {}
-
-func (x *StreamingNodeManagerRemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[64]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PChannelStatsSnapshot) GetAppendBytesPerSecond() float64 {
+	if x != nil {
+		return x.AppendBytesPerSecond
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StreamingNodeManagerRemoveResponse.ProtoReflect.Descriptor instead.
-func (*StreamingNodeManagerRemoveResponse) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{64}
+func (x *PChannelStatsSnapshot) GetAppendBytesTotal() uint64 {
+	if x != nil {
+		return x.AppendBytesTotal
+	}
+	return 0
 }
 
-type StreamingNodeManagerCollectStatusRequest struct {
+// MoveControlChannelRequest is the request to move the control channel to another pchannel.
+type MoveControlChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pchannel string `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // the target pchannel of the control channel.
 }
 
-func (x *StreamingNodeManagerCollectStatusRequest) Reset() {
-	*x = StreamingNodeManagerCollectStatusRequest{}
+func (x *MoveControlChannelRequest) Reset() {
+	*x = MoveControlChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4458,13 +4706,13 @@ func (x *StreamingNodeManagerCollectStatusRequest) Reset() {
 	}
 }
 
-func (x *StreamingNodeManagerCollectStatusRequest) String() string {
+func (x *MoveControlChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeManagerCollectStatusRequest) ProtoMessage() {}
+func (*MoveControlChannelRequest) ProtoMessage() {}
 
-func (x *StreamingNodeManagerCollectStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveControlChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4476,21 +4724,29 @@ func (x *StreamingNodeManagerCollectStatusRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeManagerCollectStatusRequest.ProtoReflect.Descriptor instead.
-func (*StreamingNodeManagerCollectStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveControlChannelRequest.ProtoReflect.Descriptor instead.
+func (*MoveControlChannelRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{65}
 }
 
-type StreamingNodeMetrics struct {
+func (x *MoveControlChannelRequest) GetPchannel() string {
+	if x != nil {
+		return x.Pchannel
+	}
+	return ""
+}
+
+// MoveControlChannelResponse is the response of moving the control channel.
+type MoveControlChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Wals []*StreamingNodeWALMetrics `protobuf:"bytes,1,rep,name=wals,proto3" json:"wals,omitempty"`
+	Meta *CChannelMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"` // the control channel meta after the move.
 }
 
-func (x *StreamingNodeMetrics) Reset() {
-	*x = StreamingNodeMetrics{}
+func (x *MoveControlChannelResponse) Reset() {
+	*x = MoveControlChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4498,13 +4754,13 @@ func (x *StreamingNodeMetrics) Reset() {
 	}
 }
 
-func (x *StreamingNodeMetrics) String() string {
+func (x *MoveControlChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeMetrics) ProtoMessage() {}
+func (*MoveControlChannelResponse) ProtoMessage() {}
 
-func (x *StreamingNodeMetrics) ProtoReflect() protoreflect.Message {
+func (x *MoveControlChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4516,33 +4772,30 @@ func (x *StreamingNodeMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeMetrics.ProtoReflect.Descriptor instead.
-func (*StreamingNodeMetrics) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveControlChannelResponse.ProtoReflect.Descriptor instead.
+func (*MoveControlChannelResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *StreamingNodeMetrics) GetWals() []*StreamingNodeWALMetrics {
+func (x *MoveControlChannelResponse) GetMeta() *CChannelMeta {
 	if x != nil {
-		return x.Wals
+		return x.Meta
 	}
 	return nil
 }
 
-type StreamingNodeWALMetrics struct {
+// UpdatePChannelAntiAffinityGroupsRequest is the request to update the pchannel anti-affinity groups.
+type UpdatePChannelAntiAffinityGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info *PChannelInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	// Types that are assignable to Metrics:
-	//
-	//	*StreamingNodeWALMetrics_Rw
-	//	*StreamingNodeWALMetrics_Ro
-	Metrics isStreamingNodeWALMetrics_Metrics `protobuf_oneof:"metrics"`
+	UpsertGroups []*PChannelAntiAffinityGroupMeta `protobuf:"bytes,1,rep,name=upsert_groups,json=upsertGroups,proto3" json:"upsert_groups,omitempty"` // the groups to be created or fully replaced.
+	DropGroups   []string                         `protobuf:"bytes,2,rep,name=drop_groups,json=dropGroups,proto3" json:"drop_groups,omitempty"`       // the names of groups to be dropped.
 }
 
-func (x *StreamingNodeWALMetrics) Reset() {
-	*x = StreamingNodeWALMetrics{}
+func (x *UpdatePChannelAntiAffinityGroupsRequest) Reset() {
+	*x = UpdatePChannelAntiAffinityGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4550,13 +4803,13 @@ func (x *StreamingNodeWALMetrics) Reset() {
 	}
 }
 
-func (x *StreamingNodeWALMetrics) String() string {
+func (x *UpdatePChannelAntiAffinityGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeWALMetrics) ProtoMessage() {}
+func (*UpdatePChannelAntiAffinityGroupsRequest) ProtoMessage() {}
 
-func (x *StreamingNodeWALMetrics) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelAntiAffinityGroupsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4568,66 +4821,36 @@ func (x *StreamingNodeWALMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeWALMetrics.ProtoReflect.Descriptor instead.
-func (*StreamingNodeWALMetrics) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelAntiAffinityGroupsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelAntiAffinityGroupsRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *StreamingNodeWALMetrics) GetInfo() *PChannelInfo {
+func (x *UpdatePChannelAntiAffinityGroupsRequest) GetUpsertGroups() []*PChannelAntiAffinityGroupMeta {
 	if x != nil {
-		return x.Info
-	}
-	return nil
-}
-
-func (m *StreamingNodeWALMetrics) GetMetrics() isStreamingNodeWALMetrics_Metrics {
-	if m != nil {
-		return m.Metrics
-	}
-	return nil
-}
-
-func (x *StreamingNodeWALMetrics) GetRw() *StreamingNodeRWWALMetrics {
-	if x, ok := x.GetMetrics().(*StreamingNodeWALMetrics_Rw); ok {
-		return x.Rw
+		return x.UpsertGroups
 	}
 	return nil
 }
 
-func (x *StreamingNodeWALMetrics) GetRo() *StreamingNodeROWALMetrics {
-	if x, ok := x.GetMetrics().(*StreamingNodeWALMetrics_Ro); ok {
-		return x.Ro
+func (x *UpdatePChannelAntiAffinityGroupsRequest) GetDropGroups() []string {
+	if x != nil {
+		return x.DropGroups
 	}
 	return nil
 }
 
-type isStreamingNodeWALMetrics_Metrics interface {
-	isStreamingNodeWALMetrics_Metrics()
-}
-
-type StreamingNodeWALMetrics_Rw struct {
-	Rw *StreamingNodeRWWALMetrics `protobuf:"bytes,2,opt,name=rw,proto3,oneof"`
-}
-
-type StreamingNodeWALMetrics_Ro struct {
-	Ro *StreamingNodeROWALMetrics `protobuf:"bytes,3,opt,name=ro,proto3,oneof"`
-}
-
-func (*StreamingNodeWALMetrics_Rw) isStreamingNodeWALMetrics_Metrics() {}
-
-func (*StreamingNodeWALMetrics_Ro) isStreamingNodeWALMetrics_Metrics() {}
-
-type StreamingNodeRWWALMetrics struct {
+// UpdatePChannelAntiAffinityGroupsResponse is the response of UpdatePChannelAntiAffinityGroups.
+type UpdatePChannelAntiAffinityGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MvccTimeTick     uint64 `protobuf:"varint,1,opt,name=mvcc_time_tick,json=mvccTimeTick,proto3" json:"mvcc_time_tick,omitempty"`             // The mvcc time tick of the pchannel.
-	RecoveryTimeTick uint64 `protobuf:"varint,2,opt,name=recovery_time_tick,json=recoveryTimeTick,proto3" json:"recovery_time_tick,omitempty"` // The recovery time tick of the pchannel.
+	Groups []*PChannelAntiAffinityGroupMeta `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // all anti-affinity groups after the update.
 }
 
-func (x *StreamingNodeRWWALMetrics) Reset() {
-	*x = StreamingNodeRWWALMetrics{}
+func (x *UpdatePChannelAntiAffinityGroupsResponse) Reset() {
+	*x = UpdatePChannelAntiAffinityGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4635,13 +4858,13 @@ func (x *StreamingNodeRWWALMetrics) Reset() {
 	}
 }
 
-func (x *StreamingNodeRWWALMetrics) String() string {
+func (x *UpdatePChannelAntiAffinityGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeRWWALMetrics) ProtoMessage() {}
+func (*UpdatePChannelAntiAffinityGroupsResponse) ProtoMessage() {}
 
-func (x *StreamingNodeRWWALMetrics) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelAntiAffinityGroupsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4653,33 +4876,30 @@ func (x *StreamingNodeRWWALMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeRWWALMetrics.ProtoReflect.Descriptor instead.
-func (*StreamingNodeRWWALMetrics) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelAntiAffinityGroupsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelAntiAffinityGroupsResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *StreamingNodeRWWALMetrics) GetMvccTimeTick() uint64 {
-	if x != nil {
-		return x.MvccTimeTick
-	}
-	return 0
-}
-
-func (x *StreamingNodeRWWALMetrics) GetRecoveryTimeTick() uint64 {
+func (x *UpdatePChannelAntiAffinityGroupsResponse) GetGroups() []*PChannelAntiAffinityGroupMeta {
 	if x != nil {
-		return x.RecoveryTimeTick
+		return x.Groups
 	}
-	return 0
+	return nil
 }
 
-type StreamingNodeROWALMetrics struct {
+// GetAssignmentHistoryRequest is the request to get the ownership history of a pchannel.
+type GetAssignmentHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pchannel string `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // the name of pchannel.
+	Term     int64  `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`        // only return the assignment at the term if it's not zero.
 }
 
-func (x *StreamingNodeROWALMetrics) Reset() {
-	*x = StreamingNodeROWALMetrics{}
+func (x *GetAssignmentHistoryRequest) Reset() {
+	*x = GetAssignmentHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4687,13 +4907,13 @@ func (x *StreamingNodeROWALMetrics) Reset() {
 	}
 }
 
-func (x *StreamingNodeROWALMetrics) String() string {
+func (x *GetAssignmentHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeROWALMetrics) ProtoMessage() {}
+func (*GetAssignmentHistoryRequest) ProtoMessage() {}
 
-func (x *StreamingNodeROWALMetrics) ProtoReflect() protoreflect.Message {
+func (x *GetAssignmentHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4705,21 +4925,37 @@ func (x *StreamingNodeROWALMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeROWALMetrics.ProtoReflect.Descriptor instead.
-func (*StreamingNodeROWALMetrics) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAssignmentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{69}
 }
 
-type StreamingNodeManagerCollectStatusResponse struct {
+func (x *GetAssignmentHistoryRequest) GetPchannel() string {
+	if x != nil {
+		return x.Pchannel
+	}
+	return ""
+}
+
+func (x *GetAssignmentHistoryRequest) GetTerm() int64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+// GetAssignmentHistoryResponse is the response of GetAssignmentHistory.
+type GetAssignmentHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metrics *StreamingNodeMetrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Pchannel  string                   `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`   // the name of pchannel.
+	Histories []*PChannelAssignmentLog `protobuf:"bytes,2,rep,name=histories,proto3" json:"histories,omitempty"` // the finished assignments ordered by term.
 }
 
-func (x *StreamingNodeManagerCollectStatusResponse) Reset() {
-	*x = StreamingNodeManagerCollectStatusResponse{}
+func (x *GetAssignmentHistoryResponse) Reset() {
+	*x = GetAssignmentHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4727,13 +4963,13 @@ func (x *StreamingNodeManagerCollectStatusResponse) Reset() {
 	}
 }
 
-func (x *StreamingNodeManagerCollectStatusResponse) String() string {
+func (x *GetAssignmentHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamingNodeManagerCollectStatusResponse) ProtoMessage() {}
+func (*GetAssignmentHistoryResponse) ProtoMessage() {}
 
-func (x *StreamingNodeManagerCollectStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAssignmentHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4745,37 +4981,37 @@ func (x *StreamingNodeManagerCollectStatusResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamingNodeManagerCollectStatusResponse.ProtoReflect.Descriptor instead.
-func (*StreamingNodeManagerCollectStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAssignmentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *StreamingNodeManagerCollectStatusResponse) GetMetrics() *StreamingNodeMetrics {
+func (x *GetAssignmentHistoryResponse) GetPchannel() string {
 	if x != nil {
-		return x.Metrics
+		return x.Pchannel
+	}
+	return ""
+}
+
+func (x *GetAssignmentHistoryResponse) GetHistories() []*PChannelAssignmentLog {
+	if x != nil {
+		return x.Histories
 	}
 	return nil
 }
 
-// /
-// / VChannelMeta
-// /
-// VChannelMeta is the meta information of a vchannel.
-// We need to add vchannel meta in wal meta, so the wal can recover the information of it.
-// The vchannel meta is also used to store the vchannel operation result, such as shard-splitting.
-type VChannelMeta struct {
+// DrainNodeRequest is the request to drain a streaming node.
+type DrainNodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vchannel           string                    `protobuf:"bytes,1,opt,name=vchannel,proto3" json:"vchannel,omitempty"`                                                  // vchannel name.
-	State              VChannelState             `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.streaming.VChannelState" json:"state,omitempty"`             // vchannel state.
-	CollectionInfo     *CollectionInfoOfVChannel `protobuf:"bytes,3,opt,name=collection_info,json=collectionInfo,proto3" json:"collection_info,omitempty"`                // if the channel is belong to a collection, the collection info will be setup.
-	CheckpointTimeTick uint64                    `protobuf:"varint,4,opt,name=checkpoint_time_tick,json=checkpointTimeTick,proto3" json:"checkpoint_time_tick,omitempty"` // The timetick of checkpoint, the meta already see the message at this timetick.
+	ServerId int64 `protobuf:"varint,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"` // the server id of streaming node to drain.
+	Cancel   bool  `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`                     // cancel the draining, the node is defrozen and can be assigned again.
 }
 
-func (x *VChannelMeta) Reset() {
-	*x = VChannelMeta{}
+func (x *DrainNodeRequest) Reset() {
+	*x = DrainNodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4783,13 +5019,13 @@ func (x *VChannelMeta) Reset() {
 	}
 }
 
-func (x *VChannelMeta) String() string {
+func (x *DrainNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VChannelMeta) ProtoMessage() {}
+func (*DrainNodeRequest) ProtoMessage() {}
 
-func (x *VChannelMeta) ProtoReflect() protoreflect.Message {
+func (x *DrainNodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4801,52 +5037,38 @@ func (x *VChannelMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VChannelMeta.ProtoReflect.Descriptor instead.
-func (*VChannelMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use DrainNodeRequest.ProtoReflect.Descriptor instead.
+func (*DrainNodeRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *VChannelMeta) GetVchannel() string {
+func (x *DrainNodeRequest) GetServerId() int64 {
 	if x != nil {
-		return x.Vchannel
+		return x.ServerId
 	}
-	return ""
+	return 0
 }
 
-func (x *VChannelMeta) GetState() VChannelState {
+func (x *DrainNodeRequest) GetCancel() bool {
 	if x != nil {
-		return x.State
+		return x.Cancel
 	}
-	return VChannelState_VCHANNEL_STATE_UNKNOWN
+	return false
 }
 
-func (x *VChannelMeta) GetCollectionInfo() *CollectionInfoOfVChannel {
-	if x != nil {
-		return x.CollectionInfo
-	}
-	return nil
-}
-
-func (x *VChannelMeta) GetCheckpointTimeTick() uint64 {
-	if x != nil {
-		return x.CheckpointTimeTick
-	}
-	return 0
-}
-
-// CollectionInfoOfVChannel is the collection info in vchannel.
-type CollectionInfoOfVChannel struct {
+// DrainNodeResponse is the response of DrainNode.
+type DrainNodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollectionId int64                         `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // collection id.
-	Partitions   []*PartitionInfoOfVChannel    `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`                          // partitions.
-	Schemas      []*CollectionSchemaOfVChannel `protobuf:"bytes,3,rep,name=schemas,proto3" json:"schemas,omitempty"`                                // The schemas of the vchannel.
+	ServerId           int64    `protobuf:"varint,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`                              // the server id of streaming node.
+	Draining           bool     `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`                                              // whether the node is draining.
+	RemainingPchannels []string `protobuf:"bytes,3,rep,name=remaining_pchannels,json=remainingPchannels,proto3" json:"remaining_pchannels,omitempty"` // the pchannels that are still on the node, ordered by name.
 }
 
-func (x *CollectionInfoOfVChannel) Reset() {
-	*x = CollectionInfoOfVChannel{}
+func (x *DrainNodeResponse) Reset() {
+	*x = DrainNodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4854,13 +5076,13 @@ func (x *CollectionInfoOfVChannel) Reset() {
 	}
 }
 
-func (x *CollectionInfoOfVChannel) String() string {
+func (x *DrainNodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectionInfoOfVChannel) ProtoMessage() {}
+func (*DrainNodeResponse) ProtoMessage() {}
 
-func (x *CollectionInfoOfVChannel) ProtoReflect() protoreflect.Message {
+func (x *DrainNodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4872,45 +5094,44 @@ func (x *CollectionInfoOfVChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectionInfoOfVChannel.ProtoReflect.Descriptor instead.
-func (*CollectionInfoOfVChannel) Descriptor() ([]byte, []int) {
+// Deprecated: Use DrainNodeResponse.ProtoReflect.Descriptor instead.
+func (*DrainNodeResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CollectionInfoOfVChannel) GetCollectionId() int64 {
+func (x *DrainNodeResponse) GetServerId() int64 {
 	if x != nil {
-		return x.CollectionId
+		return x.ServerId
 	}
 	return 0
 }
 
-func (x *CollectionInfoOfVChannel) GetPartitions() []*PartitionInfoOfVChannel {
+func (x *DrainNodeResponse) GetDraining() bool {
 	if x != nil {
-		return x.Partitions
+		return x.Draining
 	}
-	return nil
+	return false
 }
 
-func (x *CollectionInfoOfVChannel) GetSchemas() []*CollectionSchemaOfVChannel {
+func (x *DrainNodeResponse) GetRemainingPchannels() []string {
 	if x != nil {
-		return x.Schemas
+		return x.RemainingPchannels
 	}
 	return nil
 }
 
-// CollectionSchemaOfVChannel is the collection schema in vchannel.
-type CollectionSchemaOfVChannel struct {
+// UpdatePChannelPinsRequest is the request to update the pins of pchannels.
+type UpdatePChannelPinsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Schema             *schemapb.CollectionSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`                                                      // The schemas of the vchannel.
-	CheckpointTimeTick uint64                     `protobuf:"varint,2,opt,name=checkpoint_time_tick,json=checkpointTimeTick,proto3" json:"checkpoint_time_tick,omitempty"` // The timetick of the schema changed, also the version of the schema.
-	State              VChannelSchemaState        `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.streaming.VChannelSchemaState" json:"state,omitempty"`       // The state of the schema.
+	Pins           []*PChannelPin `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`                                           // the pins to be created or replaced.
+	UnpinPchannels []string       `protobuf:"bytes,2,rep,name=unpin_pchannels,json=unpinPchannels,proto3" json:"unpin_pchannels,omitempty"` // the pchannels to be unpinned.
 }
 
-func (x *CollectionSchemaOfVChannel) Reset() {
-	*x = CollectionSchemaOfVChannel{}
+func (x *UpdatePChannelPinsRequest) Reset() {
+	*x = UpdatePChannelPinsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4918,13 +5139,13 @@ func (x *CollectionSchemaOfVChannel) Reset() {
 	}
 }
 
-func (x *CollectionSchemaOfVChannel) String() string {
+func (x *UpdatePChannelPinsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectionSchemaOfVChannel) ProtoMessage() {}
+func (*UpdatePChannelPinsRequest) ProtoMessage() {}
 
-func (x *CollectionSchemaOfVChannel) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelPinsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4936,43 +5157,36 @@ func (x *CollectionSchemaOfVChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectionSchemaOfVChannel.ProtoReflect.Descriptor instead.
-func (*CollectionSchemaOfVChannel) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelPinsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelPinsRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CollectionSchemaOfVChannel) GetSchema() *schemapb.CollectionSchema {
+func (x *UpdatePChannelPinsRequest) GetPins() []*PChannelPin {
 	if x != nil {
-		return x.Schema
+		return x.Pins
 	}
 	return nil
 }
 
-func (x *CollectionSchemaOfVChannel) GetCheckpointTimeTick() uint64 {
-	if x != nil {
-		return x.CheckpointTimeTick
-	}
-	return 0
-}
-
-func (x *CollectionSchemaOfVChannel) GetState() VChannelSchemaState {
+func (x *UpdatePChannelPinsRequest) GetUnpinPchannels() []string {
 	if x != nil {
-		return x.State
+		return x.UnpinPchannels
 	}
-	return VChannelSchemaState_VCHANNEL_SCHEMA_STATE_UNKNOWN
+	return nil
 }
 
-// PartitionInfoOfVChannel is the partition info in vchannel.
-type PartitionInfoOfVChannel struct {
+// UpdatePChannelPinsResponse is the response of UpdatePChannelPins.
+type UpdatePChannelPinsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PartitionId int64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"` // partition id.
+	Pins []*PChannelPin `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"` // all pins after the update.
 }
 
-func (x *PartitionInfoOfVChannel) Reset() {
-	*x = PartitionInfoOfVChannel{}
+func (x *UpdatePChannelPinsResponse) Reset() {
+	*x = UpdatePChannelPinsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4980,13 +5194,13 @@ func (x *PartitionInfoOfVChannel) Reset() {
 	}
 }
 
-func (x *PartitionInfoOfVChannel) String() string {
+func (x *UpdatePChannelPinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionInfoOfVChannel) ProtoMessage() {}
+func (*UpdatePChannelPinsResponse) ProtoMessage() {}
 
-func (x *PartitionInfoOfVChannel) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelPinsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4998,43 +5212,30 @@ func (x *PartitionInfoOfVChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionInfoOfVChannel.ProtoReflect.Descriptor instead.
-func (*PartitionInfoOfVChannel) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelPinsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelPinsResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *PartitionInfoOfVChannel) GetPartitionId() int64 {
+func (x *UpdatePChannelPinsResponse) GetPins() []*PChannelPin {
 	if x != nil {
-		return x.PartitionId
+		return x.Pins
 	}
-	return 0
+	return nil
 }
 
-// /
-// / SegmentAssignment
-// /
-// SegmentAssignmentMeta is the stat of segment assignment.
-// These meta is only used to recover status at streaming node segment
-// assignment, don't use it outside.
-// Used to storage the segment assignment stat
-// at meta-store. The WAL use it to determine when to make the segment sealed.
-type SegmentAssignmentMeta struct {
+// UpdatePChannelPoolsRequest is the request to update the pchannel pools.
+type UpdatePChannelPoolsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollectionId       int64                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	PartitionId        int64                  `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
-	SegmentId          int64                  `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
-	Vchannel           string                 `protobuf:"bytes,4,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
-	State              SegmentAssignmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.streaming.SegmentAssignmentState" json:"state,omitempty"`
-	Stat               *SegmentAssignmentStat `protobuf:"bytes,6,opt,name=stat,proto3" json:"stat,omitempty"`
-	StorageVersion     int64                  `protobuf:"varint,7,opt,name=storage_version,json=storageVersion,proto3" json:"storage_version,omitempty"`               // only available if level is L1 or Legacy.
-	CheckpointTimeTick uint64                 `protobuf:"varint,8,opt,name=checkpoint_time_tick,json=checkpointTimeTick,proto3" json:"checkpoint_time_tick,omitempty"` // The timetick of checkpoint, the meta already see the message at this timetick.
+	UpsertPools []*PChannelPoolMeta `protobuf:"bytes,1,rep,name=upsert_pools,json=upsertPools,proto3" json:"upsert_pools,omitempty"` // the pools to be created or fully replaced.
+	DropPools   []string            `protobuf:"bytes,2,rep,name=drop_pools,json=dropPools,proto3" json:"drop_pools,omitempty"`       // the names of pools to be dropped.
 }
 
-func (x *SegmentAssignmentMeta) Reset() {
-	*x = SegmentAssignmentMeta{}
+func (x *UpdatePChannelPoolsRequest) Reset() {
+	*x = UpdatePChannelPoolsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5042,13 +5243,13 @@ func (x *SegmentAssignmentMeta) Reset() {
 	}
 }
 
-func (x *SegmentAssignmentMeta) String() string {
+func (x *UpdatePChannelPoolsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentAssignmentMeta) ProtoMessage() {}
+func (*UpdatePChannelPoolsRequest) ProtoMessage() {}
 
-func (x *SegmentAssignmentMeta) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelPoolsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5060,86 +5261,36 @@ func (x *SegmentAssignmentMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SegmentAssignmentMeta.ProtoReflect.Descriptor instead.
-func (*SegmentAssignmentMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelPoolsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelPoolsRequest) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *SegmentAssignmentMeta) GetCollectionId() int64 {
-	if x != nil {
-		return x.CollectionId
-	}
-	return 0
-}
-
-func (x *SegmentAssignmentMeta) GetPartitionId() int64 {
-	if x != nil {
-		return x.PartitionId
-	}
-	return 0
-}
-
-func (x *SegmentAssignmentMeta) GetSegmentId() int64 {
-	if x != nil {
-		return x.SegmentId
-	}
-	return 0
-}
-
-func (x *SegmentAssignmentMeta) GetVchannel() string {
-	if x != nil {
-		return x.Vchannel
-	}
-	return ""
-}
-
-func (x *SegmentAssignmentMeta) GetState() SegmentAssignmentState {
-	if x != nil {
-		return x.State
-	}
-	return SegmentAssignmentState_SEGMENT_ASSIGNMENT_STATE_UNKNOWN
-}
-
-func (x *SegmentAssignmentMeta) GetStat() *SegmentAssignmentStat {
+func (x *UpdatePChannelPoolsRequest) GetUpsertPools() []*PChannelPoolMeta {
 	if x != nil {
-		return x.Stat
+		return x.UpsertPools
 	}
 	return nil
 }
 
-func (x *SegmentAssignmentMeta) GetStorageVersion() int64 {
-	if x != nil {
-		return x.StorageVersion
-	}
-	return 0
-}
-
-func (x *SegmentAssignmentMeta) GetCheckpointTimeTick() uint64 {
+func (x *UpdatePChannelPoolsRequest) GetDropPools() []string {
 	if x != nil {
-		return x.CheckpointTimeTick
+		return x.DropPools
 	}
-	return 0
+	return nil
 }
 
-// SegmentAssignmentStat is the stat of segment assignment.
-type SegmentAssignmentStat struct {
+// UpdatePChannelPoolsResponse is the response of UpdatePChannelPools.
+type UpdatePChannelPoolsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxBinarySize         uint64              `protobuf:"varint,1,opt,name=max_binary_size,json=maxBinarySize,proto3" json:"max_binary_size,omitempty"`
-	ModifiedRows          uint64              `protobuf:"varint,2,opt,name=modified_rows,json=modifiedRows,proto3" json:"modified_rows,omitempty"`
-	ModifiedBinarySize    uint64              `protobuf:"varint,3,opt,name=modified_binary_size,json=modifiedBinarySize,proto3" json:"modified_binary_size,omitempty"`
-	CreateTimestamp       int64               `protobuf:"varint,4,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
-	LastModifiedTimestamp int64               `protobuf:"varint,5,opt,name=last_modified_timestamp,json=lastModifiedTimestamp,proto3" json:"last_modified_timestamp,omitempty"`
-	BinlogCounter         uint64              `protobuf:"varint,6,opt,name=binlog_counter,json=binlogCounter,proto3" json:"binlog_counter,omitempty"`
-	CreateSegmentTimeTick uint64              `protobuf:"varint,7,opt,name=create_segment_time_tick,json=createSegmentTimeTick,proto3" json:"create_segment_time_tick,omitempty"` // The timetick of create segment message in wal.
-	Level                 datapb.SegmentLevel `protobuf:"varint,8,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`                              // The level of the segment, only L0 or L1.
-	MaxRows               uint64              `protobuf:"varint,9,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`                                               // The max rows of the segment.
+	Pools []*PChannelPoolMeta `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"` // all pchannel pools after the update.
 }
 
-func (x *SegmentAssignmentStat) Reset() {
-	*x = SegmentAssignmentStat{}
+func (x *UpdatePChannelPoolsResponse) Reset() {
+	*x = UpdatePChannelPoolsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streaming_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5147,13 +5298,13 @@ func (x *SegmentAssignmentStat) Reset() {
 	}
 }
 
-func (x *SegmentAssignmentStat) String() string {
+func (x *UpdatePChannelPoolsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentAssignmentStat) ProtoMessage() {}
+func (*UpdatePChannelPoolsResponse) ProtoMessage() {}
 
-func (x *SegmentAssignmentStat) ProtoReflect() protoreflect.Message {
+func (x *UpdatePChannelPoolsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_streaming_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5165,112 +5316,103 @@ func (x *SegmentAssignmentStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SegmentAssignmentStat.ProtoReflect.Descriptor instead.
-func (*SegmentAssignmentStat) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePChannelPoolsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePChannelPoolsResponse) Descriptor() ([]byte, []int) {
 	return file_streaming_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SegmentAssignmentStat) GetMaxBinarySize() uint64 {
+func (x *UpdatePChannelPoolsResponse) GetPools() []*PChannelPoolMeta {
 	if x != nil {
-		return x.MaxBinarySize
+		return x.Pools
 	}
-	return 0
+	return nil
 }
 
-func (x *SegmentAssignmentStat) GetModifiedRows() uint64 {
-	if x != nil {
-		return x.ModifiedRows
-	}
-	return 0
-}
+// RenewPChannelLeaseRequest is the request to renew the lease of the pchannels
+// held by a streamingnode.
+type RenewPChannelLeaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SegmentAssignmentStat) GetModifiedBinarySize() uint64 {
-	if x != nil {
-		return x.ModifiedBinarySize
-	}
-	return 0
+	Node     *StreamingNodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`         // the streamingnode that holds the pchannels.
+	Channels []*PChannelInfo    `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"` // the pchannels opened on the streamingnode with its term.
 }
 
-func (x *SegmentAssignmentStat) GetCreateTimestamp() int64 {
-	if x != nil {
-		return x.CreateTimestamp
+func (x *RenewPChannelLeaseRequest) Reset() {
+	*x = RenewPChannelLeaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SegmentAssignmentStat) GetLastModifiedTimestamp() int64 {
-	if x != nil {
-		return x.LastModifiedTimestamp
-	}
-	return 0
+func (x *RenewPChannelLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SegmentAssignmentStat) GetBinlogCounter() uint64 {
-	if x != nil {
-		return x.BinlogCounter
+func (*RenewPChannelLeaseRequest) ProtoMessage() {}
+
+func (x *RenewPChannelLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SegmentAssignmentStat) GetCreateSegmentTimeTick() uint64 {
-	if x != nil {
-		return x.CreateSegmentTimeTick
-	}
-	return 0
+// Deprecated: Use RenewPChannelLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewPChannelLeaseRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *SegmentAssignmentStat) GetLevel() datapb.SegmentLevel {
+func (x *RenewPChannelLeaseRequest) GetNode() *StreamingNodeInfo {
 	if x != nil {
-		return x.Level
+		return x.Node
 	}
-	return datapb.SegmentLevel(0)
+	return nil
 }
 
-func (x *SegmentAssignmentStat) GetMaxRows() uint64 {
+func (x *RenewPChannelLeaseRequest) GetChannels() []*PChannelInfo {
 	if x != nil {
-		return x.MaxRows
+		return x.Channels
 	}
-	return 0
+	return nil
 }
 
-// The WALCheckpoint that is used to recovery the wal scanner.
-type WALCheckpoint struct {
+// RenewPChannelLeaseResponse is the response of RenewPChannelLease.
+type RenewPChannelLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageId *commonpb.MessageID `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // From here to recover all uncommited info.
-	// e.g., primary key index, segment assignment info, vchannel info...
-	// because current data path flush is slow, and managed by the coordinator, current current is not apply to it.
-	//
-	// because the data path flush is slow, so we add a new checkpoint here to promise fast recover the wal state from log.
-	TimeTick uint64 `protobuf:"varint,2,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"` // The timetick of checkpoint, keep consistecy with message_id.
-	// It's a hint for easier debugging.
-	RecoveryMagic int64 `protobuf:"varint,3,opt,name=recovery_magic,json=recoveryMagic,proto3" json:"recovery_magic,omitempty"` // The recovery version of the checkpoint, it's used to hint the future recovery info upgrading.
-	// The wal name of the checkpoint.
-	ReplicateConfig     *commonpb.ReplicateConfiguration `protobuf:"bytes,4,opt,name=replicate_config,json=replicateConfig,proto3" json:"replicate_config,omitempty"`             // if the wal join a replicated clusters, the replicate config is not null,
-	ReplicateCheckpoint *commonpb.ReplicateCheckpoint    `protobuf:"bytes,5,opt,name=replicate_checkpoint,json=replicateCheckpoint,proto3" json:"replicate_checkpoint,omitempty"` // if the wal is replicated from remote cluster, the checkpoint is not null,
-	// Used for Alter WAL operations to track WAL modification states
-	AlterWalState *AlterWALState `protobuf:"bytes,6,opt,name=alter_wal_state,json=alterWalState,proto3" json:"alter_wal_state,omitempty"`
+	RevokedChannels []*PChannelInfo `protobuf:"bytes,1,rep,name=revoked_channels,json=revokedChannels,proto3" json:"revoked_channels,omitempty"` // the pchannels that are not owned by the streamingnode any more,
+	// the streamingnode should stop renewing them.
+	LeaseTtlMs int64 `protobuf:"varint,2,opt,name=lease_ttl_ms,json=leaseTtlMs,proto3" json:"lease_ttl_ms,omitempty"` // the lease ttl granted by the streamingcoord.
 }
 
-func (x *WALCheckpoint) Reset() {
-	*x = WALCheckpoint{}
+func (x *RenewPChannelLeaseResponse) Reset() {
+	*x = RenewPChannelLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[77]
+		mi := &file_streaming_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WALCheckpoint) String() string {
+func (x *RenewPChannelLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WALCheckpoint) ProtoMessage() {}
+func (*RenewPChannelLeaseResponse) ProtoMessage() {}
 
-func (x *WALCheckpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[77]
+func (x *RenewPChannelLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5281,81 +5423,140 @@ func (x *WALCheckpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WALCheckpoint.ProtoReflect.Descriptor instead.
-func (*WALCheckpoint) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use RenewPChannelLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RenewPChannelLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *WALCheckpoint) GetMessageId() *commonpb.MessageID {
+func (x *RenewPChannelLeaseResponse) GetRevokedChannels() []*PChannelInfo {
 	if x != nil {
-		return x.MessageId
+		return x.RevokedChannels
 	}
 	return nil
 }
 
-func (x *WALCheckpoint) GetTimeTick() uint64 {
+func (x *RenewPChannelLeaseResponse) GetLeaseTtlMs() int64 {
 	if x != nil {
-		return x.TimeTick
+		return x.LeaseTtlMs
 	}
 	return 0
 }
 
-func (x *WALCheckpoint) GetRecoveryMagic() int64 {
-	if x != nil {
-		return x.RecoveryMagic
+type UpdateReplicateConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configuration *commonpb.ReplicateConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ForcePromote  bool                             `protobuf:"varint,2,opt,name=force_promote,json=forcePromote,proto3" json:"force_promote,omitempty"`
+	DryRun        bool                             `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // compute the plan of the configuration change without applying it.
+	Operator      string                           `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`            // the user who requested the change, recorded in the configuration history.
+	// whether the users, roles and privileges are not replicated into the secondary clusters, the current setting is kept if not set.
+	RbacReplicationDisabled *bool `protobuf:"varint,5,opt,name=rbac_replication_disabled,json=rbacReplicationDisabled,proto3,oneof" json:"rbac_replication_disabled,omitempty"`
+	// the collection filters of the target clusters, the current filters are kept if not set.
+	CollectionFilters *ReplicateCollectionFilters `protobuf:"bytes,6,opt,name=collection_filters,json=collectionFilters,proto3" json:"collection_filters,omitempty"`
+}
+
+func (x *UpdateReplicateConfigurationRequest) Reset() {
+	*x = UpdateReplicateConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WALCheckpoint) GetReplicateConfig() *commonpb.ReplicateConfiguration {
+func (x *UpdateReplicateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReplicateConfigurationRequest) ProtoMessage() {}
+
+func (x *UpdateReplicateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReplicateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReplicateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateReplicateConfigurationRequest) GetConfiguration() *commonpb.ReplicateConfiguration {
 	if x != nil {
-		return x.ReplicateConfig
+		return x.Configuration
 	}
 	return nil
 }
 
-func (x *WALCheckpoint) GetReplicateCheckpoint() *commonpb.ReplicateCheckpoint {
+func (x *UpdateReplicateConfigurationRequest) GetForcePromote() bool {
 	if x != nil {
-		return x.ReplicateCheckpoint
+		return x.ForcePromote
 	}
-	return nil
+	return false
 }
 
-func (x *WALCheckpoint) GetAlterWalState() *AlterWALState {
+func (x *UpdateReplicateConfigurationRequest) GetDryRun() bool {
 	if x != nil {
-		return x.AlterWalState
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateReplicateConfigurationRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *UpdateReplicateConfigurationRequest) GetRbacReplicationDisabled() bool {
+	if x != nil && x.RbacReplicationDisabled != nil {
+		return *x.RbacReplicationDisabled
+	}
+	return false
+}
+
+func (x *UpdateReplicateConfigurationRequest) GetCollectionFilters() *ReplicateCollectionFilters {
+	if x != nil {
+		return x.CollectionFilters
 	}
 	return nil
 }
 
-type AlterWALState struct {
+// ReplicateCollectionFilters is the collection filters of the target clusters, keyed by the target cluster id.
+type ReplicateCollectionFilters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TargetWalName commonpb.WALName  `protobuf:"varint,1,opt,name=target_wal_name,json=targetWalName,proto3,enum=milvus.proto.common.WALName" json:"target_wal_name,omitempty"`
-	TimeTick      uint64            `protobuf:"varint,2,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`
-	Configs       map[string]string `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Stage         AlterWALStage     `protobuf:"varint,4,opt,name=stage,proto3,enum=milvus.proto.streaming.AlterWALStage" json:"stage,omitempty"`
+	Filters map[string]*messagespb.ReplicateCollectionFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *AlterWALState) Reset() {
-	*x = AlterWALState{}
+func (x *ReplicateCollectionFilters) Reset() {
+	*x = ReplicateCollectionFilters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[78]
+		mi := &file_streaming_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AlterWALState) String() string {
+func (x *ReplicateCollectionFilters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlterWALState) ProtoMessage() {}
+func (*ReplicateCollectionFilters) ProtoMessage() {}
 
-func (x *AlterWALState) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[78]
+func (x *ReplicateCollectionFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5366,67 +5567,100 @@ func (x *AlterWALState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlterWALState.ProtoReflect.Descriptor instead.
-func (*AlterWALState) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ReplicateCollectionFilters.ProtoReflect.Descriptor instead.
+func (*ReplicateCollectionFilters) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *AlterWALState) GetTargetWalName() commonpb.WALName {
+func (x *ReplicateCollectionFilters) GetFilters() map[string]*messagespb.ReplicateCollectionFilter {
 	if x != nil {
-		return x.TargetWalName
+		return x.Filters
 	}
-	return commonpb.WALName(0)
+	return nil
 }
 
-func (x *AlterWALState) GetTimeTick() uint64 {
-	if x != nil {
-		return x.TimeTick
+// UpdateReplicateConfigurationResponse is the response of UpdateReplicateConfiguration service
+type UpdateReplicateConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan *ReplicateConfigurationPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"` // the plan of the configuration change, only set in dry run mode.
+}
+
+func (x *UpdateReplicateConfigurationResponse) Reset() {
+	*x = UpdateReplicateConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AlterWALState) GetConfigs() map[string]string {
-	if x != nil {
-		return x.Configs
+func (x *UpdateReplicateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReplicateConfigurationResponse) ProtoMessage() {}
+
+func (x *UpdateReplicateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AlterWALState) GetStage() AlterWALStage {
+// Deprecated: Use UpdateReplicateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReplicateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateReplicateConfigurationResponse) GetPlan() *ReplicateConfigurationPlan {
 	if x != nil {
-		return x.Stage
+		return x.Plan
 	}
-	return AlterWALStage_NONE
+	return nil
 }
 
-// ReplicateConfigurationMeta is the replicate configuration of the wal.
-type ReplicateConfigurationMeta struct {
+// ReplicateConfigurationPlan is the changes of current cluster made by applying a replicate configuration.
+type ReplicateConfigurationPlan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReplicateConfiguration *commonpb.ReplicateConfiguration `protobuf:"bytes,1,opt,name=replicate_configuration,json=replicateConfiguration,proto3" json:"replicate_configuration,omitempty"`
-	AckedResult            *AckedResult                     `protobuf:"bytes,2,opt,name=acked_result,json=ackedResult,proto3" json:"acked_result,omitempty"`        // a acked helper to help managing the consuming of PutReplicateConfigMessageV2 message at coordinator.
-	ForcePromoted          bool                             `protobuf:"varint,3,opt,name=force_promoted,json=forcePromoted,proto3" json:"force_promoted,omitempty"` // marks if this was force-promoted
+	SameAsCurrent        bool `protobuf:"varint,1,opt,name=same_as_current,json=sameAsCurrent,proto3" json:"same_as_current,omitempty"`                      // the configuration is same as the current one, applying it is a no-op.
+	IsPchannelIncreasing bool `protobuf:"varint,2,opt,name=is_pchannel_increasing,json=isPchannelIncreasing,proto3" json:"is_pchannel_increasing,omitempty"` // the configuration appends new pchannels to the existing clusters.
+	// the replicating tasks created by the configuration,
+	// the initialized checkpoint is not set, which is decided when the configuration is applied.
+	CreatedTasks []*ReplicatePChannelMeta `protobuf:"bytes,3,rep,name=created_tasks,json=createdTasks,proto3" json:"created_tasks,omitempty"`
+	// the replicating tasks of the replication edges removed by the configuration.
+	RemovedTasks []*ReplicatePChannelMeta `protobuf:"bytes,4,rep,name=removed_tasks,json=removedTasks,proto3" json:"removed_tasks,omitempty"`
+	// the channels whose availability in replication is flipped by the configuration.
+	AvailabilityChanges []*ReplicationAvailabilityChange `protobuf:"bytes,5,rep,name=availability_changes,json=availabilityChanges,proto3" json:"availability_changes,omitempty"`
 }
 
-func (x *ReplicateConfigurationMeta) Reset() {
-	*x = ReplicateConfigurationMeta{}
+func (x *ReplicateConfigurationPlan) Reset() {
+	*x = ReplicateConfigurationPlan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[79]
+		mi := &file_streaming_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplicateConfigurationMeta) String() string {
+func (x *ReplicateConfigurationPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicateConfigurationMeta) ProtoMessage() {}
+func (*ReplicateConfigurationPlan) ProtoMessage() {}
 
-func (x *ReplicateConfigurationMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[79]
+func (x *ReplicateConfigurationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5437,61 +5671,73 @@ func (x *ReplicateConfigurationMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicateConfigurationMeta.ProtoReflect.Descriptor instead.
-func (*ReplicateConfigurationMeta) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ReplicateConfigurationPlan.ProtoReflect.Descriptor instead.
+func (*ReplicateConfigurationPlan) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ReplicateConfigurationMeta) GetReplicateConfiguration() *commonpb.ReplicateConfiguration {
+func (x *ReplicateConfigurationPlan) GetSameAsCurrent() bool {
 	if x != nil {
-		return x.ReplicateConfiguration
+		return x.SameAsCurrent
+	}
+	return false
+}
+
+func (x *ReplicateConfigurationPlan) GetIsPchannelIncreasing() bool {
+	if x != nil {
+		return x.IsPchannelIncreasing
+	}
+	return false
+}
+
+func (x *ReplicateConfigurationPlan) GetCreatedTasks() []*ReplicatePChannelMeta {
+	if x != nil {
+		return x.CreatedTasks
 	}
 	return nil
 }
 
-func (x *ReplicateConfigurationMeta) GetAckedResult() *AckedResult {
+func (x *ReplicateConfigurationPlan) GetRemovedTasks() []*ReplicatePChannelMeta {
 	if x != nil {
-		return x.AckedResult
+		return x.RemovedTasks
 	}
 	return nil
 }
 
-func (x *ReplicateConfigurationMeta) GetForcePromoted() bool {
+func (x *ReplicateConfigurationPlan) GetAvailabilityChanges() []*ReplicationAvailabilityChange {
 	if x != nil {
-		return x.ForcePromoted
+		return x.AvailabilityChanges
 	}
-	return false
+	return nil
 }
 
-type ReplicatePChannelMeta struct {
+// ReplicationAvailabilityChange is the flip of the availability in replication of a pchannel.
+type ReplicationAvailabilityChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourceChannelName          string                        `protobuf:"bytes,1,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"`
-	TargetChannelName          string                        `protobuf:"bytes,2,opt,name=target_channel_name,json=targetChannelName,proto3" json:"target_channel_name,omitempty"`
-	TargetCluster              *commonpb.MilvusCluster       `protobuf:"bytes,3,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
-	InitializedCheckpoint      *commonpb.ReplicateCheckpoint `protobuf:"bytes,4,opt,name=initialized_checkpoint,json=initializedCheckpoint,proto3" json:"initialized_checkpoint,omitempty"`
-	SkipGetReplicateCheckpoint bool                          `protobuf:"varint,5,opt,name=skip_get_replicate_checkpoint,json=skipGetReplicateCheckpoint,proto3" json:"skip_get_replicate_checkpoint,omitempty"`
+	ChannelName            string `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	AvailableInReplication bool   `protobuf:"varint,2,opt,name=available_in_replication,json=availableInReplication,proto3" json:"available_in_replication,omitempty"` // the availability after the configuration is applied.
 }
 
-func (x *ReplicatePChannelMeta) Reset() {
-	*x = ReplicatePChannelMeta{}
+func (x *ReplicationAvailabilityChange) Reset() {
+	*x = ReplicationAvailabilityChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streaming_proto_msgTypes[80]
+		mi := &file_streaming_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplicatePChannelMeta) String() string {
+func (x *ReplicationAvailabilityChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicatePChannelMeta) ProtoMessage() {}
+func (*ReplicationAvailabilityChange) ProtoMessage() {}
 
-func (x *ReplicatePChannelMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_streaming_proto_msgTypes[80]
+func (x *ReplicationAvailabilityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5502,52 +5748,5368 @@ func (x *ReplicatePChannelMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicatePChannelMeta.ProtoReflect.Descriptor instead.
-func (*ReplicatePChannelMeta) Descriptor() ([]byte, []int) {
-	return file_streaming_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use ReplicationAvailabilityChange.ProtoReflect.Descriptor instead.
+func (*ReplicationAvailabilityChange) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReplicatePChannelMeta) GetSourceChannelName() string {
+func (x *ReplicationAvailabilityChange) GetChannelName() string {
 	if x != nil {
-		return x.SourceChannelName
+		return x.ChannelName
 	}
 	return ""
 }
 
-func (x *ReplicatePChannelMeta) GetTargetChannelName() string {
+func (x *ReplicationAvailabilityChange) GetAvailableInReplication() bool {
 	if x != nil {
-		return x.TargetChannelName
+		return x.AvailableInReplication
 	}
-	return ""
+	return false
 }
 
-func (x *ReplicatePChannelMeta) GetTargetCluster() *commonpb.MilvusCluster {
+// ValidateReplicateConfigurationRequest is the request of ValidateReplicateConfiguration service
+type ValidateReplicateConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configuration *commonpb.ReplicateConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *ValidateReplicateConfigurationRequest) Reset() {
+	*x = ValidateReplicateConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateReplicateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateReplicateConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateReplicateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateReplicateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateReplicateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ValidateReplicateConfigurationRequest) GetConfiguration() *commonpb.ReplicateConfiguration {
 	if x != nil {
-		return x.TargetCluster
+		return x.Configuration
 	}
 	return nil
 }
 
-func (x *ReplicatePChannelMeta) GetInitializedCheckpoint() *commonpb.ReplicateCheckpoint {
+// ValidateReplicateConfigurationResponse is the response of ValidateReplicateConfiguration service,
+// an error is returned if the configuration is invalid.
+type ValidateReplicateConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SameAsCurrent        bool `protobuf:"varint,1,opt,name=same_as_current,json=sameAsCurrent,proto3" json:"same_as_current,omitempty"`                      // the configuration is same as the current one, applying it is a no-op.
+	IsPchannelIncreasing bool `protobuf:"varint,2,opt,name=is_pchannel_increasing,json=isPchannelIncreasing,proto3" json:"is_pchannel_increasing,omitempty"` // the configuration appends new pchannels to the existing clusters.
+}
+
+func (x *ValidateReplicateConfigurationResponse) Reset() {
+	*x = ValidateReplicateConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateReplicateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateReplicateConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateReplicateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateReplicateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateReplicateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ValidateReplicateConfigurationResponse) GetSameAsCurrent() bool {
 	if x != nil {
-		return x.InitializedCheckpoint
+		return x.SameAsCurrent
 	}
-	return nil
+	return false
 }
 
-func (x *ReplicatePChannelMeta) GetSkipGetReplicateCheckpoint() bool {
+func (x *ValidateReplicateConfigurationResponse) GetIsPchannelIncreasing() bool {
 	if x != nil {
-		return x.SkipGetReplicateCheckpoint
+		return x.IsPchannelIncreasing
 	}
 	return false
 }
 
-var File_streaming_proto protoreflect.FileDescriptor
+// PromoteSecondaryRequest is the request of PromoteSecondary service.
+type PromoteSecondaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_streaming_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x16, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x1a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	TargetClusterId string `protobuf:"bytes,1,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"` // the direct secondary cluster of current cluster to be promoted to primary.
+}
+
+func (x *PromoteSecondaryRequest) Reset() {
+	*x = PromoteSecondaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteSecondaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteSecondaryRequest) ProtoMessage() {}
+
+func (x *PromoteSecondaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteSecondaryRequest.ProtoReflect.Descriptor instead.
+func (*PromoteSecondaryRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PromoteSecondaryRequest) GetTargetClusterId() string {
+	if x != nil {
+		return x.TargetClusterId
+	}
+	return ""
+}
+
+// PromoteSecondaryResponse is the response of PromoteSecondary service.
+type PromoteSecondaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configuration *commonpb.ReplicateConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"` // the replicate configuration after switchover.
+}
+
+func (x *PromoteSecondaryResponse) Reset() {
+	*x = PromoteSecondaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteSecondaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteSecondaryResponse) ProtoMessage() {}
+
+func (x *PromoteSecondaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteSecondaryResponse.ProtoReflect.Descriptor instead.
+func (*PromoteSecondaryResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PromoteSecondaryResponse) GetConfiguration() *commonpb.ReplicateConfiguration {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+// UpdateWALBalancePolicyRequest is the request to update the WAL balance policy.
+type UpdateWALBalancePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config     *WALBalancePolicyConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Nodes      *WALBalancePolicyNodes  `protobuf:"bytes,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask  `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateWALBalancePolicyRequest) Reset() {
+	*x = UpdateWALBalancePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWALBalancePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWALBalancePolicyRequest) ProtoMessage() {}
+
+func (x *UpdateWALBalancePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWALBalancePolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWALBalancePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateWALBalancePolicyRequest) GetConfig() *WALBalancePolicyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateWALBalancePolicyRequest) GetNodes() *WALBalancePolicyNodes {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *UpdateWALBalancePolicyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type WALBalancePolicyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowRebalance bool `protobuf:"varint,1,opt,name=allow_rebalance,json=allowRebalance,proto3" json:"allow_rebalance,omitempty"`
+	BalanceFrozen  bool `protobuf:"varint,2,opt,name=balance_frozen,json=balanceFrozen,proto3" json:"balance_frozen,omitempty"` // suspend the automatic reassignment, persisted in the catalog and kept after restart.
+}
+
+func (x *WALBalancePolicyConfig) Reset() {
+	*x = WALBalancePolicyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WALBalancePolicyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WALBalancePolicyConfig) ProtoMessage() {}
+
+func (x *WALBalancePolicyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WALBalancePolicyConfig.ProtoReflect.Descriptor instead.
+func (*WALBalancePolicyConfig) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *WALBalancePolicyConfig) GetAllowRebalance() bool {
+	if x != nil {
+		return x.AllowRebalance
+	}
+	return false
+}
+
+func (x *WALBalancePolicyConfig) GetBalanceFrozen() bool {
+	if x != nil {
+		return x.BalanceFrozen
+	}
+	return false
+}
+
+type WALBalancePolicyNodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FreezeNodeIds   []int64 `protobuf:"varint,1,rep,packed,name=freeze_node_ids,json=freezeNodeIds,proto3" json:"freeze_node_ids,omitempty"`       // nodes that will be frozen.
+	DefreezeNodeIds []int64 `protobuf:"varint,2,rep,packed,name=defreeze_node_ids,json=defreezeNodeIds,proto3" json:"defreeze_node_ids,omitempty"` // nodes that will be defrozen.
+}
+
+func (x *WALBalancePolicyNodes) Reset() {
+	*x = WALBalancePolicyNodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WALBalancePolicyNodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WALBalancePolicyNodes) ProtoMessage() {}
+
+func (x *WALBalancePolicyNodes) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WALBalancePolicyNodes.ProtoReflect.Descriptor instead.
+func (*WALBalancePolicyNodes) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *WALBalancePolicyNodes) GetFreezeNodeIds() []int64 {
+	if x != nil {
+		return x.FreezeNodeIds
+	}
+	return nil
+}
+
+func (x *WALBalancePolicyNodes) GetDefreezeNodeIds() []int64 {
+	if x != nil {
+		return x.DefreezeNodeIds
+	}
+	return nil
+}
+
+type UpdateWALBalancePolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config        *WALBalancePolicyConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`                                              // return current configuration of WAL balance policy.
+	FreezeNodeIds []int64                 `protobuf:"varint,2,rep,packed,name=freeze_node_ids,json=freezeNodeIds,proto3" json:"freeze_node_ids,omitempty"` // nodes that are frozen.
+}
+
+func (x *UpdateWALBalancePolicyResponse) Reset() {
+	*x = UpdateWALBalancePolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWALBalancePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWALBalancePolicyResponse) ProtoMessage() {}
+
+func (x *UpdateWALBalancePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWALBalancePolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWALBalancePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateWALBalancePolicyResponse) GetConfig() *WALBalancePolicyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateWALBalancePolicyResponse) GetFreezeNodeIds() []int64 {
+	if x != nil {
+		return x.FreezeNodeIds
+	}
+	return nil
+}
+
+// AssignmentDiscoverRequest is the request of Discovery
+type AssignmentDiscoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Command:
+	//
+	//	*AssignmentDiscoverRequest_ReportError
+	//	*AssignmentDiscoverRequest_Close
+	Command isAssignmentDiscoverRequest_Command `protobuf_oneof:"command"`
+}
+
+func (x *AssignmentDiscoverRequest) Reset() {
+	*x = AssignmentDiscoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentDiscoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentDiscoverRequest) ProtoMessage() {}
+
+func (x *AssignmentDiscoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentDiscoverRequest.ProtoReflect.Descriptor instead.
+func (*AssignmentDiscoverRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{92}
+}
+
+func (m *AssignmentDiscoverRequest) GetCommand() isAssignmentDiscoverRequest_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (x *AssignmentDiscoverRequest) GetReportError() *ReportAssignmentErrorRequest {
+	if x, ok := x.GetCommand().(*AssignmentDiscoverRequest_ReportError); ok {
+		return x.ReportError
+	}
+	return nil
+}
+
+func (x *AssignmentDiscoverRequest) GetClose() *CloseAssignmentDiscoverRequest {
+	if x, ok := x.GetCommand().(*AssignmentDiscoverRequest_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isAssignmentDiscoverRequest_Command interface {
+	isAssignmentDiscoverRequest_Command()
+}
+
+type AssignmentDiscoverRequest_ReportError struct {
+	ReportError *ReportAssignmentErrorRequest `protobuf:"bytes,1,opt,name=report_error,json=reportError,proto3,oneof"` // report streaming error, trigger reassign right now.
+}
+
+type AssignmentDiscoverRequest_Close struct {
+	Close *CloseAssignmentDiscoverRequest `protobuf:"bytes,2,opt,name=close,proto3,oneof"` // close the stream.
+}
+
+func (*AssignmentDiscoverRequest_ReportError) isAssignmentDiscoverRequest_Command() {}
+
+func (*AssignmentDiscoverRequest_Close) isAssignmentDiscoverRequest_Command() {}
+
+// ReportAssignmentErrorRequest is the request to report assignment error
+// happens.
+type ReportAssignmentErrorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo   `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"` // channel
+	Err      *StreamingError `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`           // error happend on log node
+}
+
+func (x *ReportAssignmentErrorRequest) Reset() {
+	*x = ReportAssignmentErrorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportAssignmentErrorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportAssignmentErrorRequest) ProtoMessage() {}
+
+func (x *ReportAssignmentErrorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportAssignmentErrorRequest.ProtoReflect.Descriptor instead.
+func (*ReportAssignmentErrorRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ReportAssignmentErrorRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+func (x *ReportAssignmentErrorRequest) GetErr() *StreamingError {
+	if x != nil {
+		return x.Err
+	}
+	return nil
+}
+
+// CloseAssignmentDiscoverRequest is the request to close the stream.
+type CloseAssignmentDiscoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseAssignmentDiscoverRequest) Reset() {
+	*x = CloseAssignmentDiscoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAssignmentDiscoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAssignmentDiscoverRequest) ProtoMessage() {}
+
+func (x *CloseAssignmentDiscoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAssignmentDiscoverRequest.ProtoReflect.Descriptor instead.
+func (*CloseAssignmentDiscoverRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{94}
+}
+
+// AssignmentDiscoverResponse is the response of Discovery
+type AssignmentDiscoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AssignmentDiscoverResponse_FullAssignment
+	//	*AssignmentDiscoverResponse_Close
+	Response isAssignmentDiscoverResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AssignmentDiscoverResponse) Reset() {
+	*x = AssignmentDiscoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssignmentDiscoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentDiscoverResponse) ProtoMessage() {}
+
+func (x *AssignmentDiscoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentDiscoverResponse.ProtoReflect.Descriptor instead.
+func (*AssignmentDiscoverResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{95}
+}
+
+func (m *AssignmentDiscoverResponse) GetResponse() isAssignmentDiscoverResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AssignmentDiscoverResponse) GetFullAssignment() *FullStreamingNodeAssignmentWithVersion {
+	if x, ok := x.GetResponse().(*AssignmentDiscoverResponse_FullAssignment); ok {
+		return x.FullAssignment
+	}
+	return nil
+}
+
+func (x *AssignmentDiscoverResponse) GetClose() *CloseAssignmentDiscoverResponse {
+	if x, ok := x.GetResponse().(*AssignmentDiscoverResponse_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isAssignmentDiscoverResponse_Response interface {
+	isAssignmentDiscoverResponse_Response()
+}
+
+type AssignmentDiscoverResponse_FullAssignment struct {
+	FullAssignment *FullStreamingNodeAssignmentWithVersion `protobuf:"bytes,1,opt,name=full_assignment,json=fullAssignment,proto3,oneof"` // all assignment info.
+}
+
+type AssignmentDiscoverResponse_Close struct {
+	// TODO: may be support partial assignment info in future.
+	Close *CloseAssignmentDiscoverResponse `protobuf:"bytes,2,opt,name=close,proto3,oneof"`
+}
+
+func (*AssignmentDiscoverResponse_FullAssignment) isAssignmentDiscoverResponse_Response() {}
+
+func (*AssignmentDiscoverResponse_Close) isAssignmentDiscoverResponse_Response() {}
+
+// FullStreamingNodeAssignmentWithVersion is the full assignment info of a log
+// node with version.
+type FullStreamingNodeAssignmentWithVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Marked as deprecated in streaming.proto.
+	Version                *VersionPair                     `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Assignments            []*StreamingNodeAssignment       `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Cchannel               *CChannelAssignment              `protobuf:"bytes,3,opt,name=cchannel,proto3" json:"cchannel,omitempty"` // Where the control channel located.
+	ReplicateConfiguration *commonpb.ReplicateConfiguration `protobuf:"bytes,4,opt,name=replicate_configuration,json=replicateConfiguration,proto3" json:"replicate_configuration,omitempty"`
+	StreamingVersion       *StreamingVersion                `protobuf:"bytes,5,opt,name=streaming_version,json=streamingVersion,proto3" json:"streaming_version,omitempty"`
+	VersionByRevision      *VersionPair                     `protobuf:"bytes,6,opt,name=version_by_revision,json=versionByRevision,proto3" json:"version_by_revision,omitempty"`
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) Reset() {
+	*x = FullStreamingNodeAssignmentWithVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FullStreamingNodeAssignmentWithVersion) ProtoMessage() {}
+
+func (x *FullStreamingNodeAssignmentWithVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FullStreamingNodeAssignmentWithVersion.ProtoReflect.Descriptor instead.
+func (*FullStreamingNodeAssignmentWithVersion) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{96}
+}
+
+// Deprecated: Marked as deprecated in streaming.proto.
+func (x *FullStreamingNodeAssignmentWithVersion) GetVersion() *VersionPair {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) GetAssignments() []*StreamingNodeAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) GetCchannel() *CChannelAssignment {
+	if x != nil {
+		return x.Cchannel
+	}
+	return nil
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) GetReplicateConfiguration() *commonpb.ReplicateConfiguration {
+	if x != nil {
+		return x.ReplicateConfiguration
+	}
+	return nil
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) GetStreamingVersion() *StreamingVersion {
+	if x != nil {
+		return x.StreamingVersion
+	}
+	return nil
+}
+
+func (x *FullStreamingNodeAssignmentWithVersion) GetVersionByRevision() *VersionPair {
+	if x != nil {
+		return x.VersionByRevision
+	}
+	return nil
+}
+
+// CChannelAssignment is the assignment info of a control channel.
+type CChannelAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta *CChannelMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *CChannelAssignment) Reset() {
+	*x = CChannelAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CChannelAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CChannelAssignment) ProtoMessage() {}
+
+func (x *CChannelAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CChannelAssignment.ProtoReflect.Descriptor instead.
+func (*CChannelAssignment) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CChannelAssignment) GetMeta() *CChannelMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type CloseAssignmentDiscoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseAssignmentDiscoverResponse) Reset() {
+	*x = CloseAssignmentDiscoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseAssignmentDiscoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAssignmentDiscoverResponse) ProtoMessage() {}
+
+func (x *CloseAssignmentDiscoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAssignmentDiscoverResponse.ProtoReflect.Descriptor instead.
+func (*CloseAssignmentDiscoverResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{98}
+}
+
+// StreamingNodeInfo is the information of a streaming node.
+type StreamingNodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerId int64  `protobuf:"varint,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *StreamingNodeInfo) Reset() {
+	*x = StreamingNodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeInfo) ProtoMessage() {}
+
+func (x *StreamingNodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeInfo.ProtoReflect.Descriptor instead.
+func (*StreamingNodeInfo) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *StreamingNodeInfo) GetServerId() int64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *StreamingNodeInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// StreamingNodeAssignment is the assignment info of a streaming node.
+type StreamingNodeAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node         *StreamingNodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Channels     []*PChannelInfo    `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	ReadReplicas []*PChannelInfo    `protobuf:"bytes,3,rep,name=read_replicas,json=readReplicas,proto3" json:"read_replicas,omitempty"` // the read-only replicas of the pchannels that are owned by other streaming node.
+}
+
+func (x *StreamingNodeAssignment) Reset() {
+	*x = StreamingNodeAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeAssignment) ProtoMessage() {}
+
+func (x *StreamingNodeAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeAssignment.ProtoReflect.Descriptor instead.
+func (*StreamingNodeAssignment) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *StreamingNodeAssignment) GetNode() *StreamingNodeInfo {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *StreamingNodeAssignment) GetChannels() []*PChannelInfo {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *StreamingNodeAssignment) GetReadReplicas() []*PChannelInfo {
+	if x != nil {
+		return x.ReadReplicas
+	}
+	return nil
+}
+
+// DeliverPolicy is the policy to deliver message.
+type DeliverPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Policy:
+	//
+	//	*DeliverPolicy_All
+	//	*DeliverPolicy_Latest
+	//	*DeliverPolicy_StartFrom
+	//	*DeliverPolicy_StartAfter
+	//	*DeliverPolicy_StartFromTimeTick
+	Policy isDeliverPolicy_Policy `protobuf_oneof:"policy"`
+}
+
+func (x *DeliverPolicy) Reset() {
+	*x = DeliverPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverPolicy) ProtoMessage() {}
+
+func (x *DeliverPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverPolicy.ProtoReflect.Descriptor instead.
+func (*DeliverPolicy) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{101}
+}
+
+func (m *DeliverPolicy) GetPolicy() isDeliverPolicy_Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (x *DeliverPolicy) GetAll() *emptypb.Empty {
+	if x, ok := x.GetPolicy().(*DeliverPolicy_All); ok {
+		return x.All
+	}
+	return nil
+}
+
+func (x *DeliverPolicy) GetLatest() *emptypb.Empty {
+	if x, ok := x.GetPolicy().(*DeliverPolicy_Latest); ok {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *DeliverPolicy) GetStartFrom() *commonpb.MessageID {
+	if x, ok := x.GetPolicy().(*DeliverPolicy_StartFrom); ok {
+		return x.StartFrom
+	}
+	return nil
+}
+
+func (x *DeliverPolicy) GetStartAfter() *commonpb.MessageID {
+	if x, ok := x.GetPolicy().(*DeliverPolicy_StartAfter); ok {
+		return x.StartAfter
+	}
+	return nil
+}
+
+func (x *DeliverPolicy) GetStartFromTimeTick() *DeliverPolicyStartFromTimeTick {
+	if x, ok := x.GetPolicy().(*DeliverPolicy_StartFromTimeTick); ok {
+		return x.StartFromTimeTick
+	}
+	return nil
+}
+
+type isDeliverPolicy_Policy interface {
+	isDeliverPolicy_Policy()
+}
+
+type DeliverPolicy_All struct {
+	All *emptypb.Empty `protobuf:"bytes,1,opt,name=all,proto3,oneof"` // deliver all messages.
+}
+
+type DeliverPolicy_Latest struct {
+	Latest *emptypb.Empty `protobuf:"bytes,2,opt,name=latest,proto3,oneof"` // deliver the latest message.
+}
+
+type DeliverPolicy_StartFrom struct {
+	StartFrom *commonpb.MessageID `protobuf:"bytes,3,opt,name=start_from,json=startFrom,proto3,oneof"` // deliver message from this message id. [startFrom, ...]
+}
+
+type DeliverPolicy_StartAfter struct {
+	StartAfter *commonpb.MessageID `protobuf:"bytes,4,opt,name=start_after,json=startAfter,proto3,oneof"` // deliver message after this message id. (startAfter, ...]
+}
+
+type DeliverPolicy_StartFromTimeTick struct {
+	StartFromTimeTick *DeliverPolicyStartFromTimeTick `protobuf:"bytes,5,opt,name=start_from_time_tick,json=startFromTimeTick,proto3,oneof"` // deliver message from the first message whose time tick is greater than or equal to this time tick. [timeTick, ...]
+}
+
+func (*DeliverPolicy_All) isDeliverPolicy_Policy() {}
+
+func (*DeliverPolicy_Latest) isDeliverPolicy_Policy() {}
+
+func (*DeliverPolicy_StartFrom) isDeliverPolicy_Policy() {}
+
+func (*DeliverPolicy_StartAfter) isDeliverPolicy_Policy() {}
+
+func (*DeliverPolicy_StartFromTimeTick) isDeliverPolicy_Policy() {}
+
+// DeliverPolicyStartFromTimeTick is the policy to deliver message from a time tick.
+type DeliverPolicyStartFromTimeTick struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeTick uint64 `protobuf:"varint,1,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`
+}
+
+func (x *DeliverPolicyStartFromTimeTick) Reset() {
+	*x = DeliverPolicyStartFromTimeTick{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverPolicyStartFromTimeTick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverPolicyStartFromTimeTick) ProtoMessage() {}
+
+func (x *DeliverPolicyStartFromTimeTick) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverPolicyStartFromTimeTick.ProtoReflect.Descriptor instead.
+func (*DeliverPolicyStartFromTimeTick) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeliverPolicyStartFromTimeTick) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+// DeliverFilter is the filter to deliver message.
+type DeliverFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Filter:
+	//
+	//	*DeliverFilter_TimeTickGt
+	//	*DeliverFilter_TimeTickGte
+	//	*DeliverFilter_MessageType
+	//	*DeliverFilter_Collection
+	Filter isDeliverFilter_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *DeliverFilter) Reset() {
+	*x = DeliverFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverFilter) ProtoMessage() {}
+
+func (x *DeliverFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverFilter.ProtoReflect.Descriptor instead.
+func (*DeliverFilter) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{103}
+}
+
+func (m *DeliverFilter) GetFilter() isDeliverFilter_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *DeliverFilter) GetTimeTickGt() *DeliverFilterTimeTickGT {
+	if x, ok := x.GetFilter().(*DeliverFilter_TimeTickGt); ok {
+		return x.TimeTickGt
+	}
+	return nil
+}
+
+func (x *DeliverFilter) GetTimeTickGte() *DeliverFilterTimeTickGTE {
+	if x, ok := x.GetFilter().(*DeliverFilter_TimeTickGte); ok {
+		return x.TimeTickGte
+	}
+	return nil
+}
+
+func (x *DeliverFilter) GetMessageType() *DeliverFilterMessageType {
+	if x, ok := x.GetFilter().(*DeliverFilter_MessageType); ok {
+		return x.MessageType
+	}
+	return nil
+}
+
+func (x *DeliverFilter) GetCollection() *DeliverFilterCollection {
+	if x, ok := x.GetFilter().(*DeliverFilter_Collection); ok {
+		return x.Collection
+	}
+	return nil
+}
+
+type isDeliverFilter_Filter interface {
+	isDeliverFilter_Filter()
+}
+
+type DeliverFilter_TimeTickGt struct {
+	TimeTickGt *DeliverFilterTimeTickGT `protobuf:"bytes,1,opt,name=time_tick_gt,json=timeTickGt,proto3,oneof"`
+}
+
+type DeliverFilter_TimeTickGte struct {
+	TimeTickGte *DeliverFilterTimeTickGTE `protobuf:"bytes,2,opt,name=time_tick_gte,json=timeTickGte,proto3,oneof"`
+}
+
+type DeliverFilter_MessageType struct {
+	MessageType *DeliverFilterMessageType `protobuf:"bytes,3,opt,name=message_type,json=messageType,proto3,oneof"`
+}
+
+type DeliverFilter_Collection struct {
+	Collection *DeliverFilterCollection `protobuf:"bytes,4,opt,name=collection,proto3,oneof"`
+}
+
+func (*DeliverFilter_TimeTickGt) isDeliverFilter_Filter() {}
+
+func (*DeliverFilter_TimeTickGte) isDeliverFilter_Filter() {}
+
+func (*DeliverFilter_MessageType) isDeliverFilter_Filter() {}
+
+func (*DeliverFilter_Collection) isDeliverFilter_Filter() {}
+
+// DeliverFilterTimeTickGT is the filter to deliver message with time tick
+// greater than this value.
+type DeliverFilterTimeTickGT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeTick uint64 `protobuf:"varint,1,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"` // deliver message with time tick greater than this value.
+}
+
+func (x *DeliverFilterTimeTickGT) Reset() {
+	*x = DeliverFilterTimeTickGT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverFilterTimeTickGT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverFilterTimeTickGT) ProtoMessage() {}
+
+func (x *DeliverFilterTimeTickGT) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverFilterTimeTickGT.ProtoReflect.Descriptor instead.
+func (*DeliverFilterTimeTickGT) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeliverFilterTimeTickGT) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+// DeliverFilterTimeTickGTE is the filter to deliver message with time tick
+// greater than or equal to this value.
+type DeliverFilterTimeTickGTE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeTick uint64 `protobuf:"varint,1,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"` // deliver message with time tick greater than or
+}
+
+func (x *DeliverFilterTimeTickGTE) Reset() {
+	*x = DeliverFilterTimeTickGTE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverFilterTimeTickGTE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverFilterTimeTickGTE) ProtoMessage() {}
+
+func (x *DeliverFilterTimeTickGTE) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverFilterTimeTickGTE.ProtoReflect.Descriptor instead.
+func (*DeliverFilterTimeTickGTE) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeliverFilterTimeTickGTE) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+type DeliverFilterMessageType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deliver message with message type.
+	MessageTypes []messagespb.MessageType `protobuf:"varint,1,rep,packed,name=message_types,json=messageTypes,proto3,enum=milvus.proto.messages.MessageType" json:"message_types,omitempty"`
+}
+
+func (x *DeliverFilterMessageType) Reset() {
+	*x = DeliverFilterMessageType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverFilterMessageType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverFilterMessageType) ProtoMessage() {}
+
+func (x *DeliverFilterMessageType) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverFilterMessageType.ProtoReflect.Descriptor instead.
+func (*DeliverFilterMessageType) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeliverFilterMessageType) GetMessageTypes() []messagespb.MessageType {
+	if x != nil {
+		return x.MessageTypes
+	}
+	return nil
+}
+
+// DeliverFilterCollection is the filter to deliver message of the given
+// collections, the message that is not belong to any vchannel is always
+// delivered.
+type DeliverFilterCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionIds []int64 `protobuf:"varint,1,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"` // deliver message of these collections.
+}
+
+func (x *DeliverFilterCollection) Reset() {
+	*x = DeliverFilterCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliverFilterCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliverFilterCollection) ProtoMessage() {}
+
+func (x *DeliverFilterCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliverFilterCollection.ProtoReflect.Descriptor instead.
+func (*DeliverFilterCollection) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeliverFilterCollection) GetCollectionIds() []int64 {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+// StreamingError is the error type for log internal component.
+type StreamingError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  StreamingCode `protobuf:"varint,1,opt,name=code,proto3,enum=milvus.proto.streaming.StreamingCode" json:"code,omitempty"`
+	Cause string        `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+}
+
+func (x *StreamingError) Reset() {
+	*x = StreamingError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingError) ProtoMessage() {}
+
+func (x *StreamingError) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingError.ProtoReflect.Descriptor instead.
+func (*StreamingError) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *StreamingError) GetCode() StreamingCode {
+	if x != nil {
+		return x.Code
+	}
+	return StreamingCode_STREAMING_CODE_OK
+}
+
+func (x *StreamingError) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+// GetReplicateCheckpointRequest is the request of GetReplicateCheckpoint service.
+type GetReplicateCheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+}
+
+func (x *GetReplicateCheckpointRequest) Reset() {
+	*x = GetReplicateCheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReplicateCheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicateCheckpointRequest) ProtoMessage() {}
+
+func (x *GetReplicateCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicateCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicateCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetReplicateCheckpointRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+// GetReplicateCheckpointResponse is the response of GetReplicateCheckpoint service.
+type GetReplicateCheckpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkpoint *commonpb.ReplicateCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (x *GetReplicateCheckpointResponse) Reset() {
+	*x = GetReplicateCheckpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReplicateCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicateCheckpointResponse) ProtoMessage() {}
+
+func (x *GetReplicateCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicateCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicateCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetReplicateCheckpointResponse) GetCheckpoint() *commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+// GetSalvageCheckpointRequest is the request of GetSalvageCheckpoint service.
+type GetSalvageCheckpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+}
+
+func (x *GetSalvageCheckpointRequest) Reset() {
+	*x = GetSalvageCheckpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSalvageCheckpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalvageCheckpointRequest) ProtoMessage() {}
+
+func (x *GetSalvageCheckpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalvageCheckpointRequest.ProtoReflect.Descriptor instead.
+func (*GetSalvageCheckpointRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetSalvageCheckpointRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+// GetSalvageCheckpointResponse is the response of GetSalvageCheckpoint service.
+type GetSalvageCheckpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkpoints []*commonpb.ReplicateCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (x *GetSalvageCheckpointResponse) Reset() {
+	*x = GetSalvageCheckpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSalvageCheckpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalvageCheckpointResponse) ProtoMessage() {}
+
+func (x *GetSalvageCheckpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalvageCheckpointResponse.ProtoReflect.Descriptor instead.
+func (*GetSalvageCheckpointResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetSalvageCheckpointResponse) GetCheckpoints() []*commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return nil
+}
+
+// TruncateWALRequest is the request of TruncateWAL service.
+type TruncateWALRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel  *PChannelInfo       `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+	MessageId *commonpb.MessageID `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // the position that the wal is truncated to.
+}
+
+func (x *TruncateWALRequest) Reset() {
+	*x = TruncateWALRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TruncateWALRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TruncateWALRequest) ProtoMessage() {}
+
+func (x *TruncateWALRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TruncateWALRequest.ProtoReflect.Descriptor instead.
+func (*TruncateWALRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *TruncateWALRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+func (x *TruncateWALRequest) GetMessageId() *commonpb.MessageID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+// TruncateWALResponse is the response of TruncateWAL service.
+type TruncateWALResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TruncateWALResponse) Reset() {
+	*x = TruncateWALResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TruncateWALResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TruncateWALResponse) ProtoMessage() {}
+
+func (x *TruncateWALResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TruncateWALResponse.ProtoReflect.Descriptor instead.
+func (*TruncateWALResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{114}
+}
+
+// ProduceRequest is the request of the Produce RPC.
+// Channel name will be passthrough in the header of stream bu not in the
+// request body.
+type ProduceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//
+	//	*ProduceRequest_Produce
+	//	*ProduceRequest_Close
+	Request isProduceRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ProduceRequest) Reset() {
+	*x = ProduceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProduceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProduceRequest) ProtoMessage() {}
+
+func (x *ProduceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProduceRequest.ProtoReflect.Descriptor instead.
+func (*ProduceRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{115}
+}
+
+func (m *ProduceRequest) GetRequest() isProduceRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *ProduceRequest) GetProduce() *ProduceMessageRequest {
+	if x, ok := x.GetRequest().(*ProduceRequest_Produce); ok {
+		return x.Produce
+	}
+	return nil
+}
+
+func (x *ProduceRequest) GetClose() *CloseProducerRequest {
+	if x, ok := x.GetRequest().(*ProduceRequest_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isProduceRequest_Request interface {
+	isProduceRequest_Request()
+}
+
+type ProduceRequest_Produce struct {
+	Produce *ProduceMessageRequest `protobuf:"bytes,1,opt,name=produce,proto3,oneof"`
+}
+
+type ProduceRequest_Close struct {
+	Close *CloseProducerRequest `protobuf:"bytes,2,opt,name=close,proto3,oneof"`
+}
+
+func (*ProduceRequest_Produce) isProduceRequest_Request() {}
+
+func (*ProduceRequest_Close) isProduceRequest_Request() {}
+
+// CreateProducerRequest is the request of the CreateProducer RPC.
+// CreateProducerRequest is passed in the header of stream.
+type CreateProducerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+}
+
+func (x *CreateProducerRequest) Reset() {
+	*x = CreateProducerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProducerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProducerRequest) ProtoMessage() {}
+
+func (x *CreateProducerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProducerRequest.ProtoReflect.Descriptor instead.
+func (*CreateProducerRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateProducerRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+// ProduceMessageRequest is the request of the Produce RPC.
+type ProduceMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId int64               `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // request id for reply.
+	Message   *messagespb.Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                       // message to be sent.
+}
+
+func (x *ProduceMessageRequest) Reset() {
+	*x = ProduceMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProduceMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProduceMessageRequest) ProtoMessage() {}
+
+func (x *ProduceMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProduceMessageRequest.ProtoReflect.Descriptor instead.
+func (*ProduceMessageRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ProduceMessageRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *ProduceMessageRequest) GetMessage() *messagespb.Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// CloseProducerRequest is the request of the CloseProducer RPC.
+// After CloseProducerRequest is requested, no more ProduceRequest can be sent.
+type CloseProducerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseProducerRequest) Reset() {
+	*x = CloseProducerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseProducerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseProducerRequest) ProtoMessage() {}
+
+func (x *CloseProducerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseProducerRequest.ProtoReflect.Descriptor instead.
+func (*CloseProducerRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{118}
+}
+
+// ProduceResponse is the response of the Produce RPC.
+type ProduceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ProduceResponse_Create
+	//	*ProduceResponse_Produce
+	//	*ProduceResponse_Close
+	//	*ProduceResponse_RateLimit
+	Response isProduceResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ProduceResponse) Reset() {
+	*x = ProduceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProduceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProduceResponse) ProtoMessage() {}
+
+func (x *ProduceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProduceResponse.ProtoReflect.Descriptor instead.
+func (*ProduceResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{119}
+}
+
+func (m *ProduceResponse) GetResponse() isProduceResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ProduceResponse) GetCreate() *CreateProducerResponse {
+	if x, ok := x.GetResponse().(*ProduceResponse_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *ProduceResponse) GetProduce() *ProduceMessageResponse {
+	if x, ok := x.GetResponse().(*ProduceResponse_Produce); ok {
+		return x.Produce
+	}
+	return nil
+}
+
+func (x *ProduceResponse) GetClose() *CloseProducerResponse {
+	if x, ok := x.GetResponse().(*ProduceResponse_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+func (x *ProduceResponse) GetRateLimit() *ProduceRateLimitResponse {
+	if x, ok := x.GetResponse().(*ProduceResponse_RateLimit); ok {
+		return x.RateLimit
+	}
+	return nil
+}
+
+type isProduceResponse_Response interface {
+	isProduceResponse_Response()
+}
+
+type ProduceResponse_Create struct {
+	Create *CreateProducerResponse `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type ProduceResponse_Produce struct {
+	Produce *ProduceMessageResponse `protobuf:"bytes,2,opt,name=produce,proto3,oneof"`
+}
+
+type ProduceResponse_Close struct {
+	Close *CloseProducerResponse `protobuf:"bytes,3,opt,name=close,proto3,oneof"`
+}
+
+type ProduceResponse_RateLimit struct {
+	RateLimit *ProduceRateLimitResponse `protobuf:"bytes,4,opt,name=rate_limit,json=rateLimit,proto3,oneof"`
+}
+
+func (*ProduceResponse_Create) isProduceResponse_Response() {}
+
+func (*ProduceResponse_Produce) isProduceResponse_Response() {}
+
+func (*ProduceResponse_Close) isProduceResponse_Response() {}
+
+func (*ProduceResponse_RateLimit) isProduceResponse_Response() {}
+
+// CreateProducerResponse is the result of the CreateProducer RPC.
+type CreateProducerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Marked as deprecated in streaming.proto.
+	WalName          string `protobuf:"bytes,1,opt,name=wal_name,json=walName,proto3" json:"wal_name,omitempty"`                               // wal name at server side.
+	ProducerServerId int64  `protobuf:"varint,2,opt,name=producer_server_id,json=producerServerId,proto3" json:"producer_server_id,omitempty"` // A unique producer server id on streamingnode
+}
+
+func (x *CreateProducerResponse) Reset() {
+	*x = CreateProducerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProducerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProducerResponse) ProtoMessage() {}
+
+func (x *CreateProducerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProducerResponse.ProtoReflect.Descriptor instead.
+func (*CreateProducerResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{120}
+}
+
+// Deprecated: Marked as deprecated in streaming.proto.
+func (x *CreateProducerResponse) GetWalName() string {
+	if x != nil {
+		return x.WalName
+	}
+	return ""
+}
+
+func (x *CreateProducerResponse) GetProducerServerId() int64 {
+	if x != nil {
+		return x.ProducerServerId
+	}
+	return 0
+}
+
+// ProduceMessageResponse is the response of the ProduceMessage RPC.
+type ProduceMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestId int64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Response:
+	//
+	//	*ProduceMessageResponse_Result
+	//	*ProduceMessageResponse_Error
+	Response isProduceMessageResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ProduceMessageResponse) Reset() {
+	*x = ProduceMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProduceMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProduceMessageResponse) ProtoMessage() {}
+
+func (x *ProduceMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProduceMessageResponse.ProtoReflect.Descriptor instead.
+func (*ProduceMessageResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ProduceMessageResponse) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (m *ProduceMessageResponse) GetResponse() isProduceMessageResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ProduceMessageResponse) GetResult() *ProduceMessageResponseResult {
+	if x, ok := x.GetResponse().(*ProduceMessageResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ProduceMessageResponse) GetError() *StreamingError {
+	if x, ok := x.GetResponse().(*ProduceMessageResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isProduceMessageResponse_Response interface {
+	isProduceMessageResponse_Response()
+}
+
+type ProduceMessageResponse_Result struct {
+	Result *ProduceMessageResponseResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+type ProduceMessageResponse_Error struct {
+	Error *StreamingError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*ProduceMessageResponse_Result) isProduceMessageResponse_Response() {}
+
+func (*ProduceMessageResponse_Error) isProduceMessageResponse_Response() {}
+
+// ProduceRateLimitResponse is the response to ask the client to slowdown/reject/recover the produce rate.
+type ProduceRateLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State WALRateLimitState `protobuf:"varint,1,opt,name=state,proto3,enum=milvus.proto.streaming.WALRateLimitState" json:"state,omitempty"` // the state of the rate limit.
+	Rate  int64             `protobuf:"varint,2,opt,name=rate,proto3" json:"rate,omitempty"`                                                 // the rate to slowdown current produce rate, bytes/s.
+}
+
+func (x *ProduceRateLimitResponse) Reset() {
+	*x = ProduceRateLimitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProduceRateLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProduceRateLimitResponse) ProtoMessage() {}
+
+func (x *ProduceRateLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProduceRateLimitResponse.ProtoReflect.Descriptor instead.
+func (*ProduceRateLimitResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ProduceRateLimitResponse) GetState() WALRateLimitState {
+	if x != nil {
+		return x.State
+	}
+	return WALRateLimitState_WAL_RATE_LIMIT_STATE_UNKNOWN
+}
+
+func (x *ProduceRateLimitResponse) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// ProduceMessageResponseResult is the result of the produce message streaming
+// RPC.
+type ProduceMessageResponseResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              *commonpb.MessageID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                    // the offset of the message in the channel.
+	Timetick        uint64                 `protobuf:"varint,2,opt,name=timetick,proto3" json:"timetick,omitempty"`                                       // the timetick of that message sent.
+	TxnContext      *messagespb.TxnContext `protobuf:"bytes,3,opt,name=txnContext,proto3" json:"txnContext,omitempty"`                                    // the txn context of the message.
+	Extra           *anypb.Any             `protobuf:"bytes,4,opt,name=extra,proto3" json:"extra,omitempty"`                                              // the extra message.
+	LastConfirmedId *commonpb.MessageID    `protobuf:"bytes,5,opt,name=last_confirmed_id,json=lastConfirmedId,proto3" json:"last_confirmed_id,omitempty"` // the last confirmed message id.
+}
+
+func (x *ProduceMessageResponseResult) Reset() {
+	*x = ProduceMessageResponseResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProduceMessageResponseResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProduceMessageResponseResult) ProtoMessage() {}
+
+func (x *ProduceMessageResponseResult) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProduceMessageResponseResult.ProtoReflect.Descriptor instead.
+func (*ProduceMessageResponseResult) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ProduceMessageResponseResult) GetId() *commonpb.MessageID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ProduceMessageResponseResult) GetTimetick() uint64 {
+	if x != nil {
+		return x.Timetick
+	}
+	return 0
+}
+
+func (x *ProduceMessageResponseResult) GetTxnContext() *messagespb.TxnContext {
+	if x != nil {
+		return x.TxnContext
+	}
+	return nil
+}
+
+func (x *ProduceMessageResponseResult) GetExtra() *anypb.Any {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *ProduceMessageResponseResult) GetLastConfirmedId() *commonpb.MessageID {
+	if x != nil {
+		return x.LastConfirmedId
+	}
+	return nil
+}
+
+// CloseProducerResponse is the result of the CloseProducer RPC.
+type CloseProducerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseProducerResponse) Reset() {
+	*x = CloseProducerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseProducerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseProducerResponse) ProtoMessage() {}
+
+func (x *CloseProducerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseProducerResponse.ProtoReflect.Descriptor instead.
+func (*CloseProducerResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{124}
+}
+
+// ConsumeRequest is the request of the Consume RPC.
+// Add more control block in future.
+type ConsumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//
+	//	*ConsumeRequest_CreateVchannelConsumer
+	//	*ConsumeRequest_CreateVchannelConsumers
+	//	*ConsumeRequest_CloseVchannel
+	//	*ConsumeRequest_Close
+	Request isConsumeRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ConsumeRequest) Reset() {
+	*x = ConsumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeRequest) ProtoMessage() {}
+
+func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{125}
+}
+
+func (m *ConsumeRequest) GetRequest() isConsumeRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *ConsumeRequest) GetCreateVchannelConsumer() *CreateVChannelConsumerRequest {
+	if x, ok := x.GetRequest().(*ConsumeRequest_CreateVchannelConsumer); ok {
+		return x.CreateVchannelConsumer
+	}
+	return nil
+}
+
+func (x *ConsumeRequest) GetCreateVchannelConsumers() *CreateVChannelConsumersRequest {
+	if x, ok := x.GetRequest().(*ConsumeRequest_CreateVchannelConsumers); ok {
+		return x.CreateVchannelConsumers
+	}
+	return nil
+}
+
+func (x *ConsumeRequest) GetCloseVchannel() *CloseVChannelConsumerRequest {
+	if x, ok := x.GetRequest().(*ConsumeRequest_CloseVchannel); ok {
+		return x.CloseVchannel
+	}
+	return nil
+}
+
+func (x *ConsumeRequest) GetClose() *CloseConsumerRequest {
+	if x, ok := x.GetRequest().(*ConsumeRequest_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isConsumeRequest_Request interface {
+	isConsumeRequest_Request()
+}
+
+type ConsumeRequest_CreateVchannelConsumer struct {
+	CreateVchannelConsumer *CreateVChannelConsumerRequest `protobuf:"bytes,1,opt,name=create_vchannel_consumer,json=createVchannelConsumer,proto3,oneof"`
+}
+
+type ConsumeRequest_CreateVchannelConsumers struct {
+	CreateVchannelConsumers *CreateVChannelConsumersRequest `protobuf:"bytes,2,opt,name=create_vchannel_consumers,json=createVchannelConsumers,proto3,oneof"` // Create multiple vchannel consumers, used for recovery in future.
+}
+
+type ConsumeRequest_CloseVchannel struct {
+	CloseVchannel *CloseVChannelConsumerRequest `protobuf:"bytes,3,opt,name=close_vchannel,json=closeVchannel,proto3,oneof"`
+}
+
+type ConsumeRequest_Close struct {
+	Close *CloseConsumerRequest `protobuf:"bytes,4,opt,name=close,proto3,oneof"`
+}
+
+func (*ConsumeRequest_CreateVchannelConsumer) isConsumeRequest_Request() {}
+
+func (*ConsumeRequest_CreateVchannelConsumers) isConsumeRequest_Request() {}
+
+func (*ConsumeRequest_CloseVchannel) isConsumeRequest_Request() {}
+
+func (*ConsumeRequest_Close) isConsumeRequest_Request() {}
+
+// CloseConsumerRequest is the request of the CloseConsumer RPC.
+// After CloseConsumerRequest is requested, no more ConsumeRequest can be sent.
+type CloseConsumerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseConsumerRequest) Reset() {
+	*x = CloseConsumerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseConsumerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseConsumerRequest) ProtoMessage() {}
+
+func (x *CloseConsumerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseConsumerRequest.ProtoReflect.Descriptor instead.
+func (*CloseConsumerRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{126}
+}
+
+// CreateConsumerRequest is the request of the CreateConsumer RPC.
+// CreateConsumerRequest is passed in the header of stream.
+type CreateConsumerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+}
+
+func (x *CreateConsumerRequest) Reset() {
+	*x = CreateConsumerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConsumerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConsumerRequest) ProtoMessage() {}
+
+func (x *CreateConsumerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConsumerRequest.ProtoReflect.Descriptor instead.
+func (*CreateConsumerRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateConsumerRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+type CreateVChannelConsumersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateVchannels []*CreateVChannelConsumerRequest `protobuf:"bytes,1,rep,name=create_vchannels,json=createVchannels,proto3" json:"create_vchannels,omitempty"`
+}
+
+func (x *CreateVChannelConsumersRequest) Reset() {
+	*x = CreateVChannelConsumersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVChannelConsumersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVChannelConsumersRequest) ProtoMessage() {}
+
+func (x *CreateVChannelConsumersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVChannelConsumersRequest.ProtoReflect.Descriptor instead.
+func (*CreateVChannelConsumersRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CreateVChannelConsumersRequest) GetCreateVchannels() []*CreateVChannelConsumerRequest {
+	if x != nil {
+		return x.CreateVchannels
+	}
+	return nil
+}
+
+// CreateVChannelConsumerRequest is the request of the CreateVChannelConsumer
+// RPC. It's used to create a new vchannel consumer at server side.
+type CreateVChannelConsumerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vchannel               string           `protobuf:"bytes,1,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
+	DeliverPolicy          *DeliverPolicy   `protobuf:"bytes,2,opt,name=deliver_policy,json=deliverPolicy,proto3" json:"deliver_policy,omitempty"`    // deliver policy.
+	DeliverFilters         []*DeliverFilter `protobuf:"bytes,3,rep,name=deliver_filters,json=deliverFilters,proto3" json:"deliver_filters,omitempty"` // deliver filter.
+	IgnorePauseConsumption bool             `protobuf:"varint,4,opt,name=ignore_pause_consumption,json=ignorePauseConsumption,proto3" json:"ignore_pause_consumption,omitempty"`
+}
+
+func (x *CreateVChannelConsumerRequest) Reset() {
+	*x = CreateVChannelConsumerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVChannelConsumerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVChannelConsumerRequest) ProtoMessage() {}
+
+func (x *CreateVChannelConsumerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVChannelConsumerRequest.ProtoReflect.Descriptor instead.
+func (*CreateVChannelConsumerRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CreateVChannelConsumerRequest) GetVchannel() string {
+	if x != nil {
+		return x.Vchannel
+	}
+	return ""
+}
+
+func (x *CreateVChannelConsumerRequest) GetDeliverPolicy() *DeliverPolicy {
+	if x != nil {
+		return x.DeliverPolicy
+	}
+	return nil
+}
+
+func (x *CreateVChannelConsumerRequest) GetDeliverFilters() []*DeliverFilter {
+	if x != nil {
+		return x.DeliverFilters
+	}
+	return nil
+}
+
+func (x *CreateVChannelConsumerRequest) GetIgnorePauseConsumption() bool {
+	if x != nil {
+		return x.IgnorePauseConsumption
+	}
+	return false
+}
+
+// ConsumeMessageRequest is the request of the Consume RPC.
+type CreateVChannelConsumersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateVchannels []*CreateVChannelConsumerResponse `protobuf:"bytes,1,rep,name=create_vchannels,json=createVchannels,proto3" json:"create_vchannels,omitempty"`
+}
+
+func (x *CreateVChannelConsumersResponse) Reset() {
+	*x = CreateVChannelConsumersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVChannelConsumersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVChannelConsumersResponse) ProtoMessage() {}
+
+func (x *CreateVChannelConsumersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVChannelConsumersResponse.ProtoReflect.Descriptor instead.
+func (*CreateVChannelConsumersResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CreateVChannelConsumersResponse) GetCreateVchannels() []*CreateVChannelConsumerResponse {
+	if x != nil {
+		return x.CreateVchannels
+	}
+	return nil
+}
+
+// CreateVChannelConsumerResponse is the response of the CreateVChannelConsumer
+// RPC.
+type CreateVChannelConsumerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CreateVChannelConsumerResponse_ConsumerId
+	//	*CreateVChannelConsumerResponse_Error
+	Response isCreateVChannelConsumerResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *CreateVChannelConsumerResponse) Reset() {
+	*x = CreateVChannelConsumerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVChannelConsumerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVChannelConsumerResponse) ProtoMessage() {}
+
+func (x *CreateVChannelConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVChannelConsumerResponse.ProtoReflect.Descriptor instead.
+func (*CreateVChannelConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{131}
+}
+
+func (m *CreateVChannelConsumerResponse) GetResponse() isCreateVChannelConsumerResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CreateVChannelConsumerResponse) GetConsumerId() int64 {
+	if x, ok := x.GetResponse().(*CreateVChannelConsumerResponse_ConsumerId); ok {
+		return x.ConsumerId
+	}
+	return 0
+}
+
+func (x *CreateVChannelConsumerResponse) GetError() *StreamingError {
+	if x, ok := x.GetResponse().(*CreateVChannelConsumerResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateVChannelConsumerResponse_Response interface {
+	isCreateVChannelConsumerResponse_Response()
+}
+
+type CreateVChannelConsumerResponse_ConsumerId struct {
+	ConsumerId int64 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3,oneof"`
+}
+
+type CreateVChannelConsumerResponse_Error struct {
+	Error *StreamingError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateVChannelConsumerResponse_ConsumerId) isCreateVChannelConsumerResponse_Response() {}
+
+func (*CreateVChannelConsumerResponse_Error) isCreateVChannelConsumerResponse_Response() {}
+
+// CloseVChannelConsumerRequest is the request of the CloseVChannelConsumer RPC.
+type CloseVChannelConsumerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerId int64 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (x *CloseVChannelConsumerRequest) Reset() {
+	*x = CloseVChannelConsumerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseVChannelConsumerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseVChannelConsumerRequest) ProtoMessage() {}
+
+func (x *CloseVChannelConsumerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseVChannelConsumerRequest.ProtoReflect.Descriptor instead.
+func (*CloseVChannelConsumerRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *CloseVChannelConsumerRequest) GetConsumerId() int64 {
+	if x != nil {
+		return x.ConsumerId
+	}
+	return 0
+}
+
+// CloseVChannelConsumerResponse is the response of the CloseVChannelConsumer
+// RPC.
+type CloseVChannelConsumerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerId int64 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (x *CloseVChannelConsumerResponse) Reset() {
+	*x = CloseVChannelConsumerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseVChannelConsumerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseVChannelConsumerResponse) ProtoMessage() {}
+
+func (x *CloseVChannelConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseVChannelConsumerResponse.ProtoReflect.Descriptor instead.
+func (*CloseVChannelConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *CloseVChannelConsumerResponse) GetConsumerId() int64 {
+	if x != nil {
+		return x.ConsumerId
+	}
+	return 0
+}
+
+// ConsumeResponse is the reponse of the Consume RPC.
+type ConsumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ConsumeResponse_Create
+	//	*ConsumeResponse_Consume
+	//	*ConsumeResponse_CreateVchannel
+	//	*ConsumeResponse_CreateVchannels
+	//	*ConsumeResponse_CloseVchannel
+	//	*ConsumeResponse_Close
+	Response isConsumeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ConsumeResponse) Reset() {
+	*x = ConsumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeResponse) ProtoMessage() {}
+
+func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{134}
+}
+
+func (m *ConsumeResponse) GetResponse() isConsumeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ConsumeResponse) GetCreate() *CreateConsumerResponse {
+	if x, ok := x.GetResponse().(*ConsumeResponse_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *ConsumeResponse) GetConsume() *ConsumeMessageReponse {
+	if x, ok := x.GetResponse().(*ConsumeResponse_Consume); ok {
+		return x.Consume
+	}
+	return nil
+}
+
+func (x *ConsumeResponse) GetCreateVchannel() *CreateVChannelConsumerResponse {
+	if x, ok := x.GetResponse().(*ConsumeResponse_CreateVchannel); ok {
+		return x.CreateVchannel
+	}
+	return nil
+}
+
+func (x *ConsumeResponse) GetCreateVchannels() *CreateVChannelConsumersResponse {
+	if x, ok := x.GetResponse().(*ConsumeResponse_CreateVchannels); ok {
+		return x.CreateVchannels
+	}
+	return nil
+}
+
+func (x *ConsumeResponse) GetCloseVchannel() *CloseVChannelConsumerResponse {
+	if x, ok := x.GetResponse().(*ConsumeResponse_CloseVchannel); ok {
+		return x.CloseVchannel
+	}
+	return nil
+}
+
+func (x *ConsumeResponse) GetClose() *CloseConsumerResponse {
+	if x, ok := x.GetResponse().(*ConsumeResponse_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+type isConsumeResponse_Response interface {
+	isConsumeResponse_Response()
+}
+
+type ConsumeResponse_Create struct {
+	Create *CreateConsumerResponse `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type ConsumeResponse_Consume struct {
+	Consume *ConsumeMessageReponse `protobuf:"bytes,2,opt,name=consume,proto3,oneof"`
+}
+
+type ConsumeResponse_CreateVchannel struct {
+	CreateVchannel *CreateVChannelConsumerResponse `protobuf:"bytes,3,opt,name=create_vchannel,json=createVchannel,proto3,oneof"`
+}
+
+type ConsumeResponse_CreateVchannels struct {
+	CreateVchannels *CreateVChannelConsumersResponse `protobuf:"bytes,4,opt,name=create_vchannels,json=createVchannels,proto3,oneof"`
+}
+
+type ConsumeResponse_CloseVchannel struct {
+	CloseVchannel *CloseVChannelConsumerResponse `protobuf:"bytes,5,opt,name=close_vchannel,json=closeVchannel,proto3,oneof"`
+}
+
+type ConsumeResponse_Close struct {
+	Close *CloseConsumerResponse `protobuf:"bytes,6,opt,name=close,proto3,oneof"`
+}
+
+func (*ConsumeResponse_Create) isConsumeResponse_Response() {}
+
+func (*ConsumeResponse_Consume) isConsumeResponse_Response() {}
+
+func (*ConsumeResponse_CreateVchannel) isConsumeResponse_Response() {}
+
+func (*ConsumeResponse_CreateVchannels) isConsumeResponse_Response() {}
+
+func (*ConsumeResponse_CloseVchannel) isConsumeResponse_Response() {}
+
+func (*ConsumeResponse_Close) isConsumeResponse_Response() {}
+
+type CreateConsumerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Marked as deprecated in streaming.proto.
+	WalName string `protobuf:"bytes,1,opt,name=wal_name,json=walName,proto3" json:"wal_name,omitempty"` // wal name at server side.
+	// A unique consumer id on streamingnode for this
+	// consumer in streamingnode lifetime.
+	ConsumerServerId int64 `protobuf:"varint,2,opt,name=consumer_server_id,json=consumerServerId,proto3" json:"consumer_server_id,omitempty"`
+}
+
+func (x *CreateConsumerResponse) Reset() {
+	*x = CreateConsumerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConsumerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConsumerResponse) ProtoMessage() {}
+
+func (x *CreateConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConsumerResponse.ProtoReflect.Descriptor instead.
+func (*CreateConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{135}
+}
+
+// Deprecated: Marked as deprecated in streaming.proto.
+func (x *CreateConsumerResponse) GetWalName() string {
+	if x != nil {
+		return x.WalName
+	}
+	return ""
+}
+
+func (x *CreateConsumerResponse) GetConsumerServerId() int64 {
+	if x != nil {
+		return x.ConsumerServerId
+	}
+	return 0
+}
+
+type ConsumeMessageReponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerId int64                      `protobuf:"varint,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	Message    *commonpb.ImmutableMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ConsumeMessageReponse) Reset() {
+	*x = ConsumeMessageReponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumeMessageReponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMessageReponse) ProtoMessage() {}
+
+func (x *ConsumeMessageReponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMessageReponse.ProtoReflect.Descriptor instead.
+func (*ConsumeMessageReponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ConsumeMessageReponse) GetConsumerId() int64 {
+	if x != nil {
+		return x.ConsumerId
+	}
+	return 0
+}
+
+func (x *ConsumeMessageReponse) GetMessage() *commonpb.ImmutableMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type CloseConsumerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseConsumerResponse) Reset() {
+	*x = CloseConsumerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseConsumerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseConsumerResponse) ProtoMessage() {}
+
+func (x *CloseConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseConsumerResponse.ProtoReflect.Descriptor instead.
+func (*CloseConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{137}
+}
+
+// StreamingManagerAssignRequest is the request message of Assign RPC.
+type StreamingNodeManagerAssignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+}
+
+func (x *StreamingNodeManagerAssignRequest) Reset() {
+	*x = StreamingNodeManagerAssignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerAssignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerAssignRequest) ProtoMessage() {}
+
+func (x *StreamingNodeManagerAssignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerAssignRequest.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerAssignRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *StreamingNodeManagerAssignRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+type StreamingNodeManagerAssignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamingNodeManagerAssignResponse) Reset() {
+	*x = StreamingNodeManagerAssignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerAssignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerAssignResponse) ProtoMessage() {}
+
+func (x *StreamingNodeManagerAssignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerAssignResponse.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerAssignResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{139}
+}
+
+type StreamingNodeManagerRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pchannel *PChannelInfo `protobuf:"bytes,1,opt,name=pchannel,proto3" json:"pchannel,omitempty"`
+}
+
+func (x *StreamingNodeManagerRemoveRequest) Reset() {
+	*x = StreamingNodeManagerRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerRemoveRequest) ProtoMessage() {}
+
+func (x *StreamingNodeManagerRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerRemoveRequest.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *StreamingNodeManagerRemoveRequest) GetPchannel() *PChannelInfo {
+	if x != nil {
+		return x.Pchannel
+	}
+	return nil
+}
+
+type StreamingNodeManagerRemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamingNodeManagerRemoveResponse) Reset() {
+	*x = StreamingNodeManagerRemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerRemoveResponse) ProtoMessage() {}
+
+func (x *StreamingNodeManagerRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerRemoveResponse.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{141}
+}
+
+type StreamingNodeManagerCollectStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamingNodeManagerCollectStatusRequest) Reset() {
+	*x = StreamingNodeManagerCollectStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerCollectStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerCollectStatusRequest) ProtoMessage() {}
+
+func (x *StreamingNodeManagerCollectStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerCollectStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerCollectStatusRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{142}
+}
+
+type StreamingNodeMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wals []*StreamingNodeWALMetrics `protobuf:"bytes,1,rep,name=wals,proto3" json:"wals,omitempty"`
+}
+
+func (x *StreamingNodeMetrics) Reset() {
+	*x = StreamingNodeMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeMetrics) ProtoMessage() {}
+
+func (x *StreamingNodeMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeMetrics.ProtoReflect.Descriptor instead.
+func (*StreamingNodeMetrics) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *StreamingNodeMetrics) GetWals() []*StreamingNodeWALMetrics {
+	if x != nil {
+		return x.Wals
+	}
+	return nil
+}
+
+type StreamingNodeWALMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info *PChannelInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// Types that are assignable to Metrics:
+	//
+	//	*StreamingNodeWALMetrics_Rw
+	//	*StreamingNodeWALMetrics_Ro
+	Metrics isStreamingNodeWALMetrics_Metrics `protobuf_oneof:"metrics"`
+}
+
+func (x *StreamingNodeWALMetrics) Reset() {
+	*x = StreamingNodeWALMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeWALMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeWALMetrics) ProtoMessage() {}
+
+func (x *StreamingNodeWALMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeWALMetrics.ProtoReflect.Descriptor instead.
+func (*StreamingNodeWALMetrics) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *StreamingNodeWALMetrics) GetInfo() *PChannelInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (m *StreamingNodeWALMetrics) GetMetrics() isStreamingNodeWALMetrics_Metrics {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (x *StreamingNodeWALMetrics) GetRw() *StreamingNodeRWWALMetrics {
+	if x, ok := x.GetMetrics().(*StreamingNodeWALMetrics_Rw); ok {
+		return x.Rw
+	}
+	return nil
+}
+
+func (x *StreamingNodeWALMetrics) GetRo() *StreamingNodeROWALMetrics {
+	if x, ok := x.GetMetrics().(*StreamingNodeWALMetrics_Ro); ok {
+		return x.Ro
+	}
+	return nil
+}
+
+type isStreamingNodeWALMetrics_Metrics interface {
+	isStreamingNodeWALMetrics_Metrics()
+}
+
+type StreamingNodeWALMetrics_Rw struct {
+	Rw *StreamingNodeRWWALMetrics `protobuf:"bytes,2,opt,name=rw,proto3,oneof"`
+}
+
+type StreamingNodeWALMetrics_Ro struct {
+	Ro *StreamingNodeROWALMetrics `protobuf:"bytes,3,opt,name=ro,proto3,oneof"`
+}
+
+func (*StreamingNodeWALMetrics_Rw) isStreamingNodeWALMetrics_Metrics() {}
+
+func (*StreamingNodeWALMetrics_Ro) isStreamingNodeWALMetrics_Metrics() {}
+
+type StreamingNodeRWWALMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MvccTimeTick         uint64  `protobuf:"varint,1,opt,name=mvcc_time_tick,json=mvccTimeTick,proto3" json:"mvcc_time_tick,omitempty"`                            // The mvcc time tick of the pchannel.
+	RecoveryTimeTick     uint64  `protobuf:"varint,2,opt,name=recovery_time_tick,json=recoveryTimeTick,proto3" json:"recovery_time_tick,omitempty"`                // The recovery time tick of the pchannel.
+	AppendBytesPerSecond float64 `protobuf:"fixed64,3,opt,name=append_bytes_per_second,json=appendBytesPerSecond,proto3" json:"append_bytes_per_second,omitempty"` // The average append throughput of the pchannel in recent window.
+	AppendBytesTotal     uint64  `protobuf:"varint,4,opt,name=append_bytes_total,json=appendBytesTotal,proto3" json:"append_bytes_total,omitempty"`                // The total appended bytes of the pchannel since the wal is opened.
+}
+
+func (x *StreamingNodeRWWALMetrics) Reset() {
+	*x = StreamingNodeRWWALMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeRWWALMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeRWWALMetrics) ProtoMessage() {}
+
+func (x *StreamingNodeRWWALMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeRWWALMetrics.ProtoReflect.Descriptor instead.
+func (*StreamingNodeRWWALMetrics) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *StreamingNodeRWWALMetrics) GetMvccTimeTick() uint64 {
+	if x != nil {
+		return x.MvccTimeTick
+	}
+	return 0
+}
+
+func (x *StreamingNodeRWWALMetrics) GetRecoveryTimeTick() uint64 {
+	if x != nil {
+		return x.RecoveryTimeTick
+	}
+	return 0
+}
+
+func (x *StreamingNodeRWWALMetrics) GetAppendBytesPerSecond() float64 {
+	if x != nil {
+		return x.AppendBytesPerSecond
+	}
+	return 0
+}
+
+func (x *StreamingNodeRWWALMetrics) GetAppendBytesTotal() uint64 {
+	if x != nil {
+		return x.AppendBytesTotal
+	}
+	return 0
+}
+
+type StreamingNodeROWALMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamingNodeROWALMetrics) Reset() {
+	*x = StreamingNodeROWALMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeROWALMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeROWALMetrics) ProtoMessage() {}
+
+func (x *StreamingNodeROWALMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeROWALMetrics.ProtoReflect.Descriptor instead.
+func (*StreamingNodeROWALMetrics) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{146}
+}
+
+type StreamingNodeManagerCollectStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics *StreamingNodeMetrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *StreamingNodeManagerCollectStatusResponse) Reset() {
+	*x = StreamingNodeManagerCollectStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerCollectStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerCollectStatusResponse) ProtoMessage() {}
+
+func (x *StreamingNodeManagerCollectStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerCollectStatusResponse.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerCollectStatusResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *StreamingNodeManagerCollectStatusResponse) GetMetrics() *StreamingNodeMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// StreamingNodeManagerUpdateAppendQuotasRequest is the request message of
+// UpdateAppendQuotas RPC.
+type StreamingNodeManagerUpdateAppendQuotasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The full snapshot of the append quotas, the collection that is not in
+	// the snapshot is not limited.
+	Quotas []*CollectionAppendQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasRequest) Reset() {
+	*x = StreamingNodeManagerUpdateAppendQuotasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerUpdateAppendQuotasRequest) ProtoMessage() {}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerUpdateAppendQuotasRequest.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerUpdateAppendQuotasRequest) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasRequest) GetQuotas() []*CollectionAppendQuota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+type StreamingNodeManagerUpdateAppendQuotasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasResponse) Reset() {
+	*x = StreamingNodeManagerUpdateAppendQuotasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamingNodeManagerUpdateAppendQuotasResponse) ProtoMessage() {}
+
+func (x *StreamingNodeManagerUpdateAppendQuotasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamingNodeManagerUpdateAppendQuotasResponse.ProtoReflect.Descriptor instead.
+func (*StreamingNodeManagerUpdateAppendQuotasResponse) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{149}
+}
+
+// CollectionAppendQuota is the append quota of a collection on every vchannel
+// of the collection.
+type CollectionAppendQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId      int64   `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	MaxBytesPerSecond float64 `protobuf:"fixed64,2,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"` // non-positive means unlimited.
+	MaxRowsPerSecond  float64 `protobuf:"fixed64,3,opt,name=max_rows_per_second,json=maxRowsPerSecond,proto3" json:"max_rows_per_second,omitempty"`    // non-positive means unlimited.
+	DenyWriting       bool    `protobuf:"varint,4,opt,name=deny_writing,json=denyWriting,proto3" json:"deny_writing,omitempty"`                        // all dml of the collection is rejected.
+	Reason            string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                      // the reason of denying writing.
+}
+
+func (x *CollectionAppendQuota) Reset() {
+	*x = CollectionAppendQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionAppendQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionAppendQuota) ProtoMessage() {}
+
+func (x *CollectionAppendQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionAppendQuota.ProtoReflect.Descriptor instead.
+func (*CollectionAppendQuota) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CollectionAppendQuota) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *CollectionAppendQuota) GetMaxBytesPerSecond() float64 {
+	if x != nil {
+		return x.MaxBytesPerSecond
+	}
+	return 0
+}
+
+func (x *CollectionAppendQuota) GetMaxRowsPerSecond() float64 {
+	if x != nil {
+		return x.MaxRowsPerSecond
+	}
+	return 0
+}
+
+func (x *CollectionAppendQuota) GetDenyWriting() bool {
+	if x != nil {
+		return x.DenyWriting
+	}
+	return false
+}
+
+func (x *CollectionAppendQuota) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// /
+// / VChannelMeta
+// /
+// VChannelMeta is the meta information of a vchannel.
+// We need to add vchannel meta in wal meta, so the wal can recover the information of it.
+// The vchannel meta is also used to store the vchannel operation result, such as shard-splitting.
+type VChannelMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vchannel           string                    `protobuf:"bytes,1,opt,name=vchannel,proto3" json:"vchannel,omitempty"`                                                  // vchannel name.
+	State              VChannelState             `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.streaming.VChannelState" json:"state,omitempty"`             // vchannel state.
+	CollectionInfo     *CollectionInfoOfVChannel `protobuf:"bytes,3,opt,name=collection_info,json=collectionInfo,proto3" json:"collection_info,omitempty"`                // if the channel is belong to a collection, the collection info will be setup.
+	CheckpointTimeTick uint64                    `protobuf:"varint,4,opt,name=checkpoint_time_tick,json=checkpointTimeTick,proto3" json:"checkpoint_time_tick,omitempty"` // The timetick of checkpoint, the meta already see the message at this timetick.
+}
+
+func (x *VChannelMeta) Reset() {
+	*x = VChannelMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VChannelMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VChannelMeta) ProtoMessage() {}
+
+func (x *VChannelMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VChannelMeta.ProtoReflect.Descriptor instead.
+func (*VChannelMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *VChannelMeta) GetVchannel() string {
+	if x != nil {
+		return x.Vchannel
+	}
+	return ""
+}
+
+func (x *VChannelMeta) GetState() VChannelState {
+	if x != nil {
+		return x.State
+	}
+	return VChannelState_VCHANNEL_STATE_UNKNOWN
+}
+
+func (x *VChannelMeta) GetCollectionInfo() *CollectionInfoOfVChannel {
+	if x != nil {
+		return x.CollectionInfo
+	}
+	return nil
+}
+
+func (x *VChannelMeta) GetCheckpointTimeTick() uint64 {
+	if x != nil {
+		return x.CheckpointTimeTick
+	}
+	return 0
+}
+
+// CollectionInfoOfVChannel is the collection info in vchannel.
+type CollectionInfoOfVChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64                         `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // collection id.
+	Partitions   []*PartitionInfoOfVChannel    `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`                          // partitions.
+	Schemas      []*CollectionSchemaOfVChannel `protobuf:"bytes,3,rep,name=schemas,proto3" json:"schemas,omitempty"`                                // The schemas of the vchannel.
+}
+
+func (x *CollectionInfoOfVChannel) Reset() {
+	*x = CollectionInfoOfVChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionInfoOfVChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionInfoOfVChannel) ProtoMessage() {}
+
+func (x *CollectionInfoOfVChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionInfoOfVChannel.ProtoReflect.Descriptor instead.
+func (*CollectionInfoOfVChannel) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CollectionInfoOfVChannel) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *CollectionInfoOfVChannel) GetPartitions() []*PartitionInfoOfVChannel {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *CollectionInfoOfVChannel) GetSchemas() []*CollectionSchemaOfVChannel {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+// CollectionSchemaOfVChannel is the collection schema in vchannel.
+type CollectionSchemaOfVChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema             *schemapb.CollectionSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`                                                      // The schemas of the vchannel.
+	CheckpointTimeTick uint64                     `protobuf:"varint,2,opt,name=checkpoint_time_tick,json=checkpointTimeTick,proto3" json:"checkpoint_time_tick,omitempty"` // The timetick of the schema changed, also the version of the schema.
+	State              VChannelSchemaState        `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.streaming.VChannelSchemaState" json:"state,omitempty"`       // The state of the schema.
+}
+
+func (x *CollectionSchemaOfVChannel) Reset() {
+	*x = CollectionSchemaOfVChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionSchemaOfVChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSchemaOfVChannel) ProtoMessage() {}
+
+func (x *CollectionSchemaOfVChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSchemaOfVChannel.ProtoReflect.Descriptor instead.
+func (*CollectionSchemaOfVChannel) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CollectionSchemaOfVChannel) GetSchema() *schemapb.CollectionSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *CollectionSchemaOfVChannel) GetCheckpointTimeTick() uint64 {
+	if x != nil {
+		return x.CheckpointTimeTick
+	}
+	return 0
+}
+
+func (x *CollectionSchemaOfVChannel) GetState() VChannelSchemaState {
+	if x != nil {
+		return x.State
+	}
+	return VChannelSchemaState_VCHANNEL_SCHEMA_STATE_UNKNOWN
+}
+
+// PartitionInfoOfVChannel is the partition info in vchannel.
+type PartitionInfoOfVChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartitionId int64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"` // partition id.
+}
+
+func (x *PartitionInfoOfVChannel) Reset() {
+	*x = PartitionInfoOfVChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionInfoOfVChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionInfoOfVChannel) ProtoMessage() {}
+
+func (x *PartitionInfoOfVChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionInfoOfVChannel.ProtoReflect.Descriptor instead.
+func (*PartitionInfoOfVChannel) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *PartitionInfoOfVChannel) GetPartitionId() int64 {
+	if x != nil {
+		return x.PartitionId
+	}
+	return 0
+}
+
+// /
+// / SegmentAssignment
+// /
+// SegmentAssignmentMeta is the stat of segment assignment.
+// These meta is only used to recover status at streaming node segment
+// assignment, don't use it outside.
+// Used to storage the segment assignment stat
+// at meta-store. The WAL use it to determine when to make the segment sealed.
+type SegmentAssignmentMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId       int64                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionId        int64                  `protobuf:"varint,2,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	SegmentId          int64                  `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Vchannel           string                 `protobuf:"bytes,4,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
+	State              SegmentAssignmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.streaming.SegmentAssignmentState" json:"state,omitempty"`
+	Stat               *SegmentAssignmentStat `protobuf:"bytes,6,opt,name=stat,proto3" json:"stat,omitempty"`
+	StorageVersion     int64                  `protobuf:"varint,7,opt,name=storage_version,json=storageVersion,proto3" json:"storage_version,omitempty"`               // only available if level is L1 or Legacy.
+	CheckpointTimeTick uint64                 `protobuf:"varint,8,opt,name=checkpoint_time_tick,json=checkpointTimeTick,proto3" json:"checkpoint_time_tick,omitempty"` // The timetick of checkpoint, the meta already see the message at this timetick.
+}
+
+func (x *SegmentAssignmentMeta) Reset() {
+	*x = SegmentAssignmentMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentAssignmentMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentAssignmentMeta) ProtoMessage() {}
+
+func (x *SegmentAssignmentMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentAssignmentMeta.ProtoReflect.Descriptor instead.
+func (*SegmentAssignmentMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SegmentAssignmentMeta) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentMeta) GetPartitionId() int64 {
+	if x != nil {
+		return x.PartitionId
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentMeta) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentMeta) GetVchannel() string {
+	if x != nil {
+		return x.Vchannel
+	}
+	return ""
+}
+
+func (x *SegmentAssignmentMeta) GetState() SegmentAssignmentState {
+	if x != nil {
+		return x.State
+	}
+	return SegmentAssignmentState_SEGMENT_ASSIGNMENT_STATE_UNKNOWN
+}
+
+func (x *SegmentAssignmentMeta) GetStat() *SegmentAssignmentStat {
+	if x != nil {
+		return x.Stat
+	}
+	return nil
+}
+
+func (x *SegmentAssignmentMeta) GetStorageVersion() int64 {
+	if x != nil {
+		return x.StorageVersion
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentMeta) GetCheckpointTimeTick() uint64 {
+	if x != nil {
+		return x.CheckpointTimeTick
+	}
+	return 0
+}
+
+// SegmentAssignmentStat is the stat of segment assignment.
+type SegmentAssignmentStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxBinarySize         uint64              `protobuf:"varint,1,opt,name=max_binary_size,json=maxBinarySize,proto3" json:"max_binary_size,omitempty"`
+	ModifiedRows          uint64              `protobuf:"varint,2,opt,name=modified_rows,json=modifiedRows,proto3" json:"modified_rows,omitempty"`
+	ModifiedBinarySize    uint64              `protobuf:"varint,3,opt,name=modified_binary_size,json=modifiedBinarySize,proto3" json:"modified_binary_size,omitempty"`
+	CreateTimestamp       int64               `protobuf:"varint,4,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+	LastModifiedTimestamp int64               `protobuf:"varint,5,opt,name=last_modified_timestamp,json=lastModifiedTimestamp,proto3" json:"last_modified_timestamp,omitempty"`
+	BinlogCounter         uint64              `protobuf:"varint,6,opt,name=binlog_counter,json=binlogCounter,proto3" json:"binlog_counter,omitempty"`
+	CreateSegmentTimeTick uint64              `protobuf:"varint,7,opt,name=create_segment_time_tick,json=createSegmentTimeTick,proto3" json:"create_segment_time_tick,omitempty"` // The timetick of create segment message in wal.
+	Level                 datapb.SegmentLevel `protobuf:"varint,8,opt,name=level,proto3,enum=milvus.proto.data.SegmentLevel" json:"level,omitempty"`                              // The level of the segment, only L0 or L1.
+	MaxRows               uint64              `protobuf:"varint,9,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`                                               // The max rows of the segment.
+}
+
+func (x *SegmentAssignmentStat) Reset() {
+	*x = SegmentAssignmentStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentAssignmentStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentAssignmentStat) ProtoMessage() {}
+
+func (x *SegmentAssignmentStat) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentAssignmentStat.ProtoReflect.Descriptor instead.
+func (*SegmentAssignmentStat) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SegmentAssignmentStat) GetMaxBinarySize() uint64 {
+	if x != nil {
+		return x.MaxBinarySize
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetModifiedRows() uint64 {
+	if x != nil {
+		return x.ModifiedRows
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetModifiedBinarySize() uint64 {
+	if x != nil {
+		return x.ModifiedBinarySize
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetLastModifiedTimestamp() int64 {
+	if x != nil {
+		return x.LastModifiedTimestamp
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetBinlogCounter() uint64 {
+	if x != nil {
+		return x.BinlogCounter
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetCreateSegmentTimeTick() uint64 {
+	if x != nil {
+		return x.CreateSegmentTimeTick
+	}
+	return 0
+}
+
+func (x *SegmentAssignmentStat) GetLevel() datapb.SegmentLevel {
+	if x != nil {
+		return x.Level
+	}
+	return datapb.SegmentLevel(0)
+}
+
+func (x *SegmentAssignmentStat) GetMaxRows() uint64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+// The WALCheckpoint that is used to recovery the wal scanner.
+type WALCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId *commonpb.MessageID `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // From here to recover all uncommited info.
+	// e.g., primary key index, segment assignment info, vchannel info...
+	// because current data path flush is slow, and managed by the coordinator, current current is not apply to it.
+	//
+	// because the data path flush is slow, so we add a new checkpoint here to promise fast recover the wal state from log.
+	TimeTick uint64 `protobuf:"varint,2,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"` // The timetick of checkpoint, keep consistecy with message_id.
+	// It's a hint for easier debugging.
+	RecoveryMagic int64 `protobuf:"varint,3,opt,name=recovery_magic,json=recoveryMagic,proto3" json:"recovery_magic,omitempty"` // The recovery version of the checkpoint, it's used to hint the future recovery info upgrading.
+	// The wal name of the checkpoint.
+	ReplicateConfig     *commonpb.ReplicateConfiguration `protobuf:"bytes,4,opt,name=replicate_config,json=replicateConfig,proto3" json:"replicate_config,omitempty"`             // if the wal join a replicated clusters, the replicate config is not null,
+	ReplicateCheckpoint *commonpb.ReplicateCheckpoint    `protobuf:"bytes,5,opt,name=replicate_checkpoint,json=replicateCheckpoint,proto3" json:"replicate_checkpoint,omitempty"` // if the wal is replicated from remote cluster, the checkpoint is not null,
+	// Used for Alter WAL operations to track WAL modification states
+	AlterWalState *AlterWALState `protobuf:"bytes,6,opt,name=alter_wal_state,json=alterWalState,proto3" json:"alter_wal_state,omitempty"`
+	// The wal is closed cleanly by the previous owner, all messages appended into the wal are covered by the checkpoint,
+	// so the new owner can skip the recovery from wal stream. It's cleared by the new owner once the wal is recovered.
+	CleanHandoff bool `protobuf:"varint,7,opt,name=clean_handoff,json=cleanHandoff,proto3" json:"clean_handoff,omitempty"`
+	// The users, roles and privileges are not replicated into current cluster, set by the replicate configuration.
+	RbacReplicationDisabled bool `protobuf:"varint,8,opt,name=rbac_replication_disabled,json=rbacReplicationDisabled,proto3" json:"rbac_replication_disabled,omitempty"`
+	// The topic of mq that the message id of the checkpoint belongs to, the channel name is used as the topic if empty.
+	Topic string `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *WALCheckpoint) Reset() {
+	*x = WALCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WALCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WALCheckpoint) ProtoMessage() {}
+
+func (x *WALCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WALCheckpoint.ProtoReflect.Descriptor instead.
+func (*WALCheckpoint) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *WALCheckpoint) GetMessageId() *commonpb.MessageID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *WALCheckpoint) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+func (x *WALCheckpoint) GetRecoveryMagic() int64 {
+	if x != nil {
+		return x.RecoveryMagic
+	}
+	return 0
+}
+
+func (x *WALCheckpoint) GetReplicateConfig() *commonpb.ReplicateConfiguration {
+	if x != nil {
+		return x.ReplicateConfig
+	}
+	return nil
+}
+
+func (x *WALCheckpoint) GetReplicateCheckpoint() *commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.ReplicateCheckpoint
+	}
+	return nil
+}
+
+func (x *WALCheckpoint) GetAlterWalState() *AlterWALState {
+	if x != nil {
+		return x.AlterWalState
+	}
+	return nil
+}
+
+func (x *WALCheckpoint) GetCleanHandoff() bool {
+	if x != nil {
+		return x.CleanHandoff
+	}
+	return false
+}
+
+func (x *WALCheckpoint) GetRbacReplicationDisabled() bool {
+	if x != nil {
+		return x.RbacReplicationDisabled
+	}
+	return false
+}
+
+func (x *WALCheckpoint) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+type AlterWALState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetWalName commonpb.WALName  `protobuf:"varint,1,opt,name=target_wal_name,json=targetWalName,proto3,enum=milvus.proto.common.WALName" json:"target_wal_name,omitempty"`
+	TimeTick      uint64            `protobuf:"varint,2,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`
+	Configs       map[string]string `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Stage         AlterWALStage     `protobuf:"varint,4,opt,name=stage,proto3,enum=milvus.proto.streaming.AlterWALStage" json:"stage,omitempty"`
+	TargetTopic   string            `protobuf:"bytes,5,opt,name=target_topic,json=targetTopic,proto3" json:"target_topic,omitempty"` // the topic that the wal is migrated to, the wal is kept on current topic if empty.
+}
+
+func (x *AlterWALState) Reset() {
+	*x = AlterWALState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlterWALState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlterWALState) ProtoMessage() {}
+
+func (x *AlterWALState) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlterWALState.ProtoReflect.Descriptor instead.
+func (*AlterWALState) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *AlterWALState) GetTargetWalName() commonpb.WALName {
+	if x != nil {
+		return x.TargetWalName
+	}
+	return commonpb.WALName(0)
+}
+
+func (x *AlterWALState) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+func (x *AlterWALState) GetConfigs() map[string]string {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *AlterWALState) GetStage() AlterWALStage {
+	if x != nil {
+		return x.Stage
+	}
+	return AlterWALStage_NONE
+}
+
+func (x *AlterWALState) GetTargetTopic() string {
+	if x != nil {
+		return x.TargetTopic
+	}
+	return ""
+}
+
+// ReplicateConfigurationMeta is the replicate configuration of the wal.
+type ReplicateConfigurationMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplicateConfiguration  *commonpb.ReplicateConfiguration                 `protobuf:"bytes,1,opt,name=replicate_configuration,json=replicateConfiguration,proto3" json:"replicate_configuration,omitempty"`
+	AckedResult             *AckedResult                                     `protobuf:"bytes,2,opt,name=acked_result,json=ackedResult,proto3" json:"acked_result,omitempty"`                                                                                                           // a acked helper to help managing the consuming of PutReplicateConfigMessageV2 message at coordinator.
+	ForcePromoted           bool                                             `protobuf:"varint,3,opt,name=force_promoted,json=forcePromoted,proto3" json:"force_promoted,omitempty"`                                                                                                    // marks if this was force-promoted
+	Version                 int64                                            `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                                                                                                                                     // the version of the configuration, increased by one at every accepted change.
+	RbacReplicationDisabled bool                                             `protobuf:"varint,5,opt,name=rbac_replication_disabled,json=rbacReplicationDisabled,proto3" json:"rbac_replication_disabled,omitempty"`                                                                    // the users, roles and privileges are not replicated into the secondary clusters.
+	CollectionFilters       map[string]*messagespb.ReplicateCollectionFilter `protobuf:"bytes,6,rep,name=collection_filters,json=collectionFilters,proto3" json:"collection_filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the collection filters of the target clusters, keyed by the target cluster id.
+}
+
+func (x *ReplicateConfigurationMeta) Reset() {
+	*x = ReplicateConfigurationMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateConfigurationMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateConfigurationMeta) ProtoMessage() {}
+
+func (x *ReplicateConfigurationMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateConfigurationMeta.ProtoReflect.Descriptor instead.
+func (*ReplicateConfigurationMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ReplicateConfigurationMeta) GetReplicateConfiguration() *commonpb.ReplicateConfiguration {
+	if x != nil {
+		return x.ReplicateConfiguration
+	}
+	return nil
+}
+
+func (x *ReplicateConfigurationMeta) GetAckedResult() *AckedResult {
+	if x != nil {
+		return x.AckedResult
+	}
+	return nil
+}
+
+func (x *ReplicateConfigurationMeta) GetForcePromoted() bool {
+	if x != nil {
+		return x.ForcePromoted
+	}
+	return false
+}
+
+func (x *ReplicateConfigurationMeta) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReplicateConfigurationMeta) GetRbacReplicationDisabled() bool {
+	if x != nil {
+		return x.RbacReplicationDisabled
+	}
+	return false
+}
+
+func (x *ReplicateConfigurationMeta) GetCollectionFilters() map[string]*messagespb.ReplicateCollectionFilter {
+	if x != nil {
+		return x.CollectionFilters
+	}
+	return nil
+}
+
+// ReplicateConfigurationHistoryMeta is an accepted replicate configuration with its broadcast result.
+type ReplicateConfigurationHistoryMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version                 int64                                            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ReplicateConfiguration  *commonpb.ReplicateConfiguration                 `protobuf:"bytes,2,opt,name=replicate_configuration,json=replicateConfiguration,proto3" json:"replicate_configuration,omitempty"`
+	ForcePromoted           bool                                             `protobuf:"varint,3,opt,name=force_promoted,json=forcePromoted,proto3" json:"force_promoted,omitempty"`
+	Operator                string                                           `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`                                                                                                                                    // the user who requested the change, empty if unknown.
+	BroadcastId             uint64                                           `protobuf:"varint,5,opt,name=broadcast_id,json=broadcastId,proto3" json:"broadcast_id,omitempty"`                                                                                                          // the broadcast id of the AlterReplicateConfig message.
+	AppendResults           []*ReplicateConfigurationAppendResult            `protobuf:"bytes,6,rep,name=append_results,json=appendResults,proto3" json:"append_results,omitempty"`                                                                                                     // ordered by the pchannel name.
+	AppliedTimestampSeconds int64                                            `protobuf:"varint,7,opt,name=applied_timestamp_seconds,json=appliedTimestampSeconds,proto3" json:"applied_timestamp_seconds,omitempty"`                                                                    // the unix timestamp in seconds when the configuration is applied.
+	RbacReplicationDisabled bool                                             `protobuf:"varint,8,opt,name=rbac_replication_disabled,json=rbacReplicationDisabled,proto3" json:"rbac_replication_disabled,omitempty"`                                                                    // the users, roles and privileges are not replicated into the secondary clusters.
+	CollectionFilters       map[string]*messagespb.ReplicateCollectionFilter `protobuf:"bytes,9,rep,name=collection_filters,json=collectionFilters,proto3" json:"collection_filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the collection filters of the target clusters, keyed by the target cluster id.
+}
+
+func (x *ReplicateConfigurationHistoryMeta) Reset() {
+	*x = ReplicateConfigurationHistoryMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateConfigurationHistoryMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateConfigurationHistoryMeta) ProtoMessage() {}
+
+func (x *ReplicateConfigurationHistoryMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateConfigurationHistoryMeta.ProtoReflect.Descriptor instead.
+func (*ReplicateConfigurationHistoryMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetReplicateConfiguration() *commonpb.ReplicateConfiguration {
+	if x != nil {
+		return x.ReplicateConfiguration
+	}
+	return nil
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetForcePromoted() bool {
+	if x != nil {
+		return x.ForcePromoted
+	}
+	return false
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetBroadcastId() uint64 {
+	if x != nil {
+		return x.BroadcastId
+	}
+	return 0
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetAppendResults() []*ReplicateConfigurationAppendResult {
+	if x != nil {
+		return x.AppendResults
+	}
+	return nil
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetAppliedTimestampSeconds() int64 {
+	if x != nil {
+		return x.AppliedTimestampSeconds
+	}
+	return 0
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetRbacReplicationDisabled() bool {
+	if x != nil {
+		return x.RbacReplicationDisabled
+	}
+	return false
+}
+
+func (x *ReplicateConfigurationHistoryMeta) GetCollectionFilters() map[string]*messagespb.ReplicateCollectionFilter {
+	if x != nil {
+		return x.CollectionFilters
+	}
+	return nil
+}
+
+// ReplicateConfigurationAppendResult is the position of the AlterReplicateConfig message at a pchannel.
+type ReplicateConfigurationAppendResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelName string              `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	MessageId   *commonpb.MessageID `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	TimeTick    uint64              `protobuf:"varint,3,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`
+}
+
+func (x *ReplicateConfigurationAppendResult) Reset() {
+	*x = ReplicateConfigurationAppendResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateConfigurationAppendResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateConfigurationAppendResult) ProtoMessage() {}
+
+func (x *ReplicateConfigurationAppendResult) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateConfigurationAppendResult.ProtoReflect.Descriptor instead.
+func (*ReplicateConfigurationAppendResult) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ReplicateConfigurationAppendResult) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *ReplicateConfigurationAppendResult) GetMessageId() *commonpb.MessageID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *ReplicateConfigurationAppendResult) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+type ReplicatePChannelMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChannelName          string                        `protobuf:"bytes,1,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"`
+	TargetChannelName          string                        `protobuf:"bytes,2,opt,name=target_channel_name,json=targetChannelName,proto3" json:"target_channel_name,omitempty"`
+	TargetCluster              *commonpb.MilvusCluster       `protobuf:"bytes,3,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	InitializedCheckpoint      *commonpb.ReplicateCheckpoint `protobuf:"bytes,4,opt,name=initialized_checkpoint,json=initializedCheckpoint,proto3" json:"initialized_checkpoint,omitempty"`
+	SkipGetReplicateCheckpoint bool                          `protobuf:"varint,5,opt,name=skip_get_replicate_checkpoint,json=skipGetReplicateCheckpoint,proto3" json:"skip_get_replicate_checkpoint,omitempty"`
+	State                      ReplicatePChannelTaskState    `protobuf:"varint,6,opt,name=state,proto3,enum=milvus.proto.streaming.ReplicatePChannelTaskState" json:"state,omitempty"` // the cdc doesn't replicate the task if it's paused.
+	// the start position of the replication reset by the operator, which has the highest priority.
+	CheckpointResetPolicy ReplicateCheckpointResetPolicy `protobuf:"varint,7,opt,name=checkpoint_reset_policy,json=checkpointResetPolicy,proto3,enum=milvus.proto.streaming.ReplicateCheckpointResetPolicy" json:"checkpoint_reset_policy,omitempty"`
+	// the databases and collections replicated into the target cluster, all of them are replicated if not set.
+	CollectionFilter *messagespb.ReplicateCollectionFilter `protobuf:"bytes,8,opt,name=collection_filter,json=collectionFilter,proto3" json:"collection_filter,omitempty"`
+}
+
+func (x *ReplicatePChannelMeta) Reset() {
+	*x = ReplicatePChannelMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicatePChannelMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicatePChannelMeta) ProtoMessage() {}
+
+func (x *ReplicatePChannelMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicatePChannelMeta.ProtoReflect.Descriptor instead.
+func (*ReplicatePChannelMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ReplicatePChannelMeta) GetSourceChannelName() string {
+	if x != nil {
+		return x.SourceChannelName
+	}
+	return ""
+}
+
+func (x *ReplicatePChannelMeta) GetTargetChannelName() string {
+	if x != nil {
+		return x.TargetChannelName
+	}
+	return ""
+}
+
+func (x *ReplicatePChannelMeta) GetTargetCluster() *commonpb.MilvusCluster {
+	if x != nil {
+		return x.TargetCluster
+	}
+	return nil
+}
+
+func (x *ReplicatePChannelMeta) GetInitializedCheckpoint() *commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.InitializedCheckpoint
+	}
+	return nil
+}
+
+func (x *ReplicatePChannelMeta) GetSkipGetReplicateCheckpoint() bool {
+	if x != nil {
+		return x.SkipGetReplicateCheckpoint
+	}
+	return false
+}
+
+func (x *ReplicatePChannelMeta) GetState() ReplicatePChannelTaskState {
+	if x != nil {
+		return x.State
+	}
+	return ReplicatePChannelTaskState_REPLICATE_PCHANNEL_TASK_STATE_RUNNING
+}
+
+func (x *ReplicatePChannelMeta) GetCheckpointResetPolicy() ReplicateCheckpointResetPolicy {
+	if x != nil {
+		return x.CheckpointResetPolicy
+	}
+	return ReplicateCheckpointResetPolicy_REPLICATE_CHECKPOINT_RESET_POLICY_NONE
+}
+
+func (x *ReplicatePChannelMeta) GetCollectionFilter() *messagespb.ReplicateCollectionFilter {
+	if x != nil {
+		return x.CollectionFilter
+	}
+	return nil
+}
+
+// ReplicateDeadLetterMeta is the message of a replicating task rejected by the target cluster for too many times.
+// It's saved by the cdc when the task is parked, and the task is resumed after it's removed by the requeue.
+type ReplicateDeadLetterMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChannelName       string              `protobuf:"bytes,1,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"`
+	TargetChannelName       string              `protobuf:"bytes,2,opt,name=target_channel_name,json=targetChannelName,proto3" json:"target_channel_name,omitempty"`
+	TargetClusterId         string              `protobuf:"bytes,3,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	MessageId               *commonpb.MessageID `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // the id of the rejected message at the source pchannel.
+	TimeTick                uint64              `protobuf:"varint,5,opt,name=time_tick,json=timeTick,proto3" json:"time_tick,omitempty"`   // the time tick of the rejected message.
+	MessageType             string              `protobuf:"bytes,6,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	Error                   string              `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                                                                       // the last error returned by the target cluster.
+	Retries                 int64               `protobuf:"varint,8,opt,name=retries,proto3" json:"retries,omitempty"`                                                                  // the number of the rejections before the task is parked.
+	CreatedTimestampSeconds int64               `protobuf:"varint,9,opt,name=created_timestamp_seconds,json=createdTimestampSeconds,proto3" json:"created_timestamp_seconds,omitempty"` // the unix timestamp in seconds when the task is parked.
+}
+
+func (x *ReplicateDeadLetterMeta) Reset() {
+	*x = ReplicateDeadLetterMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateDeadLetterMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateDeadLetterMeta) ProtoMessage() {}
+
+func (x *ReplicateDeadLetterMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateDeadLetterMeta.ProtoReflect.Descriptor instead.
+func (*ReplicateDeadLetterMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ReplicateDeadLetterMeta) GetSourceChannelName() string {
+	if x != nil {
+		return x.SourceChannelName
+	}
+	return ""
+}
+
+func (x *ReplicateDeadLetterMeta) GetTargetChannelName() string {
+	if x != nil {
+		return x.TargetChannelName
+	}
+	return ""
+}
+
+func (x *ReplicateDeadLetterMeta) GetTargetClusterId() string {
+	if x != nil {
+		return x.TargetClusterId
+	}
+	return ""
+}
+
+func (x *ReplicateDeadLetterMeta) GetMessageId() *commonpb.MessageID {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *ReplicateDeadLetterMeta) GetTimeTick() uint64 {
+	if x != nil {
+		return x.TimeTick
+	}
+	return 0
+}
+
+func (x *ReplicateDeadLetterMeta) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
+func (x *ReplicateDeadLetterMeta) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReplicateDeadLetterMeta) GetRetries() int64 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *ReplicateDeadLetterMeta) GetCreatedTimestampSeconds() int64 {
+	if x != nil {
+		return x.CreatedTimestampSeconds
+	}
+	return 0
+}
+
+// ReplicateFilteredCollectionsMeta is the collections filtered out by the collection filter of a replicating task.
+// The collection is decided to be filtered when its creation is replicated, so the decision is kept after the CDC restarts.
+type ReplicateFilteredCollectionsMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceChannelName string                         `protobuf:"bytes,1,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"`
+	TargetClusterId   string                         `protobuf:"bytes,2,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	Collections       []*ReplicateFilteredCollection `protobuf:"bytes,3,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *ReplicateFilteredCollectionsMeta) Reset() {
+	*x = ReplicateFilteredCollectionsMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateFilteredCollectionsMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateFilteredCollectionsMeta) ProtoMessage() {}
+
+func (x *ReplicateFilteredCollectionsMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateFilteredCollectionsMeta.ProtoReflect.Descriptor instead.
+func (*ReplicateFilteredCollectionsMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ReplicateFilteredCollectionsMeta) GetSourceChannelName() string {
+	if x != nil {
+		return x.SourceChannelName
+	}
+	return ""
+}
+
+func (x *ReplicateFilteredCollectionsMeta) GetTargetClusterId() string {
+	if x != nil {
+		return x.TargetClusterId
+	}
+	return ""
+}
+
+func (x *ReplicateFilteredCollectionsMeta) GetCollections() []*ReplicateFilteredCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+// ReplicateFilteredCollection is a collection filtered out by the collection filter of a replicating task.
+type ReplicateFilteredCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64    `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Vchannels    []string `protobuf:"bytes,2,rep,name=vchannels,proto3" json:"vchannels,omitempty"` // the vchannels of the collection on the source pchannel.
+}
+
+func (x *ReplicateFilteredCollection) Reset() {
+	*x = ReplicateFilteredCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicateFilteredCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateFilteredCollection) ProtoMessage() {}
+
+func (x *ReplicateFilteredCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateFilteredCollection.ProtoReflect.Descriptor instead.
+func (*ReplicateFilteredCollection) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ReplicateFilteredCollection) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ReplicateFilteredCollection) GetVchannels() []string {
+	if x != nil {
+		return x.Vchannels
+	}
+	return nil
+}
+
+// ReplicatePChannelArchiveMeta is the archive of a replicating task,
+// which is created when the replication edge of the task is removed from the replicate configuration.
+type ReplicatePChannelArchiveMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *ReplicatePChannelMeta `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// the checkpoint of the AlterReplicateConfig message that removes the replication edge at the source pchannel,
+	// the replication of the task is finished at this checkpoint.
+	FinalCheckpoint         *commonpb.ReplicateCheckpoint `protobuf:"bytes,2,opt,name=final_checkpoint,json=finalCheckpoint,proto3" json:"final_checkpoint,omitempty"`
+	RemovedTimestampSeconds int64                         `protobuf:"varint,3,opt,name=removed_timestamp_seconds,json=removedTimestampSeconds,proto3" json:"removed_timestamp_seconds,omitempty"` // the unix timestamp in seconds when the replication edge is removed.
+	TaskRemoved             bool                          `protobuf:"varint,4,opt,name=task_removed,json=taskRemoved,proto3" json:"task_removed,omitempty"`                                       // true if the replicating task is removed from the catalog by the garbage collector.
+}
+
+func (x *ReplicatePChannelArchiveMeta) Reset() {
+	*x = ReplicatePChannelArchiveMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streaming_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicatePChannelArchiveMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicatePChannelArchiveMeta) ProtoMessage() {}
+
+func (x *ReplicatePChannelArchiveMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_streaming_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicatePChannelArchiveMeta.ProtoReflect.Descriptor instead.
+func (*ReplicatePChannelArchiveMeta) Descriptor() ([]byte, []int) {
+	return file_streaming_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ReplicatePChannelArchiveMeta) GetTask() *ReplicatePChannelMeta {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *ReplicatePChannelArchiveMeta) GetFinalCheckpoint() *commonpb.ReplicateCheckpoint {
+	if x != nil {
+		return x.FinalCheckpoint
+	}
+	return nil
+}
+
+func (x *ReplicatePChannelArchiveMeta) GetRemovedTimestampSeconds() int64 {
+	if x != nil {
+		return x.RemovedTimestampSeconds
+	}
+	return 0
+}
+
+func (x *ReplicatePChannelArchiveMeta) GetTaskRemoved() bool {
+	if x != nil {
+		return x.TaskRemoved
+	}
+	return false
+}
+
+var File_streaming_proto protoreflect.FileDescriptor
+
+var file_streaming_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x1a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x5f,
 	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6d, 0x69, 0x6c, 0x76, 0x75,
@@ -5558,7 +11120,7 @@ var file_streaming_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
 	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x83, 0x01, 0x0a, 0x0c, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x99, 0x01, 0x0a, 0x0c, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
 	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
@@ -5566,628 +11128,1426 @@ var file_streaming_proto_rawDesc = []byte{
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
 	0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x15, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
-	0x65, 0x72, 0x6d, 0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6e, 0x6f,
-	0x64, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xf5, 0x01, 0x0a, 0x15,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xbf, 0x04, 0x0a, 0x0c, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x41, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x5e,
+	0x0a, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x12, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x55,
+	0x0a, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x52, 0x0a, 0x12, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x42, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x46, 0x0a, 0x0b, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x10,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x22, 0x51, 0x0a, 0x1d, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69,
+	0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x49, 0x44, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61,
+	0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x3b, 0x0a, 0x12, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x25,
+	0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x75, 0x0a, 0x0c, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x49, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x73, 0x22, 0x99, 0x01, 0x0a,
+	0x15, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x50, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x5f, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x6f, 0x50, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x19,
+	0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x17, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x22, 0xa4, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06,
+	0x74, 0x78, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x78,
+	0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x6a, 0x0a, 0x10, 0x76, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x78, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x2e, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x49, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x41, 0x0a, 0x13, 0x56, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x38, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x15, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x13, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x12, 0x54, 0x0a, 0x11, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x54, 0x0a, 0x11, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x10, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x65,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x19, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x16, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69,
+	0x63, 0x6b, 0x22, 0x98, 0x01, 0x0a, 0x1c, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x16, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c,
+	0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76,
+	0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x5f, 0x0a,
+	0x1d, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x03, 0x74, 0x78, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x78, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x03, 0x74, 0x78, 0x6e, 0x22, 0x36,
+	0x0a, 0x1d, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x74, 0x78, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x78, 0x6e, 0x49, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x1e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x70, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a,
+	0x1f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x78, 0x6e, 0x49, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x10, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x11, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x49, 0x64, 0x1a, 0x70, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x76, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x6d,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6,
+	0x01, 0x0a, 0x15, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x47,
+	0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x13, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x54, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xd4, 0x01, 0x0a, 0x1c, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x0f, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x42, 0x0a, 0x1d, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x22, 0x9e,
+	0x01, 0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f,
+	0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x50, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22,
+	0x76, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x6a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xcf, 0x02, 0x0a, 0x11, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x53, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x1c,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x43,
+	0x0a, 0x1e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x61, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x43, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x28, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4d,
+	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0x76, 0x0a,
+	0x20, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x23, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x49, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x65, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xbf, 0x03, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x6c, 0x61, 0x67, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x67, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x58, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x5f,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x26, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x6f, 0x0a, 0x27, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x64, 0x72,
+	0x69, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73,
+	0x22, 0xe2, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72,
+	0x69, 0x66, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xcf, 0x02, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x36, 0x0a, 0x17, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x65, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x99,
+	0x01, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x89, 0x01, 0x0a, 0x28, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x43, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x69, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x14, 0x0a,
+	0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xa9, 0x06,
+	0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x55, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x63,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x42, 0x0a, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x09, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x64, 0x0a, 0x17, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x67, 0x0a, 0x14, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x61,
+	0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x12, 0x61, 0x6e, 0x74,
+	0x69, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x68, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xe1, 0x02, 0x0a, 0x1c, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x31, 0x0a,
+	0x14, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1d,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xb2, 0x02,
+	0x0a, 0x15, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x5a, 0x0a, 0x09, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x35, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x14, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x37, 0x0a, 0x19, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x1a, 0x4d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x22, 0xa6, 0x01, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x5a, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69, 0x41, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0c, 0x75,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x72, 0x6f, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x72, 0x6f, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x79, 0x0a, 0x28,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e,
+	0x74, 0x69, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69, 0x41, 0x66,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x4d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x22, 0x87, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x4b, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x47, 0x0a, 0x10, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x7d, 0x0a, 0x11, 0x44, 0x72, 0x61,
+	0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x7d, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x5f, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x50, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x55, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x22, 0x88,
+	0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a,
+	0x0c, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b, 0x75,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x72,
+	0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x72, 0x6f, 0x70, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x5d, 0x0a, 0x1b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d,
-	0x6f, 0x64, 0x65, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22,
-	0xde, 0x02, 0x0a, 0x0c, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61,
-	0x12, 0x3e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12,
-	0x3f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
+	0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x54, 0x74, 0x6c, 0x4d, 0x73, 0x22, 0x94, 0x03, 0x0a, 0x23, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x51, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3f,
+	0x0a, 0x19, 0x72, 0x62, 0x61, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x17, 0x72, 0x62, 0x61, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x61, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x72, 0x62, 0x61, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0xe5, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x59, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x6c, 0x0a, 0x0c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
 	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x4b, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
-	0x6f, 0x67, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a,
-	0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x22, 0x2a, 0x0a, 0x0c, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x2c, 0x0a, 0x10,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x0b, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x99, 0x02, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61,
-	0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x15, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x76,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x13, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x56,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x54, 0x0a,
-	0x11, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x10, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x54,
-	0x0a, 0x11, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
-	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x10, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
-	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x09, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x16, 0x6c, 0x61, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x22,
-	0x4c, 0x0a, 0x10, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfa, 0x01,
-	0x0a, 0x11, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x72, 0x6f,
-	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x1a, 0x70, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
-	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x42,
-	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x62, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x76, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
-	0x08, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x8c, 0x03, 0x0a, 0x1a, 0x52, 0x65, 0x70,
 	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
-	0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x65, 0x22, 0x26, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x1d, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d,
+	0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x61, 0x6d, 0x65, 0x5f,
+	0x61, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x16, 0x69, 0x73, 0x5f, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x69, 0x73, 0x50, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x63, 0x72, 0x65,
+	0x61, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d,
 	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x6f, 0x64,
-	0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x41, 0x0a, 0x16, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x6b, 0x0a, 0x15, 0x57, 0x41, 0x4c,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x65,
-	0x65, 0x7a, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65,
-	0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x52, 0x0a, 0x0d, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x68, 0x0a,
+	0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x25, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x86, 0x01, 0x0a, 0x26, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x73, 0x5f, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x50, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x45, 0x0a, 0x17, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x6d, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xe9, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
 	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
 	0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65,
-	0x7a, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x19, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x69, 0x63, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x68, 0x0a, 0x16,
+	0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x72, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x15, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41,
+	0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26,
+	0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x4e, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x1c, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x38, 0x0a,
+	0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
 	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f,
-	0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x9a, 0x01,
-	0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
-	0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x12, 0x38, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe4, 0x01, 0x0a,
-	0x1a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x66,
-	0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75,
-	0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x1a, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x98, 0x04, 0x0a, 0x26, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69,
+	0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x46, 0x0a, 0x08, 0x63, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x63, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x64, 0x0a, 0x17, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x55, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x12, 0x43,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x21, 0x0a, 0x1f, 0x43,
 	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x98, 0x04, 0x0a, 0x26, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x41,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x69, 0x72, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x51, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x63, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x08, 0x63, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x64, 0x0a, 0x17,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a,
+	0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x17, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c,
+	0x12, 0x30, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x11,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3d, 0x0a, 0x1e, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x22, 0xf0, 0x02, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x67, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x47, 0x54, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47,
+	0x74, 0x12, 0x56, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x67,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x54, 0x45, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x51, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x36, 0x0a,
+	0x17, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x54, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x37, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x54,
+	0x45, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x63,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x61, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x6a, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x61,
+	0x6c, 0x76, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x6a, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53,
+	0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x13, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x11, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4e,
-	0x0a, 0x12, 0x43, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x21,
-	0x0a, 0x1f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x4a, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9a, 0x01,
-	0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x41,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x44, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13,
+	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0d, 0x44,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x03,
-	0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x48, 0x00, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x48, 0x00,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44,
-	0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x08,
-	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x9d, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0c, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x67, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47,
-	0x54, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x74, 0x12,
-	0x56, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x67, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x54, 0x45, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
-	0x54, 0x69, 0x63, 0x6b, 0x47, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x12, 0x44, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48,
-	0x00, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63,
-	0x6b, 0x47, 0x54, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b,
-	0x22, 0x37, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x47, 0x54, 0x45, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x63, 0x0a, 0x18, 0x44, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d,
-	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x61,
-	0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x39, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x61, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73,
-	0x65, 0x22, 0x61, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x6a, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x22, 0x6a, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xac, 0x01,
-	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x49, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73,
-	0x65, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x70, 0x0a,
+	0x15, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x16, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcd, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x12, 0x45, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd3,
+	0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x70, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
-	0x38, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0xcd, 0x02, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x4a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c, 0x52, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
 	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x05, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f,
-	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x65, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x08, 0x77,
-	0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
-	0x01, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f,
-	0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
-	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x2e, 0x57, 0x41, 0x4c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22,
-	0xa5, 0x02, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x69,
+	0x63, 0x6b, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x78, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54,
+	0x78, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0a, 0x74, 0x78, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x12, 0x4a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
 	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x41, 0x0a, 0x0a,
-	0x74, 0x78, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x52, 0x0a, 0x74, 0x78, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
-	0x2a, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x4a, 0x0a, 0x11, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x65, 0x64, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xa9, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x18, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x16,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
-	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x5d, 0x0a, 0x0e,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x05, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73,
-	0x65, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
-	0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22,
-	0x82, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x60, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d,
-	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x4c, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c,
+	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x49, 0x64, 0x22, 0x17, 0x0a,
+	0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x18, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x74, 0x0a, 0x19,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6d, 0x69, 0x6c,
 	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x4e, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
-	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x38, 0x0a, 0x18, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x16, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x1f, 0x43,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x44, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x1d, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
-	0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x73, 0x22, 0x8f, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x1c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x1d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa2, 0x04, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x12,
-	0x61, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x12, 0x64, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x4c, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4e, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x84, 0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x76,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d,
 	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x5e, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73,
-	0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x45, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x16, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x08, 0x77, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x77, 0x61, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x79, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x1c, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x1d, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
 	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x17, 0x0a,
-	0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x21, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa2, 0x04, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x64, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x5e,
+	0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x45,
+	0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x24, 0x0a,
-	0x22, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x21, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2a, 0x0a, 0x28, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
-	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x14,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x12, 0x43, 0x0a, 0x04, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x52, 0x04, 0x77, 0x61, 0x6c, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x17, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x41, 0x4c, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x65, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x08, 0x77,
+	0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x6d, 0x6d, 0x75, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x21,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x43, 0x0a, 0x02, 0x72, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x69,
-	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x57, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00,
-	0x52, 0x02, 0x72, 0x77, 0x12, 0x43, 0x0a, 0x02, 0x72, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x4f, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x02, 0x72, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x22, 0x6f, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x57, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x76, 0x63, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74,
-	0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x76, 0x63, 0x63, 0x54,
-	0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x4f, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x22, 0x73, 0x0a, 0x29, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e,
-	0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x46, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x56, 0x43, 0x68, 0x61,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x21, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x22, 0x24, 0x0a, 0x22, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x28, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x5b, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x43, 0x0a, 0x04, 0x77, 0x61,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x57,
+	0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x04, 0x77, 0x61, 0x6c, 0x73, 0x22,
+	0xe8, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x02, 0x72, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x57, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x02, 0x72, 0x77, 0x12, 0x43, 0x0a, 0x02, 0x72, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x4f, 0x57,
+	0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x02, 0x72, 0x6f, 0x42,
+	0x09, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x19, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x57, 0x57, 0x41,
+	0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x76, 0x63, 0x63,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6d, 0x76, 0x63, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2c,
+	0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x35, 0x0a, 0x17,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x61,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x4f, 0x57, 0x41, 0x4c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x73,
+	0x0a, 0x29, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x22, 0x76, 0x0a, 0x2d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x22, 0x30, 0x0a, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01,
+	0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x2d, 0x0a,
+	0x13, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x52,
+	0x6f, 0x77, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x6e, 0x79, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x79, 0x57, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x56, 0x43, 0x68, 0x61,
 	0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x63, 0x68, 0x61,
 	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x63, 0x68, 0x61,
 	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
@@ -6285,7 +12645,7 @@ var file_streaming_proto_rawDesc = []byte{
 	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c,
 	0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73,
 	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x22,
-	0x96, 0x03, 0x0a, 0x0d, 0x57, 0x41, 0x4c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x8d, 0x04, 0x0a, 0x0d, 0x57, 0x41, 0x4c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
 	0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73,
@@ -6310,292 +12670,789 @@ var file_streaming_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x74, 0x65,
 	0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x65, 0x72,
-	0x57, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x0d, 0x41, 0x6c, 0x74,
-	0x65, 0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x57, 0x41, 0x4c, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x4c, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x57, 0x41, 0x4c,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c,
-	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x67,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xf1, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x64, 0x0a, 0x17, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x16, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x22, 0xe6, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
-	0x74, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c,
+	0x57, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x3a, 0x0a,
+	0x19, 0x72, 0x62, 0x61, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x17, 0x72, 0x62, 0x61, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22,
+	0xdc, 0x02, 0x0a, 0x0d, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x44, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x61, 0x6c, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c,
 	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x4d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0d,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x5f, 0x0a,
-	0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x2e, 0x57, 0x41, 0x4c, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x57, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x54, 0x69, 0x63, 0x6b, 0x12, 0x4c, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x6c, 0x74, 0x65, 0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72,
+	0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb9,
+	0x04, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x64, 0x0a,
+	0x17, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19,
+	0x72, 0x62, 0x61, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x72, 0x62, 0x61, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x78, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x1a, 0x76, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdd, 0x05, 0x0a, 0x21, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x17, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x61, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x62, 0x61, 0x63, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x72, 0x62, 0x61, 0x63, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x7f, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x1a, 0x76, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x22, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b,
+	0x22, 0xff, 0x04, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x67,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73,
+	0x6b, 0x69, 0x70, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x6e, 0x0a, 0x17, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x5d, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x90, 0x03, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2e,
+	0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x52, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x60, 0x0a,
+	0x1b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22,
+	0x95, 0x02, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x41, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x12, 0x53, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x15, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x41,
-	0x0a, 0x1d, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73, 0x6b, 0x69, 0x70, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2a, 0x51, 0x0a, 0x12, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x43, 0x48, 0x41, 0x4e,
-	0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x57,
-	0x52, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e,
-	0x45, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x4f, 0x4e,
-	0x4c, 0x59, 0x10, 0x01, 0x2a, 0xc5, 0x01, 0x0a, 0x11, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x43,
-	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x50,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x2a, 0x51, 0x0a, 0x12, 0x50, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x2a, 0xc5, 0x01, 0x0a, 0x11, 0x50,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x54,
+	0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45,
+	0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x49, 0x54, 0x49,
+	0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50,
 	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d,
-	0x45, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
-	0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x53, 0x53,
-	0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x43, 0x48, 0x41, 0x4e,
-	0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
-	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x2a, 0xe7, 0x01, 0x0a,
-	0x12, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
-	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
-	0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x52, 0x4f, 0x41, 0x44,
-	0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x1d, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
-	0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57,
-	0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x23, 0x0a,
-	0x1f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f,
-	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x4f, 0x4d, 0x42, 0x53,
-	0x54, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x2a, 0xa3, 0x05, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x45,
-	0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12,
-	0x24, 0x0a, 0x20, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58,
-	0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49,
+	0x54, 0x45, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a,
+	0x1f, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x04, 0x2a, 0xe7, 0x01, 0x0a, 0x12, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x52, 0x4f,
+	0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x42,
+	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x1d,
+	0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x1a,
+	0x02, 0x08, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x52, 0x4f, 0x41,
+	0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x54, 0x4f, 0x4d, 0x42, 0x53, 0x54, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x2a, 0xdb, 0x01, 0x0a,
+	0x15, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f,
+	0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x58, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22,
+	0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54,
+	0x58, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x56, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x58, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x28, 0x0a,
+	0x24, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x54, 0x58, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e,
+	0x47, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x52, 0x4f, 0x53, 0x53,
+	0x5f, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x58, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x04, 0x2a, 0xda, 0x02, 0x0a, 0x1b, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52,
+	0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x29, 0x0a,
+	0x25, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x41, 0x52, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x12, 0x30, 0x0a, 0x2c, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x55, 0x4e,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53,
+	0x54, 0x52, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52,
+	0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x06, 0x2a, 0x92, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x32, 0x0a,
+	0x2e, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d,
+	0x41, 0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4c,
+	0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x35, 0x0a, 0x31, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x45, 0x58,
+	0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x2f, 0x0a, 0x2b, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x52, 0x49,
+	0x46, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x31, 0x0a, 0x2d, 0x52, 0x45, 0x50,
+	0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x44, 0x52,
+	0x49, 0x46, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45,
+	0x4c, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x2a, 0xa3, 0x06, 0x0a,
+	0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49,
 	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
-	0x46, 0x45, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x52, 0x45,
-	0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x48,
-	0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x54, 0x52, 0x45,
-	0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x45, 0x51, 0x10, 0x04,
-	0x12, 0x29, 0x0a, 0x25, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x43, 0x48, 0x41,
-	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x53,
-	0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x47,
-	0x4e, 0x4f, 0x52, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x06, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x07, 0x12, 0x23, 0x0a, 0x1f, 0x53,
-	0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
-	0x56, 0x41, 0x49, 0x4c, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08,
-	0x12, 0x26, 0x0a, 0x22, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
-	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x09, 0x12, 0x2c, 0x0a, 0x28, 0x53, 0x54, 0x52, 0x45,
-	0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x10, 0x0a, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
-	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x43, 0x4f, 0x56,
-	0x45, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x52, 0x45,
-	0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x26,
+	0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x46, 0x45, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x26,
 	0x0a, 0x22, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
-	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x41, 0x4c, 0x4e, 0x41, 0x4d, 0x45,
-	0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0e, 0x12, 0x2a, 0x0a, 0x26, 0x53,
-	0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x43,
-	0x48, 0x45, 0x4d, 0x41, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53,
-	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0f, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x54, 0x52, 0x45, 0x41,
-	0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c,
-	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x10, 0x12,
-	0x1b, 0x0a, 0x16, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xe7, 0x07, 0x2a, 0x9a, 0x01, 0x0a,
-	0x11, 0x57, 0x41, 0x4c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c,
-	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45,
-	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x52,
-	0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54,
-	0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4c,
-	0x4f, 0x57, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x41, 0x4c, 0x5f,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x53, 0x45, 0x51, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x45, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x10,
+	0x05, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10,
+	0x07, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x49, 0x4c, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x09, 0x12, 0x2c,
+	0x0a, 0x28, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x0a, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x12, 0x24,
+	0x0a, 0x20, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x51, 0x55, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x0c, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e,
+	0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x23, 0x0a, 0x1f,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x57,
+	0x41, 0x4c, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
+	0x0e, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0f, 0x12, 0x26, 0x0a,
+	0x22, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x10, 0x12, 0x2b, 0x0a, 0x27, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x41, 0x52,
+	0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x11, 0x12, 0x2c, 0x0a, 0x28, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x12,
+	0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x50, 0x50,
+	0x45, 0x4e, 0x44, 0x10, 0x13, 0x12, 0x1b, 0x0a, 0x16, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x49,
+	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0xe7, 0x07, 0x2a, 0x9a, 0x01, 0x0a, 0x11, 0x57, 0x41, 0x4c, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x41, 0x4c, 0x5f,
 	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0x62, 0x0a, 0x0d, 0x56, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x43,
-	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e,
-	0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
-	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x7d, 0x0a,
-	0x13, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
-	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x43, 0x48, 0x41, 0x4e,
-	0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x43, 0x48,
-	0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x8a, 0x01, 0x0a,
-	0x16, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x47, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x41,
+	0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x57,
+	0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x4c, 0x4f, 0x57, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x1f,
+	0x0a, 0x1b, 0x57, 0x41, 0x4c, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x2a,
+	0x62, 0x0a, 0x0d, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e,
+	0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x43, 0x48, 0x41, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x13, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12,
+	0x21, 0x0a, 0x1d, 0x56, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x8a, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a,
 	0x20, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d,
-	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41,
 	0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x46, 0x4c, 0x55, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x3f, 0x0a, 0x0d, 0x41, 0x6c, 0x74,
-	0x65, 0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
-	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x32, 0x89, 0x01, 0x0a, 0x19, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2e,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69,
-	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69,
-	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x73, 0x22, 0x00, 0x32, 0xe8, 0x01, 0x0a, 0x1e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x09, 0x42, 0x72, 0x6f,
-	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x52, 0x4f, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x45, 0x47,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x3f, 0x0a, 0x0d, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x57, 0x41, 0x4c, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x4c,
+	0x55, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x56, 0x41,
+	0x4e, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x02,
+	0x2a, 0xdc, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45,
+	0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x30, 0x0a, 0x2c, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x45,
+	0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x2e, 0x0a, 0x2a, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x41, 0x52, 0x4c, 0x49, 0x45, 0x53, 0x54, 0x10,
+	0x02, 0x12, 0x2c, 0x0a, 0x28, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10, 0x03, 0x2a,
+	0xa2, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x25, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x50,
+	0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x2f, 0x0a, 0x2b, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x54, 0x53, 0x54, 0x52, 0x41, 0x50, 0x50, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x32, 0x89, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x00,
+	0x32, 0xfb, 0x05, 0x0a, 0x1e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6f, 0x72, 0x64, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x28, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
-	0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a,
-	0x03, 0x41, 0x63, 0x6b, 0x12, 0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x2b,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x05, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x2d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x32, 0xd2, 0x03, 0x0a, 0x1f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78,
+	0x6e, 0x12, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x89, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
+	0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8f, 0x01, 0x0a,
+	0x18, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x12, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x56, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfd,
+	0x1b, 0x0a, 0x1f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x9b, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x8c, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41,
-	0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x35,
-	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41,
-	0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88,
-	0x02, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0xa1, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x12, 0x2f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
 	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
-	0x6e, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d,
-	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0xf3, 0x03, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x12, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8c, 0x01,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x76, 0x61, 0x67,
-	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6d, 0x69,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x41, 0x4c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x81, 0x01, 0x0a,
+	0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x7d, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x80, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x69,
 	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c,
-	0x76, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x69,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x69,
 	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x07, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0xbe, 0x03, 0x0a,
-	0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01, 0x0a,
-	0x06, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x39, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x81, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x39, 0x2e, 0x6d, 0x69,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x50, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x28,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x33,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa7, 0x01, 0x0a, 0x20,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e,
+	0x74, 0x69, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x3f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69, 0x41, 0x66, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x6e, 0x74, 0x69, 0x41, 0x66, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x31, 0x2e, 0x6d, 0x69,
 	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
-	0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x95,
+	0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8f, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x37, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa7, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40,
+	0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0xa4, 0x01, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3e, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x8f, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x37, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x98, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa7, 0x01, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x3f, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x31, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa4, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d,
+	0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12,
+	0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x50, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xdd,
+	0x04, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x36, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6c, 0x76, 0x61, 0x67, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c, 0x12,
+	0x2a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x57, 0x41, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x07, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0xe6,
+	0x04, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81,
+	0x01, 0x0a, 0x06, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x39, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x39, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
 	0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
 	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a,
-	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76,
-	0x75, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x6d, 0x69, 0x6c,
+	0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0xa5, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x45, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x46, 0x2e,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d, 0x69, 0x6f, 0x2f,
+	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6610,259 +13467,507 @@ func file_streaming_proto_rawDescGZIP() []byte {
 	return file_streaming_proto_rawDescData
 }
 
-var file_streaming_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
+var file_streaming_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 176)
 var file_streaming_proto_goTypes = []interface{}{
-	(PChannelAccessMode)(0),                           // 0: milvus.proto.streaming.PChannelAccessMode
-	(PChannelMetaState)(0),                            // 1: milvus.proto.streaming.PChannelMetaState
-	(BroadcastTaskState)(0),                           // 2: milvus.proto.streaming.BroadcastTaskState
-	(StreamingCode)(0),                                // 3: milvus.proto.streaming.StreamingCode
-	(WALRateLimitState)(0),                            // 4: milvus.proto.streaming.WALRateLimitState
-	(VChannelState)(0),                                // 5: milvus.proto.streaming.VChannelState
-	(VChannelSchemaState)(0),                          // 6: milvus.proto.streaming.VChannelSchemaState
-	(SegmentAssignmentState)(0),                       // 7: milvus.proto.streaming.SegmentAssignmentState
-	(AlterWALStage)(0),                                // 8: milvus.proto.streaming.AlterWALStage
-	(*PChannelInfo)(nil),                              // 9: milvus.proto.streaming.PChannelInfo
-	(*PChannelAssignmentLog)(nil),                     // 10: milvus.proto.streaming.PChannelAssignmentLog
-	(*PChannelMeta)(nil),                              // 11: milvus.proto.streaming.PChannelMeta
-	(*CChannelMeta)(nil),                              // 12: milvus.proto.streaming.CChannelMeta
-	(*StreamingVersion)(nil),                          // 13: milvus.proto.streaming.StreamingVersion
-	(*VersionPair)(nil),                               // 14: milvus.proto.streaming.VersionPair
-	(*BroadcastTask)(nil),                             // 15: milvus.proto.streaming.BroadcastTask
-	(*AckedResult)(nil),                               // 16: milvus.proto.streaming.AckedResult
-	(*AckedCheckpoint)(nil),                           // 17: milvus.proto.streaming.AckedCheckpoint
-	(*BroadcastRequest)(nil),                          // 18: milvus.proto.streaming.BroadcastRequest
-	(*BroadcastResponse)(nil),                         // 19: milvus.proto.streaming.BroadcastResponse
-	(*BroadcastAckRequest)(nil),                       // 20: milvus.proto.streaming.BroadcastAckRequest
-	(*BroadcastAckResponse)(nil),                      // 21: milvus.proto.streaming.BroadcastAckResponse
-	(*UpdateReplicateConfigurationRequest)(nil),       // 22: milvus.proto.streaming.UpdateReplicateConfigurationRequest
-	(*UpdateReplicateConfigurationResponse)(nil),      // 23: milvus.proto.streaming.UpdateReplicateConfigurationResponse
-	(*UpdateWALBalancePolicyRequest)(nil),             // 24: milvus.proto.streaming.UpdateWALBalancePolicyRequest
-	(*WALBalancePolicyConfig)(nil),                    // 25: milvus.proto.streaming.WALBalancePolicyConfig
-	(*WALBalancePolicyNodes)(nil),                     // 26: milvus.proto.streaming.WALBalancePolicyNodes
-	(*UpdateWALBalancePolicyResponse)(nil),            // 27: milvus.proto.streaming.UpdateWALBalancePolicyResponse
-	(*AssignmentDiscoverRequest)(nil),                 // 28: milvus.proto.streaming.AssignmentDiscoverRequest
-	(*ReportAssignmentErrorRequest)(nil),              // 29: milvus.proto.streaming.ReportAssignmentErrorRequest
-	(*CloseAssignmentDiscoverRequest)(nil),            // 30: milvus.proto.streaming.CloseAssignmentDiscoverRequest
-	(*AssignmentDiscoverResponse)(nil),                // 31: milvus.proto.streaming.AssignmentDiscoverResponse
-	(*FullStreamingNodeAssignmentWithVersion)(nil),    // 32: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion
-	(*CChannelAssignment)(nil),                        // 33: milvus.proto.streaming.CChannelAssignment
-	(*CloseAssignmentDiscoverResponse)(nil),           // 34: milvus.proto.streaming.CloseAssignmentDiscoverResponse
-	(*StreamingNodeInfo)(nil),                         // 35: milvus.proto.streaming.StreamingNodeInfo
-	(*StreamingNodeAssignment)(nil),                   // 36: milvus.proto.streaming.StreamingNodeAssignment
-	(*DeliverPolicy)(nil),                             // 37: milvus.proto.streaming.DeliverPolicy
-	(*DeliverFilter)(nil),                             // 38: milvus.proto.streaming.DeliverFilter
-	(*DeliverFilterTimeTickGT)(nil),                   // 39: milvus.proto.streaming.DeliverFilterTimeTickGT
-	(*DeliverFilterTimeTickGTE)(nil),                  // 40: milvus.proto.streaming.DeliverFilterTimeTickGTE
-	(*DeliverFilterMessageType)(nil),                  // 41: milvus.proto.streaming.DeliverFilterMessageType
-	(*StreamingError)(nil),                            // 42: milvus.proto.streaming.StreamingError
-	(*GetReplicateCheckpointRequest)(nil),             // 43: milvus.proto.streaming.GetReplicateCheckpointRequest
-	(*GetReplicateCheckpointResponse)(nil),            // 44: milvus.proto.streaming.GetReplicateCheckpointResponse
-	(*GetSalvageCheckpointRequest)(nil),               // 45: milvus.proto.streaming.GetSalvageCheckpointRequest
-	(*GetSalvageCheckpointResponse)(nil),              // 46: milvus.proto.streaming.GetSalvageCheckpointResponse
-	(*ProduceRequest)(nil),                            // 47: milvus.proto.streaming.ProduceRequest
-	(*CreateProducerRequest)(nil),                     // 48: milvus.proto.streaming.CreateProducerRequest
-	(*ProduceMessageRequest)(nil),                     // 49: milvus.proto.streaming.ProduceMessageRequest
-	(*CloseProducerRequest)(nil),                      // 50: milvus.proto.streaming.CloseProducerRequest
-	(*ProduceResponse)(nil),                           // 51: milvus.proto.streaming.ProduceResponse
-	(*CreateProducerResponse)(nil),                    // 52: milvus.proto.streaming.CreateProducerResponse
-	(*ProduceMessageResponse)(nil),                    // 53: milvus.proto.streaming.ProduceMessageResponse
-	(*ProduceRateLimitResponse)(nil),                  // 54: milvus.proto.streaming.ProduceRateLimitResponse
-	(*ProduceMessageResponseResult)(nil),              // 55: milvus.proto.streaming.ProduceMessageResponseResult
-	(*CloseProducerResponse)(nil),                     // 56: milvus.proto.streaming.CloseProducerResponse
-	(*ConsumeRequest)(nil),                            // 57: milvus.proto.streaming.ConsumeRequest
-	(*CloseConsumerRequest)(nil),                      // 58: milvus.proto.streaming.CloseConsumerRequest
-	(*CreateConsumerRequest)(nil),                     // 59: milvus.proto.streaming.CreateConsumerRequest
-	(*CreateVChannelConsumersRequest)(nil),            // 60: milvus.proto.streaming.CreateVChannelConsumersRequest
-	(*CreateVChannelConsumerRequest)(nil),             // 61: milvus.proto.streaming.CreateVChannelConsumerRequest
-	(*CreateVChannelConsumersResponse)(nil),           // 62: milvus.proto.streaming.CreateVChannelConsumersResponse
-	(*CreateVChannelConsumerResponse)(nil),            // 63: milvus.proto.streaming.CreateVChannelConsumerResponse
-	(*CloseVChannelConsumerRequest)(nil),              // 64: milvus.proto.streaming.CloseVChannelConsumerRequest
-	(*CloseVChannelConsumerResponse)(nil),             // 65: milvus.proto.streaming.CloseVChannelConsumerResponse
-	(*ConsumeResponse)(nil),                           // 66: milvus.proto.streaming.ConsumeResponse
-	(*CreateConsumerResponse)(nil),                    // 67: milvus.proto.streaming.CreateConsumerResponse
-	(*ConsumeMessageReponse)(nil),                     // 68: milvus.proto.streaming.ConsumeMessageReponse
-	(*CloseConsumerResponse)(nil),                     // 69: milvus.proto.streaming.CloseConsumerResponse
-	(*StreamingNodeManagerAssignRequest)(nil),         // 70: milvus.proto.streaming.StreamingNodeManagerAssignRequest
-	(*StreamingNodeManagerAssignResponse)(nil),        // 71: milvus.proto.streaming.StreamingNodeManagerAssignResponse
-	(*StreamingNodeManagerRemoveRequest)(nil),         // 72: milvus.proto.streaming.StreamingNodeManagerRemoveRequest
-	(*StreamingNodeManagerRemoveResponse)(nil),        // 73: milvus.proto.streaming.StreamingNodeManagerRemoveResponse
-	(*StreamingNodeManagerCollectStatusRequest)(nil),  // 74: milvus.proto.streaming.StreamingNodeManagerCollectStatusRequest
-	(*StreamingNodeMetrics)(nil),                      // 75: milvus.proto.streaming.StreamingNodeMetrics
-	(*StreamingNodeWALMetrics)(nil),                   // 76: milvus.proto.streaming.StreamingNodeWALMetrics
-	(*StreamingNodeRWWALMetrics)(nil),                 // 77: milvus.proto.streaming.StreamingNodeRWWALMetrics
-	(*StreamingNodeROWALMetrics)(nil),                 // 78: milvus.proto.streaming.StreamingNodeROWALMetrics
-	(*StreamingNodeManagerCollectStatusResponse)(nil), // 79: milvus.proto.streaming.StreamingNodeManagerCollectStatusResponse
-	(*VChannelMeta)(nil),                              // 80: milvus.proto.streaming.VChannelMeta
-	(*CollectionInfoOfVChannel)(nil),                  // 81: milvus.proto.streaming.CollectionInfoOfVChannel
-	(*CollectionSchemaOfVChannel)(nil),                // 82: milvus.proto.streaming.CollectionSchemaOfVChannel
-	(*PartitionInfoOfVChannel)(nil),                   // 83: milvus.proto.streaming.PartitionInfoOfVChannel
-	(*SegmentAssignmentMeta)(nil),                     // 84: milvus.proto.streaming.SegmentAssignmentMeta
-	(*SegmentAssignmentStat)(nil),                     // 85: milvus.proto.streaming.SegmentAssignmentStat
-	(*WALCheckpoint)(nil),                             // 86: milvus.proto.streaming.WALCheckpoint
-	(*AlterWALState)(nil),                             // 87: milvus.proto.streaming.AlterWALState
-	(*ReplicateConfigurationMeta)(nil),                // 88: milvus.proto.streaming.ReplicateConfigurationMeta
-	(*ReplicatePChannelMeta)(nil),                     // 89: milvus.proto.streaming.ReplicatePChannelMeta
-	nil,                                               // 90: milvus.proto.streaming.BroadcastResponse.ResultsEntry
-	nil,                                               // 91: milvus.proto.streaming.AlterWALState.ConfigsEntry
-	(*messagespb.Message)(nil),                        // 92: milvus.proto.messages.Message
-	(*commonpb.MessageID)(nil),                        // 93: milvus.proto.common.MessageID
-	(*commonpb.ImmutableMessage)(nil),                 // 94: milvus.proto.common.ImmutableMessage
-	(*commonpb.ReplicateConfiguration)(nil),           // 95: milvus.proto.common.ReplicateConfiguration
-	(*fieldmaskpb.FieldMask)(nil),                     // 96: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                             // 97: google.protobuf.Empty
-	(messagespb.MessageType)(0),                       // 98: milvus.proto.messages.MessageType
-	(*commonpb.ReplicateCheckpoint)(nil),              // 99: milvus.proto.common.ReplicateCheckpoint
-	(*messagespb.TxnContext)(nil),                     // 100: milvus.proto.messages.TxnContext
-	(*anypb.Any)(nil),                                 // 101: google.protobuf.Any
-	(*schemapb.CollectionSchema)(nil),                 // 102: milvus.proto.schema.CollectionSchema
-	(datapb.SegmentLevel)(0),                          // 103: milvus.proto.data.SegmentLevel
-	(commonpb.WALName)(0),                             // 104: milvus.proto.common.WALName
-	(*commonpb.MilvusCluster)(nil),                    // 105: milvus.proto.common.MilvusCluster
-	(*milvuspb.GetComponentStatesRequest)(nil),        // 106: milvus.proto.milvus.GetComponentStatesRequest
-	(*milvuspb.ComponentStates)(nil),                  // 107: milvus.proto.milvus.ComponentStates
+	(PChannelAccessMode)(0),                                // 0: milvus.proto.streaming.PChannelAccessMode
+	(PChannelMetaState)(0),                                 // 1: milvus.proto.streaming.PChannelMetaState
+	(BroadcastTaskState)(0),                                // 2: milvus.proto.streaming.BroadcastTaskState
+	(CrossVChannelTxnState)(0),                             // 3: milvus.proto.streaming.CrossVChannelTxnState
+	(ReplicatingTaskRuntimeState)(0),                       // 4: milvus.proto.streaming.ReplicatingTaskRuntimeState
+	(ReplicateSchemaDriftType)(0),                          // 5: milvus.proto.streaming.ReplicateSchemaDriftType
+	(StreamingCode)(0),                                     // 6: milvus.proto.streaming.StreamingCode
+	(WALRateLimitState)(0),                                 // 7: milvus.proto.streaming.WALRateLimitState
+	(VChannelState)(0),                                     // 8: milvus.proto.streaming.VChannelState
+	(VChannelSchemaState)(0),                               // 9: milvus.proto.streaming.VChannelSchemaState
+	(SegmentAssignmentState)(0),                            // 10: milvus.proto.streaming.SegmentAssignmentState
+	(AlterWALStage)(0),                                     // 11: milvus.proto.streaming.AlterWALStage
+	(ReplicateCheckpointResetPolicy)(0),                    // 12: milvus.proto.streaming.ReplicateCheckpointResetPolicy
+	(ReplicatePChannelTaskState)(0),                        // 13: milvus.proto.streaming.ReplicatePChannelTaskState
+	(*PChannelInfo)(nil),                                   // 14: milvus.proto.streaming.PChannelInfo
+	(*PChannelAssignmentLog)(nil),                          // 15: milvus.proto.streaming.PChannelAssignmentLog
+	(*PChannelMeta)(nil),                                   // 16: milvus.proto.streaming.PChannelMeta
+	(*WALRetentionPolicy)(nil),                             // 17: milvus.proto.streaming.WALRetentionPolicy
+	(*PChannelRetentionPolicy)(nil),                        // 18: milvus.proto.streaming.PChannelRetentionPolicy
+	(*PChannelPin)(nil),                                    // 19: milvus.proto.streaming.PChannelPin
+	(*PChannelPoolMeta)(nil),                               // 20: milvus.proto.streaming.PChannelPoolMeta
+	(*PChannelAntiAffinityGroupMeta)(nil),                  // 21: milvus.proto.streaming.PChannelAntiAffinityGroupMeta
+	(*IDAllocatorMeta)(nil),                                // 22: milvus.proto.streaming.IDAllocatorMeta
+	(*BalancerConfigMeta)(nil),                             // 23: milvus.proto.streaming.BalancerConfigMeta
+	(*CChannelMeta)(nil),                                   // 24: milvus.proto.streaming.CChannelMeta
+	(*CChannelHandoffMarker)(nil),                          // 25: milvus.proto.streaming.CChannelHandoffMarker
+	(*StreamingVersion)(nil),                               // 26: milvus.proto.streaming.StreamingVersion
+	(*VersionPair)(nil),                                    // 27: milvus.proto.streaming.VersionPair
+	(*CrossVChannelTxnMeta)(nil),                           // 28: milvus.proto.streaming.CrossVChannelTxnMeta
+	(*BroadcastTask)(nil),                                  // 29: milvus.proto.streaming.BroadcastTask
+	(*AckedResult)(nil),                                    // 30: milvus.proto.streaming.AckedResult
+	(*AckedCheckpoint)(nil),                                // 31: milvus.proto.streaming.AckedCheckpoint
+	(*BeginCrossVChannelTxnRequest)(nil),                   // 32: milvus.proto.streaming.BeginCrossVChannelTxnRequest
+	(*BeginCrossVChannelTxnResponse)(nil),                  // 33: milvus.proto.streaming.BeginCrossVChannelTxnResponse
+	(*CommitCrossVChannelTxnRequest)(nil),                  // 34: milvus.proto.streaming.CommitCrossVChannelTxnRequest
+	(*CommitCrossVChannelTxnResponse)(nil),                 // 35: milvus.proto.streaming.CommitCrossVChannelTxnResponse
+	(*RollbackCrossVChannelTxnRequest)(nil),                // 36: milvus.proto.streaming.RollbackCrossVChannelTxnRequest
+	(*RollbackCrossVChannelTxnResponse)(nil),               // 37: milvus.proto.streaming.RollbackCrossVChannelTxnResponse
+	(*BroadcastRequest)(nil),                               // 38: milvus.proto.streaming.BroadcastRequest
+	(*BroadcastResponse)(nil),                              // 39: milvus.proto.streaming.BroadcastResponse
+	(*BroadcastAckRequest)(nil),                            // 40: milvus.proto.streaming.BroadcastAckRequest
+	(*BroadcastAckResponse)(nil),                           // 41: milvus.proto.streaming.BroadcastAckResponse
+	(*BroadcastWatchRequest)(nil),                          // 42: milvus.proto.streaming.BroadcastWatchRequest
+	(*BroadcastWatchResumeToken)(nil),                      // 43: milvus.proto.streaming.BroadcastWatchResumeToken
+	(*BroadcastWatchResponse)(nil),                         // 44: milvus.proto.streaming.BroadcastWatchResponse
+	(*BroadcastWatchEvent)(nil),                            // 45: milvus.proto.streaming.BroadcastWatchEvent
+	(*BroadcastWatchResync)(nil),                           // 46: milvus.proto.streaming.BroadcastWatchResync
+	(*MigratePChannelTopicsRequest)(nil),                   // 47: milvus.proto.streaming.MigratePChannelTopicsRequest
+	(*MigratePChannelTopicsResponse)(nil),                  // 48: milvus.proto.streaming.MigratePChannelTopicsResponse
+	(*UpdatePChannelRetentionPoliciesRequest)(nil),         // 49: milvus.proto.streaming.UpdatePChannelRetentionPoliciesRequest
+	(*UpdatePChannelRetentionPoliciesResponse)(nil),        // 50: milvus.proto.streaming.UpdatePChannelRetentionPoliciesResponse
+	(*GetPChannelRetentionsRequest)(nil),                   // 51: milvus.proto.streaming.GetPChannelRetentionsRequest
+	(*GetPChannelRetentionsResponse)(nil),                  // 52: milvus.proto.streaming.GetPChannelRetentionsResponse
+	(*PChannelRetention)(nil),                              // 53: milvus.proto.streaming.PChannelRetention
+	(*GetClusterChannelsRequest)(nil),                      // 54: milvus.proto.streaming.GetClusterChannelsRequest
+	(*GetClusterChannelsResponse)(nil),                     // 55: milvus.proto.streaming.GetClusterChannelsResponse
+	(*GetReplicateConfigurationHistoryRequest)(nil),        // 56: milvus.proto.streaming.GetReplicateConfigurationHistoryRequest
+	(*GetReplicateConfigurationHistoryResponse)(nil),       // 57: milvus.proto.streaming.GetReplicateConfigurationHistoryResponse
+	(*ListReplicateDeadLettersRequest)(nil),                // 58: milvus.proto.streaming.ListReplicateDeadLettersRequest
+	(*ListReplicateDeadLettersResponse)(nil),               // 59: milvus.proto.streaming.ListReplicateDeadLettersResponse
+	(*RequeueReplicateDeadLettersRequest)(nil),             // 60: milvus.proto.streaming.RequeueReplicateDeadLettersRequest
+	(*RequeueReplicateDeadLettersResponse)(nil),            // 61: milvus.proto.streaming.RequeueReplicateDeadLettersResponse
+	(*ListReplicatingTasksRequest)(nil),                    // 62: milvus.proto.streaming.ListReplicatingTasksRequest
+	(*ListReplicatingTasksResponse)(nil),                   // 63: milvus.proto.streaming.ListReplicatingTasksResponse
+	(*ReplicatingTaskProgress)(nil),                        // 64: milvus.proto.streaming.ReplicatingTaskProgress
+	(*CheckReplicateSchemaConsistencyRequest)(nil),         // 65: milvus.proto.streaming.CheckReplicateSchemaConsistencyRequest
+	(*CheckReplicateSchemaConsistencyResponse)(nil),        // 66: milvus.proto.streaming.CheckReplicateSchemaConsistencyResponse
+	(*ReplicateSchemaDrift)(nil),                           // 67: milvus.proto.streaming.ReplicateSchemaDrift
+	(*ResetReplicateCheckpointRequest)(nil),                // 68: milvus.proto.streaming.ResetReplicateCheckpointRequest
+	(*ResetReplicateCheckpointResponse)(nil),               // 69: milvus.proto.streaming.ResetReplicateCheckpointResponse
+	(*UpdateReplicateClusterConnectionRequest)(nil),        // 70: milvus.proto.streaming.UpdateReplicateClusterConnectionRequest
+	(*UpdateReplicateClusterConnectionResponse)(nil),       // 71: milvus.proto.streaming.UpdateReplicateClusterConnectionResponse
+	(*UpdateReplicatingTaskStateRequest)(nil),              // 72: milvus.proto.streaming.UpdateReplicatingTaskStateRequest
+	(*UpdateReplicatingTaskStateResponse)(nil),             // 73: milvus.proto.streaming.UpdateReplicatingTaskStateResponse
+	(*ExportStateRequest)(nil),                             // 74: milvus.proto.streaming.ExportStateRequest
+	(*ExportStateResponse)(nil),                            // 75: milvus.proto.streaming.ExportStateResponse
+	(*ChannelManagerState)(nil),                            // 76: milvus.proto.streaming.ChannelManagerState
+	(*ReplicateTargetClusterHealth)(nil),                   // 77: milvus.proto.streaming.ReplicateTargetClusterHealth
+	(*PChannelStatsSnapshot)(nil),                          // 78: milvus.proto.streaming.PChannelStatsSnapshot
+	(*MoveControlChannelRequest)(nil),                      // 79: milvus.proto.streaming.MoveControlChannelRequest
+	(*MoveControlChannelResponse)(nil),                     // 80: milvus.proto.streaming.MoveControlChannelResponse
+	(*UpdatePChannelAntiAffinityGroupsRequest)(nil),        // 81: milvus.proto.streaming.UpdatePChannelAntiAffinityGroupsRequest
+	(*UpdatePChannelAntiAffinityGroupsResponse)(nil),       // 82: milvus.proto.streaming.UpdatePChannelAntiAffinityGroupsResponse
+	(*GetAssignmentHistoryRequest)(nil),                    // 83: milvus.proto.streaming.GetAssignmentHistoryRequest
+	(*GetAssignmentHistoryResponse)(nil),                   // 84: milvus.proto.streaming.GetAssignmentHistoryResponse
+	(*DrainNodeRequest)(nil),                               // 85: milvus.proto.streaming.DrainNodeRequest
+	(*DrainNodeResponse)(nil),                              // 86: milvus.proto.streaming.DrainNodeResponse
+	(*UpdatePChannelPinsRequest)(nil),                      // 87: milvus.proto.streaming.UpdatePChannelPinsRequest
+	(*UpdatePChannelPinsResponse)(nil),                     // 88: milvus.proto.streaming.UpdatePChannelPinsResponse
+	(*UpdatePChannelPoolsRequest)(nil),                     // 89: milvus.proto.streaming.UpdatePChannelPoolsRequest
+	(*UpdatePChannelPoolsResponse)(nil),                    // 90: milvus.proto.streaming.UpdatePChannelPoolsResponse
+	(*RenewPChannelLeaseRequest)(nil),                      // 91: milvus.proto.streaming.RenewPChannelLeaseRequest
+	(*RenewPChannelLeaseResponse)(nil),                     // 92: milvus.proto.streaming.RenewPChannelLeaseResponse
+	(*UpdateReplicateConfigurationRequest)(nil),            // 93: milvus.proto.streaming.UpdateReplicateConfigurationRequest
+	(*ReplicateCollectionFilters)(nil),                     // 94: milvus.proto.streaming.ReplicateCollectionFilters
+	(*UpdateReplicateConfigurationResponse)(nil),           // 95: milvus.proto.streaming.UpdateReplicateConfigurationResponse
+	(*ReplicateConfigurationPlan)(nil),                     // 96: milvus.proto.streaming.ReplicateConfigurationPlan
+	(*ReplicationAvailabilityChange)(nil),                  // 97: milvus.proto.streaming.ReplicationAvailabilityChange
+	(*ValidateReplicateConfigurationRequest)(nil),          // 98: milvus.proto.streaming.ValidateReplicateConfigurationRequest
+	(*ValidateReplicateConfigurationResponse)(nil),         // 99: milvus.proto.streaming.ValidateReplicateConfigurationResponse
+	(*PromoteSecondaryRequest)(nil),                        // 100: milvus.proto.streaming.PromoteSecondaryRequest
+	(*PromoteSecondaryResponse)(nil),                       // 101: milvus.proto.streaming.PromoteSecondaryResponse
+	(*UpdateWALBalancePolicyRequest)(nil),                  // 102: milvus.proto.streaming.UpdateWALBalancePolicyRequest
+	(*WALBalancePolicyConfig)(nil),                         // 103: milvus.proto.streaming.WALBalancePolicyConfig
+	(*WALBalancePolicyNodes)(nil),                          // 104: milvus.proto.streaming.WALBalancePolicyNodes
+	(*UpdateWALBalancePolicyResponse)(nil),                 // 105: milvus.proto.streaming.UpdateWALBalancePolicyResponse
+	(*AssignmentDiscoverRequest)(nil),                      // 106: milvus.proto.streaming.AssignmentDiscoverRequest
+	(*ReportAssignmentErrorRequest)(nil),                   // 107: milvus.proto.streaming.ReportAssignmentErrorRequest
+	(*CloseAssignmentDiscoverRequest)(nil),                 // 108: milvus.proto.streaming.CloseAssignmentDiscoverRequest
+	(*AssignmentDiscoverResponse)(nil),                     // 109: milvus.proto.streaming.AssignmentDiscoverResponse
+	(*FullStreamingNodeAssignmentWithVersion)(nil),         // 110: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion
+	(*CChannelAssignment)(nil),                             // 111: milvus.proto.streaming.CChannelAssignment
+	(*CloseAssignmentDiscoverResponse)(nil),                // 112: milvus.proto.streaming.CloseAssignmentDiscoverResponse
+	(*StreamingNodeInfo)(nil),                              // 113: milvus.proto.streaming.StreamingNodeInfo
+	(*StreamingNodeAssignment)(nil),                        // 114: milvus.proto.streaming.StreamingNodeAssignment
+	(*DeliverPolicy)(nil),                                  // 115: milvus.proto.streaming.DeliverPolicy
+	(*DeliverPolicyStartFromTimeTick)(nil),                 // 116: milvus.proto.streaming.DeliverPolicyStartFromTimeTick
+	(*DeliverFilter)(nil),                                  // 117: milvus.proto.streaming.DeliverFilter
+	(*DeliverFilterTimeTickGT)(nil),                        // 118: milvus.proto.streaming.DeliverFilterTimeTickGT
+	(*DeliverFilterTimeTickGTE)(nil),                       // 119: milvus.proto.streaming.DeliverFilterTimeTickGTE
+	(*DeliverFilterMessageType)(nil),                       // 120: milvus.proto.streaming.DeliverFilterMessageType
+	(*DeliverFilterCollection)(nil),                        // 121: milvus.proto.streaming.DeliverFilterCollection
+	(*StreamingError)(nil),                                 // 122: milvus.proto.streaming.StreamingError
+	(*GetReplicateCheckpointRequest)(nil),                  // 123: milvus.proto.streaming.GetReplicateCheckpointRequest
+	(*GetReplicateCheckpointResponse)(nil),                 // 124: milvus.proto.streaming.GetReplicateCheckpointResponse
+	(*GetSalvageCheckpointRequest)(nil),                    // 125: milvus.proto.streaming.GetSalvageCheckpointRequest
+	(*GetSalvageCheckpointResponse)(nil),                   // 126: milvus.proto.streaming.GetSalvageCheckpointResponse
+	(*TruncateWALRequest)(nil),                             // 127: milvus.proto.streaming.TruncateWALRequest
+	(*TruncateWALResponse)(nil),                            // 128: milvus.proto.streaming.TruncateWALResponse
+	(*ProduceRequest)(nil),                                 // 129: milvus.proto.streaming.ProduceRequest
+	(*CreateProducerRequest)(nil),                          // 130: milvus.proto.streaming.CreateProducerRequest
+	(*ProduceMessageRequest)(nil),                          // 131: milvus.proto.streaming.ProduceMessageRequest
+	(*CloseProducerRequest)(nil),                           // 132: milvus.proto.streaming.CloseProducerRequest
+	(*ProduceResponse)(nil),                                // 133: milvus.proto.streaming.ProduceResponse
+	(*CreateProducerResponse)(nil),                         // 134: milvus.proto.streaming.CreateProducerResponse
+	(*ProduceMessageResponse)(nil),                         // 135: milvus.proto.streaming.ProduceMessageResponse
+	(*ProduceRateLimitResponse)(nil),                       // 136: milvus.proto.streaming.ProduceRateLimitResponse
+	(*ProduceMessageResponseResult)(nil),                   // 137: milvus.proto.streaming.ProduceMessageResponseResult
+	(*CloseProducerResponse)(nil),                          // 138: milvus.proto.streaming.CloseProducerResponse
+	(*ConsumeRequest)(nil),                                 // 139: milvus.proto.streaming.ConsumeRequest
+	(*CloseConsumerRequest)(nil),                           // 140: milvus.proto.streaming.CloseConsumerRequest
+	(*CreateConsumerRequest)(nil),                          // 141: milvus.proto.streaming.CreateConsumerRequest
+	(*CreateVChannelConsumersRequest)(nil),                 // 142: milvus.proto.streaming.CreateVChannelConsumersRequest
+	(*CreateVChannelConsumerRequest)(nil),                  // 143: milvus.proto.streaming.CreateVChannelConsumerRequest
+	(*CreateVChannelConsumersResponse)(nil),                // 144: milvus.proto.streaming.CreateVChannelConsumersResponse
+	(*CreateVChannelConsumerResponse)(nil),                 // 145: milvus.proto.streaming.CreateVChannelConsumerResponse
+	(*CloseVChannelConsumerRequest)(nil),                   // 146: milvus.proto.streaming.CloseVChannelConsumerRequest
+	(*CloseVChannelConsumerResponse)(nil),                  // 147: milvus.proto.streaming.CloseVChannelConsumerResponse
+	(*ConsumeResponse)(nil),                                // 148: milvus.proto.streaming.ConsumeResponse
+	(*CreateConsumerResponse)(nil),                         // 149: milvus.proto.streaming.CreateConsumerResponse
+	(*ConsumeMessageReponse)(nil),                          // 150: milvus.proto.streaming.ConsumeMessageReponse
+	(*CloseConsumerResponse)(nil),                          // 151: milvus.proto.streaming.CloseConsumerResponse
+	(*StreamingNodeManagerAssignRequest)(nil),              // 152: milvus.proto.streaming.StreamingNodeManagerAssignRequest
+	(*StreamingNodeManagerAssignResponse)(nil),             // 153: milvus.proto.streaming.StreamingNodeManagerAssignResponse
+	(*StreamingNodeManagerRemoveRequest)(nil),              // 154: milvus.proto.streaming.StreamingNodeManagerRemoveRequest
+	(*StreamingNodeManagerRemoveResponse)(nil),             // 155: milvus.proto.streaming.StreamingNodeManagerRemoveResponse
+	(*StreamingNodeManagerCollectStatusRequest)(nil),       // 156: milvus.proto.streaming.StreamingNodeManagerCollectStatusRequest
+	(*StreamingNodeMetrics)(nil),                           // 157: milvus.proto.streaming.StreamingNodeMetrics
+	(*StreamingNodeWALMetrics)(nil),                        // 158: milvus.proto.streaming.StreamingNodeWALMetrics
+	(*StreamingNodeRWWALMetrics)(nil),                      // 159: milvus.proto.streaming.StreamingNodeRWWALMetrics
+	(*StreamingNodeROWALMetrics)(nil),                      // 160: milvus.proto.streaming.StreamingNodeROWALMetrics
+	(*StreamingNodeManagerCollectStatusResponse)(nil),      // 161: milvus.proto.streaming.StreamingNodeManagerCollectStatusResponse
+	(*StreamingNodeManagerUpdateAppendQuotasRequest)(nil),  // 162: milvus.proto.streaming.StreamingNodeManagerUpdateAppendQuotasRequest
+	(*StreamingNodeManagerUpdateAppendQuotasResponse)(nil), // 163: milvus.proto.streaming.StreamingNodeManagerUpdateAppendQuotasResponse
+	(*CollectionAppendQuota)(nil),                          // 164: milvus.proto.streaming.CollectionAppendQuota
+	(*VChannelMeta)(nil),                                   // 165: milvus.proto.streaming.VChannelMeta
+	(*CollectionInfoOfVChannel)(nil),                       // 166: milvus.proto.streaming.CollectionInfoOfVChannel
+	(*CollectionSchemaOfVChannel)(nil),                     // 167: milvus.proto.streaming.CollectionSchemaOfVChannel
+	(*PartitionInfoOfVChannel)(nil),                        // 168: milvus.proto.streaming.PartitionInfoOfVChannel
+	(*SegmentAssignmentMeta)(nil),                          // 169: milvus.proto.streaming.SegmentAssignmentMeta
+	(*SegmentAssignmentStat)(nil),                          // 170: milvus.proto.streaming.SegmentAssignmentStat
+	(*WALCheckpoint)(nil),                                  // 171: milvus.proto.streaming.WALCheckpoint
+	(*AlterWALState)(nil),                                  // 172: milvus.proto.streaming.AlterWALState
+	(*ReplicateConfigurationMeta)(nil),                     // 173: milvus.proto.streaming.ReplicateConfigurationMeta
+	(*ReplicateConfigurationHistoryMeta)(nil),              // 174: milvus.proto.streaming.ReplicateConfigurationHistoryMeta
+	(*ReplicateConfigurationAppendResult)(nil),             // 175: milvus.proto.streaming.ReplicateConfigurationAppendResult
+	(*ReplicatePChannelMeta)(nil),                          // 176: milvus.proto.streaming.ReplicatePChannelMeta
+	(*ReplicateDeadLetterMeta)(nil),                        // 177: milvus.proto.streaming.ReplicateDeadLetterMeta
+	(*ReplicateFilteredCollectionsMeta)(nil),               // 178: milvus.proto.streaming.ReplicateFilteredCollectionsMeta
+	(*ReplicateFilteredCollection)(nil),                    // 179: milvus.proto.streaming.ReplicateFilteredCollection
+	(*ReplicatePChannelArchiveMeta)(nil),                   // 180: milvus.proto.streaming.ReplicatePChannelArchiveMeta
+	nil,                                                    // 181: milvus.proto.streaming.CrossVChannelTxnMeta.VchannelTxnIdsEntry
+	nil,                                                    // 182: milvus.proto.streaming.CommitCrossVChannelTxnResponse.ResultsEntry
+	nil,                                                    // 183: milvus.proto.streaming.BroadcastResponse.ResultsEntry
+	nil,                                                    // 184: milvus.proto.streaming.MigratePChannelTopicsRequest.PchannelTopicsEntry
+	nil,                                                    // 185: milvus.proto.streaming.PChannelStatsSnapshot.VchannelsEntry
+	nil,                                                    // 186: milvus.proto.streaming.ReplicateCollectionFilters.FiltersEntry
+	nil,                                                    // 187: milvus.proto.streaming.AlterWALState.ConfigsEntry
+	nil,                                                    // 188: milvus.proto.streaming.ReplicateConfigurationMeta.CollectionFiltersEntry
+	nil,                                                    // 189: milvus.proto.streaming.ReplicateConfigurationHistoryMeta.CollectionFiltersEntry
+	(*messagespb.Message)(nil),                             // 190: milvus.proto.messages.Message
+	(*commonpb.MessageID)(nil),                             // 191: milvus.proto.common.MessageID
+	(*commonpb.ImmutableMessage)(nil),                      // 192: milvus.proto.common.ImmutableMessage
+	(messagespb.MessageType)(0),                            // 193: milvus.proto.messages.MessageType
+	(*milvuspb.ClusterInfo)(nil),                           // 194: milvus.proto.milvus.ClusterInfo
+	(*commonpb.ReplicateCheckpoint)(nil),                   // 195: milvus.proto.common.ReplicateCheckpoint
+	(*commonpb.ConnectionParam)(nil),                       // 196: milvus.proto.common.ConnectionParam
+	(*commonpb.ReplicateConfiguration)(nil),                // 197: milvus.proto.common.ReplicateConfiguration
+	(*fieldmaskpb.FieldMask)(nil),                          // 198: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                                  // 199: google.protobuf.Empty
+	(*messagespb.TxnContext)(nil),                          // 200: milvus.proto.messages.TxnContext
+	(*anypb.Any)(nil),                                      // 201: google.protobuf.Any
+	(*schemapb.CollectionSchema)(nil),                      // 202: milvus.proto.schema.CollectionSchema
+	(datapb.SegmentLevel)(0),                               // 203: milvus.proto.data.SegmentLevel
+	(commonpb.WALName)(0),                                  // 204: milvus.proto.common.WALName
+	(*commonpb.MilvusCluster)(nil),                         // 205: milvus.proto.common.MilvusCluster
+	(*messagespb.ReplicateCollectionFilter)(nil),           // 206: milvus.proto.messages.ReplicateCollectionFilter
+	(*milvuspb.GetComponentStatesRequest)(nil),             // 207: milvus.proto.milvus.GetComponentStatesRequest
+	(*milvuspb.ComponentStates)(nil),                       // 208: milvus.proto.milvus.ComponentStates
 }
 var file_streaming_proto_depIdxs = []int32{
 	0,   // 0: milvus.proto.streaming.PChannelInfo.access_mode:type_name -> milvus.proto.streaming.PChannelAccessMode
-	35,  // 1: milvus.proto.streaming.PChannelAssignmentLog.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
+	113, // 1: milvus.proto.streaming.PChannelAssignmentLog.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
 	0,   // 2: milvus.proto.streaming.PChannelAssignmentLog.access_mode:type_name -> milvus.proto.streaming.PChannelAccessMode
-	9,   // 3: milvus.proto.streaming.PChannelMeta.channel:type_name -> milvus.proto.streaming.PChannelInfo
-	35,  // 4: milvus.proto.streaming.PChannelMeta.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
+	14,  // 3: milvus.proto.streaming.PChannelMeta.channel:type_name -> milvus.proto.streaming.PChannelInfo
+	113, // 4: milvus.proto.streaming.PChannelMeta.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
 	1,   // 5: milvus.proto.streaming.PChannelMeta.state:type_name -> milvus.proto.streaming.PChannelMetaState
-	10,  // 6: milvus.proto.streaming.PChannelMeta.histories:type_name -> milvus.proto.streaming.PChannelAssignmentLog
-	92,  // 7: milvus.proto.streaming.BroadcastTask.message:type_name -> milvus.proto.messages.Message
-	2,   // 8: milvus.proto.streaming.BroadcastTask.state:type_name -> milvus.proto.streaming.BroadcastTaskState
-	17,  // 9: milvus.proto.streaming.BroadcastTask.acked_checkpoints:type_name -> milvus.proto.streaming.AckedCheckpoint
-	17,  // 10: milvus.proto.streaming.AckedResult.acked_checkpoints:type_name -> milvus.proto.streaming.AckedCheckpoint
-	93,  // 11: milvus.proto.streaming.AckedCheckpoint.message_id:type_name -> milvus.proto.common.MessageID
-	93,  // 12: milvus.proto.streaming.AckedCheckpoint.last_confirmed_message_id:type_name -> milvus.proto.common.MessageID
-	92,  // 13: milvus.proto.streaming.BroadcastRequest.message:type_name -> milvus.proto.messages.Message
-	90,  // 14: milvus.proto.streaming.BroadcastResponse.results:type_name -> milvus.proto.streaming.BroadcastResponse.ResultsEntry
-	94,  // 15: milvus.proto.streaming.BroadcastAckRequest.message:type_name -> milvus.proto.common.ImmutableMessage
-	95,  // 16: milvus.proto.streaming.UpdateReplicateConfigurationRequest.configuration:type_name -> milvus.proto.common.ReplicateConfiguration
-	25,  // 17: milvus.proto.streaming.UpdateWALBalancePolicyRequest.config:type_name -> milvus.proto.streaming.WALBalancePolicyConfig
-	26,  // 18: milvus.proto.streaming.UpdateWALBalancePolicyRequest.nodes:type_name -> milvus.proto.streaming.WALBalancePolicyNodes
-	96,  // 19: milvus.proto.streaming.UpdateWALBalancePolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
-	25,  // 20: milvus.proto.streaming.UpdateWALBalancePolicyResponse.config:type_name -> milvus.proto.streaming.WALBalancePolicyConfig
-	29,  // 21: milvus.proto.streaming.AssignmentDiscoverRequest.report_error:type_name -> milvus.proto.streaming.ReportAssignmentErrorRequest
-	30,  // 22: milvus.proto.streaming.AssignmentDiscoverRequest.close:type_name -> milvus.proto.streaming.CloseAssignmentDiscoverRequest
-	9,   // 23: milvus.proto.streaming.ReportAssignmentErrorRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	42,  // 24: milvus.proto.streaming.ReportAssignmentErrorRequest.err:type_name -> milvus.proto.streaming.StreamingError
-	32,  // 25: milvus.proto.streaming.AssignmentDiscoverResponse.full_assignment:type_name -> milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion
-	34,  // 26: milvus.proto.streaming.AssignmentDiscoverResponse.close:type_name -> milvus.proto.streaming.CloseAssignmentDiscoverResponse
-	14,  // 27: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.version:type_name -> milvus.proto.streaming.VersionPair
-	36,  // 28: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.assignments:type_name -> milvus.proto.streaming.StreamingNodeAssignment
-	33,  // 29: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.cchannel:type_name -> milvus.proto.streaming.CChannelAssignment
-	95,  // 30: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.replicate_configuration:type_name -> milvus.proto.common.ReplicateConfiguration
-	13,  // 31: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.streaming_version:type_name -> milvus.proto.streaming.StreamingVersion
-	14,  // 32: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.version_by_revision:type_name -> milvus.proto.streaming.VersionPair
-	12,  // 33: milvus.proto.streaming.CChannelAssignment.meta:type_name -> milvus.proto.streaming.CChannelMeta
-	35,  // 34: milvus.proto.streaming.StreamingNodeAssignment.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
-	9,   // 35: milvus.proto.streaming.StreamingNodeAssignment.channels:type_name -> milvus.proto.streaming.PChannelInfo
-	97,  // 36: milvus.proto.streaming.DeliverPolicy.all:type_name -> google.protobuf.Empty
-	97,  // 37: milvus.proto.streaming.DeliverPolicy.latest:type_name -> google.protobuf.Empty
-	93,  // 38: milvus.proto.streaming.DeliverPolicy.start_from:type_name -> milvus.proto.common.MessageID
-	93,  // 39: milvus.proto.streaming.DeliverPolicy.start_after:type_name -> milvus.proto.common.MessageID
-	39,  // 40: milvus.proto.streaming.DeliverFilter.time_tick_gt:type_name -> milvus.proto.streaming.DeliverFilterTimeTickGT
-	40,  // 41: milvus.proto.streaming.DeliverFilter.time_tick_gte:type_name -> milvus.proto.streaming.DeliverFilterTimeTickGTE
-	41,  // 42: milvus.proto.streaming.DeliverFilter.message_type:type_name -> milvus.proto.streaming.DeliverFilterMessageType
-	98,  // 43: milvus.proto.streaming.DeliverFilterMessageType.message_types:type_name -> milvus.proto.messages.MessageType
-	3,   // 44: milvus.proto.streaming.StreamingError.code:type_name -> milvus.proto.streaming.StreamingCode
-	9,   // 45: milvus.proto.streaming.GetReplicateCheckpointRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	99,  // 46: milvus.proto.streaming.GetReplicateCheckpointResponse.checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
-	9,   // 47: milvus.proto.streaming.GetSalvageCheckpointRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	99,  // 48: milvus.proto.streaming.GetSalvageCheckpointResponse.checkpoints:type_name -> milvus.proto.common.ReplicateCheckpoint
-	49,  // 49: milvus.proto.streaming.ProduceRequest.produce:type_name -> milvus.proto.streaming.ProduceMessageRequest
-	50,  // 50: milvus.proto.streaming.ProduceRequest.close:type_name -> milvus.proto.streaming.CloseProducerRequest
-	9,   // 51: milvus.proto.streaming.CreateProducerRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	92,  // 52: milvus.proto.streaming.ProduceMessageRequest.message:type_name -> milvus.proto.messages.Message
-	52,  // 53: milvus.proto.streaming.ProduceResponse.create:type_name -> milvus.proto.streaming.CreateProducerResponse
-	53,  // 54: milvus.proto.streaming.ProduceResponse.produce:type_name -> milvus.proto.streaming.ProduceMessageResponse
-	56,  // 55: milvus.proto.streaming.ProduceResponse.close:type_name -> milvus.proto.streaming.CloseProducerResponse
-	54,  // 56: milvus.proto.streaming.ProduceResponse.rate_limit:type_name -> milvus.proto.streaming.ProduceRateLimitResponse
-	55,  // 57: milvus.proto.streaming.ProduceMessageResponse.result:type_name -> milvus.proto.streaming.ProduceMessageResponseResult
-	42,  // 58: milvus.proto.streaming.ProduceMessageResponse.error:type_name -> milvus.proto.streaming.StreamingError
-	4,   // 59: milvus.proto.streaming.ProduceRateLimitResponse.state:type_name -> milvus.proto.streaming.WALRateLimitState
-	93,  // 60: milvus.proto.streaming.ProduceMessageResponseResult.id:type_name -> milvus.proto.common.MessageID
-	100, // 61: milvus.proto.streaming.ProduceMessageResponseResult.txnContext:type_name -> milvus.proto.messages.TxnContext
-	101, // 62: milvus.proto.streaming.ProduceMessageResponseResult.extra:type_name -> google.protobuf.Any
-	93,  // 63: milvus.proto.streaming.ProduceMessageResponseResult.last_confirmed_id:type_name -> milvus.proto.common.MessageID
-	61,  // 64: milvus.proto.streaming.ConsumeRequest.create_vchannel_consumer:type_name -> milvus.proto.streaming.CreateVChannelConsumerRequest
-	60,  // 65: milvus.proto.streaming.ConsumeRequest.create_vchannel_consumers:type_name -> milvus.proto.streaming.CreateVChannelConsumersRequest
-	64,  // 66: milvus.proto.streaming.ConsumeRequest.close_vchannel:type_name -> milvus.proto.streaming.CloseVChannelConsumerRequest
-	58,  // 67: milvus.proto.streaming.ConsumeRequest.close:type_name -> milvus.proto.streaming.CloseConsumerRequest
-	9,   // 68: milvus.proto.streaming.CreateConsumerRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	61,  // 69: milvus.proto.streaming.CreateVChannelConsumersRequest.create_vchannels:type_name -> milvus.proto.streaming.CreateVChannelConsumerRequest
-	37,  // 70: milvus.proto.streaming.CreateVChannelConsumerRequest.deliver_policy:type_name -> milvus.proto.streaming.DeliverPolicy
-	38,  // 71: milvus.proto.streaming.CreateVChannelConsumerRequest.deliver_filters:type_name -> milvus.proto.streaming.DeliverFilter
-	63,  // 72: milvus.proto.streaming.CreateVChannelConsumersResponse.create_vchannels:type_name -> milvus.proto.streaming.CreateVChannelConsumerResponse
-	42,  // 73: milvus.proto.streaming.CreateVChannelConsumerResponse.error:type_name -> milvus.proto.streaming.StreamingError
-	67,  // 74: milvus.proto.streaming.ConsumeResponse.create:type_name -> milvus.proto.streaming.CreateConsumerResponse
-	68,  // 75: milvus.proto.streaming.ConsumeResponse.consume:type_name -> milvus.proto.streaming.ConsumeMessageReponse
-	63,  // 76: milvus.proto.streaming.ConsumeResponse.create_vchannel:type_name -> milvus.proto.streaming.CreateVChannelConsumerResponse
-	62,  // 77: milvus.proto.streaming.ConsumeResponse.create_vchannels:type_name -> milvus.proto.streaming.CreateVChannelConsumersResponse
-	65,  // 78: milvus.proto.streaming.ConsumeResponse.close_vchannel:type_name -> milvus.proto.streaming.CloseVChannelConsumerResponse
-	69,  // 79: milvus.proto.streaming.ConsumeResponse.close:type_name -> milvus.proto.streaming.CloseConsumerResponse
-	94,  // 80: milvus.proto.streaming.ConsumeMessageReponse.message:type_name -> milvus.proto.common.ImmutableMessage
-	9,   // 81: milvus.proto.streaming.StreamingNodeManagerAssignRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	9,   // 82: milvus.proto.streaming.StreamingNodeManagerRemoveRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
-	76,  // 83: milvus.proto.streaming.StreamingNodeMetrics.wals:type_name -> milvus.proto.streaming.StreamingNodeWALMetrics
-	9,   // 84: milvus.proto.streaming.StreamingNodeWALMetrics.info:type_name -> milvus.proto.streaming.PChannelInfo
-	77,  // 85: milvus.proto.streaming.StreamingNodeWALMetrics.rw:type_name -> milvus.proto.streaming.StreamingNodeRWWALMetrics
-	78,  // 86: milvus.proto.streaming.StreamingNodeWALMetrics.ro:type_name -> milvus.proto.streaming.StreamingNodeROWALMetrics
-	75,  // 87: milvus.proto.streaming.StreamingNodeManagerCollectStatusResponse.metrics:type_name -> milvus.proto.streaming.StreamingNodeMetrics
-	5,   // 88: milvus.proto.streaming.VChannelMeta.state:type_name -> milvus.proto.streaming.VChannelState
-	81,  // 89: milvus.proto.streaming.VChannelMeta.collection_info:type_name -> milvus.proto.streaming.CollectionInfoOfVChannel
-	83,  // 90: milvus.proto.streaming.CollectionInfoOfVChannel.partitions:type_name -> milvus.proto.streaming.PartitionInfoOfVChannel
-	82,  // 91: milvus.proto.streaming.CollectionInfoOfVChannel.schemas:type_name -> milvus.proto.streaming.CollectionSchemaOfVChannel
-	102, // 92: milvus.proto.streaming.CollectionSchemaOfVChannel.schema:type_name -> milvus.proto.schema.CollectionSchema
-	6,   // 93: milvus.proto.streaming.CollectionSchemaOfVChannel.state:type_name -> milvus.proto.streaming.VChannelSchemaState
-	7,   // 94: milvus.proto.streaming.SegmentAssignmentMeta.state:type_name -> milvus.proto.streaming.SegmentAssignmentState
-	85,  // 95: milvus.proto.streaming.SegmentAssignmentMeta.stat:type_name -> milvus.proto.streaming.SegmentAssignmentStat
-	103, // 96: milvus.proto.streaming.SegmentAssignmentStat.level:type_name -> milvus.proto.data.SegmentLevel
-	93,  // 97: milvus.proto.streaming.WALCheckpoint.message_id:type_name -> milvus.proto.common.MessageID
-	95,  // 98: milvus.proto.streaming.WALCheckpoint.replicate_config:type_name -> milvus.proto.common.ReplicateConfiguration
-	99,  // 99: milvus.proto.streaming.WALCheckpoint.replicate_checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
-	87,  // 100: milvus.proto.streaming.WALCheckpoint.alter_wal_state:type_name -> milvus.proto.streaming.AlterWALState
-	104, // 101: milvus.proto.streaming.AlterWALState.target_wal_name:type_name -> milvus.proto.common.WALName
-	91,  // 102: milvus.proto.streaming.AlterWALState.configs:type_name -> milvus.proto.streaming.AlterWALState.ConfigsEntry
-	8,   // 103: milvus.proto.streaming.AlterWALState.stage:type_name -> milvus.proto.streaming.AlterWALStage
-	95,  // 104: milvus.proto.streaming.ReplicateConfigurationMeta.replicate_configuration:type_name -> milvus.proto.common.ReplicateConfiguration
-	16,  // 105: milvus.proto.streaming.ReplicateConfigurationMeta.acked_result:type_name -> milvus.proto.streaming.AckedResult
-	105, // 106: milvus.proto.streaming.ReplicatePChannelMeta.target_cluster:type_name -> milvus.proto.common.MilvusCluster
-	99,  // 107: milvus.proto.streaming.ReplicatePChannelMeta.initialized_checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
-	55,  // 108: milvus.proto.streaming.BroadcastResponse.ResultsEntry.value:type_name -> milvus.proto.streaming.ProduceMessageResponseResult
-	106, // 109: milvus.proto.streaming.StreamingNodeStateService.GetComponentStates:input_type -> milvus.proto.milvus.GetComponentStatesRequest
-	18,  // 110: milvus.proto.streaming.StreamingCoordBroadcastService.Broadcast:input_type -> milvus.proto.streaming.BroadcastRequest
-	20,  // 111: milvus.proto.streaming.StreamingCoordBroadcastService.Ack:input_type -> milvus.proto.streaming.BroadcastAckRequest
-	22,  // 112: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicateConfiguration:input_type -> milvus.proto.streaming.UpdateReplicateConfigurationRequest
-	24,  // 113: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateWALBalancePolicy:input_type -> milvus.proto.streaming.UpdateWALBalancePolicyRequest
-	28,  // 114: milvus.proto.streaming.StreamingCoordAssignmentService.AssignmentDiscover:input_type -> milvus.proto.streaming.AssignmentDiscoverRequest
-	43,  // 115: milvus.proto.streaming.StreamingNodeHandlerService.GetReplicateCheckpoint:input_type -> milvus.proto.streaming.GetReplicateCheckpointRequest
-	45,  // 116: milvus.proto.streaming.StreamingNodeHandlerService.GetSalvageCheckpoint:input_type -> milvus.proto.streaming.GetSalvageCheckpointRequest
-	47,  // 117: milvus.proto.streaming.StreamingNodeHandlerService.Produce:input_type -> milvus.proto.streaming.ProduceRequest
-	57,  // 118: milvus.proto.streaming.StreamingNodeHandlerService.Consume:input_type -> milvus.proto.streaming.ConsumeRequest
-	70,  // 119: milvus.proto.streaming.StreamingNodeManagerService.Assign:input_type -> milvus.proto.streaming.StreamingNodeManagerAssignRequest
-	72,  // 120: milvus.proto.streaming.StreamingNodeManagerService.Remove:input_type -> milvus.proto.streaming.StreamingNodeManagerRemoveRequest
-	74,  // 121: milvus.proto.streaming.StreamingNodeManagerService.CollectStatus:input_type -> milvus.proto.streaming.StreamingNodeManagerCollectStatusRequest
-	107, // 122: milvus.proto.streaming.StreamingNodeStateService.GetComponentStates:output_type -> milvus.proto.milvus.ComponentStates
-	19,  // 123: milvus.proto.streaming.StreamingCoordBroadcastService.Broadcast:output_type -> milvus.proto.streaming.BroadcastResponse
-	21,  // 124: milvus.proto.streaming.StreamingCoordBroadcastService.Ack:output_type -> milvus.proto.streaming.BroadcastAckResponse
-	23,  // 125: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicateConfiguration:output_type -> milvus.proto.streaming.UpdateReplicateConfigurationResponse
-	27,  // 126: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateWALBalancePolicy:output_type -> milvus.proto.streaming.UpdateWALBalancePolicyResponse
-	31,  // 127: milvus.proto.streaming.StreamingCoordAssignmentService.AssignmentDiscover:output_type -> milvus.proto.streaming.AssignmentDiscoverResponse
-	44,  // 128: milvus.proto.streaming.StreamingNodeHandlerService.GetReplicateCheckpoint:output_type -> milvus.proto.streaming.GetReplicateCheckpointResponse
-	46,  // 129: milvus.proto.streaming.StreamingNodeHandlerService.GetSalvageCheckpoint:output_type -> milvus.proto.streaming.GetSalvageCheckpointResponse
-	51,  // 130: milvus.proto.streaming.StreamingNodeHandlerService.Produce:output_type -> milvus.proto.streaming.ProduceResponse
-	66,  // 131: milvus.proto.streaming.StreamingNodeHandlerService.Consume:output_type -> milvus.proto.streaming.ConsumeResponse
-	71,  // 132: milvus.proto.streaming.StreamingNodeManagerService.Assign:output_type -> milvus.proto.streaming.StreamingNodeManagerAssignResponse
-	73,  // 133: milvus.proto.streaming.StreamingNodeManagerService.Remove:output_type -> milvus.proto.streaming.StreamingNodeManagerRemoveResponse
-	79,  // 134: milvus.proto.streaming.StreamingNodeManagerService.CollectStatus:output_type -> milvus.proto.streaming.StreamingNodeManagerCollectStatusResponse
-	122, // [122:135] is the sub-list for method output_type
-	109, // [109:122] is the sub-list for method input_type
-	109, // [109:109] is the sub-list for extension type_name
-	109, // [109:109] is the sub-list for extension extendee
-	0,   // [0:109] is the sub-list for field type_name
+	15,  // 6: milvus.proto.streaming.PChannelMeta.histories:type_name -> milvus.proto.streaming.PChannelAssignmentLog
+	15,  // 7: milvus.proto.streaming.PChannelMeta.ownership_histories:type_name -> milvus.proto.streaming.PChannelAssignmentLog
+	17,  // 8: milvus.proto.streaming.PChannelMeta.retention_policy:type_name -> milvus.proto.streaming.WALRetentionPolicy
+	17,  // 9: milvus.proto.streaming.PChannelRetentionPolicy.policy:type_name -> milvus.proto.streaming.WALRetentionPolicy
+	25,  // 10: milvus.proto.streaming.CChannelMeta.handoffs:type_name -> milvus.proto.streaming.CChannelHandoffMarker
+	181, // 11: milvus.proto.streaming.CrossVChannelTxnMeta.vchannel_txn_ids:type_name -> milvus.proto.streaming.CrossVChannelTxnMeta.VchannelTxnIdsEntry
+	3,   // 12: milvus.proto.streaming.CrossVChannelTxnMeta.state:type_name -> milvus.proto.streaming.CrossVChannelTxnState
+	190, // 13: milvus.proto.streaming.BroadcastTask.message:type_name -> milvus.proto.messages.Message
+	2,   // 14: milvus.proto.streaming.BroadcastTask.state:type_name -> milvus.proto.streaming.BroadcastTaskState
+	31,  // 15: milvus.proto.streaming.BroadcastTask.acked_checkpoints:type_name -> milvus.proto.streaming.AckedCheckpoint
+	31,  // 16: milvus.proto.streaming.AckedResult.acked_checkpoints:type_name -> milvus.proto.streaming.AckedCheckpoint
+	191, // 17: milvus.proto.streaming.AckedCheckpoint.message_id:type_name -> milvus.proto.common.MessageID
+	191, // 18: milvus.proto.streaming.AckedCheckpoint.last_confirmed_message_id:type_name -> milvus.proto.common.MessageID
+	28,  // 19: milvus.proto.streaming.BeginCrossVChannelTxnResponse.txn:type_name -> milvus.proto.streaming.CrossVChannelTxnMeta
+	182, // 20: milvus.proto.streaming.CommitCrossVChannelTxnResponse.results:type_name -> milvus.proto.streaming.CommitCrossVChannelTxnResponse.ResultsEntry
+	190, // 21: milvus.proto.streaming.BroadcastRequest.message:type_name -> milvus.proto.messages.Message
+	183, // 22: milvus.proto.streaming.BroadcastResponse.results:type_name -> milvus.proto.streaming.BroadcastResponse.ResultsEntry
+	192, // 23: milvus.proto.streaming.BroadcastAckRequest.message:type_name -> milvus.proto.common.ImmutableMessage
+	43,  // 24: milvus.proto.streaming.BroadcastWatchRequest.resume_token:type_name -> milvus.proto.streaming.BroadcastWatchResumeToken
+	193, // 25: milvus.proto.streaming.BroadcastWatchRequest.message_types:type_name -> milvus.proto.messages.MessageType
+	45,  // 26: milvus.proto.streaming.BroadcastWatchResponse.event:type_name -> milvus.proto.streaming.BroadcastWatchEvent
+	46,  // 27: milvus.proto.streaming.BroadcastWatchResponse.resync:type_name -> milvus.proto.streaming.BroadcastWatchResync
+	43,  // 28: milvus.proto.streaming.BroadcastWatchEvent.resume_token:type_name -> milvus.proto.streaming.BroadcastWatchResumeToken
+	190, // 29: milvus.proto.streaming.BroadcastWatchEvent.message:type_name -> milvus.proto.messages.Message
+	43,  // 30: milvus.proto.streaming.BroadcastWatchResync.resume_token:type_name -> milvus.proto.streaming.BroadcastWatchResumeToken
+	184, // 31: milvus.proto.streaming.MigratePChannelTopicsRequest.pchannel_topics:type_name -> milvus.proto.streaming.MigratePChannelTopicsRequest.PchannelTopicsEntry
+	18,  // 32: milvus.proto.streaming.UpdatePChannelRetentionPoliciesRequest.policies:type_name -> milvus.proto.streaming.PChannelRetentionPolicy
+	18,  // 33: milvus.proto.streaming.UpdatePChannelRetentionPoliciesResponse.policies:type_name -> milvus.proto.streaming.PChannelRetentionPolicy
+	53,  // 34: milvus.proto.streaming.GetPChannelRetentionsResponse.retentions:type_name -> milvus.proto.streaming.PChannelRetention
+	17,  // 35: milvus.proto.streaming.PChannelRetention.policy:type_name -> milvus.proto.streaming.WALRetentionPolicy
+	191, // 36: milvus.proto.streaming.PChannelRetention.replicate_checkpoints:type_name -> milvus.proto.common.MessageID
+	194, // 37: milvus.proto.streaming.GetClusterChannelsResponse.cluster_info:type_name -> milvus.proto.milvus.ClusterInfo
+	174, // 38: milvus.proto.streaming.GetReplicateConfigurationHistoryResponse.histories:type_name -> milvus.proto.streaming.ReplicateConfigurationHistoryMeta
+	177, // 39: milvus.proto.streaming.ListReplicateDeadLettersResponse.dead_letters:type_name -> milvus.proto.streaming.ReplicateDeadLetterMeta
+	177, // 40: milvus.proto.streaming.RequeueReplicateDeadLettersResponse.dead_letters:type_name -> milvus.proto.streaming.ReplicateDeadLetterMeta
+	64,  // 41: milvus.proto.streaming.ListReplicatingTasksResponse.tasks:type_name -> milvus.proto.streaming.ReplicatingTaskProgress
+	176, // 42: milvus.proto.streaming.ReplicatingTaskProgress.task:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	195, // 43: milvus.proto.streaming.ReplicatingTaskProgress.checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
+	4,   // 44: milvus.proto.streaming.ReplicatingTaskProgress.runtime_state:type_name -> milvus.proto.streaming.ReplicatingTaskRuntimeState
+	177, // 45: milvus.proto.streaming.ReplicatingTaskProgress.dead_letter:type_name -> milvus.proto.streaming.ReplicateDeadLetterMeta
+	67,  // 46: milvus.proto.streaming.CheckReplicateSchemaConsistencyResponse.drifts:type_name -> milvus.proto.streaming.ReplicateSchemaDrift
+	5,   // 47: milvus.proto.streaming.ReplicateSchemaDrift.type:type_name -> milvus.proto.streaming.ReplicateSchemaDriftType
+	12,  // 48: milvus.proto.streaming.ResetReplicateCheckpointRequest.policy:type_name -> milvus.proto.streaming.ReplicateCheckpointResetPolicy
+	195, // 49: milvus.proto.streaming.ResetReplicateCheckpointRequest.checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
+	176, // 50: milvus.proto.streaming.ResetReplicateCheckpointResponse.task:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	196, // 51: milvus.proto.streaming.UpdateReplicateClusterConnectionRequest.connection_param:type_name -> milvus.proto.common.ConnectionParam
+	176, // 52: milvus.proto.streaming.UpdateReplicateClusterConnectionResponse.tasks:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	13,  // 53: milvus.proto.streaming.UpdateReplicatingTaskStateRequest.state:type_name -> milvus.proto.streaming.ReplicatePChannelTaskState
+	176, // 54: milvus.proto.streaming.UpdateReplicatingTaskStateResponse.tasks:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	76,  // 55: milvus.proto.streaming.ExportStateResponse.state:type_name -> milvus.proto.streaming.ChannelManagerState
+	26,  // 56: milvus.proto.streaming.ChannelManagerState.streaming_version:type_name -> milvus.proto.streaming.StreamingVersion
+	27,  // 57: milvus.proto.streaming.ChannelManagerState.version:type_name -> milvus.proto.streaming.VersionPair
+	24,  // 58: milvus.proto.streaming.ChannelManagerState.cchannel:type_name -> milvus.proto.streaming.CChannelMeta
+	16,  // 59: milvus.proto.streaming.ChannelManagerState.pchannels:type_name -> milvus.proto.streaming.PChannelMeta
+	78,  // 60: milvus.proto.streaming.ChannelManagerState.stats:type_name -> milvus.proto.streaming.PChannelStatsSnapshot
+	197, // 61: milvus.proto.streaming.ChannelManagerState.replicate_configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	20,  // 62: milvus.proto.streaming.ChannelManagerState.pools:type_name -> milvus.proto.streaming.PChannelPoolMeta
+	21,  // 63: milvus.proto.streaming.ChannelManagerState.anti_affinity_groups:type_name -> milvus.proto.streaming.PChannelAntiAffinityGroupMeta
+	77,  // 64: milvus.proto.streaming.ChannelManagerState.target_cluster_health:type_name -> milvus.proto.streaming.ReplicateTargetClusterHealth
+	185, // 65: milvus.proto.streaming.PChannelStatsSnapshot.vchannels:type_name -> milvus.proto.streaming.PChannelStatsSnapshot.VchannelsEntry
+	24,  // 66: milvus.proto.streaming.MoveControlChannelResponse.meta:type_name -> milvus.proto.streaming.CChannelMeta
+	21,  // 67: milvus.proto.streaming.UpdatePChannelAntiAffinityGroupsRequest.upsert_groups:type_name -> milvus.proto.streaming.PChannelAntiAffinityGroupMeta
+	21,  // 68: milvus.proto.streaming.UpdatePChannelAntiAffinityGroupsResponse.groups:type_name -> milvus.proto.streaming.PChannelAntiAffinityGroupMeta
+	15,  // 69: milvus.proto.streaming.GetAssignmentHistoryResponse.histories:type_name -> milvus.proto.streaming.PChannelAssignmentLog
+	19,  // 70: milvus.proto.streaming.UpdatePChannelPinsRequest.pins:type_name -> milvus.proto.streaming.PChannelPin
+	19,  // 71: milvus.proto.streaming.UpdatePChannelPinsResponse.pins:type_name -> milvus.proto.streaming.PChannelPin
+	20,  // 72: milvus.proto.streaming.UpdatePChannelPoolsRequest.upsert_pools:type_name -> milvus.proto.streaming.PChannelPoolMeta
+	20,  // 73: milvus.proto.streaming.UpdatePChannelPoolsResponse.pools:type_name -> milvus.proto.streaming.PChannelPoolMeta
+	113, // 74: milvus.proto.streaming.RenewPChannelLeaseRequest.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
+	14,  // 75: milvus.proto.streaming.RenewPChannelLeaseRequest.channels:type_name -> milvus.proto.streaming.PChannelInfo
+	14,  // 76: milvus.proto.streaming.RenewPChannelLeaseResponse.revoked_channels:type_name -> milvus.proto.streaming.PChannelInfo
+	197, // 77: milvus.proto.streaming.UpdateReplicateConfigurationRequest.configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	94,  // 78: milvus.proto.streaming.UpdateReplicateConfigurationRequest.collection_filters:type_name -> milvus.proto.streaming.ReplicateCollectionFilters
+	186, // 79: milvus.proto.streaming.ReplicateCollectionFilters.filters:type_name -> milvus.proto.streaming.ReplicateCollectionFilters.FiltersEntry
+	96,  // 80: milvus.proto.streaming.UpdateReplicateConfigurationResponse.plan:type_name -> milvus.proto.streaming.ReplicateConfigurationPlan
+	176, // 81: milvus.proto.streaming.ReplicateConfigurationPlan.created_tasks:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	176, // 82: milvus.proto.streaming.ReplicateConfigurationPlan.removed_tasks:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	97,  // 83: milvus.proto.streaming.ReplicateConfigurationPlan.availability_changes:type_name -> milvus.proto.streaming.ReplicationAvailabilityChange
+	197, // 84: milvus.proto.streaming.ValidateReplicateConfigurationRequest.configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	197, // 85: milvus.proto.streaming.PromoteSecondaryResponse.configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	103, // 86: milvus.proto.streaming.UpdateWALBalancePolicyRequest.config:type_name -> milvus.proto.streaming.WALBalancePolicyConfig
+	104, // 87: milvus.proto.streaming.UpdateWALBalancePolicyRequest.nodes:type_name -> milvus.proto.streaming.WALBalancePolicyNodes
+	198, // 88: milvus.proto.streaming.UpdateWALBalancePolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	103, // 89: milvus.proto.streaming.UpdateWALBalancePolicyResponse.config:type_name -> milvus.proto.streaming.WALBalancePolicyConfig
+	107, // 90: milvus.proto.streaming.AssignmentDiscoverRequest.report_error:type_name -> milvus.proto.streaming.ReportAssignmentErrorRequest
+	108, // 91: milvus.proto.streaming.AssignmentDiscoverRequest.close:type_name -> milvus.proto.streaming.CloseAssignmentDiscoverRequest
+	14,  // 92: milvus.proto.streaming.ReportAssignmentErrorRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	122, // 93: milvus.proto.streaming.ReportAssignmentErrorRequest.err:type_name -> milvus.proto.streaming.StreamingError
+	110, // 94: milvus.proto.streaming.AssignmentDiscoverResponse.full_assignment:type_name -> milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion
+	112, // 95: milvus.proto.streaming.AssignmentDiscoverResponse.close:type_name -> milvus.proto.streaming.CloseAssignmentDiscoverResponse
+	27,  // 96: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.version:type_name -> milvus.proto.streaming.VersionPair
+	114, // 97: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.assignments:type_name -> milvus.proto.streaming.StreamingNodeAssignment
+	111, // 98: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.cchannel:type_name -> milvus.proto.streaming.CChannelAssignment
+	197, // 99: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.replicate_configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	26,  // 100: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.streaming_version:type_name -> milvus.proto.streaming.StreamingVersion
+	27,  // 101: milvus.proto.streaming.FullStreamingNodeAssignmentWithVersion.version_by_revision:type_name -> milvus.proto.streaming.VersionPair
+	24,  // 102: milvus.proto.streaming.CChannelAssignment.meta:type_name -> milvus.proto.streaming.CChannelMeta
+	113, // 103: milvus.proto.streaming.StreamingNodeAssignment.node:type_name -> milvus.proto.streaming.StreamingNodeInfo
+	14,  // 104: milvus.proto.streaming.StreamingNodeAssignment.channels:type_name -> milvus.proto.streaming.PChannelInfo
+	14,  // 105: milvus.proto.streaming.StreamingNodeAssignment.read_replicas:type_name -> milvus.proto.streaming.PChannelInfo
+	199, // 106: milvus.proto.streaming.DeliverPolicy.all:type_name -> google.protobuf.Empty
+	199, // 107: milvus.proto.streaming.DeliverPolicy.latest:type_name -> google.protobuf.Empty
+	191, // 108: milvus.proto.streaming.DeliverPolicy.start_from:type_name -> milvus.proto.common.MessageID
+	191, // 109: milvus.proto.streaming.DeliverPolicy.start_after:type_name -> milvus.proto.common.MessageID
+	116, // 110: milvus.proto.streaming.DeliverPolicy.start_from_time_tick:type_name -> milvus.proto.streaming.DeliverPolicyStartFromTimeTick
+	118, // 111: milvus.proto.streaming.DeliverFilter.time_tick_gt:type_name -> milvus.proto.streaming.DeliverFilterTimeTickGT
+	119, // 112: milvus.proto.streaming.DeliverFilter.time_tick_gte:type_name -> milvus.proto.streaming.DeliverFilterTimeTickGTE
+	120, // 113: milvus.proto.streaming.DeliverFilter.message_type:type_name -> milvus.proto.streaming.DeliverFilterMessageType
+	121, // 114: milvus.proto.streaming.DeliverFilter.collection:type_name -> milvus.proto.streaming.DeliverFilterCollection
+	193, // 115: milvus.proto.streaming.DeliverFilterMessageType.message_types:type_name -> milvus.proto.messages.MessageType
+	6,   // 116: milvus.proto.streaming.StreamingError.code:type_name -> milvus.proto.streaming.StreamingCode
+	14,  // 117: milvus.proto.streaming.GetReplicateCheckpointRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	195, // 118: milvus.proto.streaming.GetReplicateCheckpointResponse.checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
+	14,  // 119: milvus.proto.streaming.GetSalvageCheckpointRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	195, // 120: milvus.proto.streaming.GetSalvageCheckpointResponse.checkpoints:type_name -> milvus.proto.common.ReplicateCheckpoint
+	14,  // 121: milvus.proto.streaming.TruncateWALRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	191, // 122: milvus.proto.streaming.TruncateWALRequest.message_id:type_name -> milvus.proto.common.MessageID
+	131, // 123: milvus.proto.streaming.ProduceRequest.produce:type_name -> milvus.proto.streaming.ProduceMessageRequest
+	132, // 124: milvus.proto.streaming.ProduceRequest.close:type_name -> milvus.proto.streaming.CloseProducerRequest
+	14,  // 125: milvus.proto.streaming.CreateProducerRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	190, // 126: milvus.proto.streaming.ProduceMessageRequest.message:type_name -> milvus.proto.messages.Message
+	134, // 127: milvus.proto.streaming.ProduceResponse.create:type_name -> milvus.proto.streaming.CreateProducerResponse
+	135, // 128: milvus.proto.streaming.ProduceResponse.produce:type_name -> milvus.proto.streaming.ProduceMessageResponse
+	138, // 129: milvus.proto.streaming.ProduceResponse.close:type_name -> milvus.proto.streaming.CloseProducerResponse
+	136, // 130: milvus.proto.streaming.ProduceResponse.rate_limit:type_name -> milvus.proto.streaming.ProduceRateLimitResponse
+	137, // 131: milvus.proto.streaming.ProduceMessageResponse.result:type_name -> milvus.proto.streaming.ProduceMessageResponseResult
+	122, // 132: milvus.proto.streaming.ProduceMessageResponse.error:type_name -> milvus.proto.streaming.StreamingError
+	7,   // 133: milvus.proto.streaming.ProduceRateLimitResponse.state:type_name -> milvus.proto.streaming.WALRateLimitState
+	191, // 134: milvus.proto.streaming.ProduceMessageResponseResult.id:type_name -> milvus.proto.common.MessageID
+	200, // 135: milvus.proto.streaming.ProduceMessageResponseResult.txnContext:type_name -> milvus.proto.messages.TxnContext
+	201, // 136: milvus.proto.streaming.ProduceMessageResponseResult.extra:type_name -> google.protobuf.Any
+	191, // 137: milvus.proto.streaming.ProduceMessageResponseResult.last_confirmed_id:type_name -> milvus.proto.common.MessageID
+	143, // 138: milvus.proto.streaming.ConsumeRequest.create_vchannel_consumer:type_name -> milvus.proto.streaming.CreateVChannelConsumerRequest
+	142, // 139: milvus.proto.streaming.ConsumeRequest.create_vchannel_consumers:type_name -> milvus.proto.streaming.CreateVChannelConsumersRequest
+	146, // 140: milvus.proto.streaming.ConsumeRequest.close_vchannel:type_name -> milvus.proto.streaming.CloseVChannelConsumerRequest
+	140, // 141: milvus.proto.streaming.ConsumeRequest.close:type_name -> milvus.proto.streaming.CloseConsumerRequest
+	14,  // 142: milvus.proto.streaming.CreateConsumerRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	143, // 143: milvus.proto.streaming.CreateVChannelConsumersRequest.create_vchannels:type_name -> milvus.proto.streaming.CreateVChannelConsumerRequest
+	115, // 144: milvus.proto.streaming.CreateVChannelConsumerRequest.deliver_policy:type_name -> milvus.proto.streaming.DeliverPolicy
+	117, // 145: milvus.proto.streaming.CreateVChannelConsumerRequest.deliver_filters:type_name -> milvus.proto.streaming.DeliverFilter
+	145, // 146: milvus.proto.streaming.CreateVChannelConsumersResponse.create_vchannels:type_name -> milvus.proto.streaming.CreateVChannelConsumerResponse
+	122, // 147: milvus.proto.streaming.CreateVChannelConsumerResponse.error:type_name -> milvus.proto.streaming.StreamingError
+	149, // 148: milvus.proto.streaming.ConsumeResponse.create:type_name -> milvus.proto.streaming.CreateConsumerResponse
+	150, // 149: milvus.proto.streaming.ConsumeResponse.consume:type_name -> milvus.proto.streaming.ConsumeMessageReponse
+	145, // 150: milvus.proto.streaming.ConsumeResponse.create_vchannel:type_name -> milvus.proto.streaming.CreateVChannelConsumerResponse
+	144, // 151: milvus.proto.streaming.ConsumeResponse.create_vchannels:type_name -> milvus.proto.streaming.CreateVChannelConsumersResponse
+	147, // 152: milvus.proto.streaming.ConsumeResponse.close_vchannel:type_name -> milvus.proto.streaming.CloseVChannelConsumerResponse
+	151, // 153: milvus.proto.streaming.ConsumeResponse.close:type_name -> milvus.proto.streaming.CloseConsumerResponse
+	192, // 154: milvus.proto.streaming.ConsumeMessageReponse.message:type_name -> milvus.proto.common.ImmutableMessage
+	14,  // 155: milvus.proto.streaming.StreamingNodeManagerAssignRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	14,  // 156: milvus.proto.streaming.StreamingNodeManagerRemoveRequest.pchannel:type_name -> milvus.proto.streaming.PChannelInfo
+	158, // 157: milvus.proto.streaming.StreamingNodeMetrics.wals:type_name -> milvus.proto.streaming.StreamingNodeWALMetrics
+	14,  // 158: milvus.proto.streaming.StreamingNodeWALMetrics.info:type_name -> milvus.proto.streaming.PChannelInfo
+	159, // 159: milvus.proto.streaming.StreamingNodeWALMetrics.rw:type_name -> milvus.proto.streaming.StreamingNodeRWWALMetrics
+	160, // 160: milvus.proto.streaming.StreamingNodeWALMetrics.ro:type_name -> milvus.proto.streaming.StreamingNodeROWALMetrics
+	157, // 161: milvus.proto.streaming.StreamingNodeManagerCollectStatusResponse.metrics:type_name -> milvus.proto.streaming.StreamingNodeMetrics
+	164, // 162: milvus.proto.streaming.StreamingNodeManagerUpdateAppendQuotasRequest.quotas:type_name -> milvus.proto.streaming.CollectionAppendQuota
+	8,   // 163: milvus.proto.streaming.VChannelMeta.state:type_name -> milvus.proto.streaming.VChannelState
+	166, // 164: milvus.proto.streaming.VChannelMeta.collection_info:type_name -> milvus.proto.streaming.CollectionInfoOfVChannel
+	168, // 165: milvus.proto.streaming.CollectionInfoOfVChannel.partitions:type_name -> milvus.proto.streaming.PartitionInfoOfVChannel
+	167, // 166: milvus.proto.streaming.CollectionInfoOfVChannel.schemas:type_name -> milvus.proto.streaming.CollectionSchemaOfVChannel
+	202, // 167: milvus.proto.streaming.CollectionSchemaOfVChannel.schema:type_name -> milvus.proto.schema.CollectionSchema
+	9,   // 168: milvus.proto.streaming.CollectionSchemaOfVChannel.state:type_name -> milvus.proto.streaming.VChannelSchemaState
+	10,  // 169: milvus.proto.streaming.SegmentAssignmentMeta.state:type_name -> milvus.proto.streaming.SegmentAssignmentState
+	170, // 170: milvus.proto.streaming.SegmentAssignmentMeta.stat:type_name -> milvus.proto.streaming.SegmentAssignmentStat
+	203, // 171: milvus.proto.streaming.SegmentAssignmentStat.level:type_name -> milvus.proto.data.SegmentLevel
+	191, // 172: milvus.proto.streaming.WALCheckpoint.message_id:type_name -> milvus.proto.common.MessageID
+	197, // 173: milvus.proto.streaming.WALCheckpoint.replicate_config:type_name -> milvus.proto.common.ReplicateConfiguration
+	195, // 174: milvus.proto.streaming.WALCheckpoint.replicate_checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
+	172, // 175: milvus.proto.streaming.WALCheckpoint.alter_wal_state:type_name -> milvus.proto.streaming.AlterWALState
+	204, // 176: milvus.proto.streaming.AlterWALState.target_wal_name:type_name -> milvus.proto.common.WALName
+	187, // 177: milvus.proto.streaming.AlterWALState.configs:type_name -> milvus.proto.streaming.AlterWALState.ConfigsEntry
+	11,  // 178: milvus.proto.streaming.AlterWALState.stage:type_name -> milvus.proto.streaming.AlterWALStage
+	197, // 179: milvus.proto.streaming.ReplicateConfigurationMeta.replicate_configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	30,  // 180: milvus.proto.streaming.ReplicateConfigurationMeta.acked_result:type_name -> milvus.proto.streaming.AckedResult
+	188, // 181: milvus.proto.streaming.ReplicateConfigurationMeta.collection_filters:type_name -> milvus.proto.streaming.ReplicateConfigurationMeta.CollectionFiltersEntry
+	197, // 182: milvus.proto.streaming.ReplicateConfigurationHistoryMeta.replicate_configuration:type_name -> milvus.proto.common.ReplicateConfiguration
+	175, // 183: milvus.proto.streaming.ReplicateConfigurationHistoryMeta.append_results:type_name -> milvus.proto.streaming.ReplicateConfigurationAppendResult
+	189, // 184: milvus.proto.streaming.ReplicateConfigurationHistoryMeta.collection_filters:type_name -> milvus.proto.streaming.ReplicateConfigurationHistoryMeta.CollectionFiltersEntry
+	191, // 185: milvus.proto.streaming.ReplicateConfigurationAppendResult.message_id:type_name -> milvus.proto.common.MessageID
+	205, // 186: milvus.proto.streaming.ReplicatePChannelMeta.target_cluster:type_name -> milvus.proto.common.MilvusCluster
+	195, // 187: milvus.proto.streaming.ReplicatePChannelMeta.initialized_checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
+	13,  // 188: milvus.proto.streaming.ReplicatePChannelMeta.state:type_name -> milvus.proto.streaming.ReplicatePChannelTaskState
+	12,  // 189: milvus.proto.streaming.ReplicatePChannelMeta.checkpoint_reset_policy:type_name -> milvus.proto.streaming.ReplicateCheckpointResetPolicy
+	206, // 190: milvus.proto.streaming.ReplicatePChannelMeta.collection_filter:type_name -> milvus.proto.messages.ReplicateCollectionFilter
+	191, // 191: milvus.proto.streaming.ReplicateDeadLetterMeta.message_id:type_name -> milvus.proto.common.MessageID
+	179, // 192: milvus.proto.streaming.ReplicateFilteredCollectionsMeta.collections:type_name -> milvus.proto.streaming.ReplicateFilteredCollection
+	176, // 193: milvus.proto.streaming.ReplicatePChannelArchiveMeta.task:type_name -> milvus.proto.streaming.ReplicatePChannelMeta
+	195, // 194: milvus.proto.streaming.ReplicatePChannelArchiveMeta.final_checkpoint:type_name -> milvus.proto.common.ReplicateCheckpoint
+	137, // 195: milvus.proto.streaming.CommitCrossVChannelTxnResponse.ResultsEntry.value:type_name -> milvus.proto.streaming.ProduceMessageResponseResult
+	137, // 196: milvus.proto.streaming.BroadcastResponse.ResultsEntry.value:type_name -> milvus.proto.streaming.ProduceMessageResponseResult
+	206, // 197: milvus.proto.streaming.ReplicateCollectionFilters.FiltersEntry.value:type_name -> milvus.proto.messages.ReplicateCollectionFilter
+	206, // 198: milvus.proto.streaming.ReplicateConfigurationMeta.CollectionFiltersEntry.value:type_name -> milvus.proto.messages.ReplicateCollectionFilter
+	206, // 199: milvus.proto.streaming.ReplicateConfigurationHistoryMeta.CollectionFiltersEntry.value:type_name -> milvus.proto.messages.ReplicateCollectionFilter
+	207, // 200: milvus.proto.streaming.StreamingNodeStateService.GetComponentStates:input_type -> milvus.proto.milvus.GetComponentStatesRequest
+	38,  // 201: milvus.proto.streaming.StreamingCoordBroadcastService.Broadcast:input_type -> milvus.proto.streaming.BroadcastRequest
+	40,  // 202: milvus.proto.streaming.StreamingCoordBroadcastService.Ack:input_type -> milvus.proto.streaming.BroadcastAckRequest
+	42,  // 203: milvus.proto.streaming.StreamingCoordBroadcastService.Watch:input_type -> milvus.proto.streaming.BroadcastWatchRequest
+	32,  // 204: milvus.proto.streaming.StreamingCoordBroadcastService.BeginCrossVChannelTxn:input_type -> milvus.proto.streaming.BeginCrossVChannelTxnRequest
+	34,  // 205: milvus.proto.streaming.StreamingCoordBroadcastService.CommitCrossVChannelTxn:input_type -> milvus.proto.streaming.CommitCrossVChannelTxnRequest
+	36,  // 206: milvus.proto.streaming.StreamingCoordBroadcastService.RollbackCrossVChannelTxn:input_type -> milvus.proto.streaming.RollbackCrossVChannelTxnRequest
+	93,  // 207: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicateConfiguration:input_type -> milvus.proto.streaming.UpdateReplicateConfigurationRequest
+	98,  // 208: milvus.proto.streaming.StreamingCoordAssignmentService.ValidateReplicateConfiguration:input_type -> milvus.proto.streaming.ValidateReplicateConfigurationRequest
+	100, // 209: milvus.proto.streaming.StreamingCoordAssignmentService.PromoteSecondary:input_type -> milvus.proto.streaming.PromoteSecondaryRequest
+	102, // 210: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateWALBalancePolicy:input_type -> milvus.proto.streaming.UpdateWALBalancePolicyRequest
+	106, // 211: milvus.proto.streaming.StreamingCoordAssignmentService.AssignmentDiscover:input_type -> milvus.proto.streaming.AssignmentDiscoverRequest
+	91,  // 212: milvus.proto.streaming.StreamingCoordAssignmentService.RenewPChannelLease:input_type -> milvus.proto.streaming.RenewPChannelLeaseRequest
+	89,  // 213: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelPools:input_type -> milvus.proto.streaming.UpdatePChannelPoolsRequest
+	87,  // 214: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelPins:input_type -> milvus.proto.streaming.UpdatePChannelPinsRequest
+	85,  // 215: milvus.proto.streaming.StreamingCoordAssignmentService.DrainNode:input_type -> milvus.proto.streaming.DrainNodeRequest
+	83,  // 216: milvus.proto.streaming.StreamingCoordAssignmentService.GetAssignmentHistory:input_type -> milvus.proto.streaming.GetAssignmentHistoryRequest
+	81,  // 217: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelAntiAffinityGroups:input_type -> milvus.proto.streaming.UpdatePChannelAntiAffinityGroupsRequest
+	79,  // 218: milvus.proto.streaming.StreamingCoordAssignmentService.MoveControlChannel:input_type -> milvus.proto.streaming.MoveControlChannelRequest
+	74,  // 219: milvus.proto.streaming.StreamingCoordAssignmentService.ExportState:input_type -> milvus.proto.streaming.ExportStateRequest
+	72,  // 220: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicatingTaskState:input_type -> milvus.proto.streaming.UpdateReplicatingTaskStateRequest
+	68,  // 221: milvus.proto.streaming.StreamingCoordAssignmentService.ResetReplicateCheckpoint:input_type -> milvus.proto.streaming.ResetReplicateCheckpointRequest
+	70,  // 222: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicateClusterConnection:input_type -> milvus.proto.streaming.UpdateReplicateClusterConnectionRequest
+	65,  // 223: milvus.proto.streaming.StreamingCoordAssignmentService.CheckReplicateSchemaConsistency:input_type -> milvus.proto.streaming.CheckReplicateSchemaConsistencyRequest
+	62,  // 224: milvus.proto.streaming.StreamingCoordAssignmentService.ListReplicatingTasks:input_type -> milvus.proto.streaming.ListReplicatingTasksRequest
+	58,  // 225: milvus.proto.streaming.StreamingCoordAssignmentService.ListReplicateDeadLetters:input_type -> milvus.proto.streaming.ListReplicateDeadLettersRequest
+	60,  // 226: milvus.proto.streaming.StreamingCoordAssignmentService.RequeueReplicateDeadLetters:input_type -> milvus.proto.streaming.RequeueReplicateDeadLettersRequest
+	56,  // 227: milvus.proto.streaming.StreamingCoordAssignmentService.GetReplicateConfigurationHistory:input_type -> milvus.proto.streaming.GetReplicateConfigurationHistoryRequest
+	54,  // 228: milvus.proto.streaming.StreamingCoordAssignmentService.GetClusterChannels:input_type -> milvus.proto.streaming.GetClusterChannelsRequest
+	49,  // 229: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelRetentionPolicies:input_type -> milvus.proto.streaming.UpdatePChannelRetentionPoliciesRequest
+	51,  // 230: milvus.proto.streaming.StreamingCoordAssignmentService.GetPChannelRetentions:input_type -> milvus.proto.streaming.GetPChannelRetentionsRequest
+	47,  // 231: milvus.proto.streaming.StreamingCoordAssignmentService.MigratePChannelTopics:input_type -> milvus.proto.streaming.MigratePChannelTopicsRequest
+	123, // 232: milvus.proto.streaming.StreamingNodeHandlerService.GetReplicateCheckpoint:input_type -> milvus.proto.streaming.GetReplicateCheckpointRequest
+	125, // 233: milvus.proto.streaming.StreamingNodeHandlerService.GetSalvageCheckpoint:input_type -> milvus.proto.streaming.GetSalvageCheckpointRequest
+	127, // 234: milvus.proto.streaming.StreamingNodeHandlerService.TruncateWAL:input_type -> milvus.proto.streaming.TruncateWALRequest
+	129, // 235: milvus.proto.streaming.StreamingNodeHandlerService.Produce:input_type -> milvus.proto.streaming.ProduceRequest
+	139, // 236: milvus.proto.streaming.StreamingNodeHandlerService.Consume:input_type -> milvus.proto.streaming.ConsumeRequest
+	152, // 237: milvus.proto.streaming.StreamingNodeManagerService.Assign:input_type -> milvus.proto.streaming.StreamingNodeManagerAssignRequest
+	154, // 238: milvus.proto.streaming.StreamingNodeManagerService.Remove:input_type -> milvus.proto.streaming.StreamingNodeManagerRemoveRequest
+	156, // 239: milvus.proto.streaming.StreamingNodeManagerService.CollectStatus:input_type -> milvus.proto.streaming.StreamingNodeManagerCollectStatusRequest
+	162, // 240: milvus.proto.streaming.StreamingNodeManagerService.UpdateAppendQuotas:input_type -> milvus.proto.streaming.StreamingNodeManagerUpdateAppendQuotasRequest
+	208, // 241: milvus.proto.streaming.StreamingNodeStateService.GetComponentStates:output_type -> milvus.proto.milvus.ComponentStates
+	39,  // 242: milvus.proto.streaming.StreamingCoordBroadcastService.Broadcast:output_type -> milvus.proto.streaming.BroadcastResponse
+	41,  // 243: milvus.proto.streaming.StreamingCoordBroadcastService.Ack:output_type -> milvus.proto.streaming.BroadcastAckResponse
+	44,  // 244: milvus.proto.streaming.StreamingCoordBroadcastService.Watch:output_type -> milvus.proto.streaming.BroadcastWatchResponse
+	33,  // 245: milvus.proto.streaming.StreamingCoordBroadcastService.BeginCrossVChannelTxn:output_type -> milvus.proto.streaming.BeginCrossVChannelTxnResponse
+	35,  // 246: milvus.proto.streaming.StreamingCoordBroadcastService.CommitCrossVChannelTxn:output_type -> milvus.proto.streaming.CommitCrossVChannelTxnResponse
+	37,  // 247: milvus.proto.streaming.StreamingCoordBroadcastService.RollbackCrossVChannelTxn:output_type -> milvus.proto.streaming.RollbackCrossVChannelTxnResponse
+	95,  // 248: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicateConfiguration:output_type -> milvus.proto.streaming.UpdateReplicateConfigurationResponse
+	99,  // 249: milvus.proto.streaming.StreamingCoordAssignmentService.ValidateReplicateConfiguration:output_type -> milvus.proto.streaming.ValidateReplicateConfigurationResponse
+	101, // 250: milvus.proto.streaming.StreamingCoordAssignmentService.PromoteSecondary:output_type -> milvus.proto.streaming.PromoteSecondaryResponse
+	105, // 251: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateWALBalancePolicy:output_type -> milvus.proto.streaming.UpdateWALBalancePolicyResponse
+	109, // 252: milvus.proto.streaming.StreamingCoordAssignmentService.AssignmentDiscover:output_type -> milvus.proto.streaming.AssignmentDiscoverResponse
+	92,  // 253: milvus.proto.streaming.StreamingCoordAssignmentService.RenewPChannelLease:output_type -> milvus.proto.streaming.RenewPChannelLeaseResponse
+	90,  // 254: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelPools:output_type -> milvus.proto.streaming.UpdatePChannelPoolsResponse
+	88,  // 255: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelPins:output_type -> milvus.proto.streaming.UpdatePChannelPinsResponse
+	86,  // 256: milvus.proto.streaming.StreamingCoordAssignmentService.DrainNode:output_type -> milvus.proto.streaming.DrainNodeResponse
+	84,  // 257: milvus.proto.streaming.StreamingCoordAssignmentService.GetAssignmentHistory:output_type -> milvus.proto.streaming.GetAssignmentHistoryResponse
+	82,  // 258: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelAntiAffinityGroups:output_type -> milvus.proto.streaming.UpdatePChannelAntiAffinityGroupsResponse
+	80,  // 259: milvus.proto.streaming.StreamingCoordAssignmentService.MoveControlChannel:output_type -> milvus.proto.streaming.MoveControlChannelResponse
+	75,  // 260: milvus.proto.streaming.StreamingCoordAssignmentService.ExportState:output_type -> milvus.proto.streaming.ExportStateResponse
+	73,  // 261: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicatingTaskState:output_type -> milvus.proto.streaming.UpdateReplicatingTaskStateResponse
+	69,  // 262: milvus.proto.streaming.StreamingCoordAssignmentService.ResetReplicateCheckpoint:output_type -> milvus.proto.streaming.ResetReplicateCheckpointResponse
+	71,  // 263: milvus.proto.streaming.StreamingCoordAssignmentService.UpdateReplicateClusterConnection:output_type -> milvus.proto.streaming.UpdateReplicateClusterConnectionResponse
+	66,  // 264: milvus.proto.streaming.StreamingCoordAssignmentService.CheckReplicateSchemaConsistency:output_type -> milvus.proto.streaming.CheckReplicateSchemaConsistencyResponse
+	63,  // 265: milvus.proto.streaming.StreamingCoordAssignmentService.ListReplicatingTasks:output_type -> milvus.proto.streaming.ListReplicatingTasksResponse
+	59,  // 266: milvus.proto.streaming.StreamingCoordAssignmentService.ListReplicateDeadLetters:output_type -> milvus.proto.streaming.ListReplicateDeadLettersResponse
+	61,  // 267: milvus.proto.streaming.StreamingCoordAssignmentService.RequeueReplicateDeadLetters:output_type -> milvus.proto.streaming.RequeueReplicateDeadLettersResponse
+	57,  // 268: milvus.proto.streaming.StreamingCoordAssignmentService.GetReplicateConfigurationHistory:output_type -> milvus.proto.streaming.GetReplicateConfigurationHistoryResponse
+	55,  // 269: milvus.proto.streaming.StreamingCoordAssignmentService.GetClusterChannels:output_type -> milvus.proto.streaming.GetClusterChannelsResponse
+	50,  // 270: milvus.proto.streaming.StreamingCoordAssignmentService.UpdatePChannelRetentionPolicies:output_type -> milvus.proto.streaming.UpdatePChannelRetentionPoliciesResponse
+	52,  // 271: milvus.proto.streaming.StreamingCoordAssignmentService.GetPChannelRetentions:output_type -> milvus.proto.streaming.GetPChannelRetentionsResponse
+	48,  // 272: milvus.proto.streaming.StreamingCoordAssignmentService.MigratePChannelTopics:output_type -> milvus.proto.streaming.MigratePChannelTopicsResponse
+	124, // 273: milvus.proto.streaming.StreamingNodeHandlerService.GetReplicateCheckpoint:output_type -> milvus.proto.streaming.GetReplicateCheckpointResponse
+	126, // 274: milvus.proto.streaming.StreamingNodeHandlerService.GetSalvageCheckpoint:output_type -> milvus.proto.streaming.GetSalvageCheckpointResponse
+	128, // 275: milvus.proto.streaming.StreamingNodeHandlerService.TruncateWAL:output_type -> milvus.proto.streaming.TruncateWALResponse
+	133, // 276: milvus.proto.streaming.StreamingNodeHandlerService.Produce:output_type -> milvus.proto.streaming.ProduceResponse
+	148, // 277: milvus.proto.streaming.StreamingNodeHandlerService.Consume:output_type -> milvus.proto.streaming.ConsumeResponse
+	153, // 278: milvus.proto.streaming.StreamingNodeManagerService.Assign:output_type -> milvus.proto.streaming.StreamingNodeManagerAssignResponse
+	155, // 279: milvus.proto.streaming.StreamingNodeManagerService.Remove:output_type -> milvus.proto.streaming.StreamingNodeManagerRemoveResponse
+	161, // 280: milvus.proto.streaming.StreamingNodeManagerService.CollectStatus:output_type -> milvus.proto.streaming.StreamingNodeManagerCollectStatusResponse
+	163, // 281: milvus.proto.streaming.StreamingNodeManagerService.UpdateAppendQuotas:output_type -> milvus.proto.streaming.StreamingNodeManagerUpdateAppendQuotasResponse
+	241, // [241:282] is the sub-list for method output_type
+	200, // [200:241] is the sub-list for method input_type
+	200, // [200:200] is the sub-list for extension type_name
+	200, // [200:200] is the sub-list for extension extendee
+	0,   // [0:200] is the sub-list for field type_name
 }
 
 func init() { file_streaming_proto_init() }
@@ -6883,8 +13988,860 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PChannelAssignmentLog); i {
+		file_streaming_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelAssignmentLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WALRetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelRetentionPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelPin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelPoolMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelAntiAffinityGroupMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IDAllocatorMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalancerConfigMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChannelMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CChannelHandoffMarker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamingVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossVChannelTxnMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckedResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckedCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginCrossVChannelTxnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginCrossVChannelTxnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitCrossVChannelTxnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitCrossVChannelTxnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackCrossVChannelTxnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackCrossVChannelTxnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastAckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastAckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastWatchResumeToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastWatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastWatchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastWatchResync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigratePChannelTopicsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigratePChannelTopicsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelRetentionPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelRetentionPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPChannelRetentionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPChannelRetentionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelRetention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReplicateConfigurationHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReplicateConfigurationHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReplicateDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReplicateDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueReplicateDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueReplicateDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReplicatingTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReplicatingTasksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicatingTaskProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckReplicateSchemaConsistencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckReplicateSchemaConsistencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateSchemaDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetReplicateCheckpointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetReplicateCheckpointResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplicateClusterConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplicateClusterConnectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplicatingTaskStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplicatingTaskStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelManagerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateTargetClusterHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PChannelStatsSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveControlChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveControlChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelAntiAffinityGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelAntiAffinityGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssignmentHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssignmentHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainNodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6895,8 +14852,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PChannelMeta); i {
+		file_streaming_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelPinsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6907,8 +14864,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CChannelMeta); i {
+		file_streaming_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelPinsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6919,8 +14876,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamingVersion); i {
+		file_streaming_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelPoolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6931,8 +14888,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionPair); i {
+		file_streaming_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePChannelPoolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6943,8 +14900,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastTask); i {
+		file_streaming_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewPChannelLeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6955,8 +14912,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckedResult); i {
+		file_streaming_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewPChannelLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6967,8 +14924,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AckedCheckpoint); i {
+		file_streaming_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplicateConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6979,8 +14936,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastRequest); i {
+		file_streaming_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateCollectionFilters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6991,8 +14948,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastResponse); i {
+		file_streaming_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReplicateConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7003,8 +14960,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastAckRequest); i {
+		file_streaming_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateConfigurationPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7015,8 +14972,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BroadcastAckResponse); i {
+		file_streaming_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationAvailabilityChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7027,8 +14984,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateReplicateConfigurationRequest); i {
+		file_streaming_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateReplicateConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7039,8 +14996,8 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateReplicateConfigurationResponse); i {
+		file_streaming_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateReplicateConfigurationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7051,7 +15008,31 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteSecondaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteSecondaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateWALBalancePolicyRequest); i {
 			case 0:
 				return &v.state
@@ -7063,7 +15044,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WALBalancePolicyConfig); i {
 			case 0:
 				return &v.state
@@ -7075,7 +15056,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WALBalancePolicyNodes); i {
 			case 0:
 				return &v.state
@@ -7087,7 +15068,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateWALBalancePolicyResponse); i {
 			case 0:
 				return &v.state
@@ -7099,7 +15080,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssignmentDiscoverRequest); i {
 			case 0:
 				return &v.state
@@ -7111,7 +15092,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportAssignmentErrorRequest); i {
 			case 0:
 				return &v.state
@@ -7123,7 +15104,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseAssignmentDiscoverRequest); i {
 			case 0:
 				return &v.state
@@ -7135,7 +15116,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AssignmentDiscoverResponse); i {
 			case 0:
 				return &v.state
@@ -7147,7 +15128,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FullStreamingNodeAssignmentWithVersion); i {
 			case 0:
 				return &v.state
@@ -7159,7 +15140,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CChannelAssignment); i {
 			case 0:
 				return &v.state
@@ -7171,7 +15152,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseAssignmentDiscoverResponse); i {
 			case 0:
 				return &v.state
@@ -7183,7 +15164,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeInfo); i {
 			case 0:
 				return &v.state
@@ -7195,7 +15176,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeAssignment); i {
 			case 0:
 				return &v.state
@@ -7207,7 +15188,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeliverPolicy); i {
 			case 0:
 				return &v.state
@@ -7219,7 +15200,19 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliverPolicyStartFromTimeTick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeliverFilter); i {
 			case 0:
 				return &v.state
@@ -7231,7 +15224,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeliverFilterTimeTickGT); i {
 			case 0:
 				return &v.state
@@ -7243,7 +15236,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeliverFilterTimeTickGTE); i {
 			case 0:
 				return &v.state
@@ -7255,7 +15248,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeliverFilterMessageType); i {
 			case 0:
 				return &v.state
@@ -7267,7 +15260,19 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliverFilterCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingError); i {
 			case 0:
 				return &v.state
@@ -7279,7 +15284,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReplicateCheckpointRequest); i {
 			case 0:
 				return &v.state
@@ -7291,7 +15296,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReplicateCheckpointResponse); i {
 			case 0:
 				return &v.state
@@ -7303,7 +15308,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSalvageCheckpointRequest); i {
 			case 0:
 				return &v.state
@@ -7315,7 +15320,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSalvageCheckpointResponse); i {
 			case 0:
 				return &v.state
@@ -7327,7 +15332,31 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TruncateWALRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TruncateWALResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProduceRequest); i {
 			case 0:
 				return &v.state
@@ -7339,7 +15368,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateProducerRequest); i {
 			case 0:
 				return &v.state
@@ -7351,7 +15380,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProduceMessageRequest); i {
 			case 0:
 				return &v.state
@@ -7363,7 +15392,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseProducerRequest); i {
 			case 0:
 				return &v.state
@@ -7375,7 +15404,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProduceResponse); i {
 			case 0:
 				return &v.state
@@ -7387,7 +15416,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateProducerResponse); i {
 			case 0:
 				return &v.state
@@ -7399,7 +15428,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProduceMessageResponse); i {
 			case 0:
 				return &v.state
@@ -7411,7 +15440,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProduceRateLimitResponse); i {
 			case 0:
 				return &v.state
@@ -7423,7 +15452,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProduceMessageResponseResult); i {
 			case 0:
 				return &v.state
@@ -7435,7 +15464,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseProducerResponse); i {
 			case 0:
 				return &v.state
@@ -7447,7 +15476,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsumeRequest); i {
 			case 0:
 				return &v.state
@@ -7459,7 +15488,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseConsumerRequest); i {
 			case 0:
 				return &v.state
@@ -7471,7 +15500,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateConsumerRequest); i {
 			case 0:
 				return &v.state
@@ -7483,7 +15512,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVChannelConsumersRequest); i {
 			case 0:
 				return &v.state
@@ -7495,7 +15524,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVChannelConsumerRequest); i {
 			case 0:
 				return &v.state
@@ -7507,7 +15536,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVChannelConsumersResponse); i {
 			case 0:
 				return &v.state
@@ -7519,7 +15548,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateVChannelConsumerResponse); i {
 			case 0:
 				return &v.state
@@ -7531,7 +15560,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseVChannelConsumerRequest); i {
 			case 0:
 				return &v.state
@@ -7543,7 +15572,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseVChannelConsumerResponse); i {
 			case 0:
 				return &v.state
@@ -7555,7 +15584,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsumeResponse); i {
 			case 0:
 				return &v.state
@@ -7567,7 +15596,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateConsumerResponse); i {
 			case 0:
 				return &v.state
@@ -7579,7 +15608,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsumeMessageReponse); i {
 			case 0:
 				return &v.state
@@ -7591,7 +15620,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloseConsumerResponse); i {
 			case 0:
 				return &v.state
@@ -7603,7 +15632,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeManagerAssignRequest); i {
 			case 0:
 				return &v.state
@@ -7615,7 +15644,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeManagerAssignResponse); i {
 			case 0:
 				return &v.state
@@ -7627,7 +15656,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeManagerRemoveRequest); i {
 			case 0:
 				return &v.state
@@ -7639,7 +15668,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeManagerRemoveResponse); i {
 			case 0:
 				return &v.state
@@ -7651,7 +15680,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeManagerCollectStatusRequest); i {
 			case 0:
 				return &v.state
@@ -7663,7 +15692,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeMetrics); i {
 			case 0:
 				return &v.state
@@ -7675,7 +15704,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeWALMetrics); i {
 			case 0:
 				return &v.state
@@ -7687,7 +15716,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeRWWALMetrics); i {
 			case 0:
 				return &v.state
@@ -7699,7 +15728,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeROWALMetrics); i {
 			case 0:
 				return &v.state
@@ -7711,7 +15740,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamingNodeManagerCollectStatusResponse); i {
 			case 0:
 				return &v.state
@@ -7723,7 +15752,43 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamingNodeManagerUpdateAppendQuotasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamingNodeManagerUpdateAppendQuotasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionAppendQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VChannelMeta); i {
 			case 0:
 				return &v.state
@@ -7735,7 +15800,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectionInfoOfVChannel); i {
 			case 0:
 				return &v.state
@@ -7747,7 +15812,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CollectionSchemaOfVChannel); i {
 			case 0:
 				return &v.state
@@ -7759,7 +15824,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PartitionInfoOfVChannel); i {
 			case 0:
 				return &v.state
@@ -7771,7 +15836,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SegmentAssignmentMeta); i {
 			case 0:
 				return &v.state
@@ -7783,7 +15848,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SegmentAssignmentStat); i {
 			case 0:
 				return &v.state
@@ -7795,7 +15860,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WALCheckpoint); i {
 			case 0:
 				return &v.state
@@ -7807,7 +15872,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AlterWALState); i {
 			case 0:
 				return &v.state
@@ -7819,7 +15884,7 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicateConfigurationMeta); i {
 			case 0:
 				return &v.state
@@ -7831,7 +15896,31 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
-		file_streaming_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_streaming_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateConfigurationHistoryMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateConfigurationAppendResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicatePChannelMeta); i {
 			case 0:
 				return &v.state
@@ -7843,51 +15932,106 @@ func file_streaming_proto_init() {
 				return nil
 			}
 		}
+		file_streaming_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateDeadLetterMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateFilteredCollectionsMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicateFilteredCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streaming_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicatePChannelArchiveMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_streaming_proto_msgTypes[30].OneofWrappers = []interface{}{
+		(*BroadcastWatchResponse_Event)(nil),
+		(*BroadcastWatchResponse_Resync)(nil),
 	}
-	file_streaming_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[79].OneofWrappers = []interface{}{}
+	file_streaming_proto_msgTypes[92].OneofWrappers = []interface{}{
 		(*AssignmentDiscoverRequest_ReportError)(nil),
 		(*AssignmentDiscoverRequest_Close)(nil),
 	}
-	file_streaming_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[95].OneofWrappers = []interface{}{
 		(*AssignmentDiscoverResponse_FullAssignment)(nil),
 		(*AssignmentDiscoverResponse_Close)(nil),
 	}
-	file_streaming_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[101].OneofWrappers = []interface{}{
 		(*DeliverPolicy_All)(nil),
 		(*DeliverPolicy_Latest)(nil),
 		(*DeliverPolicy_StartFrom)(nil),
 		(*DeliverPolicy_StartAfter)(nil),
+		(*DeliverPolicy_StartFromTimeTick)(nil),
 	}
-	file_streaming_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[103].OneofWrappers = []interface{}{
 		(*DeliverFilter_TimeTickGt)(nil),
 		(*DeliverFilter_TimeTickGte)(nil),
 		(*DeliverFilter_MessageType)(nil),
+		(*DeliverFilter_Collection)(nil),
 	}
-	file_streaming_proto_msgTypes[38].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[115].OneofWrappers = []interface{}{
 		(*ProduceRequest_Produce)(nil),
 		(*ProduceRequest_Close)(nil),
 	}
-	file_streaming_proto_msgTypes[42].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[119].OneofWrappers = []interface{}{
 		(*ProduceResponse_Create)(nil),
 		(*ProduceResponse_Produce)(nil),
 		(*ProduceResponse_Close)(nil),
 		(*ProduceResponse_RateLimit)(nil),
 	}
-	file_streaming_proto_msgTypes[44].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[121].OneofWrappers = []interface{}{
 		(*ProduceMessageResponse_Result)(nil),
 		(*ProduceMessageResponse_Error)(nil),
 	}
-	file_streaming_proto_msgTypes[48].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[125].OneofWrappers = []interface{}{
 		(*ConsumeRequest_CreateVchannelConsumer)(nil),
 		(*ConsumeRequest_CreateVchannelConsumers)(nil),
 		(*ConsumeRequest_CloseVchannel)(nil),
 		(*ConsumeRequest_Close)(nil),
 	}
-	file_streaming_proto_msgTypes[54].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[131].OneofWrappers = []interface{}{
 		(*CreateVChannelConsumerResponse_ConsumerId)(nil),
 		(*CreateVChannelConsumerResponse_Error)(nil),
 	}
-	file_streaming_proto_msgTypes[57].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[134].OneofWrappers = []interface{}{
 		(*ConsumeResponse_Create)(nil),
 		(*ConsumeResponse_Consume)(nil),
 		(*ConsumeResponse_CreateVchannel)(nil),
@@ -7895,7 +16039,7 @@ func file_streaming_proto_init() {
 		(*ConsumeResponse_CloseVchannel)(nil),
 		(*ConsumeResponse_Close)(nil),
 	}
-	file_streaming_proto_msgTypes[67].OneofWrappers = []interface{}{
+	file_streaming_proto_msgTypes[144].OneofWrappers = []interface{}{
 		(*StreamingNodeWALMetrics_Rw)(nil),
 		(*StreamingNodeWALMetrics_Ro)(nil),
 	}
@@ -7904,8 +16048,8 @@ func file_streaming_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_streaming_proto_rawDesc,
-			NumEnums:      9,
-			NumMessages:   83,
+			NumEnums:      14,
+			NumMessages:   176,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
@@ -108,8 +108,12 @@ var StreamingNodeStateService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StreamingCoordBroadcastService_Broadcast_FullMethodName = "/milvus.proto.streaming.StreamingCoordBroadcastService/Broadcast"
-	StreamingCoordBroadcastService_Ack_FullMethodName       = "/milvus.proto.streaming.StreamingCoordBroadcastService/Ack"
+	StreamingCoordBroadcastService_Broadcast_FullMethodName                = "/milvus.proto.streaming.StreamingCoordBroadcastService/Broadcast"
+	StreamingCoordBroadcastService_Ack_FullMethodName                      = "/milvus.proto.streaming.StreamingCoordBroadcastService/Ack"
+	StreamingCoordBroadcastService_Watch_FullMethodName                    = "/milvus.proto.streaming.StreamingCoordBroadcastService/Watch"
+	StreamingCoordBroadcastService_BeginCrossVChannelTxn_FullMethodName    = "/milvus.proto.streaming.StreamingCoordBroadcastService/BeginCrossVChannelTxn"
+	StreamingCoordBroadcastService_CommitCrossVChannelTxn_FullMethodName   = "/milvus.proto.streaming.StreamingCoordBroadcastService/CommitCrossVChannelTxn"
+	StreamingCoordBroadcastService_RollbackCrossVChannelTxn_FullMethodName = "/milvus.proto.streaming.StreamingCoordBroadcastService/RollbackCrossVChannelTxn"
 )
 
 // StreamingCoordBroadcastServiceClient is the client API for StreamingCoordBroadcastService service.
@@ -121,6 +125,19 @@ type StreamingCoordBroadcastServiceClient interface {
 	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
 	// Ack acknowledge broadcast message is consumed.
 	Ack(ctx context.Context, in *BroadcastAckRequest, opts ...grpc.CallOption) (*BroadcastAckResponse, error)
+	// Watch subscribes the broadcast messages applied at control channel (DDL applied, replicate configuration changed...).
+	// The events are delivered in the applied order, the watcher can resume from the resume token of last received event.
+	// A resync response is delivered if the events after the resume token are not available any more,
+	// the watcher should reload the state from meta before handling the following events.
+	Watch(ctx context.Context, in *BroadcastWatchRequest, opts ...grpc.CallOption) (StreamingCoordBroadcastService_WatchClient, error)
+	// BeginCrossVChannelTxn begins a transaction across the vchannels of a collection.
+	// The txn id of every vchannel is allocated and the transaction is persisted at streamingcoord,
+	// the client should begin the transaction on every vchannel with the returned txn ids.
+	BeginCrossVChannelTxn(ctx context.Context, in *BeginCrossVChannelTxnRequest, opts ...grpc.CallOption) (*BeginCrossVChannelTxnResponse, error)
+	// CommitCrossVChannelTxn commits the cross vchannel transaction by broadcasting the commit message to all its vchannels.
+	CommitCrossVChannelTxn(ctx context.Context, in *CommitCrossVChannelTxnRequest, opts ...grpc.CallOption) (*CommitCrossVChannelTxnResponse, error)
+	// RollbackCrossVChannelTxn rollbacks the cross vchannel transaction by broadcasting the rollback message to all its vchannels.
+	RollbackCrossVChannelTxn(ctx context.Context, in *RollbackCrossVChannelTxnRequest, opts ...grpc.CallOption) (*RollbackCrossVChannelTxnResponse, error)
 }
 
 type streamingCoordBroadcastServiceClient struct {
@@ -149,6 +166,65 @@ func (c *streamingCoordBroadcastServiceClient) Ack(ctx context.Context, in *Broa
 	return out, nil
 }
 
+func (c *streamingCoordBroadcastServiceClient) Watch(ctx context.Context, in *BroadcastWatchRequest, opts ...grpc.CallOption) (StreamingCoordBroadcastService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StreamingCoordBroadcastService_ServiceDesc.Streams[0], StreamingCoordBroadcastService_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingCoordBroadcastServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreamingCoordBroadcastService_WatchClient interface {
+	Recv() (*BroadcastWatchResponse, error)
+	grpc.ClientStream
+}
+
+type streamingCoordBroadcastServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingCoordBroadcastServiceWatchClient) Recv() (*BroadcastWatchResponse, error) {
+	m := new(BroadcastWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamingCoordBroadcastServiceClient) BeginCrossVChannelTxn(ctx context.Context, in *BeginCrossVChannelTxnRequest, opts ...grpc.CallOption) (*BeginCrossVChannelTxnResponse, error) {
+	out := new(BeginCrossVChannelTxnResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordBroadcastService_BeginCrossVChannelTxn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordBroadcastServiceClient) CommitCrossVChannelTxn(ctx context.Context, in *CommitCrossVChannelTxnRequest, opts ...grpc.CallOption) (*CommitCrossVChannelTxnResponse, error) {
+	out := new(CommitCrossVChannelTxnResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordBroadcastService_CommitCrossVChannelTxn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordBroadcastServiceClient) RollbackCrossVChannelTxn(ctx context.Context, in *RollbackCrossVChannelTxnRequest, opts ...grpc.CallOption) (*RollbackCrossVChannelTxnResponse, error) {
+	out := new(RollbackCrossVChannelTxnResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordBroadcastService_RollbackCrossVChannelTxn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamingCoordBroadcastServiceServer is the server API for StreamingCoordBroadcastService service.
 // All implementations should embed UnimplementedStreamingCoordBroadcastServiceServer
 // for forward compatibility
@@ -158,6 +234,19 @@ type StreamingCoordBroadcastServiceServer interface {
 	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
 	// Ack acknowledge broadcast message is consumed.
 	Ack(context.Context, *BroadcastAckRequest) (*BroadcastAckResponse, error)
+	// Watch subscribes the broadcast messages applied at control channel (DDL applied, replicate configuration changed...).
+	// The events are delivered in the applied order, the watcher can resume from the resume token of last received event.
+	// A resync response is delivered if the events after the resume token are not available any more,
+	// the watcher should reload the state from meta before handling the following events.
+	Watch(*BroadcastWatchRequest, StreamingCoordBroadcastService_WatchServer) error
+	// BeginCrossVChannelTxn begins a transaction across the vchannels of a collection.
+	// The txn id of every vchannel is allocated and the transaction is persisted at streamingcoord,
+	// the client should begin the transaction on every vchannel with the returned txn ids.
+	BeginCrossVChannelTxn(context.Context, *BeginCrossVChannelTxnRequest) (*BeginCrossVChannelTxnResponse, error)
+	// CommitCrossVChannelTxn commits the cross vchannel transaction by broadcasting the commit message to all its vchannels.
+	CommitCrossVChannelTxn(context.Context, *CommitCrossVChannelTxnRequest) (*CommitCrossVChannelTxnResponse, error)
+	// RollbackCrossVChannelTxn rollbacks the cross vchannel transaction by broadcasting the rollback message to all its vchannels.
+	RollbackCrossVChannelTxn(context.Context, *RollbackCrossVChannelTxnRequest) (*RollbackCrossVChannelTxnResponse, error)
 }
 
 // UnimplementedStreamingCoordBroadcastServiceServer should be embedded to have forward compatible implementations.
@@ -170,6 +259,18 @@ func (UnimplementedStreamingCoordBroadcastServiceServer) Broadcast(context.Conte
 func (UnimplementedStreamingCoordBroadcastServiceServer) Ack(context.Context, *BroadcastAckRequest) (*BroadcastAckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
 }
+func (UnimplementedStreamingCoordBroadcastServiceServer) Watch(*BroadcastWatchRequest, StreamingCoordBroadcastService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedStreamingCoordBroadcastServiceServer) BeginCrossVChannelTxn(context.Context, *BeginCrossVChannelTxnRequest) (*BeginCrossVChannelTxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginCrossVChannelTxn not implemented")
+}
+func (UnimplementedStreamingCoordBroadcastServiceServer) CommitCrossVChannelTxn(context.Context, *CommitCrossVChannelTxnRequest) (*CommitCrossVChannelTxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitCrossVChannelTxn not implemented")
+}
+func (UnimplementedStreamingCoordBroadcastServiceServer) RollbackCrossVChannelTxn(context.Context, *RollbackCrossVChannelTxnRequest) (*RollbackCrossVChannelTxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCrossVChannelTxn not implemented")
+}
 
 // UnsafeStreamingCoordBroadcastServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to StreamingCoordBroadcastServiceServer will
@@ -218,6 +319,81 @@ func _StreamingCoordBroadcastService_Ack_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamingCoordBroadcastService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BroadcastWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingCoordBroadcastServiceServer).Watch(m, &streamingCoordBroadcastServiceWatchServer{stream})
+}
+
+type StreamingCoordBroadcastService_WatchServer interface {
+	Send(*BroadcastWatchResponse) error
+	grpc.ServerStream
+}
+
+type streamingCoordBroadcastServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingCoordBroadcastServiceWatchServer) Send(m *BroadcastWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StreamingCoordBroadcastService_BeginCrossVChannelTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginCrossVChannelTxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordBroadcastServiceServer).BeginCrossVChannelTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordBroadcastService_BeginCrossVChannelTxn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordBroadcastServiceServer).BeginCrossVChannelTxn(ctx, req.(*BeginCrossVChannelTxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordBroadcastService_CommitCrossVChannelTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitCrossVChannelTxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordBroadcastServiceServer).CommitCrossVChannelTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordBroadcastService_CommitCrossVChannelTxn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordBroadcastServiceServer).CommitCrossVChannelTxn(ctx, req.(*CommitCrossVChannelTxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordBroadcastService_RollbackCrossVChannelTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCrossVChannelTxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordBroadcastServiceServer).RollbackCrossVChannelTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordBroadcastService_RollbackCrossVChannelTxn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordBroadcastServiceServer).RollbackCrossVChannelTxn(ctx, req.(*RollbackCrossVChannelTxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StreamingCoordBroadcastService_ServiceDesc is the grpc.ServiceDesc for StreamingCoordBroadcastService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,15 +409,55 @@ var StreamingCoordBroadcastService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ack",
 			Handler:    _StreamingCoordBroadcastService_Ack_Handler,
 		},
+		{
+			MethodName: "BeginCrossVChannelTxn",
+			Handler:    _StreamingCoordBroadcastService_BeginCrossVChannelTxn_Handler,
+		},
+		{
+			MethodName: "CommitCrossVChannelTxn",
+			Handler:    _StreamingCoordBroadcastService_CommitCrossVChannelTxn_Handler,
+		},
+		{
+			MethodName: "RollbackCrossVChannelTxn",
+			Handler:    _StreamingCoordBroadcastService_RollbackCrossVChannelTxn_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _StreamingCoordBroadcastService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "streaming.proto",
 }
 
 const (
-	StreamingCoordAssignmentService_UpdateReplicateConfiguration_FullMethodName = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdateReplicateConfiguration"
-	StreamingCoordAssignmentService_UpdateWALBalancePolicy_FullMethodName       = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdateWALBalancePolicy"
-	StreamingCoordAssignmentService_AssignmentDiscover_FullMethodName           = "/milvus.proto.streaming.StreamingCoordAssignmentService/AssignmentDiscover"
+	StreamingCoordAssignmentService_UpdateReplicateConfiguration_FullMethodName     = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdateReplicateConfiguration"
+	StreamingCoordAssignmentService_ValidateReplicateConfiguration_FullMethodName   = "/milvus.proto.streaming.StreamingCoordAssignmentService/ValidateReplicateConfiguration"
+	StreamingCoordAssignmentService_PromoteSecondary_FullMethodName                 = "/milvus.proto.streaming.StreamingCoordAssignmentService/PromoteSecondary"
+	StreamingCoordAssignmentService_UpdateWALBalancePolicy_FullMethodName           = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdateWALBalancePolicy"
+	StreamingCoordAssignmentService_AssignmentDiscover_FullMethodName               = "/milvus.proto.streaming.StreamingCoordAssignmentService/AssignmentDiscover"
+	StreamingCoordAssignmentService_RenewPChannelLease_FullMethodName               = "/milvus.proto.streaming.StreamingCoordAssignmentService/RenewPChannelLease"
+	StreamingCoordAssignmentService_UpdatePChannelPools_FullMethodName              = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdatePChannelPools"
+	StreamingCoordAssignmentService_UpdatePChannelPins_FullMethodName               = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdatePChannelPins"
+	StreamingCoordAssignmentService_DrainNode_FullMethodName                        = "/milvus.proto.streaming.StreamingCoordAssignmentService/DrainNode"
+	StreamingCoordAssignmentService_GetAssignmentHistory_FullMethodName             = "/milvus.proto.streaming.StreamingCoordAssignmentService/GetAssignmentHistory"
+	StreamingCoordAssignmentService_UpdatePChannelAntiAffinityGroups_FullMethodName = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdatePChannelAntiAffinityGroups"
+	StreamingCoordAssignmentService_MoveControlChannel_FullMethodName               = "/milvus.proto.streaming.StreamingCoordAssignmentService/MoveControlChannel"
+	StreamingCoordAssignmentService_ExportState_FullMethodName                      = "/milvus.proto.streaming.StreamingCoordAssignmentService/ExportState"
+	StreamingCoordAssignmentService_UpdateReplicatingTaskState_FullMethodName       = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdateReplicatingTaskState"
+	StreamingCoordAssignmentService_ResetReplicateCheckpoint_FullMethodName         = "/milvus.proto.streaming.StreamingCoordAssignmentService/ResetReplicateCheckpoint"
+	StreamingCoordAssignmentService_UpdateReplicateClusterConnection_FullMethodName = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdateReplicateClusterConnection"
+	StreamingCoordAssignmentService_CheckReplicateSchemaConsistency_FullMethodName  = "/milvus.proto.streaming.StreamingCoordAssignmentService/CheckReplicateSchemaConsistency"
+	StreamingCoordAssignmentService_ListReplicatingTasks_FullMethodName             = "/milvus.proto.streaming.StreamingCoordAssignmentService/ListReplicatingTasks"
+	StreamingCoordAssignmentService_ListReplicateDeadLetters_FullMethodName         = "/milvus.proto.streaming.StreamingCoordAssignmentService/ListReplicateDeadLetters"
+	StreamingCoordAssignmentService_RequeueReplicateDeadLetters_FullMethodName      = "/milvus.proto.streaming.StreamingCoordAssignmentService/RequeueReplicateDeadLetters"
+	StreamingCoordAssignmentService_GetReplicateConfigurationHistory_FullMethodName = "/milvus.proto.streaming.StreamingCoordAssignmentService/GetReplicateConfigurationHistory"
+	StreamingCoordAssignmentService_GetClusterChannels_FullMethodName               = "/milvus.proto.streaming.StreamingCoordAssignmentService/GetClusterChannels"
+	StreamingCoordAssignmentService_UpdatePChannelRetentionPolicies_FullMethodName  = "/milvus.proto.streaming.StreamingCoordAssignmentService/UpdatePChannelRetentionPolicies"
+	StreamingCoordAssignmentService_GetPChannelRetentions_FullMethodName            = "/milvus.proto.streaming.StreamingCoordAssignmentService/GetPChannelRetentions"
+	StreamingCoordAssignmentService_MigratePChannelTopics_FullMethodName            = "/milvus.proto.streaming.StreamingCoordAssignmentService/MigratePChannelTopics"
 )
 
 // StreamingCoordAssignmentServiceClient is the client API for StreamingCoordAssignmentService service.
@@ -259,6 +475,17 @@ type StreamingCoordAssignmentServiceClient interface {
 	//   - The RPC is expected to be idempotent: submitting the same configuration
 	//     multiple times must not cause side effects.
 	UpdateReplicateConfiguration(ctx context.Context, in *UpdateReplicateConfigurationRequest, opts ...grpc.CallOption) (*UpdateReplicateConfigurationResponse, error)
+	// ValidateReplicateConfiguration validates the replicate configuration without applying it.
+	// The same validation as UpdateReplicateConfiguration is performed against the current configuration,
+	// so the conflicting topology can be rejected with a precise error before it's submitted.
+	ValidateReplicateConfiguration(ctx context.Context, in *ValidateReplicateConfigurationRequest, opts ...grpc.CallOption) (*ValidateReplicateConfigurationResponse, error)
+	// PromoteSecondary switches the primary of the replication from current cluster to a direct secondary cluster.
+	// The roles of current cluster and the secondary cluster are swapped in the CrossClusterTopology,
+	// and the new configuration is applied by a single AlterReplicateConfig message,
+	// which fences the writes on current cluster and makes the secondary cluster primary after it's replicated.
+	// The RPC returns after all replicating tasks to the secondary cluster confirm the switchover,
+	// so no replication lag is left. It's idempotent and can be retried after timeout.
+	PromoteSecondary(ctx context.Context, in *PromoteSecondaryRequest, opts ...grpc.CallOption) (*PromoteSecondaryResponse, error)
 	// Deprecated: Do not use.
 	// UpdateWALBalancePolicy is used to update the WAL balance policy.
 	// The policy is used to control the balance of the WAL.
@@ -267,6 +494,75 @@ type StreamingCoordAssignmentServiceClient interface {
 	// streamingcoord. Channel assignment information will be pushed to client
 	// by stream.
 	AssignmentDiscover(ctx context.Context, opts ...grpc.CallOption) (StreamingCoordAssignmentService_AssignmentDiscoverClient, error)
+	// RenewPChannelLease is used by the streamingnode to acknowledge the
+	// assignment of pchannels and renew the lease of the pchannels it holds.
+	// The streamingcoord will mark the pchannel as unavailable if the lease is
+	// not renewed in time.
+	RenewPChannelLease(ctx context.Context, in *RenewPChannelLeaseRequest, opts ...grpc.CallOption) (*RenewPChannelLeaseResponse, error)
+	// UpdatePChannelPools is used to create, update or drop the pchannel pools.
+	// An empty request can be used to list all pchannel pools.
+	UpdatePChannelPools(ctx context.Context, in *UpdatePChannelPoolsRequest, opts ...grpc.CallOption) (*UpdatePChannelPoolsResponse, error)
+	// UpdatePChannelPins is used to pin the pchannels to the streaming nodes or unpin them.
+	// An empty request can be used to list all pins.
+	UpdatePChannelPins(ctx context.Context, in *UpdatePChannelPinsRequest, opts ...grpc.CallOption) (*UpdatePChannelPinsResponse, error)
+	// DrainNode is used to drain the pchannels from a streaming node for maintenance.
+	// The node is frozen and the pchannels on it are moved away by balancer, respecting the reassign throttle.
+	// It can be called repeatedly to get the progress of draining.
+	DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...grpc.CallOption) (*DrainNodeResponse, error)
+	// GetAssignmentHistory is used to get the ownership history of a pchannel,
+	// to see which streaming node owned the pchannel at a given term.
+	GetAssignmentHistory(ctx context.Context, in *GetAssignmentHistoryRequest, opts ...grpc.CallOption) (*GetAssignmentHistoryResponse, error)
+	// UpdatePChannelAntiAffinityGroups is used to create, update or drop the pchannel anti-affinity groups.
+	// An empty request can be used to list all anti-affinity groups.
+	UpdatePChannelAntiAffinityGroups(ctx context.Context, in *UpdatePChannelAntiAffinityGroupsRequest, opts ...grpc.CallOption) (*UpdatePChannelAntiAffinityGroupsResponse, error)
+	// MoveControlChannel moves the control channel to another pchannel,
+	// e.g. the pchannel hosting the control channel becomes permanently unavailable.
+	// All DDL/DCL are fenced until the move is done.
+	MoveControlChannel(ctx context.Context, in *MoveControlChannelRequest, opts ...grpc.CallOption) (*MoveControlChannelResponse, error)
+	// ExportState exports a consistent snapshot of the channel manager state,
+	// which is used to build the support bundle.
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error)
+	// UpdateReplicatingTaskState pauses or resumes the replicating tasks to a target cluster,
+	// so the replication to a degraded target cluster can be stopped without editing the replicate configuration.
+	UpdateReplicatingTaskState(ctx context.Context, in *UpdateReplicatingTaskStateRequest, opts ...grpc.CallOption) (*UpdateReplicatingTaskStateResponse, error)
+	// ResetReplicateCheckpoint resets the start position of a replicating task,
+	// e.g. the target cluster is restored from backup and the stored checkpoint is wrong.
+	ResetReplicateCheckpoint(ctx context.Context, in *ResetReplicateCheckpointRequest, opts ...grpc.CallOption) (*ResetReplicateCheckpointResponse, error)
+	// UpdateReplicateClusterConnection updates the connection param of a cluster in the replicate configuration of current cluster,
+	// e.g. the endpoint of the target cluster is changed. The change is applied without broadcasting the AlterReplicateConfig message,
+	// and the cdc reconnects the target cluster with the new connection param.
+	UpdateReplicateClusterConnection(ctx context.Context, in *UpdateReplicateClusterConnectionRequest, opts ...grpc.CallOption) (*UpdateReplicateClusterConnectionResponse, error)
+	// CheckReplicateSchemaConsistency compares the collection schemas and vchannel mappings
+	// between current cluster and its target clusters in the replication topology, and reports the drifts,
+	// so the silent schema mismatch can be found before it fails the replication on the target cluster.
+	CheckReplicateSchemaConsistency(ctx context.Context, in *CheckReplicateSchemaConsistencyRequest, opts ...grpc.CallOption) (*CheckReplicateSchemaConsistencyResponse, error)
+	// ListReplicatingTasks lists the replicating tasks of current cluster with the progress confirmed by the target clusters,
+	// the runtime state, the streaming node of the source pchannel and the recent rejection of each task,
+	// so the stuck replication can be diagnosed without reading the meta from etcd or parsing the logs.
+	ListReplicatingTasks(ctx context.Context, in *ListReplicatingTasksRequest, opts ...grpc.CallOption) (*ListReplicatingTasksResponse, error)
+	// ListReplicateDeadLetters lists the dead letters of the replicating tasks,
+	// a replicating task is parked with a dead letter if the target cluster rejects its message for too many times.
+	ListReplicateDeadLetters(ctx context.Context, in *ListReplicateDeadLettersRequest, opts ...grpc.CallOption) (*ListReplicateDeadLettersResponse, error)
+	// RequeueReplicateDeadLetters removes the dead letters of the replicating tasks,
+	// so the parked tasks retry the rejected messages, e.g. after the missing schema is created on the target cluster.
+	RequeueReplicateDeadLetters(ctx context.Context, in *RequeueReplicateDeadLettersRequest, opts ...grpc.CallOption) (*RequeueReplicateDeadLettersResponse, error)
+	// GetReplicateConfigurationHistory gets the accepted replicate configurations of current cluster ordered by version,
+	// so the changes of the topology can be audited and a known-good version can be applied again to roll back.
+	GetReplicateConfigurationHistory(ctx context.Context, in *GetReplicateConfigurationHistoryRequest, opts ...grpc.CallOption) (*GetReplicateConfigurationHistoryResponse, error)
+	// GetClusterChannels gets the control channel and all pchannels of current cluster,
+	// so the pchannels of a cluster in the replicate configuration can be discovered instead of enumerated by the operator.
+	GetClusterChannels(ctx context.Context, in *GetClusterChannelsRequest, opts ...grpc.CallOption) (*GetClusterChannelsResponse, error)
+	// UpdatePChannelRetentionPolicies is used to set or clear the retention policies of the wal of pchannels.
+	// An empty request can be used to list all retention policies.
+	UpdatePChannelRetentionPolicies(ctx context.Context, in *UpdatePChannelRetentionPoliciesRequest, opts ...grpc.CallOption) (*UpdatePChannelRetentionPoliciesResponse, error)
+	// GetPChannelRetentions is used by the streamingnode to get the retention of the wal of pchannels,
+	// including the retention policy and the checkpoints confirmed by the replicating tasks from the pchannels.
+	// The streamingnode never truncates the wal beyond the retention.
+	GetPChannelRetentions(ctx context.Context, in *GetPChannelRetentionsRequest, opts ...grpc.CallOption) (*GetPChannelRetentionsResponse, error)
+	// MigratePChannelTopics migrates the wal of pchannels from current topic of mq to another topic,
+	// e.g. the cluster is moved to another pulsar namespace.
+	// The wal is fenced by an AlterWAL message, and switched to the new topic after all data is flushed.
+	MigratePChannelTopics(ctx context.Context, in *MigratePChannelTopicsRequest, opts ...grpc.CallOption) (*MigratePChannelTopicsResponse, error)
 }
 
 type streamingCoordAssignmentServiceClient struct {
@@ -286,6 +582,24 @@ func (c *streamingCoordAssignmentServiceClient) UpdateReplicateConfiguration(ctx
 	return out, nil
 }
 
+func (c *streamingCoordAssignmentServiceClient) ValidateReplicateConfiguration(ctx context.Context, in *ValidateReplicateConfigurationRequest, opts ...grpc.CallOption) (*ValidateReplicateConfigurationResponse, error) {
+	out := new(ValidateReplicateConfigurationResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_ValidateReplicateConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) PromoteSecondary(ctx context.Context, in *PromoteSecondaryRequest, opts ...grpc.CallOption) (*PromoteSecondaryResponse, error) {
+	out := new(PromoteSecondaryResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_PromoteSecondary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *streamingCoordAssignmentServiceClient) UpdateWALBalancePolicy(ctx context.Context, in *UpdateWALBalancePolicyRequest, opts ...grpc.CallOption) (*UpdateWALBalancePolicyResponse, error) {
 	out := new(UpdateWALBalancePolicyResponse)
@@ -327,6 +641,186 @@ func (x *streamingCoordAssignmentServiceAssignmentDiscoverClient) Recv() (*Assig
 	return m, nil
 }
 
+func (c *streamingCoordAssignmentServiceClient) RenewPChannelLease(ctx context.Context, in *RenewPChannelLeaseRequest, opts ...grpc.CallOption) (*RenewPChannelLeaseResponse, error) {
+	out := new(RenewPChannelLeaseResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_RenewPChannelLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) UpdatePChannelPools(ctx context.Context, in *UpdatePChannelPoolsRequest, opts ...grpc.CallOption) (*UpdatePChannelPoolsResponse, error) {
+	out := new(UpdatePChannelPoolsResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_UpdatePChannelPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) UpdatePChannelPins(ctx context.Context, in *UpdatePChannelPinsRequest, opts ...grpc.CallOption) (*UpdatePChannelPinsResponse, error) {
+	out := new(UpdatePChannelPinsResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_UpdatePChannelPins_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...grpc.CallOption) (*DrainNodeResponse, error) {
+	out := new(DrainNodeResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_DrainNode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) GetAssignmentHistory(ctx context.Context, in *GetAssignmentHistoryRequest, opts ...grpc.CallOption) (*GetAssignmentHistoryResponse, error) {
+	out := new(GetAssignmentHistoryResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_GetAssignmentHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) UpdatePChannelAntiAffinityGroups(ctx context.Context, in *UpdatePChannelAntiAffinityGroupsRequest, opts ...grpc.CallOption) (*UpdatePChannelAntiAffinityGroupsResponse, error) {
+	out := new(UpdatePChannelAntiAffinityGroupsResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_UpdatePChannelAntiAffinityGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) MoveControlChannel(ctx context.Context, in *MoveControlChannelRequest, opts ...grpc.CallOption) (*MoveControlChannelResponse, error) {
+	out := new(MoveControlChannelResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_MoveControlChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error) {
+	out := new(ExportStateResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_ExportState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) UpdateReplicatingTaskState(ctx context.Context, in *UpdateReplicatingTaskStateRequest, opts ...grpc.CallOption) (*UpdateReplicatingTaskStateResponse, error) {
+	out := new(UpdateReplicatingTaskStateResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_UpdateReplicatingTaskState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) ResetReplicateCheckpoint(ctx context.Context, in *ResetReplicateCheckpointRequest, opts ...grpc.CallOption) (*ResetReplicateCheckpointResponse, error) {
+	out := new(ResetReplicateCheckpointResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_ResetReplicateCheckpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) UpdateReplicateClusterConnection(ctx context.Context, in *UpdateReplicateClusterConnectionRequest, opts ...grpc.CallOption) (*UpdateReplicateClusterConnectionResponse, error) {
+	out := new(UpdateReplicateClusterConnectionResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_UpdateReplicateClusterConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) CheckReplicateSchemaConsistency(ctx context.Context, in *CheckReplicateSchemaConsistencyRequest, opts ...grpc.CallOption) (*CheckReplicateSchemaConsistencyResponse, error) {
+	out := new(CheckReplicateSchemaConsistencyResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_CheckReplicateSchemaConsistency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) ListReplicatingTasks(ctx context.Context, in *ListReplicatingTasksRequest, opts ...grpc.CallOption) (*ListReplicatingTasksResponse, error) {
+	out := new(ListReplicatingTasksResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_ListReplicatingTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) ListReplicateDeadLetters(ctx context.Context, in *ListReplicateDeadLettersRequest, opts ...grpc.CallOption) (*ListReplicateDeadLettersResponse, error) {
+	out := new(ListReplicateDeadLettersResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_ListReplicateDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) RequeueReplicateDeadLetters(ctx context.Context, in *RequeueReplicateDeadLettersRequest, opts ...grpc.CallOption) (*RequeueReplicateDeadLettersResponse, error) {
+	out := new(RequeueReplicateDeadLettersResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_RequeueReplicateDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) GetReplicateConfigurationHistory(ctx context.Context, in *GetReplicateConfigurationHistoryRequest, opts ...grpc.CallOption) (*GetReplicateConfigurationHistoryResponse, error) {
+	out := new(GetReplicateConfigurationHistoryResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_GetReplicateConfigurationHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) GetClusterChannels(ctx context.Context, in *GetClusterChannelsRequest, opts ...grpc.CallOption) (*GetClusterChannelsResponse, error) {
+	out := new(GetClusterChannelsResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_GetClusterChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) UpdatePChannelRetentionPolicies(ctx context.Context, in *UpdatePChannelRetentionPoliciesRequest, opts ...grpc.CallOption) (*UpdatePChannelRetentionPoliciesResponse, error) {
+	out := new(UpdatePChannelRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_UpdatePChannelRetentionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) GetPChannelRetentions(ctx context.Context, in *GetPChannelRetentionsRequest, opts ...grpc.CallOption) (*GetPChannelRetentionsResponse, error) {
+	out := new(GetPChannelRetentionsResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_GetPChannelRetentions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingCoordAssignmentServiceClient) MigratePChannelTopics(ctx context.Context, in *MigratePChannelTopicsRequest, opts ...grpc.CallOption) (*MigratePChannelTopicsResponse, error) {
+	out := new(MigratePChannelTopicsResponse)
+	err := c.cc.Invoke(ctx, StreamingCoordAssignmentService_MigratePChannelTopics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamingCoordAssignmentServiceServer is the server API for StreamingCoordAssignmentService service.
 // All implementations should embed UnimplementedStreamingCoordAssignmentServiceServer
 // for forward compatibility
@@ -342,6 +836,17 @@ type StreamingCoordAssignmentServiceServer interface {
 	//   - The RPC is expected to be idempotent: submitting the same configuration
 	//     multiple times must not cause side effects.
 	UpdateReplicateConfiguration(context.Context, *UpdateReplicateConfigurationRequest) (*UpdateReplicateConfigurationResponse, error)
+	// ValidateReplicateConfiguration validates the replicate configuration without applying it.
+	// The same validation as UpdateReplicateConfiguration is performed against the current configuration,
+	// so the conflicting topology can be rejected with a precise error before it's submitted.
+	ValidateReplicateConfiguration(context.Context, *ValidateReplicateConfigurationRequest) (*ValidateReplicateConfigurationResponse, error)
+	// PromoteSecondary switches the primary of the replication from current cluster to a direct secondary cluster.
+	// The roles of current cluster and the secondary cluster are swapped in the CrossClusterTopology,
+	// and the new configuration is applied by a single AlterReplicateConfig message,
+	// which fences the writes on current cluster and makes the secondary cluster primary after it's replicated.
+	// The RPC returns after all replicating tasks to the secondary cluster confirm the switchover,
+	// so no replication lag is left. It's idempotent and can be retried after timeout.
+	PromoteSecondary(context.Context, *PromoteSecondaryRequest) (*PromoteSecondaryResponse, error)
 	// Deprecated: Do not use.
 	// UpdateWALBalancePolicy is used to update the WAL balance policy.
 	// The policy is used to control the balance of the WAL.
@@ -350,93 +855,624 @@ type StreamingCoordAssignmentServiceServer interface {
 	// streamingcoord. Channel assignment information will be pushed to client
 	// by stream.
 	AssignmentDiscover(StreamingCoordAssignmentService_AssignmentDiscoverServer) error
+	// RenewPChannelLease is used by the streamingnode to acknowledge the
+	// assignment of pchannels and renew the lease of the pchannels it holds.
+	// The streamingcoord will mark the pchannel as unavailable if the lease is
+	// not renewed in time.
+	RenewPChannelLease(context.Context, *RenewPChannelLeaseRequest) (*RenewPChannelLeaseResponse, error)
+	// UpdatePChannelPools is used to create, update or drop the pchannel pools.
+	// An empty request can be used to list all pchannel pools.
+	UpdatePChannelPools(context.Context, *UpdatePChannelPoolsRequest) (*UpdatePChannelPoolsResponse, error)
+	// UpdatePChannelPins is used to pin the pchannels to the streaming nodes or unpin them.
+	// An empty request can be used to list all pins.
+	UpdatePChannelPins(context.Context, *UpdatePChannelPinsRequest) (*UpdatePChannelPinsResponse, error)
+	// DrainNode is used to drain the pchannels from a streaming node for maintenance.
+	// The node is frozen and the pchannels on it are moved away by balancer, respecting the reassign throttle.
+	// It can be called repeatedly to get the progress of draining.
+	DrainNode(context.Context, *DrainNodeRequest) (*DrainNodeResponse, error)
+	// GetAssignmentHistory is used to get the ownership history of a pchannel,
+	// to see which streaming node owned the pchannel at a given term.
+	GetAssignmentHistory(context.Context, *GetAssignmentHistoryRequest) (*GetAssignmentHistoryResponse, error)
+	// UpdatePChannelAntiAffinityGroups is used to create, update or drop the pchannel anti-affinity groups.
+	// An empty request can be used to list all anti-affinity groups.
+	UpdatePChannelAntiAffinityGroups(context.Context, *UpdatePChannelAntiAffinityGroupsRequest) (*UpdatePChannelAntiAffinityGroupsResponse, error)
+	// MoveControlChannel moves the control channel to another pchannel,
+	// e.g. the pchannel hosting the control channel becomes permanently unavailable.
+	// All DDL/DCL are fenced until the move is done.
+	MoveControlChannel(context.Context, *MoveControlChannelRequest) (*MoveControlChannelResponse, error)
+	// ExportState exports a consistent snapshot of the channel manager state,
+	// which is used to build the support bundle.
+	ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error)
+	// UpdateReplicatingTaskState pauses or resumes the replicating tasks to a target cluster,
+	// so the replication to a degraded target cluster can be stopped without editing the replicate configuration.
+	UpdateReplicatingTaskState(context.Context, *UpdateReplicatingTaskStateRequest) (*UpdateReplicatingTaskStateResponse, error)
+	// ResetReplicateCheckpoint resets the start position of a replicating task,
+	// e.g. the target cluster is restored from backup and the stored checkpoint is wrong.
+	ResetReplicateCheckpoint(context.Context, *ResetReplicateCheckpointRequest) (*ResetReplicateCheckpointResponse, error)
+	// UpdateReplicateClusterConnection updates the connection param of a cluster in the replicate configuration of current cluster,
+	// e.g. the endpoint of the target cluster is changed. The change is applied without broadcasting the AlterReplicateConfig message,
+	// and the cdc reconnects the target cluster with the new connection param.
+	UpdateReplicateClusterConnection(context.Context, *UpdateReplicateClusterConnectionRequest) (*UpdateReplicateClusterConnectionResponse, error)
+	// CheckReplicateSchemaConsistency compares the collection schemas and vchannel mappings
+	// between current cluster and its target clusters in the replication topology, and reports the drifts,
+	// so the silent schema mismatch can be found before it fails the replication on the target cluster.
+	CheckReplicateSchemaConsistency(context.Context, *CheckReplicateSchemaConsistencyRequest) (*CheckReplicateSchemaConsistencyResponse, error)
+	// ListReplicatingTasks lists the replicating tasks of current cluster with the progress confirmed by the target clusters,
+	// the runtime state, the streaming node of the source pchannel and the recent rejection of each task,
+	// so the stuck replication can be diagnosed without reading the meta from etcd or parsing the logs.
+	ListReplicatingTasks(context.Context, *ListReplicatingTasksRequest) (*ListReplicatingTasksResponse, error)
+	// ListReplicateDeadLetters lists the dead letters of the replicating tasks,
+	// a replicating task is parked with a dead letter if the target cluster rejects its message for too many times.
+	ListReplicateDeadLetters(context.Context, *ListReplicateDeadLettersRequest) (*ListReplicateDeadLettersResponse, error)
+	// RequeueReplicateDeadLetters removes the dead letters of the replicating tasks,
+	// so the parked tasks retry the rejected messages, e.g. after the missing schema is created on the target cluster.
+	RequeueReplicateDeadLetters(context.Context, *RequeueReplicateDeadLettersRequest) (*RequeueReplicateDeadLettersResponse, error)
+	// GetReplicateConfigurationHistory gets the accepted replicate configurations of current cluster ordered by version,
+	// so the changes of the topology can be audited and a known-good version can be applied again to roll back.
+	GetReplicateConfigurationHistory(context.Context, *GetReplicateConfigurationHistoryRequest) (*GetReplicateConfigurationHistoryResponse, error)
+	// GetClusterChannels gets the control channel and all pchannels of current cluster,
+	// so the pchannels of a cluster in the replicate configuration can be discovered instead of enumerated by the operator.
+	GetClusterChannels(context.Context, *GetClusterChannelsRequest) (*GetClusterChannelsResponse, error)
+	// UpdatePChannelRetentionPolicies is used to set or clear the retention policies of the wal of pchannels.
+	// An empty request can be used to list all retention policies.
+	UpdatePChannelRetentionPolicies(context.Context, *UpdatePChannelRetentionPoliciesRequest) (*UpdatePChannelRetentionPoliciesResponse, error)
+	// GetPChannelRetentions is used by the streamingnode to get the retention of the wal of pchannels,
+	// including the retention policy and the checkpoints confirmed by the replicating tasks from the pchannels.
+	// The streamingnode never truncates the wal beyond the retention.
+	GetPChannelRetentions(context.Context, *GetPChannelRetentionsRequest) (*GetPChannelRetentionsResponse, error)
+	// MigratePChannelTopics migrates the wal of pchannels from current topic of mq to another topic,
+	// e.g. the cluster is moved to another pulsar namespace.
+	// The wal is fenced by an AlterWAL message, and switched to the new topic after all data is flushed.
+	MigratePChannelTopics(context.Context, *MigratePChannelTopicsRequest) (*MigratePChannelTopicsResponse, error)
+}
+
+// UnimplementedStreamingCoordAssignmentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStreamingCoordAssignmentServiceServer struct {
+}
+
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdateReplicateConfiguration(context.Context, *UpdateReplicateConfigurationRequest) (*UpdateReplicateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplicateConfiguration not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) ValidateReplicateConfiguration(context.Context, *ValidateReplicateConfigurationRequest) (*ValidateReplicateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateReplicateConfiguration not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) PromoteSecondary(context.Context, *PromoteSecondaryRequest) (*PromoteSecondaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteSecondary not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdateWALBalancePolicy(context.Context, *UpdateWALBalancePolicyRequest) (*UpdateWALBalancePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWALBalancePolicy not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) AssignmentDiscover(StreamingCoordAssignmentService_AssignmentDiscoverServer) error {
+	return status.Errorf(codes.Unimplemented, "method AssignmentDiscover not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) RenewPChannelLease(context.Context, *RenewPChannelLeaseRequest) (*RenewPChannelLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewPChannelLease not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdatePChannelPools(context.Context, *UpdatePChannelPoolsRequest) (*UpdatePChannelPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePChannelPools not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdatePChannelPins(context.Context, *UpdatePChannelPinsRequest) (*UpdatePChannelPinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePChannelPins not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) DrainNode(context.Context, *DrainNodeRequest) (*DrainNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainNode not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) GetAssignmentHistory(context.Context, *GetAssignmentHistoryRequest) (*GetAssignmentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentHistory not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdatePChannelAntiAffinityGroups(context.Context, *UpdatePChannelAntiAffinityGroupsRequest) (*UpdatePChannelAntiAffinityGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePChannelAntiAffinityGroups not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) MoveControlChannel(context.Context, *MoveControlChannelRequest) (*MoveControlChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveControlChannel not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdateReplicatingTaskState(context.Context, *UpdateReplicatingTaskStateRequest) (*UpdateReplicatingTaskStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplicatingTaskState not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) ResetReplicateCheckpoint(context.Context, *ResetReplicateCheckpointRequest) (*ResetReplicateCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetReplicateCheckpoint not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdateReplicateClusterConnection(context.Context, *UpdateReplicateClusterConnectionRequest) (*UpdateReplicateClusterConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplicateClusterConnection not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) CheckReplicateSchemaConsistency(context.Context, *CheckReplicateSchemaConsistencyRequest) (*CheckReplicateSchemaConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReplicateSchemaConsistency not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) ListReplicatingTasks(context.Context, *ListReplicatingTasksRequest) (*ListReplicatingTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplicatingTasks not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) ListReplicateDeadLetters(context.Context, *ListReplicateDeadLettersRequest) (*ListReplicateDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplicateDeadLetters not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) RequeueReplicateDeadLetters(context.Context, *RequeueReplicateDeadLettersRequest) (*RequeueReplicateDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueReplicateDeadLetters not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) GetReplicateConfigurationHistory(context.Context, *GetReplicateConfigurationHistoryRequest) (*GetReplicateConfigurationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicateConfigurationHistory not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) GetClusterChannels(context.Context, *GetClusterChannelsRequest) (*GetClusterChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterChannels not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) UpdatePChannelRetentionPolicies(context.Context, *UpdatePChannelRetentionPoliciesRequest) (*UpdatePChannelRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePChannelRetentionPolicies not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) GetPChannelRetentions(context.Context, *GetPChannelRetentionsRequest) (*GetPChannelRetentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPChannelRetentions not implemented")
+}
+func (UnimplementedStreamingCoordAssignmentServiceServer) MigratePChannelTopics(context.Context, *MigratePChannelTopicsRequest) (*MigratePChannelTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigratePChannelTopics not implemented")
+}
+
+// UnsafeStreamingCoordAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamingCoordAssignmentServiceServer will
+// result in compilation errors.
+type UnsafeStreamingCoordAssignmentServiceServer interface {
+	mustEmbedUnimplementedStreamingCoordAssignmentServiceServer()
+}
+
+func RegisterStreamingCoordAssignmentServiceServer(s grpc.ServiceRegistrar, srv StreamingCoordAssignmentServiceServer) {
+	s.RegisterService(&StreamingCoordAssignmentService_ServiceDesc, srv)
+}
+
+func _StreamingCoordAssignmentService_UpdateReplicateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplicateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdateReplicateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicateConfiguration(ctx, req.(*UpdateReplicateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_ValidateReplicateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateReplicateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).ValidateReplicateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_ValidateReplicateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).ValidateReplicateConfiguration(ctx, req.(*ValidateReplicateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_PromoteSecondary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteSecondaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).PromoteSecondary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_PromoteSecondary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).PromoteSecondary(ctx, req.(*PromoteSecondaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_UpdateWALBalancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWALBalancePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateWALBalancePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdateWALBalancePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateWALBalancePolicy(ctx, req.(*UpdateWALBalancePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_AssignmentDiscover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamingCoordAssignmentServiceServer).AssignmentDiscover(&streamingCoordAssignmentServiceAssignmentDiscoverServer{stream})
+}
+
+type StreamingCoordAssignmentService_AssignmentDiscoverServer interface {
+	Send(*AssignmentDiscoverResponse) error
+	Recv() (*AssignmentDiscoverRequest, error)
+	grpc.ServerStream
+}
+
+type streamingCoordAssignmentServiceAssignmentDiscoverServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingCoordAssignmentServiceAssignmentDiscoverServer) Send(m *AssignmentDiscoverResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamingCoordAssignmentServiceAssignmentDiscoverServer) Recv() (*AssignmentDiscoverRequest, error) {
+	m := new(AssignmentDiscoverRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StreamingCoordAssignmentService_RenewPChannelLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewPChannelLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).RenewPChannelLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_RenewPChannelLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).RenewPChannelLease(ctx, req.(*RenewPChannelLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_UpdatePChannelPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePChannelPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdatePChannelPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelPools(ctx, req.(*UpdatePChannelPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_UpdatePChannelPins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePChannelPinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelPins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdatePChannelPins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelPins(ctx, req.(*UpdatePChannelPinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_DrainNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).DrainNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_DrainNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).DrainNode(ctx, req.(*DrainNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_GetAssignmentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).GetAssignmentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_GetAssignmentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).GetAssignmentHistory(ctx, req.(*GetAssignmentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_UpdatePChannelAntiAffinityGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePChannelAntiAffinityGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelAntiAffinityGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdatePChannelAntiAffinityGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelAntiAffinityGroups(ctx, req.(*UpdatePChannelAntiAffinityGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingCoordAssignmentService_MoveControlChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveControlChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).MoveControlChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_MoveControlChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).MoveControlChannel(ctx, req.(*MoveControlChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedStreamingCoordAssignmentServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedStreamingCoordAssignmentServiceServer struct {
+func _StreamingCoordAssignmentService_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_ExportState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).ExportState(ctx, req.(*ExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedStreamingCoordAssignmentServiceServer) UpdateReplicateConfiguration(context.Context, *UpdateReplicateConfigurationRequest) (*UpdateReplicateConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplicateConfiguration not implemented")
+func _StreamingCoordAssignmentService_UpdateReplicatingTaskState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplicatingTaskStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicatingTaskState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdateReplicatingTaskState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicatingTaskState(ctx, req.(*UpdateReplicatingTaskStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingCoordAssignmentServiceServer) UpdateWALBalancePolicy(context.Context, *UpdateWALBalancePolicyRequest) (*UpdateWALBalancePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWALBalancePolicy not implemented")
+
+func _StreamingCoordAssignmentService_ResetReplicateCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetReplicateCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).ResetReplicateCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_ResetReplicateCheckpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).ResetReplicateCheckpoint(ctx, req.(*ResetReplicateCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStreamingCoordAssignmentServiceServer) AssignmentDiscover(StreamingCoordAssignmentService_AssignmentDiscoverServer) error {
-	return status.Errorf(codes.Unimplemented, "method AssignmentDiscover not implemented")
+
+func _StreamingCoordAssignmentService_UpdateReplicateClusterConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplicateClusterConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicateClusterConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdateReplicateClusterConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicateClusterConnection(ctx, req.(*UpdateReplicateClusterConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeStreamingCoordAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StreamingCoordAssignmentServiceServer will
-// result in compilation errors.
-type UnsafeStreamingCoordAssignmentServiceServer interface {
-	mustEmbedUnimplementedStreamingCoordAssignmentServiceServer()
+func _StreamingCoordAssignmentService_CheckReplicateSchemaConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReplicateSchemaConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).CheckReplicateSchemaConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_CheckReplicateSchemaConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).CheckReplicateSchemaConsistency(ctx, req.(*CheckReplicateSchemaConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterStreamingCoordAssignmentServiceServer(s grpc.ServiceRegistrar, srv StreamingCoordAssignmentServiceServer) {
-	s.RegisterService(&StreamingCoordAssignmentService_ServiceDesc, srv)
+func _StreamingCoordAssignmentService_ListReplicatingTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicatingTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).ListReplicatingTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_ListReplicatingTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).ListReplicatingTasks(ctx, req.(*ListReplicatingTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingCoordAssignmentService_UpdateReplicateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateReplicateConfigurationRequest)
+func _StreamingCoordAssignmentService_ListReplicateDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicateDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicateConfiguration(ctx, in)
+		return srv.(StreamingCoordAssignmentServiceServer).ListReplicateDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingCoordAssignmentService_UpdateReplicateConfiguration_FullMethodName,
+		FullMethod: StreamingCoordAssignmentService_ListReplicateDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingCoordAssignmentServiceServer).UpdateReplicateConfiguration(ctx, req.(*UpdateReplicateConfigurationRequest))
+		return srv.(StreamingCoordAssignmentServiceServer).ListReplicateDeadLetters(ctx, req.(*ListReplicateDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingCoordAssignmentService_UpdateWALBalancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateWALBalancePolicyRequest)
+func _StreamingCoordAssignmentService_RequeueReplicateDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueReplicateDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StreamingCoordAssignmentServiceServer).UpdateWALBalancePolicy(ctx, in)
+		return srv.(StreamingCoordAssignmentServiceServer).RequeueReplicateDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StreamingCoordAssignmentService_UpdateWALBalancePolicy_FullMethodName,
+		FullMethod: StreamingCoordAssignmentService_RequeueReplicateDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StreamingCoordAssignmentServiceServer).UpdateWALBalancePolicy(ctx, req.(*UpdateWALBalancePolicyRequest))
+		return srv.(StreamingCoordAssignmentServiceServer).RequeueReplicateDeadLetters(ctx, req.(*RequeueReplicateDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StreamingCoordAssignmentService_AssignmentDiscover_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(StreamingCoordAssignmentServiceServer).AssignmentDiscover(&streamingCoordAssignmentServiceAssignmentDiscoverServer{stream})
+func _StreamingCoordAssignmentService_GetReplicateConfigurationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicateConfigurationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).GetReplicateConfigurationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_GetReplicateConfigurationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).GetReplicateConfigurationHistory(ctx, req.(*GetReplicateConfigurationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type StreamingCoordAssignmentService_AssignmentDiscoverServer interface {
-	Send(*AssignmentDiscoverResponse) error
-	Recv() (*AssignmentDiscoverRequest, error)
-	grpc.ServerStream
+func _StreamingCoordAssignmentService_GetClusterChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).GetClusterChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_GetClusterChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).GetClusterChannels(ctx, req.(*GetClusterChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type streamingCoordAssignmentServiceAssignmentDiscoverServer struct {
-	grpc.ServerStream
+func _StreamingCoordAssignmentService_UpdatePChannelRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePChannelRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_UpdatePChannelRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).UpdatePChannelRetentionPolicies(ctx, req.(*UpdatePChannelRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *streamingCoordAssignmentServiceAssignmentDiscoverServer) Send(m *AssignmentDiscoverResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _StreamingCoordAssignmentService_GetPChannelRetentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPChannelRetentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).GetPChannelRetentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_GetPChannelRetentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).GetPChannelRetentions(ctx, req.(*GetPChannelRetentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *streamingCoordAssignmentServiceAssignmentDiscoverServer) Recv() (*AssignmentDiscoverRequest, error) {
-	m := new(AssignmentDiscoverRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _StreamingCoordAssignmentService_MigratePChannelTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigratePChannelTopicsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(StreamingCoordAssignmentServiceServer).MigratePChannelTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingCoordAssignmentService_MigratePChannelTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingCoordAssignmentServiceServer).MigratePChannelTopics(ctx, req.(*MigratePChannelTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 // StreamingCoordAssignmentService_ServiceDesc is the grpc.ServiceDesc for StreamingCoordAssignmentService service.
@@ -450,10 +1486,98 @@ var StreamingCoordAssignmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateReplicateConfiguration",
 			Handler:    _StreamingCoordAssignmentService_UpdateReplicateConfiguration_Handler,
 		},
+		{
+			MethodName: "ValidateReplicateConfiguration",
+			Handler:    _StreamingCoordAssignmentService_ValidateReplicateConfiguration_Handler,
+		},
+		{
+			MethodName: "PromoteSecondary",
+			Handler:    _StreamingCoordAssignmentService_PromoteSecondary_Handler,
+		},
 		{
 			MethodName: "UpdateWALBalancePolicy",
 			Handler:    _StreamingCoordAssignmentService_UpdateWALBalancePolicy_Handler,
 		},
+		{
+			MethodName: "RenewPChannelLease",
+			Handler:    _StreamingCoordAssignmentService_RenewPChannelLease_Handler,
+		},
+		{
+			MethodName: "UpdatePChannelPools",
+			Handler:    _StreamingCoordAssignmentService_UpdatePChannelPools_Handler,
+		},
+		{
+			MethodName: "UpdatePChannelPins",
+			Handler:    _StreamingCoordAssignmentService_UpdatePChannelPins_Handler,
+		},
+		{
+			MethodName: "DrainNode",
+			Handler:    _StreamingCoordAssignmentService_DrainNode_Handler,
+		},
+		{
+			MethodName: "GetAssignmentHistory",
+			Handler:    _StreamingCoordAssignmentService_GetAssignmentHistory_Handler,
+		},
+		{
+			MethodName: "UpdatePChannelAntiAffinityGroups",
+			Handler:    _StreamingCoordAssignmentService_UpdatePChannelAntiAffinityGroups_Handler,
+		},
+		{
+			MethodName: "MoveControlChannel",
+			Handler:    _StreamingCoordAssignmentService_MoveControlChannel_Handler,
+		},
+		{
+			MethodName: "ExportState",
+			Handler:    _StreamingCoordAssignmentService_ExportState_Handler,
+		},
+		{
+			MethodName: "UpdateReplicatingTaskState",
+			Handler:    _StreamingCoordAssignmentService_UpdateReplicatingTaskState_Handler,
+		},
+		{
+			MethodName: "ResetReplicateCheckpoint",
+			Handler:    _StreamingCoordAssignmentService_ResetReplicateCheckpoint_Handler,
+		},
+		{
+			MethodName: "UpdateReplicateClusterConnection",
+			Handler:    _StreamingCoordAssignmentService_UpdateReplicateClusterConnection_Handler,
+		},
+		{
+			MethodName: "CheckReplicateSchemaConsistency",
+			Handler:    _StreamingCoordAssignmentService_CheckReplicateSchemaConsistency_Handler,
+		},
+		{
+			MethodName: "ListReplicatingTasks",
+			Handler:    _StreamingCoordAssignmentService_ListReplicatingTasks_Handler,
+		},
+		{
+			MethodName: "ListReplicateDeadLetters",
+			Handler:    _StreamingCoordAssignmentService_ListReplicateDeadLetters_Handler,
+		},
+		{
+			MethodName: "RequeueReplicateDeadLetters",
+			Handler:    _StreamingCoordAssignmentService_RequeueReplicateDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetReplicateConfigurationHistory",
+			Handler:    _StreamingCoordAssignmentService_GetReplicateConfigurationHistory_Handler,
+		},
+		{
+			MethodName: "GetClusterChannels",
+			Handler:    _StreamingCoordAssignmentService_GetClusterChannels_Handler,
+		},
+		{
+			MethodName: "UpdatePChannelRetentionPolicies",
+			Handler:    _StreamingCoordAssignmentService_UpdatePChannelRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "GetPChannelRetentions",
+			Handler:    _StreamingCoordAssignmentService_GetPChannelRetentions_Handler,
+		},
+		{
+			MethodName: "MigratePChannelTopics",
+			Handler:    _StreamingCoordAssignmentService_MigratePChannelTopics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -469,6 +1593,7 @@ var StreamingCoordAssignmentService_ServiceDesc = grpc.ServiceDesc{
 const (
 	StreamingNodeHandlerService_GetReplicateCheckpoint_FullMethodName = "/milvus.proto.streaming.StreamingNodeHandlerService/GetReplicateCheckpoint"
 	StreamingNodeHandlerService_GetSalvageCheckpoint_FullMethodName   = "/milvus.proto.streaming.StreamingNodeHandlerService/GetSalvageCheckpoint"
+	StreamingNodeHandlerService_TruncateWAL_FullMethodName            = "/milvus.proto.streaming.StreamingNodeHandlerService/TruncateWAL"
 	StreamingNodeHandlerService_Produce_FullMethodName                = "/milvus.proto.streaming.StreamingNodeHandlerService/Produce"
 	StreamingNodeHandlerService_Consume_FullMethodName                = "/milvus.proto.streaming.StreamingNodeHandlerService/Consume"
 )
@@ -483,6 +1608,9 @@ type StreamingNodeHandlerServiceClient interface {
 	// GetSalvageCheckpoint returns all salvage checkpoints captured during force promote.
 	// Returns an empty list if no force promote has occurred.
 	GetSalvageCheckpoint(ctx context.Context, in *GetSalvageCheckpointRequest, opts ...grpc.CallOption) (*GetSalvageCheckpointResponse, error)
+	// TruncateWAL truncates the wal of the pchannel to the given message id explicitly.
+	// It's rejected if the flusher or any replicating task of the wal doesn't consume beyond the message id.
+	TruncateWAL(ctx context.Context, in *TruncateWALRequest, opts ...grpc.CallOption) (*TruncateWALResponse, error)
 	// Produce is a bi-directional streaming RPC to send messages to a channel.
 	// All messages sent to a channel will be assigned a unique messageID.
 	// The messageID is used to identify the message in the channel.
@@ -526,6 +1654,15 @@ func (c *streamingNodeHandlerServiceClient) GetSalvageCheckpoint(ctx context.Con
 	return out, nil
 }
 
+func (c *streamingNodeHandlerServiceClient) TruncateWAL(ctx context.Context, in *TruncateWALRequest, opts ...grpc.CallOption) (*TruncateWALResponse, error) {
+	out := new(TruncateWALResponse)
+	err := c.cc.Invoke(ctx, StreamingNodeHandlerService_TruncateWAL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streamingNodeHandlerServiceClient) Produce(ctx context.Context, opts ...grpc.CallOption) (StreamingNodeHandlerService_ProduceClient, error) {
 	stream, err := c.cc.NewStream(ctx, &StreamingNodeHandlerService_ServiceDesc.Streams[0], StreamingNodeHandlerService_Produce_FullMethodName, opts...)
 	if err != nil {
@@ -598,6 +1735,9 @@ type StreamingNodeHandlerServiceServer interface {
 	// GetSalvageCheckpoint returns all salvage checkpoints captured during force promote.
 	// Returns an empty list if no force promote has occurred.
 	GetSalvageCheckpoint(context.Context, *GetSalvageCheckpointRequest) (*GetSalvageCheckpointResponse, error)
+	// TruncateWAL truncates the wal of the pchannel to the given message id explicitly.
+	// It's rejected if the flusher or any replicating task of the wal doesn't consume beyond the message id.
+	TruncateWAL(context.Context, *TruncateWALRequest) (*TruncateWALResponse, error)
 	// Produce is a bi-directional streaming RPC to send messages to a channel.
 	// All messages sent to a channel will be assigned a unique messageID.
 	// The messageID is used to identify the message in the channel.
@@ -625,6 +1765,9 @@ func (UnimplementedStreamingNodeHandlerServiceServer) GetReplicateCheckpoint(con
 func (UnimplementedStreamingNodeHandlerServiceServer) GetSalvageCheckpoint(context.Context, *GetSalvageCheckpointRequest) (*GetSalvageCheckpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSalvageCheckpoint not implemented")
 }
+func (UnimplementedStreamingNodeHandlerServiceServer) TruncateWAL(context.Context, *TruncateWALRequest) (*TruncateWALResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateWAL not implemented")
+}
 func (UnimplementedStreamingNodeHandlerServiceServer) Produce(StreamingNodeHandlerService_ProduceServer) error {
 	return status.Errorf(codes.Unimplemented, "method Produce not implemented")
 }
@@ -679,6 +1822,24 @@ func _StreamingNodeHandlerService_GetSalvageCheckpoint_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamingNodeHandlerService_TruncateWAL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateWALRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingNodeHandlerServiceServer).TruncateWAL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingNodeHandlerService_TruncateWAL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingNodeHandlerServiceServer).TruncateWAL(ctx, req.(*TruncateWALRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StreamingNodeHandlerService_Produce_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(StreamingNodeHandlerServiceServer).Produce(&streamingNodeHandlerServiceProduceServer{stream})
 }
@@ -746,6 +1907,10 @@ var StreamingNodeHandlerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSalvageCheckpoint",
 			Handler:    _StreamingNodeHandlerService_GetSalvageCheckpoint_Handler,
 		},
+		{
+			MethodName: "TruncateWAL",
+			Handler:    _StreamingNodeHandlerService_TruncateWAL_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -765,9 +1930,10 @@ var StreamingNodeHandlerService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StreamingNodeManagerService_Assign_FullMethodName        = "/milvus.proto.streaming.StreamingNodeManagerService/Assign"
-	StreamingNodeManagerService_Remove_FullMethodName        = "/milvus.proto.streaming.StreamingNodeManagerService/Remove"
-	StreamingNodeManagerService_CollectStatus_FullMethodName = "/milvus.proto.streaming.StreamingNodeManagerService/CollectStatus"
+	StreamingNodeManagerService_Assign_FullMethodName             = "/milvus.proto.streaming.StreamingNodeManagerService/Assign"
+	StreamingNodeManagerService_Remove_FullMethodName             = "/milvus.proto.streaming.StreamingNodeManagerService/Remove"
+	StreamingNodeManagerService_CollectStatus_FullMethodName      = "/milvus.proto.streaming.StreamingNodeManagerService/CollectStatus"
+	StreamingNodeManagerService_UpdateAppendQuotas_FullMethodName = "/milvus.proto.streaming.StreamingNodeManagerService/UpdateAppendQuotas"
 )
 
 // StreamingNodeManagerServiceClient is the client API for StreamingNodeManagerService service.
@@ -791,6 +1957,9 @@ type StreamingNodeManagerServiceClient interface {
 	// balance info on a log node. Used to recover channel info on log coord,
 	// collect balance info and health check.
 	CollectStatus(ctx context.Context, in *StreamingNodeManagerCollectStatusRequest, opts ...grpc.CallOption) (*StreamingNodeManagerCollectStatusResponse, error)
+	// UpdateAppendQuotas is unary RPC to push the append quotas of collections
+	// to a log node. The quotas are enforced by the wal when appending.
+	UpdateAppendQuotas(ctx context.Context, in *StreamingNodeManagerUpdateAppendQuotasRequest, opts ...grpc.CallOption) (*StreamingNodeManagerUpdateAppendQuotasResponse, error)
 }
 
 type streamingNodeManagerServiceClient struct {
@@ -828,6 +1997,15 @@ func (c *streamingNodeManagerServiceClient) CollectStatus(ctx context.Context, i
 	return out, nil
 }
 
+func (c *streamingNodeManagerServiceClient) UpdateAppendQuotas(ctx context.Context, in *StreamingNodeManagerUpdateAppendQuotasRequest, opts ...grpc.CallOption) (*StreamingNodeManagerUpdateAppendQuotasResponse, error) {
+	out := new(StreamingNodeManagerUpdateAppendQuotasResponse)
+	err := c.cc.Invoke(ctx, StreamingNodeManagerService_UpdateAppendQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamingNodeManagerServiceServer is the server API for StreamingNodeManagerService service.
 // All implementations should embed UnimplementedStreamingNodeManagerServiceServer
 // for forward compatibility
@@ -849,6 +2027,9 @@ type StreamingNodeManagerServiceServer interface {
 	// balance info on a log node. Used to recover channel info on log coord,
 	// collect balance info and health check.
 	CollectStatus(context.Context, *StreamingNodeManagerCollectStatusRequest) (*StreamingNodeManagerCollectStatusResponse, error)
+	// UpdateAppendQuotas is unary RPC to push the append quotas of collections
+	// to a log node. The quotas are enforced by the wal when appending.
+	UpdateAppendQuotas(context.Context, *StreamingNodeManagerUpdateAppendQuotasRequest) (*StreamingNodeManagerUpdateAppendQuotasResponse, error)
 }
 
 // UnimplementedStreamingNodeManagerServiceServer should be embedded to have forward compatible implementations.
@@ -864,6 +2045,9 @@ func (UnimplementedStreamingNodeManagerServiceServer) Remove(context.Context, *S
 func (UnimplementedStreamingNodeManagerServiceServer) CollectStatus(context.Context, *StreamingNodeManagerCollectStatusRequest) (*StreamingNodeManagerCollectStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectStatus not implemented")
 }
+func (UnimplementedStreamingNodeManagerServiceServer) UpdateAppendQuotas(context.Context, *StreamingNodeManagerUpdateAppendQuotasRequest) (*StreamingNodeManagerUpdateAppendQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppendQuotas not implemented")
+}
 
 // UnsafeStreamingNodeManagerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to StreamingNodeManagerServiceServer will
@@ -930,6 +2114,24 @@ func _StreamingNodeManagerService_CollectStatus_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamingNodeManagerService_UpdateAppendQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamingNodeManagerUpdateAppendQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingNodeManagerServiceServer).UpdateAppendQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingNodeManagerService_UpdateAppendQuotas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingNodeManagerServiceServer).UpdateAppendQuotas(ctx, req.(*StreamingNodeManagerUpdateAppendQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StreamingNodeManagerService_ServiceDesc is the grpc.ServiceDesc for StreamingNodeManagerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -949,6 +2151,10 @@ var StreamingNodeManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectStatus",
 			Handler:    _StreamingNodeManagerService_CollectStatus_Handler,
 		},
+		{
+			MethodName: "UpdateAppendQuotas",
+			Handler:    _StreamingNodeManagerService_UpdateAppendQuotas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "streaming.proto",
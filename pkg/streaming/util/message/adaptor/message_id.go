@@ -18,6 +18,7 @@ import (
 	msgkafka "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/tiered"
 	msgwoodpecker "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/wp"
 	"github.com/milvus-io/milvus/pkg/v3/util/merr"
 )
@@ -34,6 +35,8 @@ func MustGetMQWrapperIDFromMessage(messageID message.MessageID) common.MessageID
 		return mqkafka.NewKafkaID(int64(id.KafkaID()))
 	} else if id, ok := messageID.(interface{ WoodpeckerID() *rawWP.LogMessageId }); ok {
 		return mqwoodpecker.NewWoodpeckerID(id.WoodpeckerID())
+	} else if id, ok := messageID.(interface{ TieredID() (int64, uint64) }); ok {
+		return tiered.NewTieredID(id.TieredID()).(common.MessageID)
 	}
 	panic("unsupported now")
 }
@@ -47,6 +50,8 @@ func MustGetMQWrapperIDAndWALNameFromMessage(messageID message.MessageID) (commo
 		return mqkafka.NewKafkaID(int64(id.KafkaID())), commonpb.WALName_Kafka
 	} else if id, ok := messageID.(interface{ WoodpeckerID() *rawWP.LogMessageId }); ok {
 		return mqwoodpecker.NewWoodpeckerID(id.WoodpeckerID()), commonpb.WALName_WoodPecker
+	} else if id, ok := messageID.(interface{ TieredID() (int64, uint64) }); ok {
+		return tiered.NewTieredID(id.TieredID()).(common.MessageID), commonpb.WALName(message.WALNameTiered)
 	}
 	panic("unsupported now")
 }
@@ -62,6 +67,8 @@ func MustGetMessageIDFromMQWrapperID(commonMessageID common.MessageID) message.M
 		return msgkafka.NewKafkaID(rawKafka.Offset(id.MessageID))
 	} else if id, ok := commonMessageID.(interface{ WoodpeckerID() *rawWP.LogMessageId }); ok {
 		return msgwoodpecker.NewWpID(id.WoodpeckerID())
+	} else if id, ok := commonMessageID.(interface{ TieredID() (int64, uint64) }); ok {
+		return tiered.NewTieredID(id.TieredID())
 	}
 	return nil
 }
@@ -88,6 +95,12 @@ func DeserializeToMQWrapperID(msgID []byte, walName string) (common.MessageID, e
 			return nil, err
 		}
 		return mqwoodpecker.NewWoodpeckerID(wID), nil
+	case "tiered", message.WALNameTiered.String():
+		tID, err := tiered.DeserializeMessageID(msgID)
+		if err != nil {
+			return nil, err
+		}
+		return tID.(common.MessageID), nil
 	default:
 		return nil, merr.WrapErrParameterInvalidMsg("unsupported mq type %s", walName)
 	}
@@ -118,6 +131,12 @@ func MustGetMessageIDFromMQWrapperIDBytesWithWALName(walName message.WALName, ms
 			panic(err)
 		}
 		commonMsgID = mqwoodpecker.NewWoodpeckerID(msgID)
+	case message.WALNameTiered:
+		msgID, err := tiered.DeserializeMessageID(msgIDBytes)
+		if err != nil {
+			panic(err)
+		}
+		commonMsgID = msgID.(common.MessageID)
 	default:
 		panic("unsupported now")
 	}
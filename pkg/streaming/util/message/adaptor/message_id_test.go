@@ -7,9 +7,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	wp "github.com/zilliztech/woodpecker/woodpecker/log"
 
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
 	msgkafka "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/rmq"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/tiered"
 	msgwoodpecker "github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/wp"
 )
 
@@ -27,4 +29,14 @@ func TestIDConvension(t *testing.T) {
 	logMsgId := wp.EarliestLogMessageID()
 	wpID := MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(msgwoodpecker.NewWpID(&logMsgId)))
 	assert.True(t, wpID.EQ(msgwoodpecker.NewWpID(&logMsgId)))
+
+	tieredID := MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(tiered.NewTieredID(1, 2)))
+	assert.True(t, tieredID.EQ(tiered.NewTieredID(1, 2)))
+	commonID, walName := MustGetMQWrapperIDAndWALNameFromMessage(tiered.NewTieredID(1, 2))
+	assert.Equal(t, message.WALNameTiered, message.WALName(walName))
+	tieredID = MustGetMessageIDFromMQWrapperIDBytesWithWALName(message.WALNameTiered, commonID.Serialize())
+	assert.True(t, tieredID.EQ(tiered.NewTieredID(1, 2)))
+	commonID, err := DeserializeToMQWrapperID(commonID.Serialize(), message.WALNameTiered.String())
+	assert.NoError(t, err)
+	assert.True(t, MustGetMessageIDFromMQWrapperID(commonID).EQ(tiered.NewTieredID(1, 2)))
 }
@@ -180,6 +180,16 @@ func OptBuildBroadcastAckSyncUp() OptBuildBroadcast {
 	}
 }
 
+// OptBuildBroadcastBarrier sets the barrier of the broadcast message.
+// If set, the broadcaster allocates a barrier time tick before appending the message,
+// and the message at every vchannel is assigned a time tick not less than the barrier time tick.
+// So the messages with time tick less than the barrier time tick are always placed before the broadcast message at all vchannels.
+func OptBuildBroadcastBarrier() OptBuildBroadcast {
+	return func(bh *messagespb.BroadcastHeader) {
+		bh.Barrier = true
+	}
+}
+
 // WithBroadcast creates a new builder with broadcast property.
 // !!! This method should only be called from coordinator side.
 func (b *mutableMesasgeBuilder[H, B]) WithBroadcast(vchannels []string, opts ...OptBuildBroadcast) *mutableMesasgeBuilder[H, B] {
@@ -243,6 +253,34 @@ func (b *mutableMesasgeBuilder[H, B]) WithClusterLevelBroadcast(cc ClusterChanne
 	return b
 }
 
+// WithPChannelLevelBroadcast creates a new builder with pchannel-level broadcast property on part of the pchannels of cluster.
+// The control channel is substituted for the pchannel it resides on, same as WithClusterLevelBroadcast.
+// Panics if pchannels is empty or contains non-pchannel.
+// !!! This method should only be called from coordinator side.
+func (b *mutableMesasgeBuilder[H, B]) WithPChannelLevelBroadcast(cc ClusterChannels, pchannels []string, opts ...OptBuildBroadcast) *mutableMesasgeBuilder[H, B] {
+	if len(pchannels) == 0 {
+		panic("pchannels must not be empty")
+	}
+	if cc.ControlChannel == "" {
+		panic("ClusterChannels.ControlChannel must not be empty")
+	}
+
+	broadcastChannels := make([]string, 0, len(pchannels))
+	for _, ch := range pchannels {
+		if !funcutil.IsPhysicalChannel(ch) {
+			panic(fmt.Sprintf("pchannels contains non-pchannel %q", ch))
+		}
+		if funcutil.IsOnPhysicalChannel(cc.ControlChannel, ch) {
+			broadcastChannels = append(broadcastChannels, cc.ControlChannel)
+		} else {
+			broadcastChannels = append(broadcastChannels, ch)
+		}
+	}
+	b.WithBroadcast(broadcastChannels, opts...)
+	b.properties.Set(messagePChannelLevel, "")
+	return b
+}
+
 // WithAllVChannel creates a new builder with all vchannel property.
 func (b *mutableMesasgeBuilder[H, B]) WithAllVChannel() *mutableMesasgeBuilder[H, B] {
 	if b.properties.Exist(messageVChannel) || b.properties.Exist(messageBroadcastHeader) {
@@ -268,6 +306,13 @@ func (b *mutableMesasgeBuilder[H, B]) WithProperties(kvs map[string]string) *mut
 	return b
 }
 
+// WithProducerSequence creates a new builder with the producer sequence of the message.
+// The wal drops the duplicate append with the same producer sequence if the deduplication is enabled.
+func (b *mutableMesasgeBuilder[H, B]) WithProducerSequence(ps ProducerSequence) *mutableMesasgeBuilder[H, B] {
+	setProducerSequence(b.properties, ps)
+	return b
+}
+
 // WithCipher creates a new builder with cipher property.
 func (b *mutableMesasgeBuilder[H, B]) WithCipher(cipherConfig *CipherConfig) *mutableMesasgeBuilder[H, B] {
 	b.cipherConfig = cipherConfig
@@ -351,23 +396,9 @@ func (b *mutableMesasgeBuilder[H, B]) build() (*messageImpl, error) {
 			panic(fmt.Sprintf("the message type cannot enable cipher, %s", messageType))
 		}
 
-		cipher := mustGetCipher()
-		encryptor, safeKey, err := cipher.GetEncryptor(b.cipherConfig.EzID, b.cipherConfig.CollectionID)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get encryptor")
-		}
-		payloadBytes := len(payload)
-		if payload, err = encryptor.Encrypt(payload); err != nil {
-			return nil, errors.Wrap(err, "failed to encrypt payload")
-		}
-		ch, err := EncodeProto(&messagespb.CipherHeader{
-			EzId:         b.cipherConfig.EzID,
-			CollectionId: b.cipherConfig.CollectionID,
-			SafeKey:      safeKey,
-			PayloadBytes: int64(payloadBytes),
-		})
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to encode cipher header")
+		var ch string
+		if payload, ch, err = encryptPayload(b.cipherConfig, payload); err != nil {
+			return nil, err
 		}
 		b.properties.Set(messageCipherHeader, ch)
 	}
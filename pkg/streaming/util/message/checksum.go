@@ -0,0 +1,64 @@
+package message
+
+import (
+	"fmt"
+	"hash/crc32"
+	"strconv"
+)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// CorruptedMessageError is the error returned when the payload of a message read from the wal is corrupted.
+type CorruptedMessageError struct {
+	Channel   string
+	MessageID MessageID
+	Expected  uint32
+	Actual    uint32
+}
+
+// Error implements the error interface.
+func (e *CorruptedMessageError) Error() string {
+	return fmt.Sprintf("message is corrupted, channel: %s, messageID: %s, expected checksum: %d, actual checksum: %d",
+		e.Channel, e.MessageID, e.Expected, e.Actual)
+}
+
+// WithChecksum attaches the checksum of the payload into a mutable message.
+// The checksum is calculated on the payload to be written into the wal, so it should be called after the payload is encrypted.
+// If enabled is false, the checksum that may be carried by the message (e.g. replicated from another cluster) is removed.
+func WithChecksum(msg MutableMessage, enabled bool) MutableMessage {
+	impl, ok := msg.(*messageImpl)
+	if !ok {
+		return msg
+	}
+	if !enabled {
+		impl.properties.Delete(messageChecksum)
+		return impl
+	}
+	impl.properties.Set(messageChecksum, strconv.FormatUint(uint64(crc32.Checksum(impl.payload, castagnoliTable)), 10))
+	return impl
+}
+
+// VerifyChecksum verifies the checksum of the payload of an immutable message read from the wal of the channel.
+// The message without checksum (appended before the checksum is enabled) is always valid.
+// A *CorruptedMessageError is returned if the checksum is mismatched or malformed.
+func VerifyChecksum(channel string, msg ImmutableMessage) error {
+	impl, ok := msg.(*immutableMessageImpl)
+	if !ok {
+		return nil
+	}
+	value, ok := impl.properties.Get(messageChecksum)
+	if !ok {
+		return nil
+	}
+	actual := crc32.Checksum(impl.payload, castagnoliTable)
+	expected, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || uint32(expected) != actual {
+		return &CorruptedMessageError{
+			Channel:   channel,
+			MessageID: impl.MessageID(),
+			Expected:  uint32(expected),
+			Actual:    actual,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package message_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+)
+
+func TestChecksum(t *testing.T) {
+	msgID := walimplstest.NewTestMessageID(1)
+	toImmutable := func(msg message.MutableMessage, payload []byte) message.ImmutableMessage {
+		return message.NewImmutableMesasge(msgID, payload, msg.Properties().ToRawMap())
+	}
+
+	// the message without checksum is always valid.
+	msg := message.NewMutableMessageBeforeAppend([]byte("payload"), map[string]string{})
+	assert.NoError(t, message.VerifyChecksum("p1", toImmutable(msg, []byte("corrupted"))))
+
+	msg = message.WithChecksum(msg, true)
+	assert.True(t, msg.Properties().Exist("_crc"))
+	assert.NoError(t, message.VerifyChecksum("p1", toImmutable(msg, []byte("payload"))))
+
+	err := message.VerifyChecksum("p1", toImmutable(msg, []byte("corrupted")))
+	var corrupted *message.CorruptedMessageError
+	assert.ErrorAs(t, err, &corrupted)
+	assert.Equal(t, "p1", corrupted.Channel)
+	assert.True(t, corrupted.MessageID.EQ(msgID))
+	assert.NotEqual(t, corrupted.Expected, corrupted.Actual)
+	assert.Contains(t, err.Error(), "p1")
+
+	// the malformed checksum is treated as corruption.
+	im := message.NewImmutableMesasge(msgID, []byte("payload"), map[string]string{"_crc": "invalid"})
+	assert.ErrorAs(t, message.VerifyChecksum("p1", im), &corrupted)
+
+	// the checksum is removed if disabled.
+	msg = message.WithChecksum(msg, false)
+	assert.False(t, msg.Properties().Exist("_crc"))
+	assert.NoError(t, message.VerifyChecksum("p1", toImmutable(msg, []byte("corrupted"))))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/hook"
 	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 )
 
 // cipher is a global variable that is used to encrypt and decrypt messages.
@@ -107,3 +108,55 @@ type CipherConfig struct {
 	// Collection ID
 	CollectionID int64
 }
+
+// IsCipherMessage returns true if the payload of the message is encrypted.
+func IsCipherMessage(msg BasicMessage) bool {
+	return msg.Properties().Exist(messageCipherHeader)
+}
+
+// WithCipher encrypts the payload of a mutable message which is not encrypted yet with the cipher config.
+// It's used to encrypt the message which is not encrypted by its producer before it's handed to the underlying wal.
+func WithCipher(msg MutableMessage, cipherConfig *CipherConfig) (MutableMessage, error) {
+	if !msg.MessageType().CanEnableCipher() {
+		return nil, errors.Errorf("the message type cannot enable cipher, %s", msg.MessageType())
+	}
+	if IsCipherMessage(msg) {
+		return nil, errors.New("the message is already encrypted")
+	}
+	payload, ch, err := encryptPayload(cipherConfig, msg.Payload())
+	if err != nil {
+		return nil, err
+	}
+	if impl, ok := msg.(*messageImpl); ok {
+		impl.payload = payload
+		impl.properties.Set(messageCipherHeader, ch)
+		return impl, nil
+	}
+	properties := msg.Properties().ToRawMap()
+	properties[messageCipherHeader] = ch
+	return NewMutableMessageBeforeAppend(payload, properties), nil
+}
+
+// encryptPayload encrypts the payload with the cipher config,
+// and returns the encrypted payload and the encoded cipher header.
+func encryptPayload(cipherConfig *CipherConfig, payload []byte) ([]byte, string, error) {
+	encryptor, safeKey, err := mustGetCipher().GetEncryptor(cipherConfig.EzID, cipherConfig.CollectionID)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get encryptor")
+	}
+	payloadBytes := len(payload)
+	encrypted, err := encryptor.Encrypt(payload)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to encrypt payload")
+	}
+	ch, err := EncodeProto(&messagespb.CipherHeader{
+		EzId:         cipherConfig.EzID,
+		CollectionId: cipherConfig.CollectionID,
+		SafeKey:      safeKey,
+		PayloadBytes: int64(payloadBytes),
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to encode cipher header")
+	}
+	return encrypted, ch, nil
+}
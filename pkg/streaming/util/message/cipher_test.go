@@ -8,8 +8,11 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v3/hook"
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
+	"github.com/milvus-io/milvus/pkg/v3/mocks/github.com/milvus-io/milvus-proto/go-api/v3/mock_hook"
 )
 
 // mockCipher is a simple mock implementation for testing
@@ -285,3 +288,69 @@ func TestIsKmsKeyInvalidError(t *testing.T) {
 		})
 	}
 }
+
+func TestWithCipher(t *testing.T) {
+	origCipher := cipher
+	defer func() { cipher = origCipher }()
+
+	e := mock_hook.NewMockEncryptor(t)
+	e.EXPECT().Encrypt(mock.Anything).RunAndReturn(func(b []byte) ([]byte, error) {
+		return append([]byte("123"), b...), nil
+	})
+	d := mock_hook.NewMockDecryptor(t)
+	d.EXPECT().Decrypt(mock.Anything).RunAndReturn(func(b []byte) ([]byte, error) {
+		return b[3:], nil
+	})
+	cipher = &mockCipher{
+		getEncryptorFunc: func(ezID, collectionID int64) (hook.Encryptor, []byte, error) {
+			return e, []byte("safe-key"), nil
+		},
+		getDecryptorFunc: func(ezID, collectionID int64, safeKey []byte) (hook.Decryptor, error) {
+			return d, nil
+		},
+	}
+
+	msg := NewInsertMessageBuilderV1().
+		WithHeader(&InsertMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.InsertRequest{ShardName: "shard"}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	assert.False(t, IsCipherMessage(msg))
+
+	encrypted, err := WithCipher(msg, &CipherConfig{EzID: 1, CollectionID: 1})
+	assert.NoError(t, err)
+	assert.True(t, IsCipherMessage(encrypted))
+	insertMsg, err := AsMutableInsertMessageV1(encrypted)
+	assert.NoError(t, err)
+	body, err := insertMsg.Body()
+	assert.NoError(t, err)
+	assert.Equal(t, "shard", body.ShardName)
+
+	// the encrypted message cannot be encrypted again.
+	_, err = WithCipher(encrypted, &CipherConfig{EzID: 1, CollectionID: 1})
+	assert.Error(t, err)
+
+	// the message type which cannot enable cipher cannot be encrypted.
+	ttMsg := NewTimeTickMessageBuilderV1().
+		WithHeader(&TimeTickMessageHeader{}).
+		WithBody(&msgpb.TimeTickMsg{}).
+		WithAllVChannel().
+		MustBuildMutable()
+	_, err = WithCipher(ttMsg, &CipherConfig{EzID: 1, CollectionID: 1})
+	assert.Error(t, err)
+
+	// the error of encryptor is returned.
+	cipher = &mockCipher{
+		getEncryptorFunc: func(ezID, collectionID int64) (hook.Encryptor, []byte, error) {
+			return nil, nil, errors.New("test")
+		},
+	}
+	msg = NewInsertMessageBuilderV1().
+		WithHeader(&InsertMessageHeader{CollectionId: 1}).
+		WithBody(&msgpb.InsertRequest{}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	_, err = WithCipher(msg, &CipherConfig{EzID: 1, CollectionID: 1})
+	assert.Error(t, err)
+	assert.False(t, IsCipherMessage(msg))
+}
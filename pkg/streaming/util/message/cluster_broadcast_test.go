@@ -87,6 +87,43 @@ func TestWithClusterLevelBroadcastPanics(t *testing.T) {
 	})
 }
 
+func TestWithPChannelLevelBroadcast(t *testing.T) {
+	cc := ClusterChannels{
+		Channels:       []string{"pchannel1", "pchannel2", "pchannel3"},
+		ControlChannel: "pchannel1_vcchan",
+	}
+
+	msg := NewFlushAllMessageBuilderV2().
+		WithHeader(&FlushAllMessageHeader{}).
+		WithBody(&FlushAllMessageBody{}).
+		WithPChannelLevelBroadcast(cc, []string{"pchannel1", "pchannel3"}).
+		MustBuildBroadcast()
+	assert.True(t, msg.IsPChannelLevel())
+	assert.ElementsMatch(t, []string{"pchannel1_vcchan", "pchannel3"}, msg.BroadcastHeader().VChannels)
+
+	msg = NewFlushAllMessageBuilderV2().
+		WithHeader(&FlushAllMessageHeader{}).
+		WithBody(&FlushAllMessageBody{}).
+		WithPChannelLevelBroadcast(cc, []string{"pchannel2"}).
+		MustBuildBroadcast()
+	assert.ElementsMatch(t, []string{"pchannel2"}, msg.BroadcastHeader().VChannels)
+
+	assert.Panics(t, func() {
+		NewFlushAllMessageBuilderV2().
+			WithHeader(&FlushAllMessageHeader{}).
+			WithBody(&FlushAllMessageBody{}).
+			WithPChannelLevelBroadcast(cc, nil).
+			MustBuildBroadcast()
+	})
+	assert.Panics(t, func() {
+		NewFlushAllMessageBuilderV2().
+			WithHeader(&FlushAllMessageHeader{}).
+			WithBody(&FlushAllMessageBody{}).
+			WithPChannelLevelBroadcast(cc, []string{"pchannel2_100v0"}).
+			MustBuildBroadcast()
+	})
+}
+
 func TestPChannel(t *testing.T) {
 	t.Run("WithVChannel", func(t *testing.T) {
 		msg := &messageImpl{
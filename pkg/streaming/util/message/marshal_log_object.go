@@ -33,6 +33,9 @@ func (m *messageImpl) MarshalLogObject(enc mlog.ObjectEncoder) error {
 		enc.AddInt64("broadcastID", int64(broadcast.BroadcastID))
 		enc.AddString("broadcastVChannels", strings.Join(broadcast.VChannels, ","))
 		enc.AddBool("ackSyncUp", broadcast.AckSyncUp)
+		if broadcast.Barrier {
+			enc.AddBool("barrier", true)
+		}
 	}
 	if replicate := m.ReplicateHeader(); replicate != nil {
 		enc.AddString("rClusterID", replicate.ClusterID)
@@ -148,6 +148,10 @@ type BroadcastMutableMessage interface {
 	// OverwriteBroadcastHeader overwrites the broadcast header of the message.
 	OverwriteBroadcastHeader(broadcastID uint64, rks ...ResourceKey) BroadcastMutableMessage
 
+	// WithBroadcastBarrierTimeTick sets the barrier time tick of the broadcast message,
+	// it will be inherited by all the messages split from the broadcast message.
+	WithBroadcastBarrierTimeTick(tt uint64) BroadcastMutableMessage
+
 	// SplitIntoMutableMessage splits the broadcast message into multiple mutable messages.
 	// The broadcast id will be set into the properties of each message.
 	SplitIntoMutableMessage() []MutableMessage
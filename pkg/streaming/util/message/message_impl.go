@@ -229,6 +229,15 @@ func (m *messageImpl) OverwriteBroadcastHeader(id uint64, rks ...ResourceKey) Br
 	return m
 }
 
+// WithBroadcastBarrierTimeTick sets the barrier time tick of the broadcast message.
+func (m *messageImpl) WithBroadcastBarrierTimeTick(tt uint64) BroadcastMutableMessage {
+	if m.broadcastHeader() == nil {
+		panic("there's a bug in the message codes, broadcast header lost in properties of broadcast message")
+	}
+	m.properties.Set(messageBarrierTimeTick, EncodeUint64(tt))
+	return m
+}
+
 // IntoImmutableMessage converts current message to immutable message.
 func (m *messageImpl) IntoImmutableMessage(id MessageID) ImmutableMessage {
 	// payload and id is always immutable, so we only clone the prop here is ok.
@@ -64,6 +64,13 @@ func TestMessageType(t *testing.T) {
 	assert.False(t, MessageTypeCommitTxn.IsDMLMessageType())
 	assert.False(t, MessageTypeRollbackTxn.IsDMLMessageType())
 	assert.False(t, MessageTypeTimeTick.IsDMLMessageType())
+
+	assert.True(t, MessageTypeAlterUser.IsRBACMessageType())
+	assert.True(t, MessageTypeDropRole.IsRBACMessageType())
+	assert.True(t, MessageTypeAlterPrivilegeGroup.IsRBACMessageType())
+	assert.True(t, MessageTypeRestoreRBAC.IsRBACMessageType())
+	assert.False(t, MessageTypeCreateDatabase.IsRBACMessageType())
+	assert.False(t, MessageTypeInsert.IsRBACMessageType())
 }
 
 func TestVersion(t *testing.T) {
@@ -100,8 +107,25 @@ func TestBroadcast(t *testing.T) {
 	assert.ElementsMatch(t, []string{"v1", "v2"}, []string{msgs[0].VChannel(), msgs[1].VChannel()})
 	assert.True(t, msgs[0].BroadcastHeader().AckSyncUp)
 	assert.True(t, msgs[1].BroadcastHeader().AckSyncUp)
+	assert.False(t, msgs[0].BroadcastHeader().Barrier)
+	assert.Zero(t, msgs[0].BarrierTimeTick())
 
 	MustAsBroadcastCreateCollectionMessageV1(msg)
+
+	// the barrier time tick is inherited by all split messages.
+	msg, err = NewCreateCollectionMessageBuilderV1().
+		WithHeader(&CreateCollectionMessageHeader{}).
+		WithBody(&msgpb.CreateCollectionRequest{}).
+		WithBroadcast([]string{"v1", "v2"}, OptBuildBroadcastBarrier()).
+		BuildBroadcast()
+	assert.NoError(t, err)
+	assert.True(t, msg.BroadcastHeader().Barrier)
+	msgs = msg.WithBroadcastID(1).WithBroadcastBarrierTimeTick(100).SplitIntoMutableMessage()
+	assert.Len(t, msgs, 2)
+	for _, m := range msgs {
+		assert.True(t, m.BroadcastHeader().Barrier)
+		assert.Equal(t, uint64(100), m.BarrierTimeTick())
+	}
 }
 
 func TestCiper(t *testing.T) {
@@ -22,6 +22,8 @@ type MessageTypeProperties struct {
 	CipherEnabled bool
 	// A message type belong to some data operation, such as insert, delete, upsert, which may create a huge overhead if not limited.
 	DMLMessageType bool
+	// A message type belong to the user, role and privilege operation, which can be opted out from replication.
+	RBACMessageType bool
 }
 
 var messageTypePropertiesMap = map[MessageType]MessageTypeProperties{
@@ -97,29 +99,51 @@ var messageTypePropertiesMap = map[MessageType]MessageTypeProperties{
 	MessageTypeAlterCollection: {
 		ExclusiveRequired: true,
 	},
-	MessageTypeAlterLoadConfig:     {},
-	MessageTypeDropLoadConfig:      {},
-	MessageTypeCreateDatabase:      {},
-	MessageTypeAlterDatabase:       {},
-	MessageTypeDropDatabase:        {},
-	MessageTypeAlterAlias:          {},
-	MessageTypeDropAlias:           {},
-	MessageTypeAlterUser:           {},
-	MessageTypeDropUser:            {},
-	MessageTypeAlterRole:           {},
-	MessageTypeDropRole:            {},
-	MessageTypeAlterUserRole:       {},
-	MessageTypeDropUserRole:        {},
-	MessageTypeAlterPrivilege:      {},
-	MessageTypeDropPrivilege:       {},
-	MessageTypeAlterPrivilegeGroup: {},
-	MessageTypeDropPrivilegeGroup:  {},
-	MessageTypeRestoreRBAC:         {},
-	MessageTypeAlterResourceGroup:  {},
-	MessageTypeDropResourceGroup:   {},
-	MessageTypeCreateIndex:         {},
-	MessageTypeAlterIndex:          {},
-	MessageTypeDropIndex:           {},
+	MessageTypeAlterLoadConfig: {},
+	MessageTypeDropLoadConfig:  {},
+	MessageTypeCreateDatabase:  {},
+	MessageTypeAlterDatabase:   {},
+	MessageTypeDropDatabase:    {},
+	MessageTypeAlterAlias:      {},
+	MessageTypeDropAlias:       {},
+	MessageTypeAlterUser: {
+		RBACMessageType: true,
+	},
+	MessageTypeDropUser: {
+		RBACMessageType: true,
+	},
+	MessageTypeAlterRole: {
+		RBACMessageType: true,
+	},
+	MessageTypeDropRole: {
+		RBACMessageType: true,
+	},
+	MessageTypeAlterUserRole: {
+		RBACMessageType: true,
+	},
+	MessageTypeDropUserRole: {
+		RBACMessageType: true,
+	},
+	MessageTypeAlterPrivilege: {
+		RBACMessageType: true,
+	},
+	MessageTypeDropPrivilege: {
+		RBACMessageType: true,
+	},
+	MessageTypeAlterPrivilegeGroup: {
+		RBACMessageType: true,
+	},
+	MessageTypeDropPrivilegeGroup: {
+		RBACMessageType: true,
+	},
+	MessageTypeRestoreRBAC: {
+		RBACMessageType: true,
+	},
+	MessageTypeAlterResourceGroup: {},
+	MessageTypeDropResourceGroup:  {},
+	MessageTypeCreateIndex:        {},
+	MessageTypeAlterIndex:         {},
+	MessageTypeDropIndex:          {},
 	MessageTypeFlushAll: {
 		ExclusiveRequired: true,
 	},
@@ -172,6 +196,11 @@ func (t MessageType) IsDMLMessageType() bool {
 	return messageTypePropertiesMap[t].DMLMessageType
 }
 
+// IsRBACMessageType checks if the MessageType is a user, role or privilege operation message type.
+func (t MessageType) IsRBACMessageType() bool {
+	return messageTypePropertiesMap[t].RBACMessageType
+}
+
 // LogLevel returns the log level of the MessageType.
 func (t MessageType) LogLevel() mlog.Level {
 	return messageTypePropertiesMap[t].LogLevel
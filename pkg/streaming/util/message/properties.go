@@ -17,6 +17,10 @@ const (
 	messageNotPersisteted                   = "_np"  // check if the message is unpersisted.
 	messagePChannelLevel                    = "_pcl" // mark the message as pchannel level message.
 	messageReplicateMesssageHeader          = "_rh"  // replicate message header.
+	messageProducerID                       = "_pid" // producer id of the message, used to deduplicate the retried appends.
+	messageProducerEpoch                    = "_pep" // epoch of the producer of the message, used to fence the appends of the stale producer.
+	messageProducerSequence                 = "_psq" // sequence of the message in its producer, used to deduplicate the retried appends.
+	messageChecksum                         = "_crc" // crc32c checksum of the payload written into the wal, used to detect the corruption on scan.
 )
 
 var (
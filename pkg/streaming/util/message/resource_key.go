@@ -152,6 +152,15 @@ func NewExclusiveSnapshotNameResourceKey(collectionID int64, snapshotName string
 	}
 }
 
+// NewExclusiveResourceGroupResourceKey creates an exclusive resource group name resource key.
+func NewExclusiveResourceGroupResourceKey(rgName string) ResourceKey {
+	return ResourceKey{
+		Domain: messagespb.ResourceDomain_ResourceDomainResourceGroup,
+		Key:    rgName,
+		Shared: false,
+	}
+}
+
 // Deprecated: NewImportJobIDResourceKey creates a key for import job resource.
 func NewImportJobIDResourceKey(importJobID int64) ResourceKey {
 	return ResourceKey{
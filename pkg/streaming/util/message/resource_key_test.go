@@ -40,4 +40,9 @@ func TestResourceKey(t *testing.T) {
 	assert.Equal(t, rk.Domain, messagespb.ResourceDomain_ResourceDomainPrivilege)
 	assert.Equal(t, rk.Key, "")
 	assert.Equal(t, rk.Shared, false)
+
+	rk = NewExclusiveResourceGroupResourceKey("rg1")
+	assert.Equal(t, rk.Domain, messagespb.ResourceDomain_ResourceDomainResourceGroup)
+	assert.Equal(t, rk.Key, "rg1")
+	assert.Equal(t, rk.Shared, false)
 }
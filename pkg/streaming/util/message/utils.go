@@ -2,10 +2,15 @@ package message
 
 import (
 	"reflect"
+	"strconv"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// collectionIDFieldName is the field name of collection id in the specialized message header.
+const collectionIDFieldName protoreflect.Name = "collection_id"
+
 // AsImmutableTxnMessage converts an ImmutableMessage to ImmutableTxnMessage
 var AsImmutableTxnMessage = func(msg ImmutableMessage) ImmutableTxnMessage {
 	underlying, ok := msg.(*immutableTxnMessageImpl)
@@ -52,6 +57,80 @@ func MustGetMessageTypeWithVersion[H proto.Message, B proto.Message]() MessageTy
 	return mv
 }
 
+// GetCollectionIDOfMessage returns the collection id carried by the specialized header of the message.
+// Return false if the header of the message doesn't belong to a collection, e.g. the database or cluster level messages.
+func GetCollectionIDOfMessage(msg BasicMessage) (int64, bool) {
+	typ, ok := GetSerializeType(msg.MessageTypeWithVersion())
+	if !ok {
+		return 0, false
+	}
+	h, ok := msg.Properties().Get(messageHeader)
+	if !ok {
+		return 0, false
+	}
+	header := reflect.New(typ.HeaderType.Elem()).Interface().(proto.Message)
+	if err := DecodeProto(h, header); err != nil {
+		return 0, false
+	}
+	field := header.ProtoReflect().Descriptor().Fields().ByName(collectionIDFieldName)
+	if field == nil || field.Kind() != protoreflect.Int64Kind || field.IsList() {
+		return 0, false
+	}
+	collectionID := header.ProtoReflect().Get(field).Int()
+	return collectionID, collectionID != 0
+}
+
+// ProducerSequence identifies an append of a producer, the retries of the append share the same producer sequence.
+type ProducerSequence struct {
+	ProducerID string // the id of the producer, stable across the epochs of the producer.
+	Epoch      uint64 // the epoch of the producer, the appends of an older epoch are fenced by the wal once a newer epoch is seen.
+	Sequence   uint64 // the sequence of the append in the epoch of the producer, starts from 1.
+}
+
+// WithProducerSequence sets the producer sequence into a mutable message.
+// The wal drops the duplicate append with the same producer sequence if the deduplication is enabled.
+func WithProducerSequence(msg MutableMessage, ps ProducerSequence) MutableMessage {
+	if impl, ok := msg.(*messageImpl); ok {
+		setProducerSequence(impl.properties, ps)
+		return impl
+	}
+	raw := msg.Properties().ToRawMap()
+	setProducerSequence(propertiesImpl(raw), ps)
+	return NewMutableMessageBeforeAppend(msg.Payload(), raw)
+}
+
+// GetProducerSequenceOfMessage returns the producer sequence of the message.
+// Return false if the message is not produced with a producer sequence.
+// The epoch is 0 if the message is produced by an old client without epoch.
+func GetProducerSequenceOfMessage(msg BasicMessage) (ProducerSequence, bool) {
+	producerID, ok := msg.Properties().Get(messageProducerID)
+	if !ok || producerID == "" {
+		return ProducerSequence{}, false
+	}
+	value, ok := msg.Properties().Get(messageProducerSequence)
+	if !ok {
+		return ProducerSequence{}, false
+	}
+	sequence, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return ProducerSequence{}, false
+	}
+	var epoch uint64
+	if value, ok := msg.Properties().Get(messageProducerEpoch); ok {
+		if epoch, err = strconv.ParseUint(value, 10, 64); err != nil {
+			return ProducerSequence{}, false
+		}
+	}
+	return ProducerSequence{ProducerID: producerID, Epoch: epoch, Sequence: sequence}, true
+}
+
+// setProducerSequence sets the producer sequence into the properties.
+func setProducerSequence(properties propertiesImpl, ps ProducerSequence) {
+	properties.Set(messageProducerID, ps.ProducerID)
+	properties.Set(messageProducerEpoch, strconv.FormatUint(ps.Epoch, 10))
+	properties.Set(messageProducerSequence, strconv.FormatUint(ps.Sequence, 10))
+}
+
 // ReplicateHeader is the header of replicate message.
 type ReplicateHeader struct {
 	ClusterID              string
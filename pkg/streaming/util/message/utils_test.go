@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/msgpb"
 )
 
 func TestClearReplicateHeader(t *testing.T) {
@@ -40,3 +42,93 @@ func TestClearReplicateHeader(t *testing.T) {
 		assert.False(t, exists)
 	})
 }
+
+func TestGetCollectionIDOfMessage(t *testing.T) {
+	insert := NewInsertMessageBuilderV1().
+		WithHeader(&InsertMessageHeader{CollectionId: 100}).
+		WithBody(&msgpb.InsertRequest{}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	collectionID, ok := GetCollectionIDOfMessage(insert)
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), collectionID)
+
+	alterLoadConfig := NewAlterLoadConfigMessageBuilderV2().
+		WithHeader(&AlterLoadConfigMessageHeader{DbId: 1, CollectionId: 101}).
+		WithBody(&AlterLoadConfigMessageBody{}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	collectionID, ok = GetCollectionIDOfMessage(alterLoadConfig)
+	assert.True(t, ok)
+	assert.Equal(t, int64(101), collectionID)
+
+	// the database level message doesn't carry collection id.
+	createDatabase := NewCreateDatabaseMessageBuilderV2().
+		WithHeader(&CreateDatabaseMessageHeader{DbName: "db1", DbId: 1}).
+		WithBody(&CreateDatabaseMessageBody{}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	_, ok = GetCollectionIDOfMessage(createDatabase)
+	assert.False(t, ok)
+
+	alterDatabase := NewAlterDatabaseMessageBuilderV2().
+		WithHeader(&AlterDatabaseMessageHeader{DbName: "db1", DbId: 1}).
+		WithBody(&AlterDatabaseMessageBody{}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	_, ok = GetCollectionIDOfMessage(alterDatabase)
+	assert.False(t, ok)
+
+	timetick := NewTimeTickMessageBuilderV1().
+		WithHeader(&TimeTickMessageHeader{}).
+		WithBody(&msgpb.TimeTickMsg{}).
+		WithVChannel("v1").
+		MustBuildMutable()
+	_, ok = GetCollectionIDOfMessage(timetick)
+	assert.False(t, ok)
+}
+
+func TestProducerSequence(t *testing.T) {
+	msg := NewMutableMessageBeforeAppend([]byte("payload"), map[string]string{"key": "val"})
+	_, ok := GetProducerSequenceOfMessage(msg)
+	assert.False(t, ok)
+
+	msg = WithProducerSequence(msg, ProducerSequence{ProducerID: "producer", Epoch: 2, Sequence: 10})
+	ps, ok := GetProducerSequenceOfMessage(msg)
+	assert.True(t, ok)
+	assert.Equal(t, ProducerSequence{ProducerID: "producer", Epoch: 2, Sequence: 10}, ps)
+
+	msg = NewMutableMessageBeforeAppend([]byte("payload"), map[string]string{
+		messageProducerID:       "producer",
+		messageProducerSequence: "invalid",
+	})
+	_, ok = GetProducerSequenceOfMessage(msg)
+	assert.False(t, ok)
+
+	msg = NewMutableMessageBeforeAppend([]byte("payload"), map[string]string{
+		messageProducerID:       "producer",
+		messageProducerEpoch:    "invalid",
+		messageProducerSequence: "1",
+	})
+	_, ok = GetProducerSequenceOfMessage(msg)
+	assert.False(t, ok)
+
+	// the message produced by an old client without epoch.
+	msg = NewMutableMessageBeforeAppend([]byte("payload"), map[string]string{
+		messageProducerID:       "producer",
+		messageProducerSequence: "1",
+	})
+	ps, ok = GetProducerSequenceOfMessage(msg)
+	assert.True(t, ok)
+	assert.Equal(t, ProducerSequence{ProducerID: "producer", Sequence: 1}, ps)
+
+	msg = NewInsertMessageBuilderV1().
+		WithVChannel("v1").
+		WithHeader(&InsertMessageHeader{}).
+		WithBody(&msgpb.InsertRequest{}).
+		WithProducerSequence(ProducerSequence{ProducerID: "producer", Epoch: 1, Sequence: 11}).
+		MustBuildMutable()
+	ps, ok = GetProducerSequenceOfMessage(msg)
+	assert.True(t, ok)
+	assert.Equal(t, ProducerSequence{ProducerID: "producer", Epoch: 1, Sequence: 11}, ps)
+}
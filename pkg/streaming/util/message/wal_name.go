@@ -15,6 +15,9 @@ const (
 	WALNamePulsar     WALName = WALName(commonpb.WALName_Pulsar)
 	WALNameWoodpecker WALName = WALName(commonpb.WALName_WoodPecker)
 	WALNameTest       WALName = WALName(commonpb.WALName_Test)
+	// WALNameTiered is the tiered wal in front of the mq selected by mq.type.
+	// It's not defined in commonpb.WALName yet, proto3 keeps the unknown enum value on the wire.
+	WALNameTiered WALName = 100
 )
 
 var defaultWALName = atomic.NewPointer[WALName](nil)
@@ -54,6 +57,7 @@ var walNameMap = map[WALName]string{
 	WALNamePulsar:     "pulsar",
 	WALNameWoodpecker: "woodpecker",
 	WALNameTest:       "walimplstest",
+	WALNameTiered:     "tiered",
 }
 
 // String returns the string representation of the WALName.
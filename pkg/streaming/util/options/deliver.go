@@ -2,10 +2,14 @@ package options
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
 	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v3/util/typeutil"
 )
 
 const (
@@ -17,6 +21,7 @@ const (
 	DeliverFilterTypeTimeTickGT  deliverFilterType = 1
 	DeliverFilterTypeTimeTickGTE deliverFilterType = 2
 	DeliverFilterTypeMessageType deliverFilterType = 3
+	DeliverFilterTypeCollection  deliverFilterType = 4
 )
 
 type (
@@ -58,6 +63,17 @@ func DeliverPolicyStartAfter(messageID message.MessageID) DeliverPolicy {
 	}
 }
 
+// DeliverPolicyStartFromTimeTick delivers the message from the first message whose time tick is greater than or equal to the specified time tick.
+func DeliverPolicyStartFromTimeTick(timeTick uint64) DeliverPolicy {
+	return &streamingpb.DeliverPolicy{
+		Policy: &streamingpb.DeliverPolicy_StartFromTimeTick{
+			StartFromTimeTick: &streamingpb.DeliverPolicyStartFromTimeTick{
+				TimeTick: timeTick,
+			},
+		},
+	}
+}
+
 type DeliverFilter = *streamingpb.DeliverFilter
 
 //
@@ -104,6 +120,18 @@ func DeliverFilterMessageType(messageType ...message.MessageType) DeliverFilter
 	}
 }
 
+// DeliverFilterCollection delivers messages of the given collections.
+// The message that is not belong to any vchannel (e.g. time tick of pchannel) is always delivered.
+func DeliverFilterCollection(collectionIDs ...int64) DeliverFilter {
+	return &streamingpb.DeliverFilter{
+		Filter: &streamingpb.DeliverFilter_Collection{
+			Collection: &streamingpb.DeliverFilterCollection{
+				CollectionIds: collectionIDs,
+			},
+		},
+	}
+}
+
 // IsDeliverFilterTimeTick checks if the filter is time tick filter.
 func IsDeliverFilterTimeTick(filter DeliverFilter) bool {
 	switch filter.GetFilter().(type) {
@@ -151,6 +179,20 @@ func GetFilterFunc(filters []DeliverFilter) func(message.ImmutableMessage) bool
 				}
 				return false
 			})
+		case *streamingpb.DeliverFilter_Collection:
+			collectionIDs := typeutil.NewSet(filter.GetCollection().GetCollectionIds()...)
+			filterFuncs = append(filterFuncs, func(im message.ImmutableMessage) bool {
+				// the message of pchannel or control channel is shared by all collections, cannot be filtered.
+				vchannel := im.VChannel()
+				if vchannel == "" || funcutil.IsControlChannel(vchannel) {
+					return true
+				}
+				collectionID, ok := parseCollectionIDFromVChannel(vchannel)
+				if !ok {
+					return true
+				}
+				return collectionIDs.Contain(collectionID)
+			})
 		default:
 			panic("unimplemented")
 		}
@@ -164,3 +206,22 @@ func GetFilterFunc(filters []DeliverFilter) func(message.ImmutableMessage) bool
 		return true
 	}
 }
+
+// parseCollectionIDFromVChannel parses the collection id from the vchannel name formatted as `{pchannel}_{collectionID}v{idx}`.
+// It's called for every message on the scanning path, so the regexp of funcutil.GetCollectionIDFromVChannel is not used.
+func parseCollectionIDFromVChannel(vchannel string) (int64, bool) {
+	i := strings.LastIndex(vchannel, "_")
+	if i == -1 {
+		return 0, false
+	}
+	suffix := vchannel[i+1:]
+	j := strings.LastIndex(suffix, "v")
+	if j == -1 {
+		return 0, false
+	}
+	collectionID, err := strconv.ParseInt(suffix[:j], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return collectionID, true
+}
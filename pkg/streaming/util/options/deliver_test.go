@@ -25,6 +25,9 @@ func TestDeliverPolicy(t *testing.T) {
 
 	policy = DeliverPolicyStartAfter(messageID)
 	_ = policy.GetPolicy().(*streamingpb.DeliverPolicy_StartAfter)
+
+	policy = DeliverPolicyStartFromTimeTick(100)
+	assert.Equal(t, uint64(100), policy.GetStartFromTimeTick().GetTimeTick())
 }
 
 func TestDeliverFilter(t *testing.T) {
@@ -125,3 +128,23 @@ func TestNewMessageFilter(t *testing.T) {
 	msg.EXPECT().MessageType().Return(message.MessageTypeFlush).Maybe()
 	assert.False(t, filterFunc(msg))
 }
+
+func TestCollectionFilter(t *testing.T) {
+	filter := DeliverFilterCollection(1, 2)
+	assert.ElementsMatch(t, []int64{1, 2}, filter.GetCollection().GetCollectionIds())
+	filterFunc := GetFilterFunc([]DeliverFilter{filter})
+
+	cases := map[string]bool{
+		"":                              true,
+		"by-dev-rootcoord-dml_0":        true,
+		"by-dev-rootcoord-dml_0_1v0":    true,
+		"by-dev-rootcoord-dml_0_2v1":    true,
+		"by-dev-rootcoord-dml_0_3v0":    false,
+		"by-dev-rootcoord-dml_0_vcchan": true,
+	}
+	for vchannel, expected := range cases {
+		msg := mock_message.NewMockImmutableMessage(t)
+		msg.EXPECT().VChannel().Return(vchannel)
+		assert.Equal(t, expected, filterFunc(msg), vchannel)
+	}
+}
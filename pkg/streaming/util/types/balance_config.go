@@ -4,9 +4,33 @@ import "github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
 
 const (
 	UpdateMaskPathWALBalancePolicyAllowRebalance = "config.allow_rebalance"
+	UpdateMaskPathWALBalancePolicyBalanceFrozen  = "config.balance_frozen"
 )
 
 type (
-	UpdateWALBalancePolicyRequest  = streamingpb.UpdateWALBalancePolicyRequest
-	UpdateWALBalancePolicyResponse = streamingpb.UpdateWALBalancePolicyResponse
+	UpdateWALBalancePolicyRequest            = streamingpb.UpdateWALBalancePolicyRequest
+	UpdateWALBalancePolicyResponse           = streamingpb.UpdateWALBalancePolicyResponse
+	UpdatePChannelPoolsRequest               = streamingpb.UpdatePChannelPoolsRequest
+	UpdatePChannelPoolsResponse              = streamingpb.UpdatePChannelPoolsResponse
+	UpdatePChannelPinsRequest                = streamingpb.UpdatePChannelPinsRequest
+	UpdatePChannelPinsResponse               = streamingpb.UpdatePChannelPinsResponse
+	UpdatePChannelAntiAffinityGroupsRequest  = streamingpb.UpdatePChannelAntiAffinityGroupsRequest
+	UpdatePChannelAntiAffinityGroupsResponse = streamingpb.UpdatePChannelAntiAffinityGroupsResponse
+	MoveControlChannelRequest                = streamingpb.MoveControlChannelRequest
+	MoveControlChannelResponse               = streamingpb.MoveControlChannelResponse
+	ExportStateRequest                       = streamingpb.ExportStateRequest
+	ExportStateResponse                      = streamingpb.ExportStateResponse
+	UpdateReplicatingTaskStateRequest        = streamingpb.UpdateReplicatingTaskStateRequest
+	UpdateReplicatingTaskStateResponse       = streamingpb.UpdateReplicatingTaskStateResponse
+	ResetReplicateCheckpointRequest          = streamingpb.ResetReplicateCheckpointRequest
+	ResetReplicateCheckpointResponse         = streamingpb.ResetReplicateCheckpointResponse
+	UpdateReplicateClusterConnectionRequest  = streamingpb.UpdateReplicateClusterConnectionRequest
+	UpdateReplicateClusterConnectionResponse = streamingpb.UpdateReplicateClusterConnectionResponse
+	UpdatePChannelRetentionPoliciesRequest   = streamingpb.UpdatePChannelRetentionPoliciesRequest
+	UpdatePChannelRetentionPoliciesResponse  = streamingpb.UpdatePChannelRetentionPoliciesResponse
+	ReplicateConfigurationPlan               = streamingpb.ReplicateConfigurationPlan
+	DrainNodeRequest                         = streamingpb.DrainNodeRequest
+	DrainNodeResponse                        = streamingpb.DrainNodeResponse
+	GetAssignmentHistoryRequest              = streamingpb.GetAssignmentHistoryRequest
+	GetAssignmentHistoryResponse             = streamingpb.GetAssignmentHistoryResponse
 )
@@ -39,6 +39,7 @@ func NewPChannelInfoFromProto(pchannel *streamingpb.PChannelInfo) PChannelInfo {
 		Name:       pchannel.GetName(),
 		Term:       pchannel.GetTerm(),
 		AccessMode: accessMode,
+		Topic:      pchannel.GetTopic(),
 	}
 }
 
@@ -54,6 +55,7 @@ func NewProtoFromPChannelInfo(pchannel PChannelInfo) *streamingpb.PChannelInfo {
 		Name:       pchannel.Name,
 		Term:       pchannel.Term,
 		AccessMode: streamingpb.PChannelAccessMode(pchannel.AccessMode),
+		Topic:      pchannel.Topic,
 	}
 }
 
@@ -83,6 +85,15 @@ type PChannelInfo struct {
 	AccessMode AccessMode // Access mode, if AccessModeRO, the wal impls should be read-only, the append operation will panics.
 	// If accessMode is AccessModeRW, the wal impls should be read-write,
 	// and it will fence the old rw wal impls or wait the old rw wal impls close.
+	Topic string // topic of mq that backs the pchannel, the name of pchannel is used if empty.
+}
+
+// TopicName returns the topic of mq that backs the pchannel.
+func (c PChannelInfo) TopicName() string {
+	if c.Topic != "" {
+		return c.Topic
+	}
+	return c.Name
 }
 
 func (c PChannelInfo) ChannelID() ChannelID {
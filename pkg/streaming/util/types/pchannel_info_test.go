@@ -18,6 +18,12 @@ func TestPChannelInfo(t *testing.T) {
 	assert.Equal(t, info.Name, info2.Name)
 	assert.Equal(t, info.Term, info2.Term)
 	assert.Equal(t, info.AccessMode, info2.AccessMode)
+	assert.Equal(t, "pchannel", info2.TopicName())
+
+	info.Topic = "topic"
+	info2 = NewPChannelInfoFromProto(NewProtoFromPChannelInfo(info))
+	assert.Equal(t, "topic", info2.Topic)
+	assert.Equal(t, "topic", info2.TopicName())
 
 	assert.Panics(t, func() {
 		NewProtoFromPChannelInfo(PChannelInfo{Name: "", Term: 1})
@@ -51,8 +51,9 @@ func (v *VersionedStreamingNodeAssignments) PChannelOfCChannel() string {
 
 // StreamingNodeAssignment is the relation between server and channels.
 type StreamingNodeAssignment struct {
-	NodeInfo StreamingNodeInfo
-	Channels map[string]PChannelInfo
+	NodeInfo     StreamingNodeInfo
+	Channels     map[string]PChannelInfo
+	ReadReplicas map[string]PChannelInfo // the read-only replicas of the pchannels owned by other streaming nodes.
 }
 
 // NewStreamingNodeInfoFromProto creates a StreamingNodeInfo from proto.
@@ -18,9 +18,11 @@ type WALMetrics interface {
 
 // RWWALMetrics represents the WAL metrics for a read-write channel.
 type RWWALMetrics struct {
-	ChannelInfo      PChannelInfo
-	MVCCTimeTick     uint64
-	RecoveryTimeTick uint64
+	ChannelInfo          PChannelInfo
+	MVCCTimeTick         uint64
+	RecoveryTimeTick     uint64
+	AppendBytesPerSecond float64 // The average append throughput in recent window.
+	AppendBytesTotal     uint64  // The total appended bytes since the wal is opened.
 }
 
 // RecoveryLag returns the duration between the MVCCTimeTick and the RecoveryTimeTick.
@@ -56,9 +58,11 @@ func NewStreamingNodeBalanceAttrsFromProto(m *streamingpb.StreamingNodeMetrics)
 			}
 		case *streamingpb.StreamingNodeWALMetrics_Rw:
 			channels[ChannelID{Name: l.Info.Name}] = RWWALMetrics{
-				ChannelInfo:      NewPChannelInfoFromProto(l.Info),
-				MVCCTimeTick:     balanceAttr.Rw.MvccTimeTick,
-				RecoveryTimeTick: balanceAttr.Rw.RecoveryTimeTick,
+				ChannelInfo:          NewPChannelInfoFromProto(l.Info),
+				MVCCTimeTick:         balanceAttr.Rw.MvccTimeTick,
+				RecoveryTimeTick:     balanceAttr.Rw.RecoveryTimeTick,
+				AppendBytesPerSecond: balanceAttr.Rw.AppendBytesPerSecond,
+				AppendBytesTotal:     balanceAttr.Rw.AppendBytesTotal,
 			}
 		}
 	}
@@ -77,8 +81,10 @@ func NewProtoFromStreamingNodeMetrics(info StreamingNodeMetrics) *streamingpb.St
 				Info: NewProtoFromPChannelInfo(metrics.ChannelInfo),
 				Metrics: &streamingpb.StreamingNodeWALMetrics_Rw{
 					Rw: &streamingpb.StreamingNodeRWWALMetrics{
-						MvccTimeTick:     metrics.MVCCTimeTick,
-						RecoveryTimeTick: metrics.RecoveryTimeTick,
+						MvccTimeTick:         metrics.MVCCTimeTick,
+						RecoveryTimeTick:     metrics.RecoveryTimeTick,
+						AppendBytesPerSecond: metrics.AppendBytesPerSecond,
+						AppendBytesTotal:     metrics.AppendBytesTotal,
 					},
 				},
 			})
@@ -21,4 +21,13 @@ func TestWALMetrics(t *testing.T) {
 	assert.Equal(t, time.Second, rw.RecoveryLag())
 	rw.MVCCTimeTick = tsoutil.ComposeTSByTime(now.Add(-2*time.Second), 0)
 	assert.Zero(t, rw.RecoveryLag())
+
+	// The append traffic is kept after the proto round trip.
+	rw.ChannelInfo.Term = 1
+	rw.AppendBytesPerSecond = 1024
+	rw.AppendBytesTotal = 4096
+	metrics := NewStreamingNodeBalanceAttrsFromProto(NewProtoFromStreamingNodeMetrics(StreamingNodeMetrics{
+		WALMetrics: map[ChannelID]WALMetrics{rw.ChannelInfo.ChannelID(): rw},
+	}))
+	assert.Equal(t, rw, metrics.WALMetrics[rw.ChannelInfo.ChannelID()])
 }
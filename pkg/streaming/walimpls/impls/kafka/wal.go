@@ -13,7 +13,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
 )
 
-var _ walimpls.WALImpls = (*walImpl)(nil)
+var (
+	_ walimpls.WALImpls      = (*walImpl)(nil)
+	_ walimpls.BatchAppender = (*walImpl)(nil)
+)
 
 type walImpl struct {
 	*helper.WALHelper
@@ -26,38 +29,58 @@ func (w *walImpl) WALName() message.WALName {
 }
 
 func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	results := w.AppendBatch([]walimpls.BatchAppendRecord{{Ctx: ctx, Msg: msg}})
+	return results[0].MessageID, results[0].Err
+}
+
+// AppendBatch produces all messages before waiting for the delivery,
+// so the kafka producer can send them in one produce request.
+// Every message is delivered with its own delivery channel, so the failure of one message doesn't fail the others.
+func (w *walImpl) AppendBatch(records []walimpls.BatchAppendRecord) []walimpls.BatchAppendResult {
 	if w.Channel().AccessMode != types.AccessModeRW {
 		panic("write on a wal that is not in read-write mode")
 	}
 
-	pb := msg.IntoMessageProto()
-	properties := pb.Properties
-	headers := make([]kafka.Header, 0, len(properties))
-	for key, value := range properties {
-		header := kafka.Header{Key: key, Value: []byte(value)}
-		headers = append(headers, header)
-	}
-	ch := make(chan kafka.Event, 1)
-	topic := w.Channel().Name
-
-	if err := w.p.Produce(&kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
-		Value:          pb.Payload,
-		Headers:        headers,
-	}, ch); err != nil {
-		return nil, err
+	results := make([]walimpls.BatchAppendResult, len(records))
+	deliveries := make([]chan kafka.Event, len(records))
+	topic := w.Channel().TopicName()
+	for i, record := range records {
+		pb := record.Msg.IntoMessageProto()
+		properties := pb.Properties
+		headers := make([]kafka.Header, 0, len(properties))
+		for key, value := range properties {
+			header := kafka.Header{Key: key, Value: []byte(value)}
+			headers = append(headers, header)
+		}
+		ch := make(chan kafka.Event, 1)
+		if err := w.p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+			Value:          pb.Payload,
+			Headers:        headers,
+		}, ch); err != nil {
+			results[i].Err = err
+			continue
+		}
+		deliveries[i] = ch
 	}
 
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	case event := <-ch:
-		relatedMsg := event.(*kafka.Message)
-		if relatedMsg.TopicPartition.Error != nil {
-			return nil, relatedMsg.TopicPartition.Error
+	for i, ch := range deliveries {
+		if ch == nil {
+			continue
+		}
+		select {
+		case <-records[i].Ctx.Done():
+			results[i].Err = records[i].Ctx.Err()
+		case event := <-ch:
+			relatedMsg := event.(*kafka.Message)
+			if relatedMsg.TopicPartition.Error != nil {
+				results[i].Err = relatedMsg.TopicPartition.Error
+				continue
+			}
+			results[i].MessageID = kafkaID(relatedMsg.TopicPartition.Offset)
 		}
-		return kafkaID(relatedMsg.TopicPartition.Offset), nil
 	}
+	return results
 }
 
 func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls.ScannerImpls, err error) {
@@ -70,7 +93,7 @@ func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls
 		return nil, errors.Wrap(err, "failed to create kafka consumer")
 	}
 
-	topic := w.Channel().Name
+	topic := w.Channel().TopicName()
 	seekPosition := kafka.TopicPartition{
 		Topic:     &topic,
 		Partition: 0,
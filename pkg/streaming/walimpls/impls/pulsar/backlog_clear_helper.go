@@ -112,7 +112,7 @@ func (h *backlogClearHelper) getConsumer() (pulsar.Consumer, error) {
 	if h.reusedConsumer != nil {
 		return h.reusedConsumer, nil
 	}
-	topic := h.tenant.MustGetFullTopicName(h.channelName.Name)
+	topic := h.tenant.MustGetFullTopicName(h.channelName.TopicName())
 	consumer, err := h.c.Subscribe(pulsar.ConsumerOptions{
 		Topic:                    topic,
 		SubscriptionName:         backlogClearHelperName,
@@ -18,7 +18,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
 )
 
-var _ walimpls.WALImpls = (*walImpl)(nil)
+var (
+	_ walimpls.WALImpls      = (*walImpl)(nil)
+	_ walimpls.BatchAppender = (*walImpl)(nil)
+)
 
 type walImpl struct {
 	*helper.WALHelper
@@ -58,7 +61,7 @@ func (w *walImpl) initProducerAtBackground() {
 
 // initProducer initializes the producer.
 func (w *walImpl) initProducer() error {
-	topic := w.tenant.MustGetFullTopicName(w.Channel().Name)
+	topic := w.tenant.MustGetFullTopicName(w.Channel().TopicName())
 	p, err := w.c.CreateProducer(pulsar.ProducerOptions{
 		Topic: topic,
 		// TODO: current go pulsar client does not support fencing, we should enable it after go pulsar client supports it.
@@ -100,8 +103,69 @@ func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (messa
 	return pulsarID{id}, nil
 }
 
+// AppendBatch sends all messages asynchronously and flushes the producer,
+// so the pulsar producer can send them in one batch.
+// Every message is sent with its own context and gets its own result from the send callback.
+func (w *walImpl) AppendBatch(records []walimpls.BatchAppendRecord) []walimpls.BatchAppendResult {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+
+	results := make([]walimpls.BatchAppendResult, len(records))
+	sent := make([]chan walimpls.BatchAppendResult, len(records))
+	var p pulsar.Producer
+	var flushCtx context.Context
+	for i, record := range records {
+		if p == nil {
+			var err error
+			if p, err = w.p.GetWithContext(record.Ctx); err != nil {
+				results[i].Err = errors.Wrap(err, "get producer from future")
+				continue
+			}
+		}
+		ch := make(chan walimpls.BatchAppendResult, 1)
+		pb := record.Msg.IntoMessageProto()
+		p.SendAsync(record.Ctx, &pulsar.ProducerMessage{
+			Payload:    pb.Payload,
+			Properties: pb.Properties,
+		}, func(id pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+			if err != nil {
+				ch <- walimpls.BatchAppendResult{Err: err}
+				return
+			}
+			ch <- walimpls.BatchAppendResult{MessageID: pulsarID{id}}
+		})
+		sent[i] = ch
+		flushCtx = record.Ctx
+		// Observe the append traffic even if the message is not sent successfully.
+		w.backlogClearHelper.ObserveAppend(record.Msg.EstimateSize())
+	}
+	if flushCtx == nil {
+		return results
+	}
+	// The flush only triggers the sending of the pending batch,
+	// the result of every message is reported by its own send callback.
+	if err := p.FlushWithCtx(flushCtx); err != nil {
+		w.Log().RatedWarn(flushCtx, rate.Limit(1), "flush batch message to pulsar failed", mlog.Err(err))
+	}
+	for i, ch := range sent {
+		if ch == nil {
+			continue
+		}
+		select {
+		case <-records[i].Ctx.Done():
+			results[i].Err = records[i].Ctx.Err()
+		case results[i] = <-ch:
+		}
+		if results[i].Err != nil {
+			w.Log().RatedWarn(records[i].Ctx, rate.Limit(1), "send batch message to pulsar failed", mlog.Err(results[i].Err))
+		}
+	}
+	return results
+}
+
 func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls.ScannerImpls, err error) {
-	topic := w.tenant.MustGetFullTopicName(w.Channel().Name)
+	topic := w.tenant.MustGetFullTopicName(w.Channel().TopicName())
 	ch := make(chan pulsar.ReaderMessage, 1)
 	readerOpt := pulsar.ReaderOptions{
 		Topic:             topic,
@@ -145,7 +209,7 @@ func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
 		// The backlogClearHelper is always non-nil currently, so we can determine the truncate position
 		return nil
 	}
-	topic := w.tenant.MustGetFullTopicName(w.Channel().Name)
+	topic := w.tenant.MustGetFullTopicName(w.Channel().TopicName())
 	cursor, err := w.c.Subscribe(pulsar.ConsumerOptions{
 		Topic:                    topic,
 		SubscriptionName:         truncateCursorSubscriptionName,
@@ -25,7 +25,7 @@ func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimp
 	if opt.Channel.AccessMode == types.AccessModeRW {
 		var err error
 		if p, err = o.c.CreateProducer(client.ProducerOptions{
-			Topic: opt.Channel.Name,
+			Topic: opt.Channel.TopicName(),
 		}); err != nil {
 			return nil, err
 		}
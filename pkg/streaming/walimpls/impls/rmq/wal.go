@@ -17,7 +17,10 @@ import (
 
 const defaultReadAheadBufferSize = 1024
 
-var _ walimpls.WALImpls = (*walImpl)(nil)
+var (
+	_ walimpls.WALImpls      = (*walImpl)(nil)
+	_ walimpls.BatchAppender = (*walImpl)(nil)
+)
 
 // walImpl is the implementation of walimpls.WAL interface.
 type walImpl struct {
@@ -47,6 +50,44 @@ func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (messa
 	return rmqID(id), nil
 }
 
+// AppendBatch appends a batch of messages to the wal with one rocksmq produce.
+// The rocksmq produce is atomic, so all the messages that are not canceled share the same result.
+func (w *walImpl) AppendBatch(records []walimpls.BatchAppendRecord) []walimpls.BatchAppendResult {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	results := make([]walimpls.BatchAppendResult, len(records))
+	pending := make([]int, 0, len(records))
+	producerMsgs := make([]*common.ProducerMessage, 0, len(records))
+	for i, record := range records {
+		if err := record.Ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		pb := record.Msg.IntoMessageProto()
+		producerMsgs = append(producerMsgs, &common.ProducerMessage{
+			Payload:    pb.Payload,
+			Properties: pb.Properties,
+		})
+		pending = append(pending, i)
+	}
+	if len(pending) == 0 {
+		return results
+	}
+	ids, err := w.p.SendBatchForStreamingService(producerMsgs)
+	if err != nil {
+		w.Log().RatedWarn(records[pending[0]].Ctx, rate.Limit(1), "send batch message to rmq failed", mlog.Err(err))
+	}
+	for j, i := range pending {
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].MessageID = rmqID(ids[j])
+	}
+	return results
+}
+
 // Read create a scanner to read the wal.
 func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls.ScannerImpls, err error) {
 	scannerName := opt.Name
@@ -55,7 +96,7 @@ func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (s walimpls
 	}
 	receiveChannel := make(chan common.Message, opt.ReadAheadBufferSize)
 	consumerOption := client.ConsumerOptions{
-		Topic:                       w.Channel().Name,
+		Topic:                       w.Channel().TopicName(),
 		SubscriptionName:            scannerName,
 		SubscriptionInitialPosition: common.SubscriptionPositionUnknown,
 		MessageChannel:              receiveChannel,
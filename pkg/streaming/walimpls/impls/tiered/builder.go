@@ -0,0 +1,47 @@
+package tiered
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func init() {
+	// register the builder to the wal registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(message.WALNameTiered, UnmarshalMessageID)
+}
+
+// builderImpl is the builder for tiered wal.
+type builderImpl struct {
+	// remote is the wal of the remote mq, the mq selected by mq.type is used if it's unknown.
+	remote message.WALName
+}
+
+// Name returns the name of the wal.
+func (b *builderImpl) Name() message.WALName {
+	return message.WALNameTiered
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	remote := b.remote
+	if remote == message.WALNameUnknown {
+		remote = message.NewWALName(paramtable.Get().MQCfg.Type.GetValue())
+	}
+	if remote == message.WALNameUnknown || remote == message.WALNameTiered {
+		return nil, errors.Errorf("mq %s can not be the remote mq of tiered wal", paramtable.Get().MQCfg.Type.GetValue())
+	}
+	remoteOpener, err := registry.MustGetBuilder(remote).Build()
+	if err != nil {
+		return nil, err
+	}
+	return &openerImpl{
+		remote:    remoteOpener,
+		localPath: paramtable.Get().StreamingCfg.WALTieredLocalPath.GetValue(),
+	}, nil
+}
@@ -0,0 +1,313 @@
+package tiered
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+const (
+	segmentFileSuffix = ".log"
+	markerFileName    = "uploaded"
+)
+
+// segmentMeta is the meta of a segment file of the local log.
+type segmentMeta struct {
+	seq    uint64
+	size   int64
+	count  int
+	lastID tieredID
+}
+
+// uploadMarker is the last uploaded message of the local log and its position in the remote mq.
+type uploadMarker struct {
+	ID            string `json:"id"`
+	RemoteWALName int32  `json:"remote_wal_name"`
+	RemoteID      string `json:"remote_id"`
+}
+
+// localLog is the fsynced local write ahead log of a tiered wal.
+// The log is split into segment files, a segment file is removed after all its records are uploaded.
+type localLog struct {
+	mu             sync.Mutex
+	logger         *mlog.Logger
+	dir            string
+	segmentMaxSize int64
+	sealed         []*segmentMeta
+	active         *os.File
+	activeMeta     *segmentMeta
+	err            error // the sticky error, the log can not be written any more if the active segment is broken.
+}
+
+// openLocalLog opens the local log at dir and recovers the records kept in it.
+// A broken tail of the last segment file is truncated, it's the record that is not acknowledged before the crash.
+func openLocalLog(logger *mlog.Logger, dir string, segmentMaxSize int64) (*localLog, []record, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create local log dir")
+	}
+	seqs, err := listSegments(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	l := &localLog{
+		logger:         logger,
+		dir:            dir,
+		segmentMaxSize: segmentMaxSize,
+		sealed:         make([]*segmentMeta, 0, len(seqs)),
+	}
+	records := make([]record, 0)
+	nextSeq := uint64(0)
+	for i, seq := range seqs {
+		nextSeq = seq + 1
+		path := l.segmentPath(seq)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to read segment %s", path)
+		}
+		segmentRecords, valid, err := decodeRecords(data)
+		if err != nil {
+			if i != len(seqs)-1 {
+				return nil, nil, errors.Wrapf(err, "segment %s of local log is corrupted", path)
+			}
+			logger.Warn(context.TODO(), "truncate the broken tail of local log", mlog.String("segment", path), mlog.Int("valid", valid), mlog.Int("size", len(data)), mlog.Err(err))
+			if err := os.Truncate(path, int64(valid)); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to truncate segment %s", path)
+			}
+		}
+		if len(segmentRecords) == 0 {
+			if err := os.Remove(path); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to remove empty segment %s", path)
+			}
+			continue
+		}
+		l.sealed = append(l.sealed, &segmentMeta{
+			seq:    seq,
+			size:   int64(valid),
+			count:  len(segmentRecords),
+			lastID: segmentRecords[len(segmentRecords)-1].id,
+		})
+		records = append(records, segmentRecords...)
+	}
+	if err := l.createActiveSegment(nextSeq); err != nil {
+		return nil, nil, err
+	}
+	return l, records, nil
+}
+
+// append appends the records into the active segment and fsync it.
+func (l *localLog) append(records []record) error {
+	buf, err := encodeRecords(nil, records)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return l.err
+	}
+	if l.activeMeta.count > 0 && l.activeMeta.size+int64(len(buf)) > l.segmentMaxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	if _, err := l.active.Write(buf); err != nil {
+		return l.rollback(err)
+	}
+	if err := l.active.Sync(); err != nil {
+		return l.rollback(err)
+	}
+	l.activeMeta.size += int64(len(buf))
+	l.activeMeta.count += len(records)
+	l.activeMeta.lastID = records[len(records)-1].id
+	return nil
+}
+
+// rollback truncates the partial written records of the active segment.
+// The log is broken if the truncation fails, because the next record can not be appended after a partial one.
+func (l *localLog) rollback(err error) error {
+	if truncateErr := l.active.Truncate(l.activeMeta.size); truncateErr != nil {
+		l.err = errors.Wrapf(truncateErr, "local log is broken after write failure: %s", err.Error())
+		return l.err
+	}
+	if _, seekErr := l.active.Seek(l.activeMeta.size, 0); seekErr != nil {
+		l.err = errors.Wrapf(seekErr, "local log is broken after write failure: %s", err.Error())
+		return l.err
+	}
+	return errors.Wrap(err, "failed to write local log")
+}
+
+// removeUploaded removes the sealed segments whose records are all uploaded.
+// beforeRemove is called before any segment is removed, it's used to persist the upload marker.
+func (l *localLog) removeUploaded(uploaded tieredID, beforeRemove func() error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	removable := 0
+	for removable < len(l.sealed) && !uploaded.lt(l.sealed[removable].lastID) {
+		removable++
+	}
+	if removable == 0 {
+		return nil
+	}
+	if err := beforeRemove(); err != nil {
+		return err
+	}
+	for _, segment := range l.sealed[:removable] {
+		if err := os.Remove(l.segmentPath(segment.seq)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to remove uploaded segment %d", segment.seq)
+		}
+	}
+	l.sealed = l.sealed[removable:]
+	return nil
+}
+
+// close closes the local log, the active segment is removed if all its records are uploaded.
+// uploaded is nil if the upload marker is not persisted, the active segment is always kept then.
+func (l *localLog) close(uploaded *tieredID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.active.Close(); err != nil {
+		l.logger.Warn(context.TODO(), "failed to close the active segment of local log", mlog.Err(err))
+	}
+	if l.err == nil && (l.activeMeta.count == 0 || (uploaded != nil && !uploaded.lt(l.activeMeta.lastID))) {
+		if err := os.Remove(l.segmentPath(l.activeMeta.seq)); err != nil {
+			l.logger.Warn(context.TODO(), "failed to remove the uploaded active segment of local log", mlog.Err(err))
+		}
+	}
+}
+
+// rotate seals the active segment and creates a new one.
+func (l *localLog) rotate() error {
+	if err := l.active.Close(); err != nil {
+		return errors.Wrap(err, "failed to close the active segment")
+	}
+	l.sealed = append(l.sealed, l.activeMeta)
+	return l.createActiveSegment(l.activeMeta.seq + 1)
+}
+
+// createActiveSegment creates a new active segment file.
+func (l *localLog) createActiveSegment(seq uint64) error {
+	f, err := os.OpenFile(l.segmentPath(seq), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create segment of local log")
+	}
+	if err := syncDir(l.dir); err != nil {
+		f.Close()
+		return err
+	}
+	l.active = f
+	l.activeMeta = &segmentMeta{seq: seq}
+	return nil
+}
+
+// saveMarker persists the upload marker atomically.
+func (l *localLog) saveMarker(id tieredID, remoteID message.MessageID) error {
+	data, err := json.Marshal(uploadMarker{
+		ID:            id.Marshal(),
+		RemoteWALName: int32(remoteID.WALName()),
+		RemoteID:      remoteID.Marshal(),
+	})
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(l.dir, markerFileName+".tmp")
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create upload marker")
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to write upload marker")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "failed to sync upload marker")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "failed to close upload marker")
+	}
+	if err := os.Rename(tmp, filepath.Join(l.dir, markerFileName)); err != nil {
+		return errors.Wrap(err, "failed to rename upload marker")
+	}
+	return syncDir(l.dir)
+}
+
+// loadMarker loads the upload marker, nil is returned if there's no marker.
+func (l *localLog) loadMarker() (*tieredID, message.MessageID, error) {
+	data, err := os.ReadFile(filepath.Join(l.dir, markerFileName))
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read upload marker")
+	}
+	var marker uploadMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal upload marker")
+	}
+	id, err := unmarshalMessageID(marker.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	remoteID, err := message.UnmarshalMessageID(&commonpb.MessageID{
+		WALName: commonpb.WALName(marker.RemoteWALName),
+		Id:      marker.RemoteID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &id, remoteID, nil
+}
+
+func (l *localLog) segmentPath(seq uint64) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%020d%s", seq, segmentFileSuffix))
+}
+
+// listSegments lists the sequences of the segment files in dir by order.
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list local log dir")
+	}
+	seqs := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs, nil
+}
+
+// syncDir fsyncs the directory to persist the creation, removal and rename of files.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to open dir")
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync dir")
+	}
+	return nil
+}
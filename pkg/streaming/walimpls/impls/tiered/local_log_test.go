@@ -0,0 +1,119 @@
+package tiered
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+)
+
+func newTestRecords(term int64, from uint64, n int) []record {
+	records := make([]record, 0, n)
+	for i := 0; i < n; i++ {
+		records = append(records, record{
+			id: tieredID{term: term, offset: from + uint64(i)},
+			msg: &messagespb.Message{
+				Payload:    []byte(fmt.Sprintf("payload-%d", from+uint64(i))),
+				Properties: map[string]string{"id": fmt.Sprintf("%d", from+uint64(i))},
+			},
+		})
+	}
+	return records
+}
+
+func TestRecordCodec(t *testing.T) {
+	records := newTestRecords(1, 0, 3)
+	data, err := encodeRecords(nil, records)
+	require.NoError(t, err)
+
+	decoded, valid, err := decodeRecords(data)
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), valid)
+	assert.Len(t, decoded, 3)
+	for i := range records {
+		assert.Equal(t, records[i].id, decoded[i].id)
+		assert.Equal(t, records[i].msg.Payload, decoded[i].msg.Payload)
+		assert.Equal(t, records[i].msg.Properties, decoded[i].msg.Properties)
+	}
+
+	// incomplete tail.
+	decoded, valid, err = decodeRecords(data[:len(data)-1])
+	assert.ErrorIs(t, err, errCorruptedRecord)
+	assert.Len(t, decoded, 2)
+	assert.Less(t, valid, len(data))
+
+	// corrupted body.
+	corrupted := append([]byte{}, data...)
+	corrupted[recordHeaderSize] ^= 0xff
+	decoded, valid, err = decodeRecords(corrupted)
+	assert.ErrorIs(t, err, errCorruptedRecord)
+	assert.Empty(t, decoded)
+	assert.Equal(t, 0, valid)
+}
+
+func TestLocalLog(t *testing.T) {
+	dir := t.TempDir()
+	logger := mlog.With()
+
+	l, records, err := openLocalLog(logger, dir, 256)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	// every append is larger than half of the segment, so every append rotates the segment.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, l.append(newTestRecords(1, uint64(i*3), 3)))
+	}
+	assert.Len(t, l.sealed, 4)
+
+	// the crashed tail is truncated when the log is reopened.
+	f, err := os.OpenFile(l.segmentPath(l.activeMeta.seq), os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	l.close(nil)
+
+	l, records, err = openLocalLog(logger, dir, 256)
+	require.NoError(t, err)
+	assert.Len(t, records, 15)
+	for i, r := range records {
+		assert.Equal(t, tieredID{term: 1, offset: uint64(i)}, r.id)
+	}
+	assert.Len(t, l.sealed, 5)
+
+	// remove the uploaded segments, the marker is saved before the removal.
+	uploaded := tieredID{term: 1, offset: 7}
+	called := false
+	require.NoError(t, l.removeUploaded(uploaded, func() error {
+		called = true
+		return l.saveMarker(uploaded, tieredID{term: 100, offset: 100})
+	}))
+	assert.True(t, called)
+	assert.Len(t, l.sealed, 3)
+	l.close(&uploaded)
+
+	l, records, err = openLocalLog(logger, dir, 256)
+	require.NoError(t, err)
+	assert.Len(t, records, 9)
+	assert.Equal(t, tieredID{term: 1, offset: 6}, records[0].id)
+	markerID, remoteID, err := l.loadMarker()
+	require.NoError(t, err)
+	assert.Equal(t, uploaded, *markerID)
+	assert.Equal(t, tieredID{term: 100, offset: 100}, remoteID)
+
+	// all records are uploaded, the log is empty after close.
+	uploaded = tieredID{term: 1, offset: 14}
+	require.NoError(t, l.removeUploaded(uploaded, func() error { return l.saveMarker(uploaded, tieredID{term: 100, offset: 101}) }))
+	l.close(&uploaded)
+	seqs, err := listSegments(dir)
+	require.NoError(t, err)
+	assert.Empty(t, seqs)
+	_, err = os.Stat(filepath.Join(dir, markerFileName))
+	assert.NoError(t, err)
+}
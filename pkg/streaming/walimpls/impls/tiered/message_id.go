@@ -0,0 +1,159 @@
+package tiered
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/mq/common"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+var (
+	_ message.MessageID = tieredID{}
+	_ common.MessageID  = tieredID{}
+)
+
+const tieredIDSize = 16
+
+// NewTieredID creates a new message id of tiered wal.
+func NewTieredID(term int64, offset uint64) message.MessageID {
+	return tieredID{term: term, offset: offset}
+}
+
+// UnmarshalMessageID unmarshal the message id.
+func UnmarshalMessageID(data string) (message.MessageID, error) {
+	id, err := unmarshalMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// unmarshalMessageID unmarshal the message id from the string form "<term>/<offset>".
+func unmarshalMessageID(data string) (tieredID, error) {
+	term, offset, ok := strings.Cut(data, "/")
+	if !ok {
+		return tieredID{}, errors.Wrapf(message.ErrInvalidMessageID, "decode tieredID fail, id: %s", data)
+	}
+	t, err := message.DecodeInt64(term)
+	if err != nil {
+		return tieredID{}, errors.Wrapf(message.ErrInvalidMessageID, "decode tieredID term fail with err: %s, id: %s", err.Error(), data)
+	}
+	o, err := message.DecodeUint64(offset)
+	if err != nil {
+		return tieredID{}, errors.Wrapf(message.ErrInvalidMessageID, "decode tieredID offset fail with err: %s, id: %s", err.Error(), data)
+	}
+	return tieredID{term: t, offset: o}, nil
+}
+
+// DeserializeMessageID deserializes the message id from the bytes kept in the msg position.
+func DeserializeMessageID(data []byte) (message.MessageID, error) {
+	id, err := deserializeMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+func deserializeMessageID(data []byte) (tieredID, error) {
+	if len(data) != tieredIDSize {
+		return tieredID{}, errors.Wrapf(message.ErrInvalidMessageID, "deserialize tieredID fail, size: %d", len(data))
+	}
+	return tieredID{
+		term:   int64(binary.BigEndian.Uint64(data[:8])),
+		offset: binary.BigEndian.Uint64(data[8:]),
+	}, nil
+}
+
+// tieredID is the message id of tiered wal.
+// The offset is assigned by the wal of the term, and restarts from 0 when the pchannel is opened by a new term,
+// so the message id is always increasing without the help of the remote mq.
+type tieredID struct {
+	term   int64
+	offset uint64
+}
+
+// TieredID returns the term and offset of the message id.
+// Don't delete this function until conversion logic removed.
+func (id tieredID) TieredID() (int64, uint64) {
+	return id.term, id.offset
+}
+
+// WALName returns the name of message id related wal.
+func (id tieredID) WALName() message.WALName {
+	return message.WALNameTiered
+}
+
+// LT less than.
+func (id tieredID) LT(other message.MessageID) bool {
+	return id.lt(other.(tieredID))
+}
+
+// LTE less than or equal to.
+func (id tieredID) LTE(other message.MessageID) bool {
+	return !other.(tieredID).lt(id)
+}
+
+// EQ Equal to.
+func (id tieredID) EQ(other message.MessageID) bool {
+	return id == other.(tieredID)
+}
+
+func (id tieredID) lt(other tieredID) bool {
+	if id.term != other.term {
+		return id.term < other.term
+	}
+	return id.offset < other.offset
+}
+
+// Marshal marshal the message id.
+func (id tieredID) Marshal() string {
+	return message.EncodeInt64(id.term) + "/" + message.EncodeUint64(id.offset)
+}
+
+// IntoProto marshal the message id to proto.
+func (id tieredID) IntoProto() *commonpb.MessageID {
+	return &commonpb.MessageID{
+		Id:      id.Marshal(),
+		WALName: commonpb.WALName(id.WALName()),
+	}
+}
+
+func (id tieredID) String() string {
+	return fmt.Sprintf("%d/%d", id.term, id.offset)
+}
+
+// Serialize serializes the message id into the bytes kept in the msg position.
+func (id tieredID) Serialize() []byte {
+	data := make([]byte, tieredIDSize)
+	binary.BigEndian.PutUint64(data[:8], uint64(id.term))
+	binary.BigEndian.PutUint64(data[8:], id.offset)
+	return data
+}
+
+// AtEarliestPosition returns true if the message id is the earliest position of the wal.
+func (id tieredID) AtEarliestPosition() bool {
+	return id == tieredID{}
+}
+
+// LessOrEqualThan returns true if the message id is less than or equal to the serialized one.
+func (id tieredID) LessOrEqualThan(msgID []byte) (bool, error) {
+	other, err := deserializeMessageID(msgID)
+	if err != nil {
+		return false, err
+	}
+	return !other.lt(id), nil
+}
+
+// Equal returns true if the message id is equal to the serialized one.
+func (id tieredID) Equal(msgID []byte) (bool, error) {
+	other, err := deserializeMessageID(msgID)
+	if err != nil {
+		return false, err
+	}
+	return id == other, nil
+}
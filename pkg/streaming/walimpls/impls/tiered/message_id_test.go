@@ -0,0 +1,54 @@
+package tiered
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+)
+
+func TestMessageID(t *testing.T) {
+	term, offset := message.MessageID(tieredID{term: 1, offset: 2}).(interface{ TieredID() (int64, uint64) }).TieredID()
+	assert.Equal(t, int64(1), term)
+	assert.Equal(t, uint64(2), offset)
+	assert.Equal(t, message.WALNameTiered, tieredID{}.WALName())
+
+	assert.True(t, tieredID{term: 1, offset: 1}.LT(tieredID{term: 1, offset: 2}))
+	assert.True(t, tieredID{term: 1, offset: 100}.LT(tieredID{term: 2, offset: 0}))
+	assert.False(t, tieredID{term: 2, offset: 0}.LT(tieredID{term: 1, offset: 100}))
+	assert.True(t, tieredID{term: 1, offset: 1}.LTE(tieredID{term: 1, offset: 1}))
+	assert.False(t, tieredID{term: 1, offset: 2}.LTE(tieredID{term: 1, offset: 1}))
+	assert.True(t, tieredID{term: 1, offset: 1}.EQ(tieredID{term: 1, offset: 1}))
+	assert.False(t, tieredID{term: 1, offset: 1}.EQ(tieredID{term: 2, offset: 1}))
+
+	msgID, err := UnmarshalMessageID(tieredID{term: 3, offset: 4}.Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, tieredID{term: 3, offset: 4}, msgID)
+	assert.Equal(t, tieredID{term: 3, offset: 4}, message.MustUnmarshalMessageID(NewTieredID(3, 4).IntoProto()))
+	assert.Equal(t, "3/4", msgID.String())
+
+	for _, data := range []string{"", "1", "!/1", "1/!", "1/2/3"} {
+		_, err = UnmarshalMessageID(data)
+		assert.Error(t, err)
+	}
+
+	// the serialized form is used by the msg position.
+	id := tieredID{term: 3, offset: 4}
+	deserialized, err := DeserializeMessageID(id.Serialize())
+	assert.NoError(t, err)
+	assert.Equal(t, id, deserialized)
+	_, err = DeserializeMessageID([]byte{1, 2})
+	assert.Error(t, err)
+	ok, err := id.LessOrEqualThan(tieredID{term: 3, offset: 5}.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = id.LessOrEqualThan(tieredID{term: 3, offset: 3}.Serialize())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = id.Equal(id.Serialize())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, tieredID{}.AtEarliestPosition())
+	assert.False(t, id.AtEarliestPosition())
+}
@@ -0,0 +1,216 @@
+package tiered
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+// openerImpl is the opener of tiered wal.
+type openerImpl struct {
+	remote    walimpls.OpenerImpls
+	localPath string
+}
+
+// Open opens a tiered wal over the wal of remote mq.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+	remote, err := o.remote.Open(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	w := &walImpl{
+		WALHelper: helper.NewWALHelper(opt),
+		remote:    remote,
+		index:     newUploadIndex(),
+	}
+	if opt.Channel.AccessMode != types.AccessModeRW {
+		return w, nil
+	}
+	if err := w.recover(ctx, filepath.Join(o.localPath, opt.Channel.TopicName())); err != nil {
+		remote.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Close closes the opener.
+func (o *openerImpl) Close() {
+	o.remote.Close()
+}
+
+// recover opens the local log and reconciles it with the remote mq.
+// The records of local log that are not found in the remote mq are uploaded again before any new message,
+// the records that are superseded by a newer term in the remote mq are discarded.
+func (w *walImpl) recover(ctx context.Context, dir string) error {
+	log, records, err := openLocalLog(w.Log(), dir, paramtable.Get().StreamingCfg.WALTieredSegmentMaxSize.GetAsSize())
+	if err != nil {
+		return err
+	}
+	w.log = log
+	w.cond = syncutil.NewContextCond(&sync.Mutex{})
+	w.metricLabel = prometheus.Labels{
+		metrics.NodeIDLabelName:     paramtable.GetStringNodeID(),
+		metrics.WALChannelLabelName: w.Channel().Name,
+	}
+	w.pendingGauge = metrics.WALTieredPendingEntries.With(w.metricLabel)
+
+	markerID, markerRemoteID, err := log.loadMarker()
+	if err != nil {
+		log.close(nil)
+		return err
+	}
+	maxKnown := markerID
+	if markerID != nil {
+		w.uploaded, w.uploadedRemote = markerID, markerRemoteID
+		w.index.insert(*markerID, markerRemoteID)
+	}
+
+	if len(records) > 0 {
+		result, err := w.scanUploaded(ctx, markerRemoteID)
+		if err != nil {
+			log.close(nil)
+			return err
+		}
+		if result.maxID != nil {
+			maxKnown = maxOf(maxKnown, result.maxID)
+			w.uploaded, w.uploadedRemote = result.maxID, result.maxRemoteID
+			w.index.insert(*result.maxID, result.maxRemoteID)
+		}
+		discarded := 0
+		for _, r := range records {
+			if result.maxID == nil || result.maxID.lt(r.id) {
+				w.pending = append(w.pending, r)
+				continue
+			}
+			if offset, ok := result.maxOffsets[r.id.term]; !ok || offset < r.id.offset {
+				discarded++
+			}
+		}
+		maxKnown = maxOf(maxKnown, &records[len(records)-1].id)
+		if discarded > 0 {
+			w.Log().Warn(ctx, "discard the messages of local log superseded by a newer term of remote wal",
+				mlog.Int("discarded", discarded), mlog.Stringer("remoteMaxID", result.maxID))
+			metrics.WALTieredDiscardedEntriesTotal.With(w.metricLabel).Add(float64(discarded))
+		}
+		if w.uploaded != nil {
+			uploaded, uploadedRemote := *w.uploaded, w.uploadedRemote
+			if err := log.removeUploaded(uploaded, func() error {
+				return log.saveMarker(uploaded, uploadedRemote)
+			}); err != nil {
+				log.close(nil)
+				return err
+			}
+		}
+	}
+
+	if maxKnown != nil {
+		if maxKnown.term > w.Channel().Term {
+			log.close(nil)
+			return errors.Errorf("tiered wal is written by a newer term %d, current term %d", maxKnown.term, w.Channel().Term)
+		}
+		if maxKnown.term == w.Channel().Term {
+			w.nextOffset = maxKnown.offset + 1
+		}
+	}
+	w.pendingGauge.Set(float64(len(w.pending)))
+	w.Log().Info(ctx, "tiered wal recovered",
+		mlog.String("dir", dir),
+		mlog.Int("localRecords", len(records)),
+		mlog.Int("pending", len(w.pending)),
+		mlog.Uint64("nextOffset", w.nextOffset))
+
+	w.notifier = syncutil.NewAsyncTaskNotifier[struct{}]()
+	go w.uploadLoop()
+	return nil
+}
+
+// scanUploadedResult is the result of scanning the uploaded messages of the remote mq.
+type scanUploadedResult struct {
+	maxID       *tieredID
+	maxRemoteID message.MessageID
+	maxOffsets  map[int64]uint64 // the max uploaded offset of each term.
+}
+
+// scanUploaded finds the uploaded messages of the remote mq.
+// A fence message is appended into the remote mq first, all messages uploaded by the older terms are before it,
+// so the scanning is finished when the fence message is read.
+func (w *walImpl) scanUploaded(ctx context.Context, from message.MessageID) (*scanUploadedResult, error) {
+	fenceID, err := w.appendRemote(ctx, message.NewMutableMessageBeforeAppend(nil, map[string]string{
+		propertyFenceTerm: strconv.FormatInt(w.Channel().Term, 10),
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to append fence message into remote wal")
+	}
+	policy := options.DeliverPolicyAll()
+	if from != nil {
+		policy = options.DeliverPolicyStartFrom(from)
+	}
+	s, err := w.remote.Read(ctx, walimpls.ReadOption{
+		Name:          fmt.Sprintf("tiered-reconcile-%d", w.Channel().Term),
+		DeliverPolicy: policy,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read remote wal")
+	}
+	defer s.Close()
+
+	result := &scanUploadedResult{maxOffsets: make(map[int64]uint64)}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, context.Cause(ctx)
+		case msg, ok := <-s.Chan():
+			if !ok {
+				return nil, errors.Wrap(s.Error(), "remote wal scanner is closed before the fence message is read")
+			}
+			if msg.MessageID().EQ(fenceID) {
+				return result, nil
+			}
+			if msg.Properties().Exist(propertyFenceTerm) {
+				continue
+			}
+			records, _, err := decodeRecords(msg.Payload())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode the batch at %s of remote wal", msg.MessageID().String())
+			}
+			for _, r := range records {
+				if offset, ok := result.maxOffsets[r.id.term]; !ok || offset < r.id.offset {
+					result.maxOffsets[r.id.term] = r.id.offset
+				}
+				if result.maxID == nil || result.maxID.lt(r.id) {
+					id := r.id
+					result.maxID = &id
+					result.maxRemoteID = msg.MessageID()
+				}
+			}
+		}
+	}
+}
+
+// maxOf returns the greater message id, nil is the smallest one.
+func maxOf(a *tieredID, b *tieredID) *tieredID {
+	if a == nil || (b != nil && a.lt(*b)) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,79 @@
+package tiered
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+)
+
+// recordHeaderSize is the size of the header of a record:
+// crc32c(4) | body length(4) | term(8) | offset(8).
+const recordHeaderSize = 24
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errCorruptedRecord = errors.New("corrupted record")
+)
+
+// record is a message kept by the tiered wal, it's the unit of the local log and the upload batch.
+type record struct {
+	id  tieredID
+	msg *messagespb.Message
+}
+
+// encodeRecords appends the encoded records into buf.
+func encodeRecords(buf []byte, records []record) ([]byte, error) {
+	for _, r := range records {
+		body, err := proto.Marshal(r.msg)
+		if err != nil {
+			return nil, err
+		}
+		start := len(buf)
+		buf = append(buf, make([]byte, recordHeaderSize)...)
+		binary.BigEndian.PutUint32(buf[start+4:], uint32(len(body)))
+		binary.BigEndian.PutUint64(buf[start+8:], uint64(r.id.term))
+		binary.BigEndian.PutUint64(buf[start+16:], r.id.offset)
+		buf = append(buf, body...)
+		binary.BigEndian.PutUint32(buf[start:], crc32.Checksum(buf[start+4:], crcTable))
+	}
+	return buf, nil
+}
+
+// decodeRecords decodes the records from data.
+// It returns the records and the size of the valid prefix of data,
+// the error is errCorruptedRecord if the data is not a sequence of complete records.
+func decodeRecords(data []byte) ([]record, int, error) {
+	records := make([]record, 0)
+	valid := 0
+	for valid < len(data) {
+		remain := data[valid:]
+		if len(remain) < recordHeaderSize {
+			return records, valid, errors.Wrapf(errCorruptedRecord, "incomplete header at %d", valid)
+		}
+		size := int(binary.BigEndian.Uint32(remain[4:]))
+		if len(remain) < recordHeaderSize+size {
+			return records, valid, errors.Wrapf(errCorruptedRecord, "incomplete body at %d", valid)
+		}
+		if crc32.Checksum(remain[4:recordHeaderSize+size], crcTable) != binary.BigEndian.Uint32(remain) {
+			return records, valid, errors.Wrapf(errCorruptedRecord, "checksum mismatch at %d", valid)
+		}
+		msg := &messagespb.Message{}
+		if err := proto.Unmarshal(remain[recordHeaderSize:recordHeaderSize+size], msg); err != nil {
+			return records, valid, errors.Wrapf(errCorruptedRecord, "unmarshal message at %d, %s", valid, err.Error())
+		}
+		records = append(records, record{
+			id: tieredID{
+				term:   int64(binary.BigEndian.Uint64(remain[8:])),
+				offset: binary.BigEndian.Uint64(remain[16:]),
+			},
+			msg: msg,
+		})
+		valid += recordHeaderSize + size
+	}
+	return records, valid, nil
+}
@@ -0,0 +1,127 @@
+package tiered
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+)
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a scanner of tiered wal over the scanner of the remote mq.
+func newScanner(name string, remote walimpls.ScannerImpls, start *tieredID, exclusive bool) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(name),
+		remote:        remote,
+		ch:            make(chan message.ImmutableMessage),
+		start:         start,
+		exclusive:     exclusive,
+	}
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl unpacks the uploaded batches of the remote mq into the messages of tiered wal.
+// The messages are delivered in the order of message id,
+// the duplicated batch of a retried upload and the stale batch of an old term uploaded after a newer term are skipped.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	remote    walimpls.ScannerImpls
+	ch        chan message.ImmutableMessage
+	start     *tieredID
+	exclusive bool
+	last      *tieredID
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.ch
+}
+
+// Close the scanner, release the underlying resources.
+func (s *scannerImpl) Close() error {
+	err := s.ScannerHelper.Close()
+	s.remote.Close()
+	return err
+}
+
+func (s *scannerImpl) executeConsume() {
+	var err error
+	defer func() {
+		close(s.ch)
+		s.Finish(err)
+	}()
+
+	for {
+		select {
+		case <-s.Context().Done():
+			return
+		case msg, ok := <-s.remote.Chan():
+			if !ok {
+				err = s.remote.Error()
+				return
+			}
+			var msgs []message.ImmutableMessage
+			if msgs, err = s.unpack(msg); err != nil {
+				return
+			}
+			for _, msg := range msgs {
+				select {
+				case <-s.Context().Done():
+					return
+				case s.ch <- msg:
+				}
+			}
+		}
+	}
+}
+
+// unpack unpacks the remote message into the messages that should be delivered.
+func (s *scannerImpl) unpack(msg message.ImmutableMessage) ([]message.ImmutableMessage, error) {
+	if msg.Properties().Exist(propertyFenceTerm) {
+		return nil, nil
+	}
+	lastID, err := getBatchLastID(msg)
+	if err != nil {
+		return nil, err
+	}
+	if s.skip(lastID) {
+		return nil, nil
+	}
+	records, _, err := decodeRecords(msg.Payload())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode the batch at %s of remote wal", msg.MessageID().String())
+	}
+	msgs := make([]message.ImmutableMessage, 0, len(records))
+	for _, r := range records {
+		if s.skip(r.id) {
+			continue
+		}
+		id := r.id
+		s.last = &id
+		msgs = append(msgs, message.NewImmutableMesasge(id, r.msg.Payload, r.msg.Properties))
+	}
+	return msgs, nil
+}
+
+// skip returns true if the message should not be delivered.
+func (s *scannerImpl) skip(id tieredID) bool {
+	if s.last != nil && !s.last.lt(id) {
+		return true
+	}
+	if s.start != nil && (id.lt(*s.start) || (s.exclusive && id == *s.start)) {
+		return true
+	}
+	return false
+}
+
+// getBatchLastID returns the last message id of the uploaded batch.
+func getBatchLastID(msg message.ImmutableMessage) (tieredID, error) {
+	value, ok := msg.Properties().Get(propertyBatchLastID)
+	if !ok {
+		return tieredID{}, errors.Errorf("message %s of remote wal is not written by tiered wal", msg.MessageID().String())
+	}
+	return unmarshalMessageID(value)
+}
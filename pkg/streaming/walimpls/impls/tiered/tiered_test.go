@@ -0,0 +1,237 @@
+//go:build test
+// +build test
+
+package tiered
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v3/commonpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/impls/walimplstest"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	tmpPath, err := os.MkdirTemp("", "tiered_wal_test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpPath)
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTieredLocalPath.Key, tmpPath)
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(message.WALNameTiered)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, message.WALNameTiered, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(&commonpb.MessageID{
+		WALName: commonpb.WALName(message.WALNameTiered),
+		Id:      NewTieredID(1, 2).Marshal(),
+	})
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(NewTieredID(1, 2)))
+
+	// the tiered wal can not be the remote mq of itself.
+	_, err = (&builderImpl{remote: message.WALNameTiered}).Build()
+	assert.Error(t, err)
+}
+
+func TestWALImplsTest(t *testing.T) {
+	walimpls.NewWALImplsTestFramework(t, 100, &builderImpl{remote: message.WALNameTest}).Run()
+}
+
+func TestReuploadOnRecover(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTieredCloseDrainTimeout.Key, "100ms")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTieredCloseDrainTimeout.Key)
+
+	pchannel := fmt.Sprintf("tiered-reupload-%d", time.Now().UnixNano())
+	localPath := t.TempDir()
+
+	// the remote mq is unavailable, the messages are acknowledged by the local log only.
+	w := openTestWAL(t, newTestOpener(localPath, true), pchannel, 1)
+	ids := appendTestMessages(t, w, 0, 10)
+	for i, id := range ids {
+		assert.Equal(t, tieredID{term: 1, offset: uint64(i)}, id)
+	}
+	w.Close()
+	ro, err := newTestOpener(t.TempDir(), false).Open(context.Background(), &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: pchannel, Term: 1, AccessMode: types.AccessModeRO},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, readTestMessages(t, ro, 0))
+	ro.Close()
+
+	// the messages are uploaded again when the wal is reopened on the same node.
+	w = openTestWAL(t, newTestOpener(localPath, false), pchannel, 2)
+	ids = appendTestMessages(t, w, 10, 5)
+	assert.Equal(t, tieredID{term: 2, offset: 0}, ids[0])
+	msgs := readTestMessages(t, w, 15)
+	assertTestMessages(t, msgs, 0, 15)
+	w.Close()
+
+	// reopen again, nothing is uploaded twice.
+	w = openTestWAL(t, newTestOpener(localPath, false), pchannel, 2)
+	ids = appendTestMessages(t, w, 15, 1)
+	assert.Equal(t, tieredID{term: 2, offset: 5}, ids[0])
+	msgs = readTestMessages(t, w, 16)
+	assertTestMessages(t, msgs, 0, 16)
+	w.Close()
+
+	seqs, err := listSegments(localPath + "/" + types.PChannelInfo{Name: pchannel}.TopicName())
+	assert.NoError(t, err)
+	assert.Empty(t, seqs)
+}
+
+func TestDiscardOnRecover(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().StreamingCfg.WALTieredCloseDrainTimeout.Key, "100ms")
+	defer paramtable.Get().Reset(paramtable.Get().StreamingCfg.WALTieredCloseDrainTimeout.Key)
+
+	pchannel := fmt.Sprintf("tiered-discard-%d", time.Now().UnixNano())
+	localPathA := t.TempDir()
+	localPathB := t.TempDir()
+
+	// node A acknowledges the messages but fails to upload them.
+	w := openTestWAL(t, newTestOpener(localPathA, true), pchannel, 1)
+	appendTestMessages(t, w, 0, 5)
+	w.Close()
+
+	// the channel is assigned to node B with a newer term.
+	w = openTestWAL(t, newTestOpener(localPathB, false), pchannel, 2)
+	appendTestMessages(t, w, 100, 3)
+	w.Close()
+
+	// node A can not recover the wal with the older term.
+	_, err := newTestOpener(localPathA, false).Open(context.Background(), &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: pchannel, Term: 1, AccessMode: types.AccessModeRW},
+	})
+	assert.Error(t, err)
+
+	// the messages of node A are superseded by the newer term of node B when the channel is assigned back.
+	w = openTestWAL(t, newTestOpener(localPathA, false), pchannel, 3)
+	ids := appendTestMessages(t, w, 103, 1)
+	assert.Equal(t, tieredID{term: 3, offset: 0}, ids[0])
+	msgs := readTestMessages(t, w, 4)
+	assertTestMessages(t, msgs, 100, 4)
+	w.Close()
+}
+
+func TestFenced(t *testing.T) {
+	pchannel := fmt.Sprintf("tiered-fenced-%d", time.Now().UnixNano())
+	w := openTestWAL(t, newTestOpener(t.TempDir(), false), pchannel, 1)
+	walimplstest.EnableFenced(types.PChannelInfo{Name: pchannel}.TopicName())
+	defer walimplstest.DisableFenced(types.PChannelInfo{Name: pchannel}.TopicName())
+
+	// the append is rejected after the upload is fenced by the remote mq.
+	assert.Eventually(t, func() bool {
+		_, err := w.Append(context.Background(), newTestMessage(0))
+		return errors.Is(err, walimpls.ErrFenced)
+	}, 10*time.Second, 10*time.Millisecond)
+	w.Close()
+}
+
+// newTestOpener creates the opener of tiered wal over the wal of walimplstest.
+func newTestOpener(localPath string, unavailable bool) walimpls.OpenerImpls {
+	remote, err := registry.MustGetBuilder(message.WALNameTest).Build()
+	if err != nil {
+		panic(err)
+	}
+	if unavailable {
+		remote = &unavailableOpener{OpenerImpls: remote}
+	}
+	return &openerImpl{remote: remote, localPath: localPath}
+}
+
+func openTestWAL(t *testing.T, o walimpls.OpenerImpls, pchannel string, term int64) walimpls.WALImpls {
+	w, err := o.Open(context.Background(), &walimpls.OpenOption{
+		Channel: types.PChannelInfo{Name: pchannel, Term: term, AccessMode: types.AccessModeRW},
+	})
+	require.NoError(t, err)
+	return w
+}
+
+func newTestMessage(i int) message.MutableMessage {
+	return message.NewMutableMessageBeforeAppend([]byte(fmt.Sprintf("payload-%d", i)), map[string]string{"id": fmt.Sprintf("%d", i)})
+}
+
+func appendTestMessages(t *testing.T, w walimpls.WALImpls, from int, n int) []message.MessageID {
+	ids := make([]message.MessageID, 0, n)
+	for i := from; i < from+n; i++ {
+		id, err := w.Append(context.Background(), newTestMessage(i))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// readTestMessages reads n messages from the beginning of the wal and checks there's no more message.
+func readTestMessages(t *testing.T, w walimpls.WALImpls, n int) []message.ImmutableMessage {
+	s, err := w.Read(context.Background(), walimpls.ReadOption{
+		Name:          "test",
+		DeliverPolicy: options.DeliverPolicyAll(),
+	})
+	require.NoError(t, err)
+	defer s.Close()
+
+	msgs := make([]message.ImmutableMessage, 0, n)
+	for len(msgs) < n {
+		select {
+		case msg := <-s.Chan():
+			msgs = append(msgs, msg)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("read messages timeout, %d of %d messages are read", len(msgs), n)
+		}
+	}
+	select {
+	case msg := <-s.Chan():
+		t.Fatalf("unexpected message %s", msg.MessageID().String())
+	case <-time.After(200 * time.Millisecond):
+	}
+	return msgs
+}
+
+func assertTestMessages(t *testing.T, msgs []message.ImmutableMessage, from int, n int) {
+	require.Len(t, msgs, n)
+	for i, msg := range msgs {
+		assert.Equal(t, []byte(fmt.Sprintf("payload-%d", from+i)), msg.Payload())
+		if i > 0 {
+			assert.True(t, msgs[i-1].MessageID().LT(msg.MessageID()))
+		}
+	}
+}
+
+// unavailableOpener opens the remote wal that can not be appended.
+type unavailableOpener struct {
+	walimpls.OpenerImpls
+}
+
+func (o *unavailableOpener) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	w, err := o.OpenerImpls.Open(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	return &unavailableWAL{WALImpls: w}, nil
+}
+
+type unavailableWAL struct {
+	walimpls.WALImpls
+}
+
+func (w *unavailableWAL) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	return nil, errors.New("remote mq is unavailable")
+}
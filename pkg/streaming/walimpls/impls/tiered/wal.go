@@ -0,0 +1,443 @@
+package tiered
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/metrics"
+	"github.com/milvus-io/milvus/pkg/v3/mlog"
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v3/streaming/walimpls/helper"
+	"github.com/milvus-io/milvus/pkg/v3/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v3/util/syncutil"
+)
+
+const (
+	// propertyBatchLastID is the property of the remote message, it's the last message id of the uploaded batch.
+	propertyBatchLastID = "_tbl"
+	// propertyFenceTerm is the property of the fence message written into the remote mq when the wal is reopened.
+	propertyFenceTerm = "_tft"
+
+	// maxUploadBatchBytes is the max size of records uploaded by one remote message.
+	maxUploadBatchBytes = 1024 * 1024
+)
+
+var (
+	_ walimpls.WALImpls      = (*walImpl)(nil)
+	_ walimpls.BatchAppender = (*walImpl)(nil)
+)
+
+// walImpl is the tiered wal.
+// The message is appended into the local log and acknowledged after the fsync,
+// then it's uploaded into the remote mq by the background uploader in the order of message id.
+// The uploaded messages are packed into batches, so a remote message is appended or not as a whole,
+// and the scanner can always tell the duplicated or stale messages by the message id.
+type walImpl struct {
+	*helper.WALHelper
+	remote   walimpls.WALImpls
+	log      *localLog
+	index    *uploadIndex
+	notifier *syncutil.AsyncTaskNotifier[struct{}]
+
+	appendMu   sync.Mutex // make the message id assignment and the local append in order.
+	nextOffset uint64
+
+	cond           *syncutil.ContextCond
+	pending        []record // the records acknowledged but not uploaded, ordered by message id.
+	uploaded       *tieredID
+	uploadedRemote message.MessageID
+	fenceErr       error
+
+	metricLabel  prometheus.Labels
+	pendingGauge prometheus.Gauge
+}
+
+// WALName returns the name of the wal.
+func (w *walImpl) WALName() message.WALName {
+	return message.WALNameTiered
+}
+
+// Append appends the message into the local log, the message is uploaded into the remote mq asynchronously.
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	ids, err := w.append(ctx, []message.MutableMessage{msg})
+	if err != nil {
+		return nil, err
+	}
+	return ids[0], nil
+}
+
+// AppendBatch appends the messages into the local log with one fsync.
+func (w *walImpl) AppendBatch(records []walimpls.BatchAppendRecord) []walimpls.BatchAppendResult {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	results := make([]walimpls.BatchAppendResult, len(records))
+	pending := make([]int, 0, len(records))
+	msgs := make([]message.MutableMessage, 0, len(records))
+	for i, record := range records {
+		if err := record.Ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		msgs = append(msgs, record.Msg)
+		pending = append(pending, i)
+	}
+	if len(pending) == 0 {
+		return results
+	}
+	ids, err := w.append(records[pending[0]].Ctx, msgs)
+	for j, i := range pending {
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].MessageID = ids[j]
+	}
+	return results
+}
+
+// append assigns the message ids, writes the messages into the local log and hands them over to the uploader.
+func (w *walImpl) append(ctx context.Context, msgs []message.MutableMessage) ([]message.MessageID, error) {
+	if err := w.waitForPendingQuota(ctx, len(msgs)); err != nil {
+		return nil, err
+	}
+
+	w.appendMu.Lock()
+	defer w.appendMu.Unlock()
+	records := make([]record, 0, len(msgs))
+	ids := make([]message.MessageID, 0, len(msgs))
+	for _, msg := range msgs {
+		pb := msg.IntoMessageProto()
+		id := tieredID{term: w.Channel().Term, offset: w.nextOffset}
+		w.nextOffset++
+		records = append(records, record{
+			id: id,
+			msg: &messagespb.Message{
+				Payload:    pb.Payload,
+				Properties: maps.Clone(pb.Properties),
+			},
+		})
+		ids = append(ids, id)
+	}
+	if err := w.log.append(records); err != nil {
+		w.Log().RatedWarn(ctx, rate.Limit(1), "append message into local log failed", mlog.Err(err))
+		return nil, err
+	}
+
+	w.cond.LockAndBroadcast()
+	w.pending = append(w.pending, records...)
+	w.pendingGauge.Set(float64(len(w.pending)))
+	w.cond.L.Unlock()
+	return ids, nil
+}
+
+// waitForPendingQuota blocks the append until the pending messages are less than the max pending entries.
+func (w *walImpl) waitForPendingQuota(ctx context.Context, n int) error {
+	maxPending := paramtable.Get().StreamingCfg.WALTieredMaxPendingEntries.GetAsInt()
+	w.cond.L.Lock()
+	for w.fenceErr == nil && len(w.pending) > 0 && len(w.pending)+n > maxPending {
+		if err := w.cond.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	err := w.fenceErr
+	w.cond.L.Unlock()
+	return err
+}
+
+// Read reads the messages from the remote mq.
+// The messages not uploaded yet are delivered after they're uploaded.
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (walimpls.ScannerImpls, error) {
+	remoteOpt := walimpls.ReadOption{
+		Name:                opt.Name,
+		ReadAheadBufferSize: opt.ReadAheadBufferSize,
+		DeliverPolicy:       options.DeliverPolicyAll(),
+	}
+	var start *tieredID
+	exclusive := false
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_Latest:
+		remoteOpt.DeliverPolicy = opt.DeliverPolicy
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		start = &id
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		start = &id
+		exclusive = true
+	}
+	if start != nil {
+		// skip the remote messages that are uploaded before the start position.
+		if remoteID := w.index.floor(*start); remoteID != nil {
+			remoteOpt.DeliverPolicy = options.DeliverPolicyStartAfter(remoteID)
+		}
+	}
+	s, err := w.remote.Read(ctx, remoteOpt)
+	if err != nil {
+		return nil, err
+	}
+	return newScanner(opt.Name, s, start, exclusive), nil
+}
+
+// Truncate truncates the remote mq before the batch that contains the message.
+func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("truncate on a wal that is not in read-write mode")
+	}
+	tid, ok := id.(tieredID)
+	if !ok {
+		return errors.Wrapf(message.ErrInvalidMessageID, "truncate tiered wal with message id %s of %s", id.String(), id.WALName().String())
+	}
+	remoteID := w.index.floor(tid)
+	if remoteID == nil {
+		// the message is not uploaded or it's uploaded before the wal is opened, keep the remote mq as it is.
+		return nil
+	}
+	if err := w.remote.Truncate(ctx, remoteID); err != nil {
+		return err
+	}
+	w.index.truncate(tid)
+	return nil
+}
+
+// Close closes the wal.
+// The pending messages are uploaded before the wal is closed until the drain timeout,
+// the messages not uploaded are kept in the local log and uploaded when the wal is reopened on the same node.
+func (w *walImpl) Close() {
+	if w.Channel().AccessMode == types.AccessModeRW {
+		w.drain()
+		w.notifier.Cancel()
+		w.notifier.BlockUntilFinish()
+
+		w.cond.L.Lock()
+		uploaded, uploadedRemote, pending := w.uploaded, w.uploadedRemote, len(w.pending)
+		w.cond.L.Unlock()
+		if uploaded != nil {
+			if err := w.log.saveMarker(*uploaded, uploadedRemote); err != nil {
+				w.Log().Warn(context.TODO(), "failed to save upload marker of tiered wal", mlog.Err(err))
+				uploaded = nil
+			}
+		}
+		if pending > 0 {
+			w.Log().Warn(context.TODO(), "tiered wal is closed with messages not uploaded, they're kept in local log", mlog.Int("pending", pending))
+		}
+		w.log.close(uploaded)
+		metrics.WALTieredPendingEntries.Delete(w.metricLabel)
+		metrics.WALTieredDiscardedEntriesTotal.Delete(w.metricLabel)
+	}
+	w.remote.Close()
+}
+
+// drain waits for the pending messages to be uploaded.
+func (w *walImpl) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), paramtable.Get().StreamingCfg.WALTieredCloseDrainTimeout.GetAsDurationByParse())
+	defer cancel()
+
+	w.cond.L.Lock()
+	for len(w.pending) > 0 && w.fenceErr == nil {
+		if err := w.cond.Wait(ctx); err != nil {
+			w.Log().Warn(ctx, "drain the pending messages of tiered wal timeout", mlog.Err(err))
+			return
+		}
+	}
+	w.cond.L.Unlock()
+}
+
+// uploadLoop uploads the pending messages into the remote mq in order.
+func (w *walImpl) uploadLoop() {
+	defer w.notifier.Finish(struct{}{})
+	ctx := w.notifier.Context()
+
+	for {
+		batch, err := w.nextUploadBatch(ctx)
+		if err != nil {
+			return
+		}
+		payload, err := encodeRecords(nil, batch)
+		if err != nil {
+			w.fence(errors.Wrap(err, "failed to encode the upload batch"))
+			return
+		}
+		last := batch[len(batch)-1].id
+		remoteID, err := w.appendRemote(ctx, message.NewMutableMessageBeforeAppend(payload, map[string]string{
+			propertyBatchLastID: last.Marshal(),
+		}))
+		if err != nil {
+			if errors.Is(err, walimpls.ErrFenced) {
+				w.fence(err)
+			}
+			return
+		}
+		w.ackUploaded(len(batch), last, remoteID)
+	}
+}
+
+// nextUploadBatch returns the next batch of pending messages, it blocks until there's any.
+func (w *walImpl) nextUploadBatch(ctx context.Context) ([]record, error) {
+	w.cond.L.Lock()
+	for len(w.pending) == 0 {
+		if err := w.cond.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	defer w.cond.L.Unlock()
+
+	size := 0
+	n := 0
+	for ; n < len(w.pending); n++ {
+		recordSize := recordHeaderSize + proto.Size(w.pending[n].msg)
+		if n > 0 && size+recordSize > maxUploadBatchBytes {
+			break
+		}
+		size += recordSize
+	}
+	return w.pending[:n:n], nil
+}
+
+// ackUploaded removes the uploaded messages from the pending list and the local log.
+func (w *walImpl) ackUploaded(n int, last tieredID, remoteID message.MessageID) {
+	w.index.insert(last, remoteID)
+
+	w.cond.LockAndBroadcast()
+	w.pending = w.pending[n:]
+	w.uploaded = &last
+	w.uploadedRemote = remoteID
+	w.pendingGauge.Set(float64(len(w.pending)))
+	w.cond.L.Unlock()
+
+	if err := w.log.removeUploaded(last, func() error {
+		return w.log.saveMarker(last, remoteID)
+	}); err != nil {
+		w.Log().Warn(context.TODO(), "failed to remove uploaded segments of local log", mlog.Err(err))
+	}
+}
+
+// fence stops the append of the wal, it's called when the remote mq is fenced by a newer term.
+func (w *walImpl) fence(err error) {
+	w.Log().Warn(context.TODO(), "tiered wal is fenced, stop uploading", mlog.Err(err))
+	w.cond.LockAndBroadcast()
+	w.fenceErr = err
+	w.cond.L.Unlock()
+}
+
+// appendRemote appends the message into the remote mq, retry until success, fenced or canceled.
+func (w *walImpl) appendRemote(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	backoff := backoff.NewExponentialBackOff()
+	backoff.InitialInterval = 10 * time.Millisecond
+	backoff.MaxInterval = 1 * time.Second
+	backoff.MaxElapsedTime = 0
+	backoff.Reset()
+
+	for {
+		id, err := w.remote.Append(ctx, msg)
+		if err == nil {
+			return id, nil
+		}
+		if errors.Is(err, walimpls.ErrFenced) || ctx.Err() != nil {
+			return nil, err
+		}
+		nextInterval := backoff.NextBackOff()
+		w.Log().RatedWarn(ctx, rate.Limit(1), "append into remote wal failed, wait for retry...", mlog.Duration("nextRetryInterval", nextInterval), mlog.Err(err))
+		select {
+		case <-time.After(nextInterval):
+		case <-ctx.Done():
+			return nil, context.Cause(ctx)
+		}
+	}
+}
+
+// uploadIndex is a sparse index from the last message id of the uploaded batch to its remote message id.
+// It's used to translate the message id of tiered wal into the position of the remote mq.
+type uploadIndex struct {
+	mu      sync.Mutex
+	entries []uploadIndexEntry
+}
+
+type uploadIndexEntry struct {
+	lastID   tieredID
+	remoteID message.MessageID
+}
+
+// maxUploadIndexEntries is the max count of entries of the upload index,
+// the index is thinned by half if it's exceeded.
+const maxUploadIndexEntries = 100000
+
+func newUploadIndex() *uploadIndex {
+	return &uploadIndex{entries: make([]uploadIndexEntry, 0)}
+}
+
+// insert inserts a new uploaded batch into the index.
+func (idx *uploadIndex) insert(lastID tieredID, remoteID message.MessageID) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if n := len(idx.entries); n > 0 && !idx.entries[n-1].lastID.lt(lastID) {
+		return
+	}
+	idx.entries = append(idx.entries, uploadIndexEntry{lastID: lastID, remoteID: remoteID})
+	if len(idx.entries) > maxUploadIndexEntries {
+		thinned := make([]uploadIndexEntry, 0, len(idx.entries)/2+1)
+		for i := len(idx.entries) - 1; i >= 0; i -= 2 {
+			thinned = append(thinned, idx.entries[i])
+		}
+		for i, j := 0, len(thinned)-1; i < j; i, j = i+1, j-1 {
+			thinned[i], thinned[j] = thinned[j], thinned[i]
+		}
+		idx.entries = thinned
+	}
+}
+
+// floor returns the remote message id of the latest batch whose last message is before id.
+func (idx *uploadIndex) floor(id tieredID) message.MessageID {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	i := idx.search(id)
+	if i < 0 {
+		return nil
+	}
+	return idx.entries[i].remoteID
+}
+
+// truncate removes the entries before the floor entry of id.
+func (idx *uploadIndex) truncate(id tieredID) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if i := idx.search(id); i > 0 {
+		idx.entries = append(make([]uploadIndexEntry, 0, len(idx.entries)-i), idx.entries[i:]...)
+	}
+}
+
+// search returns the index of the latest entry whose last message is before id, -1 if not found.
+func (idx *uploadIndex) search(id tieredID) int {
+	lo, hi := 0, len(idx.entries)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if idx.entries[mid].lastID.lt(id) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo - 1
+}
@@ -18,7 +18,7 @@ func (*opener) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WAL
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
-	l := getOrCreateLogs(opt.Channel.Name)
+	l := getOrCreateLogs(opt.Channel.TopicName())
 	return &walImpls{
 		WALHelper: *helper.NewWALHelper(opt),
 		datas:     l,
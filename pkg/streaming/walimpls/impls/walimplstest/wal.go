@@ -19,9 +19,10 @@ import (
 )
 
 var (
-	_                walimpls.WALImpls = &walImpls{}
-	fenced                             = typeutil.NewConcurrentSet[string]()
-	enableFenceError                   = atomic.NewBool(true)
+	_                walimpls.WALImpls      = &walImpls{}
+	_                walimpls.BatchAppender = &walImpls{}
+	fenced                                  = typeutil.NewConcurrentSet[string]()
+	enableFenceError                        = atomic.NewBool(true)
 )
 
 // Reset clears global state of the in-memory WAL test implementation.
@@ -54,7 +55,7 @@ func (w *walImpls) Append(ctx context.Context, msg message.MutableMessage) (mess
 	if w.Channel().AccessMode != types.AccessModeRW {
 		panic("write on a wal that is not in read-write mode")
 	}
-	if fenced.Contain(w.Channel().Name) {
+	if fenced.Contain(w.Channel().TopicName()) {
 		return nil, errors.Mark(errors.New("err"), walimpls.ErrFenced)
 	}
 	if enableFenceError.Load() && msg.MessageType() != message.MessageTypeTimeTick && rand.Int31n(30) == 0 {
@@ -63,6 +64,21 @@ func (w *walImpls) Append(ctx context.Context, msg message.MutableMessage) (mess
 	return w.datas.Append(ctx, msg)
 }
 
+func (w *walImpls) AppendBatch(records []walimpls.BatchAppendRecord) []walimpls.BatchAppendResult {
+	if w.Channel().AccessMode != types.AccessModeRW {
+		panic("write on a wal that is not in read-write mode")
+	}
+	results := make([]walimpls.BatchAppendResult, len(records))
+	for i, record := range records {
+		if fenced.Contain(w.Channel().TopicName()) {
+			results[i].Err = errors.Mark(errors.New("err"), walimpls.ErrFenced)
+			continue
+		}
+		results[i].MessageID, results[i].Err = w.datas.Append(record.Ctx, record.Msg)
+	}
+	return results
+}
+
 func (w *walImpls) Read(ctx context.Context, opts walimpls.ReadOption) (walimpls.ScannerImpls, error) {
 	offset := int64(0)
 	switch t := opts.DeliverPolicy.GetPolicy().(type) {
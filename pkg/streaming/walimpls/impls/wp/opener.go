@@ -19,28 +19,28 @@ type openerImpl struct {
 
 // Open opens a new wal.
 func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
-	exists, err := o.c.LogExists(ctx, opt.Channel.Name)
+	exists, err := o.c.LogExists(ctx, opt.Channel.TopicName())
 	if err != nil {
-		mlog.Error(ctx, "failed to check log exists", mlog.String("log_name", opt.Channel.Name), mlog.Err(err))
+		mlog.Error(ctx, "failed to check log exists", mlog.String("log_name", opt.Channel.TopicName()), mlog.Err(err))
 		return nil, err
 	}
 	if !exists {
-		if err := o.c.CreateLog(ctx, opt.Channel.Name); err != nil {
-			mlog.Error(ctx, "failed to create log", mlog.String("log_name", opt.Channel.Name), mlog.Err(err))
+		if err := o.c.CreateLog(ctx, opt.Channel.TopicName()); err != nil {
+			mlog.Error(ctx, "failed to create log", mlog.String("log_name", opt.Channel.TopicName()), mlog.Err(err))
 			return nil, err
 		}
 	}
-	l, err := o.c.OpenLog(ctx, opt.Channel.Name)
+	l, err := o.c.OpenLog(ctx, opt.Channel.TopicName())
 	if err != nil {
-		mlog.Error(ctx, "failed to open log", mlog.String("log_name", opt.Channel.Name), mlog.Err(err))
+		mlog.Error(ctx, "failed to open log", mlog.String("log_name", opt.Channel.TopicName()), mlog.Err(err))
 		return nil, err
 	}
 	p, err := l.OpenLogWriter(ctx)
 	if err != nil {
-		mlog.Error(ctx, "failed to open log writer", mlog.String("log_name", opt.Channel.Name), mlog.Err(err))
+		mlog.Error(ctx, "failed to open log writer", mlog.String("log_name", opt.Channel.TopicName()), mlog.Err(err))
 		return nil, err
 	}
-	mlog.Info(ctx, "finish to open log writer", mlog.String("log_name", opt.Channel.Name))
+	mlog.Info(ctx, "finish to open log writer", mlog.String("log_name", opt.Channel.TopicName()))
 	return &walImpl{
 		WALHelper: helper.NewWALHelper(opt),
 		p:         p,
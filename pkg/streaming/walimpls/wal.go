@@ -40,3 +40,26 @@ type WALImpls interface {
 	// Truncate truncates the wal to the given id (inclusive).
 	Truncate(ctx context.Context, id message.MessageID) error
 }
+
+// BatchAppender is an optional interface of WALImpls to append a batch of records with one underlying produce.
+// The wal adaptor uses it to coalesce the small appends of a wal when the append batching is enabled.
+type BatchAppender interface {
+	// AppendBatch writes a batch of records to the log.
+	// Every record is appended with its own context, the canceling of one record doesn't affect the others.
+	// The returned results are in the same order as the given records, one result for every record.
+	// Only the record with error in its result should be retried by the caller,
+	// the records without error are already written and must not be appended again.
+	AppendBatch(records []BatchAppendRecord) []BatchAppendResult
+}
+
+// BatchAppendRecord is one record of the batch append.
+type BatchAppendRecord struct {
+	Ctx context.Context
+	Msg message.MutableMessage
+}
+
+// BatchAppendResult is the append result of one record of the batch append.
+type BatchAppendResult struct {
+	MessageID message.MessageID
+	Err       error
+}
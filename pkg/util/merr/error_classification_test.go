@@ -154,6 +154,7 @@ func TestSentinelErrorTypeClassification(t *testing.T) {
 		1100, 1101, 1102, // Parameter: invalid / missing / too large
 		1400, 1401, 1402, // Privilege
 		1800, 1801, 1802, 1804, // Auth / parameter format / insert data
+		1910, // ReplicateSecondaryWriteDenied
 		2100, // ImportFailed
 		2201, // QueryPlan
 	}
@@ -237,6 +237,9 @@ var (
 	ErrNoAssignSegmentID    = newMilvusError("no assign segment id", 1902, false)
 	ErrInvalidStreamObj     = newMilvusError("invalid stream object", 1903, false)
 
+	// replicate related
+	ErrReplicateSecondaryWriteDenied = newMilvusError("write is denied on the secondary cluster of replication", 1910, false, WithErrorType(InputError))
+
 	// Segcore related
 	ErrSegcore                    = newMilvusError("segcore error", 2000, false)
 	ErrSegcoreUnsupported         = newMilvusError("segcore unsupported error", 2001, false)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"golang.org/x/exp/constraints"
@@ -527,6 +528,15 @@ func WrapErrServiceRateLimit(rate float64, msg ...string) error {
 	return err
 }
 
+// WrapErrServiceRateLimitWithRetryAfter wraps ErrServiceRateLimit with the delay suggested to the caller before retrying.
+func WrapErrServiceRateLimitWithRetryAfter(rate float64, retryAfter time.Duration, msg ...string) error {
+	err := wrapFields(ErrServiceRateLimit, value("rate", rate), value("retryAfter", retryAfter))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrServiceQuotaExceeded(reason string, msg ...string) error {
 	err := wrapFields(ErrServiceQuotaExceeded, value("reason", reason))
 	if len(msg) > 0 {
@@ -682,6 +692,14 @@ func WrapErrCollectionSchemaVersionNotReady(collection any, consistentSegments,
 	)
 }
 
+func WrapErrReplicateSecondaryWriteDenied(msg ...string) error {
+	err := error(ErrReplicateSecondaryWriteDenied)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrAliasNotFound(db any, alias any, msg ...string) error {
 	err := wrapFields(ErrAliasNotFound,
 		value("database", db),
@@ -38,6 +38,9 @@ const (
 	// CollectionStorageMetrics means users request for collection storage metrics.
 	CollectionStorageMetrics = "collection_storage"
 
+	// ClusterChannelsMetrics means users request for the control channel and pchannels of the cluster.
+	ClusterChannelsMetrics = "cluster_channels"
+
 	// MetricRequestTypeKey is a key for identify request type.
 	MetricRequestTypeKey = "req_type"
 
@@ -50,6 +53,12 @@ const (
 	// ChannelKey request for get channels from the datanode/querynode/datacoord/querycoord
 	ChannelKey = "channels"
 
+	// CollectionRefKey request for get the reference info of collections from the querynode
+	CollectionRefKey = "collection_refs"
+
+	// SegmentMemoryKey request for get the memory breakdown of segments from the querynode
+	SegmentMemoryKey = "segment_memory"
+
 	// DistKey request for segment/channel/leader view distribution on querycoord
 	// DistKey request for get segments on the datacoord
 	DistKey = "dist"
@@ -245,6 +245,44 @@ type Channel struct {
 	CheckpointTS   string `json:"check_point_ts,omitempty"` // a time string, format like "2006-01-02 15:04:05"
 }
 
+// CollectionRef is the reference info of a collection in querynode.
+type CollectionRef struct {
+	CollectionID int64             `json:"collection_id,omitempty,string"`
+	NodeID       int64             `json:"node_id,omitempty,string"`
+	RefCount     uint32            `json:"ref_count"`
+	RefSources   map[string]uint64 `json:"ref_sources,omitempty"` // the number of references taken by each caller
+	UnrefCount   uint64            `json:"unref_count"`
+	NumSegments  int               `json:"num_segments"`
+	NumChannels  int               `json:"num_channels"`
+	// SuspectedLeak indicates the collection is still referenced without any segment or channel on the node,
+	// the references of the in-flight requests are counted as well, so check it more than once.
+	SuspectedLeak bool `json:"suspected_leak,omitempty"`
+}
+
+// SegmentMemory is the memory breakdown of a segment in querynode.
+type SegmentMemory struct {
+	SegmentID       int64                     `json:"segment_id,omitempty,string"`
+	CollectionID    int64                     `json:"collection_id,omitempty,string"`
+	PartitionID     int64                     `json:"partition_id,omitempty,string"`
+	NodeID          int64                     `json:"node_id,omitempty,string"`
+	State           string                    `json:"state,omitempty"`
+	Level           string                    `json:"level,omitempty"`
+	MemSize         int64                     `json:"mem_size,omitempty,string"` // memory size reported by segcore
+	HeapSize        int64                     `json:"heap_size,omitempty,string"`
+	MmapSize        int64                     `json:"mmap_size,omitempty,string"`
+	BloomFilterSize int64                     `json:"bloom_filter_size,omitempty,string"`
+	Columns         []*SegmentComponentMemory `json:"columns,omitempty"`
+	Indexes         []*SegmentComponentMemory `json:"indexes,omitempty"`
+}
+
+// SegmentComponentMemory is the memory size of a raw column or an index of a segment.
+type SegmentComponentMemory struct {
+	FieldID int64 `json:"field_id,omitempty,string"`
+	IndexID int64 `json:"index_id,omitempty,string"`
+	Size    int64 `json:"size,omitempty,string"`
+	Mmap    bool  `json:"mmap,omitempty"`
+}
+
 // DeployMetrics records the deploy information of nodes.
 type DeployMetrics struct {
 	SystemVersion string `json:"system_version"`
@@ -1528,6 +1528,7 @@ type gpuConfig struct {
 	InitSize                            ParamItem `refreshable:"false"`
 	MaxSize                             ParamItem `refreshable:"false"`
 	OverloadedMemoryThresholdPercentage ParamItem `refreshable:"false"`
+	MemoryPoolEvictionEnabled           ParamItem `refreshable:"true"`
 }
 
 func (t *gpuConfig) init(base *BaseTable) {
@@ -1558,6 +1559,17 @@ func (t *gpuConfig) init(base *BaseTable) {
 		},
 	}
 	t.OverloadedMemoryThresholdPercentage.Init(base.mgr)
+
+	t.MemoryPoolEvictionEnabled = ParamItem{
+		Key:          "gpu.memoryPool.evictionEnabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `Whether to evict the GPU indexes of the least accessed segments to host memory if there's no room to load a new GPU index.
+The evicted segments keep serving with the raw data in host memory, only the segments with raw data loaded can be evicted.
+The evicted indexes are still reported as loaded to the querycoord, they are loaded back only if the segment is reopened or reloaded.`,
+		Export: true,
+	}
+	t.MemoryPoolEvictionEnabled.Init(base.mgr)
 }
 
 type traceConfig struct {
@@ -2765,6 +2777,7 @@ type queryCoordConfig struct {
 	RandomMaxSteps                      ParamItem `refreshable:"true"`
 	GrowingRowCountWeight               ParamItem `refreshable:"true"`
 	DelegatorMemoryOverloadFactor       ParamItem `refreshable:"true"`
+	BalanceQPSWeight                    ParamItem `refreshable:"true"`
 	BalanceCostThreshold                ParamItem `refreshable:"true"`
 
 	SegmentCheckInterval       ParamItem `refreshable:"true"`
@@ -2814,6 +2827,17 @@ type queryCoordConfig struct {
 	ClusterLevelLoadReplicaNumber      ParamItem `refreshable:"true"`
 	ClusterLevelLoadResourceGroups     ParamItem `refreshable:"true"`
 	ClusterLevelLoadWaitRGReadyTimeout ParamItem `refreshable:"true"`
+	DatabaseResourceGroupIsolation     ParamItem `refreshable:"true"`
+	ReplicaHandoverTimeout             ParamItem `refreshable:"true"`
+
+	// replica auto scale
+	ReplicaAutoScaleEnabled          ParamItem `refreshable:"true"`
+	ReplicaAutoScaleMinReplicaNumber ParamItem `refreshable:"true"`
+	ReplicaAutoScaleMaxReplicaNumber ParamItem `refreshable:"true"`
+	ReplicaAutoScaleUpQPS            ParamItem `refreshable:"true"`
+	ReplicaAutoScaleDownQPS          ParamItem `refreshable:"true"`
+	ReplicaAutoScaleSustainDuration  ParamItem `refreshable:"true"`
+	ReplicaAutoScaleCheckInterval    ParamItem `refreshable:"true"`
 
 	// balance batch size in one trigger
 	BalanceSegmentBatchSize            ParamItem `refreshable:"true"`
@@ -3059,6 +3083,17 @@ If this parameter is set false, Milvus simply searches the growing segments with
 	}
 	p.DelegatorMemoryOverloadFactor.Init(base.mgr)
 
+	p.BalanceQPSWeight = ParamItem{
+		Key:          "queryCoord.balanceQPSWeight",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		PanicIfEmpty: true,
+		Doc: `the weight of the segment search and query rate reported by queryNodes when balancing segments,
+the qps of a segment is converted into rows by the average rows per qps of all the loaded segments. 0 means balancing by the row count only`,
+		Export: true,
+	}
+	p.BalanceQPSWeight.Init(base.mgr)
+
 	p.BalanceCostThreshold = ParamItem{
 		Key:          "queryCoord.balanceCostThreshold",
 		Version:      "2.4.0",
@@ -3436,6 +3471,91 @@ If this parameter is set false, Milvus simply searches the growing segments with
 	}
 	p.ClusterLevelLoadWaitRGReadyTimeout.Init(base.mgr)
 
+	p.DatabaseResourceGroupIsolation = ParamItem{
+		Key:          "queryCoord.databaseResourceGroupIsolation",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `whether to isolate the replicas of different databases by resource group,
+if enabled, a resource group only hosts the replicas of one database, so the databases are served by disjoint query nodes.
+Bind the resource groups to a database by the database property database.resource_groups`,
+		Export: true,
+	}
+	p.DatabaseResourceGroupIsolation.Init(base.mgr)
+
+	p.ReplicaHandoverTimeout = ParamItem{
+		Key:          "queryCoord.replicaHandoverTimeout",
+		Version:      "3.0.0",
+		DefaultValue: "600",
+		Doc: `the max seconds that a replica moved to another resource group by the load config keeps serving on the nodes of its previous resource group,
+until the nodes of the new resource group take over its segments and channels`,
+		Export: true,
+	}
+	p.ReplicaHandoverTimeout.Init(base.mgr)
+
+	p.ReplicaAutoScaleEnabled = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.enabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `whether to raise or lower the replica number of the loaded collections automatically,
+by the search and query rate per replica reported by queryNodes, see queryNode.segmentQPSReportInterval`,
+		Export: true,
+	}
+	p.ReplicaAutoScaleEnabled.Init(base.mgr)
+
+	p.ReplicaAutoScaleMinReplicaNumber = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.minReplicaNumber",
+		Version:      "3.0.0",
+		DefaultValue: "1",
+		Doc:          "the replica number of a collection is never lowered below this value by the auto scale",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleMinReplicaNumber.Init(base.mgr)
+
+	p.ReplicaAutoScaleMaxReplicaNumber = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.maxReplicaNumber",
+		Version:      "3.0.0",
+		DefaultValue: "3",
+		Doc:          "the replica number of a collection is never raised above this value by the auto scale",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleMaxReplicaNumber.Init(base.mgr)
+
+	p.ReplicaAutoScaleUpQPS = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.scaleUpQPSPerReplica",
+		Version:      "3.0.0",
+		DefaultValue: "100",
+		Doc:          "raise the replica number by one if the search and query rate per replica keeps above this value for sustainDuration",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleUpQPS.Init(base.mgr)
+
+	p.ReplicaAutoScaleDownQPS = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.scaleDownQPSPerReplica",
+		Version:      "3.0.0",
+		DefaultValue: "10",
+		Doc:          "lower the replica number by one if the search and query rate per replica keeps below this value for sustainDuration",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleDownQPS.Init(base.mgr)
+
+	p.ReplicaAutoScaleSustainDuration = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.sustainDuration",
+		Version:      "3.0.0",
+		DefaultValue: "600",
+		Doc:          "the duration (in seconds) the rate must keep beyond the threshold before the replica number is changed",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleSustainDuration.Init(base.mgr)
+
+	p.ReplicaAutoScaleCheckInterval = ParamItem{
+		Key:          "queryCoord.replicaAutoScale.checkInterval",
+		Version:      "3.0.0",
+		DefaultValue: "60",
+		Doc:          "the interval (in seconds) to check the search and query rate of the loaded collections",
+		Export:       true,
+	}
+	p.ReplicaAutoScaleCheckInterval.Init(base.mgr)
+
 	p.AutoBalanceInterval = ParamItem{
 		Key:          "queryCoord.autoBalanceInterval",
 		Version:      "2.5.3",
@@ -3565,6 +3685,8 @@ type queryNodeConfig struct {
 	DenseVectorInterminIndexType  ParamItem `refreshable:"false"`
 	InterimIndexMemExpandRate     ParamItem `refreshable:"false"`
 	InterimIndexBuildParallelRate ParamItem `refreshable:"false"`
+	InterimIndexAsyncBuild        ParamItem `refreshable:"false"`
+	InterimIndexAsyncConcurrency  ParamItem `refreshable:"false"`
 	MultipleChunkedEnable         ParamItem `refreshable:"false"` // Deprecated
 	EnableGeometryCache           ParamItem `refreshable:"false"`
 
@@ -3642,8 +3764,10 @@ type queryNodeConfig struct {
 	CatchUpStreamingDataTsLag ParamItem `refreshable:"true"`
 
 	// delete buffer
-	MaxSegmentDeleteBuffer ParamItem `refreshable:"false"`
-	DeleteBufferBlockSize  ParamItem `refreshable:"false"`
+	MaxSegmentDeleteBuffer       ParamItem `refreshable:"false"`
+	DeleteBufferBlockSize        ParamItem `refreshable:"false"`
+	DeleteBufferSpillEnabled     ParamItem `refreshable:"false"`
+	DeleteBufferSpillMemoryLimit ParamItem `refreshable:"false"`
 
 	// delta forward
 	LevelZeroForwardPolicy             ParamItem `refreshable:"true"`
@@ -3657,6 +3781,18 @@ type queryNodeConfig struct {
 	TextIndexExpansionFactor    ParamItem `refreshable:"true"`
 	DiskSizeFetchInterval       ParamItem `refreshable:"false"`
 
+	// load admission
+	LoadMaxConcurrency              ParamItem `refreshable:"true"`
+	LoadMaxConcurrencyPerCollection ParamItem `refreshable:"true"`
+	LoadResourceWaitTimeout         ParamItem `refreshable:"true"`
+	DeferredIndexLoadMinSize        ParamItem `refreshable:"true"`
+	SegmentQPSReportInterval        ParamItem `refreshable:"true"`
+
+	// search result cache
+	SearchResultCacheEnabled  ParamItem `refreshable:"true"`
+	SearchResultCacheCapacity ParamItem `refreshable:"false"`
+	SearchResultCacheTTL      ParamItem `refreshable:"false"`
+
 	// schedule task policy.
 	SchedulePolicyName                    ParamItem `refreshable:"false"`
 	SchedulePolicyTaskQueueExpire         ParamItem `refreshable:"true"`
@@ -3727,6 +3863,15 @@ type queryNodeConfig struct {
 	ExternalCollectionSamplePerSegment ParamItem `refreshable:"true"`
 	ExternalCollectionSampleRows       ParamItem `refreshable:"true"`
 	ExternalCollectionRawDataFactor    ParamItem `refreshable:"true"`
+
+	// segment cache
+	SegmentCacheEnabled                 ParamItem `refreshable:"false"`
+	SegmentCacheCapacity                ParamItem `refreshable:"false"`
+	SegmentCacheAdmissionMinAccessCount ParamItem `refreshable:"true"`
+
+	// lazy field
+	LazyFieldEnabled                ParamItem `refreshable:"false"`
+	LazyFieldHotFieldMinAccessCount ParamItem `refreshable:"true"`
 }
 
 func formatDurationWithMillisecondFallback(v string) string {
@@ -4221,6 +4366,25 @@ This defaults to true, indicating that Milvus creates temporary index for growin
 	}
 	p.InterimIndexBuildParallelRate.Init(base.mgr)
 
+	p.InterimIndexAsyncBuild = ParamItem{
+		Key:          "queryNode.segcore.interimIndex.asyncBuild",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `whether to build the interim index of growing segments in background,
+the growing segment is searched by brute force until the interim index is built`,
+		Export: true,
+	}
+	p.InterimIndexAsyncBuild.Init(base.mgr)
+
+	p.InterimIndexAsyncConcurrency = ParamItem{
+		Key:          "queryNode.segcore.interimIndex.asyncBuildConcurrency",
+		Version:      "3.0.0",
+		DefaultValue: "1",
+		Doc:          "max number of interim indexes built in background concurrently, to limit the cpu usage of the background builds",
+		Export:       true,
+	}
+	p.InterimIndexAsyncConcurrency.Init(base.mgr)
+
 	p.MultipleChunkedEnable = ParamItem{
 		Key:          "queryNode.segcore.multipleChunkedEnable",
 		Version:      "2.0.0",
@@ -4698,6 +4862,24 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.DeleteBufferBlockSize.Init(base.mgr)
 
+	p.DeleteBufferSpillEnabled = ParamItem{
+		Key:          "queryNode.deleteBufferSpill.enabled",
+		Version:      "3.0.0",
+		Doc:          "whether to spill the earliest blocks of the delegator delete buffer to local disk once its memory size exceeds the limit",
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.DeleteBufferSpillEnabled.Init(base.mgr)
+
+	p.DeleteBufferSpillMemoryLimit = ParamItem{
+		Key:          "queryNode.deleteBufferSpill.memoryLimit",
+		Version:      "3.0.0",
+		Doc:          "the memory size limit of the delete buffer per channel before spilling, in bytes",
+		DefaultValue: "268435456", // 256MB
+		Export:       true,
+	}
+	p.DeleteBufferSpillMemoryLimit.Init(base.mgr)
+
 	p.LevelZeroForwardPolicy = ParamItem{
 		Key:          "queryNode.levelZeroForwardPolicy",
 		Version:      "2.4.12",
@@ -4774,6 +4956,84 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.DiskSizeFetchInterval.Init(base.mgr)
 
+	p.LoadMaxConcurrency = ParamItem{
+		Key:          "queryNode.loadAdmission.maxConcurrency",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc: `The max number of the concurrent load segments requests of the querynode, 0 means no limit.
+The requests exceeding the limit wait in a queue ordered by the load priority, then by the arrival time.`,
+		Export: true,
+	}
+	p.LoadMaxConcurrency.Init(base.mgr)
+
+	p.LoadMaxConcurrencyPerCollection = ParamItem{
+		Key:          "queryNode.loadAdmission.maxConcurrencyPerCollection",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc: `The max number of the concurrent load segments requests of a collection on the querynode, 0 means no limit.
+It keeps a bulk load of one collection (e.g. a rebalance) from occupying all the load concurrency of the querynode.`,
+		Export: true,
+	}
+	p.LoadMaxConcurrencyPerCollection.Init(base.mgr)
+
+	p.LoadResourceWaitTimeout = ParamItem{
+		Key:          "queryNode.loadAdmission.resourceWaitTimeout",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc: `The max seconds a load segments request waits for the loading segments to free their resource,
+when the predicted memory or disk usage after loading exceeds the watermark. 0 means rejecting the request at once.`,
+		Export: true,
+	}
+	p.LoadResourceWaitTimeout.Init(base.mgr)
+
+	p.DeferredIndexLoadMinSize = ParamItem{
+		Key:          "queryNode.deferredIndexLoad.minIndexSizeMB",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc: `The vector index not smaller than it (in MB) is loaded in background after the segment is loaded with the raw vectors,
+the segment is searchable by the interim index or brute force before the index is loaded. 0 means loading all the indexes with the segment.`,
+		Export: true,
+	}
+	p.DeferredIndexLoadMinSize.Init(base.mgr)
+
+	p.SegmentQPSReportInterval = ParamItem{
+		Key:          "queryNode.segmentQPSReportInterval",
+		Version:      "3.0.0",
+		DefaultValue: "60",
+		Doc:          "The interval (in seconds) to report the segment search and query rate to querycoord even if the distribution is not changed, 0 means only reporting it with the distribution change",
+		Export:       true,
+	}
+	p.SegmentQPSReportInterval.Init(base.mgr)
+
+	p.SearchResultCacheEnabled = ParamItem{
+		Key:          "queryNode.searchResultCache.enabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `Whether to cache the search results on the shard delegator, the identical search is served by the cached result
+until the data or the segment distribution of the shard is changed. The iterator search and the partial search are not cached.
+The cache is built when the shard delegator is created, so enabling it only takes effect on the shards loaded afterwards`,
+		Export: true,
+	}
+	p.SearchResultCacheEnabled.Init(base.mgr)
+
+	p.SearchResultCacheCapacity = ParamItem{
+		Key:          "queryNode.searchResultCache.capacity",
+		Version:      "3.0.0",
+		DefaultValue: "128",
+		Doc:          "The max number of the cached search results per shard delegator",
+		Export:       true,
+	}
+	p.SearchResultCacheCapacity.Init(base.mgr)
+
+	p.SearchResultCacheTTL = ParamItem{
+		Key:          "queryNode.searchResultCache.ttl",
+		Version:      "3.0.0",
+		DefaultValue: "60",
+		Doc:          "The time to live (in seconds) of the cached search results",
+		Export:       true,
+	}
+	p.SearchResultCacheTTL.Init(base.mgr)
+
 	// schedule read task policy.
 	p.SchedulePolicyName = ParamItem{
 		Key:          "queryNode.scheduler.scheduleReadPolicy.name",
@@ -5111,6 +5371,65 @@ user-task-polling:
 		Export:       false,
 	}
 	p.ExternalCollectionRawDataFactor.Init(base.mgr)
+
+	p.SegmentCacheEnabled = ParamItem{
+		Key:          "queryNode.segmentCache.enabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `Whether to keep only the hot sealed segments resident on the querynode, false by default.
+If enabled, the data of the least recently used sealed segments is released when the resident segments exceed the capacity,
+only the meta of the evicted segment is kept, and its data is loaded back from the object storage on the next search or query of it.`,
+		Export: true,
+	}
+	p.SegmentCacheEnabled.Init(base.mgr)
+
+	p.SegmentCacheCapacity = ParamItem{
+		Key:          "queryNode.segmentCache.capacity",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Formatter: func(v string) string {
+			if v != "0" {
+				return v
+			}
+			return strconv.FormatInt(int64(float64(hardware.GetMemoryCount())*p.OverloadedMemoryThresholdPercentage.GetAsFloat()), 10)
+		},
+		Doc: `The max size of the resident sealed segments of the segment cache, the size is the binlog and index size of the segment.
+0 by default, which means the memory of the querynode multiplied by overloadedMemoryThresholdPercentage.`,
+		Export: true,
+	}
+	p.SegmentCacheCapacity.Init(base.mgr)
+
+	p.SegmentCacheAdmissionMinAccessCount = ParamItem{
+		Key:          "queryNode.segmentCache.admissionMinAccessCount",
+		Version:      "3.0.0",
+		DefaultValue: "1",
+		Doc: `The min count of accesses of an evicted segment before it's admitted into the segment cache again, 1 by default.
+The evicted segment accessed fewer times is loaded for the request only and released after it,
+so a scan over the cold segments doesn't flush the hot segments out of the cache.`,
+		Export: true,
+	}
+	p.SegmentCacheAdmissionMinAccessCount.Init(base.mgr)
+
+	p.LazyFieldEnabled = ParamItem{
+		Key:          "queryNode.lazyField.enabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `Whether to load only the hot fields of the sealed segments, false by default.
+If enabled, the scalar fields without index that are not referenced by the searches and queries of the collection yet are not loaded with the segment,
+they're loaded from the object storage when a search or query references them as an output field or in the filter.`,
+		Export: true,
+	}
+	p.LazyFieldEnabled.Init(base.mgr)
+
+	p.LazyFieldHotFieldMinAccessCount = ParamItem{
+		Key:          "queryNode.lazyField.hotFieldMinAccessCount",
+		Version:      "3.0.0",
+		DefaultValue: "1",
+		Doc: `The min count of the searches and queries referencing a field before it's loaded with the new segments of the collection, 1 by default.
+The field referenced fewer times is still loaded on demand.`,
+		Export: true,
+	}
+	p.LazyFieldHotFieldMinAccessCount.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -5170,6 +5489,8 @@ type dataCoordConfig struct {
 	CompactionCheckIntervalInSeconds           ParamItem `refreshable:"false"` // deprecated
 	CompactionScheduleInterval                 ParamItem `refreshable:"false"`
 	MixCompactionTriggerInterval               ParamItem `refreshable:"false"`
+	MixCompactionPolicy                        ParamItem `refreshable:"true"`
+	MixCompactionTimeWindow                    ParamItem `refreshable:"true"`
 	L0CompactionTriggerInterval                ParamItem `refreshable:"false"`
 	GlobalCompactionInterval                   ParamItem `refreshable:"false"`
 	CompactionExpiryTolerance                  ParamItem `refreshable:"true"`
@@ -5830,6 +6151,24 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.MixCompactionTriggerInterval.Init(base.mgr)
 
+	p.MixCompactionPolicy = ParamItem{
+		Key:          "dataCoord.compaction.mix.policy",
+		Version:      "3.0.0",
+		Doc:          "The default policy to select the segments of mix compaction, options: [default, size, delete_ratio, time_window], could be overridden by the collection property collection.compaction.policy",
+		DefaultValue: "default",
+		Export:       true,
+	}
+	p.MixCompactionPolicy.Init(base.mgr)
+
+	p.MixCompactionTimeWindow = ParamItem{
+		Key:          "dataCoord.compaction.mix.timeWindow",
+		Version:      "3.0.0",
+		Doc:          "The time window in seconds of the time_window mix compaction policy, only the segments flushed in the same window are merged",
+		DefaultValue: "86400",
+		Export:       true,
+	}
+	p.MixCompactionTimeWindow.Init(base.mgr)
+
 	p.L0CompactionTriggerInterval = ParamItem{
 		Key:          "dataCoord.compaction.levelzero.triggerInterval",
 		Version:      "2.4.15",
@@ -7447,11 +7786,37 @@ type streamingConfig struct {
 	WALScannerPauseConsumption ParamItem `refreshable:"true"`
 
 	// balancer
-	WALBalancerTriggerInterval        ParamItem `refreshable:"true"`
-	WALBalancerBackoffInitialInterval ParamItem `refreshable:"true"`
-	WALBalancerBackoffMultiplier      ParamItem `refreshable:"true"`
-	WALBalancerBackoffMaxInterval     ParamItem `refreshable:"true"`
-	WALBalancerOperationTimeout       ParamItem `refreshable:"true"`
+	WALBalancerTriggerInterval             ParamItem `refreshable:"true"`
+	WALBalancerBackoffInitialInterval      ParamItem `refreshable:"true"`
+	WALBalancerBackoffMultiplier           ParamItem `refreshable:"true"`
+	WALBalancerBackoffMaxInterval          ParamItem `refreshable:"true"`
+	WALBalancerOperationTimeout            ParamItem `refreshable:"true"`
+	WALBalancerLeaseEnabled                ParamItem `refreshable:"false"`
+	WALBalancerLeaseTTL                    ParamItem `refreshable:"true"`
+	WALBalancerLeaseRenewInterval          ParamItem `refreshable:"true"`
+	WALBalancerReadReplicaNum              ParamItem `refreshable:"true"`
+	WALBalancerReadReplicaDelegatorEnabled ParamItem `refreshable:"true"`
+
+	// reassign throttle
+	WALBalancerReassignThrottleMaxReassignments ParamItem `refreshable:"true"`
+	WALBalancerReassignThrottleInterval         ParamItem `refreshable:"true"`
+	WALBalancerReassignThrottleCooldown         ParamItem `refreshable:"true"`
+
+	// database vchannel quota
+	WALBalancerDatabaseVChannelQuota          ParamItem `refreshable:"true"`
+	WALBalancerDatabaseVChannelQuotaOverrides ParamItem `refreshable:"true"`
+
+	// assignment history retention
+	WALBalancerAssignmentHistoryMaxCount ParamItem `refreshable:"true"`
+	WALBalancerAssignmentHistoryMaxAge   ParamItem `refreshable:"true"`
+
+	// pchannel auto scale
+	WALBalancerAutoScaleEnabled                    ParamItem `refreshable:"true"`
+	WALBalancerAutoScaleCheckInterval              ParamItem `refreshable:"true"`
+	WALBalancerAutoScaleAppendBytesPerSecThreshold ParamItem `refreshable:"true"`
+	WALBalancerAutoScaleSustainedDuration          ParamItem `refreshable:"true"`
+	WALBalancerAutoScaleMaxPChannelNum             ParamItem `refreshable:"true"`
+	WALBalancerAutoScaleStep                       ParamItem `refreshable:"true"`
 
 	// balancer Policy
 	WALBalancerPolicyName                               ParamItem `refreshable:"true"`
@@ -7463,13 +7828,16 @@ type streamingConfig struct {
 	WALBalancerPolicyVChannelFairAntiAffinityWeight     ParamItem `refreshable:"true"`
 	WALBalancerPolicyVChannelFairRebalanceTolerance     ParamItem `refreshable:"true"`
 	WALBalancerPolicyVChannelFairRebalanceMaxStep       ParamItem `refreshable:"true"`
+	WALBalancerPolicyWeightedByLoadThroughputUnit       ParamItem `refreshable:"true"`
 	WALBalancerExpectedInitialStreamingNodeNum          ParamItem `refreshable:"true"`
+	WALBalancerIDAllocatorBatchSize                     ParamItem `refreshable:"true"`
 
 	// broadcaster
 	WALBroadcasterConcurrencyRatio       ParamItem `refreshable:"false"`
 	WALBroadcasterTombstoneCheckInternal ParamItem `refreshable:"true"`
 	WALBroadcasterTombstoneMaxCount      ParamItem `refreshable:"true"`
 	WALBroadcasterTombstoneMaxLifetime   ParamItem `refreshable:"true"`
+	WALBroadcasterWatchBufferSize        ParamItem `refreshable:"false"`
 
 	// txn
 	TxnDefaultKeepaliveTimeout ParamItem `refreshable:"true"`
@@ -7481,6 +7849,37 @@ type streamingConfig struct {
 	// read ahead buffer size
 	WALReadAheadBufferLength ParamItem `refreshable:"true"`
 
+	// adaptive time tick sync
+	WALTimeTickAdaptiveSyncEnabled     ParamItem `refreshable:"false"`
+	WALTimeTickAdaptiveSyncMinInterval ParamItem `refreshable:"false"`
+	WALTimeTickAdaptiveSyncMaxInterval ParamItem `refreshable:"true"`
+
+	// append batching
+	WALAppendBatchEnabled          ParamItem `refreshable:"false"`
+	WALAppendBatchWindow           ParamItem `refreshable:"true"`
+	WALAppendBatchMaxMessages      ParamItem `refreshable:"true"`
+	WALAppendBatchMaxBytes         ParamItem `refreshable:"true"`
+	WALAppendBatchBulkMessageTypes ParamItem `refreshable:"true"`
+
+	// time tick index
+	WALTimeTickIndexInterval   ParamItem `refreshable:"true"`
+	WALTimeTickIndexMaxEntries ParamItem `refreshable:"true"`
+
+	// append deduplication
+	WALDedupEnabled    ParamItem `refreshable:"false"`
+	WALDedupWindow     ParamItem `refreshable:"true"`
+	WALDedupMaxEntries ParamItem `refreshable:"true"`
+
+	// checksum
+	WALChecksumEnabled ParamItem `refreshable:"true"`
+
+	// tiered wal
+	WALTieredEnabled           ParamItem `refreshable:"false"`
+	WALTieredLocalPath         ParamItem `refreshable:"false"`
+	WALTieredSegmentMaxSize    ParamItem `refreshable:"false"`
+	WALTieredMaxPendingEntries ParamItem `refreshable:"true"`
+	WALTieredCloseDrainTimeout ParamItem `refreshable:"true"`
+
 	// logging
 	LoggingAppendSlowThreshold ParamItem `refreshable:"true"`
 
@@ -7499,6 +7898,10 @@ type streamingConfig struct {
 	WALRecoveryMaxDirtyMessage           ParamItem `refreshable:"true"`
 	WALRecoveryGracefulCloseTimeout      ParamItem `refreshable:"true"`
 	WALRecoverySchemaExpirationTolerance ParamItem `refreshable:"true"`
+	WALRecoveryRetentionSyncInterval     ParamItem `refreshable:"true"`
+	WALRecoveryMaxConcurrency            ParamItem `refreshable:"true"`
+	WALRecoveryHandoffEnabled            ParamItem `refreshable:"true"`
+	WALRecoveryHandoffTimeout            ParamItem `refreshable:"true"`
 
 	// wal rate limit
 	WALRateLimitDefaultBurst                     ParamItem `refreshable:"true"`
@@ -7512,6 +7915,12 @@ type streamingConfig struct {
 	WALRateLimitAppendRateSlowdownThreshold      ParamItem `refreshable:"true"`
 	WALRateLimitAppendRateRecoverThreshold       ParamItem `refreshable:"true"`
 	WALRateLimitAppendRateAdaptiveRateLimit      AdaptiveRateLimitConfig
+	WALRateLimitConsumerLagEnabled               ParamItem `refreshable:"true"`
+	WALRateLimitConsumerLagSlowdownThreshold     ParamItem `refreshable:"true"`
+	WALRateLimitConsumerLagRejectThreshold       ParamItem `refreshable:"true"`
+	WALRateLimitConsumerLagRecoverThreshold      ParamItem `refreshable:"true"`
+	WALRateLimitConsumerLagAdaptiveRateLimit     AdaptiveRateLimitConfig
+	WALRateLimitRejectRetryAfter                 ParamItem `refreshable:"true"`
 
 	// Old version message lastConfirmedMessageID window size
 	OldVersionLastConfirmedWindowSize ParamItem `refreshable:"true"`
@@ -7529,6 +7938,36 @@ type streamingConfig struct {
 	// Replication pending message queue configuration
 	ReplicationPendingMessagesQueueLength  ParamItem `refreshable:"true"`
 	ReplicationPendingMessagesQueueMaxSize ParamItem `refreshable:"true"`
+
+	// Replication removed task garbage collection configuration
+	ReplicationRemovedTaskGCInterval ParamItem `refreshable:"true"`
+	ReplicationRemovedTaskGCTimeout  ParamItem `refreshable:"true"`
+
+	// ReplicationSourceRetentionWindow is the retention window of the source wal checked by the checkpoint reset.
+	ReplicationSourceRetentionWindow ParamItem `refreshable:"true"`
+
+	// ReplicationSchemaCheckInterval is the interval of checking the schema consistency between current cluster and its target clusters.
+	ReplicationSchemaCheckInterval ParamItem `refreshable:"true"`
+
+	// Replication health probe configuration of the target clusters.
+	ReplicationHealthProbeInterval ParamItem `refreshable:"true"`
+	ReplicationHealthProbeTimeout  ParamItem `refreshable:"true"`
+
+	// ReplicationConfigHistoryMaxCount is the maximum count of the replicate configuration history kept in the catalog.
+	ReplicationConfigHistoryMaxCount ParamItem `refreshable:"true"`
+
+	// Replication rate limit configuration, applied to each target cluster.
+	ReplicationRateLimitBytesPerSecond    ParamItem  `refreshable:"true"`
+	ReplicationRateLimitMessagesPerSecond ParamItem  `refreshable:"true"`
+	ReplicationRateLimitClusters          ParamGroup `refreshable:"true"`
+	ReplicationCompressionType            ParamItem  `refreshable:"true"`
+	ReplicationCompressionClusters        ParamGroup `refreshable:"true"`
+	ReplicationRetryInitialInterval       ParamItem  `refreshable:"false"`
+	ReplicationRetryMaxInterval           ParamItem  `refreshable:"false"`
+	ReplicationRetryMaxRetries            ParamItem  `refreshable:"true"`
+
+	// ReplicationBootstrapEnabled is the switch of bootstrapping the existing data into a new target cluster before the replication.
+	ReplicationBootstrapEnabled ParamItem `refreshable:"true"`
 }
 
 func (p *streamingConfig) init(base *BaseTable) {
@@ -7626,11 +8065,195 @@ If the operation exceeds this timeout, it will be canceled.`,
 		Export:       true,
 	}
 	p.WALBalancerOperationTimeout.Init(base.mgr)
+	p.WALBalancerLeaseEnabled = ParamItem{
+		Key:     "streaming.walBalancer.lease.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to enable the lease-and-ack assignment protocol, false by default.
+If enabled, the streamingnode should acknowledge the assignment and renew the lease of the wal periodically,
+the wal will be marked as unavailable and reassigned if the lease is expired.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALBalancerLeaseEnabled.Init(base.mgr)
+	p.WALBalancerLeaseTTL = ParamItem{
+		Key:     "streaming.walBalancer.lease.ttl",
+		Version: "3.0.0",
+		Doc: `The ttl of the wal lease granted to the streamingnode, 30s by default.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "30s",
+		Export:       true,
+	}
+	p.WALBalancerLeaseTTL.Init(base.mgr)
+	p.WALBalancerLeaseRenewInterval = ParamItem{
+		Key:     "streaming.walBalancer.lease.renewInterval",
+		Version: "3.0.0",
+		Doc: `The interval of the streamingnode to renew the wal lease, 5s by default.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "5s",
+		Export:       true,
+	}
+	p.WALBalancerLeaseRenewInterval.Init(base.mgr)
+
+	p.WALBalancerReadReplicaNum = ParamItem{
+		Key:     "streaming.walBalancer.readReplica.num",
+		Version: "3.0.0",
+		Doc: `The number of read-only replicas of each wal assigned to the streamingnodes other than the read-write owner, 0 by default.
+The read-heavy consumers (replication, CDC export, recovery) can be load-balanced across the replicas,
+the read-write owner still keeps the write ordering. 0 means no read replica.`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.WALBalancerReadReplicaNum.Init(base.mgr)
+
+	p.WALBalancerReadReplicaDelegatorEnabled = ParamItem{
+		Key:     "streaming.walBalancer.readReplica.delegatorEnabled",
+		Version: "3.0.0",
+		Doc: `Whether the delegators of querynode consume the wal from the read-only replicas, false by default.
+The query-side consumption is scaled out by the replicas without contending with the writer,
+the delegator falls back to the read-write owner if there's no ready replica. Only takes effect on the newly created consumers.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALBalancerReadReplicaDelegatorEnabled.Init(base.mgr)
+
+	p.WALBalancerReassignThrottleMaxReassignments = ParamItem{
+		Key:     "streaming.walBalancer.reassignThrottle.maxReassignments",
+		Version: "3.0.0",
+		Doc: `The max number of reassignments of the serving wal in one throttle interval, 0 by default.
+The wal that is not serving (never assigned, assigning or unavailable) is always assigned without throttling.
+It's used to bound the wal fencing storm when many streamingnodes flap, 0 means no limit.`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.WALBalancerReassignThrottleMaxReassignments.Init(base.mgr)
+
+	p.WALBalancerReassignThrottleInterval = ParamItem{
+		Key:     "streaming.walBalancer.reassignThrottle.interval",
+		Version: "3.0.0",
+		Doc: `The interval of reassign throttle window, 1m by default.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "1m",
+		Export:       true,
+	}
+	p.WALBalancerReassignThrottleInterval.Init(base.mgr)
+
+	p.WALBalancerReassignThrottleCooldown = ParamItem{
+		Key:     "streaming.walBalancer.reassignThrottle.cooldown",
+		Version: "3.0.0",
+		Doc: `The cooldown of each wal after it is assigned, 0s by default.
+The serving wal will not be reassigned again until the cooldown is passed, 0s means no cooldown.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "0s",
+		Export:       true,
+	}
+	p.WALBalancerReassignThrottleCooldown.Init(base.mgr)
+
+	p.WALBalancerDatabaseVChannelQuota = ParamItem{
+		Key:     "streaming.walBalancer.databaseQuota.maxVChannels",
+		Version: "3.0.0",
+		Doc: `The max number of vchannels that one database can allocate on the shared pchannels, 0 by default.
+The shared pchannels are the pchannels that are not in any pchannel pool, the database bound to a pchannel pool is not limited.
+The collection creation is rejected with quota exceeded error if the quota is reached, 0 means no limit.`,
+		DefaultValue: "0",
+		Export:       true,
+	}
+	p.WALBalancerDatabaseVChannelQuota.Init(base.mgr)
+
+	p.WALBalancerDatabaseVChannelQuotaOverrides = ParamItem{
+		Key:     "streaming.walBalancer.databaseQuota.overrides",
+		Version: "3.0.0",
+		Doc: `The vchannel quota of the specified databases in json format, such as {"db1": "16", "db2": "0"}.
+The override takes precedence over the maxVChannels, 0 means no limit for the database.`,
+		DefaultValue: "{}",
+		Export:       true,
+	}
+	p.WALBalancerDatabaseVChannelQuotaOverrides.Init(base.mgr)
+
+	p.WALBalancerAssignmentHistoryMaxCount = ParamItem{
+		Key:     "streaming.walBalancer.assignmentHistory.maxCount",
+		Version: "3.0.0",
+		Doc: `The max number of finished assignments kept in the ownership history of each pchannel, 16 by default.
+The ownership history is persisted with the pchannel meta and can be queried for postmortem, 0 means no history is kept.`,
+		DefaultValue: "16",
+		Export:       true,
+	}
+	p.WALBalancerAssignmentHistoryMaxCount.Init(base.mgr)
+
+	p.WALBalancerAssignmentHistoryMaxAge = ParamItem{
+		Key:     "streaming.walBalancer.assignmentHistory.maxAge",
+		Version: "3.0.0",
+		Doc: `The max age of the finished assignments kept in the ownership history of each pchannel, 168h by default.
+The last finished assignment is not trimmed by age, 0s means no age limit.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "168h",
+		Export:       true,
+	}
+	p.WALBalancerAssignmentHistoryMaxAge.Init(base.mgr)
+
+	p.WALBalancerAutoScaleEnabled = ParamItem{
+		Key:     "streaming.walBalancer.autoScale.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to add the pchannels automatically when the append throughput is sustained over the threshold, false by default.
+The pchannels are only added but never removed, and the pre-created topics (common.preCreatedTopic) are never scaled.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALBalancerAutoScaleEnabled.Init(base.mgr)
+
+	p.WALBalancerAutoScaleCheckInterval = ParamItem{
+		Key:     "streaming.walBalancer.autoScale.checkInterval",
+		Version: "3.0.0",
+		Doc: `The interval to check the append throughput of the pchannels, 30s by default.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "30s",
+		Export:       true,
+	}
+	p.WALBalancerAutoScaleCheckInterval.Init(base.mgr)
+
+	p.WALBalancerAutoScaleAppendBytesPerSecThreshold = ParamItem{
+		Key:     "streaming.walBalancer.autoScale.appendBytesPerSecondThreshold",
+		Version: "3.0.0",
+		Doc: `The threshold of the average append throughput of all pchannels in bytes per second, 16MB by default.
+The pchannels are added if the average append throughput is over the threshold for the sustained duration.`,
+		DefaultValue: "16777216",
+		Export:       true,
+	}
+	p.WALBalancerAutoScaleAppendBytesPerSecThreshold.Init(base.mgr)
+
+	p.WALBalancerAutoScaleSustainedDuration = ParamItem{
+		Key:     "streaming.walBalancer.autoScale.sustainedDuration",
+		Version: "3.0.0",
+		Doc: `The duration that the append throughput should be sustained over the threshold before adding pchannels, 5m by default.
+It's ok to set it into duration string, such as 30s or 1m30s, see time.ParseDuration`,
+		DefaultValue: "5m",
+		Export:       true,
+	}
+	p.WALBalancerAutoScaleSustainedDuration.Init(base.mgr)
+
+	p.WALBalancerAutoScaleMaxPChannelNum = ParamItem{
+		Key:          "streaming.walBalancer.autoScale.maxPChannelNum",
+		Version:      "3.0.0",
+		Doc:          `The max number of pchannels that the auto scale can grow to, 64 by default.`,
+		DefaultValue: "64",
+		Export:       true,
+	}
+	p.WALBalancerAutoScaleMaxPChannelNum.Init(base.mgr)
+
+	p.WALBalancerAutoScaleStep = ParamItem{
+		Key:          "streaming.walBalancer.autoScale.step",
+		Version:      "3.0.0",
+		Doc:          `The number of pchannels added at one scale, 1 by default.`,
+		DefaultValue: "1",
+		Export:       true,
+	}
+	p.WALBalancerAutoScaleStep.Init(base.mgr)
 
 	p.WALBalancerPolicyName = ParamItem{
-		Key:          "streaming.walBalancer.balancePolicy.name",
-		Version:      "2.6.0",
-		Doc:          "The multiplier of balance task trigger backoff, 2 by default",
+		Key:     "streaming.walBalancer.balancePolicy.name",
+		Version: "2.6.0",
+		Doc: `The name of balance policy, vchannelFair by default.
+Available policies: vchannelFair, roundRobin, weightedByLoad, sticky.
+The policy can be switched at runtime, the new policy takes effect at the next balance round.`,
 		DefaultValue: "vchannelFair",
 		Export:       true,
 	}
@@ -7717,6 +8340,17 @@ it also determine the depth of depth first search method that is used to find th
 	}
 	p.WALBalancerPolicyVChannelFairRebalanceMaxStep.Init(base.mgr)
 
+	p.WALBalancerPolicyWeightedByLoadThroughputUnit = ParamItem{
+		Key:     "streaming.walBalancer.balancePolicy.weightedByLoad.throughputUnit",
+		Version: "3.0.0",
+		Doc: `The append throughput per second that is weighted as one vchannel in weightedByLoad balance policy, 1m by default.
+The load of a pchannel is 1 + vchannel count + append throughput / throughputUnit,
+the hot pchannels will be placed on the lightly loaded streaming nodes.`,
+		DefaultValue: "1m",
+		Export:       true,
+	}
+	p.WALBalancerPolicyWeightedByLoadThroughputUnit.Init(base.mgr)
+
 	p.WALBalancerExpectedInitialStreamingNodeNum = ParamItem{
 		Key:     "streaming.walBalancer.expectedInitialStreamingNodeNum",
 		Version: "2.6.9",
@@ -7728,6 +8362,17 @@ then open the streaming service to continue the upgrade process.`,
 	}
 	p.WALBalancerExpectedInitialStreamingNodeNum.Init(base.mgr)
 
+	p.WALBalancerIDAllocatorBatchSize = ParamItem{
+		Key:     "streaming.walBalancer.idAllocator.batchSize",
+		Version: "3.0.0",
+		Doc: `The count of ids reserved into the metastore at once by the id allocator of streamingcoord, 1000 by default.
+The allocator is used to generate the globally unique suffix of vchannel name,
+the reserved but unused ids are skipped after the streamingcoord is restarted.`,
+		DefaultValue: "1000",
+		Export:       false,
+	}
+	p.WALBalancerIDAllocatorBatchSize.Init(base.mgr)
+
 	p.WALBroadcasterConcurrencyRatio = ParamItem{
 		Key:          "streaming.walBroadcaster.concurrencyRatio",
 		Version:      "2.5.4",
@@ -7770,6 +8415,16 @@ too few tombstones may lead to ABA issues in the state of milvus cluster.`,
 	}
 	p.WALBroadcasterTombstoneMaxLifetime.Init(base.mgr)
 
+	p.WALBroadcasterWatchBufferSize = ParamItem{
+		Key:     "streaming.walBroadcaster.watch.bufferSize",
+		Version: "3.0.0",
+		Doc: `The count of recent applied broadcast events kept in memory for watchers, 1024 by default.
+A watcher resuming from an evicted event will receive a resync event and should reload the state from meta.`,
+		DefaultValue: "1024",
+		Export:       false,
+	}
+	p.WALBroadcasterWatchBufferSize.Init(base.mgr)
+
 	// txn
 	p.TxnDefaultKeepaliveTimeout = ParamItem{
 		Key:          "streaming.txn.defaultKeepaliveTimeout",
@@ -7808,6 +8463,214 @@ Use the underlying wal default value if 0 is given.`,
 	}
 	p.WALReadAheadBufferLength.Init(base.mgr)
 
+	p.WALTimeTickAdaptiveSyncEnabled = ParamItem{
+		Key:     "streaming.walTimeTick.adaptiveSync.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to make the time tick sync interval of each wal adaptive to its append rate, false by default.
+If disabled, the time tick of every wal is synced at the fixed proxy.timeTickInterval.
+If enabled, the wal with appended messages is synced at the minInterval, and the idle wal backs off the interval up to the maxInterval,
+which reduces the idle time tick traffic of the streaming node with thousands of wal.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALTimeTickAdaptiveSyncEnabled.Init(base.mgr)
+
+	p.WALTimeTickAdaptiveSyncMinInterval = ParamItem{
+		Key:     "streaming.walTimeTick.adaptiveSync.minInterval",
+		Version: "3.0.0",
+		Doc: `The min time tick sync interval of the wal when the adaptive sync is enabled, 200ms by default.
+The wal with appended messages since last sync is always synced at this interval.`,
+		DefaultValue: "200ms",
+		Export:       true,
+	}
+	p.WALTimeTickAdaptiveSyncMinInterval.Init(base.mgr)
+
+	p.WALTimeTickAdaptiveSyncMaxInterval = ParamItem{
+		Key:     "streaming.walTimeTick.adaptiveSync.maxInterval",
+		Version: "3.0.0",
+		Doc: `The max time tick sync interval of the idle wal when the adaptive sync is enabled, 2s by default.
+The interval of the idle wal is doubled at every sync until it reaches this interval,
+a larger one reduces more idle traffic but delays the time tick of the idle wal seen by the consumers.`,
+		DefaultValue: "2s",
+		Export:       true,
+	}
+	p.WALTimeTickAdaptiveSyncMaxInterval.Init(base.mgr)
+
+	p.WALAppendBatchEnabled = ParamItem{
+		Key:     "streaming.walAppendBatch.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to coalesce the concurrent small appends of a wal into one produce of the underlying wal, false by default.
+It only works on the wal backend that supports batch append (rocksmq, kafka, pulsar), and every message keeps its own message id.
+It raises the throughput of high-QPS small inserts at the cost of at most one batch window of append latency.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALAppendBatchEnabled.Init(base.mgr)
+
+	p.WALAppendBatchWindow = ParamItem{
+		Key:     "streaming.walAppendBatch.window",
+		Version: "3.0.0",
+		Doc: `The max time to wait for more appends before the batch is produced, 2ms by default.
+The batch is produced immediately if it reaches the maxMessages or the maxBytes.`,
+		DefaultValue: "2ms",
+		Export:       true,
+	}
+	p.WALAppendBatchWindow.Init(base.mgr)
+
+	p.WALAppendBatchMaxMessages = ParamItem{
+		Key:          "streaming.walAppendBatch.maxMessages",
+		Version:      "3.0.0",
+		Doc:          `The max count of messages in one batch, 128 by default.`,
+		DefaultValue: "128",
+		Export:       true,
+	}
+	p.WALAppendBatchMaxMessages.Init(base.mgr)
+
+	p.WALAppendBatchMaxBytes = ParamItem{
+		Key:          "streaming.walAppendBatch.maxBytes",
+		Version:      "3.0.0",
+		Doc:          `The max estimated size of messages in one batch, 1m by default.`,
+		DefaultValue: "1m",
+		Export:       true,
+	}
+	p.WALAppendBatchMaxBytes.Init(base.mgr)
+
+	p.WALAppendBatchBulkMessageTypes = ParamItem{
+		Key:     "streaming.walAppendBatch.bulkMessageTypes",
+		Version: "3.0.0",
+		Doc: `The message types appended with the bulk lane of the append batch, Insert,Delete,Import by default.
+The other message types (flush, ddl, timetick, ...) are appended with the control lane, which is always served before the bulk lane,
+so the control messages are never stuck behind a burst of bulk inserts on the same pchannel.
+The name of message type is the name defined in messages.proto, e.g. Insert, Delete, Flush, ManualFlush.`,
+		DefaultValue: "Insert,Delete,Import",
+		Export:       true,
+	}
+	p.WALAppendBatchBulkMessageTypes.Init(base.mgr)
+
+	p.WALTimeTickIndexInterval = ParamItem{
+		Key:     "streaming.walTimeTickIndex.interval",
+		Version: "3.0.0",
+		Doc: `The min interval of the time tick between two entries of the in-memory time tick index of each wal, 1s by default.
+The index is used to seek the wal by a time tick, a smaller interval makes the seek more precise but costs more memory.`,
+		DefaultValue: "1s",
+		Export:       true,
+	}
+	p.WALTimeTickIndexInterval.Init(base.mgr)
+
+	p.WALTimeTickIndexMaxEntries = ParamItem{
+		Key:     "streaming.walTimeTickIndex.maxEntries",
+		Version: "3.0.0",
+		Doc: `The max count of entries of the in-memory time tick index of each wal, 3600 by default.
+The seek to a time tick older than the earliest entry starts from the earliest message of the wal.`,
+		DefaultValue: "3600",
+		Export:       true,
+	}
+	p.WALTimeTickIndexMaxEntries.Init(base.mgr)
+
+	p.WALDedupEnabled = ParamItem{
+		Key:     "streaming.walDedup.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to deduplicate the retried appends of the streaming client, false by default.
+If enabled, the streaming client attaches a producer id, an epoch and a sequence to every message,
+and the wal drops the duplicate append with the same producer id, epoch, sequence and vchannel within the window,
+so a retry after the first append actually succeeded doesn't write the message twice.
+A retry arriving after the window is rejected as duplicate, and a new append of an older epoch of the producer is fenced.
+The deduplication state is kept in memory by each wal instance and is not persisted,
+so a retry arriving after the wal is reopened, e.g. after the pchannel is reassigned or the streaming node restarts, is not deduplicated.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALDedupEnabled.Init(base.mgr)
+
+	p.WALDedupWindow = ParamItem{
+		Key:     "streaming.walDedup.window",
+		Version: "3.0.0",
+		Doc: `The sliding window of the append deduplication, 5m by default.
+The duplicate append arrives after the window is rejected as duplicate without the result of the first append.`,
+		DefaultValue: "5m",
+		Export:       true,
+	}
+	p.WALDedupWindow.Init(base.mgr)
+
+	p.WALDedupMaxEntries = ParamItem{
+		Key:     "streaming.walDedup.maxEntries",
+		Version: "3.0.0",
+		Doc: `The max count of the appends remembered by the deduplication of each wal, 100000 by default.
+The earliest append is forgotten before the window is elapsed if the count is exceeded.`,
+		DefaultValue: "100000",
+		Export:       true,
+	}
+	p.WALDedupMaxEntries.Init(base.mgr)
+
+	p.WALChecksumEnabled = ParamItem{
+		Key:     "streaming.walChecksum.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to attach a crc32c checksum of the payload to every message appended into the wal, false by default.
+The checksum is always verified when the message is scanned from the wal if it's attached,
+a corrupted message stops the scanner with an error of the channel and message id instead of being delivered to the consumer.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALChecksumEnabled.Init(base.mgr)
+
+	p.WALTieredEnabled = ParamItem{
+		Key:     "streaming.walTiered.enabled",
+		Version: "3.0.0",
+		Doc: `Whether to create the wal of new pchannels as a tiered wal, false by default.
+The tiered wal appends the message into a local fsynced log of the streaming node and acknowledges it,
+then uploads it into the mq selected by mq.type asynchronously, and reconciles the local log with the mq when it's reopened on the same node.
+The messages acknowledged but not uploaded yet are lost if the pchannel is reassigned to another node before the old node comes back,
+so the count of them is bounded by maxPendingEntries, and the wal waits for the upload when it's closed gracefully.
+The pchannels created before are kept on their current wal.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALTieredEnabled.Init(base.mgr)
+
+	p.WALTieredLocalPath = ParamItem{
+		Key:     "streaming.walTiered.localPath",
+		Version: "3.0.0",
+		Doc:     `The local directory of the tiered wal, "tiered_wal" under localStorage.path by default.`,
+		Formatter: func(v string) string {
+			if len(v) == 0 {
+				return path.Join(getLocalStoragePath(base), "tiered_wal")
+			}
+			return v
+		},
+		Export: true,
+	}
+	p.WALTieredLocalPath.Init(base.mgr)
+
+	p.WALTieredSegmentMaxSize = ParamItem{
+		Key:     "streaming.walTiered.segmentMaxSize",
+		Version: "3.0.0",
+		Doc: `The max size of a segment file of the local log of the tiered wal, 64m by default.
+A segment file is removed after all its messages are uploaded into the mq.`,
+		DefaultValue: "64m",
+		Export:       true,
+	}
+	p.WALTieredSegmentMaxSize.Init(base.mgr)
+
+	p.WALTieredMaxPendingEntries = ParamItem{
+		Key:     "streaming.walTiered.maxPendingEntries",
+		Version: "3.0.0",
+		Doc: `The max count of the messages of a tiered wal which are acknowledged but not uploaded into the mq, 10000 by default.
+The append is blocked until the upload catches up if the count is exceeded.`,
+		DefaultValue: "10000",
+		Export:       true,
+	}
+	p.WALTieredMaxPendingEntries.Init(base.mgr)
+
+	p.WALTieredCloseDrainTimeout = ParamItem{
+		Key:     "streaming.walTiered.closeDrainTimeout",
+		Version: "3.0.0",
+		Doc: `The max duration that the tiered wal waits for the pending messages to be uploaded when it's closed, 30s by default.
+The messages not uploaded are kept in the local log and uploaded when the wal is reopened on the same node.`,
+		DefaultValue: "30s",
+		Export:       true,
+	}
+	p.WALTieredCloseDrainTimeout.Init(base.mgr)
+
 	p.LoggingAppendSlowThreshold = ParamItem{
 		Key:     "streaming.logging.appendSlowThreshold",
 		Version: "2.6.0",
@@ -7924,6 +8787,49 @@ If the schema is older than (the channel checkpoint - tolerance), it will be rem
 	}
 	p.WALRecoverySchemaExpirationTolerance.Init(base.mgr)
 
+	p.WALRecoveryRetentionSyncInterval = ParamItem{
+		Key:     "streaming.walRecovery.retentionSyncInterval",
+		Version: "3.0.0",
+		Doc: `The interval of the streamingnode to sync the retention of wal from streamingcoord, 1m by default.
+The retention includes the retention policy of the pchannel and the checkpoints confirmed by the replicating tasks of the pchannel,
+the wal is never truncated beyond the synced retention, and the truncation is held until the first sync is done.`,
+		DefaultValue: "1m",
+		Export:       true,
+	}
+	p.WALRecoveryRetentionSyncInterval.Init(base.mgr)
+
+	p.WALRecoveryMaxConcurrency = ParamItem{
+		Key:     "streaming.walRecovery.maxConcurrency",
+		Version: "3.0.0",
+		Doc: `The max number of wals that replay the recovery stream concurrently on a streamingnode, 16 by default.
+The wals of different pchannels are recovered in parallel when the streamingnode takes over many pchannels at failover,
+the recovery of a pchannel is always sequential, and the exceeded recoveries wait until a running one is done.`,
+		DefaultValue: "16",
+		Export:       true,
+	}
+	p.WALRecoveryMaxConcurrency.Init(base.mgr)
+
+	p.WALRecoveryHandoffEnabled = ParamItem{
+		Key:     "streaming.walRecovery.handoffEnabled",
+		Version: "3.0.0",
+		Doc: `Whether to hand off the wal cleanly when the wal is closed gracefully, false by default.
+A persisted time tick is synced after all append operations are finished, and a clean handoff marker is persisted with the checkpoint,
+so the new owner of the wal skips the replay of wal stream if no message is appended after the checkpoint.
+The streamingnode also reports the closed wals to streamingcoord when shutting down, so the wals are reassigned right away.`,
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.WALRecoveryHandoffEnabled.Init(base.mgr)
+
+	p.WALRecoveryHandoffTimeout = ParamItem{
+		Key:          "streaming.walRecovery.handoffTimeout",
+		Version:      "3.0.0",
+		Doc:          `The timeout of the clean handoff of a wal, the wal is closed without the clean handoff marker if timeout, 3s by default.`,
+		DefaultValue: "3s",
+		Export:       true,
+	}
+	p.WALRecoveryHandoffTimeout.Init(base.mgr)
+
 	p.OldVersionLastConfirmedWindowSize = ParamItem{
 		Key:     "streaming.walScanner.oldVersionLastConfirmedWindowSize",
 		Version: "2.6.13",
@@ -7996,6 +8902,167 @@ so we set 1 second here as a threshold.`,
 	}
 	p.ReplicationPendingMessagesQueueMaxSize.Init(base.mgr)
 
+	p.ReplicationRemovedTaskGCInterval = ParamItem{
+		Key:          "streaming.replication.removedTaskGCInterval",
+		Version:      "3.0.0",
+		DefaultValue: "10m",
+		Doc:          "The interval of checking the replicating tasks of the replication edges removed from the replicate configuration.",
+		Export:       true,
+	}
+	p.ReplicationRemovedTaskGCInterval.Init(base.mgr)
+
+	p.ReplicationRemovedTaskGCTimeout = ParamItem{
+		Key:          "streaming.replication.removedTaskGCTimeout",
+		Version:      "3.0.0",
+		DefaultValue: "1h",
+		Doc: `The timeout of waiting the CDC to remove the replicating task of a removed replication edge.
+The CDC removes the task after the target cluster confirms the configuration change,
+the task is removed forcibly by streamingcoord after the timeout, e.g. the target cluster is unreachable.`,
+		Export: true,
+	}
+	p.ReplicationRemovedTaskGCTimeout.Init(base.mgr)
+
+	p.ReplicationSourceRetentionWindow = ParamItem{
+		Key:          "streaming.replication.sourceRetentionWindow",
+		Version:      "3.0.0",
+		DefaultValue: "72h",
+		Doc: `The retention window of the wal of the source cluster.
+Resetting the checkpoint of a replicating task to a position older than the window is rejected unless it's confirmed explicitly,
+because the messages may be truncated from the source wal.`,
+		Export: true,
+	}
+	p.ReplicationSourceRetentionWindow.Init(base.mgr)
+
+	p.ReplicationSchemaCheckInterval = ParamItem{
+		Key:          "streaming.replication.schemaCheckInterval",
+		Version:      "3.0.0",
+		DefaultValue: "30m",
+		Doc: `The interval of checking the collection schemas and vchannel mappings between current cluster and its target clusters.
+The drifts are reported by the warning logs and metrics of streamingcoord, 0 means the periodic check is disabled.`,
+		Export: true,
+	}
+	p.ReplicationSchemaCheckInterval.Init(base.mgr)
+
+	p.ReplicationHealthProbeInterval = ParamItem{
+		Key:          "streaming.replication.healthProbe.interval",
+		Version:      "3.0.0",
+		DefaultValue: "30s",
+		Doc: `The interval of probing the reachability of the target clusters of current cluster, 0 means the probe is disabled.
+The reachability is reported by the metrics and the exported state of streamingcoord, so an unreachable target cluster is found before the replication lag accumulates.`,
+		Export: true,
+	}
+	p.ReplicationHealthProbeInterval.Init(base.mgr)
+
+	p.ReplicationHealthProbeTimeout = ParamItem{
+		Key:          "streaming.replication.healthProbe.timeout",
+		Version:      "3.0.0",
+		DefaultValue: "5s",
+		Doc:          "The timeout of probing a target cluster, the target cluster is unreachable if the probe is timeout.",
+		Export:       true,
+	}
+	p.ReplicationHealthProbeTimeout.Init(base.mgr)
+
+	p.ReplicationConfigHistoryMaxCount = ParamItem{
+		Key:          "streaming.replication.configHistoryMaxCount",
+		Version:      "3.0.0",
+		DefaultValue: "100",
+		Doc: `The maximum count of the accepted replicate configurations kept in the history, the oldest ones are removed first.
+The history is used to audit the changes of the replication topology and roll back to a known-good version.`,
+		Export: true,
+	}
+	p.ReplicationConfigHistoryMaxCount.Init(base.mgr)
+
+	p.ReplicationRateLimitBytesPerSecond = ParamItem{
+		Key:          "streaming.replication.rateLimit.bytesPerSecond",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc: `The maximum bytes per second replicated from current cluster to each target cluster, 0 means unlimited.
+The limit is shared by all replicating channels to the same target cluster, so the replication traffic cannot saturate the network shared with user requests.
+The unit suffix such as 10mb is supported.`,
+		Export: true,
+	}
+	p.ReplicationRateLimitBytesPerSecond.Init(base.mgr)
+
+	p.ReplicationRateLimitMessagesPerSecond = ParamItem{
+		Key:          "streaming.replication.rateLimit.messagesPerSecond",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc:          "The maximum messages per second replicated from current cluster to each target cluster, 0 means unlimited.",
+		Export:       true,
+	}
+	p.ReplicationRateLimitMessagesPerSecond.Init(base.mgr)
+
+	p.ReplicationRateLimitClusters = ParamGroup{
+		KeyPrefix: "streaming.replication.rateLimit.clusters.",
+		Version:   "3.0.0",
+		Doc: `The rate limit of the specified target cluster, overrides the default limit,
+e.g. streaming.replication.rateLimit.clusters.<clusterID>.bytesPerSecond and streaming.replication.rateLimit.clusters.<clusterID>.messagesPerSecond.`,
+		Export: true,
+	}
+	p.ReplicationRateLimitClusters.Init(base.mgr)
+
+	p.ReplicationCompressionType = ParamItem{
+		Key:          "streaming.replication.compression.type",
+		Version:      "3.0.0",
+		DefaultValue: "none",
+		Doc: `The compression of the replication stream from current cluster to each target cluster, one of none, zstd and lz4.
+zstd has higher compression ratio, lz4 costs less cpu. It cuts the WAN bandwidth of large insert payloads.
+If the target cluster doesn't support the compression, the replication stream falls back to no compression.
+The change is applied when the replication stream is reconnected.`,
+		Export: true,
+	}
+	p.ReplicationCompressionType.Init(base.mgr)
+
+	p.ReplicationCompressionClusters = ParamGroup{
+		KeyPrefix: "streaming.replication.compression.clusters.",
+		Version:   "3.0.0",
+		Doc: `The compression of the specified target cluster, overrides the default compression,
+e.g. streaming.replication.compression.clusters.<clusterID>: zstd.`,
+		Export: true,
+	}
+	p.ReplicationCompressionClusters.Init(base.mgr)
+
+	p.ReplicationRetryInitialInterval = ParamItem{
+		Key:          "streaming.replication.retry.initialInterval",
+		Version:      "3.0.0",
+		DefaultValue: "100ms",
+		Doc:          "The initial backoff interval of reconnecting the replication stream, the interval is doubled after each failure.",
+		Export:       true,
+	}
+	p.ReplicationRetryInitialInterval.Init(base.mgr)
+
+	p.ReplicationRetryMaxInterval = ParamItem{
+		Key:          "streaming.replication.retry.maxInterval",
+		Version:      "3.0.0",
+		DefaultValue: "10s",
+		Doc:          "The maximum backoff interval of reconnecting the replication stream.",
+		Export:       true,
+	}
+	p.ReplicationRetryMaxInterval.Init(base.mgr)
+
+	p.ReplicationRetryMaxRetries = ParamItem{
+		Key:          "streaming.replication.retry.maxRetries",
+		Version:      "3.0.0",
+		DefaultValue: "0",
+		Doc: `The maximum times of retrying a message rejected by the target cluster, e.g. the collection schema is missing, 0 means retry forever.
+When the threshold is reached, the replicating task is parked with a dead letter recorded in the catalog,
+and it's resumed after the dead letter is requeued by the operator. The rejected message is never skipped, so the replication keeps in order.`,
+		Export: true,
+	}
+	p.ReplicationRetryMaxRetries.Init(base.mgr)
+
+	p.ReplicationBootstrapEnabled = ParamItem{
+		Key:          "streaming.replication.bootstrap.enabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc: `Whether the replicating tasks to a new target cluster are created in bootstrapping state, applied by the source cluster.
+The cdc copies the databases, collections and rows existing at the initialized checkpoint of the bootstrapping task into the target cluster,
+by reading the flushed binlogs of the source cluster, then switches the task into running to replicate the wal from the checkpoint.
+The bootstrap should be finished within the retention window of the source wal.`,
+		Export: true,
+	}
+	p.ReplicationBootstrapEnabled.Init(base.mgr)
+
 	p.WALRateLimitDefaultBurst = ParamItem{
 		Key:          "streaming.walRateLimit.defaultBurst",
 		Version:      "2.6.9",
@@ -8118,6 +9185,66 @@ so we set 1 second here as a threshold.`,
 		RecoveryIncreaseInterval:     "1s",
 		Export:                       false,
 	})
+
+	p.WALRateLimitConsumerLagEnabled = ParamItem{
+		Key:          "streaming.walRateLimit.consumerLag.enabled",
+		Version:      "3.0.0",
+		Doc:          "Whether to enable the consumer lag rate limiter, false by default. When enabled, the rate limiter will throttle writes when the flusher or the replicating tasks fall far behind the wal.",
+		DefaultValue: "false",
+		Export:       false,
+	}
+	p.WALRateLimitConsumerLagEnabled.Init(base.mgr)
+
+	p.WALRateLimitConsumerLagSlowdownThreshold = ParamItem{
+		Key:          "streaming.walRateLimit.consumerLag.slowdownThreshold",
+		Version:      "3.0.0",
+		Doc:          "When the lag of the slowest consumer of the wal is greater than this threshold, the consumer lag rate limiter will enter slowdown mode, 10m by default.",
+		DefaultValue: "10m",
+		Export:       false,
+	}
+	p.WALRateLimitConsumerLagSlowdownThreshold.Init(base.mgr)
+
+	p.WALRateLimitConsumerLagRejectThreshold = ParamItem{
+		Key:          "streaming.walRateLimit.consumerLag.rejectThreshold",
+		Version:      "3.0.0",
+		Doc:          "When the lag of the slowest consumer of the wal is greater than this threshold, the consumer lag rate limiter will enter reject mode, 30m by default.",
+		DefaultValue: "30m",
+		Export:       false,
+	}
+	p.WALRateLimitConsumerLagRejectThreshold.Init(base.mgr)
+
+	p.WALRateLimitConsumerLagRecoverThreshold = ParamItem{
+		Key:          "streaming.walRateLimit.consumerLag.recoverThreshold",
+		Version:      "3.0.0",
+		Doc:          "When the lag of the slowest consumer of the wal is less than this threshold, the consumer lag rate limiter will enter recovery mode, 5m by default.",
+		DefaultValue: "5m",
+		Export:       false,
+	}
+	p.WALRateLimitConsumerLagRecoverThreshold.Init(base.mgr)
+
+	p.WALRateLimitConsumerLagAdaptiveRateLimit.init(base, "streaming.walRateLimit.consumerLag.adaptiveRateLimit", AdaptiveRateLimitConfigDefaultValue{
+		SlowdownStartupDelayInterval: "0",
+		SlowdownHWM:                  "16mb",
+		SlowdownLWM:                  "1mb",
+		SlowdownDecreaseInterval:     "10s",
+		SlowdownDecreaseRatio:        "0.8",
+		SlowdownRejectDelayInterval:  "0",
+		RecoveryHWM:                  "32mb",
+		RecoveryLWM:                  "4mb",
+		RecoveryNormalDelayInterval:  "10s",
+		RecoveryIncremental:          "1mb",
+		RecoveryIncreaseInterval:     "1s",
+		Export:                       false,
+	})
+
+	p.WALRateLimitRejectRetryAfter = ParamItem{
+		Key:          "streaming.walRateLimit.rejectRetryAfter",
+		Version:      "3.0.0",
+		Doc:          "The delay suggested to the caller to retry the write when the wal rejects the writes, 1s by default.",
+		DefaultValue: "1s",
+		Export:       false,
+	}
+	p.WALRateLimitRejectRetryAfter.Init(base.mgr)
 }
 
 type AdaptiveRateLimitConfigDefaultValue struct {
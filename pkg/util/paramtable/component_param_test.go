@@ -457,11 +457,21 @@ func TestComponentParam(t *testing.T) {
 		params.Reset("queryCoord.collectionObserverInterval")
 
 		assert.Equal(t, 0.1, Params.DelegatorMemoryOverloadFactor.GetAsFloat())
+		assert.Equal(t, 0.0, Params.BalanceQPSWeight.GetAsFloat())
 		assert.Equal(t, 5, Params.CollectionBalanceSegmentBatchSize.GetAsInt())
 		assert.Equal(t, 1, Params.CollectionBalanceChannelBatchSize.GetAsInt())
 
 		assert.Equal(t, 0, Params.ClusterLevelLoadReplicaNumber.GetAsInt())
 		assert.Len(t, Params.ClusterLevelLoadResourceGroups.GetAsStrings(), 0)
+		assert.False(t, Params.DatabaseResourceGroupIsolation.GetAsBool())
+		assert.Equal(t, 600*time.Second, Params.ReplicaHandoverTimeout.GetAsDuration(time.Second))
+		assert.False(t, Params.ReplicaAutoScaleEnabled.GetAsBool())
+		assert.Equal(t, int32(1), Params.ReplicaAutoScaleMinReplicaNumber.GetAsInt32())
+		assert.Equal(t, int32(3), Params.ReplicaAutoScaleMaxReplicaNumber.GetAsInt32())
+		assert.Equal(t, 100.0, Params.ReplicaAutoScaleUpQPS.GetAsFloat())
+		assert.Equal(t, 10.0, Params.ReplicaAutoScaleDownQPS.GetAsFloat())
+		assert.Equal(t, 10*time.Minute, Params.ReplicaAutoScaleSustainDuration.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.ReplicaAutoScaleCheckInterval.GetAsDuration(time.Second))
 
 		assert.Equal(t, 10, Params.CollectionChannelCountFactor.GetAsInt())
 		assert.Equal(t, 3000, Params.AutoBalanceInterval.GetAsInt())
@@ -548,6 +558,9 @@ func TestComponentParam(t *testing.T) {
 		nprobe = Params.InterimIndexNProbe.GetAsInt64()
 		assert.Equal(t, int64(16), nprobe)
 
+		assert.Equal(t, false, Params.InterimIndexAsyncBuild.GetAsBool())
+		assert.Equal(t, int64(1), Params.InterimIndexAsyncConcurrency.GetAsInt64())
+
 		params.Remove("queryNode.segcore.growing.nlist")
 		params.Remove("queryNode.segcore.growing.nprobe")
 		params.Save("queryNode.segcore.chunkRows", "64")
@@ -587,6 +600,14 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, "/var/lib/milvus/data/mmap", Params.MmapDirPath.GetValue())
 
 		assert.Equal(t, 60*time.Second, Params.DiskSizeFetchInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.LoadMaxConcurrency.GetAsInt())
+		assert.Equal(t, 0, Params.LoadMaxConcurrencyPerCollection.GetAsInt())
+		assert.Equal(t, time.Duration(0), Params.LoadResourceWaitTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, int64(0), Params.DeferredIndexLoadMinSize.GetAsInt64())
+		assert.Equal(t, 60*time.Second, Params.SegmentQPSReportInterval.GetAsDuration(time.Second))
+		assert.False(t, Params.SearchResultCacheEnabled.GetAsBool())
+		assert.Equal(t, 128, Params.SearchResultCacheCapacity.GetAsInt())
+		assert.Equal(t, 60*time.Second, Params.SearchResultCacheTTL.GetAsDuration(time.Second))
 
 		assert.Equal(t, 1.0, Params.PartialResultRequiredDataRatio.GetAsFloat())
 		params.Save(Params.PartialResultRequiredDataRatio.Key, "0.8")
@@ -605,6 +626,19 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 5*time.Second, Params.CatchUpStreamingDataTsLag.GetAsDurationByParse())
 		params.Save(Params.CatchUpStreamingDataTsLag.Key, "0s")
 		assert.Equal(t, time.Duration(0), Params.CatchUpStreamingDataTsLag.GetAsDurationByParse())
+
+		assert.False(t, Params.DeleteBufferSpillEnabled.GetAsBool())
+		assert.Equal(t, int64(256*1024*1024), Params.DeleteBufferSpillMemoryLimit.GetAsInt64())
+
+		assert.False(t, Params.SegmentCacheEnabled.GetAsBool())
+		assert.Equal(t, int64(float64(hardware.GetMemoryCount())*Params.OverloadedMemoryThresholdPercentage.GetAsFloat()), Params.SegmentCacheCapacity.GetAsSize())
+		params.Save(Params.SegmentCacheCapacity.Key, "64g")
+		assert.Equal(t, int64(64*1024*1024*1024), Params.SegmentCacheCapacity.GetAsSize())
+		params.Reset(Params.SegmentCacheCapacity.Key)
+		assert.Equal(t, 1, Params.SegmentCacheAdmissionMinAccessCount.GetAsInt())
+
+		assert.False(t, Params.LazyFieldEnabled.GetAsBool())
+		assert.Equal(t, 1, Params.LazyFieldHotFieldMinAccessCount.GetAsInt())
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
@@ -644,6 +678,8 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, float64(100), Params.CompactionGCIntervalInSeconds.GetAsDuration(time.Second).Seconds())
 		params.Save("dataCoord.compaction.dropTolerance", "100")
 		assert.Equal(t, float64(100), Params.CompactionDropToleranceInSeconds.GetAsDuration(time.Second).Seconds())
+		assert.Equal(t, "default", Params.MixCompactionPolicy.GetValue())
+		assert.Equal(t, 24*time.Hour, Params.MixCompactionTimeWindow.GetAsDuration(time.Second))
 		assert.Equal(t, int64(10000), Params.CompactionPreAllocateIDExpansionFactor.GetAsInt64())
 		assert.False(t, Params.StorageFormatCompactionEnabled.GetAsBool())
 
@@ -765,6 +801,11 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 0.25, Params.StandaloneSlotRatio.GetAsFloat())
 	})
 
+	t.Run("test gpuConfig", func(t *testing.T) {
+		assert.Equal(t, 0.95, params.GpuConfig.OverloadedMemoryThresholdPercentage.GetAsFloat())
+		assert.False(t, params.GpuConfig.MemoryPoolEvictionEnabled.GetAsBool())
+	})
+
 	t.Run("test streamingConfig", func(t *testing.T) {
 		assert.Equal(t, false, params.StreamingCfg.WALScannerPauseConsumption.GetAsBool())
 		assert.Equal(t, 1*time.Minute, params.StreamingCfg.WALBalancerTriggerInterval.GetAsDurationByParse())
@@ -780,20 +821,80 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 0.01, params.StreamingCfg.WALBalancerPolicyVChannelFairAntiAffinityWeight.GetAsFloat())
 		assert.Equal(t, 0.01, params.StreamingCfg.WALBalancerPolicyVChannelFairRebalanceTolerance.GetAsFloat())
 		assert.Equal(t, 3, params.StreamingCfg.WALBalancerPolicyVChannelFairRebalanceMaxStep.GetAsInt())
+		assert.Equal(t, int64(1024*1024), params.StreamingCfg.WALBalancerPolicyWeightedByLoadThroughputUnit.GetAsSize())
 		assert.Equal(t, 30*time.Minute, params.StreamingCfg.WALBalancerOperationTimeout.GetAsDurationByParse())
+		assert.Equal(t, false, params.StreamingCfg.WALBalancerLeaseEnabled.GetAsBool())
+		assert.Equal(t, 30*time.Second, params.StreamingCfg.WALBalancerLeaseTTL.GetAsDurationByParse())
+		assert.Equal(t, 5*time.Second, params.StreamingCfg.WALBalancerLeaseRenewInterval.GetAsDurationByParse())
+		assert.Equal(t, 0, params.StreamingCfg.WALBalancerReadReplicaNum.GetAsInt())
+		assert.False(t, params.StreamingCfg.WALBalancerReadReplicaDelegatorEnabled.GetAsBool())
+		assert.Equal(t, 0, params.StreamingCfg.WALBalancerReassignThrottleMaxReassignments.GetAsInt())
+		assert.Equal(t, time.Minute, params.StreamingCfg.WALBalancerReassignThrottleInterval.GetAsDurationByParse())
+		assert.Equal(t, time.Duration(0), params.StreamingCfg.WALBalancerReassignThrottleCooldown.GetAsDurationByParse())
+		assert.Equal(t, 0, params.StreamingCfg.WALBalancerDatabaseVChannelQuota.GetAsInt())
+		assert.Empty(t, params.StreamingCfg.WALBalancerDatabaseVChannelQuotaOverrides.GetAsJSONMap())
+		assert.Equal(t, 16, params.StreamingCfg.WALBalancerAssignmentHistoryMaxCount.GetAsInt())
+		assert.Equal(t, 168*time.Hour, params.StreamingCfg.WALBalancerAssignmentHistoryMaxAge.GetAsDurationByParse())
+		assert.False(t, params.StreamingCfg.WALBalancerAutoScaleEnabled.GetAsBool())
+		assert.Equal(t, 30*time.Second, params.StreamingCfg.WALBalancerAutoScaleCheckInterval.GetAsDurationByParse())
+		assert.Equal(t, float64(16777216), params.StreamingCfg.WALBalancerAutoScaleAppendBytesPerSecThreshold.GetAsFloat())
+		assert.Equal(t, 5*time.Minute, params.StreamingCfg.WALBalancerAutoScaleSustainedDuration.GetAsDurationByParse())
+		assert.Equal(t, 64, params.StreamingCfg.WALBalancerAutoScaleMaxPChannelNum.GetAsInt())
+		assert.Equal(t, 1, params.StreamingCfg.WALBalancerAutoScaleStep.GetAsInt())
 		assert.Equal(t, 4.0, params.StreamingCfg.WALBroadcasterConcurrencyRatio.GetAsFloat())
 		assert.Equal(t, 5*time.Minute, params.StreamingCfg.WALBroadcasterTombstoneCheckInternal.GetAsDurationByParse())
 		assert.Equal(t, 8192, params.StreamingCfg.WALBroadcasterTombstoneMaxCount.GetAsInt())
 		assert.Equal(t, 24*time.Hour, params.StreamingCfg.WALBroadcasterTombstoneMaxLifetime.GetAsDurationByParse())
+		assert.Equal(t, 1024, params.StreamingCfg.WALBroadcasterWatchBufferSize.GetAsInt())
+		assert.Equal(t, 1000, params.StreamingCfg.WALBalancerIDAllocatorBatchSize.GetAsInt())
+		assert.Equal(t, 10*time.Minute, params.StreamingCfg.ReplicationRemovedTaskGCInterval.GetAsDurationByParse())
+		assert.Equal(t, time.Hour, params.StreamingCfg.ReplicationRemovedTaskGCTimeout.GetAsDurationByParse())
+		assert.Equal(t, 72*time.Hour, params.StreamingCfg.ReplicationSourceRetentionWindow.GetAsDurationByParse())
+		assert.Equal(t, 30*time.Minute, params.StreamingCfg.ReplicationSchemaCheckInterval.GetAsDurationByParse())
+		assert.Equal(t, int64(0), params.StreamingCfg.ReplicationRateLimitBytesPerSecond.GetAsSize())
+		assert.Equal(t, int64(0), params.StreamingCfg.ReplicationRateLimitMessagesPerSecond.GetAsInt64())
+		assert.Empty(t, params.StreamingCfg.ReplicationRateLimitClusters.GetValue())
+		assert.Equal(t, "none", params.StreamingCfg.ReplicationCompressionType.GetValue())
+		assert.Empty(t, params.StreamingCfg.ReplicationCompressionClusters.GetValue())
+		assert.Equal(t, 100, params.StreamingCfg.ReplicationConfigHistoryMaxCount.GetAsInt())
+		assert.Equal(t, 30*time.Second, params.StreamingCfg.ReplicationHealthProbeInterval.GetAsDurationByParse())
+		assert.Equal(t, 5*time.Second, params.StreamingCfg.ReplicationHealthProbeTimeout.GetAsDurationByParse())
+		assert.Equal(t, 100*time.Millisecond, params.StreamingCfg.ReplicationRetryInitialInterval.GetAsDurationByParse())
+		assert.Equal(t, 10*time.Second, params.StreamingCfg.ReplicationRetryMaxInterval.GetAsDurationByParse())
+		assert.Equal(t, 0, params.StreamingCfg.ReplicationRetryMaxRetries.GetAsInt())
+		assert.False(t, params.StreamingCfg.ReplicationBootstrapEnabled.GetAsBool())
 		assert.Equal(t, 10*time.Second, params.StreamingCfg.TxnDefaultKeepaliveTimeout.GetAsDurationByParse())
 		assert.Equal(t, 30*time.Second, params.StreamingCfg.WALWriteAheadBufferKeepalive.GetAsDurationByParse())
 		assert.Equal(t, int64(64*1024*1024), params.StreamingCfg.WALWriteAheadBufferCapacity.GetAsSize())
 		assert.Equal(t, 128, params.StreamingCfg.WALReadAheadBufferLength.GetAsInt())
+		assert.False(t, params.StreamingCfg.WALTimeTickAdaptiveSyncEnabled.GetAsBool())
+		assert.Equal(t, 200*time.Millisecond, params.StreamingCfg.WALTimeTickAdaptiveSyncMinInterval.GetAsDurationByParse())
+		assert.Equal(t, 2*time.Second, params.StreamingCfg.WALTimeTickAdaptiveSyncMaxInterval.GetAsDurationByParse())
+		assert.False(t, params.StreamingCfg.WALAppendBatchEnabled.GetAsBool())
+		assert.Equal(t, 2*time.Millisecond, params.StreamingCfg.WALAppendBatchWindow.GetAsDurationByParse())
+		assert.Equal(t, 128, params.StreamingCfg.WALAppendBatchMaxMessages.GetAsInt())
+		assert.Equal(t, int64(1024*1024), params.StreamingCfg.WALAppendBatchMaxBytes.GetAsSize())
+		assert.Equal(t, []string{"Insert", "Delete", "Import"}, params.StreamingCfg.WALAppendBatchBulkMessageTypes.GetAsStrings())
+		assert.Equal(t, time.Second, params.StreamingCfg.WALTimeTickIndexInterval.GetAsDurationByParse())
+		assert.Equal(t, 3600, params.StreamingCfg.WALTimeTickIndexMaxEntries.GetAsInt())
+		assert.False(t, params.StreamingCfg.WALDedupEnabled.GetAsBool())
+		assert.Equal(t, 5*time.Minute, params.StreamingCfg.WALDedupWindow.GetAsDurationByParse())
+		assert.Equal(t, 100000, params.StreamingCfg.WALDedupMaxEntries.GetAsInt())
+		assert.False(t, params.StreamingCfg.WALChecksumEnabled.GetAsBool())
+		assert.False(t, params.StreamingCfg.WALTieredEnabled.GetAsBool())
+		assert.Contains(t, params.StreamingCfg.WALTieredLocalPath.GetValue(), "tiered_wal")
+		assert.Equal(t, int64(64*1024*1024), params.StreamingCfg.WALTieredSegmentMaxSize.GetAsSize())
+		assert.Equal(t, 10000, params.StreamingCfg.WALTieredMaxPendingEntries.GetAsInt())
+		assert.Equal(t, 30*time.Second, params.StreamingCfg.WALTieredCloseDrainTimeout.GetAsDurationByParse())
 		assert.Equal(t, 1*time.Second, params.StreamingCfg.LoggingAppendSlowThreshold.GetAsDurationByParse())
 		assert.Equal(t, 3*time.Second, params.StreamingCfg.WALRecoveryGracefulCloseTimeout.GetAsDurationByParse())
 		assert.Equal(t, 24*time.Hour, params.StreamingCfg.WALRecoverySchemaExpirationTolerance.GetAsDurationByParse())
 		assert.Equal(t, 100, params.StreamingCfg.WALRecoveryMaxDirtyMessage.GetAsInt())
 		assert.Equal(t, 10*time.Second, params.StreamingCfg.WALRecoveryPersistInterval.GetAsDurationByParse())
+		assert.Equal(t, time.Minute, params.StreamingCfg.WALRecoveryRetentionSyncInterval.GetAsDurationByParse())
+		assert.Equal(t, 16, params.StreamingCfg.WALRecoveryMaxConcurrency.GetAsInt())
+		assert.False(t, params.StreamingCfg.WALRecoveryHandoffEnabled.GetAsBool())
+		assert.Equal(t, 3*time.Second, params.StreamingCfg.WALRecoveryHandoffTimeout.GetAsDurationByParse())
 		assert.Equal(t, float64(0.6), params.StreamingCfg.FlushMemoryThreshold.GetAsFloat())
 		assert.Equal(t, float64(0.2), params.StreamingCfg.FlushGrowingSegmentBytesHwmThreshold.GetAsFloat())
 		assert.Equal(t, float64(0.1), params.StreamingCfg.FlushGrowingSegmentBytesLwmThreshold.GetAsFloat())
@@ -829,6 +930,15 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(512*1024), params.StreamingCfg.WALRateLimitAppendRateAdaptiveRateLimit.RecoveryIncremental.GetAsSize())
 		assert.Equal(t, 1*time.Second, params.StreamingCfg.WALRateLimitAppendRateAdaptiveRateLimit.RecoveryIncreaseInterval.GetAsDurationByParse())
 
+		// consumer lag rate limit
+		assert.Equal(t, false, params.StreamingCfg.WALRateLimitConsumerLagEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Minute, params.StreamingCfg.WALRateLimitConsumerLagSlowdownThreshold.GetAsDurationByParse())
+		assert.Equal(t, 30*time.Minute, params.StreamingCfg.WALRateLimitConsumerLagRejectThreshold.GetAsDurationByParse())
+		assert.Equal(t, 5*time.Minute, params.StreamingCfg.WALRateLimitConsumerLagRecoverThreshold.GetAsDurationByParse())
+		assert.Equal(t, int64(16*1024*1024), params.StreamingCfg.WALRateLimitConsumerLagAdaptiveRateLimit.SlowdownHWM.GetAsSize())
+		assert.Equal(t, int64(32*1024*1024), params.StreamingCfg.WALRateLimitConsumerLagAdaptiveRateLimit.RecoveryHWM.GetAsSize())
+		assert.Equal(t, 1*time.Second, params.StreamingCfg.WALRateLimitRejectRetryAfter.GetAsDurationByParse())
+
 		// node memory adaptive rate limit
 		assert.Equal(t, 0*time.Second, params.StreamingCfg.WALRateLimitNodeMemoryAdaptiveRateLimit.SlowdownStartupDelayInterval.GetAsDurationByParse())
 		assert.Equal(t, int64(4*1024*1024), params.StreamingCfg.WALRateLimitNodeMemoryAdaptiveRateLimit.SlowdownHWM.GetAsSize())
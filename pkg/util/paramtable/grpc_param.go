@@ -149,6 +149,21 @@ func (p *grpcConfig) GetClusterTLSConfig(clusterID string) (caPemPath, clientPem
 	return
 }
 
+// GetClusterServerName returns the server name used to verify the certificate of the target cluster for CDC outbound connections.
+// Reads from tls.clusters.<clusterID>.serverName.
+// Returns empty string if not configured, then the host of the target cluster uri is used.
+func (p *grpcConfig) GetClusterServerName(clusterID string) string {
+	return p.base.Get("tls.clusters." + clusterID + ".serverName")
+}
+
+// GetClusterToken returns the token used to authenticate to the target cluster for CDC outbound connections.
+// Reads from grpc.clusters.<clusterID>.token, which overrides the token of the connection param in the replicate configuration,
+// so the credential of the target cluster can be kept in the local config instead of the replicate configuration.
+// Returns empty string if not configured.
+func (p *grpcConfig) GetClusterToken(clusterID string) string {
+	return p.base.Get("grpc.clusters." + clusterID + ".token")
+}
+
 // GetClusterAuthority returns the gRPC :authority header for CDC outbound connections.
 // Reads from grpc.clusters.<clusterID>.authority.
 // Returns empty string if not configured.
@@ -201,6 +201,14 @@ func TestGrpcClientParams(t *testing.T) {
 	// Unknown cluster returns empty string
 	authority = clientConfig.GetClusterAuthority("unknown-cluster")
 	assert.Equal(t, "", authority)
+
+	// Per-cluster TLS server name and token config lookup
+	base.Save("tls.clusters.cluster-b.serverName", "milvus.cluster-b.example.com")
+	base.Save("grpc.clusters.cluster-b.token", "root:Milvus")
+	assert.Equal(t, "milvus.cluster-b.example.com", clientConfig.GetClusterServerName("cluster-b"))
+	assert.Equal(t, "root:Milvus", clientConfig.GetClusterToken("cluster-b"))
+	assert.Equal(t, "", clientConfig.GetClusterServerName("unknown-cluster"))
+	assert.Equal(t, "", clientConfig.GetClusterToken("unknown-cluster"))
 }
 
 func TestInternalTLSParams(t *testing.T) {
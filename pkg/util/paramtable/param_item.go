@@ -344,7 +344,12 @@ func (pi *ParamItem) GetAsDurationByParse() time.Duration {
 }
 
 func (pi *ParamItem) GetAsSize() int64 {
-	valueStr := strings.ToLower(pi.GetValue())
+	return ParseAsSize(pi.GetValue())
+}
+
+// ParseAsSize parses the size string with the optional unit suffix such as 10mb or 1g, returns 0 if the value is invalid.
+func ParseAsSize(v string) int64 {
+	valueStr := strings.ToLower(v)
 	if strings.HasSuffix(valueStr, "g") || strings.HasSuffix(valueStr, "gb") {
 		size, err := strconv.ParseInt(strings.Split(valueStr, "g")[0], 10, 64)
 		if err != nil {
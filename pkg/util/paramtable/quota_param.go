@@ -99,6 +99,8 @@ type quotaConfig struct {
 	DMLMinDeleteRatePerPartition    ParamItem `refreshable:"true"`
 	DMLMaxBulkLoadRatePerPartition  ParamItem `refreshable:"true"`
 	DMLMinBulkLoadRatePerPartition  ParamItem `refreshable:"true"`
+	DMLWALQuotaEnabled              ParamItem `refreshable:"true"`
+	DMLWALMaxRowsPerCollection      ParamItem `refreshable:"true"`
 
 	// dql
 	DQLLimitEnabled               ParamItem `refreshable:"true"`
@@ -1086,6 +1088,24 @@ To use this setting, set quotaAndLimits.dml.enabled to true at the same time.`,
 	}
 	p.DMLMinBulkLoadRatePerPartition.Init(base.mgr)
 
+	p.DMLWALQuotaEnabled = ParamItem{
+		Key:          "quotaAndLimits.dml.walQuota.enabled",
+		Version:      "3.0.0",
+		DefaultValue: "false",
+		Doc:          "Whether to push the collection insert quotas to streaming nodes, so the wal rejects the appends of a collection writing beyond its quota.",
+		Export:       true,
+	}
+	p.DMLWALQuotaEnabled.Init(base.mgr)
+
+	p.DMLWALMaxRowsPerCollection = ParamItem{
+		Key:          "quotaAndLimits.dml.walQuota.collection.maxRows",
+		Version:      "3.0.0",
+		DefaultValue: "-1",
+		Doc:          "Rows/s, the highest append rate of rows per collection in the wal, default no limit.",
+		Export:       true,
+	}
+	p.DMLWALMaxRowsPerCollection.Init(base.mgr)
+
 	// dql
 	p.DQLLimitEnabled = ParamItem{
 		Key:          "quotaAndLimits.dql.enabled",
@@ -83,6 +83,16 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, float64(1)*1024*1024, params.QuotaConfig.DMLMinBulkLoadRate.GetAsFloat())
 	})
 
+	t.Run("test wal quota", func(t *testing.T) {
+		params.Init(NewBaseTable(SkipRemote(true)))
+		assert.False(t, params.QuotaConfig.DMLWALQuotaEnabled.GetAsBool())
+		assert.Equal(t, float64(-1), params.QuotaConfig.DMLWALMaxRowsPerCollection.GetAsFloat())
+		params.Save(params.QuotaConfig.DMLWALQuotaEnabled.Key, "true")
+		params.Save(params.QuotaConfig.DMLWALMaxRowsPerCollection.Key, "1000")
+		assert.True(t, params.QuotaConfig.DMLWALQuotaEnabled.GetAsBool())
+		assert.Equal(t, float64(1000), params.QuotaConfig.DMLWALMaxRowsPerCollection.GetAsFloat())
+	})
+
 	t.Run("test collection dml", func(t *testing.T) {
 		params.Init(NewBaseTable(SkipRemote(true)))
 		params.Save(params.QuotaConfig.DMLLimitEnabled.Key, "true")
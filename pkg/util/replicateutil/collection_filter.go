@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicateutil
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+const filterWildcard = "*"
+
+// collectionFilterRule is a rule of the collection filter, the wildcard matches any name.
+type collectionFilterRule struct {
+	database   string
+	collection string
+}
+
+// matchDatabase checks if the rule may match any collection of the database.
+func (r collectionFilterRule) matchDatabase(dbName string) bool {
+	return r.database == filterWildcard || r.database == dbName
+}
+
+// matchCollection checks if the rule matches the collection.
+func (r collectionFilterRule) matchCollection(dbName string, collectionName string) bool {
+	return r.matchDatabase(dbName) && (r.collection == filterWildcard || r.collection == collectionName)
+}
+
+// matchWholeDatabase checks if the rule matches all collections of the database.
+func (r collectionFilterRule) matchWholeDatabase(dbName string) bool {
+	return r.matchDatabase(dbName) && r.collection == filterWildcard
+}
+
+// CollectionFilter selects the databases and collections to be replicated.
+// A collection is replicated if it matches any include rule (or the include rules are empty)
+// and doesn't match any exclude rule.
+type CollectionFilter struct {
+	includes []collectionFilterRule
+	excludes []collectionFilterRule
+}
+
+// NewCollectionFilter creates a collection filter from the include and exclude rules.
+// The rule is in the form of `<database>.<collection>` or `<database>`, which is short for `<database>.*`,
+// and `*` matches any name, e.g. `db1.*` selects all collections of db1, `*.orders` selects the orders collection of all databases.
+func NewCollectionFilter(includes []string, excludes []string) (*CollectionFilter, error) {
+	f := &CollectionFilter{}
+	var err error
+	if f.includes, err = parseCollectionFilterRules(includes); err != nil {
+		return nil, err
+	}
+	if f.excludes, err = parseCollectionFilterRules(excludes); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// NewCollectionFilterFromProto creates a collection filter from the collection filter of a target cluster.
+// The nil filter selects all databases and collections.
+func NewCollectionFilterFromProto(filter *messagespb.ReplicateCollectionFilter) (*CollectionFilter, error) {
+	return NewCollectionFilter(filter.GetIncludes(), filter.GetExcludes())
+}
+
+// CollectionFiltersEqual checks if the collection filters of the target clusters are the same.
+// The filter without any rule is same as the absent one.
+func CollectionFiltersEqual(a, b map[string]*messagespb.ReplicateCollectionFilter) bool {
+	a, b = pruneCollectionFilters(a), pruneCollectionFilters(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for clusterID, filter := range a {
+		if !proto.Equal(filter, b[clusterID]) {
+			return false
+		}
+	}
+	return true
+}
+
+// pruneCollectionFilters removes the filters without any rule.
+func pruneCollectionFilters(filters map[string]*messagespb.ReplicateCollectionFilter) map[string]*messagespb.ReplicateCollectionFilter {
+	pruned := make(map[string]*messagespb.ReplicateCollectionFilter, len(filters))
+	for clusterID, filter := range filters {
+		if len(filter.GetIncludes()) == 0 && len(filter.GetExcludes()) == 0 {
+			continue
+		}
+		pruned[clusterID] = filter
+	}
+	return pruned
+}
+
+// parseCollectionFilterRules parses the collection filter rules, the empty rule is ignored.
+func parseCollectionFilterRules(rules []string) ([]collectionFilterRule, error) {
+	parsed := make([]collectionFilterRule, 0, len(rules))
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		parts := strings.Split(rule, ".")
+		if len(parts) == 1 {
+			parts = append(parts, filterWildcard)
+		}
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid replication filter rule %q, should be <database>.<collection> or <database>", rule)
+		}
+		parsed = append(parsed, collectionFilterRule{database: parts[0], collection: parts[1]})
+	}
+	return parsed, nil
+}
+
+// IsEmpty checks if the filter has no rule, so all databases and collections are replicated.
+func (f *CollectionFilter) IsEmpty() bool {
+	return f == nil || (len(f.includes) == 0 && len(f.excludes) == 0)
+}
+
+// AllowCollection checks if the collection should be replicated.
+func (f *CollectionFilter) AllowCollection(dbName string, collectionName string) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	for _, rule := range f.excludes {
+		if rule.matchCollection(dbName, collectionName) {
+			return false
+		}
+	}
+	if len(f.includes) == 0 {
+		return true
+	}
+	for _, rule := range f.includes {
+		if rule.matchCollection(dbName, collectionName) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowDatabase checks if the database level operations should be replicated.
+// The database is replicated unless no collection of it can be replicated.
+func (f *CollectionFilter) AllowDatabase(dbName string) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	for _, rule := range f.excludes {
+		if rule.matchWholeDatabase(dbName) {
+			return false
+		}
+	}
+	if len(f.includes) == 0 {
+		return true
+	}
+	for _, rule := range f.includes {
+		if rule.matchDatabase(dbName) {
+			return true
+		}
+	}
+	return false
+}
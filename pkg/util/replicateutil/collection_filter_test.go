@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replicateutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v3/proto/messagespb"
+	"github.com/milvus-io/milvus/pkg/v3/util/merr"
+)
+
+func TestCollectionFilter(t *testing.T) {
+	f, err := NewCollectionFilter(nil, []string{"", " "})
+	assert.NoError(t, err)
+	assert.True(t, f.IsEmpty())
+	assert.True(t, f.AllowCollection("db1", "c1"))
+	assert.True(t, f.AllowDatabase("db1"))
+
+	var nilFilter *CollectionFilter
+	assert.True(t, nilFilter.IsEmpty())
+	assert.True(t, nilFilter.AllowCollection("db1", "c1"))
+
+	_, err = NewCollectionFilter([]string{"db1.c1.c2"}, nil)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = NewCollectionFilter(nil, []string{".c1"})
+	assert.Error(t, err)
+	_, err = NewCollectionFilter(nil, []string{"db1."})
+	assert.Error(t, err)
+
+	// only the excludes.
+	f, err = NewCollectionFilter(nil, []string{"db1", "*.tmp"})
+	assert.NoError(t, err)
+	assert.False(t, f.IsEmpty())
+	assert.False(t, f.AllowCollection("db1", "c1"))
+	assert.False(t, f.AllowCollection("db2", "tmp"))
+	assert.True(t, f.AllowCollection("db2", "c1"))
+	assert.False(t, f.AllowDatabase("db1"))
+	assert.True(t, f.AllowDatabase("db2"))
+
+	// the excludes take precedence over the includes.
+	f, err = NewCollectionFilter([]string{"default.*", "db1.orders"}, []string{"default.tmp"})
+	assert.NoError(t, err)
+	assert.True(t, f.AllowCollection("default", "c1"))
+	assert.False(t, f.AllowCollection("default", "tmp"))
+	assert.True(t, f.AllowCollection("db1", "orders"))
+	assert.False(t, f.AllowCollection("db1", "users"))
+	assert.False(t, f.AllowCollection("db2", "orders"))
+	assert.True(t, f.AllowDatabase("default"))
+	assert.True(t, f.AllowDatabase("db1"))
+	assert.False(t, f.AllowDatabase("db2"))
+
+	f, err = NewCollectionFilter([]string{"*.orders"}, nil)
+	assert.NoError(t, err)
+	assert.True(t, f.AllowCollection("db2", "orders"))
+	assert.False(t, f.AllowCollection("db2", "users"))
+	assert.True(t, f.AllowDatabase("db2"))
+}
+
+func TestCollectionFilterFromProto(t *testing.T) {
+	f, err := NewCollectionFilterFromProto(nil)
+	assert.NoError(t, err)
+	assert.True(t, f.IsEmpty())
+
+	f, err = NewCollectionFilterFromProto(&messagespb.ReplicateCollectionFilter{Includes: []string{"db1"}, Excludes: []string{"db1.tmp"}})
+	assert.NoError(t, err)
+	assert.True(t, f.AllowCollection("db1", "c1"))
+	assert.False(t, f.AllowCollection("db1", "tmp"))
+
+	_, err = NewCollectionFilterFromProto(&messagespb.ReplicateCollectionFilter{Includes: []string{"db1.c1.c2"}})
+	assert.Error(t, err)
+
+	filters := map[string]*messagespb.ReplicateCollectionFilter{
+		"by-dev2": {Includes: []string{"db1"}},
+		"by-dev3": {},
+	}
+	assert.True(t, CollectionFiltersEqual(nil, map[string]*messagespb.ReplicateCollectionFilter{"by-dev2": {}}))
+	assert.True(t, CollectionFiltersEqual(filters, map[string]*messagespb.ReplicateCollectionFilter{"by-dev2": {Includes: []string{"db1"}}}))
+	assert.False(t, CollectionFiltersEqual(filters, nil))
+	assert.False(t, CollectionFiltersEqual(filters, map[string]*messagespb.ReplicateCollectionFilter{"by-dev2": {Includes: []string{"db2"}}}))
+	assert.False(t, CollectionFiltersEqual(filters, map[string]*messagespb.ReplicateCollectionFilter{"by-dev3": {Includes: []string{"db1"}}}))
+}
@@ -68,6 +68,9 @@ func (v *ReplicateConfigValidator) Validate() error {
 	if err := v.validateTopologyEdgeUniqueness(topologies); err != nil {
 		return err
 	}
+	if err := v.validateTopologyConflict(topologies); err != nil {
+		return err
+	}
 	if err := v.validateTopologyTypeConstraint(topologies); err != nil {
 		return err
 	}
@@ -189,6 +192,94 @@ func (v *ReplicateConfigValidator) validateTopologyEdgeUniqueness(topologies []*
 	return nil
 }
 
+// validateTopologyConflict validates that the topology has no conflicting replication relationships,
+// which can not be applied by the cdc and will be rejected with a precise reason:
+// a cluster replicating to itself, a replication cycle, a cluster replicated from multiple sources, and multiple primaries.
+func (v *ReplicateConfigValidator) validateTopologyConflict(topologies []*commonpb.CrossClusterTopology) error {
+	if len(topologies) == 0 {
+		return nil
+	}
+	targets := make(map[string][]string)
+	sources := make(map[string][]string)
+	for i, topology := range topologies {
+		source := topology.GetSourceClusterId()
+		target := topology.GetTargetClusterId()
+		if source == target {
+			return merr.WrapErrParameterInvalidMsg("topology at index %d replicates cluster '%s' to itself", i, source)
+		}
+		targets[source] = append(targets[source], target)
+		sources[target] = append(sources[target], source)
+	}
+	if cycle := findReplicationCycle(targets); len(cycle) > 0 {
+		return merr.WrapErrParameterInvalidMsg("replication cycle found: %s", strings.Join(cycle, " -> "))
+	}
+	for target, s := range sources {
+		if len(s) > 1 {
+			slices.Sort(s)
+			return merr.WrapErrParameterInvalidMsg("cluster '%s' is replicated from multiple source clusters: %v, a cluster can only have one source", target, s)
+		}
+	}
+	primaries := make([]string, 0, 1)
+	for source := range targets {
+		if len(sources[source]) == 0 {
+			primaries = append(primaries, source)
+		}
+	}
+	if len(primaries) > 1 {
+		slices.Sort(primaries)
+		return merr.WrapErrParameterInvalidMsg("multiple primary clusters found: %v, only one primary cluster is allowed", primaries)
+	}
+	return nil
+}
+
+// findReplicationCycle returns the clusters on a replication cycle with the first cluster repeated at the end,
+// or nil if there's no cycle. The clusters are visited in order of id to keep the result stable.
+func findReplicationCycle(targets map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[string]int)
+	path := make([]string, 0)
+	var visit func(cluster string) []string
+	visit = func(cluster string) []string {
+		states[cluster] = visiting
+		path = append(path, cluster)
+		next := slices.Clone(targets[cluster])
+		slices.Sort(next)
+		for _, target := range next {
+			switch states[target] {
+			case visiting:
+				start := slices.Index(path, target)
+				return append(slices.Clone(path[start:]), target)
+			case unvisited:
+				if cycle := visit(target); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		states[cluster] = visited
+		return nil
+	}
+
+	clusters := make([]string, 0, len(targets))
+	for cluster := range targets {
+		clusters = append(clusters, cluster)
+	}
+	slices.Sort(clusters)
+	for _, cluster := range clusters {
+		if states[cluster] != unvisited {
+			continue
+		}
+		if cycle := visit(cluster); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
 // validateTopologyTypeConstraint validates that currently only STAR topology is supported
 func (v *ReplicateConfigValidator) validateTopologyTypeConstraint(topologies []*commonpb.CrossClusterTopology) error {
 	if len(topologies) == 0 {
@@ -702,6 +702,65 @@ func TestReplicateConfigValidator_validateTopologyTypeConstraint(t *testing.T) {
 	})
 }
 
+func TestReplicateConfigValidator_validateTopologyConflict(t *testing.T) {
+	validator := &ReplicateConfigValidator{
+		clusterMap: map[string]*commonpb.MilvusCluster{
+			"cluster-1": {ClusterId: "cluster-1"},
+			"cluster-2": {ClusterId: "cluster-2"},
+			"cluster-3": {ClusterId: "cluster-3"},
+			"cluster-4": {ClusterId: "cluster-4"},
+		},
+	}
+	edges := func(pairs ...string) []*commonpb.CrossClusterTopology {
+		topologies := make([]*commonpb.CrossClusterTopology, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			topologies = append(topologies, &commonpb.CrossClusterTopology{
+				SourceClusterId: pairs[i],
+				TargetClusterId: pairs[i+1],
+			})
+		}
+		return topologies
+	}
+
+	t.Run("success - empty topologies", func(t *testing.T) {
+		assert.NoError(t, validator.validateTopologyConflict(nil))
+	})
+
+	t.Run("success - single primary", func(t *testing.T) {
+		assert.NoError(t, validator.validateTopologyConflict(edges("cluster-1", "cluster-2", "cluster-1", "cluster-3")))
+	})
+
+	t.Run("error - self replication", func(t *testing.T) {
+		err := validator.validateTopologyConflict(edges("cluster-1", "cluster-2", "cluster-2", "cluster-2"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "topology at index 1 replicates cluster 'cluster-2' to itself")
+	})
+
+	t.Run("error - active-active cycle", func(t *testing.T) {
+		err := validator.validateTopologyConflict(edges("cluster-1", "cluster-2", "cluster-2", "cluster-1"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "replication cycle found: cluster-1 -> cluster-2 -> cluster-1")
+	})
+
+	t.Run("error - cycle without primary", func(t *testing.T) {
+		err := validator.validateTopologyConflict(edges("cluster-4", "cluster-1", "cluster-1", "cluster-2", "cluster-2", "cluster-3", "cluster-3", "cluster-1"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "replication cycle found: cluster-1 -> cluster-2 -> cluster-3 -> cluster-1")
+	})
+
+	t.Run("error - multiple sources", func(t *testing.T) {
+		err := validator.validateTopologyConflict(edges("cluster-1", "cluster-3", "cluster-2", "cluster-3"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cluster 'cluster-3' is replicated from multiple source clusters: [cluster-1 cluster-2]")
+	})
+
+	t.Run("error - multiple primaries", func(t *testing.T) {
+		err := validator.validateTopologyConflict(edges("cluster-1", "cluster-3", "cluster-2", "cluster-4"))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "multiple primary clusters found: [cluster-1 cluster-2]")
+	})
+}
+
 func TestEqualIgnoreOrder(t *testing.T) {
 	t.Run("success - same slices in different order", func(t *testing.T) {
 		a := []string{"a", "b", "c"}